@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/signer"
+)
+
+// Build information (set via ldflags)
+var (
+	Version    = "dev"
+	CommitHash = "unknown"
+	BuildTime  = "unknown"
+)
+
+// EnvSignerPassword is the password of the keystore, read instead of asking for it
+const EnvSignerPassword = "LAUNCHPAD_SIGNER_PASSWORD"
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Launchpad Signer signs a transaction session of the launchpad from the terminal\n\n")
+	fmt.Fprintf(os.Stderr, "Usage: %s [options] <session url>\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Options:\n")
+	flag.PrintDefaults()
+	fmt.Fprintf(os.Stderr, "\nThe keystore password is read from --password-file, %s or the terminal.\n", EnvSignerPassword)
+}
+
+func main() {
+	var showVersion = flag.Bool("version", false, "Show version information")
+	var keystorePath = flag.String("keystore", "", "Keystore file of the signing account (geth, clef or cast wallet JSON)")
+	var passwordFile = flag.String("password-file", "", "File containing the keystore password")
+	var useLedger = flag.Bool("ledger", false, "Sign with a Ledger instead of a keystore (requires a build with -tags ledger)")
+	var ledgerPath = flag.String("ledger-path", signer.DefaultLedgerPath, "Derivation path of the Ledger account")
+	var rpcURL = flag.String("rpc", "", "RPC used instead of the RPC of the session chain")
+	var yes = flag.Bool("yes", false, "Sign and broadcast without asking for confirmation")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Printf("Launchpad Signer\nVersion: %s\nCommit: %s\nBuilt: %s\n", Version, CommitHash, BuildTime)
+		return
+	}
+	if flag.NArg() != 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	stdin := bufio.NewReader(os.Stdin)
+	var wallet signer.Wallet
+	var err error
+	switch {
+	case *useLedger && *keystorePath != "":
+		err = fmt.Errorf("use either --keystore or --ledger")
+	case *useLedger:
+		fmt.Fprintln(os.Stderr, "Confirm every transaction on the Ledger")
+		wallet, err = signer.OpenLedger(*ledgerPath)
+	case *keystorePath != "":
+		var password string
+		password, err = readPassword(*passwordFile, stdin)
+		if err == nil {
+			wallet, err = signer.OpenKeystore(*keystorePath, password)
+		}
+	default:
+		err = fmt.Errorf("--keystore or --ledger is required")
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	err = signer.Run(ctx, signer.Options{
+		URL:    flag.Arg(0),
+		Wallet: wallet,
+		RPC:    *rpcURL,
+		Yes:    *yes,
+		In:     stdin,
+		Out:    os.Stdout,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// readPassword reads the keystore password from the file, the environment or a line of the terminal
+func readPassword(passwordFile string, stdin *bufio.Reader) (string, error) {
+	if passwordFile != "" {
+		password, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the password file: %w", err)
+		}
+		return strings.TrimRight(string(password), "\r\n"), nil
+	}
+	if password, ok := os.LookupEnv(EnvSignerPassword); ok {
+		return password, nil
+	}
+	// the password is echoed, --password-file or the environment keep it off the screen
+	fmt.Fprint(os.Stderr, "Keystore password (echoed): ")
+	password, err := stdin.ReadString('\n')
+	if err != nil && password == "" {
+		return "", fmt.Errorf("failed to read the password: %w", err)
+	}
+	return strings.TrimRight(password, "\r\n"), nil
+}
@@ -1,17 +1,22 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 
 	"github.com/rxtech-lab/launchpad-mcp/internal/api"
 	"github.com/rxtech-lab/launchpad-mcp/internal/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/selftest"
 	"github.com/rxtech-lab/launchpad-mcp/internal/server"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
 // Build information (set via ldflags)
@@ -21,16 +26,20 @@ var (
 	BuildTime  = "unknown"
 )
 
-func configureAndStartServer(dbService services.DBService, port int) (*api.APIServer, int, error) {
+// configureAndStartServer starts the API server and the MCP server.
+// localAuthToken is the per-run secret required by the API routes and included in the generated urls
+func configureAndStartServer(dbService services.DBService, port int, localAuthToken string) (*api.APIServer, int, error) {
 	// Initialize services and hooks
 	evmService, txService, uniswapService, liquidityService, hookService, chainService, templateService, deploymentService, uniswapContractService := server.InitializeServices(dbService.GetDB())
-	tokenDeploymentHook, uniswapDeploymentHook, liquidityHook := server.InitializeHooks(dbService.GetDB(), hookService, uniswapService, deploymentService, liquidityService, uniswapContractService, chainService)
-	server.RegisterHooks(hookService, tokenDeploymentHook, uniswapDeploymentHook, liquidityHook)
+	tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook := server.InitializeHooks(dbService.GetDB(), hookService, uniswapService, deploymentService, liquidityService, uniswapContractService, chainService)
+	server.RegisterHooks(hookService, tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook)
 
 	// Initialize API server (HTTP server for transaction signing) - NO AUTHENTICATION
-	apiServer := api.NewAPIServer(dbService, txService, hookService, chainService)
+	apiServer := api.NewAPIServer(dbService, txService, hookService, chainService, deploymentService, liquidityService, uniswapContractService)
 
-	// Setup routes WITHOUT enabling authentication (key difference from streamable-http)
+	// Require the local auth token instead of user authentication (key difference from streamable-http)
+	// so other local processes cannot read the session data
+	apiServer.EnableLocalAuthentication(localAuthToken)
 	apiServer.SetupRoutes()
 	// NOTE: NOT calling EnableAuthentication() or EnableStreamableHttp()
 
@@ -47,17 +56,37 @@ func configureAndStartServer(dbService services.DBService, port int) (*api.APISe
 	// Now initialize MCP server with the actual port
 	mcpServer := mcp.NewMCPServer(dbService, startedPort, evmService, txService, uniswapService, liquidityService, chainService, templateService, deploymentService)
 	apiServer.SetMCPServer(mcpServer)
+	mcpServer.StartBackgroundJobs()
 
 	return apiServer, startedPort, nil
 }
 
 func main() {
+	// The environment provides the defaults of the database flags
+	envPool, err := services.ConnectionPoolFromEnv()
+	if err != nil {
+		log.Fatal("Invalid database configuration:", err)
+	}
+
 	// Command line flags
 	var showVersion = flag.Bool("version", false, "Show version information")
 	var showHelp = flag.Bool("help", false, "Show help information")
 	var enableLog = flag.Bool("log", false, "Enable logging output")
+	var runMigrations = flag.Bool("migrate", false, "Create or update the schema of the SQLite or Turso database and exit")
+	var runSelfTest = flag.Bool("selftest", false, "Run the launchpad workflow against a managed Anvil and exit")
+	var anvilPath = flag.String("anvil", "anvil", "Anvil binary used by --selftest")
+	var forkURL = flag.String("fork-url", "", "RPC forked by the Anvil of --selftest")
+	var dbPath = flag.String("db-path", os.Getenv("LAUNCHPAD_DB"), "SQLite database file (default: ~/launchpad.db)")
+	var maxOpenConns = flag.Int("db-max-open-conns", envPool.MaxOpenConns, "Maximum number of open database connections, 0 is unlimited")
+	var maxIdleConns = flag.Int("db-max-idle-conns", envPool.MaxIdleConns, "Maximum number of idle database connections, 0 keeps the default")
+	var connMaxLifetime = flag.Duration("db-conn-max-lifetime", envPool.ConnMaxLifetime, "Maximum time a database connection is reused, 0 is forever")
+	var publicURL = flag.String("public-url", "", "Public url of the signing pages in the generated links, e.g. behind a reverse proxy or tunnel (overrides BASE_URL)")
 	flag.Parse()
 
+	if err := utils.SetPublicBaseUrl(*publicURL); err != nil {
+		log.Fatal("Invalid --public-url:", err)
+	}
+
 	// Disable logging by default
 	if !*enableLog {
 		log.SetOutput(io.Discard)
@@ -74,23 +103,54 @@ func main() {
 
 	if *showHelp {
 		log.Printf("Crypto Launchpad MCP Server\n\n")
-		log.Printf("Usage: %s [options]\n\n", os.Args[0])
+		log.Printf("Usage: %s [options] [command]\n\n", os.Args[0])
 		log.Printf("Options:\n")
 		log.Printf("  --version    Show version information\n")
 		log.Printf("  --help       Show this help message\n")
-		log.Printf("  --log        Enable logging output\n\n")
+		log.Printf("  --log        Enable logging output\n")
+		log.Printf("  --migrate    Create or update the schema of the SQLite or Turso database and exit\n")
+		log.Printf("  --selftest   Run the launchpad workflow against a managed Anvil and exit\n")
+		log.Printf("  --anvil      Anvil binary used by --selftest (default: anvil)\n")
+		log.Printf("  --fork-url   RPC forked by the Anvil of --selftest\n")
+		log.Printf("  --public-url Public url of the signing pages in the generated links (env: BASE_URL)\n")
+		log.Printf("  --db-path    SQLite database file (env: LAUNCHPAD_DB, default: ~/launchpad.db)\n")
+		log.Printf("  --db-max-open-conns    Maximum open database connections (env: LAUNCHPAD_DB_MAX_OPEN_CONNS)\n")
+		log.Printf("  --db-max-idle-conns    Maximum idle database connections (env: LAUNCHPAD_DB_MAX_IDLE_CONNS)\n")
+		log.Printf("  --db-conn-max-lifetime Maximum database connection lifetime, e.g. 30m (env: LAUNCHPAD_DB_CONN_MAX_LIFETIME)\n\n")
+		log.Printf("Commands:\n")
+		log.Printf("  export [--out backup.json]  Back up chains, templates, deployments, pools and settings\n")
+		log.Printf("  import --in backup.json     Restore a backup into an empty database\n\n")
 		log.Printf("Description:\n")
 		log.Printf("  AI-powered crypto launchpad supporting Ethereum and Solana blockchains.\n")
 		log.Printf("  Provides 17 MCP tools for token deployment and Uniswap integration.\n\n")
-		log.Printf("Database: ~/launchpad.db (SQLite)\n")
+		log.Printf("Database: ~/launchpad.db (SQLite) unless --db-path or TURSO_DATABASE_URL is set\n")
 		log.Printf("Web Interface: http://localhost:[random-port]\n")
 		return
 	}
 
-	// Get home directory for database
-	homePath, err := os.UserHomeDir()
-	if err != nil {
-		log.Fatal("Failed to get home directory:", err)
+	if *runSelfTest {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		report, err := selftest.Run(ctx, selftest.Options{AnvilPath: *anvilPath, ForkURL: *forkURL})
+		stop()
+		if err != nil {
+			// Logging is disabled by default, report the failure on stderr
+			fmt.Fprintln(os.Stderr, "Self test failed to start:", err)
+			os.Exit(1)
+		}
+		report.Write(os.Stdout)
+		if !report.Passed() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Default to the database in the home directory
+	if *dbPath == "" {
+		homePath, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatal("Failed to get home directory:", err)
+		}
+		*dbPath = filepath.Join(homePath, "launchpad.db")
 	}
 
 	// Initialize database
@@ -104,16 +164,43 @@ func main() {
 			log.Fatal("Failed to initialize Turso database:", err)
 		}
 	} else {
-		dbPath := homePath + "/launchpad.db"
-		dbService, err = services.NewSqliteDBService(dbPath)
+		dbService, err = services.NewSqliteDBService(*dbPath)
 		if err != nil {
 			log.Fatal("Failed to initialize database:", err)
 		}
 	}
 	defer dbService.Close()
+	pool := services.ConnectionPool{MaxOpenConns: *maxOpenConns, MaxIdleConns: *maxIdleConns, ConnMaxLifetime: *connMaxLifetime}
+	if err := dbService.SetConnectionPool(pool); err != nil {
+		log.Fatal("Failed to configure database connection pool:", err)
+	}
+
+	// The SQLite and Turso schemas are created with AutoMigrate when the database is opened, --migrate only opens it
+	// so the schema of a new version can be applied before the MCP client starts the server
+	if *runMigrations {
+		fmt.Fprintln(os.Stderr, "Database schema is up to date")
+		return
+	}
+
+	// export and import subcommands back up and restore the database
+	if server.IsBackupCommand(flag.Args()) {
+		if err := server.RunBackupCommand(dbService.GetDB(), flag.Args(), os.Stdout); err != nil {
+			// Logging is disabled by default, report the failure on stderr
+			fmt.Fprintln(os.Stderr, "Backup command failed:", err)
+			dbService.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Generate the secret required by the API server for this run
+	localAuthToken, err := utils.GenerateLocalAuthToken()
+	if err != nil {
+		log.Fatal("Failed to generate local auth token:", err)
+	}
 
 	// Configure and start server
-	apiServer, port, err := configureAndStartServer(dbService, 0) // 0 for random port
+	apiServer, port, err := configureAndStartServer(dbService, 0, localAuthToken) // 0 for random port
 	if err != nil {
 		log.Fatal("Failed to start API server:", err)
 	}
@@ -141,6 +228,7 @@ func main() {
 	<-c
 
 	log.Println("\nShutting down servers...")
+	mcpServer.StopBackgroundJobs()
 
 	// Shutdown API server
 	if err := apiServer.Shutdown(); err != nil {
@@ -8,12 +8,15 @@ import (
 	"time"
 
 	"github.com/rxtech-lab/launchpad-mcp/internal/api"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+const stdioTestToken = "stdio-test-token"
+
 type StdioServerTestSuite struct {
 	suite.Suite
 	db        *gorm.DB
@@ -32,7 +35,7 @@ func (suite *StdioServerTestSuite) SetupSuite() {
 	dbService := services.NewDBServiceFromDB(db)
 
 	// Configure and start server using the refactored function
-	apiServer, port, err := configureAndStartServer(dbService, 0) // 0 for random port
+	apiServer, port, err := configureAndStartServer(dbService, 0, stdioTestToken) // 0 for random port
 	suite.Require().NoError(err)
 	suite.Require().NotZero(port, "Port should not be 0")
 
@@ -92,7 +95,8 @@ func (suite *StdioServerTestSuite) TestRoutesAccessibleWithoutAuth() {
 
 		suite.Require().NoError(err, "Failed to create request for %s", testRoute.description)
 
-		// Intentionally NOT setting Authorization header
+		// Intentionally NOT setting Authorization header, only the local auth token
+		req.Header.Set(middleware.LocalAuthTokenHeader, stdioTestToken)
 		resp, err := client.Do(req)
 		suite.Require().NoError(err, "Failed to make request for %s", testRoute.description)
 
@@ -105,6 +109,51 @@ func (suite *StdioServerTestSuite) TestRoutesAccessibleWithoutAuth() {
 	}
 }
 
+func (suite *StdioServerTestSuite) TestRoutesRequireLocalToken() {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	for _, token := range []string{"", "wrong-token"} {
+		req, err := http.NewRequest("GET", suite.getBaseURL()+"/tx/test-session-id", nil)
+		suite.Require().NoError(err)
+		if token != "" {
+			req.Header.Set(middleware.LocalAuthTokenHeader, token)
+		}
+
+		resp, err := client.Do(req)
+		suite.Require().NoError(err)
+		suite.Equal(http.StatusUnauthorized, resp.StatusCode, "token %q should be rejected", token)
+		_ = resp.Body.Close()
+	}
+}
+
+func (suite *StdioServerTestSuite) TestTokenInUrlSetsCookie() {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	// Generated urls carry the token as a query parameter
+	resp, err := client.Get(suite.getBaseURL() + "/tx/test-session-id?token=" + stdioTestToken)
+	suite.Require().NoError(err)
+	suite.NotEqual(http.StatusUnauthorized, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	var cookie *http.Cookie
+	for _, c := range resp.Cookies() {
+		if c.Name == middleware.LocalAuthTokenCookie {
+			cookie = c
+		}
+	}
+	suite.Require().NotNil(cookie, "the token should be stored in a cookie")
+	suite.True(cookie.HttpOnly)
+
+	// The page calls the API with the cookie only
+	req, err := http.NewRequest("GET", suite.getBaseURL()+"/static/tx/app.js", nil)
+	suite.Require().NoError(err)
+	req.AddCookie(cookie)
+	resp, err = client.Do(req)
+	suite.Require().NoError(err)
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	_ = resp.Body.Close()
+}
+
 func (suite *StdioServerTestSuite) TestNoMCPEndpointsInStdioMode() {
 	// Test that MCP endpoints are not registered in stdio mode
 	client := &http.Client{Timeout: 10 * time.Second}
@@ -121,6 +170,7 @@ func (suite *StdioServerTestSuite) TestNoMCPEndpointsInStdioMode() {
 		url := suite.getBaseURL() + path
 		req, err := http.NewRequest("GET", url, nil)
 		suite.Require().NoError(err)
+		req.Header.Set(middleware.LocalAuthTokenHeader, stdioTestToken)
 
 		resp, err := client.Do(req)
 		suite.Require().NoError(err)
@@ -166,6 +216,7 @@ func (suite *StdioServerTestSuite) TestStaticAssetsAccessible() {
 		url := suite.getBaseURL() + asset.path
 		req, err := http.NewRequest("GET", url, nil)
 		suite.Require().NoError(err)
+		req.Header.Set(middleware.LocalAuthTokenHeader, stdioTestToken)
 
 		resp, err := client.Do(req)
 		suite.Require().NoError(err)
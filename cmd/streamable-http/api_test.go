@@ -9,7 +9,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/rxtech-lab/launchpad-mcp/internal/api"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -30,7 +32,7 @@ func (suite *StreamableHTTPTestSuite) SetupSuite() {
 	suite.db = db
 
 	// Configure and start server using the refactored function
-	apiServer, port, err := configureAndStartServer(db, 0) // 0 for random port
+	apiServer, port, err := configureAndStartServer(db, 0, nil) // 0 for random port
 	suite.Require().NoError(err)
 	suite.Require().NotZero(port, "Port should not be 0")
 
@@ -293,6 +295,38 @@ func (suite *StreamableHTTPTestSuite) TestAuthenticatedRequestsWork() {
 	_ = resp.Body.Close()
 }
 
+func (suite *StreamableHTTPTestSuite) TestRESTAPICallsTools() {
+	// The suite database is not migrated, list_chains needs its table
+	suite.Require().NoError(suite.db.AutoMigrate(&models.Chain{}, &models.AuditLog{}))
+	client := &http.Client{Timeout: 10 * time.Second}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "rest-user"}).SignedString([]byte("test-secret"))
+	suite.Require().NoError(err)
+
+	get := func(path, token string) (int, map[string]any) {
+		req, err := http.NewRequest("GET", suite.getBaseURL()+path, nil)
+		suite.Require().NoError(err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := client.Do(req)
+		suite.Require().NoError(err)
+		defer resp.Body.Close()
+		var body map[string]any
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	status, _ := get("/api/v1/chains", "")
+	suite.Equal(http.StatusUnauthorized, status)
+
+	status, body := get("/api/v1/chains", token)
+	suite.Equal(http.StatusOK, status, body)
+
+	status, spec := get("/api/v1/openapi.json", token)
+	suite.Require().Equal(http.StatusOK, status)
+	suite.Contains(spec["paths"], "/api/v1/deployments")
+}
+
 func TestStreamableHTTPTestSuite(t *testing.T) {
 	suite.Run(t, new(StreamableHTTPTestSuite))
 }
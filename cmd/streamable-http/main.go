@@ -1,21 +1,68 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 
 	_ "github.com/joho/godotenv/autoload" // Automatically load .env file if present
 	"github.com/rxtech-lab/launchpad-mcp/internal/api"
 	"github.com/rxtech-lab/launchpad-mcp/internal/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/migrations"
+	"github.com/rxtech-lab/launchpad-mcp/internal/selftest"
 	"github.com/rxtech-lab/launchpad-mcp/internal/server"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 	"gorm.io/gorm"
 )
 
-func configureAndStartServer(db *gorm.DB, port int) (*api.APIServer, int, error) {
+// openDatabase connects to Turso when configured, otherwise to PostgreSQL with the optional read replica,
+// with the connection pool tuning of the LAUNCHPAD_DB_* environment variables
+func openDatabase() (services.DBService, error) {
+	pool, err := services.ConnectionPoolFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	var dbService services.DBService
+	tursoURL := os.Getenv("TURSO_DATABASE_URL")
+	tursoAuthToken := os.Getenv("TURSO_AUTH_TOKEN")
+	if tursoURL != "" {
+		dbService, err = services.NewTursoDBService(tursoURL, tursoAuthToken)
+	} else {
+		postgresUrl := os.Getenv("POSTGRES_URL")
+		dbService, err = services.NewPostgresDBService(postgresUrl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The list and report tools and the signing page read from the replica when configured
+	if replicaUrl := os.Getenv("POSTGRES_REPLICA_URL"); replicaUrl != "" && tursoURL == "" {
+		replica, err := services.NewPostgresReplica(replicaUrl)
+		if err != nil {
+			dbService.Close()
+			return nil, err
+		}
+		dbService = services.NewReplicaDBService(dbService, replica)
+	}
+
+	if err := dbService.SetConnectionPool(pool); err != nil {
+		dbService.Close()
+		return nil, fmt.Errorf("failed to configure database connection pool: %w", err)
+	}
+	return dbService, nil
+}
+
+// configureAndStartServer starts the API server and the MCP server. Nil TLS options keep the TLS configuration of
+// the environment
+func configureAndStartServer(db *gorm.DB, port int, tlsOptions *api.TLSOptions) (*api.APIServer, int, error) {
 	// Create database service wrapper
 	var dbService services.DBService
 	var err error
@@ -23,15 +70,7 @@ func configureAndStartServer(db *gorm.DB, port int) (*api.APIServer, int, error)
 		// Use provided DB connection (for testing)
 		dbService = services.NewDBServiceFromDB(db)
 	} else {
-		// Check for Turso configuration first, then fall back to PostgreSQL
-		tursoURL := os.Getenv("TURSO_DATABASE_URL")
-		tursoAuthToken := os.Getenv("TURSO_AUTH_TOKEN")
-		if tursoURL != "" {
-			dbService, err = services.NewTursoDBService(tursoURL, tursoAuthToken)
-		} else {
-			postgresUrl := os.Getenv("POSTGRES_URL")
-			dbService, err = services.NewPostgresDBService(postgresUrl)
-		}
+		dbService, err = openDatabase()
 		if err != nil {
 			return nil, 0, err
 		}
@@ -39,21 +78,46 @@ func configureAndStartServer(db *gorm.DB, port int) (*api.APIServer, int, error)
 
 	// Initialize services and hooks
 	evmService, txService, uniswapService, liquidityService, hookService, chainService, templateService, deploymentService, uniswapContractService := server.InitializeServices(dbService.GetDB())
-	tokenDeploymentHook, uniswapDeploymentHook, liquidityHook := server.InitializeHooks(dbService.GetDB(), hookService, uniswapService, deploymentService, liquidityService, uniswapContractService, chainService)
-	server.RegisterHooks(hookService, tokenDeploymentHook, uniswapDeploymentHook, liquidityHook)
+	tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook := server.InitializeHooks(dbService.GetDB(), hookService, uniswapService, deploymentService, liquidityService, uniswapContractService, chainService)
+	server.RegisterHooks(hookService, tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook)
 
 	// Initialize MCP server
 	mcpServer := mcp.NewMCPServer(dbService, port, evmService, txService, uniswapService, liquidityService, chainService, templateService, deploymentService)
 	// Initialize API server for transaction signing (authenticator is created internally)
-	apiServer := api.NewAPIServer(dbService, txService, hookService, chainService)
+	apiServer := api.NewAPIServer(dbService, txService, hookService, chainService, deploymentService, liquidityService, uniswapContractService)
 	if os.Getenv("DISABLE_AUTHENTICATION") != "true" {
 		apiServer.EnableAuthentication()
 	} else {
 		log.Println("Warning: Authentication is disabled")
 	}
+	if tlsOptions != nil {
+		if err := apiServer.ConfigureTLS(*tlsOptions); err != nil {
+			return nil, 0, err
+		}
+	}
+	// Replicas behind a load balancer share their sessions and rate limits and elect the one running the background jobs
+	if services.ClusterModeFromEnv() {
+		if err := apiServer.EnableClusterMode(); err != nil {
+			return nil, 0, err
+		}
+		elector, err := services.NewLeaderElector(dbService.GetDB(), services.DefaultLeaderElectionInterval)
+		if err != nil {
+			return nil, 0, err
+		}
+		mcpServer.SetLeaderElector(elector)
+		log.Println("Cluster mode enabled: background jobs run on the elected leader")
+	}
 	apiServer.SetupRoutes()
 	apiServer.SetMCPServer(mcpServer)
 	apiServer.EnableStreamableHttp()
+	if err := apiServer.EnableRESTAPI(); err != nil {
+		return nil, 0, err
+	}
+	if err := apiServer.EnableGraphQL(); err != nil {
+		return nil, 0, err
+	}
+	apiServer.EnableAdminDashboard()
+	mcpServer.StartBackgroundJobs()
 	// Start API server
 	var portPtr *int
 	if port != 0 {
@@ -68,6 +132,61 @@ func configureAndStartServer(db *gorm.DB, port int) (*api.APIServer, int, error)
 }
 
 func main() {
+	// Command line flags
+	var runMigrations = flag.Bool("migrate", false, "Apply the pending Postgres migrations and exit")
+	var runSelfTest = flag.Bool("selftest", false, "Run the launchpad workflow against a managed Anvil and exit")
+	var anvilPath = flag.String("anvil", "anvil", "Anvil binary used by --selftest")
+	var forkURL = flag.String("fork-url", "", "RPC forked by the Anvil of --selftest")
+	envTLS := api.TLSOptionsFromEnv()
+	var tlsCert = flag.String("tls-cert", envTLS.CertFile, "TLS certificate file of the server (env: LAUNCHPAD_TLS_CERT_FILE)")
+	var tlsKey = flag.String("tls-key", envTLS.KeyFile, "TLS private key file of the server (env: LAUNCHPAD_TLS_KEY_FILE)")
+	var acmeDomains = flag.String("acme-domains", strings.Join(envTLS.ACMEDomains, ","), "Comma separated domains of the certificates provisioned with Let's Encrypt (env: LAUNCHPAD_ACME_DOMAINS)")
+	var acmeCacheDir = flag.String("acme-cache-dir", envTLS.ACMECacheDir, "Directory of the provisioned certificates (env: LAUNCHPAD_ACME_CACHE_DIR, default: acme-certs)")
+	var publicURL = flag.String("public-url", "", "Public url of the signing pages in the generated links, e.g. behind a reverse proxy or tunnel (overrides BASE_URL)")
+	flag.Parse()
+
+	if err := utils.SetPublicBaseUrl(*publicURL); err != nil {
+		log.Fatal("Invalid --public-url:", err)
+	}
+
+	if *runSelfTest {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		report, err := selftest.Run(ctx, selftest.Options{AnvilPath: *anvilPath, ForkURL: *forkURL})
+		stop()
+		if err != nil {
+			log.Fatal("Self test failed to start:", err)
+		}
+		report.Write(os.Stdout)
+		if !report.Passed() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *runMigrations {
+		if os.Getenv("TURSO_DATABASE_URL") != "" {
+			log.Println("Turso databases are migrated automatically on startup, nothing to migrate")
+			return
+		}
+		if err := migrations.Migrate(os.Getenv("POSTGRES_URL")); err != nil {
+			log.Fatal("Failed to migrate database:", err)
+		}
+		return
+	}
+
+	// export and import subcommands back up and restore the database
+	if server.IsBackupCommand(flag.Args()) {
+		dbService, err := openDatabase()
+		if err != nil {
+			log.Fatal("Failed to connect to database:", err)
+		}
+		defer dbService.Close()
+		if err := server.RunBackupCommand(dbService.GetDB(), flag.Args(), os.Stdout); err != nil {
+			log.Fatal("Backup command failed:", err)
+		}
+		return
+	}
+
 	// Get port from environment or use default
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -80,7 +199,13 @@ func main() {
 	}
 
 	// Configure and start server
-	apiServer, startedPort, err := configureAndStartServer(nil, parsedPort)
+	tlsOptions := envTLS
+	tlsOptions.CertFile = *tlsCert
+	tlsOptions.KeyFile = *tlsKey
+	tlsOptions.ACMEDomains = api.ParseACMEDomains(*acmeDomains)
+	tlsOptions.ACMECacheDir = *acmeCacheDir
+
+	apiServer, startedPort, err := configureAndStartServer(nil, parsedPort, &tlsOptions)
 	if err != nil {
 		log.Fatal("Failed to start API server:", err)
 	}
@@ -93,6 +218,7 @@ func main() {
 	<-c
 
 	log.Println("\nShutting down server...")
+	apiServer.GetMCPServer().StopBackgroundJobs()
 
 	// Shutdown API server
 	if err := apiServer.Shutdown(); err != nil {
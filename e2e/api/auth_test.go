@@ -135,7 +135,7 @@ func (s *AuthTestSuite) TearDownSuite() {
 
 func (s *AuthTestSuite) createAPIServerWithAuth() {
 	hookService := services.NewHookService()
-	s.apiServer = api.NewAPIServer(s.setup.DBService, s.setup.TxService, hookService, s.setup.ChainService)
+	s.apiServer = api.NewAPIServer(s.setup.DBService, s.setup.TxService, hookService, s.setup.ChainService, s.setup.DeploymentService, services.NewLiquidityService(s.setup.DBService.GetDB()), services.NewUniswapContractService(s.setup.UniswapService))
 
 	// Create additional services needed for MCP server
 	evmService := services.NewEvmService()
@@ -133,7 +133,7 @@ func (s *ChromedpTestSetup) startAPIServer() error {
 	hookService := services.NewHookService()
 
 	// Initialize API server
-	apiServer := api.NewAPIServer(s.TestSetup.DBService, s.TestSetup.TxService, hookService, s.TestSetup.ChainService)
+	apiServer := api.NewAPIServer(s.TestSetup.DBService, s.TestSetup.TxService, hookService, s.TestSetup.ChainService, s.TestSetup.DeploymentService, services.NewLiquidityService(s.TestSetup.DBService.GetDB()), services.NewUniswapContractService(s.TestSetup.UniswapService))
 	apiServer.SetupRoutes()
 	port, err := apiServer.Start(nil)
 	if err != nil {
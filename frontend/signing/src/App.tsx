@@ -1,29 +1,59 @@
 import { Activity, CheckCircle, Wallet, LogOut } from "lucide-react";
-import { useCallback, useMemo } from "react";
+import { useCallback, useEffect, useMemo, useState } from "react";
 import "./App.css";
 import { BalanceDisplay } from "./components/BalanceDisplay";
 import { ErrorDisplay } from "./components/ErrorDisplay";
 import { HorizontalStepper } from "./components/HorizontalStepper";
+import { MessageSigner } from "./components/MessageSigner";
 import { MetadataDisplay } from "./components/MetadataDisplay";
+import { MobileSigning } from "./components/MobileSigning";
 import { TransactionList } from "./components/TransactionList";
 import { TransactionSigner } from "./components/TransactionSigner";
 import { WalletSelector } from "./components/WalletSelector";
+import { useSolanaWallet } from "./hooks/useSolanaWallet";
 import { useTransaction } from "./hooks/useTransaction";
 import { useWallet } from "./hooks/useWallet";
+import type { SignatureRequest } from "./types/wallet";
+import { postEmbedEvent } from "./utils/embed";
 
 function App() {
-  const wallet = useWallet();
+  const evmWallet = useWallet();
+  const solanaWallet = useSolanaWallet();
   const transaction = useTransaction({
-    walletProvider: wallet.selectedProvider?.provider,
-    account: wallet.account,
+    walletProvider: evmWallet.selectedProvider?.provider,
+    account: evmWallet.account,
   });
 
+  // Solana sessions are signed with a Wallet Standard wallet instead of an EIP-6963 one
+  const isSolana = transaction.session?.chain_type === "solana";
+  const wallet = isSolana ? { ...evmWallet, ...solanaWallet } : evmWallet;
+
+  // request_signature sessions ask for a message signature instead of transactions
+  const [signedRequest, setSignedRequest] = useState<SignatureRequest | null>(
+    null
+  );
+  const signatureRequest =
+    signedRequest ?? transaction.session?.signature_request;
+
   const handleSignTransactions = useCallback(async () => {
     if (!wallet.isConnected) {
       console.error("Wallet not connected");
       return;
     }
 
+    if (isSolana) {
+      try {
+        await transaction.executeAllTransactions(
+          evmWallet.signTransaction,
+          undefined,
+          solanaWallet.signAndSendTransaction
+        );
+      } catch (error) {
+        console.error("Transaction failed:", error);
+      }
+      return;
+    }
+
     // Check if we need to switch networks
     const rpcNetwork = wallet.getRPCNetworkMetadata();
     if (rpcNetwork && wallet.chainId !== rpcNetwork.chain_id) {
@@ -36,11 +66,14 @@ function App() {
     }
 
     try {
-      await transaction.executeAllTransactions(wallet.signTransaction);
+      await transaction.executeAllTransactions(
+        wallet.signTransaction,
+        wallet.signRawTransaction
+      );
     } catch (error) {
       console.error("Transaction failed:", error);
     }
-  }, [wallet, transaction]);
+  }, [wallet, transaction, isSolana, evmWallet, solanaWallet]);
 
   const handleRetry = useCallback(() => {
     transaction.reset();
@@ -62,16 +95,46 @@ function App() {
 
   const allCompleted = useMemo(() => {
     if (!transaction.session) return false;
+    if (signatureRequest) return !!signatureRequest.signer;
     const totalTx = transaction.session.transaction_deployments.length;
     const completedCount = Array.from(
       transaction.transactionStatuses.values()
     ).filter((status) => status === "confirmed").length;
     return totalTx > 0 && completedCount === totalTx;
-  }, [transaction.session, transaction.transactionStatuses]);
+  }, [transaction.session, transaction.transactionStatuses, signatureRequest]);
+
+  // Notify the dApp embedding the page, see internal/assets/launchpad_embed.js
+  const sessionId = transaction.session?.id;
+  useEffect(() => {
+    if (!transaction.session) return;
+    postEmbedEvent("sessionReady", {
+      sessionId: transaction.session.id,
+      chainType: transaction.session.chain_type,
+      transactionCount: transaction.session.transaction_deployments.length,
+    });
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, [sessionId]);
+
+  useEffect(() => {
+    if (!allCompleted || !transaction.session) return;
+    postEmbedEvent("sessionConfirmed", {
+      sessionId: transaction.session.id,
+      // The transactions deploying a contract, the others were reported by txSigned
+      deployedContracts: Array.from(transaction.deployedContracts.entries()).map(
+        ([index, contract]) => ({
+          index,
+          transactionHash: contract.txHash,
+          contractAddress: contract.address,
+        })
+      ),
+    });
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+  }, [allCompleted]);
 
   // Check for network mismatch - compare wallet chain with RPC network metadata
   const networkMismatch = useMemo(() => {
-    if (!wallet.isConnected) return false;
+    // a message signature does not depend on the network of the wallet
+    if (!wallet.isConnected || isSolana || signatureRequest) return false;
     const rpcNetwork = wallet.getRPCNetworkMetadata();
     if (!rpcNetwork) return false;
     console.log(
@@ -88,7 +151,7 @@ function App() {
     const walletChainId = Number(wallet.chainId);
     const requiredChainId = Number(rpcNetwork.chain_id);
     return walletChainId !== requiredChainId;
-  }, [wallet]);
+  }, [wallet, isSolana, signatureRequest]);
 
   // Determine current step for stepper
   const currentStep = useMemo(() => {
@@ -144,6 +207,9 @@ function App() {
               <ErrorDisplay error={wallet.error} />
             </div>
           )}
+          {!isSolana && transaction.session && (
+            <MobileSigning sessionId={transaction.session.id} />
+          )}
         </>
       );
     }
@@ -153,7 +219,9 @@ function App() {
       return (
         <>
           <h2 className="text-xl font-semibold text-gray-800 mb-6">
-            Review & Sign Transactions
+            {signatureRequest
+              ? "Review & Sign Message"
+              : "Review & Sign Transactions"}
           </h2>
 
           {/* Network Status */}
@@ -206,8 +274,20 @@ function App() {
               </div>
             )}
 
+          {/* Message of a signature request */}
+          {transaction.session && signatureRequest && (
+            <MessageSigner
+              sessionId={transaction.session.id}
+              request={signatureRequest}
+              isConnected={wallet.isConnected}
+              account={evmWallet.account}
+              signMessage={evmWallet.signMessage}
+              onSigned={setSignedRequest}
+            />
+          )}
+
           {/* Transaction List */}
-          {transaction.session && (
+          {transaction.session && !signatureRequest && (
             <div className="mb-6">
               <TransactionList
                 transactions={transaction.session.transaction_deployments}
@@ -220,22 +300,24 @@ function App() {
           )}
 
           {/* Sign button and status */}
-          <TransactionSigner
-            isExecuting={transaction.isExecuting}
-            isConnected={wallet.isConnected}
-            hasTransactions={
-              (transaction.session?.transaction_deployments.length || 0) > 0
-            }
-            currentIndex={transaction.currentIndex}
-            totalTransactions={
-              transaction.session?.transaction_deployments.length || 0
-            }
-            error={transaction.error}
-            allCompleted={allCompleted}
-            networkMismatch={networkMismatch}
-            onSign={handleSignTransactions}
-            onRetry={handleRetry}
-          />
+          {!signatureRequest && (
+            <TransactionSigner
+              isExecuting={transaction.isExecuting}
+              isConnected={wallet.isConnected}
+              hasTransactions={
+                (transaction.session?.transaction_deployments.length || 0) > 0
+              }
+              currentIndex={transaction.currentIndex}
+              totalTransactions={
+                transaction.session?.transaction_deployments.length || 0
+              }
+              error={transaction.error}
+              allCompleted={allCompleted}
+              networkMismatch={networkMismatch}
+              onSign={handleSignTransactions}
+              onRetry={handleRetry}
+            />
+          )}
         </>
       );
     }
@@ -248,13 +330,17 @@ function App() {
             className="text-xl font-semibold text-gray-800 mb-6"
             data-testid="transaction-success-message"
           >
-            All Transactions Complete
+            {signatureRequest
+              ? "Signature Verified"
+              : "All Transactions Complete"}
           </h2>
 
           <div className="text-center py-8">
             <CheckCircle className="w-16 h-16 text-green-500 mx-auto mb-4" />
             <p className="text-lg text-gray-700 mb-2">
-              All transactions have been successfully executed!
+              {signatureRequest
+                ? "Your signature has been verified!"
+                : "All transactions have been successfully executed!"}
             </p>
             <p className="text-sm text-gray-500">
               You can now close this window or view the transaction details
@@ -263,7 +349,19 @@ function App() {
           </div>
 
           {/* Final transaction list */}
-          {transaction.session && (
+          {transaction.session && signatureRequest && (
+            <div className="mt-6">
+              <MessageSigner
+                sessionId={transaction.session.id}
+                request={signatureRequest}
+                isConnected={wallet.isConnected}
+                account={evmWallet.account}
+                signMessage={evmWallet.signMessage}
+                onSigned={setSignedRequest}
+              />
+            </div>
+          )}
+          {transaction.session && !signatureRequest && (
             <div className="mt-6">
               <TransactionList
                 transactions={transaction.session.transaction_deployments}
@@ -310,7 +408,7 @@ function App() {
                       {formatAddress(wallet.account)}
                     </span>
                     <span className="text-xs text-gray-500">
-                      Chain ID: {wallet.chainId}
+                      {isSolana ? "Solana" : `Chain ID: ${wallet.chainId}`}
                     </span>
                   </div>
                 </div>
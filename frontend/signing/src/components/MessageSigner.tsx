@@ -0,0 +1,143 @@
+import { useState } from "react";
+import { AlertCircle, CheckCircle2, Loader2, PenLine } from "lucide-react";
+import type { SignatureRequest } from "../types/wallet";
+
+interface MessageSignerProps {
+  sessionId: string;
+  request: SignatureRequest;
+  isConnected: boolean;
+  account: string | null;
+  signMessage: (message: string) => Promise<string>;
+  onSigned: (request: SignatureRequest) => void;
+}
+
+// Signs the message of a request_signature session, the server recovers the signer and confirms the session
+export function MessageSigner({
+  sessionId,
+  request,
+  isConnected,
+  account,
+  signMessage,
+  onSigned,
+}: MessageSignerProps) {
+  const [isSigning, setIsSigning] = useState(false);
+  const [error, setError] = useState<Error | null>(null);
+
+  const wrongAccount =
+    !!request.address &&
+    !!account &&
+    account.toLowerCase() !== request.address.toLowerCase();
+
+  const handleSign = async () => {
+    setIsSigning(true);
+    setError(null);
+    try {
+      const signature = await signMessage(request.message);
+      const response = await fetch(`/api/tx/${sessionId}/signature`, {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({ signature }),
+      });
+      const result = await response.json();
+      if (!response.ok) {
+        throw new Error(result.error || "Failed to verify the signature");
+      }
+      onSigned(result as SignatureRequest);
+    } catch (err) {
+      setError(err as Error);
+    } finally {
+      setIsSigning(false);
+    }
+  };
+
+  if (request.signer) {
+    return (
+      <div
+        data-testid="message-signed"
+        className="p-4 bg-green-50 border border-green-200 rounded-lg"
+      >
+        <div className="flex items-center space-x-3">
+          <CheckCircle2 className="h-5 w-5 text-green-500 flex-shrink-0" />
+          <div>
+            <p className="text-sm font-medium text-green-800">
+              Signature verified
+            </p>
+            <p className="text-sm text-green-600 mt-1 break-all">
+              Signed by {request.signer}
+            </p>
+          </div>
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="space-y-4">
+      <div>
+        <p className="text-sm font-medium text-gray-700 mb-2">
+          Message to sign
+        </p>
+        <pre
+          data-testid="message-signer-message"
+          className="p-4 bg-gray-50 border border-gray-200 rounded-lg text-sm text-gray-800 whitespace-pre-wrap break-all"
+        >
+          {request.message}
+        </pre>
+        <p className="text-xs text-gray-500 mt-2">
+          Signing this message proves you control the address, it does not send
+          a transaction or cost gas.
+        </p>
+      </div>
+
+      {wrongAccount && (
+        <p
+          data-testid="message-signer-wrong-account"
+          className="text-sm text-amber-600"
+        >
+          The message must be signed by {request.address}, switch to this
+          account in your wallet.
+        </p>
+      )}
+
+      <button
+        data-testid="message-sign-button"
+        onClick={handleSign}
+        disabled={!isConnected || isSigning || wrongAccount}
+        className={`
+          w-full flex items-center justify-center space-x-2 px-6 py-3
+          text-white font-medium rounded-lg shadow-sm transition-all duration-200
+          bg-blue-600 hover:bg-blue-700
+          ${
+            !isConnected || isSigning || wrongAccount
+              ? "opacity-50 cursor-not-allowed"
+              : ""
+          }
+        `}
+      >
+        {isSigning ? (
+          <>
+            <Loader2 className="h-5 w-5 animate-spin" />
+            <span>Waiting for signature...</span>
+          </>
+        ) : (
+          <>
+            <PenLine className="h-5 w-5" />
+            <span>Sign Message</span>
+          </>
+        )}
+      </button>
+
+      {error && (
+        <div
+          data-testid="message-signer-error"
+          className="p-4 bg-red-50 border border-red-200 rounded-lg"
+        >
+          <div className="flex items-start space-x-3">
+            <AlertCircle className="h-5 w-5 text-red-500 flex-shrink-0 mt-0.5" />
+            <p className="text-sm text-red-600 break-all">{error.message}</p>
+          </div>
+        </div>
+      )}
+    </div>
+  );
+}
@@ -0,0 +1,126 @@
+import { useState } from "react";
+import { AlertCircle, ExternalLink, Loader2, Smartphone } from "lucide-react";
+
+interface MobileWalletLink {
+  wallet: string;
+  url: string;
+}
+
+interface MobileLink {
+  url: string;
+  qr_code: string;
+  wallets: MobileWalletLink[];
+  reachable: boolean;
+  handed_off: boolean;
+}
+
+interface MobileSigningProps {
+  sessionId: string;
+}
+
+// Opens the session on a phone for the machines without a browser wallet: the QR code or a deep link opens the
+// page in the in-app browser of a mobile wallet
+export function MobileSigning({ sessionId }: MobileSigningProps) {
+  const [link, setLink] = useState<MobileLink | null>(null);
+  const [isLoading, setIsLoading] = useState(false);
+  const [error, setError] = useState<Error | null>(null);
+
+  const handleShow = async () => {
+    setIsLoading(true);
+    setError(null);
+    try {
+      const response = await fetch(`/api/tx/${sessionId}/mobile-link`, {
+        method: "POST",
+      });
+      const result = await response.json();
+      if (!response.ok) {
+        throw new Error(result.error || "Failed to create the mobile link");
+      }
+      setLink(result as MobileLink);
+    } catch (err) {
+      setError(err as Error);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  if (!link) {
+    return (
+      <div className="mt-6 pt-6 border-t border-gray-200">
+        <button
+          data-testid="mobile-signing-button"
+          onClick={handleShow}
+          disabled={isLoading}
+          className="w-full flex items-center justify-center space-x-2 px-6 py-3 text-gray-700 font-medium border border-gray-300 rounded-lg hover:bg-gray-50 transition-all duration-200"
+        >
+          {isLoading ? (
+            <Loader2 className="h-5 w-5 animate-spin" />
+          ) : (
+            <Smartphone className="h-5 w-5" />
+          )}
+          <span>No browser wallet? Sign on your phone</span>
+        </button>
+        {error && (
+          <div
+            data-testid="mobile-signing-error"
+            className="mt-4 flex items-start space-x-3 p-4 bg-red-50 border border-red-200 rounded-lg"
+          >
+            <AlertCircle className="h-5 w-5 text-red-500 flex-shrink-0 mt-0.5" />
+            <p className="text-sm text-red-600">{error.message}</p>
+          </div>
+        )}
+      </div>
+    );
+  }
+
+  return (
+    <div
+      data-testid="mobile-signing"
+      className="mt-6 pt-6 border-t border-gray-200 space-y-4"
+    >
+      <p className="text-sm text-gray-600">
+        Scan the code with the camera or the scanner of your mobile wallet, or
+        open the link in the wallet on this phone.
+      </p>
+      <div
+        data-testid="mobile-signing-qr"
+        className="mx-auto w-56 h-56 [&>svg]:w-full [&>svg]:h-full"
+        dangerouslySetInnerHTML={{ __html: link.qr_code }}
+      />
+      {!link.reachable && (
+        <p
+          data-testid="mobile-signing-unreachable"
+          className="text-sm text-amber-600"
+        >
+          This link points to localhost, a phone cannot open it. Start the
+          launchpad with BASE_URL or --public-url set to an address of this
+          machine on your network.
+        </p>
+      )}
+      {link.handed_off && (
+        <p
+          data-testid="mobile-signing-handed-off"
+          className="text-sm text-amber-600"
+        >
+          The session moved to the mobile link, continue on your phone. This
+          browser can no longer sign it.
+        </p>
+      )}
+      <div className="grid grid-cols-1 sm:grid-cols-3 gap-2">
+        {link.wallets.map((wallet) => (
+          <a
+            key={wallet.wallet}
+            data-testid="mobile-signing-wallet-link"
+            href={wallet.url}
+            target="_blank"
+            rel="noopener noreferrer"
+            className="flex items-center justify-center space-x-1 px-3 py-2 text-sm text-blue-600 border border-blue-200 rounded-lg hover:bg-blue-50"
+          >
+            <span>{wallet.wallet}</span>
+            <ExternalLink className="h-4 w-4" />
+          </a>
+        ))}
+      </div>
+    </div>
+  );
+}
@@ -233,6 +233,51 @@ export function TransactionList({
                   />
                 )}
 
+                {/* Maximum input of exact output swaps */}
+                {tx.maxSpend && (
+                  <p
+                    data-testid={`transaction-max-spend-${index}`}
+                    className="text-xs text-gray-600 mt-2"
+                  >
+                    Max spend:{" "}
+                    <span className="font-mono">
+                      {tx.value.length > 0 && tx.value !== "0"
+                        ? `${formatEther(tx.maxSpend)} ETH`
+                        : tx.maxSpend}
+                    </span>
+                  </p>
+                )}
+
+                {/* Gasless transactions sent by the smart account of the wallet */}
+                {tx.userOperation && (
+                  <p
+                    data-testid={`transaction-user-operation-${index}`}
+                    className="text-xs text-gray-600 mt-2"
+                  >
+                    Gasless: sent by the smart account of your wallet as a
+                    user operation, sign the operation hash when asked
+                  </p>
+                )}
+
+                {/* Aggregator quote of Solana swaps */}
+                {tx.swapQuote && (
+                  <div
+                    data-testid={`transaction-swap-quote-${index}`}
+                    className="text-xs text-gray-600 mt-2 space-y-1"
+                  >
+                    <p>
+                      Route ({tx.swapQuote.provider}):{" "}
+                      {tx.swapQuote.route.join(" → ")}
+                    </p>
+                    <p>
+                      Expected output:{" "}
+                      <span className="font-mono">{tx.swapQuote.outAmount}</span>
+                      {" · "}Price impact: {tx.swapQuote.priceImpact.toFixed(2)}%
+                      {" · "}Slippage: {tx.swapQuote.slippageBps / 100}%
+                    </p>
+                  </div>
+                )}
+
                 {/* Balance before deployment */}
                 {tx.showBalanceBeforeDeployment && tx.contractAddress && (
                   <TokenBalanceDisplay
@@ -0,0 +1,171 @@
+/* eslint-disable @typescript-eslint/no-explicit-any */
+import { useState, useEffect, useCallback } from "react";
+import type { EIP6963Provider, WalletState } from "../types/wallet";
+import { base58Encode, base64ToBytes } from "../utils/solana";
+
+const SIGN_AND_SEND_FEATURE = "solana:signAndSendTransaction";
+
+// Wallet Standard wallet (https://github.com/wallet-standard/wallet-standard)
+interface StandardWallet {
+  name: string;
+  icon: string;
+  chains: readonly string[];
+  accounts: readonly { address: string }[];
+  features: Record<string, any>;
+}
+
+// Solana wallets are discovered with the Wallet Standard and exposed with the EIP-6963 shape of the wallet selector,
+// provider holds the standard wallet
+export function useSolanaWallet() {
+  const [state, setState] = useState<WalletState>({
+    providers: [],
+    selectedProvider: null,
+    account: null,
+    chainId: null,
+    isConnected: false,
+    isConnecting: false,
+    error: null,
+  });
+
+  // Discover wallets via the Wallet Standard
+  const discoverWallets = useCallback(() => {
+    const register = (...wallets: StandardWallet[]) => {
+      const providers: EIP6963Provider[] = wallets
+        .filter((wallet) => wallet.features[SIGN_AND_SEND_FEATURE])
+        .map((wallet) => ({
+          info: {
+            uuid: wallet.name,
+            name: wallet.name,
+            icon: wallet.icon,
+            rdns: wallet.name,
+          },
+          provider: wallet,
+        }));
+      setState((prev) => ({
+        ...prev,
+        providers: [
+          ...prev.providers.filter(
+            (p) => !providers.some((provider) => provider.info.uuid === p.info.uuid)
+          ),
+          ...providers,
+        ],
+      }));
+      return () => {};
+    };
+    const api = { register };
+
+    const handleRegister = (event: CustomEvent) => {
+      event.detail(api);
+    };
+    window.addEventListener(
+      "wallet-standard:register-wallet",
+      handleRegister as EventListener
+    );
+
+    // Wallets loaded before the page register when the app is ready
+    window.dispatchEvent(
+      new CustomEvent("wallet-standard:app-ready", { detail: api })
+    );
+
+    return () => {
+      window.removeEventListener(
+        "wallet-standard:register-wallet",
+        handleRegister as EventListener
+      );
+    };
+  }, []);
+
+  // Connect to wallet
+  const connectWallet = useCallback(
+    async (providerUuid: string) => {
+      const provider = state.providers.find(
+        (p) => p.info.uuid === providerUuid
+      );
+      if (!provider) {
+        setState((prev) => ({
+          ...prev,
+          error: new Error("Provider not found"),
+        }));
+        return;
+      }
+
+      setState((prev) => ({ ...prev, isConnecting: true, error: null }));
+
+      try {
+        const wallet = provider.provider as StandardWallet;
+        const { accounts } = await wallet.features["standard:connect"].connect();
+        if (!accounts || accounts.length === 0) {
+          throw new Error("No Solana account authorized");
+        }
+        setState((prev) => ({
+          ...prev,
+          selectedProvider: provider,
+          account: accounts[0].address,
+          isConnected: true,
+          isConnecting: false,
+        }));
+      } catch (error) {
+        setState((prev) => ({
+          ...prev,
+          error: error as Error,
+          isConnecting: false,
+        }));
+      }
+    },
+    [state.providers]
+  );
+
+  // Disconnect wallet
+  const disconnectWallet = useCallback(() => {
+    const wallet = state.selectedProvider?.provider as StandardWallet | undefined;
+    wallet?.features["standard:disconnect"]?.disconnect();
+    setState((prev) => ({
+      ...prev,
+      selectedProvider: null,
+      account: null,
+      isConnected: false,
+      error: null,
+    }));
+  }, [state.selectedProvider]);
+
+  // Sign and send the base64 serialized transaction, returns the base58 signature
+  const signAndSendTransaction = useCallback(
+    async (transaction: string): Promise<string> => {
+      if (!state.selectedProvider || !state.account) {
+        throw new Error("No wallet connected");
+      }
+
+      const wallet = state.selectedProvider.provider as StandardWallet;
+      const account = wallet.accounts.find(
+        (walletAccount) => walletAccount.address === state.account
+      );
+      if (!account) {
+        throw new Error(`Account ${state.account} is no longer authorized`);
+      }
+
+      const [output] = await wallet.features[
+        SIGN_AND_SEND_FEATURE
+      ].signAndSendTransaction({
+        account,
+        transaction: base64ToBytes(transaction),
+        chain: "solana:mainnet",
+      });
+      return base58Encode(output.signature);
+    },
+    [state.selectedProvider, state.account]
+  );
+
+  // Initialize wallet discovery on mount
+  useEffect(() => {
+    const cleanup = discoverWallets();
+    return cleanup;
+  }, [discoverWallets]);
+
+  return {
+    ...state,
+    connectWallet,
+    disconnectWallet,
+    signAndSendTransaction,
+    discoverWallets,
+  };
+}
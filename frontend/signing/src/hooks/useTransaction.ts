@@ -1,7 +1,19 @@
 /* eslint-disable @typescript-eslint/no-explicit-any */
 import { useCallback, useEffect, useState, useRef } from "react";
 import { formatEther, BrowserProvider } from "ethers";
-import type { TransactionState, TransactionStatus } from "../types/wallet";
+import type {
+  PrivateTransaction,
+  TransactionState,
+  TransactionStatus,
+  UserOperation,
+} from "../types/wallet";
+import { postEmbedEvent } from "../utils/embed";
+import { waitForSolanaSignature } from "../utils/solana";
+
+// How often the private relay status is polled while the transaction is pending
+const PRIVATE_TRANSACTION_POLL_INTERVAL = 3000;
+// How often the user operation is polled while the bundler has not included it
+const USER_OPERATION_POLL_INTERVAL = 3000;
 
 interface UseTransactionProps {
   sessionId?: string;
@@ -179,9 +191,183 @@ export function useTransaction({ sessionId, walletProvider, account }: UseTransa
     []
   );
 
+  // Submit a signed transaction through the private relay and wait until the relay settles it
+  const submitPrivateTransaction = useCallback(
+    async (index: number, signedTransaction: string) => {
+      if (!state.session) {
+        throw new Error("No session loaded");
+      }
+      if (!walletProvider) {
+        throw new Error("No wallet connected");
+      }
+
+      const response = await fetch(
+        `/api/tx/${state.session.id}/transaction/${index}/private`,
+        {
+          method: "POST",
+          headers: { "Content-Type": "application/json" },
+          body: JSON.stringify({ signedTransaction }),
+        }
+      );
+      let privateTx: PrivateTransaction = await response.json();
+      if (!response.ok) {
+        throw new Error(
+          `Private relay rejected the transaction: ${(privateTx as any).error}`
+        );
+      }
+
+      while (privateTx.status === "pending") {
+        await new Promise((resolve) =>
+          setTimeout(resolve, PRIVATE_TRANSACTION_POLL_INTERVAL)
+        );
+        const statusResponse = await fetch(
+          `/api/tx/${state.session.id}/transaction/${index}/private`
+        );
+        if (statusResponse.ok) {
+          privateTx = await statusResponse.json();
+        }
+      }
+
+      if (privateTx.status !== "included") {
+        throw new Error(
+          `Private transaction ${privateTx.status}${
+            privateTx.error ? `: ${privateTx.error}` : ""
+          }`
+        );
+      }
+
+      const receipt = await new BrowserProvider(
+        walletProvider
+      ).getTransactionReceipt(privateTx.transaction_hash);
+      if (!receipt) {
+        throw new Error(
+          `Receipt of private transaction ${privateTx.transaction_hash} not found`
+        );
+      }
+      return receipt;
+    },
+    [state.session, walletProvider]
+  );
+
+  // Send the transaction as a user operation of the smart account of the wallet: the server prepares the operation,
+  // the wallet signs its hash and the bundler includes it. Created contracts get the address computed by the server
+  const sendUserOperation = useCallback(
+    async (index: number) => {
+      if (!state.session) {
+        throw new Error("No session loaded");
+      }
+      if (!walletProvider || !account) {
+        throw new Error("No wallet connected");
+      }
+
+      const baseUrl = `/api/tx/${state.session.id}/transaction/${index}/user-operation`;
+      const prepareResponse = await fetch(baseUrl, {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({ owner: account }),
+      });
+      let operation: UserOperation = await prepareResponse.json();
+      if (!prepareResponse.ok) {
+        throw new Error(
+          `Failed to prepare the user operation: ${(operation as any).error}`
+        );
+      }
+
+      // SimpleAccount checks a personal_sign signature of the user operation hash by its owner
+      const signature = await walletProvider.request({
+        method: "personal_sign",
+        params: [operation.user_operation_hash, account],
+      });
+      const sendResponse = await fetch(`${baseUrl}/send`, {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({ signature }),
+      });
+      operation = await sendResponse.json();
+      if (!sendResponse.ok) {
+        throw new Error(
+          `Bundler rejected the user operation: ${(operation as any).error}`
+        );
+      }
+
+      while (operation.status === "pending") {
+        await new Promise((resolve) =>
+          setTimeout(resolve, USER_OPERATION_POLL_INTERVAL)
+        );
+        const statusResponse = await fetch(baseUrl);
+        if (statusResponse.ok) {
+          operation = await statusResponse.json();
+        }
+      }
+
+      if (operation.status !== "included" || !operation.transaction_hash) {
+        throw new Error(
+          `User operation ${operation.status}${
+            operation.error ? `: ${operation.error}` : ""
+          }`
+        );
+      }
+      return {
+        status: 1,
+        hash: operation.transaction_hash,
+        contractAddress: operation.contract_address || null,
+      };
+    },
+    [state.session, walletProvider, account]
+  );
+
+  // Sign and send the serialized transaction with the Solana wallet and wait until the cluster confirms it
+  const executeSolanaTransaction = useCallback(
+    async (
+      index: number,
+      signAndSendSolanaTransaction?: (transaction: string) => Promise<string>
+    ) => {
+      if (!state.session) {
+        throw new Error("No session loaded");
+      }
+      if (!signAndSendSolanaTransaction) {
+        throw new Error("No Solana wallet connected");
+      }
+
+      const rpcNetwork = JSON.parse(
+        document
+          .querySelector('meta[name="rpc-network"]')
+          ?.getAttribute("content") || "{}"
+      );
+      const signature = await signAndSendSolanaTransaction(
+        state.session.transaction_deployments[index].data
+      );
+      await waitForSolanaSignature(rpcNetwork.rpc, signature);
+      updateTransactionStatus(index, "confirmed");
+
+      // Update session status on backend, the signature identifies Solana transactions
+      await fetch(`/api/tx/${state.session.id}/transaction/${index}`, {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({
+          status: "confirmed",
+          transactionHash: signature,
+        }),
+      });
+      postEmbedEvent("txSigned", {
+        sessionId: state.session.id,
+        index,
+        status: "confirmed",
+        transactionHash: signature,
+      });
+      return { status: 1, hash: signature };
+    },
+    [state.session, updateTransactionStatus]
+  );
+
   // Execute transaction
   const executeTransaction = useCallback(
-    async (index: number, signTransaction: (tx: any) => Promise<any>) => {
+    async (
+      index: number,
+      signTransaction: (tx: any) => Promise<any>,
+      signRawTransaction?: (tx: any) => Promise<string>,
+      signAndSendSolanaTransaction?: (transaction: string) => Promise<string>
+    ) => {
       if (!state.session) {
         throw new Error("No session loaded");
       }
@@ -194,6 +380,13 @@ export function useTransaction({ sessionId, walletProvider, account }: UseTransa
       updateTransactionStatus(index, "pending");
 
       try {
+        if (state.session.chain_type === "solana") {
+          return await executeSolanaTransaction(
+            index,
+            signAndSendSolanaTransaction
+          );
+        }
+
         const tx = {
           data: deployment.data,
           value:
@@ -201,12 +394,28 @@ export function useTransaction({ sessionId, walletProvider, account }: UseTransa
               ? formatEther(deployment.value)
               : "0",
           to: deployment.receiver ? deployment.receiver : undefined,
+          gasLimit: deployment.gasLimit ?? undefined,
+          gasPrice: deployment.gasPrice ?? undefined,
+          nonce: deployment.nonce ?? undefined,
         };
 
         console.log("Constructing transaction:", tx);
 
-        const txResponse = await signTransaction(tx);
-        const receipt = await txResponse.wait();
+        let receipt;
+        if (deployment.userOperation) {
+          // Gasless transactions are sent by the smart account of the wallet through the bundler
+          receipt = await sendUserOperation(index);
+        } else if (deployment.privateRelay) {
+          // MEV protected transactions are signed only and submitted through the private relay
+          if (!signRawTransaction) {
+            throw new Error("Private relay submission is not supported");
+          }
+          const signedTransaction = await signRawTransaction(tx);
+          receipt = await submitPrivateTransaction(index, signedTransaction);
+        } else {
+          const txResponse = await signTransaction(tx);
+          receipt = await txResponse.wait();
+        }
 
         updateTransactionStatus(
           index,
@@ -238,6 +447,14 @@ export function useTransaction({ sessionId, walletProvider, account }: UseTransa
           });
         }
 
+        postEmbedEvent("txSigned", {
+          sessionId: state.session.id,
+          index,
+          status: receipt.status === 1 ? "confirmed" : "failed",
+          transactionHash: receipt.hash,
+          contractAddress: receipt.contractAddress,
+        });
+
         // Refresh balances after successful transaction
         if (receipt.status === 1) {
           setTimeout(() => {
@@ -251,12 +468,22 @@ export function useTransaction({ sessionId, walletProvider, account }: UseTransa
         throw error;
       }
     },
-    [state.session, updateTransactionStatus]
+    [
+      state.session,
+      updateTransactionStatus,
+      submitPrivateTransaction,
+      sendUserOperation,
+      executeSolanaTransaction,
+    ]
   );
 
   // Execute all transactions sequentially
   const executeAllTransactions = useCallback(
-    async (signTransaction: (tx: any) => Promise<any>) => {
+    async (
+      signTransaction: (tx: any) => Promise<any>,
+      signRawTransaction?: (tx: any) => Promise<string>,
+      signAndSendSolanaTransaction?: (transaction: string) => Promise<string>
+    ) => {
       if (!state.session) {
         throw new Error("No session loaded");
       }
@@ -267,7 +494,12 @@ export function useTransaction({ sessionId, walletProvider, account }: UseTransa
         const results = [];
         for (let i = 0; i < state.session.transaction_deployments.length; i++) {
           setState((prev) => ({ ...prev, currentIndex: i }));
-          const receipt = await executeTransaction(i, signTransaction);
+          const receipt = await executeTransaction(
+            i,
+            signTransaction,
+            signRawTransaction,
+            signAndSendSolanaTransaction
+          );
           results.push(receipt);
         }
 
@@ -5,6 +5,7 @@ import type {
   EIP6963Provider,
   WalletState,
   BlockchainNetwork,
+  WalletTransactionRequest,
 } from "../types/wallet";
 
 export function useWallet() {
@@ -305,7 +306,7 @@ export function useWallet() {
 
   // Sign and send transaction
   const signTransaction = useCallback(
-    async (transaction: { to?: string; data: string; value: string }) => {
+    async (transaction: WalletTransactionRequest) => {
       if (!state.selectedProvider || !state.account) {
         throw new Error("No wallet connected");
       }
@@ -319,6 +320,9 @@ export function useWallet() {
         to: transaction.to,
         data: transaction.data,
         value: parseEther(transaction.value),
+        gasLimit: transaction.gasLimit,
+        gasPrice: transaction.gasPrice,
+        nonce: transaction.nonce,
       };
 
       const txResponse = await signer.sendTransaction(tx);
@@ -327,6 +331,41 @@ export function useWallet() {
     [state.selectedProvider, state.account]
   );
 
+  // Sign transaction without broadcasting it, used for private relay submission
+  const signRawTransaction = useCallback(
+    async (transaction: WalletTransactionRequest): Promise<string> => {
+      if (!state.selectedProvider || !state.account) {
+        throw new Error("No wallet connected");
+      }
+
+      const ethersProvider = new BrowserProvider(
+        state.selectedProvider.provider
+      );
+      const signer = await ethersProvider.getSigner();
+
+      // Fill nonce, gas and chain ID so the wallet signs a complete transaction
+      const tx = await signer.populateTransaction({
+        to: transaction.to,
+        data: transaction.data,
+        value: parseEther(transaction.value),
+        gasLimit: transaction.gasLimit,
+        gasPrice: transaction.gasPrice,
+        nonce: transaction.nonce,
+      });
+
+      try {
+        return await signer.signTransaction(tx);
+      } catch (error) {
+        throw new Error(
+          `Your wallet cannot sign a transaction without broadcasting it (eth_signTransaction), which MEV protection requires. Please use another wallet or create the transaction again without MEV protection. ${
+            (error as Error).message
+          }`
+        );
+      }
+    },
+    [state.selectedProvider, state.account]
+  );
+
   // Sign message
   const signMessage = useCallback(
     async (message: string): Promise<string> => {
@@ -366,6 +405,7 @@ export function useWallet() {
     disconnectWallet,
     switchNetwork,
     signTransaction,
+    signRawTransaction,
     signMessage,
     getSigner,
     discoverWallets,
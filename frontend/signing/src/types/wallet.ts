@@ -16,6 +16,15 @@ export interface TransactionMetadata {
   description: string;
 }
 
+export interface WalletTransactionRequest {
+  to?: string;
+  data: string;
+  value: string;
+  gasLimit?: string;
+  gasPrice?: string;
+  nonce?: number;
+}
+
 export interface TransactionDeployment {
   title: string;
   description: string;
@@ -28,11 +37,38 @@ export interface TransactionDeployment {
   rawContractArguments?: string; // Added to track raw contract arguments
   showBalanceBeforeDeployment?: boolean; // Added to track if balance should be shown before deployment
   showBalanceAfterDeployment?: boolean; // Added to track if balance should be shown after deployment
+  privateRelay?: boolean; // Added to submit the signed transaction through the private relay of the chain
+  userOperation?: boolean; // Added to send the transaction as an ERC-4337 user operation of the smart account of the wallet
+  deadline?: number; // Added to track the router deadline (unix timestamp)
+  gasLimit?: string; // Added to override the wallet gas estimate
+  gasPrice?: string; // Added to override the wallet gas price (in wei)
+  nonce?: number; // Added to override the account nonce
+  maxSpend?: string; // Added to display the maximum input spent by exact output swaps (smallest unit)
+  swapQuote?: SwapQuote; // Added to display the aggregator quote of Solana swaps
   transactionType:
     | "regular"
     | "token_swap"
     | "add_liquidity"
-    | "remove_liquidity"; // Added to track transaction type
+    | "remove_liquidity"
+    | "address_list_update"
+    | "token_fee_update"
+    | "enable_trading"; // Added to track transaction type
+}
+
+// Quote of a swap routed through an aggregator such as Jupiter
+export interface SwapQuote {
+  provider: string;
+  inputMint: string;
+  outputMint: string;
+  inAmount: string;
+  outAmount: string;
+  otherAmountThreshold: string;
+  swapMode: "ExactIn" | "ExactOut";
+  slippageBps: number;
+  priceImpact: number;
+  route: string[];
+  lastValidBlockHeight: number;
+  prioritizationFeeLamports: number;
 }
 
 export interface BlockchainNetwork {
@@ -45,7 +81,7 @@ export interface BlockchainNetwork {
 export interface TransactionSession {
   id: string;
   metadata: TransactionMetadata[];
-  status: "pending" | "confirmed" | "failed";
+  status: "pending" | "confirmed" | "failed" | "cancelled";
   chain_type: "ethereum" | "solana";
   transaction_deployments: TransactionDeployment[];
   chain_id: number;
@@ -54,6 +90,43 @@ export interface TransactionSession {
   // balances where key is the contract address and value is the balance
   // if the balance is null, it means that we need to fetch the balance from the blockchain
   balances: Record<string, string | null>;
+  // set on the sessions asking the wallet to sign a message instead of transactions
+  signature_request?: SignatureRequest;
+}
+
+// Message the wallet signs to prove it controls an address, the signer is recovered by the server
+export interface SignatureRequest {
+  message: string;
+  nonce: string;
+  address?: string;
+  signer?: string;
+  signature?: string;
+  signed_at?: string;
+}
+
+export interface PrivateTransaction {
+  id: number;
+  session_id: string;
+  transaction_index: number;
+  transaction_hash: string;
+  status: "pending" | "included" | "failed" | "cancelled" | "expired";
+  error?: string;
+  block_number?: number;
+}
+
+// ERC-4337 user operation of a transaction, prepared by the server for the smart account of the wallet
+export interface UserOperation {
+  id: number;
+  session_id: string;
+  transaction_index: number;
+  owner: string;
+  sender: string;
+  user_operation_hash: string;
+  contract_address?: string;
+  sponsored: boolean;
+  status: "prepared" | "pending" | "included" | "failed";
+  transaction_hash?: string;
+  error?: string;
 }
 
 export type TransactionStatus = "waiting" | "pending" | "confirmed" | "failed";
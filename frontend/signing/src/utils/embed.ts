@@ -0,0 +1,34 @@
+// Source of the messages posted to the dApp embedding the signing page, checked by the launchpad.js helper
+const EMBED_EVENT_SOURCE = "launchpad-signing";
+
+export type EmbedEventType = "sessionReady" | "txSigned" | "sessionConfirmed";
+
+// The origins allowed to embed the page, from LAUNCHPAD_EMBED_ALLOWED_ORIGINS
+function getEmbedOrigins(): string[] {
+  const content = document
+    .querySelector('meta[name="embed-origins"]')
+    ?.getAttribute("content");
+  if (!content) return [];
+  try {
+    const origins = JSON.parse(content);
+    return Array.isArray(origins) ? origins : [];
+  } catch {
+    return [];
+  }
+}
+
+export function isEmbedded(): boolean {
+  return window.parent !== window;
+}
+
+// postEmbedEvent notifies the parent window of an embedded signing page. The message is posted to every allowed
+// origin, the browser only delivers it to the one matching the parent
+export function postEmbedEvent(type: EmbedEventType, payload: unknown) {
+  if (!isEmbedded()) return;
+  for (const origin of getEmbedOrigins()) {
+    window.parent.postMessage(
+      { source: EMBED_EVENT_SOURCE, type, payload },
+      origin
+    );
+  }
+}
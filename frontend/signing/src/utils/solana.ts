@@ -0,0 +1,75 @@
+const BASE58_ALPHABET =
+  "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz";
+
+// How often the signature status is polled while the transaction lands
+const SIGNATURE_POLL_INTERVAL = 2000;
+// Serialized transactions expire after about 150 blocks (~60 seconds)
+const SIGNATURE_TIMEOUT = 90000;
+
+export function base58Encode(bytes: Uint8Array): string {
+  let number = 0n;
+  for (const byte of bytes) {
+    number = number * 256n + BigInt(byte);
+  }
+
+  let encoded = "";
+  while (number > 0n) {
+    encoded = BASE58_ALPHABET[Number(number % 58n)] + encoded;
+    number = number / 58n;
+  }
+
+  // Every leading zero byte is a leading 1
+  for (const byte of bytes) {
+    if (byte !== 0) break;
+    encoded = "1" + encoded;
+  }
+  return encoded;
+}
+
+export function base64ToBytes(value: string): Uint8Array {
+  const binary = atob(value);
+  const bytes = new Uint8Array(binary.length);
+  for (let i = 0; i < binary.length; i++) {
+    bytes[i] = binary.charCodeAt(i);
+  }
+  return bytes;
+}
+
+// Wait until the cluster confirms the transaction signature, throws if it failed or expired
+export async function waitForSolanaSignature(
+  rpc: string,
+  signature: string
+): Promise<void> {
+  const deadline = Date.now() + SIGNATURE_TIMEOUT;
+  while (Date.now() < deadline) {
+    const response = await fetch(rpc, {
+      method: "POST",
+      headers: { "Content-Type": "application/json" },
+      body: JSON.stringify({
+        jsonrpc: "2.0",
+        id: 1,
+        method: "getSignatureStatuses",
+        params: [[signature], { searchTransactionHistory: true }],
+      }),
+    });
+    if (response.ok) {
+      const { result } = await response.json();
+      const status = result?.value?.[0];
+      if (status?.err) {
+        throw new Error(
+          `Transaction ${signature} failed: ${JSON.stringify(status.err)}`
+        );
+      }
+      if (
+        status?.confirmationStatus === "confirmed" ||
+        status?.confirmationStatus === "finalized"
+      ) {
+        return;
+      }
+    }
+    await new Promise((resolve) =>
+      setTimeout(resolve, SIGNATURE_POLL_INTERVAL)
+    );
+  }
+  throw new Error(`Transaction ${signature} was not confirmed in time`);
+}
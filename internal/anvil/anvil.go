@@ -0,0 +1,116 @@
+// Package anvil starts and stops local Anvil nodes for the self test and the template test harness
+package anvil
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// startTimeout is how long to wait for the RPC of a started Anvil to respond
+const startTimeout = 30 * time.Second
+
+// EnvPath overrides the anvil binary used by the tools, defaults to anvil on the PATH
+const EnvPath = "LAUNCHPAD_ANVIL_PATH"
+
+// PrivateKeys are the private keys of the first prefunded accounts of every Anvil node
+var PrivateKeys = []string{
+	"ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+	"59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+	"5de4111afa1a4b94908f83103eb1f1706367c2e68ca870fc3fb9a804cdd06a65",
+}
+
+// PathFromEnv returns the anvil binary of LAUNCHPAD_ANVIL_PATH, or anvil on the PATH
+func PathFromEnv() string {
+	if path := os.Getenv(EnvPath); path != "" {
+		return path
+	}
+	return "anvil"
+}
+
+// Anvil is a local Anvil node started and stopped by its caller
+type Anvil struct {
+	cmd    *exec.Cmd
+	exited chan error
+	RPC    string
+}
+
+// Start starts the Anvil binary at path on a free port and waits until its RPC responds.
+// When forkURL is set the node forks that RPC
+func Start(ctx context.Context, path, forkURL string) (*Anvil, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find available port: %w", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	if err := listener.Close(); err != nil {
+		return nil, err
+	}
+
+	args := []string{"--port", strconv.Itoa(port)}
+	if forkURL != "" {
+		args = append(args, "--fork-url", forkURL)
+	}
+	cmd := exec.Command(path, args...)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start anvil, install Foundry or pass the anvil binary path: %w", err)
+	}
+
+	anvil := &Anvil{
+		cmd:    cmd,
+		exited: make(chan error, 1),
+		RPC:    fmt.Sprintf("http://127.0.0.1:%d", port),
+	}
+	go func() {
+		anvil.exited <- cmd.Wait()
+	}()
+
+	if err := anvil.waitReady(ctx); err != nil {
+		anvil.Stop()
+		return nil, err
+	}
+	return anvil, nil
+}
+
+// waitReady polls the chain ID until the node answers, the process exits or the timeout passes
+func (a *Anvil) waitReady(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, startTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-a.exited:
+			// Keep the exit status for Stop
+			a.exited <- err
+			return fmt.Errorf("anvil exited before it was ready: %v", err)
+		case <-ctx.Done():
+			return fmt.Errorf("anvil did not respond on %s within %s", a.RPC, startTimeout)
+		case <-ticker.C:
+			client, err := ethclient.DialContext(ctx, a.RPC)
+			if err != nil {
+				continue
+			}
+			_, err = client.ChainID(ctx)
+			client.Close()
+			if err == nil {
+				return nil
+			}
+		}
+	}
+}
+
+// Stop kills the node and waits for the process to exit
+func (a *Anvil) Stop() {
+	if a.cmd.Process != nil {
+		_ = a.cmd.Process.Kill()
+	}
+	<-a.exited
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/assets"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// adminRole is the role required by the admin dashboard, the role of create_api_key
+	adminRole = "admin"
+	// adminListLimit is the number of sessions and deployments of each list of the dashboard
+	adminListLimit = 50
+)
+
+// EnableAdminDashboard serves the admin dashboard at /admin. The page itself holds no data and asks for a token, the
+// /admin/api routes it calls require an authenticated user with the admin role
+func (s *APIServer) EnableAdminDashboard() {
+	// The tools read the templates through a cache, disabling a template must go through it
+	templateService := services.NewTemplateService(s.dbService.GetDB())
+	if s.mcpServer != nil {
+		templateService = s.mcpServer.GetTemplateService()
+	}
+	s.adminService = services.NewAdminService(s.dbService.GetDB(), templateService)
+	s.app.Get("/admin", s.handleAdminPage)
+	s.app.Get("/admin/api/overview", s.requireAdmin, s.handleAdminOverview)
+	s.app.Post("/admin/api/sessions/:session_id/expire", s.requireAdmin, s.handleAdminExpireSession)
+	s.app.Post("/admin/api/templates/:template_id/disable", s.requireAdmin, s.handleAdminSetTemplateDisabled(true))
+	s.app.Post("/admin/api/templates/:template_id/enable", s.requireAdmin, s.handleAdminSetTemplateDisabled(false))
+}
+
+// requireAdmin rejects the requests without an authenticated admin, also when authentication is disabled
+func (s *APIServer) requireAdmin(c *fiber.Ctx) error {
+	user, ok := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+	if !ok || user == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+	for _, role := range user.Roles {
+		if role == adminRole {
+			return c.Next()
+		}
+	}
+	return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+		"error": "The admin role is required",
+	})
+}
+
+// adminSubject returns the subject of the admin let through by requireAdmin
+func adminSubject(c *fiber.Ctx) string {
+	if user, ok := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser); ok && user != nil {
+		return user.Sub
+	}
+	return ""
+}
+
+func (s *APIServer) handleAdminPage(c *fiber.Ctx) error {
+	c.Set("Content-Type", "text/html; charset=utf-8")
+	// The dashboard is never framed
+	c.Set("Content-Security-Policy", "frame-ancestors 'none'")
+	return c.Send(assets.AdminHTML)
+}
+
+func (s *APIServer) handleAdminOverview(c *fiber.Ctx) error {
+	overview, err := s.adminService.GetOverview(adminListLimit)
+	if err != nil {
+		log.Printf("Error getting admin overview: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to get the overview",
+		})
+	}
+	return c.JSON(overview)
+}
+
+func (s *APIServer) handleAdminExpireSession(c *fiber.Ctx) error {
+	sessionID := c.Params("session_id")
+	if err := s.adminService.ExpireSession(sessionID); err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	log.Printf("Admin %s expired session %s", adminSubject(c), sessionID)
+	return c.JSON(fiber.Map{"status": "expired"})
+}
+
+func (s *APIServer) handleAdminSetTemplateDisabled(disabled bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		templateID, err := strconv.ParseUint(c.Params("template_id"), 10, 32)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid template ID",
+			})
+		}
+		if err := s.adminService.SetTemplateDisabled(uint(templateID), disabled); err != nil {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		log.Printf("Admin %s set template %d disabled to %t", adminSubject(c), templateID, disabled)
+		return c.JSON(fiber.Map{"disabled": disabled})
+	}
+}
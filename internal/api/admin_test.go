@@ -0,0 +1,108 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdminTestServer(t *testing.T) (*APIServer, services.DBService) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	s := &APIServer{app: fiber.New(), dbService: dbService}
+	s.app.Use(func(c *fiber.Ctx) error {
+		if sub := c.Get("X-Test-User"); sub != "" {
+			c.Locals(middleware.AuthenticatedUserContextKey, &utils.AuthenticatedUser{Sub: sub, Roles: strings.Split(c.Get("X-Test-Roles"), ",")})
+		}
+		return c.Next()
+	})
+	s.EnableAdminDashboard()
+	return s, dbService
+}
+
+func adminTestRequest(t *testing.T, s *APIServer, method, path, user, roles string) (int, string) {
+	req := httptest.NewRequest(method, path, nil)
+	if user != "" {
+		req.Header.Set("X-Test-User", user)
+		req.Header.Set("X-Test-Roles", roles)
+	}
+	resp, err := s.app.Test(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestAdminRequiresAdminRole(t *testing.T) {
+	s, _ := newAdminTestServer(t)
+
+	// The page is public, it holds no data
+	status, body := adminTestRequest(t, s, "GET", "/admin", "", "")
+	assert.Equal(t, fiber.StatusOK, status)
+	assert.Contains(t, body, "Launchpad Admin")
+
+	status, _ = adminTestRequest(t, s, "GET", "/admin/api/overview", "", "")
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	status, _ = adminTestRequest(t, s, "GET", "/admin/api/overview", "user-1", "user")
+	assert.Equal(t, fiber.StatusForbidden, status)
+	status, _ = adminTestRequest(t, s, "GET", "/admin/api/overview", "admin-1", "admin")
+	assert.Equal(t, fiber.StatusOK, status)
+}
+
+func TestAdminOverviewAndActions(t *testing.T) {
+	s, dbService := newAdminTestServer(t)
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	require.NoError(t, db.Create(&models.Deployment{TemplateID: template.ID, ChainID: chain.ID, Status: models.TransactionStatusFailed}).Error)
+	txService := services.NewTransactionService(db)
+	sessionID, err := txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Title: "Deploy"}},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+	})
+	require.NoError(t, err)
+
+	status, body := adminTestRequest(t, s, "GET", "/admin/api/overview", "admin-1", "admin")
+	require.Equal(t, fiber.StatusOK, status)
+	assert.Contains(t, body, `"name":"Anvil"`)
+	assert.Contains(t, body, sessionID)
+	assert.Contains(t, body, `"failed_deployments":[{`)
+	assert.NotContains(t, body, "contract Token {}")
+
+	status, _ = adminTestRequest(t, s, "POST", "/admin/api/sessions/"+sessionID+"/expire", "admin-1", "admin")
+	require.Equal(t, fiber.StatusOK, status)
+	_, err = txService.GetTransactionSession(sessionID)
+	assert.Error(t, err)
+	var session models.TransactionSession
+	require.NoError(t, db.First(&session, "id = ?", sessionID).Error)
+	assert.False(t, session.ExpiresAt.After(time.Now()))
+
+	status, _ = adminTestRequest(t, s, "POST", "/admin/api/sessions/unknown/expire", "admin-1", "admin")
+	assert.Equal(t, fiber.StatusNotFound, status)
+
+	status, _ = adminTestRequest(t, s, "POST", "/admin/api/templates/"+fmt.Sprint(template.ID)+"/disable", "admin-1", "admin")
+	require.Equal(t, fiber.StatusOK, status)
+	require.NoError(t, db.First(template, template.ID).Error)
+	assert.True(t, template.Disabled)
+	status, _ = adminTestRequest(t, s, "POST", "/admin/api/templates/"+fmt.Sprint(template.ID)+"/enable", "admin-1", "admin")
+	require.Equal(t, fiber.StatusOK, status)
+	require.NoError(t, db.First(template, template.ID).Error)
+	assert.False(t, template.Disabled)
+}
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// requireAPIKeyScope refuses the requests of API key clients whose key is not scoped to the scope, the tool behind
+// the route or services.APIKeyScopeSessions. It applies the scopes of scopedToolCalls to the HTTP routes
+func requireAPIKeyScope(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		user, _ := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+		if !services.APIKeyAllows(user, scope) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": fmt.Sprintf("The API key %s is not allowed to call %s", user.Jti, scope),
+			})
+		}
+		return c.Next()
+	}
+}
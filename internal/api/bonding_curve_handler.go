@@ -0,0 +1,48 @@
+package api
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+// BondingCurveProgressResponse is the public progress of a bonding curve
+type BondingCurveProgressResponse struct {
+	ID              uint   `json:"id"`
+	ChainID         uint   `json:"chain_id"`
+	ContractAddress string `json:"contract_address,omitempty"`
+	*services.BondingCurveProgress
+}
+
+// handleBondingCurveProgress serves the progress of a bonding curve from the state tracked by the BondingCurveMonitor
+func (s *APIServer) handleBondingCurveProgress(c *fiber.Ctx) error {
+	curveID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid bonding curve id",
+		})
+	}
+
+	curve, err := s.bondingCurveService.GetBondingCurve(uint(curveID))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Bonding curve not found",
+		})
+	}
+
+	progress, err := s.bondingCurveService.GetProgress(curve)
+	if err != nil {
+		log.Printf("Error computing the progress of bonding curve %d: %v", curveID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to compute the bonding curve progress",
+		})
+	}
+	return c.JSON(BondingCurveProgressResponse{
+		ID:                   curve.ID,
+		ChainID:              curve.ChainID,
+		ContractAddress:      curve.ContractAddress,
+		BondingCurveProgress: progress,
+	})
+}
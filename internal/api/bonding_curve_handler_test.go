@@ -0,0 +1,61 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBondingCurveProgressRoute(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	bondingCurveService := services.NewBondingCurveService(dbService.GetDB())
+
+	curve := &models.BondingCurve{
+		DeploymentID:        1,
+		ChainID:             1,
+		DeployerAddress:     "0x01",
+		RouterAddress:       "0x02",
+		TotalSupply:         "1000",
+		CurveSupply:         "800",
+		GraduationThreshold: "600",
+		VirtualEthReserve:   "500",
+		VirtualTokenReserve: "666",
+		EthRaised:           "300",
+		TokensSold:          "400",
+		Status:              models.BondingCurveStatusActive,
+	}
+	require.NoError(t, bondingCurveService.CreateBondingCurve(curve))
+	require.NoError(t, bondingCurveService.MarkBondingCurveDeployed(curve.ID, "0x5FbDB2315678afecb367f032d93F642f64180aa3"))
+
+	s := &APIServer{app: fiber.New(), bondingCurveService: bondingCurveService}
+	s.app.Get("/bonding-curves/:id/progress", s.handleBondingCurveProgress)
+
+	resp, err := s.app.Test(httptest.NewRequest("GET", fmt.Sprintf("/bonding-curves/%d/progress", curve.ID), nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var progress BondingCurveProgressResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&progress))
+	assert.Equal(t, curve.ID, progress.ID)
+	assert.Equal(t, "0x5FbDB2315678afecb367f032d93F642f64180aa3", progress.ContractAddress)
+	assert.Equal(t, models.BondingCurveStatusActive, progress.Status)
+	assert.Equal(t, "300", progress.EthRaised)
+	assert.Equal(t, 0.5, progress.Progress)
+	assert.Equal(t, "750750750750750750", progress.Price)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", "/bonding-curves/999/progress", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", "/bonding-curves/abc/progress", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// handleDeploymentTypings serves the TypeScript typings of a confirmed deployment as a downloadable file
+func (s *APIServer) handleDeploymentTypings(c *fiber.Ctx) error {
+	deploymentID, err := strconv.ParseUint(c.Params("deployment_id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid deployment id",
+		})
+	}
+
+	deployment, err := s.deploymentService.GetDeploymentByID(uint(deploymentID))
+	if err != nil {
+		log.Printf("Error getting deployment %d: %v", deploymentID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Deployment not found",
+		})
+	}
+	if status, message := s.checkDeploymentDownloadAccess(c, deployment); status != 0 {
+		return c.Status(status).JSON(fiber.Map{
+			"error": message,
+		})
+	}
+
+	if deployment.Status != models.TransactionStatusConfirmed || deployment.ContractAddress == "" {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Deployment is not confirmed yet",
+		})
+	}
+
+	abiString, err := utils.GetAbiString(deployment.Template.Abi)
+	if err != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error": "Template does not have ABI information",
+		})
+	}
+
+	typings, err := utils.GenerateAbiTypeScript(utils.AbiTypeScriptArgs{
+		ContractName:    deployment.Template.Name,
+		ContractAddress: deployment.ContractAddress,
+		Abi:             abiString,
+	})
+	if err != nil {
+		log.Printf("Error generating typings for deployment %d: %v", deploymentID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to generate typings",
+		})
+	}
+
+	c.Set("Content-Type", "application/typescript; charset=utf-8")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", typings.Filename))
+	return c.SendString(typings.Code)
+}
+
+// checkDeploymentDownloadAccess returns the status and error of a request that may not download the typings or
+// artifacts of the deployment, 0 when it may. A signed url is accepted until it expires, otherwise with authentication enabled only the
+// owner may download them and the deployments of other users are reported as missing
+func (s *APIServer) checkDeploymentDownloadAccess(c *fiber.Ctx, deployment *models.Deployment) (int, string) {
+	if signature := c.Query(utils.SessionSignatureQueryParam); signature != "" {
+		if !utils.VerifyDownloadSignature(c.Path(), c.Query(utils.DownloadExpiresQueryParam), signature) {
+			return fiber.StatusForbidden, "The download link is invalid or expired"
+		}
+		return 0, ""
+	}
+	if !s.authenticationEnabled {
+		return 0, ""
+	}
+
+	user, _ := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+	if user == nil {
+		return fiber.StatusUnauthorized, "Unauthorized"
+	}
+	if deployment.UserID != nil && *deployment.UserID != user.Sub {
+		return fiber.StatusNotFound, "Deployment not found"
+	}
+	return 0, ""
+}
+
+// handleDeploymentArtifacts serves the sources, bytecode, ABI and constructor arguments of a confirmed deployment as a
+// zip archive, or as JSON with ?format=json
+func (s *APIServer) handleDeploymentArtifacts(c *fiber.Ctx) error {
+	deploymentID, err := strconv.ParseUint(c.Params("deployment_id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid deployment id",
+		})
+	}
+
+	deployment, err := s.deploymentService.GetDeploymentByID(uint(deploymentID))
+	if err != nil {
+		log.Printf("Error getting deployment %d: %v", deploymentID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Deployment not found",
+		})
+	}
+	if status, message := s.checkDeploymentDownloadAccess(c, deployment); status != 0 {
+		return c.Status(status).JSON(fiber.Map{
+			"error": message,
+		})
+	}
+
+	if deployment.Status != models.TransactionStatusConfirmed || deployment.ContractAddress == "" {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Deployment is not confirmed yet",
+		})
+	}
+
+	var session *models.TransactionSession
+	if deployment.SessionId != "" {
+		// The session of a confirmed deployment is usually expired
+		sessions, err := s.txService.ListTransactionSessionsByIDs([]string{deployment.SessionId})
+		if err != nil {
+			log.Printf("Error getting session %s of deployment %d: %v", deployment.SessionId, deploymentID, err)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Failed to get the deployment session",
+			})
+		}
+		if len(sessions) > 0 {
+			session = &sessions[0]
+		}
+	}
+
+	artifacts, err := services.BuildDeploymentArtifacts(deployment, session)
+	if err != nil {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if c.Query("format") == "json" {
+		return c.JSON(artifacts)
+	}
+	archive, err := artifacts.Zip()
+	if err != nil {
+		log.Printf("Error archiving the artifacts of deployment %d: %v", deploymentID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to archive the artifacts",
+		})
+	}
+	c.Set("Content-Type", "application/zip")
+	c.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", artifacts.Filename()))
+	return c.Send(archive)
+}
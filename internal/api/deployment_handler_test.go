@@ -0,0 +1,150 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentArtifactsDownload(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{
+		Name:         "Token",
+		ChainType:    models.TransactionChainTypeEthereum,
+		TemplateCode: "contract Token {}",
+		Abi:          models.JSON{"abi": []any{map[string]any{"inputs": []any{}, "stateMutability": "nonpayable", "type": "constructor"}}},
+	}
+	require.NoError(t, db.Create(template).Error)
+	txService := services.NewTransactionService(db)
+	code := "contract Token {}"
+	sessionID, err := txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Data: "0x6080", ContractCode: &code, TransactionType: models.TransactionTypeTokenDeployment}},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+	})
+	require.NoError(t, err)
+	deploymentService := services.NewDeploymentService(db)
+	deployment := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, ContractAddress: "0xabc", ConstructorArgs: []any{}, Status: models.TransactionStatusConfirmed, SessionId: sessionID}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	pending := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, Status: models.TransactionStatusPending}
+	require.NoError(t, deploymentService.CreateDeployment(pending))
+
+	s := &APIServer{app: fiber.New(), deploymentService: deploymentService, txService: txService}
+	s.app.Get("/deployments/:deployment_id/artifacts", s.handleDeploymentArtifacts)
+
+	resp, err := s.app.Test(httptest.NewRequest("GET", fmt.Sprintf("/deployments/%d/artifacts", deployment.ID), nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/zip", resp.Header.Get("Content-Type"))
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), "Token-"+fmt.Sprint(deployment.ID)+"-artifacts.zip")
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	require.NoError(t, err)
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	assert.Equal(t, []string{"abi.json", "artifacts.json", "bytecode.txt", "constructor_args.json", "contracts/Token.sol"}, names)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", fmt.Sprintf("/deployments/%d/artifacts?format=json", deployment.ID), nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var artifacts services.DeploymentArtifacts
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&artifacts))
+	assert.Equal(t, "0x6080", artifacts.Bytecode)
+	assert.Equal(t, "contracts/Token.sol", artifacts.MainSource)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", fmt.Sprintf("/deployments/%d/artifacts", pending.ID), nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusConflict, resp.StatusCode)
+}
+
+func TestDeploymentDownloadAccess(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	t.Setenv(utils.EnvSessionURLSecret, "session-url-secret")
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{
+		Name:         "Token",
+		ChainType:    models.TransactionChainTypeEthereum,
+		TemplateCode: "contract Token {}",
+		Abi:          models.JSON{"abi": []any{map[string]any{"inputs": []any{}, "stateMutability": "nonpayable", "type": "constructor"}}},
+	}
+	require.NoError(t, db.Create(template).Error)
+	deploymentService := services.NewDeploymentService(db)
+	owner := "owner"
+	deployment := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, ContractAddress: "0xabc", ConstructorArgs: []any{}, Status: models.TransactionStatusConfirmed, UserID: &owner}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+
+	s := &APIServer{app: fiber.New(), deploymentService: deploymentService, txService: services.NewTransactionService(db), authenticationEnabled: true}
+	s.app.Use(func(c *fiber.Ctx) error {
+		if sub := c.Get("X-Test-User"); sub != "" {
+			c.Locals(middleware.AuthenticatedUserContextKey, &utils.AuthenticatedUser{Sub: sub})
+		}
+		return c.Next()
+	})
+	s.app.Get("/deployments/:deployment_id/artifacts", s.handleDeploymentArtifacts)
+	s.app.Get("/api/deployments/:deployment_id/typings", s.handleDeploymentTypings)
+
+	get := func(path, user string) int {
+		req := httptest.NewRequest("GET", path, nil)
+		if user != "" {
+			req.Header.Set("X-Test-User", user)
+		}
+		resp, err := s.app.Test(req)
+		require.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	path := fmt.Sprintf("/deployments/%d/artifacts", deployment.ID)
+	assert.Equal(t, fiber.StatusOK, get(path, owner))
+	assert.Equal(t, fiber.StatusNotFound, get(path, "other"))
+	assert.Equal(t, fiber.StatusUnauthorized, get(path, ""))
+
+	// the signed url of the tool opens without authentication until it expires
+	downloadURL, err := utils.GetDeploymentArtifactsUrl(context.Background(), 9000, deployment.ID)
+	require.NoError(t, err)
+	signedPath := strings.TrimPrefix(downloadURL, "http://localhost:9000")
+	assert.Equal(t, fiber.StatusOK, get(signedPath, ""))
+	assert.Equal(t, fiber.StatusForbidden, get(strings.Replace(signedPath, "sig=", "sig=x", 1), ""))
+
+	expired := time.Now().Add(-time.Minute).Unix()
+	expiredPath := fmt.Sprintf("%s?expires=%d&sig=%s", path, expired, utils.SignDownload(path, expired))
+	assert.Equal(t, fiber.StatusForbidden, get(expiredPath, ""))
+
+	typingsPath := fmt.Sprintf("/api/deployments/%d/typings", deployment.ID)
+	assert.Equal(t, fiber.StatusOK, get(typingsPath, owner))
+	assert.Equal(t, fiber.StatusNotFound, get(typingsPath, "other"))
+	typingsURL, err := utils.GetDeploymentTypingsUrl(context.Background(), 9000, deployment.ID)
+	require.NoError(t, err)
+	signedTypingsPath := strings.TrimPrefix(typingsURL, "http://localhost:9000")
+	assert.Equal(t, fiber.StatusOK, get(signedTypingsPath, ""))
+	// a signature of the artifacts does not open the typings
+	assert.Equal(t, fiber.StatusForbidden, get(typingsPath+signedPath[len(path):], ""))
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"log"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/assets"
+)
+
+// EnvEmbedAllowedOrigins are the comma separated origins of the dApps allowed to embed the signing page in an
+// iframe, e.g. https://app.example.com. The page posts its events to these origins only, unset forbids embedding
+const EnvEmbedAllowedOrigins = "LAUNCHPAD_EMBED_ALLOWED_ORIGINS"
+
+// embedOriginsFromEnv returns the origins of LAUNCHPAD_EMBED_ALLOWED_ORIGINS, invalid origins are ignored
+func embedOriginsFromEnv() []string {
+	origins := []string{}
+	for _, origin := range strings.Split(os.Getenv(EnvEmbedAllowedOrigins), ",") {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		if origin == "" {
+			continue
+		}
+		parsed, err := url.Parse(origin)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.Path != "" {
+			log.Printf("Warning: ignoring invalid %s origin %q", EnvEmbedAllowedOrigins, origin)
+			continue
+		}
+		origins = append(origins, origin)
+	}
+	return origins
+}
+
+// setFrameAncestors only lets the signing page be framed by itself and the embed origins, so other sites cannot
+// overlay the page to trick the signer
+func (s *APIServer) setFrameAncestors(c *fiber.Ctx) {
+	c.Set("Content-Security-Policy", "frame-ancestors "+strings.Join(append([]string{"'self'"}, s.embedOrigins...), " "))
+}
+
+// handleEmbedSDK serves the JavaScript helper mounting the signing page in an iframe of a dApp
+func (s *APIServer) handleEmbedSDK(c *fiber.Ctx) error {
+	c.Set("Content-Type", "application/javascript")
+	// The dApps load the helper from another origin
+	c.Set("Access-Control-Allow-Origin", "*")
+	return c.Send(assets.EmbedSDKJS)
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbedOriginsFromEnv(t *testing.T) {
+	t.Setenv(EnvEmbedAllowedOrigins, " https://app.example.com/, http://localhost:5173,not-an-origin,https://app.example.com/path,")
+	assert.Equal(t, []string{"https://app.example.com", "http://localhost:5173"}, embedOriginsFromEnv())
+
+	t.Setenv(EnvEmbedAllowedOrigins, "")
+	assert.Empty(t, embedOriginsFromEnv())
+}
+
+func TestFrameAncestors(t *testing.T) {
+	for _, tt := range []struct {
+		origins  []string
+		expected string
+	}{
+		{nil, "frame-ancestors 'self'"},
+		{[]string{"https://app.example.com", "http://localhost:5173"}, "frame-ancestors 'self' https://app.example.com http://localhost:5173"},
+	} {
+		s := &APIServer{embedOrigins: tt.origins}
+		app := fiber.New()
+		app.Get("/tx", func(c *fiber.Ctx) error {
+			s.setFrameAncestors(c)
+			return c.SendString("ok")
+		})
+		resp, err := app.Test(httptest.NewRequest("GET", "/tx", nil))
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, resp.Header.Get("Content-Security-Policy"))
+	}
+}
+
+func TestEmbedSDK(t *testing.T) {
+	s := &APIServer{}
+	app := fiber.New()
+	app.Get("/static/embed/launchpad.js", s.handleEmbedSDK)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/static/embed/launchpad.js", nil))
+	require.NoError(t, err)
+	assert.Equal(t, "application/javascript", resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "LaunchpadSigning")
+}
@@ -0,0 +1,550 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+// graphQLMaxDepth bounds the nesting of the analytics queries, a pool of a deployment with its swaps is depth 5
+const graphQLMaxDepth = 6
+
+// graphQLSchema is the read-only schema of the launch analytics. The lists are paginated with the endCursor of the
+// previous page, times are RFC3339 strings
+const graphQLSchema = `
+schema {
+	query: Query
+}
+
+type Query {
+	deployments(first: Int, after: String, status: String, chainType: String, templateId: ID, since: String, until: String): DeploymentConnection!
+	deployment(id: ID!): Deployment
+	pools(first: Int, after: String, status: String, tokenAddress: String, since: String, until: String): PoolConnection!
+	pool(id: ID!): Pool
+	swaps(poolId: ID, first: Int, after: String, since: String, until: String): SnapshotConnection!
+	snapshots(poolId: ID, transactionType: String, first: Int, after: String, since: String, until: String): SnapshotConnection!
+}
+
+type PageInfo {
+	hasNextPage: Boolean!
+	endCursor: String
+}
+
+type Deployment {
+	id: ID!
+	templateId: ID!
+	templateName: String!
+	chainId: ID!
+	chainName: String!
+	chainType: String!
+	contractAddress: String!
+	deployerAddress: String!
+	transactionHash: String!
+	status: String!
+	sessionId: String!
+	sellTestStatus: String
+	gasUsed: String
+	gasCost: String
+	createdAt: String!
+	pool: Pool
+}
+
+type DeploymentConnection {
+	nodes: [Deployment!]!
+	totalCount: Int!
+	pageInfo: PageInfo!
+}
+
+type Pool {
+	id: ID!
+	tokenAddress: String!
+	pairAddress: String!
+	uniswapVersion: String!
+	token0: String!
+	token1: String!
+	initialToken0: String!
+	initialToken1: String!
+	creatorAddress: String!
+	transactionHash: String!
+	status: String!
+	createdAt: String!
+	snapshots(transactionType: String, first: Int, after: String, since: String, until: String): SnapshotConnection!
+	swaps(first: Int, after: String, since: String, until: String): SnapshotConnection!
+}
+
+type PoolConnection {
+	nodes: [Pool!]!
+	totalCount: Int!
+	pageInfo: PageInfo!
+}
+
+type Snapshot {
+	id: ID!
+	poolId: ID!
+	reserve0: String!
+	reserve1: String!
+	price: Float!
+	transactionType: String!
+	transactionHash: String!
+	sessionId: String!
+	createdAt: String!
+}
+
+type SnapshotConnection {
+	nodes: [Snapshot!]!
+	totalCount: Int!
+	pageInfo: PageInfo!
+}
+`
+
+// graphQLRequest is the JSON body of POST /api/v1/graphql
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// EnableGraphQL serves the read-only analytics queries at /api/v1/graphql. The authenticated users only see their own
+// deployments, pools and snapshots
+func (s *APIServer) EnableGraphQL() error {
+	schema, err := graphql.ParseSchema(graphQLSchema, &graphQLResolver{
+		analytics: services.NewAnalyticsService(s.dbService.GetReadDB()),
+	}, graphql.MaxDepth(graphQLMaxDepth))
+	if err != nil {
+		return fmt.Errorf("failed to parse the GraphQL schema: %w", err)
+	}
+	s.app.Post(restV1Prefix+"/graphql", s.requireRESTUser, s.handleGraphQL(schema))
+	return nil
+}
+
+func (s *APIServer) handleGraphQL(schema *graphql.Schema) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var request graphQLRequest
+		if err := json.Unmarshal(c.Body(), &request); err != nil || request.Query == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "The body must be a JSON object with a query",
+			})
+		}
+
+		ctx := context.Background()
+		if user, ok := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser); ok && user != nil {
+			ctx = utils.WithAuthenticatedUser(ctx, user)
+		}
+		// The errors of the query are part of the GraphQL response, sent with 200 like other GraphQL servers
+		return c.JSON(schema.Exec(ctx, request.Query, request.OperationName, request.Variables))
+	}
+}
+
+// graphQLUserID scopes the analytics queries to the authenticated user, nil queries every user when authentication
+// is disabled
+func graphQLUserID(ctx context.Context) *string {
+	if userID := utils.GetUserID(ctx); userID != "" {
+		return &userID
+	}
+	return nil
+}
+
+// encodeGraphQLCursor returns the opaque cursor of a record ID
+func encodeGraphQLCursor(id uint) string {
+	return base64.URLEncoding.EncodeToString([]byte("cursor:" + strconv.FormatUint(uint64(id), 10)))
+}
+
+func decodeGraphQLCursor(cursor *string) (uint, error) {
+	if cursor == nil || *cursor == "" {
+		return 0, nil
+	}
+	decoded, err := base64.URLEncoding.DecodeString(*cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), "cursor:") {
+		return 0, fmt.Errorf("invalid cursor %q", *cursor)
+	}
+	id, err := strconv.ParseUint(strings.TrimPrefix(string(decoded), "cursor:"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor %q", *cursor)
+	}
+	return uint(id), nil
+}
+
+func parseGraphQLID(id graphql.ID) (uint, error) {
+	parsed, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", id)
+	}
+	return uint(parsed), nil
+}
+
+func parseGraphQLTime(name string, value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC3339 time: %w", name, err)
+	}
+	return &parsed, nil
+}
+
+func formatGraphQLTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// graphQLPageArgs are the pagination arguments of the list fields
+type graphQLPageArgs struct {
+	First *int32
+	After *string
+	Since *string
+	Until *string
+}
+
+func (args graphQLPageArgs) page(ctx context.Context) (services.AnalyticsPage, error) {
+	page := services.AnalyticsPage{UserID: graphQLUserID(ctx)}
+	if args.First != nil {
+		if *args.First < 1 || *args.First > services.MaxAnalyticsPageSize {
+			return page, fmt.Errorf("first must be between 1 and %d", services.MaxAnalyticsPageSize)
+		}
+		page.Limit = int(*args.First)
+	}
+	var err error
+	if page.After, err = decodeGraphQLCursor(args.After); err != nil {
+		return page, err
+	}
+	if page.Since, err = parseGraphQLTime("since", args.Since); err != nil {
+		return page, err
+	}
+	if page.Until, err = parseGraphQLTime("until", args.Until); err != nil {
+		return page, err
+	}
+	return page, nil
+}
+
+type graphQLPageInfo struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func newGraphQLPageInfo(hasNextPage bool, lastID uint, empty bool) *graphQLPageInfo {
+	info := &graphQLPageInfo{hasNextPage: hasNextPage}
+	if !empty {
+		cursor := encodeGraphQLCursor(lastID)
+		info.endCursor = &cursor
+	}
+	return info
+}
+
+func (p *graphQLPageInfo) HasNextPage() bool  { return p.hasNextPage }
+func (p *graphQLPageInfo) EndCursor() *string { return p.endCursor }
+
+type graphQLResolver struct {
+	analytics services.AnalyticsService
+}
+
+func (r *graphQLResolver) Deployments(ctx context.Context, args struct {
+	graphQLPageArgs
+	Status     *string
+	ChainType  *string
+	TemplateId *graphql.ID
+}) (*deploymentConnectionResolver, error) {
+	page, err := args.page(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := services.DeploymentQuery{AnalyticsPage: page}
+	if args.Status != nil {
+		query.Status = models.TransactionStatus(*args.Status)
+	}
+	if args.ChainType != nil {
+		query.ChainType = models.TransactionChainType(*args.ChainType)
+	}
+	if args.TemplateId != nil {
+		if query.TemplateID, err = parseGraphQLID(*args.TemplateId); err != nil {
+			return nil, err
+		}
+	}
+	deployments, hasMore, total, err := r.analytics.ListDeployments(query)
+	if err != nil {
+		return nil, err
+	}
+	connection := &deploymentConnectionResolver{totalCount: total}
+	var lastID uint
+	for i := range deployments {
+		connection.nodes = append(connection.nodes, &deploymentResolver{analytics: r.analytics, deployment: &deployments[i]})
+		lastID = deployments[i].ID
+	}
+	connection.pageInfo = newGraphQLPageInfo(hasMore, lastID, len(deployments) == 0)
+	return connection, nil
+}
+
+func (r *graphQLResolver) Deployment(ctx context.Context, args struct{ ID graphql.ID }) (*deploymentResolver, error) {
+	id, err := parseGraphQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := r.analytics.GetDeployment(graphQLUserID(ctx), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &deploymentResolver{analytics: r.analytics, deployment: deployment}, nil
+}
+
+func (r *graphQLResolver) Pools(ctx context.Context, args struct {
+	graphQLPageArgs
+	Status       *string
+	TokenAddress *string
+}) (*poolConnectionResolver, error) {
+	page, err := args.page(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := services.PoolQuery{AnalyticsPage: page}
+	if args.Status != nil {
+		query.Status = models.TransactionStatus(*args.Status)
+	}
+	if args.TokenAddress != nil {
+		query.TokenAddress = *args.TokenAddress
+	}
+	pools, hasMore, total, err := r.analytics.ListPools(query)
+	if err != nil {
+		return nil, err
+	}
+	connection := &poolConnectionResolver{totalCount: total}
+	var lastID uint
+	for i := range pools {
+		connection.nodes = append(connection.nodes, &poolResolver{analytics: r.analytics, pool: &pools[i]})
+		lastID = pools[i].ID
+	}
+	connection.pageInfo = newGraphQLPageInfo(hasMore, lastID, len(pools) == 0)
+	return connection, nil
+}
+
+func (r *graphQLResolver) Pool(ctx context.Context, args struct{ ID graphql.ID }) (*poolResolver, error) {
+	id, err := parseGraphQLID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := r.analytics.GetPool(graphQLUserID(ctx), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &poolResolver{analytics: r.analytics, pool: pool}, nil
+}
+
+func (r *graphQLResolver) Swaps(ctx context.Context, args struct {
+	graphQLPageArgs
+	PoolId *graphql.ID
+}) (*snapshotConnectionResolver, error) {
+	swapType := string(models.TransactionTypeTokenSwap)
+	return r.Snapshots(ctx, struct {
+		graphQLPageArgs
+		PoolId          *graphql.ID
+		TransactionType *string
+	}{args.graphQLPageArgs, args.PoolId, &swapType})
+}
+
+func (r *graphQLResolver) Snapshots(ctx context.Context, args struct {
+	graphQLPageArgs
+	PoolId          *graphql.ID
+	TransactionType *string
+}) (*snapshotConnectionResolver, error) {
+	page, err := args.page(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := services.SnapshotQuery{AnalyticsPage: page}
+	if args.PoolId != nil {
+		if query.PoolID, err = parseGraphQLID(*args.PoolId); err != nil {
+			return nil, err
+		}
+	}
+	if args.TransactionType != nil {
+		query.TransactionType = models.TransactionType(*args.TransactionType)
+	}
+	return listGraphQLSnapshots(r.analytics, query)
+}
+
+func listGraphQLSnapshots(analytics services.AnalyticsService, query services.SnapshotQuery) (*snapshotConnectionResolver, error) {
+	snapshots, hasMore, total, err := analytics.ListPoolSnapshots(query)
+	if err != nil {
+		return nil, err
+	}
+	connection := &snapshotConnectionResolver{totalCount: total}
+	var lastID uint
+	for i := range snapshots {
+		connection.nodes = append(connection.nodes, &snapshotResolver{snapshot: &snapshots[i]})
+		lastID = snapshots[i].ID
+	}
+	connection.pageInfo = newGraphQLPageInfo(hasMore, lastID, len(snapshots) == 0)
+	return connection, nil
+}
+
+type deploymentConnectionResolver struct {
+	nodes      []*deploymentResolver
+	totalCount int64
+	pageInfo   *graphQLPageInfo
+}
+
+func (c *deploymentConnectionResolver) Nodes() []*deploymentResolver { return c.nodes }
+func (c *deploymentConnectionResolver) TotalCount() int32            { return int32(c.totalCount) }
+func (c *deploymentConnectionResolver) PageInfo() *graphQLPageInfo   { return c.pageInfo }
+
+type deploymentResolver struct {
+	analytics  services.AnalyticsService
+	deployment *models.Deployment
+}
+
+func (d *deploymentResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(d.deployment.ID), 10))
+}
+func (d *deploymentResolver) TemplateId() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(d.deployment.TemplateID), 10))
+}
+func (d *deploymentResolver) TemplateName() string { return d.deployment.Template.Name }
+func (d *deploymentResolver) ChainId() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(d.deployment.ChainID), 10))
+}
+func (d *deploymentResolver) ChainName() string       { return d.deployment.Chain.Name }
+func (d *deploymentResolver) ChainType() string       { return string(d.deployment.Chain.ChainType) }
+func (d *deploymentResolver) ContractAddress() string { return d.deployment.ContractAddress }
+func (d *deploymentResolver) DeployerAddress() string { return d.deployment.DeployerAddress }
+func (d *deploymentResolver) TransactionHash() string { return d.deployment.TransactionHash }
+func (d *deploymentResolver) Status() string          { return string(d.deployment.Status) }
+func (d *deploymentResolver) SessionId() string       { return d.deployment.SessionId }
+func (d *deploymentResolver) SellTestStatus() *string {
+	if d.deployment.SellTestStatus == "" {
+		return nil
+	}
+	status := string(d.deployment.SellTestStatus)
+	return &status
+}
+func (d *deploymentResolver) GasUsed() *string {
+	if d.deployment.GasUsed == 0 {
+		return nil
+	}
+	// A string, the gas of a deployment can exceed the 32 bit Int of GraphQL
+	gasUsed := strconv.FormatUint(d.deployment.GasUsed, 10)
+	return &gasUsed
+}
+func (d *deploymentResolver) GasCost() *string {
+	if d.deployment.GasCost == "" {
+		return nil
+	}
+	return &d.deployment.GasCost
+}
+func (d *deploymentResolver) CreatedAt() string { return formatGraphQLTime(d.deployment.CreatedAt) }
+
+// Pool is the liquidity pool of the deployed token, the owner of the deployment owns its pool
+func (d *deploymentResolver) Pool() (*poolResolver, error) {
+	if d.deployment.ContractAddress == "" {
+		return nil, nil
+	}
+	pool, err := d.analytics.GetPoolByTokenAddress(d.deployment.UserID, d.deployment.ContractAddress)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &poolResolver{analytics: d.analytics, pool: pool}, nil
+}
+
+type poolConnectionResolver struct {
+	nodes      []*poolResolver
+	totalCount int64
+	pageInfo   *graphQLPageInfo
+}
+
+func (c *poolConnectionResolver) Nodes() []*poolResolver     { return c.nodes }
+func (c *poolConnectionResolver) TotalCount() int32          { return int32(c.totalCount) }
+func (c *poolConnectionResolver) PageInfo() *graphQLPageInfo { return c.pageInfo }
+
+type poolResolver struct {
+	analytics services.AnalyticsService
+	pool      *models.LiquidityPool
+}
+
+func (p *poolResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(p.pool.ID), 10))
+}
+func (p *poolResolver) TokenAddress() string    { return p.pool.TokenAddress }
+func (p *poolResolver) PairAddress() string     { return p.pool.PairAddress }
+func (p *poolResolver) UniswapVersion() string  { return p.pool.UniswapVersion }
+func (p *poolResolver) Token0() string          { return p.pool.Token0 }
+func (p *poolResolver) Token1() string          { return p.pool.Token1 }
+func (p *poolResolver) InitialToken0() string   { return p.pool.InitialToken0 }
+func (p *poolResolver) InitialToken1() string   { return p.pool.InitialToken1 }
+func (p *poolResolver) CreatorAddress() string  { return p.pool.CreatorAddress }
+func (p *poolResolver) TransactionHash() string { return p.pool.TransactionHash }
+func (p *poolResolver) Status() string          { return string(p.pool.Status) }
+func (p *poolResolver) CreatedAt() string       { return formatGraphQLTime(p.pool.CreatedAt) }
+
+func (p *poolResolver) Snapshots(ctx context.Context, args struct {
+	graphQLPageArgs
+	TransactionType *string
+}) (*snapshotConnectionResolver, error) {
+	page, err := args.page(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := services.SnapshotQuery{AnalyticsPage: page, PoolID: p.pool.ID}
+	if args.TransactionType != nil {
+		query.TransactionType = models.TransactionType(*args.TransactionType)
+	}
+	return listGraphQLSnapshots(p.analytics, query)
+}
+
+func (p *poolResolver) Swaps(ctx context.Context, args struct{ graphQLPageArgs }) (*snapshotConnectionResolver, error) {
+	page, err := args.page(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return listGraphQLSnapshots(p.analytics, services.SnapshotQuery{
+		AnalyticsPage:   page,
+		PoolID:          p.pool.ID,
+		TransactionType: models.TransactionTypeTokenSwap,
+	})
+}
+
+type snapshotConnectionResolver struct {
+	nodes      []*snapshotResolver
+	totalCount int64
+	pageInfo   *graphQLPageInfo
+}
+
+func (c *snapshotConnectionResolver) Nodes() []*snapshotResolver { return c.nodes }
+func (c *snapshotConnectionResolver) TotalCount() int32          { return int32(c.totalCount) }
+func (c *snapshotConnectionResolver) PageInfo() *graphQLPageInfo { return c.pageInfo }
+
+type snapshotResolver struct {
+	snapshot *models.PoolSnapshot
+}
+
+func (s *snapshotResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(s.snapshot.ID), 10))
+}
+func (s *snapshotResolver) PoolId() graphql.ID {
+	return graphql.ID(strconv.FormatUint(uint64(s.snapshot.PoolID), 10))
+}
+func (s *snapshotResolver) Reserve0() string        { return s.snapshot.Reserve0 }
+func (s *snapshotResolver) Reserve1() string        { return s.snapshot.Reserve1 }
+func (s *snapshotResolver) Price() float64          { return s.snapshot.Price }
+func (s *snapshotResolver) TransactionType() string { return string(s.snapshot.TransactionType) }
+func (s *snapshotResolver) TransactionHash() string { return s.snapshot.TransactionHash }
+func (s *snapshotResolver) SessionId() string       { return s.snapshot.SessionId }
+func (s *snapshotResolver) CreatedAt() string       { return formatGraphQLTime(s.snapshot.CreatedAt) }
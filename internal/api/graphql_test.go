@@ -0,0 +1,118 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGraphQLTestServer(t *testing.T) (*APIServer, *models.Deployment, *models.LiquidityPool) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	alice, bob := "alice", "bob"
+	deployment := &models.Deployment{UserID: &alice, TemplateID: template.ID, ChainID: chain.ID, ContractAddress: "0xToken", Status: models.TransactionStatusConfirmed, GasUsed: 1200000}
+	require.NoError(t, db.Create(deployment).Error)
+	require.NoError(t, db.Create(&models.Deployment{UserID: &bob, TemplateID: template.ID, ChainID: chain.ID, Status: models.TransactionStatusConfirmed}).Error)
+	pool := &models.LiquidityPool{UserID: &alice, TokenAddress: "0xtoken", PairAddress: "0xpair", UniswapVersion: "v2", Token0: "0xtoken", Token1: "0xweth", InitialToken0: "1000", InitialToken1: "1", CreatorAddress: "0xalice", TransactionHash: "0xtx", Status: models.TransactionStatusConfirmed}
+	require.NoError(t, db.Create(pool).Error)
+	for _, reserve := range []string{"1000", "900", "800"} {
+		require.NoError(t, db.Create(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: reserve, Reserve1: "1", TransactionType: models.TransactionTypeTokenSwap}).Error)
+	}
+
+	s := &APIServer{app: fiber.New(), dbService: dbService, authenticationEnabled: true}
+	s.app.Use(func(c *fiber.Ctx) error {
+		if sub := c.Get("X-Test-User"); sub != "" {
+			c.Locals(middleware.AuthenticatedUserContextKey, &utils.AuthenticatedUser{Sub: sub})
+		}
+		return c.Next()
+	})
+	require.NoError(t, s.EnableGraphQL())
+	return s, deployment, pool
+}
+
+func graphQLTestQuery(t *testing.T, s *APIServer, user, query string, variables map[string]any) (int, map[string]any) {
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	require.NoError(t, err)
+	return restTestRequest(t, s, "POST", "/api/v1/graphql", string(body), user)
+}
+
+func TestGraphQLScopesToUser(t *testing.T) {
+	s, deployment, _ := newGraphQLTestServer(t)
+
+	status, _ := graphQLTestQuery(t, s, "", `{ deployments { totalCount } }`, nil)
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+
+	status, body := graphQLTestQuery(t, s, "alice", `{
+		deployments(status: "confirmed") {
+			totalCount
+			nodes { id templateName chainType gasUsed pool { tokenAddress swaps(first: 2) { totalCount nodes { reserve0 } } } }
+		}
+	}`, nil)
+	require.Equal(t, fiber.StatusOK, status)
+	require.Nil(t, body["errors"])
+	deployments := body["data"].(map[string]any)["deployments"].(map[string]any)
+	assert.Equal(t, float64(1), deployments["totalCount"])
+	node := deployments["nodes"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Token", node["templateName"])
+	assert.Equal(t, "ethereum", node["chainType"])
+	assert.Equal(t, "1200000", node["gasUsed"])
+	swaps := node["pool"].(map[string]any)["swaps"].(map[string]any)
+	assert.Equal(t, float64(3), swaps["totalCount"])
+	assert.Len(t, swaps["nodes"], 2)
+
+	// The deployments of other users are not found
+	_, body = graphQLTestQuery(t, s, "bob", `query($id: ID!) { deployment(id: $id) { id } }`, map[string]any{"id": fmt.Sprint(deployment.ID)})
+	require.Nil(t, body["errors"])
+	assert.Nil(t, body["data"].(map[string]any)["deployment"])
+	_, body = graphQLTestQuery(t, s, "alice", `query($id: ID!) { deployment(id: $id) { id } }`, map[string]any{"id": fmt.Sprint(deployment.ID)})
+	assert.Equal(t, map[string]any{"id": fmt.Sprint(deployment.ID)}, body["data"].(map[string]any)["deployment"])
+}
+
+func TestGraphQLPaginatesWithCursor(t *testing.T) {
+	s, _, pool := newGraphQLTestServer(t)
+
+	query := `query($poolId: ID, $after: String) {
+		swaps(poolId: $poolId, first: 2, after: $after) { nodes { reserve0 } pageInfo { hasNextPage endCursor } }
+	}`
+	_, body := graphQLTestQuery(t, s, "alice", query, map[string]any{"poolId": fmt.Sprint(pool.ID)})
+	swaps := body["data"].(map[string]any)["swaps"].(map[string]any)
+	assert.Len(t, swaps["nodes"], 2)
+	pageInfo := swaps["pageInfo"].(map[string]any)
+	assert.Equal(t, true, pageInfo["hasNextPage"])
+
+	_, body = graphQLTestQuery(t, s, "alice", query, map[string]any{"poolId": fmt.Sprint(pool.ID), "after": pageInfo["endCursor"]})
+	swaps = body["data"].(map[string]any)["swaps"].(map[string]any)
+	assert.Equal(t, []any{map[string]any{"reserve0": "800"}}, swaps["nodes"])
+	assert.Equal(t, false, swaps["pageInfo"].(map[string]any)["hasNextPage"])
+
+	_, body = graphQLTestQuery(t, s, "alice", query, map[string]any{"after": "not-a-cursor"})
+	assert.NotEmpty(t, body["errors"])
+	_, body = graphQLTestQuery(t, s, "alice", `{ pools(first: 500) { totalCount } }`, nil)
+	assert.NotEmpty(t, body["errors"])
+}
+
+func TestGraphQLRejectsMutations(t *testing.T) {
+	s, _, _ := newGraphQLTestServer(t)
+
+	_, body := graphQLTestQuery(t, s, "alice", `mutation { deleteDeployment(id: 1) }`, nil)
+	assert.NotEmpty(t, body["errors"])
+
+	status, body := restTestRequest(t, s, "POST", "/api/v1/graphql", `{}`, "alice")
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	assert.Equal(t, "The body must be a JSON object with a query", body["error"])
+}
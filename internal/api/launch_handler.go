@@ -0,0 +1,67 @@
+package api
+
+import (
+	"bytes"
+	"html/template"
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/assets"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+type LaunchCountdownPageData struct {
+	Launch      *models.TradingLaunch
+	TokenName   string
+	TokenSymbol string
+	OpensAtUnix int64
+	// PoolUrl links the pool detail page of the token when it has a pool
+	PoolUrl string
+}
+
+// handleLaunchCountdownPage serves the public countdown page of a trading launch
+func (s *APIServer) handleLaunchCountdownPage(c *fiber.Ctx) error {
+	launchID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return s.renderErrorPage(c, fiber.StatusBadRequest, "Invalid Launch ID",
+			"The launch ID in the URL is not valid.")
+	}
+
+	launch, err := s.tradingLaunchService.GetTradingLaunch(uint(launchID))
+	if err != nil {
+		log.Printf("Error getting trading launch %d: %v", launchID, err)
+		return s.renderErrorPage(c, fiber.StatusNotFound, "Launch Not Found",
+			"The requested trading launch could not be found.")
+	}
+
+	data := LaunchCountdownPageData{
+		Launch:      launch,
+		TokenName:   launch.Deployment.Template.Name,
+		OpensAtUnix: launch.OpensAt.Unix(),
+	}
+	if name, ok := launch.Deployment.TemplateValues["TokenName"].(string); ok && name != "" {
+		data.TokenName = name
+	}
+	if symbol, ok := launch.Deployment.TemplateValues["TokenSymbol"].(string); ok {
+		data.TokenSymbol = symbol
+	}
+	if pool, err := s.liquidityService.GetLiquidityPoolByTokenAddress(launch.Deployment.ContractAddress, ""); err == nil {
+		data.PoolUrl = "/pool/" + strconv.FormatUint(uint64(pool.ID), 10)
+	}
+
+	tmpl, err := template.New("countdown").Parse(string(assets.CountdownHTML))
+	if err != nil {
+		log.Printf("Error parsing countdown template: %v", err)
+		return c.Status(fiber.StatusInternalServerError).SendString("Error parsing template")
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Printf("Error rendering countdown template: %v", err)
+		return c.Status(fiber.StatusInternalServerError).SendString("Error rendering template")
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+	return c.Send(buf.Bytes())
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type LaunchHandlerTestSuite struct {
+	suite.Suite
+	db                   services.DBService
+	apiServer            *APIServer
+	serverPort           int
+	deployment           *models.Deployment
+	liquidityService     services.LiquidityService
+	tradingLaunchService services.TradingLaunchService
+}
+
+func (suite *LaunchHandlerTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", "test-secret")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chainService := services.NewChainService(db.GetDB())
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	suite.liquidityService = services.NewLiquidityService(db.GetDB())
+	suite.tradingLaunchService = services.NewTradingLaunchService(db.GetDB())
+	uniswapService := services.NewUniswapService(db.GetDB())
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	suite.Require().NoError(chainService.CreateChain(chain))
+	template := &models.Template{Name: "Gated Token", ChainType: models.TransactionChainTypeEthereum}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	suite.deployment = &models.Deployment{
+		ChainID:         chain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: "0x1111111111111111111111111111111111111111",
+		TemplateValues:  models.JSON{"TokenName": "Launch Token", "TokenSymbol": "LCH"},
+	}
+	suite.Require().NoError(deploymentService.CreateDeployment(suite.deployment))
+
+	apiServer := NewAPIServer(db, services.NewTransactionService(db.GetDB()), services.NewHookService(), chainService, deploymentService, suite.liquidityService, services.NewUniswapContractService(uniswapService))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *LaunchHandlerTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *LaunchHandlerTestSuite) getPage(path string) (int, string) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", suite.serverPort, path))
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	suite.Require().NoError(err)
+	return resp.StatusCode, string(body)
+}
+
+func (suite *LaunchHandlerTestSuite) TestScheduledLaunchShowsCountdown() {
+	launch := &models.TradingLaunch{
+		DeploymentID: suite.deployment.ID,
+		ChainID:      suite.deployment.ChainID,
+		FunctionName: "enableTrading",
+		OpensAt:      time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC),
+	}
+	suite.Require().NoError(suite.tradingLaunchService.CreateTradingLaunch(launch))
+
+	status, body := suite.getPage(fmt.Sprintf("/launch/%d", launch.ID))
+	suite.Equal(http.StatusOK, status)
+	suite.Contains(body, "Launch Token (LCH)")
+	suite.Contains(body, "Trading opens soon")
+	suite.Contains(body, "Opens at 2030-01-01 09:00:00 UTC")
+	suite.Contains(body, `id="seconds"`)
+}
+
+func (suite *LaunchHandlerTestSuite) TestOpenLaunchLinksPool() {
+	pool := &models.LiquidityPool{
+		TokenAddress:   suite.deployment.ContractAddress,
+		UniswapVersion: "v2",
+		Token0:         suite.deployment.ContractAddress,
+		Token1:         "0x0000000000000000000000000000000000000000",
+	}
+	_, err := suite.liquidityService.CreateLiquidityPool(pool)
+	suite.Require().NoError(err)
+
+	launch := &models.TradingLaunch{
+		DeploymentID: suite.deployment.ID,
+		ChainID:      suite.deployment.ChainID,
+		FunctionName: "enableTrading",
+		OpensAt:      time.Now().Add(-time.Hour),
+		Status:       models.TradingLaunchStatusPending,
+		SessionId:    "launch-session",
+	}
+	suite.Require().NoError(suite.tradingLaunchService.CreateTradingLaunch(launch))
+	suite.Require().NoError(suite.tradingLaunchService.MarkTradingLaunchOpen(launch.ID, "0xopenhash"))
+
+	status, body := suite.getPage(fmt.Sprintf("/launch/%d", launch.ID))
+	suite.Equal(http.StatusOK, status)
+	suite.Contains(body, "Trading is open")
+	suite.Contains(body, "0xopenhash")
+	suite.Contains(body, fmt.Sprintf("/pool/%d", pool.ID))
+	suite.NotContains(body, "window.location.reload")
+}
+
+func (suite *LaunchHandlerTestSuite) TestLaunchPageNotFound() {
+	status, _ := suite.getPage("/launch/99999")
+	suite.Equal(http.StatusNotFound, status)
+
+	status, _ = suite.getPage("/launch/abc")
+	suite.Equal(http.StatusBadRequest, status)
+}
+
+func TestLaunchHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(LaunchHandlerTestSuite))
+}
@@ -60,6 +60,26 @@ func OauthAuthMiddleware(config ...AuthConfig) fiber.Handler {
 			return c.Next()
 		}
 
+		// skip /launch routes, the countdown page is public
+		if strings.HasPrefix(c.Path(), "/launch") {
+			return c.Next()
+		}
+
+		// skip /pool routes, the pool detail page is public
+		if strings.HasPrefix(c.Path(), "/pool/") {
+			return c.Next()
+		}
+
+		// skip /bonding-curves routes, the curve progress is public
+		if strings.HasPrefix(c.Path(), "/bonding-curves") {
+			return c.Next()
+		}
+
+		// skip the signed deployment downloads, the handlers verify the signature and expiry of the url
+		if isDeploymentDownloadPath(c.Path()) && c.Query(utils.SessionSignatureQueryParam) != "" {
+			return c.Next()
+		}
+
 		// skip /static routes
 		if strings.HasPrefix(c.Path(), "/static") {
 			return c.Next()
@@ -69,6 +89,16 @@ func OauthAuthMiddleware(config ...AuthConfig) fiber.Handler {
 			return c.Next()
 		}
 
+		// skip /tokens routes, the token info, logos and token list are public
+		if strings.HasPrefix(c.Path(), "/tokens") {
+			return c.Next()
+		}
+
+		// skip the admin dashboard page, it asks for the token of the /admin/api calls
+		if c.Path() == "/admin" {
+			return c.Next()
+		}
+
 		// skip /health route
 		if c.Path() == "/health" {
 			return c.Next()
@@ -114,3 +144,16 @@ func OauthAuthMiddleware(config ...AuthConfig) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// isDeploymentDownloadPath reports whether the path is the /deployments/:deployment_id/artifacts or
+// /api/deployments/:deployment_id/typings download
+func isDeploymentDownloadPath(path string) bool {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case len(segments) == 3:
+		return segments[0] == "deployments" && segments[1] != "" && segments[2] == "artifacts"
+	case len(segments) == 4:
+		return segments[0] == "api" && segments[1] == "deployments" && segments[2] != "" && segments[3] == "typings"
+	}
+	return false
+}
@@ -304,6 +304,33 @@ func TestAuthMiddleware_DefaultConfig(t *testing.T) {
 	assert.IsType(t, &utils.AuthenticatedUser{}, user)
 }
 
+func TestAuthMiddleware_PublicPages(t *testing.T) {
+	app := fiber.New()
+	app.Use(OauthAuthMiddleware())
+	app.Get("/*", func(c *fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	for _, path := range []string{"/launch/1", "/pool/1", "/bonding-curves/1/progress"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, path)
+	}
+
+	// the signed downloads are verified by their handler
+	for _, path := range []string{"/deployments/1/artifacts?expires=1&sig=signature", "/api/deployments/1/typings?expires=1&sig=signature"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode, path)
+	}
+
+	for _, path := range []string{"/api/session/1", "/deployments/1/artifacts", "/api/deployments/1/typings", "/deployments/1/other?sig=signature"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, resp.StatusCode, path)
+	}
+}
+
 func TestAuthMiddleware_ContextKeyConstant(t *testing.T) {
 	// Test that the context key constant is properly defined
 	assert.Equal(t, "authenticatedUser", AuthenticatedUserContextKey)
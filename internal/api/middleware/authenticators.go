@@ -0,0 +1,277 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// EnvAuthProviders is the comma separated list of authenticators enabled on the streamable-http server,
+	// see AuthProviderOIDC, AuthProviderAPIKey and AuthProviderMTLS
+	EnvAuthProviders = "LAUNCHPAD_AUTH_PROVIDERS"
+	// EnvOIDCJWKSURL is the JWKS endpoint of the OIDC provider, required by the oidc authenticator
+	EnvOIDCJWKSURL = "LAUNCHPAD_OIDC_JWKS_URL"
+	// EnvOIDCIssuer is the issuer the OIDC tokens must carry, optional
+	EnvOIDCIssuer = "LAUNCHPAD_OIDC_ISSUER"
+	// EnvOIDCAudience is the audience the OIDC tokens must carry, optional
+	EnvOIDCAudience = "LAUNCHPAD_OIDC_AUDIENCE"
+	// EnvAPIKeys is the comma separated list of static API keys as subject:key or subject:key:role|role
+	EnvAPIKeys = "LAUNCHPAD_API_KEYS"
+
+	// AuthProviderOIDC validates the Bearer tokens of an OIDC provider against its JWKS
+	AuthProviderOIDC = "oidc"
+	// AuthProviderAPIKey accepts the keys of LAUNCHPAD_API_KEYS in the X-API-Key header
+	AuthProviderAPIKey = "api_key"
+	// AuthProviderMTLS accepts the client certificates verified by the TLS listener of the server
+	AuthProviderMTLS = "mtls"
+
+	// APIKeyHeader is the header carrying the static API key
+	APIKeyHeader = "X-API-Key"
+)
+
+// Authenticator identifies the user of a request from one kind of credential
+type Authenticator interface {
+	// Name is logged with the failed authentications
+	Name() string
+	// Authenticate returns the user of the request, or nil without error when the request carries no credential for this authenticator
+	Authenticate(c *fiber.Ctx) (*utils.AuthenticatedUser, error)
+}
+
+// AuthenticatorMiddleware sets the authenticated user of the first authenticator accepting the request.
+// Like JwtAuthMiddleware it never blocks requests, the routes requiring a user reject the requests left without one
+func AuthenticatorMiddleware(authenticators ...Authenticator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// check if the ctx already has an authenticated user
+		if c.Locals(AuthenticatedUserContextKey) != nil {
+			return c.Next()
+		}
+
+		for _, authenticator := range authenticators {
+			user, err := authenticator.Authenticate(c)
+			if err != nil {
+				log.Printf("%s authentication failed: %v", authenticator.Name(), err)
+				continue
+			}
+			if user != nil {
+				c.Locals(AuthenticatedUserContextKey, user)
+				break
+			}
+		}
+		return c.Next()
+	}
+}
+
+// AuthenticatorsFromEnv builds the authenticators of LAUNCHPAD_AUTH_PROVIDERS in the order they are listed.
+// It returns no authenticator when the variable is unset and an error when a provider is unknown or misconfigured
+func AuthenticatorsFromEnv() ([]Authenticator, error) {
+	var authenticators []Authenticator
+	for _, name := range strings.Split(os.Getenv(EnvAuthProviders), ",") {
+		switch name = strings.ToLower(strings.TrimSpace(name)); name {
+		case "":
+		case AuthProviderOIDC:
+			authenticator, err := NewOIDCAuthenticator(os.Getenv(EnvOIDCJWKSURL), os.Getenv(EnvOIDCIssuer), os.Getenv(EnvOIDCAudience))
+			if err != nil {
+				return nil, err
+			}
+			authenticators = append(authenticators, authenticator)
+		case AuthProviderAPIKey:
+			authenticator, err := NewAPIKeyAuthenticator(os.Getenv(EnvAPIKeys))
+			if err != nil {
+				return nil, err
+			}
+			authenticators = append(authenticators, authenticator)
+		case AuthProviderMTLS:
+			authenticators = append(authenticators, NewMTLSAuthenticator())
+		default:
+			return nil, fmt.Errorf("unknown %s %q, supported providers: %s, %s, %s", EnvAuthProviders, name, AuthProviderOIDC, AuthProviderAPIKey, AuthProviderMTLS)
+		}
+	}
+	return authenticators, nil
+}
+
+// AuthProviderEnabled reports whether the provider is listed in LAUNCHPAD_AUTH_PROVIDERS
+func AuthProviderEnabled(provider string) bool {
+	for _, name := range strings.Split(os.Getenv(EnvAuthProviders), ",") {
+		if strings.ToLower(strings.TrimSpace(name)) == provider {
+			return true
+		}
+	}
+	return false
+}
+
+// bearerToken returns the Bearer token of the Authorization header, empty when there is none
+func bearerToken(c *fiber.Ctx) string {
+	authHeader := c.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
+}
+
+// oidcAuthenticator validates the RS256 tokens of an OIDC provider
+type oidcAuthenticator struct {
+	jwt      *utils.JwtAuthenticator
+	issuer   string
+	audience string
+}
+
+// NewOIDCAuthenticator validates the Bearer tokens against the JWKS of the provider, and their issuer and audience when set
+func NewOIDCAuthenticator(jwksURL, issuer, audience string) (Authenticator, error) {
+	if jwksURL == "" {
+		return nil, fmt.Errorf("%s is required by the %s authenticator", EnvOIDCJWKSURL, AuthProviderOIDC)
+	}
+	jwt := utils.NewJwtAuthenticator(jwksURL)
+	return &oidcAuthenticator{jwt: &jwt, issuer: issuer, audience: audience}, nil
+}
+
+func (a *oidcAuthenticator) Name() string {
+	return "OIDC"
+}
+
+func (a *oidcAuthenticator) Authenticate(c *fiber.Ctx) (*utils.AuthenticatedUser, error) {
+	token := bearerToken(c)
+	if token == "" {
+		return nil, nil
+	}
+	user, err := a.jwt.ValidateToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if a.issuer != "" && user.Iss != a.issuer {
+		return nil, fmt.Errorf("unexpected issuer %q", user.Iss)
+	}
+	if a.audience != "" && !containsString(user.Aud, a.audience) {
+		return nil, fmt.Errorf("token is not issued for the audience %q", a.audience)
+	}
+	if user.Sub == "" {
+		return nil, fmt.Errorf("token has no subject")
+	}
+	return user, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// apiKey is a static API key, only the hash of the key is kept
+type apiKey struct {
+	hash [sha256.Size]byte
+	user utils.AuthenticatedUser
+}
+
+// apiKeyAuthenticator accepts the static API keys of the configuration
+type apiKeyAuthenticator struct {
+	keys []apiKey
+}
+
+// NewAPIKeyAuthenticator accepts the keys of the comma separated subject:key or subject:key:role|role entries
+func NewAPIKeyAuthenticator(config string) (Authenticator, error) {
+	authenticator := &apiKeyAuthenticator{}
+	for _, entry := range strings.Split(config, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid %s entry, expected subject:key or subject:key:role|role", EnvAPIKeys)
+		}
+		key := apiKey{hash: sha256.Sum256([]byte(parts[1])), user: utils.AuthenticatedUser{Sub: parts[0]}}
+		if len(parts) == 3 && parts[2] != "" {
+			key.user.Roles = strings.Split(parts[2], "|")
+		}
+		authenticator.keys = append(authenticator.keys, key)
+	}
+	if len(authenticator.keys) == 0 {
+		return nil, fmt.Errorf("%s is required by the %s authenticator", EnvAPIKeys, AuthProviderAPIKey)
+	}
+	return authenticator, nil
+}
+
+func (a *apiKeyAuthenticator) Name() string {
+	return "API key"
+}
+
+func (a *apiKeyAuthenticator) Authenticate(c *fiber.Ctx) (*utils.AuthenticatedUser, error) {
+	provided := c.Get(APIKeyHeader)
+	if provided == "" {
+		return nil, nil
+	}
+	hash := sha256.Sum256([]byte(provided))
+	for _, key := range a.keys {
+		if subtle.ConstantTimeCompare(hash[:], key.hash[:]) == 1 {
+			user := key.user
+			return &user, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown API key")
+}
+
+// storedAPIKeyAuthenticator accepts the API keys created by create_api_key
+type storedAPIKeyAuthenticator struct {
+	prefix   string
+	validate func(key string) (*utils.AuthenticatedUser, error)
+}
+
+// NewStoredAPIKeyAuthenticator accepts the keys starting with the prefix in the X-API-Key header or as a Bearer token,
+// validate returns the user of a key. Keys without the prefix are left to the other authenticators
+func NewStoredAPIKeyAuthenticator(prefix string, validate func(key string) (*utils.AuthenticatedUser, error)) Authenticator {
+	return &storedAPIKeyAuthenticator{prefix: prefix, validate: validate}
+}
+
+func (a *storedAPIKeyAuthenticator) Name() string {
+	return "Stored API key"
+}
+
+func (a *storedAPIKeyAuthenticator) Authenticate(c *fiber.Ctx) (*utils.AuthenticatedUser, error) {
+	key := c.Get(APIKeyHeader)
+	if !strings.HasPrefix(key, a.prefix) {
+		key = bearerToken(c)
+	}
+	if !strings.HasPrefix(key, a.prefix) {
+		return nil, nil
+	}
+	return a.validate(key)
+}
+
+// mtlsAuthenticator identifies the user from the client certificate verified during the TLS handshake
+type mtlsAuthenticator struct{}
+
+// NewMTLSAuthenticator accepts the client certificates verified against LAUNCHPAD_TLS_CLIENT_CA_FILE by the TLS
+// listener of the server. The common name is the subject and the organizational units are the roles
+func NewMTLSAuthenticator() Authenticator {
+	return &mtlsAuthenticator{}
+}
+
+func (a *mtlsAuthenticator) Name() string {
+	return "mTLS"
+}
+
+func (a *mtlsAuthenticator) Authenticate(c *fiber.Ctx) (*utils.AuthenticatedUser, error) {
+	state := c.Context().TLSConnectionState()
+	// The listener only verifies the certificates that are given, requests without one have no verified chain
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	certificate := state.VerifiedChains[0][0]
+	if certificate.Subject.CommonName == "" {
+		return nil, fmt.Errorf("client certificate has no common name")
+	}
+	return &utils.AuthenticatedUser{
+		Sub:   certificate.Subject.CommonName,
+		Iss:   certificate.Issuer.CommonName,
+		Roles: certificate.Subject.OrganizationalUnit,
+		Exp:   int(certificate.NotAfter.Unix()),
+	}, nil
+}
@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthenticatorTestApp answers the subject and roles of the authenticated user
+func newAuthenticatorTestApp(authenticators ...Authenticator) *fiber.App {
+	app := fiber.New()
+	app.Use(AuthenticatorMiddleware(authenticators...))
+	app.Get("/test", func(c *fiber.Ctx) error {
+		user, _ := c.Locals(AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+		if user == nil {
+			return c.JSON(fiber.Map{"authenticated": false})
+		}
+		return c.JSON(fiber.Map{"authenticated": true, "sub": user.Sub, "roles": user.Roles})
+	})
+	return app
+}
+
+func authenticatedAs(t *testing.T, app *fiber.App, headers map[string]string) map[string]any {
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body
+}
+
+func TestAPIKeyAuthenticator(t *testing.T) {
+	authenticator, err := NewAPIKeyAuthenticator("alice:key-alice:admin|operator, bot:key-bot")
+	require.NoError(t, err)
+	app := newAuthenticatorTestApp(authenticator)
+
+	body := authenticatedAs(t, app, map[string]string{APIKeyHeader: "key-alice"})
+	assert.Equal(t, "alice", body["sub"])
+	assert.Equal(t, []any{"admin", "operator"}, body["roles"])
+	assert.Equal(t, "bot", authenticatedAs(t, app, map[string]string{APIKeyHeader: "key-bot"})["sub"])
+	assert.Equal(t, false, authenticatedAs(t, app, map[string]string{APIKeyHeader: "key-unknown"})["authenticated"])
+	assert.Equal(t, false, authenticatedAs(t, app, nil)["authenticated"])
+
+	_, err = NewAPIKeyAuthenticator("alice")
+	assert.Error(t, err)
+	_, err = NewAPIKeyAuthenticator("")
+	assert.ErrorContains(t, err, EnvAPIKeys)
+}
+
+func TestOIDCAuthenticator(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKey, err := jwk.FromRaw(&privateKey.PublicKey)
+	require.NoError(t, err)
+	require.NoError(t, publicKey.Set(jwk.KeyIDKey, "test-key"))
+	keySet := jwk.NewSet()
+	require.NoError(t, keySet.AddKey(publicKey))
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(keySet)
+	}))
+	t.Cleanup(jwks.Close)
+
+	sign := func(claims jwt.MapClaims) string {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "test-key"
+		signed, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+		return "Bearer " + signed
+	}
+
+	authenticator, err := NewOIDCAuthenticator(jwks.URL, "https://issuer.example", "launchpad")
+	require.NoError(t, err)
+	app := newAuthenticatorTestApp(authenticator)
+
+	body := authenticatedAs(t, app, map[string]string{"Authorization": sign(jwt.MapClaims{"sub": "user-1", "iss": "https://issuer.example", "aud": "launchpad"})})
+	assert.Equal(t, "user-1", body["sub"])
+	body = authenticatedAs(t, app, map[string]string{"Authorization": sign(jwt.MapClaims{"sub": "user-1", "iss": "https://other.example", "aud": "launchpad"})})
+	assert.Equal(t, false, body["authenticated"])
+	body = authenticatedAs(t, app, map[string]string{"Authorization": sign(jwt.MapClaims{"sub": "user-1", "iss": "https://issuer.example", "aud": "other"})})
+	assert.Equal(t, false, body["authenticated"])
+
+	_, err = NewOIDCAuthenticator("", "", "")
+	assert.ErrorContains(t, err, EnvOIDCJWKSURL)
+}
+
+func TestAuthenticatorsFromEnv(t *testing.T) {
+	t.Setenv(EnvAuthProviders, "")
+	authenticators, err := AuthenticatorsFromEnv()
+	require.NoError(t, err)
+	assert.Empty(t, authenticators)
+
+	t.Setenv(EnvAuthProviders, "api_key, mtls")
+	t.Setenv(EnvAPIKeys, "alice:key-alice")
+	authenticators, err = AuthenticatorsFromEnv()
+	require.NoError(t, err)
+	require.Len(t, authenticators, 2)
+	assert.Equal(t, "API key", authenticators[0].Name())
+	assert.Equal(t, "mTLS", authenticators[1].Name())
+
+	t.Setenv(EnvAuthProviders, "oidc")
+	t.Setenv(EnvOIDCJWKSURL, "")
+	_, err = AuthenticatorsFromEnv()
+	assert.ErrorContains(t, err, EnvOIDCJWKSURL)
+
+	t.Setenv(EnvAuthProviders, "saml")
+	_, err = AuthenticatorsFromEnv()
+	assert.ErrorContains(t, err, "unknown")
+}
+
+func TestAuthProviderEnabled(t *testing.T) {
+	t.Setenv(EnvAuthProviders, "api_key, MTLS")
+	assert.True(t, AuthProviderEnabled(AuthProviderMTLS))
+	assert.True(t, AuthProviderEnabled(AuthProviderAPIKey))
+	assert.False(t, AuthProviderEnabled(AuthProviderOIDC))
+
+	t.Setenv(EnvAuthProviders, "mtls_proxy")
+	assert.False(t, AuthProviderEnabled(AuthProviderMTLS))
+}
+
+func TestMTLSAuthenticator(t *testing.T) {
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Launchpad CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err = x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(template *x509.Certificate) tls.Certificate {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		template.NotBefore = time.Now().Add(-time.Minute)
+		template.NotAfter = time.Now().Add(time.Hour)
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	}
+	serverCert := issue(&x509.Certificate{SerialNumber: big.NewInt(2), IPAddresses: []net.IP{net.ParseIP("127.0.0.1")}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+	clientCert := issue(&x509.Certificate{SerialNumber: big.NewInt(3), Subject: pkix.Name{CommonName: "deployer", OrganizationalUnit: []string{"admin"}}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{serverCert}, ClientCAs: pool, ClientAuth: tls.VerifyClientCertIfGiven})
+	require.NoError(t, err)
+	app := newAuthenticatorTestApp(NewMTLSAuthenticator())
+	go app.Listener(listener)
+	t.Cleanup(func() { app.Shutdown() })
+
+	get := func(certificates ...tls.Certificate) map[string]any {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, Certificates: certificates}}}
+		resp, err := client.Get("https://" + listener.Addr().String() + "/test")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(data, &body))
+		return body
+	}
+
+	body := get(clientCert)
+	assert.Equal(t, "deployer", body["sub"])
+	assert.Equal(t, []any{"admin"}, body["roles"])
+	assert.Equal(t, false, get()["authenticated"])
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// LocalAuthTokenHeader is the header carrying the local auth token
+	LocalAuthTokenHeader = "X-Launchpad-Token"
+	// LocalAuthTokenCookie is the cookie set from the token of a generated url so the page can call the API
+	LocalAuthTokenCookie = "launchpad_token"
+)
+
+// LocalTokenMiddleware requires the per-run secret of the stdio server on every route except the health check.
+// The token is read from the query parameter of the generated urls, the X-Launchpad-Token header or the cookie
+// set when a page is first opened with the token in its url.
+func LocalTokenMiddleware(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Path() == "/health" {
+			return c.Next()
+		}
+
+		if queryToken := c.Query(utils.LocalAuthTokenQueryParam); queryToken != "" && tokensEqual(queryToken, token) {
+			// Remember the token for the assets and API calls of the page
+			c.Cookie(&fiber.Cookie{
+				Name:     LocalAuthTokenCookie,
+				Value:    token,
+				Path:     "/",
+				HTTPOnly: true,
+				SameSite: fiber.CookieSameSiteStrictMode,
+			})
+			return c.Next()
+		}
+
+		if tokensEqual(c.Get(LocalAuthTokenHeader), token) || tokensEqual(c.Cookies(LocalAuthTokenCookie), token) {
+			return c.Next()
+		}
+
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+}
+
+// tokensEqual compares a provided token with the expected one in constant time
+func tokensEqual(provided, expected string) bool {
+	return provided != "" && subtle.ConstantTimeCompare([]byte(provided), []byte(expected)) == 1
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// EnvRateLimitPerIP is the number of requests a client IP may send per window, unset or 0 disables the limit
+	EnvRateLimitPerIP = "LAUNCHPAD_RATE_LIMIT_PER_IP"
+	// EnvRateLimitPerUser is the number of requests an authenticated user may send per window, unset or 0 disables the limit
+	EnvRateLimitPerUser = "LAUNCHPAD_RATE_LIMIT_PER_USER"
+	// EnvRateLimitWindow overrides DefaultRateLimitWindow
+	EnvRateLimitWindow = "LAUNCHPAD_RATE_LIMIT_WINDOW"
+	// DefaultRateLimitWindow is the sliding window the requests are counted over
+	DefaultRateLimitWindow = time.Minute
+)
+
+// RateLimitedPaths are the route prefixes the rate limits apply to
+var RateLimitedPaths = []string{"/tx", "/api/tx", "/api/v1", "/mcp"}
+
+// RateLimitConfig holds the request limits of RateLimiters, zero limits are disabled
+type RateLimitConfig struct {
+	PerIP   int
+	PerUser int
+	Window  time.Duration
+	// Storage holds the request counts, nil keeps them in memory. Replicas sharing a storage share their limits
+	Storage fiber.Storage
+}
+
+// Enabled reports whether any limit is set
+func (c RateLimitConfig) Enabled() bool {
+	return c.PerIP > 0 || c.PerUser > 0
+}
+
+// RateLimitFromEnv returns the limits of the LAUNCHPAD_RATE_LIMIT_* variables, invalid values disable their limit
+func RateLimitFromEnv() RateLimitConfig {
+	config := RateLimitConfig{
+		PerIP:   rateLimitFromEnv(EnvRateLimitPerIP),
+		PerUser: rateLimitFromEnv(EnvRateLimitPerUser),
+		Window:  DefaultRateLimitWindow,
+	}
+	if value := os.Getenv(EnvRateLimitWindow); value != "" {
+		window, err := time.ParseDuration(value)
+		if err != nil || window <= 0 {
+			log.Printf("Warning: ignoring invalid %s %q, using %s", EnvRateLimitWindow, value, DefaultRateLimitWindow)
+		} else {
+			config.Window = window
+		}
+	}
+	return config
+}
+
+func rateLimitFromEnv(name string) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		log.Printf("Warning: ignoring invalid %s %q, the limit is disabled", name, value)
+		return 0
+	}
+	return limit
+}
+
+// RateLimiters returns the middlewares of the enabled limits, they answer 429 with a Retry-After header to the
+// clients over their limits. The user limiter counts the requests of the authenticated user across IPs, so it must
+// run after the authentication middlewares
+func RateLimiters(config RateLimitConfig) []fiber.Handler {
+	limitReached := func(c *fiber.Ctx) error {
+		return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"error": "Too many requests",
+		})
+	}
+
+	var limiters []fiber.Handler
+	if config.PerIP > 0 {
+		limiters = append(limiters, limiter.New(limiter.Config{
+			Max:               config.PerIP,
+			Expiration:        config.Window,
+			LimiterMiddleware: limiter.SlidingWindow{},
+			Storage:           config.Storage,
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return "ip:" + c.IP()
+			},
+			LimitReached: limitReached,
+		}))
+	}
+	if config.PerUser > 0 {
+		limiters = append(limiters, limiter.New(limiter.Config{
+			Max:               config.PerUser,
+			Expiration:        config.Window,
+			LimiterMiddleware: limiter.SlidingWindow{},
+			Storage:           config.Storage,
+			// Anonymous requests are only limited by IP
+			Next: func(c *fiber.Ctx) bool {
+				return rateLimitedUser(c) == ""
+			},
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return "user:" + rateLimitedUser(c)
+			},
+			LimitReached: limitReached,
+		}))
+	}
+
+	return limiters
+}
+
+// rateLimitedUser returns the subject of the authenticated user, empty for anonymous requests
+func rateLimitedUser(c *fiber.Ctx) string {
+	if user, ok := c.Locals(AuthenticatedUserContextKey).(*utils.AuthenticatedUser); ok && user != nil {
+		return user.Sub
+	}
+	return ""
+}
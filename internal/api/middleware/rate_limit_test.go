@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRateLimitTestApp(config RateLimitConfig) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		if user := c.Get("X-Test-User"); user != "" {
+			c.Locals(AuthenticatedUserContextKey, &utils.AuthenticatedUser{Sub: user})
+		}
+		return c.Next()
+	})
+	for _, limiter := range RateLimiters(config) {
+		app.Use(RateLimitedPaths, limiter)
+	}
+	app.Get("/tx/:id", func(c *fiber.Ctx) error { return c.SendString("ok") })
+	app.Get("/health", func(c *fiber.Ctx) error { return c.SendString("ok") })
+	return app
+}
+
+func rateLimitStatus(t *testing.T, app *fiber.App, path, user string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if user != "" {
+		req.Header.Set("X-Test-User", user)
+	}
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp.StatusCode, resp.Header.Get(fiber.HeaderRetryAfter)
+}
+
+func TestRateLimiters(t *testing.T) {
+	t.Run("per_ip", func(t *testing.T) {
+		app := newRateLimitTestApp(RateLimitConfig{PerIP: 2, Window: time.Minute})
+		for i := 0; i < 2; i++ {
+			status, _ := rateLimitStatus(t, app, "/tx/1", "")
+			assert.Equal(t, fiber.StatusOK, status)
+		}
+		status, retryAfter := rateLimitStatus(t, app, "/tx/1", "")
+		assert.Equal(t, fiber.StatusTooManyRequests, status)
+		assert.NotEmpty(t, retryAfter)
+
+		// Routes outside RateLimitedPaths are not limited
+		status, _ = rateLimitStatus(t, app, "/health", "")
+		assert.Equal(t, fiber.StatusOK, status)
+	})
+
+	t.Run("per_user", func(t *testing.T) {
+		app := newRateLimitTestApp(RateLimitConfig{PerUser: 1, Window: time.Minute})
+		status, _ := rateLimitStatus(t, app, "/tx/1", "alice")
+		assert.Equal(t, fiber.StatusOK, status)
+		status, _ = rateLimitStatus(t, app, "/tx/1", "alice")
+		assert.Equal(t, fiber.StatusTooManyRequests, status)
+		status, _ = rateLimitStatus(t, app, "/tx/1", "bob")
+		assert.Equal(t, fiber.StatusOK, status)
+		// Anonymous requests are only limited by IP
+		for i := 0; i < 3; i++ {
+			status, _ = rateLimitStatus(t, app, "/tx/1", "")
+			assert.Equal(t, fiber.StatusOK, status)
+		}
+	})
+
+	t.Run("shared_storage", func(t *testing.T) {
+		dbService, err := services.NewSqliteDBService(":memory:")
+		require.NoError(t, err)
+		t.Cleanup(func() { dbService.Close() })
+
+		// two replicas sharing the storage count the requests of the client together
+		config := RateLimitConfig{PerIP: 2, Window: time.Minute, Storage: services.NewSharedStorage(dbService.GetDB())}
+		replicas := []*fiber.App{newRateLimitTestApp(config), newRateLimitTestApp(config)}
+		for _, app := range replicas {
+			status, _ := rateLimitStatus(t, app, "/tx/1", "")
+			assert.Equal(t, fiber.StatusOK, status)
+		}
+		status, _ := rateLimitStatus(t, replicas[0], "/tx/1", "")
+		assert.Equal(t, fiber.StatusTooManyRequests, status)
+	})
+}
+
+func TestRateLimitFromEnv(t *testing.T) {
+	t.Setenv(EnvRateLimitPerIP, "")
+	t.Setenv(EnvRateLimitPerUser, "")
+	t.Setenv(EnvRateLimitWindow, "")
+	assert.False(t, RateLimitFromEnv().Enabled())
+
+	t.Setenv(EnvRateLimitPerIP, "120")
+	t.Setenv(EnvRateLimitPerUser, "many")
+	t.Setenv(EnvRateLimitWindow, "30s")
+	assert.Equal(t, RateLimitConfig{PerIP: 120, Window: 30 * time.Second}, RateLimitFromEnv())
+}
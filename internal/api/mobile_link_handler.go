@@ -0,0 +1,91 @@
+package api
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// MobileLinkResponse opens the signing page of a session on a phone, scanning the QR code or following the deep
+// link of a mobile wallet
+type MobileLinkResponse struct {
+	URL string `json:"url"`
+	// QRCode is the SVG of the QR code of the url
+	QRCode  string                   `json:"qr_code"`
+	Wallets []utils.MobileWalletLink `json:"wallets"`
+	// Reachable is false when the url points to localhost, then a phone cannot open it and BASE_URL or
+	// --public-url must be set to an address of the machine on the network
+	Reachable bool `json:"reachable"`
+	// HandedOff reports that the one-time session moved to the mobile link, the browser of the request lost its access
+	HandedOff bool `json:"handed_off"`
+}
+
+// handleCreateMobileLink returns the link of the signing page for a mobile wallet, for the machines without a
+// browser wallet. With one-time session urls the binding of the browser is handed off to the link, so only one
+// browser signs the session at a time.
+func (s *APIServer) handleCreateMobileLink(c *fiber.Ctx) error {
+	sessionID := c.Params("session_id")
+	session, err := s.txService.GetTransactionSession(sessionID)
+	if err != nil {
+		log.Printf("Error getting session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Session not found",
+		})
+	}
+	if session.TransactionStatus != models.TransactionStatusPending {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Session is not pending",
+		})
+	}
+
+	handoff := ""
+	if utils.SessionURLSigningEnabled() && s.oneTimeSessionURLs {
+		handoff, err = s.sessionAccessService.Rebind(sessionID, c.Cookies(sessionAccessCookiePrefix+sessionID))
+		if err != nil {
+			log.Printf("Error handing off session %s: %v", sessionID, err)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Failed to hand off the session",
+			})
+		}
+		if handoff == "" {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": "Session was already handed off to another browser",
+			})
+		}
+	}
+
+	// The configured public url, else the url the browser opened the page with, e.g. the LAN address of the machine
+	ctx := s.tokenURLContext(c)
+	if s.requestBaseUrl(c) == "" {
+		ctx = utils.WithRequestBaseUrl(ctx, c.BaseURL())
+	}
+	pageUrl, err := utils.GetMobileSessionUrl(ctx, s.port, sessionID, handoff)
+	if err != nil {
+		log.Printf("Error building the mobile url of session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to build the mobile link",
+		})
+	}
+	wallets, err := utils.GetMobileWalletLinks(pageUrl)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	qrCode, err := utils.EncodeQRCode(pageUrl)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(MobileLinkResponse{
+		URL:       pageUrl,
+		QRCode:    qrCode.SVG(4),
+		Wallets:   wallets,
+		Reachable: utils.IsReachableFromMobile(pageUrl),
+		HandedOff: handoff != "",
+	})
+}
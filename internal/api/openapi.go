@@ -0,0 +1,198 @@
+package api
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+)
+
+// openAPIPathParam matches the Fiber path parameters, written {name} in OpenAPI
+var openAPIPathParam = regexp.MustCompile(`:([a-zA-Z_]+)`)
+
+// buildOpenAPISpec returns the OpenAPI 3 document of the REST API. The parameters and request bodies are the input
+// schemas of the tools, so the spec follows the tools as they change
+func buildOpenAPISpec(routes []restRoute, tools map[string]mcpgo.Tool, authenticated bool) map[string]any {
+	paths := map[string]any{}
+	for _, route := range routes {
+		tool := tools[route.Tool]
+		path := restV1Prefix + openAPIPathParam.ReplaceAllString(route.Path, "{$1}")
+		pathParams := map[string]bool{}
+		var pathParamNames []string
+		for _, match := range openAPIPathParam.FindAllStringSubmatch(route.Path, -1) {
+			pathParams[match[1]] = true
+			pathParamNames = append(pathParamNames, match[1])
+		}
+
+		required := map[string]bool{}
+		for _, name := range tool.InputSchema.Required {
+			required[name] = true
+		}
+
+		var parameters []any
+		for _, name := range pathParamNames {
+			parameters = append(parameters, map[string]any{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   openAPIPropertySchema(tool, name),
+			})
+		}
+
+		operation := map[string]any{
+			"operationId": route.Tool,
+			"summary":     route.Summary,
+			"description": tool.Description,
+			"tags":        []string{route.Tag},
+			"responses":   openAPIResponses(route.Method),
+		}
+
+		bodyProperties := map[string]any{}
+		var bodyRequired []string
+		// Sorted so the spec is stable between runs
+		names := make([]string, 0, len(tool.InputSchema.Properties))
+		for name := range tool.InputSchema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property := tool.InputSchema.Properties[name]
+			if pathParams[name] {
+				continue
+			}
+			if route.Method == "GET" || route.Method == "DELETE" {
+				parameters = append(parameters, map[string]any{
+					"name":        name,
+					"in":          "query",
+					"required":    required[name],
+					"schema":      property,
+					"description": openAPIDescription(property),
+				})
+				continue
+			}
+			bodyProperties[name] = property
+			if required[name] {
+				bodyRequired = append(bodyRequired, name)
+			}
+		}
+		if len(bodyProperties) > 0 {
+			schema := map[string]any{"type": "object", "properties": bodyProperties}
+			if len(bodyRequired) > 0 {
+				schema["required"] = bodyRequired
+			}
+			operation["requestBody"] = map[string]any{
+				"required": len(bodyRequired) > 0,
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": schema},
+				},
+			}
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+
+		item, _ := paths[path].(map[string]any)
+		if item == nil {
+			item = map[string]any{}
+			paths[path] = item
+		}
+		item[strings.ToLower(route.Method)] = operation
+	}
+
+	paths[restV1Prefix+"/sessions/{session_id}"] = map[string]any{
+		"get": map[string]any{
+			"operationId": "get_session",
+			"summary":     "Get a transaction session with its transactions and status",
+			"tags":        []string{"sessions"},
+			"parameters": []any{map[string]any{
+				"name":     "session_id",
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]any{"type": "string"},
+			}},
+			"responses": map[string]any{
+				"200": map[string]any{"description": "The session"},
+				"404": openAPIErrorResponse("The session does not exist"),
+			},
+		},
+	}
+
+	spec := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "Crypto Launchpad API",
+			"version":     "1.0.0",
+			"description": "REST API of the launchpad. Every endpoint calls the MCP tool of its operationId with the same arguments.",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": map[string]any{
+				"Response": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"message": map[string]any{"type": "string"},
+						"data":    map[string]any{"description": "JSON result of the tool"},
+					},
+				},
+				"Error": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"error": map[string]any{"type": "string"}},
+				},
+			},
+		},
+	}
+	if authenticated {
+		components := spec["components"].(map[string]any)
+		components["securitySchemes"] = map[string]any{
+			"bearerAuth": map[string]any{"type": "http", "scheme": "bearer"},
+			"apiKey":     map[string]any{"type": "apiKey", "in": "header", "name": "X-API-Key"},
+		}
+		spec["security"] = []any{
+			map[string]any{"bearerAuth": []string{}},
+			map[string]any{"apiKey": []string{}},
+		}
+	}
+	return spec
+}
+
+func openAPIPropertySchema(tool mcpgo.Tool, name string) any {
+	if property, ok := tool.InputSchema.Properties[name]; ok {
+		return property
+	}
+	return map[string]any{"type": "string"}
+}
+
+func openAPIDescription(property any) string {
+	if schema, ok := property.(map[string]any); ok {
+		description, _ := schema["description"].(string)
+		return description
+	}
+	return ""
+}
+
+func openAPIResponses(method string) map[string]any {
+	status := "200"
+	if method == "POST" {
+		status = "201"
+	}
+	return map[string]any{
+		status: map[string]any{
+			"description": "The result of the tool",
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Response"}},
+			},
+		},
+		"400": openAPIErrorResponse("The arguments are invalid or the tool failed"),
+		"401": openAPIErrorResponse("Authentication is required"),
+	}
+}
+
+func openAPIErrorResponse(description string) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content": map[string]any{
+			"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Error"}},
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"html/template"
+	"log"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/assets"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// poolPageSnapshotLimit is the number of snapshots used to draw the price chart
+	poolPageSnapshotLimit = 500
+	// poolPageSwapLimit is the number of recent swaps shown on the pool page
+	poolPageSwapLimit = 20
+	// poolPageTargetCandles is the approximate number of candles drawn on the price chart
+	poolPageTargetCandles = 60
+)
+
+type PoolReserves struct {
+	Reserve0    string
+	Reserve1    string
+	Token0Share float64
+	Token1Share float64
+	// Source describes where the reserves were read from
+	Source string
+}
+
+type PoolSwap struct {
+	Time            time.Time
+	FromToken       string
+	ToToken         string
+	Amount          string
+	TransactionHash string
+}
+
+type PoolPageData struct {
+	Pool      *models.LiquidityPool
+	Reserves  PoolReserves
+	Candles   []utils.Candle
+	Swaps     []PoolSwap
+	LPHolders []services.LPHolder
+}
+
+// handlePoolPage serves the pool detail page with reserves, price chart, recent swaps and LP distribution
+func (s *APIServer) handlePoolPage(c *fiber.Ctx) error {
+	poolID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return s.renderErrorPage(c, fiber.StatusBadRequest, "Invalid Pool ID",
+			"The pool ID in the URL is not valid.")
+	}
+
+	pool, err := s.liquidityService.GetLiquidityPool(uint(poolID))
+	if err != nil {
+		log.Printf("Error getting pool %d: %v", poolID, err)
+		return s.renderErrorPage(c, fiber.StatusNotFound, "Pool Not Found",
+			"The requested liquidity pool could not be found.")
+	}
+
+	snapshots, err := s.liquidityService.ListPoolSnapshots(pool.ID, poolPageSnapshotLimit)
+	if err != nil {
+		log.Printf("Error listing snapshots for pool %d: %v", pool.ID, err)
+		return s.renderErrorPage(c, fiber.StatusInternalServerError, "Error Loading Pool",
+			"Failed to load the pool price history.")
+	}
+
+	// The chain is only known through the session that created the pool
+	var chain *models.Chain
+	if session, err := s.txService.GetTransactionSession(pool.SessionId); err == nil {
+		chain = &session.Chain
+	}
+
+	data := PoolPageData{
+		Pool:      pool,
+		Reserves:  s.getPoolReserves(pool, chain, snapshots),
+		Candles:   buildPoolCandles(snapshots),
+		Swaps:     s.getRecentPoolSwaps(pool),
+		LPHolders: []services.LPHolder{},
+	}
+
+	if chain != nil && pool.PairAddress != "" {
+		holders, err := s.uniswapContractService.GetLPDistribution(pool.PairAddress, chain)
+		if err != nil {
+			log.Printf("Error getting LP distribution for pool %d: %v", pool.ID, err)
+		} else {
+			data.LPHolders = holders
+		}
+	}
+
+	tmpl, err := template.New("pool").Parse(string(assets.PoolHTML))
+	if err != nil {
+		log.Printf("Error parsing pool template: %v", err)
+		return c.Status(fiber.StatusInternalServerError).SendString("Error parsing template")
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		log.Printf("Error rendering pool template: %v", err)
+		return c.Status(fiber.StatusInternalServerError).SendString("Error rendering template")
+	}
+
+	c.Set("Content-Type", "text/html; charset=utf-8")
+	return c.Send(buf.Bytes())
+}
+
+// getPoolReserves returns the live reserves of the pool, falling back to the latest snapshot
+// and then to the initial liquidity when the chain cannot be reached
+func (s *APIServer) getPoolReserves(pool *models.LiquidityPool, chain *models.Chain, snapshots []models.PoolSnapshot) PoolReserves {
+	if chain != nil && pool.PairAddress != "" {
+		reserve0, reserve1, err := s.uniswapContractService.GetReserves(pool.PairAddress, chain)
+		if err == nil {
+			return newPoolReserves(reserve0.String(), reserve1.String(), "on-chain")
+		}
+		log.Printf("Error getting reserves for pool %d: %v", pool.ID, err)
+	}
+
+	if len(snapshots) > 0 {
+		latest := snapshots[len(snapshots)-1]
+		return newPoolReserves(latest.Reserve0, latest.Reserve1, "latest snapshot")
+	}
+
+	return newPoolReserves(pool.InitialToken0, pool.InitialToken1, "initial liquidity")
+}
+
+// getRecentPoolSwaps returns the latest swaps recorded for the pool
+func (s *APIServer) getRecentPoolSwaps(pool *models.LiquidityPool) []PoolSwap {
+	swaps := []PoolSwap{}
+	snapshots, err := s.liquidityService.ListPoolSnapshotsByTransactionType(pool.ID, models.TransactionTypeTokenSwap, poolPageSwapLimit)
+	if err != nil {
+		log.Printf("Error listing swaps for pool %d: %v", pool.ID, err)
+		return swaps
+	}
+
+	for _, snapshot := range snapshots {
+		swap := PoolSwap{
+			Time:            snapshot.CreatedAt,
+			TransactionHash: snapshot.TransactionHash,
+		}
+		if session, err := s.txService.GetTransactionSession(snapshot.SessionId); err == nil {
+			for _, meta := range session.Metadata {
+				switch meta.Key {
+				case "from_token":
+					swap.FromToken = meta.Value
+				case "to_token":
+					swap.ToToken = meta.Value
+				case "amount":
+					swap.Amount = meta.Value
+				}
+			}
+		}
+		swaps = append(swaps, swap)
+	}
+	return swaps
+}
+
+// newPoolReserves computes the share of each side of the pool from raw reserve amounts
+func newPoolReserves(reserve0, reserve1, source string) PoolReserves {
+	reserves := PoolReserves{
+		Reserve0: reserve0,
+		Reserve1: reserve1,
+		Source:   source,
+	}
+
+	amount0, ok0 := new(big.Float).SetString(reserve0)
+	amount1, ok1 := new(big.Float).SetString(reserve1)
+	if !ok0 || !ok1 {
+		return reserves
+	}
+
+	total := new(big.Float).Add(amount0, amount1)
+	if total.Sign() == 0 {
+		return reserves
+	}
+
+	reserves.Token0Share, _ = new(big.Float).Quo(new(big.Float).Mul(amount0, big.NewFloat(100)), total).Float64()
+	reserves.Token1Share = 100 - reserves.Token0Share
+	return reserves
+}
+
+// buildPoolCandles converts pool snapshots into OHLC candles
+func buildPoolCandles(snapshots []models.PoolSnapshot) []utils.Candle {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	points := make([]utils.PricePoint, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		points = append(points, utils.PricePoint{Time: snapshot.CreatedAt, Price: snapshot.Price})
+	}
+
+	interval := utils.CandleIntervalFor(snapshots[0].CreatedAt, snapshots[len(snapshots)-1].CreatedAt, poolPageTargetCandles)
+	return utils.BuildCandles(points, interval)
+}
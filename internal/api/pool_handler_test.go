@@ -0,0 +1,135 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type PoolHandlerTestSuite struct {
+	suite.Suite
+	db               services.DBService
+	apiServer        *APIServer
+	serverPort       int
+	liquidityService services.LiquidityService
+	txService        services.TransactionService
+}
+
+func (suite *PoolHandlerTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", "test-secret")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.liquidityService = services.NewLiquidityService(db.GetDB())
+	uniswapService := services.NewUniswapService(db.GetDB())
+
+	apiServer := NewAPIServer(db, suite.txService, services.NewHookService(), services.NewChainService(db.GetDB()), services.NewDeploymentService(db.GetDB()), suite.liquidityService, services.NewUniswapContractService(uniswapService))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *PoolHandlerTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *PoolHandlerTestSuite) getPage(path string) (int, string) {
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", suite.serverPort, path))
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	suite.Require().NoError(err)
+	return resp.StatusCode, string(body)
+}
+
+func (suite *PoolHandlerTestSuite) TestPoolPageWithSnapshots() {
+	pool := &models.LiquidityPool{
+		TokenAddress:   "0x1111111111111111111111111111111111111111",
+		UniswapVersion: "v2",
+		Token0:         "0x1111111111111111111111111111111111111111",
+		Token1:         "0x2222222222222222222222222222222222222222",
+		InitialToken0:  "1000",
+		InitialToken1:  "10",
+		Status:         models.TransactionStatusConfirmed,
+	}
+	_, err := suite.liquidityService.CreateLiquidityPool(pool)
+	suite.Require().NoError(err)
+
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		ChainType: models.TransactionChainTypeEthereum,
+		Metadata: []models.TransactionMetadata{
+			{Key: "from_token", Value: pool.Token1},
+			{Key: "to_token", Value: pool.Token0},
+			{Key: "amount", Value: "5"},
+		},
+	})
+	suite.Require().NoError(err)
+
+	start := time.Now().Add(-2 * time.Hour)
+	snapshots := []models.PoolSnapshot{
+		{PoolID: pool.ID, Reserve0: "1000", Reserve1: "10", Price: 0.01, TransactionType: models.TransactionTypeLiquidityPoolCreation, CreatedAt: start},
+		{PoolID: pool.ID, Reserve0: "500", Reserve1: "20", Price: 0.04, TransactionType: models.TransactionTypeTokenSwap, TransactionHash: "0xswaphash", SessionId: sessionID, CreatedAt: start.Add(time.Hour)},
+	}
+	for i := range snapshots {
+		suite.Require().NoError(suite.liquidityService.CreatePoolSnapshot(&snapshots[i]))
+	}
+
+	status, body := suite.getPage(fmt.Sprintf("/pool/%d", pool.ID))
+	suite.Equal(http.StatusOK, status)
+	suite.Contains(body, fmt.Sprintf("Pool #%d", pool.ID))
+	suite.Contains(body, "price-chart")
+	suite.Contains(body, "latest snapshot")
+	suite.Contains(body, "0xswaphash")
+	suite.Contains(body, "LP distribution is not available")
+}
+
+func (suite *PoolHandlerTestSuite) TestPoolPageWithoutSnapshots() {
+	pool := &models.LiquidityPool{
+		TokenAddress:   "0x3333333333333333333333333333333333333333",
+		UniswapVersion: "v2",
+		Token0:         "0x3333333333333333333333333333333333333333",
+		Token1:         "0x0000000000000000000000000000000000000000",
+		InitialToken0:  "300",
+		InitialToken1:  "100",
+	}
+	_, err := suite.liquidityService.CreateLiquidityPool(pool)
+	suite.Require().NoError(err)
+
+	status, body := suite.getPage(fmt.Sprintf("/pool/%d", pool.ID))
+	suite.Equal(http.StatusOK, status)
+	suite.Contains(body, "initial liquidity")
+	suite.Contains(body, "75.00%")
+	suite.Contains(body, "No price snapshots recorded yet")
+	suite.Contains(body, "No swaps recorded yet")
+}
+
+func (suite *PoolHandlerTestSuite) TestPoolPageNotFound() {
+	status, _ := suite.getPage("/pool/99999")
+	suite.Equal(http.StatusNotFound, status)
+
+	status, _ = suite.getPage("/pool/abc")
+	suite.Equal(http.StatusBadRequest, status)
+}
+
+func TestPoolHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PoolHandlerTestSuite))
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+type PrivateTransactionRequest struct {
+	// SignedTransaction is the raw transaction signed by the wallet without broadcasting it
+	SignedTransaction string `json:"signedTransaction"`
+}
+
+// handleSubmitPrivateTransaction forwards a signed transaction of the session to the private relay of its chain.
+// The signed transaction must be the transaction of the pending session at index, on the chain of the session.
+// The private transaction monitor tracks it until it is included, the signing page then completes it
+// through handleTransactionAPI like any other transaction.
+func (s *APIServer) handleSubmitPrivateTransaction(c *fiber.Ctx) error {
+	sessionID := c.Params("session_id")
+	body := PrivateTransactionRequest{}
+	if err := c.BodyParser(&body); err != nil {
+		log.Printf("Error parsing body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if !strings.HasPrefix(body.SignedTransaction, "0x") {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "signedTransaction must be a hex encoded signed transaction",
+		})
+	}
+
+	parsedIndex, err := strconv.Atoi(c.Params("index"))
+	if err != nil {
+		log.Printf("Error parsing index: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid index",
+		})
+	}
+
+	session, err := s.txService.GetTransactionSession(sessionID)
+	if err != nil {
+		log.Printf("Error getting session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Session not found",
+		})
+	}
+
+	if parsedIndex < 0 || parsedIndex >= len(session.TransactionDeployments) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid index",
+		})
+	}
+
+	if session.TransactionStatus != models.TransactionStatusPending {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": fmt.Sprintf("Session is %s, only pending sessions can be submitted", session.TransactionStatus),
+		})
+	}
+
+	if !session.TransactionDeployments[parsedIndex].PrivateRelay {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction is not marked for private relay submission",
+		})
+	}
+
+	if err := checkPrivateTransaction(body.SignedTransaction, session.Chain.NetworkID, session.TransactionDeployments[parsedIndex]); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	relayRPC := session.Chain.PrivateRelayRPC
+	if relayRPC == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "No private relay configured for this chain",
+		})
+	}
+
+	// Only resubmit once the previous submission was dropped
+	previous, err := s.privateTxService.GetLatestPrivateTransaction(sessionID, parsedIndex)
+	if err == nil && (previous.Status == models.PrivateTransactionStatusPending || previous.Status == models.PrivateTransactionStatusIncluded) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Transaction was already submitted to the private relay",
+		})
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("Error getting private transaction of session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to get private transaction",
+		})
+	}
+
+	txHash, err := utils.SendPrivateTransaction(relayRPC, body.SignedTransaction)
+	if err != nil {
+		log.Printf("Error submitting private transaction of session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	statusURL, _ := utils.PrivateRelayStatusURL(relayRPC, txHash)
+	privateTx := &models.PrivateTransaction{
+		SessionId:        sessionID,
+		TransactionIndex: parsedIndex,
+		ChainID:          session.ChainID,
+		TransactionHash:  txHash,
+		RelayRPC:         relayRPC,
+		StatusURL:        statusURL,
+	}
+	if err := s.privateTxService.CreatePrivateTransaction(privateTx); err != nil {
+		log.Printf("Error saving private transaction %s: %v", txHash, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to save private transaction",
+		})
+	}
+
+	return c.JSON(privateTx)
+}
+
+// checkPrivateTransaction decodes the signed transaction and checks it is the transaction of the session on its chain,
+// the relay would otherwise forward any transaction signed by the wallet
+func checkPrivateTransaction(signedTransaction, networkID string, deployment models.TransactionDeployment) error {
+	tx, _, err := utils.DecodeSignedTransaction(signedTransaction)
+	if err != nil {
+		return err
+	}
+	if tx.ChainId().String() != networkID {
+		return fmt.Errorf("Transaction is signed for chain %s, the session is on chain %s", tx.ChainId(), networkID)
+	}
+
+	if deployment.Receiver == "" {
+		if tx.To() != nil {
+			return fmt.Errorf("Transaction is sent to %s, the session transaction creates a contract", tx.To().Hex())
+		}
+	} else if tx.To() == nil || *tx.To() != common.HexToAddress(deployment.Receiver) {
+		return fmt.Errorf("Transaction is not sent to the receiver %s of the session transaction", deployment.Receiver)
+	}
+
+	value := new(big.Int)
+	if deployment.Value != "" {
+		if _, ok := value.SetString(deployment.Value, 10); !ok {
+			return fmt.Errorf("Session transaction has an invalid value %s", deployment.Value)
+		}
+	}
+	if tx.Value().Cmp(value) != 0 {
+		return fmt.Errorf("Transaction value %s does not match the value %s of the session transaction", tx.Value(), value)
+	}
+
+	var data []byte
+	if deployment.Data != "" && deployment.Data != "0x" {
+		if data, err = hexutil.Decode(deployment.Data); err != nil {
+			return fmt.Errorf("Session transaction has invalid data: %v", err)
+		}
+	}
+	if !bytes.Equal(tx.Data(), data) {
+		return fmt.Errorf("Transaction data does not match the data of the session transaction")
+	}
+	return nil
+}
+
+// handleGetPrivateTransaction returns the relay status of the last submission of a transaction of the session
+func (s *APIServer) handleGetPrivateTransaction(c *fiber.Ctx) error {
+	parsedIndex, err := strconv.Atoi(c.Params("index"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid index",
+		})
+	}
+
+	privateTx, err := s.privateTxService.GetLatestPrivateTransaction(c.Params("session_id"), parsedIndex)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Private transaction not found",
+		})
+	}
+
+	return c.JSON(privateTx)
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	privateTxTestHash     = "0xcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcdcd"
+	privateTxTestReceiver = "0x1111111111111111111111111111111111111111"
+)
+
+type PrivateTxHandlerTestSuite struct {
+	suite.Suite
+	db           services.DBService
+	apiServer    *APIServer
+	serverPort   int
+	txService    services.TransactionService
+	chainService services.ChainService
+	relay        *httptest.Server
+	// relayed are the signed transactions received by the relay
+	relayed []string
+}
+
+func (suite *PrivateTxHandlerTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", "test-secret")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+
+	suite.relay = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Params []string `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		suite.relayed = append(suite.relayed, request.Params...)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": privateTxTestHash})
+	}))
+
+	err = suite.chainService.CreateChain(&models.Chain{
+		ChainType:       models.TransactionChainTypeEthereum,
+		RPC:             "http://localhost:8545",
+		NetworkID:       "1",
+		Name:            "Ethereum Mainnet",
+		PrivateRelayRPC: suite.relay.URL,
+	})
+	suite.Require().NoError(err)
+
+	apiServer := NewAPIServer(db, suite.txService, services.NewHookService(), suite.chainService, services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *PrivateTxHandlerTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.relay != nil {
+		suite.relay.Close()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *PrivateTxHandlerTestSuite) SetupTest() {
+	suite.relayed = nil
+}
+
+func (suite *PrivateTxHandlerTestSuite) createSession(privateRelay bool) string {
+	chain, err := suite.chainService.GetChainByType(string(models.TransactionChainTypeEthereum))
+	suite.Require().NoError(err)
+
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{
+			{
+				Title:           "Swap",
+				Description:     "Swap ETH for tokens",
+				Data:            "0x",
+				Value:           "0",
+				Receiver:        privateTxTestReceiver,
+				TransactionType: models.TransactionTypeTokenSwap,
+				PrivateRelay:    privateRelay,
+			},
+		},
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   chain.ID,
+	})
+	suite.Require().NoError(err)
+	return sessionID
+}
+
+// signTransaction signs a transaction to the receiver of the test sessions
+func (suite *PrivateTxHandlerTestSuite) signTransaction(chainID int64, receiver string, value int64) string {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	to := common.HexToAddress(receiver)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(chainID)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		To:        &to,
+		Value:     big.NewInt(value),
+		Gas:       21000,
+		GasFeeCap: big.NewInt(1),
+	})
+	suite.Require().NoError(err)
+	raw, err := tx.MarshalBinary()
+	suite.Require().NoError(err)
+	return hexutil.Encode(raw)
+}
+
+func (suite *PrivateTxHandlerTestSuite) request(method, sessionID string, body any) (int, map[string]interface{}) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		suite.Require().NoError(err)
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("http://localhost:%d/api/tx/%s/transaction/0/private", suite.serverPort, sessionID), reader)
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var result map[string]interface{}
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&result))
+	return resp.StatusCode, result
+}
+
+func (suite *PrivateTxHandlerTestSuite) TestSubmitPrivateTransaction() {
+	sessionID := suite.createSession(true)
+	signed := suite.signTransaction(1, privateTxTestReceiver, 0)
+
+	status, result := suite.request(http.MethodPost, sessionID, PrivateTransactionRequest{SignedTransaction: signed})
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(privateTxTestHash, result["transaction_hash"])
+	suite.Equal(string(models.PrivateTransactionStatusPending), result["status"])
+	suite.Equal([]string{signed}, suite.relayed)
+
+	status, result = suite.request(http.MethodGet, sessionID, nil)
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(privateTxTestHash, result["transaction_hash"])
+
+	// A pending transaction is not submitted twice
+	status, _ = suite.request(http.MethodPost, sessionID, PrivateTransactionRequest{SignedTransaction: signed})
+	suite.Equal(http.StatusConflict, status)
+	suite.Len(suite.relayed, 1)
+}
+
+func (suite *PrivateTxHandlerTestSuite) TestSubmitRejectsPublicTransaction() {
+	sessionID := suite.createSession(false)
+
+	status, result := suite.request(http.MethodPost, sessionID, PrivateTransactionRequest{SignedTransaction: suite.signTransaction(1, privateTxTestReceiver, 0)})
+	suite.Equal(http.StatusBadRequest, status)
+	suite.Contains(result["error"], "not marked for private relay")
+	suite.Empty(suite.relayed)
+}
+
+func (suite *PrivateTxHandlerTestSuite) TestSubmitRejectsInvalidTransaction() {
+	sessionID := suite.createSession(true)
+
+	status, _ := suite.request(http.MethodPost, sessionID, PrivateTransactionRequest{SignedTransaction: "not-hex"})
+	suite.Equal(http.StatusBadRequest, status)
+	suite.Empty(suite.relayed)
+}
+
+func (suite *PrivateTxHandlerTestSuite) TestSubmitRejectsOtherTransactions() {
+	sessionID := suite.createSession(true)
+
+	testCases := []struct {
+		name   string
+		signed string
+		error  string
+	}{
+		{"other chain", suite.signTransaction(5, privateTxTestReceiver, 0), "signed for chain 5"},
+		{"other receiver", suite.signTransaction(1, "0x2222222222222222222222222222222222222222", 0), "not sent to the receiver"},
+		{"other value", suite.signTransaction(1, privateTxTestReceiver, 1000), "does not match the value"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			status, result := suite.request(http.MethodPost, sessionID, PrivateTransactionRequest{SignedTransaction: tc.signed})
+			suite.Equal(http.StatusBadRequest, status)
+			suite.Contains(result["error"], tc.error)
+		})
+	}
+	suite.Empty(suite.relayed)
+}
+
+func (suite *PrivateTxHandlerTestSuite) TestSubmitRejectsSessionsNotPending() {
+	sessionID := suite.createSession(true)
+	_, err := suite.txService.CancelTransactionSession(sessionID)
+	suite.Require().NoError(err)
+
+	status, result := suite.request(http.MethodPost, sessionID, PrivateTransactionRequest{SignedTransaction: suite.signTransaction(1, privateTxTestReceiver, 0)})
+	suite.Equal(http.StatusConflict, status)
+	suite.Contains(result["error"], "only pending sessions")
+	suite.Empty(suite.relayed)
+}
+
+func (suite *PrivateTxHandlerTestSuite) TestGetUnknownPrivateTransaction() {
+	sessionID := suite.createSession(true)
+
+	status, _ := suite.request(http.MethodGet, sessionID, nil)
+	suite.Equal(http.StatusNotFound, status)
+}
+
+func TestPrivateTxHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PrivateTxHandlerTestSuite))
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"net"
+	"os"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// EnvTrustedProxies are the comma separated IPs or CIDR ranges of the reverse proxies in front of the server. Their
+// X-Forwarded-For, X-Forwarded-Proto, X-Forwarded-Host and X-Forwarded-Prefix headers are trusted, unset ignores them
+const EnvTrustedProxies = "LAUNCHPAD_TRUSTED_PROXIES"
+
+// trustedProxiesFromEnv returns the proxies of LAUNCHPAD_TRUSTED_PROXIES, nil when unset
+func trustedProxiesFromEnv() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv(EnvTrustedProxies), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
+// clientIPHeader carries the client IP resolved by forwardedClientIP, c.IP() reads it for the requests of the trusted
+// proxies. A value sent by the client is always overwritten
+const clientIPHeader = "X-Launchpad-Client-Ip"
+
+// proxyConfig makes Fiber read the client IP, protocol and host of the requests forwarded by the trusted proxies. The
+// client IP is the one resolved by forwardedClientIP, which must be the first middleware
+func proxyConfig(config fiber.Config, trustedProxies []string) fiber.Config {
+	if len(trustedProxies) == 0 {
+		return config
+	}
+	config.EnableTrustedProxyCheck = true
+	config.TrustedProxies = trustedProxies
+	config.EnableIPValidation = true
+	config.ProxyHeader = clientIPHeader
+	return config
+}
+
+// forwardedClientIP resolves the client IP of the requests forwarded by the trusted proxies. The leftmost hops of
+// X-Forwarded-For are sent by the client, so the client IP is the rightmost hop that is not a trusted proxy
+func forwardedClientIP(trustedProxies []string) fiber.Handler {
+	trusted := parseTrustedProxies(trustedProxies)
+	return func(c *fiber.Ctx) error {
+		clientIP := c.Context().RemoteIP().String()
+		if trusted(clientIP) {
+			var hops []string
+			for _, header := range c.Request().Header.PeekAll(fiber.HeaderXForwardedFor) {
+				hops = append(hops, strings.Split(string(header), ",")...)
+			}
+			for i := len(hops) - 1; i >= 0; i-- {
+				hop := strings.TrimSpace(hops[i])
+				if net.ParseIP(hop) == nil {
+					break
+				}
+				clientIP = hop
+				if !trusted(hop) {
+					break
+				}
+			}
+		}
+		c.Request().Header.Set(clientIPHeader, clientIP)
+		return c.Next()
+	}
+}
+
+// parseTrustedProxies returns whether an IP is one of the trusted proxies, given as IPs or CIDR ranges
+func parseTrustedProxies(trustedProxies []string) func(ip string) bool {
+	ips := map[string]bool{}
+	var ranges []*net.IPNet
+	for _, proxy := range trustedProxies {
+		if _, ipNet, err := net.ParseCIDR(proxy); err == nil {
+			ranges = append(ranges, ipNet)
+		} else if ip := net.ParseIP(proxy); ip != nil {
+			ips[ip.String()] = true
+		}
+	}
+	return func(value string) bool {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return false
+		}
+		if ips[ip.String()] {
+			return true
+		}
+		for _, ipNet := range ranges {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// requestBaseUrl returns the public url the request was sent to through a trusted proxy, empty for the requests
+// received directly so the generated links fall back to the local url
+func (s *APIServer) requestBaseUrl(c *fiber.Ctx) string {
+	if len(s.trustedProxies) == 0 || !c.IsProxyTrusted() {
+		return ""
+	}
+	if c.Get(fiber.HeaderXForwardedHost) == "" && c.Get(fiber.HeaderXForwardedProto) == "" {
+		return ""
+	}
+	prefix := strings.TrimSuffix(c.Get("X-Forwarded-Prefix"), "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	return c.Protocol() + "://" + c.Hostname() + prefix
+}
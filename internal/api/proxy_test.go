@@ -0,0 +1,90 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProxyTestApp(trustedProxies []string) *fiber.App {
+	s := &APIServer{trustedProxies: trustedProxies}
+	app := fiber.New(proxyConfig(fiber.Config{}, trustedProxies))
+	app.Use(forwardedClientIP(trustedProxies))
+	app.Get("/test", func(c *fiber.Ctx) error {
+		return c.SendString(c.IP() + " " + s.requestBaseUrl(c))
+	})
+	return app
+}
+
+func proxyTestRequest(t *testing.T, app *fiber.App) string {
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	req.Header.Set("X-Forwarded-Proto", "https")
+	req.Header.Set("X-Forwarded-Host", "launchpad.example.com")
+	req.Header.Set("X-Forwarded-Prefix", "/launchpad/")
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestRequestBaseUrlFromTrustedProxy(t *testing.T) {
+	app := newProxyTestApp([]string{"0.0.0.0/0"})
+	assert.Equal(t, "203.0.113.7 https://launchpad.example.com/launchpad", proxyTestRequest(t, app))
+}
+
+func TestRequestBaseUrlIgnoresUntrustedHeaders(t *testing.T) {
+	app := newProxyTestApp(nil)
+	assert.Equal(t, "0.0.0.0 ", proxyTestRequest(t, app))
+
+	app = newProxyTestApp([]string{"10.0.0.1"})
+	assert.Equal(t, "0.0.0.0 ", proxyTestRequest(t, app))
+}
+
+func TestForwardedClientIPIsRightmostUntrustedHop(t *testing.T) {
+	// the test requests come from 0.0.0.0, the proxy in front of the server
+	trustedProxies := []string{"0.0.0.0", "10.0.0.0/8"}
+	app := fiber.New(proxyConfig(fiber.Config{}, trustedProxies))
+	app.Use(forwardedClientIP(trustedProxies))
+	for _, limiter := range middleware.RateLimiters(middleware.RateLimitConfig{PerIP: 1, Window: time.Minute}) {
+		app.Use(limiter)
+	}
+	app.Get("/test", func(c *fiber.Ctx) error {
+		return c.SendString(c.IP())
+	})
+
+	send := func(forwardedFor string) *http.Response {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		req.Header.Set(clientIPHeader, "198.51.100.1")
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := send("198.51.100.1, 203.0.113.7, 10.0.0.2")
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", string(body))
+
+	// a spoofed leftmost hop keeps the limiter key of the client
+	resp = send("192.0.2.55, 203.0.113.7, 10.0.0.2")
+	assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestTrustedProxiesFromEnv(t *testing.T) {
+	t.Setenv(EnvTrustedProxies, " 10.0.0.1, 172.16.0.0/12,,")
+	assert.Equal(t, []string{"10.0.0.1", "172.16.0.0/12"}, trustedProxiesFromEnv())
+
+	t.Setenv(EnvTrustedProxies, "")
+	assert.Nil(t, trustedProxiesFromEnv())
+}
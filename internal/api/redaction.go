@@ -0,0 +1,62 @@
+package api
+
+import (
+	"log"
+	"strings"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// Session fields that can be hidden from the public signing page with SIGNING_PAGE_REDACTED_FIELDS.
+// They are only used for display, so the transactions can still be signed without them.
+const (
+	// RedactRawContractArguments hides the decoded calldata arguments of the transactions
+	RedactRawContractArguments = "raw_contract_arguments"
+	// RedactContractCode hides the rendered contract source of deployments
+	RedactContractCode = "contract_code"
+	// RedactBalances hides the tokens whose balances are shown
+	RedactBalances = "balances"
+)
+
+// parseRedactedFields parses the comma separated list of session fields hidden from the signing page
+func parseRedactedFields(value string) map[string]bool {
+	fields := map[string]bool{}
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		switch field {
+		case "":
+		case RedactRawContractArguments, RedactContractCode, RedactBalances:
+			fields[field] = true
+		default:
+			log.Printf("Warning: ignoring unknown signing page redacted field %q", field)
+		}
+	}
+	return fields
+}
+
+// redactSession returns a copy of the session without the redacted fields, the stored session is left untouched
+func redactSession(session *models.TransactionSession, fields map[string]bool) *models.TransactionSession {
+	if len(fields) == 0 {
+		return session
+	}
+
+	redacted := *session
+	redacted.TransactionDeployments = make([]models.TransactionDeployment, len(session.TransactionDeployments))
+	for i, deployment := range session.TransactionDeployments {
+		if fields[RedactRawContractArguments] {
+			deployment.RawContractArguments = nil
+		}
+		if fields[RedactContractCode] {
+			deployment.ContractCode = nil
+		}
+		if fields[RedactBalances] {
+			deployment.ShowBalanceBeforeDeployment = false
+			deployment.ShowBalanceAfterDeployment = false
+		}
+		redacted.TransactionDeployments[i] = deployment
+	}
+	if fields[RedactBalances] {
+		redacted.Balances = map[string]*string{}
+	}
+	return &redacted
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+const redactionTestSecret = "test-secret"
+
+type RedactionTestSuite struct {
+	suite.Suite
+	db         services.DBService
+	apiServer  *APIServer
+	serverPort int
+	txService  services.TransactionService
+	sessionID  string
+}
+
+func (suite *RedactionTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", redactionTestSecret)
+	suite.T().Setenv("SIGNING_PAGE_REDACTED_FIELDS", "raw_contract_arguments, contract_code,balances,unknown")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	suite.Require().NoError(chainService.CreateChain(chain))
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	apiServer := NewAPIServer(db, suite.txService, services.NewHookService(), chainService, services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
+	apiServer.EnableAuthentication()
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	contractCode := "contract SecretToken {}"
+	rawArguments := `{"owner":"0x1111111111111111111111111111111111111111"}`
+	userID := "user-1"
+	balance := "1000"
+	suite.sessionID, err = suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{
+			Title:                       "Deploy SecretToken",
+			Data:                        "0x6080",
+			Value:                       "0",
+			ContractCode:                &contractCode,
+			RawContractArguments:        &rawArguments,
+			ShowBalanceBeforeDeployment: true,
+			TransactionType:             models.TransactionTypeTokenDeployment,
+		}},
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   chain.ID,
+		UserID:    &userID,
+		Balances:  map[string]*string{"0x2222222222222222222222222222222222222222": &balance},
+	})
+	suite.Require().NoError(err)
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *RedactionTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *RedactionTestSuite) get(path, sub string) (int, string) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://localhost:%d%s", suite.serverPort, path), nil)
+	suite.Require().NoError(err)
+	if sub != "" {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": sub}).SignedString([]byte(redactionTestSecret))
+		suite.Require().NoError(err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	suite.Require().NoError(err)
+	return resp.StatusCode, string(body)
+}
+
+func (suite *RedactionTestSuite) TestSigningPageRedactsFields() {
+	status, body := suite.get("/tx/"+suite.sessionID, "")
+	suite.Equal(http.StatusOK, status)
+
+	page := html.UnescapeString(body)
+	suite.Contains(page, "Deploy SecretToken")
+	// The calldata is kept so the transaction can still be signed
+	suite.Contains(page, "0x6080")
+	suite.NotContains(page, "contract SecretToken")
+	suite.NotContains(page, "0x1111111111111111111111111111111111111111")
+	suite.NotContains(page, "0x2222222222222222222222222222222222222222")
+
+	// The stored session is untouched
+	session, err := suite.txService.GetTransactionSession(suite.sessionID)
+	suite.Require().NoError(err)
+	suite.NotNil(session.TransactionDeployments[0].ContractCode)
+	suite.Len(session.Balances, 1)
+}
+
+func (suite *RedactionTestSuite) TestSessionAPIReturnsFullSessionToOwner() {
+	status, body := suite.get("/api/session/"+suite.sessionID, "user-1")
+	suite.Require().Equal(http.StatusOK, status, body)
+
+	var session models.TransactionSession
+	suite.Require().NoError(json.Unmarshal([]byte(body), &session))
+	suite.Require().Len(session.TransactionDeployments, 1)
+	suite.Require().NotNil(session.TransactionDeployments[0].ContractCode)
+	suite.Equal("contract SecretToken {}", *session.TransactionDeployments[0].ContractCode)
+	suite.NotNil(session.TransactionDeployments[0].RawContractArguments)
+	suite.Len(session.Balances, 1)
+}
+
+func (suite *RedactionTestSuite) TestSessionAPIRequiresOwner() {
+	status, _ := suite.get("/api/session/"+suite.sessionID, "")
+	suite.Equal(http.StatusUnauthorized, status)
+
+	status, _ = suite.get("/api/session/"+suite.sessionID, "user-2")
+	suite.Equal(http.StatusNotFound, status)
+}
+
+func TestRedactionTestSuite(t *testing.T) {
+	suite.Run(t, new(RedactionTestSuite))
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningPageReadsFromReplica(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	primary, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	replica, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	dbService := services.NewReplicaDBService(primary, replica.GetDB())
+	defer dbService.Close()
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, services.NewChainService(primary.GetDB()).CreateChain(chain))
+	replicaChain := *chain
+	require.NoError(t, services.NewChainService(replica.GetDB()).CreateChain(&replicaChain))
+
+	createSession := func(db services.DBService, title string) string {
+		sessionID, err := services.NewTransactionService(db.GetDB()).CreateTransactionSession(services.CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{{Title: title, Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeTokenDeployment}},
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                chain.ID,
+		})
+		require.NoError(t, err)
+		return sessionID
+	}
+	// Only on the replica, the page must be read from it
+	replicatedSession := createSession(replica, "Replicated session")
+	// Only on the primary, the replica has not caught up yet
+	laggingSession := createSession(primary, "Lagging session")
+
+	txService := services.NewTransactionService(dbService.GetDB())
+	apiServer := NewAPIServer(dbService, txService, services.NewHookService(), services.NewChainService(dbService.GetDB()), services.NewDeploymentService(dbService.GetDB()), services.NewLiquidityService(dbService.GetDB()), services.NewUniswapContractService(services.NewUniswapService(dbService.GetDB())))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	require.NoError(t, err)
+	defer apiServer.Shutdown()
+	time.Sleep(100 * time.Millisecond)
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", port, path))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	status, body := get("/tx/" + replicatedSession)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "Replicated session")
+
+	status, body = get("/tx/" + laggingSession)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "Lagging session")
+
+	status, body = get("/api/session/" + laggingSession)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "Lagging session")
+
+	status, _ = get("/tx/missing")
+	assert.Equal(t, http.StatusNotFound, status)
+}
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/mark3labs/mcp-go/client"
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// restV1Prefix is the prefix of the versioned REST API
+const restV1Prefix = "/api/v1"
+
+// restRoute maps a REST endpoint to the MCP tool it calls. The path parameters, the query of GET and DELETE requests
+// and the JSON body of the others are the arguments of the tool
+type restRoute struct {
+	Method  string
+	Path    string
+	Tool    string
+	Tag     string
+	Summary string
+}
+
+// restV1Routes are the endpoints of /api/v1, the path parameters are named after the tool arguments
+var restV1Routes = []restRoute{
+	{fiber.MethodGet, "/chains", "list_chains", "chains", "List the configured chains"},
+	{fiber.MethodGet, "/templates", "list_template", "templates", "List the contract templates"},
+	{fiber.MethodPost, "/templates", "create_template", "templates", "Create a contract template"},
+	{fiber.MethodGet, "/templates/:template_id", "view_template", "templates", "View a contract template"},
+	{fiber.MethodPut, "/templates/:template_id", "update_template", "templates", "Update a contract template"},
+	{fiber.MethodDelete, "/templates/:template_id", "delete_template", "templates", "Delete a contract template"},
+	{fiber.MethodGet, "/deployments", "list_deployments", "deployments", "List the token deployments"},
+	{fiber.MethodPost, "/deployments", "launch", "deployments", "Create the signing session deploying a template"},
+	{fiber.MethodGet, "/pools/:token_address", "get_pool_info", "pools", "Get the liquidity pool of a token"},
+	{fiber.MethodPost, "/pools", "create_liquidity_pool", "pools", "Create the signing session of a new liquidity pool"},
+	{fiber.MethodGet, "/swaps/quote", "get_swap_quote", "swaps", "Quote a token swap"},
+	{fiber.MethodPost, "/swaps", "swap_tokens", "swaps", "Create the signing session of a token swap"},
+}
+
+// restToolCaller calls the MCP tools behind the REST endpoints
+type restToolCaller interface {
+	ListTools(ctx context.Context, request mcpgo.ListToolsRequest) (*mcpgo.ListToolsResult, error)
+	CallTool(ctx context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error)
+}
+
+// RESTResponse is the body of the successful /api/v1 responses: the text of the tool result and its JSON content
+type RESTResponse struct {
+	Message string `json:"message,omitempty"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// EnableRESTAPI serves the /api/v1 REST API, calling the tools of the MCP server through an in-process client so the
+// audit log, API key scopes and idempotency keys apply as for MCP clients. It must be called after SetMCPServer
+func (s *APIServer) EnableRESTAPI() error {
+	if s.mcpServer == nil {
+		return fmt.Errorf("MCP server not set, cannot enable the REST API")
+	}
+	mcpClient, err := s.mcpServer.NewInProcessClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the MCP client of the REST API: %w", err)
+	}
+	ctx := context.Background()
+	if err := mcpClient.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start the MCP client of the REST API: %w", err)
+	}
+	initRequest := mcpgo.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcpgo.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcpgo.Implementation{Name: "launchpad-rest-api", Version: "1.0.0"}
+	if _, err := mcpClient.Initialize(ctx, initRequest); err != nil {
+		return fmt.Errorf("failed to initialize the MCP client of the REST API: %w", err)
+	}
+	return s.registerRESTRoutes(mcpClient)
+}
+
+var _ restToolCaller = (*client.Client)(nil)
+
+func (s *APIServer) registerRESTRoutes(caller restToolCaller) error {
+	toolList, err := caller.ListTools(context.Background(), mcpgo.ListToolsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list the tools of the REST API: %w", err)
+	}
+	tools := make(map[string]mcpgo.Tool, len(toolList.Tools))
+	for _, tool := range toolList.Tools {
+		tools[tool.Name] = tool
+	}
+
+	var routes []restRoute
+	for _, route := range restV1Routes {
+		tool, ok := tools[route.Tool]
+		if !ok {
+			log.Printf("Warning: tool %s of %s %s%s is not registered, skipping the route", route.Tool, route.Method, restV1Prefix, route.Path)
+			continue
+		}
+		routes = append(routes, route)
+		s.app.Add(route.Method, restV1Prefix+route.Path, s.requireRESTUser, requireAPIKeyScope(route.Tool), s.handleRESTToolCall(caller, route, tool))
+	}
+
+	// The sessions are read from the database, not a tool
+	s.app.Get(restV1Prefix+"/sessions/:session_id", s.requireRESTUser, requireAPIKeyScope(services.APIKeyScopeSessions), s.handleGetTransactionSession)
+
+	spec := buildOpenAPISpec(routes, tools, s.authenticationEnabled)
+	s.app.Get(restV1Prefix+"/openapi.json", func(c *fiber.Ctx) error {
+		return c.JSON(spec)
+	})
+	return nil
+}
+
+// requireRESTUser rejects the anonymous requests when authentication is enabled, like the MCP endpoint
+func (s *APIServer) requireRESTUser(c *fiber.Ctx) error {
+	if s.authenticationEnabled && c.Locals(middleware.AuthenticatedUserContextKey) == nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+	return c.Next()
+}
+
+// handleRESTToolCall calls the tool of the route with the arguments of the request
+func (s *APIServer) handleRESTToolCall(caller restToolCaller, route restRoute, tool mcpgo.Tool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		arguments := map[string]any{}
+		if route.Method == fiber.MethodGet || route.Method == fiber.MethodDelete {
+			for name, value := range c.Queries() {
+				arguments[name] = restQueryValue(tool, name, value)
+			}
+		} else if len(c.Body()) > 0 {
+			if err := json.Unmarshal(c.Body(), &arguments); err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error": "The body must be a JSON object",
+				})
+			}
+		}
+		for _, name := range c.Route().Params {
+			arguments[name] = c.Params(name)
+		}
+
+		ctx := context.Background()
+		if user, ok := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser); ok && user != nil {
+			ctx = utils.WithAuthenticatedUser(ctx, user)
+		}
+		if baseUrl := s.requestBaseUrl(c); baseUrl != "" {
+			ctx = utils.WithRequestBaseUrl(ctx, baseUrl)
+		}
+
+		request := mcpgo.CallToolRequest{}
+		request.Params.Name = route.Tool
+		request.Params.Arguments = arguments
+		result, err := caller.CallTool(ctx, request)
+		if err != nil {
+			log.Printf("Error calling tool %s from %s %s: %v", route.Tool, route.Method, c.Path(), err)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+
+		response := restResponse(result)
+		if result.IsError {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": response.Message,
+			})
+		}
+		if route.Method == fiber.MethodPost {
+			c.Status(fiber.StatusCreated)
+		}
+		return c.JSON(response)
+	}
+}
+
+// restQueryValue converts a query value to the type of the tool argument, the query only carries strings
+func restQueryValue(tool mcpgo.Tool, name, value string) any {
+	property, _ := tool.InputSchema.Properties[name].(map[string]any)
+	switch property["type"] {
+	case "boolean":
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	case "number", "integer":
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+// restResponse splits the text content of a tool result into its message and its JSON data
+func restResponse(result *mcpgo.CallToolResult) RESTResponse {
+	var response RESTResponse
+	var messages []string
+	for _, content := range result.Content {
+		text, ok := content.(mcpgo.TextContent)
+		if !ok {
+			continue
+		}
+		var data any
+		trimmed := strings.TrimSpace(text.Text)
+		if response.Data == nil && (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Unmarshal([]byte(trimmed), &data) == nil {
+			response.Data = data
+			continue
+		}
+		if message := strings.TrimSuffix(strings.TrimSpace(text.Text), ":"); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	response.Message = strings.Join(messages, " ")
+	return response
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeToolCaller records the tool calls and answers them like the launchpad tools
+type fakeToolCaller struct {
+	calls []mcpgo.CallToolRequest
+	users []string
+}
+
+func (f *fakeToolCaller) ListTools(ctx context.Context, request mcpgo.ListToolsRequest) (*mcpgo.ListToolsResult, error) {
+	return &mcpgo.ListToolsResult{Tools: []mcpgo.Tool{
+		mcpgo.NewTool("view_template",
+			mcpgo.WithDescription("View the template by id"),
+			mcpgo.WithString("template_id", mcpgo.Required()),
+			mcpgo.WithBoolean("show_abi_methods"),
+		),
+		mcpgo.NewTool("launch",
+			mcpgo.WithString("template_id", mcpgo.Required()),
+			mcpgo.WithObject("template_values", mcpgo.Required()),
+		),
+	}}, nil
+}
+
+func (f *fakeToolCaller) CallTool(ctx context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	f.calls = append(f.calls, request)
+	f.users = append(f.users, utils.GetUserID(ctx))
+	if request.GetString("template_id", "") == "missing" {
+		return mcpgo.NewToolResultError("Template not found"), nil
+	}
+	return &mcpgo.CallToolResult{Content: []mcpgo.Content{
+		mcpgo.NewTextContent("Template found: "),
+		mcpgo.NewTextContent(`{"id":1,"name":"Token"}`),
+	}}, nil
+}
+
+func newRESTTestServer(t *testing.T, authenticationEnabled bool) (*APIServer, *fakeToolCaller) {
+	s := &APIServer{app: fiber.New(), authenticationEnabled: authenticationEnabled}
+	s.app.Use(func(c *fiber.Ctx) error {
+		if sub := c.Get("X-Test-User"); sub != "" {
+			c.Locals(middleware.AuthenticatedUserContextKey, &utils.AuthenticatedUser{Sub: sub})
+		}
+		if scopes := c.Get("X-Test-API-Key-Scopes"); scopes != "" {
+			c.Locals(middleware.AuthenticatedUserContextKey, &utils.AuthenticatedUser{Sub: "ci", ClientId: utils.APIKeyClientID, Jti: "lpk_1234", Scopes: strings.Split(scopes, ",")})
+		}
+		return c.Next()
+	})
+	caller := &fakeToolCaller{}
+	require.NoError(t, s.registerRESTRoutes(caller))
+	return s, caller
+}
+
+func restTestRequest(t *testing.T, s *APIServer, method, path, body, user string) (int, map[string]any) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if user != "" {
+		req.Header.Set("X-Test-User", user)
+	}
+	resp, err := s.app.Test(req)
+	require.NoError(t, err)
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(raw, &decoded), string(raw))
+	return resp.StatusCode, decoded
+}
+
+func TestRESTCallsToolWithPathAndQuery(t *testing.T) {
+	s, caller := newRESTTestServer(t, false)
+
+	status, body := restTestRequest(t, s, "GET", "/api/v1/templates/7?show_abi_methods=true", "", "")
+	require.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "Template found", body["message"])
+	assert.Equal(t, map[string]any{"id": float64(1), "name": "Token"}, body["data"])
+
+	require.Len(t, caller.calls, 1)
+	assert.Equal(t, "view_template", caller.calls[0].Params.Name)
+	assert.Equal(t, map[string]any{"template_id": "7", "show_abi_methods": true}, caller.calls[0].Params.Arguments)
+}
+
+func TestRESTCallsToolWithBody(t *testing.T) {
+	s, caller := newRESTTestServer(t, true)
+
+	status, _ := restTestRequest(t, s, "POST", "/api/v1/deployments", `{"template_id":"1","template_values":{"Name":"Token"}}`, "")
+	assert.Equal(t, fiber.StatusUnauthorized, status)
+	assert.Empty(t, caller.calls)
+
+	status, _ = restTestRequest(t, s, "POST", "/api/v1/deployments", `{"template_id":"1","template_values":{"Name":"Token"}}`, "user-1")
+	require.Equal(t, fiber.StatusCreated, status)
+	require.Len(t, caller.calls, 1)
+	assert.Equal(t, "launch", caller.calls[0].Params.Name)
+	assert.Equal(t, map[string]any{"template_id": "1", "template_values": map[string]any{"Name": "Token"}}, caller.calls[0].Params.Arguments)
+	// The tool runs as the authenticated user
+	assert.Equal(t, []string{"user-1"}, caller.users)
+
+	status, body := restTestRequest(t, s, "POST", "/api/v1/deployments", `not json`, "user-1")
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	assert.Equal(t, "The body must be a JSON object", body["error"])
+}
+
+func TestRESTReportsToolErrors(t *testing.T) {
+	s, _ := newRESTTestServer(t, false)
+
+	status, body := restTestRequest(t, s, "GET", "/api/v1/templates/missing", "", "")
+	assert.Equal(t, fiber.StatusBadRequest, status)
+	assert.Equal(t, "Template not found", body["error"])
+
+	// The routes of unregistered tools are skipped
+	resp, err := s.app.Test(httptest.NewRequest("GET", "/api/v1/chains", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
+
+func TestRESTChecksAPIKeyScopes(t *testing.T) {
+	s, caller := newRESTTestServer(t, true)
+	request := func(method, path, body, scopes string) (int, map[string]any) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Test-API-Key-Scopes", scopes)
+		resp, err := s.app.Test(req)
+		require.NoError(t, err)
+		var decoded map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&decoded))
+		return resp.StatusCode, decoded
+	}
+
+	status, body := request("POST", "/api/v1/deployments", `{"template_id":"1","template_values":{}}`, "view_template")
+	assert.Equal(t, fiber.StatusForbidden, status)
+	assert.Equal(t, "The API key lpk_1234 is not allowed to call launch", body["error"])
+	status, _ = request("GET", "/api/v1/sessions/session-1", "", "view_template,launch")
+	assert.Equal(t, fiber.StatusForbidden, status)
+	assert.Empty(t, caller.calls)
+
+	status, _ = request("GET", "/api/v1/templates/7", "", "view_template")
+	assert.Equal(t, fiber.StatusOK, status)
+	status, _ = request("POST", "/api/v1/deployments", `{"template_id":"1","template_values":{}}`, "*")
+	assert.Equal(t, fiber.StatusCreated, status)
+	assert.Len(t, caller.calls, 2)
+}
+
+func TestRESTOpenAPISpec(t *testing.T) {
+	s, _ := newRESTTestServer(t, true)
+
+	status, spec := restTestRequest(t, s, "GET", "/api/v1/openapi.json", "", "")
+	require.Equal(t, fiber.StatusOK, status)
+	assert.Equal(t, "3.0.3", spec["openapi"])
+
+	paths := spec["paths"].(map[string]any)
+	assert.Contains(t, paths, "/api/v1/templates/{template_id}")
+	assert.Contains(t, paths, "/api/v1/sessions/{session_id}")
+	assert.NotContains(t, paths, "/api/v1/chains")
+
+	view := paths["/api/v1/templates/{template_id}"].(map[string]any)["get"].(map[string]any)
+	assert.Equal(t, "view_template", view["operationId"])
+	parameters := view["parameters"].([]any)
+	require.Len(t, parameters, 2)
+	assert.Equal(t, "path", parameters[0].(map[string]any)["in"])
+	assert.Equal(t, "show_abi_methods", parameters[1].(map[string]any)["name"])
+	assert.Equal(t, "query", parameters[1].(map[string]any)["in"])
+
+	launch := paths["/api/v1/deployments"].(map[string]any)["post"].(map[string]any)
+	schema := launch["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)
+	assert.ElementsMatch(t, []any{"template_id", "template_values"}, schema["required"])
+	assert.Contains(t, spec["components"].(map[string]any), "securitySchemes")
+}
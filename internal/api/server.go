@@ -1,6 +1,7 @@
 package api
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net"
@@ -20,6 +21,7 @@ import (
 	auth "github.com/rxtech-lab/mcprouter-authenticator/authenticator"
 	auth2 "github.com/rxtech-lab/mcprouter-authenticator/middleware"
 	"github.com/rxtech-lab/mcprouter-authenticator/types"
+	"golang.org/x/crypto/acme/autocert"
 )
 
 type APIServer struct {
@@ -28,20 +30,63 @@ type APIServer struct {
 	txService              services.TransactionService
 	hookService            services.HookService
 	chainService           services.ChainService
+	deploymentService      services.DeploymentService
+	liquidityService       services.LiquidityService
+	uniswapContractService services.UniswapContractService
+	privateTxService       services.PrivateTransactionService
+	userOperationService   services.UserOperationService
+	tradingLaunchService   services.TradingLaunchService
+	bondingCurveService    services.BondingCurveService
 	mcpServer              *mcp.MCPServer
 	authenticator          *utils.JwtAuthenticator
 	simpleAuthenticator    *utils.SimpleJwtAuthenticator
 	mcprouterAuthenticator *auth.ApikeyAuthenticator
-	port                   int
-	authenticationEnabled  bool
+	// authenticators are the pluggable authenticators of LAUNCHPAD_AUTH_PROVIDERS, tried before the built-in ones
+	authenticators []middleware.Authenticator
+	// apiKeyService validates the API keys created by create_api_key
+	apiKeyService services.APIKeyService
+	// sessionAccessService binds the one-time session urls to the first browser opening them
+	sessionAccessService services.SessionAccessService
+	// oneTimeSessionURLs only opens a signed session url in the first browser opening it
+	oneTimeSessionURLs bool
+	// embedOrigins are the origins of LAUNCHPAD_EMBED_ALLOWED_ORIGINS allowed to embed the signing page
+	embedOrigins []string
+	// trustedProxies are the reverse proxies of LAUNCHPAD_TRUSTED_PROXIES whose forwarded headers are trusted
+	trustedProxies []string
+	// tlsConfig serves over TLS when LAUNCHPAD_TLS_CERT_FILE or LAUNCHPAD_ACME_DOMAINS is set
+	tlsConfig *tls.Config
+	// acmeManager provisions the certificates of LAUNCHPAD_ACME_DOMAINS
+	acmeManager *autocert.Manager
+	// acmeHTTPAddr answers the ACME HTTP-01 challenges when set
+	acmeHTTPAddr          string
+	acmeHTTPServer        *http.Server
+	port                  int
+	authenticationEnabled bool
+	// clusterMode shares the MCP sessions and the rate limits of the replicas through the database
+	clusterMode bool
+	// redactedFields are the session fields hidden from the public signing page
+	redactedFields map[string]bool
+	// readTxService reads the sessions of the signing page from the replica when one is configured
+	readTxService services.TransactionService
+	// chainAdapters verify the confirmed transactions by the chain type of the session
+	chainAdapters services.ChainAdapters
+	// adminService backs the admin dashboard, set by EnableAdminDashboard
+	adminService services.AdminService
+	// tokenMetadataService and assetStorage serve the token info, logos and token list of /tokens
+	tokenMetadataService services.TokenMetadataService
+	assetStorage         services.AssetStorage
 }
 
-func NewAPIServer(dbService services.DBService, txService services.TransactionService, hookService services.HookService, chainService services.ChainService) *APIServer {
-	app := fiber.New(fiber.Config{
+func NewAPIServer(dbService services.DBService, txService services.TransactionService, hookService services.HookService, chainService services.ChainService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapContractService services.UniswapContractService) *APIServer {
+	trustedProxies := trustedProxiesFromEnv()
+	app := fiber.New(proxyConfig(fiber.Config{
 		DisableStartupMessage: true,
-	})
+	}, trustedProxies))
 
 	// Add middleware
+	if len(trustedProxies) > 0 {
+		app.Use(forwardedClientIP(trustedProxies))
+	}
 	app.Use(cors.New())
 	app.Use(logger.New(logger.Config{
 		Format:     "[${time}] ${status} - ${latency} ${method} ${path}\n",
@@ -70,26 +115,101 @@ func NewAPIServer(dbService services.DBService, txService services.TransactionSe
 		mcprouterAuthenticator = auth.NewApikeyAuthenticator(os.Getenv("MCPROUTER_SERVER_URL"), http.DefaultClient)
 	}
 
+	authenticators, err := middleware.AuthenticatorsFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize the authenticators: %v", err)
+	}
+	tlsConfig, acmeManager, err := tlsConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize TLS: %v", err)
+	}
+	oneTimeSessionURLs := services.SessionURLOneTimeFromEnv()
+	if oneTimeSessionURLs && !utils.SessionURLSigningEnabled() {
+		log.Fatalf("%s requires %s", services.EnvSessionURLOneTime, utils.EnvSessionURLSecret)
+	}
+	assetStorage, err := services.NewAssetStorageFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize the asset storage: %v", err)
+	}
+
 	server := &APIServer{
 		app:                    app,
 		dbService:              dbService,
 		txService:              txService,
+		readTxService:          services.NewTransactionService(dbService.GetReadDB()),
 		hookService:            hookService,
 		chainService:           chainService,
+		deploymentService:      deploymentService,
+		liquidityService:       liquidityService,
+		uniswapContractService: uniswapContractService,
+		privateTxService:       services.NewPrivateTransactionService(dbService.GetDB()),
+		userOperationService:   services.NewUserOperationService(dbService.GetDB()),
+		tradingLaunchService:   services.NewTradingLaunchService(dbService.GetDB()),
+		bondingCurveService:    services.NewBondingCurveService(dbService.GetReadDB()),
 		authenticator:          authenticator,
 		simpleAuthenticator:    &simpleAuthenticator,
 		mcprouterAuthenticator: mcprouterAuthenticator,
+		authenticators:         authenticators,
+		apiKeyService:          services.NewAPIKeyService(dbService.GetDB()),
+		sessionAccessService:   services.NewSessionAccessService(dbService.GetDB()),
+		oneTimeSessionURLs:     oneTimeSessionURLs,
+		trustedProxies:         trustedProxies,
+		embedOrigins:           embedOriginsFromEnv(),
+		tlsConfig:              tlsConfig,
+		acmeManager:            acmeManager,
+		acmeHTTPAddr:           os.Getenv(EnvACMEHTTPAddr),
+		redactedFields:         parseRedactedFields(os.Getenv("SIGNING_PAGE_REDACTED_FIELDS")),
+		chainAdapters:          services.NewChainAdapters(services.NewEvmService()),
+		tokenMetadataService:   services.NewTokenMetadataService(dbService.GetReadDB()),
+		assetStorage:           assetStorage,
 	}
 	return server
 }
 
 func (s *APIServer) SetupRoutes() {
+	// Rate limits of the signing routes and the MCP endpoint, registered after the authentication middlewares so the
+	// user limit sees the authenticated user
+	if rateLimit := middleware.RateLimitFromEnv(); rateLimit.Enabled() {
+		if s.clusterMode {
+			rateLimit.Storage = services.NewSharedStorage(s.dbService.GetDB())
+		}
+		log.Printf("Rate limits enabled: %d requests per IP and %d per user every %s", rateLimit.PerIP, rateLimit.PerUser, rateLimit.Window)
+		for _, limiter := range middleware.RateLimiters(rateLimit) {
+			s.app.Use(middleware.RateLimitedPaths, limiter)
+		}
+	}
+
 	// Universal transaction signing routes
-	s.app.Get("/tx/:session_id", s.handleTransactionPage)
-	s.app.Post("/api/tx/:session_id/transaction/:index", s.handleTransactionAPI)
+	// With LAUNCHPAD_SESSION_URL_SECRET set they require the signature of the session url
+	s.app.Get("/tx/:session_id", s.requireSessionPageAccess, s.handleTransactionPage)
+	s.app.Get("/api/tx/:session_id", s.requireSessionAPIAccess, s.handleGetSigningSession)
+	s.app.Post("/api/tx/:session_id/transaction/:index", s.requireSessionAPIAccess, s.handleTransactionAPI)
+	s.app.Post("/api/tx/:session_id/transaction/:index/private", s.requireSessionAPIAccess, s.handleSubmitPrivateTransaction)
+	s.app.Get("/api/tx/:session_id/transaction/:index/private", s.requireSessionAPIAccess, s.handleGetPrivateTransaction)
+	s.app.Post("/api/tx/:session_id/transaction/:index/user-operation", s.requireSessionAPIAccess, s.handlePrepareUserOperation)
+	s.app.Post("/api/tx/:session_id/transaction/:index/user-operation/send", s.requireSessionAPIAccess, s.handleSendUserOperation)
+	s.app.Get("/api/tx/:session_id/transaction/:index/user-operation", s.requireSessionAPIAccess, s.handleGetUserOperation)
+	s.app.Post("/api/tx/:session_id/signature", s.requireSessionAPIAccess, s.handleSubmitSignature)
+	s.app.Post("/api/tx/:session_id/mobile-link", s.requireSessionAPIAccess, s.handleCreateMobileLink)
+	// Full session data including the fields redacted from the signing page, requires authentication
+	s.app.Get("/api/session/:session_id", requireAPIKeyScope(services.APIKeyScopeSessions), s.handleGetTransactionSession)
 	// Static assets for signing app
 	s.app.Get("/static/tx/app.js", s.handleSigningAppJS)
 	s.app.Get("/static/tx/app.css", s.handleSigningAppCSS)
+	s.app.Get("/static/embed/launchpad.js", s.handleEmbedSDK)
+	// Deployment artifacts
+	s.app.Get("/api/deployments/:deployment_id/typings", requireAPIKeyScope("generate_abi_typings"), s.handleDeploymentTypings)
+	s.app.Get("/deployments/:deployment_id/artifacts", requireAPIKeyScope("get_deployment_artifacts"), s.handleDeploymentArtifacts)
+	// Public token info, logos and token list of the tokens with metadata
+	s.app.Get("/tokens/tokenlist.json", s.handleTokenList)
+	s.app.Get("/tokens/:deployment_id", s.handleTokenInfo)
+	s.app.Get("/tokens/:deployment_id/logo", s.handleTokenLogo)
+	// Pool detail page
+	s.app.Get("/pool/:id", s.handlePoolPage)
+	// Public trading launch countdown page
+	s.app.Get("/launch/:id", s.handleLaunchCountdownPage)
+	// Public progress of a bonding curve towards its graduation
+	s.app.Get("/bonding-curves/:id/progress", s.handleBondingCurveProgress)
 	// Test API for E2E testing
 	s.app.Post("/api/test/sign-transaction", s.handleTestSignTransaction)
 	s.app.Post("/api/test/personal-sign", s.handleTestPersonalSign)
@@ -110,9 +230,16 @@ func (s *APIServer) EnableAuthentication() {
 	// Set authentication enabled state
 	s.authenticationEnabled = true
 
+	if len(s.authenticators) > 0 {
+		log.Printf("Authenticators enabled: %s", os.Getenv(middleware.EnvAuthProviders))
+	}
+	// The keys of create_api_key are always accepted, the other keys are left to the configured authenticators
+	storedAPIKeys := middleware.NewStoredAPIKeyAuthenticator(services.APIKeyPrefix, s.authenticateAPIKey)
+	s.app.Use(middleware.AuthenticatorMiddleware(append([]middleware.Authenticator{storedAPIKeys}, s.authenticators...)...))
+
 	if s.mcprouterAuthenticator != nil {
 		log.Printf("MCPRouter authenticator enabled")
-		s.app.Use(auth2.FiberApikeyMiddleware(s.mcprouterAuthenticator, os.Getenv("MCPROUTER_SERVER_API_KEY"), func(c *fiber.Ctx, user *types.User) error {
+		mcprouterMiddleware := auth2.FiberApikeyMiddleware(s.mcprouterAuthenticator, os.Getenv("MCPROUTER_SERVER_API_KEY"), func(c *fiber.Ctx, user *types.User) error {
 			// Store user in context for later use - adapt types.User to utils.AuthenticatedUser
 			authenticatedUser := &utils.AuthenticatedUser{
 				Sub:   user.ID,
@@ -121,7 +248,14 @@ func (s *APIServer) EnableAuthentication() {
 			// Store the adapted AuthenticatedUser instead of the raw types.User
 			c.Locals(middleware.AuthenticatedUserContextKey, authenticatedUser)
 			return nil
-		}))
+		})
+		s.app.Use(func(c *fiber.Ctx) error {
+			// The X-API-Key of the static API keys is not a MCPRouter key
+			if c.Locals(middleware.AuthenticatedUserContextKey) != nil {
+				return c.Next()
+			}
+			return mcprouterMiddleware(c)
+		})
 	}
 
 	// oauth routes
@@ -137,6 +271,10 @@ func (s *APIServer) EnableAuthentication() {
 			if s.authenticator != nil {
 				return s.authenticator.ValidateToken(token)
 			}
+			// The pluggable authenticators already had their chance at the token
+			if len(s.authenticators) > 0 {
+				return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid token")
+			}
 			// Default validation when no authenticator is configured
 			if token == "" {
 				return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid token")
@@ -146,6 +284,42 @@ func (s *APIServer) EnableAuthentication() {
 	}))
 }
 
+// authenticateAPIKey returns the user of a key created by create_api_key, scoped to the tools of the key
+func (s *APIServer) authenticateAPIKey(key string) (*utils.AuthenticatedUser, error) {
+	record, err := s.apiKeyService.AuthenticateAPIKey(key)
+	if err != nil {
+		return nil, err
+	}
+	user := &utils.AuthenticatedUser{
+		Sub:      record.Subject,
+		ClientId: utils.APIKeyClientID,
+		Jti:      record.Prefix,
+		Scopes:   record.Scopes,
+	}
+	if record.ExpiresAt != nil {
+		user.Exp = int(record.ExpiresAt.Unix())
+	}
+	return user, nil
+}
+
+// EnableLocalAuthentication requires the per-run local auth token on every route except the health check.
+// It is used in stdio mode where the server only listens for the local user, and must be called before SetupRoutes
+func (s *APIServer) EnableLocalAuthentication(token string) {
+	utils.SetLocalAuthToken(token)
+	s.app.Use(middleware.LocalTokenMiddleware(token))
+}
+
+// EnableClusterMode runs the server as one replica of a cluster sharing a PostgreSQL database: the MCP sessions and
+// the rate limit counters are stored in the database, so the load balancer needs no sticky sessions. It must be
+// called before SetupRoutes and EnableStreamableHttp
+func (s *APIServer) EnableClusterMode() error {
+	if s.dbService.GetDB().Dialector.Name() != "postgres" {
+		return fmt.Errorf("cluster mode requires a PostgreSQL database")
+	}
+	s.clusterMode = true
+	return nil
+}
+
 // EnableStreamableHttp enables the MCP Streamable HTTP server conditionally with authentication
 // on the /mcp and /mcp/* routes based on whether EnableAuthentication was called
 
@@ -154,8 +328,13 @@ func (s *APIServer) EnableStreamableHttp() {
 		log.Fatal("MCP server not set. Cannot enable Streamable HTTP.")
 		return
 	}
-	// Start the streamable HTTP server
-	streamableServer := s.mcpServer.StartStreamableHTTPServer()
+	// Start the streamable HTTP server, in cluster mode any replica accepts the sessions of the others
+	var options []server.StreamableHTTPOption
+	if s.clusterMode {
+		options = append(options, server.WithSessionIdManager(services.NewMCPSessionStore(s.dbService.GetDB())))
+	}
+	// The resource subscriptions are answered before the requests reach the streamable HTTP server
+	streamableServer := s.mcpServer.WithResourceSubscriptions(s.mcpServer.StartStreamableHTTPServer(options...))
 
 	// Create a custom handler based on authentication state
 	var mcpHandler fiber.Handler
@@ -176,6 +355,10 @@ func (s *APIServer) EnableStreamableHttp() {
 // Start starts the server on a random available port
 // if port is nil, otherwise starts on the specified port
 func (s *APIServer) Start(port *int) (int, error) {
+	if err := s.checkMTLS(); err != nil {
+		return 0, err
+	}
+
 	// Find an available port
 	listener, err := net.Listen("tcp", ":0")
 	if err != nil {
@@ -196,6 +379,27 @@ func (s *APIServer) Start(port *int) (int, error) {
 		return 0, err
 	}
 
+	if s.tlsConfig != nil {
+		if s.acmeManager != nil && s.acmeHTTPAddr != "" {
+			s.acmeHTTPServer = &http.Server{Addr: s.acmeHTTPAddr, Handler: s.acmeManager.HTTPHandler(nil)}
+			go func() {
+				if err := s.acmeHTTPServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					log.Printf("Error starting the ACME challenge server: %v\n", err)
+				}
+			}()
+		}
+		tlsListener, err := tls.Listen("tcp", fmt.Sprintf(":%d", s.port), s.tlsConfig)
+		if err != nil {
+			return 0, fmt.Errorf("failed to listen over TLS: %w", err)
+		}
+		go func() {
+			if err := s.app.Listener(tlsListener); err != nil {
+				log.Printf("Error starting API server: %v\n", err)
+			}
+		}()
+		return s.port, nil
+	}
+
 	// Start the server on the found port
 	go func() {
 		if err := s.app.Listen(fmt.Sprintf(":%d", s.port)); err != nil {
@@ -207,15 +411,42 @@ func (s *APIServer) Start(port *int) (int, error) {
 }
 
 func (s *APIServer) Shutdown() error {
+	if s.acmeHTTPServer != nil {
+		s.acmeHTTPServer.Close()
+	}
 	return s.app.Shutdown()
 }
 
+// checkMTLS verifies that the client certificates of the mtls authenticator are verified by the TLS listener, with
+// the TLS configuration of the environment or of ConfigureTLS
+func (s *APIServer) checkMTLS() error {
+	if middleware.AuthProviderEnabled(middleware.AuthProviderMTLS) && (s.tlsConfig == nil || s.tlsConfig.ClientCAs == nil) {
+		return fmt.Errorf("the %s authenticator requires %s, %s and %s, or the matching flags", middleware.AuthProviderMTLS, EnvTLSCertFile, EnvTLSKeyFile, EnvTLSClientCAFile)
+	}
+	return nil
+}
+
+// ConfigureTLS replaces the TLS configuration of the environment with the options, e.g. the flags of the binary.
+// It must be called before Start
+func (s *APIServer) ConfigureTLS(options TLSOptions) error {
+	tlsConfig, acmeManager, err := newTLSConfig(options)
+	if err != nil {
+		return err
+	}
+	s.tlsConfig = tlsConfig
+	s.acmeManager = acmeManager
+	s.acmeHTTPAddr = options.ACMEHTTPAddr
+	return nil
+}
+
 func (s *APIServer) GetPort() int {
 	return s.port
 }
 
 func (s *APIServer) SetMCPServer(mcpServer *mcp.MCPServer) {
 	s.mcpServer = mcpServer
+	// Transactions executed by a Safe complete their session like the ones signed in the browser
+	mcpServer.SetHookService(s.hookService)
 }
 
 func (s *APIServer) GetMCPServer() *mcp.MCPServer {
@@ -236,7 +467,7 @@ func (s *APIServer) handleSigningAppCSS(c *fiber.Ctx) error {
 
 // createAuthenticatedMCPHandler creates a Fiber handler that enforces authentication
 // and passes authenticated context to the MCP streamable HTTP server
-func (s *APIServer) createAuthenticatedMCPHandler(streamableServer *server.StreamableHTTPServer, authenticator *utils.JwtAuthenticator) fiber.Handler {
+func (s *APIServer) createAuthenticatedMCPHandler(streamableServer http.Handler, authenticator *utils.JwtAuthenticator) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		user := c.Locals(middleware.AuthenticatedUserContextKey)
 		if user == nil {
@@ -245,6 +476,7 @@ func (s *APIServer) createAuthenticatedMCPHandler(streamableServer *server.Strea
 			})
 		}
 		authenticatedUser := user.(*utils.AuthenticatedUser)
+		baseUrl := s.requestBaseUrl(c)
 		// Create a custom HTTP handler that injects authentication context
 		httpHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -253,6 +485,10 @@ func (s *APIServer) createAuthenticatedMCPHandler(streamableServer *server.Strea
 			if authenticatedUser != nil {
 				ctx = utils.WithAuthenticatedUser(ctx, authenticatedUser)
 			}
+			// Links of the tools point to the proxy the request came through
+			if baseUrl != "" {
+				ctx = utils.WithRequestBaseUrl(ctx, baseUrl)
+			}
 
 			// Update request with authenticated context
 			r = r.WithContext(ctx)
@@ -268,10 +504,15 @@ func (s *APIServer) createAuthenticatedMCPHandler(streamableServer *server.Strea
 
 // createUnauthenticatedMCPHandler creates a Fiber handler that does not enforce authentication
 // and passes the request directly to the MCP streamable HTTP server
-func (s *APIServer) createUnauthenticatedMCPHandler(streamableServer *server.StreamableHTTPServer) fiber.Handler {
+func (s *APIServer) createUnauthenticatedMCPHandler(streamableServer http.Handler) fiber.Handler {
 	return func(c *fiber.Ctx) error {
+		baseUrl := s.requestBaseUrl(c)
 		// Create a simple HTTP handler that forwards directly to MCP server
 		httpHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Links of the tools point to the proxy the request came through
+			if baseUrl != "" {
+				r = r.WithContext(utils.WithRequestBaseUrl(r.Context(), baseUrl))
+			}
 			// Forward to the actual MCP streamable server without authentication context
 			streamableServer.ServeHTTP(w, r)
 		})
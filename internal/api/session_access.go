@@ -0,0 +1,107 @@
+package api
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// sessionAccessCookiePrefix prefixes the cookie set when a signed session url is opened, so the API calls of
+// the signing page are accepted without the signature
+const sessionAccessCookiePrefix = "launchpad_session_"
+
+// setSessionAccessCookie remembers the access of the browser to the session. Lax keeps the cookie on the
+// navigations from a chat or mail client, which reopen the url of a one-time session
+func setSessionAccessCookie(c *fiber.Ctx, sessionID, value string) {
+	c.Cookie(&fiber.Cookie{
+		Name:     sessionAccessCookiePrefix + sessionID,
+		Value:    value,
+		Path:     "/",
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
+
+// hasSessionAccess reports whether the cookie of the browser grants access to the session: the signature of the
+// url, or with one-time urls the binding of the browser that opened the url first
+func (s *APIServer) hasSessionAccess(c *fiber.Ctx, sessionID string) bool {
+	value := c.Cookies(sessionAccessCookiePrefix + sessionID)
+	if !s.oneTimeSessionURLs {
+		return utils.VerifySessionSignature(sessionID, value)
+	}
+	ok, err := s.sessionAccessService.Verify(sessionID, value)
+	if err != nil {
+		log.Printf("Error verifying the access to session %s: %v", sessionID, err)
+	}
+	return ok
+}
+
+// requireSessionPageAccess lets the signing page of a session open only with the signature of its url, when
+// LAUNCHPAD_SESSION_URL_SECRET is set. One-time urls only open in the browser that opened them first
+func (s *APIServer) requireSessionPageAccess(c *fiber.Ctx) error {
+	if !utils.SessionURLSigningEnabled() {
+		return c.Next()
+	}
+	sessionID := c.Params("session_id")
+
+	if s.hasSessionAccess(c, sessionID) {
+		return c.Next()
+	}
+
+	signature := c.Query(utils.SessionSignatureQueryParam)
+	if !utils.VerifySessionSignature(sessionID, signature) {
+		return s.renderErrorPage(c, fiber.StatusForbidden, "Invalid Session Link",
+			"This transaction session link is missing its signature or the signature is invalid. Open the link exactly as it was shared with you.")
+	}
+	if !s.oneTimeSessionURLs {
+		setSessionAccessCookie(c, sessionID, signature)
+		return c.Next()
+	}
+
+	// A mobile link carries the binding handed off by the browser the session was bound to
+	if handoff := c.Query(utils.SessionHandoffQueryParam); handoff != "" {
+		ok, err := s.sessionAccessService.Verify(sessionID, handoff)
+		if err != nil {
+			log.Printf("Error verifying the handoff of session %s: %v", sessionID, err)
+		}
+		if !ok {
+			return s.renderErrorPage(c, fiber.StatusForbidden, "Session Link Already Used",
+				"This mobile link is no longer valid, the session was handed off to another browser.")
+		}
+		setSessionAccessCookie(c, sessionID, handoff)
+		return c.Next()
+	}
+
+	binding, err := s.sessionAccessService.Claim(sessionID)
+	if err != nil {
+		log.Printf("Error claiming the access to session %s: %v", sessionID, err)
+		return s.renderErrorPage(c, fiber.StatusInternalServerError, "Session Unavailable",
+			"The transaction session could not be opened, please try again.")
+	}
+	if binding == "" {
+		return s.renderErrorPage(c, fiber.StatusForbidden, "Session Link Already Used",
+			"This transaction session link can only be opened once and was already opened in another browser.")
+	}
+	setSessionAccessCookie(c, sessionID, binding)
+	return c.Next()
+}
+
+// requireSessionAPIAccess accepts the API calls of a session from the browsers its signing page was opened in,
+// or with the signature of the session url when the urls are not one-time
+func (s *APIServer) requireSessionAPIAccess(c *fiber.Ctx) error {
+	if !utils.SessionURLSigningEnabled() {
+		return c.Next()
+	}
+	sessionID := c.Params("session_id")
+
+	if s.hasSessionAccess(c, sessionID) {
+		return c.Next()
+	}
+	if !s.oneTimeSessionURLs && utils.VerifySessionSignature(sessionID, c.Query(utils.SessionSignatureQueryParam)) {
+		return c.Next()
+	}
+	return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+		"error": "Invalid session signature",
+	})
+}
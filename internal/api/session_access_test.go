@@ -0,0 +1,231 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionAccessTestSuite struct {
+	suite.Suite
+	db         services.DBService
+	apiServer  *APIServer
+	serverPort int
+	txService  services.TransactionService
+	chain      *models.Chain
+}
+
+func (suite *SessionAccessTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", "test-secret")
+	suite.T().Setenv(utils.EnvSessionURLSecret, "session-url-secret")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chainService := services.NewChainService(db.GetDB())
+	suite.chain = &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	suite.Require().NoError(chainService.CreateChain(suite.chain))
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	apiServer := NewAPIServer(db, suite.txService, services.NewHookService(), chainService, services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *SessionAccessTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *SessionAccessTestSuite) TearDownTest() {
+	suite.apiServer.oneTimeSessionURLs = false
+}
+
+func (suite *SessionAccessTestSuite) createSession() string {
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{
+			Title:           "Deploy Token",
+			Data:            "0x6080",
+			Value:           "0",
+			TransactionType: models.TransactionTypeTokenDeployment,
+		}},
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   suite.chain.ID,
+	})
+	suite.Require().NoError(err)
+	return sessionID
+}
+
+// newBrowser returns a client keeping the cookies of the server, like a browser
+func (suite *SessionAccessTestSuite) newBrowser() *http.Client {
+	jar, err := cookiejar.New(nil)
+	suite.Require().NoError(err)
+	return &http.Client{Jar: jar}
+}
+
+func (suite *SessionAccessTestSuite) do(client *http.Client, method, path string, query url.Values) int {
+	rawURL := fmt.Sprintf("http://localhost:%d%s", suite.serverPort, path)
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+	var body *strings.Reader
+	if method == http.MethodPost {
+		body = strings.NewReader(`{}`)
+	} else {
+		body = strings.NewReader("")
+	}
+	req, err := http.NewRequest(method, rawURL, body)
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	suite.Require().NoError(err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func signedQuery(sessionID string) url.Values {
+	return url.Values{utils.SessionSignatureQueryParam: {utils.SignSessionID(sessionID)}}
+}
+
+func (suite *SessionAccessTestSuite) TestPageRequiresSignature() {
+	sessionID := suite.createSession()
+	browser := suite.newBrowser()
+
+	suite.Equal(http.StatusForbidden, suite.do(browser, http.MethodGet, "/tx/"+sessionID, nil))
+	suite.Equal(http.StatusForbidden, suite.do(browser, http.MethodGet, "/tx/"+sessionID, url.Values{utils.SessionSignatureQueryParam: {"forged"}}))
+	// The signature of another session does not open this one
+	suite.Equal(http.StatusForbidden, suite.do(browser, http.MethodGet, "/tx/"+sessionID, signedQuery(suite.createSession())))
+
+	suite.Equal(http.StatusOK, suite.do(browser, http.MethodGet, "/tx/"+sessionID, signedQuery(sessionID)))
+}
+
+func (suite *SessionAccessTestSuite) TestAPIAcceptsCookieOfSignedPage() {
+	sessionID := suite.createSession()
+	apiPath := fmt.Sprintf("/api/tx/%s/transaction/0/private", sessionID)
+
+	suite.Equal(http.StatusForbidden, suite.do(suite.newBrowser(), http.MethodGet, apiPath, nil))
+	suite.NotEqual(http.StatusForbidden, suite.do(suite.newBrowser(), http.MethodGet, apiPath, signedQuery(sessionID)))
+
+	browser := suite.newBrowser()
+	suite.Require().Equal(http.StatusOK, suite.do(browser, http.MethodGet, "/tx/"+sessionID, signedQuery(sessionID)))
+	suite.NotEqual(http.StatusForbidden, suite.do(browser, http.MethodGet, apiPath, nil))
+}
+
+func (suite *SessionAccessTestSuite) TestOneTimeURLOpensInFirstBrowserOnly() {
+	suite.apiServer.oneTimeSessionURLs = true
+	sessionID := suite.createSession()
+	apiPath := fmt.Sprintf("/api/tx/%s/transaction/0/private", sessionID)
+
+	first := suite.newBrowser()
+	suite.Require().Equal(http.StatusOK, suite.do(first, http.MethodGet, "/tx/"+sessionID, signedQuery(sessionID)))
+	// The first browser reopens the page and calls the API with its binding
+	suite.Equal(http.StatusOK, suite.do(first, http.MethodGet, "/tx/"+sessionID, signedQuery(sessionID)))
+	suite.NotEqual(http.StatusForbidden, suite.do(first, http.MethodGet, apiPath, nil))
+
+	second := suite.newBrowser()
+	suite.Equal(http.StatusForbidden, suite.do(second, http.MethodGet, "/tx/"+sessionID, signedQuery(sessionID)))
+	// The signature alone does not grant the API of a one-time session
+	suite.Equal(http.StatusForbidden, suite.do(second, http.MethodGet, apiPath, signedQuery(sessionID)))
+}
+
+// createMobileLink requests the mobile link of the session from the browser
+func (suite *SessionAccessTestSuite) createMobileLink(client *http.Client, sessionID string, query url.Values) (int, MobileLinkResponse) {
+	rawURL := fmt.Sprintf("http://localhost:%d/api/tx/%s/mobile-link", suite.serverPort, sessionID)
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+	resp, err := client.Post(rawURL, "application/json", strings.NewReader(`{}`))
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var link MobileLinkResponse
+	if resp.StatusCode == http.StatusOK {
+		suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&link))
+	}
+	return resp.StatusCode, link
+}
+
+func (suite *SessionAccessTestSuite) TestMobileLinkOfSignedSession() {
+	sessionID := suite.createSession()
+
+	status, _ := suite.createMobileLink(suite.newBrowser(), sessionID, nil)
+	suite.Equal(http.StatusForbidden, status)
+
+	status, link := suite.createMobileLink(suite.newBrowser(), sessionID, signedQuery(sessionID))
+	suite.Require().Equal(http.StatusOK, status)
+	suite.Contains(link.URL, fmt.Sprintf("/tx/%s?sig=", sessionID))
+	suite.NotContains(link.URL, utils.SessionHandoffQueryParam)
+	suite.False(link.HandedOff)
+	// The page was opened on localhost, a phone cannot reach it
+	suite.False(link.Reachable)
+	suite.Len(link.Wallets, 3)
+	suite.True(strings.HasPrefix(link.QRCode, "<svg"))
+
+	// The mobile link opens the page in another browser
+	pageUrl, err := url.Parse(link.URL)
+	suite.Require().NoError(err)
+	suite.Equal(http.StatusOK, suite.do(suite.newBrowser(), http.MethodGet, pageUrl.Path, pageUrl.Query()))
+}
+
+func (suite *SessionAccessTestSuite) TestMobileLinkUsesPublicUrl() {
+	suite.T().Setenv(utils.EnvBaseURL, "http://192.168.1.20:3000")
+	sessionID := suite.createSession()
+
+	status, link := suite.createMobileLink(suite.newBrowser(), sessionID, signedQuery(sessionID))
+	suite.Require().Equal(http.StatusOK, status)
+	suite.True(strings.HasPrefix(link.URL, "http://192.168.1.20:3000/tx/"+sessionID))
+	suite.True(link.Reachable)
+}
+
+func (suite *SessionAccessTestSuite) TestMobileLinkHandsOffOneTimeSession() {
+	suite.apiServer.oneTimeSessionURLs = true
+	sessionID := suite.createSession()
+	apiPath := fmt.Sprintf("/api/tx/%s/transaction/0/private", sessionID)
+
+	desktop := suite.newBrowser()
+	suite.Require().Equal(http.StatusOK, suite.do(desktop, http.MethodGet, "/tx/"+sessionID, signedQuery(sessionID)))
+	status, link := suite.createMobileLink(desktop, sessionID, nil)
+	suite.Require().Equal(http.StatusOK, status)
+	suite.True(link.HandedOff)
+	pageUrl, err := url.Parse(link.URL)
+	suite.Require().NoError(err)
+	suite.NotEmpty(pageUrl.Query().Get(utils.SessionHandoffQueryParam))
+
+	// The phone opens the session and the desktop browser lost its access
+	phone := suite.newBrowser()
+	suite.Equal(http.StatusOK, suite.do(phone, http.MethodGet, pageUrl.Path, pageUrl.Query()))
+	suite.NotEqual(http.StatusForbidden, suite.do(phone, http.MethodGet, apiPath, nil))
+	suite.Equal(http.StatusForbidden, suite.do(desktop, http.MethodGet, apiPath, nil))
+	status, _ = suite.createMobileLink(desktop, sessionID, nil)
+	suite.Equal(http.StatusForbidden, status)
+
+	// Handing off again from the phone invalidates the first mobile link
+	status, _ = suite.createMobileLink(phone, sessionID, nil)
+	suite.Require().Equal(http.StatusOK, status)
+	suite.Equal(http.StatusForbidden, suite.do(suite.newBrowser(), http.MethodGet, pageUrl.Path, pageUrl.Query()))
+}
+
+func TestSessionAccessTestSuite(t *testing.T) {
+	suite.Run(t, new(SessionAccessTestSuite))
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type SubmitSignatureRequest struct {
+	// Signature is the personal_sign signature of the message of the signature request
+	Signature string `json:"signature"`
+}
+
+// handleSubmitSignature verifies the signature of the message requested by request_signature. The signer is
+// recovered server-side, so the session is only confirmed by a wallet controlling the requested address.
+func (s *APIServer) handleSubmitSignature(c *fiber.Ctx) error {
+	body := SubmitSignatureRequest{}
+	if err := c.BodyParser(&body); err != nil {
+		log.Printf("Error parsing body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	sessionID := c.Params("session_id")
+	session, err := s.txService.GetTransactionSession(sessionID)
+	if err != nil {
+		log.Printf("Error getting session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Session not found",
+		})
+	}
+
+	request := session.SignatureRequest
+	if request == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Session does not request a signature",
+		})
+	}
+	if request.Signer != "" {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Message was already signed",
+		})
+	}
+
+	signer, err := utils.RecoverPersonalSignatureAddress(request.Message, body.Signature)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Invalid signature: %v", err),
+		})
+	}
+	if request.Address != "" && !strings.EqualFold(signer, request.Address) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("Message was signed by %s, not by the requested address %s", signer, request.Address),
+		})
+	}
+
+	signedAt := time.Now()
+	request.Signer = signer
+	request.Signature = body.Signature
+	request.SignedAt = &signedAt
+	session.TransactionStatus = models.TransactionStatusConfirmed
+	if err := s.txService.UpdateTransactionSession(sessionID, session); err != nil {
+		log.Printf("Error updating session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to save signature",
+		})
+	}
+
+	return c.JSON(request)
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const signatureTestPrivateKey = "4f3edf983ac636a65a842ce7c78d9aa706d3b113bce9c46f30d7d21715b23b1d"
+
+type SignatureHandlerTestSuite struct {
+	suite.Suite
+	db           services.DBService
+	apiServer    *APIServer
+	serverPort   int
+	txService    services.TransactionService
+	chainService services.ChainService
+	// signer is the address of signatureTestPrivateKey
+	signer string
+}
+
+func (suite *SignatureHandlerTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", "test-secret")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+
+	key, err := crypto.HexToECDSA(signatureTestPrivateKey)
+	suite.Require().NoError(err)
+	suite.signer = crypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	err = suite.chainService.CreateChain(&models.Chain{
+		ChainType: models.TransactionChainTypeEthereum,
+		RPC:       "http://localhost:8545",
+		NetworkID: "1",
+		Name:      "Ethereum Mainnet",
+	})
+	suite.Require().NoError(err)
+
+	apiServer := NewAPIServer(db, suite.txService, services.NewHookService(), suite.chainService, services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *SignatureHandlerTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *SignatureHandlerTestSuite) createSession(address string) (string, *models.SignatureRequest) {
+	chain, err := suite.chainService.GetChainByType(string(models.TransactionChainTypeEthereum))
+	suite.Require().NoError(err)
+
+	request := &models.SignatureRequest{
+		Message: utils.GenerateOwnershipMessage("", address, "0123456789abcdef", time.Now()),
+		Nonce:   "0123456789abcdef",
+		Address: address,
+	}
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		ChainType:        models.TransactionChainTypeEthereum,
+		ChainID:          chain.ID,
+		SignatureRequest: request,
+	})
+	suite.Require().NoError(err)
+	return sessionID, request
+}
+
+func (suite *SignatureHandlerTestSuite) submit(sessionID, signature string) (int, map[string]interface{}) {
+	payload, err := json.Marshal(SubmitSignatureRequest{Signature: signature})
+	suite.Require().NoError(err)
+
+	resp, err := http.Post(fmt.Sprintf("http://localhost:%d/api/tx/%s/signature", suite.serverPort, sessionID), "application/json", bytes.NewReader(payload))
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var result map[string]interface{}
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&result))
+	return resp.StatusCode, result
+}
+
+func (suite *SignatureHandlerTestSuite) TestSubmitSignature() {
+	sessionID, request := suite.createSession(suite.signer)
+	signature, err := utils.PersonalSignFromHex(request.Message, signatureTestPrivateKey)
+	suite.Require().NoError(err)
+
+	status, result := suite.submit(sessionID, signature)
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(suite.signer, result["signer"])
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Equal(models.TransactionStatusConfirmed, session.TransactionStatus)
+	suite.Equal(suite.signer, session.SignatureRequest.Signer)
+	suite.NotNil(session.SignatureRequest.SignedAt)
+
+	// the nonce is single use
+	status, _ = suite.submit(sessionID, signature)
+	suite.Equal(http.StatusConflict, status)
+}
+
+func (suite *SignatureHandlerTestSuite) TestSubmitRejectsOtherSigner() {
+	sessionID, request := suite.createSession("0x1111111111111111111111111111111111111111")
+	signature, err := utils.PersonalSignFromHex(request.Message, signatureTestPrivateKey)
+	suite.Require().NoError(err)
+
+	status, result := suite.submit(sessionID, signature)
+	suite.Equal(http.StatusBadRequest, status)
+	suite.Contains(result["error"], suite.signer)
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Equal(models.TransactionStatusPending, session.TransactionStatus)
+	suite.Empty(session.SignatureRequest.Signer)
+}
+
+func (suite *SignatureHandlerTestSuite) TestSubmitRejectsSignatureOfAnotherMessage() {
+	sessionID, _ := suite.createSession(suite.signer)
+	signature, err := utils.PersonalSignFromHex("I am signing into Launchpad at 0", signatureTestPrivateKey)
+	suite.Require().NoError(err)
+
+	// a signature of another message recovers to another address
+	status, _ := suite.submit(sessionID, signature)
+	suite.Equal(http.StatusBadRequest, status)
+	status, _ = suite.submit(sessionID, "0x1234")
+	suite.Equal(http.StatusBadRequest, status)
+}
+
+func (suite *SignatureHandlerTestSuite) TestSubmitWithoutAddress() {
+	sessionID, request := suite.createSession("")
+	signature, err := utils.PersonalSignFromHex(request.Message, signatureTestPrivateKey)
+	suite.Require().NoError(err)
+
+	// any wallet may sign, the recovered signer is reported
+	status, result := suite.submit(sessionID, signature)
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(suite.signer, result["signer"])
+}
+
+func (suite *SignatureHandlerTestSuite) TestSubmitRejectsTransactionSession() {
+	chain, err := suite.chainService.GetChainByType(string(models.TransactionChainTypeEthereum))
+	suite.Require().NoError(err)
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Title: "Transfer", Data: "0x", Value: "1", Receiver: suite.signer, TransactionType: models.TransactionTypeRegular}},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+	})
+	suite.Require().NoError(err)
+
+	status, result := suite.submit(sessionID, "0x1234")
+	suite.Equal(http.StatusBadRequest, status)
+	suite.Contains(result["error"], "does not request a signature")
+}
+
+func TestSignatureHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SignatureHandlerTestSuite))
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/acme/autocert"
+)
+
+const (
+	// EnvTLSCertFile is the certificate the server listens with over TLS, plain HTTP when unset
+	EnvTLSCertFile = "LAUNCHPAD_TLS_CERT_FILE"
+	// EnvTLSKeyFile is the private key of LAUNCHPAD_TLS_CERT_FILE
+	EnvTLSKeyFile = "LAUNCHPAD_TLS_KEY_FILE"
+	// EnvTLSClientCAFile is the CA bundle the client certificates of the mtls authenticator are verified against
+	EnvTLSClientCAFile = "LAUNCHPAD_TLS_CLIENT_CA_FILE"
+	// EnvACMEDomains are the comma separated domains the certificates are provisioned for with ACME (Let's Encrypt)
+	EnvACMEDomains = "LAUNCHPAD_ACME_DOMAINS"
+	// EnvACMEEmail is the contact email of the ACME account, optional
+	EnvACMEEmail = "LAUNCHPAD_ACME_EMAIL"
+	// EnvACMECacheDir overrides DefaultACMECacheDir
+	EnvACMECacheDir = "LAUNCHPAD_ACME_CACHE_DIR"
+	// EnvACMEHTTPAddr is the address answering the ACME HTTP-01 challenges and redirecting HTTP to HTTPS, e.g. :80.
+	// Unset only answers the TLS-ALPN-01 challenges, which require the server to listen on port 443
+	EnvACMEHTTPAddr = "LAUNCHPAD_ACME_HTTP_ADDR"
+	// DefaultACMECacheDir is the directory the provisioned certificates and the ACME account key are stored in
+	DefaultACMECacheDir = "acme-certs"
+)
+
+// TLSOptions configures the TLS listener of the API server: a certificate and key, or the domains of the
+// certificates provisioned with ACME. Both empty serves plain HTTP
+type TLSOptions struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+	ACMEDomains  []string
+	ACMEEmail    string
+	ACMECacheDir string
+	ACMEHTTPAddr string
+}
+
+// TLSOptionsFromEnv returns the options of the LAUNCHPAD_TLS_* and LAUNCHPAD_ACME_* variables
+func TLSOptionsFromEnv() TLSOptions {
+	return TLSOptions{
+		CertFile:     os.Getenv(EnvTLSCertFile),
+		KeyFile:      os.Getenv(EnvTLSKeyFile),
+		ClientCAFile: os.Getenv(EnvTLSClientCAFile),
+		ACMEDomains:  ParseACMEDomains(os.Getenv(EnvACMEDomains)),
+		ACMEEmail:    os.Getenv(EnvACMEEmail),
+		ACMECacheDir: os.Getenv(EnvACMECacheDir),
+		ACMEHTTPAddr: os.Getenv(EnvACMEHTTPAddr),
+	}
+}
+
+// ParseACMEDomains splits a comma separated list of domains
+func ParseACMEDomains(value string) []string {
+	var domains []string
+	for _, domain := range strings.Split(value, ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+// tlsConfigFromEnv returns the TLS configuration of the LAUNCHPAD_TLS_* and LAUNCHPAD_ACME_* variables
+func tlsConfigFromEnv() (*tls.Config, *autocert.Manager, error) {
+	return newTLSConfig(TLSOptionsFromEnv())
+}
+
+// newTLSConfig returns the TLS configuration of the options, or nil when neither a certificate nor ACME domains are
+// set. With ACME domains the certificates are provisioned and renewed by the returned manager. Client certificates are
+// verified when given but not required, the signing pages and the health check stay reachable without one
+func newTLSConfig(options TLSOptions) (*tls.Config, *autocert.Manager, error) {
+	var config *tls.Config
+	var manager *autocert.Manager
+	switch {
+	case len(options.ACMEDomains) > 0:
+		if options.CertFile != "" || options.KeyFile != "" {
+			return nil, nil, fmt.Errorf("%s cannot be combined with %s and %s", EnvACMEDomains, EnvTLSCertFile, EnvTLSKeyFile)
+		}
+		cacheDir := options.ACMECacheDir
+		if cacheDir == "" {
+			cacheDir = DefaultACMECacheDir
+		}
+		manager = &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(options.ACMEDomains...),
+			Cache:      autocert.DirCache(cacheDir),
+			Email:      options.ACMEEmail,
+		}
+		config = manager.TLSConfig()
+		config.MinVersion = tls.VersionTLS12
+	case options.CertFile != "" || options.KeyFile != "":
+		certificate, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load %s and %s: %w", EnvTLSCertFile, EnvTLSKeyFile, err)
+		}
+		config = &tls.Config{Certificates: []tls.Certificate{certificate}, MinVersion: tls.VersionTLS12}
+	default:
+		return nil, nil, nil
+	}
+
+	if options.ClientCAFile != "" {
+		pem, err := os.ReadFile(options.ClientCAFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s: %w", EnvTLSClientCAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, nil, fmt.Errorf("%s has no PEM certificate", EnvTLSClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, manager, nil
+}
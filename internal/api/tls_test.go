@@ -0,0 +1,98 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key to the directory
+func writeTestCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	config, manager, err := newTLSConfig(TLSOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, config)
+	assert.Nil(t, manager)
+
+	certFile, keyFile := writeTestCertificate(t, t.TempDir())
+	config, manager, err = newTLSConfig(TLSOptions{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Len(t, config.Certificates, 1)
+	assert.Nil(t, manager)
+
+	_, _, err = newTLSConfig(TLSOptions{CertFile: certFile})
+	assert.Error(t, err)
+}
+
+func TestNewTLSConfigACME(t *testing.T) {
+	cacheDir := t.TempDir()
+	config, manager, err := newTLSConfig(TLSOptions{ACMEDomains: []string{"launchpad.example.com"}, ACMECacheDir: cacheDir})
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	require.NotNil(t, manager)
+	assert.NotNil(t, config.GetCertificate)
+	assert.Contains(t, config.NextProtos, "acme-tls/1")
+
+	// Certificates are only provisioned for the configured domains
+	require.NoError(t, manager.HostPolicy(t.Context(), "launchpad.example.com"))
+	assert.Error(t, manager.HostPolicy(t.Context(), "other.example.com"))
+
+	certFile, keyFile := writeTestCertificate(t, t.TempDir())
+	_, _, err = newTLSConfig(TLSOptions{ACMEDomains: []string{"launchpad.example.com"}, CertFile: certFile, KeyFile: keyFile})
+	assert.Error(t, err)
+}
+
+func TestTLSOptionsFromEnv(t *testing.T) {
+	t.Setenv(EnvACMEDomains, "launchpad.example.com, sign.example.com,")
+	t.Setenv(EnvACMEEmail, "ops@example.com")
+	options := TLSOptionsFromEnv()
+	assert.Equal(t, []string{"launchpad.example.com", "sign.example.com"}, options.ACMEDomains)
+	assert.Equal(t, "ops@example.com", options.ACMEEmail)
+}
+
+func TestCheckMTLSUsesTheConfiguredTLS(t *testing.T) {
+	t.Setenv(middleware.EnvAuthProviders, middleware.AuthProviderMTLS)
+	s := &APIServer{}
+	assert.ErrorContains(t, s.checkMTLS(), EnvTLSClientCAFile)
+
+	// the TLS flags of the binary are applied after the server is created
+	certFile, keyFile := writeTestCertificate(t, t.TempDir())
+	require.NoError(t, s.ConfigureTLS(TLSOptions{CertFile: certFile, KeyFile: keyFile, ClientCAFile: certFile}))
+	assert.NoError(t, s.checkMTLS())
+
+	t.Setenv(middleware.EnvAuthProviders, "api_key")
+	assert.NoError(t, (&APIServer{}).checkMTLS())
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+// tokenURLContext returns the context of the urls of the token routes, with the public url of a proxied request
+func (s *APIServer) tokenURLContext(c *fiber.Ctx) context.Context {
+	ctx := c.UserContext()
+	if baseUrl := s.requestBaseUrl(c); baseUrl != "" {
+		ctx = utils.WithRequestBaseUrl(ctx, baseUrl)
+	}
+	return ctx
+}
+
+// getTokenMetadata returns the metadata of a deployment, nil when it has none
+func (s *APIServer) getTokenMetadata(deploymentID uint) (*models.TokenMetadata, error) {
+	metadata, err := s.tokenMetadataService.GetTokenMetadata(deploymentID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return metadata, err
+}
+
+// handleTokenInfo serves the public token info of a confirmed deployment with its metadata
+func (s *APIServer) handleTokenInfo(c *fiber.Ctx) error {
+	deploymentID, err := strconv.ParseUint(c.Params("deployment_id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid deployment id",
+		})
+	}
+
+	deployment, err := s.deploymentService.GetDeploymentByID(uint(deploymentID))
+	if err != nil || deployment.Status != models.TransactionStatusConfirmed || deployment.ContractAddress == "" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Token not found",
+		})
+	}
+
+	metadata, err := s.getTokenMetadata(deployment.ID)
+	if err != nil {
+		log.Printf("Error getting token metadata of deployment %d: %v", deploymentID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to get token metadata",
+		})
+	}
+	logoURL, err := services.TokenLogoURL(s.tokenURLContext(c), s.port, metadata)
+	if err != nil {
+		log.Printf("Error getting logo url of deployment %d: %v", deploymentID, err)
+	}
+	return c.JSON(services.BuildTokenInfo(deployment, metadata, logoURL))
+}
+
+// handleTokenLogo serves the logo of a deployment from the local asset storage, or redirects to its S3 or IPFS url
+func (s *APIServer) handleTokenLogo(c *fiber.Ctx) error {
+	deploymentID, err := strconv.ParseUint(c.Params("deployment_id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid deployment id",
+		})
+	}
+
+	metadata, err := s.getTokenMetadata(uint(deploymentID))
+	if err != nil || metadata == nil || metadata.LogoKey == "" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Logo not found",
+		})
+	}
+	if metadata.LogoURL != "" {
+		return c.Redirect(metadata.LogoURL, fiber.StatusFound)
+	}
+
+	data, err := s.assetStorage.Open(c.UserContext(), metadata.LogoKey)
+	if err != nil {
+		log.Printf("Error reading logo %s of deployment %d: %v", metadata.LogoKey, deploymentID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Logo not found",
+		})
+	}
+	c.Set("Content-Type", metadata.LogoContentType)
+	// The logo is an uploaded file, it is never sniffed as another type
+	c.Set("X-Content-Type-Options", "nosniff")
+	c.Set("Cache-Control", "public, max-age=300")
+	return c.Send(data)
+}
+
+// handleTokenList serves the tokens with metadata in the token lists format, ?chain_id filters them by chain
+func (s *APIServer) handleTokenList(c *fiber.Ctx) error {
+	metadata, err := s.tokenMetadataService.ListTokenMetadata()
+	if err != nil {
+		log.Printf("Error listing token metadata: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to list tokens",
+		})
+	}
+
+	ctx := s.tokenURLContext(c)
+	chainID := c.Query("chain_id")
+	infos := make([]services.TokenInfo, 0, len(metadata))
+	for i := range metadata {
+		if chainID != "" && metadata[i].Deployment.Chain.NetworkID != chainID {
+			continue
+		}
+		logoURL, err := services.TokenLogoURL(ctx, s.port, &metadata[i])
+		if err != nil {
+			log.Printf("Error getting logo url of deployment %d: %v", metadata[i].DeploymentID, err)
+		}
+		infos = append(infos, *services.BuildTokenInfo(&metadata[i].Deployment, &metadata[i], logoURL))
+	}
+	return c.JSON(services.BuildTokenList(infos))
+}
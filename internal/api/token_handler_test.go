@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenRoutes(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	deploymentService := services.NewDeploymentService(db)
+	deployment := &models.Deployment{
+		TemplateID:      template.ID,
+		ChainID:         chain.ID,
+		ContractAddress: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		TemplateValues:  models.JSON{"TokenName": "My Token", "TokenSymbol": "MTK"},
+		Status:          models.TransactionStatusConfirmed,
+	}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	pending := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, Status: models.TransactionStatusPending}
+	require.NoError(t, deploymentService.CreateDeployment(pending))
+
+	storage := services.NewLocalAssetStorage(t.TempDir())
+	logo, err := services.NewTokenLogo(append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 8)...))
+	require.NoError(t, err)
+	key := logo.Key(deployment.ID)
+	_, err = storage.Put(context.Background(), key, logo.ContentType, logo.Data)
+	require.NoError(t, err)
+	tokenMetadataService := services.NewTokenMetadataService(db)
+	require.NoError(t, tokenMetadataService.SaveTokenMetadata(&models.TokenMetadata{
+		DeploymentID:    deployment.ID,
+		Description:     "A test token",
+		Links:           map[string]string{"website": "https://example.com"},
+		LogoKey:         key,
+		LogoContentType: logo.ContentType,
+	}))
+
+	s := &APIServer{app: fiber.New(), deploymentService: deploymentService, tokenMetadataService: tokenMetadataService, assetStorage: storage, port: 8080}
+	s.app.Get("/tokens/tokenlist.json", s.handleTokenList)
+	s.app.Get("/tokens/:deployment_id", s.handleTokenInfo)
+	s.app.Get("/tokens/:deployment_id/logo", s.handleTokenLogo)
+
+	resp, err := s.app.Test(httptest.NewRequest("GET", fmt.Sprintf("/tokens/%d", deployment.ID), nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var info services.TokenInfo
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+	assert.Equal(t, "My Token", info.Name)
+	assert.Equal(t, "MTK", info.Symbol)
+	assert.Equal(t, 18, info.Decimals)
+	assert.Equal(t, "A test token", info.Description)
+	assert.Equal(t, fmt.Sprintf("http://localhost:8080/tokens/%d/logo", deployment.ID), info.LogoURI)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", fmt.Sprintf("/tokens/%d/logo", deployment.ID), nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, logo.Data, body)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", "/tokens/tokenlist.json", nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+	var list services.TokenList
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	require.Len(t, list.Tokens, 1)
+	assert.Equal(t, 31337, list.Tokens[0].ChainID)
+	assert.Equal(t, deployment.ContractAddress, list.Tokens[0].Address)
+	assert.Equal(t, info.LogoURI, list.Tokens[0].LogoURI)
+
+	resp, err = s.app.Test(httptest.NewRequest("GET", "/tokens/tokenlist.json?chain_id=1", nil))
+	require.NoError(t, err)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	assert.Empty(t, list.Tokens)
+
+	for _, path := range []string{fmt.Sprintf("/tokens/%d", pending.ID), fmt.Sprintf("/tokens/%d/logo", pending.ID), "/tokens/999"} {
+		resp, err = s.app.Test(httptest.NewRequest("GET", path, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode, path)
+	}
+}
@@ -2,18 +2,24 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"html/template"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
 	"github.com/rxtech-lab/launchpad-mcp/internal/assets"
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
+// receiptTimeout bounds the polls of a reported transaction, covering the nodes lagging behind the RPC of the wallet
+const receiptTimeout = 6 * time.Second
+
 type RPCNetwork struct {
 	ChainID string `json:"chain_id"`
 	Name    string `json:"name"`
@@ -59,12 +65,22 @@ func (s *APIServer) renderErrorPage(c *fiber.Ctx, statusCode int, title, message
 	return c.Status(statusCode).Send(buf.Bytes())
 }
 
+// getSessionForRead reads the session from the replica, falling back to the primary
+// as the replica may not have caught up with a session that was just created
+func (s *APIServer) getSessionForRead(sessionID string) (*models.TransactionSession, error) {
+	session, err := s.readTxService.GetTransactionSession(sessionID)
+	if err == nil {
+		return session, nil
+	}
+	return s.txService.GetTransactionSession(sessionID)
+}
+
 // handleTransactionPage serves the universal transaction signing page
 func (s *APIServer) handleTransactionPage(c *fiber.Ctx) error {
 	sessionID := c.Params("session_id")
 
 	// Get the session from database
-	session, err := s.txService.GetTransactionSession(sessionID)
+	session, err := s.getSessionForRead(sessionID)
 	if err != nil {
 		log.Printf("Error getting session %s: %v", sessionID, err)
 		return s.renderErrorPage(c, fiber.StatusNotFound, "Session Not Found",
@@ -75,6 +91,10 @@ func (s *APIServer) handleTransactionPage(c *fiber.Ctx) error {
 		return s.renderErrorPage(c, fiber.StatusNotAcceptable, "Transaction Already Confirmed",
 			"This transaction has already been confirmed and completed. No further action is required.")
 	}
+	if session.TransactionStatus == models.TransactionStatusCancelled {
+		return s.renderErrorPage(c, fiber.StatusGone, "Session Cancelled",
+			"This transaction session was cancelled and can no longer be signed. Request a new session to sign these transactions.")
+	}
 
 	// Prepare template data
 	data := map[string]interface{}{
@@ -85,7 +105,10 @@ func (s *APIServer) handleTransactionPage(c *fiber.Ctx) error {
 			Rpc:     session.Chain.RPC,
 		},
 		"SigningMessage": utils.GenerateMessage(),
-		"SessionData":    session,
+		// The page is public to anyone with the url, the redacted fields are only served by the session API
+		"SessionData": redactSession(session, s.redactedFields),
+		// The events of an embedded page are only posted to these origins
+		"EmbedOrigins": s.embedOrigins,
 	}
 	// Render the template with custom functions
 	tmplBytes := assets.SigningHTML
@@ -101,10 +124,57 @@ func (s *APIServer) handleTransactionPage(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusInternalServerError).SendString("Error rendering template")
 	}
 
+	s.setFrameAncestors(c)
 	c.Set("Content-Type", "text/html; charset=utf-8")
 	return c.Send(buf.Bytes())
 }
 
+// handleGetSigningSession returns the session data of the signing page as JSON, for the clients signing the
+// session outside the browser such as launchpad-signer
+func (s *APIServer) handleGetSigningSession(c *fiber.Ctx) error {
+	sessionID := c.Params("session_id")
+
+	session, err := s.getSessionForRead(sessionID)
+	if err != nil {
+		log.Printf("Error getting session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Session not found",
+		})
+	}
+	return c.JSON(redactSession(session, s.redactedFields))
+}
+
+// handleGetTransactionSession returns the full session data including the fields redacted from the signing page.
+// With authentication enabled only the owner of the session can read it
+func (s *APIServer) handleGetTransactionSession(c *fiber.Ctx) error {
+	sessionID := c.Params("session_id")
+
+	session, err := s.getSessionForRead(sessionID)
+	if err != nil {
+		log.Printf("Error getting session %s: %v", sessionID, err)
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Session not found",
+		})
+	}
+
+	if s.authenticationEnabled {
+		user, _ := c.Locals(middleware.AuthenticatedUserContextKey).(*utils.AuthenticatedUser)
+		if user == nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Unauthorized",
+			})
+		}
+		// Sessions of other users are reported as missing
+		if session.UserID != nil && *session.UserID != user.Sub {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Session not found",
+			})
+		}
+	}
+
+	return c.JSON(session)
+}
+
 // handleTransactionAPI provides transaction data via API
 func (s *APIServer) handleTransactionAPI(c *fiber.Ctx) error {
 	sessionID := c.Params("session_id")
@@ -133,6 +203,30 @@ func (s *APIServer) handleTransactionAPI(c *fiber.Ctx) error {
 			"error": "Session not found",
 		})
 	}
+	if session.SafeProposal != nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Session was proposed to a Safe, it is completed once the Safe executes the transactions",
+		})
+	}
+	if session.TransactionStatus == models.TransactionStatusCancelled {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Session was cancelled",
+		})
+	}
+
+	// user operations are completed with the bundle transaction that included them, the contract they created is
+	// the one computed when the operation was prepared
+	if parsedIndex >= 0 && parsedIndex < len(session.TransactionDeployments) && session.TransactionDeployments[parsedIndex].UserOperation {
+		operation, err := s.userOperationService.GetLatestUserOperation(sessionID, parsedIndex)
+		if err != nil || operation.Status != models.UserOperationStatusIncluded || !strings.EqualFold(operation.TransactionHash, body.TransactionHash) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": "The user operation of the transaction was not included in this transaction",
+			})
+		}
+		if operation.ContractAddress != "" {
+			body.ContractAddress = &operation.ContractAddress
+		}
+	}
 
 	// verify the transaction hash
 	if err := s.verifyTransactionOnChain(body.TransactionHash, session.Chain); err != nil {
@@ -179,21 +273,26 @@ func (s *APIServer) handleTransactionAPI(c *fiber.Ctx) error {
 }
 
 // verifyTransactionOnChain verifies that a transaction exists and was successful on-chain
-func (s *APIServer) verifyTransactionOnChain(txHash string, chain models.Chain) error { // Get active chain configuration to get RPC URL
-	// Create RPC client
-	rpcClient := utils.NewRPCClient(chain.RPC)
-	rpcClient.SetTimeout(15 * time.Second)
+func (s *APIServer) verifyTransactionOnChain(txHash string, chain models.Chain) error {
+	adapter, err := s.chainAdapters.ForChainType(chain.ChainType)
+	if err != nil {
+		return err
+	}
 
-	// Verify transaction success
-	success, receipt, err := rpcClient.VerifyTransactionSuccess(txHash)
+	ctx, cancel := context.WithTimeout(context.Background(), receiptTimeout)
+	defer cancel()
+	receipt, err := adapter.WaitForReceipt(ctx, chain.RPC, txHash)
 	if err != nil {
 		return fmt.Errorf("failed to verify transaction: %w", err)
 	}
 
-	if !success {
+	if !receipt.Success {
+		if receipt.Error != nil {
+			return fmt.Errorf("transaction failed on-chain (status: %s, error: %v)", receipt.Status, receipt.Error)
+		}
 		return fmt.Errorf("transaction failed on-chain (status: %s)", receipt.Status)
 	}
 
-	log.Printf("Transaction %s verified successfully on chain %s (block: %s)", txHash, chain.Name, receipt.BlockNumber)
+	log.Printf("Transaction %s verified successfully on chain %s (block: %s)", txHash, chain.Name, receipt.Block)
 	return nil
 }
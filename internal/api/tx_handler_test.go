@@ -59,7 +59,7 @@ func (suite *TxHandlerTestSuite) SetupSuite() {
 	suite.deploymentService = services.NewDeploymentService(db.GetDB())
 
 	// Initialize API server
-	apiServer := NewAPIServer(db, txService, hookService, suite.chainService)
+	apiServer := NewAPIServer(db, txService, hookService, suite.chainService, suite.deploymentService, services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
 	apiServer.SetupRoutes()
 	port, err := apiServer.Start(nil) // Let it find an available port
 	suite.Require().NoError(err)
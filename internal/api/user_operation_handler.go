@@ -0,0 +1,229 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gofiber/fiber/v2"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+type PrepareUserOperationRequest struct {
+	// Owner is the connected wallet owning the smart account that sends the operation
+	Owner string `json:"owner"`
+}
+
+type SendUserOperationRequest struct {
+	// Signature is the personal_sign signature of the user operation hash by the owner
+	Signature string `json:"signature"`
+}
+
+// userOperationTransaction returns the session and the index of a transaction sent as a user operation
+func (s *APIServer) userOperationTransaction(c *fiber.Ctx) (*models.TransactionSession, int, error) {
+	parsedIndex, err := strconv.Atoi(c.Params("index"))
+	if err != nil {
+		return nil, 0, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid index",
+		})
+	}
+
+	sessionID := c.Params("session_id")
+	session, err := s.txService.GetTransactionSession(sessionID)
+	if err != nil {
+		log.Printf("Error getting session %s: %v", sessionID, err)
+		return nil, 0, c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Session not found",
+		})
+	}
+
+	if parsedIndex < 0 || parsedIndex >= len(session.TransactionDeployments) {
+		return nil, 0, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid index",
+		})
+	}
+	if !session.TransactionDeployments[parsedIndex].UserOperation {
+		return nil, 0, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction is not marked to be sent as a user operation",
+		})
+	}
+	if session.Chain.BundlerRPC == "" {
+		return nil, 0, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "No bundler configured for this chain",
+		})
+	}
+	return session, parsedIndex, nil
+}
+
+// handlePrepareUserOperation builds the user operation of a transaction of the session for the smart account of the
+// connected wallet. The signing page signs the returned user_operation_hash and sends it with handleSendUserOperation.
+func (s *APIServer) handlePrepareUserOperation(c *fiber.Ctx) error {
+	body := PrepareUserOperationRequest{}
+	if err := c.BodyParser(&body); err != nil {
+		log.Printf("Error parsing body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+	if !common.IsHexAddress(body.Owner) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "owner must be an Ethereum address",
+		})
+	}
+
+	session, parsedIndex, err := s.userOperationTransaction(c)
+	if session == nil {
+		return err
+	}
+
+	// Only prepare again once the previous operation was not sent or failed
+	previous, err := s.userOperationService.GetLatestUserOperation(session.ID, parsedIndex)
+	if err == nil && (previous.Status == models.UserOperationStatusPending || previous.Status == models.UserOperationStatusIncluded) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "Transaction was already sent as a user operation",
+		})
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("Error getting user operation of session %s: %v", session.ID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to get user operation",
+		})
+	}
+
+	operation, err := services.PrepareUserOperation(&session.Chain, session.ID, parsedIndex, session.TransactionDeployments[parsedIndex], body.Owner)
+	if err != nil {
+		log.Printf("Error preparing user operation of session %s: %v", session.ID, err)
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if err := s.userOperationService.CreateUserOperation(operation); err != nil {
+		log.Printf("Error saving user operation %s: %v", operation.UserOperationHash, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to save user operation",
+		})
+	}
+
+	return c.JSON(operation)
+}
+
+// handleSendUserOperation signs the prepared user operation of a transaction with the signature of its owner and sends
+// it to the bundler of the chain. The signing page polls handleGetUserOperation until it is bundled, then completes
+// the transaction through handleTransactionAPI with the bundle transaction hash.
+func (s *APIServer) handleSendUserOperation(c *fiber.Ctx) error {
+	body := SendUserOperationRequest{}
+	if err := c.BodyParser(&body); err != nil {
+		log.Printf("Error parsing body: %v", err)
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+	if !strings.HasPrefix(body.Signature, "0x") {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "signature must be a hex encoded signature",
+		})
+	}
+
+	session, parsedIndex, err := s.userOperationTransaction(c)
+	if session == nil {
+		return err
+	}
+
+	operation, err := s.userOperationService.GetLatestUserOperation(session.ID, parsedIndex)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User operation not found, prepare it first",
+		})
+	}
+	if operation.Status != models.UserOperationStatusPrepared {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "User operation was already sent",
+		})
+	}
+
+	signer, err := utils.RecoverUserOperationSigner(common.HexToHash(operation.UserOperationHash), body.Signature)
+	if err != nil || !strings.EqualFold(signer, operation.Owner) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "signature is not a signature of the user operation hash by the owner of the smart account",
+		})
+	}
+
+	signed := *operation.Operation
+	signed.Signature = body.Signature
+	userOperationHash, err := utils.SendUserOperation(session.Chain.BundlerRPC, &signed, operation.EntryPoint)
+	if err != nil {
+		log.Printf("Error sending user operation of session %s: %v", session.ID, err)
+		if err := s.userOperationService.UpdateUserOperationStatus(operation.ID, models.UserOperationStatusFailed, err.Error()); err != nil {
+			log.Printf("Error updating user operation %d: %v", operation.ID, err)
+		}
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if !strings.EqualFold(userOperationHash, operation.UserOperationHash) {
+		log.Printf("Bundler returned user operation hash %s for prepared operation %s", userOperationHash, operation.UserOperationHash)
+	}
+
+	if err := s.userOperationService.MarkUserOperationSent(operation.ID, &signed); err != nil {
+		log.Printf("Error updating user operation %d: %v", operation.ID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to update user operation",
+		})
+	}
+	operation.Operation = &signed
+	operation.Status = models.UserOperationStatusPending
+	return c.JSON(operation)
+}
+
+// handleGetUserOperation returns the last user operation of a transaction of the session,
+// a pending operation is refreshed from the bundler first
+func (s *APIServer) handleGetUserOperation(c *fiber.Ctx) error {
+	parsedIndex, err := strconv.Atoi(c.Params("index"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid index",
+		})
+	}
+
+	operation, err := s.userOperationService.GetLatestUserOperation(c.Params("session_id"), parsedIndex)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "User operation not found",
+		})
+	}
+	if operation.Status != models.UserOperationStatusPending || operation.Chain.BundlerRPC == "" {
+		return c.JSON(operation)
+	}
+
+	receipt, err := utils.GetUserOperationReceipt(operation.Chain.BundlerRPC, operation.UserOperationHash)
+	if err != nil {
+		// the operation stays pending and is read again on the next poll
+		log.Printf("Error getting receipt of user operation %s: %v", operation.UserOperationHash, err)
+		return c.JSON(operation)
+	}
+	if receipt == nil {
+		return c.JSON(operation)
+	}
+
+	operation.TransactionHash = receipt.TransactionHash
+	if receipt.Success {
+		operation.Status = models.UserOperationStatusIncluded
+		err = s.userOperationService.MarkUserOperationIncluded(operation.ID, receipt.TransactionHash)
+	} else {
+		operation.Status = models.UserOperationStatusFailed
+		operation.Error = "user operation reverted"
+		if receipt.Reason != "" {
+			operation.Error += ": " + receipt.Reason
+		}
+		err = s.userOperationService.UpdateUserOperationStatus(operation.ID, operation.Status, operation.Error)
+	}
+	if err != nil {
+		log.Printf("Error updating user operation %d: %v", operation.ID, err)
+	}
+	return c.JSON(operation)
+}
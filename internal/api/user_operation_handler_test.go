@@ -0,0 +1,247 @@
+package api
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	userOperationTestHash  = "0xabababababababababababababababababababababababababababababababab"
+	userOperationBundleTx  = "0xefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefefef"
+	userOperationTestOwner = "0x2222222222222222222222222222222222222222"
+)
+
+type UserOperationHandlerTestSuite struct {
+	suite.Suite
+	db                   services.DBService
+	apiServer            *APIServer
+	serverPort           int
+	txService            services.TransactionService
+	chainService         services.ChainService
+	userOperationService services.UserOperationService
+	bundler              *httptest.Server
+	// sent are the user operations received by the bundler, included makes it return their receipt
+	sent     []string
+	included bool
+}
+
+func (suite *UserOperationHandlerTestSuite) SetupSuite() {
+	suite.T().Setenv("JWT_SECRET", "test-secret")
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.userOperationService = services.NewUserOperationService(db.GetDB())
+
+	suite.bundler = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		var result any
+		switch request.Method {
+		case "eth_sendUserOperation":
+			suite.sent = append(suite.sent, string(request.Params[0]))
+			result = userOperationTestHash
+		case "eth_getUserOperationReceipt":
+			if suite.included {
+				result = map[string]any{"userOpHash": userOperationTestHash, "success": true, "receipt": map[string]any{"transactionHash": userOperationBundleTx}}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+
+	err = suite.chainService.CreateChain(&models.Chain{
+		ChainType:  models.TransactionChainTypeEthereum,
+		RPC:        "http://localhost:8545",
+		NetworkID:  "1",
+		Name:       "Ethereum Mainnet",
+		BundlerRPC: suite.bundler.URL,
+	})
+	suite.Require().NoError(err)
+
+	apiServer := NewAPIServer(db, suite.txService, services.NewHookService(), suite.chainService, services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	suite.Require().NoError(err)
+	suite.apiServer = apiServer
+	suite.serverPort = port
+
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+}
+
+func (suite *UserOperationHandlerTestSuite) TearDownSuite() {
+	if suite.apiServer != nil {
+		suite.apiServer.Shutdown()
+	}
+	if suite.bundler != nil {
+		suite.bundler.Close()
+	}
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *UserOperationHandlerTestSuite) SetupTest() {
+	suite.sent = nil
+	suite.included = false
+}
+
+func (suite *UserOperationHandlerTestSuite) createSession(userOperation bool) (string, uint) {
+	chain, err := suite.chainService.GetChainByType(string(models.TransactionChainTypeEthereum))
+	suite.Require().NoError(err)
+
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{
+			{
+				Title:           "Swap",
+				Description:     "Swap ETH for tokens",
+				Data:            "0x",
+				Value:           "0",
+				Receiver:        "0x1111111111111111111111111111111111111111",
+				TransactionType: models.TransactionTypeTokenSwap,
+				UserOperation:   userOperation,
+			},
+		},
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   chain.ID,
+	})
+	suite.Require().NoError(err)
+	return sessionID, chain.ID
+}
+
+// prepareOperation saves the operation the prepare endpoint would have built for owner
+func (suite *UserOperationHandlerTestSuite) prepareOperation(sessionID string, chainID uint, owner string) *models.UserOperation {
+	operation := &models.UserOperation{
+		SessionId:         sessionID,
+		TransactionIndex:  0,
+		ChainID:           chainID,
+		Owner:             owner,
+		Sender:            "0x3333333333333333333333333333333333333333",
+		EntryPoint:        utils.DefaultEntryPointAddress,
+		Operation:         &models.UserOperationData{Sender: "0x3333333333333333333333333333333333333333", Nonce: "0x0", InitCode: "0x", CallData: "0x", PaymasterAndData: "0x", Signature: "0x"},
+		UserOperationHash: userOperationTestHash,
+	}
+	suite.Require().NoError(suite.userOperationService.CreateUserOperation(operation))
+	return operation
+}
+
+func (suite *UserOperationHandlerTestSuite) request(method, url string, body any) (int, map[string]interface{}) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		suite.Require().NoError(err)
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("http://localhost:%d%s", suite.serverPort, url), reader)
+	suite.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var result map[string]interface{}
+	suite.Require().NoError(json.NewDecoder(resp.Body).Decode(&result))
+	return resp.StatusCode, result
+}
+
+func (suite *UserOperationHandlerTestSuite) TestSendUserOperation() {
+	key, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	sessionID, chainID := suite.createSession(true)
+	suite.prepareOperation(sessionID, chainID, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	url := fmt.Sprintf("/api/tx/%s/transaction/0/user-operation", sessionID)
+
+	// a signature by another wallet is refused before reaching the bundler
+	other, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	status, _ := suite.request(http.MethodPost, url+"/send", SendUserOperationRequest{Signature: suite.sign(other)})
+	suite.Equal(http.StatusBadRequest, status)
+	suite.Empty(suite.sent)
+
+	signature := suite.sign(key)
+	status, result := suite.request(http.MethodPost, url+"/send", SendUserOperationRequest{Signature: signature})
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(string(models.UserOperationStatusPending), result["status"])
+	suite.Require().Len(suite.sent, 1)
+	suite.Contains(suite.sent[0], signature)
+
+	// a sent operation is neither sent nor prepared again
+	status, _ = suite.request(http.MethodPost, url+"/send", SendUserOperationRequest{Signature: signature})
+	suite.Equal(http.StatusConflict, status)
+	status, _ = suite.request(http.MethodPost, url, PrepareUserOperationRequest{Owner: userOperationTestOwner})
+	suite.Equal(http.StatusConflict, status)
+
+	status, result = suite.request(http.MethodGet, url, nil)
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(string(models.UserOperationStatusPending), result["status"])
+
+	suite.included = true
+	status, result = suite.request(http.MethodGet, url, nil)
+	suite.Equal(http.StatusOK, status)
+	suite.Equal(string(models.UserOperationStatusIncluded), result["status"])
+	suite.Equal(userOperationBundleTx, result["transaction_hash"])
+
+	// the transaction is only completed with the bundle transaction that included the operation
+	status, result = suite.request(http.MethodPost, fmt.Sprintf("/api/tx/%s/transaction/0", sessionID), TransactionCompleteRequest{
+		TransactionHash: userOperationTestHash,
+		Status:          models.TransactionStatusConfirmed,
+	})
+	suite.Equal(http.StatusConflict, status)
+	suite.Contains(result["error"], "user operation")
+}
+
+func (suite *UserOperationHandlerTestSuite) TestPrepareRejectsRegularTransaction() {
+	sessionID, _ := suite.createSession(false)
+
+	status, result := suite.request(http.MethodPost, fmt.Sprintf("/api/tx/%s/transaction/0/user-operation", sessionID), PrepareUserOperationRequest{Owner: userOperationTestOwner})
+	suite.Equal(http.StatusBadRequest, status)
+	suite.Contains(result["error"], "not marked to be sent as a user operation")
+
+	status, _ = suite.request(http.MethodPost, fmt.Sprintf("/api/tx/%s/transaction/0/user-operation", sessionID), PrepareUserOperationRequest{Owner: "owner"})
+	suite.Equal(http.StatusBadRequest, status)
+}
+
+func (suite *UserOperationHandlerTestSuite) TestGetUnknownUserOperation() {
+	sessionID, _ := suite.createSession(true)
+
+	status, _ := suite.request(http.MethodGet, fmt.Sprintf("/api/tx/%s/transaction/0/user-operation", sessionID), nil)
+	suite.Equal(http.StatusNotFound, status)
+	status, _ = suite.request(http.MethodPost, fmt.Sprintf("/api/tx/%s/transaction/0/user-operation/send", sessionID), SendUserOperationRequest{Signature: "0x1234"})
+	suite.Equal(http.StatusNotFound, status)
+}
+
+// sign signs the test user operation hash like personal_sign of the signing page
+func (suite *UserOperationHandlerTestSuite) sign(key *ecdsa.PrivateKey) string {
+	signature, err := crypto.Sign(accounts.TextHash(common.HexToHash(userOperationTestHash).Bytes()), key)
+	suite.Require().NoError(err)
+	signature[64] += 27
+	return hexutil.Encode(signature)
+}
+
+func TestUserOperationHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserOperationHandlerTestSuite))
+}
@@ -11,5 +11,17 @@ var SigningAppJS []byte
 //go:embed signing_app.css
 var SigningAppCSS []byte
 
+//go:embed launchpad_embed.js
+var EmbedSDKJS []byte
+
 //go:embed error.html
 var ErrorHTML []byte
+
+//go:embed pool.html
+var PoolHTML []byte
+
+//go:embed countdown.html
+var CountdownHTML []byte
+
+//go:embed admin.html
+var AdminHTML []byte
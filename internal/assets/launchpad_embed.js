@@ -0,0 +1,87 @@
+/**
+ * Launchpad signing widget.
+ *
+ * Mounts the signing page of a transaction session in an iframe and forwards its events:
+ *
+ *   <script src="https://launchpad.example.com/static/embed/launchpad.js"></script>
+ *   <script>
+ *     const widget = LaunchpadSigning.mount("#signing", {
+ *       url: sessionUrl, // the url returned by the launchpad tools
+ *       onSessionReady: (event) => {},
+ *       onTxSigned: (event) => {},
+ *       onSessionConfirmed: (event) => {},
+ *     });
+ *     // widget.destroy() removes the iframe
+ *   </script>
+ *
+ * The origin of the dApp must be listed in LAUNCHPAD_EMBED_ALLOWED_ORIGINS of the server.
+ */
+(function (global) {
+  "use strict";
+
+  var EVENT_SOURCE = "launchpad-signing";
+  var HANDLERS = {
+    sessionReady: "onSessionReady",
+    txSigned: "onTxSigned",
+    sessionConfirmed: "onSessionConfirmed",
+  };
+
+  function mount(container, options) {
+    var element =
+      typeof container === "string"
+        ? document.querySelector(container)
+        : container;
+    if (!element) {
+      throw new Error("LaunchpadSigning: container not found");
+    }
+    if (!options || !options.url) {
+      throw new Error("LaunchpadSigning: options.url is required");
+    }
+
+    var sessionUrl = new URL(options.url, global.location.href);
+    var iframe = document.createElement("iframe");
+    iframe.src = sessionUrl.toString();
+    iframe.title = options.title || "Transaction Signing";
+    iframe.style.border = "0";
+    iframe.style.width = options.width || "100%";
+    iframe.style.height = options.height || "720px";
+    // Wallet extensions inject their providers into the frame
+    iframe.allow = "clipboard-write";
+
+    function onMessage(event) {
+      // Only the messages of this iframe from the launchpad server are trusted
+      if (
+        event.source !== iframe.contentWindow ||
+        event.origin !== sessionUrl.origin
+      ) {
+        return;
+      }
+      var data = event.data;
+      if (!data || data.source !== EVENT_SOURCE || !HANDLERS[data.type]) {
+        return;
+      }
+      var handler = options[HANDLERS[data.type]];
+      if (typeof handler === "function") {
+        handler(data.payload);
+      }
+      if (typeof options.onEvent === "function") {
+        options.onEvent(data.type, data.payload);
+      }
+    }
+
+    global.addEventListener("message", onMessage);
+    element.appendChild(iframe);
+
+    return {
+      iframe: iframe,
+      destroy: function () {
+        global.removeEventListener("message", onMessage);
+        if (iframe.parentNode) {
+          iframe.parentNode.removeChild(iframe);
+        }
+      },
+    };
+  }
+
+  global.LaunchpadSigning = { mount: mount, EVENT_SOURCE: EVENT_SOURCE };
+})(window);
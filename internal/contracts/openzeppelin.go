@@ -0,0 +1,91 @@
+package contracts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	// OpenZeppelinDefaultDir is the openzeppelin-contracts submodule used by templates without a pinned version
+	OpenZeppelinDefaultDir = "openzeppelin-contracts"
+	// openZeppelinVersionDirPrefix prefixes the submodules of the other vendored versions,
+	// e.g. openzeppelin-contracts-4.9.6
+	openZeppelinVersionDirPrefix = OpenZeppelinDefaultDir + "-"
+)
+
+// OpenZeppelinVersion is a vendored OpenZeppelin release embedded in OpenZeppelinFS
+type OpenZeppelinVersion struct {
+	Version string
+	// Dir is the directory of the release in OpenZeppelinFS
+	Dir     string
+	Default bool
+}
+
+// DefaultOpenZeppelinVersion returns the version of the default submodule read from its package.json,
+// empty when the contracts are not embedded
+func DefaultOpenZeppelinVersion() string {
+	content, err := OpenZeppelinFS.ReadFile(path.Join(OpenZeppelinDefaultDir, "package.json"))
+	if err != nil {
+		return ""
+	}
+	var pkg struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(content, &pkg); err != nil {
+		return ""
+	}
+	return pkg.Version
+}
+
+// OpenZeppelinVersions returns the embedded releases, the default first and the others sorted by version
+func OpenZeppelinVersions() []OpenZeppelinVersion {
+	var versions []OpenZeppelinVersion
+	entries, _ := fs.ReadDir(OpenZeppelinFS, ".")
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if entry.Name() == OpenZeppelinDefaultDir {
+			versions = append(versions, OpenZeppelinVersion{Version: DefaultOpenZeppelinVersion(), Dir: entry.Name(), Default: true})
+		} else if version, ok := strings.CutPrefix(entry.Name(), openZeppelinVersionDirPrefix); ok {
+			versions = append(versions, OpenZeppelinVersion{Version: version, Dir: entry.Name()})
+		}
+	}
+	slices.SortStableFunc(versions, func(a, b OpenZeppelinVersion) int {
+		if a.Default != b.Default {
+			if a.Default {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Version, b.Version)
+	})
+	return versions
+}
+
+// ResolveOpenZeppelinVersion returns the embedded release of version, the default release when version is empty
+func ResolveOpenZeppelinVersion(version string) (OpenZeppelinVersion, error) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" {
+		return OpenZeppelinVersion{Version: DefaultOpenZeppelinVersion(), Dir: OpenZeppelinDefaultDir, Default: true}, nil
+	}
+
+	versions := OpenZeppelinVersions()
+	var available []string
+	for _, v := range versions {
+		if v.Version == version {
+			return v, nil
+		}
+		if v.Version != "" {
+			available = append(available, v.Version)
+		}
+	}
+	if len(available) == 0 {
+		return OpenZeppelinVersion{}, fmt.Errorf("OpenZeppelin %s is not vendored, no OpenZeppelin contracts are embedded (run make generate)", version)
+	}
+	return OpenZeppelinVersion{}, fmt.Errorf("OpenZeppelin %s is not vendored, available versions: %s", version, strings.Join(available, ", "))
+}
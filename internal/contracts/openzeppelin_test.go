@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveOpenZeppelinVersion(t *testing.T) {
+	release, err := ResolveOpenZeppelinVersion("")
+	require.NoError(t, err)
+	assert.True(t, release.Default)
+	assert.Equal(t, OpenZeppelinDefaultDir, release.Dir)
+
+	for _, version := range OpenZeppelinVersions() {
+		if version.Version == "" {
+			continue
+		}
+		resolved, err := ResolveOpenZeppelinVersion("v" + version.Version)
+		require.NoError(t, err)
+		assert.Equal(t, version.Dir, resolved.Dir)
+	}
+
+	_, err = ResolveOpenZeppelinVersion("0.0.1")
+	assert.ErrorContains(t, err, "OpenZeppelin 0.0.1 is not vendored")
+}
@@ -0,0 +1,30 @@
+package hooks
+
+import (
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type AddressListHook struct {
+	addressListService services.AddressListService
+}
+
+// CanHandle implements Hook.
+func (a *AddressListHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeAddressListUpdate
+}
+
+// OnTransactionConfirmed implements Hook.
+// An update session may hold one transaction per address, the lists are only recorded once all of them are confirmed.
+func (a *AddressListHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	if session.TransactionStatus != models.TransactionStatusConfirmed {
+		return nil
+	}
+	return a.addressListService.ApplySessionChanges(session.ID, txHash)
+}
+
+func NewAddressListHook(addressListService services.AddressListService) services.Hook {
+	return &AddressListHook{
+		addressListService: addressListService,
+	}
+}
@@ -0,0 +1,49 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type BondingCurveHook struct {
+	deploymentService   services.DeploymentService
+	bondingCurveService services.BondingCurveService
+}
+
+// CanHandle implements Hook.
+func (b *BondingCurveHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeBondingCurveDeployment
+}
+
+// OnTransactionConfirmed implements Hook.
+// The token is the curve, once deployed the curve is active and its state is tracked by the BondingCurveMonitor
+func (b *BondingCurveHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	curve, err := b.bondingCurveService.GetBondingCurveBySessionId(session.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get bonding curve of session %s: %w", session.ID, err)
+	}
+
+	if contractAddress == nil || *contractAddress == "" {
+		if err := b.deploymentService.UpdateDeploymentStatus(curve.DeploymentID, models.TransactionStatusFailed, ""); err != nil {
+			return err
+		}
+		if err := b.bondingCurveService.UpdateBondingCurveStatus(curve.ID, models.BondingCurveStatusFailed); err != nil {
+			return err
+		}
+		return fmt.Errorf("bonding curve %d deployment %s has no contract address", curve.ID, txHash)
+	}
+
+	if err := b.deploymentService.UpdateDeploymentStatus(curve.DeploymentID, models.TransactionStatusConfirmed, *contractAddress); err != nil {
+		return err
+	}
+	return b.bondingCurveService.MarkBondingCurveDeployed(curve.ID, *contractAddress)
+}
+
+func NewBondingCurveHook(deploymentService services.DeploymentService, bondingCurveService services.BondingCurveService) services.Hook {
+	return &BondingCurveHook{
+		deploymentService:   deploymentService,
+		bondingCurveService: bondingCurveService,
+	}
+}
@@ -0,0 +1,70 @@
+package hooks
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBondingCurveHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	bondingCurveService := services.NewBondingCurveService(db.GetDB())
+	hook := NewBondingCurveHook(deploymentService, bondingCurveService)
+
+	assert.True(t, hook.CanHandle(models.TransactionTypeBondingCurveDeployment))
+	assert.False(t, hook.CanHandle(models.TransactionTypeTokenDeployment))
+
+	newCurve := func(sessionID string) *models.BondingCurve {
+		token := &models.Deployment{ChainID: 1, TemplateID: 1, Status: models.TransactionStatusPending, SessionId: sessionID}
+		require.NoError(t, deploymentService.CreateDeployment(token))
+		curve := &models.BondingCurve{
+			DeploymentID:        token.ID,
+			ChainID:             1,
+			DeployerAddress:     "0x01",
+			RouterAddress:       "0x02",
+			TotalSupply:         "1000",
+			CurveSupply:         "800",
+			GraduationThreshold: "600",
+			VirtualEthReserve:   "200",
+			VirtualTokenReserve: "1066",
+			SessionId:           sessionID,
+		}
+		require.NoError(t, bondingCurveService.CreateBondingCurve(curve))
+		return curve
+	}
+
+	t.Run("the deployed curve is active", func(t *testing.T) {
+		curve := newCurve("session-1")
+		address := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeBondingCurveDeployment, "0x01", &address, models.TransactionSession{ID: "session-1"}))
+
+		curve, err := bondingCurveService.GetBondingCurve(curve.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.BondingCurveStatusActive, curve.Status)
+		assert.Equal(t, address, curve.ContractAddress)
+		token, err := deploymentService.GetDeploymentByID(curve.DeploymentID)
+		require.NoError(t, err)
+		assert.Equal(t, models.TransactionStatusConfirmed, token.Status)
+		assert.Equal(t, address, token.ContractAddress)
+	})
+
+	t.Run("a deployment without contract address fails the curve", func(t *testing.T) {
+		curve := newCurve("session-2")
+		require.Error(t, hook.OnTransactionConfirmed(models.TransactionTypeBondingCurveDeployment, "0x02", nil, models.TransactionSession{ID: "session-2"}))
+
+		curve, err := bondingCurveService.GetBondingCurve(curve.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.BondingCurveStatusFailed, curve.Status)
+	})
+
+	t.Run("a session without curve is an error", func(t *testing.T) {
+		address := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+		assert.Error(t, hook.OnTransactionConfirmed(models.TransactionTypeBondingCurveDeployment, "0x03", &address, models.TransactionSession{ID: "unknown"}))
+	})
+}
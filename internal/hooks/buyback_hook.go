@@ -0,0 +1,52 @@
+package hooks
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+type BuybackHook struct {
+	buybackService services.BuybackService
+}
+
+// CanHandle implements Hook.
+func (b *BuybackHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeTokenSwap
+}
+
+// OnTransactionConfirmed implements Hook.
+// The burned amount is read from the Transfer events of the receipt, the swap output can be lower than quoted.
+func (b *BuybackHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	// Most swaps are not created by a buyback
+	run, err := b.buybackService.GetBuybackRunBySessionId(session.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	buyback, err := b.buybackService.GetBuyback(run.BuybackID)
+	if err != nil {
+		return fmt.Errorf("failed to get buyback %d: %w", run.BuybackID, err)
+	}
+
+	receipt, err := utils.NewRPCClient(session.Chain.RPC).GetTransactionReceipt(txHash)
+	if err != nil {
+		return fmt.Errorf("failed to get receipt of buyback swap %s: %w", txHash, err)
+	}
+
+	burned := utils.BurnedAmount(receipt, buyback.TokenAddress)
+	return b.buybackService.ConfirmBuybackRun(run.ID, txHash, burned.String())
+}
+
+func NewBuybackHook(buybackService services.BuybackService) services.Hook {
+	return &BuybackHook{
+		buybackService: buybackService,
+	}
+}
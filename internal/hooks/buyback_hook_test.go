@@ -0,0 +1,70 @@
+package hooks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuybackHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	buybackService := services.NewBuybackService(db.GetDB())
+	hook := NewBuybackHook(buybackService)
+
+	token := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result": map[string]any{
+				"status": "0x1",
+				"logs": []map[string]any{{
+					"address": token,
+					"topics": []string{
+						"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+						"0x000000000000000000000000e7f1725e7734ce288f8367e1bb143e90bb3f0512",
+						"0x000000000000000000000000000000000000000000000000000000000000dead",
+					},
+					"data": "0x00000000000000000000000000000000000000000000000000000000000003e8",
+				}},
+			},
+		})
+	}))
+	t.Cleanup(rpc.Close)
+
+	buyback := &models.Buyback{
+		ChainID:           1,
+		DeploymentID:      1,
+		TokenAddress:      token,
+		TreasuryAddress:   "0x02",
+		AmountPerRun:      "100",
+		SlippageTolerance: "1",
+		Schedule:          "@daily",
+		IntervalSeconds:   86400,
+		NextRunAt:         time.Now(),
+	}
+	require.NoError(t, buybackService.CreateBuyback(buyback))
+	require.NoError(t, buybackService.RecordBuybackRun(buyback, &models.BuybackRun{SessionId: "session-1", Status: models.BuybackRunStatusPending, EthSpent: "100"}))
+
+	session := models.TransactionSession{ID: "session-1", Chain: models.Chain{RPC: rpc.URL}}
+	require.True(t, hook.CanHandle(models.TransactionTypeTokenSwap))
+	require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeTokenSwap, "0xabc", nil, session))
+
+	run, err := buybackService.GetBuybackRunBySessionId("session-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.BuybackRunStatusConfirmed, run.Status)
+	assert.Equal(t, "1000", run.TokensBurned)
+	assert.Equal(t, "0xabc", run.TransactionHash)
+
+	// Swaps not created by a buyback are ignored
+	assert.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeTokenSwap, "0xdef", nil, models.TransactionSession{ID: "other"}))
+}
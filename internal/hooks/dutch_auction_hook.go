@@ -0,0 +1,58 @@
+package hooks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type DutchAuctionHook struct {
+	deploymentService   services.DeploymentService
+	dutchAuctionService services.DutchAuctionService
+}
+
+// CanHandle implements Hook.
+func (d *DutchAuctionHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeDutchAuctionDeployment || txType == models.TransactionTypeDutchAuctionSettlement
+}
+
+// OnTransactionConfirmed implements Hook.
+// A deployed auction is tracked by the DutchAuctionMonitor until its settlement is confirmed
+func (d *DutchAuctionHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	if txType == models.TransactionTypeDutchAuctionSettlement {
+		auction, err := d.dutchAuctionService.GetDutchAuctionBySettleSessionId(session.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get Dutch auction settled by session %s: %w", session.ID, err)
+		}
+		return d.dutchAuctionService.MarkDutchAuctionSettled(auction.ID, time.Now())
+	}
+
+	auction, err := d.dutchAuctionService.GetDutchAuctionBySessionId(session.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get Dutch auction of session %s: %w", session.ID, err)
+	}
+
+	if contractAddress == nil || *contractAddress == "" {
+		if err := d.deploymentService.UpdateDeploymentStatus(auction.DeploymentID, models.TransactionStatusFailed, ""); err != nil {
+			return err
+		}
+		if err := d.dutchAuctionService.UpdateDutchAuctionStatus(auction.ID, models.DutchAuctionStatusFailed); err != nil {
+			return err
+		}
+		return fmt.Errorf("dutch auction %d deployment %s has no contract address", auction.ID, txHash)
+	}
+
+	if err := d.deploymentService.UpdateDeploymentStatus(auction.DeploymentID, models.TransactionStatusConfirmed, *contractAddress); err != nil {
+		return err
+	}
+	return d.dutchAuctionService.MarkDutchAuctionDeployed(auction.ID, *contractAddress)
+}
+
+func NewDutchAuctionHook(deploymentService services.DeploymentService, dutchAuctionService services.DutchAuctionService) services.Hook {
+	return &DutchAuctionHook{
+		deploymentService:   deploymentService,
+		dutchAuctionService: dutchAuctionService,
+	}
+}
@@ -0,0 +1,79 @@
+package hooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutchAuctionHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	dutchAuctionService := services.NewDutchAuctionService(db.GetDB())
+	hook := NewDutchAuctionHook(deploymentService, dutchAuctionService)
+
+	assert.True(t, hook.CanHandle(models.TransactionTypeDutchAuctionDeployment))
+	assert.True(t, hook.CanHandle(models.TransactionTypeDutchAuctionSettlement))
+	assert.False(t, hook.CanHandle(models.TransactionTypeBondingCurveDeployment))
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newAuction := func(sessionID string) *models.DutchAuction {
+		token := &models.Deployment{ChainID: 1, TemplateID: 1, Status: models.TransactionStatusPending, SessionId: sessionID}
+		require.NoError(t, deploymentService.CreateDeployment(token))
+		auction := &models.DutchAuction{
+			DeploymentID:    token.ID,
+			ChainID:         1,
+			DeployerAddress: "0x01",
+			RouterAddress:   "0x02",
+			TotalSupply:     "1000",
+			AuctionSupply:   "500",
+			StartPrice:      "1000",
+			EndPrice:        "100",
+			StartTime:       start,
+			EndTime:         start.Add(time.Hour),
+			SessionId:       sessionID,
+		}
+		require.NoError(t, dutchAuctionService.CreateDutchAuction(auction))
+		return auction
+	}
+
+	t.Run("the deployed auction is active and settled by its settle session", func(t *testing.T) {
+		auction := newAuction("session-1")
+		address := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeDutchAuctionDeployment, "0x01", &address, models.TransactionSession{ID: "session-1"}))
+
+		auction, err := dutchAuctionService.GetDutchAuction(auction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.DutchAuctionStatusActive, auction.Status)
+		assert.Equal(t, address, auction.ContractAddress)
+		token, err := deploymentService.GetDeploymentByID(auction.DeploymentID)
+		require.NoError(t, err)
+		assert.Equal(t, models.TransactionStatusConfirmed, token.Status)
+
+		require.NoError(t, dutchAuctionService.SetSettleSession(auction.ID, "settle-1"))
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeDutchAuctionSettlement, "0x02", nil, models.TransactionSession{ID: "settle-1"}))
+		auction, err = dutchAuctionService.GetDutchAuction(auction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.DutchAuctionStatusSettled, auction.Status)
+		assert.NotNil(t, auction.SettledAt)
+	})
+
+	t.Run("a deployment without contract address fails the auction", func(t *testing.T) {
+		auction := newAuction("session-2")
+		require.Error(t, hook.OnTransactionConfirmed(models.TransactionTypeDutchAuctionDeployment, "0x03", nil, models.TransactionSession{ID: "session-2"}))
+
+		auction, err := dutchAuctionService.GetDutchAuction(auction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.DutchAuctionStatusFailed, auction.Status)
+	})
+
+	t.Run("an unknown settle session is an error", func(t *testing.T) {
+		assert.Error(t, hook.OnTransactionConfirmed(models.TransactionTypeDutchAuctionSettlement, "0x04", nil, models.TransactionSession{ID: "unknown"}))
+	})
+}
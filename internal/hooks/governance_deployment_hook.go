@@ -0,0 +1,70 @@
+package hooks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type GovernanceDeploymentHook struct {
+	deploymentService services.DeploymentService
+}
+
+// CanHandle implements Hook.
+func (g *GovernanceDeploymentHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeGovernanceDeployment
+}
+
+// OnTransactionConfirmed implements Hook.
+// A governance session deploys the timelock and the Governor at addresses predicted from the nonce of the deployer,
+// the role setup transactions target them. The deployed contract is matched with its deployment record by address,
+// a contract at another address means the session was signed from another account and both records are failed.
+func (g *GovernanceDeploymentHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	metadata := map[string]string{}
+	for _, entry := range session.Metadata {
+		metadata[entry.Key] = entry.Value
+	}
+	contracts := []struct {
+		idKey      string
+		addressKey string
+	}{
+		{utils.GovernanceTimelockDeploymentIDKey, utils.GovernanceTimelockAddressKey},
+		{utils.GovernanceGovernorDeploymentIDKey, utils.GovernanceGovernorAddressKey},
+	}
+
+	var address string
+	if contractAddress != nil {
+		address = *contractAddress
+	}
+	for _, contract := range contracts {
+		if address == "" || !strings.EqualFold(metadata[contract.addressKey], address) {
+			continue
+		}
+		id, err := strconv.ParseUint(metadata[contract.idKey], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid %s of session %s: %w", contract.idKey, session.ID, err)
+		}
+		return g.deploymentService.UpdateDeploymentStatus(uint(id), models.TransactionStatusConfirmed, address)
+	}
+
+	for _, contract := range contracts {
+		if id, err := strconv.ParseUint(metadata[contract.idKey], 10, 32); err == nil {
+			// A contract already confirmed at its predicted address keeps its status
+			if err := g.deploymentService.UpdateDeploymentStatus(uint(id), models.TransactionStatusFailed, ""); err != nil && !errors.Is(err, services.ErrVersionConflict) {
+				return err
+			}
+		}
+	}
+	return fmt.Errorf("governance contract of session %s was deployed at %q instead of a predicted address, the role setup transactions do not target it", session.ID, address)
+}
+
+func NewGovernanceDeploymentHook(deploymentService services.DeploymentService) services.Hook {
+	return &GovernanceDeploymentHook{
+		deploymentService: deploymentService,
+	}
+}
@@ -0,0 +1,75 @@
+package hooks
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceDeploymentHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	hook := NewGovernanceDeploymentHook(deploymentService)
+
+	deployer := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	timelockAddress := utils.PredictContractAddress(deployer, 0)
+	governorAddress := utils.PredictContractAddress(deployer, 1)
+	newSession := func() models.TransactionSession {
+		timelock := &models.Deployment{ChainID: 1, TemplateID: 1, Status: models.TransactionStatusPending}
+		governor := &models.Deployment{ChainID: 1, TemplateID: 2, Status: models.TransactionStatusPending}
+		require.NoError(t, deploymentService.CreateDeployment(timelock))
+		require.NoError(t, deploymentService.CreateDeployment(governor))
+		return models.TransactionSession{ID: "session-1", Metadata: []models.TransactionMetadata{
+			{Key: utils.GovernanceTimelockAddressKey, Value: timelockAddress},
+			{Key: utils.GovernanceGovernorAddressKey, Value: governorAddress},
+			{Key: utils.GovernanceTimelockDeploymentIDKey, Value: strconv.FormatUint(uint64(timelock.ID), 10)},
+			{Key: utils.GovernanceGovernorDeploymentIDKey, Value: strconv.FormatUint(uint64(governor.ID), 10)},
+		}}
+	}
+	deployment := func(t *testing.T, session models.TransactionSession, key string) *models.Deployment {
+		for _, entry := range session.Metadata {
+			if entry.Key == key {
+				id, err := strconv.ParseUint(entry.Value, 10, 32)
+				require.NoError(t, err)
+				deployment, err := deploymentService.GetDeploymentByID(uint(id))
+				require.NoError(t, err)
+				return deployment
+			}
+		}
+		t.Fatalf("missing %s", key)
+		return nil
+	}
+
+	assert.True(t, hook.CanHandle(models.TransactionTypeGovernanceDeployment))
+	assert.False(t, hook.CanHandle(models.TransactionTypeGovernanceRoleSetup))
+
+	t.Run("deployments are matched by the predicted address", func(t *testing.T) {
+		session := newSession()
+		// The receipt address is not checksummed like the metadata
+		governor := strings.ToLower(governorAddress)
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeGovernanceDeployment, "0x01", &timelockAddress, session))
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeGovernanceDeployment, "0x02", &governor, session))
+
+		timelock := deployment(t, session, utils.GovernanceTimelockDeploymentIDKey)
+		assert.Equal(t, models.TransactionStatusConfirmed, timelock.Status)
+		assert.Equal(t, timelockAddress, timelock.ContractAddress)
+		assert.Equal(t, models.TransactionStatusConfirmed, deployment(t, session, utils.GovernanceGovernorDeploymentIDKey).Status)
+	})
+
+	t.Run("a contract at another address fails both deployments", func(t *testing.T) {
+		session := newSession()
+		other := utils.PredictContractAddress(deployer, 5)
+		err := hook.OnTransactionConfirmed(models.TransactionTypeGovernanceDeployment, "0x03", &other, session)
+		assert.ErrorContains(t, err, "predicted address")
+		assert.Equal(t, models.TransactionStatusFailed, deployment(t, session, utils.GovernanceTimelockDeploymentIDKey).Status)
+		assert.Equal(t, models.TransactionStatusFailed, deployment(t, session, utils.GovernanceGovernorDeploymentIDKey).Status)
+	})
+}
@@ -0,0 +1,40 @@
+package hooks
+
+import (
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type LaunchGroupBridgeHook struct {
+	launchGroupService services.LaunchGroupService
+}
+
+// CanHandle implements Hook.
+func (l *LaunchGroupBridgeHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeBridgeDeposit
+}
+
+// OnTransactionConfirmed implements Hook.
+// A bridge session holds one deposit per target chain, each through its own bridge contract.
+// The deposit just confirmed is the only confirmed transaction of the session whose bridge record is still pending.
+func (l *LaunchGroupBridgeHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	for _, tx := range session.TransactionDeployments {
+		if tx.TransactionType != models.TransactionTypeBridgeDeposit || tx.Status != models.TransactionStatusConfirmed {
+			continue
+		}
+		confirmed, err := l.launchGroupService.ConfirmLaunchGroupBridge(session.ID, tx.Receiver, txHash)
+		if err != nil {
+			return err
+		}
+		if confirmed {
+			return nil
+		}
+	}
+	return nil
+}
+
+func NewLaunchGroupBridgeHook(launchGroupService services.LaunchGroupService) services.Hook {
+	return &LaunchGroupBridgeHook{
+		launchGroupService: launchGroupService,
+	}
+}
@@ -0,0 +1,38 @@
+package hooks
+
+import (
+	"errors"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"gorm.io/gorm"
+)
+
+type LimitOrderHook struct {
+	limitOrderService services.LimitOrderService
+}
+
+// CanHandle implements Hook.
+func (l *LimitOrderHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeTokenSwap
+}
+
+// OnTransactionConfirmed implements Hook.
+func (l *LimitOrderHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	// Most swaps are not created by a limit order
+	order, err := l.limitOrderService.GetLimitOrderBySessionId(session.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return l.limitOrderService.UpdateLimitOrderStatus(order.ID, models.LimitOrderStatusFilled, "")
+}
+
+func NewLimitOrderHook(limitOrderService services.LimitOrderService) services.Hook {
+	return &LimitOrderHook{
+		limitOrderService: limitOrderService,
+	}
+}
@@ -2,9 +2,14 @@ package hooks
 
 import (
 	"fmt"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 	"gorm.io/gorm"
 )
 
@@ -17,7 +22,16 @@ type LiquidityPoolHook struct {
 
 // CanHandle implements Hook.
 func (l *LiquidityPoolHook) CanHandle(txType models.TransactionType) bool {
-	return txType == models.TransactionTypeLiquidityPoolCreation
+	switch txType {
+	case models.TransactionTypeLiquidityPoolCreation,
+		models.TransactionTypeAddLiquidity,
+		models.TransactionTypeRemoveLiquidity,
+		models.TransactionTypeTokenSwap,
+		models.TransactionTypeLiquidityMigration:
+		return true
+	default:
+		return false
+	}
 }
 
 // OnTransactionConfirmed implements Hook.
@@ -27,11 +41,59 @@ func (l *LiquidityPoolHook) OnTransactionConfirmed(txType models.TransactionType
 		// only handle the creation of the liquidity pool
 		// position should be fetched from the blockchain
 		return l.handleLiquidityPoolCreation(txHash, session)
+	case models.TransactionTypeAddLiquidity, models.TransactionTypeRemoveLiquidity, models.TransactionTypeTokenSwap:
+		// reserves changed, record a snapshot for the pool detail page
+		pool, err := l.findPoolForSession(session)
+		if err != nil {
+			// the transaction is not related to a pool managed by the launchpad
+			return nil
+		}
+		l.recordSnapshot(pool, txType, txHash, session)
+		return nil
+	case models.TransactionTypeLiquidityMigration:
+		return l.handleLiquidityMigration(txHash, session)
 	default:
 		return nil
 	}
 }
 
+// handleLiquidityMigration confirms the pool recorded for a pair created by migrate_liquidity, the liquidity added to
+// a pair that already existed is not tracked by the launchpad
+func (l *LiquidityPoolHook) handleLiquidityMigration(txHash string, session models.TransactionSession) error {
+	pool, err := l.liquidityService.GetLiquidityPoolBySessionId(session.ID)
+	if err != nil {
+		return nil
+	}
+
+	var factoryAddress, wethAddress, tokenAddress string
+	for _, meta := range session.Metadata {
+		switch meta.Key {
+		case "target_factory_address":
+			factoryAddress = meta.Value
+		case "target_weth_address":
+			wethAddress = meta.Value
+		case "token_address":
+			tokenAddress = meta.Value
+		}
+	}
+	if factoryAddress == "" || wethAddress == "" || tokenAddress == "" {
+		return fmt.Errorf("migration session %s has no target pair metadata", session.ID)
+	}
+
+	// the target factory is not necessarily the default deployment of the chain
+	pairAddress, err := utils.GetV2PairAddress(session.Chain.RPC, factoryAddress, tokenAddress, wethAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get pair address: %w", err)
+	}
+	if err := l.liquidityService.UpdateLiquidityPoolStatus(pool.ID, models.TransactionStatusConfirmed, pairAddress, txHash); err != nil {
+		return err
+	}
+
+	pool.PairAddress = pairAddress
+	l.recordSnapshot(pool, models.TransactionTypeLiquidityMigration, txHash, session)
+	return nil
+}
+
 // handleLiquidityPoolCreation updates the liquidity pool with the confirmed transaction details
 func (l *LiquidityPoolHook) handleLiquidityPoolCreation(txHash string, session models.TransactionSession) error {
 	// find the pool by session id
@@ -46,14 +108,101 @@ func (l *LiquidityPoolHook) handleLiquidityPoolCreation(txHash string, session m
 		return fmt.Errorf("failed to get token addresses: %w", err)
 	}
 
-	// Get pair address from Uniswap Factory contract
-	pairAddress, err := l.uniswapContractService.GetPairAddress(token0Address, token1Address, &session.Chain)
+	// Get pair address from the factory of the deployment the session added liquidity through, sessions created
+	// before it was recorded used the default deployment of the chain
+	var factoryAddress, wethAddress string
+	for _, meta := range session.Metadata {
+		switch meta.Key {
+		case services.MetadataFactoryAddress:
+			factoryAddress = meta.Value
+		case services.MetadataWETHAddress:
+			wethAddress = meta.Value
+		}
+	}
+	var pairAddress string
+	if factoryAddress != "" && wethAddress != "" {
+		pairAddress, err = utils.GetV2PairAddress(session.Chain.RPC, factoryAddress, ethToWETH(token0Address, wethAddress), ethToWETH(token1Address, wethAddress))
+	} else {
+		pairAddress, err = l.uniswapContractService.GetPairAddress(token0Address, token1Address, &session.Chain)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get pair address: %w", err)
 	}
 
 	// Update the pool with transaction hash and pair address
-	return l.liquidityService.UpdateLiquidityPoolStatus(pool.ID, models.TransactionStatusConfirmed, pairAddress, txHash)
+	if err := l.liquidityService.UpdateLiquidityPoolStatus(pool.ID, models.TransactionStatusConfirmed, pairAddress, txHash); err != nil {
+		return err
+	}
+
+	pool.PairAddress = pairAddress
+	l.recordSnapshot(pool, models.TransactionTypeLiquidityPoolCreation, txHash, session)
+	return nil
+}
+
+// ethToWETH replaces the ETH placeholder address of a pool token by the WETH address of its deployment
+func ethToWETH(tokenAddress, wethAddress string) string {
+	if strings.EqualFold(tokenAddress, services.EthTokenAddress) {
+		return wethAddress
+	}
+	return tokenAddress
+}
+
+// findPoolForSession finds the liquidity pool a transaction session operates on
+// using the pool id, the session id or the swapped token addresses, in that order
+func (l *LiquidityPoolHook) findPoolForSession(session models.TransactionSession) (*models.LiquidityPool, error) {
+	var fromToken, toToken string
+	for _, meta := range session.Metadata {
+		switch meta.Key {
+		case "pool_id":
+			if poolID, err := strconv.ParseUint(meta.Value, 10, 32); err == nil {
+				return l.liquidityService.GetLiquidityPool(uint(poolID))
+			}
+		case "from_token":
+			fromToken = meta.Value
+		case "to_token":
+			toToken = meta.Value
+		}
+	}
+
+	if pool, err := l.liquidityService.GetLiquidityPoolBySessionId(session.ID); err == nil {
+		return pool, nil
+	}
+
+	if fromToken == "" && toToken == "" {
+		return nil, fmt.Errorf("no pool found for session %s", session.ID)
+	}
+	return l.liquidityService.GetLiquidityPoolByTokenAddress(fromToken, toToken)
+}
+
+// recordSnapshot reads the current pool reserves from the pair contract and stores them as a snapshot. The pool
+// update is already saved, so a failure is logged instead of stopping the hooks running after this one
+func (l *LiquidityPoolHook) recordSnapshot(pool *models.LiquidityPool, txType models.TransactionType, txHash string, session models.TransactionSession) {
+	if pool.PairAddress == "" {
+		return
+	}
+
+	reserve0, reserve1, err := l.uniswapContractService.GetReserves(pool.PairAddress, &session.Chain)
+	if err != nil {
+		log.Printf("Failed to get reserves of pool %d after transaction %s: %v", pool.ID, txHash, err)
+		return
+	}
+
+	var price float64
+	if reserve0.Sign() > 0 {
+		price, _ = new(big.Float).Quo(new(big.Float).SetInt(reserve1), new(big.Float).SetInt(reserve0)).Float64()
+	}
+
+	if err := l.liquidityService.CreatePoolSnapshot(&models.PoolSnapshot{
+		PoolID:          pool.ID,
+		Reserve0:        reserve0.String(),
+		Reserve1:        reserve1.String(),
+		Price:           price,
+		TransactionType: txType,
+		TransactionHash: txHash,
+		SessionId:       session.ID,
+	}); err != nil {
+		log.Printf("Failed to record snapshot of pool %d after transaction %s: %v", pool.ID, txHash, err)
+	}
 }
 
 // getTokenAddressesFromSession extracts token addresses from transaction session metadata
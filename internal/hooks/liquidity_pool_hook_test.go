@@ -0,0 +1,36 @@
+package hooks
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiquidityPoolHookSnapshotFailureDoesNotFailTheHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	uniswapService := services.NewUniswapService(db.GetDB())
+	hook := NewLiquidityPoolHook(db.GetDB(), liquidityService, services.NewUniswapContractService(uniswapService), services.NewChainService(db.GetDB()))
+
+	pool := &models.LiquidityPool{TokenAddress: "0x01", PairAddress: "0x0000000000000000000000000000000000000002", Token0: "0x01", Token1: services.EthTokenAddress, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+
+	// the reserves cannot be read from the chain, the confirmation still succeeds for the hooks running after this one
+	session := models.TransactionSession{
+		ID:       "session-1",
+		Chain:    models.Chain{RPC: "http://127.0.0.1:1", NetworkID: "31337"},
+		Metadata: []models.TransactionMetadata{{Key: "pool_id", Value: strconv.FormatUint(uint64(pool.ID), 10)}},
+	}
+	require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeAddLiquidity, "0x03", nil, session))
+
+	var snapshots int64
+	require.NoError(t, db.GetDB().Model(&models.PoolSnapshot{}).Count(&snapshots).Error)
+	assert.Zero(t, snapshots)
+}
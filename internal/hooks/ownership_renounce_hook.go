@@ -0,0 +1,41 @@
+package hooks
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type OwnershipRenounceHook struct {
+	deploymentService services.DeploymentService
+}
+
+// CanHandle implements Hook.
+func (o *OwnershipRenounceHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeOwnershipRenounce
+}
+
+// OnTransactionConfirmed implements Hook.
+// The renounceOwnership session carries the deployment it renounces in its metadata.
+func (o *OwnershipRenounceHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	for _, entry := range session.Metadata {
+		if entry.Key != "deployment_id" {
+			continue
+		}
+		id, err := strconv.ParseUint(entry.Value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid deployment_id of session %s: %w", session.ID, err)
+		}
+		return o.deploymentService.RecordOwnershipRenounced(uint(id), txHash, time.Now())
+	}
+	return fmt.Errorf("ownership renounce session %s has no deployment_id", session.ID)
+}
+
+func NewOwnershipRenounceHook(deploymentService services.DeploymentService) services.Hook {
+	return &OwnershipRenounceHook{
+		deploymentService: deploymentService,
+	}
+}
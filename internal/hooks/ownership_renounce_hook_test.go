@@ -0,0 +1,43 @@
+package hooks
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnershipRenounceHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	hook := NewOwnershipRenounceHook(deploymentService)
+
+	assert.True(t, hook.CanHandle(models.TransactionTypeOwnershipRenounce))
+	assert.False(t, hook.CanHandle(models.TransactionTypeDeploymentCleanup))
+
+	deployment := &models.Deployment{ChainID: 1, TemplateID: 1, Status: models.TransactionStatusConfirmed, ContractAddress: "0x1111111111111111111111111111111111111111"}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	session := models.TransactionSession{ID: "session-1", Metadata: []models.TransactionMetadata{
+		{Key: "deployment_id", Value: strconv.FormatUint(uint64(deployment.ID), 10)},
+	}}
+
+	require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeOwnershipRenounce, "0x01", nil, session))
+	renounced, err := deploymentService.GetDeploymentByID(deployment.ID)
+	require.NoError(t, err)
+	require.NotNil(t, renounced.OwnershipRenouncedAt)
+	assert.Equal(t, "0x01", renounced.OwnershipRenounceTxHash)
+
+	// the first renouncement is kept
+	require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeOwnershipRenounce, "0x02", nil, session))
+	renounced, err = deploymentService.GetDeploymentByID(deployment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "0x01", renounced.OwnershipRenounceTxHash)
+
+	err = hook.OnTransactionConfirmed(models.TransactionTypeOwnershipRenounce, "0x03", nil, models.TransactionSession{ID: "session-2"})
+	assert.ErrorContains(t, err, "has no deployment_id")
+}
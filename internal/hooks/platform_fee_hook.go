@@ -0,0 +1,35 @@
+package hooks
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type PlatformFeeHook struct {
+	platformFeeService services.PlatformFeeService
+}
+
+// CanHandle implements Hook.
+func (p *PlatformFeeHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypePlatformFee
+}
+
+// OnTransactionConfirmed implements Hook.
+// The fees of the session whose transfer is confirmed count as revenue
+func (p *PlatformFeeHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	var recipients []string
+	for _, tx := range session.TransactionDeployments {
+		if tx.TransactionType == models.TransactionTypePlatformFee && tx.Status == models.TransactionStatusConfirmed {
+			recipients = append(recipients, tx.Receiver)
+		}
+	}
+	return p.platformFeeService.ConfirmSessionFees(session.ID, recipients, time.Now())
+}
+
+func NewPlatformFeeHook(platformFeeService services.PlatformFeeService) services.Hook {
+	return &PlatformFeeHook{
+		platformFeeService: platformFeeService,
+	}
+}
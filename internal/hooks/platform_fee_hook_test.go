@@ -0,0 +1,52 @@
+package hooks
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformFeeHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	platformFeeService := services.NewPlatformFeeService(db.GetDB())
+	hook := NewPlatformFeeHook(platformFeeService)
+
+	assert.True(t, hook.CanHandle(models.TransactionTypePlatformFee))
+	assert.False(t, hook.CanHandle(models.TransactionTypeTokenSwap))
+
+	const platform = "0x1111111111111111111111111111111111111111"
+	const referrer = "0x2222222222222222222222222222222222222222"
+	require.NoError(t, platformFeeService.RecordFees([]models.PlatformFee{
+		{SessionID: "session-1", ChainID: 1, Kind: models.PlatformFeeKindPlatform, Recipient: platform, Amount: "75", BaseValue: "10000", Status: models.TransactionStatusPending},
+		{SessionID: "session-1", ChainID: 1, Kind: models.PlatformFeeKindReferral, Recipient: referrer, Amount: "25", BaseValue: "10000", Status: models.TransactionStatusPending},
+	}))
+
+	// only the platform transfer is confirmed so far
+	session := models.TransactionSession{
+		ID: "session-1",
+		TransactionDeployments: []models.TransactionDeployment{
+			{TransactionType: models.TransactionTypeTokenSwap, Status: models.TransactionStatusConfirmed},
+			{TransactionType: models.TransactionTypePlatformFee, Receiver: platform, Status: models.TransactionStatusConfirmed},
+			{TransactionType: models.TransactionTypePlatformFee, Receiver: referrer, Status: models.TransactionStatusPending},
+		},
+	}
+	require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypePlatformFee, "0x01", nil, session))
+
+	fees, err := platformFeeService.ListSessionFees("session-1")
+	require.NoError(t, err)
+	require.Len(t, fees, 2)
+	for _, fee := range fees {
+		if fee.Recipient == platform {
+			assert.Equal(t, models.TransactionStatusConfirmed, fee.Status)
+			assert.NotNil(t, fee.ConfirmedAt)
+		} else {
+			assert.Equal(t, models.TransactionStatusPending, fee.Status)
+			assert.Nil(t, fee.ConfirmedAt)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package hooks
+
+import (
+	"strconv"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type SellTestHook struct {
+	deploymentService    services.DeploymentService
+	liquidityService     services.LiquidityService
+	tradingLaunchService services.TradingLaunchService
+}
+
+// CanHandle implements Hook.
+func (s *SellTestHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeLiquidityPoolCreation || txType == models.TransactionTypeAddLiquidity
+}
+
+// OnTransactionConfirmed implements Hook.
+// Liquidity was added, the token is queued for a simulated buy-then-sell run by the sell test monitor. Tokens waiting
+// for a trading launch cannot be bought yet, the TradingLaunchHook queues them once trading opens.
+func (s *SellTestHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	pool, err := s.findPool(session)
+	if err != nil {
+		// the transaction is not related to a pool managed by the launchpad
+		return nil
+	}
+	gated, err := s.tradingLaunchService.HasActiveTradingLaunch(session.ChainID, pool.TokenAddress)
+	if err != nil {
+		return err
+	}
+	if gated {
+		return nil
+	}
+	return s.deploymentService.RequestSellTest(session.ChainID, pool.TokenAddress)
+}
+
+// findPool finds the pool of a pool creation or add liquidity session
+func (s *SellTestHook) findPool(session models.TransactionSession) (*models.LiquidityPool, error) {
+	for _, meta := range session.Metadata {
+		if meta.Key == "pool_id" {
+			if poolID, err := strconv.ParseUint(meta.Value, 10, 32); err == nil {
+				return s.liquidityService.GetLiquidityPool(uint(poolID))
+			}
+		}
+	}
+	return s.liquidityService.GetLiquidityPoolBySessionId(session.ID)
+}
+
+func NewSellTestHook(deploymentService services.DeploymentService, liquidityService services.LiquidityService, tradingLaunchService services.TradingLaunchService) services.Hook {
+	return &SellTestHook{
+		deploymentService:    deploymentService,
+		liquidityService:     liquidityService,
+		tradingLaunchService: tradingLaunchService,
+	}
+}
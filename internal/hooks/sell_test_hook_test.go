@@ -0,0 +1,52 @@
+package hooks
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSellTestHookWaitsForTheTradingLaunch(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	tradingLaunchService := services.NewTradingLaunchService(db.GetDB())
+	uniswapContractService := services.NewUniswapContractService(services.NewUniswapService(db.GetDB()))
+	sellTestHook := NewSellTestHook(deploymentService, liquidityService, tradingLaunchService)
+	tradingLaunchHook := NewTradingLaunchHook(tradingLaunchService, liquidityService, uniswapContractService, deploymentService)
+
+	token := "0x1111111111111111111111111111111111111111"
+	deployment := &models.Deployment{ChainID: 1, TemplateID: 1, Status: models.TransactionStatusConfirmed, ContractAddress: token}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	pool := &models.LiquidityPool{TokenAddress: token, PairAddress: "0x2222222222222222222222222222222222222222", Token0: token, Token1: services.EthTokenAddress, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+	launch := &models.TradingLaunch{DeploymentID: deployment.ID, ChainID: 1, FunctionName: "enableTrading", OpensAt: time.Now(), Status: models.TradingLaunchStatusPending, SessionId: "launch-session"}
+	require.NoError(t, tradingLaunchService.CreateTradingLaunch(launch))
+
+	pending := func() []models.Deployment {
+		deployments, err := deploymentService.ListPendingSellTests()
+		require.NoError(t, err)
+		return deployments
+	}
+
+	// the token cannot be bought before trading opens, adding liquidity does not queue its sell test
+	liquiditySession := models.TransactionSession{ID: "liquidity-session", ChainID: 1, Metadata: []models.TransactionMetadata{
+		{Key: "pool_id", Value: strconv.FormatUint(uint64(pool.ID), 10)},
+	}}
+	require.NoError(t, sellTestHook.OnTransactionConfirmed(models.TransactionTypeAddLiquidity, "0x01", nil, liquiditySession))
+	assert.Empty(t, pending())
+
+	// opening trading queues it
+	launchSession := models.TransactionSession{ID: "launch-session", ChainID: 1, Chain: models.Chain{RPC: "http://127.0.0.1:1"}}
+	require.NoError(t, tradingLaunchHook.OnTransactionConfirmed(models.TransactionTypeEnableTrading, "0x02", nil, launchSession))
+	require.Len(t, pending(), 1)
+	assert.Equal(t, deployment.ID, pending()[0].ID)
+}
@@ -0,0 +1,56 @@
+package hooks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type StakingPoolHook struct {
+	deploymentService services.DeploymentService
+	stakingService    services.StakingService
+}
+
+// CanHandle implements Hook.
+func (s *StakingPoolHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeStakingPoolDeployment || txType == models.TransactionTypeStakingRewardFunding
+}
+
+// OnTransactionConfirmed implements Hook.
+// The farm is deployed at the address predicted from the nonce of the deployer, the approve and notifyRewardAmount
+// transactions of the session target it. A farm at another address fails the pool, its funding would go elsewhere.
+func (s *StakingPoolHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	pool, err := s.stakingService.GetStakingPoolBySessionId(session.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get staking pool of session %s: %w", session.ID, err)
+	}
+
+	if txType == models.TransactionTypeStakingRewardFunding {
+		return s.stakingService.StartRewardPeriod(pool.ID, txHash, time.Now())
+	}
+
+	if contractAddress == nil || !strings.EqualFold(*contractAddress, pool.ContractAddress) {
+		if err := s.deploymentService.UpdateDeploymentStatus(pool.DeploymentID, models.TransactionStatusFailed, ""); err != nil {
+			return err
+		}
+		if err := s.stakingService.UpdateStakingPoolStatus(pool.ID, models.StakingPoolStatusFailed); err != nil {
+			return err
+		}
+		return fmt.Errorf("staking pool %d was deployed at another address than the predicted %s", pool.ID, pool.ContractAddress)
+	}
+
+	if err := s.deploymentService.UpdateDeploymentStatus(pool.DeploymentID, models.TransactionStatusConfirmed, *contractAddress); err != nil {
+		return err
+	}
+	return s.stakingService.MarkStakingPoolDeployed(pool.ID, *contractAddress)
+}
+
+func NewStakingPoolHook(deploymentService services.DeploymentService, stakingService services.StakingService) services.Hook {
+	return &StakingPoolHook{
+		deploymentService: deploymentService,
+		stakingService:    stakingService,
+	}
+}
@@ -0,0 +1,69 @@
+package hooks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakingPoolHook(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	stakingService := services.NewStakingService(db.GetDB())
+	hook := NewStakingPoolHook(deploymentService, stakingService)
+
+	farmAddress := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	newPool := func(sessionID string) *models.StakingPool {
+		farm := &models.Deployment{ChainID: 1, TemplateID: 1, Status: models.TransactionStatusPending, SessionId: sessionID}
+		require.NoError(t, deploymentService.CreateDeployment(farm))
+		pool := &models.StakingPool{
+			DeploymentID:    farm.ID,
+			ChainID:         1,
+			StakingToken:    "0x01",
+			RewardToken:     "0x01",
+			ContractAddress: farmAddress,
+			DeployerAddress: "0x02",
+			RewardAmount:    "1000",
+			RewardsDuration: 100,
+			SessionId:       sessionID,
+		}
+		require.NoError(t, stakingService.CreateStakingPool(pool))
+		return pool
+	}
+
+	t.Run("the farm is deployed and funded", func(t *testing.T) {
+		pool := newPool("session-1")
+		session := models.TransactionSession{ID: "session-1"}
+		address := strings.ToLower(farmAddress)
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeStakingPoolDeployment, "0x01", &address, session))
+		pool, err := stakingService.GetStakingPool(pool.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StakingPoolStatusDeployed, pool.Status)
+		farm, err := deploymentService.GetDeploymentByID(pool.DeploymentID)
+		require.NoError(t, err)
+		assert.Equal(t, models.TransactionStatusConfirmed, farm.Status)
+
+		require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeStakingRewardFunding, "0x03", nil, session))
+		pool, err = stakingService.GetStakingPool(pool.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StakingPoolStatusActive, pool.Status)
+		assert.Equal(t, "0x03", pool.FundingTransactionHash)
+		require.NotNil(t, pool.PeriodFinish)
+	})
+
+	t.Run("a farm at another address fails the pool", func(t *testing.T) {
+		pool := newPool("session-2")
+		other := "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"
+		err := hook.OnTransactionConfirmed(models.TransactionTypeStakingPoolDeployment, "0x04", &other, models.TransactionSession{ID: "session-2"})
+		assert.ErrorContains(t, err, "predicted")
+		pool, err = stakingService.GetStakingPool(pool.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StakingPoolStatusFailed, pool.Status)
+	})
+}
@@ -1,8 +1,13 @@
 package hooks
 
 import (
+	"log"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
 type TokenDeploymentHook struct {
@@ -12,7 +17,8 @@ type TokenDeploymentHook struct {
 // CanHandle implements Hook.
 func (t *TokenDeploymentHook) CanHandle(txType models.TransactionType) bool {
 	return txType == models.TransactionTypeTokenDeployment ||
-		txType == models.TransactionTypeUniswapV2TokenDeployment
+		txType == models.TransactionTypeUniswapV2TokenDeployment ||
+		txType == models.TransactionTypeNFTCollectionDeployment
 }
 
 // OnTransactionConfirmed implements Hook.
@@ -28,9 +34,36 @@ func (t *TokenDeploymentHook) OnTransactionConfirmed(txType models.TransactionTy
 		return err
 	}
 
+	// The gas cost feeds the template leaderboard, a missing receipt does not fail the deployment
+	if err := t.recordGas(txHash, session); err != nil {
+		log.Printf("Failed to record gas of deployment session %s: %v", session.ID, err)
+	}
+
 	return nil
 }
 
+// recordGas stores the gas used and the gas cost of the deployment transaction from its receipt
+func (t *TokenDeploymentHook) recordGas(txHash string, session models.TransactionSession) error {
+	if txHash == "" || session.Chain.RPC == "" {
+		return nil
+	}
+
+	receipt, err := utils.NewRPCClient(session.Chain.RPC).GetTransactionReceipt(txHash)
+	if err != nil {
+		return err
+	}
+
+	gasUsed, err := hexutil.DecodeUint64(receipt.GasUsed)
+	if err != nil {
+		return err
+	}
+	gasCost := ""
+	if gasPrice, err := hexutil.DecodeBig(receipt.EffectiveGasPrice); err == nil {
+		gasCost = new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice).String()
+	}
+	return t.deploymentService.UpdateDeploymentGasBySessionId(session.ID, gasUsed, gasCost)
+}
+
 func NewTokenDeploymentHook(deploymentService services.DeploymentService) services.Hook {
 	return &TokenDeploymentHook{
 		deploymentService: deploymentService,
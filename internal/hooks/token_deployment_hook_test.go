@@ -1,6 +1,9 @@
 package hooks
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -77,6 +80,7 @@ func (s *TokenDeploymentHookTestSuite) TestCanHandle() {
 	// Test supported transaction types
 	s.True(s.hook.CanHandle(models.TransactionTypeTokenDeployment))
 	s.True(s.hook.CanHandle(models.TransactionTypeUniswapV2TokenDeployment))
+	s.True(s.hook.CanHandle(models.TransactionTypeNFTCollectionDeployment))
 
 	// Test unsupported transaction types
 	s.False(s.hook.CanHandle(models.TransactionType("other_type")))
@@ -368,6 +372,39 @@ func (s *TokenDeploymentHookTestSuite) TestDatabaseTransactionIntegrity() {
 	s.Equal(models.TransactionStatusConfirmed, updatedDeployment.Status)
 }
 
+func (s *TokenDeploymentHookTestSuite) TestOnTransactionConfirmed_RecordsGas() {
+	// The receipt reports 1,000,000 gas at 2 gwei
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result":  map[string]string{"status": "0x1", "gasUsed": "0xf4240", "effectiveGasPrice": "0x77359400"},
+		})
+	}))
+	defer rpcServer.Close()
+
+	deployment := &models.Deployment{
+		TemplateID:      1,
+		ChainID:         1,
+		TransactionHash: "0x2222222222222222222222222222222222222222222222222222222222222222",
+		Status:          models.TransactionStatusPending,
+		SessionId:       "test-gas-session-id",
+	}
+	s.Require().NoError(s.deploymentService.CreateDeployment(deployment))
+
+	session := models.TransactionSession{
+		ID:    "test-gas-session-id",
+		Chain: models.Chain{RPC: rpcServer.URL},
+	}
+	contractAddress := "0xabcdef1234567890abcdef1234567890abcdef12"
+	s.NoError(s.hook.OnTransactionConfirmed(models.TransactionTypeTokenDeployment, deployment.TransactionHash, &contractAddress, session))
+
+	updatedDeployment, err := s.deploymentService.GetDeploymentByID(deployment.ID)
+	s.Require().NoError(err)
+	s.Equal(uint64(1_000_000), updatedDeployment.GasUsed)
+	s.Equal("2000000000000000", updatedDeployment.GasCost)
+}
+
 func TestTokenDeploymentHook(t *testing.T) {
 	suite.Run(t, new(TokenDeploymentHookTestSuite))
 }
@@ -0,0 +1,79 @@
+package hooks
+
+import (
+	"log"
+	"math/big"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+type TradingLaunchHook struct {
+	tradingLaunchService   services.TradingLaunchService
+	liquidityService       services.LiquidityService
+	uniswapContractService services.UniswapContractService
+	deploymentService      services.DeploymentService
+}
+
+// CanHandle implements Hook.
+func (t *TradingLaunchHook) CanHandle(txType models.TransactionType) bool {
+	return txType == models.TransactionTypeEnableTrading
+}
+
+// OnTransactionConfirmed implements Hook.
+// Opens the trading launch of the session, queues the sell test of the token held back until trading opened and
+// records the opening snapshot of the token pool, so the pool monitors start from the reserves at the moment trading opened.
+func (t *TradingLaunchHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	launch, err := t.tradingLaunchService.GetTradingLaunchBySessionId(session.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := t.tradingLaunchService.MarkTradingLaunchOpen(launch.ID, txHash); err != nil {
+		return err
+	}
+
+	pool, err := t.liquidityService.GetLiquidityPoolByTokenAddress(launch.Deployment.ContractAddress, "")
+	if err != nil || pool.PairAddress == "" {
+		// the token has no pool managed by the launchpad yet
+		return nil
+	}
+
+	if err := t.deploymentService.RequestSellTest(launch.ChainID, launch.Deployment.ContractAddress); err != nil {
+		log.Printf("Failed to request the sell test of deployment %d after trading launch %d: %v", launch.DeploymentID, launch.ID, err)
+	}
+
+	reserve0, reserve1, err := t.uniswapContractService.GetReserves(pool.PairAddress, &session.Chain)
+	if err != nil {
+		// trading is open even if the snapshot cannot be recorded
+		log.Printf("Failed to get reserves of pool %d after trading launch %d: %v", pool.ID, launch.ID, err)
+		return nil
+	}
+
+	var price float64
+	if reserve0.Sign() > 0 {
+		price, _ = new(big.Float).Quo(new(big.Float).SetInt(reserve1), new(big.Float).SetInt(reserve0)).Float64()
+	}
+
+	if err := t.liquidityService.CreatePoolSnapshot(&models.PoolSnapshot{
+		PoolID:          pool.ID,
+		Reserve0:        reserve0.String(),
+		Reserve1:        reserve1.String(),
+		Price:           price,
+		TransactionType: txType,
+		TransactionHash: txHash,
+		SessionId:       session.ID,
+	}); err != nil {
+		log.Printf("Failed to record opening snapshot of pool %d: %v", pool.ID, err)
+	}
+	return nil
+}
+
+func NewTradingLaunchHook(tradingLaunchService services.TradingLaunchService, liquidityService services.LiquidityService, uniswapContractService services.UniswapContractService, deploymentService services.DeploymentService) services.Hook {
+	return &TradingLaunchHook{
+		tradingLaunchService:   tradingLaunchService,
+		liquidityService:       liquidityService,
+		uniswapContractService: uniswapContractService,
+		deploymentService:      deploymentService,
+	}
+}
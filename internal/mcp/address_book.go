@@ -0,0 +1,34 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// addressBookReferences replaces the tool arguments written as @label with the address the user saved under the
+// label with save_address, so every tool accepts the labels of the address book
+func addressBookReferences(addressBookService services.AddressBookService) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			arguments := request.GetArguments()
+			if len(arguments) == 0 {
+				return next(ctx, request)
+			}
+			var userID *string
+			if user, ok := utils.GetAuthenticatedUser(ctx); ok {
+				userID = &user.Sub
+			}
+			expanded, err := addressBookService.ExpandReferences(userID, arguments)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to expand the address book labels: %v", err)), nil
+			}
+			request.Params.Arguments = expanded
+			return next(ctx, request)
+		}
+	}
+}
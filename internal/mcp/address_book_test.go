@@ -0,0 +1,38 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressBookReferences(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	addressBookService := services.NewAddressBookService(dbService.GetDB())
+	user := "user-1"
+	require.NoError(t, addressBookService.SaveAddress(&models.AddressBookEntry{UserID: &user, Label: "treasury", Address: "0x1111111111111111111111111111111111111111", ChainType: models.TransactionChainTypeEthereum}))
+
+	var received map[string]any
+	handler := addressBookReferences(addressBookService)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		received = request.GetArguments()
+		return mcp.NewToolResultText("called"), nil
+	})
+
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: user})
+	_, err = handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "transfer", Arguments: map[string]any{"to": "@treasury", "amount": "1"}}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"to": "0x1111111111111111111111111111111111111111", "amount": "1"}, received)
+
+	other := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	_, err = handler(other, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "transfer", Arguments: map[string]any{"to": "@treasury"}}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"to": "@treasury"}, received)
+}
@@ -0,0 +1,25 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// scopedToolCalls refuses the tool calls of API key clients outside the scopes of their key.
+// Users authenticated by other means are not restricted by their scopes
+func scopedToolCalls() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			user, ok := utils.GetAuthenticatedUser(ctx)
+			if !ok || services.APIKeyAllows(user, request.Params.Name) {
+				return next(ctx, request)
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("The API key %s is not allowed to call %s", user.Jti, request.Params.Name)), nil
+		}
+	}
+}
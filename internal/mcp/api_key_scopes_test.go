@@ -0,0 +1,35 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopedToolCalls(t *testing.T) {
+	handler := scopedToolCalls()(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("called"), nil
+	})
+	call := func(ctx context.Context, tool string) bool {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: tool}})
+		require.NoError(t, err)
+		return !result.IsError
+	}
+	apiKey := func(scopes ...string) context.Context {
+		return utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "ci", ClientId: utils.APIKeyClientID, Jti: "lpk_1234", Scopes: scopes})
+	}
+
+	assert.True(t, call(apiKey("launch"), "launch"))
+	assert.False(t, call(apiKey("launch"), "create_api_key"))
+	assert.True(t, call(apiKey("*"), "create_api_key"))
+	assert.False(t, call(apiKey(), "launch"))
+
+	// The scopes of other authentications are not tool names
+	oauth := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user", Scopes: []string{"read"}})
+	assert.True(t, call(oauth, "launch"))
+	assert.True(t, call(context.Background(), "launch"))
+}
@@ -0,0 +1,43 @@
+package mcp
+
+import (
+	"context"
+	"log"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// auditToolCalls records every tool call with the hash of its arguments and whether it failed
+func auditToolCalls(auditService services.AuditService) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+
+			var callErr string
+			switch {
+			case err != nil:
+				callErr = err.Error()
+			case result != nil && result.IsError:
+				callErr = "tool returned an error"
+				for _, content := range result.Content {
+					if text, ok := content.(mcp.TextContent); ok {
+						callErr = text.Text
+						break
+					}
+				}
+			}
+
+			var userId *string
+			if userID := utils.GetUserID(ctx); userID != "" {
+				userId = &userID
+			}
+			if auditErr := auditService.RecordToolCall(userId, request.Params.Name, request.GetArguments(), callErr); auditErr != nil {
+				log.Printf("Error recording the audit log of tool %s: %v", request.Params.Name, auditErr)
+			}
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// chainIDArgument selects the chain of a single tool call by its database ID, the id returned by list_chains
+const chainIDArgument = "chain_id"
+
+// chainSelectionExemptTools declare a chain_id argument that is not the chain of the call:
+// set_chain takes the network chain ID of the chain it configures
+var chainSelectionExemptTools = map[string]bool{"set_chain": true}
+
+// chainSelection resolves the chain_id argument of a tool call and stores the chain in the context, where
+// ChainService.ResolveChain picks it over the default chain of the user and the global active chain.
+// Concurrent clients can work on different chains without switching the active chain of each other
+func chainSelection(chainService services.ChainService) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if chainSelectionExemptTools[request.Params.Name] {
+				return next(ctx, request)
+			}
+
+			var value string
+			switch argument := request.GetArguments()[chainIDArgument].(type) {
+			case string:
+				value = strings.TrimSpace(argument)
+			case float64:
+				value = strconv.FormatFloat(argument, 'f', -1, 64)
+			}
+			if value == "" {
+				return next(ctx, request)
+			}
+
+			chainID, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid chain_id %q: expected the id of a chain of list_chains", value)), nil
+			}
+			chain, err := chainService.GetChainByID(uint(chainID))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Chain %d not found: %v", chainID, err)), nil
+			}
+			return next(utils.WithChainID(ctx, chain.ID), request)
+		}
+	}
+}
+
+// withChainSelectionArgument declares the optional chain_id argument on the listed tools that do not declare
+// their own. The schemas are copied, the registered tools are left unchanged
+func withChainSelectionArgument(ctx context.Context, tools []mcp.Tool) []mcp.Tool {
+	for i, tool := range tools {
+		if tool.RawInputSchema != nil || chainSelectionExemptTools[tool.Name] {
+			continue
+		}
+		if _, ok := tool.InputSchema.Properties[chainIDArgument]; ok {
+			continue
+		}
+		properties := make(map[string]any, len(tool.InputSchema.Properties)+1)
+		for name, property := range tool.InputSchema.Properties {
+			properties[name] = property
+		}
+		properties[chainIDArgument] = map[string]any{
+			"type":        "string",
+			"description": "ID of the chain (from list_chains) this call runs on. Optional, defaults to the chain chosen with select_chain",
+		}
+		tools[i].InputSchema.Properties = properties
+	}
+	return tools
+}
@@ -0,0 +1,90 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainSelection(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	chainService := services.NewChainService(dbService.GetDB())
+	local := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(local))
+	sepolia := &models.Chain{Name: "Sepolia", RPC: "http://sepolia", NetworkID: "11155111", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, chainService.CreateChain(sepolia))
+
+	var resolved *models.Chain
+	handler := chainSelection(chainService)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		chain, err := chainService.ResolveChain(ctx)
+		require.NoError(t, err)
+		resolved = chain
+		return mcp.NewToolResultText("ok"), nil
+	})
+	call := func(ctx context.Context, name string, arguments map[string]any) *mcp.CallToolResult {
+		resolved = nil
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: name, Arguments: arguments}})
+		require.NoError(t, err)
+		return result
+	}
+
+	// Without a selection the call runs on the active chain
+	require.False(t, call(context.Background(), "query_balance", map[string]any{}).IsError)
+	assert.Equal(t, local.ID, resolved.ID)
+
+	// The argument selects the chain of the call only
+	require.False(t, call(context.Background(), "query_balance", map[string]any{"chain_id": "2"}).IsError)
+	assert.Equal(t, sepolia.ID, resolved.ID)
+	require.False(t, call(context.Background(), "query_balance", map[string]any{"chain_id": float64(2)}).IsError)
+	assert.Equal(t, sepolia.ID, resolved.ID)
+	active, err := chainService.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, local.ID, active.ID)
+
+	// The default of a user does not change the chain of the others
+	alice := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "alice"})
+	require.NoError(t, chainService.SetUserChain("alice", sepolia.ID))
+	call(alice, "query_balance", map[string]any{})
+	assert.Equal(t, sepolia.ID, resolved.ID)
+	call(alice, "query_balance", map[string]any{"chain_id": "1"})
+	assert.Equal(t, local.ID, resolved.ID)
+	bob := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "bob"})
+	call(bob, "query_balance", map[string]any{})
+	assert.Equal(t, local.ID, resolved.ID)
+
+	result := call(context.Background(), "query_balance", map[string]any{"chain_id": "9"})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Chain 9 not found")
+	assert.Nil(t, resolved)
+	result = call(context.Background(), "query_balance", map[string]any{"chain_id": "sepolia"})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Invalid chain_id")
+
+	// set_chain takes the network chain ID, it is not a selection
+	require.False(t, call(context.Background(), "set_chain", map[string]any{"chain_id": "11155111"}).IsError)
+}
+
+func TestWithChainSelectionArgument(t *testing.T) {
+	queryBalance := mcp.NewTool("query_balance", mcp.WithString("wallet_address", mcp.Required()))
+	addDeployment := mcp.NewTool("add_deployment", mcp.WithString("chain_id", mcp.Required(), mcp.Description("ID of the chain where contract is deployed")))
+	setChain := mcp.NewTool("set_chain", mcp.WithString("chain_type"))
+
+	tools := withChainSelectionArgument(context.Background(), []mcp.Tool{queryBalance, addDeployment, setChain})
+	assert.Contains(t, tools[0].InputSchema.Properties, "chain_id")
+	assert.Contains(t, tools[0].InputSchema.Properties, "wallet_address")
+	assert.Equal(t, []string{"wallet_address"}, tools[0].InputSchema.Required)
+	// The registered schema is not changed
+	assert.NotContains(t, queryBalance.InputSchema.Properties, "chain_id")
+
+	assert.Equal(t, "ID of the chain where contract is deployed", tools[1].InputSchema.Properties["chain_id"].(map[string]any)["description"])
+	assert.NotContains(t, tools[2].InputSchema.Properties, "chain_id")
+}
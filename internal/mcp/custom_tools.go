@@ -0,0 +1,38 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/tools"
+)
+
+// registerCustomTools adds the contract call tools of the configuration, they are registered last and cannot replace
+// a built-in tool
+func registerCustomTools(srv *server.MCPServer, definitions []services.CustomToolDefinition, evmService services.EvmService, txService services.TransactionService, chainService services.ChainService, serverPort int) error {
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	registered := map[string]bool{}
+	response := srv.HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	if list, ok := response.(mcp.JSONRPCResponse); ok {
+		if result, ok := list.Result.(mcp.ListToolsResult); ok {
+			for _, tool := range result.Tools {
+				registered[tool.Name] = true
+			}
+		}
+	}
+
+	for _, definition := range definitions {
+		if registered[definition.Name] {
+			return fmt.Errorf("custom tool %q conflicts with a built-in tool", definition.Name)
+		}
+		customTool := tools.NewCustomContractTool(definition, evmService, txService, chainService, serverPort)
+		srv.AddTool(customTool.GetTool(), customTool.GetHandler())
+	}
+	return nil
+}
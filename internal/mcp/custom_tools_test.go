@@ -0,0 +1,29 @@
+package mcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCustomTools(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tools.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"tools":[{"name":"list_chains","contract_address":"0x1111111111111111111111111111111111111111","function":"pause","abi":[{"type":"function","name":"pause","stateMutability":"nonpayable","inputs":[],"outputs":[]}]}]}`), 0o600))
+	definitions, err := services.LoadCustomTools(path)
+	require.NoError(t, err)
+
+	srv := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	require.NoError(t, registerCustomTools(srv, definitions, nil, nil, nil, 0))
+
+	// A custom tool cannot replace a built-in tool
+	srv = server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	srv.AddTool(mcp.NewTool("list_chains"), nil)
+	err = registerCustomTools(srv, definitions, nil, nil, nil, 0)
+	assert.ErrorContains(t, err, "conflicts with a built-in tool")
+}
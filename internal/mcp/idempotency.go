@@ -0,0 +1,72 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/tools"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// idempotentToolCalls replays the result of the first successful call of a tool with the same idempotency_key,
+// so a client retrying a call does not launch twice. Calls without the key and dry runs, which create nothing, run as usual
+func idempotentToolCalls(idempotencyService services.IdempotencyService) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			arguments := request.GetArguments()
+			key, _ := arguments[tools.IdempotencyKeyArgument].(string)
+			key = strings.TrimSpace(key)
+			if dryRun, _ := arguments[tools.DryRunArgument].(bool); key == "" || dryRun {
+				return next(ctx, request)
+			}
+
+			// The key is not part of the compared arguments
+			compared := make(map[string]any, len(arguments))
+			for name, value := range arguments {
+				if name != tools.IdempotencyKeyArgument {
+					compared[name] = value
+				}
+			}
+
+			record, run, err := idempotencyService.Reserve(utils.GetUserID(ctx), request.Params.Name, key, services.HashToolArguments(compared))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid idempotency_key %q: %v", key, err)), nil
+			}
+			if !run {
+				raw := json.RawMessage(record.Result)
+				result, err := mcp.ParseCallToolResult(&raw)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Failed to replay the result of idempotency_key %q: %v", key, err)), nil
+				}
+				return result, nil
+			}
+
+			result, err := next(ctx, request)
+			// Failed calls free the key so the retry runs again
+			if err != nil || result == nil || result.IsError {
+				if releaseErr := idempotencyService.Release(record.ID); releaseErr != nil {
+					log.Printf("Error releasing idempotency_key %q of tool %s: %v", key, request.Params.Name, releaseErr)
+				}
+				return result, err
+			}
+
+			encoded, marshalErr := json.Marshal(result)
+			if marshalErr == nil {
+				marshalErr = idempotencyService.Complete(record.ID, string(encoded))
+			}
+			if marshalErr != nil {
+				log.Printf("Error storing the result of idempotency_key %q of tool %s: %v", key, request.Params.Name, marshalErr)
+				if releaseErr := idempotencyService.Release(record.ID); releaseErr != nil {
+					log.Printf("Error releasing idempotency_key %q of tool %s: %v", key, request.Params.Name, releaseErr)
+				}
+			}
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotentToolCalls(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	calls := 0
+	fail := false
+	handler := idempotentToolCalls(services.NewIdempotencyService(dbService.GetDB()))(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		if fail {
+			return mcp.NewToolResultError("chain not found"), nil
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{
+			mcp.NewTextContent("Session created"),
+			mcp.NewTextContent(fmt.Sprintf(`{"session_id":"session-%d"}`, calls)),
+		}}, nil
+	})
+
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "launch", Arguments: arguments}})
+		require.NoError(t, err)
+		return result
+	}
+
+	first := call(map[string]any{"template_id": "1", "idempotency_key": "retry-1"})
+	retry := call(map[string]any{"template_id": "1", "idempotency_key": "retry-1"})
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, first.Content[1].(mcp.TextContent).Text, retry.Content[1].(mcp.TextContent).Text)
+
+	// The key cannot be reused for another request
+	reused := call(map[string]any{"template_id": "2", "idempotency_key": "retry-1"})
+	assert.True(t, reused.IsError)
+	assert.Contains(t, reused.Content[0].(mcp.TextContent).Text, "different arguments")
+	assert.Equal(t, 1, calls)
+
+	// Calls without a key always run
+	call(map[string]any{"template_id": "1"})
+	call(map[string]any{"template_id": "1"})
+	assert.Equal(t, 3, calls)
+
+	// Failed calls are not replayed
+	fail = true
+	assert.True(t, call(map[string]any{"template_id": "1", "idempotency_key": "retry-2"}).IsError)
+	fail = false
+	assert.False(t, call(map[string]any{"template_id": "1", "idempotency_key": "retry-2"}).IsError)
+	assert.Equal(t, 5, calls)
+
+	// Dry runs create nothing and do not claim the key
+	call(map[string]any{"template_id": "3", "dry_run": true, "idempotency_key": "retry-3"})
+	call(map[string]any{"template_id": "3", "idempotency_key": "retry-3"})
+	call(map[string]any{"template_id": "3", "idempotency_key": "retry-3"})
+	assert.Equal(t, 7, calls)
+}
@@ -0,0 +1,201 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	methodResourcesSubscribe   = "resources/subscribe"
+	methodResourcesUnsubscribe = "resources/unsubscribe"
+	// stdioSessionID is the session of the only client of the stdio transport
+	stdioSessionID = "stdio"
+)
+
+// resourceSubscriber is an MCP session subscribed to resources, userID is the user that subscribed, empty without
+// authentication
+type resourceSubscriber struct {
+	userID string
+	uris   map[string]bool
+}
+
+// resourceSubscriptions are the resources/subscribe subscriptions of the MCP sessions connected to this process.
+// mcp-go does not handle resources/subscribe, the transports pass the requests to handle
+type resourceSubscriptions struct {
+	mu       sync.RWMutex
+	sessions map[string]*resourceSubscriber
+}
+
+func newResourceSubscriptions() *resourceSubscriptions {
+	return &resourceSubscriptions{sessions: map[string]*resourceSubscriber{}}
+}
+
+// subscribe adds the resource to the subscriptions of the session. A session belongs to the user that subscribed first
+func (r *resourceSubscriptions) subscribe(sessionID, userID, uri string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	subscriber, ok := r.sessions[sessionID]
+	if !ok {
+		subscriber = &resourceSubscriber{userID: userID, uris: map[string]bool{}}
+		r.sessions[sessionID] = subscriber
+	}
+	if subscriber.userID != userID {
+		return fmt.Errorf("session %s belongs to another user", sessionID)
+	}
+	subscriber.uris[uri] = true
+	return nil
+}
+
+func (r *resourceSubscriptions) unsubscribe(sessionID, userID, uri string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if subscriber, ok := r.sessions[sessionID]; ok && subscriber.userID == userID {
+		delete(subscriber.uris, uri)
+	}
+}
+
+// remove drops the subscriptions of a terminated session
+func (r *resourceSubscriptions) remove(sessionID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, sessionID)
+}
+
+// subscribers returns the sessions subscribed to the resource that may read it. Owned is false when the owner of a
+// deleted row is unknown, only the sessions without authentication are notified of it
+func (r *resourceSubscriptions) subscribers(uri string, owner *string, owned bool) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var sessionIDs []string
+	for sessionID, subscriber := range r.sessions {
+		if !subscriber.uris[uri] {
+			continue
+		}
+		if subscriber.userID == "" || (owned && (owner == nil || *owner == subscriber.userID)) {
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+	}
+	return sessionIDs
+}
+
+// notify sends notifications/resources/updated to the sessions subscribed to the resource that may read it
+func (r *resourceSubscriptions) notify(srv *server.MCPServer, uri string, owner *string, owned bool) {
+	for _, sessionID := range r.subscribers(uri, owner, owned) {
+		// The sessions without an open stream miss the notification, their subscription is kept for the next stream
+		_ = srv.SendNotificationToSpecificClient(sessionID, string(mcp.MethodNotificationResourceUpdated), map[string]any{"uri": uri})
+	}
+}
+
+// handleSubscription answers a resources/subscribe or resources/unsubscribe request of the session, handled is false
+// for the other messages
+func (r *resourceSubscriptions) handleSubscription(ctx context.Context, sessionID string, message []byte) (response mcp.JSONRPCMessage, handled bool) {
+	var request struct {
+		ID     mcp.RequestId `json:"id"`
+		Method string        `json:"method"`
+		Params struct {
+			URI string `json:"uri"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(message, &request); err != nil {
+		return nil, false
+	}
+	if request.Method != methodResourcesSubscribe && request.Method != methodResourcesUnsubscribe {
+		return nil, false
+	}
+	if sessionID == "" {
+		return mcp.NewJSONRPCError(request.ID, mcp.INVALID_REQUEST, "resource subscriptions require an MCP session", nil), true
+	}
+	if request.Params.URI == "" {
+		return mcp.NewJSONRPCError(request.ID, mcp.INVALID_PARAMS, "uri is required", nil), true
+	}
+
+	userID := utils.GetUserID(ctx)
+	if request.Method == methodResourcesUnsubscribe {
+		r.unsubscribe(sessionID, userID, request.Params.URI)
+	} else if err := r.subscribe(sessionID, userID, request.Params.URI); err != nil {
+		return mcp.NewJSONRPCError(request.ID, mcp.INVALID_REQUEST, err.Error(), nil), true
+	}
+	return mcp.NewJSONRPCResponse(request.ID, mcp.Result{}), true
+}
+
+// WithResourceSubscriptions answers the resources/subscribe and resources/unsubscribe requests sent to the streamable
+// HTTP server and passes the other requests to it. The authenticated user must be in the request context
+func (s *MCPServer) WithResourceSubscriptions(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sessionID := r.Header.Get(server.HeaderKeySessionID)
+		switch r.Method {
+		case http.MethodPost:
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, "failed to read the request body", http.StatusBadRequest)
+				return
+			}
+			if response, handled := s.resourceSubscriptions.handleSubscription(r.Context(), sessionID, body); handled {
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(response); err != nil {
+					http.Error(w, "failed to encode the response", http.StatusInternalServerError)
+				}
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		case http.MethodDelete:
+			s.resourceSubscriptions.remove(sessionID)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// syncWriter serializes the writes of the stdio responses and notifications
+type syncWriter struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.writer.Write(p)
+}
+
+// serveStdio serves the stdio transport like server.ServeStdio, answering the resource subscription requests of the
+// client before the other messages reach mcp-go
+func (s *MCPServer) serveStdio(stdin io.Reader, stdout io.Writer) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	output := &syncWriter{writer: stdout}
+	input, forward := io.Pipe()
+	go func() {
+		reader := bufio.NewReader(stdin)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(line) > 0 {
+				if response, handled := s.resourceSubscriptions.handleSubscription(ctx, stdioSessionID, line); handled {
+					if encoded, err := json.Marshal(response); err == nil {
+						output.Write(append(encoded, '\n'))
+					}
+				} else if _, err := forward.Write(line); err != nil {
+					return
+				}
+			}
+			if err != nil {
+				forward.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	return server.NewStdioServer(s.server).Listen(ctx, input, output)
+}
@@ -0,0 +1,277 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	chainsResourceURI      = "launchpad://chains"
+	templateResourceURI    = "launchpad://templates/%v"
+	deploymentResourceURI  = "launchpad://deployments/%v"
+	sessionResourceURI     = "launchpad://sessions/%v"
+	resourceMIMEType       = "application/json"
+	resourceCallbackName   = "launchpad:resources"
+	resourceUpdatedIDsKey  = "launchpad:resources:ids"
+	resourceUpdatedIDLimit = 100
+)
+
+// resourceURIs are the resource URIs of the tables of the launchpad resources, chains are a single resource
+var resourceURIs = map[string]string{
+	"chains":               chainsResourceURI,
+	"templates":            templateResourceURI,
+	"deployments":          deploymentResourceURI,
+	"transaction_sessions": sessionResourceURI,
+}
+
+// launchpadResources serves the read-only launchpad:// resources
+type launchpadResources struct {
+	chainService      services.ChainService
+	templateService   services.TemplateService
+	deploymentService services.DeploymentService
+	txService         services.TransactionService
+}
+
+// registerResources adds the chains resource and the template, deployment and session resource templates
+func registerResources(srv *server.MCPServer, resources launchpadResources) {
+	srv.AddResource(mcp.NewResource(chainsResourceURI, "Chains",
+		mcp.WithResourceDescription("The configured chains, the active chain is marked with is_active"),
+		mcp.WithMIMEType(resourceMIMEType),
+	), resources.readChains)
+
+	srv.AddResourceTemplate(mcp.NewResourceTemplate("launchpad://templates/{id}", "Template",
+		mcp.WithTemplateDescription("A contract template by ID"),
+		mcp.WithTemplateMIMEType(resourceMIMEType),
+	), resources.readTemplate)
+
+	srv.AddResourceTemplate(mcp.NewResourceTemplate("launchpad://deployments/{id}", "Deployment",
+		mcp.WithTemplateDescription("A contract deployment by ID with its status, address, template and chain"),
+		mcp.WithTemplateMIMEType(resourceMIMEType),
+	), resources.readDeployment)
+
+	srv.AddResourceTemplate(mcp.NewResourceTemplate("launchpad://sessions/{id}", "Transaction Session",
+		mcp.WithTemplateDescription("A transaction session by ID with its status and transactions"),
+		mcp.WithTemplateMIMEType(resourceMIMEType),
+	), resources.readSession)
+}
+
+func (r launchpadResources) readChains(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	chains, err := r.chainService.ListChains()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chains: %w", err)
+	}
+	return jsonResourceContents(request.Params.URI, chains)
+}
+
+func (r launchpadResources) readTemplate(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	id, err := resourceNumericID(request)
+	if err != nil {
+		return nil, err
+	}
+	template, err := r.templateService.GetTemplateByID(id)
+	if err != nil || !ownedByCaller(ctx, template.UserId) {
+		return nil, fmt.Errorf("template %d not found", id)
+	}
+	return jsonResourceContents(request.Params.URI, template)
+}
+
+func (r launchpadResources) readDeployment(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	id, err := resourceNumericID(request)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := r.deploymentService.GetDeploymentByID(id)
+	if err != nil || !ownedByCaller(ctx, deployment.UserID) {
+		return nil, fmt.Errorf("deployment %d not found", id)
+	}
+	return jsonResourceContents(request.Params.URI, deployment)
+}
+
+func (r launchpadResources) readSession(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	id := resourceID(request)
+	session, err := r.txService.GetTransactionSession(id)
+	if err != nil || !ownedByCaller(ctx, session.UserID) {
+		return nil, fmt.Errorf("transaction session %s not found", id)
+	}
+	return jsonResourceContents(request.Params.URI, session)
+}
+
+// resourceID returns the {id} variable of the resource URI
+func resourceID(request mcp.ReadResourceRequest) string {
+	switch id := request.Params.Arguments["id"].(type) {
+	case string:
+		return id
+	case []string:
+		if len(id) > 0 {
+			return id[0]
+		}
+	}
+	return ""
+}
+
+func resourceNumericID(request mcp.ReadResourceRequest) (uint, error) {
+	id, err := strconv.ParseUint(resourceID(request), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id in resource URI %s", request.Params.URI)
+	}
+	return uint(id), nil
+}
+
+// ownedByCaller hides the records of other users from an authenticated caller
+func ownedByCaller(ctx context.Context, owner *string) bool {
+	userID := utils.GetUserID(ctx)
+	return userID == "" || owner == nil || *owner == userID
+}
+
+func jsonResourceContents(uri string, value any) ([]mcp.ResourceContents, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource %s: %w", uri, err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: resourceMIMEType, Text: string(encoded)},
+	}, nil
+}
+
+// resourceRow is a written row of a launchpad resource and its owner, nil for the rows of no user
+type resourceRow struct {
+	ID     string
+	UserID *string
+}
+
+// notifyResourceUpdates sends notifications/resources/updated for every launchpad resource written through the
+// database to the sessions subscribed to it that may read it, so clients can refresh the resource instead of polling
+// the tools. The records of a user are only notified to the sessions of that user, like the reads of ownedByCaller
+func notifyResourceUpdates(db *gorm.DB, srv *server.MCPServer, subscriptions *resourceSubscriptions) error {
+	callbacks := db.Callback()
+	// The callbacks are shared by every session of the database, register them once per server
+	if callbacks.Create().Get(resourceCallbackName+":create") != nil {
+		return nil
+	}
+
+	notify := func(db *gorm.DB) {
+		statement := db.Statement
+		uri, ok := resourceURIs[statement.Table]
+		if !ok || db.Error != nil || db.RowsAffected == 0 {
+			return
+		}
+		if uri == chainsResourceURI {
+			subscriptions.notify(srv, uri, nil, true)
+			return
+		}
+
+		// The matched rows of updates and deletes carry their owner, the created and saved rows are looked up
+		owners := map[string]*string{}
+		var ids, lookup []string
+		if matched, ok := db.InstanceGet(resourceUpdatedIDsKey); ok {
+			for _, row := range matched.([]resourceRow) {
+				owners[row.ID] = row.UserID
+				ids = append(ids, row.ID)
+			}
+		}
+		seen := map[string]bool{}
+		for _, id := range resourceRowIDs(statement) {
+			if _, ok := owners[id]; !ok && !seen[id] {
+				seen[id] = true
+				lookup = append(lookup, id)
+			}
+		}
+		if len(lookup) > 0 {
+			for _, row := range resourceRowOwners(db, statement, lookup) {
+				owners[row.ID] = row.UserID
+			}
+		}
+		for _, id := range append(lookup, ids...) {
+			owner, owned := owners[id]
+			subscriptions.notify(srv, fmt.Sprintf(uri, id), owner, owned)
+		}
+	}
+
+	if err := callbacks.Create().After("gorm:create").Register(resourceCallbackName+":create", notify); err != nil {
+		return err
+	}
+	// Updates and deletes by condition do not carry the rows, their IDs are looked up before the statement runs
+	if err := callbacks.Update().Before("gorm:update").Register(resourceCallbackName+":match_update", matchResourceRows); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(resourceCallbackName+":update", notify); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register(resourceCallbackName+":match_delete", matchResourceRows); err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:delete").Register(resourceCallbackName+":delete", notify)
+}
+
+// matchResourceRows stores the IDs and owners of the rows matching the condition of an update or delete of a
+// launchpad resource
+func matchResourceRows(db *gorm.DB) {
+	statement := db.Statement
+	uri, ok := resourceURIs[statement.Table]
+	if !ok || uri == chainsResourceURI || db.Error != nil || statement.Schema == nil || statement.Schema.PrioritizedPrimaryField == nil {
+		return
+	}
+	where, ok := statement.Clauses["WHERE"]
+	if !ok {
+		return
+	}
+	condition, ok := where.Expression.(clause.Where)
+	if !ok || len(condition.Exprs) == 0 {
+		return
+	}
+
+	var rows []resourceRow
+	query := db.Session(&gorm.Session{NewDB: true}).Model(reflect.New(statement.Schema.ModelType).Interface()).
+		Select(statement.Schema.PrioritizedPrimaryField.DBName + " AS id, user_id").Clauses(condition).Limit(resourceUpdatedIDLimit)
+	if err := query.Scan(&rows).Error; err != nil {
+		log.Printf("Error looking up the updated rows of %s: %v", statement.Table, err)
+		return
+	}
+	db.InstanceSet(resourceUpdatedIDsKey, rows)
+}
+
+// resourceRowOwners looks up the owners of the written rows, the rows missing from the table are not returned
+func resourceRowOwners(db *gorm.DB, statement *gorm.Statement, ids []string) []resourceRow {
+	var rows []resourceRow
+	primaryKey := statement.Schema.PrioritizedPrimaryField.DBName
+	if err := db.Session(&gorm.Session{NewDB: true}).Table(statement.Table).
+		Select(primaryKey+" AS id, user_id").Where(primaryKey+" IN ?", ids).Scan(&rows).Error; err != nil {
+		log.Printf("Error looking up the owners of the updated rows of %s: %v", statement.Table, err)
+	}
+	return rows
+}
+
+// resourceRowIDs returns the primary keys of the rows of the statement model
+func resourceRowIDs(statement *gorm.Statement) []string {
+	if statement.Schema == nil || statement.Schema.PrioritizedPrimaryField == nil {
+		return nil
+	}
+	field := statement.Schema.PrioritizedPrimaryField
+
+	var ids []string
+	addRow := func(row reflect.Value) {
+		if value, zero := field.ValueOf(statement.Context, row); !zero {
+			ids = append(ids, fmt.Sprint(value))
+		}
+	}
+	switch rows := reflect.Indirect(statement.ReflectValue); rows.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rows.Len(); i++ {
+			addRow(reflect.Indirect(rows.Index(i)))
+		}
+	case reflect.Struct:
+		addRow(rows)
+	}
+	return ids
+}
@@ -0,0 +1,203 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resourceTestSession receives the notifications sent to the clients
+type resourceTestSession struct {
+	id            string
+	notifications chan mcp.JSONRPCNotification
+}
+
+func (s *resourceTestSession) Initialize()       {}
+func (s *resourceTestSession) Initialized() bool { return true }
+func (s *resourceTestSession) SessionID() string { return s.id }
+func (s *resourceTestSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+
+// updatedURIs drains the resources/updated notifications received so far
+func (s *resourceTestSession) updatedURIs() []string {
+	var uris []string
+	for {
+		select {
+		case notification := <-s.notifications:
+			if notification.Method == string(mcp.MethodNotificationResourceUpdated) {
+				uris = append(uris, fmt.Sprint(notification.Params.AdditionalFields["uri"]))
+			}
+		default:
+			return uris
+		}
+	}
+}
+
+func newResourceTestServer(t *testing.T) (*server.MCPServer, services.DBService, *resourceSubscriptions) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	srv := server.NewMCPServer("test", "1.0.0", server.WithResourceCapabilities(false, false))
+	registerResources(srv, launchpadResources{
+		chainService:      services.NewChainService(db),
+		templateService:   services.NewTemplateService(db),
+		deploymentService: services.NewDeploymentService(db),
+		txService:         services.NewTransactionService(db),
+	})
+	subscriptions := newResourceSubscriptions()
+	require.NoError(t, notifyResourceUpdates(db, srv, subscriptions))
+	return srv, dbService, subscriptions
+}
+
+// subscribeResource sends resources/subscribe for the session as the user of the context
+func subscribeResource(t *testing.T, ctx context.Context, subscriptions *resourceSubscriptions, sessionID, uri string) *mcp.JSONRPCError {
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "resources/subscribe",
+		"params":  map[string]any{"uri": uri},
+	})
+	require.NoError(t, err)
+	response, handled := subscriptions.handleSubscription(ctx, sessionID, message)
+	require.True(t, handled)
+	if rpcErr, ok := response.(mcp.JSONRPCError); ok {
+		return &rpcErr
+	}
+	return nil
+}
+
+func readResource(t *testing.T, ctx context.Context, srv *server.MCPServer, uri string) (string, *mcp.JSONRPCError) {
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "resources/read",
+		"params":  map[string]any{"uri": uri},
+	})
+	require.NoError(t, err)
+
+	switch response := srv.HandleMessage(ctx, message).(type) {
+	case mcp.JSONRPCResponse:
+		result := response.Result.(mcp.ReadResourceResult)
+		require.Len(t, result.Contents, 1)
+		return result.Contents[0].(mcp.TextResourceContents).Text, nil
+	case mcp.JSONRPCError:
+		return "", &response
+	default:
+		t.Fatalf("unexpected response %T", response)
+		return "", nil
+	}
+}
+
+func TestResourcesRead(t *testing.T) {
+	srv, dbService, _ := newResourceTestServer(t)
+	db := dbService.GetDB()
+	owner := "user-1"
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{Name: "Token", UserId: &owner, ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	deployment := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, UserID: &owner, Status: models.TransactionStatusPending, SessionId: "session-1"}
+	require.NoError(t, db.Create(deployment).Error)
+	require.NoError(t, db.Create(&models.TransactionSession{ID: "session-1", UserID: &owner, TransactionChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID, ExpiresAt: time.Now().Add(time.Hour)}).Error)
+
+	text, rpcErr := readResource(t, context.Background(), srv, "launchpad://chains")
+	require.Nil(t, rpcErr)
+	assert.Contains(t, text, `"name":"Anvil"`)
+
+	text, rpcErr = readResource(t, context.Background(), srv, fmt.Sprintf("launchpad://templates/%d", template.ID))
+	require.Nil(t, rpcErr)
+	assert.Contains(t, text, `"name":"Token"`)
+
+	text, rpcErr = readResource(t, context.Background(), srv, fmt.Sprintf("launchpad://deployments/%d", deployment.ID))
+	require.Nil(t, rpcErr)
+	assert.Contains(t, text, `"session_id":"session-1"`)
+
+	text, rpcErr = readResource(t, context.Background(), srv, "launchpad://sessions/session-1")
+	require.Nil(t, rpcErr)
+	assert.Contains(t, text, `"id":"session-1"`)
+
+	// Missing records and the records of other users are not found
+	_, rpcErr = readResource(t, context.Background(), srv, "launchpad://templates/999")
+	assert.NotNil(t, rpcErr)
+	other := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	_, rpcErr = readResource(t, other, srv, "launchpad://sessions/session-1")
+	assert.NotNil(t, rpcErr)
+	_, rpcErr = readResource(t, context.Background(), srv, "launchpad://templates/abc")
+	assert.NotNil(t, rpcErr)
+}
+
+func TestResourcesNotifyUpdates(t *testing.T) {
+	srv, dbService, subscriptions := newResourceTestServer(t)
+	db := dbService.GetDB()
+	session := &resourceTestSession{id: "resource-test", notifications: make(chan mcp.JSONRPCNotification, 100)}
+	require.NoError(t, srv.RegisterSession(context.Background(), session))
+
+	// Only the subscribed resources are notified
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	assert.Empty(t, session.updatedURIs())
+	require.Nil(t, subscribeResource(t, context.Background(), subscriptions, session.id, "launchpad://chains"))
+	require.NoError(t, db.Model(chain).Update("name", "Anvil 2").Error)
+	assert.Equal(t, []string{"launchpad://chains"}, session.updatedURIs())
+
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	assert.Empty(t, session.updatedURIs())
+
+	// Updates by condition notify the matched rows
+	deploymentService := services.NewDeploymentService(db)
+	deployment := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, Status: models.TransactionStatusPending, SessionId: "session-1"}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	deploymentURI := fmt.Sprintf("launchpad://deployments/%d", deployment.ID)
+	require.Nil(t, subscribeResource(t, context.Background(), subscriptions, session.id, deploymentURI))
+	require.NoError(t, deploymentService.UpdateDeploymentStatusWithTxHashBySessionId("session-1", models.TransactionStatusConfirmed, "0x1", "0x2"))
+	assert.Equal(t, []string{deploymentURI}, session.updatedURIs())
+
+	// Writes matching no rows and other tables are not notified
+	require.NoError(t, deploymentService.UpdateDeploymentStatusWithTxHashBySessionId("session-2", models.TransactionStatusConfirmed, "0x1", "0x2"))
+	require.NoError(t, db.Create(&models.AuditLog{Kind: models.AuditLogKindToolCall, ToolName: "launch"}).Error)
+	assert.Empty(t, session.updatedURIs())
+
+	require.NoError(t, deploymentService.DeleteDeployment(deployment.ID))
+	assert.Equal(t, []string{deploymentURI}, session.updatedURIs())
+}
+
+func TestResourcesNotifyOnlyTheOwner(t *testing.T) {
+	srv, dbService, subscriptions := newResourceTestServer(t)
+	db := dbService.GetDB()
+	ownerSession := &resourceTestSession{id: "owner", notifications: make(chan mcp.JSONRPCNotification, 100)}
+	otherSession := &resourceTestSession{id: "other", notifications: make(chan mcp.JSONRPCNotification, 100)}
+	require.NoError(t, srv.RegisterSession(context.Background(), ownerSession))
+	require.NoError(t, srv.RegisterSession(context.Background(), otherSession))
+	owner := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+	other := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+
+	ownerID := "user-1"
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	sessionURI := "launchpad://sessions/session-1"
+	require.Nil(t, subscribeResource(t, owner, subscriptions, ownerSession.id, sessionURI))
+	require.Nil(t, subscribeResource(t, other, subscriptions, otherSession.id, sessionURI))
+
+	require.NoError(t, db.Create(&models.TransactionSession{ID: "session-1", UserID: &ownerID, TransactionChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID, ExpiresAt: time.Now().Add(time.Hour)}).Error)
+	require.NoError(t, db.Model(&models.TransactionSession{}).Where("id = ?", "session-1").Update("transaction_status", models.TransactionStatusConfirmed).Error)
+	assert.Equal(t, []string{sessionURI, sessionURI}, ownerSession.updatedURIs())
+	assert.Empty(t, otherSession.updatedURIs())
+
+	// A session cannot be taken over by another user
+	assert.NotNil(t, subscribeResource(t, other, subscriptions, ownerSession.id, "launchpad://chains"))
+}
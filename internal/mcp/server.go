@@ -3,32 +3,80 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"log"
+	"os"
 	"time"
 
+	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/anvil"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
 	"github.com/rxtech-lab/launchpad-mcp/internal/tools"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
 type MCPServer struct {
-	server    *server.MCPServer
-	dbService services.DBService
+	server            *server.MCPServer
+	dbService         services.DBService
+	templateService   services.TemplateService
+	limitOrderMonitor *services.LimitOrderMonitor
+	recurringSwaps    *services.RecurringSwapScheduler
+	buybacks          *services.BuybackScheduler
+	privateTxMonitor  *services.PrivateTransactionMonitor
+	tradingLaunches   *services.TradingLaunchScheduler
+	sellTests         *services.SellTestMonitor
+	auditPruner       *services.AuditLogPruner
+	safeProposals     *services.SafeProposalMonitor
+	eventIndexer      *services.EventIndexer
+	poolAlerts        *services.PoolAlertMonitor
+	bondingCurves     *services.BondingCurveMonitor
+	dutchAuctions     *services.DutchAuctionMonitor
+	// resourceSubscriptions are the resources the connected sessions subscribed to
+	resourceSubscriptions *resourceSubscriptions
+	// leaderElector runs the background jobs on the leader of the cluster, nil runs them on this server
+	leaderElector *services.LeaderElector
+	// submitSignedTransaction runs the hooks of the transactions signed offline
+	submitSignedTransaction interface {
+		SetHookService(services.HookService)
+	}
 }
 
 func NewMCPServer(dbService services.DBService, serverPort int, evmService services.EvmService, txService services.TransactionService, uniswapService services.UniswapService, liquidityService services.LiquidityService, chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService) *MCPServer {
 	mcpServer := &MCPServer{
-		dbService: dbService,
+		dbService:       dbService,
+		templateService: templateService,
 	}
 	mcpServer.InitializeTools(dbService, serverPort, evmService, txService, uniswapService, liquidityService, chainService, templateService, deploymentService)
 	return mcpServer
 }
 
 func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int, evmService services.EvmService, txService services.TransactionService, uniswapService services.UniswapService, liquidityService services.LiquidityService, chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService) {
+	// Every tool call and every row written to the database is recorded in the audit log
+	auditService := services.NewAuditService(dbService.GetDB())
+	if err := auditService.RegisterMutationCallbacks(); err != nil {
+		log.Printf("Error registering the audit log callbacks: %v", err)
+	}
+	auditRetention := services.AuditRetentionFromEnv()
+	s.auditPruner = services.NewAuditLogPruner(auditService, auditRetention, services.DefaultAuditPruneInterval)
+	// The @label arguments of every tool are expanded from the address book of save_address
+	addressBookService := services.NewAddressBookService(dbService.GetDB())
+
 	srv := server.NewMCPServer(
 		"Crypto Launchpad MCP Server",
 		"1.0.0",
 		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(true, false),
+		server.WithToolHandlerMiddleware(auditToolCalls(auditService)),
+		server.WithToolHandlerMiddleware(scopedToolCalls()),
+		// Every tool takes an optional chain_id selecting the chain of the call
+		server.WithToolHandlerMiddleware(chainSelection(chainService)),
+		server.WithToolFilter(withChainSelectionArgument),
+		// Before the idempotency middleware so a retry after a label changed is not replayed
+		server.WithToolHandlerMiddleware(addressBookReferences(addressBookService)),
+		// Inside the audit middleware so replayed calls are audited too
+		server.WithToolHandlerMiddleware(idempotentToolCalls(services.NewIdempotencyService(dbService.GetDB()))),
 	)
 	srv.EnableSampling()
 
@@ -57,6 +105,13 @@ func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int
 		), nil
 	})
 
+	// List and report tools read from the replica when one is configured, the other tools
+	// use the primary to see the records they have just written
+	readDB := dbService.GetReadDB()
+	readTemplateService := services.NewTemplateService(readDB)
+	readDeploymentService := services.NewDeploymentService(readDB)
+	readLiquidityService := services.NewLiquidityService(readDB)
+
 	// Chain Management Tools
 	selectChainTool, selectChainHandler := tools.NewSelectChainTool(chainService)
 	srv.AddTool(selectChainTool, selectChainHandler)
@@ -64,11 +119,11 @@ func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int
 	setChainTool, setChainHandler := tools.NewSetChainTool(chainService)
 	srv.AddTool(setChainTool, setChainHandler)
 
-	listChainsTool, listChainsHandler := tools.NewListChainsTool(chainService)
+	listChainsTool, listChainsHandler := tools.NewListChainsTool(services.NewChainService(readDB))
 	srv.AddTool(listChainsTool, listChainsHandler)
 
 	// Template Management Tools
-	listTemplateTool, listTemplateHandler := tools.NewListTemplateTool(templateService)
+	listTemplateTool, listTemplateHandler := tools.NewListTemplateTool(readTemplateService)
 	srv.AddTool(listTemplateTool, listTemplateHandler)
 
 	createTemplateToolInstance := tools.NewCreateTemplateTool(templateService)
@@ -83,11 +138,123 @@ func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int
 	viewTemplateToolInstance := tools.NewViewTemplateTool(templateService, evmService)
 	srv.AddTool(viewTemplateToolInstance.GetTool(), viewTemplateToolInstance.GetHandler())
 
+	templateLeaderboardTool := tools.NewTemplateLeaderboardTool(readTemplateService, readDeploymentService, readLiquidityService, services.NewUniswapService(readDB))
+	srv.AddTool(templateLeaderboardTool.GetTool(), templateLeaderboardTool.GetHandler())
+
+	getTemplateReportTool := tools.NewGetTemplateReportTool(readTemplateService)
+	srv.AddTool(getTemplateReportTool.GetTool(), getTemplateReportTool.GetHandler())
+
+	testTemplateTool := tools.NewTestTemplateTool(templateService, evmService, anvil.PathFromEnv())
+	srv.AddTool(testTemplateTool.GetTool(), testTemplateTool.GetHandler())
+
+	exportTemplatesTool := tools.NewExportTemplatesTool(readTemplateService)
+	srv.AddTool(exportTemplatesTool.GetTool(), exportTemplatesTool.GetHandler())
+
+	importTemplatesTool := tools.NewImportTemplatesTool(templateService)
+	srv.AddTool(importTemplatesTool.GetTool(), importTemplatesTool.GetHandler())
+
+	templateRegistryService := services.NewTemplateRegistryServiceFromEnv(dbService.GetDB())
+	browseRegistryTool := tools.NewBrowseRegistryTool(templateRegistryService)
+	srv.AddTool(browseRegistryTool.GetTool(), browseRegistryTool.GetHandler())
+
+	installTemplateTool := tools.NewInstallTemplateTool(templateRegistryService, templateService)
+	srv.AddTool(installTemplateTool.GetTool(), installTemplateTool.GetHandler())
+
 	// Deployment Tools
 	launchTool := tools.NewLaunchTool(templateService, chainService, serverPort, evmService, txService, deploymentService)
 	srv.AddTool(launchTool.GetTool(), launchTool.GetHandler())
 
-	listDeploymentsTool, listDeploymentsHandler := tools.NewListDeploymentsTool(deploymentService)
+	launchReadinessTool := tools.NewLaunchReadinessTool(templateService, chainService, uniswapService)
+	srv.AddTool(launchReadinessTool.GetTool(), launchReadinessTool.GetHandler())
+
+	estimateDeploymentCostTool := tools.NewEstimateDeploymentCostTool(templateService, chainService, evmService, services.NewPriceServiceFromEnv())
+	srv.AddTool(estimateDeploymentCostTool.GetTool(), estimateDeploymentCostTool.GetHandler())
+
+	launchGroupService := services.NewLaunchGroupService(dbService.GetDB())
+	multiChainLaunchTool := tools.NewMultiChainLaunchTool(launchTool, launchGroupService)
+	srv.AddTool(multiChainLaunchTool.GetTool(), multiChainLaunchTool.GetHandler())
+
+	getLaunchGroupTool := tools.NewGetLaunchGroupTool(launchGroupService, serverPort)
+	srv.AddTool(getLaunchGroupTool.GetTool(), getLaunchGroupTool.GetHandler())
+
+	bridgeLiquidityTool := tools.NewBridgeLiquidityTool(chainService, txService, launchGroupService, serverPort)
+	srv.AddTool(bridgeLiquidityTool.GetTool(), bridgeLiquidityTool.GetHandler())
+
+	wireCrossChainTokenTool := tools.NewWireCrossChainTokenTool(txService, launchGroupService, serverPort)
+	srv.AddTool(wireCrossChainTokenTool.GetTool(), wireCrossChainTokenTool.GetHandler())
+
+	// Project Tools
+	projectService := services.NewProjectService(dbService.GetDB())
+	createProjectTool := tools.NewCreateProjectTool(projectService)
+	srv.AddTool(createProjectTool.GetTool(), createProjectTool.GetHandler())
+
+	assignToProjectTool := tools.NewAssignToProjectTool(projectService)
+	srv.AddTool(assignToProjectTool.GetTool(), assignToProjectTool.GetHandler())
+
+	listProjectAssetsTool := tools.NewListProjectAssetsTool(projectService, serverPort)
+	srv.AddTool(listProjectAssetsTool.GetTool(), listProjectAssetsTool.GetHandler())
+
+	// Address Book Tools
+	saveAddressTool := tools.NewSaveAddressTool(addressBookService)
+	srv.AddTool(saveAddressTool.GetTool(), saveAddressTool.GetHandler())
+
+	listAddressesTool := tools.NewListAddressesTool(addressBookService)
+	srv.AddTool(listAddressesTool.GetTool(), listAddressesTool.GetHandler())
+
+	// Safe Multisig Tools, signed by the owner or delegate key of LAUNCHPAD_SAFE_PROPOSER_PRIVATE_KEY
+	safeProposerKey, err := services.SafeProposerKeyFromEnv()
+	if err != nil {
+		log.Fatal("Failed to configure the Safe proposer:", err)
+	}
+	safeService := services.NewSafeServiceFromEnv()
+	proposeSafeTransactionsTool := tools.NewProposeSafeTransactionsTool(txService, safeService, safeProposerKey, os.Getenv(services.EnvSafeAddress))
+	srv.AddTool(proposeSafeTransactionsTool.GetTool(), proposeSafeTransactionsTool.GetHandler())
+	s.safeProposals = services.NewSafeProposalMonitor(txService, safeService, services.DefaultSafeProposalPollInterval)
+
+	// Search Tools
+	searchTool := tools.NewSearchTool(services.NewSearchService(dbService.GetDB()), serverPort)
+	srv.AddTool(searchTool.GetTool(), searchTool.GetHandler())
+
+	// Audit Tools
+	getAuditLogTool := tools.NewGetAuditLogTool(auditService, auditRetention)
+	srv.AddTool(getAuditLogTool.GetTool(), getAuditLogTool.GetHandler())
+
+	// API keys of the machine clients, admin only
+	apiKeyService := services.NewAPIKeyService(dbService.GetDB())
+	createAPIKeyTool := tools.NewCreateAPIKeyTool(apiKeyService)
+	srv.AddTool(createAPIKeyTool.GetTool(), createAPIKeyTool.GetHandler())
+
+	revokeAPIKeyTool := tools.NewRevokeAPIKeyTool(apiKeyService)
+	srv.AddTool(revokeAPIKeyTool.GetTool(), revokeAPIKeyTool.GetHandler())
+
+	// Platform fees and referrals, the fees themselves are appended to the sessions by the transaction service
+	platformFeeService := services.NewPlatformFeeService(dbService.GetDB())
+	setReferrerTool := tools.NewSetReferrerTool(platformFeeService)
+	srv.AddTool(setReferrerTool.GetTool(), setReferrerTool.GetHandler())
+	getPlatformRevenueTool := tools.NewGetPlatformRevenueTool(platformFeeService)
+	srv.AddTool(getPlatformRevenueTool.GetTool(), getPlatformRevenueTool.GetHandler())
+
+	// Ownership proofs, the wallet signs a nonce message verified by the API server
+	requestSignatureTool := tools.NewRequestSignatureTool(chainService, txService, serverPort)
+	srv.AddTool(requestSignatureTool.GetTool(), requestSignatureTool.GetHandler())
+
+	// Offline signing, the unsigned transactions of a session are signed on an air-gapped machine
+	exportUnsignedTransactionsTool := tools.NewExportUnsignedTransactionsTool(txService)
+	srv.AddTool(exportUnsignedTransactionsTool.GetTool(), exportUnsignedTransactionsTool.GetHandler())
+	submitSignedTransactionTool := tools.NewSubmitSignedTransactionTool(txService)
+	srv.AddTool(submitSignedTransactionTool.GetTool(), submitSignedTransactionTool.GetHandler())
+	s.submitSignedTransaction = submitSignedTransactionTool
+
+	// Cleanup of the sessions and deployments that should not be launched
+	cancelSessionTool := tools.NewCancelSessionTool(txService)
+	srv.AddTool(cancelSessionTool.GetTool(), cancelSessionTool.GetHandler())
+	abandonDeploymentTool := tools.NewAbandonDeploymentTool(deploymentService, evmService, txService, serverPort)
+	srv.AddTool(abandonDeploymentTool.GetTool(), abandonDeploymentTool.GetHandler())
+
+	renounceOwnershipTool := tools.NewRenounceOwnershipTool(deploymentService, evmService, txService, serverPort)
+	srv.AddTool(renounceOwnershipTool.GetTool(), renounceOwnershipTool.GetHandler())
+
+	listDeploymentsTool, listDeploymentsHandler := tools.NewListDeploymentsTool(readDeploymentService)
 	srv.AddTool(listDeploymentsTool, listDeploymentsHandler)
 
 	addDeploymentTool := tools.NewAddDeploymentTool(deploymentService, templateService, chainService)
@@ -97,6 +264,127 @@ func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int
 	callFunctionTool := tools.NewCallFunctionTool(templateService, evmService, txService, chainService, deploymentService, serverPort)
 	srv.AddTool(callFunctionTool.GetTool(), callFunctionTool.GetHandler())
 
+	callContractTool := tools.NewCallContractTool(templateService, evmService, txService, chainService, deploymentService, serverPort)
+	srv.AddTool(callContractTool.GetTool(), callContractTool.GetHandler())
+
+	readContractTool := tools.NewReadContractTool(readTemplateService, chainService, readDeploymentService)
+	srv.AddTool(readContractTool.GetTool(), readContractTool.GetHandler())
+
+	queryEventsTool := tools.NewQueryEventsTool(readTemplateService, chainService, readDeploymentService)
+	srv.AddTool(queryEventsTool.GetTool(), queryEventsTool.GetHandler())
+
+	decodeTransactionTool := tools.NewDecodeTransactionTool(readTemplateService, chainService, readDeploymentService, uniswapService)
+	srv.AddTool(decodeTransactionTool.GetTool(), decodeTransactionTool.GetHandler())
+
+	detectInterfacesTool := tools.NewDetectInterfacesTool(chainService, deploymentService)
+	srv.AddTool(detectInterfacesTool.GetTool(), detectInterfacesTool.GetHandler())
+
+	// Access Control Tools
+	roleService := services.NewRoleService(dbService.GetDB())
+	manageRolesTool := tools.NewManageRolesTool(chainService, deploymentService, evmService, txService, roleService, serverPort)
+	srv.AddTool(manageRolesTool.GetTool(), manageRolesTool.GetHandler())
+
+	// Governance Tools
+	deployGovernanceTool := tools.NewDeployGovernanceTool(chainService, templateService, deploymentService, evmService, txService, serverPort)
+	srv.AddTool(deployGovernanceTool.GetTool(), deployGovernanceTool.GetHandler())
+
+	// Staking Tools
+	stakingService := services.NewStakingService(dbService.GetDB())
+	createStakingPoolTool := tools.NewCreateStakingPoolTool(chainService, templateService, deploymentService, liquidityService, stakingService, evmService, txService, serverPort)
+	srv.AddTool(createStakingPoolTool.GetTool(), createStakingPoolTool.GetHandler())
+
+	getStakingPoolTool := tools.NewGetStakingPoolTool(stakingService)
+	srv.AddTool(getStakingPoolTool.GetTool(), getStakingPoolTool.GetHandler())
+
+	launchNFTCollectionTool := tools.NewLaunchNFTCollectionTool(chainService, templateService, deploymentService, evmService, txService, serverPort)
+	srv.AddTool(launchNFTCollectionTool.GetTool(), launchNFTCollectionTool.GetHandler())
+
+	// Bonding Curve Tools, the state of the deployed curves is tracked until they graduate
+	bondingCurveService := services.NewBondingCurveService(dbService.GetDB())
+	launchBondingCurveTool := tools.NewLaunchBondingCurveTool(chainService, templateService, deploymentService, uniswapService, bondingCurveService, evmService, txService, serverPort)
+	srv.AddTool(launchBondingCurveTool.GetTool(), launchBondingCurveTool.GetHandler())
+	s.bondingCurves = services.NewBondingCurveMonitor(bondingCurveService, utils.ReadBondingCurveState, services.DefaultBondingCurvePollInterval)
+
+	// Dutch Auction Tools, the deployed auctions are tracked until they are settled
+	dutchAuctionService := services.NewDutchAuctionService(dbService.GetDB())
+	launchDutchAuctionTool := tools.NewLaunchDutchAuctionTool(chainService, templateService, deploymentService, uniswapService, dutchAuctionService, evmService, txService, serverPort)
+	srv.AddTool(launchDutchAuctionTool.GetTool(), launchDutchAuctionTool.GetHandler())
+	settleDutchAuctionTool := tools.NewSettleDutchAuctionTool(chainService, dutchAuctionService, txService, serverPort)
+	srv.AddTool(settleDutchAuctionTool.GetTool(), settleDutchAuctionTool.GetHandler())
+	s.dutchAuctions = services.NewDutchAuctionMonitor(dutchAuctionService, utils.ReadDutchAuctionState, services.DefaultDutchAuctionPollInterval)
+
+	manageAddressListTool := tools.NewManageAddressListTool(chainService, deploymentService, evmService, txService, uniswapService, liquidityService, services.NewAddressListService(dbService.GetDB()), addressBookService, serverPort)
+	srv.AddTool(manageAddressListTool.GetTool(), manageAddressListTool.GetHandler())
+
+	configureTokenFeesTool := tools.NewConfigureTokenFeesTool(chainService, deploymentService, evmService, txService, serverPort)
+	srv.AddTool(configureTokenFeesTool.GetTool(), configureTokenFeesTool.GetHandler())
+
+	// Trading Launch Tools
+	tradingLaunchService := services.NewTradingLaunchService(dbService.GetDB())
+	enableTradingTool := tools.NewEnableTradingTool(chainService, deploymentService, evmService, txService, tradingLaunchService, serverPort)
+	srv.AddTool(enableTradingTool.GetTool(), enableTradingTool.GetHandler())
+
+	s.tradingLaunches = services.NewTradingLaunchScheduler(tradingLaunchService, tools.NewTradingLaunchExecutor(enableTradingTool), func(launch models.TradingLaunch, sessionID string) {
+		// Sessions of authenticated users are not broadcast to every connected client
+		if launch.UserID != nil {
+			return
+		}
+		url, err := utils.GetTransactionSessionUrl(context.Background(), serverPort, sessionID)
+		if err != nil {
+			return
+		}
+		srv.SendNotificationToAllClients("notifications/message", map[string]any{
+			"level":  "info",
+			"logger": "launchpad",
+			"data": map[string]any{
+				"message":           fmt.Sprintf("Trading launch %d reached its opening time, enable trading is ready to sign", launch.ID),
+				"trading_launch_id": launch.ID,
+				"session_id":        sessionID,
+				"signing_url":       url,
+			},
+		})
+	}, services.DefaultTradingLaunchPollInterval)
+
+	// Integration Tools
+	generateIntegrationSnippetTool := tools.NewGenerateIntegrationSnippetTool(deploymentService)
+	srv.AddTool(generateIntegrationSnippetTool.GetTool(), generateIntegrationSnippetTool.GetHandler())
+
+	generateAbiTypingsTool := tools.NewGenerateAbiTypingsTool(deploymentService, serverPort)
+	srv.AddTool(generateAbiTypingsTool.GetTool(), generateAbiTypingsTool.GetHandler())
+
+	getDeploymentArtifactsTool := tools.NewGetDeploymentArtifactsTool(deploymentService, txService, serverPort)
+	srv.AddTool(getDeploymentArtifactsTool.GetTool(), getDeploymentArtifactsTool.GetHandler())
+
+	// Logos are uploaded to the storage of LAUNCHPAD_ASSET_STORAGE
+	assetStorage, err := services.NewAssetStorageFromEnv()
+	if err != nil {
+		log.Fatal("Failed to configure the asset storage:", err)
+	}
+	setTokenMetadataTool := tools.NewSetTokenMetadataTool(deploymentService, services.NewTokenMetadataService(dbService.GetDB()), assetStorage, serverPort)
+	srv.AddTool(setTokenMetadataTool.GetTool(), setTokenMetadataTool.GetHandler())
+
+	generateSubgraphTool := tools.NewGenerateSubgraphTool(deploymentService, liquidityService)
+	srv.AddTool(generateSubgraphTool.GetTool(), generateSubgraphTool.GetHandler())
+
+	// Analytics Tools, the event indexer backfills and tails the events of the launched tokens and pools
+	indexerService := services.NewIndexerService(dbService.GetDB())
+	s.eventIndexer = services.NewEventIndexer(chainService, deploymentService, liquidityService, indexerService, utils.FetchContractEvents, services.DefaultIndexerPollInterval)
+
+	exportLaunchDataTool := tools.NewExportLaunchDataTool(readDeploymentService, readLiquidityService, services.NewTransactionService(readDB))
+	srv.AddTool(exportLaunchDataTool.GetTool(), exportLaunchDataTool.GetHandler())
+
+	generateAnalyticsQueriesTool := tools.NewGenerateAnalyticsQueriesTool(readDeploymentService, readLiquidityService)
+	srv.AddTool(generateAnalyticsQueriesTool.GetTool(), generateAnalyticsQueriesTool.GetHandler())
+
+	generateLaunchReportTool := tools.NewGenerateLaunchReportTool(readDeploymentService, readLiquidityService, services.NewUniswapService(readDB), services.NewIndexerService(readDB), serverPort)
+	srv.AddTool(generateLaunchReportTool.GetTool(), generateLaunchReportTool.GetHandler())
+
+	snapshotHoldersTool := tools.NewSnapshotHoldersTool(readDeploymentService, services.NewIndexerService(readDB))
+	srv.AddTool(snapshotHoldersTool.GetTool(), snapshotHoldersTool.GetHandler())
+
+	analyzePoolReturnsTool := tools.NewAnalyzePoolReturnsTool(chainService, readLiquidityService, services.NewUniswapService(readDB), services.NewIndexerService(readDB))
+	srv.AddTool(analyzePoolReturnsTool.GetTool(), analyzePoolReturnsTool.GetHandler())
+
 	// Uniswap Deployment Tools
 	deployUniswapTool := tools.NewDeployUniswapTool(chainService, serverPort, evmService, txService, uniswapService)
 	srv.AddTool(deployUniswapTool.GetTool(), deployUniswapTool.GetHandler())
@@ -111,21 +399,137 @@ func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int
 	srv.AddTool(setUniswapAddressesTool.GetTool(), setUniswapAddressesTool.GetHandler())
 
 	// Liquidity Management Tools
-	createLiquidityPoolTool := tools.NewCreateLiquidityPoolTool(chainService, serverPort, evmService, txService, liquidityService, uniswapService)
+	launchPolicyService := services.NewLaunchPolicyService(dbService.GetDB())
+	manageLaunchPolicyTool := tools.NewManageLaunchPolicyTool(launchPolicyService)
+	srv.AddTool(manageLaunchPolicyTool.GetTool(), manageLaunchPolicyTool.GetHandler())
+
+	createLiquidityPoolTool := tools.NewCreateLiquidityPoolTool(chainService, serverPort, evmService, txService, liquidityService, uniswapService, launchPolicyService)
 	srv.AddTool(createLiquidityPoolTool.GetTool(), createLiquidityPoolTool.GetHandler())
 
-	addLiquidityTool := tools.NewAddLiquidityTool(chainService, serverPort, evmService, txService, liquidityService, uniswapService)
+	addLiquidityTool := tools.NewAddLiquidityTool(chainService, serverPort, evmService, txService, liquidityService, uniswapService, launchPolicyService)
 	srv.AddTool(addLiquidityTool.GetTool(), addLiquidityTool.GetHandler())
 
 	removeLiquidityTool, removeLiquidityHandler := tools.NewRemoveLiquidityTool(chainService, liquidityService, uniswapService, txService, serverPort)
 	srv.AddTool(removeLiquidityTool, removeLiquidityHandler)
 
+	migrateLiquidityTool := tools.NewMigrateLiquidityTool(chainService, evmService, txService, liquidityService, uniswapService, launchPolicyService, serverPort)
+	srv.AddTool(migrateLiquidityTool.GetTool(), migrateLiquidityTool.GetHandler())
+
+	rebalancePoolTool := tools.NewRebalancePoolTool(chainService, evmService, txService, liquidityService, uniswapService, launchPolicyService, serverPort)
+	srv.AddTool(rebalancePoolTool.GetTool(), rebalancePoolTool.GetHandler())
+
 	// Trading Tools
-	swapTokensTool := tools.NewSwapTokensTool(chainService, liquidityService, uniswapService, txService, serverPort, evmService)
+	uniswapContractService := services.NewUniswapContractService(uniswapService)
+	swapTokensTool := tools.NewSwapTokensTool(chainService, liquidityService, uniswapService, txService, serverPort, evmService, uniswapContractService, services.NewJupiterServiceFromEnv())
 	srv.AddTool(swapTokensTool.GetTool(), swapTokensTool.GetHandler())
 
+	// Limit Order Tools
+	limitOrderService := services.NewLimitOrderService(dbService.GetDB())
+	createLimitOrderTool := tools.NewCreateLimitOrderTool(chainService, liquidityService, limitOrderService)
+	srv.AddTool(createLimitOrderTool.GetTool(), createLimitOrderTool.GetHandler())
+
+	listLimitOrdersTool := tools.NewListLimitOrdersTool(services.NewLimitOrderService(readDB), serverPort)
+	srv.AddTool(listLimitOrdersTool.GetTool(), listLimitOrdersTool.GetHandler())
+
+	cancelLimitOrderTool := tools.NewCancelLimitOrderTool(limitOrderService)
+	srv.AddTool(cancelLimitOrderTool.GetTool(), cancelLimitOrderTool.GetHandler())
+
+	s.limitOrderMonitor = services.NewLimitOrderMonitor(limitOrderService, liquidityService, uniswapService, uniswapContractService, tools.NewLimitOrderExecutor(swapTokensTool), services.DefaultLimitOrderPollInterval)
+
+	// Recurring Swap Tools
+	recurringSwapService := services.NewRecurringSwapService(dbService.GetDB())
+	scheduleRecurringSwapTool := tools.NewScheduleRecurringSwapTool(chainService, recurringSwapService)
+	srv.AddTool(scheduleRecurringSwapTool.GetTool(), scheduleRecurringSwapTool.GetHandler())
+
+	listRecurringSwapsTool := tools.NewListRecurringSwapsTool(services.NewRecurringSwapService(readDB), serverPort)
+	srv.AddTool(listRecurringSwapsTool.GetTool(), listRecurringSwapsTool.GetHandler())
+
+	cancelRecurringSwapTool := tools.NewCancelRecurringSwapTool(recurringSwapService)
+	srv.AddTool(cancelRecurringSwapTool.GetTool(), cancelRecurringSwapTool.GetHandler())
+
+	s.recurringSwaps = services.NewRecurringSwapScheduler(recurringSwapService, tools.NewRecurringSwapExecutor(swapTokensTool), func(swap models.RecurringSwap, sessionID string) {
+		// Sessions of authenticated users are not broadcast to every connected client
+		if swap.UserID != nil {
+			return
+		}
+		url, err := utils.GetTransactionSessionUrl(context.Background(), serverPort, sessionID)
+		if err != nil {
+			return
+		}
+		srv.SendNotificationToAllClients("notifications/message", map[string]any{
+			"level":  "info",
+			"logger": "launchpad",
+			"data": map[string]any{
+				"message":           fmt.Sprintf("Recurring swap %d is ready to sign", swap.ID),
+				"recurring_swap_id": swap.ID,
+				"session_id":        sessionID,
+				"signing_url":       url,
+			},
+		})
+	}, services.DefaultRecurringSwapPollInterval)
+
+	// Buyback Tools
+	buybackService := services.NewBuybackService(dbService.GetDB())
+	scheduleBuybackTool := tools.NewScheduleBuybackTool(chainService, deploymentService, buybackService)
+	srv.AddTool(scheduleBuybackTool.GetTool(), scheduleBuybackTool.GetHandler())
+
+	listBuybacksTool := tools.NewListBuybacksTool(services.NewBuybackService(readDB), serverPort)
+	srv.AddTool(listBuybacksTool.GetTool(), listBuybacksTool.GetHandler())
+
+	cancelBuybackTool := tools.NewCancelBuybackTool(buybackService)
+	srv.AddTool(cancelBuybackTool.GetTool(), cancelBuybackTool.GetHandler())
+
+	s.buybacks = services.NewBuybackScheduler(buybackService, tools.NewBuybackExecutor(swapTokensTool), func(buyback models.Buyback, sessionID string) {
+		// Sessions of authenticated users are not broadcast to every connected client
+		if buyback.UserID != nil {
+			return
+		}
+		url, err := utils.GetTransactionSessionUrl(context.Background(), serverPort, sessionID)
+		if err != nil {
+			return
+		}
+		srv.SendNotificationToAllClients("notifications/message", map[string]any{
+			"level":  "info",
+			"logger": "launchpad",
+			"data": map[string]any{
+				"message":     fmt.Sprintf("Buyback %d is ready to sign", buyback.ID),
+				"buyback_id":  buyback.ID,
+				"session_id":  sessionID,
+				"signing_url": url,
+			},
+		})
+	}, services.DefaultBuybackPollInterval)
+
+	// Pool Alert Tools, rules are evaluated against the pair reserves and the LP transfers of the event indexer
+	alertService := services.NewAlertService(dbService.GetDB())
+	alertNotifier := services.NewAlertNotifierFromEnv()
+	configureAlertTool := tools.NewConfigureAlertTool(chainService, liquidityService, uniswapContractService, indexerService, alertService, alertNotifier)
+	srv.AddTool(configureAlertTool.GetTool(), configureAlertTool.GetHandler())
+
+	s.poolAlerts = services.NewPoolAlertMonitor(alertService, liquidityService, uniswapContractService, indexerService, alertNotifier, services.DefaultAlertPollInterval)
+
+	// MEV protected transactions submitted through private relays
+	s.privateTxMonitor = services.NewPrivateTransactionMonitor(services.NewPrivateTransactionService(dbService.GetDB()), services.DefaultPrivateTransactionPollInterval, services.DefaultPrivateTransactionTimeout)
+
+	// Simulated buy-then-sell once liquidity is added or trading opens, alerting when the bought token cannot be sold
+	s.sellTests = services.NewSellTestMonitor(deploymentService, liquidityService, uniswapService, uniswapContractService, utils.SimulateBuyThenSell, func(deployment models.Deployment, result models.JSON) {
+		// Deployments of authenticated users are not broadcast to every connected client
+		if deployment.UserID != nil {
+			return
+		}
+		srv.SendNotificationToAllClients("notifications/message", map[string]any{
+			"level":  "warning",
+			"logger": "launchpad",
+			"data": map[string]any{
+				"message":       fmt.Sprintf("Simulated sell of deployment %d (%s) failed, the token may not be tradable. Check the transfer restrictions of the template", deployment.ID, deployment.ContractAddress),
+				"deployment_id": deployment.ID,
+				"sell_test":     result,
+			},
+		})
+	}, services.DefaultSellTestPollInterval)
+
 	// Read-only Information Tools
-	getPoolInfoTool, getPoolInfoHandler := tools.NewGetPoolInfoTool(chainService, liquidityService)
+	getPoolInfoTool, getPoolInfoHandler := tools.NewGetPoolInfoTool(chainService, liquidityService, serverPort)
 	srv.AddTool(getPoolInfoTool, getPoolInfoHandler)
 
 	getSwapQuoteTool, getSwapQuoteHandler := tools.NewGetSwapQuoteTool(chainService, liquidityService, uniswapService)
@@ -135,9 +539,161 @@ func (s *MCPServer) InitializeTools(dbService services.DBService, serverPort int
 	queryBalanceTool, queryBalanceHandler := tools.NewQueryBalanceTool(chainService, txService, serverPort)
 	srv.AddTool(queryBalanceTool, queryBalanceHandler)
 
+	// Allowance Tools
+	listAllowancesTool := tools.NewListAllowancesTool(chainService, deploymentService, liquidityService, uniswapService)
+	srv.AddTool(listAllowancesTool.GetTool(), listAllowancesTool.GetHandler())
+
+	revokeAllowanceTool := tools.NewRevokeAllowanceTool(chainService, deploymentService, liquidityService, uniswapService, evmService, txService, serverPort)
+	srv.AddTool(revokeAllowanceTool.GetTool(), revokeAllowanceTool.GetHandler())
+
+	getPortfolioTool := tools.NewGetPortfolioTool(chainService, deploymentService, liquidityService, uniswapService, uniswapContractService, services.NewPriceServiceFromEnv())
+	srv.AddTool(getPortfolioTool.GetTool(), getPortfolioTool.GetHandler())
+
+	// Transfer Tools
+	transferTokenTool := tools.NewTransferTokenTool(chainService, evmService, txService, serverPort)
+	srv.AddTool(transferTokenTool.GetTool(), transferTokenTool.GetHandler())
+
+	// Account abstraction, the smart accounts sending the gasless transactions
+	getSmartAccountTool := tools.NewGetSmartAccountTool(chainService)
+	srv.AddTool(getSmartAccountTool.GetTool(), getSmartAccountTool.GetHandler())
+
+	// Custom Tools, the contract calls declared in the file of LAUNCHPAD_CUSTOM_TOOLS
+	customTools, err := services.LoadCustomToolsFromEnv()
+	if err != nil {
+		log.Fatal("Failed to load the custom tools:", err)
+	}
+	if err := registerCustomTools(srv, customTools, evmService, txService, chainService, serverPort); err != nil {
+		log.Fatal("Failed to register the custom tools:", err)
+	}
+
+	// Read-only resources of the launchpad state, clients are notified when they change
+	registerResources(srv, launchpadResources{
+		chainService:      chainService,
+		templateService:   templateService,
+		deploymentService: deploymentService,
+		txService:         txService,
+	})
+	s.resourceSubscriptions = newResourceSubscriptions()
+	if err := notifyResourceUpdates(dbService.GetDB(), srv, s.resourceSubscriptions); err != nil {
+		log.Printf("Error registering the resource update callbacks: %v", err)
+	}
+
 	s.server = srv
 }
 
+// SetLeaderElector runs the background jobs only while this replica is the leader of the cluster
+func (s *MCPServer) SetLeaderElector(elector *services.LeaderElector) {
+	s.leaderElector = elector
+}
+
+// StartBackgroundJobs starts the jobs that run alongside the MCP server, such as the limit order monitor. With a
+// leader elector they start once this replica is elected
+func (s *MCPServer) StartBackgroundJobs() {
+	if s.leaderElector != nil {
+		s.leaderElector.Start(s.startJobs, s.stopJobs)
+		return
+	}
+	s.startJobs()
+}
+
+// StopBackgroundJobs stops the jobs started by StartBackgroundJobs, a leader hands them over to another replica
+func (s *MCPServer) StopBackgroundJobs() {
+	if s.leaderElector != nil {
+		s.leaderElector.Stop()
+		return
+	}
+	s.stopJobs()
+}
+
+func (s *MCPServer) startJobs() {
+	if s.limitOrderMonitor != nil {
+		s.limitOrderMonitor.Start()
+	}
+	if s.recurringSwaps != nil {
+		s.recurringSwaps.Start()
+	}
+	if s.buybacks != nil {
+		s.buybacks.Start()
+	}
+	if s.privateTxMonitor != nil {
+		s.privateTxMonitor.Start()
+	}
+	if s.tradingLaunches != nil {
+		s.tradingLaunches.Start()
+	}
+	if s.sellTests != nil {
+		s.sellTests.Start()
+	}
+	if s.auditPruner != nil {
+		s.auditPruner.Start()
+	}
+	if s.safeProposals != nil {
+		s.safeProposals.Start()
+	}
+	if s.eventIndexer != nil {
+		s.eventIndexer.Start()
+	}
+	if s.poolAlerts != nil {
+		s.poolAlerts.Start()
+	}
+	if s.bondingCurves != nil {
+		s.bondingCurves.Start()
+	}
+	if s.dutchAuctions != nil {
+		s.dutchAuctions.Start()
+	}
+}
+
+func (s *MCPServer) stopJobs() {
+	if s.limitOrderMonitor != nil {
+		s.limitOrderMonitor.Stop()
+	}
+	if s.recurringSwaps != nil {
+		s.recurringSwaps.Stop()
+	}
+	if s.buybacks != nil {
+		s.buybacks.Stop()
+	}
+	if s.privateTxMonitor != nil {
+		s.privateTxMonitor.Stop()
+	}
+	if s.tradingLaunches != nil {
+		s.tradingLaunches.Stop()
+	}
+	if s.sellTests != nil {
+		s.sellTests.Stop()
+	}
+	if s.auditPruner != nil {
+		s.auditPruner.Stop()
+	}
+	if s.safeProposals != nil {
+		s.safeProposals.Stop()
+	}
+	if s.eventIndexer != nil {
+		s.eventIndexer.Stop()
+	}
+	if s.poolAlerts != nil {
+		s.poolAlerts.Stop()
+	}
+	if s.bondingCurves != nil {
+		s.bondingCurves.Stop()
+	}
+	if s.dutchAuctions != nil {
+		s.dutchAuctions.Stop()
+	}
+}
+
+// SetHookService sets the hooks run when a Safe executes the proposed transactions of a session or a transaction
+// signed offline is submitted
+func (s *MCPServer) SetHookService(hookService services.HookService) {
+	if s.safeProposals != nil {
+		s.safeProposals.SetHookService(hookService)
+	}
+	if s.submitSignedTransaction != nil {
+		s.submitSignedTransaction.SetHookService(hookService)
+	}
+}
+
 func (s *MCPServer) SendMessageToAiClient(messages []mcp.SamplingMessage) error {
 	samplingRequest := mcp.CreateMessageRequest{
 		CreateMessageParams: mcp.CreateMessageParams{
@@ -164,11 +720,13 @@ func getToolInstructions(category string) string {
 1. list_chains - List all available blockchain chains with their configurations
    Usage: View all configured chains and identify the active one
 
-2. select_chain - Select active blockchain by chain_type or chain_id
-   Usage: Switch between configured blockchains using either legacy chain_type or precise chain_id
+2. select_chain - Select the default blockchain by chain_type or uuid
+   Usage: Switch between configured blockchains using either legacy chain_type or precise uuid. Authenticated users set their own default chain, without authentication the global active chain is switched
 
 3. set_chain - Configure blockchain RPC and chain ID
-   Usage: Set up custom RPC endpoints and chain configurations`
+   Usage: Set up custom RPC endpoints and chain configurations; set private_relay_rpc (e.g. Flashbots Protect) to enable mev_protection for swaps and liquidity changes; set bundler_rpc and optionally paymaster_rpc (ERC-4337 EntryPoint v0.6) to enable gasless launches and swaps
+
+Every other tool also accepts an optional chain_id (the id of list_chains) running that single call on the given chain, without changing the default chain. Calls without it use the default chain of the user, then the global active chain`
 
 	case "template":
 		return `Template Management Tools:
@@ -177,7 +735,7 @@ func getToolInstructions(category string) string {
    Usage: Browse available contract templates by chain type
 
 2. create_template - Create new contract template with validation
-   Usage: Add custom smart contract templates for deployment
+   Usage: Add custom smart contract templates for deployment. Solana templates are single file Anchor programs, validated (and built when the anchor toolchain is installed, LAUNCHPAD_ANCHOR_PATH overrides the binary) with their IDL stored on the template
 
 3. update_template - Update existing template
    Usage: Modify existing contract templates
@@ -190,13 +748,62 @@ func getToolInstructions(category string) string {
    Parameters:
    - template_id (required): ID of the template to view
    - show_abi_methods (optional): Display all available ABI methods
-   - abi_method (optional): Display details for a specific method by name`
+   - abi_method (optional): Display details for a specific method by name
+
+6. template_leaderboard - Rank templates by their launch history (read-only)
+   Usage: Pick proven templates over untested ones using the launch success rate, average deployment gas cost, pool price performance and sell test results of each template
+   Parameters:
+   - chain_type (optional): Only rank templates of this chain type
+   - limit (optional): Maximum number of templates to return, defaults to 10
+
+7. export_templates - Export templates as a shareable pack (read-only)
+   Usage: Share templates with their code, metadata, sample values and ABI as a JSON bundle or a directory of template files
+   Parameters:
+   - template_ids (optional): Comma-separated template IDs, exports every template when omitted
+   - chain_type (optional): Only export templates of this chain type
+   - format (optional): json (default) returns the bundle, directory writes the files and a templates.json manifest (stdio only)
+   - directory (optional): Directory to write to, required with the directory format
+
+8. import_templates - Import a template pack created by export_templates
+   Usage: Create the templates of a pack shared by another user, every template is validated before any is created
+   Parameters:
+   - bundle (optional): JSON bundle returned by export_templates
+   - directory (optional): Directory written by export_templates (stdio only)
+   - pack (optional): Built-in pack to import instead, cross-chain holds the LayerZero OFT and Axelar ITS token templates
+
+9. browse_registry - Browse the subscribed remote template registry (read-only)
+   Usage: Discover shared templates and their versions, including the versions already installed. The registry is set with LAUNCHPAD_TEMPLATE_REGISTRY_URL
+   Parameters:
+   - keyword (optional): Filter by name or description
+   - chain_type (optional): Only return templates of this chain type
+
+10. install_template - Install a registry template
+   Usage: Download the signed bundle of a registry template, verify its signature with LAUNCHPAD_TEMPLATE_REGISTRY_PUBLIC_KEY and import it. Installing an installed version reuses the local templates
+   Parameters:
+   - name (required): Name of the registry template
+   - version (optional): Version to install, defaults to the last version listed by the registry
+
+11. get_template_report - Compare the gas reports of templates (read-only)
+   Usage: Compare the compiler gas estimates of the deployment and of every external function, and the compiler warnings, computed when a template is created or its code updated
+   Parameters:
+   - template_ids (optional): Comma-separated template IDs, reports every template when omitted
+   - chain_type (optional): Only report templates of this chain type
+
+12. test_template - Test a template on a throwaway Anvil node before launching it
+   Usage: Deploy the rendered template and run the built-in ERC20 suite (ownership, mint, transfer, approve, supply conservation and random transfers). Needs Anvil, LAUNCHPAD_ANVIL_PATH overrides the binary
+   Parameters:
+   - template_id (required): ID of the template to test
+   - contract_name (optional): Contract to deploy, defaults to the contract of the gas report
+   - template_values (optional): Template values, defaults to the sample values
+   - constructor_args (optional): Constructor arguments
+   - fuzz_runs (optional): Number of random transfers, defaults to 20
+   - seed (optional): Seed of the random transfers to replay a failed report`
 
 	case "deployment":
 		return `Deployment Tools:
 
 1. launch - Generate deployment URL with signing interface
-   Usage: Deploy contracts through a web interface that opens for wallet signing
+   Usage: Deploy contracts through a web interface that opens for wallet signing. Anchor programs are not deployed through sessions yet, use anchor deploy; pass gasless to deploy from the smart account of the wallet through the bundler of the chain, sponsored by its paymaster
 
 2. list_deployments - List all token deployments with filtering options
    Usage: View all deployed contracts with status, addresses, and transaction details
@@ -208,7 +815,377 @@ func getToolInstructions(category string) string {
    - function_name (required): Name of function to call from contract's ABI
    - function_args (optional): Array of function arguments in ABI order
    - value (optional): ETH value to send with call (default "0")
-   - metadata (optional): Transaction metadata for state-changing functions`
+   - metadata (optional): Transaction metadata for state-changing functions
+
+4. generate_integration_snippet - Generate integration code snippets for a confirmed deployment
+   Usage: Get ready-to-paste ethers.js, viem, wagmi and web3.py snippets with the contract address and ABI inlined, the RPC URL is read from the RPC_URL environment variable
+   Parameters:
+   - deployment_id (required): ID of the confirmed deployment
+   - languages (optional): Subset of snippet languages (ethers, viem, wagmi, web3py)
+
+5. generate_abi_typings - Generate TypeScript typings for a confirmed deployment's ABI
+   Usage: Get an abitype/viem compatible .ts file with the ABI as const and typed function args, returns and events, plus a download URL
+   Parameters:
+   - deployment_id (required): ID of the confirmed deployment
+
+6. generate_subgraph - Generate a Graph Protocol subgraph for a launched token and its pool
+   Usage: Get subgraph.yaml, schema.graphql, ABIs and mappings indexing Transfer, Swap, Mint and Burn events
+   Parameters:
+   - deployment_id (required): ID of the confirmed token deployment
+   - network (optional): Graph network name, derived from the chain ID by default
+   - start_block (optional): Block to start indexing from, defaults to the deployment block
+
+7. export_launch_data - Export launch data as CSV for analytics platforms
+   Usage: Dump deployments, pools or indexed swaps with a stable column schema for Dune, BigQuery or spreadsheets
+   Parameters:
+   - dataset (required): One of deployments, pools, swaps
+
+8. generate_analytics_queries - Generate starter DuneSQL queries for a launched token
+   Usage: Get queries for transfers, holders, daily activity, pool swaps and reserves referencing the contract and pair addresses
+   Parameters:
+   - deployment_id (required): ID of the confirmed token deployment
+   - dune_chain (optional): Dune chain name, derived from the chain ID by default
+
+9. detect_interfaces - Detect the interfaces a contract supports
+   Usage: Probe ERC-20, ERC-721, ERC-1155, ERC-2612 permit, Ownable and AccessControl support via supportsInterface and bytecode selectors; stored on the deployment so call_function refuses unsupported mint, burn, pause and permit flows
+   Parameters:
+   - deployment_id (optional): ID of the confirmed deployment to probe
+   - contract_address (optional): Any contract address on the active chain, used when no deployment_id is given
+
+10. manage_roles - Manage OpenZeppelin AccessControl roles of a deployment
+    Usage: grant, revoke and renounce create a signing session; list returns role members synced from RoleGranted/RoleRevoked events into a local cache
+    Parameters:
+    - deployment_id (required): ID of the confirmed deployment
+    - action (required): One of grant, revoke, renounce, list
+    - role (optional): Role name such as MINTER_ROLE or a bytes32 identifier, required except for list
+    - account (optional): Account the role is granted to, revoked from or renounced by
+
+11. manage_address_list - Administer the blacklist or whitelist of a deployed token
+    Usage: update batches add and remove operations into one signing session and records the lists once it is confirmed; report returns the recorded list and its change history for compliance reporting
+    Parameters:
+    - deployment_id (required): ID of the confirmed deployment whose template has blacklist/whitelist functions
+    - list_type (required): blacklist or whitelist
+    - action (required): update or report
+    - add (optional): Addresses or @labels of the address book to add to the list, the saved addresses cannot be blacklisted
+    - remove (optional): Addresses or @labels of the address book to remove from the list
+
+12. configure_token_fees - Manage the buy/sell fees and fee receiver of a deployed taxed token
+    Usage: read returns the current fees and the maximums declared by the template; update validates the new fees against those maximums and creates a signing session showing the values before and after
+    Parameters:
+    - deployment_id (required): ID of the confirmed deployment whose template has setBuyFee/setSellFee/setFeeReceiver style functions
+    - action (required): read or update
+    - buy_fee (optional): New buy fee in the unit of the contract
+    - sell_fee (optional): New sell fee in the unit of the contract
+    - fee_receiver (optional): New address receiving the fees
+
+13. enable_trading - Final launch step for tokens whose transfers are gated behind enableTrading
+    Usage: without opens_at the enable trading signing session is created right away; with opens_at the launch is scheduled behind a public countdown page and the session is created and announced at the opening time. Confirming the transaction records the opening snapshot of the token pool
+    Parameters:
+    - deployment_id (required): ID of the confirmed deployment whose template has an enableTrading/openTrading/setTradingEnabled style function
+    - opens_at (optional): RFC3339 time trading opens, defaults to now
+
+14. launch_readiness - Pass/warn/fail checklist to run before launch
+    Usage: Checks the template compiler report, the RPC and chain ID of the active chain, the Uniswap deployment, the deployer balance against the deployment gas and the template values. Fix the failed checks before calling launch
+    Parameters:
+    - template_id (required): ID of the template to launch
+    - template_values (optional): Template values of the launch, defaults to the sample values
+    - deployer_address (optional): Wallet signing the deployment, the balance check warns without it
+
+15. estimate_deployment_cost - Compare the launch cost of a template across the configured chains
+    Usage: Compiles the template, estimates the deployment gas with eth_estimateGas on every Ethereum-compatible chain (compiler estimate as fallback), adds the Uniswap V2 pool creation gas and prices the total at the live gas price in the native token and USD, cheapest first
+    Parameters:
+    - template_id (required): ID of the template to estimate
+    - contract_name (optional): Contract to deploy, defaults to the contract of the gas report
+    - template_values (optional): Template values, defaults to the sample values
+    - constructor_args (optional): Constructor arguments
+    - deployer_address (optional): Sender of the estimated deployment
+    - include_pool (optional): Add the pool creation gas, defaults to true
+
+16. multi_chain_launch - Deploy the same template to several chains at once
+    Usage: Renders the template once and creates one deployment session per chain, grouped under a launch group. Return every session url to the user, each one is signed on its own chain
+    Parameters:
+    - template_id (required): ID of the template to deploy
+    - template_values (required): Template parameter values
+    - chain_ids (required): Chain IDs of the configured chains (list_chains chain_id), e.g. ["1", "8453"]
+    - contract_name (required): Contract to deploy
+    - constructor_args (optional): Constructor arguments used on every chain
+    - value (optional): Value sent with every deployment in wei
+    - metadata (optional): Transaction metadata
+
+17. get_launch_group - Consolidated status of a multi_chain_launch group
+    Usage: Pending until every deployment is signed, confirmed once deployed on every chain, partial or failed when deployments failed; lists the contract address of every chain and the urls still waiting to be signed
+    Parameters:
+    - launch_group_id (required): ID returned by multi_chain_launch
+
+18. bridge_liquidity - Route the initial liquidity ETH of a launch group to its target chains
+    Usage: Creates one session on the settlement chain (Ethereum or Sepolia) with a deposit per target chain through its canonical bridge (OP Stack L1StandardBridge for Optimism and Base, Arbitrum Inbox). The deposits are tracked in the launch group; wait for them to be credited before create_liquidity_pool on the target chain
+    Parameters:
+    - launch_group_id (required): ID returned by multi_chain_launch
+    - targets (required): [{"chain_id": "8453", "amount": "<wei>"}], target chains of the launch group
+    - recipient (optional): Address credited on the target chains, defaults to the signer (not supported by Arbitrum)
+
+19. wire_cross_chain_token - Wire the deployments of a cross-chain token launch group
+    Usage: After multi_chain_launch of a template of the cross-chain pack is confirmed on every chain. layerzero sets the peers and enforced options of every OFT, axelar registers the token with the Interchain Token Service, links it from the home chain and grants the token manager the minter role. Returns one session per chain, for axelar sign them in the returned order (home chain last)
+    Parameters:
+    - launch_group_id (required): ID returned by multi_chain_launch
+    - protocol (required): layerzero or axelar
+    - lz_receive_gas (optional): Executor gas of lzReceive (layerzero), defaults to 200000
+    - signer_address (optional): Wallet signing the home chain session (required for axelar)
+    - home_chain_id (optional): Home chain of the token (axelar), defaults to the HomeChainID template value
+    - gas_value (optional): Wei paid to Axelar per cross-chain registration and link (axelar)
+
+20. create_project - Create a named project with tags
+    Usage: Keep the records of several launches apart; the name is unique among your projects
+    Parameters:
+    - name (required): Name of the project
+    - description (optional): Description of the project
+    - tags (optional): Tags of the project, e.g. mainnet
+
+21. assign_to_project - Assign records to a project
+    Usage: Group the templates, deployments, liquidity pools and transaction sessions of a launch; a record belongs to one project, assigning it again moves it
+    Parameters:
+    - project_id (required): ID returned by create_project
+    - asset_type (required): template, deployment, liquidity_pool or session
+    - asset_ids (required): IDs of the records, session IDs for sessions
+
+22. list_project_assets - List the records of a project (read-only)
+    Usage: Reports the assigned records with the deployment status and the signing url of pending sessions; without project_id lists the projects and their record counts
+    Parameters:
+    - project_id (optional): Project to list the records of
+    - tag (optional): Only list the projects with this tag
+    - asset_type (optional): Only list the records of this type
+
+23. search - Full-text search across templates, deployments, pools and sessions (read-only)
+    Usage: Find records by template name, token name or symbol, the beginning of a contract address or a session title; every word must match, results carry the IDs for the follow-up tools
+    Parameters:
+    - query (required): Words to search for
+    - types (optional): template, deployment, liquidity_pool and/or session
+    - limit (optional): Maximum number of results, defaults to 20, at most 100
+
+24. get_audit_log - List the audit log of tool calls and mutations (read-only)
+    Usage: Compliance review of who called which tool (arguments are stored as a SHA-256 hash) and which rows were created, updated or deleted; authenticated users only see their own entries, entries older than LAUNCHPAD_AUDIT_RETENTION (default 90 days) are pruned
+    Parameters:
+    - kind (optional): tool_call or mutation
+    - tool_name (optional): Only the calls of this tool
+    - status (optional): success or error
+    - entity_type / entity_id (optional): Only the mutations of this table or row
+    - since (optional): RFC 3339 time or duration such as 24h
+    - limit (optional): Maximum number of entries, defaults to 50, at most 500
+
+25. create_api_key - Create an API key for a machine client (admin only)
+    Usage: Let a CI pipeline or bot call the streamable-http server without interactive OAuth, in the X-API-Key header or as a Bearer token; the key is shown once, only its hash is stored
+    Parameters:
+    - name (required): Name of the key
+    - scopes (required): Tools the key may call, or * for every tool
+    - subject (optional): User ID the key authenticates as, defaults to the caller
+    - expires_at (optional): RFC 3339 time or duration such as 720h, defaults to never
+
+26. revoke_api_key - Revoke an API key (admin only)
+    Parameters:
+    - id (required): ID of the API key
+
+27. get_deployment_artifacts - Get the verification artifacts of a confirmed deployment (read-only)
+    Usage: Get the rendered Solidity sources, creation bytecode, ABI and constructor arguments inline, plus a download URL of the zip archive
+    Parameters:
+    - deployment_id (required): ID of the confirmed deployment
+
+28. set_token_metadata - Set the description, links and logo of a confirmed token
+    Usage: The metadata is served at the public /tokens/:deployment_id URL and the token is added to the token list at /tokens/tokenlist.json
+    Parameters:
+    - deployment_id (required): ID of the confirmed token deployment
+    - description (optional): Description of the token
+    - links (optional): JSON object mapping link kinds such as website or twitter to their URLs
+    - logo_base64 (optional): Base64 encoded PNG, JPEG, GIF or WebP logo, up to 1MB
+
+29. save_address - Save an address in the address book under a label
+    Usage: Any tool argument written as @label (e.g. "@treasury") is replaced with the saved address before the tool runs, saving a label again replaces its address
+    Parameters:
+    - label (required): Label such as treasury or marketing wallet, matched case-insensitively
+    - address (required): EVM address or Solana public key
+    - chain_type (optional): ethereum (default) or solana
+    - notes (optional): Notes about the address
+
+30. list_addresses - List the saved addresses by label (read-only)
+    Parameters:
+    - chain_type (optional): Only list the addresses of this chain type
+
+31. propose_safe_transactions - Propose the transactions of a pending session to a Safe multisig instead of signing them in the browser
+    Usage: The owners confirm and execute the proposals in the Safe app, the confirmation counts are tracked on the session and it is completed once the Safe executed every transaction. Contract deployments cannot be proposed
+    Parameters:
+    - session_id (required): ID of the pending signing session
+    - safe_address (optional): Safe to propose to, defaults to LAUNCHPAD_SAFE_ADDRESS
+
+32. deploy_governance - Deploy a Governor and TimelockController for a launched ERC20Votes token
+    Usage: Import the templates with import_templates pack=governance first. One session deploys the timelock and the Governor at the addresses predicted from the deployer nonce, grants the Governor the proposer and canceller roles and renounces the admin role of the deployer. Every transaction is pinned to its nonce, the deployer must sign the whole session without sending other transactions
+    Parameters:
+    - token_deployment_id (required): ID of the confirmed token deployment implementing IVotes
+    - deployer_address (required): Wallet signing the session
+    - governor_template_id (required): ID of the imported Token Governor template
+    - timelock_template_id (required): ID of the imported Governance Timelock template
+    - voting_delay (optional): Blocks before the vote starts, defaults to 7200
+    - voting_period (optional): Blocks the vote lasts, defaults to 50400
+    - proposal_threshold (optional): Votes needed to propose in the smallest unit, defaults to 0
+    - quorum_percent (optional): Quorum in percent of the total supply, defaults to 4
+    - timelock_delay (optional): Seconds between queueing and executing, defaults to 172800
+    - dry_run (optional): Return the transactions without creating the session
+
+33. create_staking_pool - Deploy a staking rewards farm for a launched token or its LP token and fund the reward period
+    Usage: Import the template with import_templates pack=staking first. One session deploys the farm at the address predicted from the deployer nonce, approves the reward and calls notifyRewardAmount. Every transaction is pinned to its nonce, the deployer must sign the whole session without sending other transactions
+    Parameters:
+    - token_deployment_id (required): ID of the confirmed token deployment paid as reward
+    - template_id (required): ID of the imported Staking Rewards template
+    - deployer_address (required): Wallet signing the session, owning the farm and holding the reward
+    - reward_amount (required): Reward in the smallest unit of the token
+    - rewards_duration (optional): Reward period in seconds, defaults to 2592000 (30 days)
+    - liquidity_pool_id (optional): Stake the LP token of this pool instead of the token
+    - dry_run (optional): Return the transactions without creating the session
+
+34. get_staking_pool - Get the status, farm address and emissions of a staking pool (read-only)
+    Parameters:
+    - staking_pool_id (required): ID returned by create_staking_pool
+
+35. generate_launch_report - Generate a markdown or HTML report of a launch to post
+    Usage: Deployment details, pool funding, swap volume of the first 24h after the pool creation, holder growth from the Transfer events and a price chart (sparkline in markdown, SVG in HTML)
+    Parameters:
+    - deployment_id (required): ID of the confirmed token deployment
+    - format (optional): markdown or html, defaults to markdown
+
+36. snapshot_holders - Snapshot the token holders and balances at a block height (read-only)
+    Usage: Address,amount recipient list from the event indexer, input for an airdrop or a Merkle claim
+    Parameters:
+    - deployment_id (required): ID of the confirmed token deployment
+    - block_number (optional): Block height of the snapshot, defaults to the last indexed block
+    - min_balance (optional): Smallest balance in base units to be included
+    - exclude_addresses (optional): Addresses left out, such as the pair or the deployer
+    - format (optional): json or csv, defaults to json
+
+37. call_contract - Call a function of any contract by address and ABI or deployment ID
+    Usage: Long tail interactions without a dedicated tool; read-only functions return results directly, state-changing functions create signing sessions
+    Parameters:
+    - function_name (required): Name of the function in the ABI
+    - contract_address (optional): Address of the contract, required without deployment_id
+    - abi (optional): JSON ABI, required without deployment_id, overrides the template ABI otherwise
+    - deployment_id (optional): Confirmed deployment providing the address and ABI
+    - function_args (optional): Array of function arguments in ABI order
+    - value (optional): ETH value in wei for payable functions (default "0")
+    - metadata (optional): Transaction metadata for state-changing functions
+
+38. read_contract - Batch view calls and decode their return values (read-only)
+    Usage: Read several contracts at the same block in one JSON-RPC batch; structs, arrays, integers and bytes are decoded with the ABI
+    Parameters:
+    - calls (required): Up to 50 calls, each with function_name, contract_address and abi or deployment_id, and function_args
+    - block_number (optional): Block height to read at, defaults to the latest block
+
+39. query_events - Query and decode the logs of a contract event (read-only)
+    Usage: Answer questions such as who bought in the last hour from the chain, oldest first and paged with a cursor
+    Parameters:
+    - event_name (required): Name of the event in the ABI
+    - contract_address / abi / deployment_id: The contract and its ABI, as for read_contract
+    - topics (optional): Values of the indexed parameters keyed by name
+    - from_block / to_block (optional): Block range, defaults to the last 5000 blocks
+    - since (optional): Duration before the last block instead of from_block (e.g., 1h)
+    - limit (optional): Events per page, defaults to 100, at most 1000
+    - cursor (optional): next_cursor of the previous page
+
+40. decode_transaction - Decode a transaction or calldata into its function and arguments (read-only)
+    Usage: Explain what a transaction did, matching its selector against the deployment at the target, the Uniswap artifacts and the templates
+    Parameters:
+    - transaction_hash / data: Hash of a transaction on the active chain, or raw calldata
+    - to (optional): Target address of the calldata, read from the transaction with transaction_hash
+    - abi (optional): JSON ABI tried before the known ones
+
+41. launch_nft_collection - Launch an ERC721A or ERC1155 NFT collection with its mint phases
+    Usage: Import the templates with import_templates pack=nft first. One session deploys the collection at the address predicted from the deployer nonce and adds every mint phase with addMintPhase. Every transaction is pinned to its nonce, the deployer must sign the whole session without sending other transactions
+    Parameters:
+    - template_id (required): ID of the imported ERC721A Collection or ERC1155 Editions template
+    - name / symbol (required): Name and symbol of the collection
+    - base_uri (required): Metadata location (ipfs://, ar:// or https://), ERC1155 URIs get {id}.json appended unless they contain {id}
+    - max_supply (required): Tokens of an ERC721A collection, copies of every token ID of an ERC1155 collection
+    - deployer_address (required): Wallet signing the session and owning the collection
+    - token_count (optional): Token IDs of an ERC1155 collection, defaults to 1
+    - phases (optional): Up to 20 phases in start time order, each with start_time, end_time, price in wei, max_per_wallet and an allowlist turned into a Merkle root
+    - dry_run (optional): Return the transactions without creating the session
+
+42. launch_bonding_curve - Launch a token sold along a bonding curve that migrates its liquidity to Uniswap
+    Usage: Import the template with import_templates pack=bonding-curve first, requires a Uniswap deployment on the chain. Buyers trade with buy and sell on the token until the curve supply is sold out, the ETH raised and the rest of the supply then seed the Uniswap pair at the last curve price. The state of the curve is tracked and served at /bonding-curves/:id/progress
+    Parameters:
+    - template_id (required): ID of the imported Bonding Curve Token template
+    - name / symbol (required): Name and symbol of the token
+    - deployer_address (required): Wallet signing the deployment, the creator of the token
+    - graduation_threshold (required): ETH in wei raised when the curve is sold out
+    - total_supply (optional): Total supply in base units, defaults to 1 billion tokens
+    - curve_supply (optional): Part of the supply sold along the curve, more than half, defaults to 80%
+    - initial_buy (optional): ETH in wei the creator buys with in the deployment, below the threshold
+    - dry_run (optional): Return the transaction without creating the session
+
+43. launch_dutch_auction - Launch a token sold in a Dutch auction with a decaying price
+    Usage: Import the template with import_templates pack=dutch-auction first, requires a Uniswap deployment on the chain. The price decays linearly from start_price to end_price, buyers pay the current price until the auction supply is sold out or end_time is reached. Returns the price schedule, the state of the auction is tracked
+    Parameters:
+    - template_id (required): ID of the imported Dutch Auction Token template
+    - name / symbol (required): Name and symbol of the token
+    - deployer_address (required): Wallet signing the deployment, receiving the LP tokens at settlement
+    - start_price / end_price (required): Prices in wei of one token at the start and at the end
+    - end_time (required): RFC3339 end of the auction
+    - start_time (optional): RFC3339 start of the auction, defaults to now
+    - step_duration (optional): Seconds between two price drops, defaults to a continuous decay
+    - total_supply (optional): Total supply in base units, defaults to 1 billion tokens
+    - auction_supply (optional): Part of the supply sold in the auction, defaults to 50%
+    - dry_run (optional): Return the transaction without creating the session
+
+44. settle_dutch_auction - Settle the proceeds of an ended Dutch auction into its Uniswap pool
+    Usage: Once the auction sold out or ended, adds the ETH raised with tokens at the final price to the pair, the LP tokens go to the deployer and the unsold tokens are burned
+    Parameters:
+    - auction_id (required): ID of the Dutch auction
+
+45. set_referrer - Record the referrer of the authenticated user
+    Usage: When the operator configured a platform fee with a referral share, the launches and swaps of the user pay that share of the fee to the referrer. Set once
+    Parameters:
+    - referrer_address (required): EVM address of the referrer
+
+46. get_platform_revenue - Revenue report of the platform fees (admin only)
+    Usage: Fees in wei by chain, kind and status, the sessions that paid a fee and the referrers ranked by earnings. Launch and swap sessions carry the fee transfers of the LAUNCHPAD_PLATFORM_* settings
+    Parameters:
+    - chain_id (optional): ID of the chain of the fees
+    - since / until (optional): RFC3339 bounds of the fees counted
+    - include_pending (optional): Also count the fees of sessions not signed yet
+
+47. request_signature - Ask the user to sign a nonce message proving they control an address, with signing interface
+    Usage: Before launching on behalf of a user, confirm a human controls the deployer address. The signer is recovered server-side and must match address; call again with session_id to read the verified signer
+    Parameters:
+    - address (optional): Address the signature must recover to
+    - statement (optional): Statement shown at the top of the message
+    - session_id (optional): Check the result of an earlier request instead of creating one
+
+48. export_unsigned_transactions - Export the pending transactions of a session as unsigned EIP-1559 transactions
+    Usage: Sign a session on an air-gapped machine. The file carries each transaction in eth_signTransaction format with its EIP-2718 unsigned payload and signing hash
+    Parameters:
+    - session_id (required): ID of the pending signing session
+    - from (required): Address of the offline account signing the transactions
+    - nonce (optional): Nonce of the first transaction, defaults to the pending nonce of from
+    - gas_limit (optional): Gas limit of the transactions the node cannot estimate
+
+49. submit_signed_transaction - Broadcast a transaction signed offline and complete it on its session
+    Usage: Submit the signed transactions of export_unsigned_transactions in order, each must sign an exported transaction with the exported from address. Call again with the same transaction when it is not mined in time
+    Parameters:
+    - session_id (required): ID of the exported session
+    - signed_transaction (required): Signed raw transaction in hex
+
+50. cancel_session - Cancel a pending signing session and delete its pending deployments and pools
+    Usage: Discard a session prepared with the wrong parameters, its URL can no longer be signed. Expired sessions can be cancelled to clean up their records
+    Parameters:
+    - session_id (required): ID of the pending session
+
+51. abandon_deployment - Abandon a deployment, deleting it when pending or cleaning it up on chain when confirmed
+    Usage: Pending deployments are deleted with their session cancelled. Confirmed ones need cleanup, which creates a session calling the self-destruct function or renounceOwnership of the contract, with signing interface
+    Parameters:
+    - deployment_id (required): ID of the deployment
+    - cleanup (optional): self_destruct or renounce_ownership, required for confirmed deployments
+
+52. renounce_ownership - Renounce the ownership of a deployed token, or verify it is renounced
+    Usage: Creates a session calling renounceOwnership() to sign with the current owner, the renouncement is recorded on the deployment once confirmed. With verify, reads owner() on chain and reports the pending owner and whether the deployer still holds DEFAULT_ADMIN_ROLE
+    Parameters:
+    - deployment_id (required): ID of the confirmed token deployment
+    - verify (optional): Only check the ownership on chain`
 
 	case "uniswap":
 		return `Uniswap Integration Tools:
@@ -217,44 +1194,162 @@ func getToolInstructions(category string) string {
    Usage: Deploy complete Uniswap V2 infrastructure to enable trading
 
 2. get_uniswap_addresses - Get current Uniswap configuration
-   Usage: Retrieve the active Uniswap version and contract addresses
+   Usage: Retrieve the active Uniswap version and contract addresses, and every DEX deployment of the chain with its ID when it has several
 
 3. set_uniswap_addresses - Set or update Uniswap contract addresses
-   Usage: Manually configure factory, router, and WETH addresses for externally deployed contracts
+   Usage: Manually configure factory, router, and WETH addresses for externally deployed contracts; pass a new name to add another DEX deployment on the chain (e.g. the canonical Uniswap next to an app-owned fork), dex_deployment_id to update an existing one and is_default to make it the deployment used when the tools are not given a dex_deployment_id
 
 4. remove_uniswap_deployment - Remove Uniswap deployments by IDs
    Usage: Delete one or multiple Uniswap deployment records
 
 5. create_liquidity_pool - Create new liquidity pool with signing interface
-   Usage: Initialize new trading pairs on Uniswap
+   Usage: Initialize new trading pairs on Uniswap; pass lock_days (and locker_address) to mint the LP tokens to a liquidity locker and lock them for owner_address, required by the launch policy for pools above its size threshold; pass dex_deployment_id to create the pool on a DEX deployment other than the default one of the chain; once the pool is confirmed, or once trading opens for tokens waiting for a trading launch, a small buy-then-sell of the token is simulated and the result is recorded as sell_test_status on the deployment (warning notification when the sell fails, not_tradable without notification when the buy reverts)
 
 6. add_liquidity - Add liquidity to existing pool with signing interface
-   Usage: Provide liquidity to earn trading fees
+   Usage: Provide liquidity to earn trading fees; deadline_seconds, gas_limit, gas_price and nonce override the execution defaults; pass dex_deployment_id when the pool is on a DEX deployment other than the default one of the chain; pass lock_days when the launch policy requires a lock for the pool size after the addition
 
 7. remove_liquidity - Remove liquidity from pool with signing interface
-   Usage: Withdraw liquidity positions
+   Usage: Withdraw liquidity positions; pass dex_deployment_id when the pool is on a DEX deployment other than the default one of the chain
 
-8. swap_tokens - Execute token swaps with signing interface
-   Usage: Trade tokens through Uniswap
+8. swap_tokens - Execute token swaps with signing interface, routed through the known pools with the best output
+   Usage: Trade tokens through Uniswap; pass slippage_tolerance "auto" to derive the slippage from the pool depth and reject swaps above max_price_impact (default 5%); pass mev_protection to submit the swap through the private relay of the chain; pass gasless to send the approvals and the swap as user operations of the smart account given as user_address (see get_smart_account); pass swap_mode "exact_output" to receive exactly amount of to_token for at most the computed maximum input; pass deadline_seconds, gas_limit, gas_price or nonce to override the execution defaults; pass dex_deployment_id to swap through a DEX deployment other than the default one of the chain, the route only uses the pools of that deployment. On a Solana mainnet-beta chain the swap is quoted and built by the Jupiter aggregator (token addresses are mints, user_address is the Solana wallet, slippage_tolerance must be a percentage) and signed with a Solana wallet
 
 9. get_pool_info - Retrieve pool metrics (read-only)
-   Usage: Get current pool statistics and information
+   Usage: Get current pool statistics and information, including a link to the pool detail page with reserves, price chart, recent swaps and LP distribution
 
 10. get_swap_quote - Get swap estimates and price impact (read-only)
-    Usage: Calculate swap amounts and price impact before trading
+    Usage: Calculate swap amounts and price impact before trading; pass dex_deployment_id to quote the pool of a DEX deployment other than the default one of the chain
 
 11. monitor_pool - Real-time pool monitoring and event tracking (read-only)
-    Usage: Track pool activity and events`
+    Usage: Track pool activity and events
+
+12. create_limit_order - Create a limit order watched against the pool price
+    Usage: Generate a ready-to-sign swap session once the amount swaps for at least target_price to_token per from_token
+
+13. list_limit_orders - List limit orders and their status
+    Usage: Get the signing URL of triggered orders
+
+14. cancel_limit_order - Cancel an open limit order
+    Usage: Stop watching an order that is no longer wanted
+
+15. schedule_recurring_swap - Schedule a recurring swap (DCA), e.g. buy a token daily
+    Usage: A ready-to-sign swap session is created on every run (@hourly, @daily, @weekly or @every <duration>)
+
+16. list_recurring_swaps - List recurring swaps with their next run
+    Usage: Get the signing URLs of the latest runs
+
+17. cancel_recurring_swap - Cancel an active recurring swap
+    Usage: Stop creating swap sessions for a schedule
+
+18. manage_launch_policy - Manage the launch policy of the organization, set and clear need the admin role
+    Usage: set requires pools whose ETH side after an addition is above liquidity_lock_threshold (wei) to lock the added liquidity for at least min_liquidity_lock_days in the allow-listed liquidity locker; create_liquidity_pool, add_liquidity, migrate_liquidity and rebalance_pool refuse to create the session otherwise and show the rule in the launch checklist. Deploy the locker from the Liquidity Locker template of import_templates pack=liquidity-lock
+    Parameters:
+    - action (required): One of get, set, clear
+    - liquidity_lock_threshold (optional): ETH side of a pool in wei above which a lock is required, required by set
+    - min_liquidity_lock_days (optional): Minimum lock duration in days, required by set
+    - liquidity_locker_address (optional): Liquidity locker contract, the only locker accepted by the liquidity tools, required by set
+
+19. schedule_buyback - Schedule a buyback-and-burn of a launched token with treasury ETH
+    Usage: A ready-to-sign swap session buying the token with amount wei of ETH is created on every run, the bought tokens are sent to the burn address 0x000000000000000000000000000000000000dEaD
+    Parameters:
+    - deployment_id (required): ID of the confirmed token deployment
+    - amount (required): ETH spent on every run, in wei
+    - slippage_tolerance (required): Percentage, or auto
+    - treasury_address (required): Treasury wallet signing the swaps
+    - schedule (required): @hourly, @daily, @weekly or @every <duration>
+    - start_at (optional): RFC3339 time of the first run
+    - max_runs (optional): Runs after which the schedule completes
+
+20. list_buybacks - List buybacks with the cumulative ETH spent and tokens burned (read-only)
+    Usage: Get the signing URLs of the pending runs, the burned amount is read from the receipt of every confirmed swap
+
+21. cancel_buyback - Cancel an active buyback
+    Usage: Stop creating swap sessions for a buyback
+
+22. configure_alert - Create, list or delete pool health alert rules
+    Usage: Get a webhook or Telegram alert when a reserve drops, the price moves past a threshold or the creator removes liquidity
+    Parameters:
+    - action (required): create, list or delete
+    - pool_id (create): ID of the confirmed liquidity pool
+    - type (create): reserve_drop, price_move or creator_removal
+    - threshold_percent (reserve_drop, price_move): Change in percent that fires the rule
+    - channel (create): webhook or telegram
+    - webhook_url / telegram_chat_id: Delivery target of the channel
+    - alert_rule_id (delete): ID of the rule to delete
+
+23. migrate_liquidity - Move the liquidity of a token/ETH V2 pool to another DEX deployment with signing interface
+    Usage: Migrate from an app-owned fork to the canonical Uniswap router or between forks. One session approves the LP tokens, removes the liquidity from the source router and adds it to the target router, with the minimum amounts of both steps read from the reserves; a target pair that does not exist yet is created at the source price and recorded as a new pool. Only V2 targets are supported
+    Parameters:
+    - pool_id (required): ID of the confirmed liquidity pool
+    - target_dex_deployment_id (required): Uniswap V2 deployment receiving the liquidity
+    - owner_address (required): Address holding the LP tokens
+    - source_dex_deployment_id (optional): Deployment the pool was created on, defaults to the deployment recorded on the pool
+    - liquidity_amount (optional): LP tokens to migrate, defaults to the whole balance
+    - slippage_tolerance (optional): Percentage, defaults to 0.5
+    - lock_days (optional): Lock the LP tokens of the target pool in the liquidity locker, required by the launch policy above its threshold
+
+24. rebalance_pool - Move a token/ETH V2 pool to a target price with signing interface
+    Usage: Make a market for a freshly launched token. Computes the single-sided swap reaching the target price from the reserves (buying tokens to raise it, selling tokens to lower it) and builds one session with the swap and an optional addition of liquidity at the new price
+    Parameters:
+    - pool_id (required): ID of the confirmed liquidity pool
+    - target_price (required): ETH reserve over token reserve to reach
+    - owner_address (required): Address paying the swap and receiving the LP tokens
+    - liquidity_eth_amount (optional): Wei added with the matching tokens after the swap
+    - dex_deployment_id (optional): Deployment the pool was created on, defaults to the deployment recorded on the pool
+    - slippage_tolerance (optional): Percentage, defaults to 0.5
+    - lock_days (optional): Lock the LP tokens of the addition in the liquidity locker, required by the launch policy above its threshold
+
+25. analyze_pool_returns - Fee APR and impermanent loss of a pool over a time window (read-only)
+    Usage: Judge whether providing liquidity pays. Fees are 0.3% of the quote volume of the window, from the event indexer once it has indexed the pair and from the recorded swaps before that; the reserves come from the pool snapshots
+    Parameters:
+    - pool_id (required): ID of the confirmed liquidity pool
+    - since (optional): RFC 3339 time or duration before now, defaults to 168h
+    - owner_address (optional): Address whose actual position is analyzed from its indexed LP balance
+    - deposit_amount (optional): Quote amount of a hypothetical position deposited at the start of the window`
 
 	case "balance":
 		return `Balance Query Tools:
 
 1. query_balance - Query wallet balance for native tokens and ERC-20 tokens
-   Usage: Get wallet balances either directly in response or through web interface
+   Usage: Get wallet balances either directly in response or through web interface. On Solana chains the SOL balance is returned directly (show_browser=false)
    Parameters:
    - wallet_address (optional): Target wallet address 
    - show_browser (required): true for web interface, false for direct response
-   - token_address (optional): ERC-20 token contract address for token balance`
+   - token_address (optional): ERC-20 token contract address for token balance
+
+2. list_allowances - List the ERC-20 allowances granted by an address (read-only)
+   Usage: Scan the deployed tokens, WETH and pool LP tokens against the Uniswap router for non-zero allowances
+   Parameters:
+   - owner_address (required): Address that granted the allowances
+   - tokens (optional): Extra token addresses to scan
+   - spenders (optional): Extra spender addresses to scan
+
+3. revoke_allowance - Revoke allowances by approving 0 with signing interface
+   Usage: Clean up approvals after a launch; revokes every allowance found by list_allowances, narrowed by token and/or spender
+   Parameters:
+   - owner_address (required): Address that granted the allowances
+   - token_address (optional): Only revoke allowances of this token
+   - spender_address (optional): Only revoke allowances of this spender
+
+4. get_portfolio - Report the holdings of an address with their value (read-only)
+   Usage: Review a treasury; returns the native balance, the balances of the deployed tokens, pool tokens and WETH and the liquidity positions in the launchpad pools. Tokens are valued at the spot price of their WETH pool, in USD when the price API knows the native token of the chain
+   Parameters:
+   - address (required): Address to report
+
+5. transfer_token - Send ETH or an ERC-20 token, or approve a spender, with signing interface
+   Usage: Simple payouts and approvals; transfers are refused when the balance of from_address does not cover the amount
+   Parameters:
+   - from_address (required): Address that signs the transaction
+   - to (required): Recipient, or spender of the approval
+   - amount (required): Amount in the smallest unit of the token
+   - action (optional): transfer (default) or approve
+   - token_address (optional): ERC-20 token, ETH is sent without it
+   - memo (optional): Note stored with the session metadata
+
+6. get_smart_account - Get the ERC-4337 smart account of a wallet (read-only)
+   Usage: The SimpleAccount sending the gasless launches and swaps of the wallet, known before its first user operation deploys it; fund it with the tokens a gasless swap spends
+   Parameters:
+   - owner_address (required): Wallet owning the smart account`
 
 	case "all":
 		return `Crypto Launchpad MCP Tools Overview:
@@ -263,22 +1358,78 @@ This MCP server provides 21 tools for managing cryptocurrency token deployments
 
 CHAIN MANAGEMENT (3 tools):
 - list_chains: List all configured blockchain chains
-- select_chain: Switch between blockchains by type or ID
+- select_chain: Switch the default blockchain by type or ID (every tool also takes a per-call chain_id)
 - set_chain: Configure RPC endpoints
 
-TEMPLATE MANAGEMENT (5 tools):
+TEMPLATE MANAGEMENT (12 tools):
 - list_template: Browse contract templates
 - create_template: Add new templates
 - update_template: Modify existing templates
 - delete_template: Delete templates by ID(s)
 - view_template: View template details and ABI methods
-
-DEPLOYMENT (3 tools):
+- template_leaderboard: Rank templates by launch success, gas cost and pool performance
+- export_templates: Export templates as a JSON bundle or a directory of template files
+- import_templates: Import a template pack created by export_templates or a built-in pack
+- browse_registry: Browse the templates of the subscribed remote registry
+- install_template: Install a signed registry template
+- get_template_report: Compare the compiler gas estimates and warnings of templates
+- test_template: Run the built-in ERC20 test suite against a throwaway Anvil node
+
+DEPLOYMENT (52 tools):
 - launch: Deploy contracts via web interface
 - list_deployments: View all deployed contracts
 - call_function: Call smart contract functions using deployment ID and ABI
-
-UNISWAP INTEGRATION (11 tools):
+- call_contract: Call any contract by address and ABI or deployment ID
+- read_contract: Batch view calls with decoded structs and arrays
+- query_events: Query and decode event logs by topics and block range
+- decode_transaction: Decode a transaction hash or calldata with the known ABIs
+- generate_integration_snippet: Generate ethers.js/viem/wagmi/web3.py snippets for a deployment
+- generate_abi_typings: Generate downloadable abitype/viem TypeScript typings for a deployment
+- get_deployment_artifacts: Get the sources, bytecode, ABI and constructor arguments of a deployment for verification
+- set_token_metadata: Set the description, links and logo of a token for its token info and the token list
+- save_address: Save an address under a label, tool arguments written as @label use it
+- list_addresses: List the saved addresses of the address book
+- propose_safe_transactions: Propose the transactions of a session to a Safe multisig for its owners to confirm
+- deploy_governance: Deploy a Governor and timelock voting with a launched ERC20Votes token
+- create_staking_pool: Deploy and fund a staking rewards farm for a launched token or its LP token
+- get_staking_pool: Get the status and emissions of a staking pool
+- launch_nft_collection: Launch an ERC721A or ERC1155 collection with allowlist and public mint phases
+- launch_bonding_curve: Launch a token sold along a bonding curve that graduates to a Uniswap pool
+- launch_dutch_auction: Launch a token sold in a Dutch auction with a decaying price schedule
+- settle_dutch_auction: Settle an ended Dutch auction into its Uniswap pool
+- generate_subgraph: Generate a Graph Protocol subgraph manifest and mappings for a token and its pool
+- export_launch_data: Export deployments, pools or swaps as CSV for analytics platforms
+- generate_analytics_queries: Generate starter DuneSQL queries for a token and its pool
+- generate_launch_report: Generate a markdown or HTML launch report with volume, holder growth and a price chart
+- snapshot_holders: Snapshot the token holders and balances at a block height as JSON or CSV
+- detect_interfaces: Detect supported token and access control interfaces of a contract
+- manage_roles: Grant, revoke, renounce and list AccessControl roles
+- manage_address_list: Batch blacklist/whitelist updates and report the recorded lists
+- configure_token_fees: Read and update the fees of taxed tokens within the declared maximums
+- enable_trading: Open trading now or at a scheduled time with a public countdown page
+- launch_readiness: Check template, chain, Uniswap, deployer balance and template values before launch
+- estimate_deployment_cost: Compare the deployment and pool creation cost of a template across chains in native token and USD
+- multi_chain_launch: Deploy a template to several chains under one launch group
+- get_launch_group: Consolidated status and per-chain contract addresses of a launch group
+- bridge_liquidity: Bridge the liquidity ETH of a launch group to its target chains through canonical bridges
+- wire_cross_chain_token: Set LayerZero peers or Axelar ITS links between the deployments of a launch group
+- create_project: Create a named project with tags to group the records of a launch
+- assign_to_project: Assign templates, deployments, pools and sessions to a project
+- list_project_assets: List the records of a project, or the projects by tag
+- search: Full-text search across templates, deployments, pools and sessions
+- get_audit_log: List the audit log of tool calls and database mutations
+- create_api_key: Create a scoped API key for a CI pipeline or bot (admin only)
+- revoke_api_key: Revoke an API key (admin only)
+- set_referrer: Record the referrer sharing the platform fees of the user
+- get_platform_revenue: Revenue report of the platform and referral fees (admin only)
+- request_signature: Prove a human controls an address with a signed nonce message
+- export_unsigned_transactions: Export the unsigned transactions of a session to sign on an air-gapped machine
+- submit_signed_transaction: Broadcast a transaction signed offline and complete it on its session
+- cancel_session: Cancel a pending session and delete its pending deployments and pools
+- abandon_deployment: Delete a pending deployment or self-destruct / renounce a confirmed one
+- renounce_ownership: Renounce token ownership and record it on the deployment, or verify it on chain
+
+UNISWAP INTEGRATION (25 tools):
 - deploy_uniswap: Deploy Uniswap infrastructure contracts
 - get_uniswap_addresses: Get current Uniswap configuration
 - set_uniswap_addresses: Set or update Uniswap contract addresses
@@ -286,16 +1437,37 @@ UNISWAP INTEGRATION (11 tools):
 - create_liquidity_pool: Create new pools
 - add_liquidity: Provide liquidity
 - remove_liquidity: Withdraw liquidity
-- swap_tokens: Trade tokens
+- swap_tokens: Trade tokens (Uniswap, Jupiter on Solana)
 - get_pool_info: View pool metrics
 - get_swap_quote: Calculate swap estimates
 - monitor_pool: Track pool activity
-
-BALANCE QUERY (1 tool):
+- create_limit_order: Swap once the pool reaches a target price
+- list_limit_orders: View limit orders and signing URLs of triggered ones
+- cancel_limit_order: Cancel an open limit order
+- schedule_recurring_swap: Create swap sessions on a recurring schedule (DCA)
+- list_recurring_swaps: View recurring swaps and signing URLs of their runs
+- cancel_recurring_swap: Cancel an active recurring swap
+- manage_launch_policy: Require a minimum liquidity lock in the allow-listed locker for pools above a configured size
+- schedule_buyback: Buy back a token with treasury ETH on a schedule and burn it
+- list_buybacks: View buybacks and their cumulative burn
+- cancel_buyback: Cancel an active buyback
+- configure_alert: Alert on reserve drops, price moves and creator liquidity removals
+- migrate_liquidity: Move V2 liquidity to another DEX deployment
+- rebalance_pool: Swap a V2 pool to a target price and add liquidity there
+- analyze_pool_returns: Fee APR and impermanent loss of a pool and of an LP position
+
+BALANCE QUERY (6 tools):
 - query_balance: Query wallet balances with browser/direct modes
+- list_allowances: List ERC-20 allowances granted to known spenders
+- revoke_allowance: Revoke allowances by approving 0
+- get_portfolio: Native, token and LP holdings of an address valued in ETH and USD
+- transfer_token: Send ETH or ERC-20 tokens, or approve a spender, after a balance check
+- get_smart_account: ERC-4337 smart account of a wallet sending its gasless transactions
 
 All signing operations open a web interface for secure wallet interaction.
-No private keys are handled by the server - all signing is client-side.`
+No private keys are handled by the server - all signing is client-side.
+The tools creating sessions or records accept an idempotency_key: retrying with the same key and arguments returns the original result instead of launching twice.
+launch, multi_chain_launch, deploy_uniswap, create_liquidity_pool, add_liquidity, remove_liquidity, migrate_liquidity, rebalance_pool and swap_tokens accept dry_run: the transactions are validated and built, and the sessions and records that would be created are returned without saving them.`
 
 	default:
 		return `Invalid category. Available categories: chain, template, deployment, uniswap, balance, all`
@@ -303,15 +1475,25 @@ No private keys are handled by the server - all signing is client-side.`
 }
 
 func (s *MCPServer) StartStdioServer() error {
-	return server.ServeStdio(s.server)
+	return s.serveStdio(os.Stdin, os.Stdout)
 }
 
 // StartStreamableHTTPServer starts the MCP server with streamable HTTP interface on the specified port
-func (s *MCPServer) StartStreamableHTTPServer() *server.StreamableHTTPServer {
-	return server.NewStreamableHTTPServer(s.server)
+func (s *MCPServer) StartStreamableHTTPServer(opts ...server.StreamableHTTPOption) *server.StreamableHTTPServer {
+	return server.NewStreamableHTTPServer(s.server, opts...)
+}
+
+// NewInProcessClient returns an MCP client calling the tools of the server directly, without a transport
+func (s *MCPServer) NewInProcessClient() (*client.Client, error) {
+	return client.NewInProcessClient(s.server)
 }
 
 // GetDBService returns the database service used by the MCP server
 func (s *MCPServer) GetDBService() services.DBService {
 	return s.dbService
 }
+
+// GetTemplateService returns the template service of the tools
+func (s *MCPServer) GetTemplateService() services.TemplateService {
+	return s.templateService
+}
@@ -0,0 +1,137 @@
+// Package migrations applies the versioned SQL migrations of the Postgres schema.
+// Migrations are embedded in the binary and named <version>_<title>.up.sql and <version>_<title>.down.sql.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// Migrate applies every pending migration to the Postgres database at dsn.
+// It refuses to run on a dirty schema left by a failed migration
+func Migrate(dsn string) error {
+	return withMigrate(dsn, func(m *migrate.Migrate) error {
+		version, err := currentVersion(m)
+		if err != nil {
+			return err
+		}
+
+		if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("failed to migrate database from version %d: %w", version, err)
+		}
+
+		newVersion, err := currentVersion(m)
+		if err != nil {
+			return err
+		}
+		log.Printf("Database schema migrated from version %d to %d", version, newVersion)
+		return nil
+	})
+}
+
+// Check verifies that the schema of the Postgres database at dsn is at the latest migration and not dirty
+func Check(dsn string) error {
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	return withMigrate(dsn, func(m *migrate.Migrate) error {
+		version, err := currentVersion(m)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case version < latest:
+			return fmt.Errorf("database schema is at version %d but version %d is required, run the server with --migrate", version, latest)
+		case version > latest:
+			// A newer binary already migrated the schema, the additions are unknown to this binary
+			log.Printf("Warning: database schema version %d is newer than the latest known migration %d", version, latest)
+		}
+		return nil
+	})
+}
+
+// LatestVersion returns the version of the newest embedded migration
+func LatestVersion() (uint, error) {
+	source, err := iofs.New(files, "sql")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	defer source.Close()
+
+	version, err := source.First()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	for {
+		next, err := source.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read migrations: %w", err)
+		}
+		version = next
+	}
+}
+
+// currentVersion returns the applied version of the schema, 0 when no migration ran yet.
+// A dirty schema is an error since the failed migration has to be repaired by hand.
+func currentVersion(m *migrate.Migrate) (uint, error) {
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read database schema version: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("database schema is dirty at version %d: the migration failed part way, repair the schema and reset the version in the schema_migrations table before migrating again", version)
+	}
+	return version, nil
+}
+
+// withMigrate opens a dedicated connection to the database at dsn and runs fn with a migrator of the embedded migrations
+func withMigrate(dsn string, fn func(m *migrate.Migrate) error) error {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	driver, err := pgx.WithInstance(db, &pgx.Config{})
+	if err != nil {
+		db.Close()
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	source, err := iofs.New(files, "sql")
+	if err != nil {
+		driver.Close()
+		return fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "pgx5", driver)
+	if err != nil {
+		source.Close()
+		driver.Close()
+		return fmt.Errorf("failed to initialize migrations: %w", err)
+	}
+	// Closing the migrator closes the source, the driver and the connection
+	defer m.Close()
+
+	return fn(m)
+}
@@ -0,0 +1,91 @@
+package migrations
+
+import (
+	"io/fs"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/schema"
+)
+
+func TestMigrationsHaveUpAndDown(t *testing.T) {
+	names, err := fs.Glob(files, "sql/*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+
+	for _, name := range names {
+		if strings.HasSuffix(name, ".up.sql") {
+			assert.Contains(t, names, strings.TrimSuffix(name, ".up.sql")+".down.sql", "missing down migration of %s", name)
+		}
+	}
+
+	latest, err := LatestVersion()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, latest, uint(1))
+}
+
+// TestMigrationsCoverModels fails when a model gains a table or column without a migration
+func TestMigrationsCoverModels(t *testing.T) {
+	names, err := fs.Glob(files, "sql/*.up.sql")
+	require.NoError(t, err)
+	var migrations strings.Builder
+	for _, name := range names {
+		content, err := fs.ReadFile(files, name)
+		require.NoError(t, err)
+		migrations.Write(content)
+	}
+	sql := migrations.String()
+
+	cache := &sync.Map{}
+	for _, model := range []interface{}{
+		&models.Chain{},
+		&models.Template{},
+		&models.Deployment{},
+		&models.UniswapDeployment{},
+		&models.LiquidityPool{},
+		&models.PoolSnapshot{},
+		&models.LimitOrder{},
+		&models.RecurringSwap{},
+		&models.RecurringSwapRun{},
+		&models.RoleMember{},
+		&models.RoleSyncState{},
+		&models.PrivateTransaction{},
+		&models.AddressListChange{},
+		&models.AddressListEntry{},
+		&models.TradingLaunch{},
+		&models.LaunchPolicy{},
+		&models.TransactionSession{},
+		&models.InstalledTemplate{},
+		&models.LaunchGroup{},
+		&models.LaunchGroupBridge{},
+		&models.Project{},
+		&models.ProjectAsset{},
+		&models.SearchDocument{},
+		&models.AuditLog{},
+		&models.IdempotencyKey{},
+		&models.APIKey{},
+		&models.TokenMetadata{},
+		&models.AddressBookEntry{},
+		&models.StakingPool{},
+		&models.Buyback{},
+		&models.BuybackRun{},
+		&models.IndexedEvent{},
+		&models.IndexerCursor{},
+		&models.TokenHolder{},
+		&models.AlertRule{},
+		&models.UserChain{},
+		&models.MCPSession{},
+		&models.SharedStateEntry{},
+	} {
+		modelSchema, err := schema.Parse(model, cache, schema.NamingStrategy{})
+		require.NoError(t, err)
+		assert.Contains(t, sql, `CREATE TABLE IF NOT EXISTS "`+modelSchema.Table+`"`)
+		for _, column := range modelSchema.DBNames {
+			assert.Contains(t, sql, `"`+column+`"`, "column %s of %s has no migration", column, modelSchema.Table)
+		}
+	}
+}
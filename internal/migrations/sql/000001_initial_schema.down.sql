@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS "launch_policies";
+DROP TABLE IF EXISTS "trading_launches";
+DROP TABLE IF EXISTS "address_list_entries";
+DROP TABLE IF EXISTS "address_list_changes";
+DROP TABLE IF EXISTS "private_transactions";
+DROP TABLE IF EXISTS "role_sync_states";
+DROP TABLE IF EXISTS "role_members";
+DROP TABLE IF EXISTS "recurring_swap_runs";
+DROP TABLE IF EXISTS "recurring_swaps";
+DROP TABLE IF EXISTS "limit_orders";
+DROP TABLE IF EXISTS "pool_snapshots";
+DROP TABLE IF EXISTS "liquidity_pools";
+DROP TABLE IF EXISTS "uniswap_deployments";
+DROP TABLE IF EXISTS "deployments";
+DROP TABLE IF EXISTS "transaction_sessions";
+DROP TABLE IF EXISTS "templates";
+DROP TABLE IF EXISTS "chains";
@@ -0,0 +1,303 @@
+-- Baseline schema, matching the tables created by GORM AutoMigrate before versioned migrations.
+-- Every statement is idempotent so databases created by AutoMigrate adopt it unchanged.
+
+CREATE TABLE IF NOT EXISTS "chains" (
+    "id" bigserial,
+    "chain_type" text NOT NULL,
+    "rpc" text NOT NULL,
+    "chain_id" text,
+    "name" text NOT NULL,
+    "is_active" boolean DEFAULT false,
+    "private_relay_rpc" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_chains_deleted_at" ON "chains" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "templates" (
+    "id" bigserial,
+    "name" text NOT NULL,
+    "description" text,
+    "user_id" varchar(255),
+    "chain_type" text NOT NULL,
+    "template_code" text NOT NULL,
+    "metadata" text,
+    "sample_template_values" text,
+    "abi" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_templates_deleted_at" ON "templates" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_templates_user_id" ON "templates" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "transaction_sessions" (
+    "id" text,
+    "user_id" varchar(255),
+    "metadata" text,
+    "transaction_status" text DEFAULT 'pending',
+    "transaction_chain_type" text NOT NULL,
+    "balances" text,
+    "transaction_deployments" text,
+    "chain_id" bigint NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "expires_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_transaction_sessions_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_transaction_sessions_user_id" ON "transaction_sessions" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "deployments" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "template_id" bigint NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "contract_address" text,
+    "template_values" text,
+    "deployer_address" text,
+    "transaction_hash" text,
+    "status" text DEFAULT 'pending',
+    "session_id" text,
+    "interfaces" text,
+    "sell_test_status" text,
+    "sell_test_result" text,
+    "sell_tested_at" timestamptz,
+    "gas_used" bigint,
+    "gas_cost" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_deployments_template" FOREIGN KEY ("template_id") REFERENCES "templates"("id"),
+    CONSTRAINT "fk_deployments_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id"),
+    CONSTRAINT "fk_deployments_session" FOREIGN KEY ("session_id") REFERENCES "transaction_sessions"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_deployments_session_id" ON "deployments" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_deployments_user_id" ON "deployments" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_deployments_sell_test_status" ON "deployments" ("sell_test_status");
+
+CREATE TABLE IF NOT EXISTS "uniswap_deployments" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "version" text NOT NULL,
+    "factory_address" text,
+    "router_address" text,
+    "weth_address" text,
+    "deployer_address" text,
+    "status" text DEFAULT 'pending',
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "chain_id" bigint NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_uniswap_deployments_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_uniswap_deployments_user_id" ON "uniswap_deployments" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "liquidity_pools" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "token_address" text NOT NULL,
+    "pair_address" text NOT NULL,
+    "uniswap_version" text NOT NULL,
+    "token0" text NOT NULL,
+    "token1" text NOT NULL,
+    "initial_token0" text NOT NULL,
+    "initial_token1" text NOT NULL,
+    "creator_address" text NOT NULL,
+    "transaction_hash" text NOT NULL,
+    "status" text DEFAULT 'pending',
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "session_id" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_liquidity_pools_session" FOREIGN KEY ("session_id") REFERENCES "transaction_sessions"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_liquidity_pools_session_id" ON "liquidity_pools" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_liquidity_pools_user_id" ON "liquidity_pools" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "pool_snapshots" (
+    "id" bigserial,
+    "pool_id" bigint NOT NULL,
+    "reserve0" text NOT NULL,
+    "reserve1" text NOT NULL,
+    "price" decimal,
+    "transaction_type" text,
+    "transaction_hash" text,
+    "session_id" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_pool_snapshots_created_at" ON "pool_snapshots" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_pool_snapshots_session_id" ON "pool_snapshots" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_pool_snapshots_transaction_type" ON "pool_snapshots" ("transaction_type");
+CREATE INDEX IF NOT EXISTS "idx_pool_snapshots_pool_id" ON "pool_snapshots" ("pool_id");
+
+CREATE TABLE IF NOT EXISTS "limit_orders" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "chain_id" bigint NOT NULL,
+    "pool_id" bigint NOT NULL,
+    "from_token" text NOT NULL,
+    "to_token" text NOT NULL,
+    "amount" text NOT NULL,
+    "target_price" decimal NOT NULL,
+    "slippage_tolerance" text NOT NULL,
+    "user_address" text NOT NULL,
+    "status" text DEFAULT 'open',
+    "session_id" text,
+    "error" text,
+    "expires_at" timestamptz,
+    "triggered_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_limit_orders_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_limit_orders_session_id" ON "limit_orders" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_limit_orders_status" ON "limit_orders" ("status");
+CREATE INDEX IF NOT EXISTS "idx_limit_orders_pool_id" ON "limit_orders" ("pool_id");
+CREATE INDEX IF NOT EXISTS "idx_limit_orders_user_id" ON "limit_orders" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "recurring_swaps" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "chain_id" bigint NOT NULL,
+    "from_token" text NOT NULL,
+    "to_token" text NOT NULL,
+    "amount" text NOT NULL,
+    "slippage_tolerance" text NOT NULL,
+    "user_address" text NOT NULL,
+    "schedule" text NOT NULL,
+    "interval_seconds" bigint NOT NULL,
+    "next_run_at" timestamptz,
+    "last_run_at" timestamptz,
+    "run_count" bigint DEFAULT 0,
+    "max_runs" bigint DEFAULT 0,
+    "status" text DEFAULT 'active',
+    "last_error" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_recurring_swaps_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_recurring_swaps_next_run_at" ON "recurring_swaps" ("next_run_at");
+CREATE INDEX IF NOT EXISTS "idx_recurring_swaps_user_id" ON "recurring_swaps" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_recurring_swaps_status" ON "recurring_swaps" ("status");
+
+CREATE TABLE IF NOT EXISTS "recurring_swap_runs" (
+    "id" bigserial,
+    "recurring_swap_id" bigint NOT NULL,
+    "session_id" text,
+    "error" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_recurring_swap_runs_session_id" ON "recurring_swap_runs" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_recurring_swap_runs_recurring_swap_id" ON "recurring_swap_runs" ("recurring_swap_id");
+
+CREATE TABLE IF NOT EXISTS "role_members" (
+    "id" bigserial,
+    "deployment_id" bigint NOT NULL,
+    "role" text NOT NULL,
+    "account" text NOT NULL,
+    "block_number" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_member" ON "role_members" ("deployment_id","role","account");
+
+CREATE TABLE IF NOT EXISTS "role_sync_states" (
+    "deployment_id" bigint,
+    "last_synced_block" bigint,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("deployment_id")
+);
+
+CREATE TABLE IF NOT EXISTS "private_transactions" (
+    "id" bigserial,
+    "session_id" text NOT NULL,
+    "transaction_index" bigint NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "transaction_hash" text NOT NULL,
+    "relay_rpc" text NOT NULL,
+    "status_url" text,
+    "status" text DEFAULT 'pending',
+    "error" text,
+    "block_number" bigint,
+    "included_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_private_transactions_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_private_transactions_status" ON "private_transactions" ("status");
+CREATE INDEX IF NOT EXISTS "idx_private_transactions_transaction_hash" ON "private_transactions" ("transaction_hash");
+CREATE INDEX IF NOT EXISTS "idx_private_transactions_session_id" ON "private_transactions" ("session_id");
+
+CREATE TABLE IF NOT EXISTS "address_list_changes" (
+    "id" bigserial,
+    "deployment_id" bigint NOT NULL,
+    "session_id" text NOT NULL,
+    "list_type" text NOT NULL,
+    "action" text NOT NULL,
+    "address" text NOT NULL,
+    "status" text DEFAULT 'pending',
+    "transaction_hash" text,
+    "applied_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_address_list_changes_session_id" ON "address_list_changes" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_address_list_changes_deployment_id" ON "address_list_changes" ("deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_address_list_changes_status" ON "address_list_changes" ("status");
+
+CREATE TABLE IF NOT EXISTS "address_list_entries" (
+    "id" bigserial,
+    "deployment_id" bigint NOT NULL,
+    "list_type" text NOT NULL,
+    "address" text NOT NULL,
+    "session_id" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_address_list_entry" ON "address_list_entries" ("deployment_id","list_type","address");
+
+CREATE TABLE IF NOT EXISTS "trading_launches" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "deployment_id" bigint NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "function_name" text NOT NULL,
+    "opens_at" timestamptz NOT NULL,
+    "status" text DEFAULT 'scheduled',
+    "session_id" text,
+    "transaction_hash" text,
+    "error" text,
+    "opened_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_trading_launches_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id"),
+    CONSTRAINT "fk_trading_launches_deployment" FOREIGN KEY ("deployment_id") REFERENCES "deployments"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_trading_launches_session_id" ON "trading_launches" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_trading_launches_status" ON "trading_launches" ("status");
+CREATE INDEX IF NOT EXISTS "idx_trading_launches_opens_at" ON "trading_launches" ("opens_at");
+CREATE INDEX IF NOT EXISTS "idx_trading_launches_deployment_id" ON "trading_launches" ("deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_trading_launches_user_id" ON "trading_launches" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "launch_policies" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "liquidity_lock_threshold" text,
+    "min_liquidity_lock_days" bigint,
+    "liquidity_locker_address" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_launch_policies_user_id" ON "launch_policies" ("user_id");
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "installed_templates" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "registry" text NOT NULL,
+    "name" text NOT NULL,
+    "version" text NOT NULL,
+    "template_id" bigint NOT NULL,
+    "bundle_sha256" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_installed_templates_user_id" ON "installed_templates" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_installed_templates_name" ON "installed_templates" ("name");
+CREATE INDEX IF NOT EXISTS "idx_installed_templates_template_id" ON "installed_templates" ("template_id");
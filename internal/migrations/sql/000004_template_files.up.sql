@@ -0,0 +1 @@
+ALTER TABLE "templates" ADD COLUMN IF NOT EXISTS "files" text;
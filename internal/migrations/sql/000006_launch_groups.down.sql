@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "idx_deployments_launch_group_id";
+ALTER TABLE "deployments" DROP COLUMN IF EXISTS "launch_group_id";
+DROP TABLE IF EXISTS "launch_groups";
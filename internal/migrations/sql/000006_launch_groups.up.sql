@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "launch_groups" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "template_id" bigint NOT NULL,
+    "contract_name" text,
+    "template_values" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_launch_groups_template" FOREIGN KEY ("template_id") REFERENCES "templates"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_launch_groups_user_id" ON "launch_groups" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_launch_groups_template_id" ON "launch_groups" ("template_id");
+
+ALTER TABLE "deployments" ADD COLUMN IF NOT EXISTS "launch_group_id" bigint;
+CREATE INDEX IF NOT EXISTS "idx_deployments_launch_group_id" ON "deployments" ("launch_group_id");
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "launch_group_bridges" (
+    "id" bigserial,
+    "launch_group_id" bigint NOT NULL,
+    "source_chain_id" bigint NOT NULL,
+    "target_chain_id" bigint NOT NULL,
+    "bridge_address" text NOT NULL,
+    "bridge_name" text,
+    "recipient" text,
+    "amount" text NOT NULL,
+    "session_id" text,
+    "status" text DEFAULT 'pending',
+    "transaction_hash" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_launch_groups_bridges" FOREIGN KEY ("launch_group_id") REFERENCES "launch_groups"("id"),
+    CONSTRAINT "fk_launch_group_bridges_source_chain" FOREIGN KEY ("source_chain_id") REFERENCES "chains"("id"),
+    CONSTRAINT "fk_launch_group_bridges_target_chain" FOREIGN KEY ("target_chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_launch_group_bridges_launch_group_id" ON "launch_group_bridges" ("launch_group_id");
+CREATE INDEX IF NOT EXISTS "idx_launch_group_bridges_session_id" ON "launch_group_bridges" ("session_id");
@@ -0,0 +1,2 @@
+ALTER TABLE "uniswap_deployments" DROP COLUMN IF EXISTS "is_default";
+ALTER TABLE "uniswap_deployments" DROP COLUMN IF EXISTS "name";
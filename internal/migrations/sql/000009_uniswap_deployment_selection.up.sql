@@ -0,0 +1,2 @@
+ALTER TABLE "uniswap_deployments" ADD COLUMN IF NOT EXISTS "name" text;
+ALTER TABLE "uniswap_deployments" ADD COLUMN IF NOT EXISTS "is_default" boolean DEFAULT false;
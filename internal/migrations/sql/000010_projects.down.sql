@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "project_assets";
+DROP TABLE IF EXISTS "projects";
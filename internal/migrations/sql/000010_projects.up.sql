@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS "projects" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "name" text NOT NULL,
+    "description" text,
+    "tags" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_projects_user_id" ON "projects" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "project_assets" (
+    "id" bigserial,
+    "project_id" bigint NOT NULL,
+    "asset_type" text NOT NULL,
+    "asset_id" text NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_projects_assets" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_project_assets_project_id" ON "project_assets" ("project_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_project_assets_asset" ON "project_assets" ("asset_type", "asset_id");
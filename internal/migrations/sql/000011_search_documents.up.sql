@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "search_documents" (
+    "id" bigserial,
+    "entity_type" text NOT NULL,
+    "entity_id" text NOT NULL,
+    "user_id" varchar(255),
+    "title" text NOT NULL,
+    "body" text,
+    "summary" text,
+    "status" text,
+    "address" text,
+    "source_updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_search_documents_entity" ON "search_documents" ("entity_type", "entity_id");
+CREATE INDEX IF NOT EXISTS "idx_search_documents_user_id" ON "search_documents" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_search_documents_tsv" ON "search_documents" USING GIN (to_tsvector('simple', coalesce("title", '') || ' ' || coalesce("body", '')));
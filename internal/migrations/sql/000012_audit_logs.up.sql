@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS "audit_logs" (
+    "id" bigserial,
+    "kind" text NOT NULL,
+    "user_id" varchar(255),
+    "tool_name" text,
+    "arguments_hash" text,
+    "status" text,
+    "error" text,
+    "entity_type" text,
+    "entity_id" text,
+    "action" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_kind" ON "audit_logs" ("kind");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_user_id" ON "audit_logs" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_tool_name" ON "audit_logs" ("tool_name");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_entity_type" ON "audit_logs" ("entity_type");
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_created_at" ON "audit_logs" ("created_at");
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "idempotency_keys" (
+    "id" bigserial,
+    "scope" text NOT NULL,
+    "tool_name" text NOT NULL,
+    "key" text NOT NULL,
+    "arguments_hash" text NOT NULL,
+    "result" text,
+    "expires_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_idempotency_keys_key" ON "idempotency_keys" ("scope", "tool_name", "key");
+CREATE INDEX IF NOT EXISTS "idx_idempotency_keys_expires_at" ON "idempotency_keys" ("expires_at");
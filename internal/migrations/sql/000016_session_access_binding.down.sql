@@ -0,0 +1 @@
+ALTER TABLE "transaction_sessions" DROP COLUMN IF EXISTS "access_binding_hash";
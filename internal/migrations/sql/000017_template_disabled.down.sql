@@ -0,0 +1 @@
+ALTER TABLE "templates" DROP COLUMN IF EXISTS "disabled";
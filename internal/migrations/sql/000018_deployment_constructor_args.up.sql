@@ -0,0 +1 @@
+ALTER TABLE "deployments" ADD COLUMN IF NOT EXISTS "constructor_args" text;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "token_metadata" (
+    "id" bigserial,
+    "deployment_id" bigint NOT NULL,
+    "description" text,
+    "links" text,
+    "logo_key" text,
+    "logo_content_type" text,
+    "logo_url" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_token_metadata_deployment" FOREIGN KEY ("deployment_id") REFERENCES "deployments"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_token_metadata_deployment_id" ON "token_metadata" ("deployment_id");
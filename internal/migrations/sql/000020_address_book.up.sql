@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS "address_book_entries" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "label" text NOT NULL,
+    "address" text NOT NULL,
+    "chain_type" text NOT NULL,
+    "notes" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_address_book_entries_user_label" ON "address_book_entries" ("user_id", "label");
@@ -0,0 +1 @@
+ALTER TABLE "transaction_sessions" ADD COLUMN IF NOT EXISTS "safe_proposal" text;
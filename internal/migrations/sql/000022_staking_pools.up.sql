@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS "staking_pools" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "deployment_id" bigint NOT NULL,
+    "reward_deployment_id" bigint NOT NULL,
+    "liquidity_pool_id" bigint,
+    "chain_id" bigint NOT NULL,
+    "staking_token" text NOT NULL,
+    "reward_token" text NOT NULL,
+    "contract_address" text,
+    "deployer_address" text NOT NULL,
+    "reward_amount" text NOT NULL,
+    "rewards_duration" bigint NOT NULL,
+    "status" text DEFAULT 'pending',
+    "session_id" text,
+    "funding_transaction_hash" text,
+    "period_start" timestamptz,
+    "period_finish" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_user_id" ON "staking_pools" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_deployment_id" ON "staking_pools" ("deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_reward_deployment_id" ON "staking_pools" ("reward_deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_liquidity_pool_id" ON "staking_pools" ("liquidity_pool_id");
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_contract_address" ON "staking_pools" ("contract_address");
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_status" ON "staking_pools" ("status");
+CREATE INDEX IF NOT EXISTS "idx_staking_pools_session_id" ON "staking_pools" ("session_id");
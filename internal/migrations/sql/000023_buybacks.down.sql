@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "buyback_runs";
+DROP TABLE IF EXISTS "buybacks";
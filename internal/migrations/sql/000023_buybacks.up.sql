@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "buybacks" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "chain_id" bigint NOT NULL,
+    "deployment_id" bigint NOT NULL,
+    "token_address" text NOT NULL,
+    "treasury_address" text NOT NULL,
+    "amount_per_run" text NOT NULL,
+    "slippage_tolerance" text NOT NULL,
+    "schedule" text NOT NULL,
+    "interval_seconds" bigint NOT NULL,
+    "next_run_at" timestamptz,
+    "last_run_at" timestamptz,
+    "run_count" bigint DEFAULT 0,
+    "max_runs" bigint DEFAULT 0,
+    "status" text DEFAULT 'active',
+    "last_error" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_buybacks_user_id" ON "buybacks" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_buybacks_deployment_id" ON "buybacks" ("deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_buybacks_next_run_at" ON "buybacks" ("next_run_at");
+CREATE INDEX IF NOT EXISTS "idx_buybacks_status" ON "buybacks" ("status");
+
+CREATE TABLE IF NOT EXISTS "buyback_runs" (
+    "id" bigserial,
+    "buyback_id" bigint NOT NULL,
+    "session_id" text,
+    "status" text DEFAULT 'pending',
+    "eth_spent" text,
+    "tokens_burned" text,
+    "transaction_hash" text,
+    "error" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_buyback_runs_buyback_id" ON "buyback_runs" ("buyback_id");
+CREATE INDEX IF NOT EXISTS "idx_buyback_runs_session_id" ON "buyback_runs" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_buyback_runs_status" ON "buyback_runs" ("status");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "token_holders";
+DROP TABLE IF EXISTS "indexer_cursors";
+DROP TABLE IF EXISTS "indexed_events";
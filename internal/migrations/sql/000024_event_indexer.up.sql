@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS "indexed_events" (
+    "id" bigserial,
+    "chain_id" bigint NOT NULL,
+    "contract_address" text NOT NULL,
+    "event_type" text NOT NULL,
+    "block_number" bigint NOT NULL,
+    "block_time" timestamptz,
+    "transaction_hash" text NOT NULL,
+    "log_index" bigint NOT NULL,
+    "sender" text,
+    "recipient" text,
+    "value" text,
+    "amount0_in" text,
+    "amount1_in" text,
+    "amount0_out" text,
+    "amount1_out" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_indexed_events_log" ON "indexed_events" ("chain_id", "transaction_hash", "log_index");
+CREATE INDEX IF NOT EXISTS "idx_indexed_events_contract" ON "indexed_events" ("chain_id", "contract_address");
+CREATE INDEX IF NOT EXISTS "idx_indexed_events_event_type" ON "indexed_events" ("event_type");
+CREATE INDEX IF NOT EXISTS "idx_indexed_events_block_number" ON "indexed_events" ("block_number");
+
+CREATE TABLE IF NOT EXISTS "indexer_cursors" (
+    "id" bigserial,
+    "chain_id" bigint NOT NULL,
+    "contract_address" text NOT NULL,
+    "last_synced_block" bigint,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_indexer_cursors_contract" ON "indexer_cursors" ("chain_id", "contract_address");
+
+CREATE TABLE IF NOT EXISTS "token_holders" (
+    "id" bigserial,
+    "chain_id" bigint NOT NULL,
+    "token_address" text NOT NULL,
+    "holder_address" text NOT NULL,
+    "balance" text NOT NULL,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_token_holders_holder" ON "token_holders" ("chain_id", "token_address", "holder_address");
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS "alert_rules" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "chain_id" bigint NOT NULL,
+    "pool_id" bigint NOT NULL,
+    "type" text NOT NULL,
+    "threshold_percent" decimal,
+    "channel" text NOT NULL,
+    "target" text NOT NULL,
+    "enabled" boolean DEFAULT true,
+    "baseline_reserve0" text,
+    "baseline_reserve1" text,
+    "last_checked_block" bigint,
+    "trigger_count" bigint DEFAULT 0,
+    "last_triggered_at" timestamptz,
+    "last_error" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_alert_rules_chain" FOREIGN KEY ("chain_id") REFERENCES "chains"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_alert_rules_user_id" ON "alert_rules" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_alert_rules_pool_id" ON "alert_rules" ("pool_id");
+CREATE INDEX IF NOT EXISTS "idx_alert_rules_enabled" ON "alert_rules" ("enabled");
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "bonding_curves" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "deployment_id" bigint NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "contract_address" text,
+    "deployer_address" text NOT NULL,
+    "router_address" text NOT NULL,
+    "pair_address" text,
+    "total_supply" text NOT NULL,
+    "curve_supply" text NOT NULL,
+    "graduation_threshold" text NOT NULL,
+    "initial_buy" text NOT NULL DEFAULT '0',
+    "virtual_eth_reserve" text NOT NULL,
+    "virtual_token_reserve" text NOT NULL,
+    "eth_raised" text NOT NULL DEFAULT '0',
+    "tokens_sold" text NOT NULL DEFAULT '0',
+    "status" text DEFAULT 'pending',
+    "session_id" text,
+    "synced_at" timestamptz,
+    "graduated_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_bonding_curves_user_id" ON "bonding_curves" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_bonding_curves_deployment_id" ON "bonding_curves" ("deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_bonding_curves_contract_address" ON "bonding_curves" ("contract_address");
+CREATE INDEX IF NOT EXISTS "idx_bonding_curves_status" ON "bonding_curves" ("status");
+CREATE INDEX IF NOT EXISTS "idx_bonding_curves_session_id" ON "bonding_curves" ("session_id");
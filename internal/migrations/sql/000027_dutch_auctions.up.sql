@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS "dutch_auctions" (
+    "id" bigserial,
+    "user_id" varchar(255),
+    "deployment_id" bigint NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "contract_address" text,
+    "deployer_address" text NOT NULL,
+    "router_address" text NOT NULL,
+    "pair_address" text,
+    "total_supply" text NOT NULL,
+    "auction_supply" text NOT NULL,
+    "start_price" text NOT NULL,
+    "end_price" text NOT NULL,
+    "start_time" timestamptz NOT NULL,
+    "end_time" timestamptz NOT NULL,
+    "step_duration" bigint NOT NULL DEFAULT 0,
+    "current_price" text,
+    "eth_raised" text NOT NULL DEFAULT '0',
+    "tokens_sold" text NOT NULL DEFAULT '0',
+    "status" text DEFAULT 'pending',
+    "session_id" text,
+    "settle_session_id" text,
+    "synced_at" timestamptz,
+    "settled_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_dutch_auctions_user_id" ON "dutch_auctions" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_dutch_auctions_deployment_id" ON "dutch_auctions" ("deployment_id");
+CREATE INDEX IF NOT EXISTS "idx_dutch_auctions_contract_address" ON "dutch_auctions" ("contract_address");
+CREATE INDEX IF NOT EXISTS "idx_dutch_auctions_status" ON "dutch_auctions" ("status");
+CREATE INDEX IF NOT EXISTS "idx_dutch_auctions_session_id" ON "dutch_auctions" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_dutch_auctions_settle_session_id" ON "dutch_auctions" ("settle_session_id");
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS "platform_fees" (
+    "id" bigserial,
+    "session_id" text NOT NULL,
+    "user_id" varchar(255),
+    "chain_id" bigint NOT NULL,
+    "kind" text NOT NULL,
+    "recipient" text NOT NULL,
+    "amount" text NOT NULL,
+    "base_value" text NOT NULL DEFAULT '0',
+    "status" text DEFAULT 'pending',
+    "confirmed_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_platform_fees_session_id" ON "platform_fees" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_platform_fees_user_id" ON "platform_fees" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_platform_fees_chain_id" ON "platform_fees" ("chain_id");
+CREATE INDEX IF NOT EXISTS "idx_platform_fees_kind" ON "platform_fees" ("kind");
+CREATE INDEX IF NOT EXISTS "idx_platform_fees_status" ON "platform_fees" ("status");
+CREATE INDEX IF NOT EXISTS "idx_platform_fees_created_at" ON "platform_fees" ("created_at");
+
+CREATE TABLE IF NOT EXISTS "referrals" (
+    "id" bigserial,
+    "user_id" varchar(255) NOT NULL,
+    "referrer_address" text NOT NULL,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_referrals_user_id" ON "referrals" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_referrals_referrer_address" ON "referrals" ("referrer_address");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "user_operations";
+ALTER TABLE "chains" DROP COLUMN IF EXISTS "paymaster_rpc";
+ALTER TABLE "chains" DROP COLUMN IF EXISTS "bundler_rpc";
@@ -0,0 +1,26 @@
+ALTER TABLE "chains" ADD COLUMN IF NOT EXISTS "bundler_rpc" text;
+ALTER TABLE "chains" ADD COLUMN IF NOT EXISTS "paymaster_rpc" text;
+
+CREATE TABLE IF NOT EXISTS "user_operations" (
+    "id" bigserial,
+    "session_id" text NOT NULL,
+    "transaction_index" bigint NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "owner" text NOT NULL,
+    "sender" text NOT NULL,
+    "entry_point" text NOT NULL,
+    "operation" text,
+    "user_operation_hash" text NOT NULL,
+    "contract_address" text,
+    "sponsored" boolean DEFAULT false,
+    "status" text DEFAULT 'prepared',
+    "transaction_hash" text,
+    "error" text,
+    "included_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_operations_session_id" ON "user_operations" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_user_operations_user_operation_hash" ON "user_operations" ("user_operation_hash");
+CREATE INDEX IF NOT EXISTS "idx_user_operations_status" ON "user_operations" ("status");
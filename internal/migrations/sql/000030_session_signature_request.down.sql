@@ -0,0 +1 @@
+ALTER TABLE "transaction_sessions" DROP COLUMN IF EXISTS "signature_request";
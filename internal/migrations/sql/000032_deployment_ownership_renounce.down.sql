@@ -0,0 +1,2 @@
+ALTER TABLE "deployments" DROP COLUMN IF EXISTS "ownership_renounce_tx_hash";
+ALTER TABLE "deployments" DROP COLUMN IF EXISTS "ownership_renounced_at";
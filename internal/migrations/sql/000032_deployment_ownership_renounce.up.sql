@@ -0,0 +1,2 @@
+ALTER TABLE "deployments" ADD COLUMN IF NOT EXISTS "ownership_renounced_at" timestamptz;
+ALTER TABLE "deployments" ADD COLUMN IF NOT EXISTS "ownership_renounce_tx_hash" text;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "user_chains" (
+    "id" bigserial,
+    "user_id" varchar(255) NOT NULL,
+    "chain_id" bigint NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_chains_user_id" ON "user_chains" ("user_id");
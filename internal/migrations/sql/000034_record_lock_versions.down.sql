@@ -0,0 +1,3 @@
+ALTER TABLE "uniswap_deployments" DROP COLUMN IF EXISTS "lock_version";
+ALTER TABLE "liquidity_pools" DROP COLUMN IF EXISTS "lock_version";
+ALTER TABLE "deployments" DROP COLUMN IF EXISTS "lock_version";
@@ -0,0 +1,3 @@
+ALTER TABLE "deployments" ADD COLUMN IF NOT EXISTS "lock_version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "liquidity_pools" ADD COLUMN IF NOT EXISTS "lock_version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "uniswap_deployments" ADD COLUMN IF NOT EXISTS "lock_version" bigint NOT NULL DEFAULT 1;
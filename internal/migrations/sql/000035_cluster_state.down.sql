@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "shared_state_entries";
+DROP TABLE IF EXISTS "mcp_sessions";
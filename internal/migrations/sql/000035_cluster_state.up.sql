@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "mcp_sessions" (
+    "id" varchar(64),
+    "terminated_at" timestamptz,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_mcp_sessions_created_at" ON "mcp_sessions" ("created_at");
+
+CREATE TABLE IF NOT EXISTS "shared_state_entries" (
+    "key" varchar(255),
+    "value" bytea,
+    "expires_at" timestamptz,
+    PRIMARY KEY ("key")
+);
+CREATE INDEX IF NOT EXISTS "idx_shared_state_entries_expires_at" ON "shared_state_entries" ("expires_at");
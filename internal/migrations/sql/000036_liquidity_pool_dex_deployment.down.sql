@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_liquidity_pools_dex_deployment_id";
+ALTER TABLE "liquidity_pools" DROP COLUMN IF EXISTS "dex_deployment_id";
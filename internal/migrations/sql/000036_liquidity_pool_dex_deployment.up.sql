@@ -0,0 +1,2 @@
+ALTER TABLE "liquidity_pools" ADD COLUMN IF NOT EXISTS "dex_deployment_id" bigint;
+CREATE INDEX IF NOT EXISTS "idx_liquidity_pools_dex_deployment_id" ON "liquidity_pools" ("dex_deployment_id");
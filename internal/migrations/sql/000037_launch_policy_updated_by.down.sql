@@ -0,0 +1 @@
+ALTER TABLE "launch_policies" DROP COLUMN IF EXISTS "updated_by";
@@ -0,0 +1 @@
+ALTER TABLE "launch_policies" ADD COLUMN IF NOT EXISTS "updated_by" varchar(255);
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// AddressBookEntry is an address saved under a label by save_address. The tool arguments written as @label are
+// replaced with the address of the label before the tool runs
+type AddressBookEntry struct {
+	ID     uint    `gorm:"primaryKey" json:"id"`
+	UserID *string `gorm:"index:idx_address_book_entries_user_label;type:varchar(255)" json:"user_id,omitempty"`
+	// Label is lowercase, it is matched case-insensitively
+	Label     string               `gorm:"index:idx_address_book_entries_user_label;not null" json:"label"`
+	Address   string               `gorm:"not null" json:"address"`
+	ChainType TransactionChainType `gorm:"not null" json:"chain_type"`
+	Notes     string               `json:"notes,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
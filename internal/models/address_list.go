@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+type AddressListType string
+
+const (
+	AddressListTypeBlacklist AddressListType = "blacklist"
+	AddressListTypeWhitelist AddressListType = "whitelist"
+)
+
+type AddressListAction string
+
+const (
+	AddressListActionAdd    AddressListAction = "add"
+	AddressListActionRemove AddressListAction = "remove"
+)
+
+type AddressListChangeStatus string
+
+const (
+	// AddressListChangeStatusPending changes are waiting for their session to be signed
+	AddressListChangeStatusPending AddressListChangeStatus = "pending"
+	// AddressListChangeStatusApplied changes were confirmed on chain and applied to the recorded list
+	AddressListChangeStatusApplied AddressListChangeStatus = "applied"
+)
+
+// AddressListChange is one add or remove operation of a blacklist or whitelist update session.
+// Applied changes are kept as the compliance record of the list.
+type AddressListChange struct {
+	ID              uint                    `gorm:"primaryKey" json:"id"`
+	DeploymentID    uint                    `gorm:"index;not null" json:"deployment_id"`
+	SessionId       string                  `gorm:"index;not null" json:"session_id"`
+	ListType        AddressListType         `gorm:"not null" json:"list_type"`
+	Action          AddressListAction       `gorm:"not null" json:"action"`
+	Address         string                  `gorm:"not null" json:"address"`
+	Status          AddressListChangeStatus `gorm:"index;default:pending" json:"status"`
+	TransactionHash string                  `json:"transaction_hash,omitempty"`
+	AppliedAt       *time.Time              `json:"applied_at,omitempty"`
+	CreatedAt       time.Time               `json:"created_at"`
+}
+
+// AddressListEntry is an address currently on the blacklist or whitelist of a deployment
+type AddressListEntry struct {
+	ID           uint            `gorm:"primaryKey" json:"id"`
+	DeploymentID uint            `gorm:"uniqueIndex:idx_address_list_entry;not null" json:"deployment_id"`
+	ListType     AddressListType `gorm:"uniqueIndex:idx_address_list_entry;not null" json:"list_type"`
+	Address      string          `gorm:"uniqueIndex:idx_address_list_entry;not null" json:"address"`
+	// SessionId is the session that added the address
+	SessionId string    `json:"session_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+type AlertRuleType string
+
+const (
+	// AlertRuleTypeReserveDrop fires when a reserve of the pool falls by more than the threshold from its high
+	AlertRuleTypeReserveDrop AlertRuleType = "reserve_drop"
+	// AlertRuleTypePriceMove fires when the pool price moves by more than the threshold in either direction
+	AlertRuleTypePriceMove AlertRuleType = "price_move"
+	// AlertRuleTypeCreatorRemoval fires when the pool creator removes liquidity
+	AlertRuleTypeCreatorRemoval AlertRuleType = "creator_removal"
+)
+
+type AlertChannel string
+
+const (
+	AlertChannelWebhook  AlertChannel = "webhook"
+	AlertChannelTelegram AlertChannel = "telegram"
+)
+
+// AlertRule is a pool health rule evaluated by the pool alert monitor. Target is the webhook URL or the Telegram chat ID.
+// BaselineReserve0 and BaselineReserve1 are the pair reserves the rule compares against, they are reset whenever the
+// rule fires so an alert is only repeated after another move of the threshold
+type AlertRule struct {
+	ID               uint          `gorm:"primaryKey" json:"id"`
+	UserID           *string       `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	ChainID          uint          `gorm:"not null" json:"chain_id"`
+	PoolID           uint          `gorm:"index;not null" json:"pool_id"`
+	Type             AlertRuleType `gorm:"not null" json:"type"`
+	ThresholdPercent float64       `json:"threshold_percent,omitempty"`
+	Channel          AlertChannel  `gorm:"not null" json:"channel"`
+	Target           string        `gorm:"not null" json:"target"`
+	Enabled          bool          `gorm:"index;default:true" json:"enabled"`
+	BaselineReserve0 string        `json:"baseline_reserve0,omitempty"`
+	BaselineReserve1 string        `json:"baseline_reserve1,omitempty"`
+	// LastCheckedBlock is the last indexed block checked for liquidity removals by the creator
+	LastCheckedBlock uint64     `json:"last_checked_block,omitempty"`
+	TriggerCount     int        `gorm:"default:0" json:"trigger_count"`
+	LastTriggeredAt  *time.Time `json:"last_triggered_at,omitempty"`
+	LastError        string     `json:"last_error,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// APIKey lets a machine client such as a CI pipeline or a bot call the streamable-http server without interactive OAuth.
+// Only the SHA-256 of the key is stored, the key itself is shown once by create_api_key
+type APIKey struct {
+	ID   uint   `gorm:"primaryKey" json:"id"`
+	Name string `gorm:"not null" json:"name"`
+	// Subject is the user ID the key authenticates as, the sessions and records it creates belong to this user
+	Subject string `gorm:"index;not null" json:"subject"`
+	// Prefix is the start of the key, enough to recognize it without revealing it
+	Prefix  string `gorm:"not null" json:"prefix"`
+	KeyHash string `gorm:"uniqueIndex;not null" json:"-"`
+	// Scopes are the tools the key may call, "*" allows every tool
+	Scopes     []string   `gorm:"serializer:json;type:text" json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// CreatedBy is the user ID of the admin who created the key
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
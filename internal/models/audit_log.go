@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+type AuditLogKind string
+
+const (
+	// AuditLogKindToolCall records an MCP tool invocation
+	AuditLogKindToolCall AuditLogKind = "tool_call"
+	// AuditLogKindMutation records a row created, updated or deleted in the database
+	AuditLogKindMutation AuditLogKind = "mutation"
+)
+
+type AuditLogStatus string
+
+const (
+	AuditLogStatusSuccess AuditLogStatus = "success"
+	AuditLogStatusError   AuditLogStatus = "error"
+)
+
+type AuditLogAction string
+
+const (
+	AuditLogActionCreate AuditLogAction = "create"
+	AuditLogActionUpdate AuditLogAction = "update"
+	AuditLogActionDelete AuditLogAction = "delete"
+)
+
+// AuditLog records a tool call or an entity mutation for compliance, entries are pruned after the retention period
+type AuditLog struct {
+	ID     uint         `gorm:"primaryKey" json:"id"`
+	Kind   AuditLogKind `gorm:"index;not null" json:"kind"`
+	UserID *string      `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+
+	// ToolName, ArgumentsHash, Status and Error are set on tool calls.
+	// ArgumentsHash is the SHA-256 of the JSON arguments, the arguments themselves may hold secrets and are not stored
+	ToolName      string         `gorm:"index" json:"tool_name,omitempty"`
+	ArgumentsHash string         `json:"arguments_hash,omitempty"`
+	Status        AuditLogStatus `json:"status,omitempty"`
+	Error         string         `gorm:"type:text" json:"error,omitempty"`
+
+	// EntityType, EntityID and Action are set on mutations. EntityType is the table of the row,
+	// EntityID is empty for the updates and deletes matching rows by condition
+	EntityType string         `gorm:"index" json:"entity_type,omitempty"`
+	EntityID   string         `json:"entity_id,omitempty"`
+	Action     AuditLogAction `json:"action,omitempty"`
+
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+}
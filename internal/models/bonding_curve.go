@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+type BondingCurveStatus string
+
+const (
+	// BondingCurveStatusPending curves have a deployment session waiting to be signed
+	BondingCurveStatusPending BondingCurveStatus = "pending"
+	// BondingCurveStatusActive curves are deployed and sell their curve supply
+	BondingCurveStatusActive BondingCurveStatus = "active"
+	// BondingCurveStatusGraduated curves sold out their curve supply and moved their liquidity to the Uniswap pair
+	BondingCurveStatusGraduated BondingCurveStatus = "graduated"
+	BondingCurveStatusFailed    BondingCurveStatus = "failed"
+)
+
+// BondingCurve is a token of launch_bonding_curve sold along a bonding curve until its liquidity migrates to Uniswap.
+// Amounts are in wei and the smallest unit of the token
+type BondingCurve struct {
+	ID           uint    `gorm:"primaryKey" json:"id"`
+	UserID       *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	DeploymentID uint    `gorm:"index;not null" json:"deployment_id"`
+	ChainID      uint    `gorm:"not null" json:"chain_id"`
+	// ContractAddress is the token and the curve, set once the deployment is confirmed
+	ContractAddress string `gorm:"index" json:"contract_address,omitempty"`
+	DeployerAddress string `gorm:"not null" json:"deployer_address"`
+	RouterAddress   string `gorm:"not null" json:"router_address"`
+	// PairAddress is the Uniswap pair created with the token, read from the contract with its state
+	PairAddress string `json:"pair_address,omitempty"`
+	TotalSupply string `gorm:"not null" json:"total_supply"`
+	// CurveSupply is sold along the curve, the rest of the total supply is added to the pair at graduation
+	CurveSupply         string `gorm:"not null" json:"curve_supply"`
+	GraduationThreshold string `gorm:"not null" json:"graduation_threshold"`
+	InitialBuy          string `gorm:"not null;default:0" json:"initial_buy"`
+	// VirtualEthReserve and VirtualTokenReserve are the reserves of the curve, initial until the state is synced
+	VirtualEthReserve   string             `gorm:"not null" json:"virtual_eth_reserve"`
+	VirtualTokenReserve string             `gorm:"not null" json:"virtual_token_reserve"`
+	EthRaised           string             `gorm:"not null;default:0" json:"eth_raised"`
+	TokensSold          string             `gorm:"not null;default:0" json:"tokens_sold"`
+	Status              BondingCurveStatus `gorm:"index;default:pending" json:"status"`
+	SessionId           string             `gorm:"index" json:"session_id"`
+	// SyncedAt is the last time the state was read from the contract
+	SyncedAt    *time.Time `json:"synced_at,omitempty"`
+	GraduatedAt *time.Time `json:"graduated_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
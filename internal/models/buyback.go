@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+type BuybackStatus string
+
+const (
+	BuybackStatusActive    BuybackStatus = "active"
+	BuybackStatusCompleted BuybackStatus = "completed"
+	BuybackStatusCancelled BuybackStatus = "cancelled"
+)
+
+type BuybackRunStatus string
+
+const (
+	// BuybackRunStatusPending is a run whose swap session is waiting to be signed
+	BuybackRunStatusPending   BuybackRunStatus = "pending"
+	BuybackRunStatusConfirmed BuybackRunStatus = "confirmed"
+	// BuybackRunStatusFailed is a run whose swap session could not be created
+	BuybackRunStatusFailed BuybackRunStatus = "failed"
+)
+
+// Buyback is a buyback-and-burn schedule that swaps treasury ETH for the token on every run, the bought tokens are
+// sent to the burn address
+type Buyback struct {
+	ID           uint    `gorm:"primaryKey" json:"id"`
+	UserID       *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	ChainID      uint    `gorm:"not null" json:"chain_id"`
+	DeploymentID uint    `gorm:"index;not null" json:"deployment_id"`
+	TokenAddress string  `gorm:"not null" json:"token_address"`
+	// TreasuryAddress signs the swaps and pays the ETH
+	TreasuryAddress   string `gorm:"not null" json:"treasury_address"`
+	AmountPerRun      string `gorm:"not null" json:"amount_per_run"` // in wei
+	SlippageTolerance string `gorm:"not null" json:"slippage_tolerance"`
+	// Schedule is the cron-like descriptor the schedule was created with, e.g. @daily or @every 6h
+	Schedule        string        `gorm:"not null" json:"schedule"`
+	IntervalSeconds int64         `gorm:"not null" json:"interval_seconds"`
+	NextRunAt       time.Time     `gorm:"index" json:"next_run_at"`
+	LastRunAt       *time.Time    `json:"last_run_at,omitempty"`
+	RunCount        int           `gorm:"default:0" json:"run_count"`
+	MaxRuns         int           `gorm:"default:0" json:"max_runs"` // 0 runs until cancelled
+	Status          BuybackStatus `gorm:"index;default:active" json:"status"`
+	LastError       string        `json:"last_error,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
+
+// BuybackRun records the swap session created by one run of a buyback and the tokens it burned once confirmed
+type BuybackRun struct {
+	ID        uint             `gorm:"primaryKey" json:"id"`
+	BuybackID uint             `gorm:"index;not null" json:"buyback_id"`
+	SessionId string           `gorm:"index" json:"session_id,omitempty"`
+	Status    BuybackRunStatus `gorm:"index;default:pending" json:"status"`
+	EthSpent  string           `json:"eth_spent,omitempty"`
+	// TokensBurned is the amount transferred to the burn address by the confirmed swap
+	TokensBurned    string    `json:"tokens_burned,omitempty"`
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	Error           string    `json:"error,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -13,7 +13,24 @@ type Chain struct {
 	NetworkID string               `gorm:"column:chain_id" json:"chain_id"` // The blockchain's chain ID (e.g., "1" for Ethereum mainnet)
 	Name      string               `gorm:"not null" json:"name"`
 	IsActive  bool                 `gorm:"default:false" json:"is_active"`
-	CreatedAt time.Time            `json:"created_at"`
-	UpdatedAt time.Time            `json:"updated_at"`
-	DeletedAt gorm.DeletedAt       `gorm:"index" json:"-"`
+	// PrivateRelayRPC is the private transaction relay (e.g. Flashbots Protect, MEV Blocker) used for MEV protected transactions
+	PrivateRelayRPC string `json:"private_relay_rpc,omitempty"`
+	// BundlerRPC is the ERC-4337 bundler the gasless transactions are sent to as UserOperations,
+	// it and PaymasterRPC usually carry an API key so they are never sent to the signing page
+	BundlerRPC string `json:"-"`
+	// PaymasterRPC is the ERC-4337 paymaster sponsoring the gas of the UserOperations (optional)
+	PaymasterRPC string         `json:"-"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// UserChain is the default chain of a user, chosen with select_chain. It is used by the tool calls of the user
+// that do not pass a chain_id argument, so concurrent clients do not switch the chain of each other
+type UserChain struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    string    `gorm:"type:varchar(255);uniqueIndex;not null" json:"user_id"`
+	ChainID   uint      `gorm:"not null" json:"chain_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// MCPSession is a streamable HTTP session of an MCP client. Replicas of a cluster share them so a client can send
+// the requests of its session to any replica, without sticky sessions
+type MCPSession struct {
+	ID string `gorm:"primaryKey;type:varchar(64)" json:"id"`
+	// TerminatedAt is set when the client ends the session with a DELETE request, later requests are refused
+	TerminatedAt *time.Time `json:"terminated_at,omitempty"`
+	CreatedAt    time.Time  `gorm:"index" json:"created_at"`
+}
+
+// SharedStateEntry is an expiring value shared by the replicas of a cluster, such as the request counters of the
+// rate limits
+type SharedStateEntry struct {
+	Key   string `gorm:"primaryKey;type:varchar(255)" json:"key"`
+	Value []byte `json:"-"`
+	// ExpiresAt is nil for the entries that never expire
+	ExpiresAt *time.Time `gorm:"index" json:"expires_at,omitempty"`
+}
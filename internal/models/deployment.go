@@ -2,19 +2,52 @@ package models
 
 import "time"
 
+type SellTestStatus string
+
+const (
+	SellTestStatusPending SellTestStatus = "pending"
+	SellTestStatusPassed  SellTestStatus = "passed"
+	SellTestStatusFailed  SellTestStatus = "failed"
+	// SellTestStatusSkipped is used when the sell path cannot be simulated, such as pools not paired with ETH
+	SellTestStatusSkipped SellTestStatus = "skipped"
+	// SellTestStatusNotTradable is used when the simulated buy reverted, e.g. trading is not enabled yet. Nothing was
+	// bought so the sell path is untested, the test runs again once a trading launch opens
+	SellTestStatusNotTradable SellTestStatus = "not_tradable"
+)
+
 type Deployment struct {
-	ID              uint              `gorm:"primaryKey" json:"id"`
-	UserID          *string           `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
-	TemplateID      uint              `gorm:"not null" json:"template_id"`
-	ChainID         uint              `gorm:"not null" json:"chain_id"`
-	ContractAddress string            `json:"contract_address"`
-	TemplateValues  JSON              `gorm:"type:text" json:"template_values"` // Runtime template parameter values
+	ID              uint    `gorm:"primaryKey" json:"id"`
+	UserID          *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	TemplateID      uint    `gorm:"not null" json:"template_id"`
+	ChainID         uint    `gorm:"not null" json:"chain_id"`
+	ContractAddress string  `json:"contract_address"`
+	TemplateValues  JSON    `gorm:"type:text" json:"template_values"` // Runtime template parameter values
+	// ConstructorArgs are the constructor arguments the contract was deployed with, nil for the deployments made before they were stored
+	ConstructorArgs []any             `gorm:"type:text;serializer:json" json:"constructor_args,omitempty"`
 	DeployerAddress string            `json:"deployer_address"`
 	TransactionHash string            `json:"transaction_hash"`
 	Status          TransactionStatus `gorm:"default:pending" json:"status"` // pending, models.TransactionStatusConfirmed, failed
 	SessionId       string            `gorm:"index" json:"session_id"`
-	CreatedAt       time.Time         `json:"created_at"`
-	UpdatedAt       time.Time         `json:"updated_at"`
+	Interfaces      JSON              `gorm:"type:text" json:"interfaces,omitempty"` // Result of the last detect_interfaces run
+	// LaunchGroupID is the multi_chain_launch group of the deployment, nil for single chain launches
+	LaunchGroupID *uint `gorm:"index" json:"launch_group_id,omitempty"`
+	// SellTestStatus is the result of the simulated buy-then-sell run after liquidity is added
+	SellTestStatus SellTestStatus `gorm:"index" json:"sell_test_status,omitempty"`
+	SellTestResult JSON           `gorm:"type:text" json:"sell_test_result,omitempty"`
+	SellTestedAt   *time.Time     `json:"sell_tested_at,omitempty"`
+	// GasUsed and GasCost (in wei) are read from the receipt of the confirmed deployment transaction
+	GasUsed uint64 `json:"gas_used,omitempty"`
+	GasCost string `json:"gas_cost,omitempty"`
+	// OwnershipRenouncedAt is set once the owner of the contract was renounced, by renounce_ownership or read on chain.
+	// OwnershipRenounceTxHash is empty when the renouncement was only read on chain
+	OwnershipRenouncedAt    *time.Time `json:"ownership_renounced_at,omitempty"`
+	OwnershipRenounceTxHash string     `json:"ownership_renounce_tx_hash,omitempty"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+
+	// LockVersion is the optimistic lock of the deployment, incremented by every write of DeploymentService.
+	// Status transitions only apply to the version they read, see services.ErrVersionConflict
+	LockVersion uint `gorm:"not null;default:1" json:"lock_version"`
 
 	Template Template           `gorm:"foreignKey:TemplateID" json:"template,omitempty"`
 	Chain    Chain              `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
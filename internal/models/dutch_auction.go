@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+type DutchAuctionStatus string
+
+const (
+	// DutchAuctionStatusPending auctions have a deployment session waiting to be signed
+	DutchAuctionStatusPending DutchAuctionStatus = "pending"
+	// DutchAuctionStatusActive auctions are deployed and sell their auction supply at the decaying price
+	DutchAuctionStatusActive DutchAuctionStatus = "active"
+	// DutchAuctionStatusEnded auctions sold out or reached their end time and wait for settle_dutch_auction
+	DutchAuctionStatusEnded DutchAuctionStatus = "ended"
+	// DutchAuctionStatusSettled auctions added their proceeds to the Uniswap pair
+	DutchAuctionStatusSettled DutchAuctionStatus = "settled"
+	DutchAuctionStatusFailed  DutchAuctionStatus = "failed"
+)
+
+// DutchAuction is a token of launch_dutch_auction sold at a price decaying over time until its proceeds are settled
+// into a Uniswap pool. Amounts are in wei and the smallest unit of the token, prices in wei per token (10^18 units)
+type DutchAuction struct {
+	ID           uint    `gorm:"primaryKey" json:"id"`
+	UserID       *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	DeploymentID uint    `gorm:"index;not null" json:"deployment_id"`
+	ChainID      uint    `gorm:"not null" json:"chain_id"`
+	// ContractAddress is the token and the auction, set once the deployment is confirmed
+	ContractAddress string `gorm:"index" json:"contract_address,omitempty"`
+	DeployerAddress string `gorm:"not null" json:"deployer_address"`
+	RouterAddress   string `gorm:"not null" json:"router_address"`
+	// PairAddress is the Uniswap pair created with the token, read from the contract with its state
+	PairAddress string `json:"pair_address,omitempty"`
+	TotalSupply string `gorm:"not null" json:"total_supply"`
+	// AuctionSupply is sold in the auction, the rest of the total supply seeds the pair at settlement
+	AuctionSupply string    `gorm:"not null" json:"auction_supply"`
+	StartPrice    string    `gorm:"not null" json:"start_price"`
+	EndPrice      string    `gorm:"not null" json:"end_price"`
+	StartTime     time.Time `gorm:"not null" json:"start_time"`
+	EndTime       time.Time `gorm:"not null" json:"end_time"`
+	// StepDuration is the number of seconds between two price drops, 0 for a continuous decay
+	StepDuration int64  `gorm:"not null;default:0" json:"step_duration"`
+	CurrentPrice string `json:"current_price,omitempty"`
+	EthRaised    string `gorm:"not null;default:0" json:"eth_raised"`
+	TokensSold   string `gorm:"not null;default:0" json:"tokens_sold"`
+	// Status is ended as soon as the state read says so, settled once the settlement is confirmed or read
+	Status    DutchAuctionStatus `gorm:"index;default:pending" json:"status"`
+	SessionId string             `gorm:"index" json:"session_id"`
+	// SettleSessionId is the session of settle_dutch_auction calling settle
+	SettleSessionId string `gorm:"index" json:"settle_session_id,omitempty"`
+	// SyncedAt is the last time the state was read from the contract
+	SyncedAt  *time.Time `json:"synced_at,omitempty"`
+	SettledAt *time.Time `json:"settled_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// IdempotencyKey records a tool call made with an idempotency_key, so a retry with the same key
+// returns the result of the first call instead of creating duplicate sessions or records
+type IdempotencyKey struct {
+	ID uint `gorm:"primaryKey" json:"id"`
+	// Scope is the user ID of the call, empty without authentication, so users cannot replay the calls of others
+	Scope    string `gorm:"uniqueIndex:idx_idempotency_keys_key;not null" json:"scope"`
+	ToolName string `gorm:"uniqueIndex:idx_idempotency_keys_key;not null" json:"tool_name"`
+	Key      string `gorm:"uniqueIndex:idx_idempotency_keys_key;not null" json:"key"`
+	// ArgumentsHash is the SHA-256 of the arguments of the first call, retries with other arguments are rejected
+	ArgumentsHash string `gorm:"not null" json:"arguments_hash"`
+	// Result is the JSON tool result of the first call, empty while the call is in progress
+	Result    string    `gorm:"type:text" json:"result,omitempty"`
+	ExpiresAt time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
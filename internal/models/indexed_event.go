@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+type IndexedEventType string
+
+const (
+	IndexedEventTypeTransfer IndexedEventType = "transfer"
+	IndexedEventTypeSwap     IndexedEventType = "swap"
+	IndexedEventTypeMint     IndexedEventType = "mint"
+	IndexedEventTypeBurn     IndexedEventType = "burn"
+)
+
+// IndexedEvent is a Transfer event of a launched token or a Swap, Mint, Burn or LP Transfer event of a pool, stored
+// by the event indexer. Amounts are in base units, only the amounts of the event type are set
+type IndexedEvent struct {
+	ID              uint             `gorm:"primaryKey" json:"id"`
+	ChainID         uint             `gorm:"uniqueIndex:idx_indexed_events_log;index:idx_indexed_events_contract;not null" json:"chain_id"`
+	ContractAddress string           `gorm:"index:idx_indexed_events_contract;not null" json:"contract_address"`
+	EventType       IndexedEventType `gorm:"index;not null" json:"event_type"`
+	BlockNumber     uint64           `gorm:"index;not null" json:"block_number"`
+	// BlockTime is missing when the node does not return blockTimestamp with the logs
+	BlockTime       *time.Time `json:"block_time,omitempty"`
+	TransactionHash string     `gorm:"uniqueIndex:idx_indexed_events_log;not null" json:"transaction_hash"`
+	LogIndex        uint64     `gorm:"uniqueIndex:idx_indexed_events_log;not null" json:"log_index"`
+	// Sender is the from address of a transfer and the sender of a pool event
+	Sender string `json:"sender"`
+	// Recipient is the to address of a transfer, swap or burn
+	Recipient string `json:"recipient,omitempty"`
+	// Value is the amount of a transfer
+	Value string `json:"value,omitempty"`
+	// Amount0In and Amount1In are the amounts paid into the pool by a swap or mint
+	Amount0In string `json:"amount0_in,omitempty"`
+	Amount1In string `json:"amount1_in,omitempty"`
+	// Amount0Out and Amount1Out are the amounts paid out of the pool by a swap or burn
+	Amount0Out string    `json:"amount0_out,omitempty"`
+	Amount1Out string    `json:"amount1_out,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// IndexerCursor records up to which block the events of a contract were indexed
+type IndexerCursor struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	ChainID         uint      `gorm:"uniqueIndex:idx_indexer_cursors_contract;not null" json:"chain_id"`
+	ContractAddress string    `gorm:"uniqueIndex:idx_indexer_cursors_contract;not null" json:"contract_address"`
+	LastSyncedBlock uint64    `json:"last_synced_block"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TokenHolder is the balance of an address replayed from the indexed Transfer events of a token or pair,
+// addresses are removed once their balance is zero
+type TokenHolder struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	ChainID       uint      `gorm:"uniqueIndex:idx_token_holders_holder;not null" json:"chain_id"`
+	TokenAddress  string    `gorm:"uniqueIndex:idx_token_holders_holder;not null" json:"token_address"`
+	HolderAddress string    `gorm:"uniqueIndex:idx_token_holders_holder;not null" json:"holder_address"`
+	Balance       string    `gorm:"not null" json:"balance"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
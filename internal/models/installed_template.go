@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// InstalledTemplate records a template installed from a remote registry, so installing the same
+// version again reuses the local template instead of downloading the bundle
+type InstalledTemplate struct {
+	ID       uint    `gorm:"primaryKey" json:"id"`
+	UserID   *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	Registry string  `gorm:"not null" json:"registry"`
+	Name     string  `gorm:"index;not null" json:"name"`
+	Version  string  `gorm:"not null" json:"version"`
+	// TemplateID is the local template created from the bundle, a bundle can create several
+	TemplateID uint `gorm:"index;not null" json:"template_id"`
+	// BundleSHA256 is the hex digest of the verified bundle
+	BundleSHA256 string    `json:"bundle_sha256"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,85 @@
+package models
+
+import "time"
+
+type LaunchGroupStatus string
+
+const (
+	// LaunchGroupStatusPending groups have deployments or bridge deposits waiting to be signed
+	LaunchGroupStatusPending LaunchGroupStatus = "pending"
+	// LaunchGroupStatusConfirmed groups had the deployment and bridge deposits confirmed on every chain
+	LaunchGroupStatusConfirmed LaunchGroupStatus = "confirmed"
+	// LaunchGroupStatusPartial groups have failed deployments next to confirmed or pending ones
+	LaunchGroupStatusPartial LaunchGroupStatus = "partial"
+	LaunchGroupStatusFailed  LaunchGroupStatus = "failed"
+)
+
+// LaunchGroup is a multi_chain_launch of a template, every chain gets its own deployment and transaction session
+type LaunchGroup struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	UserID         *string   `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	TemplateID     uint      `gorm:"index;not null" json:"template_id"`
+	ContractName   string    `json:"contract_name"`
+	TemplateValues JSON      `gorm:"type:text" json:"template_values"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+
+	Template    Template     `gorm:"foreignKey:TemplateID" json:"template,omitempty"`
+	Deployments []Deployment `gorm:"foreignKey:LaunchGroupID" json:"deployments,omitempty"`
+	// Bridges route the initial liquidity ETH of the group to the target chains
+	Bridges []LaunchGroupBridge `gorm:"foreignKey:LaunchGroupID" json:"bridges,omitempty"`
+}
+
+// LaunchGroupBridge is a bridge deposit of a launch group, signed on the source chain and credited on the target chain
+type LaunchGroupBridge struct {
+	ID            uint `gorm:"primaryKey" json:"id"`
+	LaunchGroupID uint `gorm:"index;not null" json:"launch_group_id"`
+	SourceChainID uint `gorm:"not null" json:"source_chain_id"`
+	TargetChainID uint `gorm:"not null" json:"target_chain_id"`
+	// BridgeAddress is the bridge contract on the source chain, it identifies the deposit within its session
+	BridgeAddress string `gorm:"not null" json:"bridge_address"`
+	BridgeName    string `json:"bridge_name"`
+	// Recipient is credited on the target chain, empty for the signer
+	Recipient       string            `json:"recipient,omitempty"`
+	Amount          string            `gorm:"not null" json:"amount"` // in wei
+	SessionId       string            `gorm:"index" json:"session_id"`
+	Status          TransactionStatus `gorm:"default:pending" json:"status"`
+	TransactionHash string            `json:"transaction_hash,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+
+	SourceChain Chain `gorm:"foreignKey:SourceChainID;references:ID" json:"source_chain,omitempty"`
+	TargetChain Chain `gorm:"foreignKey:TargetChainID;references:ID" json:"target_chain,omitempty"`
+}
+
+// Status consolidates the status of the deployments and bridge deposits of the group
+func (g *LaunchGroup) Status() LaunchGroupStatus {
+	var statuses []TransactionStatus
+	for _, deployment := range g.Deployments {
+		statuses = append(statuses, deployment.Status)
+	}
+	for _, bridge := range g.Bridges {
+		statuses = append(statuses, bridge.Status)
+	}
+
+	var confirmed, failed int
+	for _, status := range statuses {
+		switch status {
+		case TransactionStatusConfirmed:
+			confirmed++
+		case TransactionStatusFailed:
+			failed++
+		}
+	}
+	switch {
+	case len(statuses) == 0:
+		return LaunchGroupStatusPending
+	case confirmed == len(statuses):
+		return LaunchGroupStatusConfirmed
+	case failed == len(statuses):
+		return LaunchGroupStatusFailed
+	case failed > 0:
+		return LaunchGroupStatusPartial
+	}
+	return LaunchGroupStatusPending
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// LaunchPolicy holds the launch rules of the organization, they are enforced before the launch sessions of every user
+// are created. Only admins edit it
+type LaunchPolicy struct {
+	ID uint `gorm:"primaryKey" json:"id"`
+	// UserID is only set on the policies saved per user by earlier versions, they are ignored. The organization policy
+	// has none
+	UserID *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	// UpdatedBy is the admin that saved the policy, nil on the local server
+	UpdatedBy *string `gorm:"type:varchar(255)" json:"updated_by,omitempty"`
+	// LiquidityLockThreshold is the ETH side of a new pool, in wei, above which the liquidity must be locked.
+	// Empty disables the rule
+	LiquidityLockThreshold string `json:"liquidity_lock_threshold,omitempty"`
+	// MinLiquidityLockDays is the minimum duration of the required liquidity lock
+	MinLiquidityLockDays int `json:"min_liquidity_lock_days"`
+	// LiquidityLockerAddress is the allow-listed locker contract, the locks required by the policy go through it. It
+	// implements the Liquidity Locker template of the liquidity-lock pack
+	LiquidityLockerAddress string    `json:"liquidity_locker_address,omitempty"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+type LimitOrderStatus string
+
+const (
+	// LimitOrderStatusOpen orders are watched by the limit order monitor
+	LimitOrderStatusOpen LimitOrderStatus = "open"
+	// LimitOrderStatusTriggered orders reached their target price and have a swap session waiting to be signed
+	LimitOrderStatusTriggered LimitOrderStatus = "triggered"
+	// LimitOrderStatusFilled orders had their swap transaction confirmed
+	LimitOrderStatusFilled    LimitOrderStatus = "filled"
+	LimitOrderStatusCancelled LimitOrderStatus = "cancelled"
+	LimitOrderStatusExpired   LimitOrderStatus = "expired"
+	LimitOrderStatusFailed    LimitOrderStatus = "failed"
+)
+
+// LimitOrder is a swap that is only prepared once the pool price reaches the target price.
+// TargetPrice is the minimum amount of to_token received per from_token, in raw units after the pool fee.
+type LimitOrder struct {
+	ID                uint             `gorm:"primaryKey" json:"id"`
+	UserID            *string          `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	ChainID           uint             `gorm:"not null" json:"chain_id"`
+	PoolID            uint             `gorm:"index;not null" json:"pool_id"`
+	FromToken         string           `gorm:"not null" json:"from_token"`
+	ToToken           string           `gorm:"not null" json:"to_token"`
+	Amount            string           `gorm:"not null" json:"amount"`
+	TargetPrice       float64          `gorm:"not null" json:"target_price"`
+	SlippageTolerance string           `gorm:"not null" json:"slippage_tolerance"`
+	UserAddress       string           `gorm:"not null" json:"user_address"`
+	Status            LimitOrderStatus `gorm:"index;default:open" json:"status"`
+	// SessionId is the swap session generated when the order is triggered
+	SessionId   string     `gorm:"index" json:"session_id,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	TriggeredAt *time.Time `json:"triggered_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
@@ -18,6 +18,28 @@ type LiquidityPool struct {
 	CreatedAt       time.Time         `json:"created_at"`
 	UpdatedAt       time.Time         `json:"updated_at"`
 
+	// LockVersion is the optimistic lock of the pool, incremented by every write of LiquidityService
+	LockVersion uint `gorm:"not null;default:1" json:"lock_version"`
+
+	// DexDeploymentID is the Uniswap deployment whose factory created the pair. Pools created before a chain could
+	// have several deployments have none and belong to the default deployment of the chain
+	DexDeploymentID *uint `gorm:"index" json:"dex_deployment_id,omitempty"`
+
 	SessionId string             `gorm:"index" json:"session_id"`
 	Session   TransactionSession `gorm:"foreignKey:SessionId;references:ID" json:"session,omitempty"`
 }
+
+// PoolSnapshot records the reserves of a liquidity pool after a confirmed pool transaction.
+// Snapshots are used to draw the price history on the pool detail page.
+type PoolSnapshot struct {
+	ID       uint   `gorm:"primaryKey" json:"id"`
+	PoolID   uint   `gorm:"index;not null" json:"pool_id"`
+	Reserve0 string `gorm:"not null" json:"reserve0"`
+	Reserve1 string `gorm:"not null" json:"reserve1"`
+	// Price is the price of token0 denominated in token1 (reserve1 / reserve0)
+	Price           float64         `json:"price"`
+	TransactionType TransactionType `gorm:"index" json:"transaction_type"`
+	TransactionHash string          `json:"transaction_hash"`
+	SessionId       string          `gorm:"index" json:"session_id"`
+	CreatedAt       time.Time       `gorm:"index" json:"created_at"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+type PlatformFeeKind string
+
+const (
+	// PlatformFeeKindPlatform is the part of the fee paid to the fee recipient of the operator
+	PlatformFeeKindPlatform PlatformFeeKind = "platform"
+	// PlatformFeeKindReferral is the part of the fee shared with the referrer of the user
+	PlatformFeeKindReferral PlatformFeeKind = "referral"
+)
+
+// PlatformFee is a fee transfer appended to a launch or swap session, recorded when the session is created and
+// confirmed with its transfer. Amounts are in wei
+type PlatformFee struct {
+	ID        uint            `gorm:"primaryKey" json:"id"`
+	SessionID string          `gorm:"index;not null" json:"session_id"`
+	UserID    *string         `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	ChainID   uint            `gorm:"index;not null" json:"chain_id"`
+	Kind      PlatformFeeKind `gorm:"index;not null" json:"kind"`
+	Recipient string          `gorm:"not null" json:"recipient"`
+	Amount    string          `gorm:"not null" json:"amount"`
+	// BaseValue is the native value of the launches and swaps of the session the fee is taken on
+	BaseValue   string            `gorm:"not null;default:0" json:"base_value"`
+	Status      TransactionStatus `gorm:"index;default:pending" json:"status"`
+	ConfirmedAt *time.Time        `json:"confirmed_at,omitempty"`
+	CreatedAt   time.Time         `gorm:"index" json:"created_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
+
+// Referral links a user to the address of the referrer sharing the platform fees of the user, set once by set_referrer
+type Referral struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	UserID          string    `gorm:"uniqueIndex;type:varchar(255);not null" json:"user_id"`
+	ReferrerAddress string    `gorm:"index;not null" json:"referrer_address"`
+	CreatedAt       time.Time `json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+type PrivateTransactionStatus string
+
+const (
+	// PrivateTransactionStatusPending transactions were accepted by the relay and are watched by the private transaction monitor
+	PrivateTransactionStatusPending PrivateTransactionStatus = "pending"
+	// PrivateTransactionStatusIncluded transactions were mined successfully
+	PrivateTransactionStatusIncluded PrivateTransactionStatus = "included"
+	// PrivateTransactionStatusFailed transactions were reverted on chain or dropped by the relay
+	PrivateTransactionStatusFailed    PrivateTransactionStatus = "failed"
+	PrivateTransactionStatusCancelled PrivateTransactionStatus = "cancelled"
+	// PrivateTransactionStatusExpired transactions were not included before the monitor timeout
+	PrivateTransactionStatusExpired PrivateTransactionStatus = "expired"
+)
+
+// PrivateTransaction is a signed transaction of a session submitted through the private relay of its chain
+// instead of the public mempool
+type PrivateTransaction struct {
+	ID               uint   `gorm:"primaryKey" json:"id"`
+	SessionId        string `gorm:"index;not null" json:"session_id"`
+	TransactionIndex int    `gorm:"not null" json:"transaction_index"`
+	ChainID          uint   `gorm:"not null" json:"chain_id"`
+	TransactionHash  string `gorm:"index;not null" json:"transaction_hash"`
+	RelayRPC         string `gorm:"not null" json:"relay_rpc"`
+	// StatusURL is the status API of the relay, if it has one (e.g. Flashbots Protect)
+	StatusURL   string                   `json:"status_url,omitempty"`
+	Status      PrivateTransactionStatus `gorm:"index;default:pending" json:"status"`
+	Error       string                   `json:"error,omitempty"`
+	BlockNumber uint64                   `json:"block_number,omitempty"`
+	IncludedAt  *time.Time               `json:"included_at,omitempty"`
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
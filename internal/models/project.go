@@ -0,0 +1,56 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+type ProjectAssetType string
+
+const (
+	ProjectAssetTypeTemplate      ProjectAssetType = "template"
+	ProjectAssetTypeDeployment    ProjectAssetType = "deployment"
+	ProjectAssetTypeLiquidityPool ProjectAssetType = "liquidity_pool"
+	ProjectAssetTypeSession       ProjectAssetType = "session"
+)
+
+// ProjectAssetTypes are the asset types that can be assigned to a project
+var ProjectAssetTypes = []ProjectAssetType{
+	ProjectAssetTypeTemplate,
+	ProjectAssetTypeDeployment,
+	ProjectAssetTypeLiquidityPool,
+	ProjectAssetTypeSession,
+}
+
+// Project groups the templates, deployments, pools and transaction sessions of a launch under a name
+type Project struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	UserID      *string   `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	Name        string    `gorm:"not null" json:"name"`
+	Description string    `json:"description,omitempty"`
+	Tags        []string  `gorm:"type:text;serializer:json" json:"tags"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	Assets []ProjectAsset `gorm:"foreignKey:ProjectID" json:"assets,omitempty"`
+}
+
+// ProjectAsset assigns a record to a project, a record belongs to at most one project
+type ProjectAsset struct {
+	ID        uint             `gorm:"primaryKey" json:"id"`
+	ProjectID uint             `gorm:"index;not null" json:"project_id"`
+	AssetType ProjectAssetType `gorm:"uniqueIndex:idx_project_assets_asset;not null" json:"asset_type"`
+	// AssetID is the ID of the record, the UUID of transaction sessions
+	AssetID   string    `gorm:"uniqueIndex:idx_project_assets_asset;not null" json:"asset_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HasTag reports whether the project is tagged with tag, ignoring case
+func (p *Project) HasTag(tag string) bool {
+	for _, projectTag := range p.Tags {
+		if strings.EqualFold(projectTag, tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type RecurringSwapStatus string
+
+const (
+	RecurringSwapStatusActive    RecurringSwapStatus = "active"
+	RecurringSwapStatusCompleted RecurringSwapStatus = "completed"
+	RecurringSwapStatusCancelled RecurringSwapStatus = "cancelled"
+)
+
+// RecurringSwap is a dollar-cost averaging schedule that creates a swap session on every run
+type RecurringSwap struct {
+	ID                uint    `gorm:"primaryKey" json:"id"`
+	UserID            *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	ChainID           uint    `gorm:"not null" json:"chain_id"`
+	FromToken         string  `gorm:"not null" json:"from_token"`
+	ToToken           string  `gorm:"not null" json:"to_token"`
+	Amount            string  `gorm:"not null" json:"amount"`
+	SlippageTolerance string  `gorm:"not null" json:"slippage_tolerance"`
+	UserAddress       string  `gorm:"not null" json:"user_address"`
+	// Schedule is the cron-like descriptor the schedule was created with, e.g. @daily or @every 6h
+	Schedule        string              `gorm:"not null" json:"schedule"`
+	IntervalSeconds int64               `gorm:"not null" json:"interval_seconds"`
+	NextRunAt       time.Time           `gorm:"index" json:"next_run_at"`
+	LastRunAt       *time.Time          `json:"last_run_at,omitempty"`
+	RunCount        int                 `gorm:"default:0" json:"run_count"`
+	MaxRuns         int                 `gorm:"default:0" json:"max_runs"` // 0 runs until cancelled
+	Status          RecurringSwapStatus `gorm:"index;default:active" json:"status"`
+	LastError       string              `json:"last_error,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
+
+// RecurringSwapRun records the swap session created by one run of a recurring swap
+type RecurringSwapRun struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	RecurringSwapID uint      `gorm:"index;not null" json:"recurring_swap_id"`
+	SessionId       string    `gorm:"index" json:"session_id,omitempty"`
+	Error           string    `json:"error,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
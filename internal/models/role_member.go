@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// RoleMember is a cached AccessControl role membership of a deployment, built from its RoleGranted and RoleRevoked events
+type RoleMember struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	DeploymentID uint      `gorm:"uniqueIndex:idx_role_member;not null" json:"deployment_id"`
+	Role         string    `gorm:"uniqueIndex:idx_role_member;not null" json:"role"`
+	Account      string    `gorm:"uniqueIndex:idx_role_member;not null" json:"account"`
+	BlockNumber  uint64    `json:"block_number"` // Block of the RoleGranted event
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// RoleSyncState records up to which block the role members of a deployment were synced
+type RoleSyncState struct {
+	DeploymentID    uint      `gorm:"primaryKey;autoIncrement:false" json:"deployment_id"`
+	LastSyncedBlock uint64    `json:"last_synced_block"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+type SearchEntityType string
+
+const (
+	SearchEntityTypeTemplate      SearchEntityType = "template"
+	SearchEntityTypeDeployment    SearchEntityType = "deployment"
+	SearchEntityTypeLiquidityPool SearchEntityType = "liquidity_pool"
+	SearchEntityTypeSession       SearchEntityType = "session"
+)
+
+// SearchEntityTypes are the entity types indexed by the search tool
+var SearchEntityTypes = []SearchEntityType{
+	SearchEntityTypeTemplate,
+	SearchEntityTypeDeployment,
+	SearchEntityTypeLiquidityPool,
+	SearchEntityTypeSession,
+}
+
+// SearchDocument is the full-text index entry of a template, deployment, liquidity pool or transaction session.
+// Documents are derived from their entity and rebuilt by the search service, they are not backed up
+type SearchDocument struct {
+	ID         uint             `gorm:"primaryKey" json:"id"`
+	EntityType SearchEntityType `gorm:"uniqueIndex:idx_search_documents_entity;not null" json:"entity_type"`
+	// EntityID is the ID of the entity, the UUID of transaction sessions
+	EntityID string  `gorm:"uniqueIndex:idx_search_documents_entity;not null" json:"entity_id"`
+	UserID   *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	Title    string  `gorm:"not null" json:"title"`
+	// Body is the searched text besides the title, such as token symbols and contract addresses
+	Body string `gorm:"type:text" json:"body"`
+	// Summary is the one line description of the entity shown in the results, it is not searched
+	Summary string `gorm:"type:text" json:"summary"`
+	// Status is the transaction status of deployments, pools and sessions, empty for templates
+	Status TransactionStatus `json:"status,omitempty"`
+	// Address is the contract address of deployments and the token address of pools, used by get_pool_info
+	Address string `json:"address,omitempty"`
+	// SourceUpdatedAt is the update time of the entity when the document was built
+	SourceUpdatedAt time.Time `json:"source_updated_at"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+type StakingPoolStatus string
+
+const (
+	// StakingPoolStatusPending pools have a deployment and funding session waiting to be signed
+	StakingPoolStatusPending StakingPoolStatus = "pending"
+	// StakingPoolStatusDeployed pools had the farm deployed but the reward period not funded yet
+	StakingPoolStatusDeployed StakingPoolStatus = "deployed"
+	// StakingPoolStatusActive pools had notifyRewardAmount confirmed, the reward is emitted until PeriodFinish
+	StakingPoolStatusActive StakingPoolStatus = "active"
+	StakingPoolStatusFailed StakingPoolStatus = "failed"
+)
+
+// StakingPool is a staking rewards farm of create_staking_pool, emitting a launched token to the stakers of the token or its LP token
+type StakingPool struct {
+	ID     uint    `gorm:"primaryKey" json:"id"`
+	UserID *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	// DeploymentID is the deployment of the farm contract
+	DeploymentID uint `gorm:"index;not null" json:"deployment_id"`
+	// RewardDeploymentID is the deployment of the launched token paid as reward
+	RewardDeploymentID uint `gorm:"index;not null" json:"reward_deployment_id"`
+	// LiquidityPoolID is the pool whose LP token is staked, nil when the launched token itself is staked
+	LiquidityPoolID *uint  `gorm:"index" json:"liquidity_pool_id,omitempty"`
+	ChainID         uint   `gorm:"not null" json:"chain_id"`
+	StakingToken    string `gorm:"not null" json:"staking_token"`
+	RewardToken     string `gorm:"not null" json:"reward_token"`
+	// ContractAddress is predicted from the nonce of the deployer until the deployment is confirmed
+	ContractAddress string `gorm:"index" json:"contract_address"`
+	DeployerAddress string `gorm:"not null" json:"deployer_address"`
+	RewardAmount    string `gorm:"not null" json:"reward_amount"` // in the smallest unit of the reward token
+	// RewardsDuration is the length of the reward period in seconds
+	RewardsDuration uint64            `gorm:"not null" json:"rewards_duration"`
+	Status          StakingPoolStatus `gorm:"index;default:pending" json:"status"`
+	SessionId       string            `gorm:"index" json:"session_id"`
+	// FundingTransactionHash is the notifyRewardAmount transaction starting the reward period
+	FundingTransactionHash string `json:"funding_transaction_hash,omitempty"`
+	// PeriodStart is the confirmation time of the funding transaction, the block time is at most a few seconds earlier
+	PeriodStart  *time.Time `json:"period_start,omitempty"`
+	PeriodFinish *time.Time `json:"period_finish,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
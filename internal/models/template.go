@@ -16,7 +16,28 @@ type Template struct {
 	Metadata             JSON                 `gorm:"type:text" json:"metadata"` // Template parameter definitions (key: empty value pairs)
 	SampleTemplateValues JSON                 `gorm:"type:text" json:"sample_template_values"`
 	Abi                  JSON                 `gorm:"type:text" json:"abi"`
+	Idl                  JSON                 `gorm:"type:text" json:"idl,omitempty"`                    // Anchor IDL of Solana templates, the Ethereum counterpart of the ABI
+	Files                TemplateFiles        `gorm:"type:text;serializer:json" json:"files,omitempty"`  // Libraries and interfaces imported by the template code
+	OpenZeppelinVersion  string               `json:"openzeppelin_version,omitempty"`                    // Vendored OpenZeppelin release of the imports, the default submodule when empty
+	Report               *TemplateReport      `gorm:"type:text;serializer:json" json:"report,omitempty"` // Gas report of the last create or update compilation
+	Disabled             bool                 `gorm:"default:false" json:"disabled,omitempty"`           // Disabled from the admin dashboard, disabled templates cannot be launched
 	CreatedAt            time.Time            `json:"created_at"`
 	UpdatedAt            time.Time            `json:"updated_at"`
 	DeletedAt            gorm.DeletedAt       `gorm:"index" json:"-"`
 }
+
+// TemplateFiles maps the paths of the libraries and interfaces of a multi-file template, relative to
+// the main contract, to their template code
+type TemplateFiles map[string]string
+
+// TemplateReport holds the compiler gas estimates and warnings of a template rendered with its sample values
+type TemplateReport struct {
+	ContractName    string `json:"contract_name"`
+	CompilerVersion string `json:"compiler_version"`
+	// Creation holds the codeDepositCost, executionCost and totalCost of the deployment
+	Creation map[string]string `json:"creation,omitempty"`
+	// External maps the function signatures to their estimate, "infinite" when the compiler cannot bound it
+	External    map[string]string `json:"external,omitempty"`
+	Warnings    []string          `json:"warnings,omitempty"`
+	GeneratedAt time.Time         `json:"generated_at"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// TokenMetadata is the description, links and logo of a deployed token, served at /tokens/:deployment_id and in the
+// token list of /tokens/tokenlist.json
+type TokenMetadata struct {
+	ID           uint   `gorm:"primaryKey" json:"id"`
+	DeploymentID uint   `gorm:"uniqueIndex;not null" json:"deployment_id"`
+	Description  string `gorm:"type:text" json:"description,omitempty"`
+	// Links maps the kind of a link such as website, twitter or telegram to its url
+	Links map[string]string `gorm:"type:text;serializer:json" json:"links,omitempty"`
+	// LogoKey is the key of the logo in the asset storage, empty without a logo
+	LogoKey         string `json:"logo_key,omitempty"`
+	LogoContentType string `json:"logo_content_type,omitempty"`
+	// LogoURL is the public url of the logo in S3 or IPFS, empty for the logos served by the launchpad
+	LogoURL   string    `json:"logo_url,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Deployment Deployment `gorm:"foreignKey:DeploymentID" json:"-"`
+}
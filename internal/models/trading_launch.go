@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type TradingLaunchStatus string
+
+const (
+	// TradingLaunchStatusScheduled launches wait for OpensAt, the scheduler then creates the enable trading session
+	TradingLaunchStatusScheduled TradingLaunchStatus = "scheduled"
+	// TradingLaunchStatusPending launches have an enable trading session waiting to be signed
+	TradingLaunchStatusPending TradingLaunchStatus = "pending"
+	// TradingLaunchStatusOpen launches had their enable trading transaction confirmed
+	TradingLaunchStatusOpen   TradingLaunchStatus = "open"
+	TradingLaunchStatusFailed TradingLaunchStatus = "failed"
+)
+
+// TradingLaunch is the final launch step of a token whose transfers are gated behind an enableTrading style call
+type TradingLaunch struct {
+	ID           uint    `gorm:"primaryKey" json:"id"`
+	UserID       *string `gorm:"index;type:varchar(255)" json:"user_id,omitempty"`
+	DeploymentID uint    `gorm:"index;not null" json:"deployment_id"`
+	ChainID      uint    `gorm:"not null" json:"chain_id"`
+	// FunctionName is the contract function opening trading
+	FunctionName string              `gorm:"not null" json:"function_name"`
+	OpensAt      time.Time           `gorm:"index;not null" json:"opens_at"`
+	Status       TradingLaunchStatus `gorm:"index;default:scheduled" json:"status"`
+	// SessionId is the enable trading session waiting to be signed
+	SessionId       string     `gorm:"index" json:"session_id,omitempty"`
+	TransactionHash string     `json:"transaction_hash,omitempty"`
+	Error           string     `json:"error,omitempty"`
+	OpenedAt        *time.Time `json:"opened_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	Deployment Deployment `gorm:"foreignKey:DeploymentID" json:"deployment,omitempty"`
+	Chain      Chain      `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
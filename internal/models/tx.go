@@ -17,6 +17,8 @@ const (
 	TransactionStatusPending   TransactionStatus = "pending"
 	TransactionStatusConfirmed TransactionStatus = "confirmed"
 	TransactionStatusFailed    TransactionStatus = "failed"
+	// TransactionStatusCancelled sessions were cancelled with cancel_session before being signed
+	TransactionStatusCancelled TransactionStatus = "cancelled"
 )
 
 const (
@@ -29,6 +31,23 @@ const (
 	TransactionTypeTokenSwap                  TransactionType = "token_swap"
 	TransactionTypeAddLiquidity               TransactionType = "add_liquidity"
 	TransactionTypeRemoveLiquidity            TransactionType = "remove_liquidity"
+	TransactionTypeAddressListUpdate          TransactionType = "address_list_update"
+	TransactionTypeTokenFeeUpdate             TransactionType = "token_fee_update"
+	TransactionTypeEnableTrading              TransactionType = "enable_trading"
+	TransactionTypeBridgeDeposit              TransactionType = "bridge_deposit"
+	TransactionTypeCrossChainWiring           TransactionType = "cross_chain_wiring"
+	TransactionTypeGovernanceDeployment       TransactionType = "governance_deployment"
+	TransactionTypeGovernanceRoleSetup        TransactionType = "governance_role_setup"
+	TransactionTypeStakingPoolDeployment      TransactionType = "staking_pool_deployment"
+	TransactionTypeStakingRewardFunding       TransactionType = "staking_reward_funding"
+	TransactionTypeNFTCollectionDeployment    TransactionType = "nft_collection_deployment"
+	TransactionTypeBondingCurveDeployment     TransactionType = "bonding_curve_deployment"
+	TransactionTypeDutchAuctionDeployment     TransactionType = "dutch_auction_deployment"
+	TransactionTypeDutchAuctionSettlement     TransactionType = "dutch_auction_settlement"
+	TransactionTypePlatformFee                TransactionType = "platform_fee"
+	TransactionTypeDeploymentCleanup          TransactionType = "deployment_cleanup"
+	TransactionTypeOwnershipRenounce          TransactionType = "ownership_renounce"
+	TransactionTypeLiquidityMigration         TransactionType = "liquidity_migration"
 	TransactionTypeRegular                    TransactionType = "regular"
 )
 
@@ -63,6 +82,45 @@ type TransactionDeployment struct {
 	Receiver        string            `gorm:"not null" json:"receiver"`
 	Status          TransactionStatus `gorm:"default:pending" json:"status"`
 	TransactionType TransactionType   `gorm:"not null" json:"transactionType"`
+	// PrivateRelay is the flag to submit the signed transaction through the private relay of the chain
+	// instead of broadcasting it from the wallet
+	PrivateRelay bool `gorm:"default:false" json:"privateRelay"`
+	// UserOperation is the flag to send the transaction as an ERC-4337 UserOperation of the smart account
+	// of the wallet through the bundler of the chain, so its gas can be sponsored by the paymaster
+	UserOperation bool `gorm:"default:false" json:"userOperation"`
+	// Deadline is the unix timestamp after which the router rejects the transaction (if applicable)
+	Deadline *int64 `json:"deadline"`
+	// GasLimit is the gas limit for wallet to sign instead of the wallet estimate (if applicable)
+	GasLimit *string `json:"gasLimit"`
+	// GasPrice is the gas price in wei for wallet to sign instead of the wallet default (if applicable)
+	GasPrice *string `json:"gasPrice"`
+	// Nonce is the nonce for wallet to sign instead of the next account nonce (if applicable)
+	Nonce *uint64 `json:"nonce"`
+	// MaxSpend is the maximum amount of the input token the transaction may spend, in the smallest unit (if applicable)
+	MaxSpend *string `json:"maxSpend"`
+	// SwapQuote is the aggregator quote the swap transaction was built from (if applicable)
+	SwapQuote *SwapQuote `json:"swapQuote"`
+}
+
+// SwapQuote is the quote of a swap routed through an aggregator such as Jupiter, used for display and auditing
+type SwapQuote struct {
+	// Provider is the aggregator that quoted the swap (e.g. jupiter)
+	Provider   string `json:"provider"`
+	InputMint  string `json:"inputMint"`
+	OutputMint string `json:"outputMint"`
+	InAmount   string `json:"inAmount"`
+	OutAmount  string `json:"outAmount"`
+	// OtherAmountThreshold is the minimum output of ExactIn swaps and the maximum input of ExactOut swaps after slippage
+	OtherAmountThreshold string `json:"otherAmountThreshold"`
+	SwapMode             string `json:"swapMode"`
+	SlippageBps          int    `json:"slippageBps"`
+	// PriceImpact is the price impact of the quote in percent
+	PriceImpact float64 `json:"priceImpact"`
+	// Route is the list of AMMs the swap is routed through
+	Route []string `json:"route"`
+	// LastValidBlockHeight is the block height after which the serialized transaction expires
+	LastValidBlockHeight      uint64 `json:"lastValidBlockHeight"`
+	PrioritizationFeeLamports uint64 `json:"prioritizationFeeLamports"`
 }
 
 // TransactionSession represents signing session management
@@ -82,7 +140,92 @@ type TransactionSession struct {
 	ChainID uint  `gorm:"not null" json:"chain_id"`
 	Chain   Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
 
+	// Confirmation is the human approval of a session moving value above the confirmation threshold
+	Confirmation *SessionConfirmation `gorm:"serializer:json" json:"confirmation,omitempty"`
+
+	// SafeProposal is set when the transactions were proposed to a Safe multisig instead of signed in the browser
+	SafeProposal *SafeProposal `gorm:"serializer:json" json:"safe_proposal,omitempty"`
+
+	// SignatureRequest is set on the sessions asking the wallet to sign a message instead of transactions
+	SignatureRequest *SignatureRequest `gorm:"serializer:json" json:"signature_request,omitempty"`
+
+	// OfflineExport is set when the unsigned transactions were exported to be signed on an air-gapped machine
+	OfflineExport *OfflineExport `gorm:"serializer:json" json:"offline_export,omitempty"`
+
+	// AccessBindingHash is the SHA-256 of the browser binding of a one-time session url, set when the url is first opened
+	AccessBindingHash string `json:"-"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 	ExpiresAt time.Time `json:"expires_at"`
 }
+
+// SafeProposal records the transactions of a session proposed to a Safe through the Safe Transaction Service
+type SafeProposal struct {
+	SafeAddress string `json:"safe_address"`
+	// ServiceURL is the Safe Transaction Service the transactions were proposed to
+	ServiceURL string `json:"service_url"`
+	// Proposer is the owner or delegate of the Safe that signed the proposals
+	Proposer     string                    `json:"proposer"`
+	Transactions []SafeProposalTransaction `json:"transactions"`
+	ProposedAt   time.Time                 `json:"proposed_at"`
+	// CheckedAt is when the confirmations were last read from the Safe Transaction Service
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+}
+
+// SignatureRequest asks the wallet of the session to personal_sign Message, proving it controls the signer address
+type SignatureRequest struct {
+	Message string `json:"message"`
+	Nonce   string `json:"nonce"`
+	// Address is the address the signature must recover to, empty accepts any wallet
+	Address string `json:"address,omitempty"`
+	// Signer is the address recovered from Signature by the server once the wallet signed the message
+	Signer    string     `json:"signer,omitempty"`
+	Signature string     `json:"signature,omitempty"`
+	SignedAt  *time.Time `json:"signed_at,omitempty"`
+}
+
+// OfflineExport records the unsigned transactions of a session exported by export_unsigned_transactions, a signed
+// transaction is only accepted by submit_signed_transaction when it signs one of them
+type OfflineExport struct {
+	// From is the address the transactions must be signed by, their nonces are the ones of this account
+	From         string               `json:"from"`
+	Transactions []OfflineTransaction `json:"transactions"`
+	ExportedAt   time.Time            `json:"exported_at"`
+}
+
+// OfflineTransaction is the unsigned transaction exported for the transaction deployment at Index of the session
+type OfflineTransaction struct {
+	Index int    `json:"index"`
+	Nonce uint64 `json:"nonce"`
+	// SigningHash is the hash signed by the air-gapped signer, the keccak256 of the EIP-2718 unsigned payload
+	SigningHash string `json:"signing_hash"`
+	// TransactionHash is the hash of the signed transaction once it was broadcast
+	TransactionHash string `json:"transaction_hash,omitempty"`
+}
+
+// SafeProposalTransaction is the Safe transaction proposed for the transaction deployment at Index of the session
+type SafeProposalTransaction struct {
+	Index                 int    `json:"index"`
+	SafeTxHash            string `json:"safe_tx_hash"`
+	Nonce                 uint64 `json:"nonce"`
+	Confirmations         int    `json:"confirmations"`
+	ConfirmationsRequired int    `json:"confirmations_required"`
+	Executed              bool   `json:"executed"`
+	Successful            bool   `json:"successful"`
+	// TransactionHash is the hash of the execution transaction once the Safe executed it
+	TransactionHash string `json:"transaction_hash,omitempty"`
+}
+
+// SessionConfirmation records the approval given through MCP sampling before the session was created
+type SessionConfirmation struct {
+	// Value is the native value in wei sent by the transactions of the session
+	Value string `json:"value"`
+	// Threshold is the confirmation threshold in wei the value exceeded
+	Threshold string `json:"threshold"`
+	// Response is the reply of the client to the confirmation request
+	Response string `json:"response"`
+	// Model is the model reported by the client, empty when a human answered directly
+	Model       string    `json:"model,omitempty"`
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
@@ -15,6 +15,15 @@ type UniswapDeployment struct {
 	CreatedAt       time.Time         `json:"created_at"`
 	UpdatedAt       time.Time         `json:"updated_at"`
 
+	// LockVersion is the optimistic lock of the deployment (Version is the Uniswap version), incremented by every
+	// write of UniswapService. Confirming checks the addresses of the version it read
+	LockVersion uint `gorm:"not null;default:1" json:"lock_version"`
+
+	// Name tells the DEX deployments of a chain apart, e.g. an app-owned fork and the canonical Uniswap
+	Name string `json:"name,omitempty"`
+	// IsDefault marks the deployment used by the tools when no dex_deployment_id is given
+	IsDefault bool `gorm:"default:false" json:"is_default"`
+
 	ChainID uint  `gorm:"not null" json:"chain_id"`
 	Chain   Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
 }
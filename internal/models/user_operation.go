@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+type UserOperationStatus string
+
+const (
+	// UserOperationStatusPrepared operations were built for the wallet to sign and were not sent yet
+	UserOperationStatusPrepared UserOperationStatus = "prepared"
+	// UserOperationStatusPending operations were accepted by the bundler and wait to be bundled
+	UserOperationStatusPending UserOperationStatus = "pending"
+	// UserOperationStatusIncluded operations were executed successfully in a bundle
+	UserOperationStatusIncluded UserOperationStatus = "included"
+	// UserOperationStatusFailed operations were rejected by the bundler or reverted in their bundle
+	UserOperationStatusFailed UserOperationStatus = "failed"
+)
+
+// UserOperation is a transaction of a session sent as an ERC-4337 UserOperation of the smart account of the wallet
+// through the bundler of its chain instead of signed and broadcast by the wallet
+type UserOperation struct {
+	ID               uint   `gorm:"primaryKey" json:"id"`
+	SessionId        string `gorm:"index;not null" json:"session_id"`
+	TransactionIndex int    `gorm:"not null" json:"transaction_index"`
+	ChainID          uint   `gorm:"not null" json:"chain_id"`
+	// Owner is the wallet signing the operation, Sender its smart account
+	Owner      string `gorm:"not null" json:"owner"`
+	Sender     string `gorm:"not null" json:"sender"`
+	EntryPoint string `gorm:"not null" json:"entry_point"`
+	// Operation is the operation sent to the bundler, its signature is empty until the wallet signed it
+	Operation         *UserOperationData `gorm:"serializer:json" json:"operation"`
+	UserOperationHash string             `gorm:"index;not null" json:"user_operation_hash"`
+	// ContractAddress is the address of the contract created by the operation (if applicable)
+	ContractAddress string `json:"contract_address,omitempty"`
+	// Sponsored is true when the paymaster of the chain pays the gas of the operation
+	Sponsored bool                `gorm:"default:false" json:"sponsored"`
+	Status    UserOperationStatus `gorm:"index;default:prepared" json:"status"`
+	// TransactionHash is the hash of the bundle transaction that included the operation
+	TransactionHash string     `json:"transaction_hash,omitempty"`
+	Error           string     `json:"error,omitempty"`
+	IncludedAt      *time.Time `json:"included_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+
+	Chain Chain `gorm:"foreignKey:ChainID;references:ID" json:"chain,omitempty"`
+}
+
+// UserOperationData is an ERC-4337 v0.6 UserOperation in the hex encoding of the bundler RPC
+type UserOperationData struct {
+	Sender               string `json:"sender"`
+	Nonce                string `json:"nonce"`
+	InitCode             string `json:"initCode"`
+	CallData             string `json:"callData"`
+	CallGasLimit         string `json:"callGasLimit"`
+	VerificationGasLimit string `json:"verificationGasLimit"`
+	PreVerificationGas   string `json:"preVerificationGas"`
+	MaxFeePerGas         string `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     string `json:"paymasterAndData"`
+	Signature            string `json:"signature"`
+}
@@ -0,0 +1,95 @@
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+type StepStatus string
+
+const (
+	StepStatusPassed StepStatus = "passed"
+	StepStatusFailed StepStatus = "failed"
+	// StepStatusSkipped is reported for the steps after a failure, they depend on its results
+	StepStatusSkipped StepStatus = "skipped"
+)
+
+// StepResult is one row of the pass/fail matrix
+type StepResult struct {
+	Name     string        `json:"name"`
+	Status   StepStatus    `json:"status"`
+	Detail   string        `json:"detail,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Report is the result of a self test run
+type Report struct {
+	RPC   string       `json:"rpc"`
+	Steps []StepResult `json:"steps"`
+}
+
+// Passed reports whether every step of the workflow passed
+func (r *Report) Passed() bool {
+	for _, step := range r.Steps {
+		if step.Status != StepStatusPassed {
+			return false
+		}
+	}
+	return len(r.Steps) > 0
+}
+
+// Write prints the pass/fail matrix followed by the overall result
+func (r *Report) Write(w io.Writer) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "STEP\tSTATUS\tDURATION\tDETAIL\n")
+	for _, step := range r.Steps {
+		detail := step.Detail
+		if step.Error != "" {
+			detail = step.Error
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", step.Name, step.Status, step.Duration.Round(time.Millisecond), detail)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	result := "PASSED"
+	if !r.Passed() {
+		result = "FAILED"
+	}
+	_, err := fmt.Fprintf(w, "\nSelf test %s against %s\n", result, r.RPC)
+	return err
+}
+
+// step is a stage of the workflow, it returns a short detail shown in the matrix
+type step struct {
+	name string
+	run  func(ctx context.Context) (string, error)
+}
+
+// runSteps runs the steps in order, the steps after the first failure are skipped
+func runSteps(ctx context.Context, steps []step) []StepResult {
+	results := make([]StepResult, 0, len(steps))
+	failed := false
+	for _, s := range steps {
+		if failed {
+			results = append(results, StepResult{Name: s.name, Status: StepStatusSkipped})
+			continue
+		}
+
+		start := time.Now()
+		detail, err := s.run(ctx)
+		result := StepResult{Name: s.name, Status: StepStatusPassed, Detail: detail, Duration: time.Since(start)}
+		if err != nil {
+			result.Status = StepStatusFailed
+			result.Error = err.Error()
+			failed = true
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,58 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunStepsSkipsAfterFailure(t *testing.T) {
+	var ran []string
+	steps := []step{
+		{"first", func(ctx context.Context) (string, error) {
+			ran = append(ran, "first")
+			return "ok", nil
+		}},
+		{"second", func(ctx context.Context) (string, error) {
+			ran = append(ran, "second")
+			return "", errors.New("boom")
+		}},
+		{"third", func(ctx context.Context) (string, error) {
+			ran = append(ran, "third")
+			return "", nil
+		}},
+	}
+
+	results := runSteps(context.Background(), steps)
+	assert.Equal(t, []string{"first", "second"}, ran)
+	require.Len(t, results, 3)
+	assert.Equal(t, StepStatusPassed, results[0].Status)
+	assert.Equal(t, "ok", results[0].Detail)
+	assert.Equal(t, StepStatusFailed, results[1].Status)
+	assert.Equal(t, "boom", results[1].Error)
+	assert.Equal(t, StepStatusSkipped, results[2].Status)
+
+	report := &Report{RPC: "http://127.0.0.1:8545", Steps: results}
+	assert.False(t, report.Passed())
+
+	var out bytes.Buffer
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), "second  failed")
+	assert.Contains(t, out.String(), "boom")
+	assert.Contains(t, out.String(), "Self test FAILED against http://127.0.0.1:8545")
+}
+
+func TestReportPassed(t *testing.T) {
+	assert.False(t, (&Report{}).Passed())
+	assert.True(t, (&Report{Steps: []StepResult{{Name: "swap", Status: StepStatusPassed}}}).Passed())
+}
+
+func TestRunFailsWithoutAnvil(t *testing.T) {
+	_, err := Run(context.Background(), Options{AnvilPath: "/nonexistent/anvil"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to start anvil")
+}
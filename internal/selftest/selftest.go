@@ -0,0 +1,103 @@
+// Package selftest runs the canonical launchpad workflow against a managed Anvil node,
+// signing every transaction session with a built-in test key, to verify a deployment end to end.
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/anvil"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api"
+	launchpadmcp "github.com/rxtech-lab/launchpad-mcp/internal/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// TestPrivateKey is the private key of the first prefunded Anvil account, used to sign every transaction
+var TestPrivateKey = anvil.PrivateKeys[0]
+
+// Options configure a self test run
+type Options struct {
+	// AnvilPath is the anvil binary, defaults to anvil on the PATH
+	AnvilPath string
+	// ForkURL is an optional RPC forked by Anvil
+	ForkURL string
+}
+
+// Run starts Anvil and a launchpad backed by a temporary database, then runs the
+// workflow through the MCP tools and the signing API. The error is only set when
+// the environment cannot be set up, the failed steps are reported in the Report
+func Run(ctx context.Context, opts Options) (*Report, error) {
+	if opts.AnvilPath == "" {
+		opts.AnvilPath = "anvil"
+	}
+
+	node, err := anvil.Start(ctx, opts.AnvilPath, opts.ForkURL)
+	if err != nil {
+		return nil, err
+	}
+	defer node.Stop()
+
+	// A temporary database keeps the run away from the operator's data
+	dir, err := os.MkdirTemp("", "launchpad-selftest")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	dbService, err := services.NewSqliteDBService(filepath.Join(dir, "launchpad.db"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer dbService.Close()
+
+	evmService, txService, uniswapService, liquidityService, hookService, chainService, templateService, deploymentService, uniswapContractService := server.InitializeServices(dbService.GetDB())
+	tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook := server.InitializeHooks(dbService.GetDB(), hookService, uniswapService, deploymentService, liquidityService, uniswapContractService, chainService)
+	server.RegisterHooks(hookService, tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook)
+
+	localAuthToken, err := utils.GenerateLocalAuthToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate local auth token: %w", err)
+	}
+	apiServer := api.NewAPIServer(dbService, txService, hookService, chainService, deploymentService, liquidityService, uniswapContractService)
+	apiServer.EnableLocalAuthentication(localAuthToken)
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start API server: %w", err)
+	}
+	defer func() {
+		if err := apiServer.Shutdown(); err != nil {
+			log.Printf("Error shutting down self test API server: %v", err)
+		}
+	}()
+
+	mcpServer := launchpadmcp.NewMCPServer(dbService, port, evmService, txService, uniswapService, liquidityService, chainService, templateService, deploymentService)
+	apiServer.SetMCPServer(mcpServer)
+	mcpClient, err := mcpServer.NewInProcessClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MCP client: %w", err)
+	}
+	defer mcpClient.Close()
+	if err := mcpClient.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start MCP client: %w", err)
+	}
+	initRequest := mcp.InitializeRequest{}
+	initRequest.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	initRequest.Params.ClientInfo = mcp.Implementation{Name: "launchpad-selftest", Version: "1.0.0"}
+	if _, err := mcpClient.Initialize(ctx, initRequest); err != nil {
+		return nil, fmt.Errorf("failed to initialize MCP client: %w", err)
+	}
+
+	w, err := newWorkflow(node.RPC, fmt.Sprintf("http://localhost:%d", port), localAuthToken, mcpClient, txService)
+	if err != nil {
+		return nil, err
+	}
+	defer w.close()
+
+	return &Report{RPC: node.RPC, Steps: runSteps(ctx, w.steps())}, nil
+}
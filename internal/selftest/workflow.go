@@ -0,0 +1,400 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api/middleware"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+// transactionTimeout bounds the time to mine a single transaction
+const transactionTimeout = time.Minute
+
+// selfTestTemplate is the token deployed by the workflow, the OpenZeppelin imports are resolved by the compiler
+const selfTestTemplate = `// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "@openzeppelin-contracts/contracts/token/ERC20/ERC20.sol";
+
+contract SelfTestToken is ERC20 {
+    constructor() ERC20("{{.TokenName}}", "{{.TokenSymbol}}") {
+        _mint(msg.sender, {{.InitialSupply}} * 10**decimals());
+    }
+}`
+
+var sessionIDPattern = regexp.MustCompile(`session created: ([0-9a-fA-F-]+)`)
+
+// workflow holds the state passed between the steps
+type workflow struct {
+	rpc       string
+	apiURL    string
+	token     string
+	client    *client.Client
+	txService services.TransactionService
+
+	eth        *ethclient.Client
+	chainID    *big.Int
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+
+	templateID   uint
+	tokenAddress common.Address
+}
+
+func newWorkflow(rpc, apiURL, token string, mcpClient *client.Client, txService services.TransactionService) (*workflow, error) {
+	privateKey, err := crypto.HexToECDSA(TestPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse test private key: %w", err)
+	}
+	eth, err := ethclient.Dial(rpc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to anvil: %w", err)
+	}
+	return &workflow{
+		rpc:        rpc,
+		apiURL:     apiURL,
+		token:      token,
+		client:     mcpClient,
+		txService:  txService,
+		eth:        eth,
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}, nil
+}
+
+func (w *workflow) close() {
+	w.eth.Close()
+}
+
+// steps returns the canonical workflow in order
+func (w *workflow) steps() []step {
+	return []step{
+		{"configure chain", w.configureChain},
+		{"create template", w.createTemplate},
+		{"launch token", w.launchToken},
+		{"deploy uniswap", w.deployUniswap},
+		{"create pool", w.createPool},
+		{"add liquidity", w.addLiquidity},
+		{"swap", w.swap},
+	}
+}
+
+func (w *workflow) configureChain(ctx context.Context) (string, error) {
+	chainID, err := w.eth.ChainID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	w.chainID = chainID
+
+	if _, err := w.callTool(ctx, "set_chain", map[string]any{
+		"chain_type": string(models.TransactionChainTypeEthereum),
+		"rpc":        w.rpc,
+		"name":       "Self test Anvil",
+	}); err != nil {
+		return "", err
+	}
+
+	listResult, err := w.callTool(ctx, "list_chains", map[string]any{})
+	if err != nil {
+		return "", err
+	}
+	var chains struct {
+		Chains []struct {
+			ID  uint   `json:"id"`
+			RPC string `json:"rpc"`
+		} `json:"chains"`
+	}
+	if err := json.Unmarshal([]byte(listResult[0]), &chains); err != nil {
+		return "", fmt.Errorf("failed to decode list_chains result: %w", err)
+	}
+	for _, chain := range chains.Chains {
+		if chain.RPC == w.rpc {
+			if _, err := w.callTool(ctx, "select_chain", map[string]any{"uuid": strconv.FormatUint(uint64(chain.ID), 10)}); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("chain %s", chainID), nil
+		}
+	}
+	return "", fmt.Errorf("configured chain is missing from list_chains")
+}
+
+func (w *workflow) templateValues() map[string]any {
+	return map[string]any{
+		"TokenName":     "Self Test Token",
+		"TokenSymbol":   "SELF",
+		"InitialSupply": "1000000",
+	}
+}
+
+func (w *workflow) createTemplate(ctx context.Context) (string, error) {
+	content, err := w.callTool(ctx, "create_template", map[string]any{
+		"name":            "Self test token",
+		"description":     "ERC20 token deployed by the launchpad self test",
+		"contract_name":   "SelfTestToken",
+		"chain_type":      string(models.TransactionChainTypeEthereum),
+		"template_code":   selfTestTemplate,
+		"template_values": w.templateValues(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var template struct {
+		ID uint `json:"id"`
+	}
+	if len(content) < 2 || json.Unmarshal([]byte(content[1]), &template) != nil || template.ID == 0 {
+		return "", fmt.Errorf("unexpected create_template result: %s", strings.Join(content, ""))
+	}
+	w.templateID = template.ID
+	return fmt.Sprintf("template %d", template.ID), nil
+}
+
+func (w *workflow) launchToken(ctx context.Context) (string, error) {
+	receipts, err := w.runSession(ctx, "launch", map[string]any{
+		"template_id":     strconv.FormatUint(uint64(w.templateID), 10),
+		"template_values": w.templateValues(),
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, receipt := range receipts {
+		if receipt.ContractAddress != (common.Address{}) {
+			w.tokenAddress = receipt.ContractAddress
+			return fmt.Sprintf("token %s", w.tokenAddress.Hex()), nil
+		}
+	}
+	return "", fmt.Errorf("launch did not deploy a contract")
+}
+
+func (w *workflow) deployUniswap(ctx context.Context) (string, error) {
+	receipts, err := w.runSession(ctx, "deploy_uniswap", map[string]any{"version": "v2"})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d transactions", len(receipts)), nil
+}
+
+func (w *workflow) createPool(ctx context.Context) (string, error) {
+	receipts, err := w.runSession(ctx, "create_liquidity_pool", map[string]any{
+		"token0_address":        w.tokenAddress.Hex(),
+		"token1_address":        services.EthTokenAddress,
+		"initial_token0_amount": "1000000000000000000000", // 1000 tokens
+		"initial_token1_amount": "1000000000000000000",    // 1 ETH
+		"owner_address":         w.address.Hex(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d transactions", len(receipts)), nil
+}
+
+func (w *workflow) addLiquidity(ctx context.Context) (string, error) {
+	receipts, err := w.runSession(ctx, "add_liquidity", map[string]any{
+		"token_address":    w.tokenAddress.Hex(),
+		"token_amount":     "100000000000000000000", // 100 tokens
+		"eth_amount":       "100000000000000000",    // 0.1 ETH
+		"min_token_amount": "0",
+		"min_eth_amount":   "0",
+		"owner_address":    w.address.Hex(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d transactions", len(receipts)), nil
+}
+
+func (w *workflow) swap(ctx context.Context) (string, error) {
+	receipts, err := w.runSession(ctx, "swap_tokens", map[string]any{
+		"from_token":         services.EthTokenAddress,
+		"to_token":           w.tokenAddress.Hex(),
+		"amount":             "10000000000000000", // 0.01 ETH
+		"slippage_tolerance": "5",
+		"user_address":       w.address.Hex(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d transactions", len(receipts)), nil
+}
+
+// callTool calls an MCP tool and returns the text of its result
+func (w *workflow) callTool(ctx context.Context, name string, arguments map[string]any) ([]string, error) {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = name
+	request.Params.Arguments = arguments
+	result, err := w.client.CallTool(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", name, err)
+	}
+
+	var content []string
+	for _, c := range result.Content {
+		if text, ok := c.(mcp.TextContent); ok {
+			content = append(content, text.Text)
+		}
+	}
+	if result.IsError {
+		return nil, fmt.Errorf("%s failed: %s", name, strings.Join(content, " "))
+	}
+	if len(content) == 0 {
+		return nil, fmt.Errorf("%s returned no content", name)
+	}
+	return content, nil
+}
+
+// runSession calls a tool creating a transaction session, then signs and confirms every transaction
+// of the session the way the signing page does
+func (w *workflow) runSession(ctx context.Context, name string, arguments map[string]any) ([]*types.Receipt, error) {
+	content, err := w.callTool(ctx, name, arguments)
+	if err != nil {
+		return nil, err
+	}
+	match := sessionIDPattern.FindStringSubmatch(strings.Join(content, "\n"))
+	if match == nil {
+		return nil, fmt.Errorf("%s did not create a transaction session: %s", name, strings.Join(content, " "))
+	}
+	sessionID := match[1]
+
+	session, err := w.txService.GetTransactionSession(sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session %s: %w", sessionID, err)
+	}
+
+	receipts := make([]*types.Receipt, 0, len(session.TransactionDeployments))
+	for index, deployment := range session.TransactionDeployments {
+		receipt, err := w.sendTransaction(ctx, deployment)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d (%s): %w", index, deployment.Title, err)
+		}
+		if err := w.confirmTransaction(sessionID, index, receipt); err != nil {
+			return nil, fmt.Errorf("transaction %d (%s): %w", index, deployment.Title, err)
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// sendTransaction signs the transaction with the test key and waits until it is mined
+func (w *workflow) sendTransaction(ctx context.Context, deployment models.TransactionDeployment) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, transactionTimeout)
+	defer cancel()
+
+	value := big.NewInt(0)
+	if deployment.Value != "" {
+		if _, ok := value.SetString(deployment.Value, 10); !ok {
+			return nil, fmt.Errorf("invalid value %q", deployment.Value)
+		}
+	}
+	data := common.FromHex(deployment.Data)
+	var to *common.Address
+	if deployment.Receiver != "" {
+		receiver := common.HexToAddress(deployment.Receiver)
+		to = &receiver
+	}
+
+	var nonce uint64
+	if deployment.Nonce != nil {
+		nonce = *deployment.Nonce
+	} else {
+		pendingNonce, err := w.eth.PendingNonceAt(ctx, w.address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		nonce = pendingNonce
+	}
+
+	gasPrice, ok := new(big.Int), false
+	if deployment.GasPrice != nil {
+		gasPrice, ok = gasPrice.SetString(*deployment.GasPrice, 10)
+	}
+	if !ok {
+		suggested, err := w.eth.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas price: %w", err)
+		}
+		gasPrice = suggested
+	}
+
+	var gasLimit uint64
+	if deployment.GasLimit != nil {
+		gasLimit, _ = strconv.ParseUint(*deployment.GasLimit, 10, 64)
+	}
+	if gasLimit == 0 {
+		estimated, err := w.eth.EstimateGas(ctx, ethereum.CallMsg{From: w.address, To: to, Value: value, Data: data})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		// Same margin as wallets add on top of the estimate
+		gasLimit = estimated * 12 / 10
+	}
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: gasPrice, Gas: gasLimit, To: to, Value: value, Data: data})
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(w.chainID), w.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if err := w.eth.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, w.eth, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", signedTx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", signedTx.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+// confirmTransaction reports the mined transaction to the signing API, which runs the hooks
+func (w *workflow) confirmTransaction(sessionID string, index int, receipt *types.Receipt) error {
+	body := api.TransactionCompleteRequest{
+		TransactionHash: receipt.TxHash.Hex(),
+		Status:          models.TransactionStatusConfirmed,
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		contractAddress := receipt.ContractAddress.Hex()
+		body.ContractAddress = &contractAddress
+	}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/tx/%s/transaction/%d", w.apiURL, sessionID, index), bytes.NewReader(bodyJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.LocalAuthTokenHeader, w.token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to confirm transaction: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("signing API rejected the confirmation with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"gorm.io/gorm"
+)
+
+// IsBackupCommand reports whether the command line arguments (without the program name) run the export or import subcommand
+func IsBackupCommand(args []string) bool {
+	return len(args) > 0 && (args[0] == "export" || args[0] == "import")
+}
+
+// RunBackupCommand runs the export or import subcommand given in args on the database.
+//
+//	export [--out backup.json]  writes the backup to the file, or stdout when omitted
+//	import --in backup.json     restores the backup into an empty database
+func RunBackupCommand(db *gorm.DB, args []string, stdout io.Writer) error {
+	if !IsBackupCommand(args) {
+		return fmt.Errorf("unknown command, expected export or import")
+	}
+	backupService := services.NewBackupService(db)
+
+	flags := flag.NewFlagSet(args[0], flag.ContinueOnError)
+	switch args[0] {
+	case "export":
+		out := flags.String("out", "", "File to write the backup to, defaults to stdout")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		backup, err := backupService.Export()
+		if err != nil {
+			return err
+		}
+		backupJSON, err := json.MarshalIndent(backup, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode backup: %w", err)
+		}
+
+		if *out == "" {
+			_, err = stdout.Write(append(backupJSON, '\n'))
+			return err
+		}
+		if err := os.WriteFile(*out, backupJSON, 0600); err != nil {
+			return fmt.Errorf("failed to write backup: %w", err)
+		}
+		fmt.Fprintf(stdout, "Exported %d chains, %d templates, %d deployments and %d liquidity pools to %s\n", len(backup.Chains), len(backup.Templates), len(backup.Deployments), len(backup.LiquidityPools), *out)
+		return nil
+	default:
+		in := flags.String("in", "", "Backup file written by export")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *in == "" {
+			return fmt.Errorf("import requires --in")
+		}
+
+		backupJSON, err := os.ReadFile(*in)
+		if err != nil {
+			return fmt.Errorf("failed to read backup: %w", err)
+		}
+		var backup services.Backup
+		if err := json.Unmarshal(backupJSON, &backup); err != nil {
+			return fmt.Errorf("failed to decode backup: %w", err)
+		}
+
+		if err := backupService.Import(&backup); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "Imported %d chains, %d templates, %d deployments and %d liquidity pools from %s\n", len(backup.Chains), len(backup.Templates), len(backup.Deployments), len(backup.LiquidityPools), *in)
+		return nil
+	}
+}
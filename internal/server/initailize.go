@@ -9,25 +9,58 @@ import (
 )
 
 func InitializeServices(db *gorm.DB) (services.EvmService, services.TransactionService, services.UniswapService, services.LiquidityService, services.HookService, services.ChainService, services.TemplateService, services.DeploymentService, services.UniswapContractService) {
+	// The chain, Uniswap and template lookups run on almost every tool call, they are served from a cache
+	cacheTTL := services.CacheTTLFromEnv()
+
 	evmService := services.NewEvmService()
-	txService := services.NewTransactionService(db)
-	uniswapService := services.NewUniswapService(db)
+	// Sessions involving addresses flagged by the LAUNCHPAD_SCREENING_* lists or provider are refused
+	screener, err := services.NewAddressScreenerFromEnv()
+	if err != nil {
+		log.Fatal("Failed to configure the address screening:", err)
+	}
+	// The plugins of LAUNCHPAD_PLUGINS extend the sessions before they are screened and receive the confirmed transactions
+	plugins, err := services.LoadPluginsFromEnv()
+	if err != nil {
+		log.Fatal("Failed to load the plugins:", err)
+	}
+	// Launches and swaps pay the LAUNCHPAD_PLATFORM_* fee of the operator, its transfers are appended to their sessions
+	feeConfig, err := services.PlatformFeeConfigFromEnv()
+	if err != nil {
+		log.Fatal("Failed to configure the platform fee:", err)
+	}
+	// Sessions sending more native value than LAUNCHPAD_CONFIRMATION_THRESHOLD, fees included, are confirmed by the user
+	confirmedTxService := services.NewConfirmedTransactionService(services.NewTransactionService(db), services.ConfirmationThresholdFromEnv())
+	txService := services.NewPluginTransactionService(services.NewScreenedTransactionService(services.NewFeeTransactionService(confirmedTxService, feeConfig, services.NewPlatformFeeService(db)), screener), plugins)
+	uniswapService := services.NewCachedUniswapService(services.NewUniswapService(db), cacheTTL)
 	liquidityService := services.NewLiquidityService(db)
-	hookService := services.NewHookService()
-	chainService := services.NewChainService(db)
-	templateService := services.NewTemplateService(db)
+	hookService := services.NewPluginHookService(services.NewHookService(), plugins)
+	chainService := services.NewCachedChainService(services.NewChainService(db), cacheTTL)
+	templateService := services.NewCachedTemplateService(services.NewTemplateService(db), cacheTTL)
 	deploymentService := services.NewDeploymentService(db)
 	uniswapContractService := services.NewUniswapContractService(uniswapService)
 
 	return evmService, txService, uniswapService, liquidityService, hookService, chainService, templateService, deploymentService, uniswapContractService
 }
 
-func InitializeHooks(db *gorm.DB, hookService services.HookService, uniswapService services.UniswapService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapContractService services.UniswapContractService, chainService services.ChainService) (services.Hook, services.Hook, services.Hook) {
+func InitializeHooks(db *gorm.DB, hookService services.HookService, uniswapService services.UniswapService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapContractService services.UniswapContractService, chainService services.ChainService) (services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook, services.Hook) {
 	tokenDeploymentHook := hooks.NewTokenDeploymentHook(deploymentService)
 	uniswapDeploymentHook := hooks.NewUniswapDeploymentHook(db, uniswapService)
 	liquidityHook := hooks.NewLiquidityPoolHook(db, liquidityService, uniswapContractService, chainService)
+	limitOrderHook := hooks.NewLimitOrderHook(services.NewLimitOrderService(db))
+	addressListHook := hooks.NewAddressListHook(services.NewAddressListService(db))
+	tradingLaunchService := services.NewTradingLaunchService(db)
+	tradingLaunchHook := hooks.NewTradingLaunchHook(tradingLaunchService, liquidityService, uniswapContractService, deploymentService)
+	sellTestHook := hooks.NewSellTestHook(deploymentService, liquidityService, tradingLaunchService)
+	launchGroupBridgeHook := hooks.NewLaunchGroupBridgeHook(services.NewLaunchGroupService(db))
+	governanceDeploymentHook := hooks.NewGovernanceDeploymentHook(deploymentService)
+	stakingPoolHook := hooks.NewStakingPoolHook(deploymentService, services.NewStakingService(db))
+	buybackHook := hooks.NewBuybackHook(services.NewBuybackService(db))
+	bondingCurveHook := hooks.NewBondingCurveHook(deploymentService, services.NewBondingCurveService(db))
+	dutchAuctionHook := hooks.NewDutchAuctionHook(deploymentService, services.NewDutchAuctionService(db))
+	platformFeeHook := hooks.NewPlatformFeeHook(services.NewPlatformFeeService(db))
+	ownershipRenounceHook := hooks.NewOwnershipRenounceHook(deploymentService)
 
-	return tokenDeploymentHook, uniswapDeploymentHook, liquidityHook
+	return tokenDeploymentHook, uniswapDeploymentHook, liquidityHook, limitOrderHook, addressListHook, tradingLaunchHook, sellTestHook, launchGroupBridgeHook, governanceDeploymentHook, stakingPoolHook, buybackHook, bondingCurveHook, dutchAuctionHook, platformFeeHook, ownershipRenounceHook
 }
 
 func RegisterHooks(hookService services.HookService, hooks ...services.Hook) {
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// AddressBookReferencePrefix starts the tool arguments replaced with the address of a saved label, e.g. @treasury
+const AddressBookReferencePrefix = "@"
+
+// addressBookLabelPattern are the valid labels once lowercased, such as treasury or marketing wallet
+var addressBookLabelPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9 ._-]{0,63}$`)
+
+// NormalizeAddressBookLabel trims and lowercases a label and checks it is 1 to 64 letters, digits, spaces, dots,
+// dashes or underscores starting with a letter or digit
+func NormalizeAddressBookLabel(label string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(label))
+	if !addressBookLabelPattern.MatchString(normalized) {
+		return "", fmt.Errorf("invalid label %q: use 1 to 64 letters, digits, spaces, dots, dashes or underscores starting with a letter or digit", label)
+	}
+	return normalized, nil
+}
+
+type AddressBookService interface {
+	// SaveAddress saves the entry, replacing the address of the label when the user already saved it
+	SaveAddress(entry *models.AddressBookEntry) error
+	// ListAddresses returns the entries of the user, every entry when userID is nil, by label.
+	// Only the entries of chainType are returned when it is not empty
+	ListAddresses(userID *string, chainType models.TransactionChainType) ([]models.AddressBookEntry, error)
+	// ExpandReferences returns the arguments with the string values written as @label, also nested in objects and
+	// arrays, replaced with the address saved under the label. Unknown labels are left as they are
+	ExpandReferences(userID *string, arguments map[string]any) (map[string]any, error)
+}
+
+type addressBookService struct {
+	db *gorm.DB
+}
+
+func NewAddressBookService(db *gorm.DB) AddressBookService {
+	return &addressBookService{db: db}
+}
+
+func (s *addressBookService) userQuery(userID *string) *gorm.DB {
+	query := s.db.Model(&models.AddressBookEntry{})
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	return query
+}
+
+func (s *addressBookService) SaveAddress(entry *models.AddressBookEntry) error {
+	label, err := NormalizeAddressBookLabel(entry.Label)
+	if err != nil {
+		return err
+	}
+	entry.Label = label
+
+	var existing models.AddressBookEntry
+	err = s.userQuery(entry.UserID).Where("label = ?", label).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return s.db.Create(entry).Error
+	}
+	if err != nil {
+		return err
+	}
+	entry.ID = existing.ID
+	entry.CreatedAt = existing.CreatedAt
+	return s.db.Save(entry).Error
+}
+
+func (s *addressBookService) ListAddresses(userID *string, chainType models.TransactionChainType) ([]models.AddressBookEntry, error) {
+	var entries []models.AddressBookEntry
+	query := s.userQuery(userID)
+	if chainType != "" {
+		query = query.Where("chain_type = ?", chainType)
+	}
+	err := query.Order("label ASC").Order("id ASC").Find(&entries).Error
+	return entries, err
+}
+
+func (s *addressBookService) ExpandReferences(userID *string, arguments map[string]any) (map[string]any, error) {
+	labels := map[string]bool{}
+	collectAddressBookReferences(arguments, labels)
+	if len(labels) == 0 {
+		return arguments, nil
+	}
+
+	wanted := make([]string, 0, len(labels))
+	for label := range labels {
+		wanted = append(wanted, label)
+	}
+	var entries []models.AddressBookEntry
+	if err := s.userQuery(userID).Where("label IN ?", wanted).Order("id ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to read the address book: %w", err)
+	}
+	addresses := map[string]string{}
+	for _, entry := range entries {
+		if _, ok := addresses[entry.Label]; !ok {
+			addresses[entry.Label] = entry.Address
+		}
+	}
+	if len(addresses) == 0 {
+		return arguments, nil
+	}
+	return expandAddressBookReferences(arguments, addresses).(map[string]any), nil
+}
+
+// addressBookReference returns the normalized label of a value written as @label
+func addressBookReference(value string) (string, bool) {
+	if !strings.HasPrefix(value, AddressBookReferencePrefix) {
+		return "", false
+	}
+	label, err := NormalizeAddressBookLabel(strings.TrimPrefix(value, AddressBookReferencePrefix))
+	return label, err == nil
+}
+
+func collectAddressBookReferences(value any, labels map[string]bool) {
+	switch typed := value.(type) {
+	case string:
+		if label, ok := addressBookReference(typed); ok {
+			labels[label] = true
+		}
+	case map[string]any:
+		for _, item := range typed {
+			collectAddressBookReferences(item, labels)
+		}
+	case []any:
+		for _, item := range typed {
+			collectAddressBookReferences(item, labels)
+		}
+	}
+}
+
+// expandAddressBookReferences copies the value with the references to the labels of addresses replaced
+func expandAddressBookReferences(value any, addresses map[string]string) any {
+	switch typed := value.(type) {
+	case string:
+		if label, ok := addressBookReference(typed); ok {
+			if address, ok := addresses[label]; ok {
+				return address
+			}
+		}
+		return typed
+	case map[string]any:
+		expanded := make(map[string]any, len(typed))
+		for key, item := range typed {
+			expanded[key] = expandAddressBookReferences(item, addresses)
+		}
+		return expanded
+	case []any:
+		expanded := make([]any, len(typed))
+		for i, item := range typed {
+			expanded[i] = expandAddressBookReferences(item, addresses)
+		}
+		return expanded
+	default:
+		return value
+	}
+}
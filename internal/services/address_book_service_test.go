@@ -0,0 +1,66 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAddressBookLabel(t *testing.T) {
+	label, err := NormalizeAddressBookLabel("  Marketing Wallet ")
+	require.NoError(t, err)
+	assert.Equal(t, "marketing wallet", label)
+
+	for _, invalid := range []string{"", " ", "@treasury", "-treasury", "tab\tlabel", string(make([]byte, 65))} {
+		_, err := NormalizeAddressBookLabel(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestAddressBookService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	service := NewAddressBookService(dbService.GetDB())
+
+	alice, bob := "alice", "bob"
+	require.NoError(t, service.SaveAddress(&models.AddressBookEntry{UserID: &alice, Label: "Treasury", Address: "0x1111111111111111111111111111111111111111", ChainType: models.TransactionChainTypeEthereum}))
+	require.NoError(t, service.SaveAddress(&models.AddressBookEntry{UserID: &alice, Label: "marketing wallet", Address: "0x2222222222222222222222222222222222222222", ChainType: models.TransactionChainTypeEthereum}))
+	require.NoError(t, service.SaveAddress(&models.AddressBookEntry{UserID: &bob, Label: "treasury", Address: "0x3333333333333333333333333333333333333333", ChainType: models.TransactionChainTypeEthereum}))
+	// Saving a label again replaces its address
+	require.NoError(t, service.SaveAddress(&models.AddressBookEntry{UserID: &alice, Label: "TREASURY", Address: "0x4444444444444444444444444444444444444444", ChainType: models.TransactionChainTypeEthereum}))
+
+	entries, err := service.ListAddresses(&alice, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "marketing wallet", entries[0].Label)
+	assert.Equal(t, "treasury", entries[1].Label)
+	assert.Equal(t, "0x4444444444444444444444444444444444444444", entries[1].Address)
+
+	entries, err = service.ListAddresses(&alice, models.TransactionChainTypeSolana)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	expanded, err := service.ExpandReferences(&alice, map[string]any{
+		"recipient":  "@Treasury",
+		"recipients": []any{"@marketing wallet", "0x5555555555555555555555555555555555555555", "@unknown"},
+		"nested":     map[string]any{"owner": "@treasury"},
+		"amount":     1.5,
+		"text":       "send to @treasury",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"recipient":  "0x4444444444444444444444444444444444444444",
+		"recipients": []any{"0x2222222222222222222222222222222222222222", "0x5555555555555555555555555555555555555555", "@unknown"},
+		"nested":     map[string]any{"owner": "0x4444444444444444444444444444444444444444"},
+		"amount":     1.5,
+		"text":       "send to @treasury",
+	}, expanded)
+
+	// The labels of other users are not expanded
+	expanded, err = service.ExpandReferences(&bob, map[string]any{"recipient": "@marketing wallet", "owner": "@treasury"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"recipient": "@marketing wallet", "owner": "0x3333333333333333333333333333333333333333"}, expanded)
+}
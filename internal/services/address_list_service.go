@@ -0,0 +1,93 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AddressListService interface {
+	// CreateChanges records the pending changes of an update session
+	CreateChanges(changes []models.AddressListChange) error
+	// ApplySessionChanges applies the pending changes of a confirmed session to the recorded lists
+	ApplySessionChanges(sessionId string, txHash string) error
+	// ListEntries returns the addresses currently on the list of a deployment
+	ListEntries(deploymentID uint, listType models.AddressListType) ([]models.AddressListEntry, error)
+	// ListChanges returns the change history of the list of a deployment, oldest first
+	ListChanges(deploymentID uint, listType models.AddressListType) ([]models.AddressListChange, error)
+}
+
+type addressListService struct {
+	db *gorm.DB
+}
+
+func NewAddressListService(db *gorm.DB) AddressListService {
+	return &addressListService{db: db}
+}
+
+func (s *addressListService) CreateChanges(changes []models.AddressListChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return s.db.Create(&changes).Error
+}
+
+func (s *addressListService) ApplySessionChanges(sessionId string, txHash string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var changes []models.AddressListChange
+		err := tx.Where("session_id = ? AND status = ?", sessionId, models.AddressListChangeStatusPending).
+			Order("id ASC").
+			Find(&changes).Error
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			if change.Action == models.AddressListActionRemove {
+				err := tx.Where("deployment_id = ? AND list_type = ? AND address = ?", change.DeploymentID, change.ListType, change.Address).
+					Delete(&models.AddressListEntry{}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			entry := models.AddressListEntry{
+				DeploymentID: change.DeploymentID,
+				ListType:     change.ListType,
+				Address:      change.Address,
+				SessionId:    sessionId,
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entry).Error; err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		return tx.Model(&models.AddressListChange{}).
+			Where("session_id = ? AND status = ?", sessionId, models.AddressListChangeStatusPending).
+			Updates(map[string]interface{}{
+				"status":           models.AddressListChangeStatusApplied,
+				"transaction_hash": txHash,
+				"applied_at":       &now,
+			}).Error
+	})
+}
+
+func (s *addressListService) ListEntries(deploymentID uint, listType models.AddressListType) ([]models.AddressListEntry, error) {
+	var entries []models.AddressListEntry
+	err := s.db.Where("deployment_id = ? AND list_type = ?", deploymentID, listType).
+		Order("created_at ASC").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (s *addressListService) ListChanges(deploymentID uint, listType models.AddressListType) ([]models.AddressListChange, error) {
+	var changes []models.AddressListChange
+	err := s.db.Where("deployment_id = ? AND list_type = ?", deploymentID, listType).
+		Order("id ASC").
+		Find(&changes).Error
+	return changes, err
+}
@@ -0,0 +1,346 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// EnvScreeningDenylist is the comma separated list of addresses no session may involve
+	EnvScreeningDenylist = "LAUNCHPAD_SCREENING_DENYLIST"
+	// EnvScreeningAllowlist is the comma separated list of addresses never blocked, even when the provider flags them
+	EnvScreeningAllowlist = "LAUNCHPAD_SCREENING_ALLOWLIST"
+	// EnvScreeningProvider selects the screening provider checking the other addresses, "chainalysis" or unset
+	EnvScreeningProvider = "LAUNCHPAD_SCREENING_PROVIDER"
+	// EnvChainalysisAPIKey is the API key of the Chainalysis sanctions screening API
+	EnvChainalysisAPIKey = "LAUNCHPAD_CHAINALYSIS_API_KEY"
+	// EnvChainalysisAPIURL overrides DefaultChainalysisAPIURL
+	EnvChainalysisAPIURL = "LAUNCHPAD_CHAINALYSIS_API_URL"
+	// DefaultChainalysisAPIURL is the public Chainalysis sanctions screening API
+	DefaultChainalysisAPIURL = "https://public.chainalysis.com/api/v1"
+
+	// ScreeningProviderChainalysis is the Chainalysis sanctions screening API
+	ScreeningProviderChainalysis = "chainalysis"
+	// screeningCacheTTL is how long the verdict of the provider on an address is reused
+	screeningCacheTTL = time.Hour
+)
+
+// ErrAddressFlagged is returned when a session involves an address flagged by the screening
+var ErrAddressFlagged = errors.New("the session involves a flagged address")
+
+// evmAddressPattern finds the EVM addresses in metadata and contract arguments
+var evmAddressPattern = regexp.MustCompile(`0x[0-9a-fA-F]{40}`)
+
+// FlaggedAddress is an address blocked by the screening and the reason given by the list or the provider
+type FlaggedAddress struct {
+	Address string `json:"address"`
+	Reason  string `json:"reason"`
+}
+
+// AddressScreeningProvider checks addresses against an external compliance service
+type AddressScreeningProvider interface {
+	// Name is shown in the reason of the flagged addresses
+	Name() string
+	// Screen returns the flagged addresses among the addresses
+	Screen(ctx context.Context, addresses []string) ([]FlaggedAddress, error)
+}
+
+// AddressScreener blocks the sessions involving flagged addresses
+type AddressScreener interface {
+	// Screen returns the flagged addresses among the addresses, allowlisted addresses are never flagged
+	Screen(ctx context.Context, addresses []string) ([]FlaggedAddress, error)
+}
+
+type addressScreener struct {
+	allowlist map[string]bool
+	denylist  map[string]bool
+	provider  AddressScreeningProvider
+}
+
+// NewAddressScreener screens against the local lists, then the provider when it is not nil
+func NewAddressScreener(allowlist, denylist []string, provider AddressScreeningProvider) AddressScreener {
+	screener := &addressScreener{allowlist: map[string]bool{}, denylist: map[string]bool{}, provider: provider}
+	for _, address := range allowlist {
+		screener.allowlist[normalizeScreenedAddress(address)] = true
+	}
+	for _, address := range denylist {
+		screener.denylist[normalizeScreenedAddress(address)] = true
+	}
+	return screener
+}
+
+// NewAddressScreenerFromEnv screens with the LAUNCHPAD_SCREENING_* settings, it returns nil when no list or provider is configured
+func NewAddressScreenerFromEnv() (AddressScreener, error) {
+	allowlist := splitAddressList(os.Getenv(EnvScreeningAllowlist))
+	denylist := splitAddressList(os.Getenv(EnvScreeningDenylist))
+
+	var provider AddressScreeningProvider
+	switch name := strings.ToLower(strings.TrimSpace(os.Getenv(EnvScreeningProvider))); name {
+	case "":
+	case ScreeningProviderChainalysis:
+		apiKey := os.Getenv(EnvChainalysisAPIKey)
+		if apiKey == "" {
+			return nil, fmt.Errorf("%s is required by the %s screening provider", EnvChainalysisAPIKey, name)
+		}
+		provider = NewChainalysisScreeningProvider(nil, os.Getenv(EnvChainalysisAPIURL), apiKey)
+	default:
+		return nil, fmt.Errorf("unknown %s %q, supported providers: %s", EnvScreeningProvider, name, ScreeningProviderChainalysis)
+	}
+
+	if len(denylist) == 0 && provider == nil {
+		return nil, nil
+	}
+	return NewAddressScreener(allowlist, denylist, provider), nil
+}
+
+func splitAddressList(value string) []string {
+	var addresses []string
+	for _, address := range strings.Split(value, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// normalizeScreenedAddress lowercases EVM addresses, other chains use case sensitive addresses
+func normalizeScreenedAddress(address string) string {
+	address = strings.TrimSpace(address)
+	if evmAddressPattern.MatchString(address) && len(address) == 42 {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+func (s *addressScreener) Screen(ctx context.Context, addresses []string) ([]FlaggedAddress, error) {
+	var flagged []FlaggedAddress
+	var unlisted []string
+	for _, address := range addresses {
+		normalized := normalizeScreenedAddress(address)
+		switch {
+		case s.allowlist[normalized]:
+		case s.denylist[normalized]:
+			flagged = append(flagged, FlaggedAddress{Address: address, Reason: "on the denylist of the server"})
+		default:
+			unlisted = append(unlisted, address)
+		}
+	}
+
+	if s.provider != nil && len(unlisted) > 0 {
+		providerFlagged, err := s.provider.Screen(ctx, unlisted)
+		if err != nil {
+			return nil, fmt.Errorf("%s screening failed: %w", s.provider.Name(), err)
+		}
+		flagged = append(flagged, providerFlagged...)
+	}
+	return flagged, nil
+}
+
+// SessionAddresses returns the addresses involved in the transactions and metadata of a session: the receivers,
+// the token balances, the addresses in the metadata and contract arguments, and the address arguments of the calldata
+func SessionAddresses(req CreateTransactionSessionRequest) []string {
+	seen := map[string]bool{}
+	var addresses []string
+	add := func(address string) {
+		address = strings.TrimSpace(address)
+		if address == "" || address == EthTokenAddress {
+			return
+		}
+		if normalized := normalizeScreenedAddress(address); !seen[normalized] {
+			seen[normalized] = true
+			addresses = append(addresses, address)
+		}
+	}
+	addAll := func(text string) {
+		for _, address := range evmAddressPattern.FindAllString(text, -1) {
+			add(address)
+		}
+	}
+
+	for _, tx := range req.TransactionDeployments {
+		add(tx.Receiver)
+		if tx.RawContractArguments != nil {
+			addAll(*tx.RawContractArguments)
+		}
+		for _, address := range calldataAddresses(tx.Data) {
+			add(address)
+		}
+	}
+	for token := range req.Balances {
+		add(token)
+	}
+	for _, metadata := range req.Metadata {
+		addAll(metadata.Value)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// calldataAddresses returns the ABI words of the calldata that hold an address: 12 zero bytes followed by 20 bytes
+// whose first 4 bytes are not all zero, which keeps the amounts out
+func calldataAddresses(data string) []string {
+	raw, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil || len(raw) < 4+32 {
+		return nil
+	}
+	var addresses []string
+	for offset := 4; offset+32 <= len(raw); offset += 32 {
+		word := raw[offset : offset+32]
+		if !allZero(word[:12]) || allZero(word[12:16]) {
+			continue
+		}
+		addresses = append(addresses, "0x"+hex.EncodeToString(word[12:]))
+	}
+	return addresses
+}
+
+func allZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// screenedTransactionService refuses to create the sessions involving flagged addresses
+type screenedTransactionService struct {
+	TransactionService
+	screener AddressScreener
+}
+
+// NewScreenedTransactionService wraps the service with the address screening, a nil screener returns the service as is
+func NewScreenedTransactionService(inner TransactionService, screener AddressScreener) TransactionService {
+	if screener == nil {
+		return inner
+	}
+	return &screenedTransactionService{TransactionService: inner, screener: screener}
+}
+
+func (s *screenedTransactionService) screen(addresses []string) error {
+	flagged, err := s.screener.Screen(context.Background(), addresses)
+	if err != nil {
+		return err
+	}
+	if len(flagged) == 0 {
+		return nil
+	}
+	reasons := make([]string, 0, len(flagged))
+	for _, address := range flagged {
+		reasons = append(reasons, fmt.Sprintf("%s (%s)", address.Address, address.Reason))
+	}
+	return fmt.Errorf("%w: %s", ErrAddressFlagged, strings.Join(reasons, ", "))
+}
+
+func (s *screenedTransactionService) CreateTransactionSession(req CreateTransactionSessionRequest) (string, error) {
+	return s.CreateTransactionSessionWithUser(req, nil)
+}
+
+func (s *screenedTransactionService) CreateTransactionSessionWithUser(req CreateTransactionSessionRequest, userID *string) (string, error) {
+	if err := s.screen(SessionAddresses(req)); err != nil {
+		return "", err
+	}
+	return s.TransactionService.CreateTransactionSessionWithUser(req, userID)
+}
+
+func (s *screenedTransactionService) CreateTransactionSessionLegacy(sessionType string, chainType models.TransactionChainType, chainID, data string) (string, error) {
+	return s.CreateTransactionSessionWithUserLegacy(sessionType, chainType, chainID, data, nil)
+}
+
+func (s *screenedTransactionService) CreateTransactionSessionWithUserLegacy(sessionType string, chainType models.TransactionChainType, chainID, data string, userID *string) (string, error) {
+	if err := s.screen(evmAddressPattern.FindAllString(data, -1)); err != nil {
+		return "", err
+	}
+	return s.TransactionService.CreateTransactionSessionWithUserLegacy(sessionType, chainType, chainID, data, userID)
+}
+
+// chainalysisScreeningProvider checks the addresses against the Chainalysis sanctions screening API
+type chainalysisScreeningProvider struct {
+	client  *http.Client
+	apiURL  string
+	apiKey  string
+	verdict *ttlCache[string, string]
+}
+
+// NewChainalysisScreeningProvider screens through the Chainalysis sanctions API, the public API when apiURL is empty
+func NewChainalysisScreeningProvider(client *http.Client, apiURL, apiKey string) AddressScreeningProvider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if apiURL == "" {
+		apiURL = DefaultChainalysisAPIURL
+	}
+	return &chainalysisScreeningProvider{
+		client:  client,
+		apiURL:  strings.TrimSuffix(apiURL, "/"),
+		apiKey:  apiKey,
+		verdict: newTTLCache[string, string](screeningCacheTTL),
+	}
+}
+
+func (p *chainalysisScreeningProvider) Name() string {
+	return "Chainalysis"
+}
+
+func (p *chainalysisScreeningProvider) Screen(ctx context.Context, addresses []string) ([]FlaggedAddress, error) {
+	var flagged []FlaggedAddress
+	for _, address := range addresses {
+		reason, ok := p.verdict.get(address)
+		if !ok {
+			var err error
+			if reason, err = p.screenAddress(ctx, address); err != nil {
+				return nil, err
+			}
+			p.verdict.set(address, reason)
+		}
+		if reason != "" {
+			flagged = append(flagged, FlaggedAddress{Address: address, Reason: reason})
+		}
+	}
+	return flagged, nil
+}
+
+// screenAddress returns the sanction identifications of the address, empty when it is not sanctioned
+func (p *chainalysisScreeningProvider) screenAddress(ctx context.Context, address string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+"/address/"+address, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-API-Key", p.apiKey)
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to screen %s: %w", address, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("screening %s returned %s", address, resp.Status)
+	}
+
+	var result struct {
+		Identifications []struct {
+			Category string `json:"category"`
+			Name     string `json:"name"`
+		} `json:"identifications"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to parse the screening of %s: %w", address, err)
+	}
+	var reasons []string
+	for _, identification := range result.Identifications {
+		reasons = append(reasons, fmt.Sprintf("Chainalysis %s: %s", identification.Category, identification.Name))
+	}
+	return strings.Join(reasons, "; "), nil
+}
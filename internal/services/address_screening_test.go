@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	screeningSanctioned = "0x7F367cC41522cE07553e823bf3be79A889DEbe1B"
+	screeningClean      = "0x1111111111111111111111111111111111111111"
+	screeningDenied     = "0x2222222222222222222222222222222222222222"
+)
+
+func newChainalysisTestServer(t *testing.T) (*httptest.Server, *int) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-API-Key") != "test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if strings.EqualFold(strings.TrimPrefix(r.URL.Path, "/address/"), screeningSanctioned) {
+			w.Write([]byte(`{"identifications":[{"category":"sanctions","name":"SANCTIONS: OFAC SDN"}]}`))
+			return
+		}
+		w.Write([]byte(`{"identifications":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestAddressScreener(t *testing.T) {
+	srv, requests := newChainalysisTestServer(t)
+	provider := NewChainalysisScreeningProvider(srv.Client(), srv.URL, "test-key")
+	screener := NewAddressScreener([]string{strings.ToLower(screeningSanctioned)}, []string{screeningDenied}, provider)
+
+	flagged, err := screener.Screen(context.Background(), []string{screeningClean, screeningDenied})
+	require.NoError(t, err)
+	require.Len(t, flagged, 1)
+	assert.Equal(t, "on the denylist of the server", flagged[0].Reason)
+	assert.Equal(t, 1, *requests)
+
+	// The allowlist overrides the provider
+	flagged, err = screener.Screen(context.Background(), []string{screeningSanctioned})
+	require.NoError(t, err)
+	assert.Empty(t, flagged)
+
+	screener = NewAddressScreener(nil, nil, provider)
+	flagged, err = screener.Screen(context.Background(), []string{screeningSanctioned, screeningClean})
+	require.NoError(t, err)
+	require.Len(t, flagged, 1)
+	assert.Contains(t, flagged[0].Reason, "OFAC SDN")
+	assert.Equal(t, 2, *requests, "the verdict on the clean address is cached")
+
+	// The screening fails closed
+	_, err = NewAddressScreener(nil, nil, NewChainalysisScreeningProvider(srv.Client(), srv.URL, "wrong-key")).Screen(context.Background(), []string{screeningClean})
+	assert.ErrorContains(t, err, "401")
+}
+
+func TestNewAddressScreenerFromEnv(t *testing.T) {
+	t.Setenv(EnvScreeningDenylist, "")
+	t.Setenv(EnvScreeningProvider, "")
+	screener, err := NewAddressScreenerFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, screener)
+
+	t.Setenv(EnvScreeningProvider, "chainalysis")
+	t.Setenv(EnvChainalysisAPIKey, "")
+	_, err = NewAddressScreenerFromEnv()
+	assert.ErrorContains(t, err, EnvChainalysisAPIKey)
+
+	t.Setenv(EnvScreeningProvider, "unknown")
+	_, err = NewAddressScreenerFromEnv()
+	assert.ErrorContains(t, err, "unknown")
+
+	t.Setenv(EnvScreeningProvider, "")
+	t.Setenv(EnvScreeningDenylist, screeningDenied+", ")
+	screener, err = NewAddressScreenerFromEnv()
+	require.NoError(t, err)
+	require.NotNil(t, screener)
+}
+
+func TestSessionAddresses(t *testing.T) {
+	// transfer(address,uint256) to the denied address of 1000 wei
+	calldata := "0xa9059cbb" + "000000000000000000000000" + screeningDenied[2:] + "00000000000000000000000000000000000000000000000000000000000003e8"
+	arguments := `["` + screeningSanctioned + `"]`
+	amount := "10"
+	addresses := SessionAddresses(CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{
+			{Receiver: screeningClean, Data: calldata},
+			{Data: "0x6080", RawContractArguments: &arguments},
+		},
+		Metadata: []models.TransactionMetadata{{Key: "recipient", Value: "send to " + screeningClean}},
+		Balances: map[string]*string{screeningClean: &amount},
+	})
+	assert.Equal(t, []string{screeningClean, screeningDenied, screeningSanctioned}, addresses)
+}
+
+func TestScreenedTransactionService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, dbService.GetDB().Create(chain).Error)
+
+	inner := NewTransactionService(dbService.GetDB())
+	assert.Equal(t, inner, NewScreenedTransactionService(inner, nil))
+
+	txService := NewScreenedTransactionService(inner, NewAddressScreener(nil, []string{screeningDenied}, nil))
+	session := func(receiver string) CreateTransactionSessionRequest {
+		return CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{{Title: "Transfer", Receiver: receiver, Value: "1"}},
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                chain.ID,
+		}
+	}
+
+	_, err = txService.CreateTransactionSession(session(screeningDenied))
+	assert.ErrorIs(t, err, ErrAddressFlagged)
+	assert.ErrorContains(t, err, screeningDenied)
+
+	sessionID, err := txService.CreateTransactionSession(session(screeningClean))
+	require.NoError(t, err)
+	assert.NotEmpty(t, sessionID)
+}
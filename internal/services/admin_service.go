@@ -0,0 +1,89 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// AdminOverview is the state of the launchpad shown on the admin dashboard
+type AdminOverview struct {
+	Chains            []models.Chain              `json:"chains"`
+	Templates         []models.Template           `json:"templates"`
+	ActiveSessions    []models.TransactionSession `json:"active_sessions"`
+	RecentDeployments []models.Deployment         `json:"recent_deployments"`
+	FailedDeployments []models.Deployment         `json:"failed_deployments"`
+	FailedSessions    []models.TransactionSession `json:"failed_sessions"`
+}
+
+// AdminService reads and changes the launchpad state of every user for the admin dashboard
+type AdminService interface {
+	// GetOverview returns the chains and templates, and the most recent limit sessions and deployments of each list
+	GetOverview(limit int) (*AdminOverview, error)
+	// ExpireSession expires a pending session so it can no longer be signed
+	ExpireSession(sessionID string) error
+	// SetTemplateDisabled disables or enables the launches of a template
+	SetTemplateDisabled(templateID uint, disabled bool) error
+}
+
+type adminService struct {
+	db              *gorm.DB
+	templateService TemplateService
+}
+
+// NewAdminService creates the service of the admin dashboard. Templates are disabled through the template service of
+// the tools, so its cache does not keep a disabled template launchable
+func NewAdminService(db *gorm.DB, templateService TemplateService) AdminService {
+	return &adminService{db: db, templateService: templateService}
+}
+
+func (s *adminService) GetOverview(limit int) (*AdminOverview, error) {
+	overview := &AdminOverview{}
+	if err := s.db.Order("id").Find(&overview.Chains).Error; err != nil {
+		return nil, fmt.Errorf("failed to list chains: %w", err)
+	}
+	// The template code is not shown and can be large
+	if err := s.db.Omit("template_code", "files", "abi", "idl").Order("id").Find(&overview.Templates).Error; err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	if err := s.db.Preload("Chain").
+		Where("transaction_status = ? AND expires_at > ?", models.TransactionStatusPending, time.Now()).
+		Order("created_at DESC").Limit(limit).Find(&overview.ActiveSessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list active sessions: %w", err)
+	}
+	if err := s.db.Preload("Template", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "chain_type")
+	}).Preload("Chain").Order("created_at DESC").Limit(limit).Find(&overview.RecentDeployments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	if err := s.db.Preload("Template", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "name", "chain_type")
+	}).Preload("Chain").Where("status = ?", models.TransactionStatusFailed).
+		Order("updated_at DESC").Limit(limit).Find(&overview.FailedDeployments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list failed deployments: %w", err)
+	}
+	if err := s.db.Preload("Chain").Where("transaction_status = ?", models.TransactionStatusFailed).
+		Order("updated_at DESC").Limit(limit).Find(&overview.FailedSessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list failed sessions: %w", err)
+	}
+	return overview, nil
+}
+
+func (s *adminService) ExpireSession(sessionID string) error {
+	result := s.db.Model(&models.TransactionSession{}).
+		Where("id = ? AND transaction_status = ?", sessionID, models.TransactionStatusPending).
+		Update("expires_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to expire session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("no pending session %s", sessionID)
+	}
+	return nil
+}
+
+func (s *adminService) SetTemplateDisabled(templateID uint, disabled bool) error {
+	return s.templateService.SetTemplateDisabled(templateID, disabled)
+}
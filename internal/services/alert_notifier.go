@@ -0,0 +1,118 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// EnvTelegramBotToken is the token of the Telegram bot that delivers the alerts of the telegram channel
+	EnvTelegramBotToken = "LAUNCHPAD_TELEGRAM_BOT_TOKEN"
+	// EnvTelegramAPIURL overrides the Telegram Bot API URL
+	EnvTelegramAPIURL = "LAUNCHPAD_TELEGRAM_API_URL"
+	// DefaultTelegramAPIURL is the public Telegram Bot API
+	DefaultTelegramAPIURL = "https://api.telegram.org"
+)
+
+// PoolAlert is a fired alert rule, it is the JSON body posted to webhooks
+type PoolAlert struct {
+	RuleID        uint                 `json:"rule_id"`
+	PoolID        uint                 `json:"pool_id"`
+	ChainID       string               `json:"chain_id"`
+	PairAddress   string               `json:"pair_address"`
+	Type          models.AlertRuleType `json:"type"`
+	Message       string               `json:"message"`
+	ChangePercent float64              `json:"change_percent,omitempty"`
+	Reserve0      string               `json:"reserve0,omitempty"`
+	Reserve1      string               `json:"reserve1,omitempty"`
+	// TransactionHash is the liquidity removal of a creator_removal alert
+	TransactionHash string    `json:"transaction_hash,omitempty"`
+	TriggeredAt     time.Time `json:"triggered_at"`
+}
+
+// AlertNotifier delivers fired alerts to the channel of their rule
+type AlertNotifier interface {
+	Notify(ctx context.Context, channel models.AlertChannel, target string, alert PoolAlert) error
+	// TelegramEnabled reports whether a Telegram bot is configured to deliver the telegram channel
+	TelegramEnabled() bool
+}
+
+type alertNotifier struct {
+	client         *http.Client
+	telegramAPIURL string
+	telegramToken  string
+}
+
+func NewAlertNotifier(client *http.Client, telegramAPIURL, telegramToken string) AlertNotifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if telegramAPIURL == "" {
+		telegramAPIURL = DefaultTelegramAPIURL
+	}
+	return &alertNotifier{client: client, telegramAPIURL: strings.TrimSuffix(telegramAPIURL, "/"), telegramToken: telegramToken}
+}
+
+// NewAlertNotifierFromEnv delivers Telegram alerts through the bot of LAUNCHPAD_TELEGRAM_BOT_TOKEN, the telegram channel
+// is unavailable when it is not set
+func NewAlertNotifierFromEnv() AlertNotifier {
+	return NewAlertNotifier(nil, os.Getenv(EnvTelegramAPIURL), os.Getenv(EnvTelegramBotToken))
+}
+
+func (n *alertNotifier) TelegramEnabled() bool {
+	return n.telegramToken != ""
+}
+
+func (n *alertNotifier) Notify(ctx context.Context, channel models.AlertChannel, target string, alert PoolAlert) error {
+	switch channel {
+	case models.AlertChannelWebhook:
+		return n.post(ctx, target, alert)
+	case models.AlertChannelTelegram:
+		if !n.TelegramEnabled() {
+			return fmt.Errorf("telegram alerts require %s", EnvTelegramBotToken)
+		}
+		return n.post(ctx, fmt.Sprintf("%s/bot%s/sendMessage", n.telegramAPIURL, n.telegramToken), map[string]string{
+			"chat_id": target,
+			"text":    alert.Message,
+		})
+	default:
+		return fmt.Errorf("unsupported alert channel %q", channel)
+	}
+}
+
+func (n *alertNotifier) post(ctx context.Context, url string, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		// The Telegram URL carries the bot token, it is left out of the error stored on the rule
+		message := err.Error()
+		if n.telegramToken != "" {
+			message = strings.ReplaceAll(message, n.telegramToken, "***")
+		}
+		return fmt.Errorf("failed to deliver alert: %s", message)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("alert delivery returned status %d: %s", response.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertNotifier(t *testing.T) {
+	var paths []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+		if r.URL.Path == "/failing" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(server.Close)
+	alert := PoolAlert{RuleID: 1, PoolID: 2, Type: models.AlertRuleTypePriceMove, Message: "price moved"}
+
+	t.Run("posts the alert to webhooks", func(t *testing.T) {
+		notifier := NewAlertNotifier(nil, server.URL, "")
+		require.NoError(t, notifier.Notify(context.Background(), models.AlertChannelWebhook, server.URL+"/hook", alert))
+		assert.Equal(t, "/hook", paths[len(paths)-1])
+		assert.Equal(t, "price_move", bodies[len(bodies)-1]["type"])
+		assert.Equal(t, float64(2), bodies[len(bodies)-1]["pool_id"])
+	})
+
+	t.Run("sends the message through the Telegram bot", func(t *testing.T) {
+		notifier := NewAlertNotifier(nil, server.URL, "123:secret")
+		require.NoError(t, notifier.Notify(context.Background(), models.AlertChannelTelegram, "-10042", alert))
+		assert.Equal(t, "/bot123:secret/sendMessage", paths[len(paths)-1])
+		assert.Equal(t, map[string]any{"chat_id": "-10042", "text": "price moved"}, bodies[len(bodies)-1])
+	})
+
+	t.Run("requires a bot token for Telegram", func(t *testing.T) {
+		notifier := NewAlertNotifier(nil, server.URL, "")
+		assert.False(t, notifier.TelegramEnabled())
+		assert.Error(t, notifier.Notify(context.Background(), models.AlertChannelTelegram, "-10042", alert))
+	})
+
+	t.Run("fails on error statuses", func(t *testing.T) {
+		notifier := NewAlertNotifier(nil, server.URL, "")
+		assert.ErrorContains(t, notifier.Notify(context.Background(), models.AlertChannelWebhook, server.URL+"/failing", alert), "status 500")
+	})
+}
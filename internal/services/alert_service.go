@@ -0,0 +1,85 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+type AlertService interface {
+	CreateAlertRule(rule *models.AlertRule) error
+	GetAlertRule(id uint) (*models.AlertRule, error)
+	ListEnabledAlertRules() ([]models.AlertRule, error)
+	ListAlertRulesByUser(userID string) ([]models.AlertRule, error)
+	ListAlertRules() ([]models.AlertRule, error)
+	DeleteAlertRule(id uint) error
+	// UpdateAlertBaseline moves the reserves and the indexed block the rule compares against
+	UpdateAlertBaseline(id uint, reserve0, reserve1 string, lastCheckedBlock uint64) error
+	// MarkAlertTriggered counts a fired alert, resets the baseline and records the delivery error, empty when delivered
+	MarkAlertTriggered(id uint, reserve0, reserve1 string, lastCheckedBlock uint64, deliveryError string) error
+}
+
+type alertService struct {
+	db *gorm.DB
+}
+
+func NewAlertService(db *gorm.DB) AlertService {
+	return &alertService{db: db}
+}
+
+func (s *alertService) CreateAlertRule(rule *models.AlertRule) error {
+	rule.Enabled = true
+	return s.db.Create(rule).Error
+}
+
+func (s *alertService) GetAlertRule(id uint) (*models.AlertRule, error) {
+	var rule models.AlertRule
+	err := s.db.Preload("Chain").First(&rule, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (s *alertService) ListEnabledAlertRules() ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	err := s.db.Preload("Chain").Where("enabled = ?", true).Order("created_at ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (s *alertService) ListAlertRulesByUser(userID string) ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	err := s.db.Preload("Chain").Where("user_id = ?", userID).Order("created_at DESC").Find(&rules).Error
+	return rules, err
+}
+
+func (s *alertService) ListAlertRules() ([]models.AlertRule, error) {
+	var rules []models.AlertRule
+	err := s.db.Preload("Chain").Order("created_at DESC").Find(&rules).Error
+	return rules, err
+}
+
+func (s *alertService) DeleteAlertRule(id uint) error {
+	return s.db.Delete(&models.AlertRule{}, id).Error
+}
+
+func (s *alertService) UpdateAlertBaseline(id uint, reserve0, reserve1 string, lastCheckedBlock uint64) error {
+	return s.db.Model(&models.AlertRule{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"baseline_reserve0":  reserve0,
+		"baseline_reserve1":  reserve1,
+		"last_checked_block": lastCheckedBlock,
+	}).Error
+}
+
+func (s *alertService) MarkAlertTriggered(id uint, reserve0, reserve1 string, lastCheckedBlock uint64, deliveryError string) error {
+	now := time.Now()
+	return s.db.Model(&models.AlertRule{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"baseline_reserve0":  reserve0,
+		"baseline_reserve1":  reserve1,
+		"last_checked_block": lastCheckedBlock,
+		"trigger_count":      gorm.Expr("trigger_count + 1"),
+		"last_triggered_at":  &now,
+		"last_error":         deliveryError,
+	}).Error
+}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultAnalyticsPageSize is the page size of the analytics queries without a limit
+	DefaultAnalyticsPageSize = 50
+	// MaxAnalyticsPageSize bounds the page size of the analytics queries
+	MaxAnalyticsPageSize = 100
+)
+
+// AnalyticsPage selects the records of an analytics query: the records after the ID of the cursor, in ID order,
+// created in the optional time range. Every user is queried when UserID is nil
+type AnalyticsPage struct {
+	UserID *string
+	After  uint
+	Limit  int
+	Since  *time.Time
+	Until  *time.Time
+}
+
+// DeploymentQuery filters the deployments of an analytics query, empty fields do not filter
+type DeploymentQuery struct {
+	AnalyticsPage
+	Status     models.TransactionStatus
+	ChainType  models.TransactionChainType
+	TemplateID uint
+}
+
+// PoolQuery filters the liquidity pools of an analytics query, empty fields do not filter
+type PoolQuery struct {
+	AnalyticsPage
+	Status       models.TransactionStatus
+	TokenAddress string
+}
+
+// SnapshotQuery filters the pool snapshots of an analytics query, empty fields do not filter
+type SnapshotQuery struct {
+	AnalyticsPage
+	PoolID          uint
+	TransactionType models.TransactionType
+}
+
+// AnalyticsService answers the read-only queries of the launch dashboards. The list methods return a page of records,
+// whether more records follow it and the total count of records matching the filters
+type AnalyticsService interface {
+	ListDeployments(query DeploymentQuery) ([]models.Deployment, bool, int64, error)
+	GetDeployment(userID *string, id uint) (*models.Deployment, error)
+	ListPools(query PoolQuery) ([]models.LiquidityPool, bool, int64, error)
+	GetPool(userID *string, id uint) (*models.LiquidityPool, error)
+	GetPoolByTokenAddress(userID *string, tokenAddress string) (*models.LiquidityPool, error)
+	ListPoolSnapshots(query SnapshotQuery) ([]models.PoolSnapshot, bool, int64, error)
+}
+
+type analyticsService struct {
+	db *gorm.DB
+}
+
+// NewAnalyticsService queries the given database, usually the read replica
+func NewAnalyticsService(db *gorm.DB) AnalyticsService {
+	return &analyticsService{db: db}
+}
+
+func (s *analyticsService) ListDeployments(query DeploymentQuery) ([]models.Deployment, bool, int64, error) {
+	db := s.db.Model(&models.Deployment{})
+	if query.UserID != nil {
+		db = db.Where("deployments.user_id = ?", *query.UserID)
+	}
+	if query.Status != "" {
+		db = db.Where("deployments.status = ?", query.Status)
+	}
+	if query.ChainType != "" {
+		db = db.Where("deployments.chain_id IN (?)", s.db.Model(&models.Chain{}).Select("id").Where("chain_type = ?", query.ChainType))
+	}
+	if query.TemplateID != 0 {
+		db = db.Where("deployments.template_id = ?", query.TemplateID)
+	}
+	var deployments []models.Deployment
+	hasMore, total, err := s.page(db.Preload("Template").Preload("Chain"), "deployments", query.AnalyticsPage, &deployments, func() int { return len(deployments) })
+	if hasMore {
+		deployments = deployments[:len(deployments)-1]
+	}
+	return deployments, hasMore, total, err
+}
+
+func (s *analyticsService) GetDeployment(userID *string, id uint) (*models.Deployment, error) {
+	db := s.db.Preload("Template").Preload("Chain")
+	if userID != nil {
+		db = db.Where("user_id = ?", *userID)
+	}
+	var deployment models.Deployment
+	if err := db.First(&deployment, id).Error; err != nil {
+		return nil, err
+	}
+	return &deployment, nil
+}
+
+func (s *analyticsService) ListPools(query PoolQuery) ([]models.LiquidityPool, bool, int64, error) {
+	db := s.db.Model(&models.LiquidityPool{})
+	if query.UserID != nil {
+		db = db.Where("liquidity_pools.user_id = ?", *query.UserID)
+	}
+	if query.Status != "" {
+		db = db.Where("liquidity_pools.status = ?", query.Status)
+	}
+	if query.TokenAddress != "" {
+		db = db.Where("LOWER(liquidity_pools.token_address) = LOWER(?)", query.TokenAddress)
+	}
+	var pools []models.LiquidityPool
+	hasMore, total, err := s.page(db, "liquidity_pools", query.AnalyticsPage, &pools, func() int { return len(pools) })
+	if hasMore {
+		pools = pools[:len(pools)-1]
+	}
+	return pools, hasMore, total, err
+}
+
+func (s *analyticsService) GetPool(userID *string, id uint) (*models.LiquidityPool, error) {
+	db := s.db
+	if userID != nil {
+		db = db.Where("user_id = ?", *userID)
+	}
+	var pool models.LiquidityPool
+	if err := db.First(&pool, id).Error; err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func (s *analyticsService) GetPoolByTokenAddress(userID *string, tokenAddress string) (*models.LiquidityPool, error) {
+	db := s.db.Where("LOWER(token_address) = LOWER(?)", tokenAddress)
+	if userID != nil {
+		db = db.Where("user_id = ?", *userID)
+	}
+	var pool models.LiquidityPool
+	if err := db.Order("id").First(&pool).Error; err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func (s *analyticsService) ListPoolSnapshots(query SnapshotQuery) ([]models.PoolSnapshot, bool, int64, error) {
+	db := s.db.Model(&models.PoolSnapshot{})
+	// The snapshots belong to the owner of their pool
+	if query.UserID != nil {
+		db = db.Where("pool_snapshots.pool_id IN (?)", s.db.Model(&models.LiquidityPool{}).Select("id").Where("user_id = ?", *query.UserID))
+	}
+	if query.PoolID != 0 {
+		db = db.Where("pool_snapshots.pool_id = ?", query.PoolID)
+	}
+	if query.TransactionType != "" {
+		db = db.Where("pool_snapshots.transaction_type = ?", query.TransactionType)
+	}
+	var snapshots []models.PoolSnapshot
+	hasMore, total, err := s.page(db, "pool_snapshots", query.AnalyticsPage, &snapshots, func() int { return len(snapshots) })
+	if hasMore {
+		snapshots = snapshots[:len(snapshots)-1]
+	}
+	return snapshots, hasMore, total, err
+}
+
+// page counts the records of the filtered query and reads the page of the cursor into dest. One record more than the
+// limit is read to tell whether another page follows, the caller drops it
+func (s *analyticsService) page(db *gorm.DB, table string, page AnalyticsPage, dest any, count func() int) (bool, int64, error) {
+	if page.Since != nil {
+		db = db.Where(table+".created_at >= ?", *page.Since)
+	}
+	if page.Until != nil {
+		db = db.Where(table+".created_at < ?", *page.Until)
+	}
+
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return false, 0, err
+	}
+
+	limit := page.Limit
+	if limit <= 0 {
+		limit = DefaultAnalyticsPageSize
+	}
+	if limit > MaxAnalyticsPageSize {
+		limit = MaxAnalyticsPageSize
+	}
+	if page.After != 0 {
+		db = db.Where(table+".id > ?", page.After)
+	}
+	if err := db.Order(table + ".id").Limit(limit + 1).Find(dest).Error; err != nil {
+		return false, 0, err
+	}
+	return count() > limit, total, nil
+}
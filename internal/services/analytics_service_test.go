@@ -0,0 +1,89 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsServicePaginatesAndFilters(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	ethereum := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	solana := &models.Chain{Name: "Solana", RPC: "http://localhost:8899", NetworkID: "devnet", ChainType: models.TransactionChainTypeSolana}
+	require.NoError(t, db.Create(ethereum).Error)
+	require.NoError(t, db.Create(solana).Error)
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+
+	alice, bob := "alice", "bob"
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.Create(&models.Deployment{UserID: &alice, TemplateID: template.ID, ChainID: ethereum.ID, Status: models.TransactionStatusConfirmed}).Error)
+	}
+	require.NoError(t, db.Create(&models.Deployment{UserID: &alice, TemplateID: template.ID, ChainID: solana.ID, Status: models.TransactionStatusPending}).Error)
+	require.NoError(t, db.Create(&models.Deployment{UserID: &bob, TemplateID: template.ID, ChainID: ethereum.ID, Status: models.TransactionStatusConfirmed}).Error)
+
+	analytics := NewAnalyticsService(db)
+
+	page, hasMore, total, err := analytics.ListDeployments(DeploymentQuery{AnalyticsPage: AnalyticsPage{UserID: &alice, Limit: 2}, Status: models.TransactionStatusConfirmed})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+	assert.True(t, hasMore)
+	require.Len(t, page, 2)
+	assert.Equal(t, "Anvil", page[0].Chain.Name)
+	assert.Equal(t, "Token", page[0].Template.Name)
+
+	// The next pages start after the cursor
+	page, hasMore, _, err = analytics.ListDeployments(DeploymentQuery{AnalyticsPage: AnalyticsPage{UserID: &alice, Limit: 2, After: page[1].ID}, Status: models.TransactionStatusConfirmed})
+	require.NoError(t, err)
+	assert.True(t, hasMore)
+	require.Len(t, page, 2)
+	page, hasMore, _, err = analytics.ListDeployments(DeploymentQuery{AnalyticsPage: AnalyticsPage{UserID: &alice, Limit: 2, After: page[1].ID}, Status: models.TransactionStatusConfirmed})
+	require.NoError(t, err)
+	assert.False(t, hasMore)
+	assert.Len(t, page, 1)
+
+	page, _, total, err = analytics.ListDeployments(DeploymentQuery{AnalyticsPage: AnalyticsPage{UserID: &alice}, ChainType: models.TransactionChainTypeSolana})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, solana.ID, page[0].ChainID)
+
+	_, _, total, err = analytics.ListDeployments(DeploymentQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), total)
+
+	_, err = analytics.GetDeployment(&bob, page[0].ID)
+	assert.Error(t, err)
+
+	pool := &models.LiquidityPool{UserID: &alice, TokenAddress: "0xAbC", PairAddress: "0xpair", UniswapVersion: "v2", Token0: "0xAbC", Token1: "0xweth", InitialToken0: "1", InitialToken1: "1", CreatorAddress: "0xalice", TransactionHash: "0xtx"}
+	require.NoError(t, db.Create(pool).Error)
+	found, err := analytics.GetPoolByTokenAddress(&alice, "0xabc")
+	require.NoError(t, err)
+	assert.Equal(t, pool.ID, found.ID)
+
+	yesterday := time.Now().Add(-24 * time.Hour)
+	require.NoError(t, db.Create(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: "1", Reserve1: "1", TransactionType: models.TransactionTypeLiquidityPoolCreation, CreatedAt: yesterday}).Error)
+	require.NoError(t, db.Create(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: "2", Reserve1: "1", TransactionType: models.TransactionTypeTokenSwap}).Error)
+	require.NoError(t, db.Create(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: "3", Reserve1: "1", TransactionType: models.TransactionTypeTokenSwap}).Error)
+
+	swaps, _, total, err := analytics.ListPoolSnapshots(SnapshotQuery{AnalyticsPage: AnalyticsPage{UserID: &alice}, TransactionType: models.TransactionTypeTokenSwap})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, "2", swaps[0].Reserve0)
+
+	since := time.Now().Add(-time.Hour)
+	_, _, total, err = analytics.ListPoolSnapshots(SnapshotQuery{AnalyticsPage: AnalyticsPage{UserID: &alice, Since: &since}, PoolID: pool.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+
+	// The snapshots of the pools of other users are hidden
+	_, _, total, err = analytics.ListPoolSnapshots(SnapshotQuery{AnalyticsPage: AnalyticsPage{UserID: &bob}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// APIKeyPrefix starts every key created by create_api_key, so leaked keys are easy to scan for
+	APIKeyPrefix = "lpk_"
+	// APIKeyScopeAll allows a key to call every tool
+	APIKeyScopeAll = "*"
+	// APIKeyScopeSessions allows a key to read its sessions from /api/session/:session_id and /api/v1/sessions/:session_id
+	APIKeyScopeSessions = "sessions"
+	// apiKeyDisplayLength is the number of characters of the key kept in APIKey.Prefix
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+	// apiKeyLastUsedResolution bounds how often LastUsedAt is written for a key in constant use
+	apiKeyLastUsedResolution = time.Minute
+)
+
+var (
+	// ErrAPIKeyInvalid is returned for unknown, revoked and expired keys alike, so callers cannot probe which keys exist
+	ErrAPIKeyInvalid = errors.New("invalid API key")
+	// ErrAPIKeyNotFound is returned when revoking a key that does not exist
+	ErrAPIKeyNotFound = errors.New("API key not found")
+)
+
+// CreateAPIKeyRequest describes a new API key
+type CreateAPIKeyRequest struct {
+	Name      string
+	Subject   string
+	Scopes    []string
+	ExpiresAt *time.Time
+	CreatedBy string
+}
+
+type APIKeyService interface {
+	// CreateAPIKey stores a new key and returns it with its record, the key cannot be retrieved afterwards
+	CreateAPIKey(req CreateAPIKeyRequest) (string, *models.APIKey, error)
+	// RevokeAPIKey disables the key, revoking a revoked key keeps its first revocation time
+	RevokeAPIKey(id uint) (*models.APIKey, error)
+	// AuthenticateAPIKey returns the record of a valid key and records its use
+	AuthenticateAPIKey(key string) (*models.APIKey, error)
+}
+
+type apiKeyService struct {
+	db *gorm.DB
+	// now is overridden in tests
+	now func() time.Time
+}
+
+func NewAPIKeyService(db *gorm.DB) APIKeyService {
+	return &apiKeyService{db: db, now: time.Now}
+}
+
+// hashAPIKey returns the hex SHA-256 stored for the key
+func hashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func (s *apiKeyService) CreateAPIKey(req CreateAPIKeyRequest) (string, *models.APIKey, error) {
+	if req.Name == "" || req.Subject == "" {
+		return "", nil, fmt.Errorf("an API key requires a name and a subject")
+	}
+	if len(req.Scopes) == 0 {
+		return "", nil, fmt.Errorf("an API key requires at least one scope")
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(s.now()) {
+		return "", nil, fmt.Errorf("the expiry of the API key must be in the future")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, fmt.Errorf("failed to generate the API key: %w", err)
+	}
+	key := APIKeyPrefix + hex.EncodeToString(secret)
+
+	record := &models.APIKey{
+		Name:      req.Name,
+		Subject:   req.Subject,
+		Prefix:    key[:apiKeyDisplayLength],
+		KeyHash:   hashAPIKey(key),
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+		CreatedBy: req.CreatedBy,
+	}
+	if err := s.db.Create(record).Error; err != nil {
+		return "", nil, err
+	}
+	return key, record, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(id uint) (*models.APIKey, error) {
+	var record models.APIKey
+	if err := s.db.First(&record, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	if record.RevokedAt == nil {
+		now := s.now()
+		if err := s.db.Model(&record).Update("revoked_at", now).Error; err != nil {
+			return nil, err
+		}
+		record.RevokedAt = &now
+	}
+	return &record, nil
+}
+
+func (s *apiKeyService) AuthenticateAPIKey(key string) (*models.APIKey, error) {
+	var record models.APIKey
+	if err := s.db.Where("key_hash = ?", hashAPIKey(key)).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		return nil, err
+	}
+	now := s.now()
+	if record.RevokedAt != nil || (record.ExpiresAt != nil && !record.ExpiresAt.After(now)) {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	if record.LastUsedAt == nil || now.Sub(*record.LastUsedAt) >= apiKeyLastUsedResolution {
+		if err := s.db.Model(&record).UpdateColumn("last_used_at", now).Error; err != nil {
+			return nil, err
+		}
+		record.LastUsedAt = &now
+	}
+	return &record, nil
+}
+
+// APIKeyAllows reports whether the scopes of an API key client include the scope, a tool name or APIKeyScopeSessions.
+// Users authenticated by other means are not restricted by their scopes
+func APIKeyAllows(user *utils.AuthenticatedUser, scope string) bool {
+	if user == nil || user.ClientId != utils.APIKeyClientID {
+		return true
+	}
+	for _, allowed := range user.Scopes {
+		if allowed == APIKeyScopeAll || allowed == scope {
+			return true
+		}
+	}
+	return false
+}
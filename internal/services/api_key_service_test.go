@@ -0,0 +1,59 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIKeyService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	now := time.Now()
+	service := &apiKeyService{db: dbService.GetDB(), now: func() time.Time { return now }}
+
+	expiresAt := now.Add(time.Hour)
+	key, record, err := service.CreateAPIKey(CreateAPIKeyRequest{Name: "ci", Subject: "user-1", Scopes: []string{"launch"}, ExpiresAt: &expiresAt, CreatedBy: "admin-1"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, APIKeyPrefix))
+	assert.True(t, strings.HasPrefix(key, record.Prefix))
+
+	var stored models.APIKey
+	require.NoError(t, dbService.GetDB().First(&stored, record.ID).Error)
+	assert.NotContains(t, stored.KeyHash, key[len(APIKeyPrefix):])
+	assert.Equal(t, []string{"launch"}, stored.Scopes)
+
+	authenticated, err := service.AuthenticateAPIKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", authenticated.Subject)
+	require.NotNil(t, authenticated.LastUsedAt)
+
+	_, err = service.AuthenticateAPIKey(APIKeyPrefix + "unknown")
+	assert.ErrorIs(t, err, ErrAPIKeyInvalid)
+
+	// Expired keys are rejected
+	now = now.Add(2 * time.Hour)
+	_, err = service.AuthenticateAPIKey(key)
+	assert.ErrorIs(t, err, ErrAPIKeyInvalid)
+
+	key, record, err = service.CreateAPIKey(CreateAPIKeyRequest{Name: "bot", Subject: "user-1", Scopes: []string{APIKeyScopeAll}})
+	require.NoError(t, err)
+	revoked, err := service.RevokeAPIKey(record.ID)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+	_, err = service.AuthenticateAPIKey(key)
+	assert.ErrorIs(t, err, ErrAPIKeyInvalid)
+	_, err = service.RevokeAPIKey(record.ID + 100)
+	assert.ErrorIs(t, err, ErrAPIKeyNotFound)
+
+	_, _, err = service.CreateAPIKey(CreateAPIKeyRequest{Name: "bot", Subject: "user-1"})
+	assert.ErrorContains(t, err, "scope")
+	past := now.Add(-time.Minute)
+	_, _, err = service.CreateAPIKey(CreateAPIKeyRequest{Name: "bot", Subject: "user-1", Scopes: []string{"launch"}, ExpiresAt: &past})
+	assert.ErrorContains(t, err, "future")
+}
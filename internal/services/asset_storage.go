@@ -0,0 +1,320 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvAssetStorage selects where the uploaded token logos are stored, "local" (default), "s3" or "ipfs"
+	EnvAssetStorage = "LAUNCHPAD_ASSET_STORAGE"
+	// EnvAssetDir is the directory of the local asset storage, DefaultAssetDir when unset
+	EnvAssetDir = "LAUNCHPAD_ASSET_DIR"
+	// EnvS3Endpoint is the endpoint of the S3 compatible storage, e.g. https://s3.us-east-1.amazonaws.com
+	EnvS3Endpoint = "LAUNCHPAD_S3_ENDPOINT"
+	// EnvS3Bucket is the bucket the assets are uploaded to
+	EnvS3Bucket = "LAUNCHPAD_S3_BUCKET"
+	// EnvS3Region is the region of the bucket, DefaultS3Region when unset
+	EnvS3Region = "LAUNCHPAD_S3_REGION"
+	// EnvS3AccessKeyID and EnvS3SecretAccessKey are the credentials signing the uploads
+	EnvS3AccessKeyID     = "LAUNCHPAD_S3_ACCESS_KEY_ID"
+	EnvS3SecretAccessKey = "LAUNCHPAD_S3_SECRET_ACCESS_KEY"
+	// EnvS3PublicURL is the public url the bucket is served at, e.g. a CDN, the bucket url of the endpoint when unset
+	EnvS3PublicURL = "LAUNCHPAD_S3_PUBLIC_URL"
+	// EnvIPFSAPIURL is the url of the Kubo RPC API, or of a pinning service exposing it, the assets are added to
+	EnvIPFSAPIURL = "LAUNCHPAD_IPFS_API_URL"
+	// EnvIPFSAPIToken is the optional bearer token of the IPFS API
+	EnvIPFSAPIToken = "LAUNCHPAD_IPFS_API_TOKEN"
+	// EnvIPFSGatewayURL is the gateway of the public asset urls, DefaultIPFSGatewayURL when unset
+	EnvIPFSGatewayURL = "LAUNCHPAD_IPFS_GATEWAY_URL"
+
+	AssetStorageLocal = "local"
+	AssetStorageS3    = "s3"
+	AssetStorageIPFS  = "ipfs"
+
+	// DefaultAssetDir is the directory of the local asset storage
+	DefaultAssetDir = "assets"
+	// DefaultS3Region is the region of the S3 uploads when none is configured
+	DefaultS3Region = "us-east-1"
+	// DefaultIPFSGatewayURL is the public gateway of the IPFS asset urls
+	DefaultIPFSGatewayURL = "https://ipfs.io"
+)
+
+// ErrAssetNotServed is returned by Open for the storages whose assets are served at their own public url
+var ErrAssetNotServed = errors.New("the assets of this storage are not served by the launchpad")
+
+// AssetStorage stores the files uploaded to the launchpad, such as the token logos
+type AssetStorage interface {
+	// Put stores the asset under key and returns its public url, empty when the asset is served by the launchpad from Open
+	Put(ctx context.Context, key string, contentType string, data []byte) (string, error)
+	// Open reads an asset stored with an empty url
+	Open(ctx context.Context, key string) ([]byte, error)
+}
+
+// NewAssetStorageFromEnv returns the storage of LAUNCHPAD_ASSET_STORAGE configured with its LAUNCHPAD_* settings,
+// the local storage when it is unset
+func NewAssetStorageFromEnv() (AssetStorage, error) {
+	switch name := strings.ToLower(strings.TrimSpace(os.Getenv(EnvAssetStorage))); name {
+	case "", AssetStorageLocal:
+		dir := os.Getenv(EnvAssetDir)
+		if dir == "" {
+			dir = DefaultAssetDir
+		}
+		return NewLocalAssetStorage(dir), nil
+	case AssetStorageS3:
+		for _, env := range []string{EnvS3Endpoint, EnvS3Bucket, EnvS3AccessKeyID, EnvS3SecretAccessKey} {
+			if os.Getenv(env) == "" {
+				return nil, fmt.Errorf("%s is required by the %s asset storage", env, name)
+			}
+		}
+		return NewS3AssetStorage(nil, S3AssetStorageConfig{
+			Endpoint:        os.Getenv(EnvS3Endpoint),
+			Bucket:          os.Getenv(EnvS3Bucket),
+			Region:          os.Getenv(EnvS3Region),
+			AccessKeyID:     os.Getenv(EnvS3AccessKeyID),
+			SecretAccessKey: os.Getenv(EnvS3SecretAccessKey),
+			PublicURL:       os.Getenv(EnvS3PublicURL),
+		}), nil
+	case AssetStorageIPFS:
+		apiURL := os.Getenv(EnvIPFSAPIURL)
+		if apiURL == "" {
+			return nil, fmt.Errorf("%s is required by the %s asset storage", EnvIPFSAPIURL, name)
+		}
+		return NewIPFSAssetStorage(nil, apiURL, os.Getenv(EnvIPFSAPIToken), os.Getenv(EnvIPFSGatewayURL)), nil
+	default:
+		return nil, fmt.Errorf("unknown %s %q, supported storages: %s, %s, %s", EnvAssetStorage, name, AssetStorageLocal, AssetStorageS3, AssetStorageIPFS)
+	}
+}
+
+// validateAssetKey rejects the keys escaping the storage, such as absolute paths and .. segments
+func validateAssetKey(key string) error {
+	if key == "" || path.Clean(key) != key || strings.HasPrefix(key, "/") || strings.HasPrefix(key, "..") {
+		return fmt.Errorf("invalid asset key %q", key)
+	}
+	return nil
+}
+
+type localAssetStorage struct {
+	dir string
+}
+
+// NewLocalAssetStorage stores the assets in dir, they are served by the launchpad
+func NewLocalAssetStorage(dir string) AssetStorage {
+	return &localAssetStorage{dir: dir}
+}
+
+func (s *localAssetStorage) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	if err := validateAssetKey(key); err != nil {
+		return "", err
+	}
+	filePath := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create the asset directory: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write the asset: %w", err)
+	}
+	return "", nil
+}
+
+func (s *localAssetStorage) Open(ctx context.Context, key string) ([]byte, error) {
+	if err := validateAssetKey(key); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(key)))
+}
+
+// S3AssetStorageConfig is the bucket of the S3 asset storage, any S3 compatible storage such as R2 or MinIO works
+type S3AssetStorageConfig struct {
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PublicURL is the url the keys of the bucket are served under, the path style bucket url of the endpoint when empty
+	PublicURL string
+}
+
+type s3AssetStorage struct {
+	client *http.Client
+	config S3AssetStorageConfig
+	now    func() time.Time
+}
+
+// NewS3AssetStorage uploads the assets to an S3 bucket with path style requests signed with AWS Signature Version 4
+func NewS3AssetStorage(client *http.Client, config S3AssetStorageConfig) AssetStorage {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	if config.Region == "" {
+		config.Region = DefaultS3Region
+	}
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+	config.PublicURL = strings.TrimSuffix(config.PublicURL, "/")
+	return &s3AssetStorage{client: client, config: config, now: time.Now}
+}
+
+func (s *s3AssetStorage) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	if err := validateAssetKey(key); err != nil {
+		return "", err
+	}
+	objectURL, err := url.Parse(s.config.Endpoint + "/" + s.config.Bucket + "/" + key)
+	if err != nil {
+		return "", fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL.String(), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, objectURL, data)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload to S3: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("S3 upload failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if s.config.PublicURL != "" {
+		return s.config.PublicURL + "/" + key, nil
+	}
+	return objectURL.String(), nil
+}
+
+func (s *s3AssetStorage) Open(ctx context.Context, key string) ([]byte, error) {
+	return nil, ErrAssetNotServed
+}
+
+// sign adds the AWS Signature Version 4 headers of the request, the payload is signed with its hash
+func (s *s3AssetStorage) sign(req *http.Request, objectURL *url.URL, payload []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + objectURL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{req.Method, objectURL.EscapedPath(), objectURL.RawQuery, canonicalHeaders, signedHeaders, payloadHash}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+type ipfsAssetStorage struct {
+	client     *http.Client
+	apiURL     string
+	apiToken   string
+	gatewayURL string
+}
+
+// NewIPFSAssetStorage adds and pins the assets with the /api/v0/add call of the Kubo RPC API at apiURL, they are
+// served by the gateway at gatewayURL, DefaultIPFSGatewayURL when empty
+func NewIPFSAssetStorage(client *http.Client, apiURL string, apiToken string, gatewayURL string) AssetStorage {
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	if gatewayURL == "" {
+		gatewayURL = DefaultIPFSGatewayURL
+	}
+	return &ipfsAssetStorage{
+		client:     client,
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		apiToken:   apiToken,
+		gatewayURL: strings.TrimSuffix(gatewayURL, "/"),
+	}
+}
+
+func (s *ipfsAssetStorage) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	if err := validateAssetKey(key); err != nil {
+		return "", err
+	}
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", path.Base(key))
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.apiURL+"/api/v0/add?pin=true&cid-version=1", &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if s.apiToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiToken)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to add to IPFS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("IPFS add failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		Hash string `json:"Hash"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("invalid IPFS add response: %w", err)
+	}
+	if result.Hash == "" {
+		return "", fmt.Errorf("IPFS add response has no hash")
+	}
+	return s.gatewayURL + "/ipfs/" + result.Hash, nil
+}
+
+func (s *ipfsAssetStorage) Open(ctx context.Context, key string) ([]byte, error) {
+	return nil, ErrAssetNotServed
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalAssetStorage(t *testing.T) {
+	storage := NewLocalAssetStorage(t.TempDir())
+
+	url, err := storage.Put(context.Background(), "tokens/1/logo.png", "image/png", []byte("logo"))
+	require.NoError(t, err)
+	assert.Empty(t, url)
+	data, err := storage.Open(context.Background(), "tokens/1/logo.png")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("logo"), data)
+
+	for _, key := range []string{"", "../logo.png", "/etc/passwd", "tokens/../../logo.png"} {
+		_, err := storage.Put(context.Background(), key, "image/png", []byte("logo"))
+		assert.Error(t, err, key)
+		_, err = storage.Open(context.Background(), key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestS3AssetStorage(t *testing.T) {
+	var request *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	storage := NewS3AssetStorage(server.Client(), S3AssetStorageConfig{
+		Endpoint:        server.URL,
+		Bucket:          "assets",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		PublicURL:       "https://cdn.example.com/",
+	}).(*s3AssetStorage)
+	storage.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	url, err := storage.Put(context.Background(), "tokens/1/logo.png", "image/png", []byte("logo"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/tokens/1/logo.png", url)
+	require.NotNil(t, request)
+	assert.Equal(t, http.MethodPut, request.Method)
+	assert.Equal(t, "/assets/tokens/1/logo.png", request.URL.Path)
+	assert.Equal(t, []byte("logo"), body)
+	assert.Equal(t, "image/png", request.Header.Get("Content-Type"))
+	assert.Equal(t, "20260102T030405Z", request.Header.Get("X-Amz-Date"))
+	assert.Equal(t, sha256Hex([]byte("logo")), request.Header.Get("X-Amz-Content-Sha256"))
+	assert.True(t, strings.HasPrefix(request.Header.Get("Authorization"),
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260102/us-east-1/s3/aws4_request, SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature="))
+
+	_, err = storage.Open(context.Background(), "tokens/1/logo.png")
+	assert.ErrorIs(t, err, ErrAssetNotServed)
+}
+
+func TestIPFSAssetStorage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v0/add", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("pin"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		data, _ := io.ReadAll(file)
+		assert.Equal(t, "logo.png", header.Filename)
+		assert.Equal(t, []byte("logo"), data)
+		w.Write([]byte(`{"Name":"logo.png","Hash":"bafkreitest","Size":"4"}`))
+	}))
+	defer server.Close()
+
+	storage := NewIPFSAssetStorage(server.Client(), server.URL, "token", "")
+	url, err := storage.Put(context.Background(), "tokens/1/logo.png", "image/png", []byte("logo"))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultIPFSGatewayURL+"/ipfs/bafkreitest", url)
+}
+
+func TestNewAssetStorageFromEnv(t *testing.T) {
+	t.Setenv(EnvAssetStorage, "")
+	storage, err := NewAssetStorageFromEnv()
+	require.NoError(t, err)
+	assert.IsType(t, &localAssetStorage{}, storage)
+
+	t.Setenv(EnvAssetStorage, AssetStorageS3)
+	_, err = NewAssetStorageFromEnv()
+	assert.ErrorContains(t, err, EnvS3Endpoint)
+
+	t.Setenv(EnvAssetStorage, AssetStorageIPFS)
+	_, err = NewAssetStorageFromEnv()
+	assert.ErrorContains(t, err, EnvIPFSAPIURL)
+
+	t.Setenv(EnvAssetStorage, "ftp")
+	_, err = NewAssetStorageFromEnv()
+	assert.ErrorContains(t, err, "unknown")
+}
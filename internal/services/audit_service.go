@@ -0,0 +1,307 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultAuditRetention is how long the audit log entries are kept
+	DefaultAuditRetention = 90 * 24 * time.Hour
+	// DefaultAuditPruneInterval is how often the entries older than the retention are deleted
+	DefaultAuditPruneInterval = time.Hour
+	// maxAuditErrorLength bounds the error message stored for a failed tool call
+	maxAuditErrorLength = 1000
+)
+
+// EnvAuditRetention overrides DefaultAuditRetention, 0 keeps the entries forever
+const EnvAuditRetention = "LAUNCHPAD_AUDIT_RETENTION"
+
+// auditCallbackName prefixes the GORM callbacks recording the mutations
+const auditCallbackName = "launchpad:audit"
+
+// unauditedTables are not recorded as mutations: the audit log itself, the derived search index and the idempotency bookkeeping
+var unauditedTables = map[string]bool{
+	"audit_logs":       true,
+	"search_documents": true,
+	"idempotency_keys": true,
+}
+
+// AuditRetentionFromEnv returns the retention of LAUNCHPAD_AUDIT_RETENTION, or DefaultAuditRetention when it is unset or invalid
+func AuditRetentionFromEnv() time.Duration {
+	value := os.Getenv(EnvAuditRetention)
+	if value == "" {
+		return DefaultAuditRetention
+	}
+	retention, err := time.ParseDuration(value)
+	if err != nil || retention < 0 {
+		log.Printf("Warning: ignoring invalid %s %q, using %s", EnvAuditRetention, value, DefaultAuditRetention)
+		return DefaultAuditRetention
+	}
+	return retention
+}
+
+// AuditLogFilter narrows the entries returned by ListAuditLogs, zero values match every entry
+type AuditLogFilter struct {
+	UserID     *string
+	Kind       models.AuditLogKind
+	ToolName   string
+	Status     models.AuditLogStatus
+	EntityType string
+	EntityID   string
+	Since      time.Time
+	Limit      int
+}
+
+type AuditService interface {
+	// RecordToolCall records a tool invocation with the hash of its arguments
+	RecordToolCall(userID *string, toolName string, arguments any, callErr string) error
+	// ListAuditLogs returns the entries matching the filter, newest first
+	ListAuditLogs(filter AuditLogFilter) ([]models.AuditLog, error)
+	// Prune deletes the entries created before the time and returns how many were deleted
+	Prune(before time.Time) (int64, error)
+	// RegisterMutationCallbacks records every row created, updated or deleted through the database of the service
+	RegisterMutationCallbacks() error
+}
+
+type auditService struct {
+	db *gorm.DB
+}
+
+func NewAuditService(db *gorm.DB) AuditService {
+	return &auditService{db: db}
+}
+
+func (s *auditService) RecordToolCall(userID *string, toolName string, arguments any, callErr string) error {
+	entry := models.AuditLog{
+		Kind:          models.AuditLogKindToolCall,
+		UserID:        userID,
+		ToolName:      toolName,
+		ArgumentsHash: HashToolArguments(arguments),
+		Status:        models.AuditLogStatusSuccess,
+	}
+	if callErr != "" {
+		entry.Status = models.AuditLogStatusError
+		entry.Error = truncateAuditError(callErr)
+	}
+	return s.db.Create(&entry).Error
+}
+
+// HashToolArguments returns the hex SHA-256 of the JSON arguments, maps are encoded with sorted keys so equal arguments hash alike
+func HashToolArguments(arguments any) string {
+	encoded, err := json.Marshal(arguments)
+	if err != nil {
+		encoded = []byte(fmt.Sprint(arguments))
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+func truncateAuditError(message string) string {
+	if len(message) <= maxAuditErrorLength {
+		return message
+	}
+	return message[:maxAuditErrorLength] + "..."
+}
+
+func (s *auditService) ListAuditLogs(filter AuditLogFilter) ([]models.AuditLog, error) {
+	query := s.db.Order("created_at DESC, id DESC")
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.Kind != "" {
+		query = query.Where("kind = ?", filter.Kind)
+	}
+	if filter.ToolName != "" {
+		query = query.Where("tool_name = ?", filter.ToolName)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+	if filter.EntityID != "" {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var entries []models.AuditLog
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *auditService) Prune(before time.Time) (int64, error) {
+	result := s.db.Where("created_at < ?", before).Delete(&models.AuditLog{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *auditService) RegisterMutationCallbacks() error {
+	callbacks := s.db.Callback()
+	// The callbacks are shared by every session of the database, register them once
+	if callbacks.Create().Get(auditCallbackName+":create") != nil {
+		return nil
+	}
+
+	if err := callbacks.Create().After("gorm:create").Register(auditCallbackName+":create", auditMutation(models.AuditLogActionCreate)); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register(auditCallbackName+":update", auditMutation(models.AuditLogActionUpdate)); err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:delete").Register(auditCallbackName+":delete", auditMutation(models.AuditLogActionDelete))
+}
+
+// auditMutation records the rows written by a statement, in the transaction of the statement so a rollback drops the entries
+func auditMutation(action models.AuditLogAction) func(db *gorm.DB) {
+	return func(db *gorm.DB) {
+		statement := db.Statement
+		if db.Error != nil || db.RowsAffected == 0 || statement.Schema == nil || unauditedTables[statement.Table] {
+			return
+		}
+
+		// The user of the context when the statement has one, the owner of the row otherwise
+		var contextUserID *string
+		if statement.Context != nil {
+			if userID := utils.GetUserID(statement.Context); userID != "" {
+				contextUserID = &userID
+			}
+		}
+
+		var entries []models.AuditLog
+		addEntry := func(row reflect.Value) {
+			entry := models.AuditLog{
+				Kind:       models.AuditLogKindMutation,
+				UserID:     contextUserID,
+				EntityType: statement.Table,
+				Action:     action,
+			}
+			if row.IsValid() {
+				if field := statement.Schema.PrioritizedPrimaryField; field != nil {
+					if value, zero := field.ValueOf(statement.Context, row); !zero {
+						entry.EntityID = fmt.Sprint(value)
+					}
+				}
+				if field := statement.Schema.LookUpField("user_id"); field != nil && entry.UserID == nil {
+					if value, zero := field.ValueOf(statement.Context, row); !zero {
+						if userID, ok := value.(*string); ok && userID != nil {
+							entry.UserID = userID
+						}
+					}
+				}
+			}
+			entries = append(entries, entry)
+		}
+
+		switch rows := reflect.Indirect(statement.ReflectValue); rows.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < rows.Len(); i++ {
+				addEntry(reflect.Indirect(rows.Index(i)))
+			}
+		case reflect.Struct:
+			addEntry(rows)
+		default:
+			addEntry(reflect.Value{})
+		}
+		if len(entries) == 0 {
+			return
+		}
+
+		if err := db.Session(&gorm.Session{NewDB: true}).Create(&entries).Error; err != nil {
+			log.Printf("Error recording the audit log of %s %s: %v", action, statement.Table, err)
+		}
+	}
+}
+
+// AuditLogPruner deletes the audit log entries older than the retention in the background
+type AuditLogPruner struct {
+	auditService AuditService
+	retention    time.Duration
+	interval     time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewAuditLogPruner creates a pruner of the entries older than retention, a zero retention keeps them forever
+func NewAuditLogPruner(auditService AuditService, retention time.Duration, interval time.Duration) *AuditLogPruner {
+	if interval <= 0 {
+		interval = DefaultAuditPruneInterval
+	}
+	return &AuditLogPruner{
+		auditService: auditService,
+		retention:    retention,
+		interval:     interval,
+		now:          time.Now,
+	}
+}
+
+// Start prunes the entries now and then at every interval until Stop is called
+func (p *AuditLogPruner) Start() {
+	if p.stop != nil || p.retention <= 0 {
+		return
+	}
+	p.stop = make(chan struct{})
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.PruneExpired()
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.PruneExpired()
+			case <-p.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background pruning
+func (p *AuditLogPruner) Stop() {
+	if p.stop == nil {
+		return
+	}
+	close(p.stop)
+	p.wg.Wait()
+	p.stop = nil
+}
+
+// PruneExpired deletes the entries older than the retention
+func (p *AuditLogPruner) PruneExpired() {
+	if p.retention <= 0 {
+		return
+	}
+	deleted, err := p.auditService.Prune(p.now().Add(-p.retention))
+	if err != nil {
+		log.Printf("Error pruning the audit log: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Pruned %d audit log entries older than %s", deleted, p.retention)
+	}
+}
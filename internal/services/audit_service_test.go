@@ -0,0 +1,145 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newAuditTestService(t *testing.T) (*gorm.DB, AuditService) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	service := NewAuditService(dbService.GetDB())
+	require.NoError(t, service.RegisterMutationCallbacks())
+	// Registering twice keeps a single set of callbacks
+	require.NoError(t, service.RegisterMutationCallbacks())
+	return dbService.GetDB(), service
+}
+
+func TestAuditRecordsToolCalls(t *testing.T) {
+	_, service := newAuditTestService(t)
+	userID := "user-1"
+
+	require.NoError(t, service.RecordToolCall(&userID, "launch", map[string]any{"template_id": "1", "chain_id": "2"}, ""))
+	require.NoError(t, service.RecordToolCall(nil, "launch", map[string]any{"chain_id": "2", "template_id": "1"}, "Template not found"))
+
+	entries, err := service.ListAuditLogs(AuditLogFilter{Kind: models.AuditLogKindToolCall})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditLogStatusError, entries[0].Status)
+	assert.Equal(t, "Template not found", entries[0].Error)
+	assert.Nil(t, entries[0].UserID)
+	assert.Equal(t, models.AuditLogStatusSuccess, entries[1].Status)
+	assert.Equal(t, userID, *entries[1].UserID)
+
+	// The hash does not depend on the order of the arguments
+	assert.Equal(t, entries[0].ArgumentsHash, entries[1].ArgumentsHash)
+	assert.Len(t, entries[0].ArgumentsHash, 64)
+
+	entries, err = service.ListAuditLogs(AuditLogFilter{UserID: &userID})
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestAuditRecordsMutations(t *testing.T) {
+	db, service := newAuditTestService(t)
+	userID := "user-1"
+
+	template := &models.Template{Name: "Token", UserId: &userID, ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	require.NoError(t, db.Model(template).Update("name", "Renamed").Error)
+	require.NoError(t, db.Delete(template).Error)
+
+	entries, err := service.ListAuditLogs(AuditLogFilter{Kind: models.AuditLogKindMutation, EntityType: "templates"})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, action := range []models.AuditLogAction{models.AuditLogActionDelete, models.AuditLogActionUpdate, models.AuditLogActionCreate} {
+		assert.Equal(t, action, entries[i].Action)
+		assert.Equal(t, fmt.Sprint(template.ID), entries[i].EntityID)
+		require.NotNil(t, entries[i].UserID)
+		assert.Equal(t, userID, *entries[i].UserID)
+	}
+
+	// Batch creates record every row, updates by condition record the table without a row
+	chains := []models.Chain{
+		{Name: "One", RPC: "http://localhost:8545", NetworkID: "1", ChainType: models.TransactionChainTypeEthereum},
+		{Name: "Two", RPC: "http://localhost:8546", NetworkID: "2", ChainType: models.TransactionChainTypeEthereum},
+	}
+	require.NoError(t, db.Create(&chains).Error)
+	require.NoError(t, db.Model(&models.Chain{}).Where("name = ?", "Two").Update("is_active", true).Error)
+
+	entries, err = service.ListAuditLogs(AuditLogFilter{EntityType: "chains"})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, models.AuditLogActionUpdate, entries[0].Action)
+	assert.Empty(t, entries[0].EntityID)
+
+	// Writes matching no rows and the audit log itself are not recorded
+	require.NoError(t, db.Model(&models.Chain{}).Where("name = ?", "Three").Update("is_active", true).Error)
+	entries, err = service.ListAuditLogs(AuditLogFilter{EntityType: "audit_logs"})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	entries, err = service.ListAuditLogs(AuditLogFilter{EntityType: "chains"})
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestAuditMutationsFollowTransactions(t *testing.T) {
+	db, service := newAuditTestService(t)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&models.Chain{Name: "Rolled Back", RPC: "http://localhost:8545", NetworkID: "1", ChainType: models.TransactionChainTypeEthereum}).Error; err != nil {
+			return err
+		}
+		return errors.New("abort")
+	})
+	require.Error(t, err)
+
+	entries, err := service.ListAuditLogs(AuditLogFilter{EntityType: "chains"})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestAuditLogPruner(t *testing.T) {
+	db, service := newAuditTestService(t)
+	now := time.Now()
+
+	require.NoError(t, db.Create(&[]models.AuditLog{
+		{Kind: models.AuditLogKindToolCall, ToolName: "launch", CreatedAt: now.Add(-48 * time.Hour)},
+		{Kind: models.AuditLogKindToolCall, ToolName: "list_chains", CreatedAt: now.Add(-time.Hour)},
+	}).Error)
+
+	pruner := NewAuditLogPruner(service, 24*time.Hour, time.Hour)
+	pruner.now = func() time.Time { return now }
+	pruner.PruneExpired()
+
+	entries, err := service.ListAuditLogs(AuditLogFilter{Kind: models.AuditLogKindToolCall})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "list_chains", entries[0].ToolName)
+
+	// A zero retention keeps the entries forever
+	NewAuditLogPruner(service, 0, time.Hour).PruneExpired()
+	entries, err = service.ListAuditLogs(AuditLogFilter{Kind: models.AuditLogKindToolCall})
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestAuditRetentionFromEnv(t *testing.T) {
+	t.Setenv(EnvAuditRetention, "")
+	assert.Equal(t, DefaultAuditRetention, AuditRetentionFromEnv())
+	t.Setenv(EnvAuditRetention, "0")
+	assert.Equal(t, time.Duration(0), AuditRetentionFromEnv())
+	t.Setenv(EnvAuditRetention, "720h")
+	assert.Equal(t, 720*time.Hour, AuditRetentionFromEnv())
+	t.Setenv(EnvAuditRetention, "a month")
+	assert.Equal(t, DefaultAuditRetention, AuditRetentionFromEnv())
+}
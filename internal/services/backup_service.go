@@ -0,0 +1,168 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BackupVersion is the format version of the backups written by Export
+const BackupVersion = 1
+
+// Backup is a database dump portable between the SQLite, Turso and Postgres backends.
+// Records keep their IDs so the references between them survive the restore.
+type Backup struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Chains []models.Chain `json:"chains"`
+	// DeletedChainIDs are the soft deleted chains, kept for the deployments still referencing them
+	DeletedChainIDs []uint            `json:"deleted_chain_ids,omitempty"`
+	Templates       []models.Template `json:"templates"`
+	// DeletedTemplateIDs are the soft deleted templates, kept for the deployments still referencing them
+	DeletedTemplateIDs  []uint                      `json:"deleted_template_ids,omitempty"`
+	TransactionSessions []models.TransactionSession `json:"transaction_sessions"`
+	Deployments         []models.Deployment         `json:"deployments"`
+	UniswapDeployments  []models.UniswapDeployment  `json:"uniswap_deployments"`
+	LiquidityPools      []models.LiquidityPool      `json:"liquidity_pools"`
+	PoolSnapshots       []models.PoolSnapshot       `json:"pool_snapshots"`
+	LaunchPolicies      []models.LaunchPolicy       `json:"launch_policies"`
+	LaunchGroups        []models.LaunchGroup        `json:"launch_groups,omitempty"`
+	LaunchGroupBridges  []models.LaunchGroupBridge  `json:"launch_group_bridges,omitempty"`
+	Projects            []models.Project            `json:"projects,omitempty"`
+	ProjectAssets       []models.ProjectAsset       `json:"project_assets,omitempty"`
+}
+
+// BackupService dumps and restores the chains, templates, deployments, pools and settings of the database
+type BackupService interface {
+	Export() (*Backup, error)
+	// Import restores a backup into a database without any of the backed up records
+	Import(backup *Backup) error
+}
+
+type backupService struct {
+	db *gorm.DB
+}
+
+func NewBackupService(db *gorm.DB) BackupService {
+	return &backupService{db: db}
+}
+
+// Export reads every backed up table, including soft deleted chains and templates
+func (s *backupService) Export() (*Backup, error) {
+	backup := &Backup{Version: BackupVersion, CreatedAt: time.Now().UTC()}
+
+	tables := []struct {
+		name string
+		dest interface{}
+	}{
+		{"chains", &backup.Chains},
+		{"templates", &backup.Templates},
+		{"transaction sessions", &backup.TransactionSessions},
+		{"launch groups", &backup.LaunchGroups},
+		{"deployments", &backup.Deployments},
+		{"uniswap deployments", &backup.UniswapDeployments},
+		{"liquidity pools", &backup.LiquidityPools},
+		{"pool snapshots", &backup.PoolSnapshots},
+		{"launch policies", &backup.LaunchPolicies},
+		{"launch group bridges", &backup.LaunchGroupBridges},
+		{"projects", &backup.Projects},
+		{"project assets", &backup.ProjectAssets},
+	}
+	for _, table := range tables {
+		if err := s.db.Unscoped().Order("id").Find(table.dest).Error; err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+		}
+	}
+
+	for _, chain := range backup.Chains {
+		if chain.DeletedAt.Valid {
+			backup.DeletedChainIDs = append(backup.DeletedChainIDs, chain.ID)
+		}
+	}
+	for _, template := range backup.Templates {
+		if template.DeletedAt.Valid {
+			backup.DeletedTemplateIDs = append(backup.DeletedTemplateIDs, template.ID)
+		}
+	}
+	return backup, nil
+}
+
+// Import inserts the backup in a single transaction so a failed restore leaves the database unchanged
+func (s *backupService) Import(backup *Backup) error {
+	if backup.Version != BackupVersion {
+		return fmt.Errorf("unsupported backup version %d, expected %d", backup.Version, BackupVersion)
+	}
+
+	// Tables are listed parents first so the foreign keys resolve
+	tables := []struct {
+		name  string
+		model interface{}
+		rows  interface{}
+		count int
+	}{
+		{"chains", &models.Chain{}, &backup.Chains, len(backup.Chains)},
+		{"templates", &models.Template{}, &backup.Templates, len(backup.Templates)},
+		{"transaction_sessions", &models.TransactionSession{}, &backup.TransactionSessions, len(backup.TransactionSessions)},
+		{"launch_groups", &models.LaunchGroup{}, &backup.LaunchGroups, len(backup.LaunchGroups)},
+		{"deployments", &models.Deployment{}, &backup.Deployments, len(backup.Deployments)},
+		{"uniswap_deployments", &models.UniswapDeployment{}, &backup.UniswapDeployments, len(backup.UniswapDeployments)},
+		{"liquidity_pools", &models.LiquidityPool{}, &backup.LiquidityPools, len(backup.LiquidityPools)},
+		{"pool_snapshots", &models.PoolSnapshot{}, &backup.PoolSnapshots, len(backup.PoolSnapshots)},
+		{"launch_policies", &models.LaunchPolicy{}, &backup.LaunchPolicies, len(backup.LaunchPolicies)},
+		{"launch_group_bridges", &models.LaunchGroupBridge{}, &backup.LaunchGroupBridges, len(backup.LaunchGroupBridges)},
+		{"projects", &models.Project{}, &backup.Projects, len(backup.Projects)},
+		{"project_assets", &models.ProjectAsset{}, &backup.ProjectAssets, len(backup.ProjectAssets)},
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// Restoring on top of existing records would mix the ID spaces of two databases
+		for _, table := range tables {
+			var existing int64
+			if err := tx.Unscoped().Model(table.model).Count(&existing).Error; err != nil {
+				return fmt.Errorf("failed to count %s: %w", table.name, err)
+			}
+			if existing > 0 {
+				return fmt.Errorf("cannot import into a database with existing %s, import requires an empty database", table.name)
+			}
+		}
+
+		for _, table := range tables {
+			if table.count == 0 {
+				continue
+			}
+			// The related records are imported from their own tables
+			if err := tx.Omit(clause.Associations).CreateInBatches(table.rows, 100).Error; err != nil {
+				return fmt.Errorf("failed to import %s: %w", table.name, err)
+			}
+		}
+
+		if len(backup.DeletedChainIDs) > 0 {
+			if err := tx.Delete(&models.Chain{}, backup.DeletedChainIDs).Error; err != nil {
+				return fmt.Errorf("failed to restore deleted chains: %w", err)
+			}
+		}
+		if len(backup.DeletedTemplateIDs) > 0 {
+			if err := tx.Delete(&models.Template{}, backup.DeletedTemplateIDs).Error; err != nil {
+				return fmt.Errorf("failed to restore deleted templates: %w", err)
+			}
+		}
+
+		// Explicit IDs do not advance the Postgres sequences, move them past the imported IDs
+		if tx.Dialector.Name() == "postgres" {
+			for _, table := range tables {
+				if table.name == "transaction_sessions" {
+					continue
+				}
+				query := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('%[1]s', 'id'), COALESCE((SELECT MAX(id) FROM %[1]s), 0) + 1, false)`, table.name)
+				if err := tx.Exec(query).Error; err != nil {
+					return fmt.Errorf("failed to reset the id sequence of %s: %w", table.name, err)
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBackupTestDB(t *testing.T) DBService {
+	db, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestBackupServiceRoundTrip(t *testing.T) {
+	source := newBackupTestDB(t)
+	db := source.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, db.Create(chain).Error)
+	removed := &models.Template{Name: "Removed", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Removed {}"}
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}", Metadata: models.JSON{"Name": ""}}
+	require.NoError(t, db.Create(removed).Error)
+	require.NoError(t, db.Create(template).Error)
+	require.NoError(t, db.Create(&models.Deployment{TemplateID: removed.ID, ChainID: chain.ID, ContractAddress: "0xaaaa", Status: models.TransactionStatusConfirmed}).Error)
+	require.NoError(t, db.Create(&models.Deployment{TemplateID: template.ID, ChainID: chain.ID, ContractAddress: "0xbbbb", Status: models.TransactionStatusConfirmed}).Error)
+	require.NoError(t, db.Delete(removed).Error)
+	pool := &models.LiquidityPool{TokenAddress: "0xbbbb", PairAddress: "0xcccc", UniswapVersion: "v2", Token0: "0xbbbb", Token1: "0xdddd", InitialToken0: "100", InitialToken1: "1", CreatorAddress: "0xeeee", TransactionHash: "0x01", Status: models.TransactionStatusConfirmed}
+	require.NoError(t, db.Create(pool).Error)
+	require.NoError(t, db.Create(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: "100", Reserve1: "1", Price: 0.01}).Error)
+	require.NoError(t, db.Create(&models.LaunchPolicy{LiquidityLockThreshold: "1000", MinLiquidityLockDays: 30}).Error)
+
+	backup, err := NewBackupService(db).Export()
+	require.NoError(t, err)
+	assert.Equal(t, BackupVersion, backup.Version)
+	assert.Len(t, backup.Templates, 2)
+	assert.Equal(t, []uint{removed.ID}, backup.DeletedTemplateIDs)
+
+	// The backup goes through JSON as it does in the files written by export
+	backupJSON, err := json.Marshal(backup)
+	require.NoError(t, err)
+	var restored Backup
+	require.NoError(t, json.Unmarshal(backupJSON, &restored))
+
+	target := newBackupTestDB(t)
+	require.NoError(t, NewBackupService(target.GetDB()).Import(&restored))
+
+	templates, err := NewTemplateService(target.GetDB()).ListTemplates(nil, "", "", 0)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, template.ID, templates[0].ID)
+	assert.Equal(t, "", templates[0].Metadata["Name"])
+
+	var deployments []models.Deployment
+	require.NoError(t, target.GetDB().Preload("Template").Order("id").Find(&deployments).Error)
+	require.Len(t, deployments, 2)
+	assert.Equal(t, "0xaaaa", deployments[0].ContractAddress)
+	assert.Equal(t, chain.ID, deployments[1].ChainID)
+
+	var snapshots []models.PoolSnapshot
+	require.NoError(t, target.GetDB().Find(&snapshots).Error)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, pool.ID, snapshots[0].PoolID)
+
+	policy, err := NewLaunchPolicyService(target.GetDB()).GetLaunchPolicy()
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	assert.Equal(t, 30, policy.MinLiquidityLockDays)
+
+	// New records continue after the imported IDs
+	next := &models.Chain{Name: "Sepolia", RPC: "http://localhost:8546", NetworkID: "11155111", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, target.GetDB().Create(next).Error)
+	assert.Greater(t, next.ID, chain.ID)
+}
+
+func TestBackupServiceImportRequiresEmptyDatabase(t *testing.T) {
+	dbService := newBackupTestDB(t)
+	db := dbService.GetDB()
+	require.NoError(t, db.Create(&models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}).Error)
+
+	service := NewBackupService(db)
+	backup, err := service.Export()
+	require.NoError(t, err)
+
+	err = service.Import(backup)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "import requires an empty database")
+
+	backup.Version = BackupVersion + 1
+	err = NewBackupService(newBackupTestDB(t).GetDB()).Import(backup)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported backup version")
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultBondingCurvePollInterval is how often the monitor reads the state of the active bonding curves
+const DefaultBondingCurvePollInterval = 30 * time.Second
+
+// BondingCurveStateReader reads the state of a bonding curve contract
+type BondingCurveStateReader func(rpcURL string, contractAddress string) (*utils.BondingCurveState, error)
+
+// BondingCurveMonitor tracks the ETH raised and the tokens sold of the active bonding curves and marks them graduated
+// once their liquidity moved to Uniswap, so the progress endpoint reads the database instead of the chain
+type BondingCurveMonitor struct {
+	bondingCurveService BondingCurveService
+	reader              BondingCurveStateReader
+	interval            time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewBondingCurveMonitor(bondingCurveService BondingCurveService, reader BondingCurveStateReader, interval time.Duration) *BondingCurveMonitor {
+	if interval <= 0 {
+		interval = DefaultBondingCurvePollInterval
+	}
+	return &BondingCurveMonitor{
+		bondingCurveService: bondingCurveService,
+		reader:              reader,
+		interval:            interval,
+		now:                 time.Now,
+	}
+}
+
+// Start syncs the active curves in the background until Stop is called
+func (m *BondingCurveMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.SyncActive()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background monitoring and waits for the current sync to finish
+func (m *BondingCurveMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// SyncActive reads the state of every active curve
+func (m *BondingCurveMonitor) SyncActive() {
+	curves, err := m.bondingCurveService.ListActiveBondingCurves()
+	if err != nil {
+		log.Printf("Error listing active bonding curves: %v", err)
+		return
+	}
+
+	for _, curve := range curves {
+		if err := m.sync(curve); err != nil {
+			log.Printf("Error syncing bonding curve %d: %v", curve.ID, err)
+		}
+	}
+}
+
+func (m *BondingCurveMonitor) sync(curve models.BondingCurve) error {
+	if curve.ContractAddress == "" {
+		return fmt.Errorf("bonding curve has no contract address")
+	}
+	state, err := m.reader(curve.Chain.RPC, curve.ContractAddress)
+	if err != nil {
+		// the curve stays active and is read again on the next poll
+		return err
+	}
+	return m.bondingCurveService.UpdateCurveState(curve.ID, state, m.now())
+}
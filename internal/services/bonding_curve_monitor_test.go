@@ -0,0 +1,82 @@
+package services
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBondingCurveMonitor(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	service := NewBondingCurveService(db)
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	newCurve := func(contractAddress string) *models.BondingCurve {
+		curve := &models.BondingCurve{
+			ChainID:             chain.ID,
+			DeployerAddress:     "0x01",
+			RouterAddress:       "0x02",
+			TotalSupply:         "1000",
+			CurveSupply:         "800",
+			GraduationThreshold: "600",
+			VirtualEthReserve:   "200",
+			VirtualTokenReserve: "1066",
+		}
+		require.NoError(t, service.CreateBondingCurve(curve))
+		require.NoError(t, service.MarkBondingCurveDeployed(curve.ID, contractAddress))
+		curve.ContractAddress = contractAddress
+		return curve
+	}
+	selling := newCurve("0x0000000000000000000000000000000000000001")
+	unreachable := newCurve("0x0000000000000000000000000000000000000002")
+
+	var reads []string
+	monitor := NewBondingCurveMonitor(service, func(rpcURL string, contractAddress string) (*utils.BondingCurveState, error) {
+		reads = append(reads, contractAddress)
+		assert.Equal(t, chain.RPC, rpcURL)
+		if contractAddress == unreachable.ContractAddress {
+			return nil, errors.New("connection refused")
+		}
+		return &utils.BondingCurveState{
+			EthRaised:    big.NewInt(600),
+			TokensSold:   big.NewInt(800),
+			VirtualEth:   big.NewInt(800),
+			VirtualToken: big.NewInt(266),
+			Graduated:    true,
+			PairAddress:  "0x0000000000000000000000000000000000000003",
+		}, nil
+	}, time.Minute)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	monitor.now = func() time.Time { return now }
+
+	monitor.SyncActive()
+	assert.ElementsMatch(t, []string{selling.ContractAddress, unreachable.ContractAddress}, reads)
+
+	curve, err := service.GetBondingCurve(selling.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BondingCurveStatusGraduated, curve.Status)
+	assert.Equal(t, "600", curve.EthRaised)
+	assert.Equal(t, "0x0000000000000000000000000000000000000003", curve.PairAddress)
+	require.NotNil(t, curve.GraduatedAt)
+	assert.True(t, now.Equal(*curve.GraduatedAt))
+
+	// A failed read keeps the curve active for the next poll
+	curve, err = service.GetBondingCurve(unreachable.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BondingCurveStatusActive, curve.Status)
+	assert.Nil(t, curve.SyncedAt)
+
+	reads = nil
+	monitor.SyncActive()
+	assert.Equal(t, []string{unreachable.ContractAddress}, reads)
+}
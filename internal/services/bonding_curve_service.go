@@ -0,0 +1,157 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+// bondingCurvePriceUnit is the token amount prices are quoted for, one token of 18 decimals
+var bondingCurvePriceUnit = big.NewInt(1_000_000_000_000_000_000)
+
+// BondingCurveProgress is the progress of a bonding curve towards its graduation to Uniswap
+type BondingCurveProgress struct {
+	Status              models.BondingCurveStatus `json:"status"`
+	EthRaised           string                    `json:"eth_raised"`
+	GraduationThreshold string                    `json:"graduation_threshold"`
+	TokensSold          string                    `json:"tokens_sold"`
+	CurveSupply         string                    `json:"curve_supply"`
+	// Progress is the share of the graduation threshold raised, from 0 to 1
+	Progress float64 `json:"progress"`
+	// Price is the spot price of one token (10^18 units) in wei, MarketCap the price of the total supply
+	Price       string     `json:"price"`
+	MarketCap   string     `json:"market_cap"`
+	Graduated   bool       `json:"graduated"`
+	PairAddress string     `json:"pair_address,omitempty"`
+	SyncedAt    *time.Time `json:"synced_at,omitempty"`
+}
+
+type BondingCurveService interface {
+	CreateBondingCurve(curve *models.BondingCurve) error
+	GetBondingCurve(id uint) (*models.BondingCurve, error)
+	GetBondingCurveBySessionId(sessionId string) (*models.BondingCurve, error)
+	// ListActiveBondingCurves returns the deployed curves not graduated yet, whose state is tracked
+	ListActiveBondingCurves() ([]models.BondingCurve, error)
+	// MarkBondingCurveDeployed records the confirmed address of the curve and starts tracking it
+	MarkBondingCurveDeployed(id uint, contractAddress string) error
+	// UpdateCurveState records the state read from the contract at syncedAt, a graduated state graduates the curve
+	UpdateCurveState(id uint, state *utils.BondingCurveState, syncedAt time.Time) error
+	UpdateBondingCurveStatus(id uint, status models.BondingCurveStatus) error
+	// GetProgress computes the progress and the spot price of the curve from its last synced state
+	GetProgress(curve *models.BondingCurve) (*BondingCurveProgress, error)
+}
+
+type bondingCurveService struct {
+	db *gorm.DB
+}
+
+func NewBondingCurveService(db *gorm.DB) BondingCurveService {
+	return &bondingCurveService{db: db}
+}
+
+func (s *bondingCurveService) CreateBondingCurve(curve *models.BondingCurve) error {
+	if curve.Status == "" {
+		curve.Status = models.BondingCurveStatusPending
+	}
+	return s.db.Create(curve).Error
+}
+
+func (s *bondingCurveService) GetBondingCurve(id uint) (*models.BondingCurve, error) {
+	var curve models.BondingCurve
+	err := s.db.Preload("Chain").First(&curve, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &curve, nil
+}
+
+func (s *bondingCurveService) GetBondingCurveBySessionId(sessionId string) (*models.BondingCurve, error) {
+	var curve models.BondingCurve
+	err := s.db.Preload("Chain").Where("session_id = ?", sessionId).First(&curve).Error
+	if err != nil {
+		return nil, err
+	}
+	return &curve, nil
+}
+
+func (s *bondingCurveService) ListActiveBondingCurves() ([]models.BondingCurve, error) {
+	var curves []models.BondingCurve
+	err := s.db.Preload("Chain").Where("status = ?", models.BondingCurveStatusActive).Order("id ASC").Find(&curves).Error
+	return curves, err
+}
+
+func (s *bondingCurveService) MarkBondingCurveDeployed(id uint, contractAddress string) error {
+	return s.db.Model(&models.BondingCurve{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.BondingCurveStatusActive,
+		"contract_address": contractAddress,
+	}).Error
+}
+
+func (s *bondingCurveService) UpdateCurveState(id uint, state *utils.BondingCurveState, syncedAt time.Time) error {
+	updates := map[string]interface{}{
+		"eth_raised":            state.EthRaised.String(),
+		"tokens_sold":           state.TokensSold.String(),
+		"virtual_eth_reserve":   state.VirtualEth.String(),
+		"virtual_token_reserve": state.VirtualToken.String(),
+		"pair_address":          state.PairAddress,
+		"synced_at":             syncedAt,
+	}
+	if state.Graduated {
+		updates["status"] = models.BondingCurveStatusGraduated
+		updates["graduated_at"] = syncedAt
+	}
+	return s.db.Model(&models.BondingCurve{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (s *bondingCurveService) UpdateBondingCurveStatus(id uint, status models.BondingCurveStatus) error {
+	return s.db.Model(&models.BondingCurve{}).Where("id = ?", id).Update("status", status).Error
+}
+
+func (s *bondingCurveService) GetProgress(curve *models.BondingCurve) (*BondingCurveProgress, error) {
+	amounts := map[string]*big.Int{}
+	for name, value := range map[string]string{
+		"eth raised":            curve.EthRaised,
+		"graduation threshold":  curve.GraduationThreshold,
+		"total supply":          curve.TotalSupply,
+		"virtual eth reserve":   curve.VirtualEthReserve,
+		"virtual token reserve": curve.VirtualTokenReserve,
+	} {
+		amount, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s %q of bonding curve %d", name, value, curve.ID)
+		}
+		amounts[name] = amount
+	}
+	if amounts["graduation threshold"].Sign() <= 0 || amounts["virtual token reserve"].Sign() <= 0 {
+		return nil, fmt.Errorf("bonding curve %d has no graduation threshold or token reserve", curve.ID)
+	}
+
+	progress, _ := new(big.Rat).SetFrac(amounts["eth raised"], amounts["graduation threshold"]).Float64()
+	if progress > 1 {
+		progress = 1
+	}
+	price := new(big.Int).Quo(new(big.Int).Mul(amounts["virtual eth reserve"], bondingCurvePriceUnit), amounts["virtual token reserve"])
+	marketCap := new(big.Int).Quo(new(big.Int).Mul(amounts["virtual eth reserve"], amounts["total supply"]), amounts["virtual token reserve"])
+
+	graduated := curve.Status == models.BondingCurveStatusGraduated
+	if graduated {
+		progress = 1
+	}
+	return &BondingCurveProgress{
+		Status:              curve.Status,
+		EthRaised:           curve.EthRaised,
+		GraduationThreshold: curve.GraduationThreshold,
+		TokensSold:          curve.TokensSold,
+		CurveSupply:         curve.CurveSupply,
+		Progress:            progress,
+		Price:               price.String(),
+		MarketCap:           marketCap.String(),
+		Graduated:           graduated,
+		PairAddress:         curve.PairAddress,
+		SyncedAt:            curve.SyncedAt,
+	}, nil
+}
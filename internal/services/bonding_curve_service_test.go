@@ -0,0 +1,95 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBondingCurveService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	service := NewBondingCurveService(dbService.GetDB())
+
+	curve := &models.BondingCurve{
+		DeploymentID:        1,
+		ChainID:             1,
+		DeployerAddress:     "0x01",
+		RouterAddress:       "0x02",
+		TotalSupply:         "1000",
+		CurveSupply:         "800",
+		GraduationThreshold: "600",
+		InitialBuy:          "0",
+		VirtualEthReserve:   "200",
+		VirtualTokenReserve: "1066",
+		EthRaised:           "0",
+		TokensSold:          "0",
+		SessionId:           "session-1",
+	}
+	require.NoError(t, service.CreateBondingCurve(curve))
+	assert.Equal(t, models.BondingCurveStatusPending, curve.Status)
+
+	progress, err := service.GetProgress(curve)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, progress.Progress)
+	assert.Equal(t, "187617260787992495", progress.Price)
+	assert.Equal(t, "187", progress.MarketCap)
+	assert.False(t, progress.Graduated)
+
+	// Pending curves are not tracked until their deployment is confirmed
+	active, err := service.ListActiveBondingCurves()
+	require.NoError(t, err)
+	assert.Empty(t, active)
+
+	require.NoError(t, service.MarkBondingCurveDeployed(curve.ID, "0x03"))
+	active, err = service.ListActiveBondingCurves()
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, "0x03", active[0].ContractAddress)
+
+	syncedAt := time.Now()
+	require.NoError(t, service.UpdateCurveState(curve.ID, &utils.BondingCurveState{
+		EthRaised:    big.NewInt(300),
+		TokensSold:   big.NewInt(400),
+		VirtualEth:   big.NewInt(500),
+		VirtualToken: big.NewInt(666),
+		PairAddress:  "0x04",
+	}, syncedAt))
+	curve, err = service.GetBondingCurve(curve.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BondingCurveStatusActive, curve.Status)
+	require.NotNil(t, curve.SyncedAt)
+	progress, err = service.GetProgress(curve)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, progress.Progress)
+	assert.Equal(t, "750750750750750750", progress.Price)
+	assert.Equal(t, "0x04", progress.PairAddress)
+
+	require.NoError(t, service.UpdateCurveState(curve.ID, &utils.BondingCurveState{
+		EthRaised:    big.NewInt(600),
+		TokensSold:   big.NewInt(800),
+		VirtualEth:   big.NewInt(800),
+		VirtualToken: big.NewInt(266),
+		Graduated:    true,
+		PairAddress:  "0x04",
+	}, syncedAt))
+	curve, err = service.GetBondingCurve(curve.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BondingCurveStatusGraduated, curve.Status)
+	require.NotNil(t, curve.GraduatedAt)
+	progress, err = service.GetProgress(curve)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, progress.Progress)
+	assert.True(t, progress.Graduated)
+
+	// Graduated curves are not tracked anymore
+	active, err = service.ListActiveBondingCurves()
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
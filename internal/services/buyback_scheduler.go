@@ -0,0 +1,128 @@
+package services
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultBuybackPollInterval is how often the scheduler looks for due buybacks
+const DefaultBuybackPollInterval = 30 * time.Second
+
+// BuybackExecutor creates the swap session of one run of a buyback and returns its ID
+type BuybackExecutor func(buyback models.Buyback) (string, error)
+
+// BuybackNotifier is called when the swap session of a run is ready to sign
+type BuybackNotifier func(buyback models.Buyback, sessionID string)
+
+// BuybackScheduler creates the swap sessions of buybacks when they are due
+type BuybackScheduler struct {
+	buybackService BuybackService
+	executor       BuybackExecutor
+	notifier       BuybackNotifier
+	interval       time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewBuybackScheduler(buybackService BuybackService, executor BuybackExecutor, notifier BuybackNotifier, interval time.Duration) *BuybackScheduler {
+	if interval <= 0 {
+		interval = DefaultBuybackPollInterval
+	}
+	return &BuybackScheduler{
+		buybackService: buybackService,
+		executor:       executor,
+		notifier:       notifier,
+		interval:       interval,
+		now:            time.Now,
+	}
+}
+
+// Start runs the due buybacks in the background until Stop is called
+func (b *BuybackScheduler) Start() {
+	if b.stop != nil {
+		return
+	}
+	b.stop = make(chan struct{})
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				b.RunDue()
+			case <-b.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background scheduling and waits for the current runs to finish
+func (b *BuybackScheduler) Stop() {
+	if b.stop == nil {
+		return
+	}
+	close(b.stop)
+	b.wg.Wait()
+	b.stop = nil
+}
+
+// RunDue creates a swap session for every buyback that is due
+func (b *BuybackScheduler) RunDue() {
+	now := b.now()
+	buybacks, err := b.buybackService.ListDueBuybacks(now)
+	if err != nil {
+		log.Printf("Error listing due buybacks: %v", err)
+		return
+	}
+
+	for _, buyback := range buybacks {
+		if err := b.run(buyback, now); err != nil {
+			log.Printf("Error recording run of buyback %d: %v", buyback.ID, err)
+		}
+	}
+}
+
+func (b *BuybackScheduler) run(buyback models.Buyback, now time.Time) error {
+	run := &models.BuybackRun{}
+	sessionID, err := b.executor(buyback)
+	if err != nil {
+		// A failed run is recorded and retried on the next run instead of stopping the schedule
+		log.Printf("Failed to create swap session for buyback %d: %v", buyback.ID, err)
+		run.Status = models.BuybackRunStatusFailed
+		run.Error = err.Error()
+		buyback.LastError = err.Error()
+	} else {
+		run.SessionId = sessionID
+		run.Status = models.BuybackRunStatusPending
+		run.EthSpent = buyback.AmountPerRun
+		buyback.LastError = ""
+		buyback.RunCount++
+	}
+
+	buyback.LastRunAt = &now
+	buyback.NextRunAt = utils.NextScheduledRun(buyback.NextRunAt, time.Duration(buyback.IntervalSeconds)*time.Second, now)
+	if buyback.MaxRuns > 0 && buyback.RunCount >= buyback.MaxRuns {
+		buyback.Status = models.BuybackStatusCompleted
+	}
+
+	if err := b.buybackService.RecordBuybackRun(&buyback, run); err != nil {
+		return err
+	}
+
+	if run.SessionId != "" && b.notifier != nil {
+		b.notifier(buyback, run.SessionId)
+	}
+	return nil
+}
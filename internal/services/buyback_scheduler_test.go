@@ -0,0 +1,142 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type buybackSchedulerFixture struct {
+	service     BuybackService
+	scheduler   *BuybackScheduler
+	chain       *models.Chain
+	now         time.Time
+	executorErr error
+	sessions    int
+	notified    []string
+}
+
+func setupBuybackScheduler(t *testing.T) *buybackSchedulerFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Chain{}, &models.Buyback{}, &models.BuybackRun{}))
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: "http://localhost:8545", NetworkID: "31337", Name: "Local"}
+	require.NoError(t, db.Create(chain).Error)
+
+	f := &buybackSchedulerFixture{
+		service: NewBuybackService(db),
+		chain:   chain,
+		now:     time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	executor := func(buyback models.Buyback) (string, error) {
+		if f.executorErr != nil {
+			return "", f.executorErr
+		}
+		f.sessions++
+		return fmt.Sprintf("session-%d", f.sessions), nil
+	}
+	notifier := func(buyback models.Buyback, sessionID string) {
+		f.notified = append(f.notified, sessionID)
+	}
+	f.scheduler = NewBuybackScheduler(f.service, executor, notifier, time.Minute)
+	f.scheduler.now = func() time.Time { return f.now }
+	return f
+}
+
+func (f *buybackSchedulerFixture) createBuyback(t *testing.T, maxRuns int) *models.Buyback {
+	buyback := &models.Buyback{
+		ChainID:           f.chain.ID,
+		DeploymentID:      1,
+		TokenAddress:      "0x1111111111111111111111111111111111111111",
+		TreasuryAddress:   "0x4444444444444444444444444444444444444444",
+		AmountPerRun:      "100000000000000000",
+		SlippageTolerance: "1",
+		Schedule:          "@daily",
+		IntervalSeconds:   int64((24 * time.Hour).Seconds()),
+		NextRunAt:         f.now,
+		MaxRuns:           maxRuns,
+	}
+	require.NoError(t, f.service.CreateBuyback(buyback))
+	return buyback
+}
+
+func TestBuybackSchedulerReportsCumulativeBurn(t *testing.T) {
+	f := setupBuybackScheduler(t)
+	buyback := f.createBuyback(t, 0)
+
+	f.scheduler.RunDue()
+	f.now = f.now.Add(24 * time.Hour)
+	f.scheduler.RunDue()
+	assert.Equal(t, []string{"session-1", "session-2"}, f.notified)
+
+	run, err := f.service.GetBuybackRunBySessionId("session-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.BuybackRunStatusPending, run.Status)
+	assert.Equal(t, "100000000000000000", run.EthSpent)
+	require.NoError(t, f.service.ConfirmBuybackRun(run.ID, "0xabc", "5000"))
+
+	// Only the confirmed run is counted in the totals
+	report, err := f.service.GetBurnReport(buyback.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "100000000000000000", report.TotalEthSpent)
+	assert.Equal(t, "5000", report.TotalBurned)
+	assert.Equal(t, 1, report.ConfirmedRuns)
+	assert.Equal(t, 1, report.PendingRuns)
+
+	run, err = f.service.GetBuybackRunBySessionId("session-2")
+	require.NoError(t, err)
+	require.NoError(t, f.service.ConfirmBuybackRun(run.ID, "0xdef", "2500"))
+	report, err = f.service.GetBurnReport(buyback.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "200000000000000000", report.TotalEthSpent)
+	assert.Equal(t, "7500", report.TotalBurned)
+	assert.Equal(t, 2, report.ConfirmedRuns)
+}
+
+func TestBuybackSchedulerCompletesAfterMaxRuns(t *testing.T) {
+	f := setupBuybackScheduler(t)
+	buyback := f.createBuyback(t, 1)
+
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetBuyback(buyback.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BuybackStatusCompleted, stored.Status)
+
+	f.now = f.now.Add(48 * time.Hour)
+	f.scheduler.RunDue()
+	assert.Equal(t, 1, f.sessions)
+}
+
+func TestBuybackSchedulerRecordsFailedRuns(t *testing.T) {
+	f := setupBuybackScheduler(t)
+	buyback := f.createBuyback(t, 1)
+	f.executorErr = errors.New("no liquidity pool")
+
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetBuyback(buyback.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BuybackStatusActive, stored.Status)
+	assert.Equal(t, "no liquidity pool", stored.LastError)
+	assert.Empty(t, f.notified)
+
+	runs, err := f.service.ListBuybackRuns(buyback.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, models.BuybackRunStatusFailed, runs[0].Status)
+
+	// Failed runs are neither pending nor counted
+	report, err := f.service.GetBurnReport(buyback.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "0", report.TotalBurned)
+	assert.Equal(t, 0, report.PendingRuns)
+}
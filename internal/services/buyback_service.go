@@ -0,0 +1,160 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// BuybackBurnReport is the cumulative result of the confirmed runs of a buyback
+type BuybackBurnReport struct {
+	TotalEthSpent string `json:"total_eth_spent"`
+	TotalBurned   string `json:"total_burned"`
+	ConfirmedRuns int    `json:"confirmed_runs"`
+	// PendingRuns are the runs whose swap session is not signed yet, they are not counted in the totals
+	PendingRuns int `json:"pending_runs"`
+}
+
+type BuybackService interface {
+	CreateBuyback(buyback *models.Buyback) error
+	GetBuyback(id uint) (*models.Buyback, error)
+	// ListBuybacks returns the buybacks of the user, every buyback when userID is nil, newest first
+	ListBuybacks(userID *string) ([]models.Buyback, error)
+	// ListDueBuybacks returns the active schedules whose next run is at or before now
+	ListDueBuybacks(now time.Time) ([]models.Buyback, error)
+	// RecordBuybackRun stores the run and updates the schedule counters in one transaction
+	RecordBuybackRun(buyback *models.Buyback, run *models.BuybackRun) error
+	GetBuybackRunBySessionId(sessionId string) (*models.BuybackRun, error)
+	// ConfirmBuybackRun records the confirmed swap of a run and the tokens it burned
+	ConfirmBuybackRun(id uint, txHash string, tokensBurned string) error
+	// ListBuybackRuns returns the latest runs of a schedule, newest first
+	ListBuybackRuns(buybackID uint, limit int) ([]models.BuybackRun, error)
+	UpdateBuybackStatus(id uint, status models.BuybackStatus) error
+	// GetBurnReport sums the ETH spent and the tokens burned by the confirmed runs of a buyback
+	GetBurnReport(buybackID uint) (*BuybackBurnReport, error)
+}
+
+type buybackService struct {
+	db *gorm.DB
+}
+
+func NewBuybackService(db *gorm.DB) BuybackService {
+	return &buybackService{db: db}
+}
+
+func (s *buybackService) CreateBuyback(buyback *models.Buyback) error {
+	if buyback.Status == "" {
+		buyback.Status = models.BuybackStatusActive
+	}
+	return s.db.Create(buyback).Error
+}
+
+func (s *buybackService) GetBuyback(id uint) (*models.Buyback, error) {
+	var buyback models.Buyback
+	err := s.db.Preload("Chain").First(&buyback, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &buyback, nil
+}
+
+func (s *buybackService) ListBuybacks(userID *string) ([]models.Buyback, error) {
+	var buybacks []models.Buyback
+	query := s.db.Preload("Chain").Order("id DESC")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&buybacks).Error
+	return buybacks, err
+}
+
+func (s *buybackService) ListDueBuybacks(now time.Time) ([]models.Buyback, error) {
+	var buybacks []models.Buyback
+	err := s.db.Preload("Chain").
+		Where("status = ? AND next_run_at <= ?", models.BuybackStatusActive, now).
+		Order("next_run_at ASC").
+		Find(&buybacks).Error
+	return buybacks, err
+}
+
+func (s *buybackService) RecordBuybackRun(buyback *models.Buyback, run *models.BuybackRun) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		run.BuybackID = buyback.ID
+		if err := tx.Create(run).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Buyback{}).Where("id = ?", buyback.ID).Updates(map[string]interface{}{
+			"next_run_at": buyback.NextRunAt,
+			"last_run_at": buyback.LastRunAt,
+			"run_count":   buyback.RunCount,
+			"status":      buyback.Status,
+			"last_error":  buyback.LastError,
+		}).Error
+	})
+}
+
+func (s *buybackService) GetBuybackRunBySessionId(sessionId string) (*models.BuybackRun, error) {
+	var run models.BuybackRun
+	err := s.db.Where("session_id = ?", sessionId).First(&run).Error
+	if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+func (s *buybackService) ConfirmBuybackRun(id uint, txHash string, tokensBurned string) error {
+	return s.db.Model(&models.BuybackRun{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.BuybackRunStatusConfirmed,
+		"transaction_hash": txHash,
+		"tokens_burned":    tokensBurned,
+	}).Error
+}
+
+func (s *buybackService) ListBuybackRuns(buybackID uint, limit int) ([]models.BuybackRun, error) {
+	var runs []models.BuybackRun
+	err := s.db.Where("buyback_id = ?", buybackID).Order("created_at DESC, id DESC").Limit(limit).Find(&runs).Error
+	return runs, err
+}
+
+func (s *buybackService) UpdateBuybackStatus(id uint, status models.BuybackStatus) error {
+	return s.db.Model(&models.Buyback{}).Where("id = ?", id).Update("status", status).Error
+}
+
+func (s *buybackService) GetBurnReport(buybackID uint) (*BuybackBurnReport, error) {
+	var runs []models.BuybackRun
+	err := s.db.Where("buyback_id = ? AND status IN ?", buybackID, []models.BuybackRunStatus{models.BuybackRunStatusPending, models.BuybackRunStatusConfirmed}).Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Amounts are stored as decimal strings, they are summed here since they overflow the integer columns of SQL
+	spent, burned := new(big.Int), new(big.Int)
+	report := &BuybackBurnReport{}
+	for _, run := range runs {
+		if run.Status == models.BuybackRunStatusPending {
+			report.PendingRuns++
+			continue
+		}
+		report.ConfirmedRuns++
+		for _, value := range []struct {
+			total  *big.Int
+			amount string
+		}{{spent, run.EthSpent}, {burned, run.TokensBurned}} {
+			if value.amount == "" {
+				continue
+			}
+			amount, ok := new(big.Int).SetString(value.amount, 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid amount %s in run %d", value.amount, run.ID)
+			}
+			value.total.Add(value.total, amount)
+		}
+	}
+	report.TotalEthSpent = spent.String()
+	report.TotalBurned = burned.String()
+	return report, nil
+}
@@ -0,0 +1,385 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// DefaultCacheTTL bounds how long a cached lookup is served. Writes through the cached services invalidate
+// the entries right away, the TTL only matters for writes made by other server instances
+const DefaultCacheTTL = 10 * time.Second
+
+// EnvCacheTTL overrides DefaultCacheTTL, 0 disables the cache
+const EnvCacheTTL = "LAUNCHPAD_CACHE_TTL"
+
+// CacheTTLFromEnv returns the cache TTL of LAUNCHPAD_CACHE_TTL, or DefaultCacheTTL when it is unset or invalid.
+// The cache is disabled in cluster mode: the writes of a replica would only invalidate its own cache and the others
+// would serve the old active chain, default DEX deployment or template until the TTL
+func CacheTTLFromEnv() time.Duration {
+	if ClusterModeFromEnv() {
+		return 0
+	}
+	value := os.Getenv(EnvCacheTTL)
+	if value == "" {
+		return DefaultCacheTTL
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl < 0 {
+		log.Printf("Warning: ignoring invalid %s %q, using %s", EnvCacheTTL, value, DefaultCacheTTL)
+		return DefaultCacheTTL
+	}
+	return ttl
+}
+
+type cacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// ttlCache is a concurrency safe map whose entries expire after the TTL
+type ttlCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[K]cacheEntry[V]
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{ttl: ttl, entries: map[K]cacheEntry[V]{}}
+}
+
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache[K, V]) set(key K, value V) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry[V]{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
+
+func (c *ttlCache[K, V]) delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func (c *ttlCache[K, V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[K]cacheEntry[V]{}
+}
+
+// cachedChainService caches the active chain, the chains by ID and type and the user default chains, looked up by
+// almost every tool call. Every write clears the cache as it can change the active chain
+type cachedChainService struct {
+	ChainService
+	activeChain  *ttlCache[struct{}, models.Chain]
+	chainsByID   *ttlCache[uint, models.Chain]
+	chainsByType *ttlCache[string, models.Chain]
+	// userChains holds a zero chain for the users without a default, so their calls skip the lookup too
+	userChains *ttlCache[string, models.Chain]
+}
+
+// NewCachedChainService wraps the service with a cache of the chain lookups
+func NewCachedChainService(inner ChainService, ttl time.Duration) ChainService {
+	return &cachedChainService{
+		ChainService: inner,
+		activeChain:  newTTLCache[struct{}, models.Chain](ttl),
+		chainsByID:   newTTLCache[uint, models.Chain](ttl),
+		chainsByType: newTTLCache[string, models.Chain](ttl),
+		userChains:   newTTLCache[string, models.Chain](ttl),
+	}
+}
+
+func (s *cachedChainService) invalidate() {
+	s.activeChain.clear()
+	s.chainsByID.clear()
+	s.chainsByType.clear()
+	s.userChains.clear()
+}
+
+// GetActiveChain returns a copy of the cached active chain so callers cannot change the cache
+func (s *cachedChainService) GetActiveChain() (*models.Chain, error) {
+	if chain, ok := s.activeChain.get(struct{}{}); ok {
+		return &chain, nil
+	}
+	chain, err := s.ChainService.GetActiveChain()
+	if err != nil {
+		return nil, err
+	}
+	s.activeChain.set(struct{}{}, *chain)
+	return chain, nil
+}
+
+// ResolveChain resolves the chain of the call from the cached lookups
+func (s *cachedChainService) ResolveChain(ctx context.Context) (*models.Chain, error) {
+	return resolveChain(ctx, s)
+}
+
+func (s *cachedChainService) GetChainByID(chainID uint) (*models.Chain, error) {
+	if chain, ok := s.chainsByID.get(chainID); ok {
+		return &chain, nil
+	}
+	chain, err := s.ChainService.GetChainByID(chainID)
+	if err != nil {
+		return nil, err
+	}
+	s.chainsByID.set(chainID, *chain)
+	return chain, nil
+}
+
+func (s *cachedChainService) GetUserChain(userID string) (*models.Chain, error) {
+	if chain, ok := s.userChains.get(userID); ok {
+		if chain.ID == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return &chain, nil
+	}
+	chain, err := s.ChainService.GetUserChain(userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		s.userChains.set(userID, models.Chain{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.userChains.set(userID, *chain)
+	return chain, nil
+}
+
+func (s *cachedChainService) SetUserChain(userID string, chainID uint) error {
+	defer s.userChains.delete(userID)
+	return s.ChainService.SetUserChain(userID, chainID)
+}
+
+func (s *cachedChainService) GetChainByType(chainType string) (*models.Chain, error) {
+	if chain, ok := s.chainsByType.get(chainType); ok {
+		return &chain, nil
+	}
+	chain, err := s.ChainService.GetChainByType(chainType)
+	if err != nil {
+		return nil, err
+	}
+	s.chainsByType.set(chainType, *chain)
+	return chain, nil
+}
+
+func (s *cachedChainService) CreateChain(chain *models.Chain) error {
+	defer s.invalidate()
+	return s.ChainService.CreateChain(chain)
+}
+
+func (s *cachedChainService) SetActiveChain(chainType string) error {
+	defer s.invalidate()
+	return s.ChainService.SetActiveChain(chainType)
+}
+
+func (s *cachedChainService) SetActiveChainByID(chainID uint) error {
+	defer s.invalidate()
+	return s.ChainService.SetActiveChainByID(chainID)
+}
+
+func (s *cachedChainService) UpdateChainConfig(chainType, rpc, chainID string) error {
+	defer s.invalidate()
+	return s.ChainService.UpdateChainConfig(chainType, rpc, chainID)
+}
+
+func (s *cachedChainService) UpdatePrivateRelayRPC(chainType, relayRPC string) error {
+	defer s.invalidate()
+	return s.ChainService.UpdatePrivateRelayRPC(chainType, relayRPC)
+}
+
+func (s *cachedChainService) UpdateAccountAbstraction(chainType, bundlerRPC, paymasterRPC string) error {
+	defer s.invalidate()
+	return s.ChainService.UpdateAccountAbstraction(chainType, bundlerRPC, paymasterRPC)
+}
+
+// activeUniswapKey identifies a GetActiveUniswapDeployment lookup, user is empty without a user filter
+type activeUniswapKey struct {
+	chainID uint
+	hasUser bool
+	user    string
+}
+
+// cachedUniswapService caches the Uniswap deployment lookups resolving the factory, router and WETH addresses.
+// Every write clears the cache as a deployment is reachable through several keys
+type cachedUniswapService struct {
+	UniswapService
+	byID     *ttlCache[uint, models.UniswapDeployment]
+	byChain  *ttlCache[uint, models.UniswapDeployment]
+	byActive *ttlCache[activeUniswapKey, models.UniswapDeployment]
+}
+
+// NewCachedUniswapService wraps the service with a cache of the deployment lookups
+func NewCachedUniswapService(inner UniswapService, ttl time.Duration) UniswapService {
+	return &cachedUniswapService{
+		UniswapService: inner,
+		byID:           newTTLCache[uint, models.UniswapDeployment](ttl),
+		byChain:        newTTLCache[uint, models.UniswapDeployment](ttl),
+		byActive:       newTTLCache[activeUniswapKey, models.UniswapDeployment](ttl),
+	}
+}
+
+func (s *cachedUniswapService) invalidate() {
+	s.byID.clear()
+	s.byChain.clear()
+	s.byActive.clear()
+}
+
+func (s *cachedUniswapService) GetUniswapDeployment(deploymentID uint) (*models.UniswapDeployment, error) {
+	if deployment, ok := s.byID.get(deploymentID); ok {
+		return &deployment, nil
+	}
+	deployment, err := s.UniswapService.GetUniswapDeployment(deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	s.byID.set(deploymentID, *deployment)
+	return deployment, nil
+}
+
+func (s *cachedUniswapService) GetUniswapDeploymentByChain(chainID uint) (*models.UniswapDeployment, error) {
+	if deployment, ok := s.byChain.get(chainID); ok {
+		return &deployment, nil
+	}
+	deployment, err := s.UniswapService.GetUniswapDeploymentByChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	s.byChain.set(chainID, *deployment)
+	return deployment, nil
+}
+
+func (s *cachedUniswapService) GetActiveUniswapDeployment(userId *string, chain models.Chain) (*models.UniswapDeployment, error) {
+	key := activeUniswapKey{chainID: chain.ID}
+	if userId != nil {
+		key.hasUser = true
+		key.user = *userId
+	}
+	if deployment, ok := s.byActive.get(key); ok {
+		return &deployment, nil
+	}
+	deployment, err := s.UniswapService.GetActiveUniswapDeployment(userId, chain)
+	if err != nil {
+		return nil, err
+	}
+	s.byActive.set(key, *deployment)
+	return deployment, nil
+}
+
+func (s *cachedUniswapService) CreateUniswapDeployment(chainID uint, version string, userId *string) (uint, error) {
+	defer s.invalidate()
+	return s.UniswapService.CreateUniswapDeployment(chainID, version, userId)
+}
+
+func (s *cachedUniswapService) SetDefaultUniswapDeployment(deploymentID uint) error {
+	defer s.invalidate()
+	return s.UniswapService.SetDefaultUniswapDeployment(deploymentID)
+}
+
+func (s *cachedUniswapService) UpdateName(deploymentID uint, name string) error {
+	defer s.invalidate()
+	return s.UniswapService.UpdateName(deploymentID, name)
+}
+
+func (s *cachedUniswapService) UpdateFactoryAddress(deploymentID uint, factoryAddress string) error {
+	defer s.invalidate()
+	return s.UniswapService.UpdateFactoryAddress(deploymentID, factoryAddress)
+}
+
+func (s *cachedUniswapService) UpdateRouterAddress(deploymentID uint, routerAddress string) error {
+	defer s.invalidate()
+	return s.UniswapService.UpdateRouterAddress(deploymentID, routerAddress)
+}
+
+func (s *cachedUniswapService) UpdateWETHAddress(deploymentID uint, wethAddress string) error {
+	defer s.invalidate()
+	return s.UniswapService.UpdateWETHAddress(deploymentID, wethAddress)
+}
+
+func (s *cachedUniswapService) UpdateDeployerAddress(deploymentID uint, deployerAddress string) error {
+	defer s.invalidate()
+	return s.UniswapService.UpdateDeployerAddress(deploymentID, deployerAddress)
+}
+
+func (s *cachedUniswapService) UpdateStatus(deploymentID uint, status models.TransactionStatus) error {
+	defer s.invalidate()
+	return s.UniswapService.UpdateStatus(deploymentID, status)
+}
+
+func (s *cachedUniswapService) DeleteUniswapDeployment(deploymentID uint) error {
+	defer s.invalidate()
+	return s.UniswapService.DeleteUniswapDeployment(deploymentID)
+}
+
+func (s *cachedUniswapService) DeleteUniswapDeployments(deploymentIDs []uint) error {
+	defer s.invalidate()
+	return s.UniswapService.DeleteUniswapDeployments(deploymentIDs)
+}
+
+// cachedTemplateService caches the templates by ID, whose ABI is read by every contract call
+type cachedTemplateService struct {
+	TemplateService
+	byID *ttlCache[uint, models.Template]
+}
+
+// NewCachedTemplateService wraps the service with a cache of the template lookups
+func NewCachedTemplateService(inner TemplateService, ttl time.Duration) TemplateService {
+	return &cachedTemplateService{
+		TemplateService: inner,
+		byID:            newTTLCache[uint, models.Template](ttl),
+	}
+}
+
+func (s *cachedTemplateService) GetTemplateByID(id uint) (*models.Template, error) {
+	if template, ok := s.byID.get(id); ok {
+		return &template, nil
+	}
+	template, err := s.TemplateService.GetTemplateByID(id)
+	if err != nil {
+		return nil, err
+	}
+	s.byID.set(id, *template)
+	return template, nil
+}
+
+func (s *cachedTemplateService) UpdateTemplate(template *models.Template) error {
+	defer s.byID.delete(template.ID)
+	return s.TemplateService.UpdateTemplate(template)
+}
+
+func (s *cachedTemplateService) DeleteTemplate(id uint) error {
+	defer s.byID.delete(id)
+	return s.TemplateService.DeleteTemplate(id)
+}
+
+func (s *cachedTemplateService) DeleteTemplates(ids []uint) (int64, error) {
+	defer func() {
+		for _, id := range ids {
+			s.byID.delete(id)
+		}
+	}()
+	return s.TemplateService.DeleteTemplates(ids)
+}
+
+func (s *cachedTemplateService) SetTemplateDisabled(id uint, disabled bool) error {
+	defer s.byID.delete(id)
+	return s.TemplateService.SetTemplateDisabled(id, disabled)
+}
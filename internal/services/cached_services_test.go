@@ -0,0 +1,139 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newCacheTestDB(t *testing.T) *gorm.DB {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	return dbService.GetDB()
+}
+
+func TestCachedChainServiceInvalidatesOnWrite(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewCachedChainService(NewChainService(db), time.Minute)
+
+	local := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, service.CreateChain(local))
+	chain, err := service.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8545", chain.RPC)
+
+	// Changes bypassing the service are not seen until the entry expires
+	require.NoError(t, db.Model(&models.Chain{}).Where("id = ?", local.ID).Update("rpc", "http://changed:8545").Error)
+	chain, err = service.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8545", chain.RPC)
+
+	// Callers get a copy and cannot change the cached chain
+	chain.RPC = "http://mutated:8545"
+	chain, err = service.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8545", chain.RPC)
+
+	require.NoError(t, service.UpdateChainConfig(string(models.TransactionChainTypeEthereum), "http://updated:8545", "31337"))
+	chain, err = service.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, "http://updated:8545", chain.RPC)
+
+	sepolia := &models.Chain{Name: "Sepolia", RPC: "http://sepolia", NetworkID: "11155111", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, service.CreateChain(sepolia))
+	require.NoError(t, service.SetActiveChainByID(sepolia.ID))
+	chain, err = service.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, sepolia.ID, chain.ID)
+}
+
+func TestCachedChainServiceDisabledWithoutTTL(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewCachedChainService(NewChainService(db), 0)
+
+	local := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, service.CreateChain(local))
+	_, err := service.GetActiveChain()
+	require.NoError(t, err)
+
+	require.NoError(t, db.Model(&models.Chain{}).Where("id = ?", local.ID).Update("rpc", "http://changed:8545").Error)
+	chain, err := service.GetActiveChain()
+	require.NoError(t, err)
+	assert.Equal(t, "http://changed:8545", chain.RPC)
+}
+
+func TestCachedUniswapServiceInvalidatesOnWrite(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewCachedUniswapService(NewUniswapService(db), time.Minute)
+	chain := models.Chain{ID: 1}
+
+	_, err := service.GetActiveUniswapDeployment(nil, chain)
+	require.Error(t, err, "missing deployments are not cached")
+
+	deploymentID, err := service.CreateUniswapDeployment(chain.ID, "v2", nil)
+	require.NoError(t, err)
+	deployment, err := service.GetActiveUniswapDeployment(nil, chain)
+	require.NoError(t, err)
+	assert.Empty(t, deployment.RouterAddress)
+
+	require.NoError(t, service.UpdateRouterAddress(deploymentID, "0x2222"))
+	deployment, err = service.GetActiveUniswapDeployment(nil, chain)
+	require.NoError(t, err)
+	assert.Equal(t, "0x2222", deployment.RouterAddress)
+	deployment, err = service.GetUniswapDeploymentByChain(chain.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "0x2222", deployment.RouterAddress)
+
+	require.NoError(t, service.DeleteUniswapDeployment(deploymentID))
+	_, err = service.GetUniswapDeployment(deploymentID)
+	assert.Error(t, err)
+}
+
+func TestCachedTemplateServiceInvalidatesOnWrite(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewCachedTemplateService(NewTemplateService(db), time.Minute)
+
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, service.CreateTemplate(template))
+	cached, err := service.GetTemplateByID(template.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Token", cached.Name)
+
+	cached.Name = "Renamed"
+	require.NoError(t, service.UpdateTemplate(cached))
+	cached, err = service.GetTemplateByID(template.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", cached.Name)
+
+	require.NoError(t, service.SetTemplateDisabled(template.ID, true))
+	cached, err = service.GetTemplateByID(template.ID)
+	require.NoError(t, err)
+	assert.True(t, cached.Disabled)
+	assert.Error(t, service.SetTemplateDisabled(template.ID+1, true))
+
+	require.NoError(t, service.DeleteTemplate(template.ID))
+	_, err = service.GetTemplateByID(template.ID)
+	assert.Error(t, err)
+}
+
+func TestCacheTTLFromEnv(t *testing.T) {
+	t.Setenv(EnvClusterMode, "")
+	t.Setenv(EnvCacheTTL, "")
+	assert.Equal(t, DefaultCacheTTL, CacheTTLFromEnv())
+	t.Setenv(EnvCacheTTL, "0")
+	assert.Equal(t, time.Duration(0), CacheTTLFromEnv())
+	t.Setenv(EnvCacheTTL, "1m")
+	assert.Equal(t, time.Minute, CacheTTLFromEnv())
+	t.Setenv(EnvCacheTTL, "soon")
+	assert.Equal(t, DefaultCacheTTL, CacheTTLFromEnv())
+
+	// the replicas of a cluster do not see the invalidations of each other
+	t.Setenv(EnvCacheTTL, "1m")
+	t.Setenv(EnvClusterMode, "true")
+	assert.Equal(t, time.Duration(0), CacheTTLFromEnv())
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// BuildDeployTxArgs is the template deployment built by a chain adapter
+type BuildDeployTxArgs struct {
+	Template        *models.Template
+	TemplateValues  models.JSON
+	ContractName    string
+	ConstructorArgs []any
+	Value           string
+	Title           string
+	Description     string
+}
+
+// BuildCallTxArgs is the state-changing call of a deployed template built by a chain adapter
+type BuildCallTxArgs struct {
+	Template        *models.Template
+	ContractAddress string
+	FunctionName    string
+	FunctionArgs    []any
+	Value           string
+	Title           string
+	Description     string
+}
+
+// ChainReceipt is the outcome of a transaction confirmed on chain
+type ChainReceipt struct {
+	Success bool
+	// Status is the receipt status of EVM transactions, the confirmation status of Solana ones
+	Status string
+	// Block is the block number of EVM transactions, the slot of Solana ones
+	Block string
+	// Error is the error of a failed Solana transaction
+	Error any
+}
+
+// ChainAdapter builds and tracks the transactions of one chain type, the tools look it up by the chain type of the active chain
+type ChainAdapter interface {
+	ChainType() models.TransactionChainType
+	// BuildDeployTx renders the template and builds its deployment transaction
+	BuildDeployTx(args BuildDeployTxArgs) (models.TransactionDeployment, error)
+	BuildCallTx(args BuildCallTxArgs) (models.TransactionDeployment, error)
+	// GetBalance returns the native balance of the address
+	GetBalance(rpcURL string, address string) (*utils.BalanceResult, error)
+	// WaitForReceipt polls the chain until the transaction is confirmed or the context is done
+	WaitForReceipt(ctx context.Context, rpcURL string, txHash string) (*ChainReceipt, error)
+}
+
+// ChainAdapters are the chain adapters by chain type
+type ChainAdapters map[models.TransactionChainType]ChainAdapter
+
+// NewChainAdapters returns the adapters of every supported chain type
+func NewChainAdapters(evmService EvmService) ChainAdapters {
+	adapters := ChainAdapters{}
+	for _, adapter := range []ChainAdapter{NewEvmChainAdapter(evmService), NewSolanaChainAdapter()} {
+		adapters[adapter.ChainType()] = adapter
+	}
+	return adapters
+}
+
+// ForChainType returns the adapter of the chain type
+func (a ChainAdapters) ForChainType(chainType models.TransactionChainType) (ChainAdapter, error) {
+	adapter, exists := a[chainType]
+	if !exists {
+		return nil, fmt.Errorf("unsupported chain type: %s", chainType)
+	}
+	return adapter, nil
+}
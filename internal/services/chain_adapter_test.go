@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newChainRPCServer answers every JSON-RPC method with its result in results
+func newChainRPCServer(t *testing.T, results map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		result, exists := results[request.Method]
+		require.True(t, exists, "unexpected method %s", request.Method)
+		_, _ = w.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "result": ` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestChainAdaptersForChainType(t *testing.T) {
+	adapters := NewChainAdapters(NewEvmService())
+
+	for _, chainType := range []models.TransactionChainType{models.TransactionChainTypeEthereum, models.TransactionChainTypeSolana} {
+		adapter, err := adapters.ForChainType(chainType)
+		require.NoError(t, err)
+		assert.Equal(t, chainType, adapter.ChainType())
+	}
+
+	_, err := adapters.ForChainType("bitcoin")
+	assert.EqualError(t, err, "unsupported chain type: bitcoin")
+}
+
+func TestEvmChainAdapterBuildCallTx(t *testing.T) {
+	var abi models.JSON
+	require.NoError(t, json.Unmarshal([]byte(`{"abi": [{"type": "function", "name": "transfer", "stateMutability": "nonpayable",
+		"inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]}]}`), &abi))
+	contractAddress := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+
+	tx, err := NewEvmChainAdapter(NewEvmService()).BuildCallTx(BuildCallTxArgs{
+		Template:        &models.Template{Abi: abi},
+		ContractAddress: contractAddress,
+		FunctionName:    "transfer",
+		FunctionArgs:    []any{"0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "1000"},
+		Title:           "Call transfer",
+		Description:     "Call function transfer",
+	})
+	require.NoError(t, err)
+	// transfer(address,uint256)
+	assert.Equal(t, "0xa9059cbb", tx.Data[:10])
+	assert.Equal(t, "0", tx.Value)
+	assert.Equal(t, models.TransactionTypeRegular, tx.TransactionType)
+	assert.Equal(t, contractAddress, *tx.ContractAddress)
+	assert.NotEmpty(t, *tx.RawContractArguments)
+
+	_, err = NewEvmChainAdapter(NewEvmService()).BuildCallTx(BuildCallTxArgs{Template: &models.Template{}, FunctionName: "transfer"})
+	assert.ErrorContains(t, err, "failed to get template ABI")
+}
+
+func TestEvmChainAdapterWaitForReceipt(t *testing.T) {
+	server := newChainRPCServer(t, map[string]string{
+		"eth_getTransactionReceipt": `{"transactionHash": "0x01", "blockNumber": "0x10", "status": "0x0"}`,
+	})
+
+	receipt, err := NewEvmChainAdapter(NewEvmService()).WaitForReceipt(context.Background(), server.URL, "0x01")
+	require.NoError(t, err)
+	assert.False(t, receipt.Success)
+	assert.Equal(t, "0x0", receipt.Status)
+	assert.Equal(t, "0x10", receipt.Block)
+
+	// Unknown transactions are polled until the context is done
+	pending := newChainRPCServer(t, map[string]string{"eth_getTransactionReceipt": `null`})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = NewEvmChainAdapter(NewEvmService()).WaitForReceipt(ctx, pending.URL, "0x01")
+	assert.ErrorContains(t, err, "transaction not found or not yet mined")
+}
+
+func TestSolanaChainAdapterGetBalance(t *testing.T) {
+	server := newChainRPCServer(t, map[string]string{
+		"getBalance": `{"context": {"slot": 80}, "value": 1500000000}`,
+	})
+
+	balance, err := NewSolanaChainAdapter().GetBalance(server.URL, utils.SolanaNativeMint)
+	require.NoError(t, err)
+	assert.Equal(t, "1500000000", balance.NativeBalance)
+	assert.Equal(t, "1.500000000 SOL", balance.FormattedBalance)
+
+	_, err = NewSolanaChainAdapter().GetBalance(server.URL, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	assert.EqualError(t, err, "invalid address format")
+}
+
+func TestSolanaChainAdapterWaitForReceipt(t *testing.T) {
+	confirmed := newChainRPCServer(t, map[string]string{
+		"getSignatureStatuses": `{"context": {"slot": 80}, "value": [{"slot": 72, "confirmationStatus": "confirmed", "err": null}]}`,
+	})
+	receipt, err := NewSolanaChainAdapter().WaitForReceipt(context.Background(), confirmed.URL, "signature")
+	require.NoError(t, err)
+	assert.True(t, receipt.Success)
+	assert.Equal(t, "72", receipt.Block)
+
+	// A failed transaction is final even before it is confirmed
+	failed := newChainRPCServer(t, map[string]string{
+		"getSignatureStatuses": `{"context": {"slot": 80}, "value": [{"slot": 72, "confirmationStatus": "processed", "err": {"InstructionError": [0, "Custom"]}}]}`,
+	})
+	receipt, err = NewSolanaChainAdapter().WaitForReceipt(context.Background(), failed.URL, "signature")
+	require.NoError(t, err)
+	assert.False(t, receipt.Success)
+	assert.NotNil(t, receipt.Error)
+
+	processed := newChainRPCServer(t, map[string]string{
+		"getSignatureStatuses": `{"context": {"slot": 80}, "value": [{"slot": 72, "confirmationStatus": "processed", "err": null}]}`,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = NewSolanaChainAdapter().WaitForReceipt(ctx, processed.URL, "signature")
+	assert.EqualError(t, err, "transaction is only processed")
+}
+
+func TestSolanaChainAdapterUnsupportedTransactions(t *testing.T) {
+	_, err := NewSolanaChainAdapter().BuildDeployTx(BuildDeployTxArgs{Template: &models.Template{Name: "Counter"}})
+	assert.ErrorIs(t, err, ErrUnsupportedChainOperation)
+
+	_, err = NewSolanaChainAdapter().BuildCallTx(BuildCallTxArgs{FunctionName: "increment"})
+	assert.ErrorIs(t, err, ErrUnsupportedChainOperation)
+}
@@ -1,18 +1,36 @@
 package services
 
 import (
+	"context"
+	"errors"
+
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ChainService handles chain-related operations
 type ChainService interface {
 	CreateChain(chain *models.Chain) error
+	// GetActiveChain returns the global active chain, the fallback of ResolveChain
 	GetActiveChain() (*models.Chain, error)
+	// ResolveChain returns the chain of a tool call: the chain_id argument of the request, then the default chain
+	// of the user, then the active chain
+	ResolveChain(ctx context.Context) (*models.Chain, error)
+	GetChainByID(chainID uint) (*models.Chain, error)
 	GetChainByType(chainType string) (*models.Chain, error)
 	SetActiveChain(chainType string) error
 	SetActiveChainByID(chainID uint) error
+	// GetUserChain returns the default chain of the user, gorm.ErrRecordNotFound when there is none
+	GetUserChain(userID string) (*models.Chain, error)
+	// SetUserChain sets the default chain of the user without changing the active chain of the others
+	SetUserChain(userID string, chainID uint) error
 	UpdateChainConfig(chainType, rpc, chainID string) error
+	// UpdatePrivateRelayRPC sets the private relay used for MEV protected transactions, an empty relay disables it
+	UpdatePrivateRelayRPC(chainType, relayRPC string) error
+	// UpdateAccountAbstraction sets the ERC-4337 bundler and paymaster of gasless transactions, an empty bundler disables them
+	UpdateAccountAbstraction(chainType, bundlerRPC, paymasterRPC string) error
 	ListChains() ([]models.Chain, error)
 }
 
@@ -40,6 +58,37 @@ func (s *chainService) GetActiveChain() (*models.Chain, error) {
 	return &chain, nil
 }
 
+// ResolveChain returns the chain selected for the tool call of the context
+func (s *chainService) ResolveChain(ctx context.Context) (*models.Chain, error) {
+	return resolveChain(ctx, s)
+}
+
+// resolveChain picks the chain of the request with the lookups of the service, so a cached service resolves from its cache
+func resolveChain(ctx context.Context, s ChainService) (*models.Chain, error) {
+	if chainID, ok := utils.GetChainID(ctx); ok {
+		return s.GetChainByID(chainID)
+	}
+	if userID := utils.GetUserID(ctx); userID != "" {
+		chain, err := s.GetUserChain(userID)
+		if err == nil {
+			return chain, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	return s.GetActiveChain()
+}
+
+// GetChainByID returns a chain by its database ID
+func (s *chainService) GetChainByID(chainID uint) (*models.Chain, error) {
+	var chain models.Chain
+	if err := s.db.First(&chain, chainID).Error; err != nil {
+		return nil, err
+	}
+	return &chain, nil
+}
+
 // GetChainByType returns a chain by its chain type
 func (s *chainService) GetChainByType(chainType string) (*models.Chain, error) {
 	var chain models.Chain
@@ -72,6 +121,26 @@ func (s *chainService) SetActiveChainByID(chainID uint) error {
 	return s.db.Model(&models.Chain{}).Where("id = ?", chainID).Update("is_active", true).Error
 }
 
+// GetUserChain returns the default chain of the user, a deleted chain counts as no default
+func (s *chainService) GetUserChain(userID string) (*models.Chain, error) {
+	var userChain models.UserChain
+	if err := s.db.Where("user_id = ?", userID).First(&userChain).Error; err != nil {
+		return nil, err
+	}
+	return s.GetChainByID(userChain.ChainID)
+}
+
+// SetUserChain stores the default chain of the user
+func (s *chainService) SetUserChain(userID string, chainID uint) error {
+	if _, err := s.GetChainByID(chainID); err != nil {
+		return err
+	}
+	return s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"chain_id", "updated_at"}),
+	}).Create(&models.UserChain{UserID: userID, ChainID: chainID}).Error
+}
+
 // UpdateChainConfig updates chain configuration
 func (s *chainService) UpdateChainConfig(chainType, rpc, chainID string) error {
 	return s.db.Model(&models.Chain{}).
@@ -82,6 +151,23 @@ func (s *chainService) UpdateChainConfig(chainType, rpc, chainID string) error {
 		}).Error
 }
 
+// UpdatePrivateRelayRPC updates the private relay of the chain
+func (s *chainService) UpdatePrivateRelayRPC(chainType, relayRPC string) error {
+	return s.db.Model(&models.Chain{}).
+		Where("chain_type = ?", chainType).
+		Update("private_relay_rpc", relayRPC).Error
+}
+
+// UpdateAccountAbstraction updates the bundler and the paymaster of the chain
+func (s *chainService) UpdateAccountAbstraction(chainType, bundlerRPC, paymasterRPC string) error {
+	return s.db.Model(&models.Chain{}).
+		Where("chain_type = ?", chainType).
+		Updates(map[string]interface{}{
+			"bundler_rpc":   bundlerRPC,
+			"paymaster_rpc": paymasterRPC,
+		}).Error
+}
+
 // ListChains returns all chains
 func (s *chainService) ListChains() ([]models.Chain, error) {
 	var chains []models.Chain
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestChainServiceResolveChain(t *testing.T) {
+	for name, newService := range map[string]func(db *gorm.DB) ChainService{
+		"direct": NewChainService,
+		"cached": func(db *gorm.DB) ChainService { return NewCachedChainService(NewChainService(db), time.Minute) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			service := newService(newCacheTestDB(t))
+			local := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+			require.NoError(t, service.CreateChain(local))
+			sepolia := &models.Chain{Name: "Sepolia", RPC: "http://sepolia", NetworkID: "11155111", ChainType: models.TransactionChainTypeEthereum}
+			require.NoError(t, service.CreateChain(sepolia))
+
+			user := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+			_, err := service.GetUserChain("user-1")
+			assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+			chain, err := service.ResolveChain(user)
+			require.NoError(t, err)
+			assert.Equal(t, local.ID, chain.ID)
+
+			require.NoError(t, service.SetUserChain("user-1", sepolia.ID))
+			chain, err = service.ResolveChain(user)
+			require.NoError(t, err)
+			assert.Equal(t, sepolia.ID, chain.ID)
+			// Selecting again updates the default
+			require.NoError(t, service.SetUserChain("user-1", local.ID))
+			chain, err = service.ResolveChain(user)
+			require.NoError(t, err)
+			assert.Equal(t, local.ID, chain.ID)
+			assert.Error(t, service.SetUserChain("user-1", 9))
+
+			// The chain of the request wins over the default
+			chain, err = service.ResolveChain(utils.WithChainID(user, sepolia.ID))
+			require.NoError(t, err)
+			assert.Equal(t, sepolia.ID, chain.ID)
+
+			chain, err = service.ResolveChain(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, local.ID, chain.ID)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EnvClusterMode set to true runs the streamable HTTP server as one replica of a cluster sharing a PostgreSQL
+// database: the MCP sessions and the rate limit counters are stored in the database and the background jobs only
+// run on the elected leader, so the replicas need no sticky sessions
+const EnvClusterMode = "LAUNCHPAD_CLUSTER_MODE"
+
+// ClusterModeFromEnv reports whether LAUNCHPAD_CLUSTER_MODE is enabled
+func ClusterModeFromEnv() bool {
+	return strings.EqualFold(os.Getenv(EnvClusterMode), "true")
+}
+
+// mcpSessionIDPrefix matches the session IDs of the mcp-go stateful session manager
+const mcpSessionIDPrefix = "mcp-session-"
+
+// MCPSessionStore is the session ID manager of the streamable HTTP server in cluster mode, it keeps the sessions in the
+// database so every replica accepts the sessions initialized on the others
+type MCPSessionStore struct {
+	db *gorm.DB
+}
+
+// NewMCPSessionStore creates a session ID manager backed by the mcp_sessions table
+func NewMCPSessionStore(db *gorm.DB) *MCPSessionStore {
+	return &MCPSessionStore{db: db}
+}
+
+// Generate creates the session of an initialize request. mcp-go cannot fail a generation, a session that could not
+// be stored is logged and refused on its next request
+func (s *MCPSessionStore) Generate() string {
+	session := models.MCPSession{ID: mcpSessionIDPrefix + uuid.New().String()}
+	if err := s.db.Create(&session).Error; err != nil {
+		log.Printf("Error storing MCP session %s: %v", session.ID, err)
+	}
+	return session.ID
+}
+
+// Validate accepts the sessions stored by any replica, isTerminated is true for the sessions ended by the client
+func (s *MCPSessionStore) Validate(sessionID string) (isTerminated bool, err error) {
+	var session models.MCPSession
+	if err := s.db.Where("id = ?", sessionID).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, fmt.Errorf("invalid session id: %s", sessionID)
+		}
+		return false, err
+	}
+	return session.TerminatedAt != nil, nil
+}
+
+// Terminate ends the session on every replica
+func (s *MCPSessionStore) Terminate(sessionID string) (isNotAllowed bool, err error) {
+	return false, s.db.Model(&models.MCPSession{}).
+		Where("id = ? AND terminated_at IS NULL", sessionID).
+		Update("terminated_at", time.Now()).Error
+}
+
+// sharedStatePruneInterval bounds how often a SharedStorage deletes the expired entries
+const sharedStatePruneInterval = time.Minute
+
+// SharedStorage stores expiring values in the shared_state_entries table. It implements the storage interface of the
+// fiber middlewares, so the rate limits count the requests of every replica
+type SharedStorage struct {
+	db *gorm.DB
+	// lastPrune is the last time this replica deleted the expired entries
+	mu        sync.Mutex
+	lastPrune time.Time
+}
+
+// NewSharedStorage creates a storage backed by the shared_state_entries table
+func NewSharedStorage(db *gorm.DB) *SharedStorage {
+	return &SharedStorage{db: db}
+}
+
+// Get returns the value of the key, nil when it is missing or expired
+func (s *SharedStorage) Get(key string) ([]byte, error) {
+	if key == "" {
+		return nil, nil
+	}
+	var entry models.SharedStateEntry
+	err := s.db.Where("key = ? AND (expires_at IS NULL OR expires_at > ?)", key, time.Now()).First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry.Value, nil
+}
+
+// Set stores the value of the key for exp, 0 never expires. Empty keys and values are ignored
+func (s *SharedStorage) Set(key string, value []byte, exp time.Duration) error {
+	if key == "" || len(value) == 0 {
+		return nil
+	}
+	entry := models.SharedStateEntry{Key: key, Value: value}
+	if exp > 0 {
+		expiresAt := time.Now().Add(exp)
+		entry.ExpiresAt = &expiresAt
+	}
+	if err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "expires_at"}),
+	}).Create(&entry).Error; err != nil {
+		return err
+	}
+	s.pruneExpired()
+	return nil
+}
+
+// Delete removes the key
+func (s *SharedStorage) Delete(key string) error {
+	if key == "" {
+		return nil
+	}
+	return s.db.Where("key = ?", key).Delete(&models.SharedStateEntry{}).Error
+}
+
+// Reset removes every entry
+func (s *SharedStorage) Reset() error {
+	return s.db.Where("1 = 1").Delete(&models.SharedStateEntry{}).Error
+}
+
+// Close does nothing, the database is closed by its DBService
+func (s *SharedStorage) Close() error {
+	return nil
+}
+
+// pruneExpired deletes the expired entries at most once per sharedStatePruneInterval
+func (s *SharedStorage) pruneExpired() {
+	s.mu.Lock()
+	if time.Since(s.lastPrune) < sharedStatePruneInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastPrune = time.Now()
+	s.mu.Unlock()
+
+	if err := s.db.Where("expires_at <= ?", time.Now()).Delete(&models.SharedStateEntry{}).Error; err != nil {
+		log.Printf("Error pruning the expired shared state entries: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMCPSessionStore(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	// the sessions are shared through the database, a second store stands for another replica
+	store := NewMCPSessionStore(dbService.GetDB())
+	replica := NewMCPSessionStore(dbService.GetDB())
+
+	sessionID := store.Generate()
+	assert.Contains(t, sessionID, mcpSessionIDPrefix)
+
+	terminated, err := replica.Validate(sessionID)
+	require.NoError(t, err)
+	assert.False(t, terminated)
+
+	_, err = replica.Validate("mcp-session-unknown")
+	assert.Error(t, err)
+
+	notAllowed, err := replica.Terminate(sessionID)
+	require.NoError(t, err)
+	assert.False(t, notAllowed)
+
+	terminated, err = store.Validate(sessionID)
+	require.NoError(t, err)
+	assert.True(t, terminated)
+}
+
+func TestSharedStorage(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	storage := NewSharedStorage(db)
+
+	value, err := storage.Get("missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	require.NoError(t, storage.Set("counter", []byte("1"), time.Minute))
+	require.NoError(t, storage.Set("counter", []byte("2"), time.Minute))
+	value, err = storage.Get("counter")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), value)
+
+	require.NoError(t, storage.Delete("counter"))
+	value, err = storage.Get("counter")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	t.Run("expired entries are missing and pruned", func(t *testing.T) {
+		expired := time.Now().Add(-time.Minute)
+		require.NoError(t, db.Create(&models.SharedStateEntry{Key: "expired", Value: []byte("1"), ExpiresAt: &expired}).Error)
+		value, err := storage.Get("expired")
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		storage.lastPrune = time.Time{}
+		require.NoError(t, storage.Set("fresh", []byte("1"), 0))
+		var count int64
+		require.NoError(t, db.Model(&models.SharedStateEntry{}).Where("key = ?", "expired").Count(&count).Error)
+		assert.Zero(t, count)
+
+		value, err = storage.Get("fresh")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("1"), value)
+	})
+
+	require.NoError(t, storage.Reset())
+	var count int64
+	require.NoError(t, db.Model(&models.SharedStateEntry{}).Count(&count).Error)
+	assert.Zero(t, count)
+}
+
+// fakeLeaderLock is the lock shared by the electors of the test replicas, lost simulates a dropped connection
+type fakeLeaderLock struct {
+	holder *LeaderElector
+	lost   bool
+}
+
+// electorLock is the view of an elector on the fake lock
+type electorLock struct {
+	shared  *fakeLeaderLock
+	elector *LeaderElector
+}
+
+func (l *electorLock) TryAcquire(ctx context.Context) (bool, error) {
+	if l.shared.holder != nil {
+		return false, nil
+	}
+	l.shared.holder = l.elector
+	return true, nil
+}
+
+func (l *electorLock) Check(ctx context.Context) error {
+	if l.shared.lost {
+		return errors.New("connection closed")
+	}
+	return nil
+}
+
+func (l *electorLock) Release(ctx context.Context) error {
+	if l.shared.holder == l.elector {
+		l.shared.holder = nil
+	}
+	l.shared.lost = false
+	return nil
+}
+
+func TestLeaderElector(t *testing.T) {
+	shared := &fakeLeaderLock{}
+	newElector := func() (*LeaderElector, *int) {
+		lock := &electorLock{shared: shared}
+		elector := newLeaderElector(lock, time.Minute)
+		lock.elector = elector
+		running := new(int)
+		elector.onElected = func() { *running++ }
+		elector.onDemoted = func() { *running-- }
+		return elector, running
+	}
+	first, firstJobs := newElector()
+	second, secondJobs := newElector()
+
+	first.RunOnce()
+	second.RunOnce()
+	assert.True(t, first.IsLeader())
+	assert.False(t, second.IsLeader())
+	assert.Equal(t, 1, *firstJobs)
+	assert.Equal(t, 0, *secondJobs)
+
+	// a leader losing its connection stops its jobs and frees the lock for the other replicas
+	shared.lost = true
+	first.RunOnce()
+	assert.False(t, first.IsLeader())
+	assert.Equal(t, 0, *firstJobs)
+
+	second.RunOnce()
+	first.RunOnce()
+	assert.True(t, second.IsLeader())
+	assert.False(t, first.IsLeader())
+	assert.Equal(t, 1, *secondJobs)
+
+	t.Run("stopping the leader hands the jobs over", func(t *testing.T) {
+		second.Start(second.onElected, second.onDemoted)
+		second.Stop()
+		assert.False(t, second.IsLeader())
+		assert.Equal(t, 0, *secondJobs)
+
+		first.RunOnce()
+		assert.True(t, first.IsLeader())
+	})
+}
+
+func TestNewLeaderElectorSqlite(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+
+	elector, err := NewLeaderElector(dbService.GetDB(), time.Minute)
+	require.NoError(t, err)
+	elected := make(chan struct{})
+	elector.Start(func() { close(elected) }, nil)
+	t.Cleanup(elector.Stop)
+
+	select {
+	case <-elected:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the only replica was not elected")
+	}
+	assert.True(t, elector.IsLeader())
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvCustomTools is the YAML or JSON file of the contract call tools registered at startup
+const EnvCustomTools = "LAUNCHPAD_CUSTOM_TOOLS"
+
+var customToolNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// CustomToolParameter describes an input of the contract function. A parameter with a fixed Value is not exposed to
+// the clients, the other inputs are tool arguments
+type CustomToolParameter struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Value       string `yaml:"value"`
+}
+
+// CustomToolDefinition is a contract call tool declared in the configuration file: the tool calls Function of the ABI
+// on ContractAddress. View and pure functions return their result, the other functions create a transaction session
+type CustomToolDefinition struct {
+	Name            string `yaml:"name"`
+	Description     string `yaml:"description"`
+	ContractAddress string `yaml:"contract_address"`
+	// ChainID restricts the tool to the chain with this network ID (e.g. "1"), any Ethereum chain is allowed when empty
+	ChainID string `yaml:"chain_id"`
+	// ABI is the JSON ABI of the contract, as a string or as a YAML list, only Function needs to be listed
+	ABI      any    `yaml:"abi"`
+	Function string `yaml:"function"`
+	// Parameters describe the inputs of Function, inputs left out are exposed with their ABI name
+	Parameters []CustomToolParameter `yaml:"parameters"`
+	// Value is the fixed wei sent with the call, payable functions without it take a value argument
+	Value string `yaml:"value"`
+
+	abiString string
+	method    abi.Method
+}
+
+// customToolsFile is the layout of the configuration file
+type customToolsFile struct {
+	Tools []CustomToolDefinition `yaml:"tools"`
+}
+
+// LoadCustomToolsFromEnv loads the tools of LAUNCHPAD_CUSTOM_TOOLS, there are none when it is not set
+func LoadCustomToolsFromEnv() ([]CustomToolDefinition, error) {
+	path := os.Getenv(EnvCustomTools)
+	if path == "" {
+		return nil, nil
+	}
+	return LoadCustomTools(path)
+}
+
+// LoadCustomTools reads and validates the tool definitions of a YAML or JSON file
+func LoadCustomTools(path string) ([]CustomToolDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read custom tools: %w", err)
+	}
+	// JSON is valid YAML, the same decoder reads both
+	var file customToolsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse custom tools %s: %w", path, err)
+	}
+
+	names := map[string]bool{}
+	for i := range file.Tools {
+		definition := &file.Tools[i]
+		if err := definition.validate(); err != nil {
+			return nil, fmt.Errorf("invalid custom tool %q: %w", definition.Name, err)
+		}
+		if names[definition.Name] {
+			return nil, fmt.Errorf("custom tool %q is defined twice", definition.Name)
+		}
+		names[definition.Name] = true
+	}
+	return file.Tools, nil
+}
+
+func (d *CustomToolDefinition) validate() error {
+	if !customToolNamePattern.MatchString(d.Name) {
+		return fmt.Errorf("name must be lowercase letters, digits and underscores")
+	}
+	if !common.IsHexAddress(d.ContractAddress) {
+		return fmt.Errorf("contract_address is not an address: %s", d.ContractAddress)
+	}
+
+	switch value := d.ABI.(type) {
+	case string:
+		d.abiString = value
+	case nil:
+		return fmt.Errorf("abi is required")
+	default:
+		abiJSON, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode abi: %w", err)
+		}
+		d.abiString = string(abiJSON)
+	}
+	parsed, err := abi.JSON(strings.NewReader(d.abiString))
+	if err != nil {
+		return fmt.Errorf("invalid abi: %w", err)
+	}
+	method, ok := parsed.Methods[d.Function]
+	if !ok {
+		return fmt.Errorf("function %q not found in abi", d.Function)
+	}
+	d.method = method
+
+	inputs := map[string]bool{}
+	for _, input := range method.Inputs {
+		if input.Name == "" {
+			return fmt.Errorf("the inputs of %s must be named", d.Function)
+		}
+		inputs[input.Name] = true
+	}
+	for _, parameter := range d.Parameters {
+		if !inputs[parameter.Name] {
+			return fmt.Errorf("parameter %q is not an input of %s", parameter.Name, d.Function)
+		}
+	}
+	if d.Value != "" && !method.IsPayable() {
+		return fmt.Errorf("value is set but %s is not payable", d.Function)
+	}
+	return nil
+}
+
+// ABIString is the JSON ABI of the tool
+func (d CustomToolDefinition) ABIString() string {
+	return d.abiString
+}
+
+// Method is the contract function called by the tool
+func (d CustomToolDefinition) Method() abi.Method {
+	return d.method
+}
+
+// Parameter returns the configuration of an input of the function, the zero value when it is not configured
+func (d CustomToolDefinition) Parameter(name string) CustomToolParameter {
+	for _, parameter := range d.Parameters {
+		if parameter.Name == name {
+			return parameter
+		}
+	}
+	return CustomToolParameter{Name: name}
+}
+
+// IsReadOnly reports whether the function is a view or pure function
+func (d CustomToolDefinition) IsReadOnly() bool {
+	return d.method.StateMutability == "view" || d.method.StateMutability == "pure"
+}
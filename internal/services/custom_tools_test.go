@@ -0,0 +1,84 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customToolsTestABI = `[{"type":"function","name":"stake","stateMutability":"payable","inputs":[{"name":"pool","type":"uint256"},{"name":"referrer","type":"address"}],"outputs":[]}]`
+
+func writeCustomTools(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadCustomTools(t *testing.T) {
+	t.Run("reads YAML with the ABI as a list", func(t *testing.T) {
+		path := writeCustomTools(t, "tools.yaml", `
+tools:
+  - name: stake_eth
+    description: Stake ETH in a pool of the staking protocol
+    contract_address: "0x1111111111111111111111111111111111111111"
+    chain_id: "1"
+    function: stake
+    abi:
+      - type: function
+        name: stake
+        stateMutability: payable
+        inputs:
+          - {name: pool, type: uint256}
+          - {name: referrer, type: address}
+        outputs: []
+    parameters:
+      - name: pool
+        description: ID of the pool
+      - name: referrer
+        value: "0x2222222222222222222222222222222222222222"
+`)
+		definitions, err := LoadCustomTools(path)
+		require.NoError(t, err)
+		require.Len(t, definitions, 1)
+		definition := definitions[0]
+		assert.Equal(t, "stake_eth", definition.Name)
+		assert.Equal(t, "stake", definition.Method().Name)
+		assert.False(t, definition.IsReadOnly())
+		assert.Equal(t, "ID of the pool", definition.Parameter("pool").Description)
+		assert.Equal(t, "0x2222222222222222222222222222222222222222", definition.Parameter("referrer").Value)
+		assert.Contains(t, definition.ABIString(), `"stake"`)
+	})
+
+	t.Run("reads JSON with the ABI as a string", func(t *testing.T) {
+		path := writeCustomTools(t, "tools.json", `{"tools":[{"name":"stake","contract_address":"0x1111111111111111111111111111111111111111","function":"stake","abi":`+strconv.Quote(customToolsTestABI)+`}]}`)
+		definitions, err := LoadCustomTools(path)
+		require.NoError(t, err)
+		require.Len(t, definitions, 1)
+		assert.Len(t, definitions[0].Method().Inputs, 2)
+	})
+
+	t.Run("rejects invalid definitions", func(t *testing.T) {
+		for name, content := range map[string]string{
+			"invalid name":      `{"tools":[{"name":"Stake","contract_address":"0x1111111111111111111111111111111111111111","function":"stake","abi":` + strconv.Quote(customToolsTestABI) + `}]}`,
+			"invalid address":   `{"tools":[{"name":"stake","contract_address":"0x11","function":"stake","abi":` + strconv.Quote(customToolsTestABI) + `}]}`,
+			"unknown function":  `{"tools":[{"name":"stake","contract_address":"0x1111111111111111111111111111111111111111","function":"unstake","abi":` + strconv.Quote(customToolsTestABI) + `}]}`,
+			"unknown parameter": `{"tools":[{"name":"stake","contract_address":"0x1111111111111111111111111111111111111111","function":"stake","abi":` + strconv.Quote(customToolsTestABI) + `,"parameters":[{"name":"amount"}]}]}`,
+			"duplicate name": `{"tools":[{"name":"stake","contract_address":"0x1111111111111111111111111111111111111111","function":"stake","abi":` + strconv.Quote(customToolsTestABI) + `},` +
+				`{"name":"stake","contract_address":"0x1111111111111111111111111111111111111111","function":"stake","abi":` + strconv.Quote(customToolsTestABI) + `}]}`,
+		} {
+			_, err := LoadCustomTools(writeCustomTools(t, "tools.json", content))
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("has no tools without the environment variable", func(t *testing.T) {
+		t.Setenv(EnvCustomTools, "")
+		definitions, err := LoadCustomToolsFromEnv()
+		require.NoError(t, err)
+		assert.Empty(t, definitions)
+	})
+}
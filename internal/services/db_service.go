@@ -6,8 +6,10 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
+	"github.com/rxtech-lab/launchpad-mcp/internal/migrations"
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	_ "github.com/tursodatabase/libsql-client-go/libsql"
 	"gorm.io/driver/postgres"
@@ -19,9 +21,54 @@ import (
 // DBService handles database connection and lifecycle management
 type DBService interface {
 	GetDB() *gorm.DB
+	// GetReadDB returns the read-only replica used by list and report queries, or the primary without a replica.
+	// The replica may lag behind, reads that must see a write just made use GetDB
+	GetReadDB() *gorm.DB
+	// SetConnectionPool applies the connection pool tuning to the underlying connection
+	SetConnectionPool(pool ConnectionPool) error
 	Close() error
 }
 
+// Environment variables read by ConnectionPoolFromEnv
+const (
+	EnvDBMaxOpenConns    = "LAUNCHPAD_DB_MAX_OPEN_CONNS"
+	EnvDBMaxIdleConns    = "LAUNCHPAD_DB_MAX_IDLE_CONNS"
+	EnvDBConnMaxLifetime = "LAUNCHPAD_DB_CONN_MAX_LIFETIME"
+)
+
+// ConnectionPool tunes the connection pool of a database.
+// Zero values keep the database/sql defaults
+type ConnectionPool struct {
+	// MaxOpenConns is the maximum number of open connections, 0 is unlimited
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of idle connections, 0 keeps the default of 2
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum time a connection is reused, 0 reuses connections forever
+	ConnMaxLifetime time.Duration
+}
+
+// ConnectionPoolFromEnv reads the connection pool tuning from the LAUNCHPAD_DB_* environment variables
+func ConnectionPoolFromEnv() (ConnectionPool, error) {
+	var pool ConnectionPool
+	var err error
+	if value := os.Getenv(EnvDBMaxOpenConns); value != "" {
+		if pool.MaxOpenConns, err = strconv.Atoi(value); err != nil || pool.MaxOpenConns < 0 {
+			return pool, fmt.Errorf("invalid %s %q: must be a non-negative number", EnvDBMaxOpenConns, value)
+		}
+	}
+	if value := os.Getenv(EnvDBMaxIdleConns); value != "" {
+		if pool.MaxIdleConns, err = strconv.Atoi(value); err != nil || pool.MaxIdleConns < 0 {
+			return pool, fmt.Errorf("invalid %s %q: must be a non-negative number", EnvDBMaxIdleConns, value)
+		}
+	}
+	if value := os.Getenv(EnvDBConnMaxLifetime); value != "" {
+		if pool.ConnMaxLifetime, err = time.ParseDuration(value); err != nil || pool.ConnMaxLifetime < 0 {
+			return pool, fmt.Errorf("invalid %s %q: must be a non-negative duration such as 30m", EnvDBConnMaxLifetime, value)
+		}
+	}
+	return pool, nil
+}
+
 type dbService struct {
 	db *gorm.DB
 }
@@ -68,7 +115,13 @@ func NewDBServiceFromDB(db *gorm.DB) DBService {
 	return service
 }
 
+// NewPostgresDBService creates a new DBService with a PostgreSQL connection.
+// The schema is managed by the versioned migrations, it must be at the latest version (see --migrate)
 func NewPostgresDBService(dsn string) (DBService, error) {
+	if err := migrations.Check(dsn); err != nil {
+		return nil, err
+	}
+
 	// Configure GORM logger - only log errors and slow queries
 	gormLogger := logger.New(
 		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
@@ -88,12 +141,7 @@ func NewPostgresDBService(dsn string) (DBService, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	service := &dbService{db: db}
-	if err := service.migrate(); err != nil {
-		return nil, fmt.Errorf("failed to migrate database: %w", err)
-	}
-
-	return service, nil
+	return &dbService{db: db}, nil
 }
 
 // NewTursoDBService creates a new DBService with a remote Turso (libSQL) connection.
@@ -157,7 +205,14 @@ func (s *dbService) GetDB() *gorm.DB {
 	return s.db
 }
 
-// migrate runs database migrations
+// GetReadDB returns the primary database, there is no replica
+func (s *dbService) GetReadDB() *gorm.DB {
+	return s.db
+}
+
+// migrate creates the schema of SQLite and Turso databases with AutoMigrate.
+// Postgres schemas are managed by the versioned migrations of the migrations package instead,
+// every model change needs a new migration there
 func (s *dbService) migrate() error {
 	return s.db.AutoMigrate(
 		&models.Chain{},
@@ -165,10 +220,65 @@ func (s *dbService) migrate() error {
 		&models.Deployment{},
 		&models.UniswapDeployment{},
 		&models.LiquidityPool{},
+		&models.PoolSnapshot{},
+		&models.LimitOrder{},
+		&models.RecurringSwap{},
+		&models.RecurringSwapRun{},
+		&models.RoleMember{},
+		&models.RoleSyncState{},
+		&models.PrivateTransaction{},
+		&models.UserOperation{},
+		&models.AddressListChange{},
+		&models.AddressListEntry{},
+		&models.TradingLaunch{},
+		&models.LaunchPolicy{},
 		&models.TransactionSession{},
+		&models.InstalledTemplate{},
+		&models.LaunchGroup{},
+		&models.LaunchGroupBridge{},
+		&models.Project{},
+		&models.ProjectAsset{},
+		&models.SearchDocument{},
+		&models.AuditLog{},
+		&models.IdempotencyKey{},
+		&models.APIKey{},
+		&models.TokenMetadata{},
+		&models.AddressBookEntry{},
+		&models.StakingPool{},
+		&models.Buyback{},
+		&models.BuybackRun{},
+		&models.IndexedEvent{},
+		&models.IndexerCursor{},
+		&models.TokenHolder{},
+		&models.AlertRule{},
+		&models.BondingCurve{},
+		&models.DutchAuction{},
+		&models.PlatformFee{},
+		&models.Referral{},
+		&models.UserChain{},
+		&models.MCPSession{},
+		&models.SharedStateEntry{},
 	)
 }
 
+// SetConnectionPool applies the non-zero settings of the pool
+func (s *dbService) SetConnectionPool(pool ConnectionPool) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	if pool.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(pool.MaxOpenConns)
+	}
+	if pool.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(pool.MaxIdleConns)
+	}
+	if pool.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	}
+	return nil
+}
+
 // Close closes the database connection
 func (s *dbService) Close() error {
 	sqlDB, err := s.db.DB()
@@ -2,6 +2,7 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
 	"github.com/stretchr/testify/suite"
@@ -38,6 +39,35 @@ func (suite *DBServiceTestSuite) TestNewSqliteDBServiceInMemory() {
 	defer db.Close()
 }
 
+func (suite *DBServiceTestSuite) TestSetConnectionPool() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	defer db.Close()
+
+	suite.Require().NoError(db.SetConnectionPool(services.ConnectionPool{MaxOpenConns: 4, MaxIdleConns: 2, ConnMaxLifetime: time.Minute}))
+	sqlDB, err := db.GetDB().DB()
+	suite.Require().NoError(err)
+	suite.Equal(4, sqlDB.Stats().MaxOpenConnections)
+}
+
+func (suite *DBServiceTestSuite) TestConnectionPoolFromEnv() {
+	suite.T().Setenv(services.EnvDBMaxOpenConns, "10")
+	suite.T().Setenv(services.EnvDBMaxIdleConns, "5")
+	suite.T().Setenv(services.EnvDBConnMaxLifetime, "30m")
+	pool, err := services.ConnectionPoolFromEnv()
+	suite.Require().NoError(err)
+	suite.Equal(services.ConnectionPool{MaxOpenConns: 10, MaxIdleConns: 5, ConnMaxLifetime: 30 * time.Minute}, pool)
+
+	suite.T().Setenv(services.EnvDBConnMaxLifetime, "forever")
+	_, err = services.ConnectionPoolFromEnv()
+	suite.ErrorContains(err, services.EnvDBConnMaxLifetime)
+
+	suite.T().Setenv(services.EnvDBConnMaxLifetime, "")
+	suite.T().Setenv(services.EnvDBMaxOpenConns, "-1")
+	_, err = services.ConnectionPoolFromEnv()
+	suite.ErrorContains(err, services.EnvDBMaxOpenConns)
+}
+
 func TestDBServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(DBServiceTestSuite))
 }
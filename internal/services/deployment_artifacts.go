@@ -0,0 +1,180 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// deploymentArtifactsSourceDir is the directory of the Solidity sources of the artifacts, the paths of the template
+// files are relative to the main contract in it
+const deploymentArtifactsSourceDir = "contracts"
+
+// DeploymentArtifacts are the files needed to verify or reproduce a confirmed Ethereum deployment
+type DeploymentArtifacts struct {
+	DeploymentID        uint   `json:"deployment_id"`
+	ContractName        string `json:"contract_name"`
+	ContractAddress     string `json:"contract_address"`
+	ChainID             string `json:"chain_id"`
+	TransactionHash     string `json:"transaction_hash"`
+	CompilerVersion     string `json:"compiler_version,omitempty"`
+	OpenZeppelinVersion string `json:"openzeppelin_version,omitempty"`
+	// Sources maps the paths of the rendered Solidity files to their code, the main contract is MainSource
+	Sources    map[string]string `json:"sources"`
+	MainSource string            `json:"main_source"`
+	Abi        json.RawMessage   `json:"abi"`
+	// CreationData is the data of the deployment transaction, the bytecode followed by the encoded constructor arguments.
+	// It is empty for the deployments added without a signing session
+	CreationData string `json:"creation_data,omitempty"`
+	// Bytecode is the creation bytecode, empty when the constructor arguments of the deployment were not stored
+	Bytecode               string `json:"bytecode,omitempty"`
+	ConstructorArgs        []any  `json:"constructor_args"`
+	EncodedConstructorArgs string `json:"encoded_constructor_args,omitempty"`
+}
+
+// BuildDeploymentArtifacts returns the artifacts of a confirmed deployment with its template. The session is the
+// signing session of the deployment, nil when it has none. The main contract is the code signed in the session, the
+// other template files are rendered again with the template values of the deployment
+func BuildDeploymentArtifacts(deployment *models.Deployment, session *models.TransactionSession) (*DeploymentArtifacts, error) {
+	if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+		return nil, fmt.Errorf("deployment artifacts are only supported on Ethereum, got %s", deployment.Chain.ChainType)
+	}
+	abiString, err := utils.GetAbiString(deployment.Template.Abi)
+	if err != nil {
+		return nil, fmt.Errorf("template does not have ABI information: %w", err)
+	}
+
+	artifacts := &DeploymentArtifacts{
+		DeploymentID:        deployment.ID,
+		ContractName:        deployment.Template.Name,
+		ContractAddress:     deployment.ContractAddress,
+		ChainID:             deployment.Chain.NetworkID,
+		TransactionHash:     deployment.TransactionHash,
+		OpenZeppelinVersion: deployment.Template.OpenZeppelinVersion,
+		Sources:             map[string]string{},
+		MainSource:          path.Join(deploymentArtifactsSourceDir, utils.ToIdentifier(deployment.Template.Name)+".sol"),
+		Abi:                 json.RawMessage(abiString),
+		ConstructorArgs:     deployment.ConstructorArgs,
+	}
+	if artifacts.ConstructorArgs == nil {
+		artifacts.ConstructorArgs = []any{}
+	}
+	if deployment.Template.Report != nil {
+		artifacts.CompilerVersion = deployment.Template.Report.CompilerVersion
+	}
+
+	var deployTx *models.TransactionDeployment
+	if session != nil {
+		for i, tx := range session.TransactionDeployments {
+			if tx.TransactionType == models.TransactionTypeTokenDeployment && tx.ContractCode != nil {
+				deployTx = &session.TransactionDeployments[i]
+				break
+			}
+		}
+	}
+
+	if deployTx != nil {
+		artifacts.Sources[artifacts.MainSource] = *deployTx.ContractCode
+	} else {
+		rendered, err := utils.RenderContractTemplate(deployment.Template.TemplateCode, deployment.TemplateValues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render contract template: %w", err)
+		}
+		artifacts.Sources[artifacts.MainSource] = rendered
+	}
+	renderedFiles, err := utils.RenderTemplateFiles(deployment.Template.Files, deployment.TemplateValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render contract template files: %w", err)
+	}
+	for filePath, code := range renderedFiles {
+		sourcePath := path.Join(deploymentArtifactsSourceDir, filePath)
+		// The paths come from the template, they must stay in the source directory of the archive
+		if !strings.HasPrefix(sourcePath, deploymentArtifactsSourceDir+"/") {
+			return nil, fmt.Errorf("template file %s is outside of the contract directory", filePath)
+		}
+		artifacts.Sources[sourcePath] = code
+	}
+
+	if deployTx == nil {
+		return artifacts, nil
+	}
+	artifacts.CreationData = deployTx.Data
+	if deployment.ConstructorArgs == nil {
+		return artifacts, nil
+	}
+	encodedArgs, err := utils.EncodeContractConstructorArgs(abiString, deployment.ConstructorArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode constructor arguments: %w", err)
+	}
+	encodedHex := hex.EncodeToString(encodedArgs)
+	creationHex := strings.TrimPrefix(deployTx.Data, "0x")
+	// The arguments are only split off when they are the tail of the signed data
+	if strings.HasSuffix(creationHex, encodedHex) {
+		artifacts.Bytecode = "0x" + strings.TrimSuffix(creationHex, encodedHex)
+		artifacts.EncodedConstructorArgs = "0x" + encodedHex
+	}
+	return artifacts, nil
+}
+
+// Zip returns the archive of the artifacts: the sources, abi.json, bytecode.txt, constructor_args.json and
+// artifacts.json holding every field
+func (a *DeploymentArtifacts) Zip() ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+
+	files := map[string][]byte{}
+	for sourcePath, code := range a.Sources {
+		files[sourcePath] = []byte(code)
+	}
+	var prettyAbi bytes.Buffer
+	if err := json.Indent(&prettyAbi, a.Abi, "", "  "); err != nil {
+		return nil, fmt.Errorf("invalid ABI JSON: %w", err)
+	}
+	files["abi.json"] = prettyAbi.Bytes()
+	if a.Bytecode != "" {
+		files["bytecode.txt"] = []byte(a.Bytecode)
+	}
+	constructorArgs, err := json.MarshalIndent(a.ConstructorArgs, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal constructor arguments: %w", err)
+	}
+	files["constructor_args.json"] = constructorArgs
+	artifacts, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal artifacts: %w", err)
+	}
+	files["artifacts.json"] = artifacts
+
+	// Sorted so the archive is stable between downloads
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		file, err := writer.Create(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to the archive: %w", name, err)
+		}
+		if _, err := file.Write(files[name]); err != nil {
+			return nil, fmt.Errorf("failed to write %s to the archive: %w", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close the archive: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Filename returns the name of the archive of the artifacts
+func (a *DeploymentArtifacts) Filename() string {
+	return fmt.Sprintf("%s-%d-artifacts.zip", utils.ToIdentifier(a.ContractName), a.DeploymentID)
+}
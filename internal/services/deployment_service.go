@@ -1,6 +1,8 @@
 package services
 
 import (
+	"time"
+
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"gorm.io/gorm"
 )
@@ -13,6 +15,18 @@ type DeploymentService interface {
 	ListDeploymentsByUser(userID string) ([]models.Deployment, error)
 	UpdateDeploymentStatus(id uint, status models.TransactionStatus, contractAddress string) error
 	UpdateDeploymentStatusWithTxHashBySessionId(sessionId string, status models.TransactionStatus, contractAddress, txHash string) error
+	UpdateDeploymentInterfaces(id uint, interfaces models.JSON) error
+	// RequestSellTest marks the confirmed deployment of the token on the chain for a simulated sell test.
+	// Tokens that were not deployed through the launchpad are ignored.
+	RequestSellTest(chainID uint, contractAddress string) error
+	// ListPendingSellTests returns the deployments waiting for a simulated sell test
+	ListPendingSellTests() ([]models.Deployment, error)
+	UpdateDeploymentSellTest(id uint, status models.SellTestStatus, result models.JSON) error
+	// UpdateDeploymentGasBySessionId records the gas used by the deployment transaction of the session
+	UpdateDeploymentGasBySessionId(sessionId string, gasUsed uint64, gasCost string) error
+	// RecordOwnershipRenounced records that the owner of the contract was renounced, txHash is empty when it was only
+	// read on chain. The first renouncement recorded is kept
+	RecordOwnershipRenounced(id uint, txHash string, renouncedAt time.Time) error
 	DeleteDeployment(id uint) error
 	GetDeploymentByContractAddress(contractAddress string) (*models.Deployment, error)
 	GetDeploymentsByTemplate(templateID uint) ([]models.Deployment, error)
@@ -65,23 +79,19 @@ func (s *deploymentService) ListDeploymentsByUser(userID string) ([]models.Deplo
 	return deployments, err
 }
 
-// UpdateDeploymentStatus updates the status of a deployment
+// UpdateDeploymentStatus moves the deployment to the status, ErrVersionConflict when it already reached another final status
 func (s *deploymentService) UpdateDeploymentStatus(id uint, status models.TransactionStatus, contractAddress string) error {
-	updates := map[string]interface{}{
-		"status": status,
-	}
+	updates := map[string]interface{}{}
 	if contractAddress != "" {
 		updates["contract_address"] = contractAddress
 	}
 
-	return s.db.Model(&models.Deployment{}).Where("id = ?", id).Updates(updates).Error
+	return transitionStatus(s.db, &models.Deployment{}, id, status, updates)
 }
 
-// UpdateDeploymentStatusWithTxHashBySessionId updates the status of a deployment with transaction hash by session ID
+// UpdateDeploymentStatusWithTxHashBySessionId updates the status of the deployments of the session with the transaction hash
 func (s *deploymentService) UpdateDeploymentStatusWithTxHashBySessionId(sessionId string, status models.TransactionStatus, contractAddress, txHash string) error {
-	updates := map[string]interface{}{
-		"status": status,
-	}
+	updates := map[string]interface{}{}
 	if contractAddress != "" {
 		updates["contract_address"] = contractAddress
 	}
@@ -89,10 +99,69 @@ func (s *deploymentService) UpdateDeploymentStatusWithTxHashBySessionId(sessionI
 		updates["transaction_hash"] = txHash
 	}
 
-	return s.db.Model(&models.Deployment{}).Where("session_id = ?", sessionId).Updates(updates).Error
+	var ids []uint
+	if err := s.db.Model(&models.Deployment{}).Where("session_id = ?", sessionId).Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := transitionStatus(s.db, &models.Deployment{}, id, status, updates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateDeploymentInterfaces stores the detected interfaces of a deployment
+func (s *deploymentService) UpdateDeploymentInterfaces(id uint, interfaces models.JSON) error {
+	return s.db.Model(&models.Deployment{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"interfaces":   interfaces,
+		"lock_version": lockVersionIncrement,
+	}).Error
+}
+
+// RequestSellTest marks the confirmed deployment of the token on the chain for a simulated sell test
+func (s *deploymentService) RequestSellTest(chainID uint, contractAddress string) error {
+	return s.db.Model(&models.Deployment{}).
+		Where("chain_id = ? AND LOWER(contract_address) = LOWER(?) AND status = ?", chainID, contractAddress, models.TransactionStatusConfirmed).
+		Updates(map[string]interface{}{
+			"sell_test_status": models.SellTestStatusPending,
+			"lock_version":     lockVersionIncrement,
+		}).Error
+}
+
+// ListPendingSellTests returns the deployments waiting for a simulated sell test
+func (s *deploymentService) ListPendingSellTests() ([]models.Deployment, error) {
+	var deployments []models.Deployment
+	err := s.db.Preload("Chain").Where("sell_test_status = ?", models.SellTestStatusPending).Find(&deployments).Error
+	return deployments, err
+}
+
+// UpdateDeploymentSellTest records the result of a simulated sell test
+func (s *deploymentService) UpdateDeploymentSellTest(id uint, status models.SellTestStatus, result models.JSON) error {
+	return s.db.Model(&models.Deployment{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"sell_test_status": status,
+		"sell_test_result": result,
+		"sell_tested_at":   time.Now(),
+		"lock_version":     lockVersionIncrement,
+	}).Error
+}
+
+// UpdateDeploymentGasBySessionId records the gas used by the deployment transaction of the session
+func (s *deploymentService) UpdateDeploymentGasBySessionId(sessionId string, gasUsed uint64, gasCost string) error {
+	return s.db.Model(&models.Deployment{}).Where("session_id = ?", sessionId).Updates(map[string]interface{}{
+		"gas_used":     gasUsed,
+		"gas_cost":     gasCost,
+		"lock_version": lockVersionIncrement,
+	}).Error
 }
 
 // DeleteDeployment deletes a deployment by its ID
+func (s *deploymentService) RecordOwnershipRenounced(id uint, txHash string, renouncedAt time.Time) error {
+	return s.db.Model(&models.Deployment{}).
+		Where("id = ? AND ownership_renounced_at IS NULL", id).
+		Updates(map[string]any{"ownership_renounced_at": renouncedAt, "ownership_renounce_tx_hash": txHash, "lock_version": lockVersionIncrement}).Error
+}
+
 func (s *deploymentService) DeleteDeployment(id uint) error {
 	return s.db.Delete(&models.Deployment{}, id).Error
 }
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultDutchAuctionPollInterval is how often the monitor reads the state of the tracked Dutch auctions
+const DefaultDutchAuctionPollInterval = 30 * time.Second
+
+// DutchAuctionStateReader reads the state of a Dutch auction contract
+type DutchAuctionStateReader func(rpcURL string, contractAddress string) (*utils.DutchAuctionState, error)
+
+// DutchAuctionMonitor tracks the price, the ETH raised and the tokens sold of the deployed Dutch auctions, marks them
+// ended once sold out or past their end time so they can be settled, and settled once their proceeds are in the pool
+type DutchAuctionMonitor struct {
+	dutchAuctionService DutchAuctionService
+	reader              DutchAuctionStateReader
+	interval            time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewDutchAuctionMonitor(dutchAuctionService DutchAuctionService, reader DutchAuctionStateReader, interval time.Duration) *DutchAuctionMonitor {
+	if interval <= 0 {
+		interval = DefaultDutchAuctionPollInterval
+	}
+	return &DutchAuctionMonitor{
+		dutchAuctionService: dutchAuctionService,
+		reader:              reader,
+		interval:            interval,
+		now:                 time.Now,
+	}
+}
+
+// Start syncs the tracked auctions in the background until Stop is called
+func (m *DutchAuctionMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.SyncTracked()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background monitoring and waits for the current sync to finish
+func (m *DutchAuctionMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// SyncTracked reads the state of every auction not settled yet
+func (m *DutchAuctionMonitor) SyncTracked() {
+	auctions, err := m.dutchAuctionService.ListTrackedDutchAuctions()
+	if err != nil {
+		log.Printf("Error listing tracked Dutch auctions: %v", err)
+		return
+	}
+
+	for _, auction := range auctions {
+		if err := m.sync(auction); err != nil {
+			log.Printf("Error syncing Dutch auction %d: %v", auction.ID, err)
+		}
+	}
+}
+
+func (m *DutchAuctionMonitor) sync(auction models.DutchAuction) error {
+	if auction.ContractAddress == "" {
+		return fmt.Errorf("dutch auction has no contract address")
+	}
+	state, err := m.reader(auction.Chain.RPC, auction.ContractAddress)
+	if err != nil {
+		// the auction keeps its status and is read again on the next poll
+		return err
+	}
+	return m.dutchAuctionService.UpdateAuctionState(auction.ID, state, m.now())
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutchAuctionMonitor(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	service := NewDutchAuctionService(db)
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newAuction := func(contractAddress string) *models.DutchAuction {
+		auction := &models.DutchAuction{
+			ChainID:         chain.ID,
+			DeployerAddress: "0x01",
+			RouterAddress:   "0x02",
+			TotalSupply:     "1000",
+			AuctionSupply:   "500",
+			StartPrice:      "1000",
+			EndPrice:        "100",
+			StartTime:       start,
+			EndTime:         start.Add(time.Hour),
+		}
+		require.NoError(t, service.CreateDutchAuction(auction))
+		require.NoError(t, service.MarkDutchAuctionDeployed(auction.ID, contractAddress))
+		auction.ContractAddress = contractAddress
+		return auction
+	}
+	ending := newAuction("0x0000000000000000000000000000000000000001")
+	unreachable := newAuction("0x0000000000000000000000000000000000000002")
+	pending := &models.DutchAuction{ChainID: chain.ID, DeployerAddress: "0x01", RouterAddress: "0x02", TotalSupply: "1000", AuctionSupply: "500", StartPrice: "1000", EndPrice: "100", StartTime: start, EndTime: start.Add(time.Hour)}
+	require.NoError(t, service.CreateDutchAuction(pending))
+
+	settled := false
+	var reads []string
+	monitor := NewDutchAuctionMonitor(service, func(rpcURL string, contractAddress string) (*utils.DutchAuctionState, error) {
+		reads = append(reads, contractAddress)
+		assert.Equal(t, chain.RPC, rpcURL)
+		if contractAddress == unreachable.ContractAddress {
+			return nil, errors.New("connection refused")
+		}
+		return &utils.DutchAuctionState{
+			CurrentPrice: big.NewInt(100),
+			EthRaised:    big.NewInt(30),
+			TokensSold:   big.NewInt(300),
+			Ended:        true,
+			Settled:      settled,
+			PairAddress:  "0x0000000000000000000000000000000000000003",
+		}, nil
+	}, time.Minute)
+	now := start.Add(2 * time.Hour)
+	monitor.now = func() time.Time { return now }
+
+	monitor.SyncTracked()
+	assert.ElementsMatch(t, []string{ending.ContractAddress, unreachable.ContractAddress}, reads)
+
+	auction, err := service.GetDutchAuction(ending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.DutchAuctionStatusEnded, auction.Status)
+	assert.Equal(t, "100", auction.CurrentPrice)
+	assert.Equal(t, "30", auction.EthRaised)
+	assert.Equal(t, "300", auction.TokensSold)
+	assert.Equal(t, "0x0000000000000000000000000000000000000003", auction.PairAddress)
+	assert.Nil(t, auction.SettledAt)
+
+	// A failed read keeps the auction active for the next poll
+	auction, err = service.GetDutchAuction(unreachable.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.DutchAuctionStatusActive, auction.Status)
+	assert.Nil(t, auction.SyncedAt)
+
+	// Ended auctions are tracked until they are settled
+	settled = true
+	reads = nil
+	monitor.SyncTracked()
+	assert.ElementsMatch(t, []string{ending.ContractAddress, unreachable.ContractAddress}, reads)
+	auction, err = service.GetDutchAuction(ending.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.DutchAuctionStatusSettled, auction.Status)
+	require.NotNil(t, auction.SettledAt)
+	assert.True(t, now.Equal(*auction.SettledAt))
+
+	reads = nil
+	monitor.SyncTracked()
+	assert.Equal(t, []string{unreachable.ContractAddress}, reads)
+}
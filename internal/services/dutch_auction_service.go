@@ -0,0 +1,118 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+type DutchAuctionService interface {
+	CreateDutchAuction(auction *models.DutchAuction) error
+	GetDutchAuction(id uint) (*models.DutchAuction, error)
+	GetDutchAuctionBySessionId(sessionId string) (*models.DutchAuction, error)
+	GetDutchAuctionBySettleSessionId(sessionId string) (*models.DutchAuction, error)
+	// ListTrackedDutchAuctions returns the deployed auctions not settled yet, whose state is tracked
+	ListTrackedDutchAuctions() ([]models.DutchAuction, error)
+	// MarkDutchAuctionDeployed records the confirmed address of the auction and starts tracking it
+	MarkDutchAuctionDeployed(id uint, contractAddress string) error
+	// UpdateAuctionState records the state read from the contract at syncedAt, an ended or settled state moves the
+	// auction to that status
+	UpdateAuctionState(id uint, state *utils.DutchAuctionState, syncedAt time.Time) error
+	// SetSettleSession records the session of settle_dutch_auction
+	SetSettleSession(id uint, sessionId string) error
+	// MarkDutchAuctionSettled records the confirmed settlement of the auction
+	MarkDutchAuctionSettled(id uint, settledAt time.Time) error
+	UpdateDutchAuctionStatus(id uint, status models.DutchAuctionStatus) error
+}
+
+type dutchAuctionService struct {
+	db *gorm.DB
+}
+
+func NewDutchAuctionService(db *gorm.DB) DutchAuctionService {
+	return &dutchAuctionService{db: db}
+}
+
+func (s *dutchAuctionService) CreateDutchAuction(auction *models.DutchAuction) error {
+	if auction.Status == "" {
+		auction.Status = models.DutchAuctionStatusPending
+	}
+	return s.db.Create(auction).Error
+}
+
+func (s *dutchAuctionService) GetDutchAuction(id uint) (*models.DutchAuction, error) {
+	var auction models.DutchAuction
+	err := s.db.Preload("Chain").First(&auction, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+func (s *dutchAuctionService) GetDutchAuctionBySessionId(sessionId string) (*models.DutchAuction, error) {
+	var auction models.DutchAuction
+	err := s.db.Preload("Chain").Where("session_id = ?", sessionId).First(&auction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+func (s *dutchAuctionService) GetDutchAuctionBySettleSessionId(sessionId string) (*models.DutchAuction, error) {
+	var auction models.DutchAuction
+	err := s.db.Preload("Chain").Where("settle_session_id = ?", sessionId).First(&auction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &auction, nil
+}
+
+func (s *dutchAuctionService) ListTrackedDutchAuctions() ([]models.DutchAuction, error) {
+	var auctions []models.DutchAuction
+	err := s.db.Preload("Chain").
+		Where("status IN ?", []models.DutchAuctionStatus{models.DutchAuctionStatusActive, models.DutchAuctionStatusEnded}).
+		Order("id ASC").Find(&auctions).Error
+	return auctions, err
+}
+
+func (s *dutchAuctionService) MarkDutchAuctionDeployed(id uint, contractAddress string) error {
+	return s.db.Model(&models.DutchAuction{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.DutchAuctionStatusActive,
+		"contract_address": contractAddress,
+	}).Error
+}
+
+func (s *dutchAuctionService) UpdateAuctionState(id uint, state *utils.DutchAuctionState, syncedAt time.Time) error {
+	updates := map[string]interface{}{
+		"current_price": state.CurrentPrice.String(),
+		"eth_raised":    state.EthRaised.String(),
+		"tokens_sold":   state.TokensSold.String(),
+		"pair_address":  state.PairAddress,
+		"synced_at":     syncedAt,
+	}
+	switch {
+	case state.Settled:
+		updates["status"] = models.DutchAuctionStatusSettled
+		updates["settled_at"] = syncedAt
+	case state.Ended:
+		updates["status"] = models.DutchAuctionStatusEnded
+	}
+	return s.db.Model(&models.DutchAuction{}).Where("id = ?", id).Updates(updates).Error
+}
+
+func (s *dutchAuctionService) SetSettleSession(id uint, sessionId string) error {
+	return s.db.Model(&models.DutchAuction{}).Where("id = ?", id).Update("settle_session_id", sessionId).Error
+}
+
+func (s *dutchAuctionService) MarkDutchAuctionSettled(id uint, settledAt time.Time) error {
+	return s.db.Model(&models.DutchAuction{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     models.DutchAuctionStatusSettled,
+		"settled_at": settledAt,
+	}).Error
+}
+
+func (s *dutchAuctionService) UpdateDutchAuctionStatus(id uint, status models.DutchAuctionStatus) error {
+	return s.db.Model(&models.DutchAuction{}).Where("id = ?", id).Update("status", status).Error
+}
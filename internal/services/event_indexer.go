@@ -0,0 +1,180 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// DefaultIndexerPollInterval is how often the indexer tails the events of the launched tokens and pools
+	DefaultIndexerPollInterval = 30 * time.Second
+	// DefaultIndexerBlockRange is the number of blocks requested per eth_getLogs call, within the limits of public RPCs
+	DefaultIndexerBlockRange = 2000
+	// indexerMaxRangesPerRun bounds the ranges a contract is backfilled with per run so a deep backfill does not hold up the others
+	indexerMaxRangesPerRun = 10
+)
+
+// EventFetcher returns the events of a contract from fromBlock over at most maxBlocks blocks, the last block of the
+// range and the latest block
+type EventFetcher func(rpcURL, contractAddress string, fromBlock, maxBlocks uint64) ([]models.IndexedEvent, uint64, uint64, error)
+
+// EventIndexer backfills and tails the Transfer events of the confirmed token deployments and the Swap, Mint, Burn
+// and LP Transfer events of the confirmed pools, so holders and volume are read from the database instead of
+// scanning the chain on every request
+type EventIndexer struct {
+	chainService      ChainService
+	deploymentService DeploymentService
+	liquidityService  LiquidityService
+	indexerService    IndexerService
+	fetcher           EventFetcher
+	interval          time.Duration
+	blockRange        uint64
+	// transactionBlock resolves the block a contract is indexed from, overridden in tests
+	transactionBlock func(rpcURL, txHash string) (uint64, error)
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// indexedContract is a contract of the launchpad and the transaction that created it
+type indexedContract struct {
+	address string
+	txHash  string
+}
+
+func NewEventIndexer(chainService ChainService, deploymentService DeploymentService, liquidityService LiquidityService, indexerService IndexerService, fetcher EventFetcher, interval time.Duration) *EventIndexer {
+	if interval <= 0 {
+		interval = DefaultIndexerPollInterval
+	}
+	return &EventIndexer{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		indexerService:    indexerService,
+		fetcher:           fetcher,
+		interval:          interval,
+		blockRange:        DefaultIndexerBlockRange,
+		transactionBlock:  utils.TransactionBlock,
+	}
+}
+
+// Start indexes the contracts in the background until Stop is called
+func (i *EventIndexer) Start() {
+	if i.stop != nil {
+		return
+	}
+	i.stop = make(chan struct{})
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		ticker := time.NewTicker(i.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				i.RunOnce()
+			case <-i.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background indexing and waits for the current run to finish
+func (i *EventIndexer) Stop() {
+	if i.stop == nil {
+		return
+	}
+	close(i.stop)
+	i.wg.Wait()
+	i.stop = nil
+}
+
+// RunOnce indexes the new events of the launchpad contracts on every Ethereum chain
+func (i *EventIndexer) RunOnce() {
+	chains, err := i.chainService.ListChains()
+	if err != nil {
+		log.Printf("Error listing chains to index: %v", err)
+		return
+	}
+
+	for _, chain := range chains {
+		if chain.ChainType != models.TransactionChainTypeEthereum {
+			continue
+		}
+		contracts, err := i.contracts(chain)
+		if err != nil {
+			log.Printf("Error listing contracts to index on chain %d: %v", chain.ID, err)
+			continue
+		}
+		for _, contract := range contracts {
+			if err := i.Sync(&chain, contract.address, contract.txHash); err != nil {
+				log.Printf("Error indexing %s on chain %d: %v", contract.address, chain.ID, err)
+			}
+		}
+	}
+}
+
+// contracts returns the confirmed token deployments and pools of the chain
+func (i *EventIndexer) contracts(chain models.Chain) ([]indexedContract, error) {
+	deployments, err := i.deploymentService.GetDeploymentsByChain(chain.ID)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := i.liquidityService.ListConfirmedLiquidityPoolsByChain(chain.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := []indexedContract{}
+	for _, deployment := range deployments {
+		if deployment.Status == models.TransactionStatusConfirmed && deployment.ContractAddress != "" {
+			contracts = append(contracts, indexedContract{address: deployment.ContractAddress, txHash: deployment.TransactionHash})
+		}
+	}
+	for _, pool := range pools {
+		if pool.PairAddress != "" {
+			contracts = append(contracts, indexedContract{address: pool.PairAddress, txHash: pool.TransactionHash})
+		}
+	}
+	return contracts, nil
+}
+
+// Sync indexes the events of the contract since its last indexed block. A contract indexed for the first time starts
+// at the block of the transaction that created it, or at the genesis block when that block is unknown
+func (i *EventIndexer) Sync(chain *models.Chain, contractAddress, txHash string) error {
+	var fromBlock uint64
+	lastSyncedBlock, synced, err := i.indexerService.GetLastSyncedBlock(chain.ID, contractAddress)
+	if err != nil {
+		return fmt.Errorf("failed to read indexer cursor: %w", err)
+	}
+	if synced {
+		fromBlock = lastSyncedBlock + 1
+	} else if txHash != "" {
+		if block, err := i.transactionBlock(chain.RPC, txHash); err == nil {
+			fromBlock = block
+		}
+	}
+
+	for ranges := 0; ranges < indexerMaxRangesPerRun; ranges++ {
+		events, syncedBlock, latestBlock, err := i.fetcher(chain.RPC, contractAddress, fromBlock, i.blockRange)
+		if err != nil {
+			return err
+		}
+		if err := i.indexerService.ApplyEvents(chain.ID, contractAddress, events, syncedBlock); err != nil {
+			return fmt.Errorf("failed to store events: %w", err)
+		}
+		if syncedBlock >= latestBlock {
+			return nil
+		}
+		fromBlock = syncedBlock + 1
+	}
+	return nil
+}
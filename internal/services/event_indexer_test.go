@@ -0,0 +1,66 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventIndexerSync(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chainService := NewChainService(db)
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	deploymentService := NewDeploymentService(db)
+	require.NoError(t, deploymentService.CreateDeployment(&models.Deployment{ChainID: chain.ID, Status: models.TransactionStatusConfirmed, ContractAddress: indexerToken, TransactionHash: "0xdeploy"}))
+	// Pending deployments are not indexed
+	require.NoError(t, deploymentService.CreateDeployment(&models.Deployment{ChainID: chain.ID, Status: models.TransactionStatusPending}))
+
+	type fetch struct {
+		address string
+		from    uint64
+	}
+	var fetches []fetch
+	fetcher := func(rpcURL, contractAddress string, fromBlock, maxBlocks uint64) ([]models.IndexedEvent, uint64, uint64, error) {
+		fetches = append(fetches, fetch{contractAddress, fromBlock})
+		const latest = 250
+		toBlock := min(fromBlock+maxBlocks-1, latest)
+		events := []models.IndexedEvent{}
+		if fromBlock <= 120 && toBlock >= 120 {
+			events = append(events, models.IndexedEvent{EventType: models.IndexedEventTypeTransfer, BlockNumber: 120, TransactionHash: "0xmint", Sender: EthTokenAddress, Recipient: indexerAlice, Value: "100"})
+		}
+		return events, toBlock, latest, nil
+	}
+
+	indexer := NewEventIndexer(chainService, deploymentService, NewLiquidityService(db), NewIndexerService(db), fetcher, time.Minute)
+	indexer.blockRange = 100
+	indexer.transactionBlock = func(rpcURL, txHash string) (uint64, error) {
+		assert.Equal(t, "0xdeploy", txHash)
+		return 100, nil
+	}
+
+	// The backfill starts at the deployment block and walks the ranges up to the latest block
+	indexer.RunOnce()
+	assert.Equal(t, []fetch{{indexerToken, 100}, {indexerToken, 200}}, fetches)
+
+	indexerService := NewIndexerService(db)
+	block, synced, err := indexerService.GetLastSyncedBlock(chain.ID, indexerToken)
+	require.NoError(t, err)
+	assert.True(t, synced)
+	assert.Equal(t, uint64(250), block)
+	count, err := indexerService.CountHolders(chain.ID, indexerToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// The next run tails from the cursor
+	fetches = nil
+	indexer.RunOnce()
+	assert.Equal(t, []fetch{{indexerToken, 251}}, fetches)
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// receiptPollInterval is how often WaitForReceipt polls the chain for the transaction
+const receiptPollInterval = 2 * time.Second
+
+type evmChainAdapter struct {
+	evmService EvmService
+}
+
+// NewEvmChainAdapter builds the transactions of Ethereum-compatible chains with the EVM service
+func NewEvmChainAdapter(evmService EvmService) ChainAdapter {
+	return &evmChainAdapter{evmService: evmService}
+}
+
+func (a *evmChainAdapter) ChainType() models.TransactionChainType {
+	return models.TransactionChainTypeEthereum
+}
+
+// BuildDeployTx compiles the rendered template and encodes its constructor arguments
+func (a *evmChainAdapter) BuildDeployTx(args BuildDeployTxArgs) (models.TransactionDeployment, error) {
+	renderedContract, err := utils.RenderContractTemplate(args.Template.TemplateCode, args.TemplateValues)
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to render contract template: %w", err)
+	}
+	renderedFiles, err := utils.RenderTemplateFiles(args.Template.Files, args.TemplateValues)
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to render contract template files: %w", err)
+	}
+
+	tx, abiData, err := a.evmService.GetContractDeploymentTransactionWithContractCode(ContractDeploymentWithContractCodeTransactionArgs{
+		ContractCode:        renderedContract,
+		ContractFiles:       renderedFiles,
+		OpenZeppelinVersion: args.Template.OpenZeppelinVersion,
+		ContractName:        args.ContractName,
+		ConstructorArgs:     args.ConstructorArgs,
+		Value:               args.Value,
+		Title:               args.Title,
+		Description:         args.Description,
+		Receiver:            "", // Empty for contract deployment
+		TransactionType:     models.TransactionTypeTokenDeployment,
+	})
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to get contract deployment transaction: %w", err)
+	}
+
+	// The signing page shows the arguments when they can be decoded, the deployment does not depend on them
+	rawContractArguments, _ := utils.EncodeFunctionArgsToStringMap("constructor", args.ConstructorArgs, abiData)
+	tx.ContractCode = &renderedContract
+	tx.RawContractArguments = &rawContractArguments
+	tx.ShowBalanceAfterDeployment = true
+	return tx, nil
+}
+
+// BuildCallTx encodes the call with the ABI of the template
+func (a *evmChainAdapter) BuildCallTx(args BuildCallTxArgs) (models.TransactionDeployment, error) {
+	abiString, err := utils.GetAbiString(args.Template.Abi)
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to get template ABI: %w", err)
+	}
+	value := args.Value
+	if value == "" {
+		value = "0"
+	}
+
+	tx, err := a.evmService.GetContractFunctionCallTransaction(GetContractFunctionCallTransactionArgs{
+		ContractAddress: args.ContractAddress,
+		FunctionName:    args.FunctionName,
+		FunctionArgs:    args.FunctionArgs,
+		Abi:             abiString,
+		Value:           value,
+		Title:           args.Title,
+		Description:     args.Description,
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to create function call transaction: %w", err)
+	}
+
+	functionArgs, err := utils.EncodeFunctionArgsToStringMapWithStringABI(args.FunctionName, args.FunctionArgs, abiString)
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	tx.RawContractArguments = &functionArgs
+	tx.ContractAddress = &args.ContractAddress
+	return tx, nil
+}
+
+func (a *evmChainAdapter) GetBalance(rpcURL string, address string) (*utils.BalanceResult, error) {
+	return utils.QueryNativeBalance(rpcURL, address, string(models.TransactionChainTypeEthereum))
+}
+
+// WaitForReceipt polls eth_getTransactionReceipt until the transaction is mined
+func (a *evmChainAdapter) WaitForReceipt(ctx context.Context, rpcURL string, txHash string) (*ChainReceipt, error) {
+	client := utils.NewRPCClient(rpcURL)
+	return pollReceipt(ctx, func() (*ChainReceipt, error) {
+		success, receipt, err := client.VerifyTransactionSuccess(txHash)
+		if err != nil {
+			return nil, err
+		}
+		return &ChainReceipt{Success: success, Status: receipt.Status, Block: receipt.BlockNumber}, nil
+	})
+}
+
+// pollReceipt calls getReceipt until it returns a receipt, the last error is returned when the context is done
+func pollReceipt(ctx context.Context, getReceipt func() (*ChainReceipt, error)) (*ChainReceipt, error) {
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := getReceipt()
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				err = ctx.Err()
+			}
+			return nil, err
+		case <-ticker.C:
+		}
+	}
+}
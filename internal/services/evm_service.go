@@ -53,7 +53,7 @@ func (s *evmService) GetContractDeploymentTransactionWithContractCode(args Contr
 		return models.TransactionDeployment{}, abi.ABI{}, err
 	}
 
-	txData, abiData, err := s.getContractDeploymentTransactionData(args.ContractName, args.ConstructorArgs, args.ContractCode)
+	txData, abiData, err := s.getContractDeploymentTransactionData(args.ContractName, args.ConstructorArgs, args.ContractCode, args.ContractFiles, args.OpenZeppelinVersion)
 	if err != nil {
 		return models.TransactionDeployment{}, abi.ABI{}, err
 	}
@@ -145,8 +145,8 @@ func (s *evmService) getContractDeploymentTransactionDataWithBytecodeAndAbi(abiS
 	return txData, parsedABI, nil
 }
 
-func (s *evmService) getContractDeploymentTransactionData(contractName string, constructorArgs []any, contractCode string) (string, abi.ABI, error) {
-	compilationResult, err := utils.CompileSolidity(constants.SolidityCompilerVersion, contractCode)
+func (s *evmService) getContractDeploymentTransactionData(contractName string, constructorArgs []any, contractCode string, contractFiles map[string]string, openZeppelinVersion string) (string, abi.ABI, error) {
+	compilationResult, err := utils.CompileSolidityFiles(constants.SolidityCompilerVersion, contractCode, contractFiles, openZeppelinVersion)
 	if err != nil {
 		return "", abi.ABI{}, err
 	}
@@ -6,11 +6,14 @@ type ContractDeploymentWithContractCodeTransactionArgs struct {
 	ContractName    string                 `validate:"required"`
 	ConstructorArgs []any                  // Constructor arguments can be empty
 	ContractCode    string                 `validate:"required"`
+	ContractFiles   map[string]string      // Optional libraries and interfaces imported by the contract code
 	Receiver        string                 `validate:"omitempty,eth_addr"` // Optional receiver address
 	Value           string                 `validate:"omitempty,number"`   // Optional value, defaults to "0"
 	Title           string                 `validate:"required"`
 	Description     string                 `validate:"required"`
 	TransactionType models.TransactionType `validate:"required"`
+	// OpenZeppelinVersion is the vendored OpenZeppelin release of the imports, the default submodule when empty
+	OpenZeppelinVersion string
 }
 
 type ContractDeploymentWithBytecodeAndAbiTransactionArgs struct {
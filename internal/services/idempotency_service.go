@@ -0,0 +1,90 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// DefaultIdempotencyKeyTTL is how long the result of a call is replayed for retries with its key
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+	// idempotencyInProgressTTL bounds how long a call in progress holds its key, so the key of a crashed call is freed
+	idempotencyInProgressTTL = 10 * time.Minute
+)
+
+var (
+	// ErrIdempotencyKeyInProgress is returned while the first call with the key has not finished
+	ErrIdempotencyKeyInProgress = errors.New("a call with this idempotency_key is still in progress, retry later")
+	// ErrIdempotencyKeyReused is returned when the key was used by a call with other arguments
+	ErrIdempotencyKeyReused = errors.New("the idempotency_key was already used with different arguments")
+)
+
+type IdempotencyService interface {
+	// Reserve claims the key of the scope and tool for a call with the arguments hash.
+	// It returns the record of the key and whether the call must run, a completed earlier call returns its record with false
+	Reserve(scope, toolName, key, argumentsHash string) (*models.IdempotencyKey, bool, error)
+	// Complete stores the JSON result of the call, replayed until the key expires
+	Complete(id uint, result string) error
+	// Release frees the key of a failed call so it can be retried
+	Release(id uint) error
+}
+
+type idempotencyService struct {
+	db *gorm.DB
+	// now is overridden in tests
+	now func() time.Time
+}
+
+func NewIdempotencyService(db *gorm.DB) IdempotencyService {
+	return &idempotencyService{db: db, now: time.Now}
+}
+
+func (s *idempotencyService) Reserve(scope, toolName, key, argumentsHash string) (*models.IdempotencyKey, bool, error) {
+	now := s.now()
+	// Expired keys, completed or abandoned, can be used again
+	if err := s.db.Where("expires_at < ?", now).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		return nil, false, err
+	}
+
+	record := &models.IdempotencyKey{
+		Scope:         scope,
+		ToolName:      toolName,
+		Key:           key,
+		ArgumentsHash: argumentsHash,
+		ExpiresAt:     now.Add(idempotencyInProgressTTL),
+	}
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+	if result.RowsAffected == 1 {
+		return record, true, nil
+	}
+
+	var existing models.IdempotencyKey
+	if err := s.db.Where("scope = ? AND tool_name = ? AND key = ?", scope, toolName, key).First(&existing).Error; err != nil {
+		return nil, false, err
+	}
+	if existing.ArgumentsHash != argumentsHash {
+		return nil, false, ErrIdempotencyKeyReused
+	}
+	if existing.Result == "" {
+		return nil, false, ErrIdempotencyKeyInProgress
+	}
+	return &existing, false, nil
+}
+
+func (s *idempotencyService) Complete(id uint, result string) error {
+	return s.db.Model(&models.IdempotencyKey{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"result":     result,
+		"expires_at": s.now().Add(DefaultIdempotencyKeyTTL),
+	}).Error
+}
+
+func (s *idempotencyService) Release(id uint) error {
+	return s.db.Delete(&models.IdempotencyKey{}, id).Error
+}
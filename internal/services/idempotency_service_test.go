@@ -0,0 +1,64 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyReserve(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	service := NewIdempotencyService(dbService.GetDB()).(*idempotencyService)
+	now := time.Now()
+	service.now = func() time.Time { return now }
+
+	record, run, err := service.Reserve("", "launch", "key-1", "hash-1")
+	require.NoError(t, err)
+	assert.True(t, run)
+
+	// The first call is still running
+	_, _, err = service.Reserve("", "launch", "key-1", "hash-1")
+	assert.ErrorIs(t, err, ErrIdempotencyKeyInProgress)
+
+	require.NoError(t, service.Complete(record.ID, `{"content":[]}`))
+	replayed, run, err := service.Reserve("", "launch", "key-1", "hash-1")
+	require.NoError(t, err)
+	assert.False(t, run)
+	assert.Equal(t, `{"content":[]}`, replayed.Result)
+
+	// Other arguments, tools and users do not share the key
+	_, _, err = service.Reserve("", "launch", "key-1", "hash-2")
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	_, run, err = service.Reserve("", "swap_tokens", "key-1", "hash-1")
+	require.NoError(t, err)
+	assert.True(t, run)
+	_, run, err = service.Reserve("user-1", "launch", "key-1", "hash-1")
+	require.NoError(t, err)
+	assert.True(t, run)
+
+	// Expired keys run again
+	now = now.Add(DefaultIdempotencyKeyTTL + time.Minute)
+	_, run, err = service.Reserve("", "launch", "key-1", "hash-2")
+	require.NoError(t, err)
+	assert.True(t, run)
+}
+
+func TestIdempotencyRelease(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	service := NewIdempotencyService(dbService.GetDB())
+
+	record, run, err := service.Reserve("", "launch", "key-1", "hash-1")
+	require.NoError(t, err)
+	require.True(t, run)
+	require.NoError(t, service.Release(record.ID))
+
+	_, run, err = service.Reserve("", "launch", "key-1", "hash-1")
+	require.NoError(t, err)
+	assert.True(t, run)
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SwapVolume sums the indexed Swap events of a pair
+type SwapVolume struct {
+	Swaps      int    `json:"swaps"`
+	Amount0In  string `json:"amount0_in"`
+	Amount1In  string `json:"amount1_in"`
+	Amount0Out string `json:"amount0_out"`
+	Amount1Out string `json:"amount1_out"`
+}
+
+type IndexerService interface {
+	// GetLastSyncedBlock returns the last indexed block of the contract, ok is false when it was never indexed
+	GetLastSyncedBlock(chainID uint, contractAddress string) (block uint64, ok bool, err error)
+	// ApplyEvents stores the events of the contract in chain order, replays its transfers into the holder balances and
+	// marks it as indexed up to syncedBlock. Events that are already stored are skipped
+	ApplyEvents(chainID uint, contractAddress string, events []models.IndexedEvent, syncedBlock uint64) error
+	// ListEvents returns the indexed events of the contract in chain order, of every type when eventType is empty
+	ListEvents(chainID uint, contractAddress string, eventType models.IndexedEventType) ([]models.IndexedEvent, error)
+	// CountHolders returns the number of addresses with a positive balance of the token
+	CountHolders(chainID uint, tokenAddress string) (int64, error)
+	// ListHolders returns the holders of the token, largest balance first, every holder when limit is not positive
+	ListHolders(chainID uint, tokenAddress string, limit int) ([]models.TokenHolder, error)
+	// GetSwapVolume sums the swaps of the pair in blocks timed at or after since, every swap when since is zero
+	GetSwapVolume(chainID uint, pairAddress string, since time.Time) (*SwapVolume, error)
+}
+
+type indexerService struct {
+	db *gorm.DB
+}
+
+func NewIndexerService(db *gorm.DB) IndexerService {
+	return &indexerService{db: db}
+}
+
+// indexedAddress is the checksum form the indexer stores addresses in
+func indexedAddress(address string) string {
+	return common.HexToAddress(address).Hex()
+}
+
+func (s *indexerService) GetLastSyncedBlock(chainID uint, contractAddress string) (uint64, bool, error) {
+	var cursor models.IndexerCursor
+	err := s.db.First(&cursor, "chain_id = ? AND contract_address = ?", chainID, indexedAddress(contractAddress)).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return cursor.LastSyncedBlock, true, nil
+}
+
+func (s *indexerService) ApplyEvents(chainID uint, contractAddress string, events []models.IndexedEvent, syncedBlock uint64) error {
+	contractAddress = indexedAddress(contractAddress)
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, event := range events {
+			event.ID = 0
+			event.ChainID = chainID
+			event.ContractAddress = contractAddress
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&event)
+			if result.Error != nil {
+				return result.Error
+			}
+			// A replayed range must not move the balances twice
+			if result.RowsAffected == 0 || event.EventType != models.IndexedEventTypeTransfer {
+				continue
+			}
+
+			value, ok := new(big.Int).SetString(event.Value, 10)
+			if !ok {
+				return fmt.Errorf("invalid transfer value %s", event.Value)
+			}
+			if err := updateHolderBalance(tx, chainID, contractAddress, event.Sender, new(big.Int).Neg(value)); err != nil {
+				return err
+			}
+			if err := updateHolderBalance(tx, chainID, contractAddress, event.Recipient, value); err != nil {
+				return err
+			}
+		}
+
+		cursor := models.IndexerCursor{ChainID: chainID, ContractAddress: contractAddress, LastSyncedBlock: syncedBlock}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "chain_id"}, {Name: "contract_address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_synced_block", "updated_at"}),
+		}).Create(&cursor).Error
+	})
+}
+
+// updateHolderBalance adds delta to the balance of the holder and removes holders left without a balance.
+// The zero address is the mint and burn counterparty and is not a holder
+func updateHolderBalance(tx *gorm.DB, chainID uint, tokenAddress, holderAddress string, delta *big.Int) error {
+	holderAddress = indexedAddress(holderAddress)
+	if holderAddress == (common.Address{}).Hex() {
+		return nil
+	}
+
+	var holder models.TokenHolder
+	err := tx.First(&holder, "chain_id = ? AND token_address = ? AND holder_address = ?", chainID, tokenAddress, holderAddress).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	balance, ok := new(big.Int).SetString(holder.Balance, 10)
+	if !ok {
+		balance = new(big.Int)
+	}
+	balance.Add(balance, delta)
+
+	if balance.Sign() <= 0 {
+		if holder.ID == 0 {
+			return nil
+		}
+		return tx.Delete(&holder).Error
+	}
+	holder.ChainID = chainID
+	holder.TokenAddress = tokenAddress
+	holder.HolderAddress = holderAddress
+	holder.Balance = balance.String()
+	return tx.Save(&holder).Error
+}
+
+func (s *indexerService) ListEvents(chainID uint, contractAddress string, eventType models.IndexedEventType) ([]models.IndexedEvent, error) {
+	var events []models.IndexedEvent
+	query := s.db.Where("chain_id = ? AND contract_address = ?", chainID, indexedAddress(contractAddress))
+	if eventType != "" {
+		query = query.Where("event_type = ?", eventType)
+	}
+	err := query.Order("block_number ASC, log_index ASC").Find(&events).Error
+	return events, err
+}
+
+func (s *indexerService) CountHolders(chainID uint, tokenAddress string) (int64, error) {
+	var count int64
+	err := s.db.Model(&models.TokenHolder{}).Where("chain_id = ? AND token_address = ?", chainID, indexedAddress(tokenAddress)).Count(&count).Error
+	return count, err
+}
+
+func (s *indexerService) ListHolders(chainID uint, tokenAddress string, limit int) ([]models.TokenHolder, error) {
+	var holders []models.TokenHolder
+	err := s.db.Where("chain_id = ? AND token_address = ?", chainID, indexedAddress(tokenAddress)).Find(&holders).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Balances are decimal strings, they are compared as numbers here rather than in SQL
+	balances := make(map[uint]*big.Int, len(holders))
+	for _, holder := range holders {
+		balance, _ := new(big.Int).SetString(holder.Balance, 10)
+		balances[holder.ID] = balance
+	}
+	sort.SliceStable(holders, func(i, j int) bool {
+		if cmp := balances[holders[i].ID].Cmp(balances[holders[j].ID]); cmp != 0 {
+			return cmp > 0
+		}
+		return holders[i].HolderAddress < holders[j].HolderAddress
+	})
+
+	if limit > 0 && len(holders) > limit {
+		holders = holders[:limit]
+	}
+	return holders, nil
+}
+
+func (s *indexerService) GetSwapVolume(chainID uint, pairAddress string, since time.Time) (*SwapVolume, error) {
+	query := s.db.Where("chain_id = ? AND contract_address = ? AND event_type = ?", chainID, indexedAddress(pairAddress), models.IndexedEventTypeSwap)
+	if !since.IsZero() {
+		query = query.Where("block_time >= ?", since)
+	}
+	var swaps []models.IndexedEvent
+	if err := query.Find(&swaps).Error; err != nil {
+		return nil, err
+	}
+
+	totals := [4]*big.Int{new(big.Int), new(big.Int), new(big.Int), new(big.Int)}
+	for _, swap := range swaps {
+		for i, amount := range []string{swap.Amount0In, swap.Amount1In, swap.Amount0Out, swap.Amount1Out} {
+			if value, ok := new(big.Int).SetString(amount, 10); ok {
+				totals[i].Add(totals[i], value)
+			}
+		}
+	}
+	return &SwapVolume{
+		Swaps:      len(swaps),
+		Amount0In:  totals[0].String(),
+		Amount1In:  totals[1].String(),
+		Amount0Out: totals[2].String(),
+		Amount1Out: totals[3].String(),
+	}, nil
+}
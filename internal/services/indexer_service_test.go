@@ -0,0 +1,80 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	indexerToken = "0x1111111111111111111111111111111111111111"
+	indexerPair  = "0x3333333333333333333333333333333333333333"
+	indexerAlice = "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+	indexerBob   = "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"
+)
+
+func TestIndexerServiceApplyEvents(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	service := NewIndexerService(dbService.GetDB())
+
+	_, synced, err := service.GetLastSyncedBlock(1, indexerToken)
+	require.NoError(t, err)
+	assert.False(t, synced)
+
+	transfers := []models.IndexedEvent{
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 1, TransactionHash: "0x01", Sender: EthTokenAddress, Recipient: indexerAlice, Value: "100"},
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 2, TransactionHash: "0x02", Sender: indexerAlice, Recipient: indexerBob, Value: "30"},
+	}
+	require.NoError(t, service.ApplyEvents(1, indexerToken, transfers, 5))
+	// Replaying the same range does not move the balances twice
+	require.NoError(t, service.ApplyEvents(1, indexerToken, transfers, 5))
+
+	block, synced, err := service.GetLastSyncedBlock(1, indexerToken)
+	require.NoError(t, err)
+	assert.True(t, synced)
+	assert.Equal(t, uint64(5), block)
+
+	count, err := service.CountHolders(1, indexerToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	holders, err := service.ListHolders(1, indexerToken, 0)
+	require.NoError(t, err)
+	require.Len(t, holders, 2)
+	assert.Equal(t, indexerAlice, holders[0].HolderAddress)
+	assert.Equal(t, "70", holders[0].Balance)
+	assert.Equal(t, "30", holders[1].Balance)
+
+	// Bob sends everything back and is no longer a holder
+	require.NoError(t, service.ApplyEvents(1, indexerToken, []models.IndexedEvent{
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 6, TransactionHash: "0x03", Sender: indexerBob, Recipient: indexerAlice, Value: "30"},
+	}, 6))
+	count, err = service.CountHolders(1, indexerToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	events, err := service.ListEvents(1, indexerToken, models.IndexedEventTypeTransfer)
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	early := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(48 * time.Hour)
+	require.NoError(t, service.ApplyEvents(1, indexerPair, []models.IndexedEvent{
+		{EventType: models.IndexedEventTypeSwap, BlockNumber: 3, BlockTime: &early, TransactionHash: "0x04", Amount0In: "0", Amount1In: "10", Amount0Out: "90", Amount1Out: "0"},
+		{EventType: models.IndexedEventTypeSwap, BlockNumber: 4, BlockTime: &late, TransactionHash: "0x05", Amount0In: "20", Amount1In: "0", Amount0Out: "0", Amount1Out: "2"},
+		{EventType: models.IndexedEventTypeMint, BlockNumber: 2, TransactionHash: "0x06", Amount0In: "1000", Amount1In: "100"},
+	}, 4))
+
+	volume, err := service.GetSwapVolume(1, indexerPair, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, &SwapVolume{Swaps: 2, Amount0In: "20", Amount1In: "10", Amount0Out: "90", Amount1Out: "2"}, volume)
+
+	volume, err = service.GetSwapVolume(1, indexerPair, early.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, volume.Swaps)
+	assert.Equal(t, "20", volume.Amount0In)
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvJupiterAPIURL is the base URL of the Jupiter swap API routing the Solana swaps
+	EnvJupiterAPIURL = "LAUNCHPAD_JUPITER_API_URL"
+	// DefaultJupiterAPIURL is the public Jupiter swap API
+	DefaultJupiterAPIURL = "https://lite-api.jup.ag/swap/v1"
+	// JupiterAggregatorProgram is the Jupiter aggregator v6 program the swap transactions call
+	JupiterAggregatorProgram = "JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4"
+)
+
+const (
+	JupiterSwapModeExactIn  = "ExactIn"
+	JupiterSwapModeExactOut = "ExactOut"
+)
+
+// JupiterQuoteRequest is the swap quoted by the Jupiter aggregator
+type JupiterQuoteRequest struct {
+	InputMint  string
+	OutputMint string
+	// Amount is the input amount with ExactIn and the output amount with ExactOut, in the smallest unit of the mint
+	Amount      string
+	SlippageBps int
+	SwapMode    string
+}
+
+// JupiterRoutePlanStep is one AMM of the route quoted by Jupiter
+type JupiterRoutePlanStep struct {
+	SwapInfo struct {
+		AmmKey     string `json:"ammKey"`
+		Label      string `json:"label"`
+		InputMint  string `json:"inputMint"`
+		OutputMint string `json:"outputMint"`
+		InAmount   string `json:"inAmount"`
+		OutAmount  string `json:"outAmount"`
+	} `json:"swapInfo"`
+	Percent int `json:"percent"`
+}
+
+// JupiterQuote is the quote response of Jupiter, Raw is sent back as is to build the swap transaction
+type JupiterQuote struct {
+	InputMint            string                 `json:"inputMint"`
+	InAmount             string                 `json:"inAmount"`
+	OutputMint           string                 `json:"outputMint"`
+	OutAmount            string                 `json:"outAmount"`
+	OtherAmountThreshold string                 `json:"otherAmountThreshold"`
+	SwapMode             string                 `json:"swapMode"`
+	SlippageBps          int                    `json:"slippageBps"`
+	PriceImpactPct       string                 `json:"priceImpactPct"`
+	RoutePlan            []JupiterRoutePlanStep `json:"routePlan"`
+	Raw                  json.RawMessage        `json:"-"`
+}
+
+// PriceImpact returns the price impact of the quote in percent
+func (q *JupiterQuote) PriceImpact() float64 {
+	impact, err := strconv.ParseFloat(q.PriceImpactPct, 64)
+	if err != nil {
+		return 0
+	}
+	return impact * 100
+}
+
+// JupiterSwapTransaction is the unsigned swap transaction built by Jupiter for the user
+type JupiterSwapTransaction struct {
+	// SwapTransaction is the base64 serialized versioned transaction for the wallet to sign
+	SwapTransaction           string `json:"swapTransaction"`
+	LastValidBlockHeight      uint64 `json:"lastValidBlockHeight"`
+	PrioritizationFeeLamports uint64 `json:"prioritizationFeeLamports"`
+}
+
+// JupiterService quotes the Solana swaps and builds their transactions through the Jupiter aggregator
+type JupiterService interface {
+	GetQuote(ctx context.Context, request JupiterQuoteRequest) (*JupiterQuote, error)
+	// GetSwapTransaction builds the swap transaction of the quote signed by userPublicKey, SOL is wrapped and unwrapped
+	GetSwapTransaction(ctx context.Context, quote *JupiterQuote, userPublicKey string) (*JupiterSwapTransaction, error)
+}
+
+type jupiterService struct {
+	client *http.Client
+	apiURL string
+}
+
+func NewJupiterService(client *http.Client, apiURL string) JupiterService {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if apiURL == "" {
+		apiURL = DefaultJupiterAPIURL
+	}
+	return &jupiterService{client: client, apiURL: strings.TrimSuffix(apiURL, "/")}
+}
+
+// NewJupiterServiceFromEnv routes through LAUNCHPAD_JUPITER_API_URL, the public Jupiter API when it is not set
+func NewJupiterServiceFromEnv() JupiterService {
+	return NewJupiterService(nil, os.Getenv(EnvJupiterAPIURL))
+}
+
+func (s *jupiterService) GetQuote(ctx context.Context, request JupiterQuoteRequest) (*JupiterQuote, error) {
+	swapMode := request.SwapMode
+	if swapMode == "" {
+		swapMode = JupiterSwapModeExactIn
+	}
+	query := url.Values{
+		"inputMint":   {request.InputMint},
+		"outputMint":  {request.OutputMint},
+		"amount":      {request.Amount},
+		"slippageBps": {strconv.Itoa(request.SlippageBps)},
+		"swapMode":    {swapMode},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.apiURL+"/quote?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := s.do(req, "quote")
+	if err != nil {
+		return nil, err
+	}
+
+	var quote JupiterQuote
+	if err := json.Unmarshal(body, &quote); err != nil {
+		return nil, fmt.Errorf("failed to parse Jupiter quote: %w", err)
+	}
+	quote.Raw = body
+	return &quote, nil
+}
+
+func (s *jupiterService) GetSwapTransaction(ctx context.Context, quote *JupiterQuote, userPublicKey string) (*JupiterSwapTransaction, error) {
+	payload, err := json.Marshal(map[string]any{
+		"quoteResponse":           quote.Raw,
+		"userPublicKey":           userPublicKey,
+		"wrapAndUnwrapSol":        true,
+		"dynamicComputeUnitLimit": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.apiURL+"/swap", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	body, err := s.do(req, "swap")
+	if err != nil {
+		return nil, err
+	}
+
+	var swap JupiterSwapTransaction
+	if err := json.Unmarshal(body, &swap); err != nil {
+		return nil, fmt.Errorf("failed to parse Jupiter swap transaction: %w", err)
+	}
+	if swap.SwapTransaction == "" {
+		return nil, fmt.Errorf("Jupiter returned no swap transaction")
+	}
+	return &swap, nil
+}
+
+// do sends the request and returns the body of a successful response, the Jupiter error message otherwise
+func (s *jupiterService) do(req *http.Request, endpoint string) ([]byte, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Jupiter %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Jupiter %s: %w", endpoint, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiError struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(body, &apiError) == nil && apiError.Error != "" {
+			return nil, fmt.Errorf("Jupiter %s failed: %s", endpoint, apiError.Error)
+		}
+		return nil, fmt.Errorf("Jupiter %s returned %s", endpoint, resp.Status)
+	}
+	return body, nil
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jupiterTestQuote = `{"inputMint": "So11111111111111111111111111111111111111112", "inAmount": "1000000000", "outputMint": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "outAmount": "150000000", "otherAmountThreshold": "149250000", "swapMode": "ExactIn", "slippageBps": 50, "priceImpactPct": "0.0012", "routePlan": [{"swapInfo": {"label": "Raydium"}, "percent": 100}]}`
+
+func TestJupiterServiceQuoteAndSwap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/quote":
+			assert.Equal(t, "1000000000", r.URL.Query().Get("amount"))
+			assert.Equal(t, "50", r.URL.Query().Get("slippageBps"))
+			assert.Equal(t, JupiterSwapModeExactIn, r.URL.Query().Get("swapMode"))
+			_, _ = w.Write([]byte(jupiterTestQuote))
+		case "/swap":
+			var body map[string]json.RawMessage
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			// The quote is sent back untouched
+			assert.JSONEq(t, jupiterTestQuote, string(body["quoteResponse"]))
+			assert.JSONEq(t, `"UserPublicKey"`, string(body["userPublicKey"]))
+			_, _ = w.Write([]byte(`{"swapTransaction": "AQID", "lastValidBlockHeight": 279631227, "prioritizationFeeLamports": 5000}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	service := NewJupiterService(server.Client(), server.URL+"/")
+	quote, err := service.GetQuote(context.Background(), JupiterQuoteRequest{
+		InputMint:   "So11111111111111111111111111111111111111112",
+		OutputMint:  "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		Amount:      "1000000000",
+		SlippageBps: 50,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "150000000", quote.OutAmount)
+	assert.Equal(t, "Raydium", quote.RoutePlan[0].SwapInfo.Label)
+	assert.InDelta(t, 0.12, quote.PriceImpact(), 1e-9)
+
+	swap, err := service.GetSwapTransaction(context.Background(), quote, "UserPublicKey")
+	require.NoError(t, err)
+	assert.Equal(t, "AQID", swap.SwapTransaction)
+	assert.Equal(t, uint64(279631227), swap.LastValidBlockHeight)
+}
+
+func TestJupiterServiceErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": "Could not find any route", "errorCode": "COULD_NOT_FIND_ANY_ROUTE"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewJupiterService(server.Client(), server.URL).GetQuote(context.Background(), JupiterQuoteRequest{Amount: "1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Could not find any route")
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+type LaunchGroupService interface {
+	CreateLaunchGroup(group *models.LaunchGroup) error
+	// GetLaunchGroup returns the group with its deployments and their chains
+	GetLaunchGroup(id uint) (*models.LaunchGroup, error)
+	// ListLaunchGroups returns the groups of the user, every group when userID is nil, newest first
+	ListLaunchGroups(userID *string) ([]models.LaunchGroup, error)
+	// AddDeploymentBySessionId attaches the deployment created for the session to the group
+	AddDeploymentBySessionId(groupID uint, sessionId string) error
+	CreateLaunchGroupBridges(bridges []models.LaunchGroupBridge) error
+	// ConfirmLaunchGroupBridge records the confirmed deposit through the bridge contract of the session,
+	// returns false when the session has no pending deposit through it
+	ConfirmLaunchGroupBridge(sessionId string, bridgeAddress string, txHash string) (bool, error)
+}
+
+type launchGroupService struct {
+	db *gorm.DB
+}
+
+func NewLaunchGroupService(db *gorm.DB) LaunchGroupService {
+	return &launchGroupService{db: db}
+}
+
+func (s *launchGroupService) CreateLaunchGroup(group *models.LaunchGroup) error {
+	return s.db.Create(group).Error
+}
+
+func (s *launchGroupService) GetLaunchGroup(id uint) (*models.LaunchGroup, error) {
+	var group models.LaunchGroup
+	err := s.db.Preload("Template").Preload("Deployments", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Deployments.Chain").Preload("Bridges", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Bridges.SourceChain").Preload("Bridges.TargetChain").First(&group, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (s *launchGroupService) ListLaunchGroups(userID *string) ([]models.LaunchGroup, error) {
+	var groups []models.LaunchGroup
+	query := s.db.Preload("Template").Preload("Deployments").Preload("Deployments.Chain").Preload("Bridges").Order("id DESC")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&groups).Error
+	return groups, err
+}
+
+func (s *launchGroupService) AddDeploymentBySessionId(groupID uint, sessionId string) error {
+	result := s.db.Model(&models.Deployment{}).Where("session_id = ?", sessionId).
+		Updates(map[string]any{"launch_group_id": groupID, "lock_version": lockVersionIncrement})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *launchGroupService) CreateLaunchGroupBridges(bridges []models.LaunchGroupBridge) error {
+	return s.db.Create(&bridges).Error
+}
+
+func (s *launchGroupService) ConfirmLaunchGroupBridge(sessionId string, bridgeAddress string, txHash string) (bool, error) {
+	result := s.db.Model(&models.LaunchGroupBridge{}).
+		Where("session_id = ? AND LOWER(bridge_address) = LOWER(?) AND status = ?", sessionId, bridgeAddress, models.TransactionStatusPending).
+		Updates(map[string]interface{}{
+			"status":           models.TransactionStatusConfirmed,
+			"transaction_hash": txHash,
+		})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+type LaunchPolicyService interface {
+	// GetLaunchPolicy returns the launch policy of the organization, or nil when none is configured
+	GetLaunchPolicy() (*models.LaunchPolicy, error)
+	// SaveLaunchPolicy creates or replaces the launch policy of the organization
+	SaveLaunchPolicy(policy *models.LaunchPolicy) error
+	DeleteLaunchPolicy() error
+}
+
+type launchPolicyService struct {
+	db *gorm.DB
+}
+
+func NewLaunchPolicyService(db *gorm.DB) LaunchPolicyService {
+	return &launchPolicyService{db: db}
+}
+
+// scope selects the organization policy, the policies saved per user by earlier versions are ignored
+func (s *launchPolicyService) scope() *gorm.DB {
+	return s.db.Where("user_id IS NULL")
+}
+
+func (s *launchPolicyService) GetLaunchPolicy() (*models.LaunchPolicy, error) {
+	var policy models.LaunchPolicy
+	err := s.scope().First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (s *launchPolicyService) SaveLaunchPolicy(policy *models.LaunchPolicy) error {
+	existing, err := s.GetLaunchPolicy()
+	if err != nil {
+		return err
+	}
+	policy.UserID = nil
+	if existing != nil {
+		policy.ID = existing.ID
+		policy.CreatedAt = existing.CreatedAt
+	}
+	return s.db.Save(policy).Error
+}
+
+func (s *launchPolicyService) DeleteLaunchPolicy() error {
+	return s.scope().Delete(&models.LaunchPolicy{}).Error
+}
+
+// LiquidityLockCheck is the outcome of the liquidity lock rule of a launch policy for a new pool
+type LiquidityLockCheck struct {
+	Required  bool   `json:"required"`
+	Satisfied bool   `json:"satisfied"`
+	Message   string `json:"message"`
+}
+
+// EvaluateLiquidityLock checks the liquidity lock planned for a new pool against the policy.
+// ethAmount is the ETH side of the pool in wei, nil for pools not paired with ETH.
+// lockDays is the planned lock duration, 0 when the liquidity is not locked.
+func EvaluateLiquidityLock(policy *models.LaunchPolicy, ethAmount *big.Int, lockDays int) LiquidityLockCheck {
+	locked := "liquidity is not locked"
+	if lockDays > 0 {
+		locked = fmt.Sprintf("liquidity is locked for %d days", lockDays)
+	}
+
+	if policy == nil || policy.LiquidityLockThreshold == "" {
+		return LiquidityLockCheck{Satisfied: true, Message: fmt.Sprintf("no liquidity lock policy, %s", locked)}
+	}
+
+	threshold, ok := new(big.Int).SetString(policy.LiquidityLockThreshold, 10)
+	if !ok {
+		return LiquidityLockCheck{Required: true, Message: fmt.Sprintf("invalid liquidity lock threshold %q in the launch policy", policy.LiquidityLockThreshold)}
+	}
+	thresholdEth := new(big.Float).Quo(new(big.Float).SetInt(threshold), big.NewFloat(1e18)).Text('f', -1)
+
+	if ethAmount == nil {
+		return LiquidityLockCheck{Satisfied: true, Message: fmt.Sprintf("the pool is not paired with ETH so the %s ETH lock threshold does not apply, %s", thresholdEth, locked)}
+	}
+	if ethAmount.Cmp(threshold) <= 0 {
+		return LiquidityLockCheck{Satisfied: true, Message: fmt.Sprintf("pools up to %s ETH do not require a lock, %s", thresholdEth, locked)}
+	}
+
+	check := LiquidityLockCheck{Required: true, Satisfied: lockDays >= policy.MinLiquidityLockDays && lockDays > 0}
+	if check.Satisfied {
+		check.Message = fmt.Sprintf("pools above %s ETH must lock their liquidity for at least %d days, %s", thresholdEth, policy.MinLiquidityLockDays, locked)
+	} else {
+		check.Message = fmt.Sprintf("pools above %s ETH must lock their liquidity for at least %d days but %s", thresholdEth, policy.MinLiquidityLockDays, locked)
+	}
+	return check
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestLaunchPolicyServiceSavesTheOrganizationPolicy(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.LaunchPolicy{}))
+	service := NewLaunchPolicyService(db)
+
+	// a policy saved per user by an earlier version is ignored
+	userID := "user-1"
+	require.NoError(t, db.Create(&models.LaunchPolicy{UserID: &userID, LiquidityLockThreshold: "5", MinLiquidityLockDays: 7}).Error)
+	policy, err := service.GetLaunchPolicy()
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	require.NoError(t, service.SaveLaunchPolicy(&models.LaunchPolicy{LiquidityLockThreshold: "1000", MinLiquidityLockDays: 30}))
+	require.NoError(t, service.SaveLaunchPolicy(&models.LaunchPolicy{UserID: &userID, UpdatedBy: &userID, LiquidityLockThreshold: "2000", MinLiquidityLockDays: 90}))
+
+	policy, err = service.GetLaunchPolicy()
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	assert.Equal(t, "2000", policy.LiquidityLockThreshold)
+	assert.Equal(t, 90, policy.MinLiquidityLockDays)
+	assert.Nil(t, policy.UserID)
+	assert.Equal(t, &userID, policy.UpdatedBy)
+
+	var count int64
+	require.NoError(t, db.Model(&models.LaunchPolicy{}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+
+	require.NoError(t, service.DeleteLaunchPolicy())
+	policy, err = service.GetLaunchPolicy()
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+}
+
+func TestEvaluateLiquidityLock(t *testing.T) {
+	// Pools above 10 ETH must lock their liquidity for 30 days
+	policy := &models.LaunchPolicy{LiquidityLockThreshold: "10000000000000000000", MinLiquidityLockDays: 30}
+	ether := big.NewInt(1e18)
+	large := new(big.Int).Mul(big.NewInt(20), ether)
+
+	tests := []struct {
+		name      string
+		policy    *models.LaunchPolicy
+		ethAmount *big.Int
+		lockDays  int
+		required  bool
+		satisfied bool
+		message   string
+	}{
+		{"no policy", nil, large, 0, false, true, "no liquidity lock policy"},
+		{"below threshold", policy, ether, 0, false, true, "pools up to 10 ETH do not require a lock"},
+		{"not paired with ETH", policy, nil, 0, false, true, "not paired with ETH"},
+		{"missing lock", policy, large, 0, true, false, "but liquidity is not locked"},
+		{"short lock", policy, large, 7, true, false, "but liquidity is locked for 7 days"},
+		{"long enough lock", policy, large, 30, true, true, "at least 30 days, liquidity is locked for 30 days"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := EvaluateLiquidityLock(tt.policy, tt.ethAmount, tt.lockDays)
+			assert.Equal(t, tt.required, check.Required)
+			assert.Equal(t, tt.satisfied, check.Satisfied)
+			assert.Contains(t, check.Message, tt.message)
+		})
+	}
+}
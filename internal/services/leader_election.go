@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultLeaderElectionInterval is how often a replica checks its leadership or tries to take it over
+const DefaultLeaderElectionInterval = 10 * time.Second
+
+// leaderLockKey is the PostgreSQL advisory lock held by the leader replica, "launchpd" in ASCII
+const leaderLockKey int64 = 0x6c61756e63687064
+
+// leaderLock is the lock held by the leader replica, only one holder at a time
+type leaderLock interface {
+	// TryAcquire takes the lock without waiting, false when another replica holds it
+	TryAcquire(ctx context.Context) (bool, error)
+	// Check fails when the lock was lost, the connection holding it was closed
+	Check(ctx context.Context) error
+	// Release gives the lock up
+	Release(ctx context.Context) error
+}
+
+// LeaderElector elects one replica of a cluster sharing a database to run the background jobs. The leader holds a
+// session level PostgreSQL advisory lock on a dedicated connection, the lock is freed when the leader stops or its
+// connection drops and another replica takes over on its next check
+type LeaderElector struct {
+	lock     leaderLock
+	interval time.Duration
+
+	onElected func()
+	onDemoted func()
+	leader    atomic.Bool
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewLeaderElector creates the elector of the replicas sharing the database. Replicas on a SQLite database are
+// always elected, the database file is not shared
+func NewLeaderElector(db *gorm.DB, interval time.Duration) (*LeaderElector, error) {
+	if db.Dialector.Name() != "postgres" {
+		return newLeaderElector(localLeaderLock{}, interval), nil
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the database connection pool: %w", err)
+	}
+	return newLeaderElector(&advisoryLeaderLock{db: sqlDB, key: leaderLockKey}, interval), nil
+}
+
+func newLeaderElector(lock leaderLock, interval time.Duration) *LeaderElector {
+	if interval <= 0 {
+		interval = DefaultLeaderElectionInterval
+	}
+	return &LeaderElector{lock: lock, interval: interval}
+}
+
+// Start runs the election in the background until Stop is called. onElected is called when this replica becomes the
+// leader and onDemoted when it loses the leadership
+func (e *LeaderElector) Start(onElected, onDemoted func()) {
+	if e.stop != nil {
+		return
+	}
+	e.onElected = onElected
+	e.onDemoted = onDemoted
+	e.stop = make(chan struct{})
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		e.RunOnce()
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				e.RunOnce()
+			case <-e.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the election, a leader is demoted and releases the lock for the other replicas
+func (e *LeaderElector) Stop() {
+	if e.stop == nil {
+		return
+	}
+	close(e.stop)
+	e.wg.Wait()
+	e.stop = nil
+	if e.leader.Load() {
+		e.demote()
+	}
+}
+
+// IsLeader reports whether this replica runs the background jobs
+func (e *LeaderElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// RunOnce checks the leadership of a leader, the other replicas try to take the lock over
+func (e *LeaderElector) RunOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.interval)
+	defer cancel()
+
+	if e.leader.Load() {
+		if err := e.lock.Check(ctx); err != nil {
+			log.Printf("Lost the background jobs leadership: %v", err)
+			e.demote()
+		}
+		return
+	}
+
+	acquired, err := e.lock.TryAcquire(ctx)
+	if err != nil {
+		log.Printf("Error acquiring the background jobs leadership: %v", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	log.Println("Elected to run the background jobs")
+	e.leader.Store(true)
+	if e.onElected != nil {
+		e.onElected()
+	}
+}
+
+// demote stops the jobs before the lock is released, so two replicas never run them at the same time
+func (e *LeaderElector) demote() {
+	e.leader.Store(false)
+	if e.onDemoted != nil {
+		e.onDemoted()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), e.interval)
+	defer cancel()
+	if err := e.lock.Release(ctx); err != nil {
+		log.Printf("Error releasing the background jobs leadership: %v", err)
+	}
+}
+
+// advisoryLeaderLock holds a PostgreSQL advisory lock. Advisory locks belong to the session, the lock is taken and
+// released on a connection kept out of the pool
+type advisoryLeaderLock struct {
+	db   *sql.DB
+	key  int64
+	conn *sql.Conn
+}
+
+func (l *advisoryLeaderLock) TryAcquire(ctx context.Context) (bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, err
+	}
+	if !acquired {
+		return false, conn.Close()
+	}
+	l.conn = conn
+	return true, nil
+}
+
+func (l *advisoryLeaderLock) Check(ctx context.Context) error {
+	if l.conn == nil {
+		return fmt.Errorf("advisory lock is not held")
+	}
+	return l.conn.PingContext(ctx)
+}
+
+func (l *advisoryLeaderLock) Release(ctx context.Context) error {
+	if l.conn == nil {
+		return nil
+	}
+	conn := l.conn
+	l.conn = nil
+	_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	if err != nil {
+		// a connection returned to the pool would keep the session and its lock, it is discarded instead so
+		// ending the session frees the lock
+		conn.Raw(func(any) error { return driver.ErrBadConn })
+	}
+	if closeErr := conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// localLeaderLock is the lock of a database only this process uses
+type localLeaderLock struct{}
+
+func (localLeaderLock) TryAcquire(ctx context.Context) (bool, error) { return true, nil }
+
+func (localLeaderLock) Check(ctx context.Context) error { return nil }
+
+func (localLeaderLock) Release(ctx context.Context) error { return nil }
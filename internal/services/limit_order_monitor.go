@@ -0,0 +1,166 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultLimitOrderPollInterval is how often the monitor reads the pool prices of open orders
+const DefaultLimitOrderPollInterval = 30 * time.Second
+
+// LimitOrderExecutor prepares the swap of a limit order that reached its target price
+// and returns the ID of the swap session waiting to be signed
+type LimitOrderExecutor func(order models.LimitOrder) (string, error)
+
+// LimitOrderMonitor watches the pool price of open limit orders and triggers them once the target price is reached
+type LimitOrderMonitor struct {
+	limitOrderService      LimitOrderService
+	liquidityService       LiquidityService
+	uniswapService         UniswapService
+	uniswapContractService UniswapContractService
+	executor               LimitOrderExecutor
+	interval               time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewLimitOrderMonitor(limitOrderService LimitOrderService, liquidityService LiquidityService, uniswapService UniswapService, uniswapContractService UniswapContractService, executor LimitOrderExecutor, interval time.Duration) *LimitOrderMonitor {
+	if interval <= 0 {
+		interval = DefaultLimitOrderPollInterval
+	}
+	return &LimitOrderMonitor{
+		limitOrderService:      limitOrderService,
+		liquidityService:       liquidityService,
+		uniswapService:         uniswapService,
+		uniswapContractService: uniswapContractService,
+		executor:               executor,
+		interval:               interval,
+	}
+}
+
+// Start polls the open orders in the background until Stop is called
+func (m *LimitOrderMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.CheckOrders()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background polling and waits for the current check to finish
+func (m *LimitOrderMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// CheckOrders checks every open order once
+func (m *LimitOrderMonitor) CheckOrders() {
+	orders, err := m.limitOrderService.ListLimitOrdersByStatus(models.LimitOrderStatusOpen)
+	if err != nil {
+		log.Printf("Error listing open limit orders: %v", err)
+		return
+	}
+
+	for _, order := range orders {
+		if err := m.checkOrder(order); err != nil {
+			log.Printf("Error checking limit order %d: %v", order.ID, err)
+		}
+	}
+}
+
+func (m *LimitOrderMonitor) checkOrder(order models.LimitOrder) error {
+	if order.ExpiresAt != nil && time.Now().After(*order.ExpiresAt) {
+		return m.limitOrderService.UpdateLimitOrderStatus(order.ID, models.LimitOrderStatusExpired, "")
+	}
+
+	reached, err := m.isTargetReached(order)
+	if err != nil {
+		return err
+	}
+	if !reached {
+		return nil
+	}
+
+	sessionID, err := m.executor(order)
+	if err != nil {
+		// The order cannot be executed as is, stop watching it instead of failing on every poll
+		if updateErr := m.limitOrderService.UpdateLimitOrderStatus(order.ID, models.LimitOrderStatusFailed, err.Error()); updateErr != nil {
+			return updateErr
+		}
+		return fmt.Errorf("failed to create swap session: %w", err)
+	}
+
+	if err := m.limitOrderService.MarkLimitOrderTriggered(order.ID, sessionID); err != nil {
+		if errors.Is(err, ErrLimitOrderNotOpen) {
+			// the order was cancelled while its session was created, the session is left to expire
+			log.Printf("Limit order %d was closed before it triggered, swap session %s is discarded", order.ID, sessionID)
+			return nil
+		}
+		return err
+	}
+	log.Printf("Limit order %d reached its target price, swap session %s is ready to sign", order.ID, sessionID)
+	return nil
+}
+
+// isTargetReached quotes the order amount against the current pool reserves
+func (m *LimitOrderMonitor) isTargetReached(order models.LimitOrder) (bool, error) {
+	pool, err := m.liquidityService.GetLiquidityPool(order.PoolID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get pool: %w", err)
+	}
+
+	uniswapDeployment, err := m.uniswapService.GetUniswapDeploymentByChain(order.ChainID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get uniswap deployment: %w", err)
+	}
+
+	reserve0, reserve1, err := m.uniswapContractService.GetReserves(pool.PairAddress, &order.Chain)
+	if err != nil {
+		return false, fmt.Errorf("failed to get reserves: %w", err)
+	}
+
+	// The pair holds WETH in place of ETH
+	fromToken, toToken := order.FromToken, order.ToToken
+	if strings.EqualFold(fromToken, EthTokenAddress) {
+		fromToken = uniswapDeployment.WETHAddress
+	}
+	if strings.EqualFold(toToken, EthTokenAddress) {
+		toToken = uniswapDeployment.WETHAddress
+	}
+	reserveIn, reserveOut := utils.SortPairReserves(fromToken, toToken, reserve0, reserve1)
+
+	amountIn, ok := new(big.Int).SetString(order.Amount, 10)
+	if !ok {
+		return false, fmt.Errorf("invalid amount: %s", order.Amount)
+	}
+
+	return utils.IsLimitPriceReached(amountIn, reserveIn, reserveOut, order.TargetPrice), nil
+}
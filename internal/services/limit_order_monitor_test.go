@@ -0,0 +1,198 @@
+package services
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const (
+	limitOrderTestToken = "0x1111111111111111111111111111111111111111"
+	limitOrderTestWETH  = "0x2222222222222222222222222222222222222222"
+	limitOrderTestPair  = "0x3333333333333333333333333333333333333333"
+)
+
+// fakeUniswapContractService returns fixed reserves for every pair
+type fakeUniswapContractService struct {
+	reserve0 *big.Int
+	reserve1 *big.Int
+}
+
+func (f *fakeUniswapContractService) GetPairAddress(token0Address, token1Address string, chain *models.Chain) (string, error) {
+	return limitOrderTestPair, nil
+}
+
+func (f *fakeUniswapContractService) GetReserves(pairAddress string, chain *models.Chain) (*big.Int, *big.Int, error) {
+	return f.reserve0, f.reserve1, nil
+}
+
+func (f *fakeUniswapContractService) GetLPDistribution(pairAddress string, chain *models.Chain) ([]LPHolder, error) {
+	return nil, nil
+}
+
+type limitOrderMonitorFixture struct {
+	limitOrderService LimitOrderService
+	contractService   *fakeUniswapContractService
+	monitor           *LimitOrderMonitor
+	chain             *models.Chain
+	pool              *models.LiquidityPool
+	executed          []uint
+	executorErr       error
+}
+
+func setupLimitOrderMonitor(t *testing.T) *limitOrderMonitorFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Chain{}, &models.UniswapDeployment{}, &models.LiquidityPool{}, &models.LimitOrder{}))
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: "http://localhost:8545", NetworkID: "31337", Name: "Local"}
+	require.NoError(t, db.Create(chain).Error)
+	require.NoError(t, db.Create(&models.UniswapDeployment{Version: "v2", WETHAddress: limitOrderTestWETH, ChainID: chain.ID}).Error)
+
+	liquidityService := NewLiquidityService(db)
+	pool := &models.LiquidityPool{
+		TokenAddress: limitOrderTestToken,
+		PairAddress:  limitOrderTestPair,
+		Token0:       EthTokenAddress,
+		Token1:       limitOrderTestToken,
+		Status:       models.TransactionStatusConfirmed,
+	}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+
+	fixture := &limitOrderMonitorFixture{
+		limitOrderService: NewLimitOrderService(db),
+		contractService:   &fakeUniswapContractService{},
+		chain:             chain,
+		pool:              pool,
+	}
+	executor := func(order models.LimitOrder) (string, error) {
+		if fixture.executorErr != nil {
+			return "", fixture.executorErr
+		}
+		fixture.executed = append(fixture.executed, order.ID)
+		return "session-1", nil
+	}
+	fixture.monitor = NewLimitOrderMonitor(fixture.limitOrderService, liquidityService, NewUniswapService(db), fixture.contractService, executor, time.Minute)
+	return fixture
+}
+
+func (f *limitOrderMonitorFixture) createOrder(t *testing.T, targetPrice float64, expiresAt *time.Time) *models.LimitOrder {
+	// Buy the token with 1 ETH
+	order := &models.LimitOrder{
+		ChainID:           f.chain.ID,
+		PoolID:            f.pool.ID,
+		FromToken:         EthTokenAddress,
+		ToToken:           limitOrderTestToken,
+		Amount:            "1000000000000000000",
+		TargetPrice:       targetPrice,
+		SlippageTolerance: "0.5",
+		UserAddress:       "0x4444444444444444444444444444444444444444",
+		ExpiresAt:         expiresAt,
+	}
+	require.NoError(t, f.limitOrderService.CreateLimitOrder(order))
+	return order
+}
+
+func TestLimitOrderMonitorTriggersWhenTargetReached(t *testing.T) {
+	f := setupLimitOrderMonitor(t)
+	// The token sorts before WETH, so reserve0 is the token and reserve1 is WETH: 1 WETH buys ~2000 tokens
+	ether := big.NewInt(1e18)
+	f.contractService.reserve0 = new(big.Int).Mul(big.NewInt(2_000_000), ether)
+	f.contractService.reserve1 = new(big.Int).Mul(big.NewInt(1_000), ether)
+
+	reached := f.createOrder(t, 1900, nil)
+	notReached := f.createOrder(t, 2100, nil)
+
+	f.monitor.CheckOrders()
+
+	assert.Equal(t, []uint{reached.ID}, f.executed)
+
+	order, err := f.limitOrderService.GetLimitOrder(reached.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.LimitOrderStatusTriggered, order.Status)
+	assert.Equal(t, "session-1", order.SessionId)
+	assert.NotNil(t, order.TriggeredAt)
+
+	order, err = f.limitOrderService.GetLimitOrder(notReached.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.LimitOrderStatusOpen, order.Status)
+
+	// Triggered orders are not executed twice
+	f.monitor.CheckOrders()
+	assert.Len(t, f.executed, 1)
+}
+
+func TestLimitOrderMonitorKeepsOrdersCancelledDuringTrigger(t *testing.T) {
+	f := setupLimitOrderMonitor(t)
+	ether := big.NewInt(1e18)
+	f.contractService.reserve0 = new(big.Int).Mul(big.NewInt(2_000_000), ether)
+	f.contractService.reserve1 = new(big.Int).Mul(big.NewInt(1_000), ether)
+	order := f.createOrder(t, 1900, nil)
+
+	executor := f.monitor.executor
+	f.monitor.executor = func(due models.LimitOrder) (string, error) {
+		// the user cancels the order while its swap session is created
+		require.NoError(t, f.limitOrderService.UpdateLimitOrderStatus(order.ID, models.LimitOrderStatusCancelled, ""))
+		return executor(due)
+	}
+	f.monitor.CheckOrders()
+	assert.Len(t, f.executed, 1)
+
+	stored, err := f.limitOrderService.GetLimitOrder(order.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.LimitOrderStatusCancelled, stored.Status)
+	assert.Empty(t, stored.SessionId)
+
+	err = f.limitOrderService.MarkLimitOrderTriggered(order.ID, "session-2")
+	assert.ErrorIs(t, err, ErrLimitOrderNotOpen)
+}
+
+func TestLimitOrderMonitorExpiresOrders(t *testing.T) {
+	f := setupLimitOrderMonitor(t)
+	f.contractService.reserve0 = big.NewInt(1_000_000)
+	f.contractService.reserve1 = big.NewInt(1_000_000)
+
+	expiredAt := time.Now().Add(-time.Hour)
+	expired := f.createOrder(t, 0.0001, &expiredAt)
+
+	f.monitor.CheckOrders()
+
+	assert.Empty(t, f.executed)
+	order, err := f.limitOrderService.GetLimitOrder(expired.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.LimitOrderStatusExpired, order.Status)
+}
+
+func TestLimitOrderMonitorMarksFailedOrders(t *testing.T) {
+	f := setupLimitOrderMonitor(t)
+	ether := big.NewInt(1e18)
+	f.contractService.reserve0 = new(big.Int).Mul(big.NewInt(1_000), ether)
+	f.contractService.reserve1 = new(big.Int).Mul(big.NewInt(1_000), ether)
+	f.executorErr = errors.New("router address not found")
+
+	failing := f.createOrder(t, 0.5, nil)
+
+	f.monitor.CheckOrders()
+
+	order, err := f.limitOrderService.GetLimitOrder(failing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.LimitOrderStatusFailed, order.Status)
+	assert.Equal(t, "router address not found", order.Error)
+}
+
+func TestLimitOrderMonitorStartStop(t *testing.T) {
+	f := setupLimitOrderMonitor(t)
+	f.monitor.Start()
+	// Starting twice is a no-op
+	f.monitor.Start()
+	f.monitor.Stop()
+	f.monitor.Stop()
+}
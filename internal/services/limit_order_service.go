@@ -0,0 +1,100 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrLimitOrderNotOpen is returned when an order was cancelled or expired while its swap session was created
+var ErrLimitOrderNotOpen = errors.New("the limit order is no longer open")
+
+type LimitOrderService interface {
+	CreateLimitOrder(order *models.LimitOrder) error
+	GetLimitOrder(id uint) (*models.LimitOrder, error)
+	GetLimitOrderBySessionId(sessionId string) (*models.LimitOrder, error)
+	ListLimitOrdersByStatus(status models.LimitOrderStatus) ([]models.LimitOrder, error)
+	ListLimitOrdersByUser(userID string) ([]models.LimitOrder, error)
+	ListLimitOrders() ([]models.LimitOrder, error)
+	// MarkLimitOrderTriggered stores the swap session generated for the order, it returns ErrLimitOrderNotOpen when
+	// the order is no longer open
+	MarkLimitOrderTriggered(id uint, sessionId string) error
+	UpdateLimitOrderStatus(id uint, status models.LimitOrderStatus, errorMessage string) error
+}
+
+type limitOrderService struct {
+	db *gorm.DB
+}
+
+func NewLimitOrderService(db *gorm.DB) LimitOrderService {
+	return &limitOrderService{db: db}
+}
+
+func (s *limitOrderService) CreateLimitOrder(order *models.LimitOrder) error {
+	if order.Status == "" {
+		order.Status = models.LimitOrderStatusOpen
+	}
+	return s.db.Create(order).Error
+}
+
+func (s *limitOrderService) GetLimitOrder(id uint) (*models.LimitOrder, error) {
+	var order models.LimitOrder
+	err := s.db.Preload("Chain").First(&order, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (s *limitOrderService) GetLimitOrderBySessionId(sessionId string) (*models.LimitOrder, error) {
+	var order models.LimitOrder
+	err := s.db.Preload("Chain").Where("session_id = ?", sessionId).First(&order).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (s *limitOrderService) ListLimitOrdersByStatus(status models.LimitOrderStatus) ([]models.LimitOrder, error) {
+	var orders []models.LimitOrder
+	err := s.db.Preload("Chain").Where("status = ?", status).Order("created_at ASC").Find(&orders).Error
+	return orders, err
+}
+
+func (s *limitOrderService) ListLimitOrdersByUser(userID string) ([]models.LimitOrder, error) {
+	var orders []models.LimitOrder
+	err := s.db.Preload("Chain").Where("user_id = ?", userID).Order("created_at DESC").Find(&orders).Error
+	return orders, err
+}
+
+func (s *limitOrderService) ListLimitOrders() ([]models.LimitOrder, error) {
+	var orders []models.LimitOrder
+	err := s.db.Preload("Chain").Order("created_at DESC").Find(&orders).Error
+	return orders, err
+}
+
+func (s *limitOrderService) MarkLimitOrderTriggered(id uint, sessionId string) error {
+	now := time.Now()
+	result := s.db.Model(&models.LimitOrder{}).Where("id = ? AND status = ?", id, models.LimitOrderStatusOpen).Updates(map[string]interface{}{
+		"status":       models.LimitOrderStatusTriggered,
+		"session_id":   sessionId,
+		"triggered_at": &now,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("limit order %d: %w", id, ErrLimitOrderNotOpen)
+	}
+	return nil
+}
+
+func (s *limitOrderService) UpdateLimitOrderStatus(id uint, status models.LimitOrderStatus, errorMessage string) error {
+	return s.db.Model(&models.LimitOrder{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status": status,
+		"error":  errorMessage,
+	}).Error
+}
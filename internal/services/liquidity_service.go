@@ -15,6 +15,19 @@ type LiquidityService interface {
 	ListLiquidityPools(skip, limit int) ([]models.LiquidityPool, error)
 	ListLiquidityPoolsByUser(userID string, skip, limit int) ([]models.LiquidityPool, error)
 	GetLiquidityPoolBySessionId(sessionId string) (*models.LiquidityPool, error)
+	// ListConfirmedLiquidityPoolsByChain returns the confirmed pools created on the given chain
+	ListConfirmedLiquidityPoolsByChain(chainID uint) ([]models.LiquidityPool, error)
+	// GetLiquidityPoolOnDexDeployment is GetLiquidityPoolByTokenAddress limited to the pools of the DEX deployment
+	GetLiquidityPoolOnDexDeployment(tokenAddressA string, tokenAddressB string, deployment models.UniswapDeployment) (*models.LiquidityPool, error)
+	// ListConfirmedLiquidityPoolsByDexDeployment returns the confirmed pools whose pair was created by the factory of the DEX deployment
+	ListConfirmedLiquidityPoolsByDexDeployment(deployment models.UniswapDeployment) ([]models.LiquidityPool, error)
+
+	// Pool Snapshot operations
+	CreatePoolSnapshot(snapshot *models.PoolSnapshot) error
+	// ListPoolSnapshots returns the latest snapshots of a pool ordered from oldest to newest
+	ListPoolSnapshots(poolID uint, limit int) ([]models.PoolSnapshot, error)
+	// ListPoolSnapshotsByTransactionType returns the latest snapshots of a pool created by the given transaction type, newest first
+	ListPoolSnapshotsByTransactionType(poolID uint, txType models.TransactionType, limit int) ([]models.PoolSnapshot, error)
 }
 
 type liquidityService struct {
@@ -66,10 +79,9 @@ func (l *liquidityService) GetLiquidityPoolByTokenAddress(tokenAddressA string,
 	return &pool, nil
 }
 
+// UpdateLiquidityPoolStatus moves the pool to the status, ErrVersionConflict when it already reached another final status
 func (l *liquidityService) UpdateLiquidityPoolStatus(poolID uint, status models.TransactionStatus, pairAddress, txHash string) error {
-	updates := map[string]interface{}{
-		"status": status,
-	}
+	updates := map[string]interface{}{}
 
 	if pairAddress != "" {
 		updates["pair_address"] = pairAddress
@@ -79,15 +91,13 @@ func (l *liquidityService) UpdateLiquidityPoolStatus(poolID uint, status models.
 		updates["transaction_hash"] = txHash
 	}
 
-	return l.db.Model(&models.LiquidityPool{}).
-		Where("id = ?", poolID).
-		Updates(updates).Error
+	return transitionStatus(l.db, &models.LiquidityPool{}, poolID, status, updates)
 }
 
 func (l *liquidityService) UpdateLiquidityPoolPairAddress(poolID uint, pairAddress string) error {
 	return l.db.Model(&models.LiquidityPool{}).
 		Where("id = ?", poolID).
-		Update("pair_address", pairAddress).Error
+		Updates(map[string]interface{}{"pair_address": pairAddress, "lock_version": lockVersionIncrement}).Error
 }
 
 func (l *liquidityService) ListLiquidityPools(skip, limit int) ([]models.LiquidityPool, error) {
@@ -107,3 +117,75 @@ func (l *liquidityService) ListLiquidityPoolsByUser(userID string, skip, limit i
 	}
 	return pools, nil
 }
+
+func (l *liquidityService) ListConfirmedLiquidityPoolsByChain(chainID uint) ([]models.LiquidityPool, error) {
+	var pools []models.LiquidityPool
+	// pools are linked to a chain through the session that created them
+	err := l.db.Joins("JOIN transaction_sessions ON transaction_sessions.id = liquidity_pools.session_id").
+		Where("transaction_sessions.chain_id = ? AND liquidity_pools.status = ? AND liquidity_pools.pair_address <> ''", chainID, models.TransactionStatusConfirmed).
+		Find(&pools).Error
+	if err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+func (l *liquidityService) GetLiquidityPoolOnDexDeployment(tokenAddressA string, tokenAddressB string, deployment models.UniswapDeployment) (*models.LiquidityPool, error) {
+	var pool models.LiquidityPool
+	err := onDexDeployment(l.db, deployment).
+		Where("token_address = ? OR token_address = ?", tokenAddressA, tokenAddressB).
+		First(&pool).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func (l *liquidityService) ListConfirmedLiquidityPoolsByDexDeployment(deployment models.UniswapDeployment) ([]models.LiquidityPool, error) {
+	var pools []models.LiquidityPool
+	err := onDexDeployment(l.db, deployment).
+		Joins("JOIN transaction_sessions ON transaction_sessions.id = liquidity_pools.session_id").
+		Where("transaction_sessions.chain_id = ? AND liquidity_pools.status = ? AND liquidity_pools.pair_address <> ''", deployment.ChainID, models.TransactionStatusConfirmed).
+		Find(&pools).Error
+	if err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+// onDexDeployment limits a pool query to the pools of the deployment, the default deployment of the chain also owns
+// the pools recorded without one
+func onDexDeployment(db *gorm.DB, deployment models.UniswapDeployment) *gorm.DB {
+	defaultDeployment := db.Model(&models.UniswapDeployment{}).Select("id").
+		Where("chain_id = ?", deployment.ChainID).Order(defaultDeploymentOrder).Limit(1)
+	return db.Where("liquidity_pools.dex_deployment_id = ? OR (liquidity_pools.dex_deployment_id IS NULL AND ? = (?))",
+		deployment.ID, deployment.ID, defaultDeployment)
+}
+
+// Pool Snapshot operations
+func (l *liquidityService) CreatePoolSnapshot(snapshot *models.PoolSnapshot) error {
+	return l.db.Create(snapshot).Error
+}
+
+func (l *liquidityService) ListPoolSnapshots(poolID uint, limit int) ([]models.PoolSnapshot, error) {
+	var snapshots []models.PoolSnapshot
+	err := l.db.Where("pool_id = ?", poolID).Order("created_at DESC, id DESC").Limit(limit).Find(&snapshots).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Reverse so the snapshots are in chronological order
+	for i, j := 0, len(snapshots)-1; i < j; i, j = i+1, j-1 {
+		snapshots[i], snapshots[j] = snapshots[j], snapshots[i]
+	}
+	return snapshots, nil
+}
+
+func (l *liquidityService) ListPoolSnapshotsByTransactionType(poolID uint, txType models.TransactionType, limit int) ([]models.PoolSnapshot, error) {
+	var snapshots []models.PoolSnapshot
+	err := l.db.Where("pool_id = ? AND transaction_type = ?", poolID, txType).Order("created_at DESC, id DESC").Limit(limit).Find(&snapshots).Error
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
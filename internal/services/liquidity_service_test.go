@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiquidityPoolsOnDexDeployment(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, NewChainService(db).CreateChain(chain))
+	canonical := models.UniswapDeployment{Name: "Uniswap", Version: "v2", ChainID: chain.ID}
+	require.NoError(t, db.Create(&canonical).Error)
+	fork := models.UniswapDeployment{Name: "Fork", Version: "v2", ChainID: chain.ID}
+	require.NoError(t, db.Create(&fork).Error)
+
+	liquidityService := NewLiquidityService(db)
+	token := "0x1111111111111111111111111111111111111111"
+	createPool := func(sessionID, pairAddress string, dexDeploymentID *uint) *models.LiquidityPool {
+		require.NoError(t, db.Create(&models.TransactionSession{ID: sessionID, TransactionChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID}).Error)
+		pool := &models.LiquidityPool{TokenAddress: token, PairAddress: pairAddress, UniswapVersion: "v2", Token0: token, Token1: EthTokenAddress, Status: models.TransactionStatusConfirmed, SessionId: sessionID, DexDeploymentID: dexDeploymentID}
+		_, err := liquidityService.CreateLiquidityPool(pool)
+		require.NoError(t, err)
+		return pool
+	}
+	// the pool recorded without a deployment belongs to the default deployment, the oldest one without a default
+	legacyPool := createPool("legacy", "0x2222222222222222222222222222222222222222", nil)
+	forkPool := createPool("fork", "0x3333333333333333333333333333333333333333", &fork.ID)
+
+	pool, err := liquidityService.GetLiquidityPoolOnDexDeployment(token, "", canonical)
+	require.NoError(t, err)
+	assert.Equal(t, legacyPool.ID, pool.ID)
+	pool, err = liquidityService.GetLiquidityPoolOnDexDeployment(token, "", fork)
+	require.NoError(t, err)
+	assert.Equal(t, forkPool.ID, pool.ID)
+
+	pools, err := liquidityService.ListConfirmedLiquidityPoolsByDexDeployment(fork)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	assert.Equal(t, forkPool.ID, pools[0].ID)
+
+	t.Run("the legacy pools follow the default deployment", func(t *testing.T) {
+		require.NoError(t, NewUniswapService(db).SetDefaultUniswapDeployment(fork.ID))
+
+		pools, err := liquidityService.ListConfirmedLiquidityPoolsByDexDeployment(fork)
+		require.NoError(t, err)
+		assert.Len(t, pools, 2)
+		_, err = liquidityService.GetLiquidityPoolOnDexDeployment(token, "", canonical)
+		assert.Error(t, err)
+	})
+}
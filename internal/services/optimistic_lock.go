@@ -0,0 +1,61 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrVersionConflict is returned by the status updates of deployments, pools and Uniswap deployments when another
+// writer changed the record first: it kept changing between the read and the write, or it was already confirmed.
+// The update is not applied, the caller reloads the record to see what happened
+var ErrVersionConflict = errors.New("record was changed concurrently")
+
+// maxVersionAttempts bounds the read and compare-and-swap rounds of a status transition losing races to other writers
+const maxVersionAttempts = 3
+
+// lockVersionIncrement is added to the updates of every write to a versioned record
+var lockVersionIncrement = gorm.Expr("lock_version + 1")
+
+// versionedStatus is the part of a versioned record a status transition is checked against
+type versionedStatus struct {
+	ID          uint
+	LockVersion uint
+	Status      models.TransactionStatus
+}
+
+// compareAndSetStatus moves the record read at its lock version to status, with the other updates. A confirmed record
+// is on chain and keeps its status, a failed one can still be retried. It returns false when another writer changed
+// the record since it was read
+func compareAndSetStatus(db *gorm.DB, model any, record versionedStatus, status models.TransactionStatus, updates map[string]any) (bool, error) {
+	if record.Status == models.TransactionStatusConfirmed && status != models.TransactionStatusConfirmed {
+		return false, fmt.Errorf("%w: record %d is already %s", ErrVersionConflict, record.ID, record.Status)
+	}
+	values := map[string]any{"status": status, "lock_version": lockVersionIncrement}
+	for column, value := range updates {
+		values[column] = value
+	}
+	result := db.Model(model).Where("id = ? AND lock_version = ?", record.ID, record.LockVersion).Updates(values)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// transitionStatus reads the record of the model table with the ID and sets its status with compareAndSetStatus,
+// reading it again when another writer got in between
+func transitionStatus(db *gorm.DB, model any, id uint, status models.TransactionStatus, updates map[string]any) error {
+	for attempt := 0; attempt < maxVersionAttempts; attempt++ {
+		var record versionedStatus
+		if err := db.Model(model).Select("id", "lock_version", "status").Where("id = ?", id).Take(&record).Error; err != nil {
+			return err
+		}
+		applied, err := compareAndSetStatus(db, model, record, status, updates)
+		if err != nil || applied {
+			return err
+		}
+	}
+	return fmt.Errorf("%w: record %d kept changing after %d attempts", ErrVersionConflict, id, maxVersionAttempts)
+}
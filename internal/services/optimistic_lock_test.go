@@ -0,0 +1,88 @@
+package services
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentStatusOptimisticLock(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewDeploymentService(db)
+	deployment := &models.Deployment{TemplateID: 1, ChainID: 1, SessionId: "session-1"}
+	require.NoError(t, service.CreateDeployment(deployment))
+	assert.Equal(t, uint(1), deployment.LockVersion)
+
+	// A writer holding a stale version does not overwrite the record
+	require.NoError(t, service.UpdateDeploymentInterfaces(deployment.ID, models.JSON{"erc20": true}))
+	applied, err := compareAndSetStatus(db, &models.Deployment{}, versionedStatus{ID: deployment.ID, LockVersion: 1, Status: models.TransactionStatusPending}, models.TransactionStatusFailed, nil)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	require.NoError(t, service.UpdateDeploymentStatusWithTxHashBySessionId("session-1", models.TransactionStatusConfirmed, "0x01", "0xaa"))
+	stored, err := service.GetDeploymentByID(deployment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusConfirmed, stored.Status)
+	assert.Equal(t, uint(3), stored.LockVersion)
+
+	// A late failure cannot replace the confirmation, confirming again is a no-op
+	err = service.UpdateDeploymentStatus(deployment.ID, models.TransactionStatusFailed, "")
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	require.NoError(t, service.UpdateDeploymentStatus(deployment.ID, models.TransactionStatusConfirmed, ""))
+	stored, err = service.GetDeploymentByID(deployment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusConfirmed, stored.Status)
+	assert.Equal(t, "0x01", stored.ContractAddress)
+}
+
+func TestLiquidityPoolStatusConcurrentTransitions(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewLiquidityService(db)
+	poolID, err := service.CreateLiquidityPool(&models.LiquidityPool{TokenAddress: "0x01", PairAddress: "", UniswapVersion: "v2", Token0: "0x01", Token1: "0x02"})
+	require.NoError(t, err)
+
+	// Concurrent callbacks confirming and failing the pool: one wins, the other never overwrites a confirmation
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, status := range []models.TransactionStatus{models.TransactionStatusConfirmed, models.TransactionStatusFailed} {
+		wg.Add(1)
+		go func(i int, status models.TransactionStatus) {
+			defer wg.Done()
+			errs[i] = service.UpdateLiquidityPoolStatus(poolID, status, "0xpair", "")
+		}(i, status)
+	}
+	wg.Wait()
+	require.NoError(t, errs[0])
+
+	pool, err := service.GetLiquidityPool(poolID)
+	require.NoError(t, err)
+	if errs[1] == nil {
+		// The failure ran first and was retried by the confirmation
+		assert.Equal(t, uint(3), pool.LockVersion)
+	} else {
+		assert.ErrorIs(t, errs[1], ErrVersionConflict)
+		assert.Equal(t, uint(2), pool.LockVersion)
+	}
+	assert.Equal(t, models.TransactionStatusConfirmed, pool.Status)
+	assert.Equal(t, "0xpair", pool.PairAddress)
+}
+
+func TestUniswapStatusOptimisticLock(t *testing.T) {
+	db := newCacheTestDB(t)
+	service := NewUniswapService(db)
+	deploymentID, err := service.CreateUniswapDeployment(1, "v2", nil)
+	require.NoError(t, err)
+	require.NoError(t, service.UpdateFactoryAddress(deploymentID, "0x01"))
+	require.NoError(t, service.UpdateRouterAddress(deploymentID, "0x02"))
+	require.NoError(t, service.UpdateWETHAddress(deploymentID, "0x03"))
+	require.NoError(t, service.UpdateStatus(deploymentID, models.TransactionStatusConfirmed))
+
+	deployment, err := service.GetUniswapDeployment(deploymentID)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", deployment.Version)
+	assert.Equal(t, uint(5), deployment.LockVersion)
+	assert.ErrorIs(t, service.UpdateStatus(deploymentID, models.TransactionStatusFailed), ErrVersionConflict)
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// EnvPlatformFeeRecipient is the address receiving the platform fees, unset disables the fees
+	EnvPlatformFeeRecipient = "LAUNCHPAD_PLATFORM_FEE_RECIPIENT"
+	// EnvPlatformFeeBps is the fee in basis points taken on the native value sent by the launches and swaps
+	EnvPlatformFeeBps = "LAUNCHPAD_PLATFORM_FEE_BPS"
+	// EnvPlatformLaunchFee is a flat fee in wei added to every launch session
+	EnvPlatformLaunchFee = "LAUNCHPAD_PLATFORM_LAUNCH_FEE"
+	// EnvReferralShareBps is the share of the fee in basis points paid to the referrer of the user
+	EnvReferralShareBps = "LAUNCHPAD_REFERRAL_SHARE_BPS"
+
+	// MaxPlatformFeeBps caps the fee taken on the launches and swaps at 10%
+	MaxPlatformFeeBps = 1000
+	bpsDenominator    = 10_000
+)
+
+// platformFeeLaunchTypes are the transactions launching a token, charged the launch fee and the fee on their value
+var platformFeeLaunchTypes = []models.TransactionType{
+	models.TransactionTypeTokenDeployment,
+	models.TransactionTypeBondingCurveDeployment,
+	models.TransactionTypeDutchAuctionDeployment,
+	models.TransactionTypeNFTCollectionDeployment,
+}
+
+// platformFeeSwapTypes are the transactions charged the fee on their value
+var platformFeeSwapTypes = []models.TransactionType{
+	models.TransactionTypeTokenSwap,
+}
+
+// PlatformFeeConfig is the fee of the operator taken on the launches and swaps of the server
+type PlatformFeeConfig struct {
+	Recipient string `json:"recipient"`
+	Bps       int64  `json:"bps"`
+	// LaunchFee is the flat fee in wei of a launch session, nil without one
+	LaunchFee        *big.Int `json:"launch_fee,omitempty"`
+	ReferralShareBps int64    `json:"referral_share_bps"`
+}
+
+// PlatformFeeConfigFromEnv returns the fee of the LAUNCHPAD_PLATFORM_* settings, or nil when no recipient is configured
+func PlatformFeeConfigFromEnv() (*PlatformFeeConfig, error) {
+	recipient := strings.TrimSpace(os.Getenv(EnvPlatformFeeRecipient))
+	if recipient == "" {
+		return nil, nil
+	}
+	if !common.IsHexAddress(recipient) {
+		return nil, fmt.Errorf("invalid %s %q, expected an EVM address", EnvPlatformFeeRecipient, recipient)
+	}
+	config := &PlatformFeeConfig{Recipient: common.HexToAddress(recipient).Hex()}
+
+	var err error
+	if config.Bps, err = parseBpsEnv(EnvPlatformFeeBps, MaxPlatformFeeBps); err != nil {
+		return nil, err
+	}
+	if config.ReferralShareBps, err = parseBpsEnv(EnvReferralShareBps, bpsDenominator); err != nil {
+		return nil, err
+	}
+	if value := strings.TrimSpace(os.Getenv(EnvPlatformLaunchFee)); value != "" {
+		launchFee, ok := new(big.Int).SetString(value, 10)
+		if !ok || launchFee.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s %q, expected an amount in wei", EnvPlatformLaunchFee, value)
+		}
+		if launchFee.Sign() > 0 {
+			config.LaunchFee = launchFee
+		}
+	}
+	if config.Bps == 0 && config.LaunchFee == nil {
+		log.Printf("Warning: %s is set without %s or %s, no platform fee is taken", EnvPlatformFeeRecipient, EnvPlatformFeeBps, EnvPlatformLaunchFee)
+	}
+	return config, nil
+}
+
+func parseBpsEnv(name string, max int64) (int64, error) {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return 0, nil
+	}
+	bps, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || bps < 0 || bps > max {
+		return 0, fmt.Errorf("invalid %s %q, expected basis points between 0 and %d", name, value, max)
+	}
+	return bps, nil
+}
+
+// Quote returns the fees of a session: the fee on the native value of its launches and swaps, the launch fee when it
+// launches a token, split with the referrer when there is one. Sessions without launch or swap pay no fee
+func (c *PlatformFeeConfig) Quote(transactions []models.TransactionDeployment, referrer string) []models.PlatformFee {
+	baseValue := new(big.Int)
+	launches := false
+	charged := false
+	for _, tx := range transactions {
+		isLaunch := slices.Contains(platformFeeLaunchTypes, tx.TransactionType)
+		if !isLaunch && !slices.Contains(platformFeeSwapTypes, tx.TransactionType) {
+			continue
+		}
+		charged = true
+		launches = launches || isLaunch
+		if value, ok := new(big.Int).SetString(tx.Value, 10); ok && value.Sign() > 0 {
+			baseValue.Add(baseValue, value)
+		}
+	}
+	if !charged {
+		return nil
+	}
+
+	total := new(big.Int).Quo(new(big.Int).Mul(baseValue, big.NewInt(c.Bps)), big.NewInt(bpsDenominator))
+	if launches && c.LaunchFee != nil {
+		total.Add(total, c.LaunchFee)
+	}
+	if total.Sign() == 0 {
+		return nil
+	}
+
+	var fees []models.PlatformFee
+	platform := total
+	if referrer != "" && c.ReferralShareBps > 0 {
+		share := new(big.Int).Quo(new(big.Int).Mul(total, big.NewInt(c.ReferralShareBps)), big.NewInt(bpsDenominator))
+		if share.Sign() > 0 {
+			platform = new(big.Int).Sub(total, share)
+			fees = append(fees, models.PlatformFee{Kind: models.PlatformFeeKindReferral, Recipient: referrer, Amount: share.String(), BaseValue: baseValue.String()})
+		}
+	}
+	if platform.Sign() > 0 {
+		fees = append([]models.PlatformFee{{Kind: models.PlatformFeeKindPlatform, Recipient: c.Recipient, Amount: platform.String(), BaseValue: baseValue.String()}}, fees...)
+	}
+	return fees
+}
+
+// PlatformFeeTransaction returns the transfer paying the fee
+func PlatformFeeTransaction(fee models.PlatformFee) models.TransactionDeployment {
+	title := "Platform Fee"
+	if fee.Kind == models.PlatformFeeKindReferral {
+		title = "Referral Fee"
+	}
+	return models.TransactionDeployment{
+		Title:           title,
+		Description:     fmt.Sprintf("Send the %s fee of %s wei to %s", fee.Kind, fee.Amount, fee.Recipient),
+		Data:            "0x",
+		Value:           fee.Amount,
+		Receiver:        fee.Recipient,
+		TransactionType: models.TransactionTypePlatformFee,
+	}
+}
+
+// feeTransactionService appends the platform fee transfers to the launch and swap sessions and records the fees
+type feeTransactionService struct {
+	TransactionService
+	config *PlatformFeeConfig
+	fees   PlatformFeeService
+}
+
+// NewFeeTransactionService wraps the service with the platform fee, a nil config returns the service as is
+func NewFeeTransactionService(inner TransactionService, config *PlatformFeeConfig, fees PlatformFeeService) TransactionService {
+	if config == nil {
+		return inner
+	}
+	return &feeTransactionService{TransactionService: inner, config: config, fees: fees}
+}
+
+func (s *feeTransactionService) CreateTransactionSession(req CreateTransactionSessionRequest) (string, error) {
+	return s.CreateTransactionSessionWithUser(req, nil)
+}
+
+func (s *feeTransactionService) CreateTransactionSessionWithUser(req CreateTransactionSessionRequest, userID *string) (string, error) {
+	// The fees are native transfers, only sent on EVM chains
+	if req.ChainType != models.TransactionChainTypeEthereum {
+		return s.TransactionService.CreateTransactionSessionWithUser(req, userID)
+	}
+	if userID == nil {
+		userID = req.UserID
+	}
+
+	referrer := ""
+	if userID != nil {
+		if referral, err := s.fees.GetReferrer(*userID); err == nil {
+			referrer = referral.ReferrerAddress
+		}
+	}
+	fees := s.config.Quote(req.TransactionDeployments, referrer)
+	if len(fees) == 0 {
+		return s.TransactionService.CreateTransactionSessionWithUser(req, userID)
+	}
+
+	// the fees of a gasless session are paid by the smart account sending its other transactions
+	gasless := slices.ContainsFunc(req.TransactionDeployments, func(tx models.TransactionDeployment) bool { return tx.UserOperation })
+	req.TransactionDeployments = slices.Clone(req.TransactionDeployments)
+	for _, fee := range fees {
+		tx := PlatformFeeTransaction(fee)
+		tx.UserOperation = gasless
+		req.TransactionDeployments = append(req.TransactionDeployments, tx)
+	}
+	sessionID, err := s.TransactionService.CreateTransactionSessionWithUser(req, userID)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range fees {
+		fees[i].SessionID = sessionID
+		fees[i].UserID = userID
+		fees[i].ChainID = req.ChainID
+		fees[i].Status = models.TransactionStatusPending
+	}
+	// The session is created, a failed record only leaves the fee out of the revenue report
+	if err := s.fees.RecordFees(fees); err != nil {
+		log.Printf("Error recording the platform fees of session %s: %v", sessionID, err)
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrReferrerAlreadySet is returned when a user who already has a referrer sets another one
+var ErrReferrerAlreadySet = errors.New("the referrer is already set")
+
+// PlatformRevenueFilter selects the fees of a revenue report, zero values select everything
+type PlatformRevenueFilter struct {
+	ChainID uint
+	Since   *time.Time
+	Until   *time.Time
+	// IncludePending also counts the fees whose transfer is not confirmed yet
+	IncludePending bool
+}
+
+// PlatformRevenueTotal is the sum of the fees of a chain, kind and status
+type PlatformRevenueTotal struct {
+	ChainID   uint                     `json:"chain_id"`
+	ChainName string                   `json:"chain_name,omitempty"`
+	Kind      models.PlatformFeeKind   `json:"kind"`
+	Status    models.TransactionStatus `json:"status"`
+	Count     int                      `json:"count"`
+	Amount    string                   `json:"amount"`
+	BaseValue string                   `json:"base_value"`
+}
+
+// ReferrerRevenue is the sum of the referral fees paid to a referrer
+type ReferrerRevenue struct {
+	Address string `json:"address"`
+	Count   int    `json:"count"`
+	Amount  string `json:"amount"`
+}
+
+// PlatformRevenueReport sums the platform and referral fees, amounts are in wei
+type PlatformRevenueReport struct {
+	Totals    []PlatformRevenueTotal `json:"totals"`
+	Referrers []ReferrerRevenue      `json:"referrers"`
+	// Sessions is the number of sessions that paid a fee
+	Sessions int `json:"sessions"`
+}
+
+type PlatformFeeService interface {
+	RecordFees(fees []models.PlatformFee) error
+	// ConfirmSessionFees confirms the pending fees of the session paid to the recipients
+	ConfirmSessionFees(sessionID string, recipients []string, confirmedAt time.Time) error
+	ListSessionFees(sessionID string) ([]models.PlatformFee, error)
+	// SetReferrer records the referrer of the user, it fails with ErrReferrerAlreadySet once a referrer is set
+	SetReferrer(userID string, referrerAddress string) (*models.Referral, error)
+	GetReferrer(userID string) (*models.Referral, error)
+	GetRevenueReport(filter PlatformRevenueFilter) (*PlatformRevenueReport, error)
+}
+
+type platformFeeService struct {
+	db *gorm.DB
+}
+
+func NewPlatformFeeService(db *gorm.DB) PlatformFeeService {
+	return &platformFeeService{db: db}
+}
+
+func (s *platformFeeService) RecordFees(fees []models.PlatformFee) error {
+	if len(fees) == 0 {
+		return nil
+	}
+	return s.db.Create(&fees).Error
+}
+
+func (s *platformFeeService) ConfirmSessionFees(sessionID string, recipients []string, confirmedAt time.Time) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	return s.db.Model(&models.PlatformFee{}).
+		Where("session_id = ? AND status = ? AND recipient IN ?", sessionID, models.TransactionStatusPending, recipients).
+		Updates(map[string]interface{}{
+			"status":       models.TransactionStatusConfirmed,
+			"confirmed_at": confirmedAt,
+		}).Error
+}
+
+func (s *platformFeeService) ListSessionFees(sessionID string) ([]models.PlatformFee, error) {
+	var fees []models.PlatformFee
+	err := s.db.Where("session_id = ?", sessionID).Order("id ASC").Find(&fees).Error
+	return fees, err
+}
+
+func (s *platformFeeService) SetReferrer(userID string, referrerAddress string) (*models.Referral, error) {
+	existing, err := s.GetReferrer(userID)
+	if err == nil {
+		return existing, fmt.Errorf("%w to %s", ErrReferrerAlreadySet, existing.ReferrerAddress)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	referral := &models.Referral{UserID: userID, ReferrerAddress: referrerAddress}
+	if err := s.db.Create(referral).Error; err != nil {
+		return nil, err
+	}
+	return referral, nil
+}
+
+func (s *platformFeeService) GetReferrer(userID string) (*models.Referral, error) {
+	var referral models.Referral
+	err := s.db.Where("user_id = ?", userID).First(&referral).Error
+	if err != nil {
+		return nil, err
+	}
+	return &referral, nil
+}
+
+func (s *platformFeeService) GetRevenueReport(filter PlatformRevenueFilter) (*PlatformRevenueReport, error) {
+	query := s.db.Preload("Chain")
+	if filter.ChainID != 0 {
+		query = query.Where("chain_id = ?", filter.ChainID)
+	}
+	if filter.Since != nil {
+		query = query.Where("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		query = query.Where("created_at < ?", *filter.Until)
+	}
+	if !filter.IncludePending {
+		query = query.Where("status = ?", models.TransactionStatusConfirmed)
+	}
+
+	var fees []models.PlatformFee
+	if err := query.Order("id ASC").Find(&fees).Error; err != nil {
+		return nil, err
+	}
+
+	// The amounts exceed 64 bits, they are summed here rather than by the database
+	type totalKey struct {
+		chainID uint
+		kind    models.PlatformFeeKind
+		status  models.TransactionStatus
+	}
+	type sums struct {
+		chainName string
+		count     int
+		amount    *big.Int
+		baseValue *big.Int
+	}
+	totals := map[totalKey]*sums{}
+	referrers := map[string]*sums{}
+	sessions := map[string]bool{}
+	for _, fee := range fees {
+		amount, ok := new(big.Int).SetString(fee.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q of fee %d", fee.Amount, fee.ID)
+		}
+		baseValue, ok := new(big.Int).SetString(fee.BaseValue, 10)
+		if !ok {
+			baseValue = new(big.Int)
+		}
+		sessions[fee.SessionID] = true
+
+		key := totalKey{chainID: fee.ChainID, kind: fee.Kind, status: fee.Status}
+		total, ok := totals[key]
+		if !ok {
+			total = &sums{chainName: fee.Chain.Name, amount: new(big.Int), baseValue: new(big.Int)}
+			totals[key] = total
+		}
+		total.count++
+		total.amount.Add(total.amount, amount)
+		total.baseValue.Add(total.baseValue, baseValue)
+
+		if fee.Kind == models.PlatformFeeKindReferral {
+			referrer, ok := referrers[fee.Recipient]
+			if !ok {
+				referrer = &sums{amount: new(big.Int)}
+				referrers[fee.Recipient] = referrer
+			}
+			referrer.count++
+			referrer.amount.Add(referrer.amount, amount)
+		}
+	}
+
+	report := &PlatformRevenueReport{Totals: []PlatformRevenueTotal{}, Referrers: []ReferrerRevenue{}, Sessions: len(sessions)}
+	for key, total := range totals {
+		report.Totals = append(report.Totals, PlatformRevenueTotal{
+			ChainID:   key.chainID,
+			ChainName: total.chainName,
+			Kind:      key.kind,
+			Status:    key.status,
+			Count:     total.count,
+			Amount:    total.amount.String(),
+			BaseValue: total.baseValue.String(),
+		})
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		a, b := report.Totals[i], report.Totals[j]
+		if a.ChainID != b.ChainID {
+			return a.ChainID < b.ChainID
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Status < b.Status
+	})
+	for address, referrer := range referrers {
+		report.Referrers = append(report.Referrers, ReferrerRevenue{Address: address, Count: referrer.count, Amount: referrer.amount.String()})
+	}
+	// The referrers who earned the most come first
+	sort.Slice(report.Referrers, func(i, j int) bool {
+		a, _ := new(big.Int).SetString(report.Referrers[i].Amount, 10)
+		b, _ := new(big.Int).SetString(report.Referrers[j].Amount, 10)
+		if cmp := a.Cmp(b); cmp != 0 {
+			return cmp > 0
+		}
+		return report.Referrers[i].Address < report.Referrers[j].Address
+	})
+	return report, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFeeRecipient = "0x1111111111111111111111111111111111111111"
+	testReferrer     = "0x2222222222222222222222222222222222222222"
+)
+
+func TestPlatformFeeConfigFromEnv(t *testing.T) {
+	config, err := PlatformFeeConfigFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	t.Setenv(EnvPlatformFeeRecipient, testFeeRecipient)
+	t.Setenv(EnvPlatformFeeBps, "100")
+	t.Setenv(EnvPlatformLaunchFee, "5000")
+	t.Setenv(EnvReferralShareBps, "2000")
+	config, err = PlatformFeeConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, testFeeRecipient, config.Recipient)
+	assert.Equal(t, int64(100), config.Bps)
+	assert.Equal(t, "5000", config.LaunchFee.String())
+	assert.Equal(t, int64(2000), config.ReferralShareBps)
+
+	t.Setenv(EnvPlatformFeeBps, "1001")
+	_, err = PlatformFeeConfigFromEnv()
+	assert.ErrorContains(t, err, EnvPlatformFeeBps)
+	t.Setenv(EnvPlatformFeeBps, "100")
+	t.Setenv(EnvPlatformFeeRecipient, "treasury")
+	_, err = PlatformFeeConfigFromEnv()
+	assert.ErrorContains(t, err, EnvPlatformFeeRecipient)
+}
+
+func TestFeeTransactionService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+
+	feeService := NewPlatformFeeService(db)
+	config := &PlatformFeeConfig{Recipient: testFeeRecipient, Bps: 100, ReferralShareBps: 2500}
+	txService := NewFeeTransactionService(NewTransactionService(db), config, feeService)
+	assert.Equal(t, NewTransactionService(db), NewFeeTransactionService(NewTransactionService(db), nil, feeService))
+
+	swap := CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Title: "Swap", Value: "1000000", Receiver: "0x03", TransactionType: models.TransactionTypeTokenSwap}},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+	}
+
+	var swapSessionID string
+	t.Run("swaps pay the fee on their value", func(t *testing.T) {
+		sessionID, err := txService.CreateTransactionSession(swap)
+		swapSessionID = sessionID
+		require.NoError(t, err)
+		assert.Len(t, swap.TransactionDeployments, 1)
+
+		session, err := txService.GetTransactionSession(sessionID)
+		require.NoError(t, err)
+		require.Len(t, session.TransactionDeployments, 2)
+		fee := session.TransactionDeployments[1]
+		assert.Equal(t, models.TransactionTypePlatformFee, fee.TransactionType)
+		assert.Equal(t, "10000", fee.Value)
+		assert.Equal(t, testFeeRecipient, fee.Receiver)
+
+		fees, err := feeService.ListSessionFees(sessionID)
+		require.NoError(t, err)
+		require.Len(t, fees, 1)
+		assert.Equal(t, models.PlatformFeeKindPlatform, fees[0].Kind)
+		assert.Equal(t, "1000000", fees[0].BaseValue)
+		assert.Equal(t, models.TransactionStatusPending, fees[0].Status)
+	})
+
+	t.Run("the referrer of the user shares the fee", func(t *testing.T) {
+		userID := "user-1"
+		_, err := feeService.SetReferrer(userID, testReferrer)
+		require.NoError(t, err)
+		_, err = feeService.SetReferrer(userID, testFeeRecipient)
+		assert.ErrorIs(t, err, ErrReferrerAlreadySet)
+
+		sessionID, err := txService.CreateTransactionSessionWithUser(swap, &userID)
+		require.NoError(t, err)
+		fees, err := feeService.ListSessionFees(sessionID)
+		require.NoError(t, err)
+		require.Len(t, fees, 2)
+		assert.Equal(t, "7500", fees[0].Amount)
+		assert.Equal(t, models.PlatformFeeKindReferral, fees[1].Kind)
+		assert.Equal(t, testReferrer, fees[1].Recipient)
+		assert.Equal(t, "2500", fees[1].Amount)
+		assert.Equal(t, userID, *fees[1].UserID)
+	})
+
+	t.Run("other sessions pay no fee", func(t *testing.T) {
+		for _, req := range []CreateTransactionSessionRequest{
+			{TransactionDeployments: []models.TransactionDeployment{{Title: "Send ETH", Value: "1000000", Receiver: "0x03", TransactionType: models.TransactionTypeRegular}}, ChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID},
+			{TransactionDeployments: []models.TransactionDeployment{{Title: "Swap", Value: "1000000", TransactionType: models.TransactionTypeTokenSwap}}, ChainType: models.TransactionChainTypeSolana, ChainID: chain.ID},
+			// A launch without value or launch fee
+			{TransactionDeployments: []models.TransactionDeployment{{Title: "Deploy", Value: "0", TransactionType: models.TransactionTypeTokenDeployment}}, ChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID},
+		} {
+			sessionID, err := txService.CreateTransactionSession(req)
+			require.NoError(t, err)
+			session, err := txService.GetTransactionSession(sessionID)
+			require.NoError(t, err)
+			assert.Len(t, session.TransactionDeployments, 1)
+		}
+	})
+
+	t.Run("launches pay the launch fee", func(t *testing.T) {
+		fees := (&PlatformFeeConfig{Recipient: testFeeRecipient, Bps: 100, LaunchFee: big.NewInt(5000)}).Quote([]models.TransactionDeployment{
+			{Value: "1000000", TransactionType: models.TransactionTypeBondingCurveDeployment},
+			{Value: "1000000", TransactionType: models.TransactionTypeAddLiquidity},
+		}, "")
+		require.Len(t, fees, 1)
+		assert.Equal(t, "15000", fees[0].Amount)
+		assert.Equal(t, "1000000", fees[0].BaseValue)
+	})
+
+	t.Run("the revenue report counts the confirmed fees", func(t *testing.T) {
+		require.NoError(t, feeService.ConfirmSessionFees(swapSessionID, []string{testFeeRecipient}, time.Now()))
+
+		report, err := feeService.GetRevenueReport(PlatformRevenueFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Sessions)
+		require.Len(t, report.Totals, 1)
+		assert.Equal(t, "10000", report.Totals[0].Amount)
+		assert.Equal(t, "Anvil", report.Totals[0].ChainName)
+		assert.Empty(t, report.Referrers)
+
+		report, err = feeService.GetRevenueReport(PlatformRevenueFilter{IncludePending: true})
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Sessions)
+		require.Len(t, report.Totals, 3)
+		require.Len(t, report.Referrers, 1)
+		assert.Equal(t, testReferrer, report.Referrers[0].Address)
+		assert.Equal(t, "2500", report.Referrers[0].Amount)
+
+		future := time.Now().Add(time.Hour)
+		report, err = feeService.GetRevenueReport(PlatformRevenueFilter{Since: &future, IncludePending: true})
+		require.NoError(t, err)
+		assert.Zero(t, report.Sessions)
+	})
+}
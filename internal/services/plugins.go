@@ -0,0 +1,274 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// EnvPlugins lists the executables of the hook plugins, separated by the path list separator of the OS
+	EnvPlugins = "LAUNCHPAD_PLUGINS"
+	// EnvPluginTimeout bounds every call of a plugin (e.g. 5s)
+	EnvPluginTimeout = "LAUNCHPAD_PLUGIN_TIMEOUT"
+	// DefaultPluginTimeout is the time a plugin has to answer an event
+	DefaultPluginTimeout = 10 * time.Second
+	// maxPluginOutput bounds the response read from a plugin
+	maxPluginOutput = 1 << 20
+)
+
+type PluginEventType string
+
+const (
+	// PluginEventDescribe is sent once when the plugin is loaded, the plugin answers with its name and events
+	PluginEventDescribe PluginEventType = "describe"
+	// PluginEventSessionCreating is sent before a transaction session is stored. The plugin can append transactions
+	// and metadata to the session or reject it with an error
+	PluginEventSessionCreating PluginEventType = "session.creating"
+	// PluginEventSessionCreated is sent once the session is stored
+	PluginEventSessionCreated PluginEventType = "session.created"
+	// PluginEventTransactionConfirmed is sent after the built-in hooks handled a confirmed transaction
+	PluginEventTransactionConfirmed PluginEventType = "transaction.confirmed"
+)
+
+// ErrPluginRejected is returned when a plugin rejects a transaction session
+var ErrPluginRejected = errors.New("transaction session rejected by plugin")
+
+// PluginEvent is the JSON document written to the stdin of a plugin, one process is started per event
+type PluginEvent struct {
+	Event PluginEventType `json:"event"`
+	// Request is the session being created of session.creating and session.created
+	Request   *CreateTransactionSessionRequest `json:"request,omitempty"`
+	UserID    *string                          `json:"user_id,omitempty"`
+	SessionID string                           `json:"session_id,omitempty"`
+	// The confirmed transaction and its session of transaction.confirmed
+	TransactionType models.TransactionType     `json:"transaction_type,omitempty"`
+	TransactionHash string                     `json:"transaction_hash,omitempty"`
+	ContractAddress *string                    `json:"contract_address,omitempty"`
+	Session         *models.TransactionSession `json:"session,omitempty"`
+}
+
+// PluginResponse is the JSON document a plugin writes to its stdout, empty fields are ignored
+type PluginResponse struct {
+	// Name and Events answer describe, a plugin only receives the events it lists
+	Name   string            `json:"name,omitempty"`
+	Events []PluginEventType `json:"events,omitempty"`
+	// Transactions and Metadata are appended to the session of session.creating
+	Transactions []models.TransactionDeployment `json:"transactions,omitempty"`
+	Metadata     []models.TransactionMetadata   `json:"metadata,omitempty"`
+	// Error rejects the session of session.creating, it is logged for the other events
+	Error string `json:"error,omitempty"`
+}
+
+// Plugin is an executable speaking the exec-with-JSON protocol: it reads a PluginEvent on stdin and writes a
+// PluginResponse on stdout, a non-zero exit status is a failure of the call
+type Plugin struct {
+	Path    string
+	Name    string
+	Events  []PluginEventType
+	timeout time.Duration
+}
+
+// LoadPlugin describes the plugin at path to learn its name and the events it handles
+func LoadPlugin(path string, timeout time.Duration) (*Plugin, error) {
+	if timeout <= 0 {
+		timeout = DefaultPluginTimeout
+	}
+	plugin := &Plugin{Path: path, Name: filepath.Base(path), timeout: timeout}
+	response, err := plugin.Call(context.Background(), PluginEvent{Event: PluginEventDescribe})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe plugin %s: %w", path, err)
+	}
+	if response.Name != "" {
+		plugin.Name = response.Name
+	}
+	plugin.Events = response.Events
+	return plugin, nil
+}
+
+// LoadPluginsFromEnv loads the plugins of LAUNCHPAD_PLUGINS, there are none when it is not set
+func LoadPluginsFromEnv() ([]*Plugin, error) {
+	timeout := DefaultPluginTimeout
+	if value := os.Getenv(EnvPluginTimeout); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", EnvPluginTimeout, err)
+		}
+		timeout = parsed
+	}
+
+	var plugins []*Plugin
+	for _, path := range filepath.SplitList(os.Getenv(EnvPlugins)) {
+		if path = strings.TrimSpace(path); path == "" {
+			continue
+		}
+		plugin, err := LoadPlugin(path, timeout)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
+
+// Handles reports whether the plugin subscribed to the event
+func (p *Plugin) Handles(event PluginEventType) bool {
+	return slices.Contains(p.Events, event)
+}
+
+// Call runs the plugin with the event on its stdin and parses the response of its stdout
+func (p *Plugin) Call(ctx context.Context, event PluginEvent) (*PluginResponse, error) {
+	input, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &limitedWriter{buffer: &stdout, remaining: maxPluginOutput}
+	cmd.Stderr = &limitedWriter{buffer: &stderr, remaining: 4096}
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("plugin %s timed out after %s", p.Name, p.timeout)
+		}
+		return nil, fmt.Errorf("plugin %s failed: %w: %s", p.Name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var response PluginResponse
+	if output := bytes.TrimSpace(stdout.Bytes()); len(output) > 0 {
+		if err := json.Unmarshal(output, &response); err != nil {
+			return nil, fmt.Errorf("plugin %s returned an invalid response: %w", p.Name, err)
+		}
+	}
+	return &response, nil
+}
+
+// limitedWriter keeps the first bytes written and drops the rest so a plugin cannot exhaust the memory
+type limitedWriter struct {
+	buffer    *bytes.Buffer
+	remaining int
+}
+
+func (w *limitedWriter) Write(data []byte) (int, error) {
+	if w.remaining > 0 {
+		kept := data[:min(len(data), w.remaining)]
+		w.buffer.Write(kept)
+		w.remaining -= len(kept)
+	}
+	return len(data), nil
+}
+
+// pluginTransactionService lets the plugins extend or reject the sessions before they are stored
+type pluginTransactionService struct {
+	TransactionService
+	plugins []*Plugin
+}
+
+// NewPluginTransactionService wraps the service with the session.creating and session.created plugins, the service
+// is returned as is without plugins
+func NewPluginTransactionService(inner TransactionService, plugins []*Plugin) TransactionService {
+	if len(plugins) == 0 {
+		return inner
+	}
+	return &pluginTransactionService{TransactionService: inner, plugins: plugins}
+}
+
+func (s *pluginTransactionService) CreateTransactionSession(req CreateTransactionSessionRequest) (string, error) {
+	return s.CreateTransactionSessionWithUser(req, nil)
+}
+
+// CreateTransactionSessionWithUser fails closed: a plugin that cannot be run rejects the session, since the
+// transactions it would have appended may be required by the operator
+func (s *pluginTransactionService) CreateTransactionSessionWithUser(req CreateTransactionSessionRequest, userID *string) (string, error) {
+	ctx := context.Background()
+	// The appended transactions must not write into the slices of the caller
+	req.TransactionDeployments = slices.Clone(req.TransactionDeployments)
+	req.Metadata = slices.Clone(req.Metadata)
+	for _, plugin := range s.plugins {
+		if !plugin.Handles(PluginEventSessionCreating) {
+			continue
+		}
+		response, err := plugin.Call(ctx, PluginEvent{Event: PluginEventSessionCreating, Request: &req, UserID: userID})
+		if err != nil {
+			return "", err
+		}
+		if response.Error != "" {
+			return "", fmt.Errorf("%w %s: %s", ErrPluginRejected, plugin.Name, response.Error)
+		}
+		for _, tx := range response.Transactions {
+			if tx.TransactionType == "" {
+				tx.TransactionType = models.TransactionTypeRegular
+			}
+			req.TransactionDeployments = append(req.TransactionDeployments, tx)
+		}
+		req.Metadata = append(req.Metadata, response.Metadata...)
+	}
+
+	sessionID, err := s.TransactionService.CreateTransactionSessionWithUser(req, userID)
+	if err != nil {
+		return "", err
+	}
+	notifyPlugins(ctx, s.plugins, PluginEvent{Event: PluginEventSessionCreated, Request: &req, UserID: userID, SessionID: sessionID})
+	return sessionID, nil
+}
+
+// notifyPlugins sends an event whose response is only logged
+func notifyPlugins(ctx context.Context, plugins []*Plugin, event PluginEvent) {
+	for _, plugin := range plugins {
+		if !plugin.Handles(event.Event) {
+			continue
+		}
+		response, err := plugin.Call(ctx, event)
+		if err != nil {
+			log.Printf("Error sending %s to plugin %s: %v", event.Event, plugin.Name, err)
+			continue
+		}
+		if response.Error != "" {
+			log.Printf("Plugin %s returned an error for %s: %s", plugin.Name, event.Event, response.Error)
+		}
+	}
+}
+
+// pluginHookService sends the confirmed transactions to the plugins once the built-in hooks handled them
+type pluginHookService struct {
+	HookService
+	plugins []*Plugin
+}
+
+// NewPluginHookService wraps the hook service with the transaction.confirmed plugins, the service is returned as is
+// without plugins
+func NewPluginHookService(inner HookService, plugins []*Plugin) HookService {
+	if len(plugins) == 0 {
+		return inner
+	}
+	return &pluginHookService{HookService: inner, plugins: plugins}
+}
+
+// OnTransactionConfirmed never fails because of a plugin, the confirmation is already recorded by the built-in hooks
+func (h *pluginHookService) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	if err := h.HookService.OnTransactionConfirmed(txType, txHash, contractAddress, session); err != nil {
+		return err
+	}
+	notifyPlugins(context.Background(), h.plugins, PluginEvent{
+		Event:           PluginEventTransactionConfirmed,
+		TransactionType: txType,
+		TransactionHash: txHash,
+		ContractAddress: contractAddress,
+		Session:         &session,
+	})
+	return nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePlugin writes a shell plugin answering the events with the given responses, the other events are appended to
+// the log file
+func writePlugin(t *testing.T, describe, creating string) (string, string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell plugins are not supported on windows")
+	}
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "events.log")
+	script := `#!/bin/sh
+input=$(cat)
+case "$input" in
+  *'"event":"describe"'*) echo '` + describe + `' ;;
+  *'"event":"session.creating"'*) echo '` + creating + `' ;;
+  *) echo "$input" >> ` + logFile + ` ;;
+esac
+`
+	path := filepath.Join(dir, "plugin.sh")
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+	return path, logFile
+}
+
+// recordingTransactionService records the created sessions
+type recordingTransactionService struct {
+	TransactionService
+	requests []CreateTransactionSessionRequest
+}
+
+func (s *recordingTransactionService) CreateTransactionSessionWithUser(req CreateTransactionSessionRequest, userID *string) (string, error) {
+	s.requests = append(s.requests, req)
+	return "session-1", nil
+}
+
+// confirmationHook records the confirmed transactions
+type confirmationHook struct {
+	confirmed []string
+}
+
+func (h *confirmationHook) CanHandle(txType models.TransactionType) bool {
+	return true
+}
+
+func (h *confirmationHook) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	h.confirmed = append(h.confirmed, txHash)
+	return nil
+}
+
+func TestPlugins(t *testing.T) {
+	path, logFile := writePlugin(t,
+		`{"name":"launch-fee","events":["session.creating","session.created","transaction.confirmed"]}`,
+		`{"transactions":[{"title":"Launch fee","description":"Fee of the operator","value":"1000","receiver":"0x4444444444444444444444444444444444444444"}],"metadata":[{"key":"fee","value":"1000"}]}`,
+	)
+	plugin, err := LoadPlugin(path, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "launch-fee", plugin.Name)
+	assert.True(t, plugin.Handles(PluginEventSessionCreating))
+
+	t.Run("appends the transactions of the plugin to the session", func(t *testing.T) {
+		inner := &recordingTransactionService{}
+		txService := NewPluginTransactionService(inner, []*Plugin{plugin})
+		deployments := make([]models.TransactionDeployment, 1, 4)
+		deployments[0] = models.TransactionDeployment{Title: "Swap", TransactionType: models.TransactionTypeTokenSwap}
+
+		sessionID, err := txService.CreateTransactionSession(CreateTransactionSessionRequest{TransactionDeployments: deployments, ChainType: models.TransactionChainTypeEthereum})
+		require.NoError(t, err)
+		assert.Equal(t, "session-1", sessionID)
+
+		require.Len(t, inner.requests, 1)
+		require.Len(t, inner.requests[0].TransactionDeployments, 2)
+		fee := inner.requests[0].TransactionDeployments[1]
+		assert.Equal(t, "Launch fee", fee.Title)
+		assert.Equal(t, models.TransactionTypeRegular, fee.TransactionType)
+		assert.Equal(t, []models.TransactionMetadata{{Key: "fee", Value: "1000"}}, inner.requests[0].Metadata)
+		// The slice of the caller is left as is
+		assert.Empty(t, deployments[:2][1].Title)
+
+		events, err := os.ReadFile(logFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(events), `"event":"session.created"`)
+		assert.Contains(t, string(events), `"session_id":"session-1"`)
+	})
+
+	t.Run("sends the confirmed transactions after the built-in hooks", func(t *testing.T) {
+		hook := &confirmationHook{}
+		hookService := NewPluginHookService(NewHookService(), []*Plugin{plugin})
+		require.NoError(t, hookService.AddHook(hook))
+		require.NoError(t, hookService.OnTransactionConfirmed(models.TransactionTypeTokenSwap, "0xabc", nil, models.TransactionSession{ID: "session-1"}))
+		assert.Equal(t, []string{"0xabc"}, hook.confirmed)
+
+		events, err := os.ReadFile(logFile)
+		require.NoError(t, err)
+		assert.Contains(t, string(events), `"transaction_hash":"0xabc"`)
+	})
+
+	t.Run("rejects the session on a plugin error", func(t *testing.T) {
+		rejecting, _ := writePlugin(t, `{"events":["session.creating"]}`, `{"error":"launches are paused"}`)
+		plugin, err := LoadPlugin(rejecting, time.Minute)
+		require.NoError(t, err)
+
+		inner := &recordingTransactionService{}
+		_, err = NewPluginTransactionService(inner, []*Plugin{plugin}).CreateTransactionSession(CreateTransactionSessionRequest{})
+		assert.ErrorIs(t, err, ErrPluginRejected)
+		assert.ErrorContains(t, err, "launches are paused")
+		assert.Empty(t, inner.requests)
+	})
+
+	t.Run("fails when the plugin cannot be described", func(t *testing.T) {
+		_, err := LoadPlugin(filepath.Join(t.TempDir(), "missing"), time.Minute)
+		assert.Error(t, err)
+
+		broken, _ := writePlugin(t, `not json`, `{}`)
+		_, err = LoadPlugin(broken, time.Minute)
+		assert.True(t, err != nil && strings.Contains(err.Error(), "invalid response"))
+	})
+
+	t.Run("returns the services as is without plugins", func(t *testing.T) {
+		inner := &recordingTransactionService{}
+		assert.Same(t, inner, NewPluginTransactionService(inner, nil))
+	})
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultAlertPollInterval is how often the monitor evaluates the enabled alert rules
+const DefaultAlertPollInterval = time.Minute
+
+// PoolAlertMonitor evaluates the pool health alert rules against the pair reserves and the indexed LP transfers and
+// delivers the rules that fire through the alert notifier
+type PoolAlertMonitor struct {
+	alertService           AlertService
+	liquidityService       LiquidityService
+	uniswapContractService UniswapContractService
+	indexerService         IndexerService
+	notifier               AlertNotifier
+	interval               time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewPoolAlertMonitor(alertService AlertService, liquidityService LiquidityService, uniswapContractService UniswapContractService, indexerService IndexerService, notifier AlertNotifier, interval time.Duration) *PoolAlertMonitor {
+	if interval <= 0 {
+		interval = DefaultAlertPollInterval
+	}
+	return &PoolAlertMonitor{
+		alertService:           alertService,
+		liquidityService:       liquidityService,
+		uniswapContractService: uniswapContractService,
+		indexerService:         indexerService,
+		notifier:               notifier,
+		interval:               interval,
+	}
+}
+
+// Start evaluates the rules in the background until Stop is called
+func (m *PoolAlertMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.RunOnce()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background evaluation and waits for the current run to finish
+func (m *PoolAlertMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// RunOnce evaluates every enabled rule once
+func (m *PoolAlertMonitor) RunOnce() {
+	rules, err := m.alertService.ListEnabledAlertRules()
+	if err != nil {
+		log.Printf("Error listing alert rules: %v", err)
+		return
+	}
+
+	for _, rule := range rules {
+		if err := m.checkRule(rule); err != nil {
+			log.Printf("Error checking alert rule %d: %v", rule.ID, err)
+		}
+	}
+}
+
+func (m *PoolAlertMonitor) checkRule(rule models.AlertRule) error {
+	pool, err := m.liquidityService.GetLiquidityPool(rule.PoolID)
+	if err != nil {
+		return fmt.Errorf("failed to get pool: %w", err)
+	}
+	if rule.Type == models.AlertRuleTypeCreatorRemoval {
+		return m.checkCreatorRemoval(rule, pool)
+	}
+
+	reserve0, reserve1, err := m.uniswapContractService.GetReserves(pool.PairAddress, &rule.Chain)
+	if err != nil {
+		return fmt.Errorf("failed to get reserves: %w", err)
+	}
+	baseline0, ok0 := new(big.Int).SetString(rule.BaselineReserve0, 10)
+	baseline1, ok1 := new(big.Int).SetString(rule.BaselineReserve1, 10)
+	if !ok0 || !ok1 {
+		return m.alertService.UpdateAlertBaseline(rule.ID, reserve0.String(), reserve1.String(), rule.LastCheckedBlock)
+	}
+
+	alert := PoolAlert{Reserve0: reserve0.String(), Reserve1: reserve1.String()}
+	switch rule.Type {
+	case models.AlertRuleTypeReserveDrop:
+		drop := utils.ReserveDropPercent(baseline0, baseline1, reserve0, reserve1)
+		if drop < rule.ThresholdPercent {
+			// The drop is measured from the highest reserves seen since the rule last fired
+			high0, high1 := maxBigInt(baseline0, reserve0), maxBigInt(baseline1, reserve1)
+			if high0.Cmp(baseline0) == 0 && high1.Cmp(baseline1) == 0 {
+				return nil
+			}
+			return m.alertService.UpdateAlertBaseline(rule.ID, high0.String(), high1.String(), rule.LastCheckedBlock)
+		}
+		alert.ChangePercent = -drop
+		alert.Message = fmt.Sprintf("Pool %d (%s): reserves dropped %.2f%% (reserve0 %s, reserve1 %s)", pool.ID, pool.PairAddress, drop, reserve0, reserve1)
+	case models.AlertRuleTypePriceMove:
+		move := utils.PriceMovePercent(baseline0, baseline1, reserve0, reserve1)
+		if math.Abs(move) < rule.ThresholdPercent {
+			return nil
+		}
+		alert.ChangePercent = move
+		alert.Message = fmt.Sprintf("Pool %d (%s): price moved %+.2f%% (reserve0 %s, reserve1 %s)", pool.ID, pool.PairAddress, move, reserve0, reserve1)
+	default:
+		return fmt.Errorf("unsupported alert rule type %q", rule.Type)
+	}
+	return m.fire(rule, pool, alert, reserve0.String(), reserve1.String(), rule.LastCheckedBlock)
+}
+
+// checkCreatorRemoval looks for LP tokens sent to the pair by the creator in the newly indexed blocks, which is how
+// the router removes liquidity for both the token and the ETH pairs
+func (m *PoolAlertMonitor) checkCreatorRemoval(rule models.AlertRule, pool *models.LiquidityPool) error {
+	lastSyncedBlock, indexed, err := m.indexerService.GetLastSyncedBlock(rule.ChainID, pool.PairAddress)
+	if err != nil {
+		return fmt.Errorf("failed to read indexer cursor: %w", err)
+	}
+	if !indexed || lastSyncedBlock <= rule.LastCheckedBlock {
+		return nil
+	}
+
+	transfers, err := m.indexerService.ListEvents(rule.ChainID, pool.PairAddress, models.IndexedEventTypeTransfer)
+	if err != nil {
+		return fmt.Errorf("failed to list indexed LP transfers: %w", err)
+	}
+	var removals []models.IndexedEvent
+	for _, transfer := range transfers {
+		if transfer.BlockNumber <= rule.LastCheckedBlock || transfer.BlockNumber > lastSyncedBlock {
+			continue
+		}
+		// Removals that happened before the rule was created are not reported
+		if transfer.BlockTime != nil && transfer.BlockTime.Before(rule.CreatedAt) {
+			continue
+		}
+		if strings.EqualFold(transfer.Sender, pool.CreatorAddress) && strings.EqualFold(transfer.Recipient, pool.PairAddress) {
+			removals = append(removals, transfer)
+		}
+	}
+	if len(removals) == 0 {
+		return m.alertService.UpdateAlertBaseline(rule.ID, rule.BaselineReserve0, rule.BaselineReserve1, lastSyncedBlock)
+	}
+
+	burnt := new(big.Int)
+	for _, removal := range removals {
+		if value, ok := new(big.Int).SetString(removal.Value, 10); ok {
+			burnt.Add(burnt, value)
+		}
+	}
+	alert := PoolAlert{
+		TransactionHash: removals[len(removals)-1].TransactionHash,
+		Message: fmt.Sprintf("Pool %d (%s): the creator %s removed %s LP tokens of liquidity in %d transaction(s)",
+			pool.ID, pool.PairAddress, pool.CreatorAddress, burnt, len(removals)),
+	}
+	return m.fire(rule, pool, alert, rule.BaselineReserve0, rule.BaselineReserve1, lastSyncedBlock)
+}
+
+// fire delivers the alert and resets the baseline of the rule. A failed delivery is recorded on the rule rather than
+// retried, the rule fires again on the next move past its threshold
+func (m *PoolAlertMonitor) fire(rule models.AlertRule, pool *models.LiquidityPool, alert PoolAlert, reserve0, reserve1 string, lastCheckedBlock uint64) error {
+	alert.RuleID = rule.ID
+	alert.PoolID = pool.ID
+	alert.ChainID = rule.Chain.NetworkID
+	alert.PairAddress = pool.PairAddress
+	alert.Type = rule.Type
+	alert.TriggeredAt = time.Now().UTC()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	deliveryErr := m.notifier.Notify(ctx, rule.Channel, rule.Target, alert)
+	deliveryError := ""
+	if deliveryErr != nil {
+		deliveryError = deliveryErr.Error()
+	}
+	if err := m.alertService.MarkAlertTriggered(rule.ID, reserve0, reserve1, lastCheckedBlock, deliveryError); err != nil {
+		return err
+	}
+	if deliveryErr != nil {
+		return deliveryErr
+	}
+	log.Printf("Alert rule %d fired on pool %d: %s", rule.ID, pool.ID, alert.Message)
+	return nil
+}
+
+func maxBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
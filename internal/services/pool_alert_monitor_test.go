@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNotifier records the delivered alerts
+type recordingNotifier struct {
+	alerts []PoolAlert
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, channel models.AlertChannel, target string, alert PoolAlert) error {
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func (n *recordingNotifier) TelegramEnabled() bool {
+	return true
+}
+
+func TestPoolAlertMonitor(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, NewChainService(db).CreateChain(chain))
+	liquidityService := NewLiquidityService(db)
+	creator := "0x4444444444444444444444444444444444444444"
+	pool := &models.LiquidityPool{TokenAddress: limitOrderTestToken, PairAddress: limitOrderTestPair, CreatorAddress: creator, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+
+	alertService := NewAlertService(db)
+	indexerService := NewIndexerService(db)
+	contractService := &fakeUniswapContractService{reserve0: big.NewInt(1000), reserve1: big.NewInt(100)}
+	notifier := &recordingNotifier{}
+	monitor := NewPoolAlertMonitor(alertService, liquidityService, contractService, indexerService, notifier, time.Minute)
+
+	createRule := func(rule models.AlertRule) *models.AlertRule {
+		rule.ChainID = chain.ID
+		rule.PoolID = pool.ID
+		rule.Channel = models.AlertChannelWebhook
+		rule.Target = "http://localhost/hook"
+		require.NoError(t, alertService.CreateAlertRule(&rule))
+		return &rule
+	}
+
+	t.Run("fires once the reserves drop past the threshold from their high", func(t *testing.T) {
+		notifier.alerts = nil
+		rule := createRule(models.AlertRule{Type: models.AlertRuleTypeReserveDrop, ThresholdPercent: 20, BaselineReserve0: "1000", BaselineReserve1: "100"})
+		t.Cleanup(func() { alertService.DeleteAlertRule(rule.ID) })
+
+		// The reserves grow, the high moves up
+		contractService.reserve0, contractService.reserve1 = big.NewInt(2000), big.NewInt(100)
+		monitor.RunOnce()
+		assert.Empty(t, notifier.alerts)
+
+		// 1500 is a 25% drop from the 2000 high but not from the 1000 baseline
+		contractService.reserve0 = big.NewInt(1500)
+		monitor.RunOnce()
+		require.Len(t, notifier.alerts, 1)
+		assert.Equal(t, models.AlertRuleTypeReserveDrop, notifier.alerts[0].Type)
+		assert.InDelta(t, -25.0, notifier.alerts[0].ChangePercent, 1e-9)
+
+		stored, err := alertService.GetAlertRule(rule.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stored.TriggerCount)
+		assert.Equal(t, "1500", stored.BaselineReserve0)
+		assert.NotNil(t, stored.LastTriggeredAt)
+
+		// The rule is re-armed from the reserves it fired at
+		monitor.RunOnce()
+		assert.Len(t, notifier.alerts, 1)
+	})
+
+	t.Run("fires on price moves in either direction", func(t *testing.T) {
+		notifier.alerts = nil
+		contractService.reserve0, contractService.reserve1 = big.NewInt(1000), big.NewInt(100)
+		rule := createRule(models.AlertRule{Type: models.AlertRuleTypePriceMove, ThresholdPercent: 10})
+		t.Cleanup(func() { alertService.DeleteAlertRule(rule.ID) })
+
+		// The first run records the baseline
+		monitor.RunOnce()
+		assert.Empty(t, notifier.alerts)
+
+		contractService.reserve1 = big.NewInt(105)
+		monitor.RunOnce()
+		assert.Empty(t, notifier.alerts)
+
+		contractService.reserve1 = big.NewInt(80)
+		monitor.RunOnce()
+		require.Len(t, notifier.alerts, 1)
+		assert.InDelta(t, -20.0, notifier.alerts[0].ChangePercent, 1e-9)
+	})
+
+	t.Run("fires when the creator removes liquidity", func(t *testing.T) {
+		notifier.alerts = nil
+		rule := createRule(models.AlertRule{Type: models.AlertRuleTypeCreatorRemoval, LastCheckedBlock: 10})
+		t.Cleanup(func() { alertService.DeleteAlertRule(rule.ID) })
+
+		// Pair not indexed yet
+		monitor.RunOnce()
+		assert.Empty(t, notifier.alerts)
+
+		require.NoError(t, indexerService.ApplyEvents(chain.ID, limitOrderTestPair, []models.IndexedEvent{
+			// Before the rule
+			{EventType: models.IndexedEventTypeTransfer, BlockNumber: 5, TransactionHash: "0xold", Sender: creator, Recipient: limitOrderTestPair, Value: "10"},
+			// A transfer of LP tokens to another holder is not a removal
+			{EventType: models.IndexedEventTypeTransfer, BlockNumber: 11, TransactionHash: "0xmove", Sender: creator, Recipient: limitOrderTestToken, Value: "10"},
+		}, 11))
+		monitor.RunOnce()
+		assert.Empty(t, notifier.alerts)
+
+		require.NoError(t, indexerService.ApplyEvents(chain.ID, limitOrderTestPair, []models.IndexedEvent{
+			{EventType: models.IndexedEventTypeTransfer, BlockNumber: 12, TransactionHash: "0xremove", Sender: creator, Recipient: limitOrderTestPair, Value: "40"},
+		}, 12))
+		monitor.RunOnce()
+		require.Len(t, notifier.alerts, 1)
+		assert.Equal(t, "0xremove", notifier.alerts[0].TransactionHash)
+		assert.Contains(t, notifier.alerts[0].Message, "removed 40 LP tokens")
+
+		stored, err := alertService.GetAlertRule(rule.ID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(12), stored.LastCheckedBlock)
+	})
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvPriceAPIURL is the base URL of the CoinGecko compatible API pricing the native tokens in USD
+	EnvPriceAPIURL = "LAUNCHPAD_PRICE_API_URL"
+	// DefaultPriceAPIURL is the public CoinGecko API
+	DefaultPriceAPIURL = "https://api.coingecko.com/api/v3"
+)
+
+// NativeCurrency is the native token of a chain and its CoinGecko coin ID
+type NativeCurrency struct {
+	Symbol string `json:"symbol"`
+	CoinID string `json:"-"`
+}
+
+// nativeCurrencies maps the chain IDs of the common EVM chains to their native token
+var nativeCurrencies = map[string]NativeCurrency{
+	"1":        {Symbol: "ETH", CoinID: "ethereum"},
+	"10":       {Symbol: "ETH", CoinID: "ethereum"},
+	"56":       {Symbol: "BNB", CoinID: "binancecoin"},
+	"100":      {Symbol: "XDAI", CoinID: "xdai"},
+	"137":      {Symbol: "POL", CoinID: "polygon-ecosystem-token"},
+	"8453":     {Symbol: "ETH", CoinID: "ethereum"},
+	"42161":    {Symbol: "ETH", CoinID: "ethereum"},
+	"43114":    {Symbol: "AVAX", CoinID: "avalanche-2"},
+	"59144":    {Symbol: "ETH", CoinID: "ethereum"},
+	"11155111": {Symbol: "ETH"},
+	"31337":    {Symbol: "ETH"},
+}
+
+// PriceService prices the native tokens of the configured chains in USD
+type PriceService interface {
+	// NativeCurrency returns the native token of the chain, ETH for unknown chains
+	NativeCurrency(chainID string) NativeCurrency
+	// NativeUSDPrices returns the USD price of the native token of every chain ID with a known coin,
+	// testnets and unknown chains are left out
+	NativeUSDPrices(ctx context.Context, chainIDs []string) (map[string]float64, error)
+}
+
+type priceService struct {
+	client *http.Client
+	apiURL string
+}
+
+func NewPriceService(client *http.Client, apiURL string) PriceService {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if apiURL == "" {
+		apiURL = DefaultPriceAPIURL
+	}
+	return &priceService{client: client, apiURL: strings.TrimSuffix(apiURL, "/")}
+}
+
+// NewPriceServiceFromEnv prices through LAUNCHPAD_PRICE_API_URL, the public CoinGecko API when it is not set
+func NewPriceServiceFromEnv() PriceService {
+	return NewPriceService(nil, os.Getenv(EnvPriceAPIURL))
+}
+
+func (s *priceService) NativeCurrency(chainID string) NativeCurrency {
+	if currency, ok := nativeCurrencies[chainID]; ok {
+		return currency
+	}
+	return NativeCurrency{Symbol: "ETH"}
+}
+
+func (s *priceService) NativeUSDPrices(ctx context.Context, chainIDs []string) (map[string]float64, error) {
+	coinIDs := map[string]bool{}
+	for _, chainID := range chainIDs {
+		if coinID := s.NativeCurrency(chainID).CoinID; coinID != "" {
+			coinIDs[coinID] = true
+		}
+	}
+	prices := map[string]float64{}
+	if len(coinIDs) == 0 {
+		return prices, nil
+	}
+
+	ids := make([]string, 0, len(coinIDs))
+	for coinID := range coinIDs {
+		ids = append(ids, coinID)
+	}
+	query := url.Values{"ids": {strings.Join(ids, ",")}, "vs_currencies": {"usd"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.apiURL+"/simple/price?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch prices: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price API returned %s", resp.Status)
+	}
+
+	var coins map[string]struct {
+		USD float64 `json:"usd"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&coins); err != nil {
+		return nil, fmt.Errorf("failed to parse prices: %w", err)
+	}
+	for _, chainID := range chainIDs {
+		if coin, ok := coins[s.NativeCurrency(chainID).CoinID]; ok && coin.USD > 0 {
+			prices[chainID] = coin.USD
+		}
+	}
+	return prices, nil
+}
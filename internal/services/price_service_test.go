@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceServiceNativeUSDPrices(t *testing.T) {
+	var requestedIDs string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/simple/price", r.URL.Path)
+		assert.Equal(t, "usd", r.URL.Query().Get("vs_currencies"))
+		requestedIDs = r.URL.Query().Get("ids")
+		_, _ = w.Write([]byte(`{"ethereum": {"usd": 3000.5}, "binancecoin": {"usd": 600}}`))
+	}))
+	defer server.Close()
+
+	service := NewPriceService(server.Client(), server.URL+"/")
+	prices, err := service.NativeUSDPrices(context.Background(), []string{"1", "8453", "56", "31337"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"1": 3000.5, "8453": 3000.5, "56": 600}, prices)
+	assert.ElementsMatch(t, []string{"ethereum", "binancecoin"}, strings.Split(requestedIDs, ","))
+
+	assert.Equal(t, "BNB", service.NativeCurrency("56").Symbol)
+	assert.Equal(t, "ETH", service.NativeCurrency("999999").Symbol)
+}
+
+func TestPriceServiceSkipsTestnets(t *testing.T) {
+	service := NewPriceService(nil, "http://127.0.0.1:1")
+	prices, err := service.NativeUSDPrices(context.Background(), []string{"31337", "11155111"})
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+}
+
+func TestPriceServiceReportsAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	_, err := NewPriceService(server.Client(), server.URL).NativeUSDPrices(context.Background(), []string{"1"})
+	assert.ErrorContains(t, err, "429")
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// DefaultPrivateTransactionPollInterval is how often the monitor checks the pending private transactions
+	DefaultPrivateTransactionPollInterval = 12 * time.Second
+	// DefaultPrivateTransactionTimeout is how long a private transaction may stay pending before it is expired.
+	// Relays retry a transaction for a limited number of blocks, Flashbots Protect for 25 by default.
+	DefaultPrivateTransactionTimeout = 30 * time.Minute
+)
+
+// PrivateTransactionMonitor tracks the transactions submitted through private relays until they are included,
+// dropped by the relay or expired
+type PrivateTransactionMonitor struct {
+	privateTransactionService PrivateTransactionService
+	interval                  time.Duration
+	timeout                   time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewPrivateTransactionMonitor(privateTransactionService PrivateTransactionService, interval, timeout time.Duration) *PrivateTransactionMonitor {
+	if interval <= 0 {
+		interval = DefaultPrivateTransactionPollInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultPrivateTransactionTimeout
+	}
+	return &PrivateTransactionMonitor{
+		privateTransactionService: privateTransactionService,
+		interval:                  interval,
+		timeout:                   timeout,
+	}
+}
+
+// Start polls the pending private transactions in the background until Stop is called
+func (m *PrivateTransactionMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.CheckTransactions()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background polling and waits for the current check to finish
+func (m *PrivateTransactionMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// CheckTransactions checks every pending private transaction once
+func (m *PrivateTransactionMonitor) CheckTransactions() {
+	transactions, err := m.privateTransactionService.ListPrivateTransactionsByStatus(models.PrivateTransactionStatusPending)
+	if err != nil {
+		log.Printf("Error listing pending private transactions: %v", err)
+		return
+	}
+
+	for _, transaction := range transactions {
+		if err := m.checkTransaction(transaction); err != nil {
+			log.Printf("Error checking private transaction %s: %v", transaction.TransactionHash, err)
+		}
+	}
+}
+
+func (m *PrivateTransactionMonitor) checkTransaction(transaction models.PrivateTransaction) error {
+	// The receipt is the source of truth, relays may report a transaction before the chain RPC sees it
+	receipt, err := getTransactionReceipt(transaction.Chain.RPC, transaction.TransactionHash)
+	if err != nil {
+		return err
+	}
+
+	if receipt != nil {
+		if receipt.Status != "0x1" {
+			return m.privateTransactionService.UpdatePrivateTransactionStatus(transaction.ID, models.PrivateTransactionStatusFailed, "transaction reverted on chain")
+		}
+		blockNumber, err := strconv.ParseUint(strings.TrimPrefix(receipt.BlockNumber, "0x"), 16, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse block number: %w", err)
+		}
+		log.Printf("Private transaction %s was included in block %d", transaction.TransactionHash, blockNumber)
+		return m.privateTransactionService.MarkPrivateTransactionIncluded(transaction.ID, blockNumber)
+	}
+
+	if transaction.StatusURL != "" {
+		status, err := utils.FetchPrivateRelayStatus(transaction.StatusURL)
+		if err != nil {
+			return err
+		}
+		switch status {
+		case utils.PrivateRelayStatusFailed:
+			return m.privateTransactionService.UpdatePrivateTransactionStatus(transaction.ID, models.PrivateTransactionStatusFailed, "relay dropped the transaction without including it")
+		case utils.PrivateRelayStatusCancelled:
+			return m.privateTransactionService.UpdatePrivateTransactionStatus(transaction.ID, models.PrivateTransactionStatusCancelled, "transaction was cancelled")
+		}
+	}
+
+	if time.Since(transaction.CreatedAt) > m.timeout {
+		return m.privateTransactionService.UpdatePrivateTransactionStatus(transaction.ID, models.PrivateTransactionStatusExpired, fmt.Sprintf("transaction was not included within %s", m.timeout))
+	}
+	return nil
+}
+
+// getTransactionReceipt returns the receipt of the transaction, or nil when it is not mined yet
+func getTransactionReceipt(rpcURL, txHash string) (*utils.TransactionReceipt, error) {
+	response, err := utils.NewRPCClient(rpcURL).Call("eth_getTransactionReceipt", []interface{}{txHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+	if response.Result == nil {
+		return nil, nil
+	}
+
+	receiptData, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal receipt data: %w", err)
+	}
+
+	var receipt utils.TransactionReceipt
+	if err := json.Unmarshal(receiptData, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal receipt: %w", err)
+	}
+	return &receipt, nil
+}
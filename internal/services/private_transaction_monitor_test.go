@@ -0,0 +1,147 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const privateTransactionTestHash = "0xabababababababababababababababababababababababababababababababab"
+
+type privateTransactionMonitorFixture struct {
+	service PrivateTransactionService
+	monitor *PrivateTransactionMonitor
+	chain   *models.Chain
+	// receipt is returned by eth_getTransactionReceipt, nil while the transaction is not mined
+	receipt map[string]string
+	// relayStatus is returned by the relay status API
+	relayStatus string
+	statusURL   string
+}
+
+func setupPrivateTransactionMonitor(t *testing.T) *privateTransactionMonitorFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Chain{}, &models.PrivateTransaction{}))
+
+	fixture := &privateTransactionMonitorFixture{relayStatus: "PENDING"}
+
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result interface{}
+		if fixture.receipt != nil {
+			result = fixture.receipt
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	t.Cleanup(rpcServer.Close)
+
+	statusServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": fixture.relayStatus})
+	}))
+	t.Cleanup(statusServer.Close)
+	fixture.statusURL = statusServer.URL + "/tx/" + privateTransactionTestHash
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: rpcServer.URL, NetworkID: "1", Name: "Mainnet"}
+	require.NoError(t, db.Create(chain).Error)
+
+	fixture.chain = chain
+	fixture.service = NewPrivateTransactionService(db)
+	fixture.monitor = NewPrivateTransactionMonitor(fixture.service, time.Hour, time.Hour)
+	return fixture
+}
+
+func (f *privateTransactionMonitorFixture) submit(t *testing.T, statusURL string) *models.PrivateTransaction {
+	transaction := &models.PrivateTransaction{
+		SessionId:       "session-1",
+		ChainID:         f.chain.ID,
+		TransactionHash: privateTransactionTestHash,
+		RelayRPC:        "https://rpc.flashbots.net",
+		StatusURL:       statusURL,
+	}
+	require.NoError(t, f.service.CreatePrivateTransaction(transaction))
+	return transaction
+}
+
+func (f *privateTransactionMonitorFixture) status(t *testing.T) *models.PrivateTransaction {
+	transaction, err := f.service.GetLatestPrivateTransaction("session-1", 0)
+	require.NoError(t, err)
+	return transaction
+}
+
+func TestPrivateTransactionMonitorStaysPendingUntilMined(t *testing.T) {
+	f := setupPrivateTransactionMonitor(t)
+	f.submit(t, f.statusURL)
+
+	f.monitor.CheckTransactions()
+	assert.Equal(t, models.PrivateTransactionStatusPending, f.status(t).Status)
+}
+
+func TestPrivateTransactionMonitorMarksIncluded(t *testing.T) {
+	f := setupPrivateTransactionMonitor(t)
+	f.submit(t, f.statusURL)
+	f.receipt = map[string]string{"transactionHash": privateTransactionTestHash, "blockNumber": "0x10", "status": "0x1"}
+
+	f.monitor.CheckTransactions()
+
+	transaction := f.status(t)
+	assert.Equal(t, models.PrivateTransactionStatusIncluded, transaction.Status)
+	assert.Equal(t, uint64(16), transaction.BlockNumber)
+	assert.NotNil(t, transaction.IncludedAt)
+}
+
+func TestPrivateTransactionMonitorMarksReverted(t *testing.T) {
+	f := setupPrivateTransactionMonitor(t)
+	f.submit(t, "")
+	f.receipt = map[string]string{"transactionHash": privateTransactionTestHash, "blockNumber": "0x10", "status": "0x0"}
+
+	f.monitor.CheckTransactions()
+
+	transaction := f.status(t)
+	assert.Equal(t, models.PrivateTransactionStatusFailed, transaction.Status)
+	assert.Contains(t, transaction.Error, "reverted")
+}
+
+func TestPrivateTransactionMonitorUsesRelayStatus(t *testing.T) {
+	f := setupPrivateTransactionMonitor(t)
+	f.submit(t, f.statusURL)
+
+	f.relayStatus = "FAILED"
+	f.monitor.CheckTransactions()
+	assert.Equal(t, models.PrivateTransactionStatusFailed, f.status(t).Status)
+
+	// Failed transactions are no longer watched
+	f.relayStatus = "CANCELLED"
+	f.monitor.CheckTransactions()
+	assert.Equal(t, models.PrivateTransactionStatusFailed, f.status(t).Status)
+}
+
+func TestPrivateTransactionMonitorMarksCancelled(t *testing.T) {
+	f := setupPrivateTransactionMonitor(t)
+	f.submit(t, f.statusURL)
+	f.relayStatus = "CANCELLED"
+
+	f.monitor.CheckTransactions()
+	assert.Equal(t, models.PrivateTransactionStatusCancelled, f.status(t).Status)
+}
+
+func TestPrivateTransactionMonitorExpiresPendingTransactions(t *testing.T) {
+	f := setupPrivateTransactionMonitor(t)
+	f.submit(t, "")
+	f.monitor = NewPrivateTransactionMonitor(f.service, time.Hour, time.Nanosecond)
+
+	f.monitor.CheckTransactions()
+
+	transaction := f.status(t)
+	assert.Equal(t, models.PrivateTransactionStatusExpired, transaction.Status)
+	assert.NotEmpty(t, transaction.Error)
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+type PrivateTransactionService interface {
+	CreatePrivateTransaction(transaction *models.PrivateTransaction) error
+	// GetLatestPrivateTransaction returns the last submission of a transaction of the session
+	GetLatestPrivateTransaction(sessionId string, transactionIndex int) (*models.PrivateTransaction, error)
+	ListPrivateTransactionsByStatus(status models.PrivateTransactionStatus) ([]models.PrivateTransaction, error)
+	// MarkPrivateTransactionIncluded stores the block the transaction was mined in
+	MarkPrivateTransactionIncluded(id uint, blockNumber uint64) error
+	UpdatePrivateTransactionStatus(id uint, status models.PrivateTransactionStatus, errorMessage string) error
+}
+
+type privateTransactionService struct {
+	db *gorm.DB
+}
+
+func NewPrivateTransactionService(db *gorm.DB) PrivateTransactionService {
+	return &privateTransactionService{db: db}
+}
+
+func (s *privateTransactionService) CreatePrivateTransaction(transaction *models.PrivateTransaction) error {
+	if transaction.Status == "" {
+		transaction.Status = models.PrivateTransactionStatusPending
+	}
+	return s.db.Create(transaction).Error
+}
+
+func (s *privateTransactionService) GetLatestPrivateTransaction(sessionId string, transactionIndex int) (*models.PrivateTransaction, error) {
+	var transaction models.PrivateTransaction
+	err := s.db.Where("session_id = ? AND transaction_index = ?", sessionId, transactionIndex).
+		Order("id DESC").
+		First(&transaction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+func (s *privateTransactionService) ListPrivateTransactionsByStatus(status models.PrivateTransactionStatus) ([]models.PrivateTransaction, error) {
+	var transactions []models.PrivateTransaction
+	err := s.db.Preload("Chain").Where("status = ?", status).Order("created_at ASC").Find(&transactions).Error
+	return transactions, err
+}
+
+func (s *privateTransactionService) MarkPrivateTransactionIncluded(id uint, blockNumber uint64) error {
+	now := time.Now()
+	return s.db.Model(&models.PrivateTransaction{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":       models.PrivateTransactionStatusIncluded,
+		"block_number": blockNumber,
+		"included_at":  &now,
+	}).Error
+}
+
+func (s *privateTransactionService) UpdatePrivateTransactionStatus(id uint, status models.PrivateTransactionStatus, errorMessage string) error {
+	return s.db.Model(&models.PrivateTransaction{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status": status,
+		"error":  errorMessage,
+	}).Error
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProjectAssets are the records assigned to a project
+type ProjectAssets struct {
+	Templates      []models.Template
+	Deployments    []models.Deployment
+	LiquidityPools []models.LiquidityPool
+	Sessions       []models.TransactionSession
+}
+
+type ProjectService interface {
+	CreateProject(project *models.Project) error
+	// GetProject returns the project with its asset assignments
+	GetProject(id uint) (*models.Project, error)
+	// GetProjectByName returns the project of the user with the name, matching every user when userID is nil
+	GetProjectByName(userID *string, name string) (*models.Project, error)
+	// ListProjects returns the projects of the user with their asset assignments, every project when userID is nil, newest first.
+	// Only the projects tagged with tag are returned when it is not empty
+	ListProjects(userID *string, tag string) ([]models.Project, error)
+	// AssignAssets assigns the records to the project, moving them out of the project they were assigned to.
+	// The records must exist and belong to the user when userID is not nil, none is assigned otherwise
+	AssignAssets(userID *string, projectID uint, assetType models.ProjectAssetType, assetIDs []string) error
+	// GetProjectAssets loads the records assigned to the project
+	GetProjectAssets(project *models.Project) (*ProjectAssets, error)
+}
+
+type projectService struct {
+	db *gorm.DB
+}
+
+func NewProjectService(db *gorm.DB) ProjectService {
+	return &projectService{db: db}
+}
+
+func (s *projectService) CreateProject(project *models.Project) error {
+	return s.db.Create(project).Error
+}
+
+func (s *projectService) GetProject(id uint) (*models.Project, error) {
+	var project models.Project
+	err := s.db.Preload("Assets", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).First(&project, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (s *projectService) GetProjectByName(userID *string, name string) (*models.Project, error) {
+	var project models.Project
+	query := s.db.Where("name = ?", name)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	if err := query.First(&project).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (s *projectService) ListProjects(userID *string, tag string) ([]models.Project, error) {
+	var projects []models.Project
+	query := s.db.Preload("Assets").Order("id DESC")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	if err := query.Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	if tag == "" {
+		return projects, nil
+	}
+
+	// The tags are stored as a JSON array, they are matched here to work on every database
+	var tagged []models.Project
+	for _, project := range projects {
+		if project.HasTag(tag) {
+			tagged = append(tagged, project)
+		}
+	}
+	return tagged, nil
+}
+
+func (s *projectService) AssignAssets(userID *string, projectID uint, assetType models.ProjectAssetType, assetIDs []string) error {
+	model, err := projectAssetModel(assetType)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, assetID := range assetIDs {
+			query := tx.Model(model).Where("id = ?", assetID)
+			if userID != nil {
+				query = query.Where("user_id = ?", *userID)
+			}
+			var count int64
+			if err := query.Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return fmt.Errorf("%s %s not found: %w", assetType, assetID, gorm.ErrRecordNotFound)
+			}
+
+			asset := models.ProjectAsset{ProjectID: projectID, AssetType: assetType, AssetID: assetID}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "asset_type"}, {Name: "asset_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{"project_id"}),
+			}).Create(&asset).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *projectService) GetProjectAssets(project *models.Project) (*ProjectAssets, error) {
+	ids := map[models.ProjectAssetType][]string{}
+	for _, asset := range project.Assets {
+		ids[asset.AssetType] = append(ids[asset.AssetType], asset.AssetID)
+	}
+
+	assets := &ProjectAssets{}
+	loads := []struct {
+		assetType models.ProjectAssetType
+		query     *gorm.DB
+		dest      interface{}
+	}{
+		{models.ProjectAssetTypeTemplate, s.db, &assets.Templates},
+		{models.ProjectAssetTypeDeployment, s.db.Preload("Chain"), &assets.Deployments},
+		{models.ProjectAssetTypeLiquidityPool, s.db, &assets.LiquidityPools},
+		{models.ProjectAssetTypeSession, s.db.Preload("Chain"), &assets.Sessions},
+	}
+	for _, load := range loads {
+		if len(ids[load.assetType]) == 0 {
+			continue
+		}
+		if err := load.query.Where("id IN ?", ids[load.assetType]).Order("created_at ASC").Find(load.dest).Error; err != nil {
+			return nil, fmt.Errorf("failed to load the %s assets: %w", load.assetType, err)
+		}
+	}
+	return assets, nil
+}
+
+// projectAssetModel returns the model of the records of the asset type
+func projectAssetModel(assetType models.ProjectAssetType) (interface{}, error) {
+	switch assetType {
+	case models.ProjectAssetTypeTemplate:
+		return &models.Template{}, nil
+	case models.ProjectAssetTypeDeployment:
+		return &models.Deployment{}, nil
+	case models.ProjectAssetTypeLiquidityPool:
+		return &models.LiquidityPool{}, nil
+	case models.ProjectAssetTypeSession:
+		return &models.TransactionSession{}, nil
+	}
+	return nil, errors.New("unsupported asset type: " + string(assetType))
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultRecurringSwapPollInterval is how often the scheduler looks for due recurring swaps
+const DefaultRecurringSwapPollInterval = 30 * time.Second
+
+// RecurringSwapExecutor creates the swap session of one run of a recurring swap and returns its ID
+type RecurringSwapExecutor func(swap models.RecurringSwap) (string, error)
+
+// RecurringSwapNotifier is called when the swap session of a run is ready to sign
+type RecurringSwapNotifier func(swap models.RecurringSwap, sessionID string)
+
+// RecurringSwapScheduler creates the swap sessions of recurring swaps when they are due
+type RecurringSwapScheduler struct {
+	recurringSwapService RecurringSwapService
+	executor             RecurringSwapExecutor
+	notifier             RecurringSwapNotifier
+	interval             time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewRecurringSwapScheduler(recurringSwapService RecurringSwapService, executor RecurringSwapExecutor, notifier RecurringSwapNotifier, interval time.Duration) *RecurringSwapScheduler {
+	if interval <= 0 {
+		interval = DefaultRecurringSwapPollInterval
+	}
+	return &RecurringSwapScheduler{
+		recurringSwapService: recurringSwapService,
+		executor:             executor,
+		notifier:             notifier,
+		interval:             interval,
+		now:                  time.Now,
+	}
+}
+
+// Start runs the due recurring swaps in the background until Stop is called
+func (r *RecurringSwapScheduler) Start() {
+	if r.stop != nil {
+		return
+	}
+	r.stop = make(chan struct{})
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.RunDue()
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background scheduling and waits for the current runs to finish
+func (r *RecurringSwapScheduler) Stop() {
+	if r.stop == nil {
+		return
+	}
+	close(r.stop)
+	r.wg.Wait()
+	r.stop = nil
+}
+
+// RunDue creates a swap session for every recurring swap that is due
+func (r *RecurringSwapScheduler) RunDue() {
+	now := r.now()
+	swaps, err := r.recurringSwapService.ListDueRecurringSwaps(now)
+	if err != nil {
+		log.Printf("Error listing due recurring swaps: %v", err)
+		return
+	}
+
+	for _, swap := range swaps {
+		if err := r.run(swap, now); err != nil {
+			log.Printf("Error recording run of recurring swap %d: %v", swap.ID, err)
+		}
+	}
+}
+
+func (r *RecurringSwapScheduler) run(swap models.RecurringSwap, now time.Time) error {
+	run := &models.RecurringSwapRun{}
+	sessionID, err := r.executor(swap)
+	if err != nil {
+		// A failed run is recorded and retried on the next run instead of stopping the schedule
+		log.Printf("Failed to create swap session for recurring swap %d: %v", swap.ID, err)
+		run.Error = err.Error()
+		swap.LastError = err.Error()
+	} else {
+		run.SessionId = sessionID
+		swap.LastError = ""
+		swap.RunCount++
+	}
+
+	swap.LastRunAt = &now
+	swap.NextRunAt = utils.NextScheduledRun(swap.NextRunAt, time.Duration(swap.IntervalSeconds)*time.Second, now)
+	if swap.MaxRuns > 0 && swap.RunCount >= swap.MaxRuns {
+		swap.Status = models.RecurringSwapStatusCompleted
+	}
+
+	if err := r.recurringSwapService.RecordRecurringSwapRun(&swap, run); err != nil {
+		if errors.Is(err, ErrRecurringSwapNotActive) {
+			// the schedule was cancelled during the run, its session is recorded but not sent
+			log.Printf("Recurring swap %d was cancelled during its run, session %s is not sent", swap.ID, run.SessionId)
+			return nil
+		}
+		return err
+	}
+
+	if run.SessionId != "" && r.notifier != nil {
+		r.notifier(swap, run.SessionId)
+	}
+	return nil
+}
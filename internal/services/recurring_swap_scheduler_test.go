@@ -0,0 +1,165 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type recurringSwapSchedulerFixture struct {
+	service     RecurringSwapService
+	scheduler   *RecurringSwapScheduler
+	chain       *models.Chain
+	now         time.Time
+	executorErr error
+	sessions    int
+	notified    []string
+}
+
+func setupRecurringSwapScheduler(t *testing.T) *recurringSwapSchedulerFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Chain{}, &models.RecurringSwap{}, &models.RecurringSwapRun{}))
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: "http://localhost:8545", NetworkID: "31337", Name: "Local"}
+	require.NoError(t, db.Create(chain).Error)
+
+	f := &recurringSwapSchedulerFixture{
+		service: NewRecurringSwapService(db),
+		chain:   chain,
+		now:     time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	executor := func(swap models.RecurringSwap) (string, error) {
+		if f.executorErr != nil {
+			return "", f.executorErr
+		}
+		f.sessions++
+		return fmt.Sprintf("session-%d", f.sessions), nil
+	}
+	notifier := func(swap models.RecurringSwap, sessionID string) {
+		f.notified = append(f.notified, sessionID)
+	}
+	f.scheduler = NewRecurringSwapScheduler(f.service, executor, notifier, time.Minute)
+	f.scheduler.now = func() time.Time { return f.now }
+	return f
+}
+
+func (f *recurringSwapSchedulerFixture) createSwap(t *testing.T, maxRuns int) *models.RecurringSwap {
+	swap := &models.RecurringSwap{
+		ChainID:           f.chain.ID,
+		FromToken:         EthTokenAddress,
+		ToToken:           "0x1111111111111111111111111111111111111111",
+		Amount:            "100000000000000000",
+		SlippageTolerance: "1",
+		UserAddress:       "0x4444444444444444444444444444444444444444",
+		Schedule:          "@daily",
+		IntervalSeconds:   int64((24 * time.Hour).Seconds()),
+		NextRunAt:         f.now,
+		MaxRuns:           maxRuns,
+	}
+	require.NoError(t, f.service.CreateRecurringSwap(swap))
+	return swap
+}
+
+func TestRecurringSwapSchedulerRunsDueSwaps(t *testing.T) {
+	f := setupRecurringSwapScheduler(t)
+	swap := f.createSwap(t, 0)
+
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetRecurringSwap(swap.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stored.RunCount)
+	assert.True(t, stored.NextRunAt.Equal(f.now.Add(24*time.Hour)))
+	assert.Equal(t, []string{"session-1"}, f.notified)
+
+	// Not due again until the next day
+	f.now = f.now.Add(time.Hour)
+	f.scheduler.RunDue()
+	assert.Equal(t, 1, f.sessions)
+
+	f.now = f.now.Add(23 * time.Hour)
+	f.scheduler.RunDue()
+	assert.Equal(t, 2, f.sessions)
+
+	runs, err := f.service.ListRecurringSwapRuns(swap.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "session-2", runs[0].SessionId)
+}
+
+func TestRecurringSwapSchedulerCompletesAfterMaxRuns(t *testing.T) {
+	f := setupRecurringSwapScheduler(t)
+	swap := f.createSwap(t, 1)
+
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetRecurringSwap(swap.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RecurringSwapStatusCompleted, stored.Status)
+
+	f.now = f.now.Add(48 * time.Hour)
+	f.scheduler.RunDue()
+	assert.Equal(t, 1, f.sessions)
+}
+
+func TestRecurringSwapSchedulerRecordsFailedRuns(t *testing.T) {
+	f := setupRecurringSwapScheduler(t)
+	swap := f.createSwap(t, 1)
+	f.executorErr = errors.New("no uniswap deployment")
+
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetRecurringSwap(swap.ID)
+	require.NoError(t, err)
+	// Failed runs do not count towards max runs
+	assert.Equal(t, models.RecurringSwapStatusActive, stored.Status)
+	assert.Equal(t, 0, stored.RunCount)
+	assert.Equal(t, "no uniswap deployment", stored.LastError)
+	assert.Empty(t, f.notified)
+
+	runs, err := f.service.ListRecurringSwapRuns(swap.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "no uniswap deployment", runs[0].Error)
+}
+
+func TestRecurringSwapSchedulerSkipsCancelledSwaps(t *testing.T) {
+	f := setupRecurringSwapScheduler(t)
+	swap := f.createSwap(t, 0)
+	require.NoError(t, f.service.UpdateRecurringSwapStatus(swap.ID, models.RecurringSwapStatusCancelled))
+
+	f.scheduler.RunDue()
+	assert.Equal(t, 0, f.sessions)
+}
+
+func TestRecurringSwapSchedulerKeepsCancellationDuringRun(t *testing.T) {
+	f := setupRecurringSwapScheduler(t)
+	swap := f.createSwap(t, 0)
+	executor := f.scheduler.executor
+	f.scheduler.executor = func(due models.RecurringSwap) (string, error) {
+		// the user cancels the schedule while its session is created
+		require.NoError(t, f.service.UpdateRecurringSwapStatus(swap.ID, models.RecurringSwapStatusCancelled))
+		return executor(due)
+	}
+
+	f.scheduler.RunDue()
+	assert.Equal(t, 1, f.sessions)
+	assert.Empty(t, f.notified)
+
+	stored, err := f.service.GetRecurringSwap(swap.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.RecurringSwapStatusCancelled, stored.Status)
+	assert.Equal(t, 0, stored.RunCount)
+
+	stored.RunCount++
+	err = f.service.RecordRecurringSwapRun(stored, &models.RecurringSwapRun{SessionId: "session-2"})
+	assert.ErrorIs(t, err, ErrRecurringSwapNotActive)
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrRecurringSwapNotActive is returned when a schedule was cancelled or completed while one of its runs was created
+var ErrRecurringSwapNotActive = errors.New("the recurring swap is no longer active")
+
+type RecurringSwapService interface {
+	CreateRecurringSwap(swap *models.RecurringSwap) error
+	GetRecurringSwap(id uint) (*models.RecurringSwap, error)
+	ListRecurringSwaps() ([]models.RecurringSwap, error)
+	ListRecurringSwapsByUser(userID string) ([]models.RecurringSwap, error)
+	// ListDueRecurringSwaps returns the active schedules whose next run is at or before now
+	ListDueRecurringSwaps(now time.Time) ([]models.RecurringSwap, error)
+	// RecordRecurringSwapRun stores the run and updates the schedule counters in one transaction. The counters of a
+	// schedule that is no longer active are left unchanged and ErrRecurringSwapNotActive is returned
+	RecordRecurringSwapRun(swap *models.RecurringSwap, run *models.RecurringSwapRun) error
+	// ListRecurringSwapRuns returns the latest runs of a schedule, newest first
+	ListRecurringSwapRuns(recurringSwapID uint, limit int) ([]models.RecurringSwapRun, error)
+	UpdateRecurringSwapStatus(id uint, status models.RecurringSwapStatus) error
+}
+
+type recurringSwapService struct {
+	db *gorm.DB
+}
+
+func NewRecurringSwapService(db *gorm.DB) RecurringSwapService {
+	return &recurringSwapService{db: db}
+}
+
+func (s *recurringSwapService) CreateRecurringSwap(swap *models.RecurringSwap) error {
+	if swap.Status == "" {
+		swap.Status = models.RecurringSwapStatusActive
+	}
+	return s.db.Create(swap).Error
+}
+
+func (s *recurringSwapService) GetRecurringSwap(id uint) (*models.RecurringSwap, error) {
+	var swap models.RecurringSwap
+	err := s.db.Preload("Chain").First(&swap, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &swap, nil
+}
+
+func (s *recurringSwapService) ListRecurringSwaps() ([]models.RecurringSwap, error) {
+	var swaps []models.RecurringSwap
+	err := s.db.Preload("Chain").Order("created_at DESC").Find(&swaps).Error
+	return swaps, err
+}
+
+func (s *recurringSwapService) ListRecurringSwapsByUser(userID string) ([]models.RecurringSwap, error) {
+	var swaps []models.RecurringSwap
+	err := s.db.Preload("Chain").Where("user_id = ?", userID).Order("created_at DESC").Find(&swaps).Error
+	return swaps, err
+}
+
+func (s *recurringSwapService) ListDueRecurringSwaps(now time.Time) ([]models.RecurringSwap, error) {
+	var swaps []models.RecurringSwap
+	err := s.db.Preload("Chain").
+		Where("status = ? AND next_run_at <= ?", models.RecurringSwapStatusActive, now).
+		Order("next_run_at ASC").
+		Find(&swaps).Error
+	return swaps, err
+}
+
+func (s *recurringSwapService) RecordRecurringSwapRun(swap *models.RecurringSwap, run *models.RecurringSwapRun) error {
+	var updated int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		run.RecurringSwapID = swap.ID
+		if err := tx.Create(run).Error; err != nil {
+			return err
+		}
+
+		// a cancellation made while the run was created is kept
+		result := tx.Model(&models.RecurringSwap{}).
+			Where("id = ? AND status = ?", swap.ID, models.RecurringSwapStatusActive).
+			Updates(map[string]interface{}{
+				"next_run_at": swap.NextRunAt,
+				"last_run_at": swap.LastRunAt,
+				"run_count":   swap.RunCount,
+				"status":      swap.Status,
+				"last_error":  swap.LastError,
+			})
+		updated = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("recurring swap %d: %w", swap.ID, ErrRecurringSwapNotActive)
+	}
+	return nil
+}
+
+func (s *recurringSwapService) ListRecurringSwapRuns(recurringSwapID uint, limit int) ([]models.RecurringSwapRun, error) {
+	var runs []models.RecurringSwapRun
+	err := s.db.Where("recurring_swap_id = ?", recurringSwapID).Order("created_at DESC, id DESC").Limit(limit).Find(&runs).Error
+	return runs, err
+}
+
+func (s *recurringSwapService) UpdateRecurringSwapStatus(id uint, status models.RecurringSwapStatus) error {
+	return s.db.Model(&models.RecurringSwap{}).Where("id = ?", id).Update("status", status).Error
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// replicaDBService sends the writes to the primary and the reads of GetReadDB to a replica,
+// each with its own connection pool
+type replicaDBService struct {
+	DBService
+	replica *gorm.DB
+}
+
+// NewReplicaDBService wraps the primary with a read-only replica returned by GetReadDB
+func NewReplicaDBService(primary DBService, replica *gorm.DB) DBService {
+	return &replicaDBService{DBService: primary, replica: replica}
+}
+
+// NewPostgresReplica opens a connection to a read-only Postgres replica.
+// The replica follows the schema of the primary, so the migrations are not checked
+func NewPostgresReplica(dsn string) (*gorm.DB, error) {
+	// Configure GORM logger - only log errors and slow queries
+	gormLogger := logger.New(
+		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
+		logger.Config{
+			SlowThreshold:             time.Second,  // Slow SQL threshold
+			LogLevel:                  logger.Error, // Only log errors and slow queries
+			IgnoreRecordNotFoundError: true,         // Ignore ErrRecordNotFound error for logger
+			ParameterizedQueries:      false,        // Include params in SQL log
+			Colorful:                  false,        // Disable color
+		},
+	)
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: gormLogger,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to read replica: %w", err)
+	}
+	return db, nil
+}
+
+// GetReadDB returns the replica
+func (s *replicaDBService) GetReadDB() *gorm.DB {
+	return s.replica
+}
+
+// SetConnectionPool applies the same tuning to the primary and the replica pools
+func (s *replicaDBService) SetConnectionPool(pool ConnectionPool) error {
+	if err := s.DBService.SetConnectionPool(pool); err != nil {
+		return err
+	}
+	return NewDBServiceFromDB(s.replica).SetConnectionPool(pool)
+}
+
+// Close closes the replica and the primary connections
+func (s *replicaDBService) Close() error {
+	replicaErr := NewDBServiceFromDB(s.replica).Close()
+	return errors.Join(s.DBService.Close(), replicaErr)
+}
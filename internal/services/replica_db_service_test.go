@@ -0,0 +1,41 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaDBServiceSplitsReadsAndWrites(t *testing.T) {
+	primary, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	replica, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+
+	dbService := NewReplicaDBService(primary, replica.GetDB())
+	assert.Same(t, primary.GetDB(), dbService.GetDB())
+	assert.Same(t, replica.GetDB(), dbService.GetReadDB())
+	// Without a replica the reads use the primary
+	assert.Same(t, primary.GetDB(), primary.GetReadDB())
+
+	require.NoError(t, NewChainService(dbService.GetDB()).CreateChain(&models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}))
+	primaryChains, err := NewChainService(dbService.GetDB()).ListChains()
+	require.NoError(t, err)
+	assert.Len(t, primaryChains, 1)
+	replicaChains, err := NewChainService(dbService.GetReadDB()).ListChains()
+	require.NoError(t, err)
+	assert.Empty(t, replicaChains)
+
+	require.NoError(t, dbService.SetConnectionPool(ConnectionPool{MaxOpenConns: 3}))
+	replicaSQL, err := replica.GetDB().DB()
+	require.NoError(t, err)
+	assert.Equal(t, 3, replicaSQL.Stats().MaxOpenConnections)
+
+	require.NoError(t, dbService.Close())
+	assert.Error(t, replicaSQL.Ping())
+	primarySQL, err := primary.GetDB().DB()
+	require.NoError(t, err)
+	assert.Error(t, primarySQL.Ping())
+}
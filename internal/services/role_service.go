@@ -0,0 +1,81 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RoleService interface {
+	// ListRoleMembers returns the cached members of a deployment, filtered by role unless role is empty
+	ListRoleMembers(deploymentID uint, role string) ([]models.RoleMember, error)
+	// GetLastSyncedBlock returns the last block applied to the cache, ok is false when the deployment was never synced
+	GetLastSyncedBlock(deploymentID uint) (block uint64, ok bool, err error)
+	// ApplyRoleEvents applies role events in chain order to the cache and marks it as synced up to syncedBlock
+	ApplyRoleEvents(deploymentID uint, events []utils.RoleEvent, syncedBlock uint64) error
+}
+
+type roleService struct {
+	db *gorm.DB
+}
+
+func NewRoleService(db *gorm.DB) RoleService {
+	return &roleService{db: db}
+}
+
+func (s *roleService) ListRoleMembers(deploymentID uint, role string) ([]models.RoleMember, error) {
+	var members []models.RoleMember
+	query := s.db.Where("deployment_id = ?", deploymentID)
+	if role != "" {
+		query = query.Where("role = ?", role)
+	}
+	err := query.Order("role ASC, block_number ASC").Find(&members).Error
+	return members, err
+}
+
+func (s *roleService) GetLastSyncedBlock(deploymentID uint) (uint64, bool, error) {
+	var state models.RoleSyncState
+	err := s.db.First(&state, "deployment_id = ?", deploymentID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return state.LastSyncedBlock, true, nil
+}
+
+func (s *roleService) ApplyRoleEvents(deploymentID uint, events []utils.RoleEvent, syncedBlock uint64) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, event := range events {
+			if !event.Granted {
+				err := tx.Where("deployment_id = ? AND role = ? AND account = ?", deploymentID, event.Role, event.Account).
+					Delete(&models.RoleMember{}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			member := models.RoleMember{
+				DeploymentID: deploymentID,
+				Role:         event.Role,
+				Account:      event.Account,
+				BlockNumber:  event.BlockNumber,
+			}
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&member).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		state := models.RoleSyncState{DeploymentID: deploymentID, LastSyncedBlock: syncedBlock}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "deployment_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"last_synced_block", "updated_at"}),
+		}).Create(&state).Error
+	})
+}
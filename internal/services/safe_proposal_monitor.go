@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// SafeProposalMonitor reads the confirmations of the transactions proposed to a Safe and completes the session
+// once the Safe executed them, running the same hooks as a transaction signed in the browser
+type SafeProposalMonitor struct {
+	txService   TransactionService
+	safeService SafeService
+	interval    time.Duration
+
+	hookMu      sync.RWMutex
+	hookService HookService
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewSafeProposalMonitor(txService TransactionService, safeService SafeService, interval time.Duration) *SafeProposalMonitor {
+	if interval <= 0 {
+		interval = DefaultSafeProposalPollInterval
+	}
+	return &SafeProposalMonitor{
+		txService:   txService,
+		safeService: safeService,
+		interval:    interval,
+	}
+}
+
+// SetHookService sets the hooks run for the executed transactions, they are skipped until it is set
+func (m *SafeProposalMonitor) SetHookService(hookService HookService) {
+	m.hookMu.Lock()
+	defer m.hookMu.Unlock()
+	m.hookService = hookService
+}
+
+// Start polls the proposed transactions in the background until Stop is called
+func (m *SafeProposalMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.CheckProposals()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background polling and waits for the current check to finish
+func (m *SafeProposalMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// CheckProposals checks every pending session proposed to a Safe once
+func (m *SafeProposalMonitor) CheckProposals() {
+	sessions, err := m.txService.ListSafeProposedSessions()
+	if err != nil {
+		log.Printf("Error listing the sessions proposed to a Safe: %v", err)
+		return
+	}
+
+	for i := range sessions {
+		if err := m.CheckSession(context.Background(), &sessions[i]); err != nil {
+			log.Printf("Error checking the Safe proposal of session %s: %v", sessions[i].ID, err)
+		}
+	}
+}
+
+// CheckSession updates the confirmations of the proposed transactions of the session and its status once the Safe
+// executed them. A transaction is failed when the Safe executed another transaction with its nonce
+func (m *SafeProposalMonitor) CheckSession(ctx context.Context, session *models.TransactionSession) error {
+	proposal := session.SafeProposal
+	if proposal == nil || session.TransactionStatus != models.TransactionStatusPending {
+		return nil
+	}
+	safe, err := m.safeService.GetSafe(ctx, proposal.ServiceURL, common.HexToAddress(proposal.SafeAddress))
+	if err != nil {
+		return err
+	}
+
+	var confirmed []safeExecutedTransaction
+	for i := range proposal.Transactions {
+		proposed := &proposal.Transactions[i]
+		if proposed.Executed || proposed.Index < 0 || proposed.Index >= len(session.TransactionDeployments) {
+			continue
+		}
+		transaction, err := m.safeService.GetMultisigTransaction(ctx, proposal.ServiceURL, proposed.SafeTxHash)
+		if err != nil {
+			return err
+		}
+		proposed.Confirmations = len(transaction.Confirmations)
+		proposed.ConfirmationsRequired = transaction.ConfirmationsRequired
+
+		deployment := &session.TransactionDeployments[proposed.Index]
+		switch {
+		case transaction.IsExecuted:
+			proposed.Executed = true
+			proposed.Successful = transaction.IsSuccessful == nil || *transaction.IsSuccessful
+			if transaction.TransactionHash != nil {
+				proposed.TransactionHash = *transaction.TransactionHash
+			}
+			if proposed.Successful {
+				deployment.Status = models.TransactionStatusConfirmed
+				confirmed = append(confirmed, safeExecutedTransaction{TransactionType: deployment.TransactionType, TransactionHash: proposed.TransactionHash})
+			} else {
+				deployment.Status = models.TransactionStatusFailed
+			}
+		case safe.Nonce > proposed.Nonce:
+			log.Printf("Safe %s executed another transaction with nonce %d of session %s", proposal.SafeAddress, proposed.Nonce, session.ID)
+			deployment.Status = models.TransactionStatusFailed
+		}
+	}
+
+	now := time.Now()
+	proposal.CheckedAt = &now
+	session.TransactionStatus = safeProposalSessionStatus(session.TransactionDeployments)
+	if err := m.txService.UpdateTransactionSession(session.ID, session); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	m.hookMu.RLock()
+	hookService := m.hookService
+	m.hookMu.RUnlock()
+	if hookService == nil {
+		return nil
+	}
+	for _, result := range confirmed {
+		if err := hookService.OnTransactionConfirmed(result.TransactionType, result.TransactionHash, nil, *session); err != nil {
+			log.Printf("Error on transaction confirmed: %v", err)
+		}
+	}
+	return nil
+}
+
+// safeExecutedTransaction is a proposed transaction executed by the Safe
+type safeExecutedTransaction struct {
+	TransactionType models.TransactionType
+	TransactionHash string
+}
+
+// safeProposalSessionStatus is failed once a transaction failed, confirmed once every transaction is confirmed
+func safeProposalSessionStatus(deployments []models.TransactionDeployment) models.TransactionStatus {
+	status := models.TransactionStatusConfirmed
+	for _, deployment := range deployments {
+		switch deployment.Status {
+		case models.TransactionStatusFailed:
+			return models.TransactionStatusFailed
+		case models.TransactionStatusConfirmed:
+		default:
+			status = models.TransactionStatusPending
+		}
+	}
+	return status
+}
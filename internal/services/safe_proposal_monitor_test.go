@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSafeService returns the safe nonce and the transactions by safeTxHash
+type fakeSafeService struct {
+	SafeService
+	nonce        uint64
+	transactions map[string]*SafeMultisigTransaction
+}
+
+func (f *fakeSafeService) GetSafe(ctx context.Context, serviceURL string, safe common.Address) (*SafeInfo, error) {
+	return &SafeInfo{Address: safe.Hex(), Nonce: f.nonce, Threshold: 2}, nil
+}
+
+func (f *fakeSafeService) GetMultisigTransaction(ctx context.Context, serviceURL, safeTxHash string) (*SafeMultisigTransaction, error) {
+	return f.transactions[safeTxHash], nil
+}
+
+// recordingHookService records the confirmed transactions
+type recordingHookService struct {
+	HookService
+	confirmed []string
+}
+
+func (r *recordingHookService) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	r.confirmed = append(r.confirmed, string(txType)+":"+txHash)
+	return nil
+}
+
+func TestSafeProposalMonitor(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Sepolia", RPC: "http://localhost:8545", NetworkID: "11155111", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	txService := NewTransactionService(db)
+	sessionID, err := txService.CreateTransactionSession(CreateTransactionSessionRequest{
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   chain.ID,
+		TransactionDeployments: []models.TransactionDeployment{
+			{Title: "Enable trading", Receiver: "0x01", Value: "0", TransactionType: models.TransactionTypeEnableTrading},
+			{Title: "Update fees", Receiver: "0x01", Value: "0", TransactionType: models.TransactionTypeTokenFeeUpdate},
+		},
+	})
+	require.NoError(t, err)
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	session.SafeProposal = &models.SafeProposal{
+		SafeAddress: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		ServiceURL:  "http://safe.test",
+		Transactions: []models.SafeProposalTransaction{
+			{Index: 0, SafeTxHash: "0xaa", Nonce: 4, Confirmations: 1, ConfirmationsRequired: 2},
+			{Index: 1, SafeTxHash: "0xbb", Nonce: 5, Confirmations: 1, ConfirmationsRequired: 2},
+		},
+		ProposedAt: time.Now(),
+	}
+	require.NoError(t, txService.UpdateTransactionSession(sessionID, session))
+
+	safe := &fakeSafeService{nonce: 4, transactions: map[string]*SafeMultisigTransaction{
+		"0xaa": {SafeTxHash: "0xaa", ConfirmationsRequired: 2, Confirmations: make([]struct {
+			Owner string `json:"owner"`
+		}, 1)},
+		"0xbb": {SafeTxHash: "0xbb", ConfirmationsRequired: 2},
+	}}
+	hooks := &recordingHookService{}
+	monitor := NewSafeProposalMonitor(txService, safe, time.Hour)
+	monitor.SetHookService(hooks)
+
+	// Pending confirmations are only recorded
+	monitor.CheckProposals()
+	sessions, err := txService.ListSafeProposedSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, 1, sessions[0].SafeProposal.Transactions[0].Confirmations)
+	assert.Equal(t, 0, sessions[0].SafeProposal.Transactions[1].Confirmations)
+	assert.NotNil(t, sessions[0].SafeProposal.CheckedAt)
+	assert.Empty(t, hooks.confirmed)
+
+	// The first transaction is executed, the session waits for the second
+	successful := true
+	executedHash := "0xe1"
+	safe.nonce = 5
+	safe.transactions["0xaa"].IsExecuted = true
+	safe.transactions["0xaa"].IsSuccessful = &successful
+	safe.transactions["0xaa"].TransactionHash = &executedHash
+	monitor.CheckProposals()
+	assert.Equal(t, []string{string(models.TransactionTypeEnableTrading) + ":0xe1"}, hooks.confirmed)
+	sessions, err = txService.ListTransactionSessionsByIDs([]string{sessionID})
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusPending, sessions[0].TransactionStatus)
+	assert.Equal(t, models.TransactionStatusConfirmed, sessions[0].TransactionDeployments[0].Status)
+	assert.Equal(t, "0xe1", sessions[0].SafeProposal.Transactions[0].TransactionHash)
+
+	// Another transaction took the nonce of the second one, the session failed
+	safe.nonce = 6
+	monitor.CheckProposals()
+	sessions, err = txService.ListTransactionSessionsByIDs([]string{sessionID})
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusFailed, sessions[0].TransactionStatus)
+	assert.Len(t, hooks.confirmed, 1)
+
+	proposed, err := txService.ListSafeProposedSessions()
+	require.NoError(t, err)
+	assert.Empty(t, proposed)
+}
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// EnvSafeProposerPrivateKey is the private key of the Safe owner or delegate signing the proposed transactions
+	EnvSafeProposerPrivateKey = "LAUNCHPAD_SAFE_PROPOSER_PRIVATE_KEY"
+	// EnvSafeTransactionServiceURL overrides the Safe Transaction Service of every chain, e.g. for a self-hosted service
+	EnvSafeTransactionServiceURL = "LAUNCHPAD_SAFE_TRANSACTION_SERVICE_URL"
+	// EnvSafeAPIKey is sent as a bearer token to the Safe Transaction Service when it is set
+	EnvSafeAPIKey = "LAUNCHPAD_SAFE_API_KEY"
+	// EnvSafeAddress is the Safe the transactions are proposed to when the tool is not given one
+	EnvSafeAddress = "LAUNCHPAD_SAFE_ADDRESS"
+	// DefaultSafeProposalPollInterval is how often the monitor reads the confirmations of the proposed transactions
+	DefaultSafeProposalPollInterval = 30 * time.Second
+	// SafeProposalOrigin is the origin shown by the Safe apps for the proposed transactions
+	SafeProposalOrigin = "launchpad-mcp"
+)
+
+// safeTransactionServiceNetworks are the networks of the Safe hosted transaction services by chain ID
+var safeTransactionServiceNetworks = map[string]string{
+	"1":        "mainnet",
+	"10":       "optimism",
+	"56":       "bsc",
+	"100":      "gnosis-chain",
+	"137":      "polygon",
+	"324":      "zksync",
+	"8453":     "base",
+	"42161":    "arbitrum",
+	"43114":    "avalanche",
+	"59144":    "linea",
+	"84532":    "base-sepolia",
+	"534352":   "scroll",
+	"11155111": "sepolia",
+}
+
+var (
+	safeDomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash     = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+)
+
+// SafeTransaction is a call of the Safe without gas refund, the only kind proposed by the launchpad
+type SafeTransaction struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+	Nonce uint64
+}
+
+// SafeTransactionHash returns the EIP-712 hash signed by the owners of the Safe (version 1.3.0 and later) for the transaction
+func SafeTransactionHash(chainID *big.Int, safe common.Address, tx SafeTransaction) common.Hash {
+	value := tx.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	domainSeparator := crypto.Keccak256(
+		safeDomainTypeHash.Bytes(),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(safe.Bytes(), 32),
+	)
+	zero := make([]byte, 32)
+	structHash := crypto.Keccak256(
+		safeTxTypeHash.Bytes(),
+		common.LeftPadBytes(tx.To.Bytes(), 32),
+		common.LeftPadBytes(value.Bytes(), 32),
+		crypto.Keccak256(tx.Data),
+		zero, // operation is always a call
+		zero, // safeTxGas
+		zero, // baseGas
+		zero, // gasPrice
+		zero, // gasToken
+		zero, // refundReceiver
+		common.LeftPadBytes(new(big.Int).SetUint64(tx.Nonce).Bytes(), 32),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// SignSafeTransactionHash signs the hash with the key of an owner or delegate, v is 27 or 28 as the Safe expects
+func SignSafeTransactionHash(key *ecdsa.PrivateKey, hash common.Hash) (string, error) {
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign the Safe transaction: %w", err)
+	}
+	signature[64] += 27
+	return "0x" + common.Bytes2Hex(signature), nil
+}
+
+// SafeProposerKeyFromEnv returns the proposer key of LAUNCHPAD_SAFE_PROPOSER_PRIVATE_KEY, nil when it is not set
+func SafeProposerKeyFromEnv() (*ecdsa.PrivateKey, error) {
+	value := strings.TrimPrefix(strings.TrimSpace(os.Getenv(EnvSafeProposerPrivateKey)), "0x")
+	if value == "" {
+		return nil, nil
+	}
+	key, err := crypto.HexToECDSA(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", EnvSafeProposerPrivateKey, err)
+	}
+	return key, nil
+}
+
+// SafeTransactionServiceURL returns the Safe Transaction Service of the chain, LAUNCHPAD_SAFE_TRANSACTION_SERVICE_URL when it is set
+func SafeTransactionServiceURL(chainID string) (string, error) {
+	if override := strings.TrimSpace(os.Getenv(EnvSafeTransactionServiceURL)); override != "" {
+		return strings.TrimSuffix(override, "/"), nil
+	}
+	network, ok := safeTransactionServiceNetworks[chainID]
+	if !ok {
+		return "", fmt.Errorf("no Safe Transaction Service is known for chain %s, set %s", chainID, EnvSafeTransactionServiceURL)
+	}
+	return fmt.Sprintf("https://safe-transaction-%s.safe.global", network), nil
+}
+
+// safeNumber reads the numbers the Safe Transaction Service returns either as JSON numbers or as strings
+type safeNumber uint64
+
+func (n *safeNumber) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseUint(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %s: %w", data, err)
+	}
+	*n = safeNumber(value)
+	return nil
+}
+
+// SafeInfo is the state of a Safe known to the Safe Transaction Service
+type SafeInfo struct {
+	Address   string   `json:"address"`
+	Nonce     uint64   `json:"-"`
+	Threshold int      `json:"threshold"`
+	Owners    []string `json:"owners"`
+}
+
+// SafeMultisigTransaction is a transaction of the Safe Transaction Service with its confirmations
+type SafeMultisigTransaction struct {
+	SafeTxHash            string `json:"safeTxHash"`
+	Nonce                 uint64 `json:"-"`
+	ConfirmationsRequired int    `json:"confirmationsRequired"`
+	Confirmations         []struct {
+		Owner string `json:"owner"`
+	} `json:"confirmations"`
+	IsExecuted      bool    `json:"isExecuted"`
+	IsSuccessful    *bool   `json:"isSuccessful"`
+	TransactionHash *string `json:"transactionHash"`
+}
+
+// SafeProposalRequest is a transaction proposed to a Safe with the signature of the proposer
+type SafeProposalRequest struct {
+	Safe        common.Address
+	Transaction SafeTransaction
+	SafeTxHash  common.Hash
+	Sender      common.Address
+	Signature   string
+}
+
+// SafeService talks to the Safe Transaction Service at serviceURL
+type SafeService interface {
+	GetSafe(ctx context.Context, serviceURL string, safe common.Address) (*SafeInfo, error)
+	// NextNonce returns the nonce after the transactions already executed or queued for the Safe
+	NextNonce(ctx context.Context, serviceURL string, safe common.Address) (uint64, error)
+	ProposeTransaction(ctx context.Context, serviceURL string, request SafeProposalRequest) error
+	GetMultisigTransaction(ctx context.Context, serviceURL, safeTxHash string) (*SafeMultisigTransaction, error)
+}
+
+type safeService struct {
+	client *http.Client
+	apiKey string
+}
+
+func NewSafeService(client *http.Client, apiKey string) SafeService {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &safeService{client: client, apiKey: apiKey}
+}
+
+// NewSafeServiceFromEnv authenticates with LAUNCHPAD_SAFE_API_KEY when it is set
+func NewSafeServiceFromEnv() SafeService {
+	return NewSafeService(nil, strings.TrimSpace(os.Getenv(EnvSafeAPIKey)))
+}
+
+func (s *safeService) GetSafe(ctx context.Context, serviceURL string, safe common.Address) (*SafeInfo, error) {
+	body, err := s.do(ctx, http.MethodGet, fmt.Sprintf("%s/api/v1/safes/%s/", serviceURL, safe.Hex()), nil, "safe")
+	if err != nil {
+		return nil, err
+	}
+	var info struct {
+		SafeInfo
+		Nonce safeNumber `json:"nonce"`
+	}
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse the Safe: %w", err)
+	}
+	info.SafeInfo.Nonce = uint64(info.Nonce)
+	return &info.SafeInfo, nil
+}
+
+func (s *safeService) NextNonce(ctx context.Context, serviceURL string, safe common.Address) (uint64, error) {
+	info, err := s.GetSafe(ctx, serviceURL, safe)
+	if err != nil {
+		return 0, err
+	}
+	query := url.Values{"executed": {"false"}, "nonce__gte": {strconv.FormatUint(info.Nonce, 10)}, "ordering": {"-nonce"}, "limit": {"1"}}
+	body, err := s.do(ctx, http.MethodGet, fmt.Sprintf("%s/api/v1/safes/%s/multisig-transactions/?%s", serviceURL, safe.Hex(), query.Encode()), nil, "queued transactions")
+	if err != nil {
+		return 0, err
+	}
+	var queued struct {
+		Results []struct {
+			Nonce safeNumber `json:"nonce"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal(body, &queued); err != nil {
+		return 0, fmt.Errorf("failed to parse the queued transactions: %w", err)
+	}
+	if len(queued.Results) > 0 && uint64(queued.Results[0].Nonce) >= info.Nonce {
+		return uint64(queued.Results[0].Nonce) + 1, nil
+	}
+	return info.Nonce, nil
+}
+
+func (s *safeService) ProposeTransaction(ctx context.Context, serviceURL string, request SafeProposalRequest) error {
+	value := request.Transaction.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	var data *string
+	if len(request.Transaction.Data) > 0 {
+		encoded := "0x" + common.Bytes2Hex(request.Transaction.Data)
+		data = &encoded
+	}
+	payload, err := json.Marshal(map[string]any{
+		"to":                      request.Transaction.To.Hex(),
+		"value":                   value.String(),
+		"data":                    data,
+		"operation":               0,
+		"safeTxGas":               "0",
+		"baseGas":                 "0",
+		"gasPrice":                "0",
+		"gasToken":                common.Address{}.Hex(),
+		"refundReceiver":          common.Address{}.Hex(),
+		"nonce":                   request.Transaction.Nonce,
+		"contractTransactionHash": request.SafeTxHash.Hex(),
+		"sender":                  request.Sender.Hex(),
+		"signature":               request.Signature,
+		"origin":                  SafeProposalOrigin,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.do(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/safes/%s/multisig-transactions/", serviceURL, request.Safe.Hex()), payload, "proposal")
+	return err
+}
+
+func (s *safeService) GetMultisigTransaction(ctx context.Context, serviceURL, safeTxHash string) (*SafeMultisigTransaction, error) {
+	body, err := s.do(ctx, http.MethodGet, fmt.Sprintf("%s/api/v1/multisig-transactions/%s/", serviceURL, safeTxHash), nil, "transaction")
+	if err != nil {
+		return nil, err
+	}
+	var transaction struct {
+		SafeMultisigTransaction
+		Nonce safeNumber `json:"nonce"`
+	}
+	if err := json.Unmarshal(body, &transaction); err != nil {
+		return nil, fmt.Errorf("failed to parse the Safe transaction: %w", err)
+	}
+	transaction.SafeMultisigTransaction.Nonce = uint64(transaction.Nonce)
+	return &transaction.SafeMultisigTransaction, nil
+}
+
+func (s *safeService) do(ctx context.Context, method, endpoint string, payload []byte, name string) ([]byte, error) {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call the Safe Transaction Service %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Safe Transaction Service %s: %w", name, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if detail := strings.TrimSpace(string(body)); detail != "" && len(detail) <= 500 {
+			return nil, fmt.Errorf("Safe Transaction Service %s returned %s: %s", name, resp.Status, detail)
+		}
+		return nil, fmt.Errorf("Safe Transaction Service %s returned %s", name, resp.Status)
+	}
+	return body, nil
+}
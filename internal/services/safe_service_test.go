@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafeTransactionHash(t *testing.T) {
+	safe := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	tx := SafeTransaction{
+		To:    common.HexToAddress("0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"),
+		Value: big.NewInt(1000),
+		Data:  common.FromHex("0xa9059cbb"),
+		Nonce: 7,
+	}
+
+	// The hash matches the generic EIP-712 encoding of the SafeTx type
+	typed := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "chainId", Type: "uint256"}, {Name: "verifyingContract", Type: "address"}},
+			"SafeTx": {
+				{Name: "to", Type: "address"}, {Name: "value", Type: "uint256"}, {Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"}, {Name: "safeTxGas", Type: "uint256"}, {Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"}, {Name: "gasToken", Type: "address"}, {Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain:      apitypes.TypedDataDomain{ChainId: math.NewHexOrDecimal256(11155111), VerifyingContract: safe.Hex()},
+		Message: apitypes.TypedDataMessage{
+			"to": tx.To.Hex(), "value": "1000", "data": hexutil.Encode(tx.Data), "operation": "0",
+			"safeTxGas": "0", "baseGas": "0", "gasPrice": "0",
+			"gasToken": common.Address{}.Hex(), "refundReceiver": common.Address{}.Hex(), "nonce": "7",
+		},
+	}
+	expected, _, err := apitypes.TypedDataAndHash(typed)
+	require.NoError(t, err)
+	hash := SafeTransactionHash(big.NewInt(11155111), safe, tx)
+	assert.Equal(t, common.BytesToHash(expected), hash)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := SignSafeTransactionHash(key, hash)
+	require.NoError(t, err)
+	raw := common.FromHex(signature)
+	require.Len(t, raw, 65)
+	assert.Contains(t, []byte{27, 28}, raw[64])
+	raw[64] -= 27
+	publicKey, err := crypto.SigToPub(hash.Bytes(), raw)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(*publicKey))
+}
+
+func TestSafeService(t *testing.T) {
+	safe := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	var proposed map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/safes/"+safe.Hex()+"/":
+			w.Write([]byte(`{"address":"` + safe.Hex() + `","nonce":"4","threshold":2,"owners":["0x1","0x2","0x3"]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/safes/"+safe.Hex()+"/multisig-transactions/":
+			assert.Equal(t, "false", r.URL.Query().Get("executed"))
+			assert.Equal(t, "4", r.URL.Query().Get("nonce__gte"))
+			w.Write([]byte(`{"count":2,"results":[{"nonce":5}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/safes/"+safe.Hex()+"/multisig-transactions/":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&proposed))
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/multisig-transactions/0xabc/":
+			w.Write([]byte(`{"safeTxHash":"0xabc","nonce":6,"confirmationsRequired":2,"confirmations":[{"owner":"0x1"}],"isExecuted":true,"isSuccessful":true,"transactionHash":"0xdef"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	service := NewSafeService(server.Client(), "key")
+	info, err := service.GetSafe(context.Background(), server.URL, safe)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), info.Nonce)
+	assert.Equal(t, 2, info.Threshold)
+	assert.Len(t, info.Owners, 3)
+
+	nonce, err := service.NextNonce(context.Background(), server.URL, safe)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), nonce)
+
+	require.NoError(t, service.ProposeTransaction(context.Background(), server.URL, SafeProposalRequest{
+		Safe:        safe,
+		Transaction: SafeTransaction{To: common.HexToAddress("0x01"), Value: big.NewInt(5), Nonce: 6},
+		SafeTxHash:  common.HexToHash("0xabc"),
+		Sender:      common.HexToAddress("0x02"),
+		Signature:   "0x1234",
+	}))
+	assert.Equal(t, "5", proposed["value"])
+	assert.Nil(t, proposed["data"])
+	assert.Equal(t, float64(6), proposed["nonce"])
+	assert.Equal(t, common.HexToHash("0xabc").Hex(), proposed["contractTransactionHash"])
+	assert.Equal(t, SafeProposalOrigin, proposed["origin"])
+
+	transaction, err := service.GetMultisigTransaction(context.Background(), server.URL, "0xabc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), transaction.Nonce)
+	assert.Len(t, transaction.Confirmations, 1)
+	assert.True(t, transaction.IsExecuted)
+	assert.Equal(t, "0xdef", *transaction.TransactionHash)
+
+	_, err = service.GetMultisigTransaction(context.Background(), server.URL, "0xmissing")
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestSafeTransactionServiceURL(t *testing.T) {
+	t.Setenv(EnvSafeTransactionServiceURL, "")
+	url, err := SafeTransactionServiceURL("11155111")
+	require.NoError(t, err)
+	assert.Equal(t, "https://safe-transaction-sepolia.safe.global", url)
+	_, err = SafeTransactionServiceURL("31337")
+	assert.ErrorContains(t, err, EnvSafeTransactionServiceURL)
+
+	t.Setenv(EnvSafeTransactionServiceURL, "http://localhost:8000/")
+	url, err = SafeTransactionServiceURL("31337")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8000", url)
+}
+
+func TestSafeProposerKeyFromEnv(t *testing.T) {
+	t.Setenv(EnvSafeProposerPrivateKey, "")
+	key, err := SafeProposerKeyFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	t.Setenv(EnvSafeProposerPrivateKey, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	key, err = SafeProposerKeyFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), crypto.PubkeyToAddress(key.PublicKey))
+
+	t.Setenv(EnvSafeProposerPrivateKey, "not a key")
+	_, err = SafeProposerKeyFromEnv()
+	assert.ErrorContains(t, err, EnvSafeProposerPrivateKey)
+}
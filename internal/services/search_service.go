@@ -0,0 +1,460 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SearchBackend is the full-text engine answering the searches
+type SearchBackend string
+
+const (
+	// SearchBackendTsvector matches the documents with the Postgres text search
+	SearchBackendTsvector SearchBackend = "tsvector"
+	// SearchBackendFTS5 matches the documents with a SQLite FTS5 table, the driver must be built with the sqlite_fts5 tag
+	SearchBackendFTS5 SearchBackend = "fts5"
+	// SearchBackendLike matches the documents with LIKE on databases without full-text search
+	SearchBackendLike SearchBackend = "like"
+)
+
+// maxSearchTerms bounds the terms of a query, the extra terms are ignored
+const maxSearchTerms = 10
+
+// searchDocumentVector is the text search vector of the documents, it must match the index of the migration
+const searchDocumentVector = "to_tsvector('simple', coalesce(search_documents.title, '') || ' ' || coalesce(search_documents.body, ''))"
+
+// ErrEmptySearchQuery is returned for queries without a letter or digit
+var ErrEmptySearchQuery = errors.New("the query must contain a letter or digit")
+
+type SearchService interface {
+	// Search returns the documents of the user matching every term of the query, best matches first.
+	// Terms match the beginning of the words, every user is searched when userID is nil and every type when types is empty.
+	// The index is brought up to date with the templates, deployments, pools and sessions before searching
+	Search(userID *string, query string, types []models.SearchEntityType, limit int) ([]models.SearchDocument, error)
+	// Reindex updates the documents of the entities created or updated since they were indexed and removes those of the deleted entities
+	Reindex() error
+	// Backend returns the full-text engine of the database
+	Backend() SearchBackend
+}
+
+type searchService struct {
+	db *gorm.DB
+	// mu serializes the reindexing, backend is detected on the first use
+	mu      sync.Mutex
+	backend SearchBackend
+}
+
+func NewSearchService(db *gorm.DB) SearchService {
+	return &searchService{db: db}
+}
+
+func (s *searchService) Backend() SearchBackend {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.detectBackend()
+}
+
+// detectBackend picks the full-text engine and creates the SQLite FTS5 table, s.mu must be held
+func (s *searchService) detectBackend() SearchBackend {
+	if s.backend != "" {
+		return s.backend
+	}
+
+	if s.db.Dialector.Name() == "postgres" {
+		s.backend = SearchBackendTsvector
+		return s.backend
+	}
+
+	var fts5 bool
+	if err := s.db.Raw("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&fts5).Error; err != nil || !fts5 {
+		s.backend = SearchBackendLike
+		return s.backend
+	}
+	if err := s.createFTS5Table(); err != nil {
+		log.Printf("Full-text search falls back to LIKE matching: %v", err)
+		s.backend = SearchBackendLike
+		return s.backend
+	}
+	s.backend = SearchBackendFTS5
+	return s.backend
+}
+
+// createFTS5Table creates the FTS5 table indexing the search documents, kept in sync by triggers
+func (s *searchService) createFTS5Table() error {
+	var count int64
+	if err := s.db.Raw("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = 'search_documents_fts'").Scan(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range []string{
+			"CREATE VIRTUAL TABLE search_documents_fts USING fts5(title, body, content='search_documents', content_rowid='id')",
+			`CREATE TRIGGER search_documents_fts_insert AFTER INSERT ON search_documents BEGIN
+				INSERT INTO search_documents_fts(rowid, title, body) VALUES (new.id, new.title, new.body);
+			END`,
+			`CREATE TRIGGER search_documents_fts_delete AFTER DELETE ON search_documents BEGIN
+				INSERT INTO search_documents_fts(search_documents_fts, rowid, title, body) VALUES ('delete', old.id, old.title, old.body);
+			END`,
+			`CREATE TRIGGER search_documents_fts_update AFTER UPDATE ON search_documents BEGIN
+				INSERT INTO search_documents_fts(search_documents_fts, rowid, title, body) VALUES ('delete', old.id, old.title, old.body);
+				INSERT INTO search_documents_fts(rowid, title, body) VALUES (new.id, new.title, new.body);
+			END`,
+			// Index the documents written before the table existed
+			"INSERT INTO search_documents_fts(search_documents_fts) VALUES ('rebuild')",
+		} {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *searchService) Search(userID *string, query string, types []models.SearchEntityType, limit int) ([]models.SearchDocument, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, ErrEmptySearchQuery
+	}
+
+	if err := s.Reindex(); err != nil {
+		return nil, fmt.Errorf("failed to update the search index: %w", err)
+	}
+
+	db := s.db.Model(&models.SearchDocument{})
+	if userID != nil {
+		db = db.Where("search_documents.user_id = ?", *userID)
+	}
+	if len(types) > 0 {
+		db = db.Where("search_documents.entity_type IN ?", types)
+	}
+
+	switch s.Backend() {
+	case SearchBackendTsvector:
+		prefixes := make([]string, len(terms))
+		for i, term := range terms {
+			prefixes[i] = term + ":*"
+		}
+		tsquery := strings.Join(prefixes, " & ")
+		db = db.Where(searchDocumentVector+" @@ to_tsquery('simple', ?)", tsquery).
+			Order(clause.OrderBy{Expression: clause.Expr{
+				SQL:  "ts_rank(" + searchDocumentVector + ", to_tsquery('simple', ?)) DESC, search_documents.id DESC",
+				Vars: []interface{}{tsquery},
+			}})
+	case SearchBackendFTS5:
+		phrases := make([]string, len(terms))
+		for i, term := range terms {
+			phrases[i] = `"` + term + `"*`
+		}
+		// Title matches weigh more than body matches
+		db = db.Joins("JOIN search_documents_fts ON search_documents_fts.rowid = search_documents.id").
+			Where("search_documents_fts MATCH ?", strings.Join(phrases, " ")).
+			Order("bm25(search_documents_fts, 10.0, 1.0), search_documents.id DESC")
+	default:
+		for _, term := range terms {
+			pattern := "%" + term + "%"
+			db = db.Where("(LOWER(search_documents.title) LIKE ? OR LOWER(search_documents.body) LIKE ?)", pattern, pattern)
+		}
+		db = db.Order("search_documents.id DESC")
+	}
+
+	var documents []models.SearchDocument
+	if err := db.Limit(limit).Find(&documents).Error; err != nil {
+		return nil, err
+	}
+	return documents, nil
+}
+
+// searchTerms splits the query into lower case words of letters and digits, so they are safe in every query syntax
+func searchTerms(query string) []string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > maxSearchTerms {
+		words = words[:maxSearchTerms]
+	}
+	return words
+}
+
+// searchSource loads the entities of a type and builds their documents
+type searchSource struct {
+	entityType models.SearchEntityType
+	model      interface{}
+	build      func(db *gorm.DB, ids []string) ([]models.SearchDocument, error)
+}
+
+func (s *searchService) Reindex() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.detectBackend()
+
+	for _, source := range []searchSource{
+		{models.SearchEntityTypeTemplate, &models.Template{}, buildTemplateDocuments},
+		{models.SearchEntityTypeDeployment, &models.Deployment{}, buildDeploymentDocuments},
+		{models.SearchEntityTypeLiquidityPool, &models.LiquidityPool{}, buildLiquidityPoolDocuments},
+		{models.SearchEntityTypeSession, &models.TransactionSession{}, buildSessionDocuments},
+	} {
+		if err := s.reindexSource(source); err != nil {
+			return fmt.Errorf("failed to index the %s records: %w", source.entityType, err)
+		}
+	}
+	return nil
+}
+
+// reindexSource rebuilds the stale documents of the source and removes those of the deleted entities
+func (s *searchService) reindexSource(source searchSource) error {
+	var entities []struct {
+		ID        string
+		UpdatedAt time.Time
+	}
+	if err := s.db.Model(source.model).Select("id", "updated_at").Scan(&entities).Error; err != nil {
+		return err
+	}
+
+	var documents []struct {
+		ID              uint
+		EntityID        string
+		SourceUpdatedAt time.Time
+	}
+	if err := s.db.Model(&models.SearchDocument{}).Select("id", "entity_id", "source_updated_at").
+		Where("entity_type = ?", source.entityType).Scan(&documents).Error; err != nil {
+		return err
+	}
+
+	indexed := make(map[string]time.Time, len(documents))
+	for _, document := range documents {
+		indexed[document.EntityID] = document.SourceUpdatedAt
+	}
+
+	var stale []string
+	existing := make(map[string]bool, len(entities))
+	for _, entity := range entities {
+		existing[entity.ID] = true
+		if updatedAt, ok := indexed[entity.ID]; !ok || updatedAt.Before(entity.UpdatedAt) {
+			stale = append(stale, entity.ID)
+		}
+	}
+
+	var removed []uint
+	for _, document := range documents {
+		if !existing[document.EntityID] {
+			removed = append(removed, document.ID)
+		}
+	}
+	if len(removed) > 0 {
+		if err := s.db.Delete(&models.SearchDocument{}, removed).Error; err != nil {
+			return err
+		}
+	}
+
+	// Build the documents in batches to bound the size of the IN lists
+	const batchSize = 500
+	for start := 0; start < len(stale); start += batchSize {
+		end := min(start+batchSize, len(stale))
+		built, err := source.build(s.db, stale[start:end])
+		if err != nil {
+			return err
+		}
+		if len(built) == 0 {
+			continue
+		}
+		if err := s.db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "title", "body", "summary", "status", "address", "source_updated_at"}),
+		}).Create(&built).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildTemplateDocuments(db *gorm.DB, ids []string) ([]models.SearchDocument, error) {
+	var templates []models.Template
+	if err := db.Select("id", "name", "description", "user_id", "chain_type", "updated_at").Where("id IN ?", ids).Find(&templates).Error; err != nil {
+		return nil, err
+	}
+
+	documents := make([]models.SearchDocument, 0, len(templates))
+	for _, template := range templates {
+		documents = append(documents, models.SearchDocument{
+			EntityType:      models.SearchEntityTypeTemplate,
+			EntityID:        fmt.Sprint(template.ID),
+			UserID:          template.UserId,
+			Title:           template.Name,
+			Body:            joinSearchText(template.Description, string(template.ChainType)),
+			Summary:         fmt.Sprintf("%s template", template.ChainType),
+			SourceUpdatedAt: template.UpdatedAt,
+		})
+	}
+	return documents, nil
+}
+
+func buildDeploymentDocuments(db *gorm.DB, ids []string) ([]models.SearchDocument, error) {
+	var deployments []models.Deployment
+	if err := db.Preload("Chain").Where("id IN ?", ids).Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+
+	documents := make([]models.SearchDocument, 0, len(deployments))
+	for _, deployment := range deployments {
+		title := deploymentTokenLabel(deployment)
+		if title == "" {
+			title = fmt.Sprintf("Deployment %s", deployment.ContractAddress)
+		}
+		documents = append(documents, models.SearchDocument{
+			EntityType: models.SearchEntityTypeDeployment,
+			EntityID:   fmt.Sprint(deployment.ID),
+			UserID:     deployment.UserID,
+			Title:      title,
+			Body: joinSearchText(append(templateValueStrings(deployment.TemplateValues),
+				deployment.Chain.Name, deployment.ContractAddress,
+				deployment.DeployerAddress, deployment.TransactionHash)...),
+			Summary:         fmt.Sprintf("Deployment of template %d on %s", deployment.TemplateID, deployment.Chain.Name),
+			Status:          deployment.Status,
+			Address:         deployment.ContractAddress,
+			SourceUpdatedAt: deployment.UpdatedAt,
+		})
+	}
+	return documents, nil
+}
+
+func buildLiquidityPoolDocuments(db *gorm.DB, ids []string) ([]models.SearchDocument, error) {
+	var pools []models.LiquidityPool
+	if err := db.Where("id IN ?", ids).Find(&pools).Error; err != nil {
+		return nil, err
+	}
+
+	// Name the pools after the deployed token they pair
+	tokenAddresses := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		tokenAddresses = append(tokenAddresses, pool.TokenAddress)
+	}
+	var deployments []models.Deployment
+	if err := db.Select("id", "contract_address", "template_values").Where("contract_address IN ?", tokenAddresses).Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+	tokens := map[string]models.Deployment{}
+	for _, deployment := range deployments {
+		tokens[strings.ToLower(deployment.ContractAddress)] = deployment
+	}
+
+	documents := make([]models.SearchDocument, 0, len(pools))
+	for _, pool := range pools {
+		token := tokens[strings.ToLower(pool.TokenAddress)]
+		label := deploymentTokenLabel(token)
+		if label == "" {
+			label = pool.TokenAddress
+		}
+		documents = append(documents, models.SearchDocument{
+			EntityType: models.SearchEntityTypeLiquidityPool,
+			EntityID:   fmt.Sprint(pool.ID),
+			UserID:     pool.UserID,
+			Title:      fmt.Sprintf("%s liquidity pool", label),
+			Body: joinSearchText(append(templateValueStrings(token.TemplateValues),
+				pool.PairAddress, pool.TokenAddress, pool.Token0, pool.Token1,
+				pool.CreatorAddress, pool.TransactionHash, "uniswap "+pool.UniswapVersion)...),
+			Summary:         fmt.Sprintf("Uniswap %s pool %s of %s and %s", pool.UniswapVersion, pool.PairAddress, pool.Token0, pool.Token1),
+			Status:          pool.Status,
+			Address:         pool.TokenAddress,
+			SourceUpdatedAt: pool.UpdatedAt,
+		})
+	}
+	return documents, nil
+}
+
+func buildSessionDocuments(db *gorm.DB, ids []string) ([]models.SearchDocument, error) {
+	var sessions []models.TransactionSession
+	if err := db.Preload("Chain").Where("id IN ?", ids).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	documents := make([]models.SearchDocument, 0, len(sessions))
+	for _, session := range sessions {
+		title := fmt.Sprintf("%s transaction session", session.TransactionChainType)
+		texts := []string{session.ID, session.Chain.Name}
+		for i, deployment := range session.TransactionDeployments {
+			if i == 0 && deployment.Title != "" {
+				title = deployment.Title
+			}
+			texts = append(texts, deployment.Title, deployment.Description)
+		}
+		for _, metadata := range session.Metadata {
+			texts = append(texts, metadata.Value)
+		}
+		documents = append(documents, models.SearchDocument{
+			EntityType:      models.SearchEntityTypeSession,
+			EntityID:        session.ID,
+			UserID:          session.UserID,
+			Title:           title,
+			Body:            joinSearchText(texts...),
+			Summary:         fmt.Sprintf("Session with %d transaction(s) on %s", len(session.TransactionDeployments), session.Chain.Name),
+			Status:          session.TransactionStatus,
+			SourceUpdatedAt: session.UpdatedAt,
+		})
+	}
+	return documents, nil
+}
+
+// deploymentTokenLabel returns "Name (SYMBOL)" from the token name and symbol template values of the deployment
+func deploymentTokenLabel(deployment models.Deployment) string {
+	var name, symbol string
+	for key, value := range deployment.TemplateValues {
+		text, ok := value.(string)
+		if !ok || text == "" {
+			continue
+		}
+		switch lower := strings.ToLower(key); {
+		case strings.Contains(lower, "symbol"):
+			symbol = text
+		case strings.Contains(lower, "name") && name == "":
+			name = text
+		}
+	}
+	switch {
+	case name != "" && symbol != "":
+		return fmt.Sprintf("%s (%s)", name, symbol)
+	case name != "":
+		return name
+	}
+	return symbol
+}
+
+// templateValueStrings returns the string template values, sorted by key so the documents are stable
+func templateValueStrings(values models.JSON) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var texts []string
+	for _, key := range keys {
+		if text, ok := values[key].(string); ok && text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+// joinSearchText joins the non-empty texts of a document body
+func joinSearchText(texts ...string) string {
+	var parts []string
+	for _, text := range texts {
+		if text = strings.TrimSpace(text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
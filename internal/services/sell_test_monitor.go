@@ -0,0 +1,196 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// DefaultSellTestPollInterval is how often the monitor looks for deployments waiting for a sell test
+	DefaultSellTestPollInterval = 15 * time.Second
+	// sellTestBuyShare is the share of the ETH reserve spent by the simulated buy, in 1/1000
+	sellTestBuyShare = 1
+)
+
+// SellTestSimulator simulates a buy-then-sell of a token
+type SellTestSimulator func(rpcURL string, request utils.SellSimulationRequest) (*utils.SellSimulationResult, error)
+
+// SellTestNotifier is called when the simulated sell of a deployment fails
+type SellTestNotifier func(deployment models.Deployment, result models.JSON)
+
+// SellTestMonitor simulates a small buy-then-sell of tokens once liquidity is added,
+// to catch tokens that cannot be sold (honeypots) before anyone buys them
+type SellTestMonitor struct {
+	deploymentService      DeploymentService
+	liquidityService       LiquidityService
+	uniswapService         UniswapService
+	uniswapContractService UniswapContractService
+	simulator              SellTestSimulator
+	notifier               SellTestNotifier
+	interval               time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewSellTestMonitor(deploymentService DeploymentService, liquidityService LiquidityService, uniswapService UniswapService, uniswapContractService UniswapContractService, simulator SellTestSimulator, notifier SellTestNotifier, interval time.Duration) *SellTestMonitor {
+	if interval <= 0 {
+		interval = DefaultSellTestPollInterval
+	}
+	return &SellTestMonitor{
+		deploymentService:      deploymentService,
+		liquidityService:       liquidityService,
+		uniswapService:         uniswapService,
+		uniswapContractService: uniswapContractService,
+		simulator:              simulator,
+		notifier:               notifier,
+		interval:               interval,
+		now:                    time.Now,
+	}
+}
+
+// Start runs the pending sell tests in the background until Stop is called
+func (m *SellTestMonitor) Start() {
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.RunPending()
+			case <-m.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background monitoring and waits for the current tests to finish
+func (m *SellTestMonitor) Stop() {
+	if m.stop == nil {
+		return
+	}
+	close(m.stop)
+	m.wg.Wait()
+	m.stop = nil
+}
+
+// RunPending runs the sell test of every deployment waiting for one
+func (m *SellTestMonitor) RunPending() {
+	deployments, err := m.deploymentService.ListPendingSellTests()
+	if err != nil {
+		log.Printf("Error listing pending sell tests: %v", err)
+		return
+	}
+
+	for _, deployment := range deployments {
+		if err := m.run(deployment); err != nil {
+			log.Printf("Error running sell test for deployment %d: %v", deployment.ID, err)
+		}
+	}
+}
+
+func (m *SellTestMonitor) run(deployment models.Deployment) error {
+	pool, err := m.liquidityService.GetLiquidityPoolByTokenAddress(deployment.ContractAddress, deployment.ContractAddress)
+	if err != nil || pool.PairAddress == "" {
+		return m.record(deployment, models.SellTestStatusSkipped, map[string]any{"reason": "no confirmed liquidity pool found for the token"})
+	}
+
+	uniswapDeployment, err := m.uniswapService.GetUniswapDeploymentByChain(deployment.ChainID)
+	if err != nil || uniswapDeployment.RouterAddress == "" || uniswapDeployment.WETHAddress == "" {
+		return m.record(deployment, models.SellTestStatusSkipped, map[string]any{"reason": "no Uniswap deployment found for the chain"})
+	}
+
+	pairedToken := pool.Token0
+	if strings.EqualFold(pairedToken, deployment.ContractAddress) {
+		pairedToken = pool.Token1
+	}
+	if !strings.EqualFold(pairedToken, EthTokenAddress) && !strings.EqualFold(pairedToken, uniswapDeployment.WETHAddress) {
+		return m.record(deployment, models.SellTestStatusSkipped, map[string]any{"reason": "the pool is not paired with ETH", "pool_id": pool.ID})
+	}
+
+	reserve0, reserve1, err := m.uniswapContractService.GetReserves(pool.PairAddress, &deployment.Chain)
+	if err != nil {
+		// the test stays pending and is retried on the next poll
+		return fmt.Errorf("failed to get pool reserves: %w", err)
+	}
+	reserveToken, reserveETH := utils.SortPairReserves(deployment.ContractAddress, uniswapDeployment.WETHAddress, reserve0, reserve1)
+
+	buyAmount := new(big.Int).Div(new(big.Int).Mul(reserveETH, big.NewInt(sellTestBuyShare)), big.NewInt(1000))
+	expectedTokens := utils.GetAmountOut(buyAmount, reserveETH, reserveToken)
+	if buyAmount.Sign() <= 0 || expectedTokens.Sign() <= 0 {
+		return m.record(deployment, models.SellTestStatusSkipped, map[string]any{"reason": "the pool has no liquidity", "pool_id": pool.ID})
+	}
+	// Sell half of the expected tokens back so buy taxes up to 50% do not fail the sell
+	sellAmount := new(big.Int).Div(expectedTokens, big.NewInt(2))
+
+	simulation, err := m.simulator(deployment.Chain.RPC, utils.SellSimulationRequest{
+		RouterAddress: uniswapDeployment.RouterAddress,
+		WETHAddress:   uniswapDeployment.WETHAddress,
+		TokenAddress:  deployment.ContractAddress,
+		BuyAmount:     buyAmount,
+		SellAmount:    sellAmount,
+		Deadline:      m.now().Add(10 * time.Minute).Unix(),
+	})
+	if err != nil {
+		return m.record(deployment, models.SellTestStatusSkipped, map[string]any{"reason": fmt.Sprintf("the chain RPC cannot simulate the trades: %v", err), "pool_id": pool.ID})
+	}
+
+	result := map[string]any{
+		"pool_id":         pool.ID,
+		"buy_amount":      buyAmount.String(),
+		"sell_amount":     sellAmount.String(),
+		"expected_tokens": expectedTokens.String(),
+		"simulation":      simulation,
+	}
+	status := models.SellTestStatusPassed
+	if !simulation.BuySucceeded {
+		// a token that cannot be bought is not a honeypot, its transfers are usually gated until trading opens
+		status = models.SellTestStatusNotTradable
+	} else if !simulation.SellSucceeded {
+		status = models.SellTestStatusFailed
+	}
+	return m.record(deployment, status, result)
+}
+
+// record stores the sell test result on the deployment and alerts when the sell failed
+func (m *SellTestMonitor) record(deployment models.Deployment, status models.SellTestStatus, result map[string]any) error {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode sell test result: %w", err)
+	}
+	var stored models.JSON
+	if err := json.Unmarshal(resultJSON, &stored); err != nil {
+		return fmt.Errorf("failed to encode sell test result: %w", err)
+	}
+
+	if err := m.deploymentService.UpdateDeploymentSellTest(deployment.ID, status, stored); err != nil {
+		return err
+	}
+
+	if status == models.SellTestStatusFailed {
+		log.Printf("Simulated sell failed for deployment %d (%s), the token may not be tradable", deployment.ID, deployment.ContractAddress)
+		if m.notifier != nil {
+			m.notifier(deployment, stored)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const sellTestRouter = "0x4444444444444444444444444444444444444444"
+
+type sellTestMonitorFixture struct {
+	deploymentService DeploymentService
+	contractService   *fakeUniswapContractService
+	monitor           *SellTestMonitor
+	deployment        *models.Deployment
+	pool              *models.LiquidityPool
+	simulation        *utils.SellSimulationResult
+	simulatorErr      error
+	requests          []utils.SellSimulationRequest
+	notified          []uint
+}
+
+func setupSellTestMonitor(t *testing.T) *sellTestMonitorFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Chain{}, &models.Template{}, &models.Deployment{}, &models.UniswapDeployment{}, &models.LiquidityPool{}))
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: "http://localhost:8545", NetworkID: "31337", Name: "Local"}
+	require.NoError(t, db.Create(chain).Error)
+	require.NoError(t, db.Create(&models.UniswapDeployment{Version: "v2", WETHAddress: limitOrderTestWETH, RouterAddress: sellTestRouter, ChainID: chain.ID}).Error)
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(template).Error)
+	deployment := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, ContractAddress: limitOrderTestToken, Status: models.TransactionStatusConfirmed}
+	require.NoError(t, db.Create(deployment).Error)
+
+	liquidityService := NewLiquidityService(db)
+	pool := &models.LiquidityPool{
+		TokenAddress: limitOrderTestToken,
+		PairAddress:  limitOrderTestPair,
+		Token0:       limitOrderTestToken,
+		Token1:       EthTokenAddress,
+		Status:       models.TransactionStatusConfirmed,
+	}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+
+	// The token sorts before WETH, so reserve0 is the token and reserve1 is WETH
+	ether := big.NewInt(1e18)
+	f := &sellTestMonitorFixture{
+		deploymentService: NewDeploymentService(db),
+		contractService: &fakeUniswapContractService{
+			reserve0: new(big.Int).Mul(big.NewInt(2_000_000), ether),
+			reserve1: new(big.Int).Mul(big.NewInt(1_000), ether),
+		},
+		deployment: deployment,
+		pool:       pool,
+		simulation: &utils.SellSimulationResult{BuySucceeded: true, SellSucceeded: true},
+	}
+	simulator := func(rpcURL string, request utils.SellSimulationRequest) (*utils.SellSimulationResult, error) {
+		f.requests = append(f.requests, request)
+		if f.simulatorErr != nil {
+			return nil, f.simulatorErr
+		}
+		return f.simulation, nil
+	}
+	notifier := func(deployment models.Deployment, result models.JSON) {
+		f.notified = append(f.notified, deployment.ID)
+	}
+	f.monitor = NewSellTestMonitor(f.deploymentService, liquidityService, NewUniswapService(db), f.contractService, simulator, notifier, time.Minute)
+	return f
+}
+
+func (f *sellTestMonitorFixture) runSellTest(t *testing.T) *models.Deployment {
+	require.NoError(t, f.deploymentService.RequestSellTest(f.deployment.ChainID, f.deployment.ContractAddress))
+	f.monitor.RunPending()
+
+	deployment, err := f.deploymentService.GetDeploymentByID(f.deployment.ID)
+	require.NoError(t, err)
+	return deployment
+}
+
+func TestSellTestMonitorRecordsPassedSell(t *testing.T) {
+	f := setupSellTestMonitor(t)
+
+	deployment := f.runSellTest(t)
+
+	assert.Equal(t, models.SellTestStatusPassed, deployment.SellTestStatus)
+	assert.NotNil(t, deployment.SellTestedAt)
+	assert.Empty(t, f.notified)
+
+	// The buy spends 0.1% of the 1000 ETH reserve and sells half of the expected tokens back
+	require.Len(t, f.requests, 1)
+	assert.Equal(t, sellTestRouter, f.requests[0].RouterAddress)
+	assert.Equal(t, "1000000000000000000", f.requests[0].BuyAmount.String())
+	assert.Equal(t, deployment.SellTestResult["sell_amount"], f.requests[0].SellAmount.String())
+
+	pending, err := f.deploymentService.ListPendingSellTests()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestSellTestMonitorNotifiesFailedSell(t *testing.T) {
+	f := setupSellTestMonitor(t)
+	f.simulation = &utils.SellSimulationResult{
+		BuySucceeded: true,
+		Steps:        []utils.SellSimulationStep{{Name: "sell", Error: "execution reverted: TRANSFER_BLOCKED"}},
+	}
+
+	deployment := f.runSellTest(t)
+
+	assert.Equal(t, models.SellTestStatusFailed, deployment.SellTestStatus)
+	assert.Equal(t, []uint{f.deployment.ID}, f.notified)
+}
+
+func TestSellTestMonitorDoesNotNotifyRevertedBuy(t *testing.T) {
+	f := setupSellTestMonitor(t)
+	f.simulation = &utils.SellSimulationResult{
+		Steps: []utils.SellSimulationStep{{Name: "buy", Error: "execution reverted: TRADING_NOT_ENABLED"}},
+	}
+
+	deployment := f.runSellTest(t)
+
+	assert.Equal(t, models.SellTestStatusNotTradable, deployment.SellTestStatus)
+	assert.Empty(t, f.notified)
+}
+
+func TestSellTestMonitorSkipsUnsupportedTests(t *testing.T) {
+	f := setupSellTestMonitor(t)
+	f.simulatorErr = errors.New("the method eth_simulateV1 does not exist")
+
+	deployment := f.runSellTest(t)
+
+	assert.Equal(t, models.SellTestStatusSkipped, deployment.SellTestStatus)
+	assert.Contains(t, deployment.SellTestResult["reason"], "eth_simulateV1 does not exist")
+	assert.Empty(t, f.notified)
+}
+
+func TestSellTestMonitorIgnoresUnknownTokens(t *testing.T) {
+	f := setupSellTestMonitor(t)
+
+	require.NoError(t, f.deploymentService.RequestSellTest(f.deployment.ChainID, "0x9999999999999999999999999999999999999999"))
+	pending, err := f.deploymentService.ListPendingSellTests()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// EnvSessionURLOneTime makes the signed session urls one-time: the first browser opening a url is bound to the
+// session and the url no longer opens it elsewhere. It requires LAUNCHPAD_SESSION_URL_SECRET
+const EnvSessionURLOneTime = "LAUNCHPAD_SESSION_URL_ONE_TIME"
+
+// SessionURLOneTimeFromEnv reports whether LAUNCHPAD_SESSION_URL_ONE_TIME is enabled
+func SessionURLOneTimeFromEnv() bool {
+	return os.Getenv(EnvSessionURLOneTime) == "true"
+}
+
+// SessionAccessService binds the one-time session urls to the browser that opened them first
+type SessionAccessService interface {
+	// Claim binds the session to a new browser binding and returns it, empty when the session is already bound
+	Claim(sessionID string) (string, error)
+	// Verify reports whether the binding is the one the session is bound to
+	Verify(sessionID, binding string) (bool, error)
+	// Rebind replaces the binding of the session with a new one and returns it, empty when the session is not
+	// bound to the current binding. The browser of the current binding loses its access
+	Rebind(sessionID, current string) (string, error)
+}
+
+type sessionAccessService struct {
+	db *gorm.DB
+}
+
+func NewSessionAccessService(db *gorm.DB) SessionAccessService {
+	return &sessionAccessService{db: db}
+}
+
+func hashSessionBinding(binding string) string {
+	hash := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(hash[:])
+}
+
+func generateSessionBinding() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate the session binding: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func (s *sessionAccessService) Claim(sessionID string) (string, error) {
+	binding, err := generateSessionBinding()
+	if err != nil {
+		return "", err
+	}
+
+	// Only the first claim of the session updates the row
+	result := s.db.Model(&models.TransactionSession{}).
+		Where("id = ? AND (access_binding_hash IS NULL OR access_binding_hash = '')", sessionID).
+		UpdateColumn("access_binding_hash", hashSessionBinding(binding))
+	if result.Error != nil {
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", nil
+	}
+	return binding, nil
+}
+
+func (s *sessionAccessService) Verify(sessionID, binding string) (bool, error) {
+	if binding == "" {
+		return false, nil
+	}
+	var session models.TransactionSession
+	if err := s.db.Select("access_binding_hash").Where("id = ?", sessionID).First(&session).Error; err != nil {
+		return false, err
+	}
+	expected := session.AccessBindingHash
+	return expected != "" && subtle.ConstantTimeCompare([]byte(hashSessionBinding(binding)), []byte(expected)) == 1, nil
+}
+
+func (s *sessionAccessService) Rebind(sessionID, current string) (string, error) {
+	if current == "" {
+		return "", nil
+	}
+	binding, err := generateSessionBinding()
+	if err != nil {
+		return "", err
+	}
+
+	// Only the holder of the current binding swaps it, a concurrent rebind with the same binding loses
+	result := s.db.Model(&models.TransactionSession{}).
+		Where("id = ? AND access_binding_hash = ?", sessionID, hashSessionBinding(current)).
+		UpdateColumn("access_binding_hash", hashSessionBinding(binding))
+	if result.Error != nil {
+		return "", result.Error
+	}
+	if result.RowsAffected == 0 {
+		return "", nil
+	}
+	return binding, nil
+}
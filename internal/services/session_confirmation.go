@@ -0,0 +1,137 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// confirmationApproval is the reply approving a session in the confirmation request
+	confirmationApproval = "APPROVE"
+	// confirmationMaxTokens bounds the reply to the confirmation request
+	confirmationMaxTokens = 20
+)
+
+// EnvConfirmationThreshold is the native value in wei sent by a transaction session above which the session requires
+// a human confirmation through MCP sampling before it is created. Unset disables the confirmation
+const EnvConfirmationThreshold = "LAUNCHPAD_CONFIRMATION_THRESHOLD"
+
+// ConfirmationThresholdFromEnv returns the threshold of LAUNCHPAD_CONFIRMATION_THRESHOLD, or nil when it is unset or invalid
+func ConfirmationThresholdFromEnv() *big.Int {
+	value := os.Getenv(EnvConfirmationThreshold)
+	if value == "" {
+		return nil
+	}
+	threshold, ok := new(big.Int).SetString(value, 10)
+	if !ok || threshold.Sign() < 0 {
+		log.Printf("Warning: ignoring invalid %s %q, sessions are created without confirmation", EnvConfirmationThreshold, value)
+		return nil
+	}
+	return threshold
+}
+
+// SessionValue returns the native value in wei sent by the transactions, values that are not wei amounts are ignored.
+// Token amounts moved by the calls are not part of it: they have no common unit with the threshold
+func SessionValue(transactions []models.TransactionDeployment) *big.Int {
+	total := new(big.Int)
+	for _, tx := range transactions {
+		if value, ok := new(big.Int).SetString(tx.Value, 10); ok && value.Sign() > 0 {
+			total.Add(total, value)
+		}
+	}
+	return total
+}
+
+// confirmedTransactionService asks for a human confirmation through MCP sampling before creating a session sending
+// more native value than the threshold, and records the approval on the session. The MCP server is taken from the
+// Context of the request, sessions above the threshold without one are refused unless they are Background sessions
+type confirmedTransactionService struct {
+	TransactionService
+	threshold *big.Int
+}
+
+// NewConfirmedTransactionService wraps the service with the session confirmation, a nil threshold returns the service as is
+func NewConfirmedTransactionService(inner TransactionService, threshold *big.Int) TransactionService {
+	if threshold == nil {
+		return inner
+	}
+	return &confirmedTransactionService{TransactionService: inner, threshold: threshold}
+}
+
+func (s *confirmedTransactionService) CreateTransactionSession(req CreateTransactionSessionRequest) (string, error) {
+	return s.CreateTransactionSessionWithUser(req, nil)
+}
+
+func (s *confirmedTransactionService) CreateTransactionSessionWithUser(req CreateTransactionSessionRequest, userID *string) (string, error) {
+	if req.Confirmation == nil && !req.Background {
+		confirmation, err := s.confirm(req)
+		if err != nil {
+			return "", err
+		}
+		req.Confirmation = confirmation
+	}
+	return s.TransactionService.CreateTransactionSessionWithUser(req, userID)
+}
+
+// confirm returns the approval of a session above the threshold, nil for the sessions below it
+func (s *confirmedTransactionService) confirm(req CreateTransactionSessionRequest) (*models.SessionConfirmation, error) {
+	value := SessionValue(req.TransactionDeployments)
+	if value.Cmp(s.threshold) <= 0 {
+		return nil, nil
+	}
+
+	var srv *server.MCPServer
+	if req.Context != nil {
+		srv = server.ServerFromContext(req.Context)
+	}
+	if srv == nil {
+		return nil, fmt.Errorf("the session sends %s wei, above the confirmation threshold of %s wei, and requires a human confirmation from an MCP client", value, s.threshold)
+	}
+
+	var transactions []string
+	for _, tx := range req.TransactionDeployments {
+		transactions = append(transactions, fmt.Sprintf("- %s: %s wei", tx.Title, tx.Value))
+	}
+	result, err := srv.RequestSampling(req.Context, mcp.CreateMessageRequest{
+		CreateMessageParams: mcp.CreateMessageParams{
+			Messages: []mcp.SamplingMessage{{
+				Role: mcp.RoleUser,
+				Content: mcp.NewTextContent(fmt.Sprintf("A transaction session sending %s wei in total, above the confirmation threshold of %s wei, is about to be created:\n%s\nReply %s to create the session, anything else cancels it.",
+					value, s.threshold, strings.Join(transactions, "\n"), confirmationApproval)),
+			}},
+			SystemPrompt: fmt.Sprintf("This is a confirmation request for a human. Show it to the user and reply exactly with the answer of the user, %s or DENY.", confirmationApproval),
+			MaxTokens:    confirmationMaxTokens,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("the session sends %s wei, above the confirmation threshold of %s wei, and the confirmation request failed: %v", value, s.threshold, err)
+	}
+
+	var response string
+	switch content := result.Content.(type) {
+	case mcp.TextContent:
+		response = content.Text
+	case map[string]any:
+		response, _ = content["text"].(string)
+	}
+	response = strings.TrimSpace(response)
+	if !strings.EqualFold(strings.Trim(response, ".!"), confirmationApproval) {
+		return nil, fmt.Errorf("the session sending %s wei was not confirmed", value)
+	}
+
+	return &models.SessionConfirmation{
+		Value:       value.String(),
+		Threshold:   s.threshold.String(),
+		Response:    response,
+		Model:       result.Model,
+		ConfirmedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmationThresholdFromEnv(t *testing.T) {
+	t.Setenv(EnvConfirmationThreshold, "")
+	assert.Nil(t, ConfirmationThresholdFromEnv())
+	t.Setenv(EnvConfirmationThreshold, "1000000000000000000")
+	assert.Equal(t, "1000000000000000000", ConfirmationThresholdFromEnv().String())
+	t.Setenv(EnvConfirmationThreshold, "1 ETH")
+	assert.Nil(t, ConfirmationThresholdFromEnv())
+}
+
+func TestSessionValue(t *testing.T) {
+	value := SessionValue([]models.TransactionDeployment{{Value: "0"}, {Value: "1500"}, {Value: "2500"}, {Value: ""}, {Value: "-1"}})
+	assert.Equal(t, big.NewInt(4000), value)
+}
+
+func TestSessionConfirmationIsStored(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, dbService.GetDB().Create(chain).Error)
+
+	txService := NewTransactionService(dbService.GetDB())
+	sessionID, err := txService.CreateTransactionSession(CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Title: "Swap", Value: "1500"}},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+		Confirmation:           &models.SessionConfirmation{Value: "1500", Threshold: "1000", Response: "APPROVE", ConfirmedAt: time.Now()},
+	})
+	require.NoError(t, err)
+
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, session.Confirmation)
+	assert.Equal(t, "APPROVE", session.Confirmation.Response)
+	assert.Equal(t, "1000", session.Confirmation.Threshold)
+}
+
+// confirmationTestSession answers the sampling requests of the confirmation
+type confirmationTestSession struct {
+	reply    string
+	err      error
+	requests int
+}
+
+func (s *confirmationTestSession) Initialize()       {}
+func (s *confirmationTestSession) Initialized() bool { return true }
+func (s *confirmationTestSession) SessionID() string { return "confirmation-test" }
+func (s *confirmationTestSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return make(chan mcp.JSONRPCNotification, 1)
+}
+func (s *confirmationTestSession) RequestSampling(ctx context.Context, request mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+	s.requests++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &mcp.CreateMessageResult{
+		SamplingMessage: mcp.SamplingMessage{Role: mcp.RoleAssistant, Content: mcp.NewTextContent(s.reply)},
+		Model:           "client",
+	}, nil
+}
+
+// createThroughServer creates a session sending value through the confirmed service inside a tool call of an MCP
+// server, as the tools do, and returns the request received by the inner service
+func createThroughServer(t *testing.T, clientSession server.ClientSession, threshold *big.Int, value string) (*CreateTransactionSessionRequest, error) {
+	inner := &recordingTransactionService{}
+	txService := NewConfirmedTransactionService(inner, threshold)
+
+	var createErr error
+	srv := server.NewMCPServer("test", "1.0.0")
+	srv.EnableSampling()
+	srv.AddTool(mcp.NewTool("add_liquidity"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		_, createErr = txService.CreateTransactionSessionWithUser(CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{
+				{Title: "Approve", Value: "0"},
+				{Title: "Add Liquidity", Value: value},
+			},
+			Context: ctx,
+		}, nil)
+		return mcp.NewToolResultText("done"), nil
+	})
+
+	message, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "tools/call",
+		"params":  map[string]any{"name": "add_liquidity"},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+	if clientSession != nil {
+		ctx = srv.WithContext(ctx, clientSession)
+	}
+	srv.HandleMessage(ctx, message)
+	if len(inner.requests) == 0 {
+		return nil, createErr
+	}
+	return &inner.requests[0], createErr
+}
+
+func TestConfirmedTransactionService(t *testing.T) {
+	threshold := big.NewInt(1000)
+
+	t.Run("approved", func(t *testing.T) {
+		client := &confirmationTestSession{reply: " approve. "}
+		session, err := createThroughServer(t, client, threshold, "1500")
+		require.NoError(t, err)
+		assert.Equal(t, 1, client.requests)
+		require.NotNil(t, session.Confirmation)
+		assert.Equal(t, "1500", session.Confirmation.Value)
+		assert.Equal(t, "1000", session.Confirmation.Threshold)
+		assert.Equal(t, "approve.", session.Confirmation.Response)
+		assert.Equal(t, "client", session.Confirmation.Model)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		session, err := createThroughServer(t, &confirmationTestSession{reply: "DENY"}, threshold, "1500")
+		assert.ErrorContains(t, err, "was not confirmed")
+		assert.Nil(t, session)
+	})
+
+	t.Run("sampling_failed", func(t *testing.T) {
+		_, err := createThroughServer(t, &confirmationTestSession{err: errors.New("user rejected")}, threshold, "1500")
+		assert.ErrorContains(t, err, "user rejected")
+	})
+
+	t.Run("no_sampling_session", func(t *testing.T) {
+		_, err := createThroughServer(t, nil, threshold, "1500")
+		assert.ErrorContains(t, err, "confirmation request failed")
+	})
+
+	t.Run("below_threshold", func(t *testing.T) {
+		client := &confirmationTestSession{reply: "DENY"}
+		session, err := createThroughServer(t, client, threshold, "1000")
+		require.NoError(t, err)
+		assert.Zero(t, client.requests)
+		assert.Nil(t, session.Confirmation)
+	})
+
+	t.Run("no_threshold", func(t *testing.T) {
+		client := &confirmationTestSession{reply: "DENY"}
+		_, err := createThroughServer(t, client, nil, "1000000")
+		require.NoError(t, err)
+		assert.Zero(t, client.requests)
+	})
+}
+
+func TestConfirmedTransactionServiceWithoutServer(t *testing.T) {
+	inner := &recordingTransactionService{}
+	txService := NewConfirmedTransactionService(inner, big.NewInt(1))
+
+	_, err := txService.CreateTransactionSession(CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Value: "2"}},
+	})
+	assert.ErrorContains(t, err, "requires a human confirmation")
+	assert.Empty(t, inner.requests)
+
+	// the swaps of the orders run by the background jobs have no client to confirm them
+	_, err = txService.CreateTransactionSession(CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{Value: "2"}},
+		Background:             true,
+	})
+	require.NoError(t, err)
+	assert.Len(t, inner.requests, 1)
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// ErrUnsupportedChainOperation is returned by the adapters of chains not supporting a transaction yet
+var ErrUnsupportedChainOperation = errors.New("not supported on this chain")
+
+type solanaChainAdapter struct{}
+
+// NewSolanaChainAdapter tracks Solana transactions by their signature
+func NewSolanaChainAdapter() ChainAdapter {
+	return &solanaChainAdapter{}
+}
+
+func (a *solanaChainAdapter) ChainType() models.TransactionChainType {
+	return models.TransactionChainTypeSolana
+}
+
+// BuildDeployTx is not supported, Anchor programs are deployed through the upgradeable BPF loader in several transactions
+func (a *solanaChainAdapter) BuildDeployTx(args BuildDeployTxArgs) (models.TransactionDeployment, error) {
+	return models.TransactionDeployment{}, fmt.Errorf("deploying Anchor programs through a transaction session is %w, deploy %s with anchor deploy", ErrUnsupportedChainOperation, args.Template.Name)
+}
+
+// BuildCallTx is not supported, the accounts of the instructions are not known to the launchpad
+func (a *solanaChainAdapter) BuildCallTx(args BuildCallTxArgs) (models.TransactionDeployment, error) {
+	return models.TransactionDeployment{}, fmt.Errorf("calling the %s instruction of an Anchor program is %w", args.FunctionName, ErrUnsupportedChainOperation)
+}
+
+func (a *solanaChainAdapter) GetBalance(rpcURL string, address string) (*utils.BalanceResult, error) {
+	return utils.QuerySolanaBalance(rpcURL, address)
+}
+
+// WaitForReceipt polls getSignatureStatuses until the cluster confirms the transaction, txHash is its signature
+func (a *solanaChainAdapter) WaitForReceipt(ctx context.Context, rpcURL string, txHash string) (*ChainReceipt, error) {
+	client := utils.NewRPCClient(rpcURL)
+	return pollReceipt(ctx, func() (*ChainReceipt, error) {
+		success, status, err := client.VerifySolanaTransactionSuccess(txHash)
+		if err != nil {
+			return nil, err
+		}
+		// A failed transaction is final, a processed one can still be dropped
+		if !success && status.Err == nil {
+			return nil, fmt.Errorf("transaction is only %s", status.ConfirmationStatus)
+		}
+		return &ChainReceipt{
+			Success: success,
+			Status:  status.ConfirmationStatus,
+			Block:   strconv.FormatUint(status.Slot, 10),
+			Error:   status.Err,
+		}, nil
+	})
+}
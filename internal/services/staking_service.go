@@ -0,0 +1,138 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+// StakingEmissions is the reward schedule of a staking pool at a point in time, computed like the farm contract
+type StakingEmissions struct {
+	// RewardRate is the reward emitted per second, rounded down like the rewardRate of the contract
+	RewardRate string `json:"reward_rate"`
+	Emitted    string `json:"emitted"`
+	Remaining  string `json:"remaining"`
+	// Progress is the elapsed share of the reward period, from 0 to 1
+	Progress float64 `json:"progress"`
+	Finished bool    `json:"finished"`
+}
+
+type StakingService interface {
+	CreateStakingPool(pool *models.StakingPool) error
+	GetStakingPool(id uint) (*models.StakingPool, error)
+	GetStakingPoolBySessionId(sessionId string) (*models.StakingPool, error)
+	// ListStakingPools returns the pools of the user, every pool when userID is nil, newest first
+	ListStakingPools(userID *string) ([]models.StakingPool, error)
+	// MarkStakingPoolDeployed records the confirmed address of the farm
+	MarkStakingPoolDeployed(id uint, contractAddress string) error
+	// StartRewardPeriod records the confirmed notifyRewardAmount transaction, the period runs for the rewards duration from startedAt
+	StartRewardPeriod(id uint, txHash string, startedAt time.Time) error
+	UpdateStakingPoolStatus(id uint, status models.StakingPoolStatus) error
+	// GetEmissions computes the rewards emitted by the pool at now
+	GetEmissions(pool *models.StakingPool, now time.Time) (*StakingEmissions, error)
+}
+
+type stakingService struct {
+	db *gorm.DB
+}
+
+func NewStakingService(db *gorm.DB) StakingService {
+	return &stakingService{db: db}
+}
+
+func (s *stakingService) CreateStakingPool(pool *models.StakingPool) error {
+	if pool.Status == "" {
+		pool.Status = models.StakingPoolStatusPending
+	}
+	return s.db.Create(pool).Error
+}
+
+func (s *stakingService) GetStakingPool(id uint) (*models.StakingPool, error) {
+	var pool models.StakingPool
+	err := s.db.Preload("Chain").First(&pool, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func (s *stakingService) GetStakingPoolBySessionId(sessionId string) (*models.StakingPool, error) {
+	var pool models.StakingPool
+	err := s.db.Preload("Chain").Where("session_id = ?", sessionId).First(&pool).Error
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+func (s *stakingService) ListStakingPools(userID *string) ([]models.StakingPool, error) {
+	var pools []models.StakingPool
+	query := s.db.Preload("Chain").Order("id DESC")
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+	err := query.Find(&pools).Error
+	return pools, err
+}
+
+func (s *stakingService) MarkStakingPoolDeployed(id uint, contractAddress string) error {
+	return s.db.Model(&models.StakingPool{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.StakingPoolStatusDeployed,
+		"contract_address": contractAddress,
+	}).Error
+}
+
+func (s *stakingService) StartRewardPeriod(id uint, txHash string, startedAt time.Time) error {
+	var pool models.StakingPool
+	if err := s.db.First(&pool, id).Error; err != nil {
+		return err
+	}
+	periodFinish := startedAt.Add(time.Duration(pool.RewardsDuration) * time.Second)
+	return s.db.Model(&pool).Updates(map[string]interface{}{
+		"status":                   models.StakingPoolStatusActive,
+		"funding_transaction_hash": txHash,
+		"period_start":             startedAt,
+		"period_finish":            periodFinish,
+	}).Error
+}
+
+func (s *stakingService) UpdateStakingPoolStatus(id uint, status models.StakingPoolStatus) error {
+	return s.db.Model(&models.StakingPool{}).Where("id = ?", id).Update("status", status).Error
+}
+
+func (s *stakingService) GetEmissions(pool *models.StakingPool, now time.Time) (*StakingEmissions, error) {
+	reward, ok := new(big.Int).SetString(pool.RewardAmount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid reward amount %s", pool.RewardAmount)
+	}
+	if pool.RewardsDuration == 0 {
+		return nil, fmt.Errorf("staking pool %d has no rewards duration", pool.ID)
+	}
+
+	duration := new(big.Int).SetUint64(pool.RewardsDuration)
+	rate := new(big.Int).Quo(reward, duration)
+	// The contract distributes rewardRate * duration, the rounding remainder stays in the farm
+	distributed := new(big.Int).Mul(rate, duration)
+	emissions := &StakingEmissions{
+		RewardRate: rate.String(),
+		Emitted:    "0",
+		Remaining:  distributed.String(),
+	}
+	if pool.PeriodStart == nil || now.Before(*pool.PeriodStart) {
+		return emissions, nil
+	}
+
+	elapsed := uint64(now.Sub(*pool.PeriodStart) / time.Second)
+	if elapsed >= pool.RewardsDuration {
+		elapsed = pool.RewardsDuration
+		emissions.Finished = true
+	}
+	emitted := new(big.Int).Mul(rate, new(big.Int).SetUint64(elapsed))
+	emissions.Emitted = emitted.String()
+	emissions.Remaining = new(big.Int).Sub(distributed, emitted).String()
+	emissions.Progress = float64(elapsed) / float64(pool.RewardsDuration)
+	return emissions, nil
+}
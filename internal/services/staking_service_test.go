@@ -0,0 +1,70 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakingService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	service := NewStakingService(dbService.GetDB())
+
+	owner := "user-1"
+	pool := &models.StakingPool{
+		UserID:          &owner,
+		DeploymentID:    2,
+		ChainID:         1,
+		StakingToken:    "0x01",
+		RewardToken:     "0x01",
+		DeployerAddress: "0x02",
+		RewardAmount:    "1000",
+		RewardsDuration: 300,
+		SessionId:       "session-1",
+	}
+	require.NoError(t, service.CreateStakingPool(pool))
+	assert.Equal(t, models.StakingPoolStatusPending, pool.Status)
+
+	// Nothing is emitted before the period is funded
+	emissions, err := service.GetEmissions(pool, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "3", emissions.RewardRate)
+	assert.Equal(t, "0", emissions.Emitted)
+	assert.Equal(t, "900", emissions.Remaining)
+
+	require.NoError(t, service.MarkStakingPoolDeployed(pool.ID, "0x03"))
+	startedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, service.StartRewardPeriod(pool.ID, "0xabc", startedAt))
+	pool, err = service.GetStakingPoolBySessionId("session-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.StakingPoolStatusActive, pool.Status)
+	assert.Equal(t, "0x03", pool.ContractAddress)
+	assert.Equal(t, "0xabc", pool.FundingTransactionHash)
+	assert.True(t, startedAt.Add(5*time.Minute).Equal(*pool.PeriodFinish))
+
+	emissions, err = service.GetEmissions(pool, startedAt.Add(100*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, "300", emissions.Emitted)
+	assert.Equal(t, "600", emissions.Remaining)
+	assert.InDelta(t, 1.0/3, emissions.Progress, 1e-9)
+	assert.False(t, emissions.Finished)
+
+	emissions, err = service.GetEmissions(pool, startedAt.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "900", emissions.Emitted)
+	assert.Equal(t, "0", emissions.Remaining)
+	assert.True(t, emissions.Finished)
+
+	other := "user-2"
+	pools, err := service.ListStakingPools(&other)
+	require.NoError(t, err)
+	assert.Empty(t, pools)
+	pools, err = service.ListStakingPools(nil)
+	require.NoError(t, err)
+	assert.Len(t, pools, 1)
+}
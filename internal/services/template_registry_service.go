@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// EnvTemplateRegistryURL is the HTTPS URL of the index.json of the subscribed template registry
+	EnvTemplateRegistryURL = "LAUNCHPAD_TEMPLATE_REGISTRY_URL"
+	// EnvTemplateRegistryPublicKey is the hex or base64 ed25519 public key signing the bundles of the registry
+	EnvTemplateRegistryPublicKey = "LAUNCHPAD_TEMPLATE_REGISTRY_PUBLIC_KEY"
+
+	// maxRegistryResponseSize bounds the index and bundles read from the registry
+	maxRegistryResponseSize = 10 << 20
+)
+
+// RegistryIndex is the index.json of a template registry
+type RegistryIndex struct {
+	Templates []RegistryEntry `json:"templates"`
+}
+
+// RegistryEntry is a version of a registry template. The bundle is a template bundle of export_templates,
+// signed with the ed25519 key of the registry
+type RegistryEntry struct {
+	Name        string                      `json:"name"`
+	Version     string                      `json:"version"`
+	Description string                      `json:"description"`
+	ChainType   models.TransactionChainType `json:"chain_type"`
+	// BundleURL is absolute or relative to the index URL
+	BundleURL string `json:"bundle_url"`
+	// Signature is the base64 ed25519 signature of RegistrySignedMessage, binding the bundle to its name and version
+	Signature string `json:"signature"`
+}
+
+// TemplateRegistryService fetches and verifies the templates of the subscribed registry and records the installed versions
+type TemplateRegistryService interface {
+	// RegistryURL returns the index URL, empty when no registry is subscribed
+	RegistryURL() string
+	FetchIndex(ctx context.Context) (*RegistryIndex, error)
+	// FetchBundle downloads the bundle of the entry and returns it once its signature is verified
+	FetchBundle(ctx context.Context, entry RegistryEntry) ([]byte, error)
+	// ListInstalledTemplates returns the templates the user installed from the registry
+	ListInstalledTemplates(userID *string) ([]models.InstalledTemplate, error)
+	// GetInstalledVersion returns the templates installed from the version, empty when it is not installed
+	GetInstalledVersion(userID *string, name, version string) ([]models.InstalledTemplate, error)
+	RecordInstalledTemplates(installed []models.InstalledTemplate) error
+}
+
+type templateRegistryService struct {
+	db         *gorm.DB
+	client     *http.Client
+	indexURL   string
+	publicKey  ed25519.PublicKey
+	configErr  error
+	registryID string
+}
+
+// NewTemplateRegistryService subscribes to the registry of indexURL. An invalid URL or key is reported by every fetch
+func NewTemplateRegistryService(db *gorm.DB, client *http.Client, indexURL, publicKey string) TemplateRegistryService {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	s := &templateRegistryService{db: db, client: client, indexURL: indexURL}
+	if indexURL == "" {
+		return s
+	}
+
+	parsed, err := url.Parse(indexURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		s.configErr = fmt.Errorf("%s must be an https URL, got %q", EnvTemplateRegistryURL, indexURL)
+		return s
+	}
+	s.registryID = parsed.Host + parsed.Path
+
+	key, err := decodeRegistryKey(publicKey)
+	if err != nil {
+		s.configErr = fmt.Errorf("invalid %s: %w", EnvTemplateRegistryPublicKey, err)
+		return s
+	}
+	s.publicKey = key
+	return s
+}
+
+// NewTemplateRegistryServiceFromEnv subscribes to the registry of LAUNCHPAD_TEMPLATE_REGISTRY_URL
+func NewTemplateRegistryServiceFromEnv(db *gorm.DB) TemplateRegistryService {
+	return NewTemplateRegistryService(db, nil, os.Getenv(EnvTemplateRegistryURL), os.Getenv(EnvTemplateRegistryPublicKey))
+}
+
+func decodeRegistryKey(publicKey string) (ed25519.PublicKey, error) {
+	publicKey = strings.TrimSpace(publicKey)
+	if publicKey == "" {
+		return nil, fmt.Errorf("the public key is required to verify the bundles")
+	}
+	key, err := hex.DecodeString(strings.TrimPrefix(publicKey, "0x"))
+	if err != nil {
+		key, err = base64.StdEncoding.DecodeString(publicKey)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the public key must be hex or base64")
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("the public key must be %d bytes, got %d", ed25519.PublicKeySize, len(key))
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+func (s *templateRegistryService) RegistryURL() string {
+	return s.indexURL
+}
+
+func (s *templateRegistryService) checkConfigured() error {
+	if s.indexURL == "" {
+		return fmt.Errorf("no template registry is subscribed, set %s and %s", EnvTemplateRegistryURL, EnvTemplateRegistryPublicKey)
+	}
+	return s.configErr
+}
+
+func (s *templateRegistryService) FetchIndex(ctx context.Context) (*RegistryIndex, error) {
+	if err := s.checkConfigured(); err != nil {
+		return nil, err
+	}
+	body, err := s.get(ctx, s.indexURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch registry index: %w", err)
+	}
+	var index RegistryIndex
+	if err := json.Unmarshal(body, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse registry index: %w", err)
+	}
+	return &index, nil
+}
+
+func (s *templateRegistryService) FetchBundle(ctx context.Context, entry RegistryEntry) ([]byte, error) {
+	if err := s.checkConfigured(); err != nil {
+		return nil, err
+	}
+	base, _ := url.Parse(s.indexURL)
+	bundleURL, err := base.Parse(entry.BundleURL)
+	if err != nil || entry.BundleURL == "" {
+		return nil, fmt.Errorf("invalid bundle URL %q", entry.BundleURL)
+	}
+	if bundleURL.Scheme != "https" {
+		return nil, fmt.Errorf("bundle URL %s must use https", bundleURL)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(entry.Signature)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid signature of %s %s", entry.Name, entry.Version)
+	}
+	body, err := s.get(ctx, bundleURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch bundle: %w", err)
+	}
+	if !ed25519.Verify(s.publicKey, RegistrySignedMessage(entry.Name, entry.Version, body), signature) {
+		return nil, fmt.Errorf("the signature of %s %s does not match the registry key, the bundle was not imported", entry.Name, entry.Version)
+	}
+	return body, nil
+}
+
+func (s *templateRegistryService) get(ctx context.Context, target string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned %s", target, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRegistryResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxRegistryResponseSize {
+		return nil, fmt.Errorf("GET %s returned more than %d bytes", target, maxRegistryResponseSize)
+	}
+	return body, nil
+}
+
+func (s *templateRegistryService) scope(userID *string) *gorm.DB {
+	query := s.db.Where("registry = ?", s.registryID)
+	if userID == nil {
+		return query.Where("user_id IS NULL")
+	}
+	return query.Where("user_id = ?", *userID)
+}
+
+func (s *templateRegistryService) ListInstalledTemplates(userID *string) ([]models.InstalledTemplate, error) {
+	var installed []models.InstalledTemplate
+	err := s.scope(userID).Order("id").Find(&installed).Error
+	return installed, err
+}
+
+func (s *templateRegistryService) GetInstalledVersion(userID *string, name, version string) ([]models.InstalledTemplate, error) {
+	var installed []models.InstalledTemplate
+	err := s.scope(userID).Where("name = ? AND version = ?", name, version).Order("id").Find(&installed).Error
+	return installed, err
+}
+
+// RecordInstalledTemplates records the templates created from a bundle under the registry of the service
+func (s *templateRegistryService) RecordInstalledTemplates(installed []models.InstalledTemplate) error {
+	for i := range installed {
+		installed[i].Registry = s.registryID
+	}
+	return s.db.Create(&installed).Error
+}
+
+// RegistrySignedMessage returns the message signed by the registry for a version of a template, name|version|digest
+// with the BundleDigest of the bundle, so a signed bundle cannot be listed under another name or version
+func RegistrySignedMessage(name, version string, bundle []byte) []byte {
+	return []byte(name + "|" + version + "|" + BundleDigest(bundle))
+}
+
+// BundleDigest returns the hex sha256 digest recorded for an installed bundle
+func BundleDigest(bundle []byte) string {
+	digest := sha256.Sum256(bundle)
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegistryTestServer(t *testing.T, index string, bundle []byte) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/index.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(index))
+	})
+	mux.HandleFunc("/bundles/token.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bundle)
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTemplateRegistryServiceVerifiesBundles(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	bundle := []byte(`{"version": 1, "templates": []}`)
+	server := newRegistryTestServer(t, `{"templates": [{"name": "token", "version": "1.0.0", "bundle_url": "bundles/token.json"}]}`, bundle)
+
+	service := NewTemplateRegistryService(newCacheTestDB(t), server.Client(), server.URL+"/index.json", hex.EncodeToString(publicKey))
+	index, err := service.FetchIndex(context.Background())
+	require.NoError(t, err)
+	require.Len(t, index.Templates, 1)
+	entry := index.Templates[0]
+
+	entry.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, RegistrySignedMessage("token", "1.0.0", bundle)))
+	body, err := service.FetchBundle(context.Background(), entry)
+	require.NoError(t, err)
+	assert.Equal(t, bundle, body)
+
+	// The signature of the bundle under another version does not verify
+	relabelled := entry
+	relabelled.Version = "2.0.0"
+	_, err = service.FetchBundle(context.Background(), relabelled)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match the registry key")
+
+	// Neither does a signature of the bytes of the bundle alone
+	entry.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, bundle))
+	_, err = service.FetchBundle(context.Background(), entry)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match the registry key")
+
+	entry.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, RegistrySignedMessage("token", "1.0.0", []byte("another bundle"))))
+	_, err = service.FetchBundle(context.Background(), entry)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match the registry key")
+
+	entry.BundleURL = "http://example.com/token.json"
+	_, err = service.FetchBundle(context.Background(), entry)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must use https")
+}
+
+func TestTemplateRegistryServiceConfiguration(t *testing.T) {
+	db := newCacheTestDB(t)
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		indexURL  string
+		publicKey string
+		expected  string
+	}{
+		{"not subscribed", "", "", "no template registry is subscribed"},
+		{"plain http", "http://templates.example.com/index.json", hex.EncodeToString(publicKey), "must be an https URL"},
+		{"missing key", "https://templates.example.com/index.json", "", "public key is required"},
+		{"short key", "https://templates.example.com/index.json", hex.EncodeToString(publicKey[:16]), "must be 32 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTemplateRegistryService(db, nil, tt.indexURL, tt.publicKey)
+			_, err := service.FetchIndex(context.Background())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+
+	service := NewTemplateRegistryService(db, nil, "https://templates.example.com/index.json", base64.StdEncoding.EncodeToString(publicKey))
+	assert.Equal(t, "https://templates.example.com/index.json", service.RegistryURL())
+}
+
+func TestTemplateRegistryServiceScopesInstalledTemplates(t *testing.T) {
+	db := newCacheTestDB(t)
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	service := NewTemplateRegistryService(db, nil, "https://templates.example.com/index.json", hex.EncodeToString(publicKey))
+	other := NewTemplateRegistryService(db, nil, "https://other.example.com/index.json", hex.EncodeToString(publicKey))
+
+	user := "user-1"
+	require.NoError(t, service.RecordInstalledTemplates([]models.InstalledTemplate{
+		{UserID: &user, Name: "token", Version: "1.0.0", TemplateID: 1},
+		{Name: "token", Version: "1.0.0", TemplateID: 2},
+	}))
+
+	installed, err := service.GetInstalledVersion(&user, "token", "1.0.0")
+	require.NoError(t, err)
+	require.Len(t, installed, 1)
+	assert.Equal(t, uint(1), installed[0].TemplateID)
+
+	installed, err = service.ListInstalledTemplates(nil)
+	require.NoError(t, err)
+	require.Len(t, installed, 1)
+	assert.Equal(t, uint(2), installed[0].TemplateID)
+
+	installed, err = other.ListInstalledTemplates(&user)
+	require.NoError(t, err)
+	assert.Empty(t, installed, "installs are scoped to their registry")
+}
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"fmt"
+
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"gorm.io/gorm"
 )
@@ -13,6 +15,8 @@ type TemplateService interface {
 	UpdateTemplate(template *models.Template) error
 	DeleteTemplate(id uint) error
 	DeleteTemplates(ids []uint) (int64, error)
+	// SetTemplateDisabled disables or enables the launches of a template
+	SetTemplateDisabled(id uint, disabled bool) error
 }
 
 type templateService struct {
@@ -79,3 +83,15 @@ func (s *templateService) DeleteTemplates(ids []uint) (int64, error) {
 	result := s.db.Delete(&models.Template{}, ids)
 	return result.RowsAffected, result.Error
 }
+
+// SetTemplateDisabled updates the disabled flag of a template
+func (s *templateService) SetTemplateDisabled(id uint, disabled bool) error {
+	result := s.db.Model(&models.Template{}).Where("id = ?", id).Update("disabled", disabled)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update template: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("template %d not found", id)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// MaxTokenLogoSize is the largest token logo accepted, the token list consumers expect small images
+	MaxTokenLogoSize = 1 << 20
+	// defaultTokenDecimals is the decimals of the templates without a Decimals value, the ERC-20 default
+	defaultTokenDecimals = 18
+	// TokenListName is the name of the token list of /tokens/tokenlist.json
+	TokenListName = "Launchpad MCP Tokens"
+)
+
+// tokenLogoExtensions are the accepted logo image types. SVG is refused, it can carry scripts
+var tokenLogoExtensions = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpg",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// tokenListSymbolPattern is the symbol pattern of the token list schema, the tokens not matching it are left out
+var tokenListSymbolPattern = regexp.MustCompile(`^[a-zA-Z0-9+\-%/$.]{1,20}$`)
+
+// TokenLogo is an uploaded logo image checked by NewTokenLogo
+type TokenLogo struct {
+	ContentType string
+	Data        []byte
+}
+
+// NewTokenLogo checks the size and the image type of a logo, the type is detected from the data
+func NewTokenLogo(data []byte) (*TokenLogo, error) {
+	if len(data) == 0 {
+		return nil, errors.New("the logo is empty")
+	}
+	if len(data) > MaxTokenLogoSize {
+		return nil, fmt.Errorf("the logo is %d bytes, the limit is %d", len(data), MaxTokenLogoSize)
+	}
+	contentType := http.DetectContentType(data)
+	if _, ok := tokenLogoExtensions[contentType]; !ok {
+		return nil, fmt.Errorf("unsupported logo type %s, use PNG, JPEG, GIF or WebP", contentType)
+	}
+	return &TokenLogo{ContentType: contentType, Data: data}, nil
+}
+
+// Key returns the asset storage key of the logo of a deployment. It changes with the image so the cached urls of the
+// previous logo are not served the new one
+func (l *TokenLogo) Key(deploymentID uint) string {
+	sum := sha256.Sum256(l.Data)
+	return fmt.Sprintf("tokens/%d/logo-%s.%s", deploymentID, hex.EncodeToString(sum[:8]), tokenLogoExtensions[l.ContentType])
+}
+
+// TokenInfo is the public description of a deployed token served at /tokens/:deployment_id
+type TokenInfo struct {
+	DeploymentID uint              `json:"deployment_id"`
+	ChainType    string            `json:"chain_type"`
+	ChainID      string            `json:"chain_id"`
+	Address      string            `json:"address"`
+	Name         string            `json:"name"`
+	Symbol       string            `json:"symbol"`
+	Decimals     int               `json:"decimals"`
+	Description  string            `json:"description,omitempty"`
+	LogoURI      string            `json:"logo_uri,omitempty"`
+	Links        map[string]string `json:"links,omitempty"`
+	UpdatedAt    *time.Time        `json:"updated_at,omitempty"`
+}
+
+// BuildTokenInfo returns the token info of a deployment from its template values and its metadata, which is nil when
+// the deployment has none. logoURL is the public url of the logo
+func BuildTokenInfo(deployment *models.Deployment, metadata *models.TokenMetadata, logoURL string) *TokenInfo {
+	info := &TokenInfo{
+		DeploymentID: deployment.ID,
+		ChainType:    string(deployment.Chain.ChainType),
+		ChainID:      deployment.Chain.NetworkID,
+		Address:      deployment.ContractAddress,
+		Decimals:     defaultTokenDecimals,
+	}
+	if name, ok := deployment.TemplateValues["TokenName"].(string); ok {
+		info.Name = name
+	}
+	if symbol, ok := deployment.TemplateValues["TokenSymbol"].(string); ok {
+		info.Symbol = symbol
+	}
+	if decimals, ok := deployment.TemplateValues["Decimals"].(float64); ok && decimals >= 0 && decimals <= 255 {
+		info.Decimals = int(decimals)
+	}
+	if metadata != nil {
+		info.Description = metadata.Description
+		info.Links = metadata.Links
+		info.LogoURI = logoURL
+		info.UpdatedAt = &metadata.UpdatedAt
+	}
+	return info
+}
+
+// TokenLogoURL returns the public url of the logo of the metadata, empty without a logo. The logos of the local storage
+// are served by the launchpad
+func TokenLogoURL(ctx context.Context, serverPort int, metadata *models.TokenMetadata) (string, error) {
+	if metadata == nil || metadata.LogoKey == "" {
+		return "", nil
+	}
+	if metadata.LogoURL != "" {
+		return metadata.LogoURL, nil
+	}
+	return utils.GetTokenLogoUrl(ctx, serverPort, metadata.DeploymentID)
+}
+
+// TokenList is a token list of the Uniswap token lists standard, https://tokenlists.org
+type TokenList struct {
+	Name      string           `json:"name"`
+	Timestamp string           `json:"timestamp"`
+	Version   TokenListVersion `json:"version"`
+	Tokens    []TokenListToken `json:"tokens"`
+}
+
+type TokenListVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+	Patch int `json:"patch"`
+}
+
+type TokenListToken struct {
+	ChainID    int               `json:"chainId"`
+	Address    string            `json:"address"`
+	Name       string            `json:"name"`
+	Symbol     string            `json:"symbol"`
+	Decimals   int               `json:"decimals"`
+	LogoURI    string            `json:"logoURI,omitempty"`
+	Extensions map[string]string `json:"extensions,omitempty"`
+}
+
+// BuildTokenList returns the token list of the tokens with a numeric EVM chain ID, a name and a symbol valid in the
+// standard. The timestamp is the last metadata update, the version stays 1.0.0
+func BuildTokenList(infos []TokenInfo) *TokenList {
+	list := &TokenList{
+		Name:    TokenListName,
+		Version: TokenListVersion{Major: 1},
+		Tokens:  []TokenListToken{},
+	}
+	var timestamp time.Time
+	for _, info := range infos {
+		if info.ChainType != string(models.TransactionChainTypeEthereum) || info.Address == "" {
+			continue
+		}
+		chainID, err := strconv.Atoi(info.ChainID)
+		if err != nil || chainID <= 0 {
+			continue
+		}
+		if info.Name == "" || len(info.Name) > 40 || !tokenListSymbolPattern.MatchString(info.Symbol) {
+			continue
+		}
+		token := TokenListToken{
+			ChainID:  chainID,
+			Address:  info.Address,
+			Name:     info.Name,
+			Symbol:   info.Symbol,
+			Decimals: info.Decimals,
+			LogoURI:  info.LogoURI,
+		}
+		if info.Description != "" || len(info.Links) > 0 {
+			token.Extensions = map[string]string{}
+			for kind, link := range info.Links {
+				token.Extensions[kind] = link
+			}
+			if info.Description != "" {
+				token.Extensions["description"] = info.Description
+			}
+		}
+		list.Tokens = append(list.Tokens, token)
+		if info.UpdatedAt != nil && info.UpdatedAt.After(timestamp) {
+			timestamp = *info.UpdatedAt
+		}
+	}
+	sort.Slice(list.Tokens, func(i, j int) bool {
+		if list.Tokens[i].ChainID != list.Tokens[j].ChainID {
+			return list.Tokens[i].ChainID < list.Tokens[j].ChainID
+		}
+		return list.Tokens[i].Address < list.Tokens[j].Address
+	})
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	list.Timestamp = timestamp.UTC().Format(time.RFC3339)
+	return list
+}
+
+type TokenMetadataService interface {
+	// SaveTokenMetadata creates or replaces the metadata of the deployment of metadata.DeploymentID
+	SaveTokenMetadata(metadata *models.TokenMetadata) error
+	// GetTokenMetadata returns the metadata of a deployment, gorm.ErrRecordNotFound when it has none
+	GetTokenMetadata(deploymentID uint) (*models.TokenMetadata, error)
+	// ListTokenMetadata returns the metadata of the confirmed deployments with their chains
+	ListTokenMetadata() ([]models.TokenMetadata, error)
+}
+
+type tokenMetadataService struct {
+	db *gorm.DB
+}
+
+func NewTokenMetadataService(db *gorm.DB) TokenMetadataService {
+	return &tokenMetadataService{db: db}
+}
+
+func (s *tokenMetadataService) SaveTokenMetadata(metadata *models.TokenMetadata) error {
+	return s.db.Omit("Deployment").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "deployment_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description", "links", "logo_key", "logo_content_type", "logo_url", "updated_at"}),
+	}).Create(metadata).Error
+}
+
+func (s *tokenMetadataService) GetTokenMetadata(deploymentID uint) (*models.TokenMetadata, error) {
+	var metadata models.TokenMetadata
+	if err := s.db.Where("deployment_id = ?", deploymentID).First(&metadata).Error; err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func (s *tokenMetadataService) ListTokenMetadata() ([]models.TokenMetadata, error) {
+	var metadata []models.TokenMetadata
+	err := s.db.Joins("JOIN deployments ON deployments.id = token_metadata.deployment_id").
+		Where("deployments.status = ? AND deployments.contract_address <> ''", models.TransactionStatusConfirmed).
+		Preload("Deployment.Chain").Order("token_metadata.deployment_id").Find(&metadata).Error
+	return metadata, err
+}
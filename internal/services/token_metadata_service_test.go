@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTokenLogo(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 8)...)
+	logo, err := NewTokenLogo(png)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", logo.ContentType)
+	assert.Regexp(t, `^tokens/7/logo-[0-9a-f]{16}\.png$`, logo.Key(7))
+
+	_, err = NewTokenLogo(nil)
+	assert.Error(t, err)
+	_, err = NewTokenLogo([]byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`))
+	assert.ErrorContains(t, err, "unsupported logo type")
+	_, err = NewTokenLogo(append(png, make([]byte, MaxTokenLogoSize)...))
+	assert.ErrorContains(t, err, "the limit is")
+}
+
+func TestTokenMetadataService(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Anvil", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	require.NoError(t, db.Create(template).Error)
+	confirmed := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, ContractAddress: "0xabc", Status: models.TransactionStatusConfirmed}
+	require.NoError(t, db.Create(confirmed).Error)
+	pending := &models.Deployment{TemplateID: template.ID, ChainID: chain.ID, Status: models.TransactionStatusPending}
+	require.NoError(t, db.Create(pending).Error)
+
+	service := NewTokenMetadataService(db)
+	require.NoError(t, service.SaveTokenMetadata(&models.TokenMetadata{DeploymentID: confirmed.ID, Description: "First", LogoKey: "tokens/1/logo.png"}))
+	require.NoError(t, service.SaveTokenMetadata(&models.TokenMetadata{DeploymentID: confirmed.ID, Description: "Second", Links: map[string]string{"website": "https://example.com"}}))
+	require.NoError(t, service.SaveTokenMetadata(&models.TokenMetadata{DeploymentID: pending.ID, Description: "Pending"}))
+
+	metadata, err := service.GetTokenMetadata(confirmed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Second", metadata.Description)
+	assert.Empty(t, metadata.LogoKey)
+	assert.Equal(t, "https://example.com", metadata.Links["website"])
+
+	listed, err := service.ListTokenMetadata()
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, confirmed.ID, listed[0].DeploymentID)
+	assert.Equal(t, "31337", listed[0].Deployment.Chain.NetworkID)
+}
+
+func TestTokenLogoURL(t *testing.T) {
+	url, err := TokenLogoURL(context.Background(), 8080, &models.TokenMetadata{DeploymentID: 3, LogoKey: "tokens/3/logo.png"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/tokens/3/logo", url)
+
+	url, err = TokenLogoURL(context.Background(), 8080, &models.TokenMetadata{DeploymentID: 3, LogoKey: "tokens/3/logo.png", LogoURL: "https://ipfs.io/ipfs/bafy"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://ipfs.io/ipfs/bafy", url)
+
+	url, err = TokenLogoURL(context.Background(), 8080, nil)
+	require.NoError(t, err)
+	assert.Empty(t, url)
+}
+
+func TestBuildTokenList(t *testing.T) {
+	updated := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	ethereum := string(models.TransactionChainTypeEthereum)
+	list := BuildTokenList([]TokenInfo{
+		{ChainType: ethereum, ChainID: "31337", Address: "0xbbb", Name: "Second", Symbol: "TWO", Decimals: 18},
+		{ChainType: ethereum, ChainID: "1", Address: "0xaaa", Name: "First", Symbol: "ONE", Decimals: 6, Description: "The first",
+			LogoURI: "https://cdn.example.com/one.png", Links: map[string]string{"website": "https://one.example.com"}, UpdatedAt: &updated},
+		{ChainType: string(models.TransactionChainTypeSolana), ChainID: "mainnet", Address: "So111", Name: "Solana", Symbol: "SOL"},
+		{ChainType: ethereum, ChainID: "1", Address: "0xccc", Name: "Bad Symbol", Symbol: "BAD SYMBOL"},
+	})
+
+	assert.Equal(t, TokenListName, list.Name)
+	assert.Equal(t, "2026-03-04T05:06:07Z", list.Timestamp)
+	assert.Equal(t, TokenListVersion{Major: 1}, list.Version)
+	require.Len(t, list.Tokens, 2)
+	assert.Equal(t, TokenListToken{
+		ChainID:    1,
+		Address:    "0xaaa",
+		Name:       "First",
+		Symbol:     "ONE",
+		Decimals:   6,
+		LogoURI:    "https://cdn.example.com/one.png",
+		Extensions: map[string]string{"website": "https://one.example.com", "description": "The first"},
+	}, list.Tokens[0])
+	assert.Equal(t, 31337, list.Tokens[1].ChainID)
+	assert.Nil(t, list.Tokens[1].Extensions)
+}
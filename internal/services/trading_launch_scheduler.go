@@ -0,0 +1,111 @@
+package services
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// DefaultTradingLaunchPollInterval is how often the scheduler looks for launches whose opening time has passed
+const DefaultTradingLaunchPollInterval = 15 * time.Second
+
+// TradingLaunchExecutor creates the enable trading session of a launch and returns its ID
+type TradingLaunchExecutor func(launch models.TradingLaunch) (string, error)
+
+// TradingLaunchNotifier is called when the enable trading session of a scheduled launch is ready to sign
+type TradingLaunchNotifier func(launch models.TradingLaunch, sessionID string)
+
+// TradingLaunchScheduler creates the enable trading sessions of scheduled launches once they are due
+type TradingLaunchScheduler struct {
+	tradingLaunchService TradingLaunchService
+	executor             TradingLaunchExecutor
+	notifier             TradingLaunchNotifier
+	interval             time.Duration
+	// now is overridden in tests
+	now func() time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewTradingLaunchScheduler(tradingLaunchService TradingLaunchService, executor TradingLaunchExecutor, notifier TradingLaunchNotifier, interval time.Duration) *TradingLaunchScheduler {
+	if interval <= 0 {
+		interval = DefaultTradingLaunchPollInterval
+	}
+	return &TradingLaunchScheduler{
+		tradingLaunchService: tradingLaunchService,
+		executor:             executor,
+		notifier:             notifier,
+		interval:             interval,
+		now:                  time.Now,
+	}
+}
+
+// Start runs the due launches in the background until Stop is called
+func (t *TradingLaunchScheduler) Start() {
+	if t.stop != nil {
+		return
+	}
+	t.stop = make(chan struct{})
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				t.RunDue()
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background scheduling and waits for the current runs to finish
+func (t *TradingLaunchScheduler) Stop() {
+	if t.stop == nil {
+		return
+	}
+	close(t.stop)
+	t.wg.Wait()
+	t.stop = nil
+}
+
+// RunDue creates the enable trading session of every launch whose opening time has passed
+func (t *TradingLaunchScheduler) RunDue() {
+	launches, err := t.tradingLaunchService.ListDueTradingLaunches(t.now())
+	if err != nil {
+		log.Printf("Error listing due trading launches: %v", err)
+		return
+	}
+
+	for _, launch := range launches {
+		if err := t.run(launch); err != nil {
+			log.Printf("Error running trading launch %d: %v", launch.ID, err)
+		}
+	}
+}
+
+func (t *TradingLaunchScheduler) run(launch models.TradingLaunch) error {
+	sessionID, err := t.executor(launch)
+	if err != nil {
+		// The launch cannot be executed as is, stop retrying it on every poll
+		log.Printf("Failed to create enable trading session for launch %d: %v", launch.ID, err)
+		return t.tradingLaunchService.UpdateTradingLaunchStatus(launch.ID, models.TradingLaunchStatusFailed, err.Error())
+	}
+
+	if err := t.tradingLaunchService.MarkTradingLaunchPending(launch.ID, sessionID); err != nil {
+		return err
+	}
+
+	if t.notifier != nil {
+		t.notifier(launch, sessionID)
+	}
+	return nil
+}
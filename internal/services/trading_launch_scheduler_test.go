@@ -0,0 +1,122 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type tradingLaunchSchedulerFixture struct {
+	service     TradingLaunchService
+	scheduler   *TradingLaunchScheduler
+	deployment  *models.Deployment
+	now         time.Time
+	executorErr error
+	sessions    int
+	notified    []string
+}
+
+func setupTradingLaunchScheduler(t *testing.T) *tradingLaunchSchedulerFixture {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Chain{}, &models.Template{}, &models.Deployment{}, &models.TradingLaunch{}))
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: "http://localhost:8545", NetworkID: "31337", Name: "Local"}
+	require.NoError(t, db.Create(chain).Error)
+	template := &models.Template{Name: "Gated Token", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(template).Error)
+	deployment := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, ContractAddress: "0x1111111111111111111111111111111111111111", Status: models.TransactionStatusConfirmed}
+	require.NoError(t, db.Create(deployment).Error)
+
+	f := &tradingLaunchSchedulerFixture{
+		service:    NewTradingLaunchService(db),
+		deployment: deployment,
+		now:        time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	executor := func(launch models.TradingLaunch) (string, error) {
+		if f.executorErr != nil {
+			return "", f.executorErr
+		}
+		f.sessions++
+		return fmt.Sprintf("session-%d", f.sessions), nil
+	}
+	notifier := func(launch models.TradingLaunch, sessionID string) {
+		f.notified = append(f.notified, sessionID)
+	}
+	f.scheduler = NewTradingLaunchScheduler(f.service, executor, notifier, time.Minute)
+	f.scheduler.now = func() time.Time { return f.now }
+	return f
+}
+
+func (f *tradingLaunchSchedulerFixture) createLaunch(t *testing.T, opensAt time.Time) *models.TradingLaunch {
+	launch := &models.TradingLaunch{
+		DeploymentID: f.deployment.ID,
+		ChainID:      f.deployment.ChainID,
+		FunctionName: "enableTrading",
+		OpensAt:      opensAt,
+	}
+	require.NoError(t, f.service.CreateTradingLaunch(launch))
+	return launch
+}
+
+func TestTradingLaunchSchedulerRunsDueLaunches(t *testing.T) {
+	f := setupTradingLaunchScheduler(t)
+	launch := f.createLaunch(t, f.now.Add(time.Hour))
+
+	// Not due before the opening time
+	f.scheduler.RunDue()
+	assert.Equal(t, 0, f.sessions)
+
+	active, err := f.service.GetActiveTradingLaunch(f.deployment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, launch.ID, active.ID)
+
+	f.now = f.now.Add(time.Hour)
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetTradingLaunch(launch.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TradingLaunchStatusPending, stored.Status)
+	assert.Equal(t, "session-1", stored.SessionId)
+	assert.Equal(t, []string{"session-1"}, f.notified)
+
+	// Pending launches are not executed again
+	f.scheduler.RunDue()
+	assert.Equal(t, 1, f.sessions)
+
+	require.NoError(t, f.service.MarkTradingLaunchOpen(launch.ID, "0xabc"))
+	stored, err = f.service.GetTradingLaunchBySessionId("session-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.TradingLaunchStatusOpen, stored.Status)
+	assert.Equal(t, "0xabc", stored.TransactionHash)
+	assert.NotNil(t, stored.OpenedAt)
+
+	_, err = f.service.GetActiveTradingLaunch(f.deployment.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestTradingLaunchSchedulerMarksFailedLaunches(t *testing.T) {
+	f := setupTradingLaunchScheduler(t)
+	launch := f.createLaunch(t, f.now)
+	f.executorErr = errors.New("no enable trading function")
+
+	f.scheduler.RunDue()
+
+	stored, err := f.service.GetTradingLaunch(launch.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TradingLaunchStatusFailed, stored.Status)
+	assert.Equal(t, "no enable trading function", stored.Error)
+	assert.Empty(t, f.notified)
+
+	// Failed launches are not retried
+	f.executorErr = nil
+	f.scheduler.RunDue()
+	assert.Equal(t, 0, f.sessions)
+}
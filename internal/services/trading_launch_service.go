@@ -0,0 +1,113 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+type TradingLaunchService interface {
+	CreateTradingLaunch(launch *models.TradingLaunch) error
+	GetTradingLaunch(id uint) (*models.TradingLaunch, error)
+	GetTradingLaunchBySessionId(sessionId string) (*models.TradingLaunch, error)
+	// GetActiveTradingLaunch returns the scheduled or pending launch of a deployment
+	GetActiveTradingLaunch(deploymentID uint) (*models.TradingLaunch, error)
+	// HasActiveTradingLaunch reports whether the token deployed at the address on the chain waits for a scheduled or
+	// pending launch to open trading
+	HasActiveTradingLaunch(chainID uint, contractAddress string) (bool, error)
+	// ListDueTradingLaunches returns the scheduled launches whose opening time has passed
+	ListDueTradingLaunches(now time.Time) ([]models.TradingLaunch, error)
+	// MarkTradingLaunchPending stores the enable trading session created for the launch
+	MarkTradingLaunchPending(id uint, sessionId string) error
+	// MarkTradingLaunchOpen records the confirmed enable trading transaction
+	MarkTradingLaunchOpen(id uint, txHash string) error
+	UpdateTradingLaunchStatus(id uint, status models.TradingLaunchStatus, errorMessage string) error
+}
+
+type tradingLaunchService struct {
+	db *gorm.DB
+}
+
+func NewTradingLaunchService(db *gorm.DB) TradingLaunchService {
+	return &tradingLaunchService{db: db}
+}
+
+func (s *tradingLaunchService) CreateTradingLaunch(launch *models.TradingLaunch) error {
+	if launch.Status == "" {
+		launch.Status = models.TradingLaunchStatusScheduled
+	}
+	return s.db.Create(launch).Error
+}
+
+func (s *tradingLaunchService) GetTradingLaunch(id uint) (*models.TradingLaunch, error) {
+	var launch models.TradingLaunch
+	err := s.db.Preload("Deployment.Template").Preload("Chain").First(&launch, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &launch, nil
+}
+
+func (s *tradingLaunchService) GetTradingLaunchBySessionId(sessionId string) (*models.TradingLaunch, error) {
+	var launch models.TradingLaunch
+	err := s.db.Preload("Deployment.Template").Preload("Chain").Where("session_id = ?", sessionId).First(&launch).Error
+	if err != nil {
+		return nil, err
+	}
+	return &launch, nil
+}
+
+func (s *tradingLaunchService) GetActiveTradingLaunch(deploymentID uint) (*models.TradingLaunch, error) {
+	var launch models.TradingLaunch
+	err := s.db.Preload("Deployment.Template").Preload("Chain").
+		Where("deployment_id = ? AND status IN ?", deploymentID, []models.TradingLaunchStatus{models.TradingLaunchStatusScheduled, models.TradingLaunchStatusPending}).
+		First(&launch).Error
+	if err != nil {
+		return nil, err
+	}
+	return &launch, nil
+}
+
+func (s *tradingLaunchService) HasActiveTradingLaunch(chainID uint, contractAddress string) (bool, error) {
+	var count int64
+	err := s.db.Model(&models.TradingLaunch{}).
+		Joins("JOIN deployments ON deployments.id = trading_launches.deployment_id").
+		Where("trading_launches.chain_id = ? AND LOWER(deployments.contract_address) = LOWER(?) AND trading_launches.status IN ?", chainID, contractAddress,
+			[]models.TradingLaunchStatus{models.TradingLaunchStatusScheduled, models.TradingLaunchStatusPending}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (s *tradingLaunchService) ListDueTradingLaunches(now time.Time) ([]models.TradingLaunch, error) {
+	var launches []models.TradingLaunch
+	err := s.db.Preload("Deployment.Template").Preload("Chain").
+		Where("status = ? AND opens_at <= ?", models.TradingLaunchStatusScheduled, now).
+		Order("opens_at ASC").
+		Find(&launches).Error
+	return launches, err
+}
+
+func (s *tradingLaunchService) MarkTradingLaunchPending(id uint, sessionId string) error {
+	return s.db.Model(&models.TradingLaunch{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     models.TradingLaunchStatusPending,
+		"session_id": sessionId,
+		"error":      "",
+	}).Error
+}
+
+func (s *tradingLaunchService) MarkTradingLaunchOpen(id uint, txHash string) error {
+	now := time.Now()
+	return s.db.Model(&models.TradingLaunch{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.TradingLaunchStatusOpen,
+		"transaction_hash": txHash,
+		"opened_at":        &now,
+	}).Error
+}
+
+func (s *tradingLaunchService) UpdateTradingLaunchStatus(id uint, status models.TradingLaunchStatus, errorMessage string) error {
+	return s.db.Model(&models.TradingLaunch{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status": status,
+		"error":  errorMessage,
+	}).Error
+}
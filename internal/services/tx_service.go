@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,6 +16,13 @@ type TransactionService interface {
 	GetTransactionSession(sessionID string) (*models.TransactionSession, error)
 	UpdateTransactionSession(sessionID string, session *models.TransactionSession) error
 	ListTransactionSessionsByUser(userID string) ([]models.TransactionSession, error)
+	// ListTransactionSessionsByIDs returns the sessions with the given IDs, including expired ones
+	ListTransactionSessionsByIDs(sessionIDs []string) ([]models.TransactionSession, error)
+	// ListSafeProposedSessions returns the pending sessions proposed to a Safe, including expired ones
+	ListSafeProposedSessions() ([]models.TransactionSession, error)
+	// CancelTransactionSession cancels the pending session, expired or not, and deletes the pending deployments and
+	// liquidity pools recorded for it
+	CancelTransactionSession(sessionID string) (*CancelledTransactionSession, error)
 
 	// Legacy methods for backward compatibility with database.go
 	CreateTransactionSessionLegacy(sessionType string, chainType models.TransactionChainType, chainID, data string) (string, error)
@@ -33,6 +41,22 @@ type CreateTransactionSessionRequest struct {
 	ChainID                uint                           `json:"chain_id"`
 	UserID                 *string                        `json:"user_id,omitempty"`
 	Balances               map[string]*string             `json:"balances,omitempty"`
+	// Confirmation is the approval of a session above the confirmation threshold
+	Confirmation *models.SessionConfirmation `json:"confirmation,omitempty"`
+	// SignatureRequest is the message the wallet signs in sessions without transactions
+	SignatureRequest *models.SignatureRequest `json:"signature_request,omitempty"`
+	// Context is the context of the tool call creating the session, it carries the MCP server the confirmation of
+	// high-value sessions is requested from
+	Context context.Context `json:"-"`
+	// Background marks the sessions created by the background jobs for the orders of the user, they have no client
+	// to confirm them and are signed by the user like the others
+	Background bool `json:"-"`
+}
+
+// CancelledTransactionSession lists the records deleted with a cancelled session
+type CancelledTransactionSession struct {
+	DeletedDeploymentIDs    []uint `json:"deleted_deployment_ids"`
+	DeletedLiquidityPoolIDs []uint `json:"deleted_liquidity_pool_ids"`
 }
 
 func NewTransactionService(db *gorm.DB) TransactionService {
@@ -61,6 +85,8 @@ func (s *transactionService) CreateTransactionSessionWithUser(req CreateTransact
 		TransactionDeployments: req.TransactionDeployments,
 		Balances:               req.Balances,
 		ChainID:                req.ChainID,
+		Confirmation:           req.Confirmation,
+		SignatureRequest:       req.SignatureRequest,
 		CreatedAt:              time.Now(),
 		UpdatedAt:              time.Now(),
 		ExpiresAt:              time.Now().Add(30 * time.Minute),
@@ -118,6 +144,77 @@ func (s *transactionService) ListTransactionSessionsByUser(userID string) ([]mod
 	return sessions, err
 }
 
+// ListTransactionSessionsByIDs returns the transaction sessions with the given IDs without checking expiration
+func (s *transactionService) ListTransactionSessionsByIDs(sessionIDs []string) ([]models.TransactionSession, error) {
+	var sessions []models.TransactionSession
+	if len(sessionIDs) == 0 {
+		return sessions, nil
+	}
+	err := s.db.Preload("Chain").Where("id IN ?", sessionIDs).Find(&sessions).Error
+	return sessions, err
+}
+
+// ListSafeProposedSessions returns the pending sessions with a Safe proposal without checking expiration,
+// the owners of a Safe may take longer than the signing url to confirm
+func (s *transactionService) ListSafeProposedSessions() ([]models.TransactionSession, error) {
+	var sessions []models.TransactionSession
+	err := s.db.Preload("Chain").
+		Where("safe_proposal IS NOT NULL AND transaction_status = ?", models.TransactionStatusPending).
+		Order("created_at ASC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// CancelTransactionSession cancels the session and its pending transactions in one database transaction. The
+// deployments and pools of the session still pending never reached the chain, they are deleted
+func (s *transactionService) CancelTransactionSession(sessionID string) (*CancelledTransactionSession, error) {
+	result := &CancelledTransactionSession{DeletedDeploymentIDs: []uint{}, DeletedLiquidityPoolIDs: []uint{}}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var session models.TransactionSession
+		if err := tx.Where("id = ?", sessionID).First(&session).Error; err != nil {
+			return err
+		}
+		if session.TransactionStatus != models.TransactionStatusPending {
+			return fmt.Errorf("session is %s, only pending sessions can be cancelled", session.TransactionStatus)
+		}
+
+		for i := range session.TransactionDeployments {
+			if session.TransactionDeployments[i].Status != models.TransactionStatusConfirmed {
+				session.TransactionDeployments[i].Status = models.TransactionStatusCancelled
+			}
+		}
+		session.TransactionStatus = models.TransactionStatusCancelled
+		session.UpdatedAt = time.Now()
+		if err := tx.Model(&session).Select("transaction_status", "transaction_deployments", "updated_at").Updates(&session).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Deployment{}).Where("session_id = ? AND status = ?", sessionID, models.TransactionStatusPending).
+			Pluck("id", &result.DeletedDeploymentIDs).Error; err != nil {
+			return err
+		}
+		if len(result.DeletedDeploymentIDs) > 0 {
+			if err := tx.Delete(&models.Deployment{}, result.DeletedDeploymentIDs).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&models.LiquidityPool{}).Where("session_id = ? AND status = ?", sessionID, models.TransactionStatusPending).
+			Pluck("id", &result.DeletedLiquidityPoolIDs).Error; err != nil {
+			return err
+		}
+		if len(result.DeletedLiquidityPoolIDs) > 0 {
+			if err := tx.Delete(&models.LiquidityPool{}, result.DeletedLiquidityPoolIDs).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // CreateTransactionSessionLegacy creates a transaction session with backward compatibility signature
 func (s *transactionService) CreateTransactionSessionLegacy(sessionType string, chainType models.TransactionChainType, chainID, data string) (string, error) {
 	return s.CreateTransactionSessionWithUserLegacy(sessionType, chainType, chainID, data, nil)
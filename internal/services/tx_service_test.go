@@ -522,3 +522,53 @@ func TestUpdateTransactionSession(t *testing.T) {
 		assert.Equal(t, models.TransactionStatusPending, retrieved.TransactionDeployments[1].Status)
 	})
 }
+
+func TestCancelTransactionSession(t *testing.T) {
+	dbService, err := NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	service := NewTransactionService(db)
+
+	chain := &models.Chain{ChainType: models.TransactionChainTypeEthereum, RPC: "http://localhost:8545", NetworkID: "31337", Name: "Local"}
+	require.NoError(t, db.Create(chain).Error)
+	sessionID, err := service.CreateTransactionSession(CreateTransactionSessionRequest{
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   chain.ID,
+		TransactionDeployments: []models.TransactionDeployment{
+			{Title: "Deploy Token", Value: "0", Status: models.TransactionStatusConfirmed},
+			{Title: "Create Pool", Value: "0", Status: models.TransactionStatusPending},
+		},
+	})
+	require.NoError(t, err)
+
+	pending := &models.Deployment{ChainID: chain.ID, SessionId: sessionID, Status: models.TransactionStatusPending}
+	require.NoError(t, db.Create(pending).Error)
+	// a deployment confirmed by the session is on chain, it is kept
+	confirmed := &models.Deployment{ChainID: chain.ID, SessionId: sessionID, Status: models.TransactionStatusConfirmed, ContractAddress: "0x1111111111111111111111111111111111111111"}
+	require.NoError(t, db.Create(confirmed).Error)
+	other := &models.Deployment{ChainID: chain.ID, SessionId: "other-session", Status: models.TransactionStatusPending}
+	require.NoError(t, db.Create(other).Error)
+	pool := &models.LiquidityPool{SessionId: sessionID, Status: models.TransactionStatusPending}
+	require.NoError(t, db.Create(pool).Error)
+
+	result, err := service.CancelTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, []uint{pending.ID}, result.DeletedDeploymentIDs)
+	assert.Equal(t, []uint{pool.ID}, result.DeletedLiquidityPoolIDs)
+
+	session, err := service.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusCancelled, session.TransactionStatus)
+	assert.Equal(t, models.TransactionStatusConfirmed, session.TransactionDeployments[0].Status)
+	assert.Equal(t, models.TransactionStatusCancelled, session.TransactionDeployments[1].Status)
+
+	var count int64
+	require.NoError(t, db.Model(&models.Deployment{}).Where("id IN ?", []uint{confirmed.ID, other.ID}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+	require.NoError(t, db.Model(&models.Deployment{}).Where("id = ?", pending.ID).Count(&count).Error)
+	assert.Zero(t, count)
+
+	_, err = service.CancelTransactionSession(sessionID)
+	assert.ErrorContains(t, err, "session is cancelled, only pending sessions can be cancelled")
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -16,8 +18,31 @@ const EthTokenAddress = "0x0000000000000000000000000000000000000000"
 const MetadataToken0Address = "token0_address"
 const MetadataToken1Address = "token1_address"
 
+// MetadataFactoryAddress and MetadataWETHAddress record the DEX deployment a pool creation session adds liquidity
+// through, the pair is looked up on that factory once the session is confirmed
+const MetadataFactoryAddress = "factory_address"
+const MetadataWETHAddress = "weth_address"
+
+// pairABI is the subset of the Uniswap V2 pair ABI used to read pool state
+const pairABI = `[{"constant":true,"inputs":[],"name":"getReserves","outputs":[{"name":"_reserve0","type":"uint112"},{"name":"_reserve1","type":"uint112"},{"name":"_blockTimestampLast","type":"uint32"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+// transferEventTopic is keccak256("Transfer(address,address,uint256)")
+const transferEventTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// LPHolder is a holder of liquidity pool tokens
+type LPHolder struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	// Share is the percentage of the circulating LP supply held by this address
+	Share float64 `json:"share"`
+}
+
 type UniswapContractService interface {
 	GetPairAddress(token0Address, token1Address string, chain *models.Chain) (string, error)
+	// GetReserves returns the current reserves of a Uniswap V2 pair
+	GetReserves(pairAddress string, chain *models.Chain) (reserve0 *big.Int, reserve1 *big.Int, err error)
+	// GetLPDistribution replays the pair's Transfer events and returns the LP token holders, largest first
+	GetLPDistribution(pairAddress string, chain *models.Chain) ([]LPHolder, error)
 }
 
 type uniswapContractService struct {
@@ -120,3 +145,125 @@ func (u *uniswapContractService) callGetPair(rpcClient *utils.RPCClient, factory
 
 	return pairAddress, nil
 }
+
+// GetReserves calls getReserves on the Uniswap V2 pair contract
+func (u *uniswapContractService) GetReserves(pairAddress string, chain *models.Chain) (*big.Int, *big.Int, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(pairABI))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse pair ABI: %w", err)
+	}
+
+	data, err := parsedABI.Pack("getReserves")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode getReserves call: %w", err)
+	}
+
+	callParams := map[string]interface{}{
+		"to":   pairAddress,
+		"data": "0x" + common.Bytes2Hex(data),
+	}
+
+	rpcClient := utils.NewRPCClient(chain.RPC)
+	response, err := rpcClient.Call("eth_call", []interface{}{callParams, "latest"})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to make eth_call: %w", err)
+	}
+
+	resultStr, ok := response.Result.(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid response format")
+	}
+
+	outputs, err := parsedABI.Unpack("getReserves", common.FromHex(resultStr))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode getReserves result: %w", err)
+	}
+
+	reserve0, ok0 := outputs[0].(*big.Int)
+	reserve1, ok1 := outputs[1].(*big.Int)
+	if !ok0 || !ok1 {
+		return nil, nil, fmt.Errorf("invalid reserves format")
+	}
+
+	return reserve0, reserve1, nil
+}
+
+// GetLPDistribution computes LP token balances from the pair's Transfer event logs
+func (u *uniswapContractService) GetLPDistribution(pairAddress string, chain *models.Chain) ([]LPHolder, error) {
+	filter := map[string]interface{}{
+		"address":   pairAddress,
+		"fromBlock": "0x0",
+		"toBlock":   "latest",
+		"topics":    []interface{}{transferEventTopic},
+	}
+
+	rpcClient := utils.NewRPCClient(chain.RPC)
+	response, err := rpcClient.Call("eth_getLogs", []interface{}{filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer logs: %w", err)
+	}
+
+	logsJSON, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal logs: %w", err)
+	}
+
+	var logs []struct {
+		Topics []string `json:"topics"`
+		Data   string   `json:"data"`
+	}
+	if err := json.Unmarshal(logsJSON, &logs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal logs: %w", err)
+	}
+
+	balances := map[common.Address]*big.Int{}
+	for _, entry := range logs {
+		if len(entry.Topics) < 3 {
+			continue
+		}
+		from := common.HexToAddress(entry.Topics[1])
+		to := common.HexToAddress(entry.Topics[2])
+		value := new(big.Int).SetBytes(common.FromHex(entry.Data))
+
+		if _, ok := balances[from]; !ok {
+			balances[from] = new(big.Int)
+		}
+		if _, ok := balances[to]; !ok {
+			balances[to] = new(big.Int)
+		}
+		balances[from].Sub(balances[from], value)
+		balances[to].Add(balances[to], value)
+	}
+
+	// The zero address is the mint source and holds the locked minimum liquidity, skip it
+	delete(balances, common.Address{})
+
+	total := new(big.Int)
+	for _, balance := range balances {
+		if balance.Sign() > 0 {
+			total.Add(total, balance)
+		}
+	}
+
+	holders := []LPHolder{}
+	for address, balance := range balances {
+		if balance.Sign() <= 0 {
+			continue
+		}
+		share, _ := new(big.Float).Quo(new(big.Float).SetInt(balance), new(big.Float).SetInt(total)).Float64()
+		holders = append(holders, LPHolder{
+			Address: address.Hex(),
+			Balance: balance.String(),
+			Share:   share * 100,
+		})
+	}
+
+	sort.Slice(holders, func(i, j int) bool {
+		if holders[i].Share == holders[j].Share {
+			return holders[i].Address < holders[j].Address
+		}
+		return holders[i].Share > holders[j].Share
+	})
+
+	return holders, nil
+}
@@ -23,42 +23,57 @@ type UniswapService interface {
 	DeleteUniswapDeployment(deploymentID uint) error
 	DeleteUniswapDeployments(deploymentIDs []uint) error
 	GetActiveUniswapDeployment(userId *string, chain models.Chain) (*models.UniswapDeployment, error)
+	// ListUniswapDeploymentsByChain returns the DEX deployments of a chain, the default one first
+	ListUniswapDeploymentsByChain(userId *string, chainID uint) ([]models.UniswapDeployment, error)
+	// SelectUniswapDeployment returns the deployment with dexDeploymentID, or the default deployment of the chain when it is 0
+	SelectUniswapDeployment(userId *string, chain models.Chain, dexDeploymentID uint) (*models.UniswapDeployment, error)
+	// SetDefaultUniswapDeployment makes the deployment the default of its chain
+	SetDefaultUniswapDeployment(deploymentID uint) error
+	UpdateName(deploymentID uint, name string) error
 }
 
+// defaultDeploymentOrder puts the default deployment of a chain first, then the oldest one
+const defaultDeploymentOrder = "is_default DESC, id"
+
 type uniswapService struct {
 	db *gorm.DB
 }
 
-// UpdateStatus implements UniswapService.
+// UpdateStatus implements UniswapService. Confirming requires the three addresses, checked on the version the
+// status is written to so an address cleared concurrently cannot be confirmed
 func (u *uniswapService) UpdateStatus(deploymentID uint, status models.TransactionStatus) error {
-	// If status is confirmed, validate that all required addresses are present
-	if status == models.TransactionStatusConfirmed {
+	for attempt := 0; attempt < maxVersionAttempts; attempt++ {
 		var deployment models.UniswapDeployment
 		if err := u.db.First(&deployment, deploymentID).Error; err != nil {
 			return err
 		}
 
-		// Check for missing addresses
-		var missingAddresses []string
-		if deployment.FactoryAddress == "" {
-			missingAddresses = append(missingAddresses, "factory_address")
-		}
-		if deployment.RouterAddress == "" {
-			missingAddresses = append(missingAddresses, "router_address")
-		}
-		if deployment.WETHAddress == "" {
-			missingAddresses = append(missingAddresses, "weth_address")
+		// If status is confirmed, validate that all required addresses are present
+		if status == models.TransactionStatusConfirmed {
+			var missingAddresses []string
+			if deployment.FactoryAddress == "" {
+				missingAddresses = append(missingAddresses, "factory_address")
+			}
+			if deployment.RouterAddress == "" {
+				missingAddresses = append(missingAddresses, "router_address")
+			}
+			if deployment.WETHAddress == "" {
+				missingAddresses = append(missingAddresses, "weth_address")
+			}
+
+			if len(missingAddresses) > 0 {
+				return errors.New("cannot confirm deployment with missing addresses: " +
+					fmt.Sprintf("%v", missingAddresses))
+			}
 		}
 
-		if len(missingAddresses) > 0 {
-			return errors.New("cannot confirm deployment with missing addresses: " +
-				fmt.Sprintf("%v", missingAddresses))
+		record := versionedStatus{ID: deployment.ID, LockVersion: deployment.LockVersion, Status: deployment.Status}
+		applied, err := compareAndSetStatus(u.db, &models.UniswapDeployment{}, record, status, nil)
+		if err != nil || applied {
+			return err
 		}
 	}
-
-	return u.db.Model(&models.UniswapDeployment{}).
-		Where("id = ?", deploymentID).
-		Update("status", status).Error
+	return fmt.Errorf("%w: uniswap deployment %d kept changing after %d attempts", ErrVersionConflict, deploymentID, maxVersionAttempts)
 }
 
 func NewUniswapService(db *gorm.DB) UniswapService {
@@ -95,30 +110,30 @@ func (u *uniswapService) GetUniswapDeployment(deploymentID uint) (*models.Uniswa
 func (u *uniswapService) UpdateFactoryAddress(deploymentID uint, factoryAddress string) error {
 	return u.db.Model(&models.UniswapDeployment{}).
 		Where("id = ?", deploymentID).
-		Update("factory_address", factoryAddress).Error
+		Updates(map[string]interface{}{"factory_address": factoryAddress, "lock_version": lockVersionIncrement}).Error
 }
 
 func (u *uniswapService) UpdateRouterAddress(deploymentID uint, routerAddress string) error {
 	return u.db.Model(&models.UniswapDeployment{}).
 		Where("id = ?", deploymentID).
-		Update("router_address", routerAddress).Error
+		Updates(map[string]interface{}{"router_address": routerAddress, "lock_version": lockVersionIncrement}).Error
 }
 
 func (u *uniswapService) UpdateWETHAddress(deploymentID uint, wethAddress string) error {
 	return u.db.Model(&models.UniswapDeployment{}).
 		Where("id = ?", deploymentID).
-		Update("weth_address", wethAddress).Error
+		Updates(map[string]interface{}{"weth_address": wethAddress, "lock_version": lockVersionIncrement}).Error
 }
 
 func (u *uniswapService) UpdateDeployerAddress(deploymentID uint, deployerAddress string) error {
 	return u.db.Model(&models.UniswapDeployment{}).
 		Where("id = ?", deploymentID).
-		Update("deployer_address", deployerAddress).Error
+		Updates(map[string]interface{}{"deployer_address": deployerAddress, "lock_version": lockVersionIncrement}).Error
 }
 
 func (u *uniswapService) GetUniswapDeploymentByChain(chainID uint) (*models.UniswapDeployment, error) {
 	var deployment models.UniswapDeployment
-	err := u.db.Where("chain_id = ?", chainID).First(&deployment).Error
+	err := u.db.Where("chain_id = ?", chainID).Order(defaultDeploymentOrder).First(&deployment).Error
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +178,7 @@ func (u *uniswapService) GetUniswapDeploymentByChainString(chainType, chainID st
 	}
 
 	// Then find deployment for this chain
-	err = u.db.Where("chain_id = ?", chain.ID).Preload("Chain").First(&deployment).Error
+	err = u.db.Where("chain_id = ?", chain.ID).Order(defaultDeploymentOrder).Preload("Chain").First(&deployment).Error
 	if err != nil {
 		return nil, err
 	}
@@ -180,9 +195,64 @@ func (u *uniswapService) GetActiveUniswapDeployment(userId *string, chain models
 		query = query.Where("user_id = ?", *userId)
 	}
 
-	err := query.First(&deployment).Error
+	err := query.Order(defaultDeploymentOrder).First(&deployment).Error
 	if err != nil {
 		return nil, err
 	}
 	return &deployment, nil
 }
+
+func (u *uniswapService) ListUniswapDeploymentsByChain(userId *string, chainID uint) ([]models.UniswapDeployment, error) {
+	var deployments []models.UniswapDeployment
+	query := u.db.Where("chain_id = ?", chainID)
+	if userId != nil {
+		query = query.Where("user_id = ?", *userId)
+	}
+	err := query.Order(defaultDeploymentOrder).Find(&deployments).Error
+	if err != nil {
+		return nil, err
+	}
+	return deployments, nil
+}
+
+func (u *uniswapService) SelectUniswapDeployment(userId *string, chain models.Chain, dexDeploymentID uint) (*models.UniswapDeployment, error) {
+	if dexDeploymentID == 0 {
+		return u.GetActiveUniswapDeployment(userId, chain)
+	}
+
+	deployment, err := u.GetUniswapDeployment(dexDeploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("DEX deployment %d not found: %w", dexDeploymentID, err)
+	}
+	// Deployments of other users are reported as missing
+	if userId != nil && (deployment.UserID == nil || *deployment.UserID != *userId) {
+		return nil, fmt.Errorf("DEX deployment %d not found: %w", dexDeploymentID, gorm.ErrRecordNotFound)
+	}
+	if deployment.ChainID != chain.ID {
+		return nil, fmt.Errorf("DEX deployment %d is not deployed on %s", dexDeploymentID, chain.Name)
+	}
+	return deployment, nil
+}
+
+func (u *uniswapService) SetDefaultUniswapDeployment(deploymentID uint) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		var deployment models.UniswapDeployment
+		if err := tx.First(&deployment, deploymentID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.UniswapDeployment{}).
+			Where("chain_id = ? AND id <> ?", deployment.ChainID, deploymentID).
+			Updates(map[string]interface{}{"is_default": false, "lock_version": lockVersionIncrement}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.UniswapDeployment{}).
+			Where("id = ?", deploymentID).
+			Updates(map[string]interface{}{"is_default": true, "lock_version": lockVersionIncrement}).Error
+	})
+}
+
+func (u *uniswapService) UpdateName(deploymentID uint, name string) error {
+	return u.db.Model(&models.UniswapDeployment{}).
+		Where("id = ?", deploymentID).
+		Updates(map[string]interface{}{"name": name, "lock_version": lockVersionIncrement}).Error
+}
@@ -278,6 +278,72 @@ func (suite *UniswapServiceTestSuite) TestChainQueries() {
 	})
 }
 
+func (suite *UniswapServiceTestSuite) TestDefaultDeploymentSelection() {
+	userID := "user1"
+	forkID, err := suite.uniswapService.CreateUniswapDeployment(suite.testChain.ID, "v2", &userID)
+	suite.Require().NoError(err)
+	canonicalID, err := suite.uniswapService.CreateUniswapDeployment(suite.testChain.ID, "v2", &userID)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.uniswapService.UpdateName(canonicalID, "Uniswap"))
+
+	suite.Run("Oldest deployment without a default", func() {
+		deployment, err := suite.uniswapService.SelectUniswapDeployment(&userID, *suite.testChain, 0)
+		suite.NoError(err)
+		suite.Equal(forkID, deployment.ID)
+	})
+
+	suite.Run("Default deployment", func() {
+		suite.Require().NoError(suite.uniswapService.SetDefaultUniswapDeployment(canonicalID))
+
+		deployment, err := suite.uniswapService.GetUniswapDeploymentByChain(suite.testChain.ID)
+		suite.NoError(err)
+		suite.Equal(canonicalID, deployment.ID)
+		suite.Equal("Uniswap", deployment.Name)
+		suite.True(deployment.IsDefault)
+
+		deployment, err = suite.uniswapService.GetActiveUniswapDeployment(&userID, *suite.testChain)
+		suite.NoError(err)
+		suite.Equal(canonicalID, deployment.ID)
+
+		deployments, err := suite.uniswapService.ListUniswapDeploymentsByChain(&userID, suite.testChain.ID)
+		suite.NoError(err)
+		suite.Require().Len(deployments, 2)
+		suite.Equal(canonicalID, deployments[0].ID)
+		suite.Equal(forkID, deployments[1].ID)
+	})
+
+	suite.Run("Changing the default", func() {
+		suite.Require().NoError(suite.uniswapService.SetDefaultUniswapDeployment(forkID))
+
+		canonical, err := suite.uniswapService.GetUniswapDeployment(canonicalID)
+		suite.NoError(err)
+		suite.False(canonical.IsDefault)
+		deployment, err := suite.uniswapService.SelectUniswapDeployment(&userID, *suite.testChain, 0)
+		suite.NoError(err)
+		suite.Equal(forkID, deployment.ID)
+	})
+
+	suite.Run("Explicit deployment", func() {
+		deployment, err := suite.uniswapService.SelectUniswapDeployment(&userID, *suite.testChain, canonicalID)
+		suite.NoError(err)
+		suite.Equal(canonicalID, deployment.ID)
+	})
+
+	suite.Run("Deployment of another user", func() {
+		otherUser := "user2"
+		_, err := suite.uniswapService.SelectUniswapDeployment(&otherUser, *suite.testChain, canonicalID)
+		suite.Error(err)
+		suite.Contains(err.Error(), "record not found")
+	})
+
+	suite.Run("Deployment on another chain", func() {
+		otherChain := models.Chain{ID: suite.testChain.ID + 1000, Name: "Other Chain"}
+		_, err := suite.uniswapService.SelectUniswapDeployment(&userID, otherChain, canonicalID)
+		suite.Error(err)
+		suite.Contains(err.Error(), "is not deployed on Other Chain")
+	})
+}
+
 func (suite *UniswapServiceTestSuite) TestUserQueries() {
 	userID1 := "user1"
 	userID2 := "user2"
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// UserOperationSalt is the CREATE2 salt of the contract created by a transaction of a session, so an operation
+// prepared again for the same transaction creates the contract at the same address
+func UserOperationSalt(sessionId string, transactionIndex int) common.Hash {
+	return crypto.Keccak256Hash([]byte(fmt.Sprintf("%s:%d", sessionId, transactionIndex)))
+}
+
+// PrepareUserOperation builds the UserOperation sending a transaction of a session from the smart account of owner,
+// the first operation of the account also deploys it. The bundler of the chain estimates the gas and its paymaster,
+// if any, sponsors it. The operation is returned unsigned with the hash owner signs
+func PrepareUserOperation(chain *models.Chain, sessionId string, transactionIndex int, tx models.TransactionDeployment, owner string) (*models.UserOperation, error) {
+	if chain.BundlerRPC == "" {
+		return nil, fmt.Errorf("gasless transactions are not configured for chain %s", chain.Name)
+	}
+	if !common.IsHexAddress(owner) {
+		return nil, fmt.Errorf("invalid owner address %q", owner)
+	}
+	chainID, ok := new(big.Int).SetString(chain.NetworkID, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid chain id %q of chain %s", chain.NetworkID, chain.Name)
+	}
+	value := big.NewInt(0)
+	if tx.Value != "" {
+		if _, ok := value.SetString(tx.Value, 10); !ok {
+			return nil, fmt.Errorf("invalid transaction value %q", tx.Value)
+		}
+	}
+
+	entryPoint := utils.DefaultEntryPointAddress
+	account, err := utils.ReadSmartAccount(chain.RPC, utils.DefaultAccountFactoryAddress, owner)
+	if err != nil {
+		return nil, err
+	}
+	initCode := "0x"
+	if !account.Deployed {
+		initCode, err = utils.SmartAccountInitCode(account.Factory, owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var callData, contractAddress string
+	if tx.Receiver == "" {
+		callData, contractAddress, err = utils.EncodeSmartAccountDeploy(value, tx.Data, UserOperationSalt(sessionId, transactionIndex))
+	} else {
+		callData, err = utils.EncodeSmartAccountExecute(tx.Receiver, value, tx.Data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := utils.ReadEntryPointNonce(chain.RPC, entryPoint, account.Address)
+	if err != nil {
+		return nil, err
+	}
+	maxFee, priorityFee, err := utils.UserOperationGasFees(chain.RPC)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &models.UserOperationData{
+		Sender:               account.Address,
+		Nonce:                hexutil.EncodeBig(nonce),
+		InitCode:             initCode,
+		CallData:             callData,
+		MaxFeePerGas:         hexutil.EncodeBig(maxFee),
+		MaxPriorityFeePerGas: hexutil.EncodeBig(priorityFee),
+		PaymasterAndData:     "0x",
+		Signature:            utils.DummyUserOperationSignature,
+	}
+	gas, err := utils.EstimateUserOperationGas(chain.BundlerRPC, op, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas = gas.CallGasLimit, gas.VerificationGasLimit, gas.PreVerificationGas
+
+	// the paymaster signs the gas limits, so they are sponsored last
+	sponsored := false
+	if chain.PaymasterRPC != "" {
+		paymasterAndData, sponsoredGas, err := utils.SponsorUserOperation(chain.PaymasterRPC, op, entryPoint)
+		if err != nil {
+			return nil, err
+		}
+		op.PaymasterAndData = paymasterAndData
+		if sponsoredGas != nil {
+			op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas = sponsoredGas.CallGasLimit, sponsoredGas.VerificationGasLimit, sponsoredGas.PreVerificationGas
+		}
+		sponsored = true
+	}
+
+	hash, err := utils.UserOperationHash(op, entryPoint, chainID)
+	if err != nil {
+		return nil, err
+	}
+	op.Signature = "0x"
+
+	return &models.UserOperation{
+		SessionId:         sessionId,
+		TransactionIndex:  transactionIndex,
+		ChainID:           chain.ID,
+		Owner:             common.HexToAddress(owner).Hex(),
+		Sender:            account.Address,
+		EntryPoint:        entryPoint,
+		Operation:         op,
+		UserOperationHash: hash.Hex(),
+		ContractAddress:   contractAddress,
+		Sponsored:         sponsored,
+		Status:            models.UserOperationStatusPrepared,
+	}, nil
+}
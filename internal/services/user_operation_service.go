@@ -0,0 +1,68 @@
+package services
+
+import (
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"gorm.io/gorm"
+)
+
+type UserOperationService interface {
+	CreateUserOperation(operation *models.UserOperation) error
+	// GetLatestUserOperation returns the last operation prepared for a transaction of the session
+	GetLatestUserOperation(sessionId string, transactionIndex int) (*models.UserOperation, error)
+	// MarkUserOperationSent stores the signed operation accepted by the bundler
+	MarkUserOperationSent(id uint, operation *models.UserOperationData) error
+	// MarkUserOperationIncluded stores the bundle transaction that executed the operation
+	MarkUserOperationIncluded(id uint, transactionHash string) error
+	UpdateUserOperationStatus(id uint, status models.UserOperationStatus, errorMessage string) error
+}
+
+type userOperationService struct {
+	db *gorm.DB
+}
+
+func NewUserOperationService(db *gorm.DB) UserOperationService {
+	return &userOperationService{db: db}
+}
+
+func (s *userOperationService) CreateUserOperation(operation *models.UserOperation) error {
+	if operation.Status == "" {
+		operation.Status = models.UserOperationStatusPrepared
+	}
+	return s.db.Create(operation).Error
+}
+
+func (s *userOperationService) GetLatestUserOperation(sessionId string, transactionIndex int) (*models.UserOperation, error) {
+	var operation models.UserOperation
+	err := s.db.Preload("Chain").Where("session_id = ? AND transaction_index = ?", sessionId, transactionIndex).
+		Order("id DESC").
+		First(&operation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &operation, nil
+}
+
+func (s *userOperationService) MarkUserOperationSent(id uint, operation *models.UserOperationData) error {
+	return s.db.Model(&models.UserOperation{ID: id}).Select("operation", "status").Updates(&models.UserOperation{
+		Operation: operation,
+		Status:    models.UserOperationStatusPending,
+	}).Error
+}
+
+func (s *userOperationService) MarkUserOperationIncluded(id uint, transactionHash string) error {
+	now := time.Now()
+	return s.db.Model(&models.UserOperation{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":           models.UserOperationStatusIncluded,
+		"transaction_hash": transactionHash,
+		"included_at":      &now,
+	}).Error
+}
+
+func (s *userOperationService) UpdateUserOperationStatus(id uint, status models.UserOperationStatus, errorMessage string) error {
+	return s.db.Model(&models.UserOperation{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status": status,
+		"error":  errorMessage,
+	}).Error
+}
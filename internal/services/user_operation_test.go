@@ -0,0 +1,138 @@
+package services
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSmartAccount = "0x3333333333333333333333333333333333333333"
+
+// newAccountAbstractionNode answers the calls of the chain, the bundler and the paymaster used to prepare an operation
+func newAccountAbstractionNode(t *testing.T, deployed bool) (*httptest.Server, *[]string) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		methods = append(methods, request.Method)
+
+		var result any
+		switch request.Method {
+		case "eth_call":
+			var call struct {
+				Data string `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(request.Params[0], &call))
+			switch {
+			case strings.HasPrefix(call.Data, "0x8cb84e18"): // getAddress(address,uint256)
+				result = hexutil.Encode(common.LeftPadBytes(common.HexToAddress(testSmartAccount).Bytes(), 32))
+			default: // getNonce(address,uint192)
+				result = hexutil.Encode(common.LeftPadBytes(big.NewInt(3).Bytes(), 32))
+			}
+		case "eth_getCode":
+			result = "0x"
+			if deployed {
+				result = "0x6080"
+			}
+		case "eth_gasPrice":
+			result = "0x3b9aca00"
+		case "eth_maxPriorityFeePerGas":
+			result = "0x5f5e100"
+		case "eth_estimateUserOperationGas":
+			result = map[string]any{"callGasLimit": "0x10000", "verificationGasLimit": "0x20000", "preVerificationGas": 50000}
+		case "pm_sponsorUserOperation":
+			result = map[string]any{"paymasterAndData": "0xabcdef", "callGasLimit": "0x11000", "verificationGasLimit": "0x21000", "preVerificationGas": "0xc400"}
+		default:
+			t.Errorf("unexpected method %s", request.Method)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	t.Cleanup(server.Close)
+	return server, &methods
+}
+
+func TestPrepareUserOperation(t *testing.T) {
+	owner := "0x2222222222222222222222222222222222222222"
+	swap := models.TransactionDeployment{Data: "0x7ff36ab5", Value: "1000", Receiver: "0x1111111111111111111111111111111111111111"}
+
+	t.Run("the first operation deploys the smart account", func(t *testing.T) {
+		node, methods := newAccountAbstractionNode(t, false)
+		chain := &models.Chain{ID: 1, Name: "Sepolia", NetworkID: "11155111", RPC: node.URL, BundlerRPC: node.URL}
+
+		operation, err := PrepareUserOperation(chain, "session-1", 0, swap, owner)
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToAddress(testSmartAccount).Hex(), operation.Sender)
+		assert.Equal(t, common.HexToAddress(owner).Hex(), operation.Owner)
+		assert.Equal(t, models.UserOperationStatusPrepared, operation.Status)
+		assert.False(t, operation.Sponsored)
+		assert.Empty(t, operation.ContractAddress)
+
+		op := operation.Operation
+		initCode, err := utils.SmartAccountInitCode(utils.DefaultAccountFactoryAddress, owner)
+		require.NoError(t, err)
+		assert.Equal(t, initCode, op.InitCode)
+		callData, err := utils.EncodeSmartAccountExecute(swap.Receiver, big.NewInt(1000), swap.Data)
+		require.NoError(t, err)
+		assert.Equal(t, callData, op.CallData)
+		assert.Equal(t, "0x3", op.Nonce)
+		assert.Equal(t, "0x77359400", op.MaxFeePerGas)
+		assert.Equal(t, "0x5f5e100", op.MaxPriorityFeePerGas)
+		assert.Equal(t, "0xc350", op.PreVerificationGas)
+		assert.Equal(t, "0x", op.PaymasterAndData)
+		assert.Equal(t, "0x", op.Signature)
+
+		hash, err := utils.UserOperationHash(op, utils.DefaultEntryPointAddress, big.NewInt(11155111))
+		require.NoError(t, err)
+		assert.Equal(t, hash.Hex(), operation.UserOperationHash)
+		assert.NotContains(t, *methods, "pm_sponsorUserOperation")
+	})
+
+	t.Run("the paymaster sponsors the gas it signed", func(t *testing.T) {
+		node, _ := newAccountAbstractionNode(t, true)
+		chain := &models.Chain{ID: 1, Name: "Sepolia", NetworkID: "11155111", RPC: node.URL, BundlerRPC: node.URL, PaymasterRPC: node.URL}
+
+		operation, err := PrepareUserOperation(chain, "session-1", 0, swap, owner)
+		require.NoError(t, err)
+		assert.True(t, operation.Sponsored)
+		assert.Equal(t, "0x", operation.Operation.InitCode)
+		assert.Equal(t, "0xabcdef", operation.Operation.PaymasterAndData)
+		assert.Equal(t, "0x11000", operation.Operation.CallGasLimit)
+		assert.Equal(t, "0xc400", operation.Operation.PreVerificationGas)
+	})
+
+	t.Run("deployments are created at the address of the session transaction", func(t *testing.T) {
+		node, _ := newAccountAbstractionNode(t, true)
+		chain := &models.Chain{ID: 1, Name: "Sepolia", NetworkID: "11155111", RPC: node.URL, BundlerRPC: node.URL}
+		deploy := models.TransactionDeployment{Data: "0x6080604052", Value: "0"}
+
+		operation, err := PrepareUserOperation(chain, "session-2", 1, deploy, owner)
+		require.NoError(t, err)
+		_, contractAddress, err := utils.EncodeSmartAccountDeploy(big.NewInt(0), deploy.Data, UserOperationSalt("session-2", 1))
+		require.NoError(t, err)
+		assert.Equal(t, contractAddress, operation.ContractAddress)
+
+		again, err := PrepareUserOperation(chain, "session-2", 1, deploy, owner)
+		require.NoError(t, err)
+		assert.Equal(t, operation.ContractAddress, again.ContractAddress)
+	})
+
+	t.Run("chains without bundler are refused", func(t *testing.T) {
+		_, err := PrepareUserOperation(&models.Chain{Name: "Sepolia", NetworkID: "11155111"}, "session-1", 0, swap, owner)
+		assert.ErrorContains(t, err, "not configured")
+		_, err = PrepareUserOperation(&models.Chain{Name: "Sepolia", NetworkID: "11155111", BundlerRPC: "http://localhost"}, "session-1", 0, swap, "owner")
+		assert.ErrorContains(t, err, "invalid owner")
+	})
+}
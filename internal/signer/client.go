@@ -0,0 +1,134 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// Client calls the signing API of a session like the signing page does: opening the session url first keeps the
+// cookies of its signature, local token and one-time binding for the API calls
+type Client struct {
+	http      *http.Client
+	baseURL   string
+	sessionID string
+}
+
+// Open opens the session url, the url printed by the MCP tools
+func Open(ctx context.Context, sessionURL string) (*Client, error) {
+	parsedURL, err := url.Parse(sessionURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return nil, fmt.Errorf("invalid session url %q", sessionURL)
+	}
+	prefix, sessionID, ok := strings.Cut(parsedURL.Path, "/tx/")
+	if !ok || sessionID == "" || strings.Contains(sessionID, "/") {
+		return nil, fmt.Errorf("invalid session url %q: expected a /tx/<session id> url", sessionURL)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{
+		http:      &http.Client{Jar: jar, Timeout: 30 * time.Second},
+		baseURL:   parsedURL.Scheme + "://" + parsedURL.Host + prefix,
+		sessionID: sessionID,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sessionURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the session: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to open the session: the signing page returned %s, the url may be invalid, expired, already used or the session completed", resp.Status)
+	}
+	return client, nil
+}
+
+// SessionID returns the id of the session of the url
+func (c *Client) SessionID() string {
+	return c.sessionID
+}
+
+// Session returns the session data shown by the signing page
+func (c *Client) Session(ctx context.Context) (*models.TransactionSession, error) {
+	var session models.TransactionSession
+	if err := c.do(ctx, http.MethodGet, "", nil, &session); err != nil {
+		return nil, fmt.Errorf("failed to get the session: %w", err)
+	}
+	return &session, nil
+}
+
+// Complete reports the mined transaction at index, the server verifies it on chain and runs the hooks
+func (c *Client) Complete(ctx context.Context, index int, receipt *types.Receipt) error {
+	body := api.TransactionCompleteRequest{
+		TransactionHash: receipt.TxHash.Hex(),
+		Status:          models.TransactionStatusConfirmed,
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		body.Status = models.TransactionStatusFailed
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		contractAddress := receipt.ContractAddress.Hex()
+		body.ContractAddress = &contractAddress
+	}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/transaction/%d", index), body, nil); err != nil {
+		return fmt.Errorf("failed to complete transaction %d: %w", index, err)
+	}
+	return nil
+}
+
+// do calls the API of the session at path, decoding the JSON response into result
+func (c *Client) do(ctx context.Context, method, path string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		bodyJSON, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(bodyJSON)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/api/tx/%s%s", c.baseURL, c.sessionID, path), reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var apiError struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&apiError) == nil && apiError.Error != "" {
+			return fmt.Errorf("%s: %s", resp.Status, apiError.Error)
+		}
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
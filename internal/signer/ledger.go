@@ -0,0 +1,53 @@
+//go:build ledger
+
+package signer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type ledgerWallet struct {
+	wallet  accounts.Wallet
+	account accounts.Account
+}
+
+// OpenLedger opens the account at the derivation path of the first Ledger plugged in, its Ethereum app must be open.
+// Every transaction is confirmed on the device
+func OpenLedger(path string) (Wallet, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+	}
+	hub, err := usbwallet.NewLedgerHub()
+	if err != nil {
+		return nil, fmt.Errorf("failed to access the USB devices: %w", err)
+	}
+	wallets := hub.Wallets()
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("no Ledger found, plug it in and unlock it")
+	}
+	wallet := wallets[0]
+	if err := wallet.Open(""); err != nil {
+		return nil, fmt.Errorf("failed to open the Ledger, open its Ethereum app: %w", err)
+	}
+	account, err := wallet.Derive(derivationPath, true)
+	if err != nil {
+		wallet.Close()
+		return nil, fmt.Errorf("failed to derive the account %s: %w", path, err)
+	}
+	return &ledgerWallet{wallet: wallet, account: account}, nil
+}
+
+func (w *ledgerWallet) Address() common.Address {
+	return w.account.Address
+}
+
+func (w *ledgerWallet) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return w.wallet.SignTx(w.account, tx, chainID)
+}
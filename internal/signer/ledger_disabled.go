@@ -0,0 +1,11 @@
+//go:build !ledger
+
+package signer
+
+import "fmt"
+
+// OpenLedger is only available in the builds with the ledger tag, the USB access of the Ledger requires cgo and
+// the hid library: go build -tags ledger ./cmd/launchpad-signer
+func OpenLedger(path string) (Wallet, error) {
+	return nil, fmt.Errorf("Ledger support is not compiled in, build launchpad-signer with -tags ledger")
+}
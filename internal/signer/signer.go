@@ -0,0 +1,243 @@
+// Package signer signs the transaction sessions of the launchpad from the terminal with a local keystore or a
+// Ledger, for the users who prefer not to sign on the signing page in the browser.
+package signer
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// Options configure a signing run
+type Options struct {
+	// URL is the session url printed by the MCP tools
+	URL    string
+	Wallet Wallet
+	// RPC overrides the RPC of the session chain, e.g. when the RPC of the server is not reachable from this machine
+	RPC string
+	// Yes signs without asking for the confirmation
+	Yes bool
+	// In reads the confirmation, Out shows the session and the progress
+	In  io.Reader
+	Out io.Writer
+}
+
+// Run shows the transactions of the session, then signs, broadcasts and reports each pending one in order once the
+// user confirms. Transactions already confirmed, e.g. on the signing page, are skipped
+func Run(ctx context.Context, opts Options) error {
+	client, err := Open(ctx, opts.URL)
+	if err != nil {
+		return err
+	}
+	session, err := client.Session(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkSession(session); err != nil {
+		return err
+	}
+
+	rpcURL := opts.RPC
+	if rpcURL == "" {
+		rpcURL = session.Chain.RPC
+	}
+	eth, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", rpcURL, err)
+	}
+	defer eth.Close()
+	chainID, err := eth.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the chain id of %s: %w", rpcURL, err)
+	}
+	if chainID.String() != session.Chain.NetworkID {
+		return fmt.Errorf("the RPC %s is on chain %s, the session is on chain %s", rpcURL, chainID, session.Chain.NetworkID)
+	}
+
+	pending := printSession(opts.Out, session, opts.Wallet.Address())
+	if len(pending) == 0 {
+		fmt.Fprintln(opts.Out, "Every transaction of the session is already confirmed.")
+		return nil
+	}
+	if !opts.Yes && !confirm(opts.In, opts.Out, fmt.Sprintf("Sign and broadcast %d transaction(s) from %s?", len(pending), opts.Wallet.Address().Hex())) {
+		return fmt.Errorf("signing cancelled")
+	}
+
+	for _, index := range pending {
+		deployment := session.TransactionDeployments[index]
+		fmt.Fprintf(opts.Out, "Signing %d. %s...\n", index+1, deployment.Title)
+		receipt, err := sendTransaction(ctx, eth, chainID, opts.Wallet, deployment)
+		if err != nil {
+			return fmt.Errorf("transaction %d (%s): %w", index+1, deployment.Title, err)
+		}
+		// the reverted transactions are reported too, the server marks the session failed like the signing page does
+		if err := client.Complete(ctx, index, receipt); err != nil {
+			return err
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("transaction %d (%s) reverted: %s", index+1, deployment.Title, receipt.TxHash.Hex())
+		}
+		line := fmt.Sprintf("Confirmed %d. %s: %s", index+1, deployment.Title, receipt.TxHash.Hex())
+		if receipt.ContractAddress != (common.Address{}) {
+			line += fmt.Sprintf(" (contract %s)", receipt.ContractAddress.Hex())
+		}
+		fmt.Fprintln(opts.Out, line)
+	}
+	fmt.Fprintf(opts.Out, "Session %s completed.\n", client.SessionID())
+	return nil
+}
+
+// checkSession rejects the sessions only the signing page can complete
+func checkSession(session *models.TransactionSession) error {
+	if session.TransactionStatus != models.TransactionStatusPending {
+		return fmt.Errorf("session %s is %s, not pending", session.ID, session.TransactionStatus)
+	}
+	if session.TransactionChainType != models.TransactionChainTypeEthereum {
+		return fmt.Errorf("session %s is on %s, launchpad-signer only signs Ethereum sessions", session.ID, session.TransactionChainType)
+	}
+	if session.SignatureRequest != nil {
+		return fmt.Errorf("session %s requests a message signature, sign it on the signing page", session.ID)
+	}
+	if session.SafeProposal != nil {
+		return fmt.Errorf("session %s was proposed to a Safe, it is completed once the Safe executes it", session.ID)
+	}
+	for i, deployment := range session.TransactionDeployments {
+		if deployment.Status == models.TransactionStatusConfirmed {
+			continue
+		}
+		if deployment.UserOperation {
+			return fmt.Errorf("transaction %d (%s) is gasless, sign it on the signing page", i+1, deployment.Title)
+		}
+		if deployment.PrivateRelay {
+			return fmt.Errorf("transaction %d (%s) is submitted through the private relay, sign it on the signing page", i+1, deployment.Title)
+		}
+	}
+	return nil
+}
+
+// printSession shows the transactions of the session and returns the indexes of the pending ones
+func printSession(out io.Writer, session *models.TransactionSession, signer common.Address) []int {
+	fmt.Fprintf(out, "Session %s on %s (chain %s)\n", session.ID, session.Chain.Name, session.Chain.NetworkID)
+	fmt.Fprintf(out, "Signer: %s\n\n", signer.Hex())
+
+	var pending []int
+	for i, deployment := range session.TransactionDeployments {
+		status := "pending"
+		if deployment.Status == models.TransactionStatusConfirmed {
+			status = "confirmed, skipped"
+		} else {
+			pending = append(pending, i)
+		}
+		fmt.Fprintf(out, "%d. %s [%s]\n", i+1, deployment.Title, status)
+		if deployment.Description != "" {
+			fmt.Fprintf(out, "   %s\n", deployment.Description)
+		}
+		if deployment.Receiver != "" {
+			fmt.Fprintf(out, "   To:    %s\n", deployment.Receiver)
+		} else {
+			fmt.Fprintln(out, "   To:    contract creation")
+		}
+		fmt.Fprintf(out, "   Value: %s ETH\n", formatEther(deployment.Value))
+		fmt.Fprintf(out, "   Data:  %d bytes\n", len(common.FromHex(deployment.Data)))
+	}
+	fmt.Fprintln(out)
+	return pending
+}
+
+// formatEther formats a wei amount in ether without the trailing zeros
+func formatEther(wei string) string {
+	value, ok := new(big.Int).SetString(wei, 10)
+	if !ok {
+		return "0"
+	}
+	ether := new(big.Rat).SetFrac(value, big.NewInt(1e18)).FloatString(18)
+	ether = strings.TrimRight(ether, "0")
+	return strings.TrimSuffix(ether, ".")
+}
+
+// confirm asks the question on out, only a yes answer confirms
+func confirm(in io.Reader, out io.Writer, question string) bool {
+	fmt.Fprintf(out, "%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// sendTransaction signs and broadcasts the transaction with the gas, price and nonce of the session when set, then
+// waits for its receipt
+func sendTransaction(ctx context.Context, eth *ethclient.Client, chainID *big.Int, wallet Wallet, deployment models.TransactionDeployment) (*types.Receipt, error) {
+	value := big.NewInt(0)
+	if deployment.Value != "" {
+		if _, ok := value.SetString(deployment.Value, 10); !ok {
+			return nil, fmt.Errorf("invalid value %q", deployment.Value)
+		}
+	}
+	data := common.FromHex(deployment.Data)
+	var to *common.Address
+	if deployment.Receiver != "" {
+		receiver := common.HexToAddress(deployment.Receiver)
+		to = &receiver
+	}
+
+	var nonce uint64
+	if deployment.Nonce != nil {
+		nonce = *deployment.Nonce
+	} else {
+		pendingNonce, err := eth.PendingNonceAt(ctx, wallet.Address())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		nonce = pendingNonce
+	}
+
+	gasPrice, ok := new(big.Int), false
+	if deployment.GasPrice != nil {
+		gasPrice, ok = gasPrice.SetString(*deployment.GasPrice, 10)
+	}
+	if !ok {
+		suggested, err := eth.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas price: %w", err)
+		}
+		gasPrice = suggested
+	}
+
+	var gasLimit uint64
+	if deployment.GasLimit != nil {
+		gasLimit, _ = strconv.ParseUint(*deployment.GasLimit, 10, 64)
+	}
+	if gasLimit == 0 {
+		estimated, err := eth.EstimateGas(ctx, ethereum.CallMsg{From: wallet.Address(), To: to, Value: value, Data: data})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		// Same margin as wallets add on top of the estimate
+		gasLimit = estimated * 12 / 10
+	}
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: gasPrice, Gas: gasLimit, To: to, Value: value, Data: data})
+	signedTx, err := wallet.SignTx(tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if err := eth.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, eth, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", signedTx.Hash().Hex(), err)
+	}
+	return receipt, nil
+}
@@ -0,0 +1,253 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/rxtech-lab/launchpad-mcp/internal/api"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// fakeNode is a JSON-RPC node of chain 31337 mining every raw transaction it receives
+type fakeNode struct {
+	*httptest.Server
+	mu           sync.Mutex
+	transactions []*types.Transaction
+}
+
+func newFakeNode(t *testing.T) *fakeNode {
+	node := &fakeNode{}
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		switch req.Method {
+		case "eth_chainId":
+			result = "0x7a69"
+		case "eth_getTransactionCount":
+			node.mu.Lock()
+			result = hexutil.Uint64(len(node.transactions))
+			node.mu.Unlock()
+		case "eth_gasPrice":
+			result = "0x3b9aca00"
+		case "eth_estimateGas":
+			result = "0x5208"
+		case "eth_sendRawTransaction":
+			var raw hexutil.Bytes
+			require.NoError(t, json.Unmarshal(req.Params[0], &raw))
+			tx := new(types.Transaction)
+			require.NoError(t, tx.UnmarshalBinary(raw))
+			node.mu.Lock()
+			node.transactions = append(node.transactions, tx)
+			node.mu.Unlock()
+			result = tx.Hash().Hex()
+		case "eth_getTransactionReceipt":
+			var hash common.Hash
+			require.NoError(t, json.Unmarshal(req.Params[0], &hash))
+			result = node.receipt(hash)
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+// receipt returns the receipt of a mined transaction, the contract creations deploy at the address of the sender nonce
+func (n *fakeNode) receipt(hash common.Hash) map[string]any {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, tx := range n.transactions {
+		if tx.Hash() != hash {
+			continue
+		}
+		receipt := map[string]any{
+			"transactionHash":   hash.Hex(),
+			"blockHash":         common.HexToHash("0x01").Hex(),
+			"blockNumber":       "0x1",
+			"status":            "0x1",
+			"cumulativeGasUsed": "0x5208",
+			"gasUsed":           "0x5208",
+			"logs":              []any{},
+			"logsBloom":         hexutil.Bytes(make([]byte, 256)),
+		}
+		if tx.To() == nil {
+			sender, _ := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+			receipt["contractAddress"] = crypto.CreateAddress(sender, tx.Nonce()).Hex()
+		}
+		return receipt
+	}
+	return nil
+}
+
+type testEnv struct {
+	node         *fakeNode
+	txService    services.TransactionService
+	chainService services.ChainService
+	chain        *models.Chain
+	port         int
+	wallet       Wallet
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Setenv("JWT_SECRET", "test-secret")
+	t.Setenv(utils.EnvSessionURLSecret, "session-url-secret")
+	node := newFakeNode(t)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: node.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	txService := services.NewTransactionService(db.GetDB())
+
+	apiServer := api.NewAPIServer(db, txService, services.NewHookService(), chainService, services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapContractService(services.NewUniswapService(db.GetDB())))
+	apiServer.SetupRoutes()
+	port, err := apiServer.Start(nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { apiServer.Shutdown() })
+	// Wait for server to be ready
+	time.Sleep(100 * time.Millisecond)
+
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	return &testEnv{node: node, txService: txService, chainService: chainService, chain: chain, port: port, wallet: NewPrivateKeyWallet(key)}
+}
+
+// createSession creates a session and returns its signed url
+func (e *testEnv) createSession(t *testing.T, deployments ...models.TransactionDeployment) (string, string) {
+	sessionID, err := e.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: deployments,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                e.chain.ID,
+	})
+	require.NoError(t, err)
+	sessionURL, err := utils.GetTransactionSessionUrl(context.Background(), e.port, sessionID)
+	require.NoError(t, err)
+	return sessionID, sessionURL
+}
+
+func TestRun(t *testing.T) {
+	env := newTestEnv(t)
+	sessionID, sessionURL := env.createSession(t,
+		models.TransactionDeployment{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeRegular},
+		models.TransactionDeployment{Title: "Fund Pool", Description: "Send ETH to the pool", Data: "0x", Value: "1500000000000000000", Receiver: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", TransactionType: models.TransactionTypeRegular},
+	)
+
+	var out bytes.Buffer
+	err := Run(context.Background(), Options{URL: sessionURL, Wallet: env.wallet, In: strings.NewReader("y\n"), Out: &out})
+	require.NoError(t, err, out.String())
+
+	output := out.String()
+	assert.Contains(t, output, "1. Deploy Token [pending]")
+	assert.Contains(t, output, "To:    contract creation")
+	assert.Contains(t, output, "Value: 1.5 ETH")
+	assert.Contains(t, output, "(contract "+crypto.CreateAddress(env.wallet.Address(), 0).Hex()+")")
+	assert.Contains(t, output, "Session "+sessionID+" completed.")
+
+	require.Len(t, env.node.transactions, 2)
+	for i, tx := range env.node.transactions {
+		sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		require.NoError(t, err)
+		assert.Equal(t, env.wallet.Address(), sender)
+		assert.Equal(t, uint64(i), tx.Nonce())
+		assert.Equal(t, int64(31337), tx.ChainId().Int64())
+	}
+	assert.Equal(t, "1500000000000000000", env.node.transactions[1].Value().String())
+
+	session, err := env.txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusConfirmed, session.TransactionStatus)
+
+	// A completed session no longer opens
+	err = Run(context.Background(), Options{URL: sessionURL, Wallet: env.wallet, Yes: true, Out: &out})
+	assert.ErrorContains(t, err, "failed to open the session")
+}
+
+func TestRunCancelled(t *testing.T) {
+	env := newTestEnv(t)
+	_, sessionURL := env.createSession(t, models.TransactionDeployment{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeRegular})
+
+	var out bytes.Buffer
+	err := Run(context.Background(), Options{URL: sessionURL, Wallet: env.wallet, In: strings.NewReader("\n"), Out: &out})
+	assert.EqualError(t, err, "signing cancelled")
+	assert.Empty(t, env.node.transactions)
+}
+
+func TestRunRejectsSessions(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, gasless := env.createSession(t, models.TransactionDeployment{Title: "Swap", Data: "0x", Value: "0", Receiver: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", TransactionType: models.TransactionTypeRegular, UserOperation: true})
+	err := Run(context.Background(), Options{URL: gasless, Wallet: env.wallet, Yes: true, Out: &bytes.Buffer{}})
+	assert.ErrorContains(t, err, "is gasless, sign it on the signing page")
+
+	// The url must carry its signature
+	sessionID, signed := env.createSession(t, models.TransactionDeployment{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeRegular})
+	unsigned, _, _ := strings.Cut(signed, "?")
+	err = Run(context.Background(), Options{URL: unsigned, Wallet: env.wallet, Yes: true, Out: &bytes.Buffer{}})
+	assert.ErrorContains(t, err, "403 Forbidden")
+
+	_, err = Open(context.Background(), "http://localhost/launch/"+sessionID)
+	assert.ErrorContains(t, err, "expected a /tx/<session id> url")
+
+	// The RPC must be on the chain of the session
+	env.chain = &models.Chain{Name: "Mainnet", RPC: env.node.URL, NetworkID: "1", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, env.chainService.CreateChain(env.chain))
+	_, mainnet := env.createSession(t, models.TransactionDeployment{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeRegular})
+	err = Run(context.Background(), Options{URL: mainnet, Wallet: env.wallet, Yes: true, Out: &bytes.Buffer{}})
+	assert.ErrorContains(t, err, "is on chain 31337, the session is on chain 1")
+	assert.Empty(t, env.node.transactions)
+}
+
+func TestOpenKeystore(t *testing.T) {
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(key.PublicKey), PrivateKey: key}, "password", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(path, keyJSON, 0600))
+
+	wallet, err := OpenKeystore(path, "password")
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), wallet.Address())
+
+	_, err = OpenKeystore(path, "wrong")
+	assert.ErrorContains(t, err, "failed to decrypt keystore")
+}
+
+func TestFormatEther(t *testing.T) {
+	assert.Equal(t, "0", formatEther("0"))
+	assert.Equal(t, "1.5", formatEther("1500000000000000000"))
+	assert.Equal(t, "0.000000000000000001", formatEther("1"))
+	assert.Equal(t, "0", formatEther(""))
+}
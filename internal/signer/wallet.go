@@ -0,0 +1,52 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DefaultLedgerPath is the derivation path of the first Ethereum account of a Ledger
+const DefaultLedgerPath = "m/44'/60'/0'/0/0"
+
+// Wallet signs the transactions of a session
+type Wallet interface {
+	Address() common.Address
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+type privateKeyWallet struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewPrivateKeyWallet returns a wallet signing with the private key
+func NewPrivateKeyWallet(key *ecdsa.PrivateKey) Wallet {
+	return &privateKeyWallet{key: key}
+}
+
+func (w *privateKeyWallet) Address() common.Address {
+	return crypto.PubkeyToAddress(w.key.PublicKey)
+}
+
+func (w *privateKeyWallet) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), w.key)
+}
+
+// OpenKeystore decrypts the account of a keystore file, the JSON file written by geth, clef or cast wallet
+func OpenKeystore(path, password string) (Wallet, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return NewPrivateKeyWallet(key.PrivateKey), nil
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/utils/ReentrancyGuard.sol";
+
+interface IUniswapV2Router02 {
+    function factory() external pure returns (address);
+
+    function WETH() external pure returns (address);
+
+    function addLiquidityETH(
+        address token,
+        uint256 amountTokenDesired,
+        uint256 amountTokenMin,
+        uint256 amountETHMin,
+        address to,
+        uint256 deadline
+    ) external payable returns (uint256 amountToken, uint256 amountETH, uint256 liquidity);
+}
+
+interface IUniswapV2Factory {
+    function createPair(address tokenA, address tokenB) external returns (address pair);
+}
+
+/// @title {{.CurveName}}
+/// @notice Token sold along a constant product bonding curve with virtual reserves. The curve holds the curve supply
+/// and sells it for ETH until it is sold out, the ETH raised and the rest of the supply are then added to a Uniswap V2
+/// pair and the LP tokens are burned. The virtual reserves are chosen so the pool opens at the last price of the curve.
+contract {{.CurveName}} is ERC20, ReentrancyGuard {
+    address private constant DEAD = 0x000000000000000000000000000000000000dEaD;
+
+    IUniswapV2Router02 public immutable router;
+    address public immutable creator;
+
+    // Read by the initial buy of the constructor, so not immutable
+    address public pair;
+    uint256 public curveSupply;
+    uint256 public liquiditySupply;
+    uint256 public graduationThreshold;
+    uint256 public initialVirtualEth;
+    uint256 public initialVirtualToken;
+
+    uint256 public virtualEth;
+    uint256 public virtualToken;
+    uint256 public ethRaised;
+    uint256 public tokensSold;
+    bool public graduated;
+
+    event Buy(address indexed buyer, uint256 ethIn, uint256 tokensOut, uint256 virtualEth, uint256 virtualToken);
+    event Sell(address indexed seller, uint256 tokensIn, uint256 ethOut, uint256 virtualEth, uint256 virtualToken);
+    event Graduated(address indexed pair, uint256 ethLiquidity, uint256 tokenLiquidity);
+
+    /// @param _curveSupply tokens sold along the curve, more than half of the total supply
+    /// @param _graduationThreshold ETH raised when the curve supply is sold out
+    /// @param _router Uniswap V2 router receiving the liquidity at graduation
+    constructor(
+        string memory _name,
+        string memory _symbol,
+        uint256 _totalSupply,
+        uint256 _curveSupply,
+        uint256 _graduationThreshold,
+        address _router,
+        address _creator
+    ) payable ERC20(_name, _symbol) {
+        require(_curveSupply * 2 > _totalSupply && _curveSupply < _totalSupply, "Curve supply must be between half and all of the supply");
+        require(_graduationThreshold > 0, "Graduation threshold is zero");
+
+        router = IUniswapV2Router02(_router);
+        creator = _creator;
+        curveSupply = _curveSupply;
+        liquiditySupply = _totalSupply - _curveSupply;
+        graduationThreshold = _graduationThreshold;
+
+        // Sold out at the threshold, the price of the curve then equals threshold / liquiditySupply
+        initialVirtualEth = (_graduationThreshold * (_totalSupply - _curveSupply)) / (2 * _curveSupply - _totalSupply);
+        initialVirtualToken = ((initialVirtualEth + _graduationThreshold) * _curveSupply) / _graduationThreshold;
+        virtualEth = initialVirtualEth;
+        virtualToken = initialVirtualToken;
+
+        // The pair exists from the start so nobody can seed it at another price before graduation
+        pair = IUniswapV2Factory(IUniswapV2Router02(_router).factory()).createPair(address(this), IUniswapV2Router02(_router).WETH());
+        _mint(address(this), _totalSupply);
+
+        // The creator buys first with the ETH sent, below the threshold so the curve cannot graduate before it exists
+        if (msg.value > 0) {
+            require(msg.value < _graduationThreshold, "Initial buy reaches the graduation threshold");
+            _buy(_creator, msg.value, 0);
+        }
+    }
+
+    /// @notice State of the curve read by the launchpad
+    function curveState()
+        external
+        view
+        returns (uint256 _ethRaised, uint256 _tokensSold, uint256 _virtualEth, uint256 _virtualToken, bool _graduated)
+    {
+        return (ethRaised, tokensSold, virtualEth, virtualToken, graduated);
+    }
+
+    /// @notice Tokens bought for ethIn, capped at the unsold curve supply
+    function quoteBuy(uint256 ethIn) public view returns (uint256) {
+        uint256 tokensOut = virtualToken - _divUp(virtualEth * virtualToken, virtualEth + ethIn);
+        uint256 remaining = curveSupply - tokensSold;
+        return tokensOut > remaining ? remaining : tokensOut;
+    }
+
+    /// @notice ETH paid for selling tokensIn back to the curve
+    function quoteSell(uint256 tokensIn) public view returns (uint256) {
+        return virtualEth - _divUp(virtualEth * virtualToken, virtualToken + tokensIn);
+    }
+
+    function buy(uint256 minTokensOut) external payable nonReentrant {
+        _buy(msg.sender, msg.value, minTokensOut);
+    }
+
+    function sell(uint256 tokensIn, uint256 minEthOut) external nonReentrant {
+        require(!graduated, "Curve graduated, trade on Uniswap");
+        require(tokensIn > 0, "Nothing to sell");
+
+        uint256 ethOut = quoteSell(tokensIn);
+        require(ethOut >= minEthOut, "Slippage");
+        require(ethOut <= ethRaised, "Not enough ETH in the curve");
+
+        _transfer(msg.sender, address(this), tokensIn);
+        virtualEth -= ethOut;
+        virtualToken += tokensIn;
+        ethRaised -= ethOut;
+        tokensSold -= tokensIn;
+        emit Sell(msg.sender, tokensIn, ethOut, virtualEth, virtualToken);
+
+        (bool sent, ) = payable(msg.sender).call{value: ethOut}("");
+        require(sent, "ETH transfer failed");
+    }
+
+    function _buy(address buyer, uint256 ethIn, uint256 minTokensOut) private {
+        require(!graduated, "Curve graduated, trade on Uniswap");
+        require(ethIn > 0, "Nothing to buy");
+
+        uint256 tokensOut = quoteBuy(ethIn);
+        require(tokensOut > 0 && tokensOut >= minTokensOut, "Slippage");
+
+        // The last buy takes the rest of the curve supply and gets the ETH above its price back
+        uint256 ethUsed = ethIn;
+        if (tokensSold + tokensOut == curveSupply) {
+            uint256 needed = _divUp(virtualEth * virtualToken, virtualToken - tokensOut) - virtualEth;
+            if (needed < ethIn) {
+                ethUsed = needed;
+            }
+        }
+
+        virtualEth += ethUsed;
+        virtualToken -= tokensOut;
+        ethRaised += ethUsed;
+        tokensSold += tokensOut;
+        _transfer(address(this), buyer, tokensOut);
+        emit Buy(buyer, ethUsed, tokensOut, virtualEth, virtualToken);
+
+        if (tokensSold == curveSupply) {
+            _graduate();
+        }
+        if (ethUsed < ethIn) {
+            (bool sent, ) = payable(buyer).call{value: ethIn - ethUsed}("");
+            require(sent, "ETH refund failed");
+        }
+    }
+
+    function _graduate() private {
+        graduated = true;
+        uint256 ethLiquidity = ethRaised;
+        _approve(address(this), address(router), liquiditySupply);
+        router.addLiquidityETH{value: ethLiquidity}(address(this), liquiditySupply, liquiditySupply, 0, DEAD, block.timestamp);
+        emit Graduated(pair, ethLiquidity, liquiditySupply);
+    }
+
+    function _update(address from, address to, uint256 value) internal override {
+        // Only the graduation adds liquidity, which transfers from the curve through the router
+        require(graduated || to != pair || from == address(this), "Pair opens at graduation");
+        super._update(from, to, value);
+    }
+
+    function _divUp(uint256 a, uint256 b) private pure returns (uint256) {
+        return (a + b - 1) / b;
+    }
+}
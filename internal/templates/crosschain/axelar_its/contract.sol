@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+
+/// @title {{.TokenName}}
+/// @notice Axelar Interchain Token Service custom token. The token is linked to its deployments on the other
+/// chains through the Interchain Token Factory with a mint/burn token manager, which is granted the minter role.
+contract {{.TokenName}} is ERC20, Ownable {
+    /// @notice Accounts allowed to mint and burn, the ITS token manager once the token is wired
+    mapping(address => bool) public isMinter;
+
+    event MinterUpdated(address indexed minter, bool allowed);
+
+    error NotMinter(address account);
+
+    modifier onlyMinter() {
+        if (!isMinter[msg.sender]) revert NotMinter(msg.sender);
+        _;
+    }
+
+    constructor(uint256 _initialSupply) ERC20("{{.TokenName}}", "{{.TokenSymbol}}") Ownable(msg.sender) {
+        // The supply is minted once on the home chain, the other chains only mint what is bridged to them
+        if (block.chainid == {{.HomeChainID}}) {
+            _mint(msg.sender, _initialSupply);
+        }
+    }
+
+    function setMinter(address _minter, bool _allowed) external onlyOwner {
+        isMinter[_minter] = _allowed;
+        emit MinterUpdated(_minter, _allowed);
+    }
+
+    function mint(address _to, uint256 _amount) external onlyMinter {
+        _mint(_to, _amount);
+    }
+
+    function burn(address _from, uint256 _amount) external onlyMinter {
+        _burn(_from, _amount);
+    }
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "./interfaces/ILayerZeroEndpointV2.sol";
+
+/// @title {{.TokenName}}
+/// @notice LayerZero V2 Omnichain Fungible Token. Transfers burn on the source chain and mint on the
+/// destination chain, messages use the OFT codec so the token is compatible with the LayerZero OFT standard.
+contract {{.TokenName}} is ERC20, Ownable {
+    uint8 public constant SHARED_DECIMALS = 6;
+
+    ILayerZeroEndpointV2 public immutable endpoint;
+    uint256 public immutable decimalConversionRate;
+
+    /// @notice The OFT deployment of every remote endpoint ID, set by the wiring transactions
+    mapping(uint32 => bytes32) public peers;
+    /// @notice Executor options used when a send has no extra options
+    mapping(uint32 => bytes) public enforcedOptions;
+
+    event PeerSet(uint32 eid, bytes32 peer);
+    event EnforcedOptionSet(uint32 eid, bytes options);
+    event OFTSent(bytes32 indexed guid, uint32 dstEid, address indexed fromAddress, uint256 amountSentLD, uint256 amountReceivedLD);
+    event OFTReceived(bytes32 indexed guid, uint32 srcEid, address indexed toAddress, uint256 amountReceivedLD);
+
+    error NoPeer(uint32 eid);
+    error OnlyEndpoint(address addr);
+    error OnlyPeer(uint32 eid, bytes32 sender);
+    error SlippageExceeded(uint256 amountLD, uint256 minAmountLD);
+    error AmountSDOverflowed(uint256 amountSD);
+    error NotEnoughNative(uint256 msgValue);
+    error UnsupportedSendParam();
+
+    constructor(address _endpoint, uint256 _initialSupply) ERC20("{{.TokenName}}", "{{.TokenSymbol}}") Ownable(msg.sender) {
+        endpoint = ILayerZeroEndpointV2(_endpoint);
+        endpoint.setDelegate(msg.sender);
+        decimalConversionRate = 10 ** (decimals() - SHARED_DECIMALS);
+
+        // The supply is minted once on the home chain, the other chains only mint what is bridged to them
+        if (block.chainid == {{.HomeChainID}}) {
+            _mint(msg.sender, _initialSupply);
+        }
+    }
+
+    function setPeer(uint32 _eid, bytes32 _peer) external onlyOwner {
+        peers[_eid] = _peer;
+        emit PeerSet(_eid, _peer);
+    }
+
+    function setEnforcedOptions(uint32 _eid, bytes calldata _options) external onlyOwner {
+        enforcedOptions[_eid] = _options;
+        emit EnforcedOptionSet(_eid, _options);
+    }
+
+    /// @notice Called by the endpoint before the first message of a path
+    function allowInitializePath(Origin calldata _origin) external view returns (bool) {
+        return peers[_origin.srcEid] == _origin.sender;
+    }
+
+    /// @notice Messages are delivered unordered
+    function nextNonce(uint32, bytes32) external pure returns (uint64) {
+        return 0;
+    }
+
+    function quoteSend(SendParam calldata _sendParam, bool _payInLzToken) external view returns (MessagingFee memory) {
+        (, uint256 amountReceivedLD) = _debitView(_sendParam.amountLD, _sendParam.minAmountLD);
+        return endpoint.quote(_buildMessagingParams(_sendParam, amountReceivedLD, _payInLzToken), address(this));
+    }
+
+    function send(SendParam calldata _sendParam, MessagingFee calldata _fee, address _refundAddress)
+        external
+        payable
+        returns (MessagingReceipt memory msgReceipt, OFTReceipt memory oftReceipt)
+    {
+        if (_sendParam.composeMsg.length != 0 || _sendParam.oftCmd.length != 0 || _fee.lzTokenFee != 0) {
+            revert UnsupportedSendParam();
+        }
+        if (msg.value != _fee.nativeFee) revert NotEnoughNative(msg.value);
+
+        (uint256 amountSentLD, uint256 amountReceivedLD) = _debitView(_sendParam.amountLD, _sendParam.minAmountLD);
+        _burn(msg.sender, amountSentLD);
+
+        msgReceipt = endpoint.send{value: msg.value}(_buildMessagingParams(_sendParam, amountReceivedLD, false), _refundAddress);
+        oftReceipt = OFTReceipt(amountSentLD, amountReceivedLD);
+        emit OFTSent(msgReceipt.guid, _sendParam.dstEid, msg.sender, amountSentLD, amountReceivedLD);
+    }
+
+    function lzReceive(Origin calldata _origin, bytes32 _guid, bytes calldata _message, address, bytes calldata) external payable {
+        if (msg.sender != address(endpoint)) revert OnlyEndpoint(msg.sender);
+        if (_origin.sender == bytes32(0) || peers[_origin.srcEid] != _origin.sender) revert OnlyPeer(_origin.srcEid, _origin.sender);
+
+        address to = address(uint160(uint256(bytes32(_message[0:32]))));
+        uint256 amountReceivedLD = uint256(uint64(bytes8(_message[32:40]))) * decimalConversionRate;
+        // Tokens sent to the zero address are minted to a burn address instead, _mint rejects the zero address
+        if (to == address(0)) to = address(0xdead);
+
+        _mint(to, amountReceivedLD);
+        emit OFTReceived(_guid, _origin.srcEid, to, amountReceivedLD);
+    }
+
+    /// @dev Removes the dust below the shared decimals, which cannot be represented on every chain
+    function _debitView(uint256 _amountLD, uint256 _minAmountLD) internal view returns (uint256 amountSentLD, uint256 amountReceivedLD) {
+        amountSentLD = (_amountLD / decimalConversionRate) * decimalConversionRate;
+        amountReceivedLD = amountSentLD;
+        if (amountReceivedLD < _minAmountLD) revert SlippageExceeded(amountReceivedLD, _minAmountLD);
+    }
+
+    function _buildMessagingParams(SendParam calldata _sendParam, uint256 _amountLD, bool _payInLzToken) internal view returns (MessagingParams memory) {
+        bytes32 peer = peers[_sendParam.dstEid];
+        if (peer == bytes32(0)) revert NoPeer(_sendParam.dstEid);
+
+        uint256 amountSD = _amountLD / decimalConversionRate;
+        if (amountSD > type(uint64).max) revert AmountSDOverflowed(amountSD);
+
+        bytes memory options = _sendParam.extraOptions.length == 0 ? enforcedOptions[_sendParam.dstEid] : _sendParam.extraOptions;
+        return MessagingParams(_sendParam.dstEid, peer, abi.encodePacked(_sendParam.to, uint64(amountSD)), options, _payInLzToken);
+    }
+}
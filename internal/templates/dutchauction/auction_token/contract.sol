@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/utils/ReentrancyGuard.sol";
+
+interface IUniswapV2Router02 {
+    function factory() external pure returns (address);
+
+    function WETH() external pure returns (address);
+
+    function addLiquidityETH(
+        address token,
+        uint256 amountTokenDesired,
+        uint256 amountTokenMin,
+        uint256 amountETHMin,
+        address to,
+        uint256 deadline
+    ) external payable returns (uint256 amountToken, uint256 amountETH, uint256 liquidity);
+}
+
+interface IUniswapV2Factory {
+    function createPair(address tokenA, address tokenB) external returns (address pair);
+}
+
+/// @title {{.AuctionName}}
+/// @notice Token sold in a Dutch auction: the price of one token (10^18 units) decays from the start price to the end
+/// price between the start and the end time, continuously or in steps. Buyers pay the current price until the auction
+/// supply is sold out or the auction ends. settle then adds the ETH raised with tokens at the final price to a Uniswap
+/// V2 pair, the LP tokens go to the creator and the unsold tokens are burned.
+contract {{.AuctionName}} is ERC20, ReentrancyGuard {
+    uint256 private constant UNIT = 1e18;
+
+    IUniswapV2Router02 public immutable router;
+    address public immutable creator;
+    uint256 public immutable auctionSupply;
+    uint256 public immutable liquiditySupply;
+    uint256 public immutable startPrice;
+    uint256 public immutable endPrice;
+    uint256 public immutable startTime;
+    uint256 public immutable endTime;
+    uint256 public immutable stepDuration;
+    // Read by the mint of the constructor, so not immutable
+    address public pair;
+
+    uint256 public ethRaised;
+    uint256 public tokensSold;
+    // Price of the last buy, the final price when the auction sells out before its end
+    uint256 public lastPrice;
+    bool public settled;
+
+    event Buy(address indexed buyer, uint256 ethIn, uint256 tokensOut, uint256 price);
+    event Settled(address indexed pair, uint256 ethLiquidity, uint256 tokenLiquidity, uint256 tokensBurned);
+
+    /// @param _auctionSupply tokens sold in the auction, the rest of the total supply is available for the liquidity
+    /// @param _startPrice price in wei of one token at the start time, decaying to _endPrice at the end time
+    /// @param _stepDuration seconds between two price drops, 0 for a continuous decay
+    /// @param _router Uniswap V2 router receiving the liquidity at settlement
+    constructor(
+        string memory _name,
+        string memory _symbol,
+        uint256 _totalSupply,
+        uint256 _auctionSupply,
+        uint256 _startPrice,
+        uint256 _endPrice,
+        uint256 _startTime,
+        uint256 _endTime,
+        uint256 _stepDuration,
+        address _router,
+        address _creator
+    ) ERC20(_name, _symbol) {
+        require(_auctionSupply > 0 && _auctionSupply < _totalSupply, "Auction supply must be between zero and all of the supply");
+        require(_startPrice > _endPrice && _endPrice > 0, "Start price must be above the end price");
+        require(_endTime > _startTime && _endTime > block.timestamp, "Invalid auction window");
+        require(_stepDuration < _endTime - _startTime, "Step longer than the auction");
+
+        router = IUniswapV2Router02(_router);
+        creator = _creator;
+        auctionSupply = _auctionSupply;
+        liquiditySupply = _totalSupply - _auctionSupply;
+        startPrice = _startPrice;
+        endPrice = _endPrice;
+        startTime = _startTime;
+        endTime = _endTime;
+        stepDuration = _stepDuration;
+
+        // The pair exists from the start so nobody can seed it at another price before settlement
+        pair = IUniswapV2Factory(IUniswapV2Router02(_router).factory()).createPair(address(this), IUniswapV2Router02(_router).WETH());
+        _mint(address(this), _totalSupply);
+    }
+
+    /// @notice Price in wei of one token at timestamp
+    function priceAt(uint256 timestamp) public view returns (uint256) {
+        if (timestamp <= startTime) {
+            return startPrice;
+        }
+        if (timestamp >= endTime) {
+            return endPrice;
+        }
+        uint256 elapsed = timestamp - startTime;
+        if (stepDuration > 0) {
+            elapsed -= elapsed % stepDuration;
+        }
+        return startPrice - ((startPrice - endPrice) * elapsed) / (endTime - startTime);
+    }
+
+    function currentPrice() public view returns (uint256) {
+        return priceAt(block.timestamp);
+    }
+
+    /// @notice The auction is over once it is sold out or its end time is reached
+    function ended() public view returns (bool) {
+        return tokensSold == auctionSupply || block.timestamp >= endTime;
+    }
+
+    /// @notice State of the auction read by the launchpad
+    function auctionState()
+        external
+        view
+        returns (uint256 _currentPrice, uint256 _ethRaised, uint256 _tokensSold, bool _ended, bool _settled)
+    {
+        return (currentPrice(), ethRaised, tokensSold, ended(), settled);
+    }
+
+    /// @notice Tokens bought for ethIn at the current price, capped at the unsold auction supply
+    function quoteBuy(uint256 ethIn) public view returns (uint256) {
+        uint256 tokensOut = (ethIn * UNIT) / currentPrice();
+        uint256 remaining = auctionSupply - tokensSold;
+        return tokensOut > remaining ? remaining : tokensOut;
+    }
+
+    function buy(uint256 minTokensOut) external payable nonReentrant {
+        require(block.timestamp >= startTime, "Auction not started");
+        require(!ended(), "Auction ended");
+        require(msg.value > 0, "Nothing to buy");
+
+        uint256 price = currentPrice();
+        uint256 tokensOut = quoteBuy(msg.value);
+        require(tokensOut > 0 && tokensOut >= minTokensOut, "Slippage");
+
+        // The last buy takes the rest of the auction supply and gets the ETH above its price back
+        uint256 ethUsed = msg.value;
+        if (tokensSold + tokensOut == auctionSupply) {
+            uint256 needed = (tokensOut * price + UNIT - 1) / UNIT;
+            if (needed < msg.value) {
+                ethUsed = needed;
+            }
+        }
+
+        ethRaised += ethUsed;
+        tokensSold += tokensOut;
+        lastPrice = price;
+        _transfer(address(this), msg.sender, tokensOut);
+        emit Buy(msg.sender, ethUsed, tokensOut, price);
+
+        if (ethUsed < msg.value) {
+            (bool sent, ) = payable(msg.sender).call{value: msg.value - ethUsed}("");
+            require(sent, "ETH refund failed");
+        }
+    }
+
+    /// @notice Adds the proceeds to the pair at the final price of the auction, callable by anyone once it ended
+    function settle() external nonReentrant {
+        require(ended(), "Auction not ended");
+        require(!settled, "Auction settled");
+        settled = true;
+
+        uint256 finalPrice = tokensSold == auctionSupply ? lastPrice : endPrice;
+        uint256 tokenLiquidity = (ethRaised * UNIT) / finalPrice;
+        if (tokenLiquidity > liquiditySupply) {
+            tokenLiquidity = liquiditySupply;
+        }
+
+        uint256 ethLiquidity = ethRaised;
+        if (ethLiquidity > 0 && tokenLiquidity > 0) {
+            _approve(address(this), address(router), tokenLiquidity);
+            router.addLiquidityETH{value: ethLiquidity}(address(this), tokenLiquidity, tokenLiquidity, 0, creator, block.timestamp);
+        }
+
+        // Unsold auction tokens and the liquidity supply left over are burned
+        uint256 leftover = balanceOf(address(this));
+        if (leftover > 0) {
+            _burn(address(this), leftover);
+        }
+        emit Settled(pair, ethLiquidity, tokenLiquidity, leftover);
+
+        // The router refunds the ETH it did not add, which goes to the creator
+        if (address(this).balance > 0) {
+            (bool sent, ) = payable(creator).call{value: address(this).balance}("");
+            require(sent, "ETH transfer failed");
+        }
+    }
+
+    function _update(address from, address to, uint256 value) internal override {
+        // Only the settlement adds liquidity, which transfers from the auction through the router
+        require(settled || to != pair || from == address(this), "Pair opens at settlement");
+        super._update(from, to, value);
+    }
+
+    receive() external payable {
+        require(msg.sender == address(router), "Use buy");
+    }
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/governance/TimelockController.sol";
+
+/// @title {{.TimelockName}}
+/// @notice Timelock executing the proposals of the Governor once the minimum delay passed. The timelock holds the
+/// treasury and the roles of the governed contracts, deploy_governance grants the Governor the proposer and canceller
+/// roles and renounces the admin role of the deployer so the timelock is its own admin.
+contract {{.TimelockName}} is TimelockController {
+    constructor(uint256 _minDelay, address[] memory _proposers, address[] memory _executors, address _admin)
+        TimelockController(_minDelay, _proposers, _executors, _admin)
+    {}
+}
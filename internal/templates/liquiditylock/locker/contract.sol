@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
+import "@openzeppelin/contracts/utils/ReentrancyGuard.sol";
+
+interface IUniswapV2FactoryPairs {
+    function getPair(address tokenA, address tokenB) external view returns (address pair);
+}
+
+/// @title {{.LockerName}}
+/// @notice Locks the Uniswap V2 LP tokens of the pairs of one factory until an unlock time. The launch sessions mint
+/// the LP tokens of a new pool to the locker and call lockLiquidity in the next transaction, which locks the LP tokens
+/// the locker holds beyond its existing locks for the beneficiary. The beneficiary withdraws them once unlocked.
+contract {{.LockerName}} is ReentrancyGuard {
+    using SafeERC20 for IERC20;
+
+    struct Lock {
+        address lpToken;
+        address beneficiary;
+        uint256 amount;
+        uint256 unlockTime;
+        bool withdrawn;
+    }
+
+    IUniswapV2FactoryPairs public immutable factory;
+    Lock[] public locks;
+    /// @notice LP tokens of each pair held for the locks not withdrawn yet
+    mapping(address => uint256) public lockedBalance;
+
+    event LiquidityLocked(uint256 indexed lockId, address indexed lpToken, address indexed beneficiary, uint256 amount, uint256 unlockTime);
+    event LiquidityWithdrawn(uint256 indexed lockId, address indexed beneficiary, uint256 amount);
+
+    constructor(address _factory) {
+        require(_factory != address(0), "Factory is zero");
+        factory = IUniswapV2FactoryPairs(_factory);
+    }
+
+    /// @notice Locks the LP tokens of the tokenA/tokenB pair minted to the locker and not locked yet
+    function lockLiquidity(address tokenA, address tokenB, address beneficiary, uint256 unlockTime) external nonReentrant returns (uint256 lockId) {
+        require(beneficiary != address(0), "Beneficiary is zero");
+        require(unlockTime > block.timestamp, "Unlock time is in the past");
+        address lpToken = factory.getPair(tokenA, tokenB);
+        require(lpToken != address(0), "Pair not found");
+
+        uint256 amount = IERC20(lpToken).balanceOf(address(this)) - lockedBalance[lpToken];
+        require(amount > 0, "No LP tokens to lock");
+        lockedBalance[lpToken] += amount;
+        locks.push(Lock({lpToken: lpToken, beneficiary: beneficiary, amount: amount, unlockTime: unlockTime, withdrawn: false}));
+        lockId = locks.length - 1;
+        emit LiquidityLocked(lockId, lpToken, beneficiary, amount, unlockTime);
+    }
+
+    /// @notice Sends the LP tokens of an expired lock to its beneficiary
+    function withdraw(uint256 lockId) external nonReentrant {
+        Lock storage lock = locks[lockId];
+        require(msg.sender == lock.beneficiary, "Not the beneficiary");
+        require(block.timestamp >= lock.unlockTime, "Liquidity is locked");
+        require(!lock.withdrawn, "Already withdrawn");
+        lock.withdrawn = true;
+        lockedBalance[lock.lpToken] -= lock.amount;
+        IERC20(lock.lpToken).safeTransfer(lock.beneficiary, lock.amount);
+        emit LiquidityWithdrawn(lockId, lock.beneficiary, lock.amount);
+    }
+
+    function lockCount() external view returns (uint256) {
+        return locks.length;
+    }
+}
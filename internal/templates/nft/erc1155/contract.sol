@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/token/ERC1155/ERC1155.sol";
+import "@openzeppelin/contracts/token/ERC1155/extensions/ERC1155Supply.sol";
+import "@openzeppelin/contracts/utils/ReentrancyGuard.sol";
+import "@openzeppelin/contracts/utils/cryptography/MerkleProof.sol";
+
+/// @title {{.EditionName}}
+/// @notice ERC1155 editions collection of tokenCount token IDs (0 to tokenCount - 1) of up to maxSupplyPerToken copies
+/// each, minted through the mint phases added by the owner. A phase sells copies of any ID at its price from its start
+/// time until its end time (0 for no end), up to maxPerWallet copies per wallet (0 for no limit), to the addresses of
+/// its Merkle allowlist or to everyone when its root is zero. The metadata URI follows the ERC1155 {id} substitution
+/// until the owner freezes it.
+contract {{.EditionName}} is ERC1155Supply, Ownable, ReentrancyGuard {
+    struct MintPhase {
+        uint64 startTime;
+        uint64 endTime;
+        uint256 price;
+        uint32 maxPerWallet;
+        bytes32 merkleRoot;
+    }
+
+    string public name;
+    string public symbol;
+    uint256 public immutable tokenCount;
+    uint256 public immutable maxSupplyPerToken;
+    bool public metadataFrozen;
+    MintPhase[] public mintPhases;
+    mapping(uint256 => mapping(address => uint256)) public mintedInPhase;
+
+    event MintPhaseAdded(uint256 indexed phaseId, uint64 startTime, uint64 endTime, uint256 price, uint32 maxPerWallet, bytes32 merkleRoot);
+    event MetadataFrozen();
+
+    constructor(string memory name_, string memory symbol_, string memory uri_, uint256 tokenCount_, uint256 maxSupplyPerToken_, address owner_) ERC1155(uri_) Ownable(owner_) {
+        require(tokenCount_ > 0, "Token count is zero");
+        require(maxSupplyPerToken_ > 0, "Max supply is zero");
+        name = name_;
+        symbol = symbol_;
+        tokenCount = tokenCount_;
+        maxSupplyPerToken = maxSupplyPerToken_;
+    }
+
+    function addMintPhase(uint64 startTime, uint64 endTime, uint256 price, uint32 maxPerWallet, bytes32 merkleRoot) external onlyOwner {
+        require(endTime == 0 || endTime > startTime, "Phase ends before it starts");
+        if (mintPhases.length > 0) {
+            require(startTime >= mintPhases[mintPhases.length - 1].startTime, "Phases must be added in start time order");
+        }
+        mintPhases.push(MintPhase(startTime, endTime, price, maxPerWallet, merkleRoot));
+        emit MintPhaseAdded(mintPhases.length - 1, startTime, endTime, price, maxPerWallet, merkleRoot);
+    }
+
+    function mintPhaseCount() external view returns (uint256) {
+        return mintPhases.length;
+    }
+
+    /// @notice Returns the phase open at the current time, the latest started one when phases overlap
+    function activePhase() public view returns (uint256 phaseId, bool active) {
+        for (uint256 i = mintPhases.length; i > 0; i--) {
+            MintPhase storage phase = mintPhases[i - 1];
+            if (block.timestamp >= phase.startTime && (phase.endTime == 0 || block.timestamp < phase.endTime)) {
+                return (i - 1, true);
+            }
+        }
+        return (0, false);
+    }
+
+    /// @notice Mints quantity copies of a token ID in the open phase, proof is the Merkle proof of the sender in an
+    /// allowlist phase
+    function mint(uint256 id, uint256 quantity, bytes32[] calldata proof) external payable nonReentrant {
+        (uint256 phaseId, bool active) = activePhase();
+        require(active, "No mint phase is open");
+        MintPhase storage phase = mintPhases[phaseId];
+
+        require(id < tokenCount, "Unknown token ID");
+        require(quantity > 0, "Quantity is zero");
+        require(totalSupply(id) + quantity <= maxSupplyPerToken, "Max supply reached");
+        require(msg.value == phase.price * quantity, "Wrong payment");
+        if (phase.merkleRoot != bytes32(0)) {
+            require(MerkleProof.verify(proof, phase.merkleRoot, keccak256(abi.encodePacked(msg.sender))), "Not on the allowlist");
+        }
+        if (phase.maxPerWallet > 0) {
+            require(mintedInPhase[phaseId][msg.sender] + quantity <= phase.maxPerWallet, "Wallet limit reached");
+        }
+
+        mintedInPhase[phaseId][msg.sender] += quantity;
+        _mint(msg.sender, id, quantity, "");
+    }
+
+    /// @notice Mints outside of the phases, for the team reserve or giveaways
+    function ownerMint(address to, uint256 id, uint256 quantity) external onlyOwner nonReentrant {
+        require(id < tokenCount, "Unknown token ID");
+        require(totalSupply(id) + quantity <= maxSupplyPerToken, "Max supply reached");
+        _mint(to, id, quantity, "");
+    }
+
+    function setURI(string calldata uri_) external onlyOwner {
+        require(!metadataFrozen, "Metadata is frozen");
+        _setURI(uri_);
+    }
+
+    function freezeMetadata() external onlyOwner {
+        metadataFrozen = true;
+        emit MetadataFrozen();
+    }
+
+    function withdraw(address payable to) external onlyOwner {
+        (bool success, ) = to.call{value: address(this).balance}("");
+        require(success, "Withdraw failed");
+    }
+}
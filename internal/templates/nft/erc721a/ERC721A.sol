@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/token/ERC721/IERC721.sol";
+import "@openzeppelin/contracts/token/ERC721/IERC721Receiver.sol";
+import "@openzeppelin/contracts/token/ERC721/extensions/IERC721Metadata.sol";
+import "@openzeppelin/contracts/utils/Strings.sol";
+import "@openzeppelin/contracts/utils/introspection/ERC165.sol";
+
+/// @title ERC721A
+/// @notice Compact ERC721A implementation in the style of the Azuki contract. A batch mint writes the owner of its
+/// first token only, the owner of the following tokens is found by scanning back to the last written ownership, so
+/// minting many tokens costs about as much as minting one. Tokens start at 1 and cannot be burned.
+abstract contract ERC721A is ERC165, IERC721, IERC721Metadata {
+    struct TokenOwnership {
+        address addr;
+        uint64 startTimestamp;
+    }
+
+    struct AddressData {
+        uint64 balance;
+        uint64 numberMinted;
+    }
+
+    string private _name;
+    string private _symbol;
+    uint256 private _currentIndex;
+
+    mapping(uint256 => TokenOwnership) private _ownerships;
+    mapping(address => AddressData) private _addressData;
+    mapping(uint256 => address) private _tokenApprovals;
+    mapping(address => mapping(address => bool)) private _operatorApprovals;
+
+    constructor(string memory name_, string memory symbol_) {
+        _name = name_;
+        _symbol = symbol_;
+        _currentIndex = _startTokenId();
+    }
+
+    function _startTokenId() internal pure virtual returns (uint256) {
+        return 1;
+    }
+
+    function _baseURI() internal view virtual returns (string memory) {
+        return "";
+    }
+
+    function totalSupply() public view returns (uint256) {
+        return _currentIndex - _startTokenId();
+    }
+
+    function _numberMinted(address owner) internal view returns (uint256) {
+        return _addressData[owner].numberMinted;
+    }
+
+    function _exists(uint256 tokenId) internal view returns (bool) {
+        return tokenId >= _startTokenId() && tokenId < _currentIndex;
+    }
+
+    function supportsInterface(bytes4 interfaceId) public view virtual override(ERC165, IERC165) returns (bool) {
+        return interfaceId == type(IERC721).interfaceId || interfaceId == type(IERC721Metadata).interfaceId || super.supportsInterface(interfaceId);
+    }
+
+    function name() public view virtual override returns (string memory) {
+        return _name;
+    }
+
+    function symbol() public view virtual override returns (string memory) {
+        return _symbol;
+    }
+
+    function tokenURI(uint256 tokenId) public view virtual override returns (string memory) {
+        require(_exists(tokenId), "ERC721A: URI query for nonexistent token");
+        string memory baseURI = _baseURI();
+        return bytes(baseURI).length > 0 ? string.concat(baseURI, Strings.toString(tokenId)) : "";
+    }
+
+    function balanceOf(address owner) public view override returns (uint256) {
+        require(owner != address(0), "ERC721A: balance query for the zero address");
+        return _addressData[owner].balance;
+    }
+
+    function ownerOf(uint256 tokenId) public view override returns (address) {
+        return _ownershipOf(tokenId).addr;
+    }
+
+    function _ownershipOf(uint256 tokenId) internal view returns (TokenOwnership memory ownership) {
+        require(_exists(tokenId), "ERC721A: owner query for nonexistent token");
+        // The first token of every batch has its ownership written, the scan stops there at the latest
+        for (uint256 current = tokenId; ; current--) {
+            ownership = _ownerships[current];
+            if (ownership.addr != address(0)) {
+                return ownership;
+            }
+        }
+    }
+
+    function approve(address to, uint256 tokenId) public override {
+        address owner = ownerOf(tokenId);
+        require(to != owner, "ERC721A: approval to current owner");
+        require(msg.sender == owner || isApprovedForAll(owner, msg.sender), "ERC721A: approve caller is not owner nor approved for all");
+        _tokenApprovals[tokenId] = to;
+        emit Approval(owner, to, tokenId);
+    }
+
+    function getApproved(uint256 tokenId) public view override returns (address) {
+        require(_exists(tokenId), "ERC721A: approved query for nonexistent token");
+        return _tokenApprovals[tokenId];
+    }
+
+    function setApprovalForAll(address operator, bool approved) public override {
+        require(operator != msg.sender, "ERC721A: approve to caller");
+        _operatorApprovals[msg.sender][operator] = approved;
+        emit ApprovalForAll(msg.sender, operator, approved);
+    }
+
+    function isApprovedForAll(address owner, address operator) public view override returns (bool) {
+        return _operatorApprovals[owner][operator];
+    }
+
+    function transferFrom(address from, address to, uint256 tokenId) public override {
+        _transfer(from, to, tokenId);
+    }
+
+    function safeTransferFrom(address from, address to, uint256 tokenId) public override {
+        safeTransferFrom(from, to, tokenId, "");
+    }
+
+    function safeTransferFrom(address from, address to, uint256 tokenId, bytes memory data) public override {
+        _transfer(from, to, tokenId);
+        require(_checkOnERC721Received(from, to, tokenId, data), "ERC721A: transfer to non ERC721Receiver implementer");
+    }
+
+    function _safeMint(address to, uint256 quantity) internal {
+        require(to != address(0), "ERC721A: mint to the zero address");
+        require(quantity > 0, "ERC721A: quantity must be greater than 0");
+
+        uint256 startTokenId = _currentIndex;
+        _addressData[to].balance += uint64(quantity);
+        _addressData[to].numberMinted += uint64(quantity);
+        _ownerships[startTokenId] = TokenOwnership(to, uint64(block.timestamp));
+        // The index moves before the receiver hooks run, a reentrant mint starts after this batch
+        _currentIndex = startTokenId + quantity;
+
+        for (uint256 i = 0; i < quantity; i++) {
+            emit Transfer(address(0), to, startTokenId + i);
+            require(_checkOnERC721Received(address(0), to, startTokenId + i, ""), "ERC721A: transfer to non ERC721Receiver implementer");
+        }
+    }
+
+    function _transfer(address from, address to, uint256 tokenId) private {
+        TokenOwnership memory previousOwnership = _ownershipOf(tokenId);
+        require(previousOwnership.addr == from, "ERC721A: transfer from incorrect owner");
+        require(
+            msg.sender == from || isApprovedForAll(from, msg.sender) || _tokenApprovals[tokenId] == msg.sender,
+            "ERC721A: transfer caller is not owner nor approved"
+        );
+        require(to != address(0), "ERC721A: transfer to the zero address");
+
+        delete _tokenApprovals[tokenId];
+        _addressData[from].balance -= 1;
+        _addressData[to].balance += 1;
+        _ownerships[tokenId] = TokenOwnership(to, uint64(block.timestamp));
+
+        // The next token keeps its owner when it was only implied by the ownership of this token
+        uint256 nextTokenId = tokenId + 1;
+        if (_ownerships[nextTokenId].addr == address(0) && _exists(nextTokenId)) {
+            _ownerships[nextTokenId] = previousOwnership;
+        }
+
+        emit Transfer(from, to, tokenId);
+    }
+
+    function _checkOnERC721Received(address from, address to, uint256 tokenId, bytes memory data) private returns (bool) {
+        if (to.code.length == 0) {
+            return true;
+        }
+        try IERC721Receiver(to).onERC721Received(msg.sender, from, tokenId, data) returns (bytes4 retval) {
+            return retval == IERC721Receiver.onERC721Received.selector;
+        } catch (bytes memory reason) {
+            if (reason.length == 0) {
+                revert("ERC721A: transfer to non ERC721Receiver implementer");
+            }
+            assembly {
+                revert(add(32, reason), mload(reason))
+            }
+        }
+    }
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/utils/ReentrancyGuard.sol";
+import "@openzeppelin/contracts/utils/cryptography/MerkleProof.sol";
+import "./ERC721A.sol";
+
+/// @title {{.CollectionName}}
+/// @notice NFT collection minted in batches (ERC721A) through the mint phases added by the owner. A phase sells tokens
+/// at its price from its start time until its end time (0 for no end), up to maxPerWallet tokens per wallet (0 for no
+/// limit), to the addresses of its Merkle allowlist or to everyone when its root is zero. The metadata of a token is
+/// read at baseURI followed by the token ID until the owner freezes it.
+contract {{.CollectionName}} is ERC721A, Ownable, ReentrancyGuard {
+    struct MintPhase {
+        uint64 startTime;
+        uint64 endTime;
+        uint256 price;
+        uint32 maxPerWallet;
+        bytes32 merkleRoot;
+    }
+
+    uint256 public immutable maxSupply;
+    bool public metadataFrozen;
+    MintPhase[] public mintPhases;
+    mapping(uint256 => mapping(address => uint256)) public mintedInPhase;
+
+    string private _baseTokenURI;
+
+    event MintPhaseAdded(uint256 indexed phaseId, uint64 startTime, uint64 endTime, uint256 price, uint32 maxPerWallet, bytes32 merkleRoot);
+    event BaseURIUpdated(string baseURI);
+    event MetadataFrozen();
+
+    constructor(string memory name_, string memory symbol_, string memory baseURI_, uint256 maxSupply_, address owner_) ERC721A(name_, symbol_) Ownable(owner_) {
+        require(maxSupply_ > 0, "Max supply is zero");
+        maxSupply = maxSupply_;
+        _baseTokenURI = baseURI_;
+    }
+
+    function addMintPhase(uint64 startTime, uint64 endTime, uint256 price, uint32 maxPerWallet, bytes32 merkleRoot) external onlyOwner {
+        require(endTime == 0 || endTime > startTime, "Phase ends before it starts");
+        if (mintPhases.length > 0) {
+            require(startTime >= mintPhases[mintPhases.length - 1].startTime, "Phases must be added in start time order");
+        }
+        mintPhases.push(MintPhase(startTime, endTime, price, maxPerWallet, merkleRoot));
+        emit MintPhaseAdded(mintPhases.length - 1, startTime, endTime, price, maxPerWallet, merkleRoot);
+    }
+
+    function mintPhaseCount() external view returns (uint256) {
+        return mintPhases.length;
+    }
+
+    /// @notice Returns the phase open at the current time, the latest started one when phases overlap
+    function activePhase() public view returns (uint256 phaseId, bool active) {
+        for (uint256 i = mintPhases.length; i > 0; i--) {
+            MintPhase storage phase = mintPhases[i - 1];
+            if (block.timestamp >= phase.startTime && (phase.endTime == 0 || block.timestamp < phase.endTime)) {
+                return (i - 1, true);
+            }
+        }
+        return (0, false);
+    }
+
+    /// @notice Mints quantity tokens in the open phase, proof is the Merkle proof of the sender in an allowlist phase
+    function mint(uint256 quantity, bytes32[] calldata proof) external payable nonReentrant {
+        (uint256 phaseId, bool active) = activePhase();
+        require(active, "No mint phase is open");
+        MintPhase storage phase = mintPhases[phaseId];
+
+        require(quantity > 0, "Quantity is zero");
+        require(totalSupply() + quantity <= maxSupply, "Max supply reached");
+        require(msg.value == phase.price * quantity, "Wrong payment");
+        if (phase.merkleRoot != bytes32(0)) {
+            require(MerkleProof.verify(proof, phase.merkleRoot, keccak256(abi.encodePacked(msg.sender))), "Not on the allowlist");
+        }
+        if (phase.maxPerWallet > 0) {
+            require(mintedInPhase[phaseId][msg.sender] + quantity <= phase.maxPerWallet, "Wallet limit reached");
+        }
+
+        mintedInPhase[phaseId][msg.sender] += quantity;
+        _safeMint(msg.sender, quantity);
+    }
+
+    /// @notice Mints outside of the phases, for the team reserve or giveaways
+    function ownerMint(address to, uint256 quantity) external onlyOwner nonReentrant {
+        require(totalSupply() + quantity <= maxSupply, "Max supply reached");
+        _safeMint(to, quantity);
+    }
+
+    function numberMinted(address owner) external view returns (uint256) {
+        return _numberMinted(owner);
+    }
+
+    function setBaseURI(string calldata baseURI_) external onlyOwner {
+        require(!metadataFrozen, "Metadata is frozen");
+        _baseTokenURI = baseURI_;
+        emit BaseURIUpdated(baseURI_);
+    }
+
+    function freezeMetadata() external onlyOwner {
+        metadataFrozen = true;
+        emit MetadataFrozen();
+    }
+
+    function withdraw(address payable to) external onlyOwner {
+        (bool success, ) = to.call{value: address(this).balance}("");
+        require(success, "Withdraw failed");
+    }
+
+    function _baseURI() internal view override returns (string memory) {
+        return _baseTokenURI;
+    }
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
+import "@openzeppelin/contracts/utils/ReentrancyGuard.sol";
+
+/// @title {{.FarmName}}
+/// @notice Staking rewards farm in the style of the Synthetix StakingRewards contract. Stakers of the staking token
+/// earn the rewards token pro rata, the owner funds a reward period with notifyRewardAmount which emits the reward
+/// linearly over the rewards duration.
+contract {{.FarmName}} is Ownable, ReentrancyGuard {
+    using SafeERC20 for IERC20;
+
+    IERC20 public immutable stakingToken;
+    IERC20 public immutable rewardsToken;
+
+    uint256 public rewardsDuration;
+    uint256 public periodFinish;
+    uint256 public rewardRate;
+    uint256 public lastUpdateTime;
+    uint256 public rewardPerTokenStored;
+
+    mapping(address => uint256) public userRewardPerTokenPaid;
+    mapping(address => uint256) public rewards;
+
+    uint256 private _totalSupply;
+    mapping(address => uint256) private _balances;
+
+    event RewardAdded(uint256 reward);
+    event Staked(address indexed user, uint256 amount);
+    event Withdrawn(address indexed user, uint256 amount);
+    event RewardPaid(address indexed user, uint256 reward);
+    event RewardsDurationUpdated(uint256 newDuration);
+
+    constructor(address _stakingToken, address _rewardsToken, uint256 _rewardsDuration, address _owner) Ownable(_owner) {
+        require(_rewardsDuration > 0, "Rewards duration is zero");
+        stakingToken = IERC20(_stakingToken);
+        rewardsToken = IERC20(_rewardsToken);
+        rewardsDuration = _rewardsDuration;
+    }
+
+    modifier updateReward(address _account) {
+        rewardPerTokenStored = rewardPerToken();
+        lastUpdateTime = lastTimeRewardApplicable();
+        if (_account != address(0)) {
+            rewards[_account] = earned(_account);
+            userRewardPerTokenPaid[_account] = rewardPerTokenStored;
+        }
+        _;
+    }
+
+    function totalSupply() external view returns (uint256) {
+        return _totalSupply;
+    }
+
+    function balanceOf(address _account) external view returns (uint256) {
+        return _balances[_account];
+    }
+
+    function lastTimeRewardApplicable() public view returns (uint256) {
+        return block.timestamp < periodFinish ? block.timestamp : periodFinish;
+    }
+
+    function rewardPerToken() public view returns (uint256) {
+        if (_totalSupply == 0) {
+            return rewardPerTokenStored;
+        }
+        return rewardPerTokenStored + ((lastTimeRewardApplicable() - lastUpdateTime) * rewardRate * 1e18) / _totalSupply;
+    }
+
+    function earned(address _account) public view returns (uint256) {
+        return (_balances[_account] * (rewardPerToken() - userRewardPerTokenPaid[_account])) / 1e18 + rewards[_account];
+    }
+
+    function getRewardForDuration() external view returns (uint256) {
+        return rewardRate * rewardsDuration;
+    }
+
+    function stake(uint256 _amount) external nonReentrant updateReward(msg.sender) {
+        require(_amount > 0, "Cannot stake 0");
+        _totalSupply += _amount;
+        _balances[msg.sender] += _amount;
+        stakingToken.safeTransferFrom(msg.sender, address(this), _amount);
+        emit Staked(msg.sender, _amount);
+    }
+
+    function withdraw(uint256 _amount) public nonReentrant updateReward(msg.sender) {
+        require(_amount > 0, "Cannot withdraw 0");
+        _totalSupply -= _amount;
+        _balances[msg.sender] -= _amount;
+        stakingToken.safeTransfer(msg.sender, _amount);
+        emit Withdrawn(msg.sender, _amount);
+    }
+
+    function getReward() public nonReentrant updateReward(msg.sender) {
+        uint256 reward = rewards[msg.sender];
+        if (reward > 0) {
+            rewards[msg.sender] = 0;
+            rewardsToken.safeTransfer(msg.sender, reward);
+            emit RewardPaid(msg.sender, reward);
+        }
+    }
+
+    function exit() external {
+        withdraw(_balances[msg.sender]);
+        getReward();
+    }
+
+    /// @notice Pulls the reward from the owner, who approved it first, and starts a reward period. The leftover of a
+    /// running period is added to the new one
+    function notifyRewardAmount(uint256 _reward) external onlyOwner updateReward(address(0)) {
+        rewardsToken.safeTransferFrom(msg.sender, address(this), _reward);
+        if (block.timestamp >= periodFinish) {
+            rewardRate = _reward / rewardsDuration;
+        } else {
+            uint256 leftover = (periodFinish - block.timestamp) * rewardRate;
+            rewardRate = (_reward + leftover) / rewardsDuration;
+        }
+
+        // The staked tokens are excluded when both tokens are the same
+        uint256 balance = rewardsToken.balanceOf(address(this));
+        if (address(rewardsToken) == address(stakingToken)) {
+            balance -= _totalSupply;
+        }
+        require(rewardRate <= balance / rewardsDuration, "Provided reward too high");
+
+        lastUpdateTime = block.timestamp;
+        periodFinish = block.timestamp + rewardsDuration;
+        emit RewardAdded(_reward);
+    }
+
+    function setRewardsDuration(uint256 _rewardsDuration) external onlyOwner {
+        require(block.timestamp > periodFinish, "Previous rewards period must be complete");
+        require(_rewardsDuration > 0, "Rewards duration is zero");
+        rewardsDuration = _rewardsDuration;
+        emit RewardsDurationUpdated(_rewardsDuration);
+    }
+}
@@ -0,0 +1,265 @@
+// Package templates holds the template packs shipped with the launchpad, imported with import_templates
+package templates
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// PackCrossChain holds the natively cross-chain token templates wired with wire_cross_chain_token
+	PackCrossChain = "cross-chain"
+	// PackGovernance holds the Governor and timelock templates deployed together with deploy_governance
+	PackGovernance = "governance"
+	// PackStaking holds the staking rewards farm deployed and funded with create_staking_pool
+	PackStaking = "staking"
+	// PackNFT holds the NFT collection templates launched with launch_nft_collection
+	PackNFT = "nft"
+	// PackBondingCurve holds the bonding curve token launched with launch_bonding_curve
+	PackBondingCurve = "bonding-curve"
+	// PackDutchAuction holds the Dutch auction token launched with launch_dutch_auction
+	PackDutchAuction = "dutch-auction"
+	// PackLiquidityLock holds the liquidity locker allow-listed by the launch policy with manage_launch_policy
+	PackLiquidityLock = "liquidity-lock"
+)
+
+const (
+	// LayerZeroOFTTemplateName is the LayerZero V2 OFT template of the cross-chain pack
+	LayerZeroOFTTemplateName = "LayerZero OFT Token"
+	// AxelarITSTemplateName is the Axelar Interchain Token Service template of the cross-chain pack
+	AxelarITSTemplateName = "Axelar ITS Token"
+	// TimelockTemplateName is the OpenZeppelin TimelockController template of the governance pack
+	TimelockTemplateName = "Governance Timelock"
+	// GovernorTemplateName is the OpenZeppelin Governor template of the governance pack
+	GovernorTemplateName = "Token Governor"
+	// StakingRewardsTemplateName is the staking rewards farm template of the staking pack
+	StakingRewardsTemplateName = "Staking Rewards"
+	// ERC721ATemplateName is the ERC721A collection template of the NFT pack
+	ERC721ATemplateName = "ERC721A Collection"
+	// ERC1155TemplateName is the ERC1155 editions template of the NFT pack
+	ERC1155TemplateName = "ERC1155 Editions"
+	// BondingCurveTemplateName is the bonding curve token template of the bonding curve pack
+	BondingCurveTemplateName = "Bonding Curve Token"
+	// DutchAuctionTemplateName is the Dutch auction token template of the Dutch auction pack
+	DutchAuctionTemplateName = "Dutch Auction Token"
+	// LiquidityLockerTemplateName is the LP token locker template of the liquidity lock pack
+	LiquidityLockerTemplateName = "Liquidity Locker"
+)
+
+//go:embed crosschain governance staking nft bondingcurve dutchauction liquiditylock
+var packsFS embed.FS
+
+// Pack is a set of built-in templates imported together
+type Pack struct {
+	Name        string
+	Description string
+	Templates   []models.Template
+}
+
+// templateSource locates a built-in template, Dir holds its contract.sol and template files
+type templateSource struct {
+	Name        string
+	Description string
+	Dir         string
+	Metadata    models.JSON
+	Sample      models.JSON
+}
+
+// packSource is a built-in pack and its templates
+type packSource struct {
+	Description string
+	Templates   []templateSource
+}
+
+var crossChainMetadata = models.JSON{"TokenName": "", "TokenSymbol": "", "HomeChainID": ""}
+
+var crossChainSample = models.JSON{"TokenName": "CrossChainToken", "TokenSymbol": "CCT", "HomeChainID": "1"}
+
+var packs = map[string]packSource{
+	PackCrossChain: {
+		Description: "Natively cross-chain tokens (LayerZero OFT, Axelar ITS)",
+		Templates: []templateSource{
+			{
+				Name: LayerZeroOFTTemplateName,
+				Description: "LayerZero V2 Omnichain Fungible Token (OFT). Deploy it on every chain with the LayerZero endpoint of the chain and the initial supply as constructor arguments, " +
+					"the supply is only minted on HomeChainID. wire_cross_chain_token with protocol layerzero sets the peers and enforced options",
+				Dir:      "crosschain/layerzero_oft",
+				Metadata: crossChainMetadata,
+				Sample:   crossChainSample,
+			},
+			{
+				Name: AxelarITSTemplateName,
+				Description: "Axelar Interchain Token Service mint/burn token. Deploy it on every chain with the initial supply as constructor argument, " +
+					"the supply is only minted on HomeChainID. wire_cross_chain_token with protocol axelar registers and links the token and grants the token manager the minter role",
+				Dir:      "crosschain/axelar_its",
+				Metadata: crossChainMetadata,
+				Sample:   crossChainSample,
+			},
+		},
+	},
+	PackGovernance: {
+		Description: "OpenZeppelin Governor with a TimelockController, voting with an ERC20Votes token",
+		Templates: []templateSource{
+			{
+				Name: TimelockTemplateName,
+				Description: "OpenZeppelin TimelockController executing the accepted proposals of the Governor after the minimum delay. " +
+					"Constructor arguments are the minimum delay in seconds, the proposers, the executors and the admin. deploy_governance deploys it with the Governor and sets up the roles",
+				Dir:      "governance/timelock",
+				Metadata: models.JSON{"TimelockName": ""},
+				Sample:   models.JSON{"TimelockName": "LaunchTimelock"},
+			},
+			{
+				Name: GovernorTemplateName,
+				Description: "OpenZeppelin Governor with simple counting, a quorum fraction and timelock control, voting with the delegated votes of an ERC20Votes token. " +
+					"Constructor arguments are the token, the timelock, the voting delay and period in blocks, the proposal threshold and the quorum percentage. deploy_governance deploys it with the timelock and sets up the roles",
+				Dir:      "governance/governor",
+				Metadata: models.JSON{"GovernorName": ""},
+				Sample:   models.JSON{"GovernorName": "LaunchGovernor"},
+			},
+		},
+	},
+	PackStaking: {
+		Description: "Staking rewards farm emitting a reward token to the stakers of a token or LP token",
+		Templates: []templateSource{
+			{
+				Name: StakingRewardsTemplateName,
+				Description: "Synthetix style staking rewards farm. Constructor arguments are the staking token, the rewards token, the rewards duration in seconds and the owner. " +
+					"The owner approves the reward and calls notifyRewardAmount to emit it linearly over the duration, create_staking_pool deploys and funds it in one session",
+				Dir:      "staking/staking_rewards",
+				Metadata: models.JSON{"FarmName": ""},
+				Sample:   models.JSON{"FarmName": "LaunchFarm"},
+			},
+		},
+	},
+	PackNFT: {
+		Description: "NFT collections (ERC721A, ERC1155) sold through mint phases with Merkle allowlists",
+		Templates: []templateSource{
+			{
+				Name: ERC721ATemplateName,
+				Description: "ERC721A collection with batch mints, token metadata at the base URI followed by the token ID. Constructor arguments are the name, the symbol, the base URI, the max supply and the owner. " +
+					"The owner adds the mint phases with addMintPhase, launch_nft_collection deploys it with its phases in one session",
+				Dir:      "nft/erc721a",
+				Metadata: models.JSON{"CollectionName": ""},
+				Sample:   models.JSON{"CollectionName": "LaunchCollection"},
+			},
+			{
+				Name: ERC1155TemplateName,
+				Description: "ERC1155 editions of a number of token IDs with a max supply per ID, metadata at the URI with the {id} substitution. Constructor arguments are the name, the symbol, the URI, the token count, the max supply per token and the owner. " +
+					"The owner adds the mint phases with addMintPhase, launch_nft_collection deploys it with its phases in one session",
+				Dir:      "nft/erc1155",
+				Metadata: models.JSON{"EditionName": ""},
+				Sample:   models.JSON{"EditionName": "LaunchEditions"},
+			},
+		},
+	},
+	PackBondingCurve: {
+		Description: "Token sold along a bonding curve, its liquidity migrates to Uniswap V2 once the curve is sold out",
+		Templates: []templateSource{
+			{
+				Name: BondingCurveTemplateName,
+				Description: "ERC20 sold for ETH along a constant product curve with virtual reserves, the ETH raised and the rest of the supply are added to a Uniswap V2 pair when the curve supply is sold out. " +
+					"Constructor arguments are the name, the symbol, the total supply, the curve supply, the graduation threshold in wei, the Uniswap V2 router and the creator, the ETH sent is an initial buy of the creator. " +
+					"launch_bonding_curve deploys it",
+				Dir:      "bondingcurve/curve_token",
+				Metadata: models.JSON{"CurveName": ""},
+				Sample:   models.JSON{"CurveName": "LaunchCurve"},
+			},
+		},
+	},
+	PackDutchAuction: {
+		Description: "Token sold in a Dutch auction with a decaying price, the proceeds are settled into a Uniswap V2 pool once the auction ends",
+		Templates: []templateSource{
+			{
+				Name: DutchAuctionTemplateName,
+				Description: "ERC20 sold for ETH at a price decaying from a start price to an end price between a start and an end time, continuously or in steps, like a liquidity bootstrapping pool. " +
+					"Once sold out or ended, settle adds the ETH raised with tokens at the final price to a Uniswap V2 pair, the LP tokens go to the creator and the unsold tokens are burned. " +
+					"Constructor arguments are the name, the symbol, the total supply, the auction supply, the start and end prices in wei per token, the start and end unix times, the step duration in seconds, the Uniswap V2 router and the creator. " +
+					"launch_dutch_auction deploys it",
+				Dir:      "dutchauction/auction_token",
+				Metadata: models.JSON{"AuctionName": ""},
+				Sample:   models.JSON{"AuctionName": "LaunchAuction"},
+			},
+		},
+	},
+	PackLiquidityLock: {
+		Description: "Locker of the Uniswap V2 LP tokens of new pools, required by the liquidity lock rule of the launch policy",
+		Templates: []templateSource{
+			{
+				Name: LiquidityLockerTemplateName,
+				Description: "Locks the LP tokens of the pairs of a Uniswap V2 factory for a beneficiary until an unlock time with lockLiquidity(tokenA, tokenB, beneficiary, unlockTime), the beneficiary withdraws them once unlocked. " +
+					"The constructor argument is the factory. Deploy it once per chain and set it as liquidity_locker_address with manage_launch_policy, the liquidity tools mint the LP tokens of a locked addition to it and lock them in the same session",
+				Dir:      "liquiditylock/locker",
+				Metadata: models.JSON{"LockerName": ""},
+				Sample:   models.JSON{"LockerName": "LaunchLiquidityLocker"},
+			},
+		},
+	},
+}
+
+// PackNames returns the names of the built-in packs
+func PackNames() []string {
+	return []string{PackCrossChain, PackGovernance, PackStaking, PackNFT, PackBondingCurve, PackDutchAuction, PackLiquidityLock}
+}
+
+// GetPack reads the templates of a built-in pack
+func GetPack(name string) (Pack, error) {
+	source, ok := packs[name]
+	if !ok {
+		return Pack{}, fmt.Errorf("unknown template pack %q, available packs: %s", name, strings.Join(PackNames(), ", "))
+	}
+
+	pack := Pack{
+		Name:        name,
+		Description: source.Description,
+	}
+	for _, templateSource := range source.Templates {
+		template, err := readTemplate(templateSource)
+		if err != nil {
+			return Pack{}, err
+		}
+		template.Metadata = templateSource.Metadata
+		template.SampleTemplateValues = templateSource.Sample
+		pack.Templates = append(pack.Templates, template)
+	}
+	return pack, nil
+}
+
+func readTemplate(source templateSource) (models.Template, error) {
+	template := models.Template{
+		Name:        source.Name,
+		Description: source.Description,
+		ChainType:   models.TransactionChainTypeEthereum,
+	}
+	err := fs.WalkDir(packsFS, source.Dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || path.Ext(filePath) != ".sol" {
+			return err
+		}
+		content, err := packsFS.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(filePath, source.Dir+"/")
+		if name == utils.TemplateMainFile {
+			template.TemplateCode = string(content)
+			return nil
+		}
+		if template.Files == nil {
+			template.Files = models.TemplateFiles{}
+		}
+		template.Files[name] = string(content)
+		return nil
+	})
+	if err != nil {
+		return template, fmt.Errorf("failed to read template %s: %w", source.Name, err)
+	}
+	if template.TemplateCode == "" {
+		return template, fmt.Errorf("template %s has no contract.sol", source.Name)
+	}
+	return template, nil
+}
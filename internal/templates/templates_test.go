@@ -0,0 +1,59 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPack(t *testing.T) {
+	for _, name := range PackNames() {
+		pack, err := GetPack(name)
+		require.NoError(t, err)
+		require.NotEmpty(t, pack.Templates)
+
+		for _, template := range pack.Templates {
+			t.Run(template.Name, func(t *testing.T) {
+				assert.NotEmpty(t, template.TemplateCode)
+				// Templates only reference their declared metadata
+				require.NoError(t, utils.ValidateTemplateKeys(template.TemplateCode, template.Metadata))
+				_, err := utils.RenderContractTemplate(template.TemplateCode, template.SampleTemplateValues)
+				assert.NoError(t, err)
+			})
+		}
+	}
+
+	pack, err := GetPack(PackCrossChain)
+	require.NoError(t, err)
+	assert.Contains(t, pack.Templates[0].Files, "interfaces/ILayerZeroEndpointV2.sol")
+	pack, err = GetPack(PackGovernance)
+	require.NoError(t, err)
+	assert.Equal(t, TimelockTemplateName, pack.Templates[0].Name)
+	assert.Contains(t, pack.Templates[1].TemplateCode, "GovernorTimelockControl")
+	pack, err = GetPack(PackStaking)
+	require.NoError(t, err)
+	require.Len(t, pack.Templates, 1)
+	assert.Contains(t, pack.Templates[0].TemplateCode, "function notifyRewardAmount")
+	pack, err = GetPack(PackNFT)
+	require.NoError(t, err)
+	require.Len(t, pack.Templates, 2)
+	assert.Contains(t, pack.Templates[0].Files, "ERC721A.sol")
+	assert.Contains(t, pack.Templates[1].TemplateCode, "function addMintPhase")
+	pack, err = GetPack(PackBondingCurve)
+	require.NoError(t, err)
+	require.Len(t, pack.Templates, 1)
+	assert.Contains(t, pack.Templates[0].TemplateCode, "function curveState()")
+	pack, err = GetPack(PackDutchAuction)
+	require.NoError(t, err)
+	require.Len(t, pack.Templates, 1)
+	assert.Contains(t, pack.Templates[0].TemplateCode, "function auctionState()")
+	pack, err = GetPack(PackLiquidityLock)
+	require.NoError(t, err)
+	require.Len(t, pack.Templates, 1)
+	assert.Contains(t, pack.Templates[0].TemplateCode, "function lockLiquidity(address tokenA, address tokenB, address beneficiary, uint256 unlockTime)")
+
+	_, err = GetPack("unknown")
+	assert.Error(t, err)
+}
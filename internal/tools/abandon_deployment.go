@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type abandonDeploymentTool struct {
+	deploymentService services.DeploymentService
+	evmService        services.EvmService
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type AbandonDeploymentArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	Cleanup string `json:"cleanup,omitempty" validate:"omitempty,oneof=self_destruct renounce_ownership"`
+}
+
+func NewAbandonDeploymentTool(deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, serverPort int) *abandonDeploymentTool {
+	return &abandonDeploymentTool{
+		deploymentService: deploymentService,
+		evmService:        evmService,
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (a *abandonDeploymentTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("abandon_deployment",
+		mcp.WithDescription("Abandon a deployment that should not be launched. A deployment not confirmed on chain is deleted and its pending session cancelled. "+
+			"A confirmed contract stays on chain: pass cleanup to create a session calling its self-destruct function (since the Cancun upgrade it only withdraws the balance, the code stays) or renounceOwnership so nobody can call its admin functions."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the deployment to abandon"),
+		),
+		mcp.WithString("cleanup",
+			mcp.Description("On-chain cleanup of a confirmed deployment. Optional, required for confirmed deployments"),
+			mcp.Enum(string(utils.CleanupSelfDestruct), string(utils.CleanupRenounceOwnership)),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (a *abandonDeploymentTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args AbandonDeploymentArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		id, err := strconv.ParseUint(args.DeploymentID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid deployment_id format: %v", err)), nil
+		}
+		deployment, err := a.deploymentService.GetDeploymentByID(uint(id))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment not found: %v", err)), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (deployment.UserID == nil || *deployment.UserID != userID) {
+			return mcp.NewToolResultError("Deployment not found"), nil
+		}
+
+		if deployment.Status != models.TransactionStatusConfirmed {
+			if args.Cleanup != "" {
+				return mcp.NewToolResultError(fmt.Sprintf("Deployment %d is %s, there is no contract on chain to clean up", deployment.ID, deployment.Status)), nil
+			}
+			return a.deletePendingDeployment(deployment)
+		}
+
+		if args.Cleanup == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment %d is confirmed at %s, pass cleanup to self-destruct it or renounce its ownership", deployment.ID, deployment.ContractAddress)), nil
+		}
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("On-chain cleanup is only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+		if deployment.Template.Abi == nil {
+			return mcp.NewToolResultError("Template does not have ABI information"), nil
+		}
+		abiString, err := utils.GetAbiString(deployment.Template.Abi)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read template ABI: %v", err)), nil
+		}
+		action := utils.CleanupAction(args.Cleanup)
+		functionName, err := utils.FindCleanupFunction(abiString, action)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		title := "Self-Destruct Contract"
+		description := fmt.Sprintf("Call %s on contract %s, the balance of the contract is withdrawn", functionName, deployment.ContractAddress)
+		txType := models.TransactionTypeDeploymentCleanup
+		if action == utils.CleanupRenounceOwnership {
+			title = "Renounce Ownership"
+			description = fmt.Sprintf("Call %s on contract %s, nobody can call its owner functions afterwards", functionName, deployment.ContractAddress)
+			// recorded on the deployment by the ownership renounce hook
+			txType = models.TransactionTypeOwnershipRenounce
+		}
+		tx, err := a.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: deployment.ContractAddress,
+			FunctionName:    functionName,
+			FunctionArgs:    []any{},
+			Abi:             abiString,
+			Value:           "0",
+			Title:           title,
+			Description:     description,
+			TransactionType: txType,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create %s transaction: %v", functionName, err)), nil
+		}
+		tx.ContractAddress = &deployment.ContractAddress
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+		sessionID, err := a.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{tx},
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                deployment.ChainID,
+			Metadata: []models.TransactionMetadata{
+				{Key: "deployment_id", Value: strconv.FormatUint(uint64(deployment.ID), 10)},
+				{Key: "cleanup", Value: args.Cleanup},
+			},
+			UserID:  userId,
+			Context: ctx,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, a.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Cleanup session created: %s", sessionID)),
+				mcp.NewTextContent(description),
+				mcp.NewTextContent("Please sign the transaction in the URL with the owner of the contract:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// deletePendingDeployment cancels the pending session of the deployment, which deletes the pending records of the
+// session, then deletes the deployment in case its session already expired or failed
+func (a *abandonDeploymentTool) deletePendingDeployment(deployment *models.Deployment) (*mcp.CallToolResult, error) {
+	message := fmt.Sprintf("Deployment %d deleted", deployment.ID)
+	if deployment.SessionId != "" {
+		sessions, err := a.txService.ListTransactionSessionsByIDs([]string{deployment.SessionId})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		if len(sessions) > 0 && sessions[0].TransactionStatus == models.TransactionStatusPending {
+			result, err := a.txService.CancelTransactionSession(deployment.SessionId)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel session %s: %v", deployment.SessionId, err)), nil
+			}
+			message = fmt.Sprintf("Deployment %d deleted and session %s cancelled, deleting %d pending deployments and %d pending liquidity pools",
+				deployment.ID, deployment.SessionId, len(result.DeletedDeploymentIDs), len(result.DeletedLiquidityPoolIDs))
+		}
+	}
+
+	if err := a.deploymentService.DeleteDeployment(deployment.ID); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to delete deployment: %v", err)), nil
+	}
+	return mcp.NewToolResultText(message), nil
+}
@@ -0,0 +1,108 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const abandonDeploymentTemplateAbi = `[
+	{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"renounceOwnership","stateMutability":"nonpayable","inputs":[],"outputs":[]}
+]`
+
+func TestAbandonDeployment(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	var abiArray []any
+	require.NoError(t, json.Unmarshal([]byte(abandonDeploymentTemplateAbi), &abiArray))
+	template := &models.Template{Name: "Ownable Token", ChainType: models.TransactionChainTypeEthereum, Abi: models.JSON(map[string]any{"abi": abiArray})}
+	require.NoError(t, services.NewTemplateService(db).CreateTemplate(template))
+
+	txService := services.NewTransactionService(db)
+	deploymentService := services.NewDeploymentService(db)
+	owner := "user-1"
+	handler := NewAbandonDeploymentTool(deploymentService, services.NewEvmService(), txService, 8080).GetHandler()
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	// a pending deployment is deleted with its session cancelled
+	sessionID, err := txService.CreateTransactionSessionWithUser(services.CreateTransactionSessionRequest{
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+		TransactionDeployments: []models.TransactionDeployment{{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeTokenDeployment}},
+	}, &owner)
+	require.NoError(t, err)
+	pending := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, UserID: &owner, SessionId: sessionID, Status: models.TransactionStatusPending}
+	require.NoError(t, deploymentService.CreateDeployment(pending))
+	pendingID := strconv.FormatUint(uint64(pending.ID), 10)
+
+	result := call(map[string]any{"deployment_id": pendingID, "cleanup": "renounce_ownership"})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "there is no contract on chain to clean up")
+
+	result = call(map[string]any{"deployment_id": pendingID})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "session "+sessionID+" cancelled")
+	_, err = deploymentService.GetDeploymentByID(pending.ID)
+	assert.Error(t, err)
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusCancelled, session.TransactionStatus)
+
+	// a confirmed deployment needs an on-chain cleanup the contract supports
+	confirmed := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, UserID: &owner, Status: models.TransactionStatusConfirmed, ContractAddress: "0x1111111111111111111111111111111111111111"}
+	require.NoError(t, deploymentService.CreateDeployment(confirmed))
+	confirmedID := strconv.FormatUint(uint64(confirmed.ID), 10)
+	result = call(map[string]any{"deployment_id": confirmedID})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "pass cleanup")
+
+	result = call(map[string]any{"deployment_id": confirmedID, "cleanup": "self_destruct"})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "no self-destruct function")
+
+	result = call(map[string]any{"deployment_id": confirmedID, "cleanup": "renounce_ownership"})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Cleanup session created")
+
+	sessions, err := txService.ListTransactionSessionsByUser(owner)
+	require.NoError(t, err)
+	var cleanup *models.TransactionSession
+	for i := range sessions {
+		if sessions[i].ID != sessionID {
+			cleanup = &sessions[i]
+		}
+	}
+	require.NotNil(t, cleanup)
+	require.Len(t, cleanup.TransactionDeployments, 1)
+	assert.Equal(t, models.TransactionTypeOwnershipRenounce, cleanup.TransactionDeployments[0].TransactionType)
+	assert.Equal(t, confirmed.ContractAddress, cleanup.TransactionDeployments[0].Receiver)
+	// the selector of renounceOwnership()
+	assert.Equal(t, "0x715018a6", cleanup.TransactionDeployments[0].Data)
+	_, err = deploymentService.GetDeploymentByID(confirmed.ID)
+	assert.NoError(t, err)
+
+	other := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	result, err = handler(other, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"deployment_id": confirmedID, "cleanup": "renounce_ownership"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "Deployment not found", result.Content[0].(mcp.TextContent).Text)
+}
@@ -78,6 +78,7 @@ func (a *addDeploymentTool) GetTool() mcp.Tool {
 		mcp.WithString("description",
 			mcp.Description("Description for the auto-created template"),
 		),
+		withIdempotencyKey(),
 	)
 
 	return tool
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
-	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -17,12 +16,13 @@ import (
 )
 
 type addLiquidityTool struct {
-	chainService     services.ChainService
-	evmService       services.EvmService
-	txService        services.TransactionService
-	liquidityService services.LiquidityService
-	uniswapService   services.UniswapService
-	serverPort       int
+	chainService        services.ChainService
+	evmService          services.EvmService
+	txService           services.TransactionService
+	liquidityService    services.LiquidityService
+	uniswapService      services.UniswapService
+	launchPolicyService services.LaunchPolicyService
+	serverPort          int
 }
 
 type AddLiquidityArguments struct {
@@ -35,23 +35,30 @@ type AddLiquidityArguments struct {
 	OwnerAddress   string `json:"owner_address" validate:"required"`
 
 	// Optional fields
-	Metadata []models.TransactionMetadata `json:"metadata,omitempty"`
+	MevProtection   bool                         `json:"mev_protection,omitempty"`
+	Metadata        []models.TransactionMetadata `json:"metadata,omitempty"`
+	DexDeploymentID string                       `json:"dex_deployment_id,omitempty"`
+	DryRun          bool                         `json:"dry_run,omitempty"`
+	LiquidityLockArguments
+	RouterTransactionOverrides
 }
 
-func NewAddLiquidityTool(chainService services.ChainService, serverPort int, evmService services.EvmService, txService services.TransactionService, liquidityService services.LiquidityService, uniswapService services.UniswapService) *addLiquidityTool {
+func NewAddLiquidityTool(chainService services.ChainService, serverPort int, evmService services.EvmService, txService services.TransactionService, liquidityService services.LiquidityService, uniswapService services.UniswapService, launchPolicyService services.LaunchPolicyService) *addLiquidityTool {
 	return &addLiquidityTool{
-		chainService:     chainService,
-		evmService:       evmService,
-		txService:        txService,
-		liquidityService: liquidityService,
-		uniswapService:   uniswapService,
-		serverPort:       serverPort,
+		chainService:        chainService,
+		evmService:          evmService,
+		txService:           txService,
+		liquidityService:    liquidityService,
+		uniswapService:      uniswapService,
+		launchPolicyService: launchPolicyService,
+		serverPort:          serverPort,
 	}
 }
 
 func (a *addLiquidityTool) GetTool() mcp.Tool {
 	tool := mcp.NewTool("add_liquidity",
-		mcp.WithDescription("Add liquidity to existing Uniswap pool with signing interface. Generates a URL where users can connect wallet and sign the liquidity addition transaction."),
+		mcp.WithDescription("Add liquidity to existing Uniswap pool with signing interface. Generates a URL where users can connect wallet and sign the liquidity addition transaction. "+
+			"The liquidity lock rule of the launch policy applies to the pool size after the addition, pass lock_days when it requires a lock."),
 		mcp.WithString("token_address",
 			mcp.Required(),
 			mcp.Description("Address of the token in the pool"),
@@ -76,6 +83,9 @@ func (a *addLiquidityTool) GetTool() mcp.Tool {
 			mcp.Required(),
 			mcp.Description("Address that will receive the liquidity pool tokens. Ask user to provide this address."),
 		),
+		mcp.WithBoolean("mev_protection",
+			mcp.Description("Submit the signed add liquidity transaction through the private relay of the chain (e.g. Flashbots Protect) instead of the public mempool. Requires private_relay_rpc to be configured with set_chain. Optional, defaults to false"),
+		),
 		mcp.WithArray("metadata",
 			mcp.Description("JSON array of metadata for the transaction (e.g., [{\"key\": \"Liquidity Action\", \"value\": \"Add Liquidity\"}]). Optional."),
 			mcp.Items(map[string]any{
@@ -89,7 +99,16 @@ func (a *addLiquidityTool) GetTool() mcp.Tool {
 				},
 			}),
 		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description(dexDeploymentIDDescription),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
 	)
+
+	for _, option := range append(liquidityLockOptions(), routerOverrideOptions()...) {
+		option(&tool)
+	}
 	return tool
 }
 
@@ -105,7 +124,7 @@ func (a *addLiquidityTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get active chain configuration
-		activeChain, err := a.chainService.GetActiveChain()
+		activeChain, err := a.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
@@ -121,8 +140,21 @@ func (a *addLiquidityTool) GetHandler() server.ToolHandlerFunc {
 }
 
 func (a *addLiquidityTool) createEthereumAddLiquidity(ctx context.Context, args AddLiquidityArguments, activeChain *models.Chain) (*mcp.CallToolResult, error) {
+	// Get the active Uniswap settings
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	// The liquidity is added through the router of the deployment whose factory created the pool
+	uniswapDeployment, err := selectDexDeployment(a.uniswapService, userId, activeChain, args.DexDeploymentID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	// Check if pool exists
-	pool, err := a.liquidityService.GetLiquidityPoolByTokenAddress(args.TokenAddress, "")
+	pool, err := a.liquidityService.GetLiquidityPoolOnDexDeployment(args.TokenAddress, "", *uniswapDeployment)
 	if err != nil {
 		return mcp.NewToolResultError("Liquidity pool not found. Please create a pool first using create_liquidity_pool tool"), nil
 	}
@@ -137,38 +169,37 @@ func (a *addLiquidityTool) createEthereumAddLiquidity(ctx context.Context, args
 		return mcp.NewToolResultError("Liquidity pool does not have a pair address. Please ensure the pool was created successfully"), nil
 	}
 
-	// Get the active Uniswap settings
-	user, _ := utils.GetAuthenticatedUser(ctx)
-	var userId *string
-	if user != nil {
-		userId = &user.Sub
-	}
-
-	// get active chain
-	chain, err := a.chainService.GetActiveChain()
-	if err != nil {
-		return mcp.NewToolResultError("Unable to get active chain. Is there any chain selected?"), nil
+	// Verify router address is available
+	if uniswapDeployment.RouterAddress == "" {
+		return mcp.NewToolResultError("Uniswap router address not found. Please ensure Uniswap deployment is completed"), nil
 	}
 
-	// Verify Uniswap settings exist
-	_, err = a.uniswapService.GetActiveUniswapDeployment(userId, *chain)
+	overrides, err := parseRouterOverrides(args.RouterTransactionOverrides)
 	if err != nil {
-		return mcp.NewToolResultError("No Uniswap version selected. Please use set_uniswap_version tool first"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	// Get Uniswap deployment to retrieve router address
-	uniswapDeployment, err := a.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+	// Enforce the liquidity lock rule of the launch policy on the ETH reserve of the pool after the addition
+	lockPlan, err := planLiquidityLock(a.launchPolicyService, activeChain.RPC, args.LiquidityLockArguments, func() (*big.Int, error) {
+		ethAmount, ok := new(big.Int).SetString(args.ETHAmount, 10)
+		if !ok {
+			return nil, fmt.Errorf("Invalid eth_amount: %q", args.ETHAmount)
+		}
+		position, err := utils.ReadV2PairPosition(activeChain.RPC, pool.PairAddress, args.OwnerAddress)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read the reserves of pool %d: %v", pool.ID, err)
+		}
+		if reserve := position.ReserveOf(uniswapDeployment.WETHAddress); reserve != nil {
+			ethAmount.Add(ethAmount, reserve)
+		}
+		return ethAmount, nil
+	})
 	if err != nil {
-		return mcp.NewToolResultError("No Uniswap deployment found for this chain. Please deploy Uniswap first using deploy_uniswap tool"), nil
-	}
-
-	// Verify router address is available
-	if uniswapDeployment.RouterAddress == "" {
-		return mcp.NewToolResultError("Uniswap router address not found. Please ensure Uniswap deployment is completed"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	// Prepare enhanced metadata
-	enhancedMetadata := a.prepareMetadata(args.Metadata, pool)
+	enhancedMetadata := lockPlan.metadata(a.prepareMetadata(args.Metadata, pool))
 
 	// Create transaction deployments for adding liquidity
 	transactionDeployments, err := a.createEthereumAddLiquidityTransactions(
@@ -179,34 +210,71 @@ func (a *addLiquidityTool) createEthereumAddLiquidity(ctx context.Context, args
 		args.ETHAmount,
 		args.MinTokenAmount,
 		args.MinETHAmount,
-		args.OwnerAddress,
+		lockPlan.lpReceiver(args.OwnerAddress),
+		overrides.Deadline,
 	)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Error creating add liquidity transactions: %v", err)), nil
 	}
+	if lockPlan.Lock != nil {
+		lockTx, err := createLiquidityLockTransaction(a.evmService, lockPlan.Lock, pool.TokenAddress, uniswapDeployment.WETHAddress, args.OwnerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity lock transaction: %v", err)), nil
+		}
+		transactionDeployments = append(transactionDeployments, lockTx)
+	}
 
-	// Create transaction session with the add liquidity transactions
-	sessionID, err := a.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+	// The token approval is left out of the session when the allowance of the owner already covers it
+	transactionDeployments, skippedApprovals := omitSufficientApprovals(activeChain.RPC, args.OwnerAddress, transactionDeployments, map[string]string{
+		pool.TokenAddress: args.TokenAmount,
+	})
+	enhancedMetadata = skippedApprovalsMetadata(enhancedMetadata, skippedApprovals)
+
+	overrides.apply(transactionDeployments)
+
+	if args.MevProtection {
+		if err := applyMevProtection(activeChain, transactionDeployments); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   mevProtectionMetadataKey,
+			Value: "enabled",
+		})
+	}
+
+	session := services.CreateTransactionSessionRequest{
 		TransactionDeployments: transactionDeployments,
 		ChainType:              models.TransactionChainTypeEthereum,
 		ChainID:                activeChain.ID,
 		Metadata:               enhancedMetadata,
 		UserID:                 userId,
-	})
+	}
+	if args.DryRun {
+		return newDryRunResult("add_liquidity", []services.CreateTransactionSessionRequest{session})
+	}
+
+	session.Context = ctx
+
+	// Create transaction session with the add liquidity transactions
+	sessionID, err := a.txService.CreateTransactionSession(session)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Error creating transaction session: %v", err)), nil
 	}
 
-	url, err := utils.GetTransactionSessionUrl(a.serverPort, sessionID)
+	url, err := utils.GetTransactionSessionUrl(ctx, a.serverPort, sessionID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
 	}
+	poolUrl, err := utils.GetPoolPageUrl(ctx, a.serverPort, pool.ID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get pool page url: %v", err)), nil
+	}
 
 	// Return success with URL
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			mcp.NewTextContent(fmt.Sprintf("Transaction session created: %s", sessionID)),
-			mcp.NewTextContent("Please sign the add liquidity transactions in the URL"),
+			mcp.NewTextContent(fmt.Sprintf("Please sign the add liquidity transactions in the URL. Pool details: %s", poolUrl)),
 			mcp.NewTextContent(url),
 		},
 	}, nil
@@ -253,7 +321,7 @@ func (a *addLiquidityTool) prepareMetadata(
 // minTokenAmount is the minimum amount of tokens (slippage protection)
 // minETHAmount is the minimum amount of ETH (slippage protection)
 // ownerAddress is the address that will receive the liquidity pool tokens
-func (a *addLiquidityTool) createEthereumAddLiquidityTransactions(routerAddress, tokenAddress, wethAddress, tokenAmount, ethAmount, minTokenAmount, minETHAmount, ownerAddress string) ([]models.TransactionDeployment, error) {
+func (a *addLiquidityTool) createEthereumAddLiquidityTransactions(routerAddress, tokenAddress, wethAddress, tokenAmount, ethAmount, minTokenAmount, minETHAmount, ownerAddress string, deadline int64) ([]models.TransactionDeployment, error) {
 	// Get Uniswap V2 contracts to extract Router ABI
 	v2Contracts, err := utils.FetchUniswapV2Contracts()
 	if err != nil {
@@ -273,9 +341,6 @@ func (a *addLiquidityTool) createEthereumAddLiquidityTransactions(routerAddress,
 	maxUint256 := new(big.Int)
 	maxUint256.SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
 
-	// Calculate deadline (10 minutes from now)
-	deadline := time.Now().Unix() + 600
-
 	var transactionDeployments []models.TransactionDeployment
 
 	functionArgs := []any{routerAddress, maxUint256.String()}
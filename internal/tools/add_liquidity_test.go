@@ -99,6 +99,7 @@ func (suite *AddLiquidityTestSuite) SetupSuite() {
 		suite.txService,
 		suite.liquidityService,
 		suite.uniswapService,
+		services.NewLaunchPolicyService(db.GetDB()),
 	)
 
 	// Setup test data
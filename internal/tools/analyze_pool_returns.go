@@ -0,0 +1,264 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// defaultPoolReturnsWindow is the window analyzed when since is not given
+const defaultPoolReturnsWindow = 7 * 24 * time.Hour
+
+type analyzePoolReturnsTool struct {
+	chainService     services.ChainService
+	liquidityService services.LiquidityService
+	uniswapService   services.UniswapService
+	indexerService   services.IndexerService
+}
+
+type AnalyzePoolReturnsArguments struct {
+	// Required fields
+	PoolID string `json:"pool_id" validate:"required"`
+
+	// Optional fields
+	Since         string `json:"since,omitempty"`
+	OwnerAddress  string `json:"owner_address,omitempty"`
+	DepositAmount string `json:"deposit_amount,omitempty"`
+}
+
+// PoolReturnsReport is the result of analyze_pool_returns
+type PoolReturnsReport struct {
+	PoolID      uint   `json:"pool_id"`
+	PairAddress string `json:"pair_address"`
+	QuoteToken  string `json:"quote_token"`
+	// VolumeSource is indexer when the swaps were read from the event indexer, pool snapshots otherwise
+	VolumeSource string                     `json:"volume_source"`
+	Returns      *utils.PoolReturns         `json:"returns"`
+	Positions    []*utils.LPPositionReturns `json:"positions"`
+}
+
+func NewAnalyzePoolReturnsTool(chainService services.ChainService, liquidityService services.LiquidityService, uniswapService services.UniswapService, indexerService services.IndexerService) *analyzePoolReturnsTool {
+	return &analyzePoolReturnsTool{
+		chainService:     chainService,
+		liquidityService: liquidityService,
+		uniswapService:   uniswapService,
+		indexerService:   indexerService,
+	}
+}
+
+func (a *analyzePoolReturnsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("analyze_pool_returns",
+		mcp.WithDescription("Compute the fee APR and the impermanent loss of a Uniswap V2 pool of the active chain over a time window, and the returns of an LP position held over it. "+
+			"The swap volume is read from the event indexer once it has indexed the pair, before that from the swaps recorded by the launchpad; the reserves come from the pool snapshots. "+
+			"Fees are 0.3% of the quote token volume. Amounts are in base units of the quote token. This is a read-only operation."),
+		mcp.WithString("pool_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed liquidity pool"),
+		),
+		mcp.WithString("since",
+			mcp.Description("Start of the window as an RFC 3339 time (e.g. 2025-01-02T15:04:05Z) or a duration before now (e.g. 24h). Optional, defaults to 168h, the window never starts before the pool was funded"),
+		),
+		mcp.WithString("owner_address",
+			mcp.Description("Address whose actual position is analyzed, from its indexed LP token balance. Its current share of the pool is assumed over the whole window. Optional"),
+		),
+		mcp.WithString("deposit_amount",
+			mcp.Description("Quote token amount in base units of a hypothetical position deposited at the start of the window with the matching amount of tokens. Optional"),
+		),
+	)
+
+	return tool
+}
+
+func (a *analyzePoolReturnsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args AnalyzePoolReturnsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		until := time.Now().UTC()
+		since := until.Add(-defaultPoolReturnsWindow)
+		if args.Since != "" {
+			if parsed, err := time.Parse(time.RFC3339, args.Since); err == nil {
+				since = parsed
+			} else if duration, err := time.ParseDuration(args.Since); err == nil && duration > 0 {
+				since = until.Add(-duration)
+			} else {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid since %q: must be an RFC 3339 time or a duration such as 24h", args.Since)), nil
+			}
+		}
+		if args.OwnerAddress != "" && !utils.IsValidEthereumAddress(args.OwnerAddress) {
+			return mcp.NewToolResultError("Owner address is not a valid Ethereum address"), nil
+		}
+		var deposit *big.Int
+		if args.DepositAmount != "" {
+			amount, ok := new(big.Int).SetString(args.DepositAmount, 10)
+			if !ok || amount.Sign() <= 0 {
+				return mcp.NewToolResultError("Invalid deposit_amount: must be a positive amount in base units"), nil
+			}
+			deposit = amount
+		}
+
+		activeChain, err := a.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Pool returns are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		poolID, err := strconv.ParseUint(args.PoolID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid pool_id format: %v", err)), nil
+		}
+		pool, err := a.liquidityService.GetLiquidityPool(uint(poolID))
+		if err != nil {
+			return mcp.NewToolResultError("Liquidity pool not found"), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (pool.UserID == nil || *pool.UserID != userID) {
+			return mcp.NewToolResultError("Liquidity pool not found"), nil
+		}
+		if pool.Status != models.TransactionStatusConfirmed || pool.PairAddress == "" {
+			return mcp.NewToolResultError("Liquidity pool is not confirmed yet. Please wait for the pool creation transaction to be confirmed"), nil
+		}
+
+		report, err := a.analyze(activeChain, pool, since, until)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if err := a.addPositions(report, activeChain, pool, args.OwnerAddress, deposit); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Pool %d over %.1f days: fee APR %.2f%%, impermanent loss %.2f%% (price %g to %g)",
+					pool.ID, report.Returns.Days, report.Returns.FeeAPR, report.Returns.ImpermanentLoss, report.Returns.StartPrice, report.Returns.EndPrice)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// analyze builds the reserve history of the pool with the token first and computes its returns over the window
+func (a *analyzePoolReturnsTool) analyze(chain *models.Chain, pool *models.LiquidityPool, since, until time.Time) (*PoolReturnsReport, error) {
+	// The pool stores its tokens in the order they were given, the snapshots in the order of the pair
+	tokenInitial, quoteToken, quoteInitial := pool.InitialToken1, pool.Token0, pool.InitialToken0
+	if !strings.EqualFold(pool.Token1, pool.TokenAddress) {
+		tokenInitial, quoteToken, quoteInitial = pool.InitialToken0, pool.Token1, pool.InitialToken1
+	}
+	quoteLabel, quotePairToken := quoteToken, quoteToken
+	if strings.EqualFold(quoteToken, services.EthTokenAddress) {
+		uniswapDeployment, err := a.uniswapService.GetUniswapDeploymentByChain(chain.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get uniswap deployment: %v", err)
+		}
+		quoteLabel, quotePairToken = "ETH", uniswapDeployment.WETHAddress
+	}
+
+	// A negative limit returns every snapshot
+	snapshots, err := a.liquidityService.ListPoolSnapshots(pool.ID, -1)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list pool snapshots: %v", err)
+	}
+	states := []utils.LaunchPoolState{{
+		Time:            pool.CreatedAt,
+		TransactionType: models.TransactionTypeLiquidityPoolCreation,
+		TokenReserve:    parseReportAmount(tokenInitial),
+		QuoteReserve:    parseReportAmount(quoteInitial),
+	}}
+	for _, snapshot := range snapshots {
+		tokenReserve, quoteReserve := utils.SortPairReserves(pool.TokenAddress, quotePairToken, parseReportAmount(snapshot.Reserve0), parseReportAmount(snapshot.Reserve1))
+		states = append(states, utils.LaunchPoolState{
+			Time:            snapshot.CreatedAt,
+			TransactionType: snapshot.TransactionType,
+			TokenReserve:    tokenReserve,
+			QuoteReserve:    quoteReserve,
+		})
+	}
+
+	// The snapshot volume compares the swaps of the window to the last state before it
+	windowStart := 0
+	for i, state := range states {
+		if !state.Time.After(since) {
+			windowStart = i
+		}
+	}
+	volume := utils.BuildLaunchVolume(states[windowStart], states[windowStart+1:], until)
+	report := &PoolReturnsReport{PoolID: pool.ID, PairAddress: pool.PairAddress, QuoteToken: quoteLabel, VolumeSource: launchReportSourceSnapshots}
+	// The indexed swaps also count the trades made outside of the launchpad
+	if _, indexed, err := a.indexerService.GetLastSyncedBlock(chain.ID, pool.PairAddress); err == nil && indexed {
+		swaps, err := a.indexerService.ListEvents(chain.ID, pool.PairAddress, models.IndexedEventTypeSwap)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list indexed swaps: %v", err)
+		}
+		tokenIsToken0 := strings.ToLower(pool.TokenAddress) < strings.ToLower(quotePairToken)
+		if indexedVolume, ok := utils.BuildIndexedLaunchVolume(swaps, tokenIsToken0, since, until); ok {
+			volume, report.VolumeSource = indexedVolume, launchReportSourceIndexer
+		}
+	}
+
+	report.Returns, err = utils.ComputePoolReturns(states, volume, since, until)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to compute the returns of pool %d: %v", pool.ID, err)
+	}
+	report.Positions = []*utils.LPPositionReturns{}
+	return report, nil
+}
+
+// addPositions adds the actual position of ownerAddress and the hypothetical position of deposit when they are given
+func (a *analyzePoolReturnsTool) addPositions(report *PoolReturnsReport, chain *models.Chain, pool *models.LiquidityPool, ownerAddress string, deposit *big.Int) error {
+	returns := report.Returns
+	startToken, startQuote := parseReportAmount(returns.StartTokenReserve), parseReportAmount(returns.StartQuoteReserve)
+
+	if ownerAddress != "" {
+		if _, indexed, err := a.indexerService.GetLastSyncedBlock(chain.ID, pool.PairAddress); err != nil || !indexed {
+			return fmt.Errorf("The LP tokens of pool %d are not indexed yet, the actual position cannot be read. Please retry once the event indexer has synced the pair", pool.ID)
+		}
+		holders, err := a.indexerService.ListHolders(chain.ID, pool.PairAddress, 0)
+		if err != nil {
+			return fmt.Errorf("Failed to list the LP token holders: %v", err)
+		}
+		// The locked minimum liquidity is held by the zero address, which is not a holder
+		supply, balance := new(big.Int), new(big.Int)
+		for _, holder := range holders {
+			amount := parseReportAmount(holder.Balance)
+			supply.Add(supply, amount)
+			if strings.EqualFold(holder.HolderAddress, ownerAddress) {
+				balance = amount
+			}
+		}
+		if balance.Sign() <= 0 {
+			return fmt.Errorf("%s holds no LP tokens of pool %d", ownerAddress, pool.ID)
+		}
+		share, _ := new(big.Float).Quo(new(big.Float).SetInt(balance), new(big.Float).SetInt(supply)).Float64()
+		tokenDeposit := new(big.Int).Div(new(big.Int).Mul(startToken, balance), supply)
+		quoteDeposit := new(big.Int).Div(new(big.Int).Mul(startQuote, balance), supply)
+		report.Positions = append(report.Positions, returns.Position(utils.PositionSourceActual, tokenDeposit, quoteDeposit, share*100))
+	}
+
+	if deposit != nil {
+		// The deposit joins the pool, its fee share is taken against the average liquidity grown by the deposit
+		tokenDeposit := new(big.Int).Div(new(big.Int).Mul(deposit, startToken), startQuote)
+		averageQuote := new(big.Int).Div(parseReportAmount(returns.AverageLiquidity), big.NewInt(2))
+		share, _ := new(big.Float).Quo(new(big.Float).SetInt(deposit), new(big.Float).SetInt(new(big.Int).Add(averageQuote, deposit))).Float64()
+		report.Positions = append(report.Positions, returns.Position(utils.PositionSourceHypothetical, tokenDeposit, deposit, share*100))
+	}
+	return nil
+}
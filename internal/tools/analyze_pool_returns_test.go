@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzePoolReturns(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chainService := services.NewChainService(db)
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	require.NoError(t, db.Create(&models.UniswapDeployment{Name: "Uniswap", Version: "v2", WETHAddress: migrationWETH, IsDefault: true, Status: models.TransactionStatusConfirmed, ChainID: chain.ID}).Error)
+
+	// the pool is funded with 1,000,000 tokens and 10,000 wei, a buy quadruples the price a day later
+	funded := time.Now().UTC().Add(-72 * time.Hour).Truncate(time.Second)
+	liquidityService := services.NewLiquidityService(db)
+	pool := &models.LiquidityPool{TokenAddress: migrationToken, PairAddress: migrationSourcePair, UniswapVersion: "v2", Token0: migrationToken, Token1: services.EthTokenAddress, InitialToken0: "1000000", InitialToken1: "10000", Status: models.TransactionStatusConfirmed, CreatedAt: funded}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+	require.NoError(t, liquidityService.CreatePoolSnapshot(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: "500000", Reserve1: "20000", Price: 0.04, TransactionType: models.TransactionTypeTokenSwap, CreatedAt: funded.Add(24 * time.Hour)}))
+
+	indexerService := services.NewIndexerService(db)
+	handler := NewAnalyzePoolReturnsTool(chainService, liquidityService, services.NewUniswapService(db), indexerService).GetHandler()
+	call := func(args map[string]any) (*mcp.CallToolResult, PoolReturnsReport) {
+		args["pool_id"] = strconv.FormatUint(uint64(pool.ID), 10)
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		var report PoolReturnsReport
+		if !result.IsError {
+			require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+		}
+		return result, report
+	}
+
+	// before the pair is indexed the volume is the reserve change of the recorded swap
+	result, report := call(map[string]any{"since": "96h", "deposit_amount": "1000"})
+	require.False(t, result.IsError, result.Content)
+	assert.Equal(t, launchReportSourceSnapshots, report.VolumeSource)
+	assert.Equal(t, "ETH", report.QuoteToken)
+	assert.Equal(t, funded, report.Returns.Since.UTC())
+	assert.Equal(t, "30", report.Returns.Fees)
+	assert.InDelta(t, -20, report.Returns.ImpermanentLoss, 0.0001)
+	require.Len(t, report.Positions, 1)
+	assert.Equal(t, utils.PositionSourceHypothetical, report.Positions[0].Source)
+	assert.Equal(t, "100000", report.Positions[0].TokenDeposit)
+	assert.Equal(t, "5000", report.Positions[0].HoldValue)
+	assert.Equal(t, "4000", report.Positions[0].LPValue)
+
+	result, _ = call(map[string]any{"owner_address": migrationOwner})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "not indexed yet")
+
+	// the indexed swaps count a sell made outside of the launchpad, the LP transfers give the actual share
+	blockTime := funded.Add(36 * time.Hour)
+	require.NoError(t, indexerService.ApplyEvents(chain.ID, migrationSourcePair, []models.IndexedEvent{
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 1, TransactionHash: "0x01", Sender: "0x0000000000000000000000000000000000000000", Recipient: migrationOwner, Value: "900"},
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 1, TransactionHash: "0x01", LogIndex: 1, Sender: "0x0000000000000000000000000000000000000000", Recipient: migrationSourceFactory, Value: "100"},
+		{EventType: models.IndexedEventTypeSwap, BlockNumber: 2, BlockTime: &blockTime, TransactionHash: "0x02", Amount1In: "10000", Amount0Out: "500000"},
+		{EventType: models.IndexedEventTypeSwap, BlockNumber: 3, BlockTime: &blockTime, TransactionHash: "0x03", Amount0In: "50000", Amount1Out: "1000"},
+	}, 3))
+
+	result, report = call(map[string]any{"since": "96h", "owner_address": migrationOwner})
+	require.False(t, result.IsError, result.Content)
+	assert.Equal(t, launchReportSourceIndexer, report.VolumeSource)
+	assert.Equal(t, 2, report.Returns.Swaps)
+	assert.Equal(t, "33", report.Returns.Fees)
+	require.Len(t, report.Positions, 1)
+	assert.Equal(t, utils.PositionSourceActual, report.Positions[0].Source)
+	assert.InDelta(t, 90, report.Positions[0].FeeShare, 0.0001)
+	assert.Equal(t, "900000", report.Positions[0].TokenDeposit)
+	assert.Equal(t, "9000", report.Positions[0].QuoteDeposit)
+	assert.Equal(t, "29", report.Positions[0].Fees)
+}
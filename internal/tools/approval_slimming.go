@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// skippedApprovalsMetadataKey is the session metadata listing the approvals omitted because of an existing allowance
+const skippedApprovalsMetadataKey = "skipped_approvals"
+
+// approveSelector is the function selector of approve(address,uint256)
+const approveSelector = "0x095ea7b3"
+
+// omitSufficientApprovals removes the approve transactions whose spender already holds an allowance of the owner
+// covering the amount the session spends. amounts is keyed by token address. An approval is kept when the allowance
+// cannot be read or the spent amount is unknown. It returns the remaining transactions and the reason of every omitted approval.
+func omitSufficientApprovals(rpcURL, ownerAddress string, transactionDeployments []models.TransactionDeployment, amounts map[string]string) ([]models.TransactionDeployment, []string) {
+	if rpcURL == "" || !utils.IsValidEthereumAddress(ownerAddress) {
+		return transactionDeployments, nil
+	}
+
+	spent := make(map[string]*big.Int, len(amounts))
+	for token, amount := range amounts {
+		if parsed, ok := new(big.Int).SetString(amount, 10); ok {
+			spent[strings.ToLower(token)] = parsed
+		}
+	}
+
+	var kept []models.TransactionDeployment
+	var skipped []string
+	for _, tx := range transactionDeployments {
+		data := strings.ToLower(tx.Data)
+		amount, ok := spent[strings.ToLower(tx.Receiver)]
+		// approve(address,uint256) is the selector followed by two 32 byte words
+		if !ok || !strings.HasPrefix(data, approveSelector) || len(data) != len(approveSelector)+128 {
+			kept = append(kept, tx)
+			continue
+		}
+
+		spender := common.HexToAddress(data[len(approveSelector) : len(approveSelector)+64]).Hex()
+		allowance, err := utils.GetERC20Allowance(rpcURL, tx.Receiver, ownerAddress, spender)
+		if err != nil || allowance.Allowance.Cmp(amount) < 0 {
+			kept = append(kept, tx)
+			continue
+		}
+
+		existing := allowance.Allowance.String()
+		if allowance.Unlimited {
+			existing = "unlimited"
+		}
+		skipped = append(skipped, fmt.Sprintf("%s: allowance of %s for spender %s is already %s, covering %s", tx.Title, tx.Receiver, spender, existing, amount.String()))
+	}
+	return kept, skipped
+}
+
+// skippedApprovalsMetadata records the omitted approvals in the session metadata
+func skippedApprovalsMetadata(metadata []models.TransactionMetadata, skipped []string) []models.TransactionMetadata {
+	if len(skipped) == 0 {
+		return metadata
+	}
+	return append(metadata, models.TransactionMetadata{
+		Key:   skippedApprovalsMetadataKey,
+		Value: strings.Join(skipped, "; "),
+	})
+}
@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOmitSufficientApprovals(t *testing.T) {
+	// The router may spend 1000 tokens of the owner and an unlimited amount of WETH
+	unlimited := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	rpcServer := newAllowanceRPCServer(map[string]*big.Int{
+		strings.ToLower(allowanceToken): big.NewInt(1000),
+		strings.ToLower(allowanceWETH):  unlimited,
+	})
+	defer rpcServer.Close()
+
+	evmService := services.NewEvmService()
+	approve := func(token string) models.TransactionDeployment {
+		tx, err := evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: token,
+			FunctionName:    "approve",
+			FunctionArgs:    []any{allowanceRouter, unlimited.String()},
+			Abi:             erc20ApproveAbi,
+			Value:           "0",
+			Title:           "Approve Token for Router",
+			Description:     "Approve token",
+			TransactionType: models.TransactionTypeRegular,
+		})
+		require.NoError(t, err)
+		return tx
+	}
+	addLiquidity := models.TransactionDeployment{Title: "Add Liquidity", Receiver: allowanceRouter, Data: "0xe8e33700"}
+
+	tests := []struct {
+		name    string
+		amounts map[string]string
+		kept    int
+		skipped int
+	}{
+		{"allowance covers the amount", map[string]string{allowanceToken: "1000", allowanceWETH: "5"}, 1, 2},
+		{"allowance below the amount", map[string]string{allowanceToken: "1001", allowanceWETH: "5"}, 2, 1},
+		{"amount unknown", map[string]string{}, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactions := []models.TransactionDeployment{approve(allowanceToken), approve(allowanceWETH), addLiquidity}
+
+			kept, skipped := omitSufficientApprovals(rpcServer.URL, allowanceOwner, transactions, tt.amounts)
+			assert.Len(t, kept, tt.kept)
+			assert.Len(t, skipped, tt.skipped)
+			assert.Equal(t, "Add Liquidity", kept[len(kept)-1].Title)
+		})
+	}
+
+	kept, skipped := omitSufficientApprovals(rpcServer.URL, allowanceOwner, []models.TransactionDeployment{approve(allowanceWETH)}, map[string]string{allowanceWETH: "5"})
+	assert.Empty(t, kept)
+	require.Len(t, skipped, 1)
+	assert.Contains(t, skipped[0], "is already unlimited, covering 5")
+
+	metadata := skippedApprovalsMetadata(nil, skipped)
+	require.Len(t, metadata, 1)
+	assert.Equal(t, skippedApprovalsMetadataKey, metadata[0].Key)
+}
+
+func TestOmitSufficientApprovalsKeepsApprovalsWhenAllowanceIsUnreadable(t *testing.T) {
+	// Every token reverts
+	rpcServer := newAllowanceRPCServer(map[string]*big.Int{})
+	defer rpcServer.Close()
+
+	tx, err := services.NewEvmService().GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: allowanceToken,
+		FunctionName:    "approve",
+		FunctionArgs:    []any{allowanceRouter, "1000"},
+		Abi:             erc20ApproveAbi,
+		Value:           "0",
+		Title:           "Approve Token for Swap",
+		Description:     "Approve token",
+		TransactionType: models.TransactionTypeRegular,
+	})
+	require.NoError(t, err)
+
+	kept, skipped := omitSufficientApprovals(rpcServer.URL, allowanceOwner, []models.TransactionDeployment{tx}, map[string]string{allowanceToken: "1000"})
+	assert.Len(t, kept, 1)
+	assert.Empty(t, skipped)
+}
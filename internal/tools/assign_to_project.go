@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type assignToProjectTool struct {
+	projectService services.ProjectService
+}
+
+type AssignToProjectArguments struct {
+	ProjectID string   `json:"project_id" validate:"required"`
+	AssetType string   `json:"asset_type" validate:"required,oneof=template deployment liquidity_pool session"`
+	AssetIDs  []string `json:"asset_ids" validate:"required,min=1"`
+}
+
+func NewAssignToProjectTool(projectService services.ProjectService) *assignToProjectTool {
+	return &assignToProjectTool{
+		projectService: projectService,
+	}
+}
+
+func (a *assignToProjectTool) GetTool() mcp.Tool {
+	assetTypes := make([]string, len(models.ProjectAssetTypes))
+	for i, assetType := range models.ProjectAssetTypes {
+		assetTypes[i] = string(assetType)
+	}
+
+	tool := mcp.NewTool("assign_to_project",
+		mcp.WithDescription("Assign templates, deployments, liquidity pools or transaction sessions to a project created by create_project. "+
+			"A record belongs to at most one project, assigning it again moves it to the new project."),
+		mcp.WithString("project_id",
+			mcp.Required(),
+			mcp.Description("ID of the project returned by create_project"),
+		),
+		mcp.WithString("asset_type",
+			mcp.Required(),
+			mcp.Description("Type of the assigned records"),
+			mcp.Enum(assetTypes...),
+		),
+		mcp.WithArray("asset_ids",
+			mcp.Required(),
+			mcp.Description("IDs of the records to assign, the session IDs of transaction sessions"),
+			mcp.WithStringItems(),
+		),
+	)
+
+	return tool
+}
+
+func (a *assignToProjectTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args AssignToProjectArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		projectID, err := strconv.ParseUint(args.ProjectID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid project_id format: %v", err)), nil
+		}
+
+		project, err := a.projectService.GetProject(uint(projectID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Project not found: %v", err)), nil
+		}
+
+		// Authenticated users can only assign records to their own projects, and only their own records
+		var userId *string
+		if userID := utils.GetUserID(ctx); userID != "" {
+			if project.UserID == nil || *project.UserID != userID {
+				return mcp.NewToolResultError("Project not found"), nil
+			}
+			userId = &userID
+		}
+
+		assetType := models.ProjectAssetType(args.AssetType)
+		var assetIDs []string
+		for _, assetID := range args.AssetIDs {
+			assetID = strings.TrimSpace(assetID)
+			// Every record but the transaction sessions has a numeric ID
+			if assetType != models.ProjectAssetTypeSession {
+				if _, err := strconv.ParseUint(assetID, 10, 32); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Invalid %s ID %q: must be a numeric ID", assetType, assetID)), nil
+				}
+			}
+			assetIDs = append(assetIDs, assetID)
+		}
+
+		if err := a.projectService.AssignAssets(userId, project.ID, assetType, assetIDs); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to assign to project %s: %v", project.Name, err)), nil
+		}
+
+		result := map[string]interface{}{
+			"project_id": project.ID,
+			"asset_type": assetType,
+			"asset_ids":  assetIDs,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Assigned %d %s record(s) to project %s", len(assetIDs), assetType, project.Name)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,225 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type bridgeLiquidityTool struct {
+	chainService       services.ChainService
+	txService          services.TransactionService
+	launchGroupService services.LaunchGroupService
+	serverPort         int
+}
+
+type BridgeLiquidityTarget struct {
+	ChainID string `json:"chain_id" validate:"required"`
+	Amount  string `json:"amount" validate:"required"`
+}
+
+type BridgeLiquidityArguments struct {
+	// Required fields
+	LaunchGroupID string                  `json:"launch_group_id" validate:"required"`
+	Targets       []BridgeLiquidityTarget `json:"targets" validate:"required,min=1,dive"`
+
+	// Optional fields
+	Recipient string `json:"recipient,omitempty"`
+}
+
+func NewBridgeLiquidityTool(chainService services.ChainService, txService services.TransactionService, launchGroupService services.LaunchGroupService, serverPort int) *bridgeLiquidityTool {
+	return &bridgeLiquidityTool{
+		chainService:       chainService,
+		txService:          txService,
+		launchGroupService: launchGroupService,
+		serverPort:         serverPort,
+	}
+}
+
+func (b *bridgeLiquidityTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("bridge_liquidity",
+		mcp.WithDescription("Route the initial liquidity ETH of a multi_chain_launch group to its target chains through their canonical bridges "+
+			"(the L1StandardBridge of OP Stack chains such as Optimism and Base, the Inbox of Arbitrum). "+
+			"Creates one signing session on the settlement chain with a deposit per target chain, the deposits are tracked in the launch group and reported by get_launch_group. "+
+			"Deposits take a few minutes (OP Stack, Arbitrum) to be credited on the target chain before create_liquidity_pool can use them."),
+		mcp.WithString("launch_group_id",
+			mcp.Required(),
+			mcp.Description("ID of the launch group returned by multi_chain_launch"),
+		),
+		mcp.WithArray("targets",
+			mcp.Required(),
+			mcp.Description("Deposits to make, one per target chain of the launch group (e.g., [{\"chain_id\": \"8453\", \"amount\": \"1000000000000000000\"}])"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"chain_id": map[string]any{
+						"type":        "string",
+						"description": "Chain ID of the target chain as listed by list_chains",
+					},
+					"amount": map[string]any{
+						"type":        "string",
+						"description": "ETH to bridge in wei",
+					},
+				},
+				"required": []string{"chain_id", "amount"},
+			}),
+		),
+		mcp.WithString("recipient",
+			mcp.Description("Address credited on the target chains, defaults to the signer. Not supported by Arbitrum bridges, which always credit the signer. Optional."),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (b *bridgeLiquidityTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args BridgeLiquidityArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		groupID, err := strconv.ParseUint(args.LaunchGroupID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid launch_group_id format: %v", err)), nil
+		}
+
+		group, err := b.launchGroupService.GetLaunchGroup(uint(groupID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Launch group not found: %v", err)), nil
+		}
+
+		// Authenticated users can only bridge for their own launch groups
+		if userID := utils.GetUserID(ctx); userID != "" && (group.UserID == nil || *group.UserID != userID) {
+			return mcp.NewToolResultError("Launch group not found"), nil
+		}
+
+		chains, err := b.chainService.ListChains()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list chains: %v", err)), nil
+		}
+
+		var sourceChainID string
+		var deposits []models.TransactionDeployment
+		var bridges []models.LaunchGroupBridge
+		total := new(big.Int)
+		for _, target := range args.Targets {
+			index := slices.IndexFunc(group.Deployments, func(deployment models.Deployment) bool {
+				return deployment.Chain.NetworkID == target.ChainID
+			})
+			if index < 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("Chain %s is not a target chain of launch group %d", target.ChainID, group.ID)), nil
+			}
+			targetChain := group.Deployments[index].Chain
+			if slices.ContainsFunc(bridges, func(bridge models.LaunchGroupBridge) bool { return bridge.TargetChainID == targetChain.ID }) {
+				return mcp.NewToolResultError(fmt.Sprintf("Chain %s is listed twice in targets", target.ChainID)), nil
+			}
+
+			amount, err := utils.ParseWeiAmount(target.Amount)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid amount for chain %s: %v", target.ChainID, err)), nil
+			}
+
+			bridge, err := utils.GetCanonicalBridge(target.ChainID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// Every deposit of the session is signed on the same settlement chain
+			if sourceChainID != "" && bridge.SourceChainID != sourceChainID {
+				return mcp.NewToolResultError(fmt.Sprintf("Chain %s bridges from chain %s while the other targets bridge from chain %s, call bridge_liquidity once per settlement chain", target.ChainID, bridge.SourceChainID, sourceChainID)), nil
+			}
+			sourceChainID = bridge.SourceChainID
+
+			data, err := utils.EncodeBridgeDeposit(bridge.Kind, args.Recipient)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to encode the deposit to chain %s: %v", target.ChainID, err)), nil
+			}
+
+			total.Add(total, amount)
+			deposits = append(deposits, models.TransactionDeployment{
+				Title:           fmt.Sprintf("Bridge liquidity to %s", targetChain.Name),
+				Description:     fmt.Sprintf("Deposit %s wei through the %s", amount.String(), bridge.Name),
+				Data:            data,
+				Value:           amount.String(),
+				Receiver:        bridge.Address,
+				TransactionType: models.TransactionTypeBridgeDeposit,
+			})
+			bridges = append(bridges, models.LaunchGroupBridge{
+				LaunchGroupID: group.ID,
+				TargetChainID: targetChain.ID,
+				BridgeAddress: bridge.Address,
+				BridgeName:    bridge.Name,
+				Recipient:     args.Recipient,
+				Amount:        amount.String(),
+				Status:        models.TransactionStatusPending,
+			})
+		}
+
+		sourceIndex := slices.IndexFunc(chains, func(chain models.Chain) bool {
+			return chain.ChainType == models.TransactionChainTypeEthereum && chain.NetworkID == sourceChainID
+		})
+		if sourceIndex < 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("The deposits are signed on chain %s, which is not configured. Add it with set_chain first", sourceChainID)), nil
+		}
+		sourceChain := chains[sourceIndex]
+
+		session := services.CreateTransactionSessionRequest{
+			TransactionDeployments: deposits,
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                sourceChain.ID,
+			UserID:                 group.UserID,
+			Metadata: []models.TransactionMetadata{
+				{Key: "Launch Group", Value: strconv.FormatUint(uint64(group.ID), 10)},
+				{Key: "Total", Value: fmt.Sprintf("%s wei", total.String())},
+			},
+		}
+		session.Context = ctx
+
+		sessionID, err := b.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+
+		for i := range bridges {
+			bridges[i].SourceChainID = sourceChain.ID
+			bridges[i].SessionId = sessionID
+		}
+		if err := b.launchGroupService.CreateLaunchGroupBridges(bridges); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to record the bridge deposits: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, b.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		group, err = b.launchGroupService.GetLaunchGroup(group.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get launch group: %v", err)), nil
+		}
+		resultJSON, _ := json.Marshal(newLaunchGroupReport(ctx, group, b.serverPort))
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Bridge session created on %s for %d deposits (%s wei in total): %s", sourceChain.Name, len(deposits), total.String(), sessionID)),
+				mcp.NewTextContent("Please return the following url to the user: "),
+				mcp.NewTextContent(url),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
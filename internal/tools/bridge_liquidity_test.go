@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/hooks"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeLiquidity(t *testing.T) {
+	env := setupMultiChainLaunchTest(t)
+	group := &models.LaunchGroup{TemplateID: env.template.ID, ContractName: "Sample"}
+	require.NoError(t, env.launchGroupService.CreateLaunchGroup(group))
+	for _, chain := range env.chains {
+		sessionID, err := env.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{ChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID})
+		require.NoError(t, err)
+		require.NoError(t, env.deploymentService.CreateDeployment(&models.Deployment{ChainID: chain.ID, TemplateID: env.template.ID, Status: models.TransactionStatusConfirmed, SessionId: sessionID}))
+		require.NoError(t, env.launchGroupService.AddDeploymentBySessionId(group.ID, sessionID))
+	}
+
+	handler := NewBridgeLiquidityTool(env.chainService, env.txService, env.launchGroupService, TEST_SERVER_PORT).GetHandler()
+	oneEther := "1000000000000000000"
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			arguments map[string]any
+			expected  string
+		}{
+			{"missing targets", map[string]any{"launch_group_id": "1"}, "Invalid arguments"},
+			{"unknown group", map[string]any{"launch_group_id": "9", "targets": []any{map[string]any{"chain_id": "8453", "amount": oneEther}}}, "Launch group not found"},
+			{"chain outside the group", map[string]any{"launch_group_id": "1", "targets": []any{map[string]any{"chain_id": "10", "amount": oneEther}}}, "Chain 10 is not a target chain of launch group 1"},
+			{"invalid amount", map[string]any{"launch_group_id": "1", "targets": []any{map[string]any{"chain_id": "8453", "amount": "0"}}}, "Invalid amount for chain 8453"},
+			{"no bridge", map[string]any{"launch_group_id": "1", "targets": []any{map[string]any{"chain_id": "1", "amount": oneEther}}}, "no canonical bridge is known for chain 1"},
+			{"duplicate target", map[string]any{"launch_group_id": "1", "targets": []any{map[string]any{"chain_id": "8453", "amount": oneEther}, map[string]any{"chain_id": "8453", "amount": oneEther}}}, "listed twice"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := callTemplatePackTool(t, context.Background(), handler, tt.arguments)
+				assert.True(t, result.IsError)
+				assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+			})
+		}
+	})
+
+	result := callTemplatePackTool(t, context.Background(), handler, map[string]any{
+		"launch_group_id": "1",
+		"targets":         []any{map[string]any{"chain_id": "8453", "amount": oneEther}},
+	})
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	var report LaunchGroupReport
+	require.NoError(t, json.Unmarshal([]byte(result.Content[3].(mcp.TextContent).Text), &report))
+	assert.Equal(t, models.LaunchGroupStatusPending, report.Status)
+	require.Len(t, report.Bridges, 1)
+	bridge := report.Bridges[0]
+	assert.Equal(t, "1", bridge.SourceChainID)
+	assert.Equal(t, "8453", bridge.TargetChainID)
+	assert.Equal(t, "0x3154Cf16ccdb4C6d922629664174b904d80F2C35", bridge.BridgeAddress)
+	assert.Equal(t, oneEther, bridge.Amount)
+	assert.Contains(t, bridge.URL, bridge.SessionID)
+
+	// The deposit is signed on the settlement chain
+	session, err := env.txService.GetTransactionSession(bridge.SessionID)
+	require.NoError(t, err)
+	assert.Equal(t, env.chains[0].ID, session.ChainID)
+	require.Len(t, session.TransactionDeployments, 1)
+	deposit := session.TransactionDeployments[0]
+	assert.Equal(t, models.TransactionTypeBridgeDeposit, deposit.TransactionType)
+	assert.Equal(t, oneEther, deposit.Value)
+	assert.Equal(t, "0xb1a1a882", deposit.Data[:10])
+
+	// Confirming the deposit confirms the bridge and the group
+	session.TransactionDeployments[0].Status = models.TransactionStatusConfirmed
+	hook := hooks.NewLaunchGroupBridgeHook(env.launchGroupService)
+	require.True(t, hook.CanHandle(models.TransactionTypeBridgeDeposit))
+	require.NoError(t, hook.OnTransactionConfirmed(models.TransactionTypeBridgeDeposit, "0xabc", nil, *session))
+
+	confirmed, err := env.launchGroupService.GetLaunchGroup(group.ID)
+	require.NoError(t, err)
+	require.Len(t, confirmed.Bridges, 1)
+	assert.Equal(t, models.TransactionStatusConfirmed, confirmed.Bridges[0].Status)
+	assert.Equal(t, "0xabc", confirmed.Bridges[0].TransactionHash)
+	assert.Equal(t, models.LaunchGroupStatusConfirmed, confirmed.Status())
+}
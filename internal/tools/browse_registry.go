@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type browseRegistryTool struct {
+	registryService services.TemplateRegistryService
+}
+
+type BrowseRegistryArguments struct {
+	// Optional fields
+	Keyword   string `json:"keyword,omitempty"`
+	ChainType string `json:"chain_type,omitempty" validate:"omitempty,oneof=ethereum solana"`
+}
+
+type RegistryTemplateInfo struct {
+	Name        string                      `json:"name"`
+	Version     string                      `json:"version"`
+	Description string                      `json:"description"`
+	ChainType   models.TransactionChainType `json:"chain_type"`
+	// InstalledTemplateIDs are the local templates of the version, empty when it is not installed
+	InstalledTemplateIDs []uint `json:"installed_template_ids,omitempty"`
+}
+
+func NewBrowseRegistryTool(registryService services.TemplateRegistryService) *browseRegistryTool {
+	return &browseRegistryTool{
+		registryService: registryService,
+	}
+}
+
+func (b *browseRegistryTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("browse_registry",
+		mcp.WithDescription("Browse the templates of the subscribed remote template registry with their versions, and which versions are already installed. Use install_template to install one."),
+		mcp.WithString("keyword",
+			mcp.Description("Only return templates whose name or description contains the keyword. Optional"),
+		),
+		mcp.WithString("chain_type",
+			mcp.Description("Only return templates of this chain type. Optional"),
+			mcp.Enum(string(models.TransactionChainTypeEthereum), string(models.TransactionChainTypeSolana)),
+		),
+	)
+
+	return tool
+}
+
+func (b *browseRegistryTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var userId *string
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		var args BrowseRegistryArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		index, err := b.registryService.FetchIndex(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to browse the template registry: %v", err)), nil
+		}
+
+		installed, err := b.registryService.ListInstalledTemplates(userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list installed templates: %v", err)), nil
+		}
+		installedIDs := map[string][]uint{}
+		for _, template := range installed {
+			key := template.Name + "@" + template.Version
+			installedIDs[key] = append(installedIDs[key], template.TemplateID)
+		}
+
+		keyword := strings.ToLower(args.Keyword)
+		templates := []RegistryTemplateInfo{}
+		for _, entry := range index.Templates {
+			if args.ChainType != "" && string(entry.ChainType) != args.ChainType {
+				continue
+			}
+			if keyword != "" && !strings.Contains(strings.ToLower(entry.Name), keyword) && !strings.Contains(strings.ToLower(entry.Description), keyword) {
+				continue
+			}
+			templates = append(templates, RegistryTemplateInfo{
+				Name:                 entry.Name,
+				Version:              entry.Version,
+				Description:          entry.Description,
+				ChainType:            entry.ChainType,
+				InstalledTemplateIDs: installedIDs[entry.Name+"@"+entry.Version],
+			})
+		}
+
+		resultJSON, _ := json.Marshal(templates)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d templates in the registry %s: ", len(templates), b.registryService.RegistryURL())),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,291 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type callContractTool struct {
+	templateService   services.TemplateService
+	evmService        services.EvmService
+	txService         services.TransactionService
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	serverPort        int
+}
+
+type CallContractArguments struct {
+	// Required fields
+	FunctionName string `json:"function_name" validate:"required"`
+
+	// Optional fields
+	ContractAddress string                       `json:"contract_address,omitempty" validate:"required_without=DeploymentID,excluded_with=DeploymentID,omitempty,eth_addr"`
+	Abi             string                       `json:"abi,omitempty" validate:"required_without=DeploymentID"`
+	DeploymentID    string                       `json:"deployment_id,omitempty"`
+	FunctionArgs    []any                        `json:"function_args,omitempty"`
+	Value           string                       `json:"value,omitempty" validate:"omitempty,numeric"`
+	Metadata        []models.TransactionMetadata `json:"metadata,omitempty"`
+}
+
+func NewCallContractTool(templateService services.TemplateService, evmService services.EvmService, txService services.TransactionService, chainService services.ChainService, deploymentService services.DeploymentService, serverPort int) *callContractTool {
+	return &callContractTool{
+		templateService:   templateService,
+		evmService:        evmService,
+		txService:         txService,
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		serverPort:        serverPort,
+	}
+}
+
+func (c *callContractTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("call_contract",
+		mcp.WithDescription("Call a function of any contract on the active Ethereum chain, given its address and ABI or a deployment ID. "+
+			"For read-only functions (view/pure), returns the result directly. For state-changing functions, creates a transaction session with signing URL. "+
+			"Use it for interactions not covered by a dedicated tool."),
+		mcp.WithString("function_name",
+			mcp.Required(),
+			mcp.Description("Name of the function to call from the ABI. Overloaded functions are suffixed with their index in the ABI (e.g., 'safeTransferFrom0')"),
+		),
+		mcp.WithString("contract_address",
+			mcp.Description("Address of the contract. Required without deployment_id"),
+		),
+		mcp.WithString("abi",
+			mcp.Description("JSON ABI of the contract, only the called function needs to be listed. Required without deployment_id, overrides the template ABI of the deployment otherwise (e.g., the implementation ABI of a proxy)"),
+		),
+		mcp.WithString("deployment_id",
+			mcp.Description("ID of a confirmed deployment, its contract address and template ABI are used. Optional"),
+		),
+		mcp.WithArray("function_args",
+			mcp.Description("JSON array of function arguments in ABI order (e.g., [\"0x123...\", \"1000000000000000000\"]). Optional"),
+			mcp.Items(map[string]any{
+				"type":        "any",
+				"description": "Function argument, provide the final value (e.g., for uint256 value of 1 ETH, provide \"1000000000000000000\")",
+			}),
+		),
+		mcp.WithString("value",
+			mcp.Description("ETH value to send with the call in wei. Optional, defaults to \"0\""),
+		),
+		mcp.WithArray("metadata",
+			mcp.Description("JSON array of metadata for the transaction (e.g., [{\"key\": \"Purpose\", \"value\": \"Claim rewards\"}]). Optional"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"key": map[string]any{
+						"type":        "string",
+						"description": "Key of the metadata",
+					},
+					"value": map[string]any{
+						"type":        "string",
+						"description": "Value of the metadata",
+					},
+				},
+				"required": []string{"key", "value"},
+			}),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (c *callContractTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CallContractArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := c.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("call_contract only supports Ethereum chains, got %s", activeChain.ChainType)), nil
+		}
+
+		contractAddress, abiString, deployment, err := resolveContractTarget(c.deploymentService, c.templateService, activeChain, args.DeploymentID, args.ContractAddress, args.Abi)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		parsedABI, err := abi.JSON(strings.NewReader(abiString))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid ABI: %v", err)), nil
+		}
+		method, ok := parsedABI.Methods[args.FunctionName]
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Function '%s' not found in ABI", args.FunctionName)), nil
+		}
+		functionArgs := args.FunctionArgs
+		if functionArgs == nil {
+			functionArgs = []any{}
+		}
+		if len(functionArgs) != len(method.Inputs) {
+			return mcp.NewToolResultError(fmt.Sprintf("Function '%s' expects %d arguments, got %d", args.FunctionName, len(method.Inputs), len(functionArgs))), nil
+		}
+
+		if method.StateMutability == "view" || method.StateMutability == "pure" {
+			result, err := c.evmService.CallReadOnlyEthereumFunction(services.CallReadOnlyEthereumFunctionArgs{
+				ContractAddress: contractAddress,
+				FunctionName:    args.FunctionName,
+				FunctionArgs:    functionArgs,
+				Abi:             abiString,
+				RpcURL:          activeChain.RPC,
+				Value:           "0",
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to call read-only function: %v", err)), nil
+			}
+			resultString, _ := json.Marshal(result)
+			resultJSON, _ := json.Marshal(CallFunctionResult{
+				DeploymentID:    args.DeploymentID,
+				ContractAddress: contractAddress,
+				FunctionName:    args.FunctionName,
+				Result:          string(resultString),
+				Success:         true,
+				IsReadOnly:      true,
+			})
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Function '%s' called successfully on %s:", args.FunctionName, contractAddress)),
+					mcp.NewTextContent(string(resultJSON)),
+				},
+			}, nil
+		}
+
+		if args.Value != "" && args.Value != "0" && !method.IsPayable() {
+			return mcp.NewToolResultError(fmt.Sprintf("Function '%s' is not payable, value must be 0", args.FunctionName)), nil
+		}
+		// Refuse mint, burn, pause and permit flows the deployment was detected not to support
+		if deployment != nil {
+			if err := checkDeploymentCapability(deployment, args.FunctionName); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		sessionID, err := c.createCallTransaction(ctx, args, activeChain, contractAddress, abiString, functionArgs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create function call transaction: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, c.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Function call transaction session created: %s", sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Contract: %s", contractAddress)),
+				mcp.NewTextContent("Please sign the function call in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+func (c *callContractTool) createCallTransaction(ctx context.Context, args CallContractArguments, activeChain *models.Chain, contractAddress string, abiString string, functionArgs []any) (string, error) {
+	value := args.Value
+	if value == "" {
+		value = "0"
+	}
+	tx, err := c.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: contractAddress,
+		FunctionName:    args.FunctionName,
+		FunctionArgs:    functionArgs,
+		Abi:             abiString,
+		Value:           value,
+		Title:           fmt.Sprintf("Call %s", args.FunctionName),
+		Description:     fmt.Sprintf("Call function %s on contract %s", args.FunctionName, contractAddress),
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return "", err
+	}
+	rawArgs, err := utils.EncodeFunctionArgsToStringMapWithStringABI(args.FunctionName, functionArgs, abiString)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	tx.RawContractArguments = &rawArgs
+	tx.ContractAddress = &contractAddress
+
+	metadata := append(args.Metadata,
+		models.TransactionMetadata{Key: "function_name", Value: args.FunctionName},
+		models.TransactionMetadata{Key: "contract_address", Value: contractAddress},
+	)
+	if args.DeploymentID != "" {
+		metadata = append(metadata, models.TransactionMetadata{Key: "deployment_id", Value: args.DeploymentID})
+	}
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{tx},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata:               metadata,
+		UserID:                 userId,
+	}
+	session.Context = ctx
+
+	sessionID, err := c.txService.CreateTransactionSession(session)
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+	return sessionID, nil
+}
+
+// resolveContractTarget returns the address and ABI of a call: those of the deployment when deploymentID is set, the
+// given ABI overriding the template ABI, the given address and ABI otherwise
+func resolveContractTarget(deploymentService services.DeploymentService, templateService services.TemplateService, activeChain *models.Chain, deploymentID string, contractAddress string, abiString string) (string, string, *models.Deployment, error) {
+	if deploymentID == "" {
+		return contractAddress, abiString, nil, nil
+	}
+
+	id, err := strconv.ParseUint(deploymentID, 10, 32)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("Invalid deployment_id format: %v", err)
+	}
+	deployment, err := deploymentService.GetDeploymentByID(uint(id))
+	if err != nil {
+		return "", "", nil, fmt.Errorf("Deployment not found: %v", err)
+	}
+	if deployment.Status != models.TransactionStatusConfirmed || deployment.ContractAddress == "" {
+		return "", "", nil, fmt.Errorf("Deployment is not confirmed yet. Contract address not available")
+	}
+	if deployment.ChainID != activeChain.ID {
+		return "", "", nil, fmt.Errorf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)
+	}
+
+	if abiString == "" {
+		template, err := templateService.GetTemplateByID(deployment.TemplateID)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("Template not found: %v", err)
+		}
+		abiString, err = utils.GetAbiString(template.Abi)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("Template does not have ABI information")
+		}
+	}
+	return deployment.ContractAddress, abiString, deployment, nil
+}
@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const callContractTestABI = `[{"type":"function","name":"claim","stateMutability":"nonpayable","inputs":[{"name":"amount","type":"uint256"}],"outputs":[]},` +
+	`{"type":"function","name":"pending","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}]`
+
+func TestCallContract(t *testing.T) {
+	// Every eth_call returns 42
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		result := "0x0"
+		if request.Method == "eth_call" {
+			result = "0x000000000000000000000000000000000000000000000000000000000000002a"
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+	t.Cleanup(rpc.Close)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	templateService := services.NewTemplateService(db.GetDB())
+	var templateABI models.JSON
+	require.NoError(t, json.Unmarshal([]byte(`{"abi":`+callContractTestABI+`}`), &templateABI))
+	template := &models.Template{Name: "Rewards", ChainType: models.TransactionChainTypeEthereum, Abi: templateABI}
+	require.NoError(t, templateService.CreateTemplate(template))
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	deployment := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, Status: models.TransactionStatusConfirmed, ContractAddress: "0x1111111111111111111111111111111111111111"}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	txService := services.NewTransactionService(db.GetDB())
+
+	handler := NewCallContractTool(templateService, services.NewEvmService(), txService, chainService, deploymentService, 9999).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("creates a signing session from an address and an ABI", func(t *testing.T) {
+		result := call(map[string]any{
+			"contract_address": "0x2222222222222222222222222222222222222222",
+			"abi":              callContractTestABI,
+			"function_name":    "claim",
+			"function_args":    []any{"5"},
+		})
+		require.False(t, result.IsError, result.Content)
+
+		sessionID := strings.TrimPrefix(result.Content[0].(mcp.TextContent).Text, "Function call transaction session created: ")
+		session, err := txService.GetTransactionSession(sessionID)
+		require.NoError(t, err)
+		require.Len(t, session.TransactionDeployments, 1)
+		assert.Equal(t, "0x2222222222222222222222222222222222222222", session.TransactionDeployments[0].Receiver)
+		assert.True(t, strings.HasSuffix(session.TransactionDeployments[0].Data, "0000000000000000000000000000000000000000000000000000000000000005"))
+	})
+
+	t.Run("calls view functions of a deployment with its template ABI", func(t *testing.T) {
+		result := call(map[string]any{
+			"deployment_id": strconv.FormatUint(uint64(deployment.ID), 10),
+			"function_name": "pending",
+			"function_args": []any{"0x3333333333333333333333333333333333333333"},
+		})
+		require.False(t, result.IsError, result.Content)
+
+		var callResult CallFunctionResult
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &callResult))
+		assert.True(t, callResult.IsReadOnly)
+		assert.Equal(t, deployment.ContractAddress, callResult.ContractAddress)
+		assert.Equal(t, `["42"]`, callResult.Result)
+	})
+
+	t.Run("rejects invalid calls", func(t *testing.T) {
+		for name, args := range map[string]map[string]any{
+			"no address":        {"abi": callContractTestABI, "function_name": "claim", "function_args": []any{"5"}},
+			"address and id":    {"contract_address": "0x2222222222222222222222222222222222222222", "deployment_id": "1", "function_name": "claim"},
+			"unknown function":  {"contract_address": "0x2222222222222222222222222222222222222222", "abi": callContractTestABI, "function_name": "stake"},
+			"missing argument":  {"contract_address": "0x2222222222222222222222222222222222222222", "abi": callContractTestABI, "function_name": "claim"},
+			"value non-payable": {"contract_address": "0x2222222222222222222222222222222222222222", "abi": callContractTestABI, "function_name": "claim", "function_args": []any{"5"}, "value": "1"},
+		} {
+			assert.True(t, call(args).IsError, name)
+		}
+	})
+}
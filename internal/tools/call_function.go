@@ -18,6 +18,7 @@ import (
 type callFunctionTool struct {
 	templateService   services.TemplateService
 	evmService        services.EvmService
+	chainAdapters     services.ChainAdapters
 	txService         services.TransactionService
 	chainService      services.ChainService
 	deploymentService services.DeploymentService
@@ -49,6 +50,7 @@ func NewCallFunctionTool(templateService services.TemplateService, evmService se
 	return &callFunctionTool{
 		templateService:   templateService,
 		evmService:        evmService,
+		chainAdapters:     services.NewChainAdapters(evmService),
 		txService:         txService,
 		chainService:      chainService,
 		deploymentService: deploymentService,
@@ -94,6 +96,7 @@ func (c *callFunctionTool) GetTool() mcp.Tool {
 				"required": []string{"key", "value"},
 			}),
 		),
+		withIdempotencyKey(),
 	)
 
 	return tool
@@ -132,7 +135,7 @@ func (c *callFunctionTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get active chain configuration
-		activeChain, err := c.chainService.GetActiveChain()
+		activeChain, err := c.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
@@ -142,16 +145,16 @@ func (c *callFunctionTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)), nil
 		}
 
-		// Currently only support Ethereum
-		if activeChain.ChainType != models.TransactionChainTypeEthereum {
-			return mcp.NewToolResultError(fmt.Sprintf("Function calls are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		adapter, err := c.chainAdapters.ForChainType(activeChain.ChainType)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		return c.makeEthereumFunctionCall(ctx, args, activeChain, deployment)
+		return c.makeFunctionCall(ctx, args, adapter, activeChain, deployment)
 	}
 }
 
-func (c *callFunctionTool) makeEthereumFunctionCall(ctx context.Context, args CallFunctionArguments, activeChain *models.Chain, deployment *models.Deployment) (*mcp.CallToolResult, error) {
+func (c *callFunctionTool) makeFunctionCall(ctx context.Context, args CallFunctionArguments, adapter services.ChainAdapter, activeChain *models.Chain, deployment *models.Deployment) (*mcp.CallToolResult, error) {
 	// Get template to access ABI
 	template, err := c.templateService.GetTemplateByID(deployment.TemplateID)
 	if err != nil {
@@ -207,14 +210,19 @@ func (c *callFunctionTool) makeEthereumFunctionCall(ctx context.Context, args Ca
 		}, nil
 
 	} else {
+		// Refuse mint, burn, pause and permit flows the contract was detected not to support
+		if err := checkDeploymentCapability(deployment, args.FunctionName); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
 		// For state-changing functions, create transaction session
-		sessionID, err := c.createFunctionCallTransaction(ctx, args, activeChain, deployment, template)
+		sessionID, err := c.createFunctionCallTransaction(ctx, args, adapter, activeChain, deployment, template)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to create function call transaction: %v", err)), nil
 		}
 
 		// Generate transaction session URL
-		url, err := utils.GetTransactionSessionUrl(c.serverPort, sessionID)
+		url, err := utils.GetTransactionSessionUrl(ctx, c.serverPort, sessionID)
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
 		}
@@ -265,53 +273,19 @@ func (c *callFunctionTool) callReadOnlyEthereumFunction(contractAddress string,
 	return result, nil
 }
 
-func (c *callFunctionTool) createFunctionCallTransaction(ctx context.Context, args CallFunctionArguments, activeChain *models.Chain, deployment *models.Deployment, template *models.Template) (string, error) {
-	// Set default value if not provided
-	value := args.Value
-	if value == "" {
-		value = "0"
-	}
-
-	// Extract ABI array from template.Abi and convert to string
-	var abiString string
-	if abiData, exists := template.Abi["abi"]; exists {
-		// If ABI is stored as "abi" field, marshal the array directly
-		abiBytes, err := json.Marshal(abiData)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal ABI data: %w", err)
-		}
-		abiString = string(abiBytes)
-	} else {
-		// If template.Abi is the ABI array directly, marshal it
-		abiBytes, err := json.Marshal(template.Abi)
-		if err != nil {
-			return "", fmt.Errorf("failed to marshal ABI: %w", err)
-		}
-		abiString = string(abiBytes)
-	}
-
-	// Create function call transaction
-	tx, err := c.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+func (c *callFunctionTool) createFunctionCallTransaction(ctx context.Context, args CallFunctionArguments, adapter services.ChainAdapter, activeChain *models.Chain, deployment *models.Deployment, template *models.Template) (string, error) {
+	tx, err := adapter.BuildCallTx(services.BuildCallTxArgs{
+		Template:        template,
 		ContractAddress: deployment.ContractAddress,
 		FunctionName:    args.FunctionName,
 		FunctionArgs:    args.FunctionArgs,
-		Abi:             string(abiString),
-		Value:           value,
+		Value:           args.Value,
 		Title:           fmt.Sprintf("Call %s", args.FunctionName),
 		Description:     fmt.Sprintf("Call function %s on contract %s", args.FunctionName, deployment.ContractAddress),
-		TransactionType: models.TransactionTypeRegular,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create function call transaction: %w", err)
-	}
-
-	// Encode function arguments for raw contract arguments
-	functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(args.FunctionName, args.FunctionArgs, string(abiString))
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		return "", err
 	}
-	tx.RawContractArguments = &functionArgsString
-	tx.ContractAddress = &deployment.ContractAddress
 
 	// Add metadata
 	enhancedMetadata := append(args.Metadata, models.TransactionMetadata{
@@ -334,14 +308,17 @@ func (c *callFunctionTool) createFunctionCallTransaction(ctx context.Context, ar
 		userId = &user.Sub
 	}
 
-	// Create transaction session
-	sessionID, err := c.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+	session := services.CreateTransactionSessionRequest{
 		TransactionDeployments: []models.TransactionDeployment{tx},
-		ChainType:              models.TransactionChainTypeEthereum,
+		ChainType:              adapter.ChainType(),
 		ChainID:                activeChain.ID,
 		Metadata:               enhancedMetadata,
 		UserID:                 userId,
-	})
+	}
+	session.Context = ctx
+
+	// Create transaction session
+	sessionID, err := c.txService.CreateTransactionSession(session)
 	if err != nil {
 		return "", fmt.Errorf("failed to create transaction session: %w", err)
 	}
@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type cancelBuybackTool struct {
+	buybackService services.BuybackService
+}
+
+type CancelBuybackArguments struct {
+	// Required fields
+	BuybackID string `json:"buyback_id" validate:"required"`
+}
+
+func NewCancelBuybackTool(buybackService services.BuybackService) *cancelBuybackTool {
+	return &cancelBuybackTool{
+		buybackService: buybackService,
+	}
+}
+
+func (c *cancelBuybackTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("cancel_buyback",
+		mcp.WithDescription("Cancel an active buyback so no further buyback sessions are created, the pending runs can still be signed"),
+		mcp.WithString("buyback_id",
+			mcp.Required(),
+			mcp.Description("ID of the buyback to cancel"),
+		),
+	)
+
+	return tool
+}
+
+func (c *cancelBuybackTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CancelBuybackArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		id, err := strconv.ParseUint(args.BuybackID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid buyback_id format: %v", err)), nil
+		}
+
+		buyback, err := c.buybackService.GetBuyback(uint(id))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Buyback not found: %v", err)), nil
+		}
+
+		// Authenticated users can only cancel their own schedules
+		if userID := utils.GetUserID(ctx); userID != "" && (buyback.UserID == nil || *buyback.UserID != userID) {
+			return mcp.NewToolResultError("Buyback not found"), nil
+		}
+
+		if buyback.Status != models.BuybackStatusActive {
+			return mcp.NewToolResultError(fmt.Sprintf("Only active buybacks can be cancelled, buyback %d is %s", buyback.ID, buyback.Status)), nil
+		}
+
+		if err := c.buybackService.UpdateBuybackStatus(buyback.ID, models.BuybackStatusCancelled); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel buyback: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Buyback %d cancelled", buyback.ID)), nil
+	}
+}
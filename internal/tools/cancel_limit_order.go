@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type cancelLimitOrderTool struct {
+	limitOrderService services.LimitOrderService
+}
+
+type CancelLimitOrderArguments struct {
+	// Required fields
+	OrderID string `json:"order_id" validate:"required"`
+}
+
+func NewCancelLimitOrderTool(limitOrderService services.LimitOrderService) *cancelLimitOrderTool {
+	return &cancelLimitOrderTool{
+		limitOrderService: limitOrderService,
+	}
+}
+
+func (c *cancelLimitOrderTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("cancel_limit_order",
+		mcp.WithDescription("Cancel an open limit order so it is no longer watched"),
+		mcp.WithString("order_id",
+			mcp.Required(),
+			mcp.Description("ID of the limit order to cancel"),
+		),
+	)
+
+	return tool
+}
+
+func (c *cancelLimitOrderTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CancelLimitOrderArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		orderID, err := strconv.ParseUint(args.OrderID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid order_id format: %v", err)), nil
+		}
+
+		order, err := c.limitOrderService.GetLimitOrder(uint(orderID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Limit order not found: %v", err)), nil
+		}
+
+		// Authenticated users can only cancel their own orders
+		if userID := utils.GetUserID(ctx); userID != "" && (order.UserID == nil || *order.UserID != userID) {
+			return mcp.NewToolResultError("Limit order not found"), nil
+		}
+
+		if order.Status != models.LimitOrderStatusOpen {
+			return mcp.NewToolResultError(fmt.Sprintf("Only open limit orders can be cancelled, order %d is %s", order.ID, order.Status)), nil
+		}
+
+		if err := c.limitOrderService.UpdateLimitOrderStatus(order.ID, models.LimitOrderStatusCancelled, ""); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel limit order: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Limit order %d cancelled", order.ID)), nil
+	}
+}
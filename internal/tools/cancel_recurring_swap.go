@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type cancelRecurringSwapTool struct {
+	recurringSwapService services.RecurringSwapService
+}
+
+type CancelRecurringSwapArguments struct {
+	// Required fields
+	RecurringSwapID string `json:"recurring_swap_id" validate:"required"`
+}
+
+func NewCancelRecurringSwapTool(recurringSwapService services.RecurringSwapService) *cancelRecurringSwapTool {
+	return &cancelRecurringSwapTool{
+		recurringSwapService: recurringSwapService,
+	}
+}
+
+func (c *cancelRecurringSwapTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("cancel_recurring_swap",
+		mcp.WithDescription("Cancel an active recurring swap so no further swap sessions are created"),
+		mcp.WithString("recurring_swap_id",
+			mcp.Required(),
+			mcp.Description("ID of the recurring swap to cancel"),
+		),
+	)
+
+	return tool
+}
+
+func (c *cancelRecurringSwapTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CancelRecurringSwapArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		id, err := strconv.ParseUint(args.RecurringSwapID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid recurring_swap_id format: %v", err)), nil
+		}
+
+		swap, err := c.recurringSwapService.GetRecurringSwap(uint(id))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Recurring swap not found: %v", err)), nil
+		}
+
+		// Authenticated users can only cancel their own schedules
+		if userID := utils.GetUserID(ctx); userID != "" && (swap.UserID == nil || *swap.UserID != userID) {
+			return mcp.NewToolResultError("Recurring swap not found"), nil
+		}
+
+		if swap.Status != models.RecurringSwapStatusActive {
+			return mcp.NewToolResultError(fmt.Sprintf("Only active recurring swaps can be cancelled, recurring swap %d is %s", swap.ID, swap.Status)), nil
+		}
+
+		if err := c.recurringSwapService.UpdateRecurringSwapStatus(swap.ID, models.RecurringSwapStatusCancelled); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel recurring swap: %v", err)), nil
+		}
+
+		return mcp.NewToolResultText(fmt.Sprintf("Recurring swap %d cancelled", swap.ID)), nil
+	}
+}
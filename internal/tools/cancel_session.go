@@ -0,0 +1,86 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type cancelSessionTool struct {
+	txService services.TransactionService
+}
+
+type CancelSessionArguments struct {
+	// Required fields
+	SessionID string `json:"session_id" validate:"required"`
+}
+
+func NewCancelSessionTool(txService services.TransactionService) *cancelSessionTool {
+	return &cancelSessionTool{
+		txService: txService,
+	}
+}
+
+func (c *cancelSessionTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("cancel_session",
+		mcp.WithDescription("Cancel a pending signing session so its URL can no longer be signed, e.g. a launch prepared with the wrong parameters. "+
+			"The deployments and liquidity pools recorded for the session that are still pending are deleted, the transactions already confirmed on chain are kept. Expired sessions can be cancelled too."),
+		mcp.WithString("session_id",
+			mcp.Required(),
+			mcp.Description("ID of the pending session to cancel"),
+		),
+	)
+
+	return tool
+}
+
+func (c *cancelSessionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CancelSessionArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		// expired sessions are listed too, their pending records are orphaned the same way
+		sessions, err := c.txService.ListTransactionSessionsByIDs([]string{args.SessionID})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+		}
+		if len(sessions) == 0 {
+			return mcp.NewToolResultError("Session not found"), nil
+		}
+		session := sessions[0]
+		if userID := utils.GetUserID(ctx); userID != "" && (session.UserID == nil || *session.UserID != userID) {
+			return mcp.NewToolResultError("Session not found"), nil
+		}
+		if session.TransactionStatus != models.TransactionStatusPending {
+			return mcp.NewToolResultError(fmt.Sprintf("Session is %s, only pending sessions can be cancelled", session.TransactionStatus)), nil
+		}
+
+		result, err := c.txService.CancelTransactionSession(session.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to cancel session: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Session %s cancelled, deleted %d pending deployments and %d pending liquidity pools", session.ID, len(result.DeletedDeploymentIDs), len(result.DeletedLiquidityPoolIDs))),
+		}
+		if session.SafeProposal != nil {
+			content = append(content, mcp.NewTextContent(fmt.Sprintf("The transactions proposed to Safe %s are still in the Safe queue, reject them in the Safe app", session.SafeProposal.SafeAddress)))
+		}
+		resultJSON, _ := json.Marshal(result)
+		content = append(content, mcp.NewTextContent(string(resultJSON)))
+		return &mcp.CallToolResult{Content: content}, nil
+	}
+}
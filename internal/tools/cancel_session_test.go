@@ -0,0 +1,59 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelSession(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	txService := services.NewTransactionService(db)
+	owner := "user-1"
+	sessionID, err := txService.CreateTransactionSessionWithUser(services.CreateTransactionSessionRequest{
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                chain.ID,
+		TransactionDeployments: []models.TransactionDeployment{{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeTokenDeployment}},
+	}, &owner)
+	require.NoError(t, err)
+	deployment := &models.Deployment{ChainID: chain.ID, UserID: &owner, SessionId: sessionID, Status: models.TransactionStatusPending}
+	require.NoError(t, db.Create(deployment).Error)
+
+	handler := NewCancelSessionTool(txService).GetHandler()
+	call := func(ctx context.Context) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"session_id": sessionID}}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"}))
+	require.True(t, result.IsError)
+	assert.Equal(t, "Session not found", result.Content[0].(mcp.TextContent).Text)
+
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+	result = call(ctx)
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "deleted 1 pending deployments and 0 pending liquidity pools")
+
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusCancelled, session.TransactionStatus)
+	_, err = services.NewDeploymentService(db).GetDeploymentByID(deployment.ID)
+	assert.Error(t, err)
+
+	result = call(ctx)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Session is cancelled")
+}
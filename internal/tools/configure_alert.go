@@ -0,0 +1,246 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	alertActionCreate = "create"
+	alertActionList   = "list"
+	alertActionDelete = "delete"
+)
+
+type configureAlertTool struct {
+	chainService           services.ChainService
+	liquidityService       services.LiquidityService
+	uniswapContractService services.UniswapContractService
+	indexerService         services.IndexerService
+	alertService           services.AlertService
+	notifier               services.AlertNotifier
+}
+
+type ConfigureAlertArguments struct {
+	// Required fields
+	Action string `json:"action" validate:"required,oneof=create list delete"`
+
+	// Optional fields
+	PoolID           string `json:"pool_id,omitempty" validate:"required_if=Action create"`
+	Type             string `json:"type,omitempty" validate:"required_if=Action create,omitempty,oneof=reserve_drop price_move creator_removal"`
+	ThresholdPercent string `json:"threshold_percent,omitempty"`
+	Channel          string `json:"channel,omitempty" validate:"required_if=Action create,omitempty,oneof=webhook telegram"`
+	WebhookURL       string `json:"webhook_url,omitempty" validate:"omitempty,url"`
+	TelegramChatID   string `json:"telegram_chat_id,omitempty"`
+	AlertRuleID      string `json:"alert_rule_id,omitempty" validate:"required_if=Action delete"`
+}
+
+func NewConfigureAlertTool(chainService services.ChainService, liquidityService services.LiquidityService, uniswapContractService services.UniswapContractService, indexerService services.IndexerService, alertService services.AlertService, notifier services.AlertNotifier) *configureAlertTool {
+	return &configureAlertTool{
+		chainService:           chainService,
+		liquidityService:       liquidityService,
+		uniswapContractService: uniswapContractService,
+		indexerService:         indexerService,
+		alertService:           alertService,
+		notifier:               notifier,
+	}
+}
+
+func (c *configureAlertTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("configure_alert",
+		mcp.WithDescription("Create, list or delete pool health alert rules on the Uniswap pools of the active chain. "+
+			"reserve_drop fires when a reserve falls by more than threshold_percent from its high, price_move when the pool price moves by more than threshold_percent in either direction, "+
+			"creator_removal when the pool creator removes liquidity (detected from the LP transfers of the event indexer). "+
+			"Rules are evaluated in the background, they fire once per move past the threshold and are delivered as a JSON POST to a webhook or as a Telegram message."),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("create adds a rule, list returns the rules with their last trigger and delivery error, delete removes a rule"),
+			mcp.Enum(alertActionCreate, alertActionList, alertActionDelete),
+		),
+		mcp.WithString("pool_id",
+			mcp.Description("ID of the confirmed liquidity pool to watch. Required for create"),
+		),
+		mcp.WithString("type",
+			mcp.Description("Rule to evaluate. Required for create"),
+			mcp.Enum(string(models.AlertRuleTypeReserveDrop), string(models.AlertRuleTypePriceMove), string(models.AlertRuleTypeCreatorRemoval)),
+		),
+		mcp.WithString("threshold_percent",
+			mcp.Description("Change in percent that fires the rule (e.g., '20' for 20%). Required for reserve_drop and price_move"),
+		),
+		mcp.WithString("channel",
+			mcp.Description("Delivery channel of the alerts. Required for create"),
+			mcp.Enum(string(models.AlertChannelWebhook), string(models.AlertChannelTelegram)),
+		),
+		mcp.WithString("webhook_url",
+			mcp.Description("HTTP(S) URL the alerts are posted to. Required for the webhook channel"),
+		),
+		mcp.WithString("telegram_chat_id",
+			mcp.Description(fmt.Sprintf("Telegram chat the bot of %s sends the alerts to. Required for the telegram channel", services.EnvTelegramBotToken)),
+		),
+		mcp.WithString("alert_rule_id",
+			mcp.Description("ID of the alert rule. Required for delete"),
+		),
+	)
+
+	return tool
+}
+
+func (c *configureAlertTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ConfigureAlertArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		switch args.Action {
+		case alertActionList:
+			return c.list(ctx)
+		case alertActionDelete:
+			return c.delete(ctx, args.AlertRuleID)
+		default:
+			return c.create(ctx, args)
+		}
+	}
+}
+
+func (c *configureAlertTool) create(ctx context.Context, args ConfigureAlertArguments) (*mcp.CallToolResult, error) {
+	ruleType := models.AlertRuleType(args.Type)
+	var threshold float64
+	if ruleType != models.AlertRuleTypeCreatorRemoval {
+		var err error
+		threshold, err = strconv.ParseFloat(args.ThresholdPercent, 64)
+		if err != nil || threshold <= 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("threshold_percent must be a positive number for %s rules", ruleType)), nil
+		}
+	}
+
+	channel := models.AlertChannel(args.Channel)
+	target := args.WebhookURL
+	switch channel {
+	case models.AlertChannelWebhook:
+		parsed, err := url.Parse(args.WebhookURL)
+		if args.WebhookURL == "" || err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return mcp.NewToolResultError("webhook_url must be an http or https URL for the webhook channel"), nil
+		}
+	case models.AlertChannelTelegram:
+		if args.TelegramChatID == "" {
+			return mcp.NewToolResultError("telegram_chat_id is required for the telegram channel"), nil
+		}
+		if !c.notifier.TelegramEnabled() {
+			return mcp.NewToolResultError(fmt.Sprintf("Telegram alerts are not available, %s is not set on the server", services.EnvTelegramBotToken)), nil
+		}
+		target = args.TelegramChatID
+	}
+
+	activeChain, err := c.chainService.ResolveChain(ctx)
+	if err != nil {
+		return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+	}
+	if activeChain.ChainType != models.TransactionChainTypeEthereum {
+		return mcp.NewToolResultError(fmt.Sprintf("Pool alerts are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+	}
+
+	poolID, err := strconv.ParseUint(args.PoolID, 10, 32)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid pool_id format: %v", err)), nil
+	}
+	pool, err := c.liquidityService.GetLiquidityPool(uint(poolID))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Liquidity pool not found: %v", err)), nil
+	}
+	// Authenticated users can only watch their own pools
+	if userID := utils.GetUserID(ctx); userID != "" && (pool.UserID == nil || *pool.UserID != userID) {
+		return mcp.NewToolResultError("Liquidity pool not found"), nil
+	}
+	if pool.Status != models.TransactionStatusConfirmed || pool.PairAddress == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("Liquidity pool %d is not confirmed yet", pool.ID)), nil
+	}
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	rule := &models.AlertRule{
+		UserID:           userId,
+		ChainID:          activeChain.ID,
+		PoolID:           pool.ID,
+		Type:             ruleType,
+		ThresholdPercent: threshold,
+		Channel:          channel,
+		Target:           target,
+	}
+	// The rule compares against the pool as it is now, the monitor reads the reserves on its first run otherwise
+	if reserve0, reserve1, err := c.uniswapContractService.GetReserves(pool.PairAddress, activeChain); err == nil {
+		rule.BaselineReserve0, rule.BaselineReserve1 = reserve0.String(), reserve1.String()
+	}
+	if block, indexed, err := c.indexerService.GetLastSyncedBlock(activeChain.ID, pool.PairAddress); err == nil && indexed {
+		rule.LastCheckedBlock = block
+	}
+	if err := c.alertService.CreateAlertRule(rule); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to create alert rule: %v", err)), nil
+	}
+
+	ruleJSON, _ := json.Marshal(rule)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Alert rule %d created on pool %d: ", rule.ID, pool.ID)),
+			mcp.NewTextContent(string(ruleJSON)),
+		},
+	}, nil
+}
+
+func (c *configureAlertTool) list(ctx context.Context) (*mcp.CallToolResult, error) {
+	var rules []models.AlertRule
+	var err error
+	if userID := utils.GetUserID(ctx); userID != "" {
+		rules, err = c.alertService.ListAlertRulesByUser(userID)
+	} else {
+		rules, err = c.alertService.ListAlertRules()
+	}
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list alert rules: %v", err)), nil
+	}
+
+	rulesJSON, _ := json.Marshal(rules)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Found %d alert rules: ", len(rules))),
+			mcp.NewTextContent(string(rulesJSON)),
+		},
+	}, nil
+}
+
+func (c *configureAlertTool) delete(ctx context.Context, alertRuleID string) (*mcp.CallToolResult, error) {
+	ruleID, err := strconv.ParseUint(alertRuleID, 10, 32)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid alert_rule_id format: %v", err)), nil
+	}
+	rule, err := c.alertService.GetAlertRule(uint(ruleID))
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Alert rule not found: %v", err)), nil
+	}
+	// Authenticated users can only delete their own rules
+	if userID := utils.GetUserID(ctx); userID != "" && (rule.UserID == nil || *rule.UserID != userID) {
+		return mcp.NewToolResultError("Alert rule not found"), nil
+	}
+
+	if err := c.alertService.DeleteAlertRule(rule.ID); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to delete alert rule: %v", err)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Alert rule %d deleted", rule.ID)), nil
+}
@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureAlert(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	pool := &models.LiquidityPool{TokenAddress: portfolioToken, PairAddress: portfolioPair, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+
+	alertService := services.NewAlertService(db.GetDB())
+	handler := NewConfigureAlertTool(chainService, liquidityService, portfolioContractService{}, services.NewIndexerService(db.GetDB()), alertService, services.NewAlertNotifier(nil, "", "")).GetHandler()
+	call := func(ctx context.Context, args map[string]any) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+	poolID := strconv.FormatUint(uint64(pool.ID), 10)
+
+	t.Run("creates a rule with the current reserves as baseline", func(t *testing.T) {
+		result := call(context.Background(), map[string]any{"action": "create", "pool_id": poolID, "type": "reserve_drop", "threshold_percent": "20", "channel": "webhook", "webhook_url": "https://example.com/hook"})
+		require.False(t, result.IsError, result.Content)
+
+		var rule models.AlertRule
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &rule))
+		assert.Equal(t, 20.0, rule.ThresholdPercent)
+		assert.Equal(t, "https://example.com/hook", rule.Target)
+		assert.Equal(t, "1000000", rule.BaselineReserve0)
+		assert.True(t, rule.Enabled)
+	})
+
+	t.Run("validates the rule", func(t *testing.T) {
+		for name, args := range map[string]map[string]any{
+			"missing threshold":      {"action": "create", "pool_id": poolID, "type": "price_move", "channel": "webhook", "webhook_url": "https://example.com/hook"},
+			"non http webhook":       {"action": "create", "pool_id": poolID, "type": "creator_removal", "channel": "webhook", "webhook_url": "ftp://example.com/hook"},
+			"telegram without a bot": {"action": "create", "pool_id": poolID, "type": "creator_removal", "channel": "telegram", "telegram_chat_id": "-1001"},
+			"unknown pool":           {"action": "create", "pool_id": "999", "type": "creator_removal", "channel": "webhook", "webhook_url": "https://example.com/hook"},
+			"missing rule id":        {"action": "delete"},
+		} {
+			assert.True(t, call(context.Background(), args).IsError, name)
+		}
+	})
+
+	t.Run("lists and deletes rules of the user only", func(t *testing.T) {
+		ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+		result := call(ctx, map[string]any{"action": "list"})
+		require.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Found 0 alert rules")
+
+		rules, err := alertService.ListAlertRules()
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		ruleID := strconv.FormatUint(uint64(rules[0].ID), 10)
+		assert.True(t, call(ctx, map[string]any{"action": "delete", "alert_rule_id": ruleID}).IsError)
+
+		result = call(context.Background(), map[string]any{"action": "delete", "alert_rule_id": ruleID})
+		require.False(t, result.IsError)
+		rules, err = alertService.ListAlertRules()
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+}
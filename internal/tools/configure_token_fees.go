@@ -0,0 +1,336 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	tokenFeesActionRead   = "read"
+	tokenFeesActionUpdate = "update"
+)
+
+type configureTokenFeesTool struct {
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	evmService        services.EvmService
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type ConfigureTokenFeesArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+	Action       string `json:"action" validate:"required,oneof=read update"`
+
+	// Optional fields
+	BuyFee      string `json:"buy_fee,omitempty"`
+	SellFee     string `json:"sell_fee,omitempty"`
+	FeeReceiver string `json:"fee_receiver,omitempty"`
+}
+
+// TokenFeeValue is the current value of a fee setting, its declared maximum and the requested value of an update
+type TokenFeeValue struct {
+	Setting  utils.TokenFeeSetting `json:"setting"`
+	Function string                `json:"function"`
+	Current  string                `json:"current,omitempty"`
+	Maximum  string                `json:"maximum,omitempty"`
+	New      string                `json:"new,omitempty"`
+}
+
+func NewConfigureTokenFeesTool(chainService services.ChainService, deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, serverPort int) *configureTokenFeesTool {
+	return &configureTokenFeesTool{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		evmService:        evmService,
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (c *configureTokenFeesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("configure_token_fees",
+		mcp.WithDescription("Manage the fees of a deployed taxed token whose template has setBuyFee/setSellFee/setFeeReceiver style functions. read returns the current fees and the maximums declared by the template. update validates the new fees against the declared maximums and creates a transaction session with a signing URL showing the values before and after the update."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed deployment"),
+		),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("read to get the current fees, update to change them"),
+			mcp.Enum(tokenFeesActionRead, tokenFeesActionUpdate),
+		),
+		mcp.WithString("buy_fee",
+			mcp.Description("New buy fee in the unit of the contract (e.g. basis points). Used by update"),
+		),
+		mcp.WithString("sell_fee",
+			mcp.Description("New sell fee in the unit of the contract (e.g. basis points). Used by update"),
+		),
+		mcp.WithString("fee_receiver",
+			mcp.Description("New address receiving the fees. Used by update"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (c *configureTokenFeesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ConfigureTokenFeesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(c.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		activeChain, err := c.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Token fee configuration is only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if deployment.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)), nil
+		}
+
+		functions, err := utils.FindTokenFeeFunctions(abiString)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		values, err := c.readFees(deployment, abiString, activeChain, functions)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read current fees: %v", err)), nil
+		}
+
+		if args.Action == tokenFeesActionRead {
+			valuesJSON, _ := json.Marshal(values)
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Current fees of %s: ", deployment.ContractAddress)),
+					mcp.NewTextContent(string(valuesJSON)),
+				},
+			}, nil
+		}
+
+		requested := map[utils.TokenFeeSetting]string{
+			utils.TokenFeeSettingBuyFee:      args.BuyFee,
+			utils.TokenFeeSettingSellFee:     args.SellFee,
+			utils.TokenFeeSettingFeeReceiver: args.FeeReceiver,
+		}
+		updates, err := validateFeeUpdates(values, requested)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		sessionID, err := c.createUpdateSession(ctx, deployment, abiString, activeChain, updates)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create fee update: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, c.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		updatesJSON, _ := json.Marshal(updates)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Fee update session created: %s", sessionID)),
+				mcp.NewTextContent(string(updatesJSON)),
+				mcp.NewTextContent("Please sign the transactions in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// readFees reads the current value and declared maximum of every fee setting the contract can update
+func (c *configureTokenFeesTool) readFees(deployment *models.Deployment, abiString string, activeChain *models.Chain, functions map[utils.TokenFeeSetting]utils.TokenFeeFunctions) ([]TokenFeeValue, error) {
+	var values []TokenFeeValue
+	for _, setting := range utils.TokenFeeSettings {
+		function, ok := functions[setting]
+		if !ok {
+			continue
+		}
+
+		value := TokenFeeValue{Setting: setting, Function: function.Setter}
+		var err error
+		if function.Getter != "" {
+			if value.Current, err = c.readValue(deployment, abiString, activeChain, function.Getter); err != nil {
+				return nil, err
+			}
+		}
+		if function.Maximum != "" {
+			if value.Maximum, err = c.readValue(deployment, abiString, activeChain, function.Maximum); err != nil {
+				return nil, err
+			}
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func (c *configureTokenFeesTool) readValue(deployment *models.Deployment, abiString string, activeChain *models.Chain, functionName string) (string, error) {
+	result, err := c.evmService.CallReadOnlyEthereumFunction(services.CallReadOnlyEthereumFunctionArgs{
+		ContractAddress: deployment.ContractAddress,
+		FunctionName:    functionName,
+		FunctionArgs:    []any{},
+		Abi:             abiString,
+		RpcURL:          activeChain.RPC,
+		Value:           "0",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to call %s: %w", functionName, err)
+	}
+	if len(result) == 0 {
+		return "", fmt.Errorf("%s returned no value", functionName)
+	}
+	return fmt.Sprintf("%v", result[0]), nil
+}
+
+// validateFeeUpdates checks the requested values against the declared maximums and returns the settings that change
+func validateFeeUpdates(values []TokenFeeValue, requested map[utils.TokenFeeSetting]string) ([]TokenFeeValue, error) {
+	known := map[utils.TokenFeeSetting]TokenFeeValue{}
+	for _, value := range values {
+		known[value.Setting] = value
+	}
+
+	var updates []TokenFeeValue
+	for _, setting := range utils.TokenFeeSettings {
+		newValue := strings.TrimSpace(requested[setting])
+		if newValue == "" {
+			continue
+		}
+
+		value, ok := known[setting]
+		if !ok {
+			return nil, fmt.Errorf("Contract has no function to update the %s", setting)
+		}
+
+		if setting == utils.TokenFeeSettingFeeReceiver {
+			if !utils.IsValidEthereumAddress(newValue) {
+				return nil, fmt.Errorf("Invalid fee_receiver address: %q", newValue)
+			}
+			address := common.HexToAddress(newValue)
+			if address == (common.Address{}) {
+				return nil, fmt.Errorf("The fee_receiver cannot be the zero address")
+			}
+			newValue = address.Hex()
+			if value.Current != "" && strings.EqualFold(value.Current, newValue) {
+				return nil, fmt.Errorf("The fee_receiver is already %s", newValue)
+			}
+		} else {
+			fee, ok := new(big.Int).SetString(newValue, 10)
+			if !ok || fee.Sign() < 0 {
+				return nil, fmt.Errorf("Invalid %s: must be a non-negative integer", setting)
+			}
+			if value.Maximum == "" {
+				return nil, fmt.Errorf("The template declares no maximum for the %s (e.g. a MAX_FEE constant), refusing to update it", setting)
+			}
+			maximum, ok := new(big.Int).SetString(value.Maximum, 10)
+			if !ok {
+				return nil, fmt.Errorf("Invalid maximum %s for the %s", value.Maximum, setting)
+			}
+			if fee.Cmp(maximum) > 0 {
+				return nil, fmt.Errorf("The %s of %s exceeds the maximum of %s declared by the template", setting, fee, maximum)
+			}
+			newValue = fee.String()
+			if value.Current == newValue {
+				return nil, fmt.Errorf("The %s is already %s", setting, newValue)
+			}
+		}
+
+		value.New = newValue
+		updates = append(updates, value)
+	}
+
+	if len(updates) == 0 {
+		return nil, fmt.Errorf("At least one of buy_fee, sell_fee or fee_receiver is required")
+	}
+	return updates, nil
+}
+
+func (c *configureTokenFeesTool) createUpdateSession(ctx context.Context, deployment *models.Deployment, abiString string, activeChain *models.Chain, updates []TokenFeeValue) (string, error) {
+	metadata := []models.TransactionMetadata{
+		{Key: "deployment_id", Value: fmt.Sprintf("%d", deployment.ID)},
+	}
+
+	var transactions []models.TransactionDeployment
+	for _, update := range updates {
+		functionArgs := []any{update.New}
+		before := update.Current
+		if before == "" {
+			before = "unknown"
+		}
+
+		tx, err := c.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: deployment.ContractAddress,
+			FunctionName:    update.Function,
+			FunctionArgs:    functionArgs,
+			Abi:             abiString,
+			Value:           "0",
+			Title:           fmt.Sprintf("Update %s", strings.ReplaceAll(string(update.Setting), "_", " ")),
+			Description:     fmt.Sprintf("Change the %s of contract %s from %s to %s", update.Setting, deployment.ContractAddress, before, update.New),
+			TransactionType: models.TransactionTypeTokenFeeUpdate,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to create %s transaction: %w", update.Function, err)
+		}
+
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(update.Function, functionArgs, abiString)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		tx.RawContractArguments = &functionArgsString
+		tx.ContractAddress = &deployment.ContractAddress
+		transactions = append(transactions, tx)
+
+		metadata = append(metadata, models.TransactionMetadata{
+			Key:   string(update.Setting),
+			Value: fmt.Sprintf("%s -> %s", before, update.New),
+		})
+	}
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	sessionID, err := c.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: transactions,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata:               metadata,
+		UserID:                 userId,
+		Context:                ctx,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+
+	return sessionID, nil
+}
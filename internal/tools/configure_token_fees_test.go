@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	feeContract = "0x1111111111111111111111111111111111111111"
+	feeReceiver = "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+)
+
+const feeTemplateAbi = `[
+	{"type":"function","name":"setBuyFee","stateMutability":"nonpayable","inputs":[{"name":"fee","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"setSellFee","stateMutability":"nonpayable","inputs":[{"name":"fee","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"setFeeReceiver","stateMutability":"nonpayable","inputs":[{"name":"receiver","type":"address"}],"outputs":[]},
+	{"type":"function","name":"buyFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"sellFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"feeReceiver","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"MAX_FEE","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+type ConfigureTokenFeesToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	rpcServer         *httptest.Server
+	tool              *configureTokenFeesTool
+	deployment        *models.Deployment
+	deploymentService services.DeploymentService
+	txService         services.TransactionService
+}
+
+func (suite *ConfigureTokenFeesToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	// The RPC serves a token with a 3% buy fee, 5% sell fee and a 10% maximum in basis points
+	results := map[string][]byte{
+		"buyFee()":      common.LeftPadBytes(big.NewInt(300).Bytes(), 32),
+		"sellFee()":     common.LeftPadBytes(big.NewInt(500).Bytes(), 32),
+		"MAX_FEE()":     common.LeftPadBytes(big.NewInt(1000).Bytes(), 32),
+		"feeReceiver()": common.LeftPadBytes(common.HexToAddress(feeReceiver).Bytes(), 32),
+	}
+	suite.rpcServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     interface{}              `json:"id"`
+			Params []map[string]interface{} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		result := "0x"
+		if len(request.Params) > 0 {
+			input, _ := request.Params[0]["input"].(string)
+			for signature, value := range results {
+				if strings.HasPrefix(input, "0x"+hex.EncodeToString(utils.FunctionSelector(signature))) {
+					result = "0x" + hex.EncodeToString(value)
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+
+	chainService := services.NewChainService(db.GetDB())
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tool = NewConfigureTokenFeesTool(chainService, suite.deploymentService, services.NewEvmService(), suite.txService, 8080)
+
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       suite.rpcServer.URL,
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(chainService.CreateChain(chain))
+
+	var abiArray []interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(feeTemplateAbi), &abiArray))
+	template := &models.Template{
+		Name:      "Taxed Token",
+		ChainType: models.TransactionChainTypeEthereum,
+		Abi:       models.JSON(map[string]interface{}{"abi": abiArray}),
+	}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+
+	deployment := &models.Deployment{
+		ChainID:         chain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: feeContract,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	suite.deployment = deployment
+}
+
+func (suite *ConfigureTokenFeesToolTestSuite) TearDownSuite() {
+	suite.rpcServer.Close()
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ConfigureTokenFeesToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	arguments["deployment_id"] = fmt.Sprintf("%d", suite.deployment.ID)
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *ConfigureTokenFeesToolTestSuite) TestReadFees() {
+	result := suite.callHandler(map[string]interface{}{"action": "read"})
+	suite.Require().False(result.IsError, result.Content)
+	suite.Require().Len(result.Content, 2)
+
+	var values []TokenFeeValue
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &values))
+	suite.Equal([]TokenFeeValue{
+		{Setting: utils.TokenFeeSettingBuyFee, Function: "setBuyFee", Current: "300", Maximum: "1000"},
+		{Setting: utils.TokenFeeSettingSellFee, Function: "setSellFee", Current: "500", Maximum: "1000"},
+		{Setting: utils.TokenFeeSettingFeeReceiver, Function: "setFeeReceiver", Current: feeReceiver},
+	}, values)
+}
+
+func (suite *ConfigureTokenFeesToolTestSuite) TestUpdateFees() {
+	result := suite.callHandler(map[string]interface{}{
+		"action":   "update",
+		"buy_fee":  "200",
+		"sell_fee": "1000",
+	})
+	suite.Require().False(result.IsError, result.Content)
+
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	var sessionID string
+	_, err := fmt.Sscanf(textContent.Text, "Fee update session created: %s", &sessionID)
+	suite.Require().NoError(err)
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Require().Len(session.TransactionDeployments, 2)
+	suite.Equal(models.TransactionTypeTokenFeeUpdate, session.TransactionDeployments[0].TransactionType)
+	suite.Equal(feeContract, session.TransactionDeployments[0].Receiver)
+	suite.Contains(session.TransactionDeployments[0].Description, "from 300 to 200")
+	suite.Contains(session.Metadata, models.TransactionMetadata{Key: "sell_fee", Value: "500 -> 1000"})
+}
+
+func (suite *ConfigureTokenFeesToolTestSuite) TestRejectsInvalidUpdates() {
+	cases := map[string]map[string]interface{}{
+		"exceeds the maximum of 1000": {"buy_fee": "1001"},
+		"non-negative integer":        {"sell_fee": "-1"},
+		"is already 300":              {"buy_fee": "300"},
+		"Invalid fee_receiver":        {"fee_receiver": "0x123"},
+		"zero address":                {"fee_receiver": "0x0000000000000000000000000000000000000000"},
+		"At least one of":             {},
+	}
+	for message, arguments := range cases {
+		arguments["action"] = "update"
+
+		result := suite.callHandler(arguments)
+		suite.True(result.IsError, message)
+		textContent, _ := result.Content[0].(mcp.TextContent)
+		suite.Contains(textContent.Text, message)
+	}
+}
+
+func TestConfigureTokenFeesToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigureTokenFeesToolTestSuite))
+}
@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// adminRole is the role of the users allowed to manage the API keys
+const adminRole = "admin"
+
+type createAPIKeyTool struct {
+	apiKeyService services.APIKeyService
+}
+
+type CreateAPIKeyArguments struct {
+	Name      string   `json:"name" validate:"required"`
+	Scopes    []string `json:"scopes" validate:"required,min=1,dive,required"`
+	Subject   string   `json:"subject,omitempty"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+}
+
+// CreateAPIKeyResult is the result of create_api_key, the key is only returned here
+type CreateAPIKeyResult struct {
+	Key       string     `json:"key"`
+	ID        uint       `json:"id"`
+	Name      string     `json:"name"`
+	Subject   string     `json:"subject"`
+	Prefix    string     `json:"prefix"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+func NewCreateAPIKeyTool(apiKeyService services.APIKeyService) *createAPIKeyTool {
+	return &createAPIKeyTool{
+		apiKeyService: apiKeyService,
+	}
+}
+
+func (c *createAPIKeyTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("create_api_key",
+		mcp.WithDescription("Create an API key for a machine client such as a CI pipeline or a bot, sent in the X-API-Key header or as a Bearer token to the streamable-http server. "+
+			"The key calls the tools of its scopes as the subject user. Only its hash is stored: the key is shown once in the result and cannot be retrieved later. Requires the admin role."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the key, e.g. the pipeline using it"),
+		),
+		mcp.WithArray("scopes",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Names of the tools the key may call (e.g. launch, list_deployments), %q to read its sessions over HTTP, or %q for every tool", services.APIKeyScopeSessions, services.APIKeyScopeAll)),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("subject",
+			mcp.Description("User ID the key authenticates as, owning the sessions and records it creates. Optional, defaults to your user ID"),
+		),
+		mcp.WithString("expires_at",
+			mcp.Description("Expiry of the key as an RFC 3339 time (e.g. 2026-01-02T15:04:05Z) or a duration from now (e.g. 720h). Optional, defaults to never"),
+		),
+	)
+
+	return tool
+}
+
+func (c *createAPIKeyTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !utils.HasRole(ctx, adminRole) {
+			return mcp.NewToolResultError("create_api_key requires an authenticated user with the admin role"), nil
+		}
+
+		var args CreateAPIKeyArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		createdBy := utils.GetUserID(ctx)
+		req := services.CreateAPIKeyRequest{
+			Name:      strings.TrimSpace(args.Name),
+			Subject:   strings.TrimSpace(args.Subject),
+			Scopes:    args.Scopes,
+			CreatedBy: createdBy,
+		}
+		if req.Subject == "" {
+			req.Subject = createdBy
+		}
+
+		if args.ExpiresAt != "" {
+			if expiresAt, err := time.Parse(time.RFC3339, args.ExpiresAt); err == nil {
+				req.ExpiresAt = &expiresAt
+			} else if duration, err := time.ParseDuration(args.ExpiresAt); err == nil && duration > 0 {
+				expiresAt := time.Now().Add(duration)
+				req.ExpiresAt = &expiresAt
+			} else {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid expires_at %q: must be an RFC 3339 time or a duration such as 720h", args.ExpiresAt)), nil
+			}
+		}
+
+		key, record, err := c.apiKeyService.CreateAPIKey(req)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create the API key: %v", err)), nil
+		}
+
+		result := CreateAPIKeyResult{
+			Key:       key,
+			ID:        record.ID,
+			Name:      record.Name,
+			Subject:   record.Subject,
+			Prefix:    record.Prefix,
+			Scopes:    record.Scopes,
+			ExpiresAt: record.ExpiresAt,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("API key %s created with ID %d. Store the key now, it is not shown again: ", record.Name, record.ID)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
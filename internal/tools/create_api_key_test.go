@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callAPIKeyTool(t *testing.T, ctx context.Context, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) *mcp.CallToolResult {
+	result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	return result
+}
+
+func TestAPIKeyTools(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	apiKeyService := services.NewAPIKeyService(db.GetDB())
+	create := NewCreateAPIKeyTool(apiKeyService).GetHandler()
+	revoke := NewRevokeAPIKeyTool(apiKeyService).GetHandler()
+
+	admin := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "admin-1", Roles: []string{"admin"}})
+	user := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1", Roles: []string{"user"}})
+	args := map[string]any{"name": "ci", "scopes": []any{"launch", "list_deployments"}, "expires_at": "720h"}
+
+	assert.True(t, callAPIKeyTool(t, user, create, args).IsError)
+	assert.True(t, callAPIKeyTool(t, context.Background(), create, args).IsError)
+
+	result := callAPIKeyTool(t, admin, create, args)
+	require.False(t, result.IsError)
+	var created CreateAPIKeyResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &created))
+	assert.Equal(t, "admin-1", created.Subject)
+	assert.Equal(t, []string{"launch", "list_deployments"}, created.Scopes)
+	require.NotNil(t, created.ExpiresAt)
+
+	record, err := apiKeyService.AuthenticateAPIKey(created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, record.ID)
+
+	assert.True(t, callAPIKeyTool(t, admin, create, map[string]any{"name": "ci", "scopes": []any{}}).IsError)
+	assert.True(t, callAPIKeyTool(t, admin, create, map[string]any{"name": "ci", "scopes": []any{"*"}, "expires_at": "soon"}).IsError)
+
+	assert.True(t, callAPIKeyTool(t, user, revoke, map[string]any{"id": "1"}).IsError)
+	assert.True(t, callAPIKeyTool(t, admin, revoke, map[string]any{"id": "999"}).IsError)
+	require.False(t, callAPIKeyTool(t, admin, revoke, map[string]any{"id": "1"}).IsError)
+	_, err = apiKeyService.AuthenticateAPIKey(created.Key)
+	assert.ErrorIs(t, err, services.ErrAPIKeyInvalid)
+}
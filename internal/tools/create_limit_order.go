@@ -0,0 +1,208 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type createLimitOrderTool struct {
+	chainService      services.ChainService
+	liquidityService  services.LiquidityService
+	limitOrderService services.LimitOrderService
+}
+
+type CreateLimitOrderArguments struct {
+	// Required fields
+	FromToken         string `json:"from_token" validate:"required"`
+	ToToken           string `json:"to_token" validate:"required"`
+	Amount            string `json:"amount" validate:"required"`
+	TargetPrice       string `json:"target_price" validate:"required"`
+	SlippageTolerance string `json:"slippage_tolerance" validate:"required"`
+	UserAddress       string `json:"user_address" validate:"required"`
+
+	// Optional fields
+	ExpiresInHours string `json:"expires_in_hours,omitempty"`
+}
+
+func NewCreateLimitOrderTool(chainService services.ChainService, liquidityService services.LiquidityService, limitOrderService services.LimitOrderService) *createLimitOrderTool {
+	return &createLimitOrderTool{
+		chainService:      chainService,
+		liquidityService:  liquidityService,
+		limitOrderService: limitOrderService,
+	}
+}
+
+func (c *createLimitOrderTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("create_limit_order",
+		mcp.WithDescription("Create a limit order on a Uniswap pool of the active chain. The pool price is watched in the background and a ready-to-sign swap session is generated once swapping the amount returns at least target_price to_token per from_token. Use list_limit_orders to get the signing URL of triggered orders."),
+		mcp.WithString("from_token",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Address of the token to swap from (use %s for ETH)", services.EthTokenAddress)),
+		),
+		mcp.WithString("to_token",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Address of the token to swap to (use %s for ETH)", services.EthTokenAddress)),
+		),
+		mcp.WithString("amount",
+			mcp.Required(),
+			mcp.Description("Amount of tokens to swap (in wei for ETH, or smallest unit for tokens)"),
+		),
+		mcp.WithString("target_price",
+			mcp.Required(),
+			mcp.Description("Minimum amount of to_token received per from_token, in smallest units after the pool fee (e.g. '2000' to receive at least 2000 units per unit swapped)"),
+		),
+		mcp.WithString("slippage_tolerance",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Maximum slippage tolerance as percentage used when the swap is generated (e.g., '0.5' for 0.5%%), or '%s' to derive it from the pool depth at that time", utils.AutoSlippage)),
+		),
+		mcp.WithString("user_address",
+			mcp.Required(),
+			mcp.Description("Address that will execute the swap"),
+		),
+		mcp.WithString("expires_in_hours",
+			mcp.Description("Number of hours after which the order expires. Optional, orders never expire by default"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (c *createLimitOrderTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CreateLimitOrderArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := c.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Limit orders are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.UserAddress) {
+			return mcp.NewToolResultError("User address is not a valid Ethereum address"), nil
+		}
+
+		if strings.EqualFold(args.FromToken, args.ToToken) {
+			return mcp.NewToolResultError("Cannot swap token to itself"), nil
+		}
+
+		amount, ok := new(big.Int).SetString(args.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return mcp.NewToolResultError("Amount must be a positive integer in the smallest unit of the token"), nil
+		}
+
+		targetPrice, err := strconv.ParseFloat(args.TargetPrice, 64)
+		if err != nil || targetPrice <= 0 {
+			return mcp.NewToolResultError("Target price must be a positive number"), nil
+		}
+
+		if err := validateSlippageTolerance(args.SlippageTolerance); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid slippage tolerance: %v", err)), nil
+		}
+
+		var expiresAt *time.Time
+		if args.ExpiresInHours != "" {
+			hours, err := strconv.ParseFloat(args.ExpiresInHours, 64)
+			if err != nil || hours <= 0 {
+				return mcp.NewToolResultError("expires_in_hours must be a positive number"), nil
+			}
+			expiry := time.Now().Add(time.Duration(hours * float64(time.Hour)))
+			expiresAt = &expiry
+		}
+
+		pool, err := c.findPool(activeChain.ID, args.FromToken, args.ToToken)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		order := &models.LimitOrder{
+			UserID:            userId,
+			ChainID:           activeChain.ID,
+			PoolID:            pool.ID,
+			FromToken:         args.FromToken,
+			ToToken:           args.ToToken,
+			Amount:            args.Amount,
+			TargetPrice:       targetPrice,
+			SlippageTolerance: args.SlippageTolerance,
+			UserAddress:       args.UserAddress,
+			ExpiresAt:         expiresAt,
+		}
+		if err := c.limitOrderService.CreateLimitOrder(order); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create limit order: %v", err)), nil
+		}
+
+		orderJSON, _ := json.Marshal(order)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Limit order %d created. A swap session will be generated once the target price is reached: ", order.ID)),
+				mcp.NewTextContent(string(orderJSON)),
+			},
+		}, nil
+	}
+}
+
+// findPool returns the confirmed pool of the chain trading fromToken against toToken
+func (c *createLimitOrderTool) findPool(chainID uint, fromToken, toToken string) (*models.LiquidityPool, error) {
+	pools, err := c.liquidityService.ListConfirmedLiquidityPoolsByChain(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list liquidity pools: %v", err)
+	}
+
+	for _, pool := range pools {
+		if (strings.EqualFold(pool.Token0, fromToken) && strings.EqualFold(pool.Token1, toToken)) ||
+			(strings.EqualFold(pool.Token0, toToken) && strings.EqualFold(pool.Token1, fromToken)) {
+			return &pool, nil
+		}
+	}
+	return nil, fmt.Errorf("No confirmed liquidity pool found for %s and %s on the active chain", fromToken, toToken)
+}
+
+// NewLimitOrderExecutor returns the executor used by the limit order monitor to generate the swap session of a triggered order
+func NewLimitOrderExecutor(swapTool *swapTokensTool) services.LimitOrderExecutor {
+	return func(order models.LimitOrder) (string, error) {
+		swapSession, err := swapTool.CreateSwapSession(SwapTokensArguments{
+			FromToken:         order.FromToken,
+			ToToken:           order.ToToken,
+			Amount:            order.Amount,
+			SlippageTolerance: order.SlippageTolerance,
+			UserAddress:       order.UserAddress,
+			Metadata: []models.TransactionMetadata{
+				{Key: "limit_order_id", Value: strconv.FormatUint(uint64(order.ID), 10)},
+				{Key: "target_price", Value: strconv.FormatFloat(order.TargetPrice, 'g', -1, 64)},
+			},
+			background: true,
+		}, &order.Chain, order.UserID)
+		if err != nil {
+			return "", err
+		}
+		return swapSession.SessionID, nil
+	}
+}
@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	limitOrderToken = "0x1111111111111111111111111111111111111111"
+	limitOrderUser  = "0x4444444444444444444444444444444444444444"
+)
+
+type CreateLimitOrderToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	tool              *createLimitOrderTool
+	chainService      services.ChainService
+	liquidityService  services.LiquidityService
+	limitOrderService services.LimitOrderService
+	txService         services.TransactionService
+}
+
+func (suite *CreateLimitOrderToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.liquidityService = services.NewLiquidityService(db.GetDB())
+	suite.limitOrderService = services.NewLimitOrderService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tool = NewCreateLimitOrderTool(suite.chainService, suite.liquidityService, suite.limitOrderService)
+
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+
+	// Pools are matched to the chain through the session that created them
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   chain.ID,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.liquidityService.CreateLiquidityPool(&models.LiquidityPool{
+		TokenAddress: limitOrderToken,
+		PairAddress:  "0x3333333333333333333333333333333333333333",
+		Token0:       services.EthTokenAddress,
+		Token1:       limitOrderToken,
+		Status:       models.TransactionStatusConfirmed,
+		SessionId:    sessionID,
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *CreateLimitOrderToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *CreateLimitOrderToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *CreateLimitOrderToolTestSuite) validArguments() map[string]interface{} {
+	return map[string]interface{}{
+		"from_token":         services.EthTokenAddress,
+		"to_token":           limitOrderToken,
+		"amount":             "1000000000000000000",
+		"target_price":       "2000",
+		"slippage_tolerance": "0.5",
+		"user_address":       limitOrderUser,
+	}
+}
+
+func (suite *CreateLimitOrderToolTestSuite) TestCreateLimitOrder() {
+	args := suite.validArguments()
+	args["expires_in_hours"] = "24"
+	result := suite.callHandler(args)
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var order models.LimitOrder
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &order))
+	suite.Equal(models.LimitOrderStatusOpen, order.Status)
+	suite.Equal(2000.0, order.TargetPrice)
+	suite.NotNil(order.ExpiresAt)
+
+	stored, err := suite.limitOrderService.GetLimitOrder(order.ID)
+	suite.Require().NoError(err)
+	suite.Equal(limitOrderToken, stored.ToToken)
+}
+
+func (suite *CreateLimitOrderToolTestSuite) TestInvalidArguments() {
+	testCases := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"invalid user address", "user_address", "0x123"},
+		{"same token", "to_token", services.EthTokenAddress},
+		{"zero amount", "amount", "0"},
+		{"negative target price", "target_price", "-1"},
+		{"invalid slippage", "slippage_tolerance", "abc"},
+		{"invalid expiry", "expires_in_hours", "soon"},
+		{"no pool", "to_token", "0x5555555555555555555555555555555555555555"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			args := suite.validArguments()
+			args[tc.key] = tc.value
+			result := suite.callHandler(args)
+			suite.True(result.IsError)
+		})
+	}
+}
+
+func TestCreateLimitOrderToolTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateLimitOrderToolTestSuite))
+}
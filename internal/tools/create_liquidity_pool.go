@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
+	"math/big"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -16,12 +16,13 @@ import (
 )
 
 type createLiquidityPoolTool struct {
-	chainService     services.ChainService
-	evmService       services.EvmService
-	txService        services.TransactionService
-	liquidityService services.LiquidityService
-	uniswapService   services.UniswapService
-	serverPort       int
+	chainService        services.ChainService
+	evmService          services.EvmService
+	txService           services.TransactionService
+	liquidityService    services.LiquidityService
+	uniswapService      services.UniswapService
+	launchPolicyService services.LaunchPolicyService
+	serverPort          int
 }
 
 type CreateLiquidityPoolArguments struct {
@@ -34,16 +35,22 @@ type CreateLiquidityPoolArguments struct {
 
 	// Optional fields
 	Metadata []models.TransactionMetadata `json:"metadata,omitempty"`
+	// DexDeploymentID selects one of the DEX deployments of the chain, defaults to the default deployment
+	DexDeploymentID string `json:"dex_deployment_id,omitempty"`
+	DryRun          bool   `json:"dry_run,omitempty"`
+	LiquidityLockArguments
+	RouterTransactionOverrides
 }
 
-func NewCreateLiquidityPoolTool(chainService services.ChainService, serverPort int, evmService services.EvmService, txService services.TransactionService, liquidityService services.LiquidityService, uniswapService services.UniswapService) *createLiquidityPoolTool {
+func NewCreateLiquidityPoolTool(chainService services.ChainService, serverPort int, evmService services.EvmService, txService services.TransactionService, liquidityService services.LiquidityService, uniswapService services.UniswapService, launchPolicyService services.LaunchPolicyService) *createLiquidityPoolTool {
 	return &createLiquidityPoolTool{
-		chainService:     chainService,
-		evmService:       evmService,
-		txService:        txService,
-		liquidityService: liquidityService,
-		uniswapService:   uniswapService,
-		serverPort:       serverPort,
+		chainService:        chainService,
+		evmService:          evmService,
+		txService:           txService,
+		liquidityService:    liquidityService,
+		uniswapService:      uniswapService,
+		launchPolicyService: launchPolicyService,
+		serverPort:          serverPort,
 	}
 }
 
@@ -83,7 +90,16 @@ func (c *createLiquidityPoolTool) GetTool() mcp.Tool {
 				},
 			}),
 		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description(dexDeploymentIDDescription),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
 	)
+
+	for _, option := range append(liquidityLockOptions(), routerOverrideOptions()...) {
+		option(&tool)
+	}
 	return tool
 }
 
@@ -99,7 +115,7 @@ func (c *createLiquidityPoolTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get active chain configuration
-		activeChain, err := c.chainService.GetActiveChain()
+		activeChain, err := c.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
@@ -127,20 +143,14 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 	}
 
 	// get active chain
-	chain, err := c.chainService.GetActiveChain()
+	chain, err := c.chainService.ResolveChain(ctx)
 	if err != nil {
 		return mcp.NewToolResultError("Unable to get active chain. Is there any chain selected?"), nil
 	}
-	// Get active Uniswap settings
-	uniswapSettings, err := c.uniswapService.GetActiveUniswapDeployment(userId, *chain)
-	if err != nil {
-		return mcp.NewToolResultError("No Uniswap version selected. Please use set_uniswap_version tool first"), nil
-	}
-
-	// Get Uniswap deployment to retrieve WETH address
-	uniswapDeployment, err := c.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+	// Get the selected Uniswap deployment to retrieve its version and WETH address
+	uniswapDeployment, err := selectDexDeployment(c.uniswapService, userId, chain, args.DexDeploymentID)
 	if err != nil {
-		return mcp.NewToolResultError("No Uniswap deployment found for this chain. Please deploy Uniswap first using deploy_uniswap tool"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	// Verify WETH address is available
@@ -155,12 +165,12 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 		return mcp.NewToolResultError("Token0 and Token1 addresses cannot be the same"), nil
 	}
 
-	// Check if pool already exists - for now check based on token0 (could be enhanced to check both tokens)
-	existingPool, err := c.liquidityService.GetLiquidityPoolByTokenAddress(args.Token0Address, args.Token1Address)
+	// Check if pool already exists on the selected deployment, the same pair can be listed on several DEX deployments
+	existingPool, err := c.liquidityService.GetLiquidityPoolOnDexDeployment(args.Token0Address, args.Token1Address, *uniswapDeployment)
 	if err == nil && existingPool != nil {
 		// Check if pool is already confirmed
 		if existingPool.Status == models.TransactionStatusConfirmed {
-			return mcp.NewToolResultError("Liquidity pool already exists for this token pair"), nil
+			return mcp.NewToolResultError(fmt.Sprintf("Liquidity pool already exists for this token pair on DEX deployment %d", uniswapDeployment.ID)), nil
 		}
 	}
 
@@ -171,6 +181,33 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 		return mcp.NewToolResultError(fmt.Sprintf("Error calculating initial price: %v", err)), nil
 	}
 
+	overrides, err := parseRouterOverrides(args.RouterTransactionOverrides)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Enforce the liquidity lock rule of the launch policy before the session is created
+	var ethSide func() (*big.Int, error)
+	if isETHPair {
+		ethSide = func() (*big.Int, error) {
+			amount := args.InitialToken1Amount
+			if args.Token0Address == services.EthTokenAddress {
+				amount = args.InitialToken0Amount
+			}
+			parsed, ok := new(big.Int).SetString(amount, 10)
+			if !ok {
+				return nil, fmt.Errorf("Invalid ETH amount: %q", amount)
+			}
+			return parsed, nil
+		}
+	}
+	lockPlan, err := planLiquidityLock(c.launchPolicyService, activeChain.RPC, args.LiquidityLockArguments, ethSide)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	// The LP tokens of a locked pool are minted to the locker
+	lpReceiver := lockPlan.lpReceiver(args.OwnerAddress)
+
 	// Create transaction deployments for liquidity pool creation based on pair type
 	var transactionDeployments []models.TransactionDeployment
 	if isETHPair {
@@ -191,7 +228,8 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 			uniswapDeployment.WETHAddress,
 			nonEthTokenAmount,
 			ethTokenAmount,
-			args.OwnerAddress,
+			lpReceiver,
+			overrides.Deadline,
 		)
 	} else {
 		// Token pair: use addLiquidity
@@ -202,13 +240,37 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 			args.Token1Address,
 			args.InitialToken0Amount,
 			args.InitialToken1Amount,
-			args.OwnerAddress,
+			lpReceiver,
+			overrides.Deadline,
 		)
 	}
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity pool transactions: %v", err)), nil
 	}
 
+	// Approvals already covered by the allowance of the owner are left out of the session
+	transactionDeployments, skippedApprovals := omitSufficientApprovals(activeChain.RPC, args.OwnerAddress, transactionDeployments, map[string]string{
+		args.Token0Address: args.InitialToken0Amount,
+		args.Token1Address: args.InitialToken1Amount,
+	})
+
+	if lock := lockPlan.Lock; lock != nil {
+		tokenA, tokenB := args.Token0Address, args.Token1Address
+		if tokenA == services.EthTokenAddress {
+			tokenA = uniswapDeployment.WETHAddress
+		}
+		if tokenB == services.EthTokenAddress {
+			tokenB = uniswapDeployment.WETHAddress
+		}
+		lockTx, err := createLiquidityLockTransaction(c.evmService, lock, tokenA, tokenB, args.OwnerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity lock transaction: %v", err)), nil
+		}
+		transactionDeployments = append(transactionDeployments, lockTx)
+	}
+
+	overrides.apply(transactionDeployments)
+
 	enhancedMetadata := append(args.Metadata, models.TransactionMetadata{
 		Key:   services.MetadataToken0Address,
 		Value: args.Token0Address,
@@ -219,6 +281,17 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 		Value: args.Token1Address,
 	})
 
+	// The pair is looked up on the factory of the selected deployment, not necessarily the default one
+	enhancedMetadata = append(enhancedMetadata,
+		models.TransactionMetadata{Key: services.MetadataFactoryAddress, Value: uniswapDeployment.FactoryAddress},
+		models.TransactionMetadata{Key: services.MetadataWETHAddress, Value: uniswapDeployment.WETHAddress},
+	)
+
+	enhancedMetadata = skippedApprovalsMetadata(enhancedMetadata, skippedApprovals)
+
+	// The launch checklist shown on the signing page
+	enhancedMetadata = lockPlan.metadata(enhancedMetadata)
+
 	// Create transaction session with the liquidity pool transactions
 	balances := map[string]*string{}
 	isFirstTokenETH := args.Token0Address == services.EthTokenAddress
@@ -230,44 +303,58 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 	if !isSecondTokenETH {
 		balances[args.Token1Address] = nil
 	}
-	// Create transaction session
-	sessionID, err := c.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+	session := services.CreateTransactionSessionRequest{
 		TransactionDeployments: transactionDeployments,
 		ChainType:              models.TransactionChainTypeEthereum,
 		ChainID:                activeChain.ID,
 		Metadata:               enhancedMetadata,
 		UserID:                 userId,
 		Balances:               balances,
-	})
+	}
+	pool := &models.LiquidityPool{
+		TokenAddress:    args.Token0Address, // Use token0 as the primary token address for backward compatibility
+		UniswapVersion:  uniswapDeployment.Version,
+		Token0:          args.Token0Address,
+		Token1:          args.Token1Address,
+		InitialToken0:   args.InitialToken0Amount,
+		InitialToken1:   args.InitialToken1Amount,
+		CreatorAddress:  "",
+		Status:          models.TransactionStatusPending,
+		DexDeploymentID: &uniswapDeployment.ID,
+	}
+	if args.DryRun {
+		return newDryRunResult("create_liquidity_pool", []services.CreateTransactionSessionRequest{session}, DryRunRecord{Type: "liquidity_pool", Record: pool})
+	}
+
+	session.Context = ctx
+
+	// Create transaction session
+	sessionID, err := c.txService.CreateTransactionSession(session)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Error creating transaction session: %v", err)), nil
 	}
 
-	pool := &models.LiquidityPool{
-		TokenAddress:   args.Token0Address, // Use token0 as the primary token address for backward compatibility
-		UniswapVersion: uniswapSettings.Version,
-		Token0:         args.Token0Address,
-		Token1:         args.Token1Address,
-		InitialToken0:  args.InitialToken0Amount,
-		InitialToken1:  args.InitialToken1Amount,
-		CreatorAddress: "",
-		Status:         models.TransactionStatusPending,
-		SessionId:      sessionID,
-	}
+	pool.SessionId = sessionID
 	_, err = c.liquidityService.CreateLiquidityPool(pool)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity pool record: %v", err)), nil
 	}
 
-	url, err := utils.GetTransactionSessionUrl(c.serverPort, sessionID)
+	url, err := utils.GetTransactionSessionUrl(ctx, c.serverPort, sessionID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
 	}
+	poolUrl, err := utils.GetPoolPageUrl(ctx, c.serverPort, pool.ID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get pool page url: %v", err)), nil
+	}
 	return &mcp.CallToolResult{
 		Content: []mcp.Content{
 			mcp.NewTextContent(fmt.Sprintf("Transaction session created: %s", sessionID)),
 			mcp.NewTextContent("Please sign the liquidity pool creation transaction in the URL"),
 			mcp.NewTextContent(url),
+			mcp.NewTextContent(fmt.Sprintf("Pool details will be available at: %s", poolUrl)),
+			mcp.NewTextContent(fmt.Sprintf("Launch checklist: liquidity lock %s", liquidityLockChecklistItem(lockPlan.Check))),
 		},
 	}, nil
 }
@@ -280,7 +367,7 @@ func (c *createLiquidityPoolTool) createEthereumLiquidityPool(ctx context.Contex
 // token0Amount is the amount of custom token to add to the pool
 // token1Amount is the amount of ETH to add to the pool (will be sent as transaction value)
 // ownerAddress is the address that will receive the liquidity pool tokens
-func (c *createLiquidityPoolTool) createETHPairTransactions(factoryAddress, routerAddress, nonEthTokenAddress, wethAddress, nonEthTokenAmount, ethTokenAmount, ownerAddress string) ([]models.TransactionDeployment, error) {
+func (c *createLiquidityPoolTool) createETHPairTransactions(factoryAddress, routerAddress, nonEthTokenAddress, wethAddress, nonEthTokenAmount, ethTokenAmount, ownerAddress string, deadline int64) ([]models.TransactionDeployment, error) {
 	// Get Uniswap V2 contracts to extract Router ABI
 	v2Contracts, err := utils.FetchUniswapV2Contracts()
 	if err != nil {
@@ -298,9 +385,6 @@ func (c *createLiquidityPoolTool) createETHPairTransactions(factoryAddress, rout
 
 	// MaxUint256 for unlimited approval
 
-	// Calculate deadline (10 minutes from now)
-	deadline := time.Now().Unix() + 600
-
 	var transactionDeployments []models.TransactionDeployment
 
 	// Validate addresses before creating transactions
@@ -429,7 +513,7 @@ func (c *createLiquidityPoolTool) createPairTransaction(factoryAddress, token0Ad
 // token0Amount is the amount of first token to add to the pool
 // token1Amount is the amount of second token to add to the pool
 // ownerAddress is the address that will receive the liquidity pool tokens
-func (c *createLiquidityPoolTool) createTokenPairTransactions(factoryAddress, routerAddress, token0Address, token1Address, token0Amount, token1Amount, ownerAddress string) ([]models.TransactionDeployment, error) {
+func (c *createLiquidityPoolTool) createTokenPairTransactions(factoryAddress, routerAddress, token0Address, token1Address, token0Amount, token1Amount, ownerAddress string, deadline int64) ([]models.TransactionDeployment, error) {
 	// Get Uniswap V2 contracts to extract Router ABI
 	v2Contracts, err := utils.FetchUniswapV2Contracts()
 	if err != nil {
@@ -446,9 +530,6 @@ func (c *createLiquidityPoolTool) createTokenPairTransactions(factoryAddress, ro
 	erc20ABI := `[{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
 	// MaxUint256 for unlimited approval
 
-	// Calculate deadline (10 minutes from now)
-	deadline := time.Now().Unix() + 600
-
 	// Validate addresses before creating transactions
 	if !utils.IsValidEthereumAddress(token0Address) {
 		return nil, fmt.Errorf("invalid token0 address: %s", token0Address)
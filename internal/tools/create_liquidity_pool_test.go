@@ -100,6 +100,7 @@ func (suite *CreateLiquidityPoolTestSuite) SetupSuite() {
 		suite.txService,
 		suite.liquidityService,
 		suite.uniswapService,
+		services.NewLaunchPolicyService(db.GetDB()),
 	)
 
 	// Setup test data
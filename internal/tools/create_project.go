@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type createProjectTool struct {
+	projectService services.ProjectService
+}
+
+type CreateProjectArguments struct {
+	Name        string   `json:"name" validate:"required"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+func NewCreateProjectTool(projectService services.ProjectService) *createProjectTool {
+	return &createProjectTool{
+		projectService: projectService,
+	}
+}
+
+func (c *createProjectTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("create_project",
+		mcp.WithDescription("Create a named project grouping the templates, deployments, liquidity pools and transaction sessions of a launch. "+
+			"Assign records to it with assign_to_project and list them with list_project_assets, which also lists the projects by tag."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the project, unique among your projects"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Description of the project. Optional"),
+		),
+		mcp.WithArray("tags",
+			mcp.Description("Tags of the project (e.g. 'mainnet', 'q3-launch'), matched case-insensitively by list_project_assets. Optional"),
+			mcp.WithStringItems(),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (c *createProjectTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CreateProjectArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		name := strings.TrimSpace(args.Name)
+		if name == "" {
+			return mcp.NewToolResultError("Invalid arguments: name cannot be empty"), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		if existing, err := c.projectService.GetProjectByName(userId, name); err == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Project %s already exists with ID %d", name, existing.ID)), nil
+		}
+
+		project := &models.Project{
+			UserID:      userId,
+			Name:        name,
+			Description: args.Description,
+			Tags:        normalizeProjectTags(args.Tags),
+		}
+		if err := c.projectService.CreateProject(project); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create project: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(project)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Project %s created with ID %d, assign records to it with assign_to_project", project.Name, project.ID)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// normalizeProjectTags trims the tags and drops the empty and duplicated ones, keeping their order
+func normalizeProjectTags(tags []string) []string {
+	normalized := []string{}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+		seen[strings.ToLower(tag)] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
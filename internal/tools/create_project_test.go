@@ -0,0 +1,260 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProjectToolsTestSuite struct {
+	suite.Suite
+	db             services.DBService
+	projectService services.ProjectService
+	chain          *models.Chain
+	template       *models.Template
+	deployment     *models.Deployment
+	session        *models.TransactionSession
+}
+
+func (suite *ProjectToolsTestSuite) SetupTest() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.projectService = services.NewProjectService(db.GetDB())
+
+	suite.chain = &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.chain).Error)
+
+	suite.template = &models.Template{Name: "Launch Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract LaunchToken {}"}
+	suite.Require().NoError(db.GetDB().Create(suite.template).Error)
+
+	suite.session = &models.TransactionSession{
+		ID:                   "b8d4c1c2-59a3-4dbb-9d4e-2b8f6f0b7c11",
+		TransactionStatus:    models.TransactionStatusPending,
+		TransactionChainType: models.TransactionChainTypeEthereum,
+		ChainID:              suite.chain.ID,
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.session).Error)
+
+	suite.deployment = &models.Deployment{
+		TemplateID:      suite.template.ID,
+		ChainID:         suite.chain.ID,
+		ContractAddress: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		Status:          models.TransactionStatusConfirmed,
+		SessionId:       suite.session.ID,
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.deployment).Error)
+}
+
+func (suite *ProjectToolsTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ProjectToolsTestSuite) callTool(ctx context.Context, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), arguments map[string]interface{}) *mcp.CallToolResult {
+	result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *ProjectToolsTestSuite) createProject(ctx context.Context, name string, tags ...interface{}) uint {
+	result := suite.callTool(ctx, NewCreateProjectTool(suite.projectService).GetHandler(), map[string]interface{}{
+		"name": name,
+		"tags": tags,
+	})
+	suite.Require().False(result.IsError, "%v", result.Content)
+
+	var project models.Project
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &project))
+	return project.ID
+}
+
+func (suite *ProjectToolsTestSuite) TestCreateProject() {
+	result := suite.callTool(context.Background(), NewCreateProjectTool(suite.projectService).GetHandler(), map[string]interface{}{
+		"name":        "Summer Launch",
+		"description": "Mainnet launch of the summer token",
+		"tags":        []interface{}{"mainnet", " Q3 ", "MAINNET", ""},
+	})
+	suite.Require().False(result.IsError)
+
+	var project models.Project
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &project))
+	suite.Equal("Summer Launch", project.Name)
+	suite.Equal([]string{"mainnet", "Q3"}, project.Tags)
+
+	// Project names are unique per user
+	duplicate := suite.callTool(context.Background(), NewCreateProjectTool(suite.projectService).GetHandler(), map[string]interface{}{"name": "Summer Launch"})
+	suite.True(duplicate.IsError)
+	suite.Contains(duplicate.Content[0].(mcp.TextContent).Text, fmt.Sprintf("already exists with ID %d", project.ID))
+
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+	other := suite.callTool(ctx, NewCreateProjectTool(suite.projectService).GetHandler(), map[string]interface{}{"name": "Summer Launch"})
+	suite.False(other.IsError)
+}
+
+func (suite *ProjectToolsTestSuite) TestAssignAndListProjectAssets() {
+	projectID := suite.createProject(context.Background(), "Summer Launch", "mainnet")
+	assign := NewAssignToProjectTool(suite.projectService).GetHandler()
+
+	for assetType, ids := range map[string][]interface{}{
+		"template":   {fmt.Sprint(suite.template.ID)},
+		"deployment": {fmt.Sprint(suite.deployment.ID)},
+		"session":    {suite.session.ID},
+	} {
+		result := suite.callTool(context.Background(), assign, map[string]interface{}{
+			"project_id": fmt.Sprint(projectID),
+			"asset_type": assetType,
+			"asset_ids":  ids,
+		})
+		suite.Require().False(result.IsError, "%v", result.Content)
+	}
+
+	result := suite.callTool(context.Background(), NewListProjectAssetsTool(suite.projectService, 8080).GetHandler(), map[string]interface{}{
+		"project_id": fmt.Sprint(projectID),
+	})
+	suite.Require().False(result.IsError)
+
+	var report ProjectAssetsReport
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+	suite.Require().Len(report.Templates, 1)
+	suite.Equal("Launch Token", report.Templates[0].Name)
+	suite.Require().Len(report.Deployments, 1)
+	suite.Equal(suite.deployment.ContractAddress, report.Deployments[0].ContractAddress)
+	suite.Equal("Local", report.Deployments[0].ChainName)
+	suite.Require().Len(report.Sessions, 1)
+	suite.Contains(report.Sessions[0].URL, suite.session.ID)
+	suite.Empty(report.LiquidityPools)
+
+	// asset_type narrows the records
+	result = suite.callTool(context.Background(), NewListProjectAssetsTool(suite.projectService, 8080).GetHandler(), map[string]interface{}{
+		"project_id": fmt.Sprint(projectID),
+		"asset_type": "deployment",
+	})
+	report = ProjectAssetsReport{}
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+	suite.Empty(report.Templates)
+	suite.Len(report.Deployments, 1)
+}
+
+func (suite *ProjectToolsTestSuite) TestReassignMovesAsset() {
+	first := suite.createProject(context.Background(), "First")
+	second := suite.createProject(context.Background(), "Second")
+	assign := NewAssignToProjectTool(suite.projectService).GetHandler()
+
+	for _, projectID := range []uint{first, second} {
+		result := suite.callTool(context.Background(), assign, map[string]interface{}{
+			"project_id": fmt.Sprint(projectID),
+			"asset_type": "deployment",
+			"asset_ids":  []interface{}{fmt.Sprint(suite.deployment.ID)},
+		})
+		suite.Require().False(result.IsError)
+	}
+
+	project, err := suite.projectService.GetProject(first)
+	suite.Require().NoError(err)
+	suite.Empty(project.Assets)
+	project, err = suite.projectService.GetProject(second)
+	suite.Require().NoError(err)
+	suite.Require().Len(project.Assets, 1)
+	suite.Equal(fmt.Sprint(suite.deployment.ID), project.Assets[0].AssetID)
+}
+
+func (suite *ProjectToolsTestSuite) TestAssignErrors() {
+	projectID := suite.createProject(context.Background(), "Summer Launch")
+	assign := NewAssignToProjectTool(suite.projectService).GetHandler()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		arguments map[string]interface{}
+		errorMsg  string
+	}{
+		{
+			name:      "unknown_asset_type",
+			ctx:       context.Background(),
+			arguments: map[string]interface{}{"project_id": fmt.Sprint(projectID), "asset_type": "chain", "asset_ids": []interface{}{"1"}},
+			errorMsg:  "Invalid arguments",
+		},
+		{
+			name:      "non_numeric_id",
+			ctx:       context.Background(),
+			arguments: map[string]interface{}{"project_id": fmt.Sprint(projectID), "asset_type": "template", "asset_ids": []interface{}{"abc"}},
+			errorMsg:  "must be a numeric ID",
+		},
+		{
+			name:      "missing_asset",
+			ctx:       context.Background(),
+			arguments: map[string]interface{}{"project_id": fmt.Sprint(projectID), "asset_type": "liquidity_pool", "asset_ids": []interface{}{"999"}},
+			errorMsg:  "liquidity_pool 999 not found",
+		},
+		{
+			name:      "project_of_another_user",
+			ctx:       utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"}),
+			arguments: map[string]interface{}{"project_id": fmt.Sprint(projectID), "asset_type": "template", "asset_ids": []interface{}{fmt.Sprint(suite.template.ID)}},
+			errorMsg:  "Project not found",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result := suite.callTool(tt.ctx, assign, tt.arguments)
+			suite.True(result.IsError)
+			suite.Contains(result.Content[0].(mcp.TextContent).Text, tt.errorMsg)
+		})
+	}
+
+	// A failed assignment assigns none of the records
+	result := suite.callTool(context.Background(), assign, map[string]interface{}{
+		"project_id": fmt.Sprint(projectID),
+		"asset_type": "template",
+		"asset_ids":  []interface{}{fmt.Sprint(suite.template.ID), "999"},
+	})
+	suite.True(result.IsError)
+	project, err := suite.projectService.GetProject(projectID)
+	suite.Require().NoError(err)
+	suite.Empty(project.Assets)
+}
+
+func (suite *ProjectToolsTestSuite) TestListProjectsByTag() {
+	mainnet := suite.createProject(context.Background(), "Mainnet Launch", "Mainnet")
+	suite.createProject(context.Background(), "Testnet Launch", "testnet")
+
+	result := suite.callTool(context.Background(), NewAssignToProjectTool(suite.projectService).GetHandler(), map[string]interface{}{
+		"project_id": fmt.Sprint(mainnet),
+		"asset_type": "template",
+		"asset_ids":  []interface{}{fmt.Sprint(suite.template.ID)},
+	})
+	suite.Require().False(result.IsError)
+
+	result = suite.callTool(context.Background(), NewListProjectAssetsTool(suite.projectService, 8080).GetHandler(), map[string]interface{}{"tag": "mainnet"})
+	suite.Require().False(result.IsError)
+
+	var summaries []ProjectSummary
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &summaries))
+	suite.Require().Len(summaries, 1)
+	suite.Equal("Mainnet Launch", summaries[0].Name)
+	suite.Equal(1, summaries[0].AssetCounts[models.ProjectAssetTypeTemplate])
+
+	result = suite.callTool(context.Background(), NewListProjectAssetsTool(suite.projectService, 8080).GetHandler(), map[string]interface{}{})
+	summaries = nil
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &summaries))
+	suite.Len(summaries, 2)
+}
+
+func TestProjectToolsTestSuite(t *testing.T) {
+	suite.Run(t, new(ProjectToolsTestSuite))
+}
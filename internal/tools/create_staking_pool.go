@@ -0,0 +1,316 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// defaultStakingRewardsDuration is the reward period of a staking pool, 30 days
+const defaultStakingRewardsDuration = 30 * 24 * 60 * 60
+
+// stakingRewardsAbi is the funding function of the staking rewards template
+const stakingRewardsAbi = `[{"inputs":[{"name":"_reward","type":"uint256"}],"name":"notifyRewardAmount","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+type createStakingPoolTool struct {
+	chainService      services.ChainService
+	templateService   services.TemplateService
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	stakingService    services.StakingService
+	evmService        services.EvmService
+	chainAdapters     services.ChainAdapters
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type CreateStakingPoolArguments struct {
+	// Required fields
+	TokenDeploymentID string `json:"token_deployment_id" validate:"required"`
+	TemplateID        string `json:"template_id" validate:"required"`
+	DeployerAddress   string `json:"deployer_address" validate:"required,eth_addr"`
+	RewardAmount      string `json:"reward_amount" validate:"required,numeric"`
+
+	// Optional fields
+	RewardsDuration uint64 `json:"rewards_duration,omitempty"`
+	LiquidityPoolID string `json:"liquidity_pool_id,omitempty"`
+	DryRun          bool   `json:"dry_run,omitempty"`
+}
+
+func NewCreateStakingPoolTool(chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, stakingService services.StakingService, evmService services.EvmService, txService services.TransactionService, serverPort int) *createStakingPoolTool {
+	return &createStakingPoolTool{
+		chainService:      chainService,
+		templateService:   templateService,
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		stakingService:    stakingService,
+		evmService:        evmService,
+		chainAdapters:     services.NewChainAdapters(evmService),
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (c *createStakingPoolTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("create_staking_pool",
+		mcp.WithDescription("Deploy a staking rewards farm paying a launched token to the stakers of the token or of its Uniswap LP token, and fund its reward period in the same transaction session. "+
+			"The session deploys the farm owned by deployer_address, approves the reward and calls notifyRewardAmount, which emits the reward linearly over the rewards duration. "+
+			"The farm address is predicted from the nonce of the deployer, every transaction is pinned to its nonce and the session must be signed by deployer_address without other transactions in between. "+
+			"Import the template with import_templates pack=staking first, get_staking_pool reports the emissions."),
+		mcp.WithString("token_deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment paid as reward, staked as well unless liquidity_pool_id is given"),
+		),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("ID of the %q template imported from the staking pack", templates.StakingRewardsTemplateName)),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Required(),
+			mcp.Description("Address of the wallet signing the session and owning the farm, it must hold the reward"),
+		),
+		mcp.WithString("reward_amount",
+			mcp.Required(),
+			mcp.Description("Reward emitted over the period in the smallest unit of the token (e.g., \"1000000000000000000000\" for 1000 tokens with 18 decimals)"),
+		),
+		mcp.WithNumber("rewards_duration",
+			mcp.Description(fmt.Sprintf("Length of the reward period in seconds. Optional, defaults to %d (30 days)", defaultStakingRewardsDuration)),
+		),
+		mcp.WithString("liquidity_pool_id",
+			mcp.Description("ID of a confirmed liquidity pool of the token, its LP token is staked instead of the token. Optional"),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (c *createStakingPoolTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args CreateStakingPoolArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		rewardsDuration := valueOrDefault(args.RewardsDuration, defaultStakingRewardsDuration)
+		reward, _ := new(big.Int).SetString(args.RewardAmount, 10)
+		// The farm emits reward / duration per second, rounded down
+		if reward == nil || reward.Cmp(new(big.Int).SetUint64(rewardsDuration)) < 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("reward_amount must be at least the rewards duration of %d seconds, the reward rate would be zero", rewardsDuration)), nil
+		}
+
+		activeChain, err := c.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Staking pools are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		userID := utils.GetUserID(ctx)
+		token, err := getConfirmedDeployment(c.deploymentService, args.TokenDeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if userID != "" && (token.UserID == nil || *token.UserID != userID) {
+			return mcp.NewToolResultError("Deployment not found"), nil
+		}
+		if token.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", token.ChainID, activeChain.ID)), nil
+		}
+
+		stakingToken := token.ContractAddress
+		var liquidityPoolID *uint
+		if args.LiquidityPoolID != "" {
+			poolID, err := strconv.ParseUint(args.LiquidityPoolID, 10, 32)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid liquidity_pool_id format: %v", err)), nil
+			}
+			pool, err := c.liquidityService.GetLiquidityPool(uint(poolID))
+			if err != nil || (userID != "" && (pool.UserID == nil || *pool.UserID != userID)) {
+				return mcp.NewToolResultError("Liquidity pool not found"), nil
+			}
+			if !strings.EqualFold(pool.TokenAddress, token.ContractAddress) {
+				return mcp.NewToolResultError(fmt.Sprintf("Liquidity pool %d is not a pool of token %s", pool.ID, token.ContractAddress)), nil
+			}
+			if pool.Status != models.TransactionStatusConfirmed || pool.PairAddress == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("Liquidity pool %d is not confirmed yet", pool.ID)), nil
+			}
+			stakingToken = pool.PairAddress
+			liquidityPoolID = &pool.ID
+		}
+
+		template, err := getPackTemplate(c.templateService, args.TemplateID, templates.PackStaking, templates.StakingRewardsTemplateName, "FarmName")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		nonce, err := pendingNonce(activeChain.RPC, args.DeployerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var userId *string
+		if userID != "" {
+			userId = &userID
+		}
+		stakingPool := &models.StakingPool{
+			UserID:             userId,
+			RewardDeploymentID: token.ID,
+			LiquidityPoolID:    liquidityPoolID,
+			ChainID:            activeChain.ID,
+			StakingToken:       stakingToken,
+			RewardToken:        token.ContractAddress,
+			ContractAddress:    utils.PredictContractAddress(args.DeployerAddress, nonce),
+			DeployerAddress:    args.DeployerAddress,
+			RewardAmount:       reward.String(),
+			RewardsDuration:    rewardsDuration,
+		}
+
+		session, farm, err := c.buildStakingPoolSession(activeChain, token, template, stakingPool, nonce)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create staking pool transactions: %v", err)), nil
+		}
+
+		if args.DryRun {
+			return newDryRunResult("create_staking_pool", []services.CreateTransactionSessionRequest{session},
+				DryRunRecord{Type: "deployment", Record: farm},
+				DryRunRecord{Type: "staking_pool", Record: stakingPool},
+			)
+		}
+
+		session.Context = ctx
+		sessionID, err := c.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+		farm.SessionId = sessionID
+		if err := c.deploymentService.CreateDeployment(farm); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create deployment: %v", err)), nil
+		}
+		stakingPool.DeploymentID = farm.ID
+		stakingPool.SessionId = sessionID
+		if err := c.stakingService.CreateStakingPool(stakingPool); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create staking pool: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, c.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(stakingPool)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Staking pool %d created with session %s: ", stakingPool.ID, sessionID)),
+				mcp.NewTextContent(string(resultJSON)),
+				mcp.NewTextContent(fmt.Sprintf("The session must be signed by %s starting at nonce %d. Please return the following url to the user: ", args.DeployerAddress, nonce)),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// buildStakingPoolSession builds the farm deployment, the approval of the reward and the notifyRewardAmount call targeting the predicted farm,
+// with the pending deployment of the farm without saving them
+func (c *createStakingPoolTool) buildStakingPoolSession(activeChain *models.Chain, token *models.Deployment, template *models.Template, pool *models.StakingPool, nonce uint64) (services.CreateTransactionSessionRequest, *models.Deployment, error) {
+	adapter, err := c.chainAdapters.ForChainType(activeChain.ChainType)
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, err
+	}
+
+	templateValues := models.JSON{"FarmName": tokenContractName(token, "Farm")}
+	constructorArgs := []any{pool.StakingToken, pool.RewardToken, strconv.FormatUint(pool.RewardsDuration, 10), pool.DeployerAddress}
+	deployTx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        template,
+		TemplateValues:  templateValues,
+		ContractName:    templateValues["FarmName"].(string),
+		ConstructorArgs: constructorArgs,
+		Value:           "0",
+		Title:           "Deploy Staking Pool",
+		Description:     fmt.Sprintf("Deploy the staking rewards farm at %s staking %s", pool.ContractAddress, pool.StakingToken),
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to build the farm deployment: %w", err)
+	}
+	deployTx.TransactionType = models.TransactionTypeStakingPoolDeployment
+	transactions := []models.TransactionDeployment{deployTx}
+
+	calls := []struct {
+		contract        string
+		functionName    string
+		functionArgs    []any
+		abi             string
+		title           string
+		description     string
+		transactionType models.TransactionType
+	}{
+		{pool.RewardToken, "approve", []any{pool.ContractAddress, pool.RewardAmount}, erc20ApproveAbi, "Approve Reward",
+			fmt.Sprintf("Approve the farm %s to pull the reward of %s", pool.ContractAddress, pool.RewardAmount), models.TransactionTypeRegular},
+		{pool.ContractAddress, "notifyRewardAmount", []any{pool.RewardAmount}, stakingRewardsAbi, "Fund Reward Period",
+			fmt.Sprintf("Start the reward period emitting %s over %d seconds", pool.RewardAmount, pool.RewardsDuration), models.TransactionTypeStakingRewardFunding},
+	}
+	for _, call := range calls {
+		tx, err := c.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: call.contract,
+			FunctionName:    call.functionName,
+			FunctionArgs:    call.functionArgs,
+			Abi:             call.abi,
+			Value:           "0",
+			Title:           call.title,
+			Description:     call.description,
+			TransactionType: call.transactionType,
+		})
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to create %s transaction: %w", call.functionName, err)
+		}
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(call.functionName, call.functionArgs, call.abi)
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		tx.RawContractArguments = &functionArgsString
+		contract := call.contract
+		tx.ContractAddress = &contract
+		transactions = append(transactions, tx)
+	}
+	pinNonces(transactions, nonce)
+
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: transactions,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "token_deployment_id", Value: strconv.FormatUint(uint64(token.ID), 10)},
+			{Key: "staking_token", Value: pool.StakingToken},
+			{Key: "farm_address", Value: pool.ContractAddress},
+		},
+		UserID: pool.UserID,
+	}
+	farm := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  templateValues,
+		ConstructorArgs: constructorArgs,
+		DeployerAddress: pool.DeployerAddress,
+		UserID:          pool.UserID,
+	}
+	return session, farm, nil
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStakingPoolValidation(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	templateService := services.NewTemplateService(db.GetDB())
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+
+	pack, err := templates.GetPack(templates.PackStaking)
+	require.NoError(t, err)
+	farmTemplate := pack.Templates[0]
+	require.NoError(t, templateService.CreateTemplate(&farmTemplate))
+	tokenTemplate := &models.Template{Name: "My Token", ChainType: models.TransactionChainTypeEthereum, Metadata: models.JSON{"TokenName": ""}}
+	require.NoError(t, templateService.CreateTemplate(tokenTemplate))
+
+	owner := "user-1"
+	token := &models.Deployment{TemplateID: tokenTemplate.ID, ChainID: chain.ID, ContractAddress: "0x1111111111111111111111111111111111111111", Status: models.TransactionStatusConfirmed, UserID: &owner}
+	require.NoError(t, deploymentService.CreateDeployment(token))
+	otherPool := &models.LiquidityPool{TokenAddress: "0x2222222222222222222222222222222222222222", PairAddress: "0x3333333333333333333333333333333333333333", Status: models.TransactionStatusConfirmed, UserID: &owner}
+	_, err = liquidityService.CreateLiquidityPool(otherPool)
+	require.NoError(t, err)
+
+	handler := NewCreateStakingPoolTool(chainService, templateService, deploymentService, liquidityService, services.NewStakingService(db.GetDB()), services.NewEvmService(), services.NewTransactionService(db.GetDB()), 8080).GetHandler()
+	call := func(ctx context.Context, args map[string]any) string {
+		arguments := map[string]any{
+			"token_deployment_id": fmt.Sprint(token.ID),
+			"template_id":         fmt.Sprint(farmTemplate.ID),
+			"deployer_address":    "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"reward_amount":       "1000000000000000000000",
+		}
+		for key, value := range args {
+			arguments[key] = value
+		}
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+
+	// The reward rate of the farm would round down to zero
+	assert.Contains(t, call(ctx, map[string]any{"reward_amount": "1000"}), "reward rate would be zero")
+	assert.Contains(t, call(ctx, map[string]any{"reward_amount": "1e18"}), "Invalid arguments")
+	assert.Contains(t, call(ctx, map[string]any{"liquidity_pool_id": fmt.Sprint(otherPool.ID)}), "is not a pool of token")
+	assert.Contains(t, call(ctx, map[string]any{"template_id": fmt.Sprint(tokenTemplate.ID)}), "import_templates pack=staking")
+
+	otherCtx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	assert.Contains(t, call(otherCtx, nil), "Deployment not found")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/launchpad-mcp/internal/constants"
+	"github.com/rxtech-lab/launchpad-mcp/internal/contracts"
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
 	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
@@ -28,7 +29,10 @@ type CreateTemplateArguments struct {
 	TemplateValues map[string]any `json:"template_values" validate:"required"`
 
 	// Optional fields
-	TemplateMetadata string `json:"template_metadata,omitempty"`
+	TemplateMetadata string               `json:"template_metadata,omitempty"`
+	TemplateFiles    models.TemplateFiles `json:"template_files,omitempty"`
+	// OpenZeppelinVersion pins the vendored OpenZeppelin release of the imports
+	OpenZeppelinVersion string `json:"openzeppelin_version,omitempty"`
 }
 
 type CreateTemplateResult struct {
@@ -39,6 +43,8 @@ type CreateTemplateResult struct {
 	ContractNames      []string                    `json:"contract_names,omitempty"`
 	TemplateParameters int                         `json:"template_parameters,omitempty"`
 	Metadata           models.JSON                 `json:"metadata,omitempty"`
+	// Warnings are the compiler warnings of the template, see get_template_report for the gas estimates
+	Warnings []string `json:"warnings,omitempty"`
 }
 
 type CompilationResult struct {
@@ -54,7 +60,10 @@ func NewCreateTemplateTool(templateService services.TemplateService) *createTemp
 
 func (c *createTemplateTool) GetTool() mcp.Tool {
 	tool := mcp.NewTool("create_template",
-		mcp.WithDescription("Create new smart contract template with syntax validation. Template code should use Go template syntax ({{.VariableName}}) for dynamic parameters. OpenZeppelin contracts are available to use."),
+		mcp.WithDescription("Create new smart contract template with syntax validation. Template code should use Go template syntax ({{.VariableName}}) for dynamic parameters. "+
+			"Helpers: {{toWei .Amount}} converts a decimal amount to base units (18 decimals, or {{toWei .Amount 6}}), {{checksumAddress .Owner}}, {{now}} (unix seconds), {{randomSalt}} (bytes32) and {{upper .Symbol}}/{{lower .Symbol}}. "+
+			"Every value used by the code must be declared in template_metadata when it is provided. OpenZeppelin contracts are available to use. "+
+			"Solana templates are single file Anchor programs declaring their instructions in a #[program] module, the IDL of the program is stored on the template."),
 		mcp.WithString("name",
 			mcp.Required(),
 			mcp.Description("Name of the template (e.g., 'ERC20 Basic Token', 'SPL Token')"),
@@ -79,10 +88,18 @@ func (c *createTemplateTool) GetTool() mcp.Tool {
 		mcp.WithString("template_metadata",
 			mcp.Description("JSON object defining template parameters as key-value pairs where values are empty strings (e.g., {\"TokenName\": \"\", \"TokenSymbol\": \"\"})"),
 		),
+		mcp.WithObject("template_files",
+			mcp.Description("JSON object mapping the paths of additional library and interface files to their template code, for templates spanning multiple files (e.g., {\"lib/Math.sol\": \"library Math {...}\"}). "+
+				"The template code is the main contract and imports them relative to itself (import \"./lib/Math.sol\";). The files are rendered with the same template values. Optional"),
+		),
+		mcp.WithString("openzeppelin_version",
+			mcp.Description("OpenZeppelin version the template targets (e.g., 4.9.6), the imports resolve to that vendored release. Optional, defaults to the default vendored release"),
+		),
 		mcp.WithObject("template_values",
 			mcp.Required(),
 			mcp.Description("JSON object with runtime values for template parameters (e.g., {\"TokenName\": \"MyToken\", \"TokenSymbol\": \"MTK\"})"),
 		),
+		withIdempotencyKey(),
 	)
 
 	return tool
@@ -126,6 +143,24 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 			}
 		}
 
+		if err := utils.ValidateTemplateFiles(args.TemplateFiles); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_files: %v", err)), nil
+		}
+		if args.OpenZeppelinVersion != "" {
+			openZeppelin, err := contracts.ResolveOpenZeppelinVersion(args.OpenZeppelinVersion)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid openzeppelin_version: %v", err)), nil
+			}
+			args.OpenZeppelinVersion = openZeppelin.Version
+		}
+
+		// Every template value of the code must be a declared parameter
+		if len(metadata) > 0 {
+			if err := utils.ValidateTemplateFilesKeys(args.TemplateCode, args.TemplateFiles, metadata); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid template_metadata: %v", err)), nil
+			}
+		}
+
 		// Validate chain type
 		if args.ChainType != "ethereum" && args.ChainType != "solana" {
 			return mcp.NewToolResultError("Invalid chain_type. Supported values: ethereum, solana"), nil
@@ -133,6 +168,7 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 
 		// Validate template code using Solidity compiler for Ethereum
 		var compilationResult *utils.CompilationResult
+		var anchorResult *utils.AnchorCompilationResult
 		switch args.ChainType {
 		case "ethereum":
 			// Render template with dummy values
@@ -140,9 +176,13 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Error rendering template with provided values: %v", err)), nil
 			}
+			validationFiles, err := utils.RenderTemplateFiles(args.TemplateFiles, args.TemplateValues)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error rendering template files with provided values: %v", err)), nil
+			}
 
 			// Use Solidity version 0.8.20 for validation
-			result, err := utils.CompileSolidity(constants.SolidityCompilerVersion, validationCode)
+			result, err := utils.CompileSolidityFiles(constants.SolidityCompilerVersion, validationCode, validationFiles, args.OpenZeppelinVersion)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Solidity compilation failed. Please fix the template code base on the error: %v", err)), nil
 			}
@@ -158,7 +198,20 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 			}
 			compilationResult = &result
 		case "solana":
-			// Solana validation skipped - accept any template code
+			if len(args.TemplateFiles) > 0 {
+				return mcp.NewToolResultError("template_files are only supported by ethereum templates"), nil
+			}
+			validationCode, err := utils.RenderAnchorTemplate(args.TemplateCode, args.TemplateValues)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error rendering template with provided values: %v", err)), nil
+			}
+
+			// Built with anchor when the toolchain is installed, statically validated otherwise
+			result, err := utils.CompileAnchorProgram(ctx, validationCode)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Anchor program validation failed. Please fix the template code base on the error: %v", err)), nil
+			}
+			anchorResult = &result
 		}
 
 		// Create template
@@ -167,10 +220,15 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 			Description:          args.Description,
 			ChainType:            models.TransactionChainType(args.ChainType),
 			TemplateCode:         args.TemplateCode,
+			Files:                args.TemplateFiles,
+			OpenZeppelinVersion:  args.OpenZeppelinVersion,
 			SampleTemplateValues: args.TemplateValues,
 			Metadata:             metadata,
 			UserId:               userId,
 		}
+		if compilationResult != nil {
+			template.Report = utils.NewTemplateReport(*compilationResult, args.ContractName, constants.SolidityCompilerVersion)
+		}
 
 		// Set ABI only for Ethereum contracts with successful compilation
 		if compilationResult != nil && args.ChainType == "ethereum" {
@@ -188,6 +246,10 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 			}
 		}
 
+		if anchorResult != nil {
+			template.Idl = anchorResult.Idl
+		}
+
 		if err := c.templateService.CreateTemplate(template); err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Error creating template: %v", err)), nil
 		}
@@ -207,6 +269,7 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 				contractNames = append(contractNames, contractName)
 			}
 			result.ContractNames = contractNames
+			result.Warnings = compilationResult.Warnings
 		}
 
 		// Add metadata information
@@ -219,8 +282,10 @@ func (c *createTemplateTool) GetHandler() server.ToolHandlerFunc {
 		successMessage := "Template created successfully"
 		if compilationResult != nil {
 			successMessage += " (Solidity compilation validated)"
-		} else if args.ChainType == "solana" {
-			successMessage += " (Rust syntax validated)"
+		} else if anchorResult != nil && anchorResult.Built {
+			successMessage += " (Anchor build validated)"
+		} else if anchorResult != nil {
+			successMessage += " (Anchor program validated)"
 		}
 
 		resultJSON, _ := json.Marshal(result)
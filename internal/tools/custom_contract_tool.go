@@ -0,0 +1,197 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// customContractValueArgument is the wei sent to payable functions without a fixed value
+const customContractValueArgument = "value"
+
+type customContractTool struct {
+	definition   services.CustomToolDefinition
+	evmService   services.EvmService
+	txService    services.TransactionService
+	chainService services.ChainService
+	serverPort   int
+}
+
+func NewCustomContractTool(definition services.CustomToolDefinition, evmService services.EvmService, txService services.TransactionService, chainService services.ChainService, serverPort int) *customContractTool {
+	return &customContractTool{
+		definition:   definition,
+		evmService:   evmService,
+		txService:    txService,
+		chainService: chainService,
+		serverPort:   serverPort,
+	}
+}
+
+func (c *customContractTool) GetTool() mcp.Tool {
+	method := c.definition.Method()
+	description := c.definition.Description
+	if description == "" {
+		description = fmt.Sprintf("Call %s on contract %s", method.Sig, c.definition.ContractAddress)
+	}
+	if c.definition.IsReadOnly() {
+		description += ". Read-only, returns the result of the call."
+	} else {
+		description += ". Creates a transaction session with signing URL."
+	}
+
+	options := []mcp.ToolOption{mcp.WithDescription(description)}
+	for _, input := range method.Inputs {
+		parameter := c.definition.Parameter(input.Name)
+		if parameter.Value != "" {
+			continue
+		}
+		parameterDescription := parameter.Description
+		if parameterDescription == "" {
+			parameterDescription = input.Name
+		}
+		parameterDescription = fmt.Sprintf("%s (%s)", parameterDescription, input.Type.String())
+		if input.Type.T == abi.SliceTy || input.Type.T == abi.ArrayTy || input.Type.T == abi.TupleTy {
+			options = append(options, mcp.WithArray(input.Name, mcp.Required(), mcp.Description(parameterDescription), mcp.Items(map[string]any{"type": "any"})))
+		} else {
+			options = append(options, mcp.WithString(input.Name, mcp.Required(), mcp.Description(parameterDescription)))
+		}
+	}
+	if method.IsPayable() && c.definition.Value == "" {
+		options = append(options, mcp.WithString(customContractValueArgument,
+			mcp.Description("ETH value to send with the call in wei. Optional, defaults to \"0\"."),
+		))
+	}
+	if !c.definition.IsReadOnly() {
+		options = append(options, withIdempotencyKey())
+	}
+
+	return mcp.NewTool(c.definition.Name, options...)
+}
+
+func (c *customContractTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := request.GetArguments()
+		method := c.definition.Method()
+
+		// The fixed values of the configuration come first, the other inputs are read from the arguments
+		functionArgs := make([]any, 0, len(method.Inputs))
+		for _, input := range method.Inputs {
+			if value := c.definition.Parameter(input.Name).Value; value != "" {
+				functionArgs = append(functionArgs, value)
+				continue
+			}
+			value, ok := arguments[input.Name]
+			if !ok || value == nil || value == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %s is required", input.Name)), nil
+			}
+			functionArgs = append(functionArgs, value)
+		}
+
+		value := c.definition.Value
+		if value == "" {
+			value = "0"
+			if provided, ok := arguments[customContractValueArgument].(string); ok && provided != "" && method.IsPayable() {
+				if err := validator.New().Var(provided, "numeric"); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %s must be an amount in wei", customContractValueArgument)), nil
+				}
+				value = provided
+			}
+		}
+
+		activeChain, err := c.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("%s only supports Ethereum chains, got %s", c.definition.Name, activeChain.ChainType)), nil
+		}
+		if c.definition.ChainID != "" && activeChain.NetworkID != c.definition.ChainID {
+			return mcp.NewToolResultError(fmt.Sprintf("%s is configured for chain %s, the active chain is %s", c.definition.Name, c.definition.ChainID, activeChain.NetworkID)), nil
+		}
+
+		if c.definition.IsReadOnly() {
+			result, err := c.evmService.CallReadOnlyEthereumFunction(services.CallReadOnlyEthereumFunctionArgs{
+				ContractAddress: c.definition.ContractAddress,
+				FunctionName:    method.Name,
+				FunctionArgs:    functionArgs,
+				Abi:             c.definition.ABIString(),
+				RpcURL:          activeChain.RPC,
+				Value:           "0",
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to call read-only function: %v", err)), nil
+			}
+			resultJSON, _ := json.Marshal(result)
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Function '%s' called successfully on %s:", method.Name, c.definition.ContractAddress)),
+					mcp.NewTextContent(string(resultJSON)),
+				},
+			}, nil
+		}
+
+		tx, err := c.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: c.definition.ContractAddress,
+			FunctionName:    method.Name,
+			FunctionArgs:    functionArgs,
+			Abi:             c.definition.ABIString(),
+			Value:           value,
+			Title:           fmt.Sprintf("Call %s", method.Name),
+			Description:     fmt.Sprintf("Call function %s on contract %s", method.Name, c.definition.ContractAddress),
+			TransactionType: models.TransactionTypeRegular,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create function call transaction: %v", err)), nil
+		}
+		if rawArgs, err := utils.EncodeFunctionArgsToStringMapWithStringABI(method.Name, functionArgs, c.definition.ABIString()); err == nil {
+			tx.RawContractArguments = &rawArgs
+		}
+		contractAddress := c.definition.ContractAddress
+		tx.ContractAddress = &contractAddress
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		session := services.CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{tx},
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                activeChain.ID,
+			Metadata: []models.TransactionMetadata{
+				{Key: "custom_tool", Value: c.definition.Name},
+				{Key: "function_name", Value: method.Name},
+				{Key: "contract_address", Value: contractAddress},
+			},
+			UserID: userId,
+		}
+		session.Context = ctx
+		sessionID, err := c.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, c.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Function call transaction session created: %s", sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Contract: %s", contractAddress)),
+				mcp.NewTextContent("Please sign the function call in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
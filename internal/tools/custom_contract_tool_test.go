@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomContractTool(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	txService := services.NewTransactionService(db.GetDB())
+
+	path := filepath.Join(t.TempDir(), "tools.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+tools:
+  - name: stake_eth
+    description: Stake ETH in a pool of the staking protocol
+    contract_address: "0x1111111111111111111111111111111111111111"
+    function: stake
+    abi: '[{"type":"function","name":"stake","stateMutability":"payable","inputs":[{"name":"pool","type":"uint256"},{"name":"referrer","type":"address"}],"outputs":[]}]'
+    parameters:
+      - name: pool
+        description: ID of the pool
+      - name: referrer
+        value: "0x2222222222222222222222222222222222222222"
+  - name: stake_mainnet
+    contract_address: "0x1111111111111111111111111111111111111111"
+    chain_id: "1"
+    function: stake
+    abi: '[{"type":"function","name":"stake","stateMutability":"payable","inputs":[{"name":"pool","type":"uint256"},{"name":"referrer","type":"address"}],"outputs":[]}]'
+`), 0o600))
+	definitions, err := services.LoadCustomTools(path)
+	require.NoError(t, err)
+	require.Len(t, definitions, 2)
+
+	tool := NewCustomContractTool(definitions[0], services.NewEvmService(), txService, chainService, 9999)
+	call := func(tool *customContractTool, args map[string]any) *mcp.CallToolResult {
+		result, err := tool.GetHandler()(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("exposes the inputs without a fixed value", func(t *testing.T) {
+		schema := tool.GetTool()
+		assert.Equal(t, "stake_eth", schema.Name)
+		assert.Contains(t, schema.InputSchema.Properties, "pool")
+		assert.Contains(t, schema.InputSchema.Properties, "value")
+		assert.NotContains(t, schema.InputSchema.Properties, "referrer")
+		assert.Contains(t, schema.InputSchema.Required, "pool")
+	})
+
+	t.Run("creates a transaction session for the call", func(t *testing.T) {
+		result := call(tool, map[string]any{"pool": "3", "value": "1000"})
+		require.False(t, result.IsError, result.Content)
+
+		sessionID := result.Content[0].(mcp.TextContent).Text[len("Function call transaction session created: "):]
+		session, err := txService.GetTransactionSession(sessionID)
+		require.NoError(t, err)
+		require.Len(t, session.TransactionDeployments, 1)
+		tx := session.TransactionDeployments[0]
+		assert.Equal(t, "0x1111111111111111111111111111111111111111", tx.Receiver)
+		assert.Equal(t, "1000", tx.Value)
+		// stake(uint256,address) with the referrer of the configuration
+		assert.Contains(t, tx.Data, "0000000000000000000000000000000000000000000000000000000000000003")
+		assert.Contains(t, tx.Data, "2222222222222222222222222222222222222222")
+		assert.Contains(t, session.Metadata, models.TransactionMetadata{Key: "custom_tool", Value: "stake_eth"})
+	})
+
+	t.Run("requires the exposed inputs", func(t *testing.T) {
+		assert.True(t, call(tool, map[string]any{}).IsError)
+	})
+
+	t.Run("rejects another chain than the configured one", func(t *testing.T) {
+		result := call(NewCustomContractTool(definitions[1], services.NewEvmService(), txService, chainService, 9999), map[string]any{"pool": "3", "referrer": "0x2222222222222222222222222222222222222222"})
+		require.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "configured for chain 1")
+	})
+}
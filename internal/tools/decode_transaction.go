@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type decodeTransactionTool struct {
+	templateService   services.TemplateService
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	uniswapService    services.UniswapService
+}
+
+type DecodeTransactionArguments struct {
+	// Optional fields
+	TransactionHash string `json:"transaction_hash,omitempty" validate:"required_without=Data,excluded_with=Data,omitempty,hexadecimal,len=66"`
+	Data            string `json:"data,omitempty" validate:"required_without=TransactionHash,omitempty,hexadecimal"`
+	To              string `json:"to,omitempty" validate:"omitempty,eth_addr"`
+	Abi             string `json:"abi,omitempty"`
+}
+
+// DecodeTransactionResult is a decoded call, From, Value and TransactionHash are only known when decoding a hash
+type DecodeTransactionResult struct {
+	TransactionHash string `json:"transaction_hash,omitempty"`
+	From            string `json:"from,omitempty"`
+	To              string `json:"to,omitempty"`
+	Value           string `json:"value,omitempty"`
+	*utils.DecodedCall
+}
+
+func NewDecodeTransactionTool(templateService services.TemplateService, chainService services.ChainService, deploymentService services.DeploymentService, uniswapService services.UniswapService) *decodeTransactionTool {
+	return &decodeTransactionTool{
+		templateService:   templateService,
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		uniswapService:    uniswapService,
+	}
+}
+
+func (d *decodeTransactionTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("decode_transaction",
+		mcp.WithDescription("Decode a transaction on the active Ethereum chain, or raw calldata, into its function and arguments. "+
+			"The selector is matched against the ABI of the deployment at the target address first, then the Uniswap artifacts and the templates known to the launchpad."),
+		mcp.WithString("transaction_hash",
+			mcp.Description("Hash of the transaction to fetch and decode. Required without data"),
+		),
+		mcp.WithString("data",
+			mcp.Description("Raw calldata starting with the 4 byte function selector. Required without transaction_hash"),
+		),
+		mcp.WithString("to",
+			mcp.Description("Address the calldata was sent to, used to pick the ABI of its deployment. Optional, read from the transaction with transaction_hash"),
+		),
+		mcp.WithString("abi",
+			mcp.Description("JSON ABI tried before the known ones, for contracts the launchpad did not deploy. Optional"),
+		),
+	)
+
+	return tool
+}
+
+func (d *decodeTransactionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args DecodeTransactionArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := d.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("decode_transaction only supports Ethereum chains, got %s", activeChain.ChainType)), nil
+		}
+
+		result := DecodeTransactionResult{To: args.To}
+		data := args.Data
+		if args.TransactionHash != "" {
+			tx, err := utils.GetTransactionByHash(activeChain.RPC, args.TransactionHash)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get the transaction: %v", err)), nil
+			}
+			if tx.To == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("Transaction %s is a contract creation, its data is bytecode and not a function call", args.TransactionHash)), nil
+			}
+			result = DecodeTransactionResult{TransactionHash: tx.Hash, From: tx.From, To: tx.To, Value: tx.Value}
+			data = tx.Input
+		}
+		if result.To != "" {
+			result.To = common.HexToAddress(result.To).Hex()
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		decoded, err := utils.DecodeCalldata(common.FromHex(data), d.abiSources(userId, activeChain, args.Abi, result.To))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to decode the transaction: %v", err)), nil
+		}
+		result.DecodedCall = decoded
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Decoded %s with the ABI of %s: ", decoded.Function, decoded.Source)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// abiSources lists the ABIs to match in order: the given one, the contract at the target address when the launchpad
+// deployed it, the Uniswap artifacts and the templates
+func (d *decodeTransactionTool) abiSources(userId *string, activeChain *models.Chain, abiString string, to string) []utils.ABISource {
+	var sources []utils.ABISource
+	if abiString != "" {
+		sources = append(sources, utils.ABISource{Name: "the given ABI", ABI: abiString})
+	}
+
+	uniswapContracts, err := utils.FetchUniswapV2Contracts()
+	if err != nil {
+		uniswapContracts = nil
+	}
+	uniswapSource := func(name string, contract utils.UniswapContract) utils.ABISource {
+		abiJSON, _ := json.Marshal(contract.ABI)
+		return utils.ABISource{Name: name, ABI: string(abiJSON)}
+	}
+
+	if to != "" {
+		// Addresses are stored as they were given, checksummed or not
+		for _, address := range []string{to, strings.ToLower(to)} {
+			deployment, err := d.deploymentService.GetDeploymentByContractAddress(address)
+			if err != nil || deployment.ChainID != activeChain.ID {
+				continue
+			}
+			if abiString, err := utils.GetAbiString(deployment.Template.Abi); err == nil {
+				sources = append(sources, utils.ABISource{Name: fmt.Sprintf("deployment %d (%s)", deployment.ID, deployment.Template.Name), ABI: abiString})
+			}
+			break
+		}
+
+		if uniswapContracts != nil {
+			deployments, _ := d.uniswapService.ListUniswapDeploymentsByChain(userId, activeChain.ID)
+			for _, deployment := range deployments {
+				switch {
+				case strings.EqualFold(deployment.RouterAddress, to):
+					sources = append(sources, uniswapSource(fmt.Sprintf("Uniswap V2 router of DEX deployment %d", deployment.ID), uniswapContracts.Router))
+				case strings.EqualFold(deployment.FactoryAddress, to):
+					sources = append(sources, uniswapSource(fmt.Sprintf("Uniswap V2 factory of DEX deployment %d", deployment.ID), uniswapContracts.Factory))
+				case strings.EqualFold(deployment.WETHAddress, to):
+					sources = append(sources, uniswapSource(fmt.Sprintf("WETH9 of DEX deployment %d", deployment.ID), uniswapContracts.WETH9))
+				}
+			}
+		}
+	}
+
+	if uniswapContracts != nil {
+		sources = append(sources,
+			uniswapSource("Uniswap V2 router", uniswapContracts.Router),
+			uniswapSource("Uniswap V2 factory", uniswapContracts.Factory),
+			uniswapSource("WETH9", uniswapContracts.WETH9),
+		)
+	}
+
+	templates, _ := d.templateService.ListTemplates(userId, string(models.TransactionChainTypeEthereum), "", 0)
+	for _, template := range templates {
+		if abiString, err := utils.GetAbiString(template.Abi); err == nil {
+			sources = append(sources, utils.ABISource{Name: fmt.Sprintf("template %s", template.Name), ABI: abiString})
+		}
+	}
+	return sources
+}
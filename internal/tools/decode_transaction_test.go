@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// claim(uint256) with an amount of 5
+const decodeTransactionTestCalldata = "0x379607f50000000000000000000000000000000000000000000000000000000000000005"
+
+func TestDecodeTransaction(t *testing.T) {
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []any           `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var result any
+		switch request.Params[0] {
+		case "0xaa00000000000000000000000000000000000000000000000000000000000000":
+			result = map[string]any{"hash": request.Params[0], "from": "0x3333333333333333333333333333333333333333", "to": "0x1111111111111111111111111111111111111111", "input": decodeTransactionTestCalldata, "value": "0xde0b6b3a7640000"}
+		case "0xbb00000000000000000000000000000000000000000000000000000000000000":
+			result = map[string]any{"hash": request.Params[0], "from": "0x3333333333333333333333333333333333333333", "to": nil, "input": "0x6080", "value": "0x0"}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+	t.Cleanup(rpc.Close)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	templateService := services.NewTemplateService(db.GetDB())
+	var templateABI models.JSON
+	require.NoError(t, json.Unmarshal([]byte(`{"abi":`+callContractTestABI+`}`), &templateABI))
+	template := &models.Template{Name: "Rewards", ChainType: models.TransactionChainTypeEthereum, Abi: templateABI}
+	require.NoError(t, templateService.CreateTemplate(template))
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	deployment := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, Status: models.TransactionStatusConfirmed, ContractAddress: "0x1111111111111111111111111111111111111111"}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+
+	handler := NewDecodeTransactionTool(templateService, chainService, deploymentService, services.NewUniswapService(db.GetDB())).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+	decode := func(args map[string]any) DecodeTransactionResult {
+		result := call(args)
+		require.False(t, result.IsError, result.Content)
+		var decoded DecodeTransactionResult
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &decoded))
+		return decoded
+	}
+	claim := []utils.DecodedValue{{Name: "amount", Type: "uint256", Value: "5"}}
+
+	t.Run("decodes a transaction with the ABI of its deployment", func(t *testing.T) {
+		decoded := decode(map[string]any{"transaction_hash": "0xaa00000000000000000000000000000000000000000000000000000000000000"})
+		assert.Equal(t, "claim(uint256)", decoded.Function)
+		assert.Equal(t, "0x379607f5", decoded.Selector)
+		assert.Equal(t, "deployment 1 (Rewards)", decoded.Source)
+		assert.Equal(t, claim, decoded.Arguments)
+		assert.Equal(t, "0x3333333333333333333333333333333333333333", decoded.From)
+		assert.Equal(t, "1000000000000000000", decoded.Value)
+	})
+
+	t.Run("decodes raw calldata with the templates", func(t *testing.T) {
+		decoded := decode(map[string]any{"data": decodeTransactionTestCalldata})
+		assert.Equal(t, "template Rewards", decoded.Source)
+		assert.Equal(t, claim, decoded.Arguments)
+	})
+
+	t.Run("tries the given ABI first", func(t *testing.T) {
+		decoded := decode(map[string]any{"data": decodeTransactionTestCalldata, "to": "0x1111111111111111111111111111111111111111", "abi": `[{"type":"function","name":"claim","stateMutability":"nonpayable","inputs":[{"name":"shares","type":"uint256"}],"outputs":[]}]`})
+		assert.Equal(t, "the given ABI", decoded.Source)
+		assert.Equal(t, "shares", decoded.Arguments[0].Name)
+	})
+
+	t.Run("rejects unknown selectors and contract creations", func(t *testing.T) {
+		assert.True(t, call(map[string]any{"data": "0xdeadbeef"}).IsError)
+		assert.True(t, call(map[string]any{"transaction_hash": "0xbb00000000000000000000000000000000000000000000000000000000000000"}).IsError)
+		assert.True(t, call(map[string]any{}).IsError)
+		assert.True(t, call(map[string]any{"data": decodeTransactionTestCalldata, "transaction_hash": "0xaa00000000000000000000000000000000000000000000000000000000000000"}).IsError)
+	})
+}
@@ -0,0 +1,384 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type deployGovernanceTool struct {
+	chainService      services.ChainService
+	templateService   services.TemplateService
+	deploymentService services.DeploymentService
+	evmService        services.EvmService
+	chainAdapters     services.ChainAdapters
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type DeployGovernanceArguments struct {
+	// Required fields
+	TokenDeploymentID  string `json:"token_deployment_id" validate:"required"`
+	DeployerAddress    string `json:"deployer_address" validate:"required,eth_addr"`
+	GovernorTemplateID string `json:"governor_template_id" validate:"required"`
+	TimelockTemplateID string `json:"timelock_template_id" validate:"required"`
+
+	// Optional fields
+	VotingDelay       uint64                       `json:"voting_delay,omitempty"`
+	VotingPeriod      uint64                       `json:"voting_period,omitempty"`
+	ProposalThreshold string                       `json:"proposal_threshold,omitempty" validate:"omitempty,numeric"`
+	QuorumPercent     uint64                       `json:"quorum_percent,omitempty" validate:"omitempty,max=100"`
+	TimelockDelay     uint64                       `json:"timelock_delay,omitempty"`
+	Metadata          []models.TransactionMetadata `json:"metadata,omitempty"`
+	DryRun            bool                         `json:"dry_run,omitempty"`
+}
+
+// DeployGovernanceResult lists the addresses the governance contracts are deployed at once the session is signed
+type DeployGovernanceResult struct {
+	SessionID            string `json:"session_id,omitempty"`
+	TokenAddress         string `json:"token_address"`
+	TimelockAddress      string `json:"timelock_address"`
+	TimelockDeploymentID uint   `json:"timelock_deployment_id,omitempty"`
+	GovernorAddress      string `json:"governor_address"`
+	GovernorDeploymentID uint   `json:"governor_deployment_id,omitempty"`
+	StartNonce           uint64 `json:"start_nonce"`
+}
+
+func NewDeployGovernanceTool(chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, serverPort int) *deployGovernanceTool {
+	return &deployGovernanceTool{
+		chainService:      chainService,
+		templateService:   templateService,
+		deploymentService: deploymentService,
+		evmService:        evmService,
+		chainAdapters:     services.NewChainAdapters(evmService),
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (d *deployGovernanceTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("deploy_governance",
+		mcp.WithDescription("Deploy an OpenZeppelin Governor and TimelockController for a launched ERC20Votes token in one transaction session. The session deploys the timelock and the Governor voting with the token, grants the Governor the proposer and canceller roles of the timelock and renounces the admin role of the deployer, so the timelock only acts on accepted proposals. The contract addresses are predicted from the nonce of the deployer, every transaction is pinned to its nonce and the session must be signed by deployer_address without other transactions in between. Import the templates with import_templates pack=governance first."),
+		mcp.WithString("token_deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment used as the voting token, the token must implement IVotes (ERC20Votes)"),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Required(),
+			mcp.Description("Address of the wallet signing the session, its pending nonce is used to predict the contract addresses"),
+		),
+		mcp.WithString("governor_template_id",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("ID of the %q template imported from the governance pack", templates.GovernorTemplateName)),
+		),
+		mcp.WithString("timelock_template_id",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("ID of the %q template imported from the governance pack", templates.TimelockTemplateName)),
+		),
+		mcp.WithNumber("voting_delay",
+			mcp.Description(fmt.Sprintf("Blocks between a proposal and the start of the vote. Optional, defaults to %d (1 day)", utils.DefaultGovernanceVotingDelay)),
+		),
+		mcp.WithNumber("voting_period",
+			mcp.Description(fmt.Sprintf("Blocks the vote lasts. Optional, defaults to %d (1 week)", utils.DefaultGovernanceVotingPeriod)),
+		),
+		mcp.WithString("proposal_threshold",
+			mcp.Description("Votes needed to create a proposal in the smallest unit of the token. Optional, defaults to \"0\""),
+		),
+		mcp.WithNumber("quorum_percent",
+			mcp.Description(fmt.Sprintf("Percentage of the total supply that must vote for a proposal to pass. Optional, defaults to %d", utils.DefaultGovernanceQuorumPercent)),
+		),
+		mcp.WithNumber("timelock_delay",
+			mcp.Description(fmt.Sprintf("Seconds between queueing and executing an accepted proposal. Optional, defaults to %d (2 days)", utils.DefaultGovernanceTimelockDelay)),
+		),
+		mcp.WithArray("metadata",
+			mcp.Description("JSON array of metadata for the transaction session (e.g., [{\"key\": \"project\", \"value\": \"MyToken DAO\"}]). Optional."),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"key": map[string]any{
+						"type": "string",
+					},
+					"value": map[string]any{
+						"type": "string",
+					},
+				},
+				"required": []string{"key", "value"},
+			}),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (d *deployGovernanceTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args DeployGovernanceArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := d.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Governance deployment is only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		token, err := getConfirmedDeployment(d.deploymentService, args.TokenDeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (token.UserID == nil || *token.UserID != userID) {
+			return mcp.NewToolResultError("Deployment not found"), nil
+		}
+		if token.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", token.ChainID, activeChain.ID)), nil
+		}
+
+		timelockTemplate, err := getPackTemplate(d.templateService, args.TimelockTemplateID, templates.PackGovernance, templates.TimelockTemplateName, "TimelockName")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		governorTemplate, err := getPackTemplate(d.templateService, args.GovernorTemplateID, templates.PackGovernance, templates.GovernorTemplateName, "GovernorName")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// GovernorVotes accepts any address as the token, a token without votes deploys a Governor that can never pass a proposal
+		interfaces, err := utils.DetectInterfaces(activeChain.RPC, token.ContractAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to detect the interfaces of the token: %v", err)), nil
+		}
+		if !interfaces.Supports(utils.InterfaceVotes) {
+			return mcp.NewToolResultError(fmt.Sprintf("Token %s does not implement IVotes, launch it from a template using ERC20Votes to govern it", token.ContractAddress)), nil
+		}
+
+		nonce, err := pendingNonce(activeChain.RPC, args.DeployerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		session, timelock, governor, result, err := d.buildGovernanceSession(args, activeChain, token, timelockTemplate, governorTemplate, nonce, userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create governance deployment transactions: %v", err)), nil
+		}
+
+		if args.DryRun {
+			return newDryRunResult("deploy_governance", []services.CreateTransactionSessionRequest{session},
+				DryRunRecord{Type: "deployment", Record: timelock},
+				DryRunRecord{Type: "deployment", Record: governor},
+			)
+		}
+
+		session.Context = ctx
+		sessionID, err := d.createGovernanceSession(session, timelock, governor)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create governance deployment session: %v", err)), nil
+		}
+		result.SessionID = sessionID
+		result.TimelockDeploymentID = timelock.ID
+		result.GovernorDeploymentID = governor.ID
+
+		url, err := utils.GetTransactionSessionUrl(ctx, d.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Governance deployment session created: %s", sessionID)),
+				mcp.NewTextContent(string(resultJSON)),
+				mcp.NewTextContent(fmt.Sprintf("The session must be signed by %s starting at nonce %d. Please return the following url to the user: ", args.DeployerAddress, result.StartNonce)),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// buildGovernanceSession builds the five transactions of the governance session and the pending deployments without saving them.
+// The timelock and the Governor are the next two contracts of the deployer, the role setup targets the predicted timelock
+func (d *deployGovernanceTool) buildGovernanceSession(args DeployGovernanceArguments, activeChain *models.Chain, token *models.Deployment, timelockTemplate, governorTemplate *models.Template, nonce uint64, userId *string) (services.CreateTransactionSessionRequest, *models.Deployment, *models.Deployment, *DeployGovernanceResult, error) {
+	votingDelay := valueOrDefault(args.VotingDelay, utils.DefaultGovernanceVotingDelay)
+	votingPeriod := valueOrDefault(args.VotingPeriod, utils.DefaultGovernanceVotingPeriod)
+	quorumPercent := valueOrDefault(args.QuorumPercent, utils.DefaultGovernanceQuorumPercent)
+	timelockDelay := valueOrDefault(args.TimelockDelay, utils.DefaultGovernanceTimelockDelay)
+	proposalThreshold := args.ProposalThreshold
+	if proposalThreshold == "" {
+		proposalThreshold = "0"
+	}
+
+	result := &DeployGovernanceResult{
+		TokenAddress:    token.ContractAddress,
+		TimelockAddress: utils.PredictContractAddress(args.DeployerAddress, nonce),
+		GovernorAddress: utils.PredictContractAddress(args.DeployerAddress, nonce+1),
+		StartNonce:      nonce,
+	}
+
+	timelockValues := models.JSON{"TimelockName": tokenContractName(token, "Timelock")}
+	governorValues := models.JSON{"GovernorName": tokenContractName(token, "Governor")}
+
+	adapter, err := d.chainAdapters.ForChainType(activeChain.ChainType)
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, nil, nil, err
+	}
+
+	// The deployer is the temporary admin of the timelock so the roles can be granted once the Governor exists,
+	// anyone can execute a proposal once its delay passed
+	timelockArgs := []any{strconv.FormatUint(timelockDelay, 10), []any{}, []any{"0x0000000000000000000000000000000000000000"}, args.DeployerAddress}
+	timelockTx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        timelockTemplate,
+		TemplateValues:  timelockValues,
+		ContractName:    timelockValues["TimelockName"].(string),
+		ConstructorArgs: timelockArgs,
+		Value:           "0",
+		Title:           "Deploy Timelock",
+		Description:     fmt.Sprintf("Deploy the timelock at %s with a delay of %d seconds", result.TimelockAddress, timelockDelay),
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, nil, nil, fmt.Errorf("failed to build the timelock deployment: %w", err)
+	}
+
+	governorArgs := []any{token.ContractAddress, result.TimelockAddress, strconv.FormatUint(votingDelay, 10), strconv.FormatUint(votingPeriod, 10), proposalThreshold, strconv.FormatUint(quorumPercent, 10)}
+	governorTx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        governorTemplate,
+		TemplateValues:  governorValues,
+		ContractName:    governorValues["GovernorName"].(string),
+		ConstructorArgs: governorArgs,
+		Value:           "0",
+		Title:           "Deploy Governor",
+		Description:     fmt.Sprintf("Deploy the Governor at %s voting with %s, with a quorum of %d%%", result.GovernorAddress, token.ContractAddress, quorumPercent),
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, nil, nil, fmt.Errorf("failed to build the Governor deployment: %w", err)
+	}
+
+	transactions := []models.TransactionDeployment{timelockTx, governorTx}
+	for i := range transactions {
+		transactions[i].TransactionType = models.TransactionTypeGovernanceDeployment
+	}
+
+	roleCalls := []struct {
+		functionName string
+		role         string
+		account      string
+	}{
+		{"grantRole", "PROPOSER_ROLE", result.GovernorAddress},
+		{"grantRole", "CANCELLER_ROLE", result.GovernorAddress},
+		{"renounceRole", "DEFAULT_ADMIN_ROLE", args.DeployerAddress},
+	}
+	for _, call := range roleCalls {
+		role, err := utils.ResolveRole(call.role)
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, nil, nil, err
+		}
+		functionArgs := []any{role, call.account}
+		tx, err := d.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: result.TimelockAddress,
+			FunctionName:    call.functionName,
+			FunctionArgs:    functionArgs,
+			Abi:             utils.AccessControlAbi,
+			Value:           "0",
+			Title:           fmt.Sprintf("%s %s", call.functionName, call.role),
+			Description:     fmt.Sprintf("Call %s with role %s for %s on the timelock %s", call.functionName, call.role, call.account, result.TimelockAddress),
+			TransactionType: models.TransactionTypeGovernanceRoleSetup,
+		})
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, nil, nil, fmt.Errorf("failed to create %s transaction: %w", call.functionName, err)
+		}
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(call.functionName, functionArgs, utils.AccessControlAbi)
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, nil, nil, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		tx.RawContractArguments = &functionArgsString
+		tx.ContractAddress = &result.TimelockAddress
+		transactions = append(transactions, tx)
+	}
+
+	pinNonces(transactions, nonce)
+
+	metadata := append([]models.TransactionMetadata{
+		{Key: "token_deployment_id", Value: args.TokenDeploymentID},
+		{Key: utils.GovernanceTimelockAddressKey, Value: result.TimelockAddress},
+		{Key: utils.GovernanceGovernorAddressKey, Value: result.GovernorAddress},
+	}, args.Metadata...)
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: transactions,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata:               metadata,
+		UserID:                 userId,
+	}
+
+	timelock := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      timelockTemplate.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  timelockValues,
+		ConstructorArgs: timelockArgs,
+		DeployerAddress: args.DeployerAddress,
+		UserID:          userId,
+	}
+	governor := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      governorTemplate.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  governorValues,
+		ConstructorArgs: governorArgs,
+		DeployerAddress: args.DeployerAddress,
+		UserID:          userId,
+	}
+	return session, timelock, governor, result, nil
+}
+
+// createGovernanceSession saves the session and its deployments, then records the deployment IDs in the session metadata
+// for the governance deployment hook
+func (d *deployGovernanceTool) createGovernanceSession(request services.CreateTransactionSessionRequest, timelock, governor *models.Deployment) (string, error) {
+	sessionID, err := d.txService.CreateTransactionSession(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+
+	for _, deployment := range []*models.Deployment{timelock, governor} {
+		deployment.SessionId = sessionID
+		if err := d.deploymentService.CreateDeployment(deployment); err != nil {
+			return "", fmt.Errorf("failed to create deployment: %w", err)
+		}
+	}
+
+	session, err := d.txService.GetTransactionSession(sessionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get transaction session: %w", err)
+	}
+	session.Metadata = append(session.Metadata,
+		models.TransactionMetadata{Key: utils.GovernanceTimelockDeploymentIDKey, Value: strconv.FormatUint(uint64(timelock.ID), 10)},
+		models.TransactionMetadata{Key: utils.GovernanceGovernorDeploymentIDKey, Value: strconv.FormatUint(uint64(governor.ID), 10)},
+	)
+	if err := d.txService.UpdateTransactionSession(sessionID, session); err != nil {
+		return "", fmt.Errorf("failed to update transaction session: %w", err)
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployGovernanceValidation(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	// The RPC serves a plain ERC-20 token without votes
+	var code []byte
+	for _, signature := range []string{"totalSupply()", "balanceOf(address)", "transfer(address,uint256)", "allowance(address,address)", "approve(address,uint256)", "transferFrom(address,address,uint256)"} {
+		code = append(code, 0x63)
+		code = append(code, utils.FunctionSelector(signature)...)
+	}
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: "0x" + hex.EncodeToString(code)})
+	}))
+	t.Cleanup(rpcServer.Close)
+
+	chainService := services.NewChainService(db.GetDB())
+	templateService := services.NewTemplateService(db.GetDB())
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: rpcServer.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+
+	pack, err := templates.GetPack(templates.PackGovernance)
+	require.NoError(t, err)
+	templateIDs := map[string]string{}
+	for _, template := range pack.Templates {
+		template.ChainType = models.TransactionChainTypeEthereum
+		require.NoError(t, templateService.CreateTemplate(&template))
+		templateIDs[template.Name] = fmt.Sprint(template.ID)
+	}
+	tokenTemplate := &models.Template{Name: "My Token", ChainType: models.TransactionChainTypeEthereum, Metadata: models.JSON{"TokenName": ""}}
+	require.NoError(t, templateService.CreateTemplate(tokenTemplate))
+	owner := "user-1"
+	token := &models.Deployment{
+		TemplateID:      tokenTemplate.ID,
+		ChainID:         chain.ID,
+		ContractAddress: "0x1111111111111111111111111111111111111111",
+		TemplateValues:  models.JSON{"TokenName": "My Token"},
+		Status:          models.TransactionStatusConfirmed,
+		UserID:          &owner,
+	}
+	require.NoError(t, deploymentService.CreateDeployment(token))
+
+	handler := NewDeployGovernanceTool(chainService, templateService, deploymentService, nil, services.NewTransactionService(db.GetDB()), 8080).GetHandler()
+	call := func(ctx context.Context, args map[string]any) string {
+		arguments := map[string]any{
+			"token_deployment_id":  fmt.Sprint(token.ID),
+			"deployer_address":     "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"governor_template_id": templateIDs[templates.GovernorTemplateName],
+			"timelock_template_id": templateIDs[templates.TimelockTemplateName],
+		}
+		for key, value := range args {
+			arguments[key] = value
+		}
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+
+	assert.Contains(t, call(ctx, nil), "does not implement IVotes")
+	assert.Contains(t, call(ctx, map[string]any{"quorum_percent": 150}), "Invalid arguments")
+
+	// The templates are swapped
+	message := call(ctx, map[string]any{"governor_template_id": templateIDs[templates.TimelockTemplateName]})
+	assert.Contains(t, message, fmt.Sprintf("not the %q template", templates.GovernorTemplateName))
+	message = call(ctx, map[string]any{"timelock_template_id": fmt.Sprint(tokenTemplate.ID)})
+	assert.Contains(t, message, "governance pack")
+
+	otherCtx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	assert.Contains(t, call(otherCtx, nil), "Deployment not found")
+}
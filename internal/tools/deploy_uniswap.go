@@ -29,6 +29,7 @@ type DeployUniswapArguments struct {
 	// Optional fields
 	DeployRouter *bool                        `json:"deploy_router,omitempty"`
 	Metadata     []models.TransactionMetadata `json:"metadata,omitempty"`
+	DryRun       bool                         `json:"dry_run,omitempty"`
 }
 
 func NewDeployUniswapTool(chainService services.ChainService, serverPort int, evmService services.EvmService, txService services.TransactionService, uniswapService services.UniswapService) *deployUniswapTool {
@@ -64,6 +65,8 @@ func (d *deployUniswapTool) GetTool() mcp.Tool {
 				},
 			}),
 		),
+		withDryRun(),
+		withIdempotencyKey(),
 	)
 
 	return tool
@@ -92,7 +95,7 @@ func (d *deployUniswapTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get active chain configuration
-		activeChain, err := d.chainService.GetActiveChain()
+		activeChain, err := d.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
@@ -125,20 +128,30 @@ func (d *deployUniswapTool) GetHandler() server.ToolHandlerFunc {
 
 		switch args.Version {
 		case "v2":
-			return d.createUniswapV2DeploymentSession(activeChain, args.DeployRouter, args.Metadata, userId)
+			return d.createUniswapV2DeploymentSession(ctx, activeChain, args.DeployRouter, args.Metadata, userId, args.DryRun)
 		default:
 			return mcp.NewToolResultError(fmt.Sprintf("Unsupported version: %s", args.Version)), nil
 		}
 	}
 }
 
-// createUniswapV2DeploymentSession creates a transaction session for Uniswap V2 deployment
-func (d *deployUniswapTool) createUniswapV2DeploymentSession(activeChain *models.Chain, deployRouter *bool, metadata []models.TransactionMetadata, userId *string) (*mcp.CallToolResult, error) {
+// createUniswapV2DeploymentSession creates a transaction session for Uniswap V2 deployment,
+// a dry run builds the transactions without creating the session or the deployment record
+func (d *deployUniswapTool) createUniswapV2DeploymentSession(ctx context.Context, activeChain *models.Chain, deployRouter *bool, metadata []models.TransactionMetadata, userId *string, dryRun bool) (*mcp.CallToolResult, error) {
 	// Get or create Uniswap deployment record
 	existingDeployment, err := d.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
 	var uniswapDeployment *models.UniswapDeployment
+	var dryRunRecords []DryRunRecord
 
-	if err != nil || existingDeployment == nil {
+	if (err != nil || existingDeployment == nil) && dryRun {
+		uniswapDeployment = &models.UniswapDeployment{
+			Version: "v2",
+			Status:  "pending",
+			ChainID: activeChain.ID,
+			UserID:  userId,
+		}
+		dryRunRecords = append(dryRunRecords, DryRunRecord{Type: "uniswap_deployment", Record: uniswapDeployment})
+	} else if err != nil || existingDeployment == nil {
 		// Create new deployment record
 		uniswapDeployment = &models.UniswapDeployment{
 			Version: "v2",
@@ -194,14 +207,20 @@ func (d *deployUniswapTool) createUniswapV2DeploymentSession(activeChain *models
 		Value: strconv.FormatUint(uint64(uniswapDeployment.ID), 10),
 	})
 
-	// Create transaction session
-	sessionID, err := d.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+	session := services.CreateTransactionSessionRequest{
 		TransactionDeployments: transactionDeployments,
 		ChainType:              models.TransactionChainTypeEthereum,
 		ChainID:                activeChain.ID,
 		Metadata:               enhancedMetadata,
 		UserID:                 userId,
-	})
+	}
+	if dryRun {
+		return newDryRunResult("deploy_uniswap", []services.CreateTransactionSessionRequest{session}, dryRunRecords...)
+	}
+
+	// Create transaction session
+	session.Context = ctx
+	sessionID, err := d.txService.CreateTransactionSession(session)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
 	}
@@ -211,7 +230,7 @@ func (d *deployUniswapTool) createUniswapV2DeploymentSession(activeChain *models
 		deploymentType = "Router"
 	}
 
-	url, err := utils.GetTransactionSessionUrl(d.serverPort, sessionID)
+	url, err := utils.GetTransactionSessionUrl(ctx, d.serverPort, sessionID)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
 	}
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// getConfirmedDeployment loads a deployment by its string ID and verifies that it is confirmed
+// and has a contract address.
+// The returned error message is safe to return to the AI client as is.
+func getConfirmedDeployment(deploymentService services.DeploymentService, deploymentIDStr string) (*models.Deployment, error) {
+	deploymentID, err := strconv.ParseUint(deploymentIDStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid deployment_id format: %v", err)
+	}
+
+	deployment, err := deploymentService.GetDeploymentByID(uint(deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("Deployment not found: %v", err)
+	}
+
+	if deployment.Status != models.TransactionStatusConfirmed {
+		return nil, fmt.Errorf("Deployment is not confirmed yet. Contract address not available")
+	}
+	if deployment.ContractAddress == "" {
+		return nil, fmt.Errorf("Deployment does not have a contract address")
+	}
+
+	return deployment, nil
+}
+
+// getConfirmedDeploymentWithAbi is getConfirmedDeployment that also verifies that the template carries an ABI.
+func getConfirmedDeploymentWithAbi(deploymentService services.DeploymentService, deploymentIDStr string) (*models.Deployment, string, error) {
+	deployment, err := getConfirmedDeployment(deploymentService, deploymentIDStr)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if deployment.Template.Abi == nil {
+		return nil, "", fmt.Errorf("Template does not have ABI information")
+	}
+
+	abiString, err := utils.GetAbiString(deployment.Template.Abi)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to read template ABI: %v", err)
+	}
+
+	return deployment, abiString, nil
+}
+
+// nonIdentifierCharacters are stripped from a token name to build the contract names of the contracts deployed for the token
+var nonIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// tokenContractName builds a Solidity contract name from the TokenName template value of the token deployment and the suffix
+func tokenContractName(token *models.Deployment, suffix string) string {
+	tokenName, _ := token.TemplateValues["TokenName"].(string)
+	tokenName = nonIdentifierCharacters.ReplaceAllString(tokenName, "")
+	if tokenName == "" || (tokenName[0] >= '0' && tokenName[0] <= '9') {
+		tokenName = "Token" + tokenName
+	}
+	return tokenName + suffix
+}
+
+// pendingNonce returns the nonce of the next transaction of the account, used to predict the addresses of the contracts
+// it deploys in a session
+func pendingNonce(rpcURL string, account string) (uint64, error) {
+	nonce, err := rpcQuantity(utils.NewRPCClient(rpcURL), "eth_getTransactionCount", account, "pending")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the nonce of %s: %w", account, err)
+	}
+	if !nonce.IsUint64() {
+		return 0, fmt.Errorf("invalid nonce of %s: %s", account, nonce)
+	}
+	return nonce.Uint64(), nil
+}
+
+// pinNonces pins the transactions to consecutive nonces from nonce, so another transaction of the signer in between
+// fails the session instead of moving the contracts away from their predicted addresses
+func pinNonces(transactions []models.TransactionDeployment, nonce uint64) {
+	for i := range transactions {
+		transactionNonce := nonce + uint64(i)
+		transactions[i].Nonce = &transactionNonce
+	}
+}
+
+// getPackTemplate returns the enabled template imported from a built-in pack, identified by its template parameter
+// as the imported templates can be renamed
+func getPackTemplate(templateService services.TemplateService, templateIDStr string, pack string, name string, parameter string) (*models.Template, error) {
+	templateID, err := strconv.ParseUint(templateIDStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid template ID %s: %v", templateIDStr, err)
+	}
+
+	template, err := templateService.GetTemplateByID(uint(templateID))
+	if err != nil {
+		return nil, fmt.Errorf("Template not found: %v", err)
+	}
+	if template.Disabled {
+		return nil, fmt.Errorf("Template %s is disabled by an admin and cannot be launched", template.Name)
+	}
+	if template.ChainType != models.TransactionChainTypeEthereum {
+		return nil, fmt.Errorf("Template %s is not an Ethereum template", template.Name)
+	}
+	if _, ok := template.Metadata[parameter]; !ok {
+		return nil, fmt.Errorf("Template %s is not the %q template of the %s pack, import it with import_templates pack=%s", template.Name, name, pack, pack)
+	}
+	return template, nil
+}
+
+// valueOrDefault returns the default of an optional numeric argument left out
+func valueOrDefault(value uint64, defaultValue uint64) uint64 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type detectInterfacesTool struct {
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+}
+
+// capabilityFunctionNames maps the functions of the mint, burn, pause and permit flows to their capability
+var capabilityFunctionNames = map[string]string{
+	"mint":     "mint",
+	"safeMint": "mint",
+	"burn":     "burn",
+	"burnFrom": "burn",
+	"pause":    "pause",
+	"unpause":  "pause",
+	"permit":   "permit",
+}
+
+func NewDetectInterfacesTool(chainService services.ChainService, deploymentService services.DeploymentService) *detectInterfacesTool {
+	return &detectInterfacesTool{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+	}
+}
+
+func (d *detectInterfacesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("detect_interfaces",
+		mcp.WithDescription("Detect the interfaces a contract on the active chain supports (ERC-20, ERC-721, ERC-1155, ERC-2612 permit, Ownable, AccessControl, IVotes) through ERC-165 supportsInterface and selector probing of the deployed bytecode. The result lists which mint, burn, pause and permit flows can be used. When a deployment is given, the result is stored on the deployment and call_function refuses flows the contract does not support."),
+		mcp.WithString("deployment_id",
+			mcp.Description("ID of the deployment to probe. Either deployment_id or contract_address is required"),
+		),
+		mcp.WithString("contract_address",
+			mcp.Description("Address of any contract on the active chain to probe. Either deployment_id or contract_address is required"),
+		),
+	)
+
+	return tool
+}
+
+func (d *detectInterfacesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		deploymentID := request.GetString("deployment_id", "")
+		contractAddress := request.GetString("contract_address", "")
+
+		if (deploymentID == "") == (contractAddress == "") {
+			return mcp.NewToolResultError("Exactly one of deployment_id or contract_address is required"), nil
+		}
+
+		activeChain, err := d.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Interface detection is only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		var deployment *models.Deployment
+		if deploymentID != "" {
+			deployment, err = getConfirmedDeployment(d.deploymentService, deploymentID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if deployment.ChainID != activeChain.ID {
+				return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)), nil
+			}
+			contractAddress = deployment.ContractAddress
+		}
+
+		result, err := utils.DetectInterfaces(activeChain.RPC, contractAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to detect interfaces: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(result)
+
+		if deployment != nil {
+			var interfaces models.JSON
+			if err := json.Unmarshal(resultJSON, &interfaces); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to encode detected interfaces: %v", err)), nil
+			}
+			if err := d.deploymentService.UpdateDeploymentInterfaces(deployment.ID, interfaces); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to store detected interfaces: %v", err)), nil
+			}
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Detected interfaces of %s: ", contractAddress)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// checkDeploymentCapability returns an error when detect_interfaces found that the deployment does not
+// support the flow the function belongs to.
+// Deployments that were never probed are not restricted.
+func checkDeploymentCapability(deployment *models.Deployment, functionName string) error {
+	capability, ok := capabilityFunctionNames[functionName]
+	if !ok || deployment.Interfaces == nil {
+		return nil
+	}
+
+	capabilities, ok := deployment.Interfaces["capabilities"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if supported, ok := capabilities[capability].(bool); ok && !supported {
+		return fmt.Errorf("Contract %s does not support %s (detected by detect_interfaces). Run detect_interfaces again if the contract was upgraded", deployment.ContractAddress, capability)
+	}
+	return nil
+}
+
+// deploymentLacksInterface reports whether detect_interfaces found that the deployment does not support the interface.
+// Deployments that were never probed are assumed to support it.
+func deploymentLacksInterface(deployment *models.Deployment, contractInterface utils.ContractInterface) bool {
+	if deployment.Interfaces == nil {
+		return false
+	}
+
+	interfaces, ok := deployment.Interfaces["interfaces"].([]interface{})
+	if !ok {
+		return false
+	}
+
+	for _, entry := range interfaces {
+		detection, ok := entry.(map[string]interface{})
+		if !ok || detection["interface"] != string(contractInterface) {
+			continue
+		}
+		supported, ok := detection["supported"].(bool)
+		return ok && !supported
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const detectInterfacesContract = "0x1111111111111111111111111111111111111111"
+
+type DetectInterfacesToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	rpcServer         *httptest.Server
+	tool              *detectInterfacesTool
+	chain             *models.Chain
+	template          *models.Template
+	deploymentService services.DeploymentService
+	chainService      services.ChainService
+}
+
+func (suite *DetectInterfacesToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	// The RPC serves an ERC-20 token with permit but without mint, burn or pause
+	var code []byte
+	for _, signature := range []string{
+		"totalSupply()", "balanceOf(address)", "transfer(address,uint256)", "allowance(address,address)", "approve(address,uint256)", "transferFrom(address,address,uint256)",
+		"permit(address,address,uint256,uint256,uint8,bytes32,bytes32)", "nonces(address)", "DOMAIN_SEPARATOR()",
+	} {
+		code = append(code, 0x63)
+		code = append(code, utils.FunctionSelector(signature)...)
+	}
+	suite.rpcServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: "0x" + hex.EncodeToString(code)})
+	}))
+
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.tool = NewDetectInterfacesTool(suite.chainService, suite.deploymentService)
+
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       suite.rpcServer.URL,
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{
+		Name:      "My Token",
+		ChainType: models.TransactionChainTypeEthereum,
+	}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *DetectInterfacesToolTestSuite) TearDownSuite() {
+	suite.rpcServer.Close()
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *DetectInterfacesToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *DetectInterfacesToolTestSuite) TestDetectDeploymentInterfaces() {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: detectInterfacesContract,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+
+	result := suite.callHandler(map[string]interface{}{"deployment_id": fmt.Sprintf("%d", deployment.ID)})
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var detection utils.InterfaceDetectionResult
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &detection))
+	suite.True(detection.Supports(utils.InterfaceERC20))
+	suite.True(detection.Supports(utils.InterfaceERC2612))
+	suite.False(detection.Supports(utils.InterfaceOwnable))
+
+	// The detection is stored and restricts the dependent flows
+	stored, err := suite.deploymentService.GetDeploymentByID(deployment.ID)
+	suite.Require().NoError(err)
+	suite.NotNil(stored.Interfaces)
+	suite.Error(checkDeploymentCapability(stored, "mint"))
+	suite.Error(checkDeploymentCapability(stored, "pause"))
+	suite.NoError(checkDeploymentCapability(stored, "permit"))
+	suite.NoError(checkDeploymentCapability(stored, "transfer"))
+}
+
+func (suite *DetectInterfacesToolTestSuite) TestDetectContractAddress() {
+	result := suite.callHandler(map[string]interface{}{"contract_address": detectInterfacesContract})
+	suite.False(result.IsError)
+}
+
+func (suite *DetectInterfacesToolTestSuite) TestInvalidArguments() {
+	suite.True(suite.callHandler(map[string]interface{}{}).IsError)
+	suite.True(suite.callHandler(map[string]interface{}{"deployment_id": "1", "contract_address": detectInterfacesContract}).IsError)
+	suite.True(suite.callHandler(map[string]interface{}{"contract_address": "0x123"}).IsError)
+	suite.True(suite.callHandler(map[string]interface{}{"deployment_id": "999"}).IsError)
+}
+
+func (suite *DetectInterfacesToolTestSuite) TestUndetectedDeploymentIsNotRestricted() {
+	suite.NoError(checkDeploymentCapability(&models.Deployment{}, "mint"))
+}
+
+func TestDetectInterfacesToolTestSuite(t *testing.T) {
+	suite.Run(t, new(DetectInterfacesToolTestSuite))
+}
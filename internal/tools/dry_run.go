@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+)
+
+// DryRunArgument is the argument of the launch, pool, swap and uniswap tools that validates and builds the transactions
+// without creating the session or any record
+const DryRunArgument = "dry_run"
+
+// withDryRun declares the dry_run argument
+func withDryRun() mcp.ToolOption {
+	return mcp.WithBoolean(DryRunArgument,
+		mcp.Description("Validate the arguments, compile and build the transactions without creating the transaction session or any record, and return what would have been created. Optional, defaults to false"),
+	)
+}
+
+// DryRunRecord is a record the tool would have created, without its ID and session ID
+type DryRunRecord struct {
+	Type   string `json:"type"`
+	Record any    `json:"record"`
+}
+
+// DryRunSession is the transaction session the tool would have created
+type DryRunSession struct {
+	ChainType              models.TransactionChainType    `json:"chain_type"`
+	ChainID                uint                           `json:"chain_id"`
+	Metadata               []models.TransactionMetadata   `json:"metadata"`
+	TransactionDeployments []models.TransactionDeployment `json:"transaction_deployments"`
+}
+
+// DryRunResult is returned instead of the session URL when a tool is called with dry_run
+type DryRunResult struct {
+	DryRun   bool            `json:"dry_run"`
+	Tool     string          `json:"tool"`
+	Sessions []DryRunSession `json:"sessions"`
+	Records  []DryRunRecord  `json:"records"`
+}
+
+// newDryRunResult reports the sessions and records a tool would have created
+func newDryRunResult(toolName string, sessions []services.CreateTransactionSessionRequest, records ...DryRunRecord) (*mcp.CallToolResult, error) {
+	result := DryRunResult{
+		DryRun:   true,
+		Tool:     toolName,
+		Sessions: make([]DryRunSession, 0, len(sessions)),
+		Records:  records,
+	}
+	if result.Records == nil {
+		result.Records = []DryRunRecord{}
+	}
+
+	transactions := 0
+	for _, session := range sessions {
+		result.Sessions = append(result.Sessions, DryRunSession{
+			ChainType:              session.ChainType,
+			ChainID:                session.ChainID,
+			Metadata:               session.Metadata,
+			TransactionDeployments: session.TransactionDeployments,
+		})
+		transactions += len(session.TransactionDeployments)
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to marshal the dry run result: %v", err)), nil
+	}
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Dry run of %s: nothing was created. It would create %d transaction session(s) with %d transaction(s) and %d record(s): ", toolName, len(result.Sessions), transactions, len(result.Records))),
+			mcp.NewTextContent(string(resultJSON)),
+		},
+	}, nil
+}
@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployUniswapDryRun(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chainService := services.NewChainService(db)
+	require.NoError(t, chainService.CreateChain(&models.Chain{
+		ChainType: models.TransactionChainTypeEthereum,
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		Name:      "Ethereum Testnet",
+		IsActive:  true,
+	}))
+	tool := NewDeployUniswapTool(chainService, TEST_SERVER_PORT, services.NewEvmService(), services.NewTransactionService(db), services.NewUniswapService(db))
+
+	result, err := tool.GetHandler()(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"version": "v2",
+		"dry_run": true,
+	}}})
+	require.NoError(t, err)
+	require.False(t, result.IsError, "%v", result.Content)
+
+	var dryRun DryRunResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &dryRun))
+	assert.True(t, dryRun.DryRun)
+	assert.Equal(t, "deploy_uniswap", dryRun.Tool)
+	require.Len(t, dryRun.Sessions, 1)
+	assert.Len(t, dryRun.Sessions[0].TransactionDeployments, 2)
+	require.Len(t, dryRun.Records, 1)
+	assert.Equal(t, "uniswap_deployment", dryRun.Records[0].Type)
+
+	// Nothing was persisted
+	var sessions, deployments int64
+	require.NoError(t, db.Model(&models.TransactionSession{}).Count(&sessions).Error)
+	require.NoError(t, db.Model(&models.UniswapDeployment{}).Count(&deployments).Error)
+	assert.Zero(t, sessions)
+	assert.Zero(t, deployments)
+
+	assert.Contains(t, tool.GetTool().InputSchema.Properties, DryRunArgument)
+}
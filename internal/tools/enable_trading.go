@@ -0,0 +1,241 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+type enableTradingTool struct {
+	chainService         services.ChainService
+	deploymentService    services.DeploymentService
+	evmService           services.EvmService
+	txService            services.TransactionService
+	tradingLaunchService services.TradingLaunchService
+	serverPort           int
+}
+
+type EnableTradingArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	OpensAt string `json:"opens_at,omitempty"`
+}
+
+func NewEnableTradingTool(chainService services.ChainService, deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, tradingLaunchService services.TradingLaunchService, serverPort int) *enableTradingTool {
+	return &enableTradingTool{
+		chainService:         chainService,
+		deploymentService:    deploymentService,
+		evmService:           evmService,
+		txService:            txService,
+		tradingLaunchService: tradingLaunchService,
+		serverPort:           serverPort,
+	}
+}
+
+func (e *enableTradingTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("enable_trading",
+		mcp.WithDescription("Final launch step for tokens whose transfers are gated behind an enableTrading style call. Without opens_at the enable trading session is created right away with a signing URL. With opens_at the launch is scheduled: a public countdown page is served and the signing session is created and announced once the opening time is reached. The pool of the token is snapshotted the moment trading opens."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment"),
+		),
+		mcp.WithString("opens_at",
+			mcp.Description("RFC3339 time trading opens (e.g. 2025-01-01T09:00:00Z). Optional, defaults to now"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (e *enableTradingTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args EnableTradingArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(e.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		activeChain, err := e.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Enabling trading is only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if deployment.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)), nil
+		}
+
+		function, err := utils.FindEnableTradingFunction(abiString)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		existing, err := e.tradingLaunchService.GetActiveTradingLaunch(deployment.ID)
+		if err == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment already has a %s trading launch (ID: %d)", existing.Status, existing.ID)), nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read trading launches: %v", err)), nil
+		}
+
+		now := time.Now()
+		opensAt := now
+		if args.OpensAt != "" {
+			opensAt, err = time.Parse(time.RFC3339, args.OpensAt)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid opens_at format, expected RFC3339: %v", err)), nil
+			}
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		launch := &models.TradingLaunch{
+			UserID:       userId,
+			DeploymentID: deployment.ID,
+			ChainID:      activeChain.ID,
+			FunctionName: function.Name,
+			OpensAt:      opensAt,
+		}
+		if err := e.tradingLaunchService.CreateTradingLaunch(launch); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create trading launch: %v", err)), nil
+		}
+
+		countdownUrl, err := utils.GetLaunchCountdownUrl(ctx, e.serverPort, launch.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get countdown url: %v", err)), nil
+		}
+
+		if opensAt.After(now) {
+			launchJSON, _ := json.Marshal(launch)
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(fmt.Sprintf("Trading launch %d scheduled at %s, the signing session is created once the opening time is reached: ", launch.ID, opensAt.Format(time.RFC3339))),
+					mcp.NewTextContent(string(launchJSON)),
+					mcp.NewTextContent("Public countdown page:"),
+					mcp.NewTextContent(countdownUrl),
+				},
+			}, nil
+		}
+
+		launch.Deployment = *deployment
+		sessionID, err := e.createEnableTradingSession(ctx, *launch)
+		if err != nil {
+			if updateErr := e.tradingLaunchService.UpdateTradingLaunchStatus(launch.ID, models.TradingLaunchStatusFailed, err.Error()); updateErr != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to update trading launch: %v", updateErr)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create enable trading session: %v", err)), nil
+		}
+
+		if err := e.tradingLaunchService.MarkTradingLaunchPending(launch.ID, sessionID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update trading launch: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, e.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Enable trading session created: %s", sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Trading launch %d calls %s on contract %s, public countdown page: %s", launch.ID, function.Name, deployment.ContractAddress, countdownUrl)),
+				mcp.NewTextContent("Please sign the transaction in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// CreateEnableTradingSession creates the transaction session calling the enable trading function of the launch
+func (e *enableTradingTool) CreateEnableTradingSession(launch models.TradingLaunch) (string, error) {
+	return e.createEnableTradingSession(nil, launch)
+}
+
+// createEnableTradingSession creates the enable trading session of the launch, ctx is the context of the tool call and
+// nil for the sessions created by the trading launch scheduler
+func (e *enableTradingTool) createEnableTradingSession(ctx context.Context, launch models.TradingLaunch) (string, error) {
+	deployment := launch.Deployment
+	abiString, err := utils.GetAbiString(deployment.Template.Abi)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template ABI: %w", err)
+	}
+
+	function, err := utils.FindEnableTradingFunction(abiString)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := e.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: deployment.ContractAddress,
+		FunctionName:    function.Name,
+		FunctionArgs:    function.Args(),
+		Abi:             abiString,
+		Value:           "0",
+		Title:           "Enable Trading",
+		Description:     fmt.Sprintf("Call %s on contract %s to open trading", function.Name, deployment.ContractAddress),
+		TransactionType: models.TransactionTypeEnableTrading,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s transaction: %w", function.Name, err)
+	}
+	tx.ContractAddress = &deployment.ContractAddress
+
+	functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(function.Name, function.Args(), abiString)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	tx.RawContractArguments = &functionArgsString
+
+	sessionID, err := e.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{tx},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                launch.ChainID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "trading_launch_id", Value: strconv.FormatUint(uint64(launch.ID), 10)},
+			{Key: "deployment_id", Value: strconv.FormatUint(uint64(deployment.ID), 10)},
+			{Key: "opens_at", Value: launch.OpensAt.Format(time.RFC3339)},
+		},
+		UserID:     launch.UserID,
+		Context:    ctx,
+		Background: ctx == nil,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+
+	return sessionID, nil
+}
+
+// NewTradingLaunchExecutor returns the executor used by the trading launch scheduler to create the enable trading session
+func NewTradingLaunchExecutor(enableTradingTool *enableTradingTool) services.TradingLaunchExecutor {
+	return enableTradingTool.CreateEnableTradingSession
+}
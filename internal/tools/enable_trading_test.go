@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+const enableTradingTemplateAbi = `[
+	{"type":"function","name":"enableTrading","stateMutability":"nonpayable","inputs":[],"outputs":[]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+type EnableTradingToolTestSuite struct {
+	suite.Suite
+	db                   services.DBService
+	tool                 *enableTradingTool
+	chain                *models.Chain
+	template             *models.Template
+	deploymentService    services.DeploymentService
+	txService            services.TransactionService
+	tradingLaunchService services.TradingLaunchService
+}
+
+func (suite *EnableTradingToolTestSuite) SetupTest() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chainService := services.NewChainService(db.GetDB())
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tradingLaunchService = services.NewTradingLaunchService(db.GetDB())
+	suite.tool = NewEnableTradingTool(chainService, suite.deploymentService, services.NewEvmService(), suite.txService, suite.tradingLaunchService, 8080)
+
+	suite.chain = &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(chainService.CreateChain(suite.chain))
+
+	var abiArray []interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(enableTradingTemplateAbi), &abiArray))
+	suite.template = &models.Template{
+		Name:      "Gated Token",
+		ChainType: models.TransactionChainTypeEthereum,
+		Abi:       models.JSON(map[string]interface{}{"abi": abiArray}),
+	}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(suite.template))
+}
+
+func (suite *EnableTradingToolTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *EnableTradingToolTestSuite) createDeployment() *models.Deployment {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: "0x1111111111111111111111111111111111111111",
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *EnableTradingToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *EnableTradingToolTestSuite) TestEnableTradingNow() {
+	deployment := suite.createDeployment()
+
+	result := suite.callHandler(map[string]interface{}{"deployment_id": fmt.Sprintf("%d", deployment.ID)})
+	suite.Require().False(result.IsError, result.Content)
+
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	var sessionID string
+	_, err := fmt.Sscanf(textContent.Text, "Enable trading session created: %s", &sessionID)
+	suite.Require().NoError(err)
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Require().Len(session.TransactionDeployments, 1)
+	suite.Equal(models.TransactionTypeEnableTrading, session.TransactionDeployments[0].TransactionType)
+	suite.Equal(deployment.ContractAddress, session.TransactionDeployments[0].Receiver)
+
+	launch, err := suite.tradingLaunchService.GetTradingLaunchBySessionId(sessionID)
+	suite.Require().NoError(err)
+	suite.Equal(models.TradingLaunchStatusPending, launch.Status)
+	suite.Equal("enableTrading", launch.FunctionName)
+
+	detail, _ := result.Content[1].(mcp.TextContent)
+	suite.Contains(detail.Text, fmt.Sprintf("/launch/%d", launch.ID))
+
+	// A second launch is rejected while the first one is pending
+	result = suite.callHandler(map[string]interface{}{"deployment_id": fmt.Sprintf("%d", deployment.ID)})
+	suite.True(result.IsError)
+	textContent, _ = result.Content[0].(mcp.TextContent)
+	suite.Contains(textContent.Text, "already has a pending trading launch")
+}
+
+func (suite *EnableTradingToolTestSuite) TestScheduleEnableTrading() {
+	deployment := suite.createDeployment()
+	opensAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"opens_at":      opensAt.Format(time.RFC3339),
+	})
+	suite.Require().False(result.IsError, result.Content)
+	suite.Require().Len(result.Content, 4)
+
+	var launch models.TradingLaunch
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &launch))
+	suite.Equal(models.TradingLaunchStatusScheduled, launch.Status)
+	suite.Empty(launch.SessionId)
+	suite.True(launch.OpensAt.Equal(opensAt))
+
+	countdown, _ := result.Content[3].(mcp.TextContent)
+	suite.Equal(fmt.Sprintf("http://localhost:8080/launch/%d", launch.ID), countdown.Text)
+
+	// The scheduler creates the session through the executor once the launch is due
+	stored, err := suite.tradingLaunchService.GetTradingLaunch(launch.ID)
+	suite.Require().NoError(err)
+	sessionID, err := NewTradingLaunchExecutor(suite.tool)(*stored)
+	suite.Require().NoError(err)
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Contains(session.Metadata, models.TransactionMetadata{Key: "trading_launch_id", Value: fmt.Sprintf("%d", launch.ID)})
+}
+
+func (suite *EnableTradingToolTestSuite) TestRejectsInvalidLaunches() {
+	deployment := suite.createDeployment()
+
+	cases := map[string]map[string]interface{}{
+		"Invalid opens_at format": {"deployment_id": fmt.Sprintf("%d", deployment.ID), "opens_at": "tomorrow"},
+		"Deployment not found":    {"deployment_id": "999"},
+	}
+	for message, arguments := range cases {
+		result := suite.callHandler(arguments)
+		suite.True(result.IsError, message)
+		textContent, _ := result.Content[0].(mcp.TextContent)
+		suite.Contains(textContent.Text, message)
+	}
+
+	var abiArray []interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(`[{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[],"outputs":[]}]`), &abiArray))
+	suite.Require().NoError(suite.db.GetDB().Model(suite.template).Update("abi", models.JSON(map[string]interface{}{"abi": abiArray})).Error)
+
+	result := suite.callHandler(map[string]interface{}{"deployment_id": fmt.Sprintf("%d", deployment.ID)})
+	suite.True(result.IsError)
+}
+
+func TestEnableTradingToolTestSuite(t *testing.T) {
+	suite.Run(t, new(EnableTradingToolTestSuite))
+}
@@ -0,0 +1,297 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// poolApprovalGas is the approval of the token for the Uniswap V2 router
+	poolApprovalGas = 46_000
+	// poolCreationGas is addLiquidityETH creating the Uniswap V2 pair and minting the first liquidity
+	poolCreationGas = 2_400_000
+)
+
+const (
+	DeploymentGasSourceEstimate = "eth_estimateGas"
+	DeploymentGasSourceCompiler = "compiler"
+	DeploymentGasSourceDefault  = "default"
+)
+
+type estimateDeploymentCostTool struct {
+	templateService services.TemplateService
+	chainService    services.ChainService
+	evmService      services.EvmService
+	priceService    services.PriceService
+}
+
+type EstimateDeploymentCostArguments struct {
+	// Required fields
+	TemplateID string `json:"template_id" validate:"required"`
+
+	// Optional fields
+	ContractName    string         `json:"contract_name,omitempty"`
+	TemplateValues  map[string]any `json:"template_values,omitempty"`
+	ConstructorArgs []any          `json:"constructor_args,omitempty"`
+	DeployerAddress string         `json:"deployer_address,omitempty" validate:"omitempty,eth_addr"`
+	IncludePool     *bool          `json:"include_pool,omitempty"`
+}
+
+// ChainDeploymentCost is the estimated launch cost on a configured chain, Error is set when the chain could not be estimated
+type ChainDeploymentCost struct {
+	ChainID             string   `json:"chain_id"`
+	ChainName           string   `json:"chain_name"`
+	NativeSymbol        string   `json:"native_symbol"`
+	DeploymentGas       uint64   `json:"deployment_gas,omitempty"`
+	DeploymentGasSource string   `json:"deployment_gas_source,omitempty"`
+	PoolGas             uint64   `json:"pool_gas,omitempty"`
+	GasPriceWei         string   `json:"gas_price_wei,omitempty"`
+	CostWei             string   `json:"cost_wei,omitempty"`
+	CostNative          string   `json:"cost_native,omitempty"`
+	NativeUSDPrice      *float64 `json:"native_usd_price,omitempty"`
+	CostUSD             *float64 `json:"cost_usd,omitempty"`
+	Error               string   `json:"error,omitempty"`
+}
+
+type EstimateDeploymentCostResult struct {
+	TemplateID   uint                  `json:"template_id"`
+	ContractName string                `json:"contract_name"`
+	Chains       []ChainDeploymentCost `json:"chains"`
+	// PriceError is set when the USD prices could not be fetched, the native costs are still returned
+	PriceError string `json:"price_error,omitempty"`
+}
+
+func NewEstimateDeploymentCostTool(templateService services.TemplateService, chainService services.ChainService, evmService services.EvmService, priceService services.PriceService) *estimateDeploymentCostTool {
+	return &estimateDeploymentCostTool{
+		templateService: templateService,
+		chainService:    chainService,
+		evmService:      evmService,
+		priceService:    priceService,
+	}
+}
+
+func (e *estimateDeploymentCostTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("estimate_deployment_cost",
+		mcp.WithDescription(fmt.Sprintf("Compare the cost of launching a template on every configured Ethereum-compatible chain. The template is compiled and its deployment gas estimated with eth_estimateGas on each chain "+
+			"(falling back to the compiler estimate), the Uniswap V2 pool creation (approve and addLiquidityETH, about %d gas) is added, and the total is multiplied by the live gas price of the chain. "+
+			"Returns the cost in the native token and in USD, cheapest first. USD prices come from a CoinGecko compatible API, set %s to use another one.", poolApprovalGas+poolCreationGas, services.EnvPriceAPIURL)),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template to estimate"),
+		),
+		mcp.WithString("contract_name",
+			mcp.Description("Name of the contract to deploy. Optional, defaults to the contract of the template gas report"),
+		),
+		mcp.WithObject("template_values",
+			mcp.Description("JSON object with runtime values for template parameters. Optional, defaults to the sample values of the template"),
+		),
+		mcp.WithArray("constructor_args",
+			mcp.Description("JSON array of constructor arguments for contract deployment. Optional. Please provide this if the template requires constructor arguments."),
+			mcp.Items(map[string]interface{}{
+				"type":        "any",
+				"description": "Constructor argument, provide the final value (e.g., for uint256 value of 1 ETH, provide 1000000000000000000)",
+			}),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Description("Address the deployment is estimated from. Optional, set it when the constructor depends on the sender"),
+		),
+		mcp.WithBoolean("include_pool",
+			mcp.Description("Whether to add the Uniswap V2 pool creation gas. Optional, defaults to true"),
+		),
+	)
+
+	return tool
+}
+
+func (e *estimateDeploymentCostTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args EstimateDeploymentCostArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		templateID, err := strconv.ParseUint(args.TemplateID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+		template, err := e.templateService.GetTemplateByID(uint(templateID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
+		}
+		if template.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Only ethereum templates can be estimated, template %d is a %s template", template.ID, template.ChainType)), nil
+		}
+
+		contractName := args.ContractName
+		if contractName == "" && template.Report != nil {
+			contractName = template.Report.ContractName
+		}
+		if contractName == "" {
+			return mcp.NewToolResultError("contract_name is required, the template has no gas report naming its contract"), nil
+		}
+
+		chains, err := e.chainService.ListChains()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list chains: %v", err)), nil
+		}
+		chains = slices.DeleteFunc(chains, func(chain models.Chain) bool {
+			return chain.ChainType != models.TransactionChainTypeEthereum
+		})
+		if len(chains) == 0 {
+			return mcp.NewToolResultError("No Ethereum-compatible chain is configured, use set_chain first"), nil
+		}
+
+		templateValues := models.JSON(args.TemplateValues)
+		if templateValues == nil {
+			templateValues = template.SampleTemplateValues
+		}
+		renderedContract, err := utils.RenderContractTemplate(template.TemplateCode, templateValues)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to render contract template: %v", err)), nil
+		}
+		renderedFiles, err := utils.RenderTemplateFiles(template.Files, templateValues)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to render contract template files: %v", err)), nil
+		}
+		tx, _, err := e.evmService.GetContractDeploymentTransactionWithContractCode(services.ContractDeploymentWithContractCodeTransactionArgs{
+			ContractCode:        renderedContract,
+			ContractFiles:       renderedFiles,
+			ContractName:        contractName,
+			ConstructorArgs:     args.ConstructorArgs,
+			Title:               "Estimate Contract",
+			Description:         "Estimate the deployment of the contract",
+			TransactionType:     models.TransactionTypeTokenDeployment,
+			OpenZeppelinVersion: template.OpenZeppelinVersion,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to build the deployment: %v", err)), nil
+		}
+
+		// The compiler estimate is only used when it is bounded and belongs to the estimated contract
+		var compilerGas uint64
+		if template.Report != nil && template.Report.ContractName == contractName {
+			compilerGas, _ = strconv.ParseUint(template.Report.Creation["totalCost"], 10, 64)
+		}
+		var poolGas uint64
+		if args.IncludePool == nil || *args.IncludePool {
+			poolGas = poolApprovalGas + poolCreationGas
+		}
+
+		result := EstimateDeploymentCostResult{TemplateID: template.ID, ContractName: contractName}
+		chainIDs := make([]string, 0, len(chains))
+		for _, chain := range chains {
+			chainIDs = append(chainIDs, chain.NetworkID)
+			result.Chains = append(result.Chains, e.estimateChainCost(chain, tx.Data, args.DeployerAddress, compilerGas, poolGas))
+		}
+
+		prices, err := e.priceService.NativeUSDPrices(ctx, chainIDs)
+		if err != nil {
+			result.PriceError = err.Error()
+		}
+		for i := range result.Chains {
+			cost := &result.Chains[i]
+			price, ok := prices[cost.ChainID]
+			if !ok || cost.Error != "" {
+				continue
+			}
+			native, _ := strconv.ParseFloat(cost.CostNative, 64)
+			usd := native * price
+			cost.NativeUSDPrice = &price
+			cost.CostUSD = &usd
+		}
+		sortChainDeploymentCosts(result.Chains)
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Estimated the launch cost of template %s on %d chains: ", template.Name, len(result.Chains))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// estimateChainCost estimates the deployment gas on the chain and prices it with the gas price of the chain
+func (e *estimateDeploymentCostTool) estimateChainCost(chain models.Chain, deployData string, deployerAddress string, compilerGas uint64, poolGas uint64) ChainDeploymentCost {
+	cost := ChainDeploymentCost{
+		ChainID:      chain.NetworkID,
+		ChainName:    chain.Name,
+		NativeSymbol: e.priceService.NativeCurrency(chain.NetworkID).Symbol,
+		PoolGas:      poolGas,
+	}
+	client := utils.NewRPCClient(chain.RPC)
+
+	gasPrice, err := rpcQuantity(client, "eth_gasPrice")
+	if err != nil {
+		cost.Error = fmt.Sprintf("failed to get the gas price: %v", err)
+		return cost
+	}
+	cost.GasPriceWei = gasPrice.String()
+
+	call := map[string]string{"data": deployData}
+	if deployerAddress != "" {
+		call["from"] = deployerAddress
+	}
+	switch estimate, err := rpcQuantity(client, "eth_estimateGas", call); {
+	case err == nil && estimate.IsUint64():
+		cost.DeploymentGas, cost.DeploymentGasSource = estimate.Uint64(), DeploymentGasSourceEstimate
+	case compilerGas > 0:
+		cost.DeploymentGas, cost.DeploymentGasSource = compilerGas, DeploymentGasSourceCompiler
+	default:
+		cost.DeploymentGas, cost.DeploymentGasSource = defaultDeploymentGas, DeploymentGasSourceDefault
+	}
+
+	wei := new(big.Int).SetUint64(cost.DeploymentGas + cost.PoolGas)
+	wei.Mul(wei, gasPrice)
+	cost.CostWei = wei.String()
+	native := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(1e18))
+	cost.CostNative = native.Text('f', 8)
+	return cost
+}
+
+// sortChainDeploymentCosts orders the chains by USD cost, then the unpriced chains by native cost and the failed chains last
+func sortChainDeploymentCosts(costs []ChainDeploymentCost) {
+	rank := func(cost ChainDeploymentCost) int {
+		switch {
+		case cost.Error != "":
+			return 2
+		case cost.CostUSD == nil:
+			return 1
+		default:
+			return 0
+		}
+	}
+	slices.SortStableFunc(costs, func(a, b ChainDeploymentCost) int {
+		if rankA, rankB := rank(a), rank(b); rankA != rankB {
+			return rankA - rankB
+		}
+		switch rank(a) {
+		case 0:
+			if *a.CostUSD < *b.CostUSD {
+				return -1
+			} else if *a.CostUSD > *b.CostUSD {
+				return 1
+			}
+		case 1:
+			weiA, _ := new(big.Int).SetString(a.CostWei, 10)
+			weiB, _ := new(big.Int).SetString(b.CostWei, 10)
+			return weiA.Cmp(weiB)
+		}
+		return 0
+	})
+}
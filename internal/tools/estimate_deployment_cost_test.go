@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGasRPCServer serves a gas price and a deployment estimate, the estimate fails when estimateGas is empty
+func newGasRPCServer(t *testing.T, gasPrice, estimateGas string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		response := utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID}
+		switch {
+		case request.Method == "eth_gasPrice":
+			response.Result = gasPrice
+		case request.Method == "eth_estimateGas" && estimateGas != "":
+			response.Result = estimateGas
+		default:
+			response.Error = &utils.RPCError{Code: -32000, Message: "execution reverted"}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEstimateChainCost(t *testing.T) {
+	tool := NewEstimateDeploymentCostTool(nil, nil, nil, services.NewPriceService(nil, ""))
+
+	// 1M gas estimated plus 1M of the pool at 2 gwei
+	estimated := tool.estimateChainCost(models.Chain{Name: "Mainnet", NetworkID: "1", RPC: newGasRPCServer(t, "0x77359400", "0xf4240").URL}, "0x6080", "", 500_000, 1_000_000)
+	assert.Empty(t, estimated.Error)
+	assert.Equal(t, uint64(1_000_000), estimated.DeploymentGas)
+	assert.Equal(t, DeploymentGasSourceEstimate, estimated.DeploymentGasSource)
+	assert.Equal(t, "4000000000000000", estimated.CostWei)
+	assert.Equal(t, "0.00400000", estimated.CostNative)
+	assert.Equal(t, "ETH", estimated.NativeSymbol)
+
+	compiler := tool.estimateChainCost(models.Chain{Name: "BSC", NetworkID: "56", RPC: newGasRPCServer(t, "0x3b9aca00", "").URL}, "0x6080", "", 500_000, 0)
+	assert.Equal(t, uint64(500_000), compiler.DeploymentGas)
+	assert.Equal(t, DeploymentGasSourceCompiler, compiler.DeploymentGasSource)
+	assert.Equal(t, "BNB", compiler.NativeSymbol)
+
+	fallback := tool.estimateChainCost(models.Chain{Name: "Local", NetworkID: "31337", RPC: newGasRPCServer(t, "0x1", "").URL}, "0x6080", "", 0, 0)
+	assert.Equal(t, uint64(defaultDeploymentGas), fallback.DeploymentGas)
+	assert.Equal(t, DeploymentGasSourceDefault, fallback.DeploymentGasSource)
+
+	unreachable := tool.estimateChainCost(models.Chain{Name: "Down", NetworkID: "10", RPC: "http://127.0.0.1:1"}, "0x6080", "", 0, 0)
+	assert.Contains(t, unreachable.Error, "failed to get the gas price")
+}
+
+func TestSortChainDeploymentCosts(t *testing.T) {
+	usd := func(value float64) *float64 { return &value }
+	costs := []ChainDeploymentCost{
+		{ChainName: "Down", Error: "failed"},
+		{ChainName: "Local", CostWei: "300"},
+		{ChainName: "Mainnet", CostWei: "100", CostUSD: usd(40)},
+		{ChainName: "Testnet", CostWei: "200"},
+		{ChainName: "Base", CostWei: "50", CostUSD: usd(0.2)},
+	}
+	sortChainDeploymentCosts(costs)
+
+	var names []string
+	for _, cost := range costs {
+		names = append(names, cost.ChainName)
+	}
+	assert.Equal(t, []string{"Base", "Mainnet", "Testnet", "Local", "Down"}, names)
+}
+
+func TestEstimateDeploymentCostValidation(t *testing.T) {
+	templateService, _ := setupTemplatePackTest(t)
+	solanaTemplate := &models.Template{Name: "Solana Token", ChainType: models.TransactionChainTypeSolana, TemplateCode: "pub fn main() {}"}
+	require.NoError(t, templateService.CreateTemplate(solanaTemplate))
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	handler := NewEstimateDeploymentCostTool(templateService, services.NewChainService(db.GetDB()), services.NewEvmService(), services.NewPriceService(nil, "")).GetHandler()
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		expected  string
+	}{
+		{"missing template", map[string]any{}, "Invalid arguments"},
+		{"unknown template", map[string]any{"template_id": "99"}, "Template not found"},
+		{"solana template", map[string]any{"template_id": "2"}, "Only ethereum templates can be estimated"},
+		{"missing contract name", map[string]any{"template_id": "1"}, "contract_name is required"},
+		{"no chains", map[string]any{"template_id": "1", "contract_name": "Sample"}, "No Ethereum-compatible chain is configured"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTemplatePackTool(t, context.Background(), handler, tt.arguments)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+		})
+	}
+}
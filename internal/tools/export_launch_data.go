@@ -0,0 +1,202 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type exportLaunchDataTool struct {
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	txService         services.TransactionService
+}
+
+type ExportLaunchDataArguments struct {
+	// Required fields
+	Dataset string `json:"dataset" validate:"required,oneof=deployments pools swaps"`
+}
+
+func NewExportLaunchDataTool(deploymentService services.DeploymentService, liquidityService services.LiquidityService, txService services.TransactionService) *exportLaunchDataTool {
+	return &exportLaunchDataTool{
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		txService:         txService,
+	}
+}
+
+func (e *exportLaunchDataTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("export_launch_data",
+		mcp.WithDescription("Export deployments, liquidity pools or indexed swaps as CSV with a stable column schema for analytics platforms such as Dune, BigQuery or spreadsheets. Only data of the authenticated user is exported when signed in."),
+		mcp.WithString("dataset",
+			mcp.Required(),
+			mcp.Description("Dataset to export"),
+			mcp.Enum(string(utils.ExportDatasetDeployments), string(utils.ExportDatasetPools), string(utils.ExportDatasetSwaps)),
+		),
+	)
+
+	return tool
+}
+
+func (e *exportLaunchDataTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ExportLaunchDataArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		dataset := utils.ExportDataset(args.Dataset)
+		columns, err := utils.GetExportColumns(dataset)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		userID := utils.GetUserID(ctx)
+		var rows [][]string
+		switch dataset {
+		case utils.ExportDatasetDeployments:
+			rows, err = e.deploymentRows(userID)
+		case utils.ExportDatasetPools:
+			rows, err = e.poolRows(userID)
+		case utils.ExportDatasetSwaps:
+			rows, err = e.swapRows(userID)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to export %s: %v", dataset, err)), nil
+		}
+
+		var buf bytes.Buffer
+		if err := utils.WriteCSV(&buf, columns, rows); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to write CSV: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Exported %d %s rows as CSV (%s.csv): ", len(rows), dataset, dataset)),
+				mcp.NewTextContent(buf.String()),
+			},
+		}, nil
+	}
+}
+
+func (e *exportLaunchDataTool) deploymentRows(userID string) ([][]string, error) {
+	var deployments []models.Deployment
+	var err error
+	if userID != "" {
+		deployments, err = e.deploymentService.ListDeploymentsByUser(userID)
+	} else {
+		deployments, err = e.deploymentService.ListDeployments()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return utils.DeploymentExportRows(deployments), nil
+}
+
+func (e *exportLaunchDataTool) poolRows(userID string) ([][]string, error) {
+	pools, err := e.listPools(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		sessionIDs = append(sessionIDs, pool.SessionId)
+	}
+	sessions, err := e.getSessions(sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The chain of a pool is only known through the session that created it
+	chainIDs := map[uint]string{}
+	for _, pool := range pools {
+		chainIDs[pool.ID] = sessions[pool.SessionId].Chain.NetworkID
+	}
+	return utils.PoolExportRows(pools, chainIDs), nil
+}
+
+func (e *exportLaunchDataTool) swapRows(userID string) ([][]string, error) {
+	pools, err := e.listPools(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []models.PoolSnapshot
+	snapshotPools := map[uint]models.LiquidityPool{}
+	sessionIDs := []string{}
+	for _, pool := range pools {
+		poolSnapshots, err := e.liquidityService.ListPoolSnapshotsByTransactionType(pool.ID, models.TransactionTypeTokenSwap, -1)
+		if err != nil {
+			return nil, err
+		}
+
+		// Snapshots are newest first, export them in chronological order
+		for i := len(poolSnapshots) - 1; i >= 0; i-- {
+			snapshots = append(snapshots, poolSnapshots[i])
+			sessionIDs = append(sessionIDs, poolSnapshots[i].SessionId)
+		}
+		snapshotPools[pool.ID] = pool
+		sessionIDs = append(sessionIDs, pool.SessionId)
+	}
+
+	sessions, err := e.getSessions(sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]utils.SwapExportRecord, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		pool := snapshotPools[snapshot.PoolID]
+		record := utils.SwapExportRecord{
+			Snapshot: snapshot,
+			Pool:     pool,
+			ChainID:  sessions[pool.SessionId].Chain.NetworkID,
+		}
+		for _, meta := range sessions[snapshot.SessionId].Metadata {
+			switch meta.Key {
+			case "from_token":
+				record.FromToken = meta.Value
+			case "to_token":
+				record.ToToken = meta.Value
+			case "amount":
+				record.Amount = meta.Value
+			}
+		}
+		records = append(records, record)
+	}
+	return utils.SwapExportRows(records), nil
+}
+
+func (e *exportLaunchDataTool) listPools(userID string) ([]models.LiquidityPool, error) {
+	// A negative limit exports every pool
+	if userID != "" {
+		return e.liquidityService.ListLiquidityPoolsByUser(userID, 0, -1)
+	}
+	return e.liquidityService.ListLiquidityPools(0, -1)
+}
+
+// getSessions returns the sessions keyed by ID, sessions that do not exist are left out
+func (e *exportLaunchDataTool) getSessions(sessionIDs []string) (map[string]models.TransactionSession, error) {
+	sessions, err := e.txService.ListTransactionSessionsByIDs(sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsByID := make(map[string]models.TransactionSession, len(sessions))
+	for _, session := range sessions {
+		sessionsByID[session.ID] = session
+	}
+	return sessionsByID, nil
+}
@@ -0,0 +1,179 @@
+package tools
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportLaunchDataToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	tool              *exportLaunchDataTool
+	chain             *models.Chain
+	pool              *models.LiquidityPool
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	txService         services.TransactionService
+	chainService      services.ChainService
+	templateService   services.TemplateService
+}
+
+func (suite *ExportLaunchDataToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.liquidityService = services.NewLiquidityService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.templateService = services.NewTemplateService(db.GetDB())
+	suite.tool = NewExportLaunchDataTool(suite.deploymentService, suite.liquidityService, suite.txService)
+
+	suite.setupTestData()
+}
+
+func (suite *ExportLaunchDataToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ExportLaunchDataToolTestSuite) setupTestData() {
+	chain := &models.Chain{
+		Name:      "Ethereum",
+		RPC:       "http://localhost:8545",
+		NetworkID: "1",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{
+		Name:      "My Token",
+		ChainType: models.TransactionChainTypeEthereum,
+	}
+	suite.Require().NoError(suite.templateService.CreateTemplate(template))
+
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(&models.Deployment{
+		ChainID:         chain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: "0x1111111111111111111111111111111111111111",
+	}))
+
+	poolSessionID := suite.createSession(nil)
+	pool := &models.LiquidityPool{
+		TokenAddress:   "0x1111111111111111111111111111111111111111",
+		PairAddress:    "0x2222222222222222222222222222222222222222",
+		UniswapVersion: "v2",
+		Token0:         "0x0000000000000000000000000000000000000000",
+		Token1:         "0x1111111111111111111111111111111111111111",
+		InitialToken0:  "1000",
+		InitialToken1:  "2000",
+		Status:         models.TransactionStatusConfirmed,
+		SessionId:      poolSessionID,
+	}
+	_, err := suite.liquidityService.CreateLiquidityPool(pool)
+	suite.Require().NoError(err)
+	suite.pool = pool
+
+	swapSessionID := suite.createSession([]models.TransactionMetadata{
+		{Key: "from_token", Value: "0x0000000000000000000000000000000000000000"},
+		{Key: "to_token", Value: "0x1111111111111111111111111111111111111111"},
+		{Key: "amount", Value: "10"},
+	})
+	suite.Require().NoError(suite.liquidityService.CreatePoolSnapshot(&models.PoolSnapshot{
+		PoolID:          pool.ID,
+		Reserve0:        "1010",
+		Reserve1:        "1980",
+		Price:           1.96,
+		TransactionType: models.TransactionTypeTokenSwap,
+		TransactionHash: "0xswap",
+		SessionId:       swapSessionID,
+	}))
+}
+
+func (suite *ExportLaunchDataToolTestSuite) createSession(metadata []models.TransactionMetadata) string {
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		Metadata:  metadata,
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   suite.chain.ID,
+	})
+	suite.Require().NoError(err)
+	return sessionID
+}
+
+func (suite *ExportLaunchDataToolTestSuite) export(dataset string) [][]string {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"dataset": dataset},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	records, err := csv.NewReader(strings.NewReader(textContent.Text)).ReadAll()
+	suite.Require().NoError(err)
+	return records
+}
+
+func (suite *ExportLaunchDataToolTestSuite) TestExportDeployments() {
+	records := suite.export("deployments")
+	suite.Require().Len(records, 2)
+	suite.Equal(utils.DeploymentExportColumns, records[0])
+	suite.Equal("1", records[1][1])
+	suite.Equal("My Token", records[1][3])
+	suite.Equal("0x1111111111111111111111111111111111111111", records[1][4])
+}
+
+func (suite *ExportLaunchDataToolTestSuite) TestExportPools() {
+	records := suite.export("pools")
+	suite.Require().Len(records, 2)
+	suite.Equal(utils.PoolExportColumns, records[0])
+	// chain ID is resolved through the session that created the pool
+	suite.Equal("1", records[1][1])
+	suite.Equal("0x2222222222222222222222222222222222222222", records[1][3])
+}
+
+func (suite *ExportLaunchDataToolTestSuite) TestExportSwaps() {
+	records := suite.export("swaps")
+	suite.Require().Len(records, 2)
+	suite.Equal(utils.SwapExportColumns, records[0])
+	suite.Equal("1", records[1][2])
+	suite.Equal("0x0000000000000000000000000000000000000000", records[1][4])
+	suite.Equal("0x1111111111111111111111111111111111111111", records[1][5])
+	suite.Equal("10", records[1][6])
+	suite.Equal("0xswap", records[1][10])
+}
+
+func (suite *ExportLaunchDataToolTestSuite) TestInvalidDataset() {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"dataset": "transfers"},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.True(result.IsError)
+}
+
+func TestExportLaunchDataToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportLaunchDataToolTestSuite))
+}
@@ -0,0 +1,225 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// TemplateBundleVersion is the version of the template bundle format
+	TemplateBundleVersion = 1
+	// TemplateBundleManifest is the manifest of a template directory, listing the templates and their files
+	TemplateBundleManifest = "templates.json"
+
+	templateFormatJSON      = "json"
+	templateFormatDirectory = "directory"
+)
+
+// TemplateBundle is a portable pack of templates shared between users
+type TemplateBundle struct {
+	Version   int                   `json:"version"`
+	Templates []TemplateBundleEntry `json:"templates"`
+}
+
+// TemplateBundleEntry is a template of a bundle. A JSON bundle embeds the template code,
+// the manifest of a directory points to the template file relative to the directory instead
+type TemplateBundleEntry struct {
+	Name                 string                      `json:"name"`
+	Description          string                      `json:"description"`
+	ChainType            models.TransactionChainType `json:"chain_type"`
+	TemplateCode         string                      `json:"template_code,omitempty"`
+	File                 string                      `json:"file,omitempty"`
+	Metadata             models.JSON                 `json:"metadata,omitempty"`
+	SampleTemplateValues models.JSON                 `json:"sample_template_values,omitempty"`
+	Abi                  models.JSON                 `json:"abi,omitempty"`
+	Idl                  models.JSON                 `json:"idl,omitempty"`
+	// Files are the libraries and interfaces of a multi-file template, inline in both formats
+	Files               models.TemplateFiles `json:"files,omitempty"`
+	OpenZeppelinVersion string               `json:"openzeppelin_version,omitempty"`
+}
+
+type exportTemplatesTool struct {
+	templateService services.TemplateService
+}
+
+type ExportTemplatesArguments struct {
+	// Optional fields
+	TemplateIDs string `json:"template_ids,omitempty"`
+	ChainType   string `json:"chain_type,omitempty" validate:"omitempty,oneof=ethereum solana"`
+	Format      string `json:"format,omitempty" validate:"omitempty,oneof=json directory"`
+	Directory   string `json:"directory,omitempty" validate:"required_if=Format directory"`
+}
+
+func NewExportTemplatesTool(templateService services.TemplateService) *exportTemplatesTool {
+	return &exportTemplatesTool{
+		templateService: templateService,
+	}
+}
+
+func (e *exportTemplatesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("export_templates",
+		mcp.WithDescription("Export templates with their code, metadata, sample values and ABI as a JSON bundle, or as a directory of template files with a templates.json manifest, to share template packs with other users through import_templates. The directory format writes to the filesystem of the server and is only available to the local stdio server."),
+		mcp.WithString("template_ids",
+			mcp.Description("Comma-separated list of template IDs to export (e.g., '1,2,3'). Optional, exports every template when omitted"),
+		),
+		mcp.WithString("chain_type",
+			mcp.Description("Only export templates of this chain type. Optional"),
+			mcp.Enum(string(models.TransactionChainTypeEthereum), string(models.TransactionChainTypeSolana)),
+		),
+		mcp.WithString("format",
+			mcp.Description("Export format. json returns the bundle in the result, directory writes one file per template and a templates.json manifest. Optional, defaults to json"),
+			mcp.Enum(templateFormatJSON, templateFormatDirectory),
+		),
+		mcp.WithString("directory",
+			mcp.Description("Directory to write the templates to, created when missing. Required when format is directory"),
+		),
+	)
+
+	return tool
+}
+
+func (e *exportTemplatesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var userId *string
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		var args ExportTemplatesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if args.Format == templateFormatDirectory && user != nil {
+			return mcp.NewToolResultError("The directory format is only available to the local stdio server, use the json format instead"), nil
+		}
+
+		templates, err := e.selectTemplates(userId, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if len(templates) == 0 {
+			return mcp.NewToolResultError("No templates to export"), nil
+		}
+
+		bundle := TemplateBundle{Version: TemplateBundleVersion}
+		for _, template := range templates {
+			bundle.Templates = append(bundle.Templates, TemplateBundleEntry{
+				Name:                 template.Name,
+				Description:          template.Description,
+				ChainType:            template.ChainType,
+				TemplateCode:         template.TemplateCode,
+				Files:                template.Files,
+				OpenZeppelinVersion:  template.OpenZeppelinVersion,
+				Metadata:             template.Metadata,
+				SampleTemplateValues: template.SampleTemplateValues,
+				Abi:                  template.Abi,
+				Idl:                  template.Idl,
+			})
+		}
+
+		if args.Format == templateFormatDirectory {
+			if err := writeTemplateDirectory(args.Directory, templates, &bundle); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to write templates: %v", err)), nil
+			}
+			return mcp.NewToolResultText(fmt.Sprintf("Exported %d templates to %s", len(bundle.Templates), args.Directory)), nil
+		}
+
+		bundleJSON, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to encode templates: %v", err)), nil
+		}
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Exported %d templates, pass the bundle to import_templates to import them: ", len(bundle.Templates))),
+				mcp.NewTextContent(string(bundleJSON)),
+			},
+		}, nil
+	}
+}
+
+// selectTemplates returns the requested templates, or every template of the chain type without template_ids
+func (e *exportTemplatesTool) selectTemplates(userId *string, args ExportTemplatesArguments) ([]models.Template, error) {
+	if strings.TrimSpace(args.TemplateIDs) == "" {
+		templates, err := e.templateService.ListTemplates(userId, args.ChainType, "", 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list templates: %w", err)
+		}
+		return templates, nil
+	}
+
+	var templates []models.Template
+	for _, idStr := range strings.Split(args.TemplateIDs, ",") {
+		idStr = strings.TrimSpace(idStr)
+		if idStr == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template ID %s, IDs must be positive integers", idStr)
+		}
+		template, err := e.templateService.GetTemplateByID(uint(id))
+		if err != nil {
+			return nil, fmt.Errorf("template %d not found: %w", id, err)
+		}
+		if args.ChainType != "" && string(template.ChainType) != args.ChainType {
+			continue
+		}
+		templates = append(templates, *template)
+	}
+	return templates, nil
+}
+
+var templateFileNameReplacer = regexp.MustCompile(`[^a-z0-9]+`)
+
+// templateFileName returns the file of the template in a directory, prefixed with the ID to keep it unique
+func templateFileName(template models.Template) string {
+	name := strings.Trim(templateFileNameReplacer.ReplaceAllString(strings.ToLower(template.Name), "-"), "-")
+	if name == "" {
+		name = "template"
+	}
+	extension := ".sol.tmpl"
+	if template.ChainType == models.TransactionChainTypeSolana {
+		extension = ".rs.tmpl"
+	}
+	return fmt.Sprintf("%d-%s%s", template.ID, name, extension)
+}
+
+// writeTemplateDirectory writes the code of every template to its own file and the rest of the bundle to the manifest
+func writeTemplateDirectory(directory string, templates []models.Template, bundle *TemplateBundle) error {
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return err
+	}
+	for i, template := range templates {
+		file := templateFileName(template)
+		if err := os.WriteFile(filepath.Join(directory, file), []byte(template.TemplateCode), 0644); err != nil {
+			return err
+		}
+		bundle.Templates[i].File = file
+		bundle.Templates[i].TemplateCode = ""
+	}
+
+	manifest, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(directory, TemplateBundleManifest), manifest, 0644)
+}
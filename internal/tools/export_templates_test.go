@@ -0,0 +1,164 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTemplatePackTest(t *testing.T) (services.TemplateService, *models.Template) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	templateService := services.NewTemplateService(db.GetDB())
+	template := &models.Template{
+		Name:                 "Basic Token",
+		Description:          "ERC20 token",
+		ChainType:            models.TransactionChainTypeEthereum,
+		TemplateCode:         "contract {{.TokenName}} {}",
+		Metadata:             models.JSON{"TokenName": ""},
+		SampleTemplateValues: models.JSON{"TokenName": "Sample"},
+		Abi:                  models.JSON{"abi": []any{}},
+	}
+	require.NoError(t, templateService.CreateTemplate(template))
+	return templateService, template
+}
+
+func callTemplatePackTool(t *testing.T, ctx context.Context, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), arguments map[string]any) *mcp.CallToolResult {
+	result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+	require.NoError(t, err)
+	return result
+}
+
+func TestExportImportTemplatesJSONBundle(t *testing.T) {
+	templateService, template := setupTemplatePackTest(t)
+	ctx := context.Background()
+
+	exported := callTemplatePackTool(t, ctx, NewExportTemplatesTool(templateService).GetHandler(), map[string]any{})
+	require.False(t, exported.IsError, exported.Content[0].(mcp.TextContent).Text)
+	require.Len(t, exported.Content, 2)
+	bundleJSON := exported.Content[1].(mcp.TextContent).Text
+
+	var bundle TemplateBundle
+	require.NoError(t, json.Unmarshal([]byte(bundleJSON), &bundle))
+	assert.Equal(t, TemplateBundleVersion, bundle.Version)
+	require.Len(t, bundle.Templates, 1)
+	assert.Equal(t, template.TemplateCode, bundle.Templates[0].TemplateCode)
+
+	imported := callTemplatePackTool(t, ctx, NewImportTemplatesTool(templateService).GetHandler(), map[string]any{"bundle": bundleJSON})
+	require.False(t, imported.IsError, imported.Content[0].(mcp.TextContent).Text)
+
+	var result ImportTemplatesResult
+	require.NoError(t, json.Unmarshal([]byte(imported.Content[1].(mcp.TextContent).Text), &result))
+	require.Len(t, result.Imported, 1)
+	assert.NotEqual(t, template.ID, result.Imported[0].ID)
+
+	copied, err := templateService.GetTemplateByID(result.Imported[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, template.Name, copied.Name)
+	assert.Equal(t, template.TemplateCode, copied.TemplateCode)
+	assert.Equal(t, template.Metadata, copied.Metadata)
+	assert.Equal(t, template.SampleTemplateValues, copied.SampleTemplateValues)
+	assert.NotNil(t, copied.Abi)
+}
+
+func TestExportImportTemplatesDirectory(t *testing.T) {
+	templateService, template := setupTemplatePackTest(t)
+	ctx := context.Background()
+	directory := filepath.Join(t.TempDir(), "pack")
+
+	exported := callTemplatePackTool(t, ctx, NewExportTemplatesTool(templateService).GetHandler(), map[string]any{"format": "directory", "directory": directory})
+	require.False(t, exported.IsError, exported.Content[0].(mcp.TextContent).Text)
+
+	code, err := os.ReadFile(filepath.Join(directory, "1-basic-token.sol.tmpl"))
+	require.NoError(t, err)
+	assert.Equal(t, template.TemplateCode, string(code))
+	assert.FileExists(t, filepath.Join(directory, TemplateBundleManifest))
+
+	imported := callTemplatePackTool(t, ctx, NewImportTemplatesTool(templateService).GetHandler(), map[string]any{"directory": directory})
+	require.False(t, imported.IsError, imported.Content[0].(mcp.TextContent).Text)
+
+	templates, err := templateService.ListTemplates(nil, "", "", 0)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, template.TemplateCode, templates[1].TemplateCode)
+}
+
+func TestImportTemplatesRejectsInvalidBundles(t *testing.T) {
+	templateService, _ := setupTemplatePackTest(t)
+	handler := NewImportTemplatesTool(templateService).GetHandler()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		expected  string
+	}{
+		{"missing source", map[string]any{}, "Invalid arguments"},
+		{"invalid json", map[string]any{"bundle": "{"}, "Invalid template bundle"},
+		{"unsupported version", map[string]any{"bundle": `{"version": 2, "templates": []}`}, "unsupported template bundle version"},
+		{"invalid chain type", map[string]any{"bundle": `{"version": 1, "templates": [{"name": "Token", "chain_type": "bitcoin", "template_code": "code"}]}`}, "invalid chain_type"},
+		{"invalid metadata", map[string]any{"bundle": `{"version": 1, "templates": [{"name": "Token", "chain_type": "ethereum", "template_code": "code", "metadata": {"TokenName": "x"}}]}`}, "metadata values must be empty strings"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTemplatePackTool(t, ctx, handler, tt.arguments)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+		})
+	}
+
+	templates, err := templateService.ListTemplates(nil, "", "", 0)
+	require.NoError(t, err)
+	assert.Len(t, templates, 1, "invalid bundles must not create templates")
+}
+
+func TestTemplatePackDirectoryRequiresLocalServer(t *testing.T) {
+	templateService, _ := setupTemplatePackTest(t)
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+	directory := t.TempDir()
+
+	exported := callTemplatePackTool(t, ctx, NewExportTemplatesTool(templateService).GetHandler(), map[string]any{"format": "directory", "directory": directory})
+	assert.True(t, exported.IsError)
+	imported := callTemplatePackTool(t, ctx, NewImportTemplatesTool(templateService).GetHandler(), map[string]any{"directory": directory})
+	assert.True(t, imported.IsError)
+
+	// Template files are read relative to the directory only
+	manifest := `{"version": 1, "templates": [{"name": "Token", "chain_type": "ethereum", "file": "../secret.sol.tmpl"}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(directory, TemplateBundleManifest), []byte(manifest), 0644))
+	imported = callTemplatePackTool(t, context.Background(), NewImportTemplatesTool(templateService).GetHandler(), map[string]any{"directory": directory})
+	assert.True(t, imported.IsError)
+	assert.Contains(t, imported.Content[0].(mcp.TextContent).Text, "must be inside the directory")
+}
+
+func TestImportTemplatesBuiltInPack(t *testing.T) {
+	templateService, _ := setupTemplatePackTest(t)
+	handler := NewImportTemplatesTool(templateService).GetHandler()
+	ctx := context.Background()
+
+	conflicting := callTemplatePackTool(t, ctx, handler, map[string]any{"pack": "cross-chain", "bundle": `{"version": 1, "templates": []}`})
+	assert.True(t, conflicting.IsError)
+	unknown := callTemplatePackTool(t, ctx, handler, map[string]any{"pack": "unknown"})
+	assert.True(t, unknown.IsError)
+	assert.Contains(t, unknown.Content[0].(mcp.TextContent).Text, "unknown template pack")
+
+	imported := callTemplatePackTool(t, ctx, handler, map[string]any{"pack": "cross-chain"})
+	require.False(t, imported.IsError, imported.Content[0].(mcp.TextContent).Text)
+
+	templates, err := templateService.ListTemplates(nil, "", "", 0)
+	require.NoError(t, err)
+	require.Len(t, templates, 3)
+	assert.Equal(t, "LayerZero OFT Token", templates[1].Name)
+	assert.Contains(t, templates[1].Files, "interfaces/ILayerZeroEndpointV2.sol")
+	assert.Equal(t, "Axelar ITS Token", templates[2].Name)
+}
@@ -0,0 +1,260 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type exportUnsignedTransactionsTool struct {
+	txService services.TransactionService
+}
+
+type ExportUnsignedTransactionsArguments struct {
+	// Required fields
+	SessionID string `json:"session_id" validate:"required"`
+	From      string `json:"from" validate:"required,eth_addr"`
+
+	// Optional fields
+	Nonce    *uint64 `json:"nonce,omitempty"`
+	GasLimit *uint64 `json:"gas_limit,omitempty" validate:"omitempty,gt=0"`
+}
+
+func NewExportUnsignedTransactionsTool(txService services.TransactionService) *exportUnsignedTransactionsTool {
+	return &exportUnsignedTransactionsTool{
+		txService: txService,
+	}
+}
+
+func (e *exportUnsignedTransactionsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("export_unsigned_transactions",
+		mcp.WithDescription("Export the pending transactions of a signing session as unsigned EIP-1559 transactions to sign on an air-gapped machine instead of in the browser. "+
+			"Each transaction comes in the JSON-RPC format of eth_signTransaction with its EIP-2718 unsigned payload and the hash to sign, nonces follow the pending nonce of the from address. "+
+			"Submit each signed transaction in order with submit_signed_transaction. Exporting again replaces the previous export."),
+		mcp.WithString("session_id",
+			mcp.Required(),
+			mcp.Description("ID of the pending signing session returned by the tool that created it"),
+		),
+		mcp.WithString("from",
+			mcp.Required(),
+			mcp.Description("Address of the offline account that signs the transactions"),
+		),
+		mcp.WithNumber("nonce",
+			mcp.Description("Nonce of the first transaction. Optional, defaults to the pending nonce of the from address"),
+		),
+		mcp.WithNumber("gas_limit",
+			mcp.Description("Gas limit of the transactions the node cannot estimate, e.g. calls to a contract deployed by an earlier transaction of the session. Optional"),
+		),
+	)
+
+	return tool
+}
+
+func (e *exportUnsignedTransactionsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ExportUnsignedTransactionsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		session, err := e.txService.GetTransactionSession(args.SessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Session not found: %v", err)), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (session.UserID == nil || *session.UserID != userID) {
+			return mcp.NewToolResultError("Session not found"), nil
+		}
+		if err := checkOfflineSession(session); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		chainID, ok := new(big.Int).SetString(session.Chain.NetworkID, 10)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid chain ID: %s", session.Chain.NetworkID)), nil
+		}
+
+		client, err := ethclient.DialContext(ctx, session.Chain.RPC)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to connect to %s: %v", session.Chain.RPC, err)), nil
+		}
+		defer client.Close()
+
+		from := common.HexToAddress(args.From)
+		nonce, err := client.PendingNonceAt(ctx, from)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get the nonce of %s: %v", from.Hex(), err)), nil
+		}
+		if args.Nonce != nil {
+			nonce = *args.Nonce
+		}
+		tip, feeCap, err := suggestDynamicFees(ctx, client)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		file := utils.UnsignedTransactionFile{
+			Format:    utils.UnsignedTransactionFormat,
+			SessionID: session.ID,
+			ChainID:   chainID.String(),
+			From:      from.Hex(),
+		}
+		export := &models.OfflineExport{From: from.Hex(), ExportedAt: time.Now()}
+		for i, deployment := range session.TransactionDeployments {
+			if deployment.Status == models.TransactionStatusConfirmed {
+				continue
+			}
+			tx, err := offlineTransaction(ctx, client, chainID, from, nonce, tip, feeCap, args.GasLimit, deployment)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Transaction %d (%s): %v", i, deployment.Title, err)), nil
+			}
+			unsigned, err := utils.NewUnsignedTransaction(i, deployment.Title, tx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			file.Transactions = append(file.Transactions, unsigned)
+			export.Transactions = append(export.Transactions, models.OfflineTransaction{Index: i, Nonce: nonce, SigningHash: unsigned.SigningHash})
+			nonce++
+		}
+
+		session.OfflineExport = export
+		if err := e.txService.UpdateTransactionSession(session.ID, session); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to record the export on the session: %v", err)), nil
+		}
+
+		fileJSON, _ := json.MarshalIndent(file, "", "  ")
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Exported %d unsigned transactions of session %s for %s on chain %s. Sign them on the offline machine, then call submit_signed_transaction with each signed raw transaction in order",
+					len(file.Transactions), session.ID, from.Hex(), chainID)),
+				mcp.NewTextContent(string(fileJSON)),
+			},
+		}, nil
+	}
+}
+
+// checkOfflineSession rejects the sessions that are not signed as plain transactions by one account
+func checkOfflineSession(session *models.TransactionSession) error {
+	if session.TransactionStatus != models.TransactionStatusPending {
+		return fmt.Errorf("Session is %s, only pending sessions can be signed offline", session.TransactionStatus)
+	}
+	if session.TransactionChainType != models.TransactionChainTypeEthereum {
+		return fmt.Errorf("Only Ethereum sessions can be signed offline")
+	}
+	if session.SignatureRequest != nil {
+		return fmt.Errorf("Session requests a message signature, sign it in the browser")
+	}
+	if session.SafeProposal != nil {
+		return fmt.Errorf("Session was proposed to Safe %s, it is completed once the Safe executes it", session.SafeProposal.SafeAddress)
+	}
+	for i, deployment := range session.TransactionDeployments {
+		if deployment.Status == models.TransactionStatusConfirmed {
+			continue
+		}
+		if deployment.UserOperation {
+			return fmt.Errorf("Transaction %d (%s) is gasless, sign it in the browser", i, deployment.Title)
+		}
+		if deployment.PrivateRelay {
+			return fmt.Errorf("Transaction %d (%s) is submitted through the private relay, sign it in the browser", i, deployment.Title)
+		}
+	}
+	return nil
+}
+
+// suggestDynamicFees returns the suggested priority fee and a fee cap covering the base fee doubling, the margin
+// go-ethereum uses, since an offline transaction is usually broadcast a while after its export
+func suggestDynamicFees(ctx context.Context, client *ethclient.Client) (*big.Int, *big.Int, error) {
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to get the priority fee: %v", err)
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to get the latest block: %v", err)
+	}
+	if header.BaseFee == nil {
+		return nil, nil, fmt.Errorf("The chain does not support EIP-1559 transactions")
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+	return tip, feeCap, nil
+}
+
+// offlineTransaction builds the unsigned transaction of the deployment with the gas and fee cap of the session when
+// set. The estimate falls back to gasLimit, later transactions of a session often call contracts not deployed yet
+func offlineTransaction(ctx context.Context, client *ethclient.Client, chainID *big.Int, from common.Address, nonce uint64, tip, feeCap *big.Int, gasLimit *uint64, deployment models.TransactionDeployment) (*types.DynamicFeeTx, error) {
+	value := new(big.Int)
+	if deployment.Value != "" {
+		if _, ok := value.SetString(deployment.Value, 10); !ok || value.Sign() < 0 {
+			return nil, fmt.Errorf("invalid value %s", deployment.Value)
+		}
+	}
+	var data []byte
+	if deployment.Data != "" && deployment.Data != "0x" {
+		var err error
+		if data, err = hexutil.Decode(deployment.Data); err != nil {
+			return nil, fmt.Errorf("invalid data: %v", err)
+		}
+	}
+	var to *common.Address
+	if deployment.Receiver != "" {
+		if !utils.IsValidEthereumAddress(deployment.Receiver) {
+			return nil, fmt.Errorf("invalid receiver %s", deployment.Receiver)
+		}
+		receiver := common.HexToAddress(deployment.Receiver)
+		to = &receiver
+	}
+
+	if deployment.GasPrice != nil {
+		if gasPrice, ok := new(big.Int).SetString(*deployment.GasPrice, 10); ok {
+			feeCap = gasPrice
+			if tip.Cmp(feeCap) > 0 {
+				tip = feeCap
+			}
+		}
+	}
+
+	var gas uint64
+	if deployment.GasLimit != nil {
+		gas, _ = strconv.ParseUint(*deployment.GasLimit, 10, 64)
+	}
+	if gas == 0 {
+		estimated, err := client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: to, Value: value, Data: data})
+		switch {
+		case err == nil:
+			// Same margin as wallets add on top of the estimate
+			gas = estimated * 12 / 10
+		case gasLimit != nil:
+			gas = *gasLimit
+		default:
+			return nil, fmt.Errorf("failed to estimate gas, pass gas_limit: %v", err)
+		}
+	}
+
+	return &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	}, nil
+}
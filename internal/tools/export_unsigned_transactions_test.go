@@ -0,0 +1,274 @@
+package tools
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// offlineNode is a JSON-RPC node of chain 31337 mining every raw transaction it receives
+type offlineNode struct {
+	*httptest.Server
+	mu           sync.Mutex
+	transactions []*types.Transaction
+}
+
+func newOfflineNode(t *testing.T) *offlineNode {
+	node := &offlineNode{}
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		switch req.Method {
+		case "eth_getTransactionCount":
+			node.mu.Lock()
+			result = hexutil.Uint64(len(node.transactions))
+			node.mu.Unlock()
+		case "eth_maxPriorityFeePerGas":
+			result = "0x3b9aca00"
+		case "eth_getBlockByNumber":
+			result = &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(0), GasLimit: 30000000, BaseFee: big.NewInt(2e9)}
+		case "eth_estimateGas":
+			result = "0x5208"
+		case "eth_sendRawTransaction":
+			var raw hexutil.Bytes
+			require.NoError(t, json.Unmarshal(req.Params[0], &raw))
+			tx := new(types.Transaction)
+			require.NoError(t, tx.UnmarshalBinary(raw))
+			node.mu.Lock()
+			node.transactions = append(node.transactions, tx)
+			node.mu.Unlock()
+			result = tx.Hash().Hex()
+		case "eth_getTransactionReceipt":
+			var hash common.Hash
+			require.NoError(t, json.Unmarshal(req.Params[0], &hash))
+			result = node.receipt(hash)
+		default:
+			t.Errorf("unexpected RPC method %s", req.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+// receipt returns the receipt of a mined transaction, the contract creations deploy at the address of the sender nonce
+func (n *offlineNode) receipt(hash common.Hash) map[string]any {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, tx := range n.transactions {
+		if tx.Hash() != hash {
+			continue
+		}
+		receipt := map[string]any{
+			"transactionHash":   hash.Hex(),
+			"blockHash":         common.HexToHash("0x01").Hex(),
+			"blockNumber":       "0x1",
+			"status":            "0x1",
+			"cumulativeGasUsed": "0x5208",
+			"gasUsed":           "0x5208",
+			"logs":              []any{},
+			"logsBloom":         hexutil.Bytes(make([]byte, 256)),
+		}
+		if tx.To() == nil {
+			sender, _ := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+			receipt["contractAddress"] = crypto.CreateAddress(sender, tx.Nonce()).Hex()
+		}
+		return receipt
+	}
+	return nil
+}
+
+// recordingHookService records the confirmed transactions
+type recordingHookService struct {
+	services.HookService
+	confirmed []string
+}
+
+func (r *recordingHookService) OnTransactionConfirmed(txType models.TransactionType, txHash string, contractAddress *string, session models.TransactionSession) error {
+	r.confirmed = append(r.confirmed, txHash)
+	return nil
+}
+
+func TestExportAndSubmitOfflineTransactions(t *testing.T) {
+	node := newOfflineNode(t)
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: node.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	txService := services.NewTransactionService(db)
+	owner := "user-1"
+	createSession := func(deployments ...models.TransactionDeployment) string {
+		sessionID, err := txService.CreateTransactionSessionWithUser(services.CreateTransactionSessionRequest{
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                chain.ID,
+			TransactionDeployments: deployments,
+		}, &owner)
+		require.NoError(t, err)
+		return sessionID
+	}
+
+	hooks := &recordingHookService{}
+	submitTool := NewSubmitSignedTransactionTool(txService)
+	submitTool.SetHookService(hooks)
+	exportHandler := NewExportUnsignedTransactionsTool(txService).GetHandler()
+	submitHandler := submitTool.GetHandler()
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+	call := func(ctx context.Context, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	sessionID := createSession(
+		models.TransactionDeployment{Title: "Deploy Token", Data: "0x6080", Value: "0", TransactionType: models.TransactionTypeTokenDeployment},
+		models.TransactionDeployment{Title: "Fund", Receiver: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512", Value: "1000", TransactionType: models.TransactionTypeRegular},
+	)
+	result := call(ctx, exportHandler, map[string]any{"session_id": sessionID, "from": from.Hex()})
+	require.False(t, result.IsError, result.Content)
+
+	var file utils.UnsignedTransactionFile
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &file))
+	assert.Equal(t, utils.UnsignedTransactionFormat, file.Format)
+	assert.Equal(t, "31337", file.ChainID)
+	assert.Equal(t, from.Hex(), file.From)
+	require.Len(t, file.Transactions, 2)
+	assert.Nil(t, file.Transactions[0].To)
+	assert.Equal(t, "0x0", file.Transactions[0].Nonce)
+	assert.Equal(t, "0x1", file.Transactions[1].Nonce)
+	assert.Equal(t, "0x3b9aca00", file.Transactions[1].MaxPriorityFeePerGas)
+	// twice the base fee plus the priority fee
+	assert.Equal(t, "0x12a05f200", file.Transactions[1].MaxFeePerGas)
+	assert.Equal(t, "0x6270", file.Transactions[1].Gas)
+
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, session.OfflineExport)
+	assert.Equal(t, file.Transactions[1].SigningHash, session.OfflineExport.Transactions[1].SigningHash)
+
+	// sign the exported transactions like an offline signer does, from the JSON-RPC fields of the file
+	sign := func(key *ecdsa.PrivateKey, unsigned utils.UnsignedTransaction) string {
+		tx := &types.DynamicFeeTx{
+			ChainID:   hexutil.MustDecodeBig(unsigned.ChainID),
+			Nonce:     hexutil.MustDecodeUint64(unsigned.Nonce),
+			GasTipCap: hexutil.MustDecodeBig(unsigned.MaxPriorityFeePerGas),
+			GasFeeCap: hexutil.MustDecodeBig(unsigned.MaxFeePerGas),
+			Gas:       hexutil.MustDecodeUint64(unsigned.Gas),
+			Value:     hexutil.MustDecodeBig(unsigned.Value),
+			Data:      hexutil.MustDecode(unsigned.Data),
+		}
+		if unsigned.To != nil {
+			to := common.HexToAddress(*unsigned.To)
+			tx.To = &to
+		}
+		signedTx, err := types.SignNewTx(key, types.LatestSignerForChainID(tx.ChainID), tx)
+		require.NoError(t, err)
+		raw, err := signedTx.MarshalBinary()
+		require.NoError(t, err)
+		return hexutil.Encode(raw)
+	}
+
+	// only the exported from address can sign
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	result = call(ctx, submitHandler, map[string]any{"session_id": sessionID, "signed_transaction": sign(otherKey, file.Transactions[0])})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "the transactions were exported for "+from.Hex())
+
+	// a transaction modified before signing does not match the export
+	modified := file.Transactions[1]
+	modified.Value = "0x1"
+	result = call(ctx, submitHandler, map[string]any{"session_id": sessionID, "signed_transaction": sign(key, modified)})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "does not match any exported transaction")
+	assert.Empty(t, node.transactions)
+
+	result = call(ctx, submitHandler, map[string]any{"session_id": sessionID, "signed_transaction": sign(key, file.Transactions[0])})
+	require.False(t, result.IsError, result.Content)
+	var submitted SubmittedTransactionResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &submitted))
+	assert.Equal(t, models.TransactionStatusConfirmed, submitted.Status)
+	assert.Equal(t, crypto.CreateAddress(from, 0).Hex(), submitted.ContractAddress)
+	assert.Equal(t, models.TransactionStatusPending, submitted.SessionStatus)
+
+	result = call(ctx, submitHandler, map[string]any{"session_id": sessionID, "signed_transaction": sign(key, file.Transactions[1])})
+	require.False(t, result.IsError, result.Content)
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &submitted))
+	assert.Equal(t, models.TransactionStatusConfirmed, submitted.SessionStatus)
+	require.Len(t, node.transactions, 2)
+	assert.Equal(t, []string{node.transactions[0].Hash().Hex(), node.transactions[1].Hash().Hex()}, hooks.confirmed)
+
+	session, err = txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusConfirmed, session.TransactionStatus)
+	assert.Equal(t, node.transactions[1].Hash().Hex(), session.OfflineExport.Transactions[1].TransactionHash)
+}
+
+func TestExportUnsignedTransactionsRejectsSessions(t *testing.T) {
+	node := newOfflineNode(t)
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Local", RPC: node.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	txService := services.NewTransactionService(db)
+	owner := "user-1"
+	handler := NewExportUnsignedTransactionsTool(txService).GetHandler()
+	from := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	export := func(ctx context.Context, deployment models.TransactionDeployment) string {
+		sessionID, err := txService.CreateTransactionSessionWithUser(services.CreateTransactionSessionRequest{
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                chain.ID,
+			TransactionDeployments: []models.TransactionDeployment{deployment},
+		}, &owner)
+		require.NoError(t, err)
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"session_id": sessionID, "from": from}}})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+	fund := models.TransactionDeployment{Title: "Fund", Receiver: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512", Value: "1000", TransactionType: models.TransactionTypeRegular}
+
+	gasless := fund
+	gasless.UserOperation = true
+	assert.Contains(t, export(ctx, gasless), "is gasless, sign it in the browser")
+
+	private := fund
+	private.PrivateRelay = true
+	assert.Contains(t, export(ctx, private), "is submitted through the private relay")
+
+	otherUser := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	assert.Equal(t, "Session not found", export(otherUser, fund))
+}
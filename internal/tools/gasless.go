@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// gaslessMetadataKey is the session metadata shown in the signing page when the transactions are sent as user operations
+const gaslessMetadataKey = "gasless"
+
+// applyGasless flags every transaction of the session to be sent as an ERC-4337 user operation of the smart account of
+// the wallet through the bundler of the chain. Approvals are flagged too, the smart account is the one holding and
+// spending the tokens.
+func applyGasless(chain *models.Chain, transactions []models.TransactionDeployment) error {
+	if chain.ChainType != models.TransactionChainTypeEthereum {
+		return fmt.Errorf("gasless transactions are only supported on ethereum chains")
+	}
+	if chain.BundlerRPC == "" {
+		return fmt.Errorf("gasless transactions are not configured for chain %s. Please use set_chain with bundler_rpc first", chain.Name)
+	}
+
+	for i := range transactions {
+		if transactions[i].PrivateRelay {
+			return fmt.Errorf("gasless transactions cannot be combined with mev_protection, user operations are sent through the bundler")
+		}
+		transactions[i].UserOperation = true
+	}
+	return nil
+}
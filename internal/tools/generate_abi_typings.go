@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type generateAbiTypingsTool struct {
+	deploymentService services.DeploymentService
+	serverPort        int
+}
+
+type GenerateAbiTypingsArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+}
+
+type GenerateAbiTypingsResult struct {
+	DeploymentID    uint   `json:"deployment_id"`
+	ContractAddress string `json:"contract_address"`
+	Filename        string `json:"filename"`
+	DownloadURL     string `json:"download_url"`
+	Code            string `json:"code"`
+}
+
+func NewGenerateAbiTypingsTool(deploymentService services.DeploymentService, serverPort int) *generateAbiTypingsTool {
+	return &generateAbiTypingsTool{
+		deploymentService: deploymentService,
+		serverPort:        serverPort,
+	}
+}
+
+func (g *generateAbiTypingsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("generate_abi_typings",
+		mcp.WithDescription("Generate abitype/viem compatible TypeScript type definitions for a confirmed deployment's ABI. Returns the generated file content and a download URL for the .ts file."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed deployment to generate typings for"),
+		),
+	)
+
+	return tool
+}
+
+func (g *generateAbiTypingsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GenerateAbiTypingsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(g.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		// the download url is signed, it is only issued to the owner of the deployment
+		if user, _ := utils.GetAuthenticatedUser(ctx); user != nil && deployment.UserID != nil && *deployment.UserID != user.Sub {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment not found: %s", args.DeploymentID)), nil
+		}
+
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("TypeScript typings are only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+
+		typings, err := utils.GenerateAbiTypeScript(utils.AbiTypeScriptArgs{
+			ContractName:    deployment.Template.Name,
+			ContractAddress: deployment.ContractAddress,
+			Abi:             abiString,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to generate typings: %v", err)), nil
+		}
+
+		downloadURL, err := utils.GetDeploymentTypingsUrl(ctx, g.serverPort, deployment.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get download url: %v", err)), nil
+		}
+
+		result := GenerateAbiTypingsResult{
+			DeploymentID:    deployment.ID,
+			ContractAddress: deployment.ContractAddress,
+			Filename:        typings.Filename,
+			DownloadURL:     downloadURL,
+			Code:            typings.Code,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Generated TypeScript typings for deployment %s. Download %s at: %s", args.DeploymentID, typings.Filename, downloadURL)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
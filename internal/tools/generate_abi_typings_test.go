@@ -0,0 +1,155 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerateAbiTypingsToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	tool              *generateAbiTypingsTool
+	chain             *models.Chain
+	template          *models.Template
+	deploymentService services.DeploymentService
+	templateService   services.TemplateService
+	chainService      services.ChainService
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.templateService = services.NewTemplateService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.tool = NewGenerateAbiTypingsTool(suite.deploymentService, 8080)
+
+	suite.setupTestData()
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) setupTestData() {
+	chain := &models.Chain{
+		Name:      "Test Ethereum",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{
+		Name:        "My Token",
+		Description: "Test ERC20 contract",
+		ChainType:   models.TransactionChainTypeEthereum,
+		Abi: models.JSON{
+			"abi": []interface{}{
+				map[string]interface{}{
+					"inputs": []interface{}{
+						map[string]interface{}{"name": "account", "type": "address"},
+					},
+					"name":            "balanceOf",
+					"outputs":         []interface{}{map[string]interface{}{"name": "", "type": "uint256"}},
+					"stateMutability": "view",
+					"type":            "function",
+				},
+			},
+		},
+	}
+	suite.Require().NoError(suite.templateService.CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) createDeployment(status models.TransactionStatus, contractAddress string) *models.Deployment {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          status,
+		ContractAddress: contractAddress,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) TestGetTool() {
+	tool := suite.tool.GetTool()
+
+	suite.Equal("generate_abi_typings", tool.Name)
+	suite.NotEmpty(tool.Description)
+	suite.Contains(tool.InputSchema.Properties, "deployment_id")
+	suite.Contains(tool.InputSchema.Required, "deployment_id")
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) TestHandlerSuccess() {
+	suite.T().Setenv("BASE_URL", "")
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, "0x1234567890123456789012345678901234567890")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+	})
+	suite.False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var typingsResult GenerateAbiTypingsResult
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &typingsResult))
+	suite.Equal(deployment.ID, typingsResult.DeploymentID)
+	suite.Equal("myToken.abi.ts", typingsResult.Filename)
+	suite.Equal(fmt.Sprintf("http://localhost:8080/api/deployments/%d/typings", deployment.ID), typingsResult.DownloadURL)
+	suite.Contains(typingsResult.Code, deployment.ContractAddress)
+	suite.Contains(typingsResult.Code, "export type MyTokenBalanceOfReturn = bigint;")
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) TestHandlerPendingDeployment() {
+	deployment := suite.createDeployment(models.TransactionStatusPending, "")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+	})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "not confirmed")
+	}
+}
+
+func (suite *GenerateAbiTypingsToolTestSuite) TestHandlerMissingRequiredFields() {
+	result := suite.callHandler(map[string]interface{}{})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "Invalid arguments")
+	}
+}
+
+func TestGenerateAbiTypingsToolTestSuite(t *testing.T) {
+	suite.Run(t, new(GenerateAbiTypingsToolTestSuite))
+}
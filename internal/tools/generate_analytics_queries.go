@@ -0,0 +1,110 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type generateAnalyticsQueriesTool struct {
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+}
+
+type GenerateAnalyticsQueriesArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	DuneChain string `json:"dune_chain,omitempty"`
+}
+
+type GenerateAnalyticsQueriesResult struct {
+	DeploymentID    uint                   `json:"deployment_id"`
+	ContractAddress string                 `json:"contract_address"`
+	PairAddress     string                 `json:"pair_address,omitempty"`
+	Queries         []utils.AnalyticsQuery `json:"queries"`
+}
+
+func NewGenerateAnalyticsQueriesTool(deploymentService services.DeploymentService, liquidityService services.LiquidityService) *generateAnalyticsQueriesTool {
+	return &generateAnalyticsQueriesTool{
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+	}
+}
+
+func (g *generateAnalyticsQueriesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("generate_analytics_queries",
+		mcp.WithDescription("Generate starter DuneSQL queries for a launched token and its liquidity pool: transfers, holder balances, daily activity, pool swaps and reserve history. The queries reference the deployed contract and pair addresses."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment"),
+		),
+		mcp.WithString("dune_chain",
+			mcp.Description("Dune chain name used in table names (e.g. ethereum, base, arbitrum). Optional, derived from the chain ID when omitted"),
+		),
+	)
+
+	return tool
+}
+
+func (g *generateAnalyticsQueriesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GenerateAnalyticsQueriesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, err := getConfirmedDeployment(g.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Analytics queries are only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+
+		// Include pool queries when the token has a confirmed liquidity pool
+		var pairAddress string
+		pool, err := g.liquidityService.GetLiquidityPoolByTokenAddress(deployment.ContractAddress, deployment.ContractAddress)
+		if err == nil && pool.Status == models.TransactionStatusConfirmed {
+			pairAddress = pool.PairAddress
+		}
+
+		queries, err := utils.GenerateAnalyticsQueries(utils.AnalyticsQueryArgs{
+			ChainID:         deployment.Chain.NetworkID,
+			ContractAddress: deployment.ContractAddress,
+			PairAddress:     pairAddress,
+			DuneChain:       args.DuneChain,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to generate queries: %v", err)), nil
+		}
+
+		result := GenerateAnalyticsQueriesResult{
+			DeploymentID:    deployment.ID,
+			ContractAddress: deployment.ContractAddress,
+			PairAddress:     pairAddress,
+			Queries:         queries,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Generated %d DuneSQL queries for deployment %s: ", len(queries), args.DeploymentID)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
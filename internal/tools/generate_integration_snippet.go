@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type generateIntegrationSnippetTool struct {
+	deploymentService services.DeploymentService
+}
+
+type GenerateIntegrationSnippetArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	Languages []string `json:"languages,omitempty"`
+}
+
+type GenerateIntegrationSnippetResult struct {
+	DeploymentID    uint                       `json:"deployment_id"`
+	ContractAddress string                     `json:"contract_address"`
+	ChainID         string                     `json:"chain_id"`
+	Snippets        []utils.IntegrationSnippet `json:"snippets"`
+}
+
+func NewGenerateIntegrationSnippetTool(deploymentService services.DeploymentService) *generateIntegrationSnippetTool {
+	return &generateIntegrationSnippetTool{
+		deploymentService: deploymentService,
+	}
+}
+
+func (g *generateIntegrationSnippetTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("generate_integration_snippet",
+		mcp.WithDescription("Generate ready-to-paste code snippets (ethers.js, viem, wagmi hooks, web3.py) for a confirmed deployment with the contract address and ABI inlined and the RPC URL read from the RPC_URL environment variable, so frontend teams can integrate the launched contract immediately."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed deployment to generate snippets for"),
+		),
+		mcp.WithArray("languages",
+			mcp.Description(fmt.Sprintf("Snippet languages to generate. Optional, defaults to all supported languages: %v", utils.SupportedSnippetLanguages)),
+			mcp.Items(map[string]any{
+				"type": "string",
+				"enum": utils.SupportedSnippetLanguages,
+			}),
+		),
+	)
+
+	return tool
+}
+
+func (g *generateIntegrationSnippetTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GenerateIntegrationSnippetArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		// Resolve requested languages, default to every supported language
+		languages := utils.SupportedSnippetLanguages
+		if len(args.Languages) > 0 {
+			languages = make([]utils.SnippetLanguage, 0, len(args.Languages))
+			for _, language := range args.Languages {
+				parsedLanguage, err := utils.ParseSnippetLanguage(language)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				languages = append(languages, parsedLanguage)
+			}
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(g.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Integration snippets are only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+
+		snippetArgs := utils.IntegrationSnippetArgs{
+			ContractName:    deployment.Template.Name,
+			ContractAddress: deployment.ContractAddress,
+			Abi:             abiString,
+			ChainID:         deployment.Chain.NetworkID,
+		}
+
+		result := GenerateIntegrationSnippetResult{
+			DeploymentID:    deployment.ID,
+			ContractAddress: deployment.ContractAddress,
+			ChainID:         deployment.Chain.NetworkID,
+		}
+		for _, language := range languages {
+			snippet, err := utils.GenerateIntegrationSnippet(language, snippetArgs)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to generate %s snippet: %v", language, err)), nil
+			}
+			result.Snippets = append(result.Snippets, snippet)
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Generated %d integration snippets for deployment %s: ", len(result.Snippets), args.DeploymentID)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,208 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerateIntegrationSnippetToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	tool              *generateIntegrationSnippetTool
+	chain             *models.Chain
+	template          *models.Template
+	deploymentService services.DeploymentService
+	templateService   services.TemplateService
+	chainService      services.ChainService
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.templateService = services.NewTemplateService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.tool = NewGenerateIntegrationSnippetTool(suite.deploymentService)
+
+	suite.setupTestData()
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) setupTestData() {
+	chain := &models.Chain{
+		Name:      "Test Ethereum",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{
+		Name:        "My Token",
+		Description: "Test ERC20 contract",
+		ChainType:   models.TransactionChainTypeEthereum,
+		Abi: models.JSON{
+			"abi": []interface{}{
+				map[string]interface{}{
+					"inputs": []interface{}{
+						map[string]interface{}{"name": "account", "type": "address"},
+					},
+					"name":            "balanceOf",
+					"outputs":         []interface{}{map[string]interface{}{"name": "", "type": "uint256"}},
+					"stateMutability": "view",
+					"type":            "function",
+				},
+			},
+		},
+	}
+	suite.Require().NoError(suite.templateService.CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) createDeployment(status models.TransactionStatus, contractAddress string) *models.Deployment {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          status,
+		ContractAddress: contractAddress,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestGetTool() {
+	tool := suite.tool.GetTool()
+
+	suite.Equal("generate_integration_snippet", tool.Name)
+	suite.NotEmpty(tool.Description)
+	suite.Contains(tool.InputSchema.Properties, "deployment_id")
+	suite.Contains(tool.InputSchema.Properties, "languages")
+	suite.Contains(tool.InputSchema.Required, "deployment_id")
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestHandlerAllLanguages() {
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, "0x1234567890123456789012345678901234567890")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+	})
+	suite.False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var snippetResult GenerateIntegrationSnippetResult
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &snippetResult))
+	suite.Equal(deployment.ContractAddress, snippetResult.ContractAddress)
+	suite.Equal("31337", snippetResult.ChainID)
+	suite.Require().Len(snippetResult.Snippets, len(utils.SupportedSnippetLanguages))
+
+	for _, snippet := range snippetResult.Snippets {
+		suite.Contains(snippet.Code, deployment.ContractAddress)
+		suite.Contains(snippet.Code, "balanceOf")
+		// The RPC of the chain may carry a provider API key, it is never inlined
+		suite.NotContains(snippet.Code, "localhost:8545")
+	}
+	suite.Contains(snippetResult.Snippets[0].Code, "process.env.RPC_URL")
+	suite.Contains(snippetResult.Snippets[3].Code, `os.environ["RPC_URL"]`)
+	suite.Equal("myToken.ethers.ts", snippetResult.Snippets[0].Filename)
+	suite.Equal("useMyToken.ts", snippetResult.Snippets[2].Filename)
+	suite.Equal("my_token.py", snippetResult.Snippets[3].Filename)
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestHandlerSelectedLanguages() {
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, "0x1234567890123456789012345678901234567890")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"languages":     []interface{}{"viem"},
+	})
+	suite.False(result.IsError)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var snippetResult GenerateIntegrationSnippetResult
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &snippetResult))
+	suite.Require().Len(snippetResult.Snippets, 1)
+	suite.Equal(utils.SnippetLanguageViem, snippetResult.Snippets[0].Language)
+	suite.Contains(snippetResult.Snippets[0].Code, "as const")
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestHandlerUnsupportedLanguage() {
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, "0x1234567890123456789012345678901234567890")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"languages":     []interface{}{"cobol"},
+	})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "unsupported snippet language")
+	}
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestHandlerPendingDeployment() {
+	deployment := suite.createDeployment(models.TransactionStatusPending, "")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+	})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "not confirmed")
+	}
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestHandlerDeploymentNotFound() {
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": "99999",
+	})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "Deployment not found")
+	}
+}
+
+func (suite *GenerateIntegrationSnippetToolTestSuite) TestHandlerMissingRequiredFields() {
+	result := suite.callHandler(map[string]interface{}{})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "Invalid arguments")
+	}
+}
+
+func TestGenerateIntegrationSnippetToolTestSuite(t *testing.T) {
+	suite.Run(t, new(GenerateIntegrationSnippetToolTestSuite))
+}
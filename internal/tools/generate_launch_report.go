@@ -0,0 +1,252 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// launchReportVolumeWindow is the period after the pool creation whose swaps are summed
+	launchReportVolumeWindow  = 24 * time.Hour
+	launchReportTargetCandles = 48
+	launchReportHolderPoints  = 24
+
+	launchReportSourceIndexer   = "indexer"
+	launchReportSourceSnapshots = "pool snapshots"
+	launchReportSourceRPC       = "rpc"
+)
+
+type generateLaunchReportTool struct {
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	uniswapService    services.UniswapService
+	indexerService    services.IndexerService
+	serverPort        int
+}
+
+type GenerateLaunchReportArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	Format string `json:"format,omitempty" validate:"omitempty,oneof=markdown html"`
+}
+
+func NewGenerateLaunchReportTool(deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService, indexerService services.IndexerService, serverPort int) *generateLaunchReportTool {
+	return &generateLaunchReportTool{
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		uniswapService:    uniswapService,
+		indexerService:    indexerService,
+		serverPort:        serverPort,
+	}
+}
+
+func (g *generateLaunchReportTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("generate_launch_report",
+		mcp.WithDescription("Generate a markdown or HTML report of a token launch ready to be posted: deployment details, pool funding, swap volume of the first 24 hours after the pool creation, holder growth replayed from the Transfer events and a price chart. "+
+			"Amounts are in the base units of their token. Volume and holders are read from the event indexer once it has indexed the pool and token, before that the volume is computed from the reserve changes of the swaps recorded by the launchpad and the holders from the Transfer logs."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format of the report. Optional, defaults to markdown"),
+			mcp.Enum(string(utils.LaunchReportFormatMarkdown), string(utils.LaunchReportFormatHTML)),
+		),
+	)
+
+	return tool
+}
+
+func (g *generateLaunchReportTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GenerateLaunchReportArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, err := getConfirmedDeployment(g.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Launch reports are only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+
+		report := utils.LaunchReport{
+			Deployment: utils.LaunchReportDeployment{
+				ID:              deployment.ID,
+				TemplateName:    deployment.Template.Name,
+				ContractAddress: deployment.ContractAddress,
+				ChainName:       deployment.Chain.Name,
+				ChainID:         deployment.Chain.NetworkID,
+				DeployerAddress: deployment.DeployerAddress,
+				TransactionHash: deployment.TransactionHash,
+				GasCost:         deployment.GasCost,
+				DeployedAt:      deployment.CreatedAt,
+			},
+			GeneratedAt: time.Now().UTC(),
+		}
+		if deployment.GasUsed > 0 {
+			report.Deployment.GasUsed = strconv.FormatUint(deployment.GasUsed, 10)
+		}
+
+		pool, err := g.liquidityService.GetLiquidityPoolByTokenAddress(deployment.ContractAddress, deployment.ContractAddress)
+		if err == nil && pool.Status == models.TransactionStatusConfirmed {
+			if err := g.addPool(ctx, &report, deployment, pool); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		if err := g.addHolders(&report, deployment); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		rendered, err := utils.RenderLaunchReport(report, utils.LaunchReportFormat(args.Format))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to render launch report: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Generated the launch report of deployment %s: ", args.DeploymentID)),
+				mcp.NewTextContent(rendered),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// addPool adds the funding, the first day volume and the price chart of the launch pool
+func (g *generateLaunchReportTool) addPool(ctx context.Context, report *utils.LaunchReport, deployment *models.Deployment, pool *models.LiquidityPool) error {
+	// The pool stores its tokens in the order they were given, the snapshots in the order of the pair
+	tokenInitial, quoteToken, quoteInitial := pool.InitialToken1, pool.Token0, pool.InitialToken0
+	if !strings.EqualFold(pool.Token1, deployment.ContractAddress) {
+		tokenInitial, quoteToken, quoteInitial = pool.InitialToken0, pool.Token1, pool.InitialToken1
+	}
+
+	quoteLabel := quoteToken
+	quotePairToken := quoteToken
+	if strings.EqualFold(quoteToken, services.EthTokenAddress) {
+		uniswapDeployment, err := g.uniswapService.GetUniswapDeploymentByChain(deployment.ChainID)
+		if err != nil {
+			return fmt.Errorf("Failed to get uniswap deployment: %v", err)
+		}
+		quoteLabel = "ETH"
+		quotePairToken = uniswapDeployment.WETHAddress
+	}
+
+	poolURL, err := utils.GetPoolPageUrl(ctx, g.serverPort, pool.ID)
+	if err != nil {
+		return fmt.Errorf("Failed to get pool page url: %v", err)
+	}
+	report.Pool = &utils.LaunchReportPool{
+		ID:              pool.ID,
+		PairAddress:     pool.PairAddress,
+		QuoteToken:      quoteLabel,
+		TokenAmount:     tokenInitial,
+		QuoteAmount:     quoteInitial,
+		CreatorAddress:  pool.CreatorAddress,
+		TransactionHash: pool.TransactionHash,
+		CreatedAt:       pool.CreatedAt,
+		PageURL:         poolURL,
+	}
+
+	// A negative limit returns every snapshot
+	snapshots, err := g.liquidityService.ListPoolSnapshots(pool.ID, -1)
+	if err != nil {
+		return fmt.Errorf("Failed to list pool snapshots: %v", err)
+	}
+
+	initial := utils.LaunchPoolState{
+		Time:            pool.CreatedAt,
+		TransactionType: models.TransactionTypeLiquidityPoolCreation,
+		TokenReserve:    parseReportAmount(tokenInitial),
+		QuoteReserve:    parseReportAmount(quoteInitial),
+	}
+	states := make([]utils.LaunchPoolState, 0, len(snapshots))
+	points := make([]utils.PricePoint, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		tokenReserve, quoteReserve := utils.SortPairReserves(deployment.ContractAddress, quotePairToken, parseReportAmount(snapshot.Reserve0), parseReportAmount(snapshot.Reserve1))
+		states = append(states, utils.LaunchPoolState{
+			Time:            snapshot.CreatedAt,
+			TransactionType: snapshot.TransactionType,
+			TokenReserve:    tokenReserve,
+			QuoteReserve:    quoteReserve,
+		})
+		if tokenReserve.Sign() > 0 {
+			price, _ := new(big.Float).Quo(new(big.Float).SetInt(quoteReserve), new(big.Float).SetInt(tokenReserve)).Float64()
+			points = append(points, utils.PricePoint{Time: snapshot.CreatedAt, Price: price})
+		}
+	}
+
+	volume := utils.BuildLaunchVolume(initial, states, pool.CreatedAt.Add(launchReportVolumeWindow))
+	report.Volume, report.VolumeSource = &volume, launchReportSourceSnapshots
+	// The indexed swaps also count the trades made outside of the launchpad
+	if _, indexed, err := g.indexerService.GetLastSyncedBlock(deployment.ChainID, pool.PairAddress); err == nil && indexed {
+		swaps, err := g.indexerService.ListEvents(deployment.ChainID, pool.PairAddress, models.IndexedEventTypeSwap)
+		if err != nil {
+			return fmt.Errorf("Failed to list indexed swaps: %v", err)
+		}
+		tokenIsToken0 := strings.ToLower(deployment.ContractAddress) < strings.ToLower(quotePairToken)
+		if indexedVolume, ok := utils.BuildIndexedLaunchVolume(swaps, tokenIsToken0, pool.CreatedAt, pool.CreatedAt.Add(launchReportVolumeWindow)); ok {
+			report.Volume, report.VolumeSource = &indexedVolume, launchReportSourceIndexer
+		}
+	}
+	if len(points) > 0 {
+		interval := utils.CandleIntervalFor(points[0].Time, points[len(points)-1].Time, launchReportTargetCandles)
+		report.Candles = utils.BuildCandles(points, interval)
+	}
+	return nil
+}
+
+// addHolders adds the holder growth from the indexed transfers, or from the transfer logs when the token is not indexed yet
+func (g *generateLaunchReportTool) addHolders(report *utils.LaunchReport, deployment *models.Deployment) error {
+	if _, indexed, err := g.indexerService.GetLastSyncedBlock(deployment.ChainID, deployment.ContractAddress); err == nil && indexed {
+		events, err := g.indexerService.ListEvents(deployment.ChainID, deployment.ContractAddress, models.IndexedEventTypeTransfer)
+		if err != nil {
+			return fmt.Errorf("Failed to list indexed transfers: %v", err)
+		}
+		report.Holders = utils.HolderGrowth(utils.IndexedTransfers(events), launchReportHolderPoints)
+		report.HoldersSource = launchReportSourceIndexer
+		return nil
+	}
+
+	// The report is still useful without holders when the node does not serve eth_getLogs
+	transfers, err := utils.GetTokenTransfers(deployment.Chain.RPC, deployment.ContractAddress)
+	if err != nil {
+		report.HoldersError = err.Error()
+		return nil
+	}
+	report.Holders = utils.HolderGrowth(transfers, launchReportHolderPoints)
+	report.HoldersSource = launchReportSourceRPC
+	return nil
+}
+
+// parseReportAmount parses a stored base unit amount, amounts that are not integers count as zero
+func parseReportAmount(amount string) *big.Int {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
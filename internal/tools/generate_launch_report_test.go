@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	reportToken = "0x1111111111111111111111111111111111111111"
+	reportWETH  = "0x2222222222222222222222222222222222222222"
+	reportPair  = "0x3333333333333333333333333333333333333333"
+)
+
+// reportRPC serves the Transfer logs of the token: a mint to the deployer and a transfer of part of it to a buyer
+func reportRPC(t *testing.T) *httptest.Server {
+	topic := func(address string) string {
+		return "0x000000000000000000000000" + address[2:]
+	}
+	logs := []map[string]any{
+		{
+			"topics":         []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", topic(services.EthTokenAddress), topic("0x4444444444444444444444444444444444444444")},
+			"data":           "0x00000000000000000000000000000000000000000000000000000000000003e8",
+			"blockNumber":    "0x1",
+			"blockTimestamp": "0x" + strconv.FormatInt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), 16),
+		},
+		{
+			"topics":      []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef", topic("0x4444444444444444444444444444444444444444"), topic("0x5555555555555555555555555555555555555555")},
+			"data":        "0x000000000000000000000000000000000000000000000000000000000000005a",
+			"blockNumber": "0x2",
+		},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var result any = "0x0"
+		if request.Method == "eth_getLogs" {
+			result = logs
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+}
+
+func TestGenerateLaunchReport(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	rpc := reportRPC(t)
+	t.Cleanup(rpc.Close)
+
+	chain := &models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, services.NewChainService(db.GetDB()).CreateChain(chain))
+	template := &models.Template{Name: "Moon Token", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	require.NoError(t, db.GetDB().Create(&models.UniswapDeployment{ChainID: chain.ID, Version: "v2", Status: models.TransactionStatusConfirmed, WETHAddress: reportWETH}).Error)
+
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	deployment := &models.Deployment{
+		ChainID:         chain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: reportToken,
+		DeployerAddress: "0x4444444444444444444444444444444444444444",
+		GasUsed:         21000,
+		GasCost:         "42000",
+	}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	unpooled := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, Status: models.TransactionStatusConfirmed, ContractAddress: "0x6666666666666666666666666666666666666666"}
+	require.NoError(t, deploymentService.CreateDeployment(unpooled))
+
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	poolCreated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	pool := &models.LiquidityPool{
+		TokenAddress:   reportToken,
+		PairAddress:    reportPair,
+		Token0:         services.EthTokenAddress,
+		Token1:         reportToken,
+		InitialToken0:  "100",
+		InitialToken1:  "1000",
+		CreatorAddress: "0x4444444444444444444444444444444444444444",
+		Status:         models.TransactionStatusConfirmed,
+		CreatedAt:      poolCreated,
+	}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+	// The token sorts before WETH, reserve0 is the token
+	for _, snapshot := range []models.PoolSnapshot{
+		{Reserve0: "1000", Reserve1: "100", TransactionType: models.TransactionTypeLiquidityPoolCreation, CreatedAt: poolCreated},
+		{Reserve0: "910", Reserve1: "110", TransactionType: models.TransactionTypeTokenSwap, CreatedAt: poolCreated.Add(time.Hour)},
+		{Reserve0: "930", Reserve1: "108", TransactionType: models.TransactionTypeTokenSwap, CreatedAt: poolCreated.Add(2 * time.Hour)},
+		// Outside of the first day
+		{Reserve0: "500", Reserve1: "200", TransactionType: models.TransactionTypeTokenSwap, CreatedAt: poolCreated.Add(30 * time.Hour)},
+	} {
+		snapshot.PoolID = pool.ID
+		require.NoError(t, liquidityService.CreatePoolSnapshot(&snapshot))
+	}
+
+	handler := NewGenerateLaunchReportTool(deploymentService, liquidityService, services.NewUniswapService(db.GetDB()), services.NewIndexerService(db.GetDB()), 8080).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("reports the pool, first day volume and holders", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": strconv.FormatUint(uint64(deployment.ID), 10)})
+		require.False(t, result.IsError)
+		require.Len(t, result.Content, 3)
+
+		var report utils.LaunchReport
+		require.NoError(t, json.Unmarshal([]byte(result.Content[2].(mcp.TextContent).Text), &report))
+		require.NotNil(t, report.Pool)
+		assert.Equal(t, "ETH", report.Pool.QuoteToken)
+		assert.Equal(t, "1000", report.Pool.TokenAmount)
+		assert.Equal(t, "100", report.Pool.QuoteAmount)
+		assert.Equal(t, &utils.LaunchVolume{Swaps: 2, Buys: 1, Sells: 1, QuoteVolume: "12", TokensBought: "90", TokensSold: "20"}, report.Volume)
+		assert.NotEmpty(t, report.Candles)
+		require.Len(t, report.Holders, 2)
+		assert.Equal(t, 1, report.Holders[0].Holders)
+		assert.Equal(t, 2, report.Holders[1].Holders)
+		assert.Equal(t, "21000", report.Deployment.GasUsed)
+
+		markdown := result.Content[1].(mcp.TextContent).Text
+		assert.Contains(t, markdown, "# Launch report: Moon Token")
+		assert.Contains(t, markdown, "| ETH volume | 12 |")
+	})
+
+	t.Run("reads volume and holders from the indexer", func(t *testing.T) {
+		indexerService := services.NewIndexerService(db.GetDB())
+		swapTime := poolCreated.Add(time.Hour)
+		// The token is token0 of the pair, a buy of 50 tokens for 5 ETH
+		require.NoError(t, indexerService.ApplyEvents(chain.ID, reportPair, []models.IndexedEvent{
+			{EventType: models.IndexedEventTypeSwap, BlockNumber: 3, BlockTime: &swapTime, TransactionHash: "0xswap", Amount0In: "0", Amount1In: "5", Amount0Out: "50", Amount1Out: "0"},
+		}, 3))
+		require.NoError(t, indexerService.ApplyEvents(chain.ID, reportToken, []models.IndexedEvent{
+			{EventType: models.IndexedEventTypeTransfer, BlockNumber: 1, BlockTime: &poolCreated, TransactionHash: "0xmint", Sender: services.EthTokenAddress, Recipient: "0x4444444444444444444444444444444444444444", Value: "1000"},
+		}, 3))
+
+		result := call(map[string]any{"deployment_id": strconv.FormatUint(uint64(deployment.ID), 10)})
+		require.False(t, result.IsError)
+		var report utils.LaunchReport
+		require.NoError(t, json.Unmarshal([]byte(result.Content[2].(mcp.TextContent).Text), &report))
+		assert.Equal(t, "indexer", report.VolumeSource)
+		assert.Equal(t, "indexer", report.HoldersSource)
+		assert.Equal(t, &utils.LaunchVolume{Swaps: 1, Buys: 1, QuoteVolume: "5", TokensBought: "50", TokensSold: "0"}, report.Volume)
+		require.Len(t, report.Holders, 1)
+		assert.Equal(t, 1, report.Holders[0].Holders)
+	})
+
+	t.Run("renders HTML", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": strconv.FormatUint(uint64(deployment.ID), 10), "format": "html"})
+		require.False(t, result.IsError)
+		assert.Contains(t, result.Content[1].(mcp.TextContent).Text, "<!DOCTYPE html>")
+	})
+
+	t.Run("reports tokens without a pool", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": strconv.FormatUint(uint64(unpooled.ID), 10)})
+		require.False(t, result.IsError)
+		assert.Contains(t, result.Content[1].(mcp.TextContent).Text, "The token has no confirmed liquidity pool.")
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": strconv.FormatUint(uint64(deployment.ID), 10), "format": "pdf"})
+		assert.True(t, result.IsError)
+	})
+}
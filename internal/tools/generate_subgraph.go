@@ -0,0 +1,161 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type generateSubgraphTool struct {
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+}
+
+type GenerateSubgraphArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	Network    string `json:"network,omitempty"`
+	StartBlock string `json:"start_block,omitempty"`
+}
+
+type GenerateSubgraphResult struct {
+	DeploymentID    uint                 `json:"deployment_id"`
+	ContractAddress string               `json:"contract_address"`
+	PairAddress     string               `json:"pair_address,omitempty"`
+	Network         string               `json:"network"`
+	StartBlock      uint64               `json:"start_block"`
+	Files           []utils.SubgraphFile `json:"files"`
+}
+
+func NewGenerateSubgraphTool(deploymentService services.DeploymentService, liquidityService services.LiquidityService) *generateSubgraphTool {
+	return &generateSubgraphTool{
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+	}
+}
+
+func (g *generateSubgraphTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("generate_subgraph",
+		mcp.WithDescription("Generate a ready-to-deploy Graph Protocol subgraph (subgraph.yaml manifest, schema.graphql, ABIs and AssemblyScript mappings) for a launched token and its liquidity pool. Indexes token Transfer events and pool Swap, Mint, Burn and Sync events."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment to index"),
+		),
+		mcp.WithString("network",
+			mcp.Description("Graph network name (e.g. mainnet, sepolia, base). Optional, derived from the chain ID when omitted"),
+		),
+		mcp.WithString("start_block",
+			mcp.Description("Block to start indexing from. Optional, defaults to the block of the deployment transaction"),
+		),
+	)
+
+	return tool
+}
+
+func (g *generateSubgraphTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GenerateSubgraphArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(g.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Subgraphs are only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+
+		startBlock, err := g.resolveStartBlock(args.StartBlock, deployment)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// Index the pool as well when the token has a confirmed liquidity pool
+		var pairAddress string
+		pool, err := g.liquidityService.GetLiquidityPoolByTokenAddress(deployment.ContractAddress, deployment.ContractAddress)
+		if err == nil && pool.Status == models.TransactionStatusConfirmed {
+			pairAddress = pool.PairAddress
+		}
+
+		subgraphArgs := utils.SubgraphArgs{
+			ContractName:    deployment.Template.Name,
+			ContractAddress: deployment.ContractAddress,
+			Abi:             abiString,
+			ChainID:         deployment.Chain.NetworkID,
+			Network:         args.Network,
+			StartBlock:      startBlock,
+			PairAddress:     pairAddress,
+		}
+
+		files, err := utils.GenerateSubgraph(subgraphArgs)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to generate subgraph: %v", err)), nil
+		}
+
+		network := args.Network
+		if network == "" {
+			network, _ = utils.GetGraphNetwork(deployment.Chain.NetworkID)
+		}
+
+		result := GenerateSubgraphResult{
+			DeploymentID:    deployment.ID,
+			ContractAddress: deployment.ContractAddress,
+			PairAddress:     pairAddress,
+			Network:         network,
+			StartBlock:      startBlock,
+			Files:           files,
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Generated subgraph with %d files for deployment %s. Write the files to a directory and run `npm install && npm run codegen && npm run build`: ", len(files), args.DeploymentID)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// resolveStartBlock parses the provided start block, falling back to the block of the deployment transaction.
+// Returns 0 when the deployment block cannot be determined.
+func (g *generateSubgraphTool) resolveStartBlock(startBlock string, deployment *models.Deployment) (uint64, error) {
+	if startBlock != "" {
+		block, err := strconv.ParseUint(startBlock, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("Invalid start_block format: %v", err)
+		}
+		return block, nil
+	}
+
+	if deployment.TransactionHash == "" || deployment.Chain.RPC == "" {
+		return 0, nil
+	}
+
+	receipt, err := utils.NewRPCClient(deployment.Chain.RPC).GetTransactionReceipt(deployment.TransactionHash)
+	if err != nil {
+		return 0, nil
+	}
+
+	block, err := strconv.ParseUint(receipt.BlockNumber, 0, 64)
+	if err != nil {
+		return 0, nil
+	}
+	return block, nil
+}
@@ -0,0 +1,194 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenerateSubgraphToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	tool              *generateSubgraphTool
+	chain             *models.Chain
+	template          *models.Template
+	deploymentService services.DeploymentService
+	templateService   services.TemplateService
+	chainService      services.ChainService
+	liquidityService  services.LiquidityService
+}
+
+func (suite *GenerateSubgraphToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.templateService = services.NewTemplateService(db.GetDB())
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.liquidityService = services.NewLiquidityService(db.GetDB())
+	suite.tool = NewGenerateSubgraphTool(suite.deploymentService, suite.liquidityService)
+
+	suite.setupTestData()
+}
+
+func (suite *GenerateSubgraphToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *GenerateSubgraphToolTestSuite) setupTestData() {
+	chain := &models.Chain{
+		Name:      "Sepolia",
+		RPC:       "http://localhost:8545",
+		NetworkID: "11155111",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{
+		Name:        "My Token",
+		Description: "Test ERC20 contract",
+		ChainType:   models.TransactionChainTypeEthereum,
+		Abi: models.JSON{
+			"abi": []interface{}{
+				map[string]interface{}{
+					"anonymous": false,
+					"inputs": []interface{}{
+						map[string]interface{}{"indexed": true, "name": "from", "type": "address"},
+						map[string]interface{}{"indexed": true, "name": "to", "type": "address"},
+						map[string]interface{}{"indexed": false, "name": "value", "type": "uint256"},
+					},
+					"name": "Transfer",
+					"type": "event",
+				},
+			},
+		},
+	}
+	suite.Require().NoError(suite.templateService.CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *GenerateSubgraphToolTestSuite) createDeployment(status models.TransactionStatus, contractAddress string) *models.Deployment {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          status,
+		ContractAddress: contractAddress,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *GenerateSubgraphToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *GenerateSubgraphToolTestSuite) parseResult(result *mcp.CallToolResult) GenerateSubgraphResult {
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var subgraphResult GenerateSubgraphResult
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &subgraphResult))
+	return subgraphResult
+}
+
+func (suite *GenerateSubgraphToolTestSuite) TestGetTool() {
+	tool := suite.tool.GetTool()
+
+	suite.Equal("generate_subgraph", tool.Name)
+	suite.NotEmpty(tool.Description)
+	suite.Contains(tool.InputSchema.Properties, "deployment_id")
+	suite.Contains(tool.InputSchema.Properties, "network")
+	suite.Contains(tool.InputSchema.Properties, "start_block")
+	suite.Contains(tool.InputSchema.Required, "deployment_id")
+}
+
+func (suite *GenerateSubgraphToolTestSuite) TestHandlerTokenOnly() {
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, "0x1234567890123456789012345678901234567890")
+
+	result := suite.parseResult(suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"start_block":   "100",
+	}))
+
+	suite.Equal("sepolia", result.Network)
+	suite.Equal(uint64(100), result.StartBlock)
+	suite.Empty(result.PairAddress)
+	suite.Len(result.Files, 5)
+}
+
+func (suite *GenerateSubgraphToolTestSuite) TestHandlerWithPool() {
+	tokenAddress := "0x9999999999999999999999999999999999999999"
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, tokenAddress)
+	_, err := suite.liquidityService.CreateLiquidityPool(&models.LiquidityPool{
+		TokenAddress:   tokenAddress,
+		PairAddress:    "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+		UniswapVersion: "v2",
+		Token0:         tokenAddress,
+		Token1:         services.EthTokenAddress,
+		InitialToken0:  "1000",
+		InitialToken1:  "1",
+		Status:         models.TransactionStatusConfirmed,
+	})
+	suite.Require().NoError(err)
+
+	result := suite.parseResult(suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"network":       "base-sepolia",
+	}))
+
+	suite.Equal("base-sepolia", result.Network)
+	suite.Equal("0xabcdefabcdefabcdefabcdefabcdefabcdefabcd", result.PairAddress)
+	suite.Len(result.Files, 7)
+}
+
+func (suite *GenerateSubgraphToolTestSuite) TestHandlerInvalidStartBlock() {
+	deployment := suite.createDeployment(models.TransactionStatusConfirmed, "0x1234567890123456789012345678901234567890")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"start_block":   "latest",
+	})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "Invalid start_block format")
+	}
+}
+
+func (suite *GenerateSubgraphToolTestSuite) TestHandlerPendingDeployment() {
+	deployment := suite.createDeployment(models.TransactionStatusPending, "")
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+	})
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "not confirmed")
+	}
+}
+
+func TestGenerateSubgraphToolTestSuite(t *testing.T) {
+	suite.Run(t, new(GenerateSubgraphToolTestSuite))
+}
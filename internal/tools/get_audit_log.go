@@ -0,0 +1,148 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	defaultAuditLogLimit = 50
+	maxAuditLogLimit     = 500
+)
+
+type getAuditLogTool struct {
+	auditService services.AuditService
+	retention    time.Duration
+}
+
+type GetAuditLogArguments struct {
+	Kind       string `json:"kind,omitempty" validate:"omitempty,oneof=tool_call mutation"`
+	ToolName   string `json:"tool_name,omitempty"`
+	Status     string `json:"status,omitempty" validate:"omitempty,oneof=success error"`
+	EntityType string `json:"entity_type,omitempty"`
+	EntityID   string `json:"entity_id,omitempty"`
+	Since      string `json:"since,omitempty"`
+	Limit      string `json:"limit,omitempty"`
+}
+
+// AuditLogReport is the result of get_audit_log
+type AuditLogReport struct {
+	// Retention is how long the entries are kept, "forever" when they are never pruned
+	Retention string            `json:"retention"`
+	Entries   []models.AuditLog `json:"entries"`
+}
+
+func NewGetAuditLogTool(auditService services.AuditService, retention time.Duration) *getAuditLogTool {
+	return &getAuditLogTool{
+		auditService: auditService,
+		retention:    retention,
+	}
+}
+
+func (g *getAuditLogTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_audit_log",
+		mcp.WithDescription("List the audit log of the tool calls (tool name, SHA-256 of the arguments, user and result status) and of the rows created, updated or deleted in the database, newest first. "+
+			"Authenticated users only see their own entries. Entries older than the retention (LAUNCHPAD_AUDIT_RETENTION) are pruned."),
+		mcp.WithString("kind",
+			mcp.Description("Only list tool calls or mutations. Optional, defaults to both"),
+			mcp.Enum(string(models.AuditLogKindToolCall), string(models.AuditLogKindMutation)),
+		),
+		mcp.WithString("tool_name",
+			mcp.Description("Only list the calls of this tool. Optional"),
+		),
+		mcp.WithString("status",
+			mcp.Description("Only list the tool calls with this result. Optional"),
+			mcp.Enum(string(models.AuditLogStatusSuccess), string(models.AuditLogStatusError)),
+		),
+		mcp.WithString("entity_type",
+			mcp.Description("Only list the mutations of this table (e.g. deployments, liquidity_pools). Optional"),
+		),
+		mcp.WithString("entity_id",
+			mcp.Description("Only list the mutations of the row with this ID, use with entity_type. Optional"),
+		),
+		mcp.WithString("since",
+			mcp.Description("Only list the entries since this RFC 3339 time (e.g. 2025-01-02T15:04:05Z) or for this duration (e.g. 24h). Optional"),
+		),
+		mcp.WithString("limit",
+			mcp.Description(fmt.Sprintf("Maximum number of entries. Optional, defaults to %d, at most %d", defaultAuditLogLimit, maxAuditLogLimit)),
+		),
+	)
+
+	return tool
+}
+
+func (g *getAuditLogTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetAuditLogArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		filter := services.AuditLogFilter{
+			Kind:       models.AuditLogKind(args.Kind),
+			ToolName:   args.ToolName,
+			Status:     models.AuditLogStatus(args.Status),
+			EntityType: args.EntityType,
+			EntityID:   args.EntityID,
+			Limit:      defaultAuditLogLimit,
+		}
+
+		if args.Limit != "" {
+			limit, err := strconv.Atoi(args.Limit)
+			if err != nil || limit < 1 || limit > maxAuditLogLimit {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid limit %q: must be a number between 1 and %d", args.Limit, maxAuditLogLimit)), nil
+			}
+			filter.Limit = limit
+		}
+
+		if args.Since != "" {
+			if since, err := time.Parse(time.RFC3339, args.Since); err == nil {
+				filter.Since = since
+			} else if duration, err := time.ParseDuration(args.Since); err == nil && duration > 0 {
+				filter.Since = time.Now().Add(-duration)
+			} else {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid since %q: must be an RFC 3339 time or a duration such as 24h", args.Since)), nil
+			}
+		}
+
+		// Authenticated users can only see their own entries
+		if userID := utils.GetUserID(ctx); userID != "" {
+			filter.UserID = &userID
+		}
+
+		entries, err := g.auditService.ListAuditLogs(filter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list the audit log: %v", err)), nil
+		}
+
+		report := AuditLogReport{Retention: "forever", Entries: entries}
+		if g.retention > 0 {
+			report.Retention = g.retention.String()
+		}
+		if report.Entries == nil {
+			report.Entries = []models.AuditLog{}
+		}
+
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d audit log entries (retention %s): ", len(entries), report.Retention)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
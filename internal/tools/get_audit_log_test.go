@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type GetAuditLogToolTestSuite struct {
+	suite.Suite
+	db           services.DBService
+	auditService services.AuditService
+}
+
+func (suite *GetAuditLogToolTestSuite) SetupTest() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.auditService = services.NewAuditService(db.GetDB())
+
+	userID := "user-1"
+	suite.Require().NoError(suite.auditService.RecordToolCall(&userID, "launch", map[string]any{"template_id": "1"}, ""))
+	suite.Require().NoError(suite.auditService.RecordToolCall(nil, "launch", map[string]any{"template_id": "2"}, "Template not found"))
+	suite.Require().NoError(suite.auditService.RecordToolCall(nil, "list_chains", map[string]any{}, ""))
+	suite.Require().NoError(db.GetDB().Create(&models.AuditLog{
+		Kind:       models.AuditLogKindMutation,
+		EntityType: "deployments",
+		EntityID:   "7",
+		Action:     models.AuditLogActionUpdate,
+		CreatedAt:  time.Now().Add(-48 * time.Hour),
+	}).Error)
+}
+
+func (suite *GetAuditLogToolTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *GetAuditLogToolTestSuite) getAuditLog(ctx context.Context, arguments map[string]interface{}) *mcp.CallToolResult {
+	result, err := NewGetAuditLogTool(suite.auditService, 24*time.Hour).GetHandler()(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *GetAuditLogToolTestSuite) report(result *mcp.CallToolResult) AuditLogReport {
+	suite.Require().False(result.IsError, "%v", result.Content)
+	var report AuditLogReport
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+	return report
+}
+
+func (suite *GetAuditLogToolTestSuite) TestFilters() {
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		expected  int
+	}{
+		{name: "everything", arguments: map[string]interface{}{}, expected: 4},
+		{name: "tool_calls", arguments: map[string]interface{}{"kind": "tool_call"}, expected: 3},
+		{name: "tool_name", arguments: map[string]interface{}{"tool_name": "launch"}, expected: 2},
+		{name: "failed_calls", arguments: map[string]interface{}{"status": "error"}, expected: 1},
+		{name: "entity", arguments: map[string]interface{}{"entity_type": "deployments", "entity_id": "7"}, expected: 1},
+		{name: "since_duration", arguments: map[string]interface{}{"since": "24h"}, expected: 3},
+		{name: "since_time", arguments: map[string]interface{}{"since": time.Now().Add(-72 * time.Hour).Format(time.RFC3339)}, expected: 4},
+		{name: "limit", arguments: map[string]interface{}{"limit": "2"}, expected: 2},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			report := suite.report(suite.getAuditLog(context.Background(), tt.arguments))
+			suite.Len(report.Entries, tt.expected)
+			suite.Equal("24h0m0s", report.Retention)
+		})
+	}
+}
+
+func (suite *GetAuditLogToolTestSuite) TestUserScoped() {
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+	report := suite.report(suite.getAuditLog(ctx, map[string]interface{}{}))
+	suite.Require().Len(report.Entries, 1)
+	suite.Equal("launch", report.Entries[0].ToolName)
+	suite.NotEmpty(report.Entries[0].ArgumentsHash)
+}
+
+func (suite *GetAuditLogToolTestSuite) TestInvalidArguments() {
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		errorMsg  string
+	}{
+		{name: "unknown_kind", arguments: map[string]interface{}{"kind": "login"}, errorMsg: "Invalid arguments"},
+		{name: "invalid_since", arguments: map[string]interface{}{"since": "yesterday"}, errorMsg: "Invalid since"},
+		{name: "limit_too_large", arguments: map[string]interface{}{"limit": "1000"}, errorMsg: "Invalid limit"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result := suite.getAuditLog(context.Background(), tt.arguments)
+			suite.True(result.IsError)
+			suite.Contains(result.Content[0].(mcp.TextContent).Text, tt.errorMsg)
+		})
+	}
+}
+
+func TestGetAuditLogToolTestSuite(t *testing.T) {
+	suite.Run(t, new(GetAuditLogToolTestSuite))
+}
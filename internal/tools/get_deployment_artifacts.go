@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getDeploymentArtifactsTool struct {
+	deploymentService services.DeploymentService
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type GetDeploymentArtifactsArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+}
+
+type GetDeploymentArtifactsResult struct {
+	*services.DeploymentArtifacts
+	DownloadURL string `json:"download_url"`
+}
+
+func NewGetDeploymentArtifactsTool(deploymentService services.DeploymentService, txService services.TransactionService, serverPort int) *getDeploymentArtifactsTool {
+	return &getDeploymentArtifactsTool{
+		deploymentService: deploymentService,
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (g *getDeploymentArtifactsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_deployment_artifacts",
+		mcp.WithDescription("Get the artifacts of a confirmed Ethereum deployment for contract verification: the rendered Solidity sources, the creation bytecode, the ABI and the constructor arguments. Returns the artifacts inline and a download URL of the zip archive."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed deployment to get the artifacts of"),
+		),
+	)
+
+	return tool
+}
+
+func (g *getDeploymentArtifactsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetDeploymentArtifactsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, err := getConfirmedDeployment(g.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		// the download url is signed, it is only issued to the owner of the deployment
+		if user, _ := utils.GetAuthenticatedUser(ctx); user != nil && deployment.UserID != nil && *deployment.UserID != user.Sub {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment not found: %s", args.DeploymentID)), nil
+		}
+
+		var session *models.TransactionSession
+		if deployment.SessionId != "" {
+			sessions, err := g.txService.ListTransactionSessionsByIDs([]string{deployment.SessionId})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get the deployment session: %v", err)), nil
+			}
+			if len(sessions) > 0 {
+				session = &sessions[0]
+			}
+		}
+
+		artifacts, err := services.BuildDeploymentArtifacts(deployment, session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get deployment artifacts: %v", err)), nil
+		}
+
+		downloadURL, err := utils.GetDeploymentArtifactsUrl(ctx, g.serverPort, deployment.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get download url: %v", err)), nil
+		}
+
+		message := fmt.Sprintf("Artifacts of deployment %s (%d source files). Download the zip archive at: %s", args.DeploymentID, len(artifacts.Sources), downloadURL)
+		if artifacts.Bytecode == "" {
+			message += ". The bytecode could not be separated from the constructor arguments, use creation_data"
+		}
+		resultJSON, _ := json.Marshal(GetDeploymentArtifactsResult{DeploymentArtifacts: artifacts, DownloadURL: downloadURL})
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(message),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
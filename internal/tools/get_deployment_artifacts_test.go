@@ -0,0 +1,150 @@
+package tools
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const artifactsTestAbi = `[{"inputs":[{"name":"name","type":"string"},{"name":"supply","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"}]`
+
+type GetDeploymentArtifactsToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	tool              *getDeploymentArtifactsTool
+	chain             *models.Chain
+	template          *models.Template
+	deploymentService services.DeploymentService
+	txService         services.TransactionService
+}
+
+func (suite *GetDeploymentArtifactsToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tool = NewGetDeploymentArtifactsTool(suite.deploymentService, suite.txService, 8080)
+
+	chain := &models.Chain{Name: "Test Ethereum", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	suite.Require().NoError(services.NewChainService(db.GetDB()).CreateChain(chain))
+	suite.chain = chain
+
+	var abi []any
+	suite.Require().NoError(json.Unmarshal([]byte(artifactsTestAbi), &abi))
+	template := &models.Template{
+		Name:         "My Token",
+		ChainType:    models.TransactionChainTypeEthereum,
+		TemplateCode: "contract {{.TokenName}} {}",
+		Files:        models.TemplateFiles{"lib/Math.sol": "library Math {}"},
+		Abi:          models.JSON{"abi": abi},
+		Report:       &models.TemplateReport{CompilerVersion: "0.8.27"},
+	}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *GetDeploymentArtifactsToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+// createDeployment stores a confirmed deployment whose session signed the bytecode followed by the encoded arguments
+func (suite *GetDeploymentArtifactsToolTestSuite) createDeployment(constructorArgs []any) *models.Deployment {
+	encoded, err := utils.EncodeContractConstructorArgs(artifactsTestAbi, []any{"Token", "1000"})
+	suite.Require().NoError(err)
+	code := "contract Token {}"
+	sessionID, err := suite.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{{
+			Title:           "Deploy Contract",
+			Data:            "0x6080604052" + hex.EncodeToString(encoded),
+			ContractCode:    &code,
+			TransactionType: models.TransactionTypeTokenDeployment,
+		}},
+		ChainType: models.TransactionChainTypeEthereum,
+		ChainID:   suite.chain.ID,
+	})
+	suite.Require().NoError(err)
+
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		ContractAddress: "0x1234567890123456789012345678901234567890",
+		TemplateValues:  models.JSON{"TokenName": "Token"},
+		ConstructorArgs: constructorArgs,
+		Status:          models.TransactionStatusConfirmed,
+		SessionId:       sessionID,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *GetDeploymentArtifactsToolTestSuite) callTool(deploymentID uint) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"deployment_id": fmt.Sprint(deploymentID)}
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *GetDeploymentArtifactsToolTestSuite) TestReturnsArtifacts() {
+	deployment := suite.createDeployment([]any{"Token", "1000"})
+
+	result := suite.callTool(deployment.ID)
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	var artifacts map[string]any
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &artifacts))
+	suite.Equal("0x6080604052", artifacts["bytecode"])
+	suite.Equal([]any{"Token", "1000"}, artifacts["constructor_args"])
+	suite.Equal("0.8.27", artifacts["compiler_version"])
+	suite.Equal(map[string]any{
+		"contracts/MyToken.sol":  "contract Token {}",
+		"contracts/lib/Math.sol": "library Math {}",
+	}, artifacts["sources"])
+	suite.Contains(artifacts["download_url"], fmt.Sprintf("/deployments/%d/artifacts", deployment.ID))
+
+	stored, err := suite.deploymentService.GetDeploymentByID(deployment.ID)
+	suite.Require().NoError(err)
+	withoutSession, err := services.BuildDeploymentArtifacts(stored, nil)
+	suite.Require().NoError(err)
+	// Without a session the main contract is rendered from the template
+	suite.Equal("contract Token {}", withoutSession.Sources["contracts/MyToken.sol"])
+	suite.Empty(withoutSession.Bytecode)
+}
+
+func (suite *GetDeploymentArtifactsToolTestSuite) TestDeploymentsWithoutStoredArguments() {
+	deployment := suite.createDeployment(nil)
+
+	result := suite.callTool(deployment.ID)
+	suite.Require().False(result.IsError)
+	suite.Contains(result.Content[0].(mcp.TextContent).Text, "use creation_data")
+
+	var artifacts map[string]any
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &artifacts))
+	suite.Nil(artifacts["bytecode"])
+	suite.NotEmpty(artifacts["creation_data"])
+}
+
+func (suite *GetDeploymentArtifactsToolTestSuite) TestRejectsPendingDeployments() {
+	deployment := suite.createDeployment(nil)
+	suite.Require().NoError(suite.deploymentService.UpdateDeploymentStatus(deployment.ID, models.TransactionStatusPending, ""))
+
+	result := suite.callTool(deployment.ID)
+	suite.True(result.IsError)
+}
+
+func TestGetDeploymentArtifactsToolTestSuite(t *testing.T) {
+	suite.Run(t, new(GetDeploymentArtifactsToolTestSuite))
+}
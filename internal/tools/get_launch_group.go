@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getLaunchGroupTool struct {
+	launchGroupService services.LaunchGroupService
+	serverPort         int
+}
+
+type GetLaunchGroupArguments struct {
+	LaunchGroupID string `json:"launch_group_id" validate:"required"`
+}
+
+func NewGetLaunchGroupTool(launchGroupService services.LaunchGroupService, serverPort int) *getLaunchGroupTool {
+	return &getLaunchGroupTool{
+		launchGroupService: launchGroupService,
+		serverPort:         serverPort,
+	}
+}
+
+func (g *getLaunchGroupTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_launch_group",
+		mcp.WithDescription("Get the consolidated status of a multi_chain_launch group: pending until every deployment is signed, confirmed once the contract is deployed on every chain, partial or failed when deployments failed. "+
+			"Lists the status, contract address and transaction hash of every chain, with the session url of the deployments still waiting to be signed."),
+		mcp.WithString("launch_group_id",
+			mcp.Required(),
+			mcp.Description("ID of the launch group returned by multi_chain_launch"),
+		),
+	)
+
+	return tool
+}
+
+func (g *getLaunchGroupTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetLaunchGroupArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		groupID, err := strconv.ParseUint(args.LaunchGroupID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid launch_group_id format: %v", err)), nil
+		}
+
+		group, err := g.launchGroupService.GetLaunchGroup(uint(groupID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Launch group not found: %v", err)), nil
+		}
+
+		// Authenticated users can only see their own launch groups
+		if userID := utils.GetUserID(ctx); userID != "" && (group.UserID == nil || *group.UserID != userID) {
+			return mcp.NewToolResultError("Launch group not found"), nil
+		}
+
+		report := newLaunchGroupReport(ctx, group, g.serverPort)
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Launch group %d is %s: ", group.ID, report.Status)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getPlatformRevenueTool struct {
+	platformFeeService services.PlatformFeeService
+}
+
+type GetPlatformRevenueArguments struct {
+	// Optional fields
+	ChainID        string `json:"chain_id,omitempty"`
+	Since          string `json:"since,omitempty"`
+	Until          string `json:"until,omitempty"`
+	IncludePending bool   `json:"include_pending,omitempty"`
+}
+
+// PlatformRevenueResult is the revenue report with the fee configured on the server
+type PlatformRevenueResult struct {
+	Config *services.PlatformFeeConfig `json:"config"`
+	*services.PlatformRevenueReport
+}
+
+func NewGetPlatformRevenueTool(platformFeeService services.PlatformFeeService) *getPlatformRevenueTool {
+	return &getPlatformRevenueTool{
+		platformFeeService: platformFeeService,
+	}
+}
+
+func (g *getPlatformRevenueTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_platform_revenue",
+		mcp.WithDescription("Revenue report of the platform fees taken on the launches and swaps of the server: the fees in wei by chain, kind (platform or referral) and status, the value they were taken on, "+
+			"the number of sessions that paid a fee and the referrers ranked by the fees they earned. Only confirmed fee transfers count unless include_pending is set. Authenticated users need the admin role."),
+		mcp.WithString("chain_id",
+			mcp.Description("ID of the chain of the fees. Optional, defaults to every chain"),
+		),
+		mcp.WithString("since",
+			mcp.Description("RFC3339 time of the first fees counted. Optional"),
+		),
+		mcp.WithString("until",
+			mcp.Description("RFC3339 time the fees counted stop at. Optional"),
+		),
+		mcp.WithBoolean("include_pending",
+			mcp.Description("Also count the fees of sessions not signed yet. Optional, defaults to false"),
+		),
+	)
+
+	return tool
+}
+
+func (g *getPlatformRevenueTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// The local server has no user, the report covers every user so a user needs the admin role
+		if user, _ := utils.GetAuthenticatedUser(ctx); user != nil && !utils.HasRole(ctx, adminRole) {
+			return mcp.NewToolResultError("get_platform_revenue requires the admin role"), nil
+		}
+
+		var args GetPlatformRevenueArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		filter := services.PlatformRevenueFilter{IncludePending: args.IncludePending}
+		if args.ChainID != "" {
+			chainID, err := strconv.ParseUint(args.ChainID, 10, 32)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid chain_id %q: must be a number", args.ChainID)), nil
+			}
+			filter.ChainID = uint(chainID)
+		}
+		if args.Since != "" {
+			since, err := time.Parse(time.RFC3339, args.Since)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid since format, expected RFC3339: %v", err)), nil
+			}
+			filter.Since = &since
+		}
+		if args.Until != "" {
+			until, err := time.Parse(time.RFC3339, args.Until)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid until format, expected RFC3339: %v", err)), nil
+			}
+			filter.Until = &until
+		}
+
+		config, err := services.PlatformFeeConfigFromEnv()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read the platform fee: %v", err)), nil
+		}
+		report, err := g.platformFeeService.GetRevenueReport(filter)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get the platform revenue: %v", err)), nil
+		}
+
+		summary := fmt.Sprintf("Platform revenue of %d sessions: ", report.Sessions)
+		if config == nil {
+			summary = fmt.Sprintf("No platform fee is configured, set %s to take fees. Platform revenue of %d sessions: ", services.EnvPlatformFeeRecipient, report.Sessions)
+		}
+		resultJSON, _ := json.Marshal(PlatformRevenueResult{Config: config, PlatformRevenueReport: report})
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(summary),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
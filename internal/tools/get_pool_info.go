@@ -8,9 +8,10 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
-func NewGetPoolInfoTool(chainService services.ChainService, liquidityService services.LiquidityService) (mcp.Tool, server.ToolHandlerFunc) {
+func NewGetPoolInfoTool(chainService services.ChainService, liquidityService services.LiquidityService, serverPort int) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("get_pool_info",
 		mcp.WithDescription("Retrieve pool metrics including reserves, liquidity, price, and volume. This is a read-only operation that doesn't require wallet connection."),
 		mcp.WithString("token_address",
@@ -26,7 +27,7 @@ func NewGetPoolInfoTool(chainService services.ChainService, liquidityService ser
 		}
 
 		// Get active chain configuration
-		activeChain, err := chainService.GetActiveChain()
+		activeChain, err := chainService.ResolveChain(ctx)
 		if err != nil {
 			return &mcp.CallToolResult{
 				Content: []mcp.Content{
@@ -57,7 +58,13 @@ func NewGetPoolInfoTool(chainService services.ChainService, liquidityService ser
 			}, nil
 		}
 
+		poolURL, err := utils.GetPoolPageUrl(ctx, serverPort, pool.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pool page url: %v", err)), nil
+		}
+
 		result := map[string]interface{}{
+			"pool_url": poolURL,
 			"pool_info": map[string]interface{}{
 				"id":              pool.ID,
 				"token_address":   pool.TokenAddress,
@@ -0,0 +1,340 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getPortfolioTool struct {
+	chainService           services.ChainService
+	deploymentService      services.DeploymentService
+	liquidityService       services.LiquidityService
+	uniswapService         services.UniswapService
+	uniswapContractService services.UniswapContractService
+	priceService           services.PriceService
+}
+
+type GetPortfolioArguments struct {
+	// Required fields
+	Address string `json:"address" validate:"required"`
+}
+
+// PortfolioToken is a non-zero token balance of the address
+type PortfolioToken struct {
+	TokenAddress string `json:"token_address"`
+	Label        string `json:"label"`
+	Symbol       string `json:"symbol"`
+	Decimals     int    `json:"decimals"`
+	Balance      string `json:"balance"`
+	// Value is the balance in wei of the native token at the spot price of the WETH pool, empty without a WETH pool
+	Value    string   `json:"value,omitempty"`
+	ValueUSD *float64 `json:"value_usd,omitempty"`
+}
+
+// PortfolioLiquidityPosition is the share of a launchpad pool owned through its LP tokens
+type PortfolioLiquidityPosition struct {
+	PoolID      uint   `json:"pool_id"`
+	PairAddress string `json:"pair_address"`
+	LPBalance   string `json:"lp_balance"`
+	// Share is the percentage of the LP supply held by the address
+	Share    float64  `json:"share"`
+	Token0   string   `json:"token0"`
+	Token1   string   `json:"token1"`
+	Amount0  string   `json:"amount0"`
+	Amount1  string   `json:"amount1"`
+	Value    string   `json:"value,omitempty"`
+	ValueUSD *float64 `json:"value_usd,omitempty"`
+}
+
+type Portfolio struct {
+	Address      string `json:"address"`
+	ChainID      string `json:"chain_id"`
+	NativeSymbol string `json:"native_symbol"`
+	// NativeUSDPrice is missing on testnets and when the price API is unavailable
+	NativeUSDPrice     *float64                     `json:"native_usd_price,omitempty"`
+	NativeBalance      string                       `json:"native_balance"`
+	NativeValueUSD     *float64                     `json:"native_value_usd,omitempty"`
+	Tokens             []PortfolioToken             `json:"tokens"`
+	LiquidityPositions []PortfolioLiquidityPosition `json:"liquidity_positions"`
+	// TotalValue is the sum in wei of the native balance and the valued tokens and positions
+	TotalValue    string   `json:"total_value"`
+	TotalValueUSD *float64 `json:"total_value_usd,omitempty"`
+	// SkippedTokens are the known contracts whose balance could not be read, such as NFT contracts
+	SkippedTokens []string `json:"skipped_tokens,omitempty"`
+}
+
+func NewGetPortfolioTool(chainService services.ChainService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService, uniswapContractService services.UniswapContractService, priceService services.PriceService) *getPortfolioTool {
+	return &getPortfolioTool{
+		chainService:           chainService,
+		deploymentService:      deploymentService,
+		liquidityService:       liquidityService,
+		uniswapService:         uniswapService,
+		uniswapContractService: uniswapContractService,
+		priceService:           priceService,
+	}
+}
+
+func (g *getPortfolioTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_portfolio",
+		mcp.WithDescription("Report the portfolio of an address on the active chain, such as a treasury: the native balance, the balances of the tokens known to the launchpad (deployed tokens, pool tokens and WETH) and the liquidity positions in the launchpad pools. Tokens are valued at the spot price of their WETH pool and the native token in USD through the price API."),
+		mcp.WithString("address",
+			mcp.Required(),
+			mcp.Description("Address to report the portfolio of"),
+		),
+	)
+
+	return tool
+}
+
+func (g *getPortfolioTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetPortfolioArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := g.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Portfolios are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.Address) {
+			return mcp.NewToolResultError("Address is not a valid Ethereum address"), nil
+		}
+
+		portfolio, err := g.buildPortfolio(ctx, activeChain, args.Address)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		portfolioJSON, _ := json.Marshal(portfolio)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Portfolio of %s: %d tokens and %d liquidity positions: ", portfolio.Address, len(portfolio.Tokens), len(portfolio.LiquidityPositions))),
+				mcp.NewTextContent(string(portfolioJSON)),
+			},
+		}, nil
+	}
+}
+
+// portfolioPool is a confirmed pool of the chain with its reserves ordered like Token0 and Token1, ETH replaced by WETH
+type portfolioPool struct {
+	pool               models.LiquidityPool
+	token0, token1     string
+	reserve0, reserve1 *big.Int
+}
+
+func (g *getPortfolioTool) buildPortfolio(ctx context.Context, chain *models.Chain, address string) (*Portfolio, error) {
+	native, err := utils.QueryNativeBalance(chain.RPC, address, string(chain.ChainType))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to query native balance: %v", err)
+	}
+	nativeBalance, _ := new(big.Int).SetString(native.NativeBalance, 10)
+
+	portfolio := &Portfolio{
+		Address:            address,
+		ChainID:            chain.NetworkID,
+		NativeSymbol:       g.priceService.NativeCurrency(chain.NetworkID).Symbol,
+		NativeBalance:      native.NativeBalance,
+		Tokens:             []PortfolioToken{},
+		LiquidityPositions: []PortfolioLiquidityPosition{},
+	}
+	// Prices are optional, the balances are still reported without them
+	if prices, err := g.priceService.NativeUSDPrices(ctx, []string{chain.NetworkID}); err != nil {
+		log.Printf("Error getting the USD price of chain %s: %v", chain.NetworkID, err)
+	} else if price, ok := prices[chain.NetworkID]; ok {
+		portfolio.NativeUSDPrice = &price
+	}
+	toUSD := func(wei *big.Int) *float64 {
+		if wei == nil || portfolio.NativeUSDPrice == nil {
+			return nil
+		}
+		usd := utils.WeiToUSD(wei, *portfolio.NativeUSDPrice)
+		return &usd
+	}
+	portfolio.NativeValueUSD = toUSD(nativeBalance)
+	total := new(big.Int).Set(nativeBalance)
+
+	var wethAddress string
+	if uniswapDeployment, err := g.uniswapService.GetUniswapDeploymentByChain(chain.ID); err == nil {
+		wethAddress = uniswapDeployment.WETHAddress
+	}
+
+	pools, prices, err := g.loadPools(chain, wethAddress)
+	if err != nil {
+		return nil, err
+	}
+	// valueOf returns the amount of the token in wei, nil when the token has no WETH pool
+	valueOf := func(token string, amount *big.Int) *big.Int {
+		if wethAddress != "" && strings.EqualFold(token, wethAddress) {
+			return new(big.Int).Set(amount)
+		}
+		price, ok := prices[strings.ToLower(token)]
+		if !ok {
+			return nil
+		}
+		return price.Value(amount)
+	}
+
+	tokens, err := g.knownTokens(chain, pools, wethAddress)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		balance, err := utils.QueryERC20Balance(chain.RPC, token.Address, address)
+		if err != nil {
+			portfolio.SkippedTokens = append(portfolio.SkippedTokens, token.Address)
+			continue
+		}
+		amount, _ := new(big.Int).SetString(balance.TokenBalance, 10)
+		if amount.Sign() == 0 {
+			continue
+		}
+
+		entry := PortfolioToken{
+			TokenAddress: token.Address,
+			Label:        token.Label,
+			Symbol:       balance.TokenSymbol,
+			Decimals:     balance.TokenDecimals,
+			Balance:      balance.TokenBalance,
+		}
+		if value := valueOf(token.Address, amount); value != nil {
+			entry.Value = value.String()
+			entry.ValueUSD = toUSD(value)
+			total.Add(total, value)
+		}
+		portfolio.Tokens = append(portfolio.Tokens, entry)
+	}
+
+	for _, pool := range pools {
+		balance, err := utils.QueryERC20Balance(chain.RPC, pool.pool.PairAddress, address)
+		if err != nil {
+			continue
+		}
+		lpBalance, _ := new(big.Int).SetString(balance.TokenBalance, 10)
+		if lpBalance.Sign() == 0 {
+			continue
+		}
+		supply, err := utils.QueryERC20TotalSupply(chain.RPC, pool.pool.PairAddress)
+		if err != nil || supply.Sign() == 0 {
+			continue
+		}
+
+		amount0 := utils.ShareOfReserve(pool.reserve0, lpBalance, supply)
+		amount1 := utils.ShareOfReserve(pool.reserve1, lpBalance, supply)
+		share, _ := new(big.Float).Quo(new(big.Float).SetInt(lpBalance), new(big.Float).SetInt(supply)).Float64()
+		position := PortfolioLiquidityPosition{
+			PoolID:      pool.pool.ID,
+			PairAddress: pool.pool.PairAddress,
+			LPBalance:   lpBalance.String(),
+			Share:       share * 100,
+			Token0:      pool.pool.Token0,
+			Token1:      pool.pool.Token1,
+			Amount0:     amount0.String(),
+			Amount1:     amount1.String(),
+		}
+		value0, value1 := valueOf(pool.token0, amount0), valueOf(pool.token1, amount1)
+		if value0 != nil && value1 != nil {
+			value := new(big.Int).Add(value0, value1)
+			position.Value = value.String()
+			position.ValueUSD = toUSD(value)
+			total.Add(total, value)
+		}
+		portfolio.LiquidityPositions = append(portfolio.LiquidityPositions, position)
+	}
+
+	portfolio.TotalValue = total.String()
+	portfolio.TotalValueUSD = toUSD(total)
+	return portfolio, nil
+}
+
+// loadPools reads the reserves of the confirmed pools of the chain and the WETH price of the tokens paired with WETH,
+// the deepest WETH pool of a token sets its price. Pools whose reserves cannot be read are skipped
+func (g *getPortfolioTool) loadPools(chain *models.Chain, wethAddress string) ([]portfolioPool, map[string]utils.WETHPrice, error) {
+	pools, err := g.liquidityService.ListConfirmedLiquidityPoolsByChain(chain.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list liquidity pools: %v", err)
+	}
+
+	result := make([]portfolioPool, 0, len(pools))
+	prices := map[string]utils.WETHPrice{}
+	for _, pool := range pools {
+		reserve0, reserve1, err := g.uniswapContractService.GetReserves(pool.PairAddress, chain)
+		if err != nil {
+			log.Printf("Skipping pool %d for the portfolio: %v", pool.ID, err)
+			continue
+		}
+
+		token0, token1 := pool.Token0, pool.Token1
+		if strings.EqualFold(token0, services.EthTokenAddress) {
+			token0 = wethAddress
+		}
+		if strings.EqualFold(token1, services.EthTokenAddress) {
+			token1 = wethAddress
+		}
+		reserveA, reserveB := utils.SortPairReserves(token0, token1, reserve0, reserve1)
+		result = append(result, portfolioPool{pool: pool, token0: token0, token1: token1, reserve0: reserveA, reserve1: reserveB})
+
+		if wethAddress == "" {
+			continue
+		}
+		for _, side := range []struct {
+			token, other          string
+			reserve, otherReserve *big.Int
+		}{{token0, token1, reserveA, reserveB}, {token1, token0, reserveB, reserveA}} {
+			if !strings.EqualFold(side.other, wethAddress) || strings.EqualFold(side.token, wethAddress) {
+				continue
+			}
+			key := strings.ToLower(side.token)
+			if current, ok := prices[key]; !ok || side.otherReserve.Cmp(current.WETHReserve) > 0 {
+				prices[key] = utils.WETHPrice{TokenReserve: side.reserve, WETHReserve: side.otherReserve}
+			}
+		}
+	}
+	return result, prices, nil
+}
+
+// knownTokens returns the confirmed token deployments of the chain, the tokens of its pools and WETH
+func (g *getPortfolioTool) knownTokens(chain *models.Chain, pools []portfolioPool, wethAddress string) ([]allowanceCandidate, error) {
+	deployments, err := g.deploymentService.GetDeploymentsByChain(chain.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list deployments: %v", err)
+	}
+
+	var tokens []allowanceCandidate
+	for _, deployment := range deployments {
+		if deployment.Status == models.TransactionStatusConfirmed && deployment.ContractAddress != "" {
+			tokens = appendCandidate(tokens, deployment.ContractAddress, fmt.Sprintf("%s (deployment %d)", deployment.Template.Name, deployment.ID))
+		}
+	}
+	for _, pool := range pools {
+		for _, token := range []string{pool.token0, pool.token1} {
+			if token != "" && !strings.EqualFold(token, wethAddress) {
+				tokens = appendCandidate(tokens, token, fmt.Sprintf("Token of pool %d", pool.pool.ID))
+			}
+		}
+	}
+	if wethAddress != "" {
+		tokens = appendCandidate(tokens, wethAddress, "WETH")
+	}
+	return tokens, nil
+}
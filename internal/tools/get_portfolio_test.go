@@ -0,0 +1,142 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	portfolioToken = "0x1111111111111111111111111111111111111111"
+	portfolioWETH  = "0x2222222222222222222222222222222222222222"
+	portfolioPair  = "0x3333333333333333333333333333333333333333"
+)
+
+// portfolioContractService returns fixed reserves: 1,000,000 tokens against 10 WETH
+type portfolioContractService struct{}
+
+func (portfolioContractService) GetPairAddress(token0Address, token1Address string, chain *models.Chain) (string, error) {
+	return portfolioPair, nil
+}
+
+func (portfolioContractService) GetReserves(pairAddress string, chain *models.Chain) (*big.Int, *big.Int, error) {
+	weth, _ := new(big.Int).SetString("10000000000000000000", 10)
+	return big.NewInt(1_000_000), weth, nil
+}
+
+func (portfolioContractService) GetLPDistribution(pairAddress string, chain *models.Chain) ([]services.LPHolder, error) {
+	return nil, nil
+}
+
+type portfolioPriceService struct{}
+
+func (portfolioPriceService) NativeCurrency(chainID string) services.NativeCurrency {
+	return services.NativeCurrency{Symbol: "ETH"}
+}
+
+func (portfolioPriceService) NativeUSDPrices(ctx context.Context, chainIDs []string) (map[string]float64, error) {
+	return map[string]float64{"31337": 2000}, nil
+}
+
+// portfolioRPC answers the balance queries of the portfolio: 1 ETH, 500,000 tokens and 25 of the 100 LP tokens
+func portfolioRPC(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		result := "0x0"
+		switch request.Method {
+		case "eth_getBalance":
+			result = "0xde0b6b3a7640000"
+		case "eth_call":
+			var call struct {
+				To   string `json:"to"`
+				Data string `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(request.Params[0], &call))
+			switch {
+			case strings.HasPrefix(call.Data, "0x70a08231") && strings.EqualFold(call.To, portfolioToken):
+				result = fmt.Sprintf("0x%x", 500_000)
+			case strings.HasPrefix(call.Data, "0x70a08231") && strings.EqualFold(call.To, portfolioPair):
+				result = fmt.Sprintf("0x%x", 25)
+			case call.Data == "0x18160ddd":
+				result = fmt.Sprintf("0x%x", 100)
+			case call.Data == "0x313ce567":
+				result = "0x12"
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+}
+
+func TestGetPortfolio(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	rpc := portfolioRPC(t)
+	t.Cleanup(rpc.Close)
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	require.NoError(t, db.GetDB().Create(&models.UniswapDeployment{ChainID: chain.ID, Version: "v2", Status: models.TransactionStatusConfirmed, WETHAddress: portfolioWETH}).Error)
+	require.NoError(t, db.GetDB().Create(&models.TransactionSession{ID: "pool-session", ChainID: chain.ID, TransactionChainType: models.TransactionChainTypeEthereum}).Error)
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	_, err = liquidityService.CreateLiquidityPool(&models.LiquidityPool{
+		TokenAddress: portfolioToken,
+		PairAddress:  portfolioPair,
+		Token0:       services.EthTokenAddress,
+		Token1:       portfolioToken,
+		Status:       models.TransactionStatusConfirmed,
+		SessionId:    "pool-session",
+	})
+	require.NoError(t, err)
+
+	handler := NewGetPortfolioTool(chainService, services.NewDeploymentService(db.GetDB()), liquidityService, services.NewUniswapService(db.GetDB()), portfolioContractService{}, portfolioPriceService{}).GetHandler()
+	call := func(address string) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"address": address}}})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("values the tokens and positions at the WETH pool price", func(t *testing.T) {
+		result := call("0x4444444444444444444444444444444444444444")
+		require.False(t, result.IsError)
+
+		var portfolio Portfolio
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &portfolio))
+		require.Len(t, portfolio.Tokens, 1)
+		assert.Equal(t, "500000", portfolio.Tokens[0].Balance)
+		assert.Equal(t, "5000000000000000000", portfolio.Tokens[0].Value)
+
+		require.Len(t, portfolio.LiquidityPositions, 1)
+		position := portfolio.LiquidityPositions[0]
+		assert.InDelta(t, 25.0, position.Share, 1e-9)
+		assert.Equal(t, "2500000000000000000", position.Amount0)
+		assert.Equal(t, "250000", position.Amount1)
+		assert.Equal(t, "5000000000000000000", position.Value)
+
+		// 1 ETH, 5 ETH of tokens and 5 ETH of liquidity at 2000 USD
+		assert.Equal(t, "11000000000000000000", portfolio.TotalValue)
+		require.NotNil(t, portfolio.TotalValueUSD)
+		assert.InDelta(t, 22000.0, *portfolio.TotalValueUSD, 1e-6)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		assert.True(t, call("0x123").IsError)
+	})
+}
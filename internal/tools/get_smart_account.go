@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getSmartAccountTool struct {
+	chainService services.ChainService
+}
+
+type GetSmartAccountArguments struct {
+	OwnerAddress string `json:"owner_address" validate:"required,eth_addr"`
+}
+
+// SmartAccountResult is the smart account sending the gasless transactions of a wallet on the active chain
+type SmartAccountResult struct {
+	*utils.SmartAccount
+	EntryPoint string `json:"entry_point"`
+	// Gasless is true when the chain has a bundler, Sponsored when its paymaster pays the gas
+	Gasless   bool `json:"gasless"`
+	Sponsored bool `json:"sponsored"`
+}
+
+func NewGetSmartAccountTool(chainService services.ChainService) *getSmartAccountTool {
+	return &getSmartAccountTool{
+		chainService: chainService,
+	}
+}
+
+func (g *getSmartAccountTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_smart_account",
+		mcp.WithDescription("Get the ERC-4337 smart account (SimpleAccount) of a wallet on the active chain, the account sending its gasless launches and swaps. The account address is known before it is deployed, it is deployed by its first user operation. Use it as the user_address of gasless swaps and fund it with the tokens to spend."),
+		mcp.WithString("owner_address",
+			mcp.Required(),
+			mcp.Description("Address of the wallet owning the smart account"),
+		),
+	)
+
+	return tool
+}
+
+func (g *getSmartAccountTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetSmartAccountArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := g.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Smart accounts are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		account, err := utils.ReadSmartAccount(activeChain.RPC, utils.DefaultAccountFactoryAddress, args.OwnerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read the smart account: %v", err)), nil
+		}
+
+		result := SmartAccountResult{
+			SmartAccount: account,
+			EntryPoint:   utils.DefaultEntryPointAddress,
+			Gasless:      activeChain.BundlerRPC != "",
+			Sponsored:    activeChain.BundlerRPC != "" && activeChain.PaymasterRPC != "",
+		}
+		summary := fmt.Sprintf("Smart account of %s on %s: %s", account.Owner, activeChain.Name, account.Address)
+		if !account.Deployed {
+			summary += " (deployed by its first user operation)"
+		}
+		if !result.Gasless {
+			summary += ". Gasless transactions are not configured for this chain, use set_chain with bundler_rpc first"
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(summary),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
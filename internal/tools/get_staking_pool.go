@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getStakingPoolTool struct {
+	stakingService services.StakingService
+}
+
+type GetStakingPoolArguments struct {
+	StakingPoolID string `json:"staking_pool_id" validate:"required"`
+}
+
+// StakingPoolReport is a staking pool with its emissions at the time of the call
+type StakingPoolReport struct {
+	models.StakingPool
+	Emissions *services.StakingEmissions `json:"emissions"`
+}
+
+func NewGetStakingPoolTool(stakingService services.StakingService) *getStakingPoolTool {
+	return &getStakingPoolTool{
+		stakingService: stakingService,
+	}
+}
+
+func (g *getStakingPoolTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_staking_pool",
+		mcp.WithDescription("Get a staking pool created by create_staking_pool: its status (pending until the session is signed, deployed, active once the reward period is funded), "+
+			"the farm address and the emissions of the reward period: reward rate per second, emitted and remaining reward and the elapsed share of the period."),
+		mcp.WithString("staking_pool_id",
+			mcp.Required(),
+			mcp.Description("ID of the staking pool returned by create_staking_pool"),
+		),
+	)
+
+	return tool
+}
+
+func (g *getStakingPoolTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetStakingPoolArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		poolID, err := strconv.ParseUint(args.StakingPoolID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid staking_pool_id format: %v", err)), nil
+		}
+
+		pool, err := g.stakingService.GetStakingPool(uint(poolID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Staking pool not found: %v", err)), nil
+		}
+
+		// Authenticated users can only see their own staking pools
+		if userID := utils.GetUserID(ctx); userID != "" && (pool.UserID == nil || *pool.UserID != userID) {
+			return mcp.NewToolResultError("Staking pool not found"), nil
+		}
+
+		emissions, err := g.stakingService.GetEmissions(pool, time.Now())
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to compute the emissions: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(StakingPoolReport{StakingPool: *pool, Emissions: emissions})
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Staking pool %d is %s: ", pool.ID, pool.Status)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
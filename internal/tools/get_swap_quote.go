@@ -28,6 +28,9 @@ func NewGetSwapQuoteTool(chainService services.ChainService, liquidityService se
 			mcp.Required(),
 			mcp.Description("Amount of tokens to swap"),
 		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description(dexDeploymentIDDescription),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -47,7 +50,7 @@ func NewGetSwapQuoteTool(chainService services.ChainService, liquidityService se
 		}
 
 		// Get active chain configuration
-		activeChain, err := chainService.GetActiveChain()
+		activeChain, err := chainService.ResolveChain(ctx)
 		if err != nil {
 			return &mcp.CallToolResult{
 				Content: []mcp.Content{
@@ -84,19 +87,10 @@ func NewGetSwapQuoteTool(chainService services.ChainService, liquidityService se
 			userId = &user.Sub
 		}
 
-		chain, err := chainService.GetActiveChain()
+		// The quote is read from the pool of the deployment the swap would be sent to
+		uniswapSettings, err := selectDexDeployment(uniswapService, userId, activeChain, request.GetString("dex_deployment_id", ""))
 		if err != nil {
-			return mcp.NewToolResultError("Unable to get active chain. Is there any chain selected?"), nil
-		}
-
-		uniswapSettings, err := uniswapService.GetActiveUniswapDeployment(userId, *chain)
-		if err != nil {
-			return &mcp.CallToolResult{
-				Content: []mcp.Content{
-					mcp.NewTextContent("Error: "),
-					mcp.NewTextContent("No Uniswap version selected. Please use set_uniswap_version tool first"),
-				},
-			}, nil
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		// Determine which token pool to check
@@ -112,7 +106,7 @@ func NewGetSwapQuoteTool(chainService services.ChainService, liquidityService se
 		}
 
 		// Get pool information
-		pool, err := liquidityService.GetLiquidityPoolByTokenAddress(poolToken, "")
+		pool, err := liquidityService.GetLiquidityPoolOnDexDeployment(poolToken, "", *uniswapSettings)
 		if err != nil {
 			return &mcp.CallToolResult{
 				Content: []mcp.Content{
@@ -154,6 +148,7 @@ func NewGetSwapQuoteTool(chainService services.ChainService, liquidityService se
 				"estimated_output":   estimatedOutput,
 				"price_impact":       priceImpact,
 				"uniswap_version":    uniswapSettings.Version,
+				"dex_deployment_id":  uniswapSettings.ID,
 			},
 			"pool_info": map[string]interface{}{
 				"pool_id":        pool.ID,
@@ -0,0 +1,108 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type getTemplateReportTool struct {
+	templateService services.TemplateService
+}
+
+type GetTemplateReportArguments struct {
+	// Optional fields
+	TemplateIDs string `json:"template_ids,omitempty"`
+	ChainType   string `json:"chain_type,omitempty" validate:"omitempty,oneof=ethereum solana"`
+}
+
+func NewGetTemplateReportTool(templateService services.TemplateService) *getTemplateReportTool {
+	return &getTemplateReportTool{
+		templateService: templateService,
+	}
+}
+
+func (g *getTemplateReportTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("get_template_report",
+		mcp.WithDescription("Compare the costs of templates with the static gas report computed by the compiler when the template was created or its code updated: the deployment gas estimate, the estimate of every external function and the compiler warnings. Templates are sorted by deployment gas, \"infinite\" estimates cannot be bounded by the compiler. Templates without a report were never compiled by create_template or update_template."),
+		mcp.WithString("template_ids",
+			mcp.Description("Comma-separated list of template IDs to compare (e.g., '1,2,3'). Optional, reports every template when omitted"),
+		),
+		mcp.WithString("chain_type",
+			mcp.Description("Only report templates of this chain type. Optional"),
+			mcp.Enum(string(models.TransactionChainTypeEthereum), string(models.TransactionChainTypeSolana)),
+		),
+	)
+
+	return tool
+}
+
+func (g *getTemplateReportTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args GetTemplateReportArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		var templates []models.Template
+		if strings.TrimSpace(args.TemplateIDs) == "" {
+			var userId *string
+			if user, _ := utils.GetAuthenticatedUser(ctx); user != nil {
+				userId = &user.Sub
+			}
+			list, err := g.templateService.ListTemplates(userId, args.ChainType, "", 0)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to list templates: %v", err)), nil
+			}
+			templates = list
+		} else {
+			for _, idStr := range strings.Split(args.TemplateIDs, ",") {
+				idStr = strings.TrimSpace(idStr)
+				if idStr == "" {
+					continue
+				}
+				id, err := strconv.ParseUint(idStr, 10, 32)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Invalid template ID %s, IDs must be positive integers", idStr)), nil
+				}
+				template, err := g.templateService.GetTemplateByID(uint(id))
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Template %d not found: %v", id, err)), nil
+				}
+				if args.ChainType != "" && string(template.ChainType) != args.ChainType {
+					continue
+				}
+				templates = append(templates, *template)
+			}
+		}
+
+		reports := utils.BuildTemplateReports(templates)
+		available := 0
+		for _, report := range reports {
+			if report.Available {
+				available++
+			}
+		}
+
+		resultJSON, _ := json.Marshal(reports)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Gas reports of %d templates (%d without a report): ", len(reports), len(reports)-available)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTemplateReport(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	templateService := services.NewTemplateService(db.GetDB())
+
+	reported := &models.Template{
+		Name:         "Reported",
+		ChainType:    models.TransactionChainTypeEthereum,
+		TemplateCode: "contract Token {}",
+		Report: &models.TemplateReport{
+			ContractName: "Token",
+			Creation:     map[string]string{"totalCost": "350000", "codeDepositCost": "300000"},
+			External:     map[string]string{"transfer(address,uint256)": "51234"},
+			Warnings:     []string{"Unused local variable."},
+		},
+	}
+	require.NoError(t, templateService.CreateTemplate(reported))
+	unreported := &models.Template{Name: "Imported", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Imported {}"}
+	require.NoError(t, templateService.CreateTemplate(unreported))
+
+	handler := NewGetTemplateReportTool(templateService).GetHandler()
+	result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Gas reports of 2 templates (1 without a report)")
+
+	var reports []utils.TemplateReportEntry
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &reports))
+	require.Len(t, reports, 2)
+	assert.Equal(t, reported.ID, reports[0].TemplateID)
+	assert.True(t, reports[0].Available)
+	assert.Equal(t, "350000", reports[0].DeploymentGas)
+	assert.Equal(t, []utils.TemplateFunctionGas{{Signature: "transfer(address,uint256)", Gas: "51234"}}, reports[0].Functions)
+	assert.Equal(t, []string{"Unused local variable."}, reports[0].Warnings)
+	assert.False(t, reports[1].Available)
+
+	result, err = handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"template_ids": "abc"}}})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+}
@@ -13,7 +13,7 @@ import (
 
 func NewGetUniswapAddressesTool(uniswapService services.UniswapService, chainService services.ChainService) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("get_uniswap_addresses",
-		mcp.WithDescription("Get current Uniswap configuration including version and contract addresses. Returns the active Uniswap settings from database."),
+		mcp.WithDescription("Get current Uniswap configuration including version and contract addresses. Returns the active Uniswap settings from database, and every DEX deployment of the chain when it has several (select one with dex_deployment_id)."),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -25,7 +25,7 @@ func NewGetUniswapAddressesTool(uniswapService services.UniswapService, chainSer
 		}
 
 		// get active chain
-		chain, err := chainService.GetActiveChain()
+		chain, err := chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("Unable to get active chain. Is there any chain selected?"), nil
 		}
@@ -42,12 +42,23 @@ func NewGetUniswapAddressesTool(uniswapService services.UniswapService, chainSer
 		}
 
 		resultJSON, _ := json.Marshal(settings)
-		return &mcp.CallToolResult{
+		result := &mcp.CallToolResult{
 			Content: []mcp.Content{
 				mcp.NewTextContent("Current Uniswap configuration: "),
 				mcp.NewTextContent(string(resultJSON)),
 			},
-		}, nil
+		}
+
+		// The tools use the default deployment unless they are given a dex_deployment_id
+		deployments, err := uniswapService.ListUniswapDeploymentsByChain(userId, chain.ID)
+		if err == nil && len(deployments) > 1 {
+			deploymentsJSON, _ := json.Marshal(deployments)
+			result.Content = append(result.Content,
+				mcp.NewTextContent("DEX deployments of this chain, the default one first: "),
+				mcp.NewTextContent(string(deploymentsJSON)),
+			)
+		}
+		return result, nil
 	}
 
 	return tool, handler
@@ -0,0 +1,14 @@
+package tools
+
+import "github.com/mark3labs/mcp-go/mcp"
+
+// IdempotencyKeyArgument is the argument of the tools creating sessions or records that makes their retries safe.
+// The MCP server replays the result of the first successful call with the same key instead of running the tool again
+const IdempotencyKeyArgument = "idempotency_key"
+
+// withIdempotencyKey declares the idempotency_key argument, handled by the MCP server before the tool handler runs
+func withIdempotencyKey() mcp.ToolOption {
+	return mcp.WithString(IdempotencyKeyArgument,
+		mcp.Description("Unique key of this request (e.g. a UUID). Retrying with the same key and arguments returns the original result instead of creating duplicate sessions or records, keys expire after 24 hours. Optional"),
+	)
+}
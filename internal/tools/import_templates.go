@@ -0,0 +1,237 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/contracts"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type importTemplatesTool struct {
+	templateService services.TemplateService
+}
+
+type ImportTemplatesArguments struct {
+	// One of the fields is required
+	Bundle    string `json:"bundle,omitempty" validate:"required_without_all=Directory Pack,excluded_with=Directory Pack"`
+	Directory string `json:"directory,omitempty" validate:"required_without_all=Bundle Pack,excluded_with=Pack"`
+	Pack      string `json:"pack,omitempty"`
+}
+
+type ImportTemplatesResult struct {
+	Imported []CreateTemplateResult `json:"imported"`
+}
+
+func NewImportTemplatesTool(templateService services.TemplateService) *importTemplatesTool {
+	return &importTemplatesTool{
+		templateService: templateService,
+	}
+}
+
+func (i *importTemplatesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("import_templates",
+		mcp.WithDescription("Import a template pack created by export_templates, either a JSON bundle or a directory with a templates.json manifest, or a template pack built into the server. Every template is validated before any is created, the imported templates get new IDs. The directory format reads the filesystem of the server and is only available to the local stdio server."),
+		mcp.WithString("bundle",
+			mcp.Description("JSON bundle returned by export_templates with the json format. Required without directory"),
+		),
+		mcp.WithString("directory",
+			mcp.Description("Directory written by export_templates with the directory format. Required without bundle"),
+		),
+		mcp.WithString("pack",
+			mcp.Description(fmt.Sprintf("Built-in template pack to import instead of a bundle or directory. %s: LayerZero OFT and Axelar ITS natively cross-chain tokens, wired with wire_cross_chain_token. "+
+				"%s: OpenZeppelin Governor and TimelockController, deployed with deploy_governance. %s: staking rewards farm, deployed and funded with create_staking_pool. "+
+				"%s: ERC721A and ERC1155 NFT collections, launched with launch_nft_collection. %s: token sold along a bonding curve migrating to Uniswap, launched with launch_bonding_curve. "+
+				"%s: token sold in a Dutch auction settled into a Uniswap pool, launched with launch_dutch_auction. %s: liquidity locker allow-listed by the launch policy with manage_launch_policy",
+				templates.PackCrossChain, templates.PackGovernance, templates.PackStaking, templates.PackNFT, templates.PackBondingCurve, templates.PackDutchAuction, templates.PackLiquidityLock)),
+			mcp.Enum(templates.PackNames()...),
+		),
+	)
+
+	return tool
+}
+
+func (i *importTemplatesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var userId *string
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		var args ImportTemplatesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if args.Directory != "" && user != nil {
+			return mcp.NewToolResultError("The directory format is only available to the local stdio server, use a json bundle instead"), nil
+		}
+
+		var bundle TemplateBundle
+		var err error
+		if args.Pack != "" {
+			bundle, err = readTemplatePack(args.Pack)
+		} else if args.Directory != "" {
+			bundle, err = readTemplateDirectory(args.Directory)
+		} else {
+			err = json.Unmarshal([]byte(args.Bundle), &bundle)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template bundle: %v", err)), nil
+		}
+
+		result, err := importTemplateBundle(i.templateService, bundle, userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to import templates: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Imported %d templates: ", len(result.Imported))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// importTemplateBundle validates every template of the bundle before creating them for the user
+func importTemplateBundle(templateService services.TemplateService, bundle TemplateBundle, userId *string) (ImportTemplatesResult, error) {
+	var result ImportTemplatesResult
+	if bundle.Version != TemplateBundleVersion {
+		return result, fmt.Errorf("unsupported template bundle version %d, expected %d", bundle.Version, TemplateBundleVersion)
+	}
+	if len(bundle.Templates) == 0 {
+		return result, fmt.Errorf("the template bundle has no templates")
+	}
+	for index, entry := range bundle.Templates {
+		if err := validateTemplateBundleEntry(entry); err != nil {
+			return result, fmt.Errorf("invalid template %d (%s): %w", index+1, entry.Name, err)
+		}
+	}
+
+	for _, entry := range bundle.Templates {
+		template := &models.Template{
+			Name:                 entry.Name,
+			Description:          entry.Description,
+			ChainType:            entry.ChainType,
+			TemplateCode:         entry.TemplateCode,
+			Files:                entry.Files,
+			OpenZeppelinVersion:  entry.OpenZeppelinVersion,
+			Metadata:             entry.Metadata,
+			SampleTemplateValues: entry.SampleTemplateValues,
+			Abi:                  entry.Abi,
+			Idl:                  entry.Idl,
+			UserId:               userId,
+		}
+		if err := templateService.CreateTemplate(template); err != nil {
+			return result, fmt.Errorf("error creating template %s after importing %d templates: %w", entry.Name, len(result.Imported), err)
+		}
+		result.Imported = append(result.Imported, CreateTemplateResult{
+			ID:                 template.ID,
+			Name:               template.Name,
+			Description:        template.Description,
+			ChainType:          template.ChainType,
+			TemplateParameters: len(template.Metadata),
+		})
+	}
+	return result, nil
+}
+
+// readTemplateDirectory reads the manifest of the directory and loads the code of every template from its file
+func readTemplateDirectory(directory string) (TemplateBundle, error) {
+	var bundle TemplateBundle
+	manifest, err := os.ReadFile(filepath.Join(directory, TemplateBundleManifest))
+	if err != nil {
+		return bundle, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	if err := json.Unmarshal(manifest, &bundle); err != nil {
+		return bundle, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	for index, entry := range bundle.Templates {
+		if entry.File == "" {
+			continue
+		}
+		// Files outside of the directory are rejected so a shared pack cannot read arbitrary files
+		if !filepath.IsLocal(entry.File) {
+			return bundle, fmt.Errorf("template file %s must be inside the directory", entry.File)
+		}
+		code, err := os.ReadFile(filepath.Join(directory, entry.File))
+		if err != nil {
+			return bundle, fmt.Errorf("failed to read template file: %w", err)
+		}
+		bundle.Templates[index].TemplateCode = string(code)
+	}
+	return bundle, nil
+}
+
+// readTemplatePack returns the templates of a built-in pack as a bundle
+func readTemplatePack(name string) (TemplateBundle, error) {
+	bundle := TemplateBundle{Version: TemplateBundleVersion}
+	pack, err := templates.GetPack(name)
+	if err != nil {
+		return bundle, err
+	}
+	for _, template := range pack.Templates {
+		bundle.Templates = append(bundle.Templates, TemplateBundleEntry{
+			Name:                 template.Name,
+			Description:          template.Description,
+			ChainType:            template.ChainType,
+			TemplateCode:         template.TemplateCode,
+			Files:                template.Files,
+			Metadata:             template.Metadata,
+			SampleTemplateValues: template.SampleTemplateValues,
+		})
+	}
+	return bundle, nil
+}
+
+// validateTemplateBundleEntry applies the checks of create_template that do not need a compiler
+func validateTemplateBundleEntry(entry TemplateBundleEntry) error {
+	if entry.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if entry.ChainType != models.TransactionChainTypeEthereum && entry.ChainType != models.TransactionChainTypeSolana {
+		return fmt.Errorf("invalid chain_type %q, supported values: ethereum, solana", entry.ChainType)
+	}
+	if entry.TemplateCode == "" {
+		return fmt.Errorf("template code is required")
+	}
+	for key, value := range entry.Metadata {
+		if key == "" {
+			return fmt.Errorf("metadata keys cannot be empty")
+		}
+		if str, ok := value.(string); !ok || str != "" {
+			return fmt.Errorf("metadata values must be empty strings for parameter definitions, got %v for key %s", value, key)
+		}
+	}
+	if err := utils.ValidateTemplateFiles(entry.Files); err != nil {
+		return err
+	}
+	if entry.OpenZeppelinVersion != "" {
+		if _, err := contracts.ResolveOpenZeppelinVersion(entry.OpenZeppelinVersion); err != nil {
+			return err
+		}
+	}
+	if len(entry.Metadata) > 0 {
+		if err := utils.ValidateTemplateFilesKeys(entry.TemplateCode, entry.Files, entry.Metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
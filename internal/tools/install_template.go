@@ -0,0 +1,175 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type installTemplateTool struct {
+	registryService services.TemplateRegistryService
+	templateService services.TemplateService
+}
+
+type InstallTemplateArguments struct {
+	// Required fields
+	Name string `json:"name" validate:"required"`
+
+	// Optional fields
+	Version string `json:"version,omitempty"`
+}
+
+type InstallTemplateResult struct {
+	Name             string                 `json:"name"`
+	Version          string                 `json:"version"`
+	AlreadyInstalled bool                   `json:"already_installed"`
+	Templates        []CreateTemplateResult `json:"templates"`
+}
+
+func NewInstallTemplateTool(registryService services.TemplateRegistryService, templateService services.TemplateService) *installTemplateTool {
+	return &installTemplateTool{
+		registryService: registryService,
+		templateService: templateService,
+	}
+}
+
+func (i *installTemplateTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("install_template",
+		mcp.WithDescription("Install a template of the subscribed remote template registry. The signed bundle is downloaded and its signature checked against the registry key before the templates are imported. Installed versions are kept locally, installing the same version again returns the existing templates."),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the registry template, as returned by browse_registry"),
+		),
+		mcp.WithString("version",
+			mcp.Description("Version to install. Optional, defaults to the last version listed by the registry"),
+		),
+	)
+
+	return tool
+}
+
+func (i *installTemplateTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var userId *string
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		var args InstallTemplateArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		// A pinned version that is already installed does not need the registry
+		if args.Version != "" {
+			if result, ok := i.installedResult(userId, args.Name, args.Version); ok {
+				return installTemplateToolResult(result)
+			}
+		}
+
+		index, err := i.registryService.FetchIndex(ctx)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to fetch the template registry: %v", err)), nil
+		}
+		var entry *services.RegistryEntry
+		for idx := range index.Templates {
+			candidate := index.Templates[idx]
+			if candidate.Name == args.Name && (args.Version == "" || candidate.Version == args.Version) {
+				entry = &candidate
+			}
+		}
+		if entry == nil {
+			if args.Version != "" {
+				return mcp.NewToolResultError(fmt.Sprintf("Template %s version %s not found in the registry", args.Name, args.Version)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Template %s not found in the registry", args.Name)), nil
+		}
+		if result, ok := i.installedResult(userId, entry.Name, entry.Version); ok {
+			return installTemplateToolResult(result)
+		}
+
+		bundleBytes, err := i.registryService.FetchBundle(ctx, *entry)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to download %s %s: %v", entry.Name, entry.Version, err)), nil
+		}
+		var bundle TemplateBundle
+		if err := json.Unmarshal(bundleBytes, &bundle); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template bundle: %v", err)), nil
+		}
+		imported, err := importTemplateBundle(i.templateService, bundle, userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to install %s %s: %v", entry.Name, entry.Version, err)), nil
+		}
+
+		digest := services.BundleDigest(bundleBytes)
+		installed := make([]models.InstalledTemplate, 0, len(imported.Imported))
+		for _, template := range imported.Imported {
+			installed = append(installed, models.InstalledTemplate{
+				UserID:       userId,
+				Name:         entry.Name,
+				Version:      entry.Version,
+				TemplateID:   template.ID,
+				BundleSHA256: digest,
+			})
+		}
+		if err := i.registryService.RecordInstalledTemplates(installed); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Installed the templates but failed to record the installed version: %v", err)), nil
+		}
+
+		return installTemplateToolResult(InstallTemplateResult{
+			Name:      entry.Name,
+			Version:   entry.Version,
+			Templates: imported.Imported,
+		})
+	}
+}
+
+// installedResult returns the local templates of an installed version. A version whose templates
+// were deleted since is installed again
+func (i *installTemplateTool) installedResult(userId *string, name, version string) (InstallTemplateResult, bool) {
+	result := InstallTemplateResult{Name: name, Version: version, AlreadyInstalled: true}
+	installed, err := i.registryService.GetInstalledVersion(userId, name, version)
+	if err != nil || len(installed) == 0 {
+		return result, false
+	}
+	for _, record := range installed {
+		template, err := i.templateService.GetTemplateByID(record.TemplateID)
+		if err != nil {
+			return result, false
+		}
+		result.Templates = append(result.Templates, CreateTemplateResult{
+			ID:                 template.ID,
+			Name:               template.Name,
+			Description:        template.Description,
+			ChainType:          template.ChainType,
+			TemplateParameters: len(template.Metadata),
+		})
+	}
+	return result, true
+}
+
+func installTemplateToolResult(result InstallTemplateResult) (*mcp.CallToolResult, error) {
+	message := fmt.Sprintf("Installed %s %s", result.Name, result.Version)
+	if result.AlreadyInstalled {
+		message = fmt.Sprintf("%s %s is already installed", result.Name, result.Version)
+	}
+	resultJSON, _ := json.Marshal(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(message + ": "),
+			mcp.NewTextContent(string(resultJSON)),
+		},
+	}, nil
+}
@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type registryFixture struct {
+	templateService services.TemplateService
+	registryService services.TemplateRegistryService
+	bundleRequests  *atomic.Int32
+}
+
+// setupRegistryTest serves a registry with two versions of a token template, the second one signed with another key
+func setupRegistryTest(t *testing.T) registryFixture {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	bundle := []byte(`{"version": 1, "templates": [{"name": "Registry Token", "description": "Token from the registry", "chain_type": "ethereum", "template_code": "contract {{.TokenName}} {}", "metadata": {"TokenName": ""}}]}`)
+	index := fmt.Sprintf(`{"templates": [
+		{"name": "token", "version": "1.0.0", "description": "Fixed supply token", "chain_type": "ethereum", "bundle_url": "bundles/token.json", "signature": %q},
+		{"name": "token", "version": "2.0.0", "description": "Fixed supply token", "chain_type": "ethereum", "bundle_url": "bundles/token.json", "signature": %q}
+	]}`, base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, services.RegistrySignedMessage("token", "1.0.0", bundle))), base64.StdEncoding.EncodeToString(ed25519.Sign(otherKey, services.RegistrySignedMessage("token", "2.0.0", bundle))))
+
+	bundleRequests := &atomic.Int32{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/index.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(index))
+	})
+	mux.HandleFunc("/bundles/token.json", func(w http.ResponseWriter, r *http.Request) {
+		bundleRequests.Add(1)
+		w.Write(bundle)
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	return registryFixture{
+		templateService: services.NewTemplateService(db.GetDB()),
+		registryService: services.NewTemplateRegistryService(db.GetDB(), server.Client(), server.URL+"/index.json", hex.EncodeToString(publicKey)),
+		bundleRequests:  bundleRequests,
+	}
+}
+
+func TestInstallTemplateFromRegistry(t *testing.T) {
+	fixture := setupRegistryTest(t)
+	ctx := context.Background()
+	handler := NewInstallTemplateTool(fixture.registryService, fixture.templateService).GetHandler()
+
+	result := callTemplatePackTool(t, ctx, handler, map[string]any{"name": "token", "version": "1.0.0"})
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	var installed InstallTemplateResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &installed))
+	assert.False(t, installed.AlreadyInstalled)
+	require.Len(t, installed.Templates, 1)
+
+	template, err := fixture.templateService.GetTemplateByID(installed.Templates[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Registry Token", template.Name)
+
+	// The installed version is reused without downloading the bundle again
+	result = callTemplatePackTool(t, ctx, handler, map[string]any{"name": "token", "version": "1.0.0"})
+	require.False(t, result.IsError)
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &installed))
+	assert.True(t, installed.AlreadyInstalled)
+	assert.Equal(t, int32(1), fixture.bundleRequests.Load())
+
+	browse := callTemplatePackTool(t, ctx, NewBrowseRegistryTool(fixture.registryService).GetHandler(), map[string]any{"keyword": "fixed"})
+	require.False(t, browse.IsError, browse.Content[0].(mcp.TextContent).Text)
+	var templates []RegistryTemplateInfo
+	require.NoError(t, json.Unmarshal([]byte(browse.Content[1].(mcp.TextContent).Text), &templates))
+	require.Len(t, templates, 2)
+	assert.Equal(t, []uint{template.ID}, templates[0].InstalledTemplateIDs)
+	assert.Empty(t, templates[1].InstalledTemplateIDs)
+}
+
+func TestInstallTemplateRejectsInvalidSignature(t *testing.T) {
+	fixture := setupRegistryTest(t)
+	handler := NewInstallTemplateTool(fixture.registryService, fixture.templateService).GetHandler()
+
+	// The latest version is signed with a key the registry does not trust
+	result := callTemplatePackTool(t, context.Background(), handler, map[string]any{"name": "token"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "does not match the registry key")
+
+	templates, err := fixture.templateService.ListTemplates(nil, "", "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, templates)
+
+	result = callTemplatePackTool(t, context.Background(), handler, map[string]any{"name": "missing"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "not found in the registry")
+}
@@ -16,7 +16,7 @@ import (
 type launchTool struct {
 	templateService   services.TemplateService
 	chainService      services.ChainService
-	evmService        services.EvmService
+	chainAdapters     services.ChainAdapters
 	txService         services.TransactionService
 	deploymentService services.DeploymentService
 	serverPort        int
@@ -32,13 +32,15 @@ type LaunchArguments struct {
 	Value           string                       `json:"value,omitempty"`
 	Metadata        []models.TransactionMetadata `json:"metadata,omitempty"`
 	ContractName    string                       `json:"contract_name,omitempty"`
+	DryRun          bool                         `json:"dry_run,omitempty"`
+	Gasless         bool                         `json:"gasless,omitempty"`
 }
 
 func NewLaunchTool(templateService services.TemplateService, chainService services.ChainService, serverPort int, evmService services.EvmService, txService services.TransactionService, deploymentService services.DeploymentService) *launchTool {
 	return &launchTool{
 		templateService:   templateService,
 		chainService:      chainService,
-		evmService:        evmService,
+		chainAdapters:     services.NewChainAdapters(evmService),
 		txService:         txService,
 		serverPort:        serverPort,
 		deploymentService: deploymentService,
@@ -88,6 +90,11 @@ func (l *launchTool) GetTool() mcp.Tool {
 				"required": []string{"title"},
 			}),
 		),
+		mcp.WithBoolean("gasless",
+			mcp.Description("Send the deployment as an ERC-4337 UserOperation of the smart account of the wallet through the bundler of the chain, sponsored by its paymaster if configured with set_chain. The contract is created through the deterministic CREATE2 deployer, so templates taking their owner from msg.sender should take it as a constructor argument. Optional, defaults to false"),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
 	)
 
 	return tool
@@ -114,9 +121,12 @@ func (l *launchTool) GetHandler() server.ToolHandlerFunc {
 		if err != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
 		}
+		if template.Disabled {
+			return mcp.NewToolResultError(fmt.Sprintf("Template %s is disabled by an admin and cannot be launched", template.Name)), nil
+		}
 
 		// Get active chain configuration
-		activeChain, err := l.chainService.GetActiveChain()
+		activeChain, err := l.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
@@ -131,106 +141,85 @@ func (l *launchTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Template values validation failed, make sure your template values matches %s", template.SampleTemplateValues)), nil
 		}
 
-		switch activeChain.ChainType {
-		case models.TransactionChainTypeEthereum:
-			// Render contract template
-			renderedContract, err := utils.RenderContractTemplate(template.TemplateCode, args.TemplateValues)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Failed to render contract template: %v", err)), nil
-			}
+		adapter, err := l.chainAdapters.ForChainType(activeChain.ChainType)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 
-			user, _ := utils.GetAuthenticatedUser(ctx)
-			var userId *string
-			if user != nil {
-				userId = &user.Sub
-			}
-			sessionID, err := l.createEvmContractDeploymentTransaction(activeChain, args.Metadata, renderedContract, args.ContractName, args.ConstructorArgs, args.Value, "Deploy Contract", "Deploy contract to the active chain", template.ID, args.TemplateValues, userId)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Failed to create contract deployment transaction: %v", err)), nil
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+		session, deployment, err := l.buildContractDeploymentTransaction(adapter, activeChain, template, args.Metadata, args.ContractName, args.ConstructorArgs, args.Value, "Deploy Contract", "Deploy contract to the active chain", args.TemplateValues, userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create contract deployment transaction: %v", err)), nil
+		}
+		if args.Gasless {
+			if err := applyGasless(activeChain, session.TransactionDeployments); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
 			}
+			session.Metadata = append(session.Metadata, models.TransactionMetadata{
+				Key:   gaslessMetadataKey,
+				Value: "enabled",
+			})
+		}
+		if args.DryRun {
+			return newDryRunResult("launch", []services.CreateTransactionSessionRequest{session}, DryRunRecord{Type: "deployment", Record: deployment})
+		}
 
-			// Generate transaction session URL
-			url, err := utils.GetTransactionSessionUrl(l.serverPort, sessionID)
-			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
-			}
-			return &mcp.CallToolResult{
-				Content: []mcp.Content{
-					mcp.NewTextContent(fmt.Sprintf("Transaction session created: %s", sessionID)),
-					mcp.NewTextContent("Please return the following url to the user: "),
-					mcp.NewTextContent(url),
-				},
-			}, nil
-		case models.TransactionChainTypeSolana:
-			// Solana not implemented yet
-			// Placeholder for future Solana implementation
-			sessionID := "solana-tx-session-placeholder"
-			// In real implementation, create a Solana transaction session similar to EVM above
-
-			return &mcp.CallToolResult{
-				Content: []mcp.Content{
-					mcp.NewTextContent(fmt.Sprintf("Transaction session created: %s", sessionID)),
-					mcp.NewTextContent("Please return the following url to the user: "),
-					mcp.NewTextContent(fmt.Sprintf("http://localhost:%d/tx/%s", l.serverPort, sessionID)),
-				},
-			}, nil
+		sessionID, err := l.saveContractDeploymentTransaction(ctx, session, deployment)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create contract deployment transaction: %v", err)), nil
 		}
 
-		return mcp.NewToolResultError("Solana is not implemented yet"), nil
+		// Generate transaction session URL
+		url, err := utils.GetTransactionSessionUrl(ctx, l.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Transaction session created: %s", sessionID)),
+				mcp.NewTextContent("Please return the following url to the user: "),
+				mcp.NewTextContent(url),
+			},
+		}, nil
 	}
 
 }
 
-// createEvmContractDeploymentTransaction creates a transaction deployment for a contract deployment to db
-// it will also compile the contract and return error if compilation fails
+// createContractDeploymentTransaction creates a transaction deployment for a contract deployment to db
+// the adapter of the chain renders and builds the template and returns error if it fails to compile
+// sessions sending value above the confirmation threshold are confirmed by the client of ctx first
 // metadata is the metadata of the transaction
-// renderedContract is the rendered contract code
 // contractName is the name of the contract
 // args is the constructor arguments
 // value is the value of the transaction that needs to be sent. 0 means no value is needed.
 // title is the title of the transaction
 // description is the description of the transaction
-func (l *launchTool) createEvmContractDeploymentTransaction(activeChain *models.Chain, metadata []models.TransactionMetadata, renderedContract string, contractName string, args []any, value string, title string, description string, templateId uint, templateValues models.JSON, userId *string) (string, error) {
-	tx, abiData, err := l.evmService.GetContractDeploymentTransactionWithContractCode(services.ContractDeploymentWithContractCodeTransactionArgs{
-		ContractCode:    renderedContract,
-		ContractName:    contractName,
-		ConstructorArgs: args,
-		Value:           value,
-		Title:           title,
-		Description:     description,
-		Receiver:        "", // Empty for contract deployment
-		TransactionType: models.TransactionTypeTokenDeployment,
-	})
+func (l *launchTool) createContractDeploymentTransaction(ctx context.Context, adapter services.ChainAdapter, activeChain *models.Chain, template *models.Template, metadata []models.TransactionMetadata, contractName string, args []any, value string, title string, description string, templateValues models.JSON, userId *string) (string, error) {
+	session, deployment, err := l.buildContractDeploymentTransaction(adapter, activeChain, template, metadata, contractName, args, value, title, description, templateValues, userId)
 	if err != nil {
-		return "", fmt.Errorf("failed to get contract deployment transaction: %w", err)
+		return "", err
 	}
+	return l.saveContractDeploymentTransaction(ctx, session, deployment)
+}
 
-	rawContractArgumentMap, err := utils.EncodeFunctionArgsToStringMap("constructor", args, abiData)
-	tx.ContractCode = &renderedContract
-	tx.RawContractArguments = &rawContractArgumentMap
-	tx.ShowBalanceAfterDeployment = true
-	sessionID, err := l.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
-		TransactionDeployments: []models.TransactionDeployment{tx},
-		ChainType:              models.TransactionChainTypeEthereum,
-		ChainID:                activeChain.ID,
-		Metadata:               metadata,
-	})
+// saveContractDeploymentTransaction confirms and creates the session built by buildContractDeploymentTransaction
+// and its pending deployment
+func (l *launchTool) saveContractDeploymentTransaction(ctx context.Context, session services.CreateTransactionSessionRequest, deployment *models.Deployment) (string, error) {
+	session.Context = ctx
+
+	sessionID, err := l.txService.CreateTransactionSession(session)
 
 	if err != nil {
 		return "", fmt.Errorf("failed to create transaction session: %w", err)
 	}
 
 	// create a deployment
-	err = l.deploymentService.CreateDeployment(
-		&models.Deployment{
-			ChainID:        activeChain.ID,
-			TemplateID:     templateId,
-			Status:         models.TransactionStatusPending,
-			TemplateValues: templateValues,
-			SessionId:      sessionID,
-			UserID:         userId,
-		},
-	)
+	deployment.SessionId = sessionID
+	err = l.deploymentService.CreateDeployment(deployment)
 
 	if err != nil {
 		return "", fmt.Errorf("failed to create deployment: %w", err)
@@ -239,3 +228,36 @@ func (l *launchTool) createEvmContractDeploymentTransaction(activeChain *models.
 	return sessionID, nil
 
 }
+
+// buildContractDeploymentTransaction builds the session and the pending deployment of createContractDeploymentTransaction without saving them,
+// the deployment has no session ID yet
+func (l *launchTool) buildContractDeploymentTransaction(adapter services.ChainAdapter, activeChain *models.Chain, template *models.Template, metadata []models.TransactionMetadata, contractName string, args []any, value string, title string, description string, templateValues models.JSON, userId *string) (services.CreateTransactionSessionRequest, *models.Deployment, error) {
+	tx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        template,
+		TemplateValues:  templateValues,
+		ContractName:    contractName,
+		ConstructorArgs: args,
+		Value:           value,
+		Title:           title,
+		Description:     description,
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, err
+	}
+
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{tx},
+		ChainType:              adapter.ChainType(),
+		ChainID:                activeChain.ID,
+		Metadata:               metadata,
+	}
+	deployment := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  templateValues,
+		ConstructorArgs: args,
+		UserID:          userId,
+	}
+	return session, deployment, nil
+}
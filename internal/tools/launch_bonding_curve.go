@@ -0,0 +1,290 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// defaultBondingCurveTotalSupply is 1 billion tokens of 18 decimals
+	defaultBondingCurveTotalSupply = "1000000000000000000000000000"
+	// defaultBondingCurveSharePercent is the share of the total supply sold along the curve, the rest goes to the pair
+	defaultBondingCurveSharePercent = 80
+	bondingCurveParameter           = "CurveName"
+)
+
+type launchBondingCurveTool struct {
+	chainService        services.ChainService
+	templateService     services.TemplateService
+	deploymentService   services.DeploymentService
+	uniswapService      services.UniswapService
+	bondingCurveService services.BondingCurveService
+	chainAdapters       services.ChainAdapters
+	txService           services.TransactionService
+	serverPort          int
+}
+
+type LaunchBondingCurveArguments struct {
+	// Required fields
+	TemplateID          string `json:"template_id" validate:"required"`
+	Name                string `json:"name" validate:"required"`
+	Symbol              string `json:"symbol" validate:"required"`
+	DeployerAddress     string `json:"deployer_address" validate:"required,eth_addr"`
+	GraduationThreshold string `json:"graduation_threshold" validate:"required,numeric"`
+
+	// Optional fields
+	TotalSupply string `json:"total_supply,omitempty" validate:"omitempty,numeric"`
+	CurveSupply string `json:"curve_supply,omitempty" validate:"omitempty,numeric"`
+	InitialBuy  string `json:"initial_buy,omitempty" validate:"omitempty,numeric"`
+	DryRun      bool   `json:"dry_run,omitempty"`
+}
+
+func NewLaunchBondingCurveTool(chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService, uniswapService services.UniswapService, bondingCurveService services.BondingCurveService, evmService services.EvmService, txService services.TransactionService, serverPort int) *launchBondingCurveTool {
+	return &launchBondingCurveTool{
+		chainService:        chainService,
+		templateService:     templateService,
+		deploymentService:   deploymentService,
+		uniswapService:      uniswapService,
+		bondingCurveService: bondingCurveService,
+		chainAdapters:       services.NewChainAdapters(evmService),
+		txService:           txService,
+		serverPort:          serverPort,
+	}
+}
+
+func (l *launchBondingCurveTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("launch_bonding_curve",
+		mcp.WithDescription("Launch a token sold along a bonding curve (pump.fun style) instead of seeding a pool. Buyers call buy and sell on the token contract along a constant product curve with virtual reserves, "+
+			"once the curve supply is sold out, when graduation_threshold wei have been raised, the ETH raised and the rest of the supply are added to the Uniswap V2 pair of the chain and the LP tokens are burned. "+
+			"The pool opens at the last price of the curve. The launchpad tracks the state of the curve, its progress is served at the progress url. "+
+			"Requires a Uniswap deployment on the active chain, import the template with import_templates pack=bonding-curve first."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("ID of the %q template imported from the bonding curve pack", templates.BondingCurveTemplateName)),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the token"),
+		),
+		mcp.WithString("symbol",
+			mcp.Required(),
+			mcp.Description("Symbol of the token"),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Required(),
+			mcp.Description("Address of the wallet signing the deployment, the creator of the token receiving the initial buy"),
+		),
+		mcp.WithString("graduation_threshold",
+			mcp.Required(),
+			mcp.Description("ETH in wei raised when the curve is sold out and the liquidity migrates to Uniswap (e.g., \"85000000000000000000\" for 85 ETH)"),
+		),
+		mcp.WithString("total_supply",
+			mcp.Description(fmt.Sprintf("Total supply in the smallest unit of the token (18 decimals). Optional, defaults to %s (1 billion tokens)", defaultBondingCurveTotalSupply)),
+		),
+		mcp.WithString("curve_supply",
+			mcp.Description(fmt.Sprintf("Part of the total supply sold along the curve, more than half of it. Optional, defaults to %d%% of the total supply", defaultBondingCurveSharePercent)),
+		),
+		mcp.WithString("initial_buy",
+			mcp.Description("ETH in wei the creator buys with in the deployment, below the graduation threshold. Optional"),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (l *launchBondingCurveTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args LaunchBondingCurveArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if args.TotalSupply == "" {
+			args.TotalSupply = defaultBondingCurveTotalSupply
+		}
+		if args.InitialBuy == "" {
+			args.InitialBuy = "0"
+		}
+		totalSupply, ok := new(big.Int).SetString(args.TotalSupply, 10)
+		if !ok || totalSupply.Sign() <= 0 {
+			return mcp.NewToolResultError("total_supply must be greater than 0"), nil
+		}
+		curveSupply := new(big.Int).Quo(new(big.Int).Mul(totalSupply, big.NewInt(defaultBondingCurveSharePercent)), big.NewInt(100))
+		if args.CurveSupply != "" {
+			if curveSupply, ok = new(big.Int).SetString(args.CurveSupply, 10); !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid curve_supply: %s", args.CurveSupply)), nil
+			}
+		}
+		threshold, ok := new(big.Int).SetString(args.GraduationThreshold, 10)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid graduation_threshold: %s", args.GraduationThreshold)), nil
+		}
+		virtualEth, virtualToken, err := utils.BondingCurveReserves(totalSupply, curveSupply, threshold)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		initialBuy, ok := new(big.Int).SetString(args.InitialBuy, 10)
+		if !ok || initialBuy.Sign() < 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid initial_buy: %s", args.InitialBuy)), nil
+		}
+		if initialBuy.Cmp(threshold) >= 0 {
+			return mcp.NewToolResultError("initial_buy must be below the graduation threshold, the curve cannot graduate in its deployment"), nil
+		}
+
+		activeChain, err := l.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Bonding curves are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		template, err := getPackTemplate(l.templateService, args.TemplateID, templates.PackBondingCurve, templates.BondingCurveTemplateName, bondingCurveParameter)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		uniswapDeployment, err := l.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+		if err != nil {
+			return mcp.NewToolResultError("No Uniswap deployment found for this chain. Please deploy Uniswap first using deploy_uniswap tool"), nil
+		}
+		if uniswapDeployment.RouterAddress == "" {
+			return mcp.NewToolResultError("Uniswap router address not found. Please ensure Uniswap deployment is completed"), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+		curve := &models.BondingCurve{
+			UserID:              userId,
+			ChainID:             activeChain.ID,
+			DeployerAddress:     args.DeployerAddress,
+			RouterAddress:       uniswapDeployment.RouterAddress,
+			TotalSupply:         totalSupply.String(),
+			CurveSupply:         curveSupply.String(),
+			GraduationThreshold: threshold.String(),
+			InitialBuy:          initialBuy.String(),
+			VirtualEthReserve:   virtualEth.String(),
+			VirtualTokenReserve: virtualToken.String(),
+			EthRaised:           "0",
+			TokensSold:          "0",
+		}
+
+		session, deployment, err := l.buildBondingCurveSession(activeChain, template, args, curve)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create bonding curve transaction: %v", err)), nil
+		}
+
+		if args.DryRun {
+			return newDryRunResult("launch_bonding_curve", []services.CreateTransactionSessionRequest{session},
+				DryRunRecord{Type: "deployment", Record: deployment},
+				DryRunRecord{Type: "bonding_curve", Record: curve},
+			)
+		}
+
+		session.Context = ctx
+		sessionID, err := l.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+		deployment.SessionId = sessionID
+		if err := l.deploymentService.CreateDeployment(deployment); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create deployment: %v", err)), nil
+		}
+		curve.DeploymentID = deployment.ID
+		curve.SessionId = sessionID
+		if err := l.bondingCurveService.CreateBondingCurve(curve); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create bonding curve: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, l.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+		progressUrl, err := utils.GetBondingCurveProgressUrl(ctx, l.serverPort, curve.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get bonding curve progress url: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(curve)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Bonding curve %d created with session %s: ", curve.ID, sessionID)),
+				mcp.NewTextContent(string(resultJSON)),
+				mcp.NewTextContent(fmt.Sprintf("The progress of the curve is served at %s once it is deployed. Please return the following url to the user: ", progressUrl)),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// buildBondingCurveSession builds the deployment of the token, paying the initial buy, with its pending deployment
+// without saving them
+func (l *launchBondingCurveTool) buildBondingCurveSession(activeChain *models.Chain, template *models.Template, args LaunchBondingCurveArguments, curve *models.BondingCurve) (services.CreateTransactionSessionRequest, *models.Deployment, error) {
+	adapter, err := l.chainAdapters.ForChainType(activeChain.ChainType)
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, err
+	}
+
+	contractName := nonIdentifierCharacters.ReplaceAllString(args.Name, "")
+	if contractName == "" || (contractName[0] >= '0' && contractName[0] <= '9') {
+		contractName = "Token" + contractName
+	}
+	templateValues := models.JSON{bondingCurveParameter: contractName, "TokenName": args.Name, "TokenSymbol": args.Symbol}
+	constructorArgs := []any{args.Name, args.Symbol, curve.TotalSupply, curve.CurveSupply, curve.GraduationThreshold, curve.RouterAddress, curve.DeployerAddress}
+	deployTx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        template,
+		TemplateValues:  templateValues,
+		ContractName:    contractName,
+		ConstructorArgs: constructorArgs,
+		Value:           curve.InitialBuy,
+		Title:           "Deploy Bonding Curve Token",
+		Description:     fmt.Sprintf("Deploy %s (%s) selling %s of %s tokens along a bonding curve until %s wei are raised", args.Name, args.Symbol, curve.CurveSupply, curve.TotalSupply, curve.GraduationThreshold),
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to build the token deployment: %w", err)
+	}
+	deployTx.TransactionType = models.TransactionTypeBondingCurveDeployment
+
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{deployTx},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "token_name", Value: args.Name},
+			{Key: "token_symbol", Value: args.Symbol},
+			{Key: "graduation_threshold", Value: curve.GraduationThreshold},
+			{Key: "router_address", Value: curve.RouterAddress},
+		},
+		UserID: curve.UserID,
+	}
+	deployment := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  templateValues,
+		ConstructorArgs: constructorArgs,
+		DeployerAddress: curve.DeployerAddress,
+		UserID:          curve.UserID,
+	}
+	return session, deployment, nil
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchBondingCurveValidation(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	templateService := services.NewTemplateService(db.GetDB())
+	require.NoError(t, chainService.CreateChain(&models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}))
+
+	pack, err := templates.GetPack(templates.PackBondingCurve)
+	require.NoError(t, err)
+	curveTemplate := pack.Templates[0]
+	require.NoError(t, templateService.CreateTemplate(&curveTemplate))
+	tokenTemplate := &models.Template{Name: "My Token", ChainType: models.TransactionChainTypeEthereum, Metadata: models.JSON{"TokenName": ""}}
+	require.NoError(t, templateService.CreateTemplate(tokenTemplate))
+
+	handler := NewLaunchBondingCurveTool(chainService, templateService, services.NewDeploymentService(db.GetDB()), services.NewUniswapService(db.GetDB()),
+		services.NewBondingCurveService(db.GetDB()), services.NewEvmService(), services.NewTransactionService(db.GetDB()), 8080).GetHandler()
+	call := func(args map[string]any) string {
+		arguments := map[string]any{
+			"template_id":          fmt.Sprint(curveTemplate.ID),
+			"name":                 "Launch Curve",
+			"symbol":               "CURVE",
+			"deployer_address":     "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"graduation_threshold": "10000000000000000000",
+		}
+		for key, value := range args {
+			arguments[key] = value
+		}
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	assert.Contains(t, call(map[string]any{"template_id": fmt.Sprint(tokenTemplate.ID)}), "import_templates pack=bonding-curve")
+	assert.Contains(t, call(map[string]any{"total_supply": "1000", "curve_supply": "500"}), "more than half")
+	assert.Contains(t, call(map[string]any{"graduation_threshold": "0"}), "graduation threshold must be greater than 0")
+	assert.Contains(t, call(map[string]any{"initial_buy": "10000000000000000000"}), "initial_buy must be below the graduation threshold")
+	assert.Contains(t, call(map[string]any{"initial_buy": "-1"}), "Invalid initial_buy")
+	assert.Contains(t, call(nil), "No Uniswap deployment found")
+}
@@ -0,0 +1,317 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// defaultDutchAuctionSharePercent is the share of the total supply sold in the auction, the rest seeds the pair
+	defaultDutchAuctionSharePercent = 50
+	// dutchAuctionSchedulePoints is the number of prices of the schedule preview returned by launch_dutch_auction
+	dutchAuctionSchedulePoints = 10
+	dutchAuctionParameter      = "AuctionName"
+)
+
+type launchDutchAuctionTool struct {
+	chainService        services.ChainService
+	templateService     services.TemplateService
+	deploymentService   services.DeploymentService
+	uniswapService      services.UniswapService
+	dutchAuctionService services.DutchAuctionService
+	chainAdapters       services.ChainAdapters
+	txService           services.TransactionService
+	serverPort          int
+}
+
+type LaunchDutchAuctionArguments struct {
+	// Required fields
+	TemplateID      string `json:"template_id" validate:"required"`
+	Name            string `json:"name" validate:"required"`
+	Symbol          string `json:"symbol" validate:"required"`
+	DeployerAddress string `json:"deployer_address" validate:"required,eth_addr"`
+	StartPrice      string `json:"start_price" validate:"required,numeric"`
+	EndPrice        string `json:"end_price" validate:"required,numeric"`
+	EndTime         string `json:"end_time" validate:"required"`
+
+	// Optional fields
+	StartTime     string `json:"start_time,omitempty"`
+	StepDuration  uint64 `json:"step_duration,omitempty"`
+	TotalSupply   string `json:"total_supply,omitempty" validate:"omitempty,numeric"`
+	AuctionSupply string `json:"auction_supply,omitempty" validate:"omitempty,numeric"`
+	DryRun        bool   `json:"dry_run,omitempty"`
+}
+
+func NewLaunchDutchAuctionTool(chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService, uniswapService services.UniswapService, dutchAuctionService services.DutchAuctionService, evmService services.EvmService, txService services.TransactionService, serverPort int) *launchDutchAuctionTool {
+	return &launchDutchAuctionTool{
+		chainService:        chainService,
+		templateService:     templateService,
+		deploymentService:   deploymentService,
+		uniswapService:      uniswapService,
+		dutchAuctionService: dutchAuctionService,
+		chainAdapters:       services.NewChainAdapters(evmService),
+		txService:           txService,
+		serverPort:          serverPort,
+	}
+}
+
+func (l *launchDutchAuctionTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("launch_dutch_auction",
+		mcp.WithDescription("Launch a token sold in a Dutch auction, a liquidity bootstrapping launch where the price starts high and decays until buyers step in. "+
+			"The price of one token decays linearly from start_price to end_price between start_time and end_time, continuously or every step_duration seconds, buyers call buy on the token and pay the current price. "+
+			"Once the auction supply is sold out or end_time is reached, settle_dutch_auction adds the ETH raised with tokens at the final price to the Uniswap V2 pair of the token, the LP tokens go to the deployer and the unsold tokens are burned. "+
+			"The launchpad tracks the state of the auction. Returns the price schedule. Requires a Uniswap deployment on the active chain, import the template with import_templates pack=dutch-auction first."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("ID of the %q template imported from the Dutch auction pack", templates.DutchAuctionTemplateName)),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the token"),
+		),
+		mcp.WithString("symbol",
+			mcp.Required(),
+			mcp.Description("Symbol of the token"),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Required(),
+			mcp.Description("Address of the wallet signing the deployment, the creator of the token receiving the LP tokens"),
+		),
+		mcp.WithString("start_price",
+			mcp.Required(),
+			mcp.Description("Price in wei of one token (10^18 units) at start_time (e.g., \"1000000000000000\" for 0.001 ETH)"),
+		),
+		mcp.WithString("end_price",
+			mcp.Required(),
+			mcp.Description("Price in wei of one token at end_time, below start_price and the price the pool opens at when the auction does not sell out"),
+		),
+		mcp.WithString("end_time",
+			mcp.Required(),
+			mcp.Description("RFC3339 time the auction ends (e.g. 2025-01-02T09:00:00Z)"),
+		),
+		mcp.WithString("start_time",
+			mcp.Description("RFC3339 time the auction starts. Optional, defaults to now"),
+		),
+		mcp.WithNumber("step_duration",
+			mcp.Description("Seconds between two price drops, shorter than the auction. Optional, defaults to 0 for a continuous decay"),
+		),
+		mcp.WithString("total_supply",
+			mcp.Description(fmt.Sprintf("Total supply in the smallest unit of the token (18 decimals). Optional, defaults to %s (1 billion tokens)", defaultBondingCurveTotalSupply)),
+		),
+		mcp.WithString("auction_supply",
+			mcp.Description(fmt.Sprintf("Part of the total supply sold in the auction, the rest is available for the pool. Optional, defaults to %d%% of the total supply", defaultDutchAuctionSharePercent)),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (l *launchDutchAuctionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args LaunchDutchAuctionArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if args.TotalSupply == "" {
+			args.TotalSupply = defaultBondingCurveTotalSupply
+		}
+		totalSupply, ok := new(big.Int).SetString(args.TotalSupply, 10)
+		if !ok || totalSupply.Sign() <= 0 {
+			return mcp.NewToolResultError("total_supply must be greater than 0"), nil
+		}
+		auctionSupply := new(big.Int).Quo(new(big.Int).Mul(totalSupply, big.NewInt(defaultDutchAuctionSharePercent)), big.NewInt(100))
+		if args.AuctionSupply != "" {
+			if auctionSupply, ok = new(big.Int).SetString(args.AuctionSupply, 10); !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid auction_supply: %s", args.AuctionSupply)), nil
+			}
+		}
+		if auctionSupply.Sign() <= 0 || auctionSupply.Cmp(totalSupply) >= 0 {
+			return mcp.NewToolResultError("auction_supply must be greater than 0 and less than the total supply"), nil
+		}
+
+		now := time.Now()
+		schedule := utils.DutchAuctionSchedule{StartTime: now.Truncate(time.Second), StepDuration: time.Duration(args.StepDuration) * time.Second}
+		if schedule.StartPrice, ok = new(big.Int).SetString(args.StartPrice, 10); !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid start_price: %s", args.StartPrice)), nil
+		}
+		if schedule.EndPrice, ok = new(big.Int).SetString(args.EndPrice, 10); !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid end_price: %s", args.EndPrice)), nil
+		}
+		var err error
+		if args.StartTime != "" {
+			if schedule.StartTime, err = time.Parse(time.RFC3339, args.StartTime); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid start_time format, expected RFC3339: %v", err)), nil
+			}
+		}
+		if schedule.EndTime, err = time.Parse(time.RFC3339, args.EndTime); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid end_time format, expected RFC3339: %v", err)), nil
+		}
+		if err := schedule.Validate(now); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		activeChain, err := l.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Dutch auctions are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		template, err := getPackTemplate(l.templateService, args.TemplateID, templates.PackDutchAuction, templates.DutchAuctionTemplateName, dutchAuctionParameter)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		uniswapDeployment, err := l.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+		if err != nil {
+			return mcp.NewToolResultError("No Uniswap deployment found for this chain. Please deploy Uniswap first using deploy_uniswap tool"), nil
+		}
+		if uniswapDeployment.RouterAddress == "" {
+			return mcp.NewToolResultError("Uniswap router address not found. Please ensure Uniswap deployment is completed"), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+		auction := &models.DutchAuction{
+			UserID:          userId,
+			ChainID:         activeChain.ID,
+			DeployerAddress: args.DeployerAddress,
+			RouterAddress:   uniswapDeployment.RouterAddress,
+			TotalSupply:     totalSupply.String(),
+			AuctionSupply:   auctionSupply.String(),
+			StartPrice:      schedule.StartPrice.String(),
+			EndPrice:        schedule.EndPrice.String(),
+			StartTime:       schedule.StartTime,
+			EndTime:         schedule.EndTime,
+			StepDuration:    int64(args.StepDuration),
+			CurrentPrice:    schedule.PriceAt(now).String(),
+			EthRaised:       "0",
+			TokensSold:      "0",
+		}
+
+		session, deployment, err := l.buildDutchAuctionSession(activeChain, template, args, auction)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create Dutch auction transaction: %v", err)), nil
+		}
+
+		if args.DryRun {
+			return newDryRunResult("launch_dutch_auction", []services.CreateTransactionSessionRequest{session},
+				DryRunRecord{Type: "deployment", Record: deployment},
+				DryRunRecord{Type: "dutch_auction", Record: auction},
+			)
+		}
+
+		session.Context = ctx
+		sessionID, err := l.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+		deployment.SessionId = sessionID
+		if err := l.deploymentService.CreateDeployment(deployment); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create deployment: %v", err)), nil
+		}
+		auction.DeploymentID = deployment.ID
+		auction.SessionId = sessionID
+		if err := l.dutchAuctionService.CreateDutchAuction(auction); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create Dutch auction: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, l.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(auction)
+		scheduleJSON, _ := json.Marshal(schedule.Points(dutchAuctionSchedulePoints))
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Dutch auction %d created with session %s: ", auction.ID, sessionID)),
+				mcp.NewTextContent(string(resultJSON)),
+				mcp.NewTextContent("Price schedule in wei per token:"),
+				mcp.NewTextContent(string(scheduleJSON)),
+				mcp.NewTextContent(fmt.Sprintf("Once the auction ended, call settle_dutch_auction with auction_id %d. Please return the following url to the user: ", auction.ID)),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// buildDutchAuctionSession builds the deployment of the token with its pending deployment without saving them
+func (l *launchDutchAuctionTool) buildDutchAuctionSession(activeChain *models.Chain, template *models.Template, args LaunchDutchAuctionArguments, auction *models.DutchAuction) (services.CreateTransactionSessionRequest, *models.Deployment, error) {
+	adapter, err := l.chainAdapters.ForChainType(activeChain.ChainType)
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, err
+	}
+
+	contractName := nonIdentifierCharacters.ReplaceAllString(args.Name, "")
+	if contractName == "" || (contractName[0] >= '0' && contractName[0] <= '9') {
+		contractName = "Token" + contractName
+	}
+	templateValues := models.JSON{dutchAuctionParameter: contractName, "TokenName": args.Name, "TokenSymbol": args.Symbol}
+	constructorArgs := []any{
+		args.Name, args.Symbol, auction.TotalSupply, auction.AuctionSupply, auction.StartPrice, auction.EndPrice,
+		strconv.FormatInt(auction.StartTime.Unix(), 10), strconv.FormatInt(auction.EndTime.Unix(), 10), strconv.FormatInt(auction.StepDuration, 10),
+		auction.RouterAddress, auction.DeployerAddress,
+	}
+	deployTx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        template,
+		TemplateValues:  templateValues,
+		ContractName:    contractName,
+		ConstructorArgs: constructorArgs,
+		Value:           "0",
+		Title:           "Deploy Dutch Auction Token",
+		Description:     fmt.Sprintf("Deploy %s (%s) selling %s of %s tokens from %s to %s wei per token until %s", args.Name, args.Symbol, auction.AuctionSupply, auction.TotalSupply, auction.StartPrice, auction.EndPrice, auction.EndTime.Format(time.RFC3339)),
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to build the token deployment: %w", err)
+	}
+	deployTx.TransactionType = models.TransactionTypeDutchAuctionDeployment
+
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{deployTx},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "token_name", Value: args.Name},
+			{Key: "token_symbol", Value: args.Symbol},
+			{Key: "start_time", Value: auction.StartTime.Format(time.RFC3339)},
+			{Key: "end_time", Value: auction.EndTime.Format(time.RFC3339)},
+			{Key: "router_address", Value: auction.RouterAddress},
+		},
+		UserID: auction.UserID,
+	}
+	deployment := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  templateValues,
+		ConstructorArgs: constructorArgs,
+		DeployerAddress: auction.DeployerAddress,
+		UserID:          auction.UserID,
+	}
+	return session, deployment, nil
+}
@@ -0,0 +1,128 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchDutchAuctionValidation(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	templateService := services.NewTemplateService(db.GetDB())
+	require.NoError(t, chainService.CreateChain(&models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}))
+
+	pack, err := templates.GetPack(templates.PackDutchAuction)
+	require.NoError(t, err)
+	auctionTemplate := pack.Templates[0]
+	require.NoError(t, templateService.CreateTemplate(&auctionTemplate))
+	tokenTemplate := &models.Template{Name: "My Token", ChainType: models.TransactionChainTypeEthereum, Metadata: models.JSON{"TokenName": ""}}
+	require.NoError(t, templateService.CreateTemplate(tokenTemplate))
+
+	handler := NewLaunchDutchAuctionTool(chainService, templateService, services.NewDeploymentService(db.GetDB()), services.NewUniswapService(db.GetDB()),
+		services.NewDutchAuctionService(db.GetDB()), services.NewEvmService(), services.NewTransactionService(db.GetDB()), 8080).GetHandler()
+	endTime := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	call := func(args map[string]any) string {
+		arguments := map[string]any{
+			"template_id":      fmt.Sprint(auctionTemplate.ID),
+			"name":             "Launch Auction",
+			"symbol":           "AUCT",
+			"deployer_address": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			"start_price":      "1000000000000000",
+			"end_price":        "100000000000000",
+			"end_time":         endTime,
+		}
+		for key, value := range args {
+			arguments[key] = value
+		}
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	assert.Contains(t, call(map[string]any{"template_id": fmt.Sprint(tokenTemplate.ID)}), "import_templates pack=dutch-auction")
+	assert.Contains(t, call(map[string]any{"total_supply": "1000", "auction_supply": "1000"}), "auction_supply must be greater than 0 and less than the total supply")
+	assert.Contains(t, call(map[string]any{"end_price": "1000000000000000"}), "start price must be above the end price")
+	assert.Contains(t, call(map[string]any{"end_price": "0"}), "end price must be greater than 0")
+	assert.Contains(t, call(map[string]any{"end_time": "tomorrow"}), "Invalid end_time format")
+	assert.Contains(t, call(map[string]any{"start_time": "2019-12-31T00:00:00Z", "end_time": "2020-01-01T00:00:00Z"}), "end time must be in the future")
+	assert.Contains(t, call(map[string]any{"step_duration": 7 * 24 * 3600}), "step duration must be shorter than the auction")
+	assert.Contains(t, call(nil), "No Uniswap deployment found")
+}
+
+func TestSettleDutchAuction(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	dutchAuctionService := services.NewDutchAuctionService(db.GetDB())
+	txService := services.NewTransactionService(db.GetDB())
+	handler := NewSettleDutchAuctionTool(chainService, dutchAuctionService, txService, 8080).GetHandler()
+
+	newAuction := func(endTime time.Time) *models.DutchAuction {
+		auction := &models.DutchAuction{
+			ChainID:         chain.ID,
+			DeployerAddress: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			RouterAddress:   "0x02",
+			TotalSupply:     "1000",
+			AuctionSupply:   "500",
+			StartPrice:      "1000",
+			EndPrice:        "100",
+			StartTime:       endTime.Add(-time.Hour),
+			EndTime:         endTime,
+			TokensSold:      "300",
+			EthRaised:       "30",
+		}
+		require.NoError(t, dutchAuctionService.CreateDutchAuction(auction))
+		return auction
+	}
+	call := func(auction *models.DutchAuction) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"auction_id": fmt.Sprint(auction.ID)}}})
+		require.NoError(t, err)
+		return result
+	}
+
+	running := newAuction(time.Now().Add(time.Hour))
+	result := call(running)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "is not deployed yet")
+
+	require.NoError(t, dutchAuctionService.MarkDutchAuctionDeployed(running.ID, "0x5FbDB2315678afecb367f032d93F642f64180aa3"))
+	result = call(running)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "is still running")
+
+	ended := newAuction(time.Now().Add(-time.Minute))
+	require.NoError(t, dutchAuctionService.MarkDutchAuctionDeployed(ended.ID, "0x5FbDB2315678afecb367f032d93F642f64180aa3"))
+	result = call(ended)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+
+	auction, err := dutchAuctionService.GetDutchAuction(ended.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, auction.SettleSessionId)
+	session, err := txService.GetTransactionSession(auction.SettleSessionId)
+	require.NoError(t, err)
+	require.Len(t, session.TransactionDeployments, 1)
+	assert.Equal(t, models.TransactionTypeDutchAuctionSettlement, session.TransactionDeployments[0].TransactionType)
+	assert.Equal(t, "0x5FbDB2315678afecb367f032d93F642f64180aa3", session.TransactionDeployments[0].Receiver)
+
+	require.NoError(t, dutchAuctionService.MarkDutchAuctionSettled(ended.ID, time.Now()))
+	result = call(ended)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "already settled")
+}
@@ -0,0 +1,419 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// maxNFTMintPhases bounds the addMintPhase calls of one launch session
+	maxNFTMintPhases = 20
+	// nftCollectionParameter and nftEditionParameter name the contract of the ERC721A and ERC1155 templates of the
+	// NFT pack and tell the two apart
+	nftCollectionParameter = "CollectionName"
+	nftEditionParameter    = "EditionName"
+)
+
+// nftMintPhaseAbi is the phase setup function shared by the templates of the NFT pack
+const nftMintPhaseAbi = `[{"inputs":[{"name":"startTime","type":"uint64"},{"name":"endTime","type":"uint64"},{"name":"price","type":"uint256"},{"name":"maxPerWallet","type":"uint32"},{"name":"merkleRoot","type":"bytes32"}],"name":"addMintPhase","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+type launchNFTCollectionTool struct {
+	chainService      services.ChainService
+	templateService   services.TemplateService
+	deploymentService services.DeploymentService
+	evmService        services.EvmService
+	chainAdapters     services.ChainAdapters
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type NFTMintPhaseArgument struct {
+	// Required fields
+	StartTime string `json:"start_time" validate:"required"`
+
+	// Optional fields
+	Name         string   `json:"name,omitempty"`
+	EndTime      string   `json:"end_time,omitempty"`
+	Price        string   `json:"price,omitempty" validate:"omitempty,numeric"`
+	MaxPerWallet uint32   `json:"max_per_wallet,omitempty"`
+	Allowlist    []string `json:"allowlist,omitempty" validate:"omitempty,dive,eth_addr"`
+}
+
+type LaunchNFTCollectionArguments struct {
+	// Required fields
+	TemplateID      string `json:"template_id" validate:"required"`
+	Name            string `json:"name" validate:"required"`
+	Symbol          string `json:"symbol" validate:"required"`
+	BaseURI         string `json:"base_uri" validate:"required"`
+	MaxSupply       string `json:"max_supply" validate:"required,numeric"`
+	DeployerAddress string `json:"deployer_address" validate:"required,eth_addr"`
+
+	// Optional fields
+	TokenCount string                 `json:"token_count,omitempty" validate:"omitempty,numeric"`
+	Phases     []NFTMintPhaseArgument `json:"phases,omitempty" validate:"omitempty,max=20,dive"`
+	DryRun     bool                   `json:"dry_run,omitempty"`
+}
+
+// NFTMintPhase is a mint phase as added to the collection, EndTime is nil for a phase without end and MerkleRoot is
+// the zero hash for a public phase
+type NFTMintPhase struct {
+	Name          string     `json:"name,omitempty"`
+	StartTime     time.Time  `json:"start_time"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	Price         string     `json:"price"`
+	MaxPerWallet  uint32     `json:"max_per_wallet"`
+	MerkleRoot    string     `json:"merkle_root"`
+	AllowlistSize int        `json:"allowlist_size"`
+}
+
+// NFTCollectionLaunch is the launched collection, ContractAddress is predicted from the nonce of the deployer
+type NFTCollectionLaunch struct {
+	DeploymentID    uint           `json:"deployment_id,omitempty"`
+	Standard        string         `json:"standard"`
+	ContractAddress string         `json:"contract_address"`
+	BaseURI         string         `json:"base_uri"`
+	MaxSupply       string         `json:"max_supply"`
+	TokenCount      string         `json:"token_count,omitempty"`
+	Phases          []NFTMintPhase `json:"phases"`
+}
+
+func NewLaunchNFTCollectionTool(chainService services.ChainService, templateService services.TemplateService, deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, serverPort int) *launchNFTCollectionTool {
+	return &launchNFTCollectionTool{
+		chainService:      chainService,
+		templateService:   templateService,
+		deploymentService: deploymentService,
+		evmService:        evmService,
+		chainAdapters:     services.NewChainAdapters(evmService),
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (l *launchNFTCollectionTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("launch_nft_collection",
+		mcp.WithDescription("Launch an NFT collection from the ERC721A or ERC1155 template of the NFT pack and configure its mint phases in the same transaction session. "+
+			"A phase sells tokens at its price from its start time until its end time, up to max_per_wallet tokens per wallet, to everyone or only to the addresses of its allowlist (verified with a Merkle proof). "+
+			"The collection address is predicted from the nonce of the deployer, every transaction is pinned to its nonce and the session must be signed by deployer_address without other transactions in between. "+
+			"Without phases the collection is deployed closed, the owner mints with ownerMint. Import the templates with import_templates pack=nft first."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("ID of the %q or %q template imported from the NFT pack", templates.ERC721ATemplateName, templates.ERC1155TemplateName)),
+		),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Name of the collection"),
+		),
+		mcp.WithString("symbol",
+			mcp.Required(),
+			mcp.Description("Symbol of the collection"),
+		),
+		mcp.WithString("base_uri",
+			mcp.Required(),
+			mcp.Description("Metadata location (ipfs://, ar:// or https://). ERC721A tokens read their metadata at the base URI followed by the token ID, "+
+				"ERC1155 URIs get {id}.json appended unless they contain the {id} substitution"),
+		),
+		mcp.WithString("max_supply",
+			mcp.Required(),
+			mcp.Description("Maximum number of tokens of an ERC721A collection, maximum number of copies of every token ID of an ERC1155 collection"),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Required(),
+			mcp.Description("Address of the wallet signing the session and owning the collection, it receives the mint proceeds with withdraw"),
+		),
+		mcp.WithString("token_count",
+			mcp.Description("Number of token IDs of an ERC1155 collection (0 to token_count - 1). Optional, defaults to 1, not used by ERC721A"),
+		),
+		mcp.WithArray("phases",
+			mcp.Description(fmt.Sprintf("Mint phases in start time order, the latest started open phase sells. At most %d. Optional", maxNFTMintPhases)),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "Label of the phase (e.g., 'allowlist', 'public')",
+					},
+					"start_time": map[string]any{
+						"type":        "string",
+						"description": "Start of the phase in RFC3339 (e.g., '2025-01-01T16:00:00Z')",
+					},
+					"end_time": map[string]any{
+						"type":        "string",
+						"description": "End of the phase in RFC3339. Optional, the phase stays open without it",
+					},
+					"price": map[string]any{
+						"type":        "string",
+						"description": "Price of a token in wei. Optional, defaults to \"0\" for a free mint",
+					},
+					"max_per_wallet": map[string]any{
+						"type":        "number",
+						"description": "Tokens a wallet can mint in the phase. Optional, 0 for no limit",
+					},
+					"allowlist": map[string]any{
+						"type":        "array",
+						"description": "Addresses allowed to mint in the phase. Optional, everyone can mint without it",
+						"items":       map[string]any{"type": "string"},
+					},
+				},
+				"required": []string{"start_time"},
+			}),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (l *launchNFTCollectionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args LaunchNFTCollectionArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := l.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("NFT collections are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		// The ERC721A and ERC1155 templates are told apart by their contract name parameter
+		template, err := getPackTemplate(l.templateService, args.TemplateID, templates.PackNFT, templates.ERC721ATemplateName, nftCollectionParameter)
+		editions := false
+		if err != nil {
+			editionsTemplate, editionsErr := getPackTemplate(l.templateService, args.TemplateID, templates.PackNFT, templates.ERC1155TemplateName, nftEditionParameter)
+			if editionsErr != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			template, editions = editionsTemplate, true
+		}
+
+		collection := NFTCollectionLaunch{Standard: "erc721a", MaxSupply: args.MaxSupply}
+		if editions {
+			collection.Standard = "erc1155"
+			collection.TokenCount = args.TokenCount
+			if collection.TokenCount == "" {
+				collection.TokenCount = "1"
+			}
+		} else if args.TokenCount != "" {
+			return mcp.NewToolResultError("token_count is only used by ERC1155 collections"), nil
+		}
+		if maxSupply, ok := new(big.Int).SetString(args.MaxSupply, 10); !ok || maxSupply.Sign() <= 0 {
+			return mcp.NewToolResultError("max_supply must be greater than 0"), nil
+		}
+		if tokenCount, ok := new(big.Int).SetString(collection.TokenCount, 10); editions && (!ok || tokenCount.Sign() <= 0) {
+			return mcp.NewToolResultError("token_count must be greater than 0"), nil
+		}
+
+		collection.BaseURI, err = utils.NormalizeNFTBaseURI(args.BaseURI, editions)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		collection.Phases, err = buildNFTMintPhases(args.Phases)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		nonce, err := pendingNonce(activeChain.RPC, args.DeployerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		collection.ContractAddress = utils.PredictContractAddress(args.DeployerAddress, nonce)
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		session, deployment, err := l.buildCollectionSession(activeChain, template, args, collection, editions, nonce, userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create NFT collection transactions: %v", err)), nil
+		}
+
+		if args.DryRun {
+			return newDryRunResult("launch_nft_collection", []services.CreateTransactionSessionRequest{session},
+				DryRunRecord{Type: "deployment", Record: deployment},
+				DryRunRecord{Type: "nft_collection", Record: collection},
+			)
+		}
+
+		session.Context = ctx
+		sessionID, err := l.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+		deployment.SessionId = sessionID
+		if err := l.deploymentService.CreateDeployment(deployment); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create deployment: %v", err)), nil
+		}
+		collection.DeploymentID = deployment.ID
+
+		url, err := utils.GetTransactionSessionUrl(ctx, l.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(collection)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("NFT collection deployment %d created with session %s: ", deployment.ID, sessionID)),
+				mcp.NewTextContent(string(resultJSON)),
+				mcp.NewTextContent(fmt.Sprintf("The session must be signed by %s starting at nonce %d. Please return the following url to the user: ", args.DeployerAddress, nonce)),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// buildNFTMintPhases checks the phases and computes the Merkle roots of their allowlists
+func buildNFTMintPhases(arguments []NFTMintPhaseArgument) ([]NFTMintPhase, error) {
+	phases := make([]NFTMintPhase, 0, len(arguments))
+	for i, argument := range arguments {
+		startTime, err := time.Parse(time.RFC3339, argument.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("Phase %d: invalid start_time %q, expected RFC3339", i, argument.StartTime)
+		}
+		phase := NFTMintPhase{Name: argument.Name, StartTime: startTime.UTC(), Price: argument.Price, MaxPerWallet: argument.MaxPerWallet, MerkleRoot: common.Hash{}.Hex()}
+		if phase.Price == "" {
+			phase.Price = "0"
+		}
+		if argument.EndTime != "" {
+			endTime, err := time.Parse(time.RFC3339, argument.EndTime)
+			if err != nil {
+				return nil, fmt.Errorf("Phase %d: invalid end_time %q, expected RFC3339", i, argument.EndTime)
+			}
+			if !endTime.After(startTime) {
+				return nil, fmt.Errorf("Phase %d: end_time must be after start_time", i)
+			}
+			endTime = endTime.UTC()
+			phase.EndTime = &endTime
+		}
+		if i > 0 && startTime.Before(phases[i-1].StartTime) {
+			return nil, fmt.Errorf("Phase %d: phases must be given in start time order", i)
+		}
+		if len(argument.Allowlist) > 0 {
+			root, err := utils.AllowlistMerkleRoot(argument.Allowlist)
+			if err != nil {
+				return nil, fmt.Errorf("Phase %d: %v", i, err)
+			}
+			phase.MerkleRoot = root.Hex()
+			phase.AllowlistSize = len(argument.Allowlist)
+		}
+		phases = append(phases, phase)
+	}
+	return phases, nil
+}
+
+// buildCollectionSession builds the collection deployment and the addMintPhase calls targeting the predicted
+// address, with the pending deployment of the collection without saving them
+func (l *launchNFTCollectionTool) buildCollectionSession(activeChain *models.Chain, template *models.Template, args LaunchNFTCollectionArguments, collection NFTCollectionLaunch, editions bool, nonce uint64, userId *string) (services.CreateTransactionSessionRequest, *models.Deployment, error) {
+	adapter, err := l.chainAdapters.ForChainType(activeChain.ChainType)
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, err
+	}
+
+	parameter := nftCollectionParameter
+	constructorArgs := []any{args.Name, args.Symbol, collection.BaseURI, args.MaxSupply, args.DeployerAddress}
+	if editions {
+		parameter = nftEditionParameter
+		constructorArgs = []any{args.Name, args.Symbol, collection.BaseURI, collection.TokenCount, args.MaxSupply, args.DeployerAddress}
+	}
+	contractName := nonIdentifierCharacters.ReplaceAllString(args.Name, "")
+	if contractName == "" || (contractName[0] >= '0' && contractName[0] <= '9') {
+		contractName = "Collection" + contractName
+	}
+	templateValues := models.JSON{parameter: contractName}
+
+	deployTx, err := adapter.BuildDeployTx(services.BuildDeployTxArgs{
+		Template:        template,
+		TemplateValues:  templateValues,
+		ContractName:    contractName,
+		ConstructorArgs: constructorArgs,
+		Value:           "0",
+		Title:           "Deploy NFT Collection",
+		Description:     fmt.Sprintf("Deploy the %s collection %s (%s) at %s", collection.Standard, args.Name, args.Symbol, collection.ContractAddress),
+	})
+	if err != nil {
+		return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to build the collection deployment: %w", err)
+	}
+	deployTx.TransactionType = models.TransactionTypeNFTCollectionDeployment
+	transactions := []models.TransactionDeployment{deployTx}
+
+	for i, phase := range collection.Phases {
+		endTime := "0"
+		if phase.EndTime != nil {
+			endTime = strconv.FormatInt(phase.EndTime.Unix(), 10)
+		}
+		functionArgs := []any{strconv.FormatInt(phase.StartTime.Unix(), 10), endTime, phase.Price, strconv.FormatUint(uint64(phase.MaxPerWallet), 10), phase.MerkleRoot}
+		label := phase.Name
+		if label == "" {
+			label = strconv.Itoa(i)
+		}
+		tx, err := l.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: collection.ContractAddress,
+			FunctionName:    "addMintPhase",
+			FunctionArgs:    functionArgs,
+			Abi:             nftMintPhaseAbi,
+			Value:           "0",
+			Title:           fmt.Sprintf("Add Mint Phase %s", label),
+			Description:     fmt.Sprintf("Open mint phase %s at %s for %s wei per token", label, phase.StartTime.Format(time.RFC3339), phase.Price),
+			TransactionType: models.TransactionTypeRegular,
+		})
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to create addMintPhase transaction: %w", err)
+		}
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI("addMintPhase", functionArgs, nftMintPhaseAbi)
+		if err != nil {
+			return services.CreateTransactionSessionRequest{}, nil, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		tx.RawContractArguments = &functionArgsString
+		contractAddress := collection.ContractAddress
+		tx.ContractAddress = &contractAddress
+		transactions = append(transactions, tx)
+	}
+	pinNonces(transactions, nonce)
+
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: transactions,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "nft_standard", Value: collection.Standard},
+			{Key: "collection_address", Value: collection.ContractAddress},
+			{Key: "base_uri", Value: collection.BaseURI},
+			{Key: "mint_phases", Value: strconv.Itoa(len(collection.Phases))},
+		},
+		UserID: userId,
+	}
+	deployment := &models.Deployment{
+		ChainID:         activeChain.ID,
+		TemplateID:      template.ID,
+		Status:          models.TransactionStatusPending,
+		TemplateValues:  templateValues,
+		ConstructorArgs: constructorArgs,
+		DeployerAddress: args.DeployerAddress,
+		UserID:          userId,
+	}
+	return session, deployment, nil
+}
@@ -0,0 +1,86 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchNFTCollectionValidation(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	templateService := services.NewTemplateService(db.GetDB())
+	require.NoError(t, chainService.CreateChain(&models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}))
+
+	pack, err := templates.GetPack(templates.PackNFT)
+	require.NoError(t, err)
+	collectionTemplate, editionsTemplate := pack.Templates[0], pack.Templates[1]
+	require.NoError(t, templateService.CreateTemplate(&collectionTemplate))
+	require.NoError(t, templateService.CreateTemplate(&editionsTemplate))
+	tokenTemplate := &models.Template{Name: "My Token", ChainType: models.TransactionChainTypeEthereum, Metadata: models.JSON{"TokenName": ""}}
+	require.NoError(t, templateService.CreateTemplate(tokenTemplate))
+
+	handler := NewLaunchNFTCollectionTool(chainService, templateService, services.NewDeploymentService(db.GetDB()), services.NewEvmService(), services.NewTransactionService(db.GetDB()), 8080).GetHandler()
+	call := func(args map[string]any) string {
+		arguments := map[string]any{
+			"template_id":      fmt.Sprint(collectionTemplate.ID),
+			"name":             "Launch Apes",
+			"symbol":           "APE",
+			"base_uri":         "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+			"max_supply":       "10000",
+			"deployer_address": "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		}
+		for key, value := range args {
+			arguments[key] = value
+		}
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	assert.Contains(t, call(map[string]any{"template_id": fmt.Sprint(tokenTemplate.ID)}), "import_templates pack=nft")
+	assert.Contains(t, call(map[string]any{"max_supply": "0"}), "max_supply must be greater than 0")
+	assert.Contains(t, call(map[string]any{"token_count": "3"}), "only used by ERC1155")
+	assert.Contains(t, call(map[string]any{"template_id": fmt.Sprint(editionsTemplate.ID), "token_count": "0"}), "token_count must be greater than 0")
+	assert.Contains(t, call(map[string]any{"base_uri": "/metadata/"}), "invalid base URI")
+	assert.Contains(t, call(map[string]any{"phases": []any{map[string]any{"start_time": "tomorrow"}}}), "invalid start_time")
+	assert.Contains(t, call(map[string]any{"phases": []any{
+		map[string]any{"start_time": "2025-01-02T00:00:00Z"},
+		map[string]any{"start_time": "2025-01-01T00:00:00Z"},
+	}}), "start time order")
+	assert.Contains(t, call(map[string]any{"phases": []any{map[string]any{"start_time": "2025-01-01T00:00:00Z", "allowlist": []any{"0x123"}}}}), "Invalid arguments")
+}
+
+func TestBuildNFTMintPhases(t *testing.T) {
+	phases, err := buildNFTMintPhases([]NFTMintPhaseArgument{
+		{Name: "allowlist", StartTime: "2025-01-01T16:00:00+02:00", EndTime: "2025-01-02T14:00:00Z", Price: "10000000000000000", MaxPerWallet: 2,
+			Allowlist: []string{"0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"}},
+		{Name: "public", StartTime: "2025-01-02T14:00:00Z"},
+	})
+	require.NoError(t, err)
+	require.Len(t, phases, 2)
+
+	assert.Equal(t, "2025-01-01T14:00:00Z", phases[0].StartTime.Format("2006-01-02T15:04:05Z07:00"))
+	require.NotNil(t, phases[0].EndTime)
+	assert.Equal(t, 2, phases[0].AllowlistSize)
+	assert.NotEqual(t, "0x0000000000000000000000000000000000000000000000000000000000000000", phases[0].MerkleRoot)
+
+	// A public phase is free and open ended by default
+	assert.Equal(t, "0", phases[1].Price)
+	assert.Nil(t, phases[1].EndTime)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000000", phases[1].MerkleRoot)
+
+	_, err = buildNFTMintPhases([]NFTMintPhaseArgument{{StartTime: "2025-01-02T00:00:00Z", EndTime: "2025-01-01T00:00:00Z"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,338 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	// defaultDeploymentGas is assumed when the template has no bounded deployment estimate
+	defaultDeploymentGas = 3_000_000
+	// deploymentGasMarginPercent is added to the deployment gas of the balance check
+	deploymentGasMarginPercent = 20
+)
+
+type LaunchReadinessStatus string
+
+const (
+	LaunchReadinessPass LaunchReadinessStatus = "pass"
+	LaunchReadinessWarn LaunchReadinessStatus = "warn"
+	LaunchReadinessFail LaunchReadinessStatus = "fail"
+)
+
+type launchReadinessTool struct {
+	templateService services.TemplateService
+	chainService    services.ChainService
+	uniswapService  services.UniswapService
+}
+
+type LaunchReadinessArguments struct {
+	// Required fields
+	TemplateID string `json:"template_id" validate:"required"`
+
+	// Optional fields
+	TemplateValues  map[string]any `json:"template_values,omitempty"`
+	DeployerAddress string         `json:"deployer_address,omitempty" validate:"omitempty,eth_addr"`
+}
+
+type LaunchReadinessCheck struct {
+	Name   string                `json:"name"`
+	Status LaunchReadinessStatus `json:"status"`
+	Detail string                `json:"detail"`
+}
+
+// LaunchReadinessReport fails when any check fails and warns when any check warns
+type LaunchReadinessReport struct {
+	Status     LaunchReadinessStatus  `json:"status"`
+	TemplateID uint                   `json:"template_id"`
+	ChainID    string                 `json:"chain_id"`
+	Checks     []LaunchReadinessCheck `json:"checks"`
+}
+
+func NewLaunchReadinessTool(templateService services.TemplateService, chainService services.ChainService, uniswapService services.UniswapService) *launchReadinessTool {
+	return &launchReadinessTool{
+		templateService: templateService,
+		chainService:    chainService,
+		uniswapService:  uniswapService,
+	}
+}
+
+func (l *launchReadinessTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("launch_readiness",
+		mcp.WithDescription("Check whether a template is ready to be launched on the active chain before calling launch. Returns a pass/warn/fail report of the checks: "+
+			"template (compiled with a gas report and without compiler warnings), chain (RPC reachable and serving the configured chain ID), uniswap (confirmed Uniswap deployment for the liquidity steps), "+
+			"deployer_balance (the deployer can pay the deployment gas at the current gas price) and token_metadata (every template parameter has a value). Fix the failed checks before launching."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template to launch"),
+		),
+		mcp.WithObject("template_values",
+			mcp.Description("JSON object with the template values of the launch. Optional, the sample values of the template are checked without it"),
+		),
+		mcp.WithString("deployer_address",
+			mcp.Description("Address of the wallet signing the deployment. Optional, the balance check warns without it"),
+		),
+	)
+
+	return tool
+}
+
+func (l *launchReadinessTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var userId *string
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		var args LaunchReadinessArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		templateID, err := strconv.ParseUint(args.TemplateID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+		template, err := l.templateService.GetTemplateByID(uint(templateID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
+		}
+
+		activeChain, err := l.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError("Launch readiness is only supported on Ethereum-compatible chains"), nil
+		}
+
+		chainCheck, rpcClient := checkChainReachable(activeChain)
+		checks := []LaunchReadinessCheck{
+			checkTemplateScanned(template, activeChain),
+			chainCheck,
+			l.checkUniswapDeployment(userId, activeChain),
+			checkDeployerBalance(template, rpcClient, args.DeployerAddress),
+			checkTokenMetadata(template, args.TemplateValues),
+		}
+
+		report := LaunchReadinessReport{
+			Status:     LaunchReadinessPass,
+			TemplateID: template.ID,
+			ChainID:    activeChain.NetworkID,
+			Checks:     checks,
+		}
+		for _, check := range checks {
+			if check.Status == LaunchReadinessFail {
+				report.Status = LaunchReadinessFail
+			} else if check.Status == LaunchReadinessWarn && report.Status == LaunchReadinessPass {
+				report.Status = LaunchReadinessWarn
+			}
+		}
+
+		message := "Template is ready to launch"
+		switch report.Status {
+		case LaunchReadinessWarn:
+			message = "Template can be launched, review the warnings first"
+		case LaunchReadinessFail:
+			message = "Template is not ready to launch, fix the failed checks first"
+		}
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(message + ": "),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// checkTemplateScanned checks the chain type and the compiler report stored by create_template and update_template
+func checkTemplateScanned(template *models.Template, chain *models.Chain) LaunchReadinessCheck {
+	check := LaunchReadinessCheck{Name: "template"}
+	switch {
+	case template.ChainType != chain.ChainType:
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("Template chain type (%s) doesn't match active chain (%s)", template.ChainType, chain.ChainType)
+	case template.Report == nil:
+		check.Status = LaunchReadinessWarn
+		check.Detail = "The template has no compiler report, update the template code to compile it again"
+	case len(template.Report.Warnings) > 0:
+		check.Status = LaunchReadinessWarn
+		check.Detail = fmt.Sprintf("The compiler reported %d warnings, see get_template_report: %s", len(template.Report.Warnings), strings.Join(template.Report.Warnings, "; "))
+	default:
+		check.Status = LaunchReadinessPass
+		check.Detail = fmt.Sprintf("Contract %s compiled with solc %s without warnings", template.Report.ContractName, template.Report.CompilerVersion)
+	}
+	return check
+}
+
+// checkChainReachable returns the RPC client of the chain when it serves the configured chain ID
+func checkChainReachable(chain *models.Chain) (LaunchReadinessCheck, *utils.RPCClient) {
+	check := LaunchReadinessCheck{Name: "chain"}
+	client := utils.NewRPCClient(chain.RPC)
+	chainID, err := rpcQuantity(client, "eth_chainId")
+	if err != nil {
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("RPC of %s is not reachable: %v", chain.Name, err)
+		return check, nil
+	}
+	if chain.NetworkID != "" && chainID.String() != chain.NetworkID {
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("RPC of %s serves chain ID %s, the chain is configured with %s", chain.Name, chainID, chain.NetworkID)
+		return check, nil
+	}
+	check.Status = LaunchReadinessPass
+	check.Detail = fmt.Sprintf("RPC of %s is reachable on chain ID %s", chain.Name, chainID)
+	return check, client
+}
+
+func (l *launchReadinessTool) checkUniswapDeployment(userId *string, chain *models.Chain) LaunchReadinessCheck {
+	check := LaunchReadinessCheck{Name: "uniswap"}
+	deployment, err := l.uniswapService.GetActiveUniswapDeployment(userId, *chain)
+	switch {
+	case err != nil:
+		check.Status = LaunchReadinessWarn
+		check.Detail = "No Uniswap deployment on the active chain, deploy_uniswap or set_uniswap_addresses is needed before creating the liquidity pool"
+	case deployment.Status != models.TransactionStatusConfirmed:
+		check.Status = LaunchReadinessWarn
+		check.Detail = fmt.Sprintf("Uniswap deployment %d is %s, confirm it before creating the liquidity pool", deployment.ID, deployment.Status)
+	default:
+		check.Status = LaunchReadinessPass
+		check.Detail = fmt.Sprintf("Uniswap %s deployment %d is confirmed (router %s)", deployment.Version, deployment.ID, deployment.RouterAddress)
+	}
+	return check
+}
+
+// checkDeployerBalance compares the balance of the deployer with the deployment gas of the template report at the current gas price
+func checkDeployerBalance(template *models.Template, client *utils.RPCClient, deployerAddress string) LaunchReadinessCheck {
+	check := LaunchReadinessCheck{Name: "deployer_balance"}
+	if deployerAddress == "" {
+		check.Status = LaunchReadinessWarn
+		check.Detail = "No deployer_address given, the balance of the deployer was not checked"
+		return check
+	}
+	if client == nil {
+		check.Status = LaunchReadinessFail
+		check.Detail = "The balance cannot be checked while the chain is not reachable"
+		return check
+	}
+
+	gas := big.NewInt(defaultDeploymentGas)
+	estimated := false
+	if template.Report != nil {
+		if total, ok := new(big.Int).SetString(template.Report.Creation["totalCost"], 10); ok {
+			gas, estimated = total, true
+		}
+	}
+	gas.Mul(gas, big.NewInt(100+deploymentGasMarginPercent))
+	gas.Div(gas, big.NewInt(100))
+
+	gasPrice, err := rpcQuantity(client, "eth_gasPrice")
+	if err != nil {
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("Failed to get the gas price: %v", err)
+		return check
+	}
+	balance, err := rpcQuantity(client, "eth_getBalance", deployerAddress, "latest")
+	if err != nil {
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("Failed to get the balance of %s: %v", deployerAddress, err)
+		return check
+	}
+
+	required := new(big.Int).Mul(gas, gasPrice)
+	switch {
+	case balance.Cmp(required) < 0:
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("Balance of %s is %s wei, the deployment needs about %s wei (%s gas at %s wei)", deployerAddress, balance, required, gas, gasPrice)
+	case !estimated:
+		check.Status = LaunchReadinessWarn
+		check.Detail = fmt.Sprintf("Balance of %s is %s wei, the template has no bounded deployment estimate so %d gas was assumed (%s wei)", deployerAddress, balance, defaultDeploymentGas, required)
+	default:
+		check.Status = LaunchReadinessPass
+		check.Detail = fmt.Sprintf("Balance of %s is %s wei, the deployment needs about %s wei (%s gas at %s wei)", deployerAddress, balance, required, gas, gasPrice)
+	}
+	return check
+}
+
+// checkTokenMetadata checks that every parameter of the template has a non-empty value of the sample type
+func checkTokenMetadata(template *models.Template, templateValues map[string]any) LaunchReadinessCheck {
+	check := LaunchReadinessCheck{Name: "token_metadata"}
+	values := templateValues
+	if values == nil {
+		values = template.SampleTemplateValues
+	}
+
+	parameters := map[string]bool{}
+	for key := range template.Metadata {
+		parameters[key] = true
+	}
+	for key := range template.SampleTemplateValues {
+		parameters[key] = true
+	}
+	var missing []string
+	for key := range parameters {
+		value, ok := values[key]
+		if !ok || value == nil || strings.TrimSpace(fmt.Sprint(value)) == "" {
+			missing = append(missing, key)
+		}
+	}
+	slices.Sort(missing)
+	var mismatch error
+	if templateValues != nil {
+		mismatch = utils.CheckSampleKeysMatch(template.SampleTemplateValues, templateValues)
+	}
+
+	switch {
+	case len(missing) > 0:
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("Template values are missing for %s", strings.Join(missing, ", "))
+	case mismatch != nil:
+		check.Status = LaunchReadinessFail
+		check.Detail = fmt.Sprintf("Template values don't match the sample values: %v", mismatch)
+	case templateValues == nil:
+		check.Status = LaunchReadinessWarn
+		check.Detail = "No template_values given, the sample values of the template were checked"
+	default:
+		check.Status = LaunchReadinessPass
+		check.Detail = fmt.Sprintf("All %d template parameters have values", len(parameters))
+	}
+	return check
+}
+
+// rpcQuantity calls a JSON-RPC method returning a hex quantity
+func rpcQuantity(client *utils.RPCClient, method string, params ...interface{}) (*big.Int, error) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	response, err := client.Call(method, params)
+	if err != nil {
+		return nil, err
+	}
+	result, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s result %v", method, response.Result)
+	}
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(result, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s result %s", method, result)
+	}
+	return value, nil
+}
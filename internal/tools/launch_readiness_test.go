@@ -0,0 +1,146 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const launchReadinessDeployer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+type launchReadinessTestSetup struct {
+	templateService services.TemplateService
+	uniswapService  services.UniswapService
+	chainService    services.ChainService
+	template        *models.Template
+	chain           *models.Chain
+	handler         func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+}
+
+// setupLaunchReadinessTest serves chain ID 31337, a gas price of 1 gwei and the balance of the deployer
+func setupLaunchReadinessTest(t *testing.T, balance string) *launchReadinessTestSetup {
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		results := map[string]string{"eth_chainId": "0x7a69", "eth_gasPrice": "0x3b9aca00", "eth_getBalance": balance}
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: results[request.Method]})
+	}))
+	t.Cleanup(rpcServer.Close)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	setup := &launchReadinessTestSetup{
+		templateService: services.NewTemplateService(db.GetDB()),
+		uniswapService:  services.NewUniswapService(db.GetDB()),
+	}
+	setup.chainService = services.NewChainService(db.GetDB())
+	setup.chain = &models.Chain{Name: "Local", RPC: rpcServer.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, setup.chainService.CreateChain(setup.chain))
+
+	setup.template = &models.Template{
+		Name:                 "Basic Token",
+		ChainType:            models.TransactionChainTypeEthereum,
+		TemplateCode:         "contract {{.TokenName}} {}",
+		Metadata:             models.JSON{"TokenName": "", "TokenSymbol": ""},
+		SampleTemplateValues: models.JSON{"TokenName": "Sample", "TokenSymbol": "SMP"},
+		Report: &models.TemplateReport{
+			ContractName:    "Sample",
+			CompilerVersion: "0.8.27",
+			Creation:        map[string]string{"totalCost": "1000000"},
+		},
+	}
+	require.NoError(t, setup.templateService.CreateTemplate(setup.template))
+
+	setup.handler = NewLaunchReadinessTool(setup.templateService, setup.chainService, setup.uniswapService).GetHandler()
+	return setup
+}
+
+func (s *launchReadinessTestSetup) confirmUniswap(t *testing.T) {
+	deploymentID, err := s.uniswapService.CreateUniswapDeployment(s.chain.ID, "v2", nil)
+	require.NoError(t, err)
+	require.NoError(t, s.uniswapService.UpdateFactoryAddress(deploymentID, "0x1111111111111111111111111111111111111111"))
+	require.NoError(t, s.uniswapService.UpdateRouterAddress(deploymentID, "0x2222222222222222222222222222222222222222"))
+	require.NoError(t, s.uniswapService.UpdateWETHAddress(deploymentID, "0x3333333333333333333333333333333333333333"))
+	require.NoError(t, s.uniswapService.UpdateStatus(deploymentID, models.TransactionStatusConfirmed))
+}
+
+func callLaunchReadiness(t *testing.T, setup *launchReadinessTestSetup, arguments map[string]any) LaunchReadinessReport {
+	result := callTemplatePackTool(t, context.Background(), setup.handler, arguments)
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	require.Len(t, result.Content, 2)
+
+	var report LaunchReadinessReport
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+	return report
+}
+
+func launchReadinessStatuses(report LaunchReadinessReport) map[string]LaunchReadinessStatus {
+	statuses := map[string]LaunchReadinessStatus{}
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	return statuses
+}
+
+func TestLaunchReadinessPasses(t *testing.T) {
+	// 1.2M gas at 1 gwei needs 0.0012 ETH, the deployer has 1 ETH
+	setup := setupLaunchReadinessTest(t, "0xde0b6b3a7640000")
+	setup.confirmUniswap(t)
+
+	report := callLaunchReadiness(t, setup, map[string]any{
+		"template_id":      "1",
+		"template_values":  map[string]any{"TokenName": "Launch", "TokenSymbol": "LCH"},
+		"deployer_address": launchReadinessDeployer,
+	})
+	assert.Equal(t, LaunchReadinessPass, report.Status)
+	assert.Equal(t, map[string]LaunchReadinessStatus{
+		"template":         LaunchReadinessPass,
+		"chain":            LaunchReadinessPass,
+		"uniswap":          LaunchReadinessPass,
+		"deployer_balance": LaunchReadinessPass,
+		"token_metadata":   LaunchReadinessPass,
+	}, launchReadinessStatuses(report))
+}
+
+func TestLaunchReadinessWarnsAndFails(t *testing.T) {
+	setup := setupLaunchReadinessTest(t, "0x0")
+
+	report := callLaunchReadiness(t, setup, map[string]any{"template_id": "1"})
+	assert.Equal(t, LaunchReadinessWarn, report.Status)
+	statuses := launchReadinessStatuses(report)
+	assert.Equal(t, LaunchReadinessWarn, statuses["uniswap"])
+	assert.Equal(t, LaunchReadinessWarn, statuses["deployer_balance"])
+	assert.Equal(t, LaunchReadinessWarn, statuses["token_metadata"])
+
+	report = callLaunchReadiness(t, setup, map[string]any{
+		"template_id":      "1",
+		"template_values":  map[string]any{"TokenName": "Launch", "TokenSymbol": ""},
+		"deployer_address": launchReadinessDeployer,
+	})
+	assert.Equal(t, LaunchReadinessFail, report.Status)
+	statuses = launchReadinessStatuses(report)
+	assert.Equal(t, LaunchReadinessFail, statuses["deployer_balance"])
+	assert.Equal(t, LaunchReadinessFail, statuses["token_metadata"])
+}
+
+func TestLaunchReadinessFailsOnChainMismatch(t *testing.T) {
+	setup := setupLaunchReadinessTest(t, "0xde0b6b3a7640000")
+	require.NoError(t, setup.chainService.UpdateChainConfig("ethereum", setup.chain.RPC, "1"))
+
+	report := callLaunchReadiness(t, setup, map[string]any{"template_id": "1", "deployer_address": launchReadinessDeployer})
+	assert.Equal(t, LaunchReadinessFail, report.Status)
+	statuses := launchReadinessStatuses(report)
+	assert.Equal(t, LaunchReadinessFail, statuses["chain"])
+	assert.Equal(t, LaunchReadinessFail, statuses["deployer_balance"])
+}
@@ -529,6 +529,44 @@ func (suite *LaunchToolTestSuite) TestHandlerNoActiveChain() {
 	suite.chain.IsActive = true
 }
 
+func (suite *LaunchToolTestSuite) TestHandlerSolanaDeploymentNotSupported() {
+	solanaChain := &models.Chain{
+		ChainType: models.TransactionChainTypeSolana,
+		RPC:       "https://api.devnet.solana.com",
+		NetworkID: "devnet",
+		Name:      "Solana Devnet",
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(solanaChain))
+	suite.Require().NoError(suite.chainService.SetActiveChainByID(solanaChain.ID))
+	defer func() {
+		suite.Require().NoError(suite.chainService.SetActiveChainByID(suite.chain.ID))
+	}()
+
+	solanaTemplate := &models.Template{
+		Name:         "Counter",
+		Description:  "An Anchor counter program",
+		ChainType:    models.TransactionChainTypeSolana,
+		TemplateCode: "// Anchor program code",
+	}
+	suite.Require().NoError(suite.templateService.CreateTemplate(solanaTemplate))
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"template_id":     fmt.Sprintf("%d", solanaTemplate.ID),
+				"template_values": map[string]interface{}{},
+				"contract_name":   "counter",
+			},
+		},
+	}
+
+	result, err := suite.launchTool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.True(result.IsError)
+	// The Solana adapter refuses the deployment instead of returning a session that cannot be signed
+	suite.Contains(result.Content[0].(mcp.TextContent).Text, "not supported on this chain, deploy Counter with anchor deploy")
+}
+
 func (suite *LaunchToolTestSuite) TestHandlerChainTypeMismatch() {
 	// Create a Solana template
 	solanaTemplate := &models.Template{
@@ -699,12 +737,13 @@ contract TokenRequiringConstructor {
 	}
 }
 
-func (suite *LaunchToolTestSuite) TestCreateEvmContractDeploymentTransaction() {
+func (suite *LaunchToolTestSuite) TestCreateContractDeploymentTransaction() {
 	metadata := []models.TransactionMetadata{
 		{Key: "test_key", Value: "test_value"},
 	}
 
-	renderedContract := `// SPDX-License-Identifier: MIT
+	template := *suite.template
+	template.TemplateCode = `// SPDX-License-Identifier: MIT
 pragma solidity ^0.8.19;
 
 contract TestContract {
@@ -712,17 +751,20 @@ contract TestContract {
     
     constructor() {}
 }`
+	adapter, err := suite.launchTool.chainAdapters.ForChainType(models.TransactionChainTypeEthereum)
+	suite.Require().NoError(err)
 
-	sessionID, err := suite.launchTool.createEvmContractDeploymentTransaction(
+	sessionID, err := suite.launchTool.createContractDeploymentTransaction(
+		context.Background(),
+		adapter,
 		suite.chain,
+		&template,
 		metadata,
-		renderedContract,
 		"TestContract",
 		[]interface{}{},
 		"0",
 		"Deploy TestContract",
 		"Deploy a test contract",
-		suite.template.ID,
 		models.JSON{},
 		nil,
 	)
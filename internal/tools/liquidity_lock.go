@@ -0,0 +1,187 @@
+package tools
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// liquidityLockerAbi is the locker interface of the liquidity lock step, implemented by the Liquidity Locker template
+// of the liquidity-lock pack. The LP tokens are minted to the locker, which resolves the pair of tokenA and tokenB on
+// its factory and locks the LP tokens it holds beyond its existing locks for the beneficiary until unlockTime
+const liquidityLockerAbi = `[{"inputs":[{"name":"tokenA","type":"address"},{"name":"tokenB","type":"address"},{"name":"beneficiary","type":"address"},{"name":"unlockTime","type":"uint256"}],"name":"lockLiquidity","outputs":[{"name":"lockId","type":"uint256"}],"stateMutability":"nonpayable","type":"function"}]`
+
+// LiquidityLockArguments are the optional lock step arguments of the tools adding liquidity
+type LiquidityLockArguments struct {
+	LockDays      string `json:"lock_days,omitempty"`
+	LockerAddress string `json:"locker_address,omitempty"`
+}
+
+// liquidityLock is the lock step planned for the LP tokens minted by an addition of liquidity
+type liquidityLock struct {
+	Days          int
+	LockerAddress string
+	UnlockTime    time.Time
+}
+
+// liquidityLockPlan is the outcome of the liquidity lock rule of the launch policy for an addition of liquidity,
+// Lock is nil when the liquidity is not locked
+type liquidityLockPlan struct {
+	Check services.LiquidityLockCheck
+	Lock  *liquidityLock
+}
+
+// liquidityLockOptions returns the tool options of the LiquidityLockArguments parameters
+func liquidityLockOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("lock_days",
+			mcp.Description("Lock the LP tokens minted by the addition for this many days: they are minted to the liquidity locker and locked for owner_address. Required by the launch policy when the ETH side of the pool after the addition is above its threshold. Optional"),
+		),
+		mcp.WithString("locker_address",
+			mcp.Description("Liquidity locker contract deployed from the Liquidity Locker template (import_templates pack=liquidity-lock), it must be the locker of the launch policy when one is set. Optional, defaults to the locker of the launch policy"),
+		),
+	}
+}
+
+// planLiquidityLock validates the lock step arguments and enforces the liquidity lock rule of the launch policy.
+// ethSide returns the ETH side of the pool after the addition, nil for pools not paired with ETH; it is only read
+// when a policy is configured. The locker must be the allow-listed locker of the policy and have code on the chain
+func planLiquidityLock(launchPolicyService services.LaunchPolicyService, rpcURL string, args LiquidityLockArguments, ethSide func() (*big.Int, error)) (*liquidityLockPlan, error) {
+	policy, err := launchPolicyService.GetLaunchPolicy()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get launch policy: %v", err)
+	}
+
+	lock, err := parseLiquidityLock(args, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var ethAmount *big.Int
+	if policy != nil && ethSide != nil {
+		if ethAmount, err = ethSide(); err != nil {
+			return nil, err
+		}
+	}
+	lockDays := 0
+	if lock != nil {
+		lockDays = lock.Days
+	}
+	check := services.EvaluateLiquidityLock(policy, ethAmount, lockDays)
+	if !check.Satisfied {
+		return nil, fmt.Errorf("Launch policy violation: %s. Pass lock_days to add a liquidity lock step", check.Message)
+	}
+
+	if lock != nil {
+		deployed, err := utils.HasContractCode(rpcURL, lock.LockerAddress)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to check the liquidity locker %s: %v", lock.LockerAddress, err)
+		}
+		if !deployed {
+			return nil, fmt.Errorf("No liquidity locker is deployed at %s on this chain, deploy the Liquidity Locker template of import_templates pack=liquidity-lock", lock.LockerAddress)
+		}
+	}
+	return &liquidityLockPlan{Check: check, Lock: lock}, nil
+}
+
+// parseLiquidityLock validates the lock step arguments against the locker of the policy, it returns nil when the
+// liquidity is not locked
+func parseLiquidityLock(args LiquidityLockArguments, policy *models.LaunchPolicy) (*liquidityLock, error) {
+	if args.LockDays == "" {
+		if args.LockerAddress != "" {
+			return nil, fmt.Errorf("locker_address requires lock_days")
+		}
+		return nil, nil
+	}
+
+	days, err := strconv.Atoi(args.LockDays)
+	if err != nil || days <= 0 {
+		return nil, fmt.Errorf("Invalid lock_days: must be a positive number of days")
+	}
+
+	lockerAddress := args.LockerAddress
+	if lockerAddress != "" && !utils.IsValidEthereumAddress(lockerAddress) {
+		return nil, fmt.Errorf("Invalid locker_address: %q", lockerAddress)
+	}
+	if policy != nil && policy.LiquidityLockerAddress != "" {
+		// only the locker of the policy is trusted to hold the LP tokens
+		if lockerAddress != "" && !strings.EqualFold(lockerAddress, policy.LiquidityLockerAddress) {
+			return nil, fmt.Errorf("locker_address %s is not the liquidity locker %s of the launch policy", lockerAddress, policy.LiquidityLockerAddress)
+		}
+		lockerAddress = policy.LiquidityLockerAddress
+	}
+	if lockerAddress == "" {
+		return nil, fmt.Errorf("lock_days requires a locker_address, or a liquidity locker configured in the launch policy")
+	}
+
+	return &liquidityLock{
+		Days:          days,
+		LockerAddress: common.HexToAddress(lockerAddress).Hex(),
+		UnlockTime:    time.Now().Add(time.Duration(days) * 24 * time.Hour),
+	}, nil
+}
+
+// lpReceiver returns the address the LP tokens of the addition are minted to, the locker when they are locked
+func (p *liquidityLockPlan) lpReceiver(ownerAddress string) string {
+	if p.Lock != nil {
+		return p.Lock.LockerAddress
+	}
+	return ownerAddress
+}
+
+// metadata appends the launch checklist item of the rule and the lock step to the session metadata
+func (p *liquidityLockPlan) metadata(metadata []models.TransactionMetadata) []models.TransactionMetadata {
+	metadata = append(metadata, models.TransactionMetadata{
+		Key:   "liquidity_lock_policy",
+		Value: liquidityLockChecklistItem(p.Check),
+	})
+	if p.Lock != nil {
+		metadata = append(metadata,
+			models.TransactionMetadata{Key: "liquidity_locker", Value: p.Lock.LockerAddress},
+			models.TransactionMetadata{Key: "liquidity_locked_until", Value: p.Lock.UnlockTime.UTC().Format(time.RFC3339)},
+		)
+	}
+	return metadata
+}
+
+// createLiquidityLockTransaction creates the lockLiquidity call locking the LP tokens minted to the locker for the owner
+func createLiquidityLockTransaction(evmService services.EvmService, lock *liquidityLock, tokenA, tokenB, ownerAddress string) (models.TransactionDeployment, error) {
+	functionArgs := []any{tokenA, tokenB, ownerAddress, fmt.Sprintf("%d", lock.UnlockTime.Unix())}
+	lockTx, err := evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: lock.LockerAddress,
+		FunctionName:    "lockLiquidity",
+		FunctionArgs:    functionArgs,
+		Abi:             liquidityLockerAbi,
+		Value:           "0",
+		Title:           "Lock Liquidity",
+		Description:     fmt.Sprintf("Lock the LP tokens of %s/%s for %d days, until %s", tokenA, tokenB, lock.Days, lock.UnlockTime.UTC().Format(time.RFC3339)),
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return models.TransactionDeployment{}, err
+	}
+
+	functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI("lockLiquidity", functionArgs, liquidityLockerAbi)
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	lockTx.RawContractArguments = &functionArgsString
+	return lockTx, nil
+}
+
+// liquidityLockChecklistItem describes the liquidity lock rule outcome for the launch checklist
+func liquidityLockChecklistItem(check services.LiquidityLockCheck) string {
+	status := "not required"
+	if check.Required {
+		status = "passed"
+	}
+	return fmt.Sprintf("%s: %s", status, check.Message)
+}
@@ -0,0 +1,72 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type listAddressesTool struct {
+	addressBookService services.AddressBookService
+}
+
+type ListAddressesArguments struct {
+	ChainType string `json:"chain_type,omitempty" validate:"omitempty,oneof=ethereum solana"`
+}
+
+func NewListAddressesTool(addressBookService services.AddressBookService) *listAddressesTool {
+	return &listAddressesTool{
+		addressBookService: addressBookService,
+	}
+}
+
+func (l *listAddressesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("list_addresses",
+		mcp.WithDescription("List the addresses saved in the address book with save_address, by label. Refer to them with @label in any tool argument."),
+		mcp.WithString("chain_type",
+			mcp.Description("Only list the addresses of this chain type. Optional"),
+			mcp.Enum(string(models.TransactionChainTypeEthereum), string(models.TransactionChainTypeSolana)),
+		),
+	)
+
+	return tool
+}
+
+func (l *listAddressesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ListAddressesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		entries, err := l.addressBookService.ListAddresses(userId, models.TransactionChainType(args.ChainType))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list addresses: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(entries)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d saved addresses", len(entries))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,228 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type listAllowancesTool struct {
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	uniswapService    services.UniswapService
+}
+
+type ListAllowancesArguments struct {
+	// Required fields
+	OwnerAddress string `json:"owner_address" validate:"required"`
+
+	// Optional fields
+	Tokens   []string `json:"tokens,omitempty"`
+	Spenders []string `json:"spenders,omitempty"`
+}
+
+// AllowanceEntry is a non-zero allowance granted by the owner to a spender
+type AllowanceEntry struct {
+	Token        string `json:"token"`
+	TokenLabel   string `json:"token_label"`
+	Spender      string `json:"spender"`
+	SpenderLabel string `json:"spender_label"`
+	Allowance    string `json:"allowance"`
+	Unlimited    bool   `json:"unlimited"`
+}
+
+type ListAllowancesResult struct {
+	OwnerAddress string           `json:"owner_address"`
+	Allowances   []AllowanceEntry `json:"allowances"`
+	// ScannedPairs is the number of token and spender pairs checked
+	ScannedPairs int `json:"scanned_pairs"`
+	// SkippedTokens are the tokens whose allowance could not be read, such as NFT contracts
+	SkippedTokens []string `json:"skipped_tokens,omitempty"`
+}
+
+// allowanceCandidate is a token or spender address known to the launchpad
+type allowanceCandidate struct {
+	Address string
+	Label   string
+}
+
+func NewListAllowancesTool(chainService services.ChainService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService) *listAllowancesTool {
+	return &listAllowancesTool{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		uniswapService:    uniswapService,
+	}
+}
+
+func (l *listAllowancesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("list_allowances",
+		mcp.WithDescription("List the ERC-20 allowances an address has granted on the active chain. Scans the tokens known to the launchpad (deployed tokens, WETH and pool LP tokens) against the known spenders (the Uniswap router), plus any extra tokens and spenders given. Only non-zero allowances are returned, use revoke_allowance to revoke them."),
+		mcp.WithString("owner_address",
+			mcp.Required(),
+			mcp.Description("Address that granted the allowances"),
+		),
+		mcp.WithArray("tokens",
+			mcp.Description("Extra token addresses to scan. Optional"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("spenders",
+			mcp.Description("Extra spender addresses to scan. Optional"),
+			mcp.WithStringItems(),
+		),
+	)
+
+	return tool
+}
+
+func (l *listAllowancesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ListAllowancesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := l.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Allowances are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		result, err := scanAllowances(l.deploymentService, l.liquidityService, l.uniswapService, activeChain, args.OwnerAddress, args.Tokens, args.Spenders)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d allowances granted by %s: ", len(result.Allowances), result.OwnerAddress)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// scanAllowances reads the allowance of every known token and spender pair and returns the non-zero ones
+func scanAllowances(deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService, activeChain *models.Chain, ownerAddress string, extraTokens, extraSpenders []string) (*ListAllowancesResult, error) {
+	if !utils.IsValidEthereumAddress(ownerAddress) {
+		return nil, fmt.Errorf("Invalid owner address: %q", ownerAddress)
+	}
+
+	tokens, spenders, err := knownAllowanceCandidates(deploymentService, liquidityService, uniswapService, activeChain)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, extra := range []struct {
+		entries    []string
+		candidates *[]allowanceCandidate
+		label      string
+	}{
+		{extraTokens, &tokens, "token"},
+		{extraSpenders, &spenders, "spender"},
+	} {
+		for _, entry := range extra.entries {
+			entry = strings.TrimSpace(entry)
+			if !utils.IsValidEthereumAddress(entry) {
+				return nil, fmt.Errorf("Invalid %s address: %q", extra.label, entry)
+			}
+			*extra.candidates = appendCandidate(*extra.candidates, entry, "Custom "+extra.label)
+		}
+	}
+
+	if len(spenders) == 0 {
+		return nil, fmt.Errorf("No known spenders on this chain. Deploy Uniswap first or pass spenders")
+	}
+
+	result := &ListAllowancesResult{
+		OwnerAddress: common.HexToAddress(ownerAddress).Hex(),
+		Allowances:   []AllowanceEntry{},
+	}
+	for _, token := range tokens {
+		for _, spender := range spenders {
+			result.ScannedPairs++
+			allowance, err := utils.GetERC20Allowance(activeChain.RPC, token.Address, ownerAddress, spender.Address)
+			if err != nil {
+				// Contracts without allowance(address,address) revert, there is nothing to revoke on them
+				result.SkippedTokens = append(result.SkippedTokens, token.Address)
+				break
+			}
+			if allowance.Allowance.Sign() == 0 {
+				continue
+			}
+			result.Allowances = append(result.Allowances, AllowanceEntry{
+				Token:        token.Address,
+				TokenLabel:   token.Label,
+				Spender:      spender.Address,
+				SpenderLabel: spender.Label,
+				Allowance:    allowance.Allowance.String(),
+				Unlimited:    allowance.Unlimited,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// knownAllowanceCandidates returns the tokens and spenders of the active chain recorded by the launchpad
+func knownAllowanceCandidates(deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService, activeChain *models.Chain) ([]allowanceCandidate, []allowanceCandidate, error) {
+	var tokens, spenders []allowanceCandidate
+
+	deployments, err := deploymentService.GetDeploymentsByChain(activeChain.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list deployments: %v", err)
+	}
+	for _, deployment := range deployments {
+		if deployment.Status == models.TransactionStatusConfirmed && deployment.ContractAddress != "" {
+			tokens = appendCandidate(tokens, deployment.ContractAddress, fmt.Sprintf("%s (deployment %d)", deployment.Template.Name, deployment.ID))
+		}
+	}
+
+	pools, err := liquidityService.ListConfirmedLiquidityPoolsByChain(activeChain.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to list liquidity pools: %v", err)
+	}
+	for _, pool := range pools {
+		tokens = appendCandidate(tokens, pool.PairAddress, fmt.Sprintf("LP token of pool %d", pool.ID))
+	}
+
+	if uniswapDeployment, err := uniswapService.GetUniswapDeploymentByChain(activeChain.ID); err == nil {
+		if uniswapDeployment.WETHAddress != "" {
+			tokens = appendCandidate(tokens, uniswapDeployment.WETHAddress, "WETH")
+		}
+		if uniswapDeployment.RouterAddress != "" {
+			spenders = appendCandidate(spenders, uniswapDeployment.RouterAddress, "Uniswap router")
+		}
+	}
+
+	return tokens, spenders, nil
+}
+
+// appendCandidate adds the address in checksum form unless it is already known
+func appendCandidate(candidates []allowanceCandidate, address, label string) []allowanceCandidate {
+	checksum := common.HexToAddress(address).Hex()
+	for _, candidate := range candidates {
+		if candidate.Address == checksum {
+			return candidates
+		}
+	}
+	return append(candidates, allowanceCandidate{Address: checksum, Label: label})
+}
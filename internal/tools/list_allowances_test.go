@@ -0,0 +1,151 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	allowanceOwner  = "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+	allowanceToken  = "0x1111111111111111111111111111111111111111"
+	allowanceRouter = "0x2222222222222222222222222222222222222222"
+	allowanceWETH   = "0x3333333333333333333333333333333333333333"
+	allowanceNFT    = "0x4444444444444444444444444444444444444444"
+)
+
+// newAllowanceRPCServer serves the allowances of the router keyed by token address, every other token reverts
+func newAllowanceRPCServer(allowances map[string]*big.Int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int                 `json:"id"`
+			Params []map[string]string `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		allowance, ok := allowances[strings.ToLower(request.Params[0]["to"])]
+		if !ok {
+			_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Error: &utils.RPCError{Code: 3, Message: "execution reverted"}})
+			return
+		}
+		// The spender is the second argument of allowance(owner, spender)
+		if !strings.HasSuffix(strings.ToLower(request.Params[0]["data"]), strings.ToLower(strings.TrimPrefix(allowanceRouter, "0x"))) {
+			allowance = big.NewInt(0)
+		}
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: "0x" + common.Bytes2Hex(common.LeftPadBytes(allowance.Bytes(), 32))})
+	}))
+}
+
+// setupAllowanceFixtures creates a chain with a Uniswap deployment, a confirmed token and an NFT deployment
+func setupAllowanceFixtures(s *suite.Suite, db services.DBService, rpcURL string) {
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       rpcURL,
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	s.Require().NoError(chainService.CreateChain(chain))
+
+	s.Require().NoError(db.GetDB().Create(&models.UniswapDeployment{Version: "v2", RouterAddress: allowanceRouter, WETHAddress: allowanceWETH, ChainID: chain.ID}).Error)
+
+	template := &models.Template{Name: "My Token", ChainType: models.TransactionChainTypeEthereum}
+	s.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	for _, address := range []string{allowanceToken, allowanceNFT} {
+		s.Require().NoError(deploymentService.CreateDeployment(&models.Deployment{
+			ChainID:         chain.ID,
+			TemplateID:      template.ID,
+			Status:          models.TransactionStatusConfirmed,
+			ContractAddress: address,
+		}))
+	}
+}
+
+type ListAllowancesToolTestSuite struct {
+	suite.Suite
+	db        services.DBService
+	rpcServer *httptest.Server
+	tool      *listAllowancesTool
+}
+
+func (suite *ListAllowancesToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	suite.rpcServer = newAllowanceRPCServer(map[string]*big.Int{
+		strings.ToLower(allowanceToken): maxUint256,
+		strings.ToLower(allowanceWETH):  big.NewInt(0),
+	})
+	setupAllowanceFixtures(&suite.Suite, db, suite.rpcServer.URL)
+
+	suite.tool = NewListAllowancesTool(services.NewChainService(db.GetDB()), services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapService(db.GetDB()))
+}
+
+func (suite *ListAllowancesToolTestSuite) TearDownSuite() {
+	suite.rpcServer.Close()
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ListAllowancesToolTestSuite) TestListsNonZeroAllowances() {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"owner_address": allowanceOwner},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().False(result.IsError, result.Content)
+	suite.Require().Len(result.Content, 2)
+
+	var listResult ListAllowancesResult
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &listResult))
+
+	// The token, the NFT and WETH are scanned against the router, the NFT call reverts and WETH has no allowance
+	suite.Equal(3, listResult.ScannedPairs)
+	suite.Equal([]string{allowanceNFT}, listResult.SkippedTokens)
+	suite.Require().Len(listResult.Allowances, 1)
+	allowance := listResult.Allowances[0]
+	suite.Equal(allowanceToken, allowance.Token)
+	suite.Equal(allowanceRouter, allowance.Spender)
+	suite.Equal("Uniswap router", allowance.SpenderLabel)
+	suite.True(allowance.Unlimited)
+}
+
+func (suite *ListAllowancesToolTestSuite) TestRejectsInvalidAddresses() {
+	for message, arguments := range map[string]map[string]interface{}{
+		"Invalid owner address":   {"owner_address": "0x123"},
+		"Invalid spender address": {"owner_address": allowanceOwner, "spenders": []interface{}{"router"}},
+	} {
+		result, err := suite.tool.GetHandler()(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Arguments: arguments},
+		})
+		suite.Require().NoError(err)
+		suite.True(result.IsError, message)
+		textContent, _ := result.Content[0].(mcp.TextContent)
+		suite.Contains(textContent.Text, message)
+	}
+}
+
+func TestListAllowancesToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ListAllowancesToolTestSuite))
+}
@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// buybackRunLimit is the number of latest runs returned for every buyback
+const buybackRunLimit = 5
+
+type listBuybacksTool struct {
+	buybackService services.BuybackService
+	serverPort     int
+}
+
+type BuybackRunResult struct {
+	models.BuybackRun
+	SigningUrl string `json:"signing_url,omitempty"`
+}
+
+type BuybackResult struct {
+	models.Buyback
+	Burn       *services.BuybackBurnReport `json:"burn"`
+	RecentRuns []BuybackRunResult          `json:"recent_runs"`
+}
+
+func NewListBuybacksTool(buybackService services.BuybackService, serverPort int) *listBuybacksTool {
+	return &listBuybacksTool{
+		buybackService: buybackService,
+		serverPort:     serverPort,
+	}
+}
+
+func (l *listBuybacksTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("list_buybacks",
+		mcp.WithDescription(fmt.Sprintf("List buyback-and-burn schedules with the cumulative ETH spent and tokens burned by their confirmed runs, and the signing URLs of the latest %d runs", buybackRunLimit)),
+		mcp.WithString("status",
+			mcp.Description("Filter by schedule status. Leave empty to get all schedules"),
+			mcp.Enum(
+				string(models.BuybackStatusActive),
+				string(models.BuybackStatusCompleted),
+				string(models.BuybackStatusCancelled),
+			),
+		),
+	)
+
+	return tool
+}
+
+func (l *listBuybacksTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		status := request.GetString("status", "")
+
+		var userID *string
+		if id := utils.GetUserID(ctx); id != "" {
+			userID = &id
+		}
+		buybacks, err := l.buybackService.ListBuybacks(userID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error retrieving buybacks: %v", err)), nil
+		}
+
+		results := []BuybackResult{}
+		for _, buyback := range buybacks {
+			if status != "" && buyback.Status != models.BuybackStatus(status) {
+				continue
+			}
+
+			report, err := l.buybackService.GetBurnReport(buyback.ID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error computing the burn of buyback %d: %v", buyback.ID, err)), nil
+			}
+
+			runs, err := l.buybackService.ListBuybackRuns(buyback.ID, buybackRunLimit)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error retrieving runs of buyback %d: %v", buyback.ID, err)), nil
+			}
+
+			result := BuybackResult{Buyback: buyback, Burn: report, RecentRuns: []BuybackRunResult{}}
+			for _, run := range runs {
+				runResult := BuybackRunResult{BuybackRun: run}
+				if run.SessionId != "" && run.Status == models.BuybackRunStatusPending {
+					runResult.SigningUrl, _ = utils.GetTransactionSessionUrl(ctx, l.serverPort, run.SessionId)
+				}
+				result.RecentRuns = append(result.RecentRuns, runResult)
+			}
+			results = append(results, result)
+		}
+
+		resultJSON, _ := json.Marshal(results)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d buybacks: ", len(results))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
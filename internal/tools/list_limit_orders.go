@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type listLimitOrdersTool struct {
+	limitOrderService services.LimitOrderService
+	serverPort        int
+}
+
+type LimitOrderResult struct {
+	models.LimitOrder
+	// SigningUrl is the URL of the swap session of a triggered order
+	SigningUrl string `json:"signing_url,omitempty"`
+}
+
+func NewListLimitOrdersTool(limitOrderService services.LimitOrderService, serverPort int) *listLimitOrdersTool {
+	return &listLimitOrdersTool{
+		limitOrderService: limitOrderService,
+		serverPort:        serverPort,
+	}
+}
+
+func (l *listLimitOrdersTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("list_limit_orders",
+		mcp.WithDescription("List limit orders with their status. Triggered orders include the URL of the swap session that is ready to sign."),
+		mcp.WithString("status",
+			mcp.Description("Filter by order status. Leave empty to get all orders"),
+			mcp.Enum(
+				string(models.LimitOrderStatusOpen),
+				string(models.LimitOrderStatusTriggered),
+				string(models.LimitOrderStatusFilled),
+				string(models.LimitOrderStatusCancelled),
+				string(models.LimitOrderStatusExpired),
+				string(models.LimitOrderStatusFailed),
+			),
+		),
+	)
+
+	return tool
+}
+
+func (l *listLimitOrdersTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		status := request.GetString("status", "")
+
+		var orders []models.LimitOrder
+		var err error
+		if userID := utils.GetUserID(ctx); userID != "" {
+			orders, err = l.limitOrderService.ListLimitOrdersByUser(userID)
+		} else {
+			orders, err = l.limitOrderService.ListLimitOrders()
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error retrieving limit orders: %v", err)), nil
+		}
+
+		results := []LimitOrderResult{}
+		for _, order := range orders {
+			if status != "" && order.Status != models.LimitOrderStatus(status) {
+				continue
+			}
+
+			result := LimitOrderResult{LimitOrder: order}
+			if order.Status == models.LimitOrderStatusTriggered && order.SessionId != "" {
+				result.SigningUrl, _ = utils.GetTransactionSessionUrl(ctx, l.serverPort, order.SessionId)
+			}
+			results = append(results, result)
+		}
+
+		resultJSON, _ := json.Marshal(results)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d limit orders: ", len(results))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,258 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type listProjectAssetsTool struct {
+	projectService services.ProjectService
+	serverPort     int
+}
+
+type ListProjectAssetsArguments struct {
+	ProjectID string `json:"project_id,omitempty"`
+	Tag       string `json:"tag,omitempty"`
+	AssetType string `json:"asset_type,omitempty" validate:"omitempty,oneof=template deployment liquidity_pool session"`
+}
+
+// ProjectSummary is a project listed by list_project_assets with the number of records of every asset type
+type ProjectSummary struct {
+	ID          uint                            `json:"id"`
+	Name        string                          `json:"name"`
+	Description string                          `json:"description,omitempty"`
+	Tags        []string                        `json:"tags"`
+	AssetCounts map[models.ProjectAssetType]int `json:"asset_counts"`
+	CreatedAt   time.Time                       `json:"created_at"`
+}
+
+// ProjectAssetsReport lists the records assigned to a project
+type ProjectAssetsReport struct {
+	ProjectID      uint                   `json:"project_id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	Tags           []string               `json:"tags"`
+	Templates      []ProjectTemplate      `json:"templates,omitempty"`
+	Deployments    []ProjectDeployment    `json:"deployments,omitempty"`
+	LiquidityPools []ProjectLiquidityPool `json:"liquidity_pools,omitempty"`
+	Sessions       []ProjectSession       `json:"sessions,omitempty"`
+}
+
+type ProjectTemplate struct {
+	ID          uint                        `json:"id"`
+	Name        string                      `json:"name"`
+	Description string                      `json:"description,omitempty"`
+	ChainType   models.TransactionChainType `json:"chain_type"`
+}
+
+type ProjectDeployment struct {
+	ID              uint                     `json:"id"`
+	TemplateID      uint                     `json:"template_id"`
+	ChainName       string                   `json:"chain_name"`
+	ContractAddress string                   `json:"contract_address,omitempty"`
+	TransactionHash string                   `json:"transaction_hash,omitempty"`
+	Status          models.TransactionStatus `json:"status"`
+}
+
+type ProjectLiquidityPool struct {
+	ID          uint                     `json:"id"`
+	PairAddress string                   `json:"pair_address,omitempty"`
+	Token0      string                   `json:"token0"`
+	Token1      string                   `json:"token1"`
+	Status      models.TransactionStatus `json:"status"`
+}
+
+type ProjectSession struct {
+	ID        string                      `json:"id"`
+	ChainType models.TransactionChainType `json:"chain_type"`
+	ChainName string                      `json:"chain_name"`
+	Status    models.TransactionStatus    `json:"status"`
+	// URL is the signing page of the sessions still waiting to be signed
+	URL string `json:"url,omitempty"`
+}
+
+func NewListProjectAssetsTool(projectService services.ProjectService, serverPort int) *listProjectAssetsTool {
+	return &listProjectAssetsTool{
+		projectService: projectService,
+		serverPort:     serverPort,
+	}
+}
+
+func (l *listProjectAssetsTool) GetTool() mcp.Tool {
+	assetTypes := make([]string, len(models.ProjectAssetTypes))
+	for i, assetType := range models.ProjectAssetTypes {
+		assetTypes[i] = string(assetType)
+	}
+
+	tool := mcp.NewTool("list_project_assets",
+		mcp.WithDescription("List the templates, deployments, liquidity pools and transaction sessions assigned to a project, with the status of the deployments and the signing url of pending sessions. "+
+			"Without project_id, lists the projects with the number of records of every type, optionally narrowed by tag."),
+		mcp.WithString("project_id",
+			mcp.Description("ID of the project to list the records of. Optional, lists the projects when omitted"),
+		),
+		mcp.WithString("tag",
+			mcp.Description("Only list the projects with this tag, ignored with project_id. Optional"),
+		),
+		mcp.WithString("asset_type",
+			mcp.Description("Only list the records of this type. Optional, defaults to every type"),
+			mcp.Enum(assetTypes...),
+		),
+	)
+
+	return tool
+}
+
+func (l *listProjectAssetsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ListProjectAssetsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		if args.ProjectID == "" {
+			return l.listProjects(userId, args.Tag)
+		}
+
+		projectID, err := strconv.ParseUint(args.ProjectID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid project_id format: %v", err)), nil
+		}
+
+		project, err := l.projectService.GetProject(uint(projectID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Project not found: %v", err)), nil
+		}
+
+		// Authenticated users can only see their own projects
+		if userId != nil && (project.UserID == nil || *project.UserID != *userId) {
+			return mcp.NewToolResultError("Project not found"), nil
+		}
+
+		// Narrow the assignments before loading the records
+		if args.AssetType != "" {
+			var assets []models.ProjectAsset
+			for _, asset := range project.Assets {
+				if asset.AssetType == models.ProjectAssetType(args.AssetType) {
+					assets = append(assets, asset)
+				}
+			}
+			project.Assets = assets
+		}
+
+		assets, err := l.projectService.GetProjectAssets(project)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to load project assets: %v", err)), nil
+		}
+
+		report := newProjectAssetsReport(ctx, project, assets, l.serverPort)
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Project %s has %d assigned record(s): ", project.Name, len(project.Assets))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+func (l *listProjectAssetsTool) listProjects(userId *string, tag string) (*mcp.CallToolResult, error) {
+	projects, err := l.projectService.ListProjects(userId, tag)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list projects: %v", err)), nil
+	}
+
+	summaries := []ProjectSummary{}
+	for _, project := range projects {
+		summary := ProjectSummary{
+			ID:          project.ID,
+			Name:        project.Name,
+			Description: project.Description,
+			Tags:        project.Tags,
+			AssetCounts: map[models.ProjectAssetType]int{},
+			CreatedAt:   project.CreatedAt,
+		}
+		for _, asset := range project.Assets {
+			summary.AssetCounts[asset.AssetType]++
+		}
+		summaries = append(summaries, summary)
+	}
+
+	resultJSON, _ := json.Marshal(summaries)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Found %d project(s), pass project_id to list the records of a project: ", len(summaries))),
+			mcp.NewTextContent(string(resultJSON)),
+		},
+	}, nil
+}
+
+// newProjectAssetsReport summarizes the records of the project
+func newProjectAssetsReport(ctx context.Context, project *models.Project, assets *services.ProjectAssets, serverPort int) ProjectAssetsReport {
+	report := ProjectAssetsReport{
+		ProjectID:   project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		Tags:        project.Tags,
+	}
+	for _, template := range assets.Templates {
+		report.Templates = append(report.Templates, ProjectTemplate{
+			ID:          template.ID,
+			Name:        template.Name,
+			Description: template.Description,
+			ChainType:   template.ChainType,
+		})
+	}
+	for _, deployment := range assets.Deployments {
+		report.Deployments = append(report.Deployments, ProjectDeployment{
+			ID:              deployment.ID,
+			TemplateID:      deployment.TemplateID,
+			ChainName:       deployment.Chain.Name,
+			ContractAddress: deployment.ContractAddress,
+			TransactionHash: deployment.TransactionHash,
+			Status:          deployment.Status,
+		})
+	}
+	for _, pool := range assets.LiquidityPools {
+		report.LiquidityPools = append(report.LiquidityPools, ProjectLiquidityPool{
+			ID:          pool.ID,
+			PairAddress: pool.PairAddress,
+			Token0:      pool.Token0,
+			Token1:      pool.Token1,
+			Status:      pool.Status,
+		})
+	}
+	for _, session := range assets.Sessions {
+		projectSession := ProjectSession{
+			ID:        session.ID,
+			ChainType: session.TransactionChainType,
+			ChainName: session.Chain.Name,
+			Status:    session.TransactionStatus,
+		}
+		// Only the pending sessions still need to be signed
+		if session.TransactionStatus == models.TransactionStatusPending {
+			projectSession.URL, _ = utils.GetTransactionSessionUrl(ctx, serverPort, session.ID)
+		}
+		report.Sessions = append(report.Sessions, projectSession)
+	}
+	return report
+}
@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// recurringSwapRunLimit is the number of latest runs returned for every recurring swap
+const recurringSwapRunLimit = 5
+
+type listRecurringSwapsTool struct {
+	recurringSwapService services.RecurringSwapService
+	serverPort           int
+}
+
+type RecurringSwapRunResult struct {
+	models.RecurringSwapRun
+	SigningUrl string `json:"signing_url,omitempty"`
+}
+
+type RecurringSwapResult struct {
+	models.RecurringSwap
+	RecentRuns []RecurringSwapRunResult `json:"recent_runs"`
+}
+
+func NewListRecurringSwapsTool(recurringSwapService services.RecurringSwapService, serverPort int) *listRecurringSwapsTool {
+	return &listRecurringSwapsTool{
+		recurringSwapService: recurringSwapService,
+		serverPort:           serverPort,
+	}
+}
+
+func (l *listRecurringSwapsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("list_recurring_swaps",
+		mcp.WithDescription(fmt.Sprintf("List recurring swap schedules with their next run and the signing URLs of the latest %d runs", recurringSwapRunLimit)),
+		mcp.WithString("status",
+			mcp.Description("Filter by schedule status. Leave empty to get all schedules"),
+			mcp.Enum(
+				string(models.RecurringSwapStatusActive),
+				string(models.RecurringSwapStatusCompleted),
+				string(models.RecurringSwapStatusCancelled),
+			),
+		),
+	)
+
+	return tool
+}
+
+func (l *listRecurringSwapsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		status := request.GetString("status", "")
+
+		var swaps []models.RecurringSwap
+		var err error
+		if userID := utils.GetUserID(ctx); userID != "" {
+			swaps, err = l.recurringSwapService.ListRecurringSwapsByUser(userID)
+		} else {
+			swaps, err = l.recurringSwapService.ListRecurringSwaps()
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error retrieving recurring swaps: %v", err)), nil
+		}
+
+		results := []RecurringSwapResult{}
+		for _, swap := range swaps {
+			if status != "" && swap.Status != models.RecurringSwapStatus(status) {
+				continue
+			}
+
+			runs, err := l.recurringSwapService.ListRecurringSwapRuns(swap.ID, recurringSwapRunLimit)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error retrieving runs of recurring swap %d: %v", swap.ID, err)), nil
+			}
+
+			result := RecurringSwapResult{RecurringSwap: swap, RecentRuns: []RecurringSwapRunResult{}}
+			for _, run := range runs {
+				runResult := RecurringSwapRunResult{RecurringSwapRun: run}
+				if run.SessionId != "" {
+					runResult.SigningUrl, _ = utils.GetTransactionSessionUrl(ctx, l.serverPort, run.SessionId)
+				}
+				result.RecentRuns = append(result.RecentRuns, runResult)
+			}
+			results = append(results, result)
+		}
+
+		resultJSON, _ := json.Marshal(results)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d recurring swaps: ", len(results))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,427 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	addressListActionUpdate = "update"
+	addressListActionReport = "report"
+)
+
+type manageAddressListTool struct {
+	chainService       services.ChainService
+	deploymentService  services.DeploymentService
+	evmService         services.EvmService
+	txService          services.TransactionService
+	uniswapService     services.UniswapService
+	liquidityService   services.LiquidityService
+	addressListService services.AddressListService
+	addressBookService services.AddressBookService
+	serverPort         int
+}
+
+type ManageAddressListArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+	ListType     string `json:"list_type" validate:"required,oneof=blacklist whitelist"`
+	Action       string `json:"action" validate:"required,oneof=update report"`
+
+	// Optional fields
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+type AddressListReport struct {
+	DeploymentID    uint                       `json:"deployment_id"`
+	ContractAddress string                     `json:"contract_address"`
+	ListType        models.AddressListType     `json:"list_type"`
+	Addresses       []string                   `json:"addresses"`
+	History         []models.AddressListChange `json:"history"`
+}
+
+func NewManageAddressListTool(chainService services.ChainService, deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, uniswapService services.UniswapService, liquidityService services.LiquidityService, addressListService services.AddressListService, addressBookService services.AddressBookService, serverPort int) *manageAddressListTool {
+	return &manageAddressListTool{
+		chainService:       chainService,
+		deploymentService:  deploymentService,
+		evmService:         evmService,
+		txService:          txService,
+		uniswapService:     uniswapService,
+		liquidityService:   liquidityService,
+		addressListService: addressListService,
+		addressBookService: addressBookService,
+		serverPort:         serverPort,
+	}
+}
+
+func (m *manageAddressListTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("manage_address_list",
+		mcp.WithDescription("Administer the blacklist or whitelist of a deployed token whose template has blacklist/whitelist functions. update batches every add and remove operation into one transaction session with a signing URL, the recorded lists are updated once the session is confirmed. report returns the recorded list and its change history for compliance reporting."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed deployment"),
+		),
+		mcp.WithString("list_type",
+			mcp.Required(),
+			mcp.Description("List to administer"),
+			mcp.Enum(string(models.AddressListTypeBlacklist), string(models.AddressListTypeWhitelist)),
+		),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("update to change the list, report to read the recorded list and its history"),
+			mcp.Enum(addressListActionUpdate, addressListActionReport),
+		),
+		mcp.WithArray("add",
+			mcp.Description("Addresses or @labels of the address book to add to the list, the addresses saved in the address book cannot be blacklisted. Used by update"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("remove",
+			mcp.Description("Addresses or @labels of the address book to remove from the list. Used by update"),
+			mcp.WithStringItems(),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (m *manageAddressListTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ManageAddressListArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(m.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		listType := models.AddressListType(args.ListType)
+		if args.Action == addressListActionReport {
+			return m.report(deployment, listType)
+		}
+
+		activeChain, err := m.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Address list administration is only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if deployment.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)), nil
+		}
+
+		if len(args.Add) == 0 && len(args.Remove) == 0 {
+			return mcp.NewToolResultError("At least one address to add or remove is required"), nil
+		}
+
+		add, remove, err := m.validateEntries(ctx, deployment, listType, args.Add, args.Remove)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		sessionID, err := m.createUpdateSession(ctx, deployment, abiString, activeChain, listType, add, remove)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create %s update: %v", listType, err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, m.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("%s update session created: %s", listType, sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Adding %d and removing %d addresses on contract %s", len(add), len(remove), deployment.ContractAddress)),
+				mcp.NewTextContent("Please sign the transactions in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// validateEntries normalizes the addresses to their checksum form and rejects duplicates, labels missing from the
+// address book, addresses already on the recorded list and addresses that must not be blacklisted, such as the token
+// itself, its Uniswap contracts and the addresses saved in the address book of the user
+func (m *manageAddressListTool) validateEntries(ctx context.Context, deployment *models.Deployment, listType models.AddressListType, addEntries, removeEntries []string) ([]string, []string, error) {
+	seen := map[string]bool{}
+	normalize := func(entries []string) ([]string, error) {
+		addresses := []string{}
+		for _, entry := range entries {
+			entry = strings.TrimSpace(entry)
+			// the saved labels were already replaced with their address
+			if strings.HasPrefix(entry, services.AddressBookReferencePrefix) {
+				return nil, fmt.Errorf("%s is not a label of the address book, save it with save_address or pass the address", entry)
+			}
+			if !utils.IsValidEthereumAddress(entry) {
+				return nil, fmt.Errorf("Invalid address: %q", entry)
+			}
+			address := common.HexToAddress(entry)
+			if address == (common.Address{}) {
+				return nil, fmt.Errorf("The zero address cannot be added to or removed from the %s", listType)
+			}
+			if seen[address.Hex()] {
+				return nil, fmt.Errorf("Address %s is given more than once", address.Hex())
+			}
+			seen[address.Hex()] = true
+			addresses = append(addresses, address.Hex())
+		}
+		return addresses, nil
+	}
+
+	add, err := normalize(addEntries)
+	if err != nil {
+		return nil, nil, err
+	}
+	remove, err := normalize(removeEntries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries, err := m.addressListService.ListEntries(deployment.ID, listType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to read recorded %s: %v", listType, err)
+	}
+	listed := map[string]bool{}
+	for _, entry := range entries {
+		listed[entry.Address] = true
+	}
+	for _, address := range add {
+		if listed[address] {
+			return nil, nil, fmt.Errorf("Address %s is already on the %s", address, listType)
+		}
+	}
+
+	if listType == models.AddressListTypeBlacklist {
+		protected, err := m.protectedAddresses(ctx, deployment)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, address := range add {
+			if name, ok := protected[address]; ok {
+				return nil, nil, fmt.Errorf("Address %s is the %s and cannot be blacklisted", address, name)
+			}
+		}
+	}
+
+	return add, remove, nil
+}
+
+// protectedAddresses returns the known addresses that would break the token when blacklisted and the addresses the
+// user saved in the address book, keyed by checksum address
+func (m *manageAddressListTool) protectedAddresses(ctx context.Context, deployment *models.Deployment) (map[string]string, error) {
+	protected := map[string]string{}
+
+	var userID *string
+	if user, ok := utils.GetAuthenticatedUser(ctx); ok {
+		userID = &user.Sub
+	}
+	saved, err := m.addressBookService.ListAddresses(userID, models.TransactionChainTypeEthereum)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read the address book: %v", err)
+	}
+	for _, entry := range saved {
+		if utils.IsValidEthereumAddress(entry.Address) {
+			protected[common.HexToAddress(entry.Address).Hex()] = fmt.Sprintf("address book entry %s%s", services.AddressBookReferencePrefix, entry.Label)
+		}
+	}
+
+	protected[common.HexToAddress(deployment.ContractAddress).Hex()] = "token contract"
+
+	if uniswapDeployment, err := m.uniswapService.GetUniswapDeploymentByChain(deployment.ChainID); err == nil {
+		for name, address := range map[string]string{
+			"Uniswap router":  uniswapDeployment.RouterAddress,
+			"Uniswap factory": uniswapDeployment.FactoryAddress,
+		} {
+			if address != "" {
+				protected[common.HexToAddress(address).Hex()] = name
+			}
+		}
+	}
+
+	if pool, err := m.liquidityService.GetLiquidityPoolByTokenAddress(deployment.ContractAddress, ""); err == nil && pool.PairAddress != "" {
+		protected[common.HexToAddress(pool.PairAddress).Hex()] = "Uniswap pair of the token"
+	}
+
+	return protected, nil
+}
+
+func (m *manageAddressListTool) createUpdateSession(ctx context.Context, deployment *models.Deployment, abiString string, activeChain *models.Chain, listType models.AddressListType, add, remove []string) (string, error) {
+	var transactions []models.TransactionDeployment
+	var changes []models.AddressListChange
+
+	for _, operation := range []struct {
+		action    models.AddressListAction
+		addresses []string
+	}{
+		{models.AddressListActionAdd, add},
+		{models.AddressListActionRemove, remove},
+	} {
+		if len(operation.addresses) == 0 {
+			continue
+		}
+
+		isAdd := operation.action == models.AddressListActionAdd
+		function, err := utils.FindAddressListFunction(abiString, string(listType), isAdd)
+		if err != nil {
+			return "", err
+		}
+
+		operationTransactions, err := m.createListTransactions(deployment, abiString, function, listType, operation.action, operation.addresses)
+		if err != nil {
+			return "", err
+		}
+		transactions = append(transactions, operationTransactions...)
+
+		for _, address := range operation.addresses {
+			changes = append(changes, models.AddressListChange{
+				DeploymentID: deployment.ID,
+				ListType:     listType,
+				Action:       operation.action,
+				Address:      address,
+			})
+		}
+	}
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	sessionID, err := m.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: transactions,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "deployment_id", Value: fmt.Sprintf("%d", deployment.ID)},
+			{Key: "list_type", Value: string(listType)},
+			{Key: "added", Value: strings.Join(add, ",")},
+			{Key: "removed", Value: strings.Join(remove, ",")},
+		},
+		UserID:  userId,
+		Context: ctx,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+
+	for i := range changes {
+		changes[i].SessionId = sessionID
+	}
+	if err := m.addressListService.CreateChanges(changes); err != nil {
+		return "", fmt.Errorf("failed to record %s changes: %w", listType, err)
+	}
+
+	return sessionID, nil
+}
+
+// createListTransactions creates one transaction for batch functions and one transaction per address otherwise
+func (m *manageAddressListTool) createListTransactions(deployment *models.Deployment, abiString string, function *utils.AddressListFunction, listType models.AddressListType, action models.AddressListAction, addresses []string) ([]models.TransactionDeployment, error) {
+	var calls [][]any
+	if function.Batch {
+		batch := make([]any, len(addresses))
+		for i, address := range addresses {
+			batch[i] = address
+		}
+		calls = append(calls, []any{batch})
+	} else {
+		for _, address := range addresses {
+			calls = append(calls, []any{address})
+		}
+	}
+
+	var transactions []models.TransactionDeployment
+	for _, functionArgs := range calls {
+		if function.WithFlag {
+			functionArgs = append(functionArgs, action == models.AddressListActionAdd)
+		}
+
+		target := fmt.Sprintf("%d addresses", len(addresses))
+		if !function.Batch {
+			target = functionArgs[0].(string)
+		}
+
+		tx, err := m.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: deployment.ContractAddress,
+			FunctionName:    function.Name,
+			FunctionArgs:    functionArgs,
+			Abi:             abiString,
+			Value:           "0",
+			Title:           listTransactionTitle(action, listType, target),
+			Description:     fmt.Sprintf("Call %s on contract %s", function.Name, deployment.ContractAddress),
+			TransactionType: models.TransactionTypeAddressListUpdate,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s transaction: %w", function.Name, err)
+		}
+
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(function.Name, functionArgs, abiString)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		tx.RawContractArguments = &functionArgsString
+		tx.ContractAddress = &deployment.ContractAddress
+		transactions = append(transactions, tx)
+	}
+
+	return transactions, nil
+}
+
+func listTransactionTitle(action models.AddressListAction, listType models.AddressListType, target string) string {
+	if action == models.AddressListActionAdd {
+		return fmt.Sprintf("Add %s to %s", target, listType)
+	}
+	return fmt.Sprintf("Remove %s from %s", target, listType)
+}
+
+func (m *manageAddressListTool) report(deployment *models.Deployment, listType models.AddressListType) (*mcp.CallToolResult, error) {
+	entries, err := m.addressListService.ListEntries(deployment.ID, listType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read recorded %s: %v", listType, err)), nil
+	}
+
+	history, err := m.addressListService.ListChanges(deployment.ID, listType)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read %s history: %v", listType, err)), nil
+	}
+
+	report := AddressListReport{
+		DeploymentID:    deployment.ID,
+		ContractAddress: deployment.ContractAddress,
+		ListType:        listType,
+		Addresses:       []string{},
+		History:         history,
+	}
+	for _, entry := range entries {
+		report.Addresses = append(report.Addresses, entry.Address)
+	}
+
+	reportJSON, _ := json.Marshal(report)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Recorded %s of %s has %d addresses: ", listType, deployment.ContractAddress, len(report.Addresses))),
+			mcp.NewTextContent(string(reportJSON)),
+		},
+	}, nil
+}
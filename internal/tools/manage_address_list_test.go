@@ -0,0 +1,241 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/hooks"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	listContract = "0x1111111111111111111111111111111111111111"
+	listRouter   = "0x2222222222222222222222222222222222222222"
+	listAccount1 = "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+	listAccount2 = "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"
+	listTreasury = "0xcCCCcCCCcCCCCCcCcCCcCCcccCcccCcCcCCCcCCC"
+)
+
+const listTemplateAbi = `[
+	{"type":"function","name":"batchBlacklist","stateMutability":"nonpayable","inputs":[{"name":"accounts","type":"address[]"}],"outputs":[]},
+	{"type":"function","name":"removeFromBlacklist","stateMutability":"nonpayable","inputs":[{"name":"account","type":"address"}],"outputs":[]}
+]`
+
+type ManageAddressListToolTestSuite struct {
+	suite.Suite
+	db                 services.DBService
+	tool               *manageAddressListTool
+	chain              *models.Chain
+	template           *models.Template
+	deploymentService  services.DeploymentService
+	addressListService services.AddressListService
+	txService          services.TransactionService
+}
+
+func (suite *ManageAddressListToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chainService := services.NewChainService(db.GetDB())
+	uniswapService := services.NewUniswapService(db.GetDB())
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.addressListService = services.NewAddressListService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	addressBookService := services.NewAddressBookService(db.GetDB())
+	suite.tool = NewManageAddressListTool(chainService, suite.deploymentService, services.NewEvmService(), suite.txService, uniswapService, services.NewLiquidityService(db.GetDB()), suite.addressListService, addressBookService, 8080)
+	suite.Require().NoError(addressBookService.SaveAddress(&models.AddressBookEntry{Label: "treasury", Address: strings.ToLower(listTreasury), ChainType: models.TransactionChainTypeEthereum}))
+
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(chainService.CreateChain(chain))
+	suite.chain = chain
+
+	suite.Require().NoError(db.GetDB().Create(&models.UniswapDeployment{Version: "v2", RouterAddress: listRouter, ChainID: chain.ID}).Error)
+
+	var abiArray []interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(listTemplateAbi), &abiArray))
+	template := &models.Template{
+		Name:      "Blacklist Token",
+		ChainType: models.TransactionChainTypeEthereum,
+		Abi:       models.JSON(map[string]interface{}{"abi": abiArray}),
+	}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *ManageAddressListToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ManageAddressListToolTestSuite) createDeployment() *models.Deployment {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: listContract,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *ManageAddressListToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *ManageAddressListToolTestSuite) sessionID(result *mcp.CallToolResult) string {
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	suite.Require().True(ok)
+	var sessionID string
+	_, err := fmt.Sscanf(textContent.Text, "blacklist update session created: %s", &sessionID)
+	suite.Require().NoError(err)
+	return sessionID
+}
+
+// confirmSession marks the session confirmed and runs the hook like the transaction API does
+func (suite *ManageAddressListToolTestSuite) confirmSession(sessionID string) {
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	session.TransactionStatus = models.TransactionStatusConfirmed
+
+	hook := hooks.NewAddressListHook(suite.addressListService)
+	suite.Require().NoError(hook.OnTransactionConfirmed(models.TransactionTypeAddressListUpdate, "0xhash", nil, *session))
+}
+
+func (suite *ManageAddressListToolTestSuite) TestBatchesUpdateIntoOneSession() {
+	deployment := suite.createDeployment()
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"list_type":     "blacklist",
+		"action":        "update",
+		"add":           []interface{}{listAccount1, listAccount2},
+	})
+	suite.Require().False(result.IsError, result.Content)
+
+	session, err := suite.txService.GetTransactionSession(suite.sessionID(result))
+	suite.Require().NoError(err)
+	// The batch function adds both addresses in one transaction
+	suite.Require().Len(session.TransactionDeployments, 1)
+	tx := session.TransactionDeployments[0]
+	suite.Equal(models.TransactionTypeAddressListUpdate, tx.TransactionType)
+	suite.Equal(listContract, tx.Receiver)
+	suite.Contains(*tx.RawContractArguments, listAccount1)
+}
+
+func (suite *ManageAddressListToolTestSuite) TestRecordsListOnConfirmation() {
+	deployment := suite.createDeployment()
+	deploymentID := fmt.Sprintf("%d", deployment.ID)
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": deploymentID,
+		"list_type":     "blacklist",
+		"action":        "update",
+		"add":           []interface{}{listAccount1, listAccount2},
+	})
+	suite.Require().False(result.IsError, result.Content)
+	sessionID := suite.sessionID(result)
+
+	// Nothing is recorded before the session is confirmed
+	entries, err := suite.addressListService.ListEntries(deployment.ID, models.AddressListTypeBlacklist)
+	suite.Require().NoError(err)
+	suite.Empty(entries)
+
+	suite.confirmSession(sessionID)
+
+	// The single address remove function creates one transaction per address
+	result = suite.callHandler(map[string]interface{}{
+		"deployment_id": deploymentID,
+		"list_type":     "blacklist",
+		"action":        "update",
+		"remove":        []interface{}{listAccount1},
+	})
+	suite.Require().False(result.IsError, result.Content)
+	suite.confirmSession(suite.sessionID(result))
+
+	result = suite.callHandler(map[string]interface{}{
+		"deployment_id": deploymentID,
+		"list_type":     "blacklist",
+		"action":        "report",
+	})
+	suite.Require().False(result.IsError, result.Content)
+	suite.Require().Len(result.Content, 2)
+
+	var report AddressListReport
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &report))
+	suite.Equal([]string{listAccount2}, report.Addresses)
+	suite.Require().Len(report.History, 3)
+	for _, change := range report.History {
+		suite.Equal(models.AddressListChangeStatusApplied, change.Status)
+		suite.Equal("0xhash", change.TransactionHash)
+	}
+	suite.Equal(models.AddressListActionRemove, report.History[2].Action)
+}
+
+func (suite *ManageAddressListToolTestSuite) TestRejectsInvalidEntries() {
+	deployment := suite.createDeployment()
+	deploymentID := fmt.Sprintf("%d", deployment.ID)
+
+	cases := map[string]map[string]interface{}{
+		"Invalid address":         {"add": []interface{}{"0x123"}},
+		"is given more than once": {"add": []interface{}{listAccount1}, "remove": []interface{}{listAccount1}},
+		"zero address":            {"add": []interface{}{"0x0000000000000000000000000000000000000000"}},
+		"token contract":          {"add": []interface{}{listContract}},
+		"Uniswap router":          {"add": []interface{}{listRouter}},
+		// the addresses of the address book are not blacklisted, the labels left are not saved
+		"address book entry @treasury":                {"add": []interface{}{listTreasury}},
+		"@unknown is not a label of the address book": {"remove": []interface{}{"@unknown"}},
+		"At least one address":                        {},
+	}
+	for message, arguments := range cases {
+		arguments["deployment_id"] = deploymentID
+		arguments["list_type"] = "blacklist"
+		arguments["action"] = "update"
+
+		result := suite.callHandler(arguments)
+		suite.True(result.IsError, message)
+		textContent, _ := result.Content[0].(mcp.TextContent)
+		suite.Contains(textContent.Text, message)
+	}
+}
+
+func (suite *ManageAddressListToolTestSuite) TestRejectsMissingListFunction() {
+	deployment := suite.createDeployment()
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"list_type":     "whitelist",
+		"action":        "update",
+		"add":           []interface{}{listAccount1},
+	})
+	suite.True(result.IsError)
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	suite.Contains(textContent.Text, "no function to add to the whitelist")
+}
+
+func TestManageAddressListToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ManageAddressListToolTestSuite))
+}
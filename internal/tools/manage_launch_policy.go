@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	launchPolicyActionGet   = "get"
+	launchPolicyActionSet   = "set"
+	launchPolicyActionClear = "clear"
+)
+
+type manageLaunchPolicyTool struct {
+	launchPolicyService services.LaunchPolicyService
+}
+
+type ManageLaunchPolicyArguments struct {
+	// Required fields
+	Action string `json:"action" validate:"required,oneof=get set clear"`
+
+	// Optional fields
+	LiquidityLockThreshold string `json:"liquidity_lock_threshold,omitempty"`
+	MinLiquidityLockDays   string `json:"min_liquidity_lock_days,omitempty"`
+	LiquidityLockerAddress string `json:"liquidity_locker_address,omitempty"`
+}
+
+func NewManageLaunchPolicyTool(launchPolicyService services.LaunchPolicyService) *manageLaunchPolicyTool {
+	return &manageLaunchPolicyTool{
+		launchPolicyService: launchPolicyService,
+	}
+}
+
+func (m *manageLaunchPolicyTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("manage_launch_policy",
+		mcp.WithDescription("Manage the launch policy of the organization, enforced for every user before launch sessions are created. Anyone may read it, authenticated users need the admin role to set or clear it. "+
+			"The liquidity lock rule requires pools whose ETH side is above liquidity_lock_threshold after an addition to lock the added liquidity for at least min_liquidity_lock_days in the allow-listed liquidity locker: "+
+			"create_liquidity_pool, add_liquidity, migrate_liquidity and rebalance_pool refuse to create the session otherwise and show the rule in the session checklist. "+
+			"Deploy the locker from the Liquidity Locker template, import it with import_templates pack=liquidity-lock."),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("get to read the policy, set to create or replace it, clear to remove it"),
+			mcp.Enum(launchPolicyActionGet, launchPolicyActionSet, launchPolicyActionClear),
+		),
+		mcp.WithString("liquidity_lock_threshold",
+			mcp.Description("ETH side of a new pool in wei above which the liquidity must be locked, \"0\" requires a lock for every ETH pool. Required by set"),
+		),
+		mcp.WithString("min_liquidity_lock_days",
+			mcp.Description("Minimum lock duration in days. Required by set"),
+		),
+		mcp.WithString("liquidity_locker_address",
+			mcp.Description("Liquidity locker contract deployed from the Liquidity Locker template on the chains of the launches, the only locker the liquidity tools accept. Required by set"),
+		),
+	)
+
+	return tool
+}
+
+func (m *manageLaunchPolicyTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ManageLaunchPolicyArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		// The policy applies to every user, the local server has no user so a user needs the admin role to edit it
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if args.Action != launchPolicyActionGet && user != nil && !utils.HasRole(ctx, adminRole) {
+			return mcp.NewToolResultError(fmt.Sprintf("manage_launch_policy action=%s requires the admin role", args.Action)), nil
+		}
+
+		switch args.Action {
+		case launchPolicyActionClear:
+			if err := m.launchPolicyService.DeleteLaunchPolicy(); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to clear launch policy: %v", err)), nil
+			}
+			return mcp.NewToolResultText("Launch policy cleared"), nil
+		case launchPolicyActionSet:
+			policy, err := parseLaunchPolicy(args)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if user != nil {
+				policy.UpdatedBy = &user.Sub
+			}
+			if err := m.launchPolicyService.SaveLaunchPolicy(policy); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to save launch policy: %v", err)), nil
+			}
+			return launchPolicyResult("Launch policy saved: ", policy), nil
+		}
+
+		policy, err := m.launchPolicyService.GetLaunchPolicy()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get launch policy: %v", err)), nil
+		}
+		if policy == nil {
+			return mcp.NewToolResultText("No launch policy configured"), nil
+		}
+		return launchPolicyResult("Launch policy: ", policy), nil
+	}
+}
+
+// parseLaunchPolicy validates the policy values of a set action
+func parseLaunchPolicy(args ManageLaunchPolicyArguments) (*models.LaunchPolicy, error) {
+	threshold, ok := new(big.Int).SetString(args.LiquidityLockThreshold, 10)
+	if !ok || threshold.Sign() < 0 {
+		return nil, fmt.Errorf("Invalid liquidity_lock_threshold: must be a non-negative amount of wei")
+	}
+
+	days, err := strconv.Atoi(args.MinLiquidityLockDays)
+	if err != nil || days <= 0 {
+		return nil, fmt.Errorf("Invalid min_liquidity_lock_days: must be a positive number of days")
+	}
+
+	if !utils.IsValidEthereumAddress(args.LiquidityLockerAddress) {
+		return nil, fmt.Errorf("Invalid liquidity_locker_address: %q, the policy needs the address of the liquidity locker", args.LiquidityLockerAddress)
+	}
+
+	return &models.LaunchPolicy{
+		LiquidityLockThreshold: threshold.String(),
+		MinLiquidityLockDays:   days,
+		LiquidityLockerAddress: common.HexToAddress(args.LiquidityLockerAddress).Hex(),
+	}, nil
+}
+
+func launchPolicyResult(title string, policy *models.LaunchPolicy) *mcp.CallToolResult {
+	policyJSON, _ := json.Marshal(policy)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(title),
+			mcp.NewTextContent(string(policyJSON)),
+		},
+	}
+}
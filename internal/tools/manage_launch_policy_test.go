@@ -0,0 +1,209 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const launchPolicyLocker = "0x4444444444444444444444444444444444444444"
+
+type ManageLaunchPolicyToolTestSuite struct {
+	suite.Suite
+	db                  services.DBService
+	tool                *manageLaunchPolicyTool
+	poolTool            *createLiquidityPoolTool
+	launchPolicyService services.LaunchPolicyService
+	txService           services.TransactionService
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chainService := services.NewChainService(db.GetDB())
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(chainService.CreateChain(chain))
+	suite.Require().NoError(db.GetDB().Create(&models.UniswapDeployment{Version: "v2", RouterAddress: allowanceRouter, WETHAddress: allowanceWETH, ChainID: chain.ID}).Error)
+
+	suite.launchPolicyService = services.NewLaunchPolicyService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tool = NewManageLaunchPolicyTool(suite.launchPolicyService)
+	suite.poolTool = NewCreateLiquidityPoolTool(chainService, 8080, services.NewEvmService(), suite.txService, services.NewLiquidityService(db.GetDB()), services.NewUniswapService(db.GetDB()), suite.launchPolicyService)
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) SetupTest() {
+	suite.Require().NoError(suite.launchPolicyService.DeleteLaunchPolicy())
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) call(handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), arguments map[string]interface{}) *mcp.CallToolResult {
+	result, err := handler(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{Arguments: arguments},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) setPolicy() {
+	result := suite.call(suite.tool.GetHandler(), map[string]interface{}{
+		"action":                   "set",
+		"liquidity_lock_threshold": "10000000000000000000",
+		"min_liquidity_lock_days":  "30",
+		"liquidity_locker_address": launchPolicyLocker,
+	})
+	suite.Require().False(result.IsError, result.Content)
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) createPool(arguments map[string]interface{}) *mcp.CallToolResult {
+	arguments["token0_address"] = allowanceToken
+	arguments["token1_address"] = services.EthTokenAddress
+	arguments["initial_token0_amount"] = "1000000000000000000000000"
+	arguments["owner_address"] = allowanceOwner
+	return suite.call(suite.poolTool.GetHandler(), arguments)
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TestSetGetAndClearPolicy() {
+	suite.setPolicy()
+
+	result := suite.call(suite.tool.GetHandler(), map[string]interface{}{"action": "get"})
+	suite.Require().False(result.IsError, result.Content)
+	suite.Require().Len(result.Content, 2)
+
+	var policy models.LaunchPolicy
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &policy))
+	suite.Equal("10000000000000000000", policy.LiquidityLockThreshold)
+	suite.Equal(30, policy.MinLiquidityLockDays)
+	suite.Equal(launchPolicyLocker, policy.LiquidityLockerAddress)
+
+	result = suite.call(suite.tool.GetHandler(), map[string]interface{}{"action": "clear"})
+	suite.Require().False(result.IsError, result.Content)
+
+	result = suite.call(suite.tool.GetHandler(), map[string]interface{}{"action": "get"})
+	textContent, _ = result.Content[0].(mcp.TextContent)
+	suite.Equal("No launch policy configured", textContent.Text)
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TestEditsRequireTheAdminRole() {
+	arguments := map[string]interface{}{
+		"action":                   "set",
+		"liquidity_lock_threshold": "0",
+		"min_liquidity_lock_days":  "30",
+		"liquidity_locker_address": launchPolicyLocker,
+	}
+	call := func(ctx context.Context, arguments map[string]interface{}) *mcp.CallToolResult {
+		result, err := suite.tool.GetHandler()(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		suite.Require().NoError(err)
+		return result
+	}
+	user := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1", Roles: []string{"user"}})
+	admin := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "admin-1", Roles: []string{"admin"}})
+
+	result := call(user, arguments)
+	suite.True(result.IsError)
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	suite.Contains(textContent.Text, "requires the admin role")
+
+	result = call(admin, arguments)
+	suite.Require().False(result.IsError, result.Content)
+
+	// the organization policy applies to every user, who can read it but not clear it
+	policy, err := suite.launchPolicyService.GetLaunchPolicy()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(policy)
+	suite.Require().NotNil(policy.UpdatedBy)
+	suite.Equal("admin-1", *policy.UpdatedBy)
+
+	result = call(user, map[string]interface{}{"action": "get"})
+	suite.Require().False(result.IsError, result.Content)
+	suite.Len(result.Content, 2)
+	result = call(user, map[string]interface{}{"action": "clear"})
+	suite.True(result.IsError)
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TestRejectsInvalidPolicies() {
+	cases := map[string]map[string]interface{}{
+		"Invalid liquidity_lock_threshold":                     {"liquidity_lock_threshold": "-1", "min_liquidity_lock_days": "30"},
+		"Invalid min_liquidity_lock_days":                      {"liquidity_lock_threshold": "0", "min_liquidity_lock_days": "0"},
+		"Invalid liquidity_locker_address":                     {"liquidity_lock_threshold": "0", "min_liquidity_lock_days": "30", "liquidity_locker_address": "0x123"},
+		"the policy needs the address of the liquidity locker": {"liquidity_lock_threshold": "0", "min_liquidity_lock_days": "30"},
+	}
+	for message, arguments := range cases {
+		arguments["action"] = "set"
+
+		result := suite.call(suite.tool.GetHandler(), arguments)
+		suite.True(result.IsError, message)
+		textContent, _ := result.Content[0].(mcp.TextContent)
+		suite.Contains(textContent.Text, message)
+	}
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TestCreateLiquidityPoolEnforcesLiquidityLock() {
+	suite.setPolicy()
+
+	cases := map[string]map[string]interface{}{
+		"must lock their liquidity for at least 30 days but liquidity is not locked": {},
+		"but liquidity is locked for 7 days":                                         {"lock_days": "7"},
+	}
+	for message, arguments := range cases {
+		// 20 ETH is above the 10 ETH threshold of the policy
+		arguments["initial_token1_amount"] = "20000000000000000000"
+
+		result := suite.createPool(arguments)
+		suite.True(result.IsError, message)
+		textContent, _ := result.Content[0].(mcp.TextContent)
+		suite.Contains(textContent.Text, "Launch policy violation")
+		suite.Contains(textContent.Text, message)
+	}
+
+	var sessions int64
+	suite.Require().NoError(suite.db.GetDB().Model(&models.TransactionSession{}).Count(&sessions).Error)
+	suite.Zero(sessions)
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TestCreateLiquidityPoolRequiresThePolicyLocker() {
+	suite.setPolicy()
+
+	result := suite.createPool(map[string]interface{}{
+		"initial_token1_amount": "20000000000000000000",
+		"lock_days":             "30",
+		"locker_address":        "0x5555555555555555555555555555555555555555",
+	})
+	suite.True(result.IsError)
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	suite.Contains(textContent.Text, "is not the liquidity locker "+launchPolicyLocker+" of the launch policy")
+}
+
+func (suite *ManageLaunchPolicyToolTestSuite) TestCreateLiquidityPoolRequiresLocker() {
+	result := suite.createPool(map[string]interface{}{
+		"initial_token1_amount": "20000000000000000000",
+		"lock_days":             "30",
+	})
+	suite.True(result.IsError)
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	suite.Contains(textContent.Text, "lock_days requires a locker_address")
+}
+
+func TestManageLaunchPolicyToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ManageLaunchPolicyToolTestSuite))
+}
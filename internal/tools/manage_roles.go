@@ -0,0 +1,300 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	roleActionGrant    = "grant"
+	roleActionRevoke   = "revoke"
+	roleActionRenounce = "renounce"
+	roleActionList     = "list"
+)
+
+// roleSyncMaxRanges bounds the block ranges a list call syncs, the next call continues from the cache
+const roleSyncMaxRanges = 25
+
+// roleActionFunctions maps the state-changing role actions to their AccessControl function
+var roleActionFunctions = map[string]string{
+	roleActionGrant:    "grantRole",
+	roleActionRevoke:   "revokeRole",
+	roleActionRenounce: "renounceRole",
+}
+
+type manageRolesTool struct {
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	evmService        services.EvmService
+	txService         services.TransactionService
+	roleService       services.RoleService
+	serverPort        int
+}
+
+type ManageRolesArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+	Action       string `json:"action" validate:"required,oneof=grant revoke renounce list"`
+
+	// Optional fields
+	Role    string `json:"role,omitempty"`
+	Account string `json:"account,omitempty"`
+}
+
+type RoleMembersResult struct {
+	Role     string   `json:"role"`
+	RoleName string   `json:"role_name,omitempty"`
+	Members  []string `json:"members"`
+}
+
+type ListRolesResult struct {
+	DeploymentID    uint                `json:"deployment_id"`
+	ContractAddress string              `json:"contract_address"`
+	SyncedBlock     uint64              `json:"synced_block"`
+	LatestBlock     uint64              `json:"latest_block"`
+	Roles           []RoleMembersResult `json:"roles"`
+}
+
+func NewManageRolesTool(chainService services.ChainService, deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, roleService services.RoleService, serverPort int) *manageRolesTool {
+	return &manageRolesTool{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		evmService:        evmService,
+		txService:         txService,
+		roleService:       roleService,
+		serverPort:        serverPort,
+	}
+}
+
+func (m *manageRolesTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("manage_roles",
+		mcp.WithDescription("Manage OpenZeppelin AccessControl roles of a deployed contract. grant, revoke and renounce create a transaction session with a signing URL. list returns the role members, synced from RoleGranted and RoleRevoked events into a local cache."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed deployment using AccessControl"),
+		),
+		mcp.WithString("action",
+			mcp.Required(),
+			mcp.Description("Role action to perform"),
+			mcp.Enum(roleActionGrant, roleActionRevoke, roleActionRenounce, roleActionList),
+		),
+		mcp.WithString("role",
+			mcp.Description("Role name (e.g. MINTER_ROLE, DEFAULT_ADMIN_ROLE) or bytes32 role identifier. Required for grant, revoke and renounce, optional filter for list"),
+		),
+		mcp.WithString("account",
+			mcp.Description("Account to grant or revoke the role. For renounce, the account renouncing its role, which must sign the transaction"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (m *manageRolesTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ManageRolesArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := m.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Role management is only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		deployment, err := getConfirmedDeployment(m.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		if deployment.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", deployment.ChainID, activeChain.ID)), nil
+		}
+
+		if deploymentLacksInterface(deployment, utils.InterfaceAccessControl) {
+			return mcp.NewToolResultError(fmt.Sprintf("Contract %s does not use AccessControl (detected by detect_interfaces)", deployment.ContractAddress)), nil
+		}
+
+		var role string
+		if args.Role != "" {
+			role, err = utils.ResolveRole(args.Role)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid role: %v", err)), nil
+			}
+		}
+
+		if args.Action == roleActionList {
+			return m.listRoles(deployment, activeChain, role)
+		}
+
+		if role == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("role is required for %s", args.Action)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.Account) {
+			return mcp.NewToolResultError("account must be a valid Ethereum address"), nil
+		}
+
+		sessionID, err := m.createRoleTransaction(ctx, args, role, activeChain, deployment)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create role transaction: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, m.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Role %s transaction session created: %s", args.Action, sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Contract: %s (Deployment ID: %s)", deployment.ContractAddress, args.DeploymentID)),
+				mcp.NewTextContent("Please sign the transaction in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// listRoles syncs the role cache from the events emitted since the last sync and returns the members. A deployment
+// synced for the first time starts at the block of its deployment transaction, the events are read in
+// DefaultIndexerBlockRange chunks and the cache advances after each chunk
+func (m *manageRolesTool) listRoles(deployment *models.Deployment, activeChain *models.Chain, role string) (*mcp.CallToolResult, error) {
+	var fromBlock uint64
+	lastSyncedBlock, synced, err := m.roleService.GetLastSyncedBlock(deployment.ID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to read role cache: %v", err)), nil
+	}
+	if synced {
+		fromBlock = lastSyncedBlock + 1
+	} else if deployment.TransactionHash != "" {
+		if block, err := utils.TransactionBlock(activeChain.RPC, deployment.TransactionHash); err == nil {
+			fromBlock = block
+		}
+	}
+
+	var syncedBlock, latestBlock uint64
+	for ranges := 0; ranges < roleSyncMaxRanges; ranges++ {
+		var events []utils.RoleEvent
+		events, syncedBlock, latestBlock, err = utils.FetchRoleEvents(activeChain.RPC, deployment.ContractAddress, fromBlock, services.DefaultIndexerBlockRange)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to sync role events: %v", err)), nil
+		}
+		if err := m.roleService.ApplyRoleEvents(deployment.ID, events, syncedBlock); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update role cache: %v", err)), nil
+		}
+		if syncedBlock >= latestBlock {
+			break
+		}
+		fromBlock = syncedBlock + 1
+	}
+
+	members, err := m.roleService.ListRoleMembers(deployment.ID, role)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list role members: %v", err)), nil
+	}
+
+	result := ListRolesResult{
+		DeploymentID:    deployment.ID,
+		ContractAddress: deployment.ContractAddress,
+		SyncedBlock:     syncedBlock,
+		LatestBlock:     latestBlock,
+		Roles:           []RoleMembersResult{},
+	}
+	// Members are ordered by role, group consecutive members
+	for _, member := range members {
+		if len(result.Roles) == 0 || result.Roles[len(result.Roles)-1].Role != member.Role {
+			result.Roles = append(result.Roles, RoleMembersResult{
+				Role:     member.Role,
+				RoleName: utils.RoleName(member.Role),
+				Members:  []string{},
+			})
+		}
+		last := &result.Roles[len(result.Roles)-1]
+		last.Members = append(last.Members, member.Account)
+	}
+
+	message := fmt.Sprintf("Found %d roles with members: ", len(result.Roles))
+	if syncedBlock < latestBlock {
+		message = fmt.Sprintf("Found %d roles with members up to block %d of %d, list again to sync the next blocks: ", len(result.Roles), syncedBlock, latestBlock)
+	}
+	resultJSON, _ := json.Marshal(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(message),
+			mcp.NewTextContent(string(resultJSON)),
+		},
+	}, nil
+}
+
+func (m *manageRolesTool) createRoleTransaction(ctx context.Context, args ManageRolesArguments, role string, activeChain *models.Chain, deployment *models.Deployment) (string, error) {
+	functionName := roleActionFunctions[args.Action]
+	functionArgs := []any{role, args.Account}
+
+	roleLabel := args.Role
+	if name := utils.RoleName(role); name != "" {
+		roleLabel = name
+	}
+
+	tx, err := m.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: deployment.ContractAddress,
+		FunctionName:    functionName,
+		FunctionArgs:    functionArgs,
+		Abi:             utils.AccessControlAbi,
+		Value:           "0",
+		Title:           fmt.Sprintf("%s %s", functionName, roleLabel),
+		Description:     fmt.Sprintf("Call %s with role %s for %s on contract %s", functionName, roleLabel, args.Account, deployment.ContractAddress),
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s transaction: %w", functionName, err)
+	}
+
+	functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(functionName, functionArgs, utils.AccessControlAbi)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	tx.RawContractArguments = &functionArgsString
+	tx.ContractAddress = &deployment.ContractAddress
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	sessionID, err := m.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{tx},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "deployment_id", Value: args.DeploymentID},
+			{Key: "function_name", Value: functionName},
+			{Key: "role", Value: roleLabel},
+			{Key: "account", Value: args.Account},
+		},
+		UserID:  userId,
+		Context: ctx,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+
+	return sessionID, nil
+}
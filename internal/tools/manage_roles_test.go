@@ -0,0 +1,268 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	roleContract = "0x1111111111111111111111111111111111111111"
+	roleAccount  = "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+)
+
+type ManageRolesToolTestSuite struct {
+	suite.Suite
+	db                services.DBService
+	rpcServer         *httptest.Server
+	tool              *manageRolesTool
+	chain             *models.Chain
+	template          *models.Template
+	deploymentService services.DeploymentService
+	roleService       services.RoleService
+	txService         services.TransactionService
+	blockNumber       uint64
+	logs              []map[string]interface{}
+	logFilters        []map[string]interface{}
+	deploymentBlock   uint64
+}
+
+func (suite *ManageRolesToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.rpcServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		var result interface{}
+		switch request.Method {
+		case "eth_blockNumber":
+			result = fmt.Sprintf("0x%x", suite.blockNumber)
+		case "eth_getLogs":
+			suite.logFilters = append(suite.logFilters, request.Params[0].(map[string]interface{}))
+			result = suite.logs
+		case "eth_getTransactionReceipt":
+			result = map[string]interface{}{"blockNumber": fmt.Sprintf("0x%x", suite.deploymentBlock), "status": "0x1"}
+		}
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result})
+	}))
+
+	chainService := services.NewChainService(db.GetDB())
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.roleService = services.NewRoleService(db.GetDB())
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tool = NewManageRolesTool(chainService, suite.deploymentService, services.NewEvmService(), suite.txService, suite.roleService, 8080)
+
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       suite.rpcServer.URL,
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(chainService.CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{
+		Name:      "Roles Token",
+		ChainType: models.TransactionChainTypeEthereum,
+	}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *ManageRolesToolTestSuite) TearDownSuite() {
+	suite.rpcServer.Close()
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ManageRolesToolTestSuite) createDeployment() *models.Deployment {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: roleContract,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *ManageRolesToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func roleLog(event string, role string, account string, block uint64) map[string]interface{} {
+	topic := crypto.Keccak256Hash([]byte(event + "(bytes32,address,address)")).Hex()
+	return map[string]interface{}{
+		"topics":      []string{topic, role, "0x000000000000000000000000" + account[2:], "0x000000000000000000000000" + account[2:]},
+		"blockNumber": fmt.Sprintf("0x%x", block),
+		"logIndex":    "0x0",
+	}
+}
+
+func (suite *ManageRolesToolTestSuite) TestGrantRole() {
+	deployment := suite.createDeployment()
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"action":        "grant",
+		"role":          "MINTER_ROLE",
+		"account":       roleAccount,
+	})
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 4)
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	suite.Require().True(ok)
+	var sessionID string
+	_, err := fmt.Sscanf(textContent.Text, "Role grant transaction session created: %s", &sessionID)
+	suite.Require().NoError(err)
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Require().Len(session.TransactionDeployments, 1)
+	// grantRole(bytes32,address) selector
+	suite.Contains(session.TransactionDeployments[0].Data, "2f2ff15d")
+	suite.Equal(roleContract, session.TransactionDeployments[0].Receiver)
+}
+
+func (suite *ManageRolesToolTestSuite) TestListRolesSyncsCache() {
+	deployment := suite.createDeployment()
+	minterRole, _ := utils.ResolveRole("MINTER_ROLE")
+	otherAccount := "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"
+
+	suite.blockNumber = 10
+	suite.logs = []map[string]interface{}{
+		roleLog("RoleGranted", utils.DefaultAdminRole, roleAccount, 1),
+		roleLog("RoleGranted", minterRole, roleAccount, 2),
+		roleLog("RoleGranted", minterRole, otherAccount, 3),
+	}
+	suite.logFilters = nil
+
+	args := map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"action":        "list",
+	}
+	result := suite.callHandler(args)
+	suite.Require().False(result.IsError)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+	var listed ListRolesResult
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &listed))
+	suite.Require().Len(listed.Roles, 2)
+	suite.Equal("DEFAULT_ADMIN_ROLE", listed.Roles[0].RoleName)
+	suite.Equal("MINTER_ROLE", listed.Roles[1].RoleName)
+	suite.ElementsMatch([]string{roleAccount, otherAccount}, listed.Roles[1].Members)
+
+	// The next sync only fetches new blocks and applies the revocation
+	suite.blockNumber = 12
+	suite.logs = []map[string]interface{}{roleLog("RoleRevoked", minterRole, otherAccount, 11)}
+	args["role"] = "MINTER_ROLE"
+	result = suite.callHandler(args)
+	suite.Require().False(result.IsError)
+	suite.Equal("0xb", suite.logFilters[1]["fromBlock"])
+
+	members, err := suite.roleService.ListRoleMembers(deployment.ID, minterRole)
+	suite.Require().NoError(err)
+	suite.Require().Len(members, 1)
+	suite.Equal(roleAccount, members[0].Account)
+}
+
+func (suite *ManageRolesToolTestSuite) TestListRolesPagesFromTheDeploymentBlock() {
+	deployment := &models.Deployment{
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		Status:          models.TransactionStatusConfirmed,
+		ContractAddress: roleContract,
+		TransactionHash: "0xdeploy",
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+
+	suite.deploymentBlock = 100
+	suite.blockNumber = 100 + 2*services.DefaultIndexerBlockRange + 5
+	suite.logs = []map[string]interface{}{}
+	suite.logFilters = nil
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"action":        "list",
+	})
+	suite.Require().False(result.IsError)
+
+	// The sync starts at the deployment block and reads bounded ranges up to the latest block
+	suite.Require().Len(suite.logFilters, 3)
+	suite.Equal("0x64", suite.logFilters[0]["fromBlock"])
+	suite.Equal(fmt.Sprintf("0x%x", 100+services.DefaultIndexerBlockRange-1), suite.logFilters[0]["toBlock"])
+	suite.Equal(fmt.Sprintf("0x%x", 100+services.DefaultIndexerBlockRange), suite.logFilters[1]["fromBlock"])
+	suite.Equal(fmt.Sprintf("0x%x", suite.blockNumber), suite.logFilters[2]["toBlock"])
+
+	syncedBlock, synced, err := suite.roleService.GetLastSyncedBlock(deployment.ID)
+	suite.Require().NoError(err)
+	suite.True(synced)
+	suite.Equal(suite.blockNumber, syncedBlock)
+}
+
+func (suite *ManageRolesToolTestSuite) TestInvalidArguments() {
+	deployment := suite.createDeployment()
+	id := fmt.Sprintf("%d", deployment.ID)
+
+	testCases := []struct {
+		name string
+		args map[string]interface{}
+	}{
+		{"invalid action", map[string]interface{}{"deployment_id": id, "action": "transfer"}},
+		{"missing role", map[string]interface{}{"deployment_id": id, "action": "grant", "account": roleAccount}},
+		{"invalid role", map[string]interface{}{"deployment_id": id, "action": "grant", "role": "not a role", "account": roleAccount}},
+		{"invalid account", map[string]interface{}{"deployment_id": id, "action": "revoke", "role": "MINTER_ROLE", "account": "0x123"}},
+		{"unknown deployment", map[string]interface{}{"deployment_id": "999", "action": "list"}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.True(suite.callHandler(tc.args).IsError)
+		})
+	}
+}
+
+func (suite *ManageRolesToolTestSuite) TestRejectsContractWithoutAccessControl() {
+	deployment := suite.createDeployment()
+	suite.Require().NoError(suite.deploymentService.UpdateDeploymentInterfaces(deployment.ID, models.JSON{
+		"interfaces": []interface{}{
+			map[string]interface{}{"interface": string(utils.InterfaceAccessControl), "supported": false},
+		},
+	}))
+
+	result := suite.callHandler(map[string]interface{}{
+		"deployment_id": fmt.Sprintf("%d", deployment.ID),
+		"action":        "list",
+	})
+	suite.True(result.IsError)
+}
+
+func TestManageRolesToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ManageRolesToolTestSuite))
+}
@@ -0,0 +1,25 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// mevProtectionMetadataKey is the session metadata shown in the signing page when MEV protection is on
+const mevProtectionMetadataKey = "mev_protection"
+
+// applyMevProtection flags the swap and liquidity transactions to be submitted through the private relay of the chain.
+// Approvals and other regular transactions are still broadcast by the wallet.
+func applyMevProtection(chain *models.Chain, transactions []models.TransactionDeployment) error {
+	if chain.PrivateRelayRPC == "" {
+		return fmt.Errorf("MEV protection is not configured for chain %s. Please use set_chain with private_relay_rpc first", chain.Name)
+	}
+
+	for i := range transactions {
+		if transactions[i].TransactionType != models.TransactionTypeRegular {
+			transactions[i].PrivateRelay = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,436 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// defaultMigrationSlippage is the slippage tolerance of the removal and the addition of a migration, in percent
+const defaultMigrationSlippage = 0.5
+
+type migrateLiquidityTool struct {
+	chainService        services.ChainService
+	evmService          services.EvmService
+	txService           services.TransactionService
+	liquidityService    services.LiquidityService
+	uniswapService      services.UniswapService
+	launchPolicyService services.LaunchPolicyService
+	serverPort          int
+}
+
+type MigrateLiquidityArguments struct {
+	// Required fields
+	PoolID                string `json:"pool_id" validate:"required"`
+	TargetDexDeploymentID string `json:"target_dex_deployment_id" validate:"required"`
+	OwnerAddress          string `json:"owner_address" validate:"required"`
+
+	// Optional fields
+	SourceDexDeploymentID string `json:"source_dex_deployment_id,omitempty"`
+	LiquidityAmount       string `json:"liquidity_amount,omitempty"`
+	SlippageTolerance     string `json:"slippage_tolerance,omitempty"`
+	DryRun                bool   `json:"dry_run,omitempty"`
+	LiquidityLockArguments
+	RouterTransactionOverrides
+}
+
+func NewMigrateLiquidityTool(chainService services.ChainService, evmService services.EvmService, txService services.TransactionService, liquidityService services.LiquidityService, uniswapService services.UniswapService, launchPolicyService services.LaunchPolicyService, serverPort int) *migrateLiquidityTool {
+	return &migrateLiquidityTool{
+		chainService:        chainService,
+		evmService:          evmService,
+		txService:           txService,
+		liquidityService:    liquidityService,
+		uniswapService:      uniswapService,
+		launchPolicyService: launchPolicyService,
+		serverPort:          serverPort,
+	}
+}
+
+func (m *migrateLiquidityTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("migrate_liquidity",
+		mcp.WithDescription("Move the liquidity of a token/ETH Uniswap V2 pool to another DEX deployment of the chain, e.g. from an app-owned fork to the canonical Uniswap router. "+
+			"Creates one session approving the LP tokens, removing the liquidity from the source router and adding it to the target router, with the minimum amounts of both steps computed from the on-chain reserves. "+
+			"The addition spends at most the minimums of the removal at the price of the target pair, the rest stays in the wallet. Only V2 targets are supported, V3 positions cannot be created yet. "+
+			"The liquidity lock rule of the launch policy applies to the target pool after the addition, pass lock_days when it requires a lock."),
+		mcp.WithString("pool_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed liquidity pool to migrate"),
+		),
+		mcp.WithString("target_dex_deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the Uniswap V2 deployment receiving the liquidity, as listed by get_uniswap_addresses"),
+		),
+		mcp.WithString("owner_address",
+			mcp.Required(),
+			mcp.Description("Address holding the LP tokens, it signs the session and receives the LP tokens of the target pool, or their lock when lock_days is set"),
+		),
+		mcp.WithString("source_dex_deployment_id",
+			mcp.Description("ID of the Uniswap deployment the pool was created on. Optional, defaults to the deployment recorded on the pool, or the default deployment of the chain"),
+		),
+		mcp.WithString("liquidity_amount",
+			mcp.Description("Amount of LP tokens to migrate. Optional, defaults to the whole LP balance of the owner"),
+		),
+		mcp.WithString("slippage_tolerance",
+			mcp.Description(fmt.Sprintf("Slippage tolerance in percent of the removal and the addition. Optional, defaults to %g", defaultMigrationSlippage)),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	for _, option := range append(liquidityLockOptions(), routerOverrideOptions()...) {
+		option(&tool)
+	}
+	return tool
+}
+
+func (m *migrateLiquidityTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args MigrateLiquidityArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.OwnerAddress) {
+			return mcp.NewToolResultError("Owner address is not a valid Ethereum address"), nil
+		}
+		slippage := defaultMigrationSlippage
+		if args.SlippageTolerance != "" {
+			parsed, err := strconv.ParseFloat(args.SlippageTolerance, 64)
+			if err != nil || parsed < 0 || parsed >= 100 {
+				return mcp.NewToolResultError("Invalid slippage_tolerance: must be a percentage between 0 and 100"), nil
+			}
+			slippage = parsed
+		}
+		overrides, err := parseRouterOverrides(args.RouterTransactionOverrides)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		activeChain, err := m.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Uniswap liquidity operations are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		poolID, err := strconv.ParseUint(args.PoolID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid pool_id format: %v", err)), nil
+		}
+		pool, err := m.liquidityService.GetLiquidityPool(uint(poolID))
+		if err != nil {
+			return mcp.NewToolResultError("Liquidity pool not found"), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (pool.UserID == nil || *pool.UserID != userID) {
+			return mcp.NewToolResultError("Liquidity pool not found"), nil
+		}
+		if pool.Status != models.TransactionStatusConfirmed || pool.PairAddress == "" {
+			return mcp.NewToolResultError("Liquidity pool is not confirmed yet. Please wait for the pool creation transaction to be confirmed"), nil
+		}
+		tokenAddress := pool.Token0
+		if tokenAddress == services.EthTokenAddress {
+			tokenAddress = pool.Token1
+		} else if pool.Token1 != services.EthTokenAddress {
+			return mcp.NewToolResultError("Only token/ETH pools can be migrated"), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+		source, err := selectPoolDexDeployment(m.uniswapService, userId, activeChain, pool, args.SourceDexDeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		target, err := selectDexDeployment(m.uniswapService, userId, activeChain, args.TargetDexDeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if source.ID == target.ID {
+			return mcp.NewToolResultError("The source and target DEX deployments are the same, pass source_dex_deployment_id when the pool is not on the default deployment"), nil
+		}
+		if !strings.EqualFold(target.Version, "v2") {
+			return mcp.NewToolResultError(fmt.Sprintf("DEX deployment %d is Uniswap %s, only V2 targets are supported: V3 positions cannot be created yet", target.ID, target.Version)), nil
+		}
+		if target.RouterAddress == "" || target.FactoryAddress == "" || target.WETHAddress == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("DEX deployment %d is missing its router, factory or WETH address. Please set them with set_uniswap_addresses", target.ID)), nil
+		}
+
+		migration, targetPair, err := m.computeMigration(activeChain.RPC, pool, tokenAddress, source, target, args, slippage)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// Enforce the liquidity lock rule of the launch policy on the ETH reserve of the target pair after the addition
+		lockPlan, err := planLiquidityLock(m.launchPolicyService, activeChain.RPC, args.LiquidityLockArguments, func() (*big.Int, error) {
+			ethAmount, _ := new(big.Int).SetString(migration.AddETH, 10)
+			targetETH, _ := new(big.Int).SetString(migration.TargetETHReserve, 10)
+			return ethAmount.Add(ethAmount, targetETH), nil
+		})
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		transactionDeployments, err := m.createMigrationTransactions(pool.PairAddress, tokenAddress, source, target, migration, args.OwnerAddress, lockPlan.lpReceiver(args.OwnerAddress), overrides.Deadline)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity migration transactions: %v", err)), nil
+		}
+		if lockPlan.Lock != nil {
+			lockTx, err := createLiquidityLockTransaction(m.evmService, lockPlan.Lock, tokenAddress, target.WETHAddress, args.OwnerAddress)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity lock transaction: %v", err)), nil
+			}
+			transactionDeployments = append(transactionDeployments, lockTx)
+		}
+		// The approvals already covered by the allowances of the owner are left out of the session
+		transactionDeployments, skippedApprovals := omitSufficientApprovals(activeChain.RPC, args.OwnerAddress, transactionDeployments, map[string]string{
+			pool.PairAddress: migration.Liquidity,
+			tokenAddress:     migration.AddToken,
+		})
+		overrides.apply(transactionDeployments)
+
+		metadata := []models.TransactionMetadata{
+			{Key: "pool_id", Value: strconv.FormatUint(uint64(pool.ID), 10)},
+			{Key: "action", Value: "migrate_liquidity"},
+			{Key: "token_address", Value: tokenAddress},
+			{Key: "source_dex_deployment_id", Value: strconv.FormatUint(uint64(source.ID), 10)},
+			{Key: "target_dex_deployment_id", Value: strconv.FormatUint(uint64(target.ID), 10)},
+			{Key: "target_factory_address", Value: target.FactoryAddress},
+			{Key: "target_weth_address", Value: target.WETHAddress},
+		}
+		metadata = lockPlan.metadata(skippedApprovalsMetadata(metadata, skippedApprovals))
+		session := services.CreateTransactionSessionRequest{
+			TransactionDeployments: transactionDeployments,
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                activeChain.ID,
+			Metadata:               metadata,
+			UserID:                 userId,
+		}
+
+		// a pair created by the migration is recorded as a new pool, confirmed by the liquidity pool hook
+		var targetPool *models.LiquidityPool
+		if targetPair == "" {
+			targetPool = &models.LiquidityPool{
+				UserID:          userId,
+				TokenAddress:    pool.TokenAddress,
+				UniswapVersion:  target.Version,
+				Token0:          pool.Token0,
+				Token1:          pool.Token1,
+				InitialToken0:   migration.AddToken,
+				InitialToken1:   migration.AddETH,
+				CreatorAddress:  args.OwnerAddress,
+				Status:          models.TransactionStatusPending,
+				DexDeploymentID: &target.ID,
+			}
+			if pool.Token0 == services.EthTokenAddress {
+				targetPool.InitialToken0, targetPool.InitialToken1 = migration.AddETH, migration.AddToken
+			}
+		}
+		if args.DryRun {
+			var records []DryRunRecord
+			if targetPool != nil {
+				records = append(records, DryRunRecord{Type: "liquidity_pool", Record: targetPool})
+			}
+			return newDryRunResult("migrate_liquidity", []services.CreateTransactionSessionRequest{session}, records...)
+		}
+
+		session.Context = ctx
+		sessionID, err := m.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating transaction session: %v", err)), nil
+		}
+		if targetPool != nil {
+			targetPool.SessionId = sessionID
+			if _, err := m.liquidityService.CreateLiquidityPool(targetPool); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity pool record: %v", err)), nil
+			}
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, m.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Liquidity migration session created: %s", sessionID)),
+			mcp.NewTextContent(fmt.Sprintf("Removes %s LP tokens from pool %d on DEX deployment %d and adds %s tokens and %s wei to DEX deployment %d",
+				migration.Liquidity, pool.ID, source.ID, migration.AddToken, migration.AddETH, target.ID)),
+		}
+		if targetPair == "" {
+			content = append(content, mcp.NewTextContent(fmt.Sprintf("The target pair does not exist yet, it is created at the source price and recorded as pool %d", targetPool.ID)))
+		} else if migration.TargetPrice > 0 && migration.SourcePrice > 0 {
+			content = append(content, mcp.NewTextContent(fmt.Sprintf("The target pair %s trades at %g ETH per token against %g in the source pool", targetPair, migration.TargetPrice, migration.SourcePrice)))
+		}
+		resultJSON, _ := json.Marshal(migration)
+		content = append(content,
+			mcp.NewTextContent(string(resultJSON)),
+			mcp.NewTextContent("Please sign the migration transactions in the URL:"),
+			mcp.NewTextContent(url),
+		)
+		return &mcp.CallToolResult{Content: content}, nil
+	}
+}
+
+// computeMigration reads the source and target pairs and computes the migrated amounts, it also returns the address
+// of the target pair, empty when the migration creates it
+func (m *migrateLiquidityTool) computeMigration(rpcURL string, pool *models.LiquidityPool, tokenAddress string, source, target *models.UniswapDeployment, args MigrateLiquidityArguments, slippage float64) (*utils.LiquidityMigration, string, error) {
+	// the router removing the liquidity must belong to the factory of the pair
+	sourcePair, err := utils.GetV2PairAddress(rpcURL, source.FactoryAddress, tokenAddress, source.WETHAddress)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to read the pair of DEX deployment %d: %v", source.ID, err)
+	}
+	if !strings.EqualFold(sourcePair, pool.PairAddress) {
+		return nil, "", fmt.Errorf("Pool %d is not a pair of DEX deployment %d, pass the source_dex_deployment_id it was created on", pool.ID, source.ID)
+	}
+
+	position, err := utils.ReadV2PairPosition(rpcURL, pool.PairAddress, args.OwnerAddress)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to read pool %d: %v", pool.ID, err)
+	}
+	liquidity := position.Balance
+	if args.LiquidityAmount != "" {
+		amount, ok := new(big.Int).SetString(args.LiquidityAmount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, "", fmt.Errorf("Invalid liquidity_amount: must be a positive amount of LP tokens")
+		}
+		liquidity = amount
+	}
+	if liquidity.Sign() <= 0 || liquidity.Cmp(position.Balance) > 0 {
+		return nil, "", fmt.Errorf("%s holds %s LP tokens of pool %d, not enough to migrate %s", args.OwnerAddress, position.Balance, pool.ID, liquidity)
+	}
+	sourceToken, sourceETH := position.ReserveOf(tokenAddress), position.ReserveOf(source.WETHAddress)
+	if sourceToken == nil || sourceETH == nil {
+		return nil, "", fmt.Errorf("Pair %s does not hold %s and WETH", pool.PairAddress, tokenAddress)
+	}
+
+	targetPair, err := utils.GetV2PairAddress(rpcURL, target.FactoryAddress, tokenAddress, target.WETHAddress)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to read the pair of DEX deployment %d: %v", target.ID, err)
+	}
+	targetToken, targetETH := new(big.Int), new(big.Int)
+	if targetPair != "" {
+		targetPosition, err := utils.ReadV2PairPosition(rpcURL, targetPair, args.OwnerAddress)
+		if err != nil {
+			return nil, "", fmt.Errorf("Failed to read the target pair %s: %v", targetPair, err)
+		}
+		if reserve := targetPosition.ReserveOf(tokenAddress); reserve != nil {
+			targetToken = reserve
+		}
+		if reserve := targetPosition.ReserveOf(target.WETHAddress); reserve != nil {
+			targetETH = reserve
+		}
+	}
+
+	migration, err := utils.ComputeLiquidityMigration(liquidity, position.TotalSupply, sourceToken, sourceETH, targetToken, targetETH, slippage)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to compute the migration of pool %d: %v", pool.ID, err)
+	}
+	return migration, targetPair, nil
+}
+
+// createMigrationTransactions creates the LP token approval, the removal from the source router, the token approval
+// and the addition to the target router minting the LP tokens to lpReceiver
+func (m *migrateLiquidityTool) createMigrationTransactions(pairAddress, tokenAddress string, source, target *models.UniswapDeployment, migration *utils.LiquidityMigration, ownerAddress, lpReceiver string, deadline int64) ([]models.TransactionDeployment, error) {
+	v2Contracts, err := utils.FetchUniswapV2Contracts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Uniswap V2 contracts: %w", err)
+	}
+	routerAbi, err := json.Marshal(v2Contracts.Router.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Router ABI: %w", err)
+	}
+	erc20ABI := `[{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	approveLPTx, err := m.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: pairAddress,
+		FunctionName:    "approve",
+		FunctionArgs:    []any{source.RouterAddress, migration.Liquidity},
+		Abi:             erc20ABI,
+		Value:           "0",
+		Title:           "Approve LP Tokens for Source Router",
+		Description:     fmt.Sprintf("Approve %s LP tokens for the router at %s", migration.Liquidity, source.RouterAddress),
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LP token approval transaction: %w", err)
+	}
+	approveLPTx.ContractAddress = &pairAddress
+
+	// removeLiquidityETH(address token, uint liquidity, uint amountTokenMin, uint amountETHMin, address to, uint deadline)
+	removeTx, err := m.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: source.RouterAddress,
+		FunctionName:    "removeLiquidityETH",
+		FunctionArgs: []any{
+			tokenAddress,
+			migration.Liquidity,
+			migration.RemoveMinToken,
+			migration.RemoveMinETH,
+			ownerAddress,
+			fmt.Sprintf("%d", deadline),
+		},
+		Abi:             string(routerAbi),
+		Value:           "0",
+		Title:           "Remove Liquidity from Source Pool",
+		Description:     fmt.Sprintf("Remove %s LP tokens for at least %s tokens and %s wei", migration.Liquidity, migration.RemoveMinToken, migration.RemoveMinETH),
+		TransactionType: models.TransactionTypeRemoveLiquidity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remove liquidity transaction: %w", err)
+	}
+
+	approveTokenTx, err := m.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: tokenAddress,
+		FunctionName:    "approve",
+		FunctionArgs:    []any{target.RouterAddress, maxUint256.String()},
+		Abi:             erc20ABI,
+		Value:           "0",
+		Title:           "Approve Token for Target Router",
+		Description:     fmt.Sprintf("Approve unlimited token spending for the router at %s", target.RouterAddress),
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token approval transaction: %w", err)
+	}
+	approveTokenTx.ContractAddress = &tokenAddress
+
+	// addLiquidityETH(address token, uint amountTokenDesired, uint amountTokenMin, uint amountETHMin, address to, uint deadline) payable
+	addTx, err := m.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: target.RouterAddress,
+		FunctionName:    "addLiquidityETH",
+		FunctionArgs: []any{
+			tokenAddress,
+			migration.AddToken,
+			migration.AddMinToken,
+			migration.AddMinETH,
+			lpReceiver,
+			fmt.Sprintf("%d", deadline),
+		},
+		Abi:             string(routerAbi),
+		Value:           migration.AddETH,
+		Title:           "Add Liquidity to Target Pool",
+		Description:     fmt.Sprintf("Add %s tokens and %s wei to the pool of the router at %s", migration.AddToken, migration.AddETH, target.RouterAddress),
+		TransactionType: models.TransactionTypeLiquidityMigration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create add liquidity transaction: %w", err)
+	}
+
+	return []models.TransactionDeployment{approveLPTx, removeTx, approveTokenTx, addTx}, nil
+}
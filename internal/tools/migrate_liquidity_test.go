@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	migrationToken         = "0x3333333333333333333333333333333333333333"
+	migrationWETH          = "0x4444444444444444444444444444444444444444"
+	migrationSourcePair    = "0x2222222222222222222222222222222222222222"
+	migrationSourceFactory = "0x5555555555555555555555555555555555555555"
+	migrationTargetFactory = "0x6666666666666666666666666666666666666666"
+	migrationOwner         = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	migrationLocker        = "0x9999999999999999999999999999999999999999"
+)
+
+// newMigrationNode answers the reads of the source pair, a source factory returning the pair and a target factory
+// without it. Allowances are zero so no approval is skipped
+func newMigrationNode(t *testing.T) *httptest.Server {
+	word := func(value int64) []byte { return common.LeftPadBytes(big.NewInt(value).Bytes(), 32) }
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request.Method == "eth_getCode" {
+			// every address holds a contract, e.g. the liquidity locker
+			_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: "0x6080"})
+			return
+		}
+		call := request.Params[0].(map[string]interface{})
+		to, data := strings.ToLower(call["to"].(string)), call["data"].(string)
+
+		output := word(0)
+		switch data[:10] {
+		case "0x0dfe1681": // token0()
+			output = common.LeftPadBytes(common.HexToAddress(migrationToken).Bytes(), 32)
+		case "0xd21220a7": // token1()
+			output = common.LeftPadBytes(common.HexToAddress(migrationWETH).Bytes(), 32)
+		case "0x0902f1ac": // getReserves()
+			output = append(append(word(1_000_000), word(10_000)...), word(1)...)
+		case "0x18160ddd": // totalSupply()
+			output = word(1000)
+		case "0x70a08231": // balanceOf(address)
+			output = word(100)
+		case "0xe6a43905": // getPair(address,address)
+			if to == migrationSourceFactory {
+				output = common.LeftPadBytes(common.HexToAddress(migrationSourcePair).Bytes(), 32)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: hexutil.Encode(output)})
+	}))
+	t.Cleanup(node.Close)
+	return node
+}
+
+func TestMigrateLiquidity(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	node := newMigrationNode(t)
+
+	chainService := services.NewChainService(db)
+	chain := &models.Chain{Name: "Local", RPC: node.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	source := &models.UniswapDeployment{Name: "Fork", Version: "v2", FactoryAddress: migrationSourceFactory, RouterAddress: "0x7777777777777777777777777777777777777777", WETHAddress: migrationWETH, IsDefault: true, Status: models.TransactionStatusConfirmed, ChainID: chain.ID}
+	target := &models.UniswapDeployment{Name: "Uniswap", Version: "v2", FactoryAddress: migrationTargetFactory, RouterAddress: "0x8888888888888888888888888888888888888888", WETHAddress: migrationWETH, Status: models.TransactionStatusConfirmed, ChainID: chain.ID}
+	v3 := &models.UniswapDeployment{Name: "Uniswap V3", Version: "v3", Status: models.TransactionStatusConfirmed, ChainID: chain.ID}
+	for _, deployment := range []*models.UniswapDeployment{source, target, v3} {
+		require.NoError(t, db.Create(deployment).Error)
+	}
+
+	liquidityService := services.NewLiquidityService(db)
+	pool := &models.LiquidityPool{TokenAddress: migrationToken, PairAddress: migrationSourcePair, UniswapVersion: "v2", Token0: migrationToken, Token1: services.EthTokenAddress, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+	poolID := strconv.FormatUint(uint64(pool.ID), 10)
+
+	txService := services.NewTransactionService(db)
+	handler := NewMigrateLiquidityTool(chainService, services.NewEvmService(), txService, liquidityService, services.NewUniswapService(db), services.NewLaunchPolicyService(db), 8080).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		args["pool_id"] = poolID
+		args["owner_address"] = migrationOwner
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(map[string]any{"target_dex_deployment_id": strconv.FormatUint(uint64(v3.ID), 10)})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "only V2 targets are supported")
+
+	targetID := strconv.FormatUint(uint64(target.ID), 10)
+	result = call(map[string]any{"target_dex_deployment_id": targetID, "liquidity_amount": "101"})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "not enough to migrate 101")
+
+	result = call(map[string]any{"target_dex_deployment_id": targetID, "slippage_tolerance": "1"})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Liquidity migration session created")
+
+	sessionID := strings.TrimPrefix(result.Content[0].(mcp.TextContent).Text, "Liquidity migration session created: ")
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	require.Len(t, session.TransactionDeployments, 4)
+	assert.Equal(t, migrationSourcePair, session.TransactionDeployments[0].Receiver)
+	assert.Equal(t, models.TransactionTypeRemoveLiquidity, session.TransactionDeployments[1].TransactionType)
+	assert.Equal(t, source.RouterAddress, session.TransactionDeployments[1].Receiver)
+	assert.Equal(t, migrationToken, session.TransactionDeployments[2].Receiver)
+	assert.Equal(t, models.TransactionTypeLiquidityMigration, session.TransactionDeployments[3].TransactionType)
+	assert.Equal(t, target.RouterAddress, session.TransactionDeployments[3].Receiver)
+	// the whole balance of 100 out of 1000 LP tokens withdraws 1000 wei, of which at least 990 are added
+	assert.Equal(t, "990", session.TransactionDeployments[3].Value)
+
+	// the pair created on the target deployment is recorded as a pending pool of the session
+	targetPool, err := liquidityService.GetLiquidityPoolBySessionId(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusPending, targetPool.Status)
+	assert.Equal(t, "99000", targetPool.InitialToken0)
+	assert.Equal(t, "990", targetPool.InitialToken1)
+
+	// the launch policy requires the migrated liquidity to be locked in its locker
+	launchPolicyService := services.NewLaunchPolicyService(db)
+	require.NoError(t, launchPolicyService.SaveLaunchPolicy(&models.LaunchPolicy{LiquidityLockThreshold: "0", MinLiquidityLockDays: 30, LiquidityLockerAddress: migrationLocker}))
+	result = call(map[string]any{"target_dex_deployment_id": targetID})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Launch policy violation")
+
+	result = call(map[string]any{"target_dex_deployment_id": targetID, "lock_days": "30"})
+	require.False(t, result.IsError, result.Content)
+	sessionID = strings.TrimPrefix(result.Content[0].(mcp.TextContent).Text, "Liquidity migration session created: ")
+	session, err = txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	require.Len(t, session.TransactionDeployments, 5)
+	// the LP tokens of the target pool are minted to the locker, which locks them for the owner
+	assert.Contains(t, strings.ToLower(session.TransactionDeployments[3].Data), migrationLocker[2:])
+	assert.Equal(t, migrationLocker, session.TransactionDeployments[4].Receiver)
+}
@@ -0,0 +1,345 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type multiChainLaunchTool struct {
+	launchTool         *launchTool
+	launchGroupService services.LaunchGroupService
+}
+
+type MultiChainLaunchArguments struct {
+	// Required fields
+	TemplateID     string         `json:"template_id" validate:"required"`
+	TemplateValues map[string]any `json:"template_values" validate:"required"`
+	ChainIDs       []string       `json:"chain_ids" validate:"required,min=1,dive,required"`
+	ContractName   string         `json:"contract_name" validate:"required"`
+
+	// Optional fields
+	ConstructorArgs []any                        `json:"constructor_args,omitempty"`
+	Value           string                       `json:"value,omitempty"`
+	Metadata        []models.TransactionMetadata `json:"metadata,omitempty"`
+	DryRun          bool                         `json:"dry_run,omitempty"`
+}
+
+// LaunchGroupChain is the deployment of a launch group on one chain.
+// Error is set when the deployment session could not be created for the chain.
+type LaunchGroupChain struct {
+	ChainID         string                   `json:"chain_id"`
+	ChainName       string                   `json:"chain_name"`
+	DeploymentID    uint                     `json:"deployment_id,omitempty"`
+	SessionID       string                   `json:"session_id,omitempty"`
+	URL             string                   `json:"url,omitempty"`
+	Status          models.TransactionStatus `json:"status,omitempty"`
+	ContractAddress string                   `json:"contract_address,omitempty"`
+	TransactionHash string                   `json:"transaction_hash,omitempty"`
+	Error           string                   `json:"error,omitempty"`
+}
+
+// LaunchGroupReport is the consolidated status of a launch group
+type LaunchGroupReport struct {
+	LaunchGroupID uint                     `json:"launch_group_id"`
+	Status        models.LaunchGroupStatus `json:"status"`
+	TemplateID    uint                     `json:"template_id"`
+	ContractName  string                   `json:"contract_name"`
+	Chains        []LaunchGroupChain       `json:"chains"`
+	// Bridges are the deposits routing the initial liquidity ETH to the target chains
+	Bridges []LaunchGroupBridgeReport `json:"bridges,omitempty"`
+}
+
+// LaunchGroupBridgeReport is a bridge deposit of a launch group, chain IDs are the chain IDs of the networks
+type LaunchGroupBridgeReport struct {
+	ID              uint                     `json:"id"`
+	SourceChainID   string                   `json:"source_chain_id"`
+	TargetChainID   string                   `json:"target_chain_id"`
+	TargetChainName string                   `json:"target_chain_name"`
+	BridgeName      string                   `json:"bridge_name"`
+	BridgeAddress   string                   `json:"bridge_address"`
+	Recipient       string                   `json:"recipient,omitempty"`
+	Amount          string                   `json:"amount"`
+	Status          models.TransactionStatus `json:"status"`
+	SessionID       string                   `json:"session_id"`
+	URL             string                   `json:"url,omitempty"`
+	TransactionHash string                   `json:"transaction_hash,omitempty"`
+}
+
+func NewMultiChainLaunchTool(launchTool *launchTool, launchGroupService services.LaunchGroupService) *multiChainLaunchTool {
+	return &multiChainLaunchTool{
+		launchTool:         launchTool,
+		launchGroupService: launchGroupService,
+	}
+}
+
+func (m *multiChainLaunchTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("multi_chain_launch",
+		mcp.WithDescription("Deploy the same template to several configured Ethereum-compatible chains. The template is rendered once and a transaction session is created on every chain, "+
+			"the deployments are grouped under a launch group whose consolidated status and per-chain contract addresses are returned by get_launch_group. "+
+			"Return every session url to the user, each deployment is signed on its own chain."),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template to deploy"),
+		),
+		mcp.WithObject("template_values",
+			mcp.Required(),
+			mcp.Description("JSON object with runtime values for template parameters (e.g., {\"TokenName\": \"MyToken\", \"TokenSymbol\": \"MTK\"})"),
+		),
+		mcp.WithArray("chain_ids",
+			mcp.Required(),
+			mcp.Description("Chain IDs of the configured chains to deploy to as listed by list_chains (e.g., [\"1\", \"8453\", \"56\"])"),
+			mcp.Items(map[string]any{
+				"type": "string",
+			}),
+		),
+		mcp.WithString("contract_name",
+			mcp.Required(),
+			mcp.Description("Name of the contract to deploy. If the template's contract name is rendered from template values, then this is the rendered name."),
+		),
+		mcp.WithArray(
+			"constructor_args",
+			mcp.Description("JSON array of constructor arguments for contract deployment (e.g., [\"arg1\", 123, true]), the same arguments are used on every chain. Optional."),
+			mcp.Items(map[string]interface{}{
+				"type":        "any",
+				"description": "Constructor argument, don't do the math in the argument, provide the final value (e.g., for uint256 value of 1 ETH, provide 1000000000000000000)",
+			}),
+		),
+		mcp.WithString("value",
+			mcp.Description("Native token value to send with every deployment transaction in wei. Optional, defaults to \"0\"."),
+		),
+		mcp.WithArray("metadata",
+			mcp.Description("JSON array of metadata for the transactions (e.g., [{\"title\": \"Deploy MyToken\", \"description\": \"Deploy ERC20 token\"}]). Optional."),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"title": map[string]any{
+						"type":        "string",
+						"description": "Title of the transaction",
+					},
+					"description": map[string]any{
+						"type":        "string",
+						"description": "Description of the transaction",
+					},
+				},
+				"required": []string{"title"},
+			}),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (m *multiChainLaunchTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args MultiChainLaunchArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		templateID, err := strconv.ParseUint(args.TemplateID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+
+		template, err := m.launchTool.templateService.GetTemplateByID(uint(templateID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
+		}
+		if template.Disabled {
+			return mcp.NewToolResultError(fmt.Sprintf("Template %s is disabled by an admin and cannot be launched", template.Name)), nil
+		}
+		if template.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Only ethereum templates can be launched on multiple chains, template %s is a %s template", template.Name, template.ChainType)), nil
+		}
+
+		if err := utils.CheckSampleKeysMatch(template.SampleTemplateValues, args.TemplateValues); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template values validation failed, make sure your template values matches %s", template.SampleTemplateValues)), nil
+		}
+
+		chains, err := m.resolveChains(args.ChainIDs)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		adapter, err := m.launchTool.chainAdapters.ForChainType(template.ChainType)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		if args.DryRun {
+			return m.dryRun(adapter, chains, template, args, userId)
+		}
+
+		// Sessions are created first so a template failing to compile leaves no empty group behind
+		var sessions []string
+		var failedChains []LaunchGroupChain
+		for _, chain := range chains {
+			sessionID, err := m.launchTool.createContractDeploymentTransaction(ctx, adapter, &chain, template, args.Metadata, args.ContractName, args.ConstructorArgs, args.Value, "Deploy Contract", fmt.Sprintf("Deploy contract to %s", chain.Name), args.TemplateValues, userId)
+			if err != nil {
+				failedChains = append(failedChains, LaunchGroupChain{ChainID: chain.NetworkID, ChainName: chain.Name, Error: err.Error()})
+				continue
+			}
+			sessions = append(sessions, sessionID)
+		}
+		if len(sessions) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create contract deployment transaction on any chain: %s", failedChains[0].Error)), nil
+		}
+
+		group := &models.LaunchGroup{
+			UserID:         userId,
+			TemplateID:     template.ID,
+			ContractName:   args.ContractName,
+			TemplateValues: args.TemplateValues,
+		}
+		if err := m.launchGroupService.CreateLaunchGroup(group); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create launch group: %v", err)), nil
+		}
+		for _, sessionID := range sessions {
+			if err := m.launchGroupService.AddDeploymentBySessionId(group.ID, sessionID); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to add the deployment of session %s to the launch group: %v", sessionID, err)), nil
+			}
+		}
+
+		group, err = m.launchGroupService.GetLaunchGroup(group.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get launch group: %v", err)), nil
+		}
+		report := newLaunchGroupReport(ctx, group, m.launchTool.serverPort)
+		report.Chains = append(report.Chains, failedChains...)
+
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Launch group %d created with %d of %d deployment sessions, please return every url to the user: ", group.ID, len(sessions), len(chains))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// dryRun builds the deployment of every chain and the launch group without saving them
+func (m *multiChainLaunchTool) dryRun(adapter services.ChainAdapter, chains []models.Chain, template *models.Template, args MultiChainLaunchArguments, userId *string) (*mcp.CallToolResult, error) {
+	var sessions []services.CreateTransactionSessionRequest
+	var records []DryRunRecord
+	for _, chain := range chains {
+		session, deployment, err := m.launchTool.buildContractDeploymentTransaction(adapter, &chain, template, args.Metadata, args.ContractName, args.ConstructorArgs, args.Value, "Deploy Contract", fmt.Sprintf("Deploy contract to %s", chain.Name), args.TemplateValues, userId)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create contract deployment transaction on %s: %v", chain.Name, err)), nil
+		}
+		sessions = append(sessions, session)
+		records = append(records, DryRunRecord{Type: "deployment", Record: deployment})
+	}
+
+	records = append(records, DryRunRecord{Type: "launch_group", Record: &models.LaunchGroup{
+		UserID:         userId,
+		TemplateID:     template.ID,
+		ContractName:   args.ContractName,
+		TemplateValues: args.TemplateValues,
+	}})
+	return newDryRunResult("multi_chain_launch", sessions, records...)
+}
+
+// resolveChains returns the configured Ethereum-compatible chains of the chain IDs in the given order, duplicates are ignored
+func (m *multiChainLaunchTool) resolveChains(chainIDs []string) ([]models.Chain, error) {
+	configured, err := m.launchTool.chainService.ListChains()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chains: %w", err)
+	}
+	configured = slices.DeleteFunc(configured, func(chain models.Chain) bool {
+		return chain.ChainType != models.TransactionChainTypeEthereum
+	})
+
+	var chains []models.Chain
+	var unknown []string
+	for _, chainID := range chainIDs {
+		chainID = strings.TrimSpace(chainID)
+		if slices.ContainsFunc(chains, func(chain models.Chain) bool { return chain.NetworkID == chainID }) {
+			continue
+		}
+		index := slices.IndexFunc(configured, func(chain models.Chain) bool { return chain.NetworkID == chainID })
+		if index < 0 {
+			unknown = append(unknown, chainID)
+			continue
+		}
+		chains = append(chains, configured[index])
+	}
+	if len(unknown) > 0 {
+		var available []string
+		for _, chain := range configured {
+			available = append(available, fmt.Sprintf("%s (%s)", chain.NetworkID, chain.Name))
+		}
+		return nil, fmt.Errorf("chains %s are not configured, available Ethereum-compatible chains: %s", strings.Join(unknown, ", "), strings.Join(available, ", "))
+	}
+	return chains, nil
+}
+
+// newLaunchGroupReport reports the status, session url and contract address of every deployment of the group
+// and the status of its bridge deposits
+func newLaunchGroupReport(ctx context.Context, group *models.LaunchGroup, serverPort int) LaunchGroupReport {
+	report := LaunchGroupReport{
+		LaunchGroupID: group.ID,
+		Status:        group.Status(),
+		TemplateID:    group.TemplateID,
+		ContractName:  group.ContractName,
+		Chains:        []LaunchGroupChain{},
+	}
+	for _, deployment := range group.Deployments {
+		chain := LaunchGroupChain{
+			ChainID:         deployment.Chain.NetworkID,
+			ChainName:       deployment.Chain.Name,
+			DeploymentID:    deployment.ID,
+			SessionID:       deployment.SessionId,
+			Status:          deployment.Status,
+			ContractAddress: deployment.ContractAddress,
+			TransactionHash: deployment.TransactionHash,
+		}
+		// Only the pending sessions still need to be signed
+		if deployment.Status == models.TransactionStatusPending {
+			chain.URL, _ = utils.GetTransactionSessionUrl(ctx, serverPort, deployment.SessionId)
+		}
+		report.Chains = append(report.Chains, chain)
+	}
+	for _, bridge := range group.Bridges {
+		deposit := LaunchGroupBridgeReport{
+			ID:              bridge.ID,
+			SourceChainID:   bridge.SourceChain.NetworkID,
+			TargetChainID:   bridge.TargetChain.NetworkID,
+			TargetChainName: bridge.TargetChain.Name,
+			BridgeName:      bridge.BridgeName,
+			BridgeAddress:   bridge.BridgeAddress,
+			Recipient:       bridge.Recipient,
+			Amount:          bridge.Amount,
+			Status:          bridge.Status,
+			SessionID:       bridge.SessionId,
+			TransactionHash: bridge.TransactionHash,
+		}
+		if bridge.Status == models.TransactionStatusPending {
+			deposit.URL, _ = utils.GetTransactionSessionUrl(ctx, serverPort, bridge.SessionId)
+		}
+		report.Bridges = append(report.Bridges, deposit)
+	}
+	return report
+}
@@ -0,0 +1,133 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type multiChainLaunchTestEnv struct {
+	templateService    services.TemplateService
+	chainService       services.ChainService
+	txService          services.TransactionService
+	deploymentService  services.DeploymentService
+	launchGroupService services.LaunchGroupService
+	template           *models.Template
+	chains             []models.Chain
+}
+
+func setupMultiChainLaunchTest(t *testing.T) *multiChainLaunchTestEnv {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	env := &multiChainLaunchTestEnv{
+		templateService:    services.NewTemplateService(db.GetDB()),
+		chainService:       services.NewChainService(db.GetDB()),
+		txService:          services.NewTransactionService(db.GetDB()),
+		deploymentService:  services.NewDeploymentService(db.GetDB()),
+		launchGroupService: services.NewLaunchGroupService(db.GetDB()),
+		template: &models.Template{
+			Name:                 "Basic Token",
+			ChainType:            models.TransactionChainTypeEthereum,
+			TemplateCode:         "contract {{.TokenName}} {}",
+			SampleTemplateValues: models.JSON{"TokenName": "Sample"},
+		},
+	}
+	require.NoError(t, env.templateService.CreateTemplate(env.template))
+	for _, chain := range []models.Chain{
+		{ChainType: models.TransactionChainTypeEthereum, Name: "Mainnet", RPC: "http://127.0.0.1:1", NetworkID: "1"},
+		{ChainType: models.TransactionChainTypeEthereum, Name: "Base", RPC: "http://127.0.0.1:1", NetworkID: "8453"},
+	} {
+		require.NoError(t, env.chainService.CreateChain(&chain))
+		env.chains = append(env.chains, chain)
+	}
+	return env
+}
+
+func TestMultiChainLaunchValidation(t *testing.T) {
+	env := setupMultiChainLaunchTest(t)
+	solanaTemplate := &models.Template{Name: "Solana Token", ChainType: models.TransactionChainTypeSolana, TemplateCode: "pub fn main() {}"}
+	require.NoError(t, env.templateService.CreateTemplate(solanaTemplate))
+
+	launch := NewLaunchTool(env.templateService, env.chainService, TEST_SERVER_PORT, services.NewEvmService(), env.txService, env.deploymentService)
+	handler := NewMultiChainLaunchTool(launch, env.launchGroupService).GetHandler()
+
+	values := map[string]any{"TokenName": "Sample"}
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		expected  string
+	}{
+		{"missing chains", map[string]any{"template_id": "1", "template_values": values, "contract_name": "Sample"}, "Invalid arguments"},
+		{"empty chains", map[string]any{"template_id": "1", "template_values": values, "contract_name": "Sample", "chain_ids": []any{}}, "Invalid arguments"},
+		{"unknown template", map[string]any{"template_id": "99", "template_values": values, "contract_name": "Sample", "chain_ids": []any{"1"}}, "Template not found"},
+		{"solana template", map[string]any{"template_id": "2", "template_values": values, "contract_name": "Sample", "chain_ids": []any{"1"}}, "Only ethereum templates can be launched on multiple chains"},
+		{"mismatched values", map[string]any{"template_id": "1", "template_values": map[string]any{"Other": "x"}, "contract_name": "Sample", "chain_ids": []any{"1"}}, "Template values validation failed"},
+		{"unknown chain", map[string]any{"template_id": "1", "template_values": values, "contract_name": "Sample", "chain_ids": []any{"1", "56"}}, "chains 56 are not configured, available Ethereum-compatible chains: 1 (Mainnet), 8453 (Base)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTemplatePackTool(t, context.Background(), handler, tt.arguments)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+		})
+	}
+
+	// No launch group is left behind by rejected launches
+	groups, err := env.launchGroupService.ListLaunchGroups(nil)
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
+
+func TestGetLaunchGroup(t *testing.T) {
+	env := setupMultiChainLaunchTest(t)
+	group := &models.LaunchGroup{TemplateID: env.template.ID, ContractName: "Sample", TemplateValues: models.JSON{"TokenName": "Sample"}}
+	require.NoError(t, env.launchGroupService.CreateLaunchGroup(group))
+
+	var sessions []string
+	for _, chain := range env.chains {
+		sessionID, err := env.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{ChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID})
+		require.NoError(t, err)
+		require.NoError(t, env.deploymentService.CreateDeployment(&models.Deployment{ChainID: chain.ID, TemplateID: env.template.ID, Status: models.TransactionStatusPending, SessionId: sessionID}))
+		require.NoError(t, env.launchGroupService.AddDeploymentBySessionId(group.ID, sessionID))
+		sessions = append(sessions, sessionID)
+	}
+	assert.Error(t, env.launchGroupService.AddDeploymentBySessionId(group.ID, "unknown-session"))
+
+	handler := NewGetLaunchGroupTool(env.launchGroupService, TEST_SERVER_PORT).GetHandler()
+	getReport := func() LaunchGroupReport {
+		result := callTemplatePackTool(t, context.Background(), handler, map[string]any{"launch_group_id": "1"})
+		require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+		var report LaunchGroupReport
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+		return report
+	}
+
+	report := getReport()
+	assert.Equal(t, models.LaunchGroupStatusPending, report.Status)
+	require.Len(t, report.Chains, 2)
+	assert.Equal(t, "1", report.Chains[0].ChainID)
+	assert.Equal(t, "Base", report.Chains[1].ChainName)
+	assert.Contains(t, report.Chains[0].URL, sessions[0])
+
+	require.NoError(t, env.deploymentService.UpdateDeploymentStatusWithTxHashBySessionId(sessions[0], models.TransactionStatusConfirmed, "0x0000000000000000000000000000000000000001", "0xabc"))
+	require.NoError(t, env.deploymentService.UpdateDeploymentStatusWithTxHashBySessionId(sessions[1], models.TransactionStatusFailed, "", "0xdef"))
+	report = getReport()
+	assert.Equal(t, models.LaunchGroupStatusPartial, report.Status)
+	assert.Equal(t, "0x0000000000000000000000000000000000000001", report.Chains[0].ContractAddress)
+	assert.Empty(t, report.Chains[0].URL)
+	assert.Equal(t, models.TransactionStatusFailed, report.Chains[1].Status)
+
+	require.NoError(t, env.deploymentService.UpdateDeploymentStatusWithTxHashBySessionId(sessions[1], models.TransactionStatusConfirmed, "0x0000000000000000000000000000000000000002", "0xdef"))
+	assert.Equal(t, models.LaunchGroupStatusConfirmed, getReport().Status)
+
+	missing := callTemplatePackTool(t, context.Background(), handler, map[string]any{"launch_group_id": "2"})
+	assert.True(t, missing.IsError)
+}
@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformFeeTools(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	platformFeeService := services.NewPlatformFeeService(db.GetDB())
+	setReferrer := NewSetReferrerTool(platformFeeService).GetHandler()
+	getRevenue := NewGetPlatformRevenueTool(platformFeeService).GetHandler()
+
+	const recipient = "0x1111111111111111111111111111111111111111"
+	const referrer = "0x2222222222222222222222222222222222222222"
+	t.Setenv(services.EnvPlatformFeeRecipient, recipient)
+	t.Setenv(services.EnvPlatformFeeBps, "100")
+	t.Setenv(services.EnvReferralShareBps, "2500")
+
+	admin := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "admin-1", Roles: []string{"admin"}})
+	user := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1", Roles: []string{"user"}})
+
+	t.Run("set_referrer", func(t *testing.T) {
+		args := map[string]any{"referrer_address": referrer}
+		assert.True(t, callAPIKeyTool(t, context.Background(), setReferrer, args).IsError)
+		assert.True(t, callAPIKeyTool(t, user, setReferrer, map[string]any{"referrer_address": "not-an-address"}).IsError)
+		assert.True(t, callAPIKeyTool(t, user, setReferrer, map[string]any{"referrer_address": recipient}).IsError)
+
+		require.False(t, callAPIKeyTool(t, user, setReferrer, args).IsError)
+		referral, err := platformFeeService.GetReferrer("user-1")
+		require.NoError(t, err)
+		assert.Equal(t, referrer, referral.ReferrerAddress)
+
+		result := callAPIKeyTool(t, user, setReferrer, map[string]any{"referrer_address": "0x3333333333333333333333333333333333333333"})
+		require.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "cannot be changed")
+	})
+
+	t.Run("get_platform_revenue", func(t *testing.T) {
+		assert.True(t, callAPIKeyTool(t, user, getRevenue, map[string]any{}).IsError)
+		assert.True(t, callAPIKeyTool(t, admin, getRevenue, map[string]any{"since": "yesterday"}).IsError)
+
+		result := callAPIKeyTool(t, admin, getRevenue, map[string]any{"include_pending": true})
+		require.False(t, result.IsError)
+		var report PlatformRevenueResult
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &report))
+		require.NotNil(t, report.Config)
+		assert.Equal(t, recipient, report.Config.Recipient)
+		assert.Equal(t, int64(100), report.Config.Bps)
+	})
+}
@@ -0,0 +1,203 @@
+package tools
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type proposeSafeTransactionsTool struct {
+	txService   services.TransactionService
+	safeService services.SafeService
+	proposerKey *ecdsa.PrivateKey
+	defaultSafe string
+}
+
+type ProposeSafeTransactionsArguments struct {
+	// Required fields
+	SessionID string `json:"session_id" validate:"required"`
+
+	// Optional fields
+	SafeAddress string `json:"safe_address,omitempty"`
+}
+
+// NewProposeSafeTransactionsTool proposes with proposerKey, the tool refuses every call when it is nil.
+// defaultSafe is the Safe used when the call does not name one
+func NewProposeSafeTransactionsTool(txService services.TransactionService, safeService services.SafeService, proposerKey *ecdsa.PrivateKey, defaultSafe string) *proposeSafeTransactionsTool {
+	return &proposeSafeTransactionsTool{
+		txService:   txService,
+		safeService: safeService,
+		proposerKey: proposerKey,
+		defaultSafe: defaultSafe,
+	}
+}
+
+func (p *proposeSafeTransactionsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("propose_safe_transactions",
+		mcp.WithDescription("Propose the transactions of a pending signing session to a Safe multisig through the Safe Transaction Service instead of signing them in the browser. "+
+			"The owners confirm and execute the proposals in the Safe app, the session is completed once the Safe executed every transaction and its confirmation count is tracked on the session. "+
+			"Only contract calls can be proposed, sessions deploying a contract must be signed in the browser. Requires Safe 1.3.0 or later."),
+		mcp.WithString("session_id",
+			mcp.Required(),
+			mcp.Description("ID of the pending signing session returned by the tool that created it"),
+		),
+		mcp.WithString("safe_address",
+			mcp.Description("Address of the Safe to propose to. Optional, defaults to the Safe configured on the server"),
+		),
+	)
+
+	return tool
+}
+
+func (p *proposeSafeTransactionsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ProposeSafeTransactionsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if p.proposerKey == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Safe proposals are not configured, set %s to the key of a Safe owner or delegate", services.EnvSafeProposerPrivateKey)), nil
+		}
+		safeAddress := strings.TrimSpace(args.SafeAddress)
+		if safeAddress == "" {
+			safeAddress = p.defaultSafe
+		}
+		if safeAddress == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("safe_address is required when %s is not set", services.EnvSafeAddress)), nil
+		}
+		if !utils.IsValidEthereumAddress(safeAddress) {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid Safe address: %s", safeAddress)), nil
+		}
+		safe := common.HexToAddress(safeAddress)
+
+		session, err := p.txService.GetTransactionSession(args.SessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Session not found: %v", err)), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (session.UserID == nil || *session.UserID != userID) {
+			return mcp.NewToolResultError("Session not found"), nil
+		}
+		if session.TransactionStatus != models.TransactionStatusPending {
+			return mcp.NewToolResultError(fmt.Sprintf("Session is %s, only pending sessions can be proposed", session.TransactionStatus)), nil
+		}
+		if session.SafeProposal != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Session was already proposed to Safe %s", session.SafeProposal.SafeAddress)), nil
+		}
+		if session.TransactionChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError("Only Ethereum sessions can be proposed to a Safe"), nil
+		}
+		chainID, ok := new(big.Int).SetString(session.Chain.NetworkID, 10)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid chain ID: %s", session.Chain.NetworkID)), nil
+		}
+
+		transactions := make([]services.SafeTransaction, len(session.TransactionDeployments))
+		for i, deployment := range session.TransactionDeployments {
+			if deployment.Receiver == "" {
+				return mcp.NewToolResultError(fmt.Sprintf("Transaction %d (%s) deploys a contract, a Safe can only call contracts. Sign the session in the browser instead", i, deployment.Title)), nil
+			}
+			if !utils.IsValidEthereumAddress(deployment.Receiver) {
+				return mcp.NewToolResultError(fmt.Sprintf("Transaction %d has an invalid receiver: %s", i, deployment.Receiver)), nil
+			}
+			value := new(big.Int)
+			if deployment.Value != "" {
+				if _, ok := value.SetString(deployment.Value, 10); !ok || value.Sign() < 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("Transaction %d has an invalid value: %s", i, deployment.Value)), nil
+				}
+			}
+			var data []byte
+			if deployment.Data != "" && deployment.Data != "0x" {
+				if data, err = hexutil.Decode(deployment.Data); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Transaction %d has invalid data: %v", i, err)), nil
+				}
+			}
+			transactions[i] = services.SafeTransaction{To: common.HexToAddress(deployment.Receiver), Value: value, Data: data}
+		}
+
+		serviceURL, err := services.SafeTransactionServiceURL(session.Chain.NetworkID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		info, err := p.safeService.GetSafe(ctx, serviceURL, safe)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read Safe %s: %v", safe.Hex(), err)), nil
+		}
+		nonce, err := p.safeService.NextNonce(ctx, serviceURL, safe)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read the nonce of Safe %s: %v", safe.Hex(), err)), nil
+		}
+
+		proposer := crypto.PubkeyToAddress(p.proposerKey.PublicKey)
+		confirmations := 0
+		for _, owner := range info.Owners {
+			if strings.EqualFold(owner, proposer.Hex()) {
+				confirmations = 1
+				break
+			}
+		}
+
+		proposal := &models.SafeProposal{
+			SafeAddress: safe.Hex(),
+			ServiceURL:  serviceURL,
+			Proposer:    proposer.Hex(),
+			ProposedAt:  time.Now(),
+		}
+		for i, transaction := range transactions {
+			transaction.Nonce = nonce + uint64(i)
+			hash := services.SafeTransactionHash(chainID, safe, transaction)
+			signature, err := services.SignSafeTransactionHash(p.proposerKey, hash)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := p.safeService.ProposeTransaction(ctx, serviceURL, services.SafeProposalRequest{
+				Safe:        safe,
+				Transaction: transaction,
+				SafeTxHash:  hash,
+				Sender:      proposer,
+				Signature:   signature,
+			}); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to propose transaction %d after %d proposed, reject the proposed ones in the Safe app before retrying: %v", i, i, err)), nil
+			}
+			proposal.Transactions = append(proposal.Transactions, models.SafeProposalTransaction{
+				Index:                 i,
+				SafeTxHash:            hash.Hex(),
+				Nonce:                 transaction.Nonce,
+				Confirmations:         confirmations,
+				ConfirmationsRequired: info.Threshold,
+			})
+		}
+
+		session.SafeProposal = proposal
+		if err := p.txService.UpdateTransactionSession(session.ID, session); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to record the Safe proposal on the session: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(proposal)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Proposed %d transactions of session %s to Safe %s starting at nonce %d. %d of the %d owners must confirm them in the Safe app, the session is completed once the Safe executes them",
+					len(proposal.Transactions), session.ID, proposal.SafeAddress, nonce, info.Threshold, len(info.Owners))),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
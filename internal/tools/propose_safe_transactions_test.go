@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSafeService is a Safe with one owner besides the proposer and a threshold of 2
+type fakeSafeService struct {
+	services.SafeService
+	owners    []string
+	proposals []services.SafeProposalRequest
+}
+
+func (f *fakeSafeService) GetSafe(ctx context.Context, serviceURL string, safe common.Address) (*services.SafeInfo, error) {
+	return &services.SafeInfo{Address: safe.Hex(), Nonce: 3, Threshold: 2, Owners: f.owners}, nil
+}
+
+func (f *fakeSafeService) NextNonce(ctx context.Context, serviceURL string, safe common.Address) (uint64, error) {
+	return 3, nil
+}
+
+func (f *fakeSafeService) ProposeTransaction(ctx context.Context, serviceURL string, request services.SafeProposalRequest) error {
+	f.proposals = append(f.proposals, request)
+	return nil
+}
+
+func TestProposeSafeTransactions(t *testing.T) {
+	t.Setenv(services.EnvSafeTransactionServiceURL, "")
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	chain := &models.Chain{Name: "Sepolia", RPC: "http://localhost:8545", NetworkID: "11155111", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	txService := services.NewTransactionService(db)
+	owner := "user-1"
+	createSession := func(deployments ...models.TransactionDeployment) string {
+		sessionID, err := txService.CreateTransactionSessionWithUser(services.CreateTransactionSessionRequest{
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                chain.ID,
+			TransactionDeployments: deployments,
+		}, &owner)
+		require.NoError(t, err)
+		return sessionID
+	}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	proposer := crypto.PubkeyToAddress(key.PublicKey)
+	safeService := &fakeSafeService{owners: []string{proposer.Hex(), "0x0000000000000000000000000000000000000002"}}
+	safeAddress := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	handler := NewProposeSafeTransactionsTool(txService, safeService, key, safeAddress).GetHandler()
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+	call := func(ctx context.Context, args map[string]any) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	sessionID := createSession(
+		models.TransactionDeployment{Title: "Enable trading", Receiver: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512", Data: "0x8a8c523c", Value: "0", TransactionType: models.TransactionTypeEnableTrading},
+		models.TransactionDeployment{Title: "Fund", Receiver: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512", Value: "1000", TransactionType: models.TransactionTypeRegular},
+	)
+	result := call(ctx, map[string]any{"session_id": sessionID})
+	require.False(t, result.IsError, result.Content)
+
+	var proposal models.SafeProposal
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &proposal))
+	assert.Equal(t, safeAddress, proposal.SafeAddress)
+	assert.Equal(t, "https://safe-transaction-sepolia.safe.global", proposal.ServiceURL)
+	assert.Equal(t, proposer.Hex(), proposal.Proposer)
+	require.Len(t, proposal.Transactions, 2)
+	assert.Equal(t, uint64(3), proposal.Transactions[0].Nonce)
+	assert.Equal(t, uint64(4), proposal.Transactions[1].Nonce)
+	assert.Equal(t, 1, proposal.Transactions[0].Confirmations)
+	assert.Equal(t, 2, proposal.Transactions[0].ConfirmationsRequired)
+
+	require.Len(t, safeService.proposals, 2)
+	second := safeService.proposals[1]
+	assert.Equal(t, big.NewInt(1000), second.Transaction.Value)
+	assert.Empty(t, second.Transaction.Data)
+	assert.Equal(t, services.SafeTransactionHash(big.NewInt(11155111), common.HexToAddress(safeAddress), second.Transaction), second.SafeTxHash)
+	assert.Equal(t, proposal.Transactions[1].SafeTxHash, second.SafeTxHash.Hex())
+
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, session.SafeProposal)
+	assert.Equal(t, proposal.Transactions[0].SafeTxHash, session.SafeProposal.Transactions[0].SafeTxHash)
+
+	// A session is proposed once
+	result = call(ctx, map[string]any{"session_id": sessionID})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "already proposed")
+
+	// A Safe cannot deploy contracts
+	deploymentID := createSession(models.TransactionDeployment{Title: "Deploy token", Value: "0", TransactionType: models.TransactionTypeTokenDeployment})
+	result = call(ctx, map[string]any{"session_id": deploymentID})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "deploys a contract")
+
+	// Sessions of other users are not found
+	otherCtx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	result = call(otherCtx, map[string]any{"session_id": createSession(models.TransactionDeployment{Title: "Call", Receiver: safeAddress, Value: "0"})})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Session not found")
+	assert.Len(t, safeService.proposals, 2)
+
+	// Without a proposer key the tool is refused
+	result, err = NewProposeSafeTransactionsTool(txService, safeService, nil, safeAddress).GetHandler()(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"session_id": sessionID}}})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, services.EnvSafeProposerPrivateKey)
+}
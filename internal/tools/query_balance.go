@@ -28,6 +28,7 @@ func NewQueryBalanceTool(chainService services.ChainService, txService services.
 			mcp.Required(),
 		),
 	)
+	chainAdapters := services.NewChainAdapters(services.NewEvmService())
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		showBrowser := request.GetBool("show_browser", false)
@@ -35,25 +36,29 @@ func NewQueryBalanceTool(chainService services.ChainService, txService services.
 		tokenAddress := request.GetString("token_address", "")
 
 		// Get active chain configuration
-		activeChain, err := chainService.GetActiveChain()
+		activeChain, err := chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
 
-		// Validate chain type
-		if activeChain.ChainType != "ethereum" {
-			return mcp.NewToolResultError("Balance queries currently only supported on Ethereum-compatible chains"), nil
+		adapter, err := chainAdapters.ForChainType(activeChain.ChainType)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
 		if showBrowser {
+			// The balance page reads the balances with the Ethereum wallet of the browser
+			if activeChain.ChainType != models.TransactionChainTypeEthereum {
+				return mcp.NewToolResultError("Browser balance display is only supported on Ethereum-compatible chains, use show_browser=false"), nil
+			}
 			// Web mode - create session and return URL
-			return handleBrowserMode(txService, serverPort, activeChain, walletAddress, tokenAddress)
+			return handleBrowserMode(ctx, txService, serverPort, activeChain, walletAddress, tokenAddress)
 		} else {
 			// Direct mode - require wallet address and return balance immediately
 			if walletAddress == "" {
 				return mcp.NewToolResultError("wallet_address is required when show_browser=false"), nil
 			}
-			return handleDirectMode(activeChain, walletAddress, tokenAddress)
+			return handleDirectMode(adapter, activeChain, walletAddress, tokenAddress)
 		}
 	}
 
@@ -61,7 +66,7 @@ func NewQueryBalanceTool(chainService services.ChainService, txService services.
 }
 
 // handleBrowserMode creates a session for web-based balance display
-func handleBrowserMode(txService services.TransactionService, serverPort int, activeChain *models.Chain, walletAddress, tokenAddress string) (*mcp.CallToolResult, error) {
+func handleBrowserMode(ctx context.Context, txService services.TransactionService, serverPort int, activeChain *models.Chain, walletAddress, tokenAddress string) (*mcp.CallToolResult, error) {
 	// Prepare session data
 	sessionData := map[string]interface{}{
 		"query_type":     "balance",
@@ -87,13 +92,17 @@ func handleBrowserMode(txService services.TransactionService, serverPort int, ac
 		ChainType: activeChain.ChainType,
 		ChainID:   uint(chainIDUint),
 	}
+	req.Context = ctx
 	sessionID, err := txService.CreateTransactionSession(req)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Error creating session: %v", err)), nil
 	}
 
 	// Generate URL
-	balanceURL := fmt.Sprintf("http://localhost:%d/balance/%s", serverPort, sessionID)
+	balanceURL, err := utils.GetBalancePageUrl(ctx, serverPort, sessionID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get balance url: %v", err)), nil
+	}
 
 	result := map[string]interface{}{
 		"session_id":  sessionID,
@@ -122,7 +131,7 @@ func handleBrowserMode(txService services.TransactionService, serverPort int, ac
 }
 
 // handleDirectMode queries balance immediately and returns in response
-func handleDirectMode(activeChain *models.Chain, walletAddress, tokenAddress string) (*mcp.CallToolResult, error) {
+func handleDirectMode(adapter services.ChainAdapter, activeChain *models.Chain, walletAddress, tokenAddress string) (*mcp.CallToolResult, error) {
 	result := map[string]interface{}{
 		"wallet_address": walletAddress,
 		"chain_type":     activeChain.ChainType,
@@ -131,7 +140,7 @@ func handleDirectMode(activeChain *models.Chain, walletAddress, tokenAddress str
 	}
 
 	// Query native balance
-	nativeBalance, err := utils.QueryNativeBalance(activeChain.RPC, walletAddress, string(activeChain.ChainType))
+	nativeBalance, err := adapter.GetBalance(activeChain.RPC, walletAddress)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to query native balance: %v", err)), nil
 	}
@@ -139,7 +148,9 @@ func handleDirectMode(activeChain *models.Chain, walletAddress, tokenAddress str
 	result["native_balance"] = nativeBalance
 
 	// Query token balance if token address provided
-	if tokenAddress != "" {
+	if tokenAddress != "" && adapter.ChainType() != models.TransactionChainTypeEthereum {
+		result["token_balance_error"] = "Token balances are only supported for ERC-20 tokens on Ethereum-compatible chains"
+	} else if tokenAddress != "" {
 		tokenBalance, err := utils.QueryERC20Balance(activeChain.RPC, tokenAddress, walletAddress)
 		if err != nil {
 			// Don't fail completely, just note the error
@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	defaultQueryEventsLimit = 100
+	maxQueryEventsLimit     = 1000
+	// defaultQueryEventsBlocks is the range scanned when neither from_block nor since is given
+	defaultQueryEventsBlocks = 5000
+)
+
+type queryEventsTool struct {
+	templateService   services.TemplateService
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+}
+
+type QueryEventsArguments struct {
+	// Required fields
+	EventName string `json:"event_name" validate:"required"`
+
+	// Optional fields
+	ContractAddress string         `json:"contract_address,omitempty" validate:"required_without=DeploymentID,excluded_with=DeploymentID,omitempty,eth_addr"`
+	Abi             string         `json:"abi,omitempty" validate:"required_without=DeploymentID"`
+	DeploymentID    string         `json:"deployment_id,omitempty"`
+	Topics          map[string]any `json:"topics,omitempty"`
+	FromBlock       string         `json:"from_block,omitempty" validate:"omitempty,numeric"`
+	ToBlock         string         `json:"to_block,omitempty" validate:"omitempty,numeric"`
+	Since           string         `json:"since,omitempty"`
+	Limit           string         `json:"limit,omitempty"`
+	Cursor          string         `json:"cursor,omitempty"`
+}
+
+// QueryEventsResult is a page of decoded logs, NextCursor is set when logs are left in the range
+type QueryEventsResult struct {
+	ContractAddress string           `json:"contract_address"`
+	Event           string           `json:"event"`
+	FromBlock       uint64           `json:"from_block"`
+	ToBlock         uint64           `json:"to_block"`
+	Events          []utils.EventLog `json:"events"`
+	NextCursor      string           `json:"next_cursor,omitempty"`
+}
+
+func NewQueryEventsTool(templateService services.TemplateService, chainService services.ChainService, deploymentService services.DeploymentService) *queryEventsTool {
+	return &queryEventsTool{
+		templateService:   templateService,
+		chainService:      chainService,
+		deploymentService: deploymentService,
+	}
+}
+
+func (q *queryEventsTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("query_events",
+		mcp.WithDescription("Query the logs of an event of a contract on the active Ethereum chain over a block range and decode them with the ABI, oldest first. "+
+			"Filter on the indexed parameters with topics and page through large ranges with cursor. "+
+			"Use since (e.g., '1h') to answer questions such as who bought in the last hour, the start block is estimated from the average block time."),
+		mcp.WithString("event_name",
+			mcp.Required(),
+			mcp.Description("Name of the event in the ABI (e.g., 'Transfer', 'Swap')"),
+		),
+		mcp.WithString("contract_address",
+			mcp.Description("Address of the contract. Required without deployment_id"),
+		),
+		mcp.WithString("abi",
+			mcp.Description("JSON ABI of the contract, only the event needs to be listed. Required without deployment_id, overrides the template ABI of the deployment otherwise"),
+		),
+		mcp.WithString("deployment_id",
+			mcp.Description("ID of a confirmed deployment, its contract address and template ABI are used. Optional"),
+		),
+		mcp.WithObject("topics",
+			mcp.Description("Values of the indexed parameters to filter on, keyed by parameter name (e.g., {\"to\": \"0x123...\"}). Optional"),
+		),
+		mcp.WithString("from_block",
+			mcp.Description(fmt.Sprintf("First block of the range. Optional, defaults to the last %d blocks", defaultQueryEventsBlocks)),
+		),
+		mcp.WithString("to_block",
+			mcp.Description("Last block of the range. Optional, defaults to the latest block"),
+		),
+		mcp.WithString("since",
+			mcp.Description("Start the range this duration before the last block (e.g., '1h', '30m') instead of from_block. Optional"),
+		),
+		mcp.WithString("limit",
+			mcp.Description(fmt.Sprintf("Maximum number of events per page. Optional, defaults to %d, at most %d", defaultQueryEventsLimit, maxQueryEventsLimit)),
+		),
+		mcp.WithString("cursor",
+			mcp.Description("next_cursor of the previous page, pass the same to_block to page through a fixed range. Optional"),
+		),
+	)
+
+	return tool
+}
+
+func (q *queryEventsTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args QueryEventsArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		limit := defaultQueryEventsLimit
+		if args.Limit != "" {
+			parsed, err := strconv.Atoi(args.Limit)
+			if err != nil || parsed < 1 || parsed > maxQueryEventsLimit {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid limit %q: must be a number between 1 and %d", args.Limit, maxQueryEventsLimit)), nil
+			}
+			limit = parsed
+		}
+
+		activeChain, err := q.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("query_events only supports Ethereum chains, got %s", activeChain.ChainType)), nil
+		}
+
+		contractAddress, abiString, _, err := resolveContractTarget(q.deploymentService, q.templateService, activeChain, args.DeploymentID, args.ContractAddress, args.Abi)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		parsedABI, err := abi.JSON(strings.NewReader(abiString))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid ABI: %v", err)), nil
+		}
+		event, ok := parsedABI.Events[args.EventName]
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Event '%s' not found in ABI", args.EventName)), nil
+		}
+		topics, err := utils.EventTopics(event, args.Topics)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid topics: %v", err)), nil
+		}
+
+		toBlock, err := q.latestBlock(activeChain.RPC)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if args.ToBlock != "" {
+			toBlock, _ = strconv.ParseUint(args.ToBlock, 10, 64)
+		}
+
+		var fromBlock uint64
+		switch {
+		case args.FromBlock != "":
+			fromBlock, _ = strconv.ParseUint(args.FromBlock, 10, 64)
+		case args.Since != "":
+			duration, err := time.ParseDuration(args.Since)
+			if err != nil || duration <= 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid since %q: must be a duration such as 1h", args.Since)), nil
+			}
+			fromBlock, err = utils.EstimateBlockBefore(activeChain.RPC, toBlock, duration)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to estimate the start block: %v", err)), nil
+			}
+		case toBlock >= defaultQueryEventsBlocks:
+			fromBlock = toBlock - defaultQueryEventsBlocks + 1
+		}
+		if fromBlock > toBlock {
+			return mcp.NewToolResultError(fmt.Sprintf("from_block %d is after to_block %d", fromBlock, toBlock)), nil
+		}
+
+		// The cursor is the block and log index of the first log of the next page
+		startBlock, startLogIndex := fromBlock, uint64(0)
+		if args.Cursor != "" {
+			block, logIndex, ok := strings.Cut(args.Cursor, ":")
+			startBlock, err = strconv.ParseUint(block, 10, 64)
+			if ok && err == nil {
+				startLogIndex, err = strconv.ParseUint(logIndex, 10, 64)
+			}
+			if !ok || err != nil || startBlock < fromBlock || startBlock > toBlock {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid cursor %q for this block range", args.Cursor)), nil
+			}
+		}
+
+		result := QueryEventsResult{ContractAddress: contractAddress, Event: event.Sig, FromBlock: fromBlock, ToBlock: toBlock, Events: []utils.EventLog{}}
+		// The range is read in chunks within the eth_getLogs limits of public RPCs, one more log than the page tells
+		// whether a next page exists
+		for chunkStart := startBlock; chunkStart <= toBlock && len(result.Events) <= limit; chunkStart += services.DefaultIndexerBlockRange {
+			chunkEnd := min(chunkStart+services.DefaultIndexerBlockRange-1, toBlock)
+			logs, err := utils.FetchEventLogs(activeChain.RPC, contractAddress, event, topics, chunkStart, chunkEnd)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to query events: %v", err)), nil
+			}
+			for _, log := range logs {
+				if log.BlockNumber == startBlock && log.LogIndex < startLogIndex {
+					continue
+				}
+				result.Events = append(result.Events, log)
+			}
+		}
+		if len(result.Events) > limit {
+			next := result.Events[limit]
+			result.NextCursor = fmt.Sprintf("%d:%d", next.BlockNumber, next.LogIndex)
+			result.Events = result.Events[:limit]
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d %s events between blocks %d and %d: ", len(result.Events), event.Name, fromBlock, toBlock)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+func (q *queryEventsTool) latestBlock(rpcURL string) (uint64, error) {
+	latestHex, err := utils.NewRPCClient(rpcURL).GetBlockNumber()
+	if err != nil {
+		return 0, fmt.Errorf("Failed to get the latest block: %v", err)
+	}
+	latest, err := strconv.ParseUint(strings.TrimPrefix(latestHex, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to parse the latest block: %v", err)
+	}
+	return latest, nil
+}
@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const queryEventsTestABI = `[{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}]`
+
+func TestQueryEvents(t *testing.T) {
+	topic := func(address string) string {
+		return "0x000000000000000000000000" + address[2:]
+	}
+	transferTopic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	// Three transfers at blocks 10, 10 and 12 with the latest block 20, one block every 12 seconds
+	logs := []map[string]any{
+		{"topics": []string{transferTopic, topic("0x1111111111111111111111111111111111111111"), topic("0x2222222222222222222222222222222222222222")}, "data": "0x00000000000000000000000000000000000000000000000000000000000003e8", "blockNumber": "0xa", "logIndex": "0x0", "transactionHash": "0xAA"},
+		{"topics": []string{transferTopic, topic("0x1111111111111111111111111111111111111111"), topic("0x3333333333333333333333333333333333333333")}, "data": "0x0000000000000000000000000000000000000000000000000000000000000001", "blockNumber": "0xa", "logIndex": "0x1", "transactionHash": "0xbb"},
+		{"topics": []string{transferTopic, topic("0x2222222222222222222222222222222222222222"), topic("0x3333333333333333333333333333333333333333")}, "data": "0x0000000000000000000000000000000000000000000000000000000000000002", "blockNumber": "0xc", "logIndex": "0x0", "transactionHash": "0xcc"},
+	}
+	var filters []map[string]any
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var result any
+		switch request.Method {
+		case "eth_blockNumber":
+			result = "0x14"
+		case "eth_getBlockByNumber":
+			block, _ := strconv.ParseUint(strings.TrimPrefix(request.Params[0].(string), "0x"), 16, 64)
+			result = map[string]any{"timestamp": fmt.Sprintf("0x%x", 1000+12*block)}
+		case "eth_getLogs":
+			filter := request.Params[0].(map[string]any)
+			filters = append(filters, filter)
+			from, _ := strconv.ParseUint(strings.TrimPrefix(filter["fromBlock"].(string), "0x"), 16, 64)
+			matched := []map[string]any{}
+			for _, log := range logs {
+				block, _ := strconv.ParseUint(strings.TrimPrefix(log["blockNumber"].(string), "0x"), 16, 64)
+				if block >= from {
+					matched = append(matched, log)
+				}
+			}
+			result = matched
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 1, "result": result})
+	}))
+	t.Cleanup(rpc.Close)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	chain := &models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, services.NewChainService(db.GetDB()).CreateChain(chain))
+
+	handler := NewQueryEventsTool(services.NewTemplateService(db.GetDB()), services.NewChainService(db.GetDB()), services.NewDeploymentService(db.GetDB())).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+	query := func(args map[string]any) QueryEventsResult {
+		args["contract_address"] = "0x4444444444444444444444444444444444444444"
+		args["abi"] = queryEventsTestABI
+		args["event_name"] = "Transfer"
+		result := call(args)
+		require.False(t, result.IsError, result.Content)
+		var page QueryEventsResult
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &page))
+		return page
+	}
+
+	t.Run("decodes and pages through the logs", func(t *testing.T) {
+		page := query(map[string]any{"from_block": "0", "limit": "2"})
+		assert.Equal(t, uint64(20), page.ToBlock)
+		require.Len(t, page.Events, 2)
+		assert.Equal(t, map[string]any{"from": "0x1111111111111111111111111111111111111111", "to": "0x2222222222222222222222222222222222222222", "value": "1000"}, page.Events[0].Args)
+		assert.Equal(t, "0xaa", page.Events[0].TransactionHash)
+		assert.Equal(t, "12:0", page.NextCursor)
+
+		page = query(map[string]any{"from_block": "0", "to_block": "20", "limit": "2", "cursor": page.NextCursor})
+		require.Len(t, page.Events, 1)
+		assert.Equal(t, "0xcc", page.Events[0].TransactionHash)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("filters on the indexed parameters", func(t *testing.T) {
+		filters = nil
+		query(map[string]any{"topics": map[string]any{"to": "0x3333333333333333333333333333333333333333"}})
+		require.Len(t, filters, 1)
+		assert.Equal(t, []any{transferTopic, nil, topic("0x3333333333333333333333333333333333333333")}, filters[0]["topics"])
+		// The last 5000 blocks by default
+		assert.Equal(t, "0x0", filters[0]["fromBlock"])
+	})
+
+	t.Run("estimates the start block of since", func(t *testing.T) {
+		filters = nil
+		page := query(map[string]any{"since": "1m"})
+		// 60 seconds are 5 blocks of 12 seconds before block 20
+		assert.Equal(t, uint64(15), page.FromBlock)
+		assert.Empty(t, page.Events)
+	})
+
+	t.Run("rejects unknown events and topics", func(t *testing.T) {
+		assert.True(t, call(map[string]any{"contract_address": "0x4444444444444444444444444444444444444444", "abi": queryEventsTestABI, "event_name": "Approval"}).IsError)
+		assert.True(t, call(map[string]any{"contract_address": "0x4444444444444444444444444444444444444444", "abi": queryEventsTestABI, "event_name": "Transfer", "topics": map[string]any{"value": "1"}}).IsError)
+		assert.True(t, call(map[string]any{"contract_address": "0x4444444444444444444444444444444444444444", "abi": queryEventsTestABI, "event_name": "Transfer", "cursor": "bad"}).IsError)
+	})
+}
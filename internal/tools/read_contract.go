@@ -0,0 +1,204 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// maxReadContractCalls bounds the eth_call batch of one read_contract invocation
+const maxReadContractCalls = 50
+
+type readContractTool struct {
+	templateService   services.TemplateService
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+}
+
+type ReadContractCall struct {
+	// Required fields
+	FunctionName string `json:"function_name" validate:"required"`
+
+	// Optional fields
+	ContractAddress string `json:"contract_address,omitempty" validate:"required_without=DeploymentID,excluded_with=DeploymentID,omitempty,eth_addr"`
+	Abi             string `json:"abi,omitempty" validate:"required_without=DeploymentID"`
+	DeploymentID    string `json:"deployment_id,omitempty"`
+	FunctionArgs    []any  `json:"function_args,omitempty"`
+}
+
+type ReadContractArguments struct {
+	// Required fields
+	Calls []ReadContractCall `json:"calls" validate:"required,min=1,max=50,dive"`
+
+	// Optional fields
+	BlockNumber string `json:"block_number,omitempty" validate:"omitempty,numeric"`
+}
+
+// ReadContractResult is the decoded outcome of one call, a reverted call has an error and no outputs
+type ReadContractResult struct {
+	ContractAddress string               `json:"contract_address"`
+	FunctionName    string               `json:"function_name"`
+	Outputs         []utils.DecodedValue `json:"outputs,omitempty"`
+	Error           string               `json:"error,omitempty"`
+}
+
+type ReadContractResponse struct {
+	Block   string               `json:"block"`
+	Results []ReadContractResult `json:"results"`
+}
+
+func NewReadContractTool(templateService services.TemplateService, chainService services.ChainService, deploymentService services.DeploymentService) *readContractTool {
+	return &readContractTool{
+		templateService:   templateService,
+		chainService:      chainService,
+		deploymentService: deploymentService,
+	}
+}
+
+func (r *readContractTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("read_contract",
+		mcp.WithDescription(fmt.Sprintf("Call up to %d view or pure functions on the active Ethereum chain in one JSON-RPC batch and decode their return values with the ABI. "+
+			"Structs are returned as objects keyed by component name, arrays as lists, integers as decimal strings and bytes as hex. "+
+			"Every call takes a contract address and ABI or a deployment ID, a reverted call reports its error without failing the others.", maxReadContractCalls)),
+		mcp.WithArray("calls",
+			mcp.Required(),
+			mcp.Description("Calls to make, read at the same block"),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"function_name": map[string]any{
+						"type":        "string",
+						"description": "Name of the view or pure function in the ABI",
+					},
+					"contract_address": map[string]any{
+						"type":        "string",
+						"description": "Address of the contract. Required without deployment_id",
+					},
+					"abi": map[string]any{
+						"type":        "string",
+						"description": "JSON ABI of the contract. Required without deployment_id, overrides the template ABI of the deployment otherwise",
+					},
+					"deployment_id": map[string]any{
+						"type":        "string",
+						"description": "ID of a confirmed deployment, its contract address and template ABI are used",
+					},
+					"function_args": map[string]any{
+						"type":        "array",
+						"description": "Function arguments in ABI order",
+						"items":       map[string]any{"type": "any"},
+					},
+				},
+				"required": []string{"function_name"},
+			}),
+		),
+		mcp.WithString("block_number",
+			mcp.Description("Block height to read at. Optional, defaults to the latest block"),
+		),
+	)
+
+	return tool
+}
+
+func (r *readContractTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ReadContractArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := r.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("read_contract only supports Ethereum chains, got %s", activeChain.ChainType)), nil
+		}
+
+		block := "latest"
+		if args.BlockNumber != "" {
+			number, err := strconv.ParseUint(args.BlockNumber, 10, 64)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid block_number: %v", err)), nil
+			}
+			block = fmt.Sprintf("0x%x", number)
+		}
+
+		// Every call is checked before anything is sent, a mistake in one call is reported with its index
+		methods := make([]abi.Method, len(args.Calls))
+		results := make([]ReadContractResult, len(args.Calls))
+		params := make([][]interface{}, len(args.Calls))
+		for i, call := range args.Calls {
+			contractAddress, abiString, _, err := resolveContractTarget(r.deploymentService, r.templateService, activeChain, call.DeploymentID, call.ContractAddress, call.Abi)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Call %d: %v", i, err)), nil
+			}
+			parsedABI, err := abi.JSON(strings.NewReader(abiString))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Call %d: Invalid ABI: %v", i, err)), nil
+			}
+			method, ok := parsedABI.Methods[call.FunctionName]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Call %d: Function '%s' not found in ABI", i, call.FunctionName)), nil
+			}
+			if method.StateMutability != "view" && method.StateMutability != "pure" {
+				return mcp.NewToolResultError(fmt.Sprintf("Call %d: Function '%s' is not read-only, use call_contract to send a transaction", i, call.FunctionName)), nil
+			}
+			if len(call.FunctionArgs) != len(method.Inputs) {
+				return mcp.NewToolResultError(fmt.Sprintf("Call %d: Function '%s' expects %d arguments, got %d", i, call.FunctionName, len(method.Inputs), len(call.FunctionArgs))), nil
+			}
+			data, err := utils.EncodeContractFunctionCall(abiString, call.FunctionName, append([]any{}, call.FunctionArgs...))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Call %d: %v", i, err)), nil
+			}
+
+			methods[i] = method
+			results[i] = ReadContractResult{ContractAddress: common.HexToAddress(contractAddress).Hex(), FunctionName: call.FunctionName}
+			params[i] = []interface{}{map[string]string{"to": contractAddress, "data": data}, block}
+		}
+
+		responses, err := utils.NewRPCClient(activeChain.RPC).BatchCall("eth_call", params)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to call the contracts: %v", err)), nil
+		}
+		for i, response := range responses {
+			if response.Error != nil {
+				results[i].Error = response.Error.Message
+				continue
+			}
+			returnData, ok := response.Result.(string)
+			if !ok {
+				results[i].Error = "unexpected eth_call result"
+				continue
+			}
+			outputs, err := utils.DecodeFunctionOutputs(methods[i], common.FromHex(returnData))
+			if err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+			results[i].Outputs = outputs
+		}
+
+		resultJSON, _ := json.Marshal(ReadContractResponse{Block: block, Results: results})
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Read %d calls at block %s: ", len(results), block)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const readContractTestABI = `[{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},` +
+	`{"type":"function","name":"position","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"position","type":"tuple","components":[{"name":"amount","type":"uint256"},{"name":"unlocked","type":"bool"}]}]},` +
+	`{"type":"function","name":"claim","stateMutability":"nonpayable","inputs":[],"outputs":[]}]`
+
+func TestReadContract(t *testing.T) {
+	var blocks []string
+	// Answers the batches in reverse order, the second contract reverts
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []struct {
+			ID     int   `json:"id"`
+			Params []any `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&requests))
+		responses := []map[string]any{}
+		for i := len(requests) - 1; i >= 0; i-- {
+			request := requests[i]
+			blocks = append(blocks, request.Params[1].(string))
+			call := request.Params[0].(map[string]any)
+			response := map[string]any{"jsonrpc": "2.0", "id": request.ID}
+			switch call["to"] {
+			case "0x2222222222222222222222222222222222222222":
+				response["error"] = map[string]any{"code": 3, "message": "execution reverted"}
+			case "0x1111111111111111111111111111111111111111":
+				if call["data"] == "0x18160ddd" {
+					response["result"] = "0x00000000000000000000000000000000000000000000000000000000000003e8"
+				} else {
+					response["result"] = "0x00000000000000000000000000000000000000000000000000000000000000070000000000000000000000000000000000000000000000000000000000000001"
+				}
+			}
+			responses = append(responses, response)
+		}
+		json.NewEncoder(w).Encode(responses)
+	}))
+	t.Cleanup(rpc.Close)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	chain := &models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, services.NewChainService(db.GetDB()).CreateChain(chain))
+
+	handler := NewReadContractTool(services.NewTemplateService(db.GetDB()), services.NewChainService(db.GetDB()), services.NewDeploymentService(db.GetDB())).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("decodes a batch of calls", func(t *testing.T) {
+		result := call(map[string]any{
+			"block_number": "16",
+			"calls": []any{
+				map[string]any{"contract_address": "0x1111111111111111111111111111111111111111", "abi": readContractTestABI, "function_name": "totalSupply"},
+				map[string]any{"contract_address": "0x1111111111111111111111111111111111111111", "abi": readContractTestABI, "function_name": "position", "function_args": []any{"0x3333333333333333333333333333333333333333"}},
+				map[string]any{"contract_address": "0x2222222222222222222222222222222222222222", "abi": readContractTestABI, "function_name": "totalSupply"},
+			},
+		})
+		require.False(t, result.IsError, result.Content)
+
+		var response ReadContractResponse
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &response))
+		assert.Equal(t, "0x10", response.Block)
+		assert.Equal(t, []string{"0x10", "0x10", "0x10"}, blocks)
+		require.Len(t, response.Results, 3)
+		assert.Equal(t, "1000", response.Results[0].Outputs[0].Value)
+		assert.Equal(t, map[string]any{"amount": "7", "unlocked": true}, response.Results[1].Outputs[0].Value)
+		assert.Equal(t, "position", response.Results[1].Outputs[0].Name)
+		assert.Equal(t, "execution reverted", response.Results[2].Error)
+		assert.Empty(t, response.Results[2].Outputs)
+	})
+
+	t.Run("rejects state-changing functions", func(t *testing.T) {
+		result := call(map[string]any{"calls": []any{
+			map[string]any{"contract_address": "0x1111111111111111111111111111111111111111", "abi": readContractTestABI, "function_name": "claim"},
+		}})
+		require.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Call 0: Function 'claim' is not read-only")
+	})
+
+	t.Run("requires calls", func(t *testing.T) {
+		assert.True(t, call(map[string]any{"calls": []any{}}).IsError)
+	})
+}
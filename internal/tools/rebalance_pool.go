@@ -0,0 +1,391 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/constants"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// defaultRebalanceSlippage is the slippage tolerance of the swap and the addition of a rebalance, in percent
+const defaultRebalanceSlippage = 0.5
+
+type rebalancePoolTool struct {
+	chainService        services.ChainService
+	evmService          services.EvmService
+	txService           services.TransactionService
+	liquidityService    services.LiquidityService
+	uniswapService      services.UniswapService
+	launchPolicyService services.LaunchPolicyService
+	serverPort          int
+}
+
+type RebalancePoolArguments struct {
+	// Required fields
+	PoolID       string `json:"pool_id" validate:"required"`
+	TargetPrice  string `json:"target_price" validate:"required"`
+	OwnerAddress string `json:"owner_address" validate:"required"`
+
+	// Optional fields
+	LiquidityETHAmount string `json:"liquidity_eth_amount,omitempty"`
+	DexDeploymentID    string `json:"dex_deployment_id,omitempty"`
+	SlippageTolerance  string `json:"slippage_tolerance,omitempty"`
+	DryRun             bool   `json:"dry_run,omitempty"`
+	LiquidityLockArguments
+	RouterTransactionOverrides
+}
+
+func NewRebalancePoolTool(chainService services.ChainService, evmService services.EvmService, txService services.TransactionService, liquidityService services.LiquidityService, uniswapService services.UniswapService, launchPolicyService services.LaunchPolicyService, serverPort int) *rebalancePoolTool {
+	return &rebalancePoolTool{
+		chainService:        chainService,
+		evmService:          evmService,
+		txService:           txService,
+		liquidityService:    liquidityService,
+		uniswapService:      uniswapService,
+		launchPolicyService: launchPolicyService,
+		serverPort:          serverPort,
+	}
+}
+
+func (r *rebalancePoolTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("rebalance_pool",
+		mcp.WithDescription("Move the price of a token/ETH Uniswap V2 pool to a target price, e.g. to make a market for a freshly launched token. "+
+			"Computes the single-sided swap reaching the price from the on-chain reserves (buying tokens with ETH to raise it, selling tokens to lower it) and creates one session with the swap and, "+
+			"when liquidity_eth_amount is set, the addition of liquidity at the price reached. The liquidity lock rule of the launch policy applies to the pool after the addition, pass lock_days when it requires a lock."),
+		mcp.WithString("pool_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed liquidity pool to rebalance"),
+		),
+		mcp.WithString("target_price",
+			mcp.Required(),
+			mcp.Description("Target price of the pool as the ETH reserve over the token reserve, i.e. ETH per token for an 18 decimals token"),
+		),
+		mcp.WithString("owner_address",
+			mcp.Required(),
+			mcp.Description("Address signing the session, it pays the swap and receives its output and the LP tokens, or their lock when lock_days is set"),
+		),
+		mcp.WithString("liquidity_eth_amount",
+			mcp.Description("Amount of ETH in wei added to the pool after the swap with the matching amount of tokens. Optional, only the swap is made when omitted"),
+		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description("ID of the Uniswap deployment the pool was created on. Optional, defaults to the deployment recorded on the pool, or the default deployment of the chain"),
+		),
+		mcp.WithString("slippage_tolerance",
+			mcp.Description(fmt.Sprintf("Slippage tolerance in percent of the swap output and the addition. Optional, defaults to %g", defaultRebalanceSlippage)),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
+	)
+
+	for _, option := range append(liquidityLockOptions(), routerOverrideOptions()...) {
+		option(&tool)
+	}
+	return tool
+}
+
+func (r *rebalancePoolTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args RebalancePoolArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.OwnerAddress) {
+			return mcp.NewToolResultError("Owner address is not a valid Ethereum address"), nil
+		}
+		targetPrice, err := strconv.ParseFloat(args.TargetPrice, 64)
+		if err != nil || targetPrice <= 0 {
+			return mcp.NewToolResultError("Invalid target_price: must be a positive number"), nil
+		}
+		var addETH *big.Int
+		if args.LiquidityETHAmount == "" && args.LockDays != "" {
+			return mcp.NewToolResultError("lock_days requires liquidity_eth_amount, only added liquidity can be locked"), nil
+		}
+		if args.LiquidityETHAmount != "" {
+			amount, ok := new(big.Int).SetString(args.LiquidityETHAmount, 10)
+			if !ok || amount.Sign() <= 0 {
+				return mcp.NewToolResultError("Invalid liquidity_eth_amount: must be a positive amount of wei"), nil
+			}
+			addETH = amount
+		}
+		slippage := defaultRebalanceSlippage
+		if args.SlippageTolerance != "" {
+			parsed, err := strconv.ParseFloat(args.SlippageTolerance, 64)
+			if err != nil || parsed < 0 || parsed >= 100 {
+				return mcp.NewToolResultError("Invalid slippage_tolerance: must be a percentage between 0 and 100"), nil
+			}
+			slippage = parsed
+		}
+		overrides, err := parseRouterOverrides(args.RouterTransactionOverrides)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		activeChain, err := r.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Uniswap liquidity operations are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		poolID, err := strconv.ParseUint(args.PoolID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid pool_id format: %v", err)), nil
+		}
+		pool, err := r.liquidityService.GetLiquidityPool(uint(poolID))
+		if err != nil {
+			return mcp.NewToolResultError("Liquidity pool not found"), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (pool.UserID == nil || *pool.UserID != userID) {
+			return mcp.NewToolResultError("Liquidity pool not found"), nil
+		}
+		if pool.Status != models.TransactionStatusConfirmed || pool.PairAddress == "" {
+			return mcp.NewToolResultError("Liquidity pool is not confirmed yet. Please wait for the pool creation transaction to be confirmed"), nil
+		}
+		tokenAddress := pool.Token0
+		if tokenAddress == services.EthTokenAddress {
+			tokenAddress = pool.Token1
+		} else if pool.Token1 != services.EthTokenAddress {
+			return mcp.NewToolResultError("Only token/ETH pools can be rebalanced"), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+		deployment, err := selectPoolDexDeployment(r.uniswapService, userId, activeChain, pool, args.DexDeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if !strings.EqualFold(deployment.Version, "v2") || deployment.RouterAddress == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("DEX deployment %d has no Uniswap V2 router, only V2 pools can be rebalanced", deployment.ID)), nil
+		}
+
+		rebalance, err := r.computeRebalance(activeChain.RPC, pool, tokenAddress, deployment, args.OwnerAddress, targetPrice, addETH, slippage)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		// Enforce the liquidity lock rule of the launch policy on the ETH reserve of the pool after the addition
+		lockPlan := &liquidityLockPlan{}
+		if rebalance.AddToken != "" {
+			lockPlan, err = planLiquidityLock(r.launchPolicyService, activeChain.RPC, args.LiquidityLockArguments, func() (*big.Int, error) {
+				ethAmount, _ := new(big.Int).SetString(rebalance.AddETH, 10)
+				ethReserve, _ := new(big.Int).SetString(rebalance.ETHReserve, 10)
+				return ethAmount.Add(ethAmount, ethReserve), nil
+			})
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		transactionDeployments, err := r.createRebalanceTransactions(tokenAddress, deployment, rebalance, args.OwnerAddress, lockPlan.lpReceiver(args.OwnerAddress), overrides.Deadline)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating rebalance transactions: %v", err)), nil
+		}
+		if lockPlan.Lock != nil {
+			lockTx, err := createLiquidityLockTransaction(r.evmService, lockPlan.Lock, tokenAddress, deployment.WETHAddress, args.OwnerAddress)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error creating liquidity lock transaction: %v", err)), nil
+			}
+			transactionDeployments = append(transactionDeployments, lockTx)
+		}
+		// The token approval is left out when the allowance of the owner covers the tokens sold and added
+		spentTokens := new(big.Int)
+		if rebalance.Direction == utils.RebalanceDirectionSell {
+			spentTokens.SetString(rebalance.AmountIn, 10)
+		}
+		if rebalance.AddToken != "" {
+			addToken, _ := new(big.Int).SetString(rebalance.AddToken, 10)
+			spentTokens.Add(spentTokens, addToken)
+		}
+		transactionDeployments, skippedApprovals := omitSufficientApprovals(activeChain.RPC, args.OwnerAddress, transactionDeployments, map[string]string{
+			tokenAddress: spentTokens.String(),
+		})
+		overrides.apply(transactionDeployments)
+
+		metadata := []models.TransactionMetadata{
+			{Key: "pool_id", Value: strconv.FormatUint(uint64(pool.ID), 10)},
+			{Key: "pool_pair_address", Value: pool.PairAddress},
+			{Key: "action", Value: "rebalance_pool"},
+			{Key: "token_address", Value: tokenAddress},
+			{Key: "target_price", Value: strconv.FormatFloat(targetPrice, 'g', -1, 64)},
+		}
+		metadata = skippedApprovalsMetadata(metadata, skippedApprovals)
+		if rebalance.AddToken != "" {
+			metadata = lockPlan.metadata(metadata)
+		}
+		session := services.CreateTransactionSessionRequest{
+			TransactionDeployments: transactionDeployments,
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                activeChain.ID,
+			Metadata:               metadata,
+			UserID:                 userId,
+		}
+		if args.DryRun {
+			return newDryRunResult("rebalance_pool", []services.CreateTransactionSessionRequest{session})
+		}
+
+		session.Context = ctx
+		sessionID, err := r.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Error creating transaction session: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, r.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		swapSummary := fmt.Sprintf("Buys at least %s tokens with %s wei", rebalance.MinAmountOut, rebalance.AmountIn)
+		if rebalance.Direction == utils.RebalanceDirectionSell {
+			swapSummary = fmt.Sprintf("Sells %s tokens for at least %s wei", rebalance.AmountIn, rebalance.MinAmountOut)
+		}
+		content := []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Pool rebalance session created: %s", sessionID)),
+			mcp.NewTextContent(fmt.Sprintf("%s, moving pool %d from %g to %g", swapSummary, pool.ID, rebalance.CurrentPrice, rebalance.ResultingPrice)),
+		}
+		if rebalance.AddToken != "" {
+			content = append(content, mcp.NewTextContent(fmt.Sprintf("Then adds %s tokens and %s wei of liquidity at the new price", rebalance.AddToken, rebalance.AddETH)))
+		}
+		resultJSON, _ := json.Marshal(rebalance)
+		content = append(content,
+			mcp.NewTextContent(string(resultJSON)),
+			mcp.NewTextContent("Please sign the rebalance transactions in the URL:"),
+			mcp.NewTextContent(url),
+		)
+		return &mcp.CallToolResult{Content: content}, nil
+	}
+}
+
+// computeRebalance reads the reserves of the pool and computes the swap reaching the target price
+func (r *rebalancePoolTool) computeRebalance(rpcURL string, pool *models.LiquidityPool, tokenAddress string, deployment *models.UniswapDeployment, ownerAddress string, targetPrice float64, addETH *big.Int, slippage float64) (*utils.PoolRebalance, error) {
+	// the router swapping on the pool must belong to the factory of the pair
+	pairAddress, err := utils.GetV2PairAddress(rpcURL, deployment.FactoryAddress, tokenAddress, deployment.WETHAddress)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read the pair of DEX deployment %d: %v", deployment.ID, err)
+	}
+	if !strings.EqualFold(pairAddress, pool.PairAddress) {
+		return nil, fmt.Errorf("Pool %d is not a pair of DEX deployment %d, pass the dex_deployment_id it was created on", pool.ID, deployment.ID)
+	}
+
+	position, err := utils.ReadV2PairPosition(rpcURL, pool.PairAddress, ownerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read pool %d: %v", pool.ID, err)
+	}
+	tokenReserve, ethReserve := position.ReserveOf(tokenAddress), position.ReserveOf(deployment.WETHAddress)
+	if tokenReserve == nil || ethReserve == nil {
+		return nil, fmt.Errorf("Pair %s does not hold %s and WETH", pool.PairAddress, tokenAddress)
+	}
+
+	rebalance, err := utils.ComputePoolRebalance(tokenReserve, ethReserve, targetPrice, addETH, slippage)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to compute the rebalance of pool %d: %v", pool.ID, err)
+	}
+	return rebalance, nil
+}
+
+// createRebalanceTransactions creates the token approval when tokens are sold or added, the swap and the addition
+// of liquidity minting the LP tokens to lpReceiver when one is requested
+func (r *rebalancePoolTool) createRebalanceTransactions(tokenAddress string, deployment *models.UniswapDeployment, rebalance *utils.PoolRebalance, ownerAddress, lpReceiver string, deadline int64) ([]models.TransactionDeployment, error) {
+	v2Contracts, err := utils.FetchUniswapV2Contracts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Uniswap V2 contracts: %w", err)
+	}
+	routerAbi, err := json.Marshal(v2Contracts.Router.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Router ABI: %w", err)
+	}
+	erc20ABI := `[{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
+	routerAddress := deployment.RouterAddress
+
+	var transactionDeployments []models.TransactionDeployment
+	if rebalance.Direction == utils.RebalanceDirectionSell || rebalance.AddToken != "" {
+		approveTx, err := r.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: tokenAddress,
+			FunctionName:    "approve",
+			FunctionArgs:    []any{routerAddress, constants.MaxUint256.String()},
+			Abi:             erc20ABI,
+			Value:           "0",
+			Title:           "Approve Token for Router",
+			Description:     fmt.Sprintf("Approve unlimited token spending for Uniswap Router at %s", routerAddress),
+			TransactionType: models.TransactionTypeRegular,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create token approval transaction: %w", err)
+		}
+		approveTx.ShowBalanceBeforeDeployment = true
+		approveTx.ContractAddress = &tokenAddress
+		transactionDeployments = append(transactionDeployments, approveTx)
+	}
+
+	deadlineArg := fmt.Sprintf("%d", deadline)
+	swapArgs := services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: routerAddress,
+		Abi:             string(routerAbi),
+		TransactionType: models.TransactionTypeTokenSwap,
+	}
+	if rebalance.Direction == utils.RebalanceDirectionBuy {
+		// swapExactETHForTokens(uint amountOutMin, address[] path, address to, uint deadline) payable
+		swapArgs.FunctionName = "swapExactETHForTokens"
+		swapArgs.FunctionArgs = []any{rebalance.MinAmountOut, toAnyPath([]string{deployment.WETHAddress, tokenAddress}), ownerAddress, deadlineArg}
+		swapArgs.Value = rebalance.AmountIn
+		swapArgs.Title = "Buy Tokens to Raise the Price"
+		swapArgs.Description = fmt.Sprintf("Swap %s wei for at least %s tokens, moving the price to %g", rebalance.AmountIn, rebalance.MinAmountOut, rebalance.ResultingPrice)
+	} else {
+		// swapExactTokensForETH(uint amountIn, uint amountOutMin, address[] path, address to, uint deadline)
+		swapArgs.FunctionName = "swapExactTokensForETH"
+		swapArgs.FunctionArgs = []any{rebalance.AmountIn, rebalance.MinAmountOut, toAnyPath([]string{tokenAddress, deployment.WETHAddress}), ownerAddress, deadlineArg}
+		swapArgs.Value = "0"
+		swapArgs.Title = "Sell Tokens to Lower the Price"
+		swapArgs.Description = fmt.Sprintf("Swap %s tokens for at least %s wei, moving the price to %g", rebalance.AmountIn, rebalance.MinAmountOut, rebalance.ResultingPrice)
+	}
+	swapTx, err := r.evmService.GetContractFunctionCallTransaction(swapArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create swap transaction: %w", err)
+	}
+	swapTx.ShowBalanceBeforeDeployment = true
+	transactionDeployments = append(transactionDeployments, swapTx)
+
+	if rebalance.AddToken == "" {
+		return transactionDeployments, nil
+	}
+	// addLiquidityETH(address token, uint amountTokenDesired, uint amountTokenMin, uint amountETHMin, address to, uint deadline) payable
+	addTx, err := r.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: routerAddress,
+		FunctionName:    "addLiquidityETH",
+		FunctionArgs: []any{
+			tokenAddress,
+			rebalance.AddToken,
+			rebalance.AddMinToken,
+			rebalance.AddMinETH,
+			lpReceiver,
+			deadlineArg,
+		},
+		Abi:             string(routerAbi),
+		Value:           rebalance.AddETH,
+		Title:           "Add Liquidity at the New Price",
+		Description:     fmt.Sprintf("Add %s tokens and %s wei to the pool", rebalance.AddToken, rebalance.AddETH),
+		TransactionType: models.TransactionTypeAddLiquidity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create add liquidity transaction: %w", err)
+	}
+	return append(transactionDeployments, addTx), nil
+}
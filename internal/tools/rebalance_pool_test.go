@@ -0,0 +1,68 @@
+package tools
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalancePool(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+	// the pair holds 1,000,000 tokens and 10,000 wei, a price of 0.01
+	node := newMigrationNode(t)
+
+	chainService := services.NewChainService(db)
+	chain := &models.Chain{Name: "Local", RPC: node.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+	deployment := &models.UniswapDeployment{Name: "Fork", Version: "v2", FactoryAddress: migrationSourceFactory, RouterAddress: "0x7777777777777777777777777777777777777777", WETHAddress: migrationWETH, IsDefault: true, Status: models.TransactionStatusConfirmed, ChainID: chain.ID}
+	require.NoError(t, db.Create(deployment).Error)
+
+	liquidityService := services.NewLiquidityService(db)
+	pool := &models.LiquidityPool{TokenAddress: migrationToken, PairAddress: migrationSourcePair, UniswapVersion: "v2", Token0: migrationToken, Token1: services.EthTokenAddress, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	require.NoError(t, err)
+
+	txService := services.NewTransactionService(db)
+	handler := NewRebalancePoolTool(chainService, services.NewEvmService(), txService, liquidityService, services.NewUniswapService(db), services.NewLaunchPolicyService(db), 8080).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		args["pool_id"] = strconv.FormatUint(uint64(pool.ID), 10)
+		args["owner_address"] = migrationOwner
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(map[string]any{"target_price": "0.01"})
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "already at the target price")
+
+	// raising the price buys tokens with ETH, the tokens added need an approval
+	result = call(map[string]any{"target_price": "0.04", "liquidity_eth_amount": "1000", "slippage_tolerance": "1"})
+	require.False(t, result.IsError, result.Content)
+	sessionID := strings.TrimPrefix(result.Content[0].(mcp.TextContent).Text, "Pool rebalance session created: ")
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	require.Len(t, session.TransactionDeployments, 3)
+	assert.Equal(t, migrationToken, session.TransactionDeployments[0].Receiver)
+	assert.Equal(t, models.TransactionTypeTokenSwap, session.TransactionDeployments[1].TransactionType)
+	assert.Equal(t, deployment.RouterAddress, session.TransactionDeployments[1].Receiver)
+	assert.Equal(t, "10031", session.TransactionDeployments[1].Value)
+	assert.Equal(t, models.TransactionTypeAddLiquidity, session.TransactionDeployments[2].TransactionType)
+	assert.Equal(t, "1000", session.TransactionDeployments[2].Value)
+
+	// raising the price without an addition is a single swap
+	result = call(map[string]any{"target_price": "0.04", "dry_run": true})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "1 transaction session(s) with 1 transaction(s)")
+}
@@ -36,6 +36,14 @@ func NewRemoveLiquidityTool(chainService services.ChainService, liquidityService
 			mcp.Required(),
 			mcp.Description("Address that will remove liquidity"),
 		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description(dexDeploymentIDDescription),
+		),
+		mcp.WithBoolean("mev_protection",
+			mcp.Description("Submit the signed liquidity removal through the private relay of the chain (e.g. Flashbots Protect) instead of the public mempool. Requires private_relay_rpc to be configured with set_chain. Optional, defaults to false"),
+		),
+		withDryRun(),
+		withIdempotencyKey(),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -65,7 +73,7 @@ func NewRemoveLiquidityTool(chainService services.ChainService, liquidityService
 		}
 
 		// Get active chain configuration
-		activeChain, err := chainService.GetActiveChain()
+		activeChain, err := chainService.ResolveChain(ctx)
 		if err != nil {
 			return &mcp.CallToolResult{
 				Content: []mcp.Content{
@@ -85,17 +93,6 @@ func NewRemoveLiquidityTool(chainService services.ChainService, liquidityService
 			}, nil
 		}
 
-		// Check if pool exists
-		pool, err := liquidityService.GetLiquidityPoolByTokenAddress(tokenAddress, "")
-		if err != nil {
-			return &mcp.CallToolResult{
-				Content: []mcp.Content{
-					mcp.NewTextContent("Error: "),
-					mcp.NewTextContent("Liquidity pool not found"),
-				},
-			}, nil
-		}
-
 		// Get the active Uniswap settings
 		user, _ := utils.GetAuthenticatedUser(ctx)
 		var userId *string
@@ -103,34 +100,44 @@ func NewRemoveLiquidityTool(chainService services.ChainService, liquidityService
 			userId = &user.Sub
 		}
 
-		// get active chain
-		chain, err := chainService.GetActiveChain()
+		// The liquidity is removed through the router of the deployment whose factory created the pool
+		uniswapSettings, err := selectDexDeployment(uniswapService, userId, activeChain, request.GetString("dex_deployment_id", ""))
 		if err != nil {
-			return mcp.NewToolResultError("Unable to get active chain. Is there any chain selected?"), nil
+			return mcp.NewToolResultError(err.Error()), nil
 		}
 
-		// Get active Uniswap settings
-		uniswapSettings, err := uniswapService.GetActiveUniswapDeployment(userId, *chain)
+		// Check if pool exists
+		pool, err := liquidityService.GetLiquidityPoolOnDexDeployment(tokenAddress, "", *uniswapSettings)
 		if err != nil {
 			return &mcp.CallToolResult{
 				Content: []mcp.Content{
 					mcp.NewTextContent("Error: "),
-					mcp.NewTextContent("No Uniswap version selected. Please use set_uniswap_version tool first"),
+					mcp.NewTextContent("Liquidity pool not found"),
 				},
 			}, nil
 		}
 
+		mevProtection := request.GetBool("mev_protection", false)
+		if mevProtection && activeChain.PrivateRelayRPC == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("MEV protection is not configured for chain %s. Please use set_chain with private_relay_rpc first", activeChain.Name)), nil
+		}
+
 		// Prepare transaction data for signing
 		transactionData := map[string]interface{}{
-			"pool_id":          pool.ID,
-			"token_address":    tokenAddress,
-			"liquidity_amount": liquidityAmount,
-			"min_token_amount": minTokenAmount,
-			"min_eth_amount":   minETHAmount,
-			"user_address":     userAddress,
-			"chain_type":       activeChain.ChainType,
-			"chain_id":         activeChain.NetworkID,
-			"rpc":              activeChain.RPC,
+			"pool_id":           pool.ID,
+			"pair_address":      pool.PairAddress,
+			"dex_deployment_id": uniswapSettings.ID,
+			"router_address":    uniswapSettings.RouterAddress,
+			"weth_address":      uniswapSettings.WETHAddress,
+			"token_address":     tokenAddress,
+			"liquidity_amount":  liquidityAmount,
+			"min_token_amount":  minTokenAmount,
+			"min_eth_amount":    minETHAmount,
+			"user_address":      userAddress,
+			"chain_type":        activeChain.ChainType,
+			"chain_id":          activeChain.NetworkID,
+			"rpc":               activeChain.RPC,
+			"mev_protection":    mevProtection,
 		}
 
 		transactionDataJSON, err := json.Marshal(transactionData)
@@ -153,6 +160,10 @@ func NewRemoveLiquidityTool(chainService services.ChainService, liquidityService
 			ChainType: activeChain.ChainType,
 			ChainID:   uint(chainIDUint),
 		}
+		if request.GetBool(DryRunArgument, false) {
+			return newDryRunResult("remove_liquidity", []services.CreateTransactionSessionRequest{req})
+		}
+		req.Context = ctx
 		sessionID, err := txService.CreateTransactionSession(req)
 		if err != nil {
 			return &mcp.CallToolResult{
@@ -164,11 +175,19 @@ func NewRemoveLiquidityTool(chainService services.ChainService, liquidityService
 		}
 
 		// Generate signing URL
-		signingURL := fmt.Sprintf("http://localhost:%d/liquidity/remove/%s", serverPort, sessionID)
+		signingURL, err := utils.GetRemoveLiquidityUrl(ctx, serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get signing url: %v", err)), nil
+		}
+		poolURL, err := utils.GetPoolPageUrl(ctx, serverPort, pool.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get pool page url: %v", err)), nil
+		}
 
 		result := map[string]interface{}{
 			"session_id":       sessionID,
 			"signing_url":      signingURL,
+			"pool_url":         poolURL,
 			"token_address":    tokenAddress,
 			"liquidity_amount": liquidityAmount,
 			"min_token_amount": minTokenAmount,
@@ -0,0 +1,206 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type renounceOwnershipTool struct {
+	deploymentService services.DeploymentService
+	evmService        services.EvmService
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type RenounceOwnershipArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	Verify bool `json:"verify,omitempty"`
+}
+
+// OwnershipVerification is the result of renounce_ownership with verify
+type OwnershipVerification struct {
+	DeploymentID    uint   `json:"deployment_id"`
+	ContractAddress string `json:"contract_address"`
+	*utils.OwnershipStatus
+	RenouncedAt    *time.Time `json:"renounced_at,omitempty"`
+	RenounceTxHash string     `json:"renounce_tx_hash,omitempty"`
+}
+
+func NewRenounceOwnershipTool(deploymentService services.DeploymentService, evmService services.EvmService, txService services.TransactionService, serverPort int) *renounceOwnershipTool {
+	return &renounceOwnershipTool{
+		deploymentService: deploymentService,
+		evmService:        evmService,
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (r *renounceOwnershipTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("renounce_ownership",
+		mcp.WithDescription("Renounce the ownership of a deployed token so nobody can call its owner functions anymore, commonly demanded by communities after a launch. "+
+			"Creates a session calling renounceOwnership() with signing URL, to sign with the current owner; the renouncement is recorded on the deployment once confirmed. "+
+			"With verify, reads owner() on chain instead and reports whether the ownership is renounced, the pending owner of Ownable2Step contracts and whether the deployer still holds DEFAULT_ADMIN_ROLE."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment"),
+		),
+		mcp.WithBoolean("verify",
+			mcp.Description("Only read the ownership on chain and record a renouncement found there. Optional, defaults to false"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (r *renounceOwnershipTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args RenounceOwnershipArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, abiString, err := getConfirmedDeploymentWithAbi(r.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (deployment.UserID == nil || *deployment.UserID != userID) {
+			return mcp.NewToolResultError("Deployment not found"), nil
+		}
+		if deployment.Chain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Renouncing ownership is only supported on Ethereum, got %s", deployment.Chain.ChainType)), nil
+		}
+
+		status, err := utils.ReadOwnershipStatus(deployment.Chain.RPC, deployment.ContractAddress, abiString, deployment.DeployerAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read the ownership of %s: %v", deployment.ContractAddress, err)), nil
+		}
+		// renounced outside of the launchpad, e.g. from a block explorer
+		if status.Renounced && deployment.OwnershipRenouncedAt == nil {
+			now := time.Now()
+			if err := r.deploymentService.RecordOwnershipRenounced(deployment.ID, "", now); err != nil {
+				log.Printf("Error recording the renounced ownership of deployment %d: %v", deployment.ID, err)
+			} else {
+				deployment.OwnershipRenouncedAt = &now
+			}
+		}
+
+		if args.Verify || status.Renounced {
+			return r.verification(deployment, status)
+		}
+
+		functionName, err := utils.FindCleanupFunction(abiString, utils.CleanupRenounceOwnership)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		sessionID, err := r.createRenounceSession(ctx, deployment, abiString, functionName, models.TransactionTypeOwnershipRenounce)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, r.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		content := []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Renounce ownership session created: %s", sessionID)),
+			mcp.NewTextContent(fmt.Sprintf("Calls %s on contract %s, sign it with the current owner %s", functionName, deployment.ContractAddress, status.Owner)),
+		}
+		if status.DeployerIsAdmin != nil && *status.DeployerIsAdmin {
+			content = append(content, mcp.NewTextContent("The deployer also holds DEFAULT_ADMIN_ROLE, renounce it with manage_roles so the admin functions are locked too"))
+		}
+		content = append(content,
+			mcp.NewTextContent("Please sign the transaction in the URL:"),
+			mcp.NewTextContent(url),
+		)
+		return &mcp.CallToolResult{Content: content}, nil
+	}
+}
+
+// verification reports the ownership read on chain with the renouncement recorded on the deployment
+func (r *renounceOwnershipTool) verification(deployment *models.Deployment, status *utils.OwnershipStatus) (*mcp.CallToolResult, error) {
+	result := OwnershipVerification{
+		DeploymentID:    deployment.ID,
+		ContractAddress: deployment.ContractAddress,
+		OwnershipStatus: status,
+		RenouncedAt:     deployment.OwnershipRenouncedAt,
+		RenounceTxHash:  deployment.OwnershipRenounceTxHash,
+	}
+
+	summary := fmt.Sprintf("Ownership of %s is held by %s, it is not renounced", deployment.ContractAddress, status.Owner)
+	if status.Renounced {
+		summary = fmt.Sprintf("Ownership of %s is renounced, owner() returns the zero address", deployment.ContractAddress)
+	}
+	if status.PendingOwner != "" {
+		summary += fmt.Sprintf(". %s can still accept the ownership", status.PendingOwner)
+	}
+	if status.DeployerIsAdmin != nil && *status.DeployerIsAdmin {
+		summary += fmt.Sprintf(". The deployer %s still holds DEFAULT_ADMIN_ROLE", deployment.DeployerAddress)
+	}
+
+	resultJSON, _ := json.Marshal(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(summary),
+			mcp.NewTextContent(string(resultJSON)),
+		},
+	}, nil
+}
+
+// createRenounceSession creates the session calling the renounce function of the deployment, the ownership renounce
+// hook records the renouncement once it is confirmed
+func (r *renounceOwnershipTool) createRenounceSession(ctx context.Context, deployment *models.Deployment, abiString, functionName string, txType models.TransactionType) (string, error) {
+	tx, err := r.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: deployment.ContractAddress,
+		FunctionName:    functionName,
+		FunctionArgs:    []any{},
+		Abi:             abiString,
+		Value:           "0",
+		Title:           "Renounce Ownership",
+		Description:     fmt.Sprintf("Call %s on contract %s, nobody can call its owner functions afterwards", functionName, deployment.ContractAddress),
+		TransactionType: txType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Failed to create %s transaction: %v", functionName, err)
+	}
+	tx.ContractAddress = &deployment.ContractAddress
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+	sessionID, err := r.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{tx},
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                deployment.ChainID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "deployment_id", Value: strconv.FormatUint(uint64(deployment.ID), 10)},
+		},
+		UserID:  userId,
+		Context: ctx,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Failed to create transaction session: %v", err)
+	}
+	return sessionID, nil
+}
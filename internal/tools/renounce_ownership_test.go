@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenounceOwnership(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	db := dbService.GetDB()
+
+	// owner() returns the deployer until the test renounces it
+	owner := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request utils.JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		data := request.Params[0].(map[string]interface{})["data"].(string)
+		require.True(t, strings.HasPrefix(data, "0x8da5cb5b"), data)
+		_ = json.NewEncoder(w).Encode(utils.JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: hexutil.Encode(common.LeftPadBytes(owner.Bytes(), 32))})
+	}))
+	t.Cleanup(node.Close)
+
+	chain := &models.Chain{Name: "Local", RPC: node.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum}
+	require.NoError(t, db.Create(chain).Error)
+	var abiArray []any
+	require.NoError(t, json.Unmarshal([]byte(abandonDeploymentTemplateAbi), &abiArray))
+	template := &models.Template{Name: "Ownable Token", ChainType: models.TransactionChainTypeEthereum, Abi: models.JSON(map[string]any{"abi": abiArray})}
+	require.NoError(t, services.NewTemplateService(db).CreateTemplate(template))
+
+	txService := services.NewTransactionService(db)
+	deploymentService := services.NewDeploymentService(db)
+	user := "user-1"
+	deployment := &models.Deployment{ChainID: chain.ID, TemplateID: template.ID, UserID: &user, Status: models.TransactionStatusConfirmed,
+		ContractAddress: "0x1111111111111111111111111111111111111111", DeployerAddress: owner.Hex()}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	deploymentID := strconv.FormatUint(uint64(deployment.ID), 10)
+
+	handler := NewRenounceOwnershipTool(deploymentService, services.NewEvmService(), txService, 8080).GetHandler()
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: user})
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(map[string]any{"deployment_id": deploymentID, "verify": true})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "it is not renounced")
+
+	result = call(map[string]any{"deployment_id": deploymentID})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Renounce ownership session created")
+	assert.Contains(t, result.Content[1].(mcp.TextContent).Text, owner.Hex())
+
+	sessions, err := txService.ListTransactionSessionsByUser(user)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Len(t, sessions[0].TransactionDeployments, 1)
+	assert.Equal(t, models.TransactionTypeOwnershipRenounce, sessions[0].TransactionDeployments[0].TransactionType)
+	assert.Equal(t, "0x715018a6", sessions[0].TransactionDeployments[0].Data)
+
+	// renounced on chain, the renouncement is recorded without a new session
+	owner = common.Address{}
+	result = call(map[string]any{"deployment_id": deploymentID})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "is renounced")
+	var verification OwnershipVerification
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &verification))
+	assert.True(t, verification.Renounced)
+	assert.NotNil(t, verification.RenouncedAt)
+
+	updated, err := deploymentService.GetDeploymentByID(deployment.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, updated.OwnershipRenouncedAt)
+	sessions, err = txService.ListTransactionSessionsByUser(user)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+
+	other := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	result, err = handler(other, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"deployment_id": deploymentID}}})
+	require.NoError(t, err)
+	assert.Equal(t, "Deployment not found", result.Content[0].(mcp.TextContent).Text)
+}
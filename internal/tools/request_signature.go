@@ -0,0 +1,179 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type requestSignatureTool struct {
+	chainService services.ChainService
+	txService    services.TransactionService
+	serverPort   int
+}
+
+type RequestSignatureArguments struct {
+	// Optional fields
+	Address   string `json:"address,omitempty" validate:"omitempty,eth_addr"`
+	Statement string `json:"statement,omitempty" validate:"omitempty,max=512"`
+	// SessionID checks the result of an earlier request instead of creating one
+	SessionID string `json:"session_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// SignatureRequestResult is the state of a signature request, Verified once the server recovered the signer
+type SignatureRequestResult struct {
+	SessionID string `json:"session_id"`
+	Verified  bool   `json:"verified"`
+	Expired   bool   `json:"expired"`
+	*models.SignatureRequest
+}
+
+func NewRequestSignatureTool(chainService services.ChainService, txService services.TransactionService, serverPort int) *requestSignatureTool {
+	return &requestSignatureTool{
+		chainService: chainService,
+		txService:    txService,
+		serverPort:   serverPort,
+	}
+}
+
+func (r *requestSignatureTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("request_signature",
+		mcp.WithDescription("Ask the user to sign a message with a nonce in the browser to prove they control an address, e.g. the deployer address before a launch. "+
+			"The signer is recovered from the signature by the server, the session is only confirmed when it matches the address. "+
+			"Creates a session with signing URL; call again with session_id to read the verified signer."),
+		mcp.WithString("address",
+			mcp.Description("Address the signature must recover to. Optional, any wallet can sign without it and the signer is reported"),
+		),
+		mcp.WithString("statement",
+			mcp.Description("Statement shown at the top of the message, e.g. the reason of the request. Optional"),
+		),
+		mcp.WithString("session_id",
+			mcp.Description("Session of an earlier request to check instead of creating a new one. Optional"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (r *requestSignatureTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args RequestSignatureArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		if args.SessionID != "" {
+			return r.checkSignature(ctx, args.SessionID)
+		}
+
+		activeChain, err := r.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("request_signature only supports Ethereum chains, got %s", activeChain.ChainType)), nil
+		}
+
+		nonce, err := utils.GenerateSignatureNonce()
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		address := ""
+		if args.Address != "" {
+			address = common.HexToAddress(args.Address).Hex()
+		}
+		signatureRequest := &models.SignatureRequest{
+			Message: utils.GenerateOwnershipMessage(args.Statement, address, nonce, time.Now()),
+			Nonce:   nonce,
+			Address: address,
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		metadata := []models.TransactionMetadata{{Key: "action", Value: "request_signature"}}
+		if address != "" {
+			metadata = append(metadata, models.TransactionMetadata{Key: "address", Value: address})
+		}
+		sessionID, err := r.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+			ChainType:        models.TransactionChainTypeEthereum,
+			ChainID:          activeChain.ID,
+			Metadata:         metadata,
+			UserID:           userId,
+			SignatureRequest: signatureRequest,
+			Context:          ctx,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create signature session: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, r.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Signature session created: %s", sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Message to sign:\n%s", signatureRequest.Message)),
+				mcp.NewTextContent("Please sign the message in the URL, then call request_signature with the session_id to read the verified signer:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// checkSignature reports the signature request of a session, expired sessions included
+func (r *requestSignatureTool) checkSignature(ctx context.Context, sessionID string) (*mcp.CallToolResult, error) {
+	sessions, err := r.txService.ListTransactionSessionsByIDs([]string{sessionID})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get session: %v", err)), nil
+	}
+	if len(sessions) == 0 || sessions[0].SignatureRequest == nil {
+		return mcp.NewToolResultError("Signature session not found"), nil
+	}
+	session := sessions[0]
+	if userID := utils.GetUserID(ctx); userID != "" && (session.UserID == nil || *session.UserID != userID) {
+		return mcp.NewToolResultError("Signature session not found"), nil
+	}
+
+	result := SignatureRequestResult{
+		SessionID:        session.ID,
+		Verified:         session.SignatureRequest.Signer != "",
+		SignatureRequest: session.SignatureRequest,
+	}
+	result.Expired = !result.Verified && time.Now().After(session.ExpiresAt)
+
+	summary := fmt.Sprintf("Message of session %s is not signed yet", session.ID)
+	switch {
+	case result.Verified:
+		summary = fmt.Sprintf("Message of session %s was signed by %s", session.ID, session.SignatureRequest.Signer)
+	case result.Expired:
+		summary = fmt.Sprintf("Session %s expired before the message was signed, request a new signature", session.ID)
+	}
+
+	resultJSON, _ := json.Marshal(result)
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(summary),
+			mcp.NewTextContent(string(resultJSON)),
+		},
+	}, nil
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestSignature(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	require.NoError(t, chainService.CreateChain(&models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}))
+	txService := services.NewTransactionService(db.GetDB())
+
+	handler := NewRequestSignatureTool(chainService, txService, 9999).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(map[string]any{"address": "0x1111111111111111111111111111111111111111", "statement": "Prove you own the deployer"})
+	require.False(t, result.IsError, result.Content)
+	_, sessionID, _ := strings.Cut(result.Content[0].(mcp.TextContent).Text, "session created: ")
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+
+	request := session.SignatureRequest
+	require.NotNil(t, request)
+	assert.Empty(t, session.TransactionDeployments)
+	assert.Equal(t, "0x1111111111111111111111111111111111111111", request.Address)
+	assert.Len(t, request.Nonce, 32)
+	assert.True(t, strings.HasPrefix(request.Message, "Prove you own the deployer\n\n"))
+	assert.Contains(t, request.Message, "Nonce: "+request.Nonce)
+
+	// every request signs a new nonce
+	other := call(map[string]any{})
+	require.False(t, other.IsError, other.Content)
+	assert.NotContains(t, other.Content[1].(mcp.TextContent).Text, request.Nonce)
+
+	t.Run("reports the request until it is signed", func(t *testing.T) {
+		check := call(map[string]any{"session_id": sessionID})
+		require.False(t, check.IsError, check.Content)
+		assert.Contains(t, check.Content[0].(mcp.TextContent).Text, "not signed yet")
+
+		signedAt := time.Now()
+		request.Signer = "0x1111111111111111111111111111111111111111"
+		request.SignedAt = &signedAt
+		require.NoError(t, txService.UpdateTransactionSession(sessionID, session))
+
+		check = call(map[string]any{"session_id": sessionID})
+		require.False(t, check.IsError, check.Content)
+		var signed SignatureRequestResult
+		require.NoError(t, json.Unmarshal([]byte(check.Content[1].(mcp.TextContent).Text), &signed))
+		assert.True(t, signed.Verified)
+		assert.Equal(t, request.Signer, signed.Signer)
+	})
+
+	t.Run("rejects invalid arguments", func(t *testing.T) {
+		assert.True(t, call(map[string]any{"address": "not an address"}).IsError)
+		assert.True(t, call(map[string]any{"session_id": "00000000-0000-0000-0000-000000000000"}).IsError)
+	})
+}
@@ -0,0 +1,193 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// erc20ApproveAbi is the ERC-20 approve function used to revoke allowances
+const erc20ApproveAbi = `[{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
+
+type revokeAllowanceTool struct {
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	uniswapService    services.UniswapService
+	evmService        services.EvmService
+	txService         services.TransactionService
+	serverPort        int
+}
+
+type RevokeAllowanceArguments struct {
+	// Required fields
+	OwnerAddress string `json:"owner_address" validate:"required"`
+
+	// Optional fields
+	TokenAddress   string `json:"token_address,omitempty"`
+	SpenderAddress string `json:"spender_address,omitempty"`
+}
+
+func NewRevokeAllowanceTool(chainService services.ChainService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService, evmService services.EvmService, txService services.TransactionService, serverPort int) *revokeAllowanceTool {
+	return &revokeAllowanceTool{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		uniswapService:    uniswapService,
+		evmService:        evmService,
+		txService:         txService,
+		serverPort:        serverPort,
+	}
+}
+
+func (r *revokeAllowanceTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("revoke_allowance",
+		mcp.WithDescription("Revoke ERC-20 allowances granted by an address by setting them to 0 (approve 0). Revokes every non-zero allowance found by list_allowances, narrowed to a token and/or spender when given, in one transaction session with a signing URL."),
+		mcp.WithString("owner_address",
+			mcp.Required(),
+			mcp.Description("Address that granted the allowances, it signs the revoke transactions"),
+		),
+		mcp.WithString("token_address",
+			mcp.Description("Only revoke allowances of this token. Optional"),
+		),
+		mcp.WithString("spender_address",
+			mcp.Description("Only revoke allowances of this spender. Optional"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (r *revokeAllowanceTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args RevokeAllowanceArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := r.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Allowances are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		var extraTokens, extraSpenders []string
+		if args.TokenAddress != "" {
+			extraTokens = []string{args.TokenAddress}
+		}
+		if args.SpenderAddress != "" {
+			extraSpenders = []string{args.SpenderAddress}
+		}
+
+		scan, err := scanAllowances(r.deploymentService, r.liquidityService, r.uniswapService, activeChain, args.OwnerAddress, extraTokens, extraSpenders)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		var allowances []AllowanceEntry
+		for _, allowance := range scan.Allowances {
+			if args.TokenAddress != "" && !strings.EqualFold(allowance.Token, args.TokenAddress) {
+				continue
+			}
+			if args.SpenderAddress != "" && !strings.EqualFold(allowance.Spender, args.SpenderAddress) {
+				continue
+			}
+			allowances = append(allowances, allowance)
+		}
+
+		if len(allowances) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("No allowances to revoke for %s", scan.OwnerAddress)), nil
+		}
+
+		sessionID, err := r.createRevokeSession(ctx, activeChain, scan.OwnerAddress, allowances)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create revoke session: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, r.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Revoke session created: %s", sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Revoking %d allowances granted by %s", len(allowances), scan.OwnerAddress)),
+				mcp.NewTextContent("Please sign the transactions in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+func (r *revokeAllowanceTool) createRevokeSession(ctx context.Context, activeChain *models.Chain, ownerAddress string, allowances []AllowanceEntry) (string, error) {
+	var transactions []models.TransactionDeployment
+	for _, allowance := range allowances {
+		functionArgs := []any{allowance.Spender, "0"}
+
+		current := allowance.Allowance
+		if allowance.Unlimited {
+			current = "unlimited"
+		}
+
+		tx, err := r.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: allowance.Token,
+			FunctionName:    "approve",
+			FunctionArgs:    functionArgs,
+			Abi:             erc20ApproveAbi,
+			Value:           "0",
+			Title:           fmt.Sprintf("Revoke %s allowance on %s", allowance.SpenderLabel, allowance.TokenLabel),
+			Description:     fmt.Sprintf("Set the allowance of %s on token %s from %s to 0", allowance.Spender, allowance.Token, current),
+			TransactionType: models.TransactionTypeRegular,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to create approve transaction: %w", err)
+		}
+
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI("approve", functionArgs, erc20ApproveAbi)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		tx.RawContractArguments = &functionArgsString
+		transactions = append(transactions, tx)
+	}
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+
+	sessionID, err := r.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+		TransactionDeployments: transactions,
+		ChainType:              models.TransactionChainTypeEthereum,
+		ChainID:                activeChain.ID,
+		Metadata: []models.TransactionMetadata{
+			{Key: "owner_address", Value: common.HexToAddress(ownerAddress).Hex()},
+			{Key: "revoked_allowances", Value: fmt.Sprintf("%d", len(allowances))},
+		},
+		UserID:  userId,
+		Context: ctx,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create transaction session: %w", err)
+	}
+
+	return sessionID, nil
+}
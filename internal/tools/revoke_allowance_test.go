@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type RevokeAllowanceToolTestSuite struct {
+	suite.Suite
+	db        services.DBService
+	rpcServer *httptest.Server
+	tool      *revokeAllowanceTool
+	txService services.TransactionService
+}
+
+func (suite *RevokeAllowanceToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.rpcServer = newAllowanceRPCServer(map[string]*big.Int{
+		strings.ToLower(allowanceToken): big.NewInt(1000),
+		strings.ToLower(allowanceWETH):  big.NewInt(5),
+	})
+	setupAllowanceFixtures(&suite.Suite, db, suite.rpcServer.URL)
+
+	suite.txService = services.NewTransactionService(db.GetDB())
+	suite.tool = NewRevokeAllowanceTool(services.NewChainService(db.GetDB()), services.NewDeploymentService(db.GetDB()), services.NewLiquidityService(db.GetDB()), services.NewUniswapService(db.GetDB()), services.NewEvmService(), suite.txService, 8080)
+}
+
+func (suite *RevokeAllowanceToolTestSuite) TearDownSuite() {
+	suite.rpcServer.Close()
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *RevokeAllowanceToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	result, err := suite.tool.GetHandler()(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{Arguments: arguments},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *RevokeAllowanceToolTestSuite) TestRevokesAllAllowances() {
+	result := suite.callHandler(map[string]interface{}{"owner_address": allowanceOwner})
+	suite.Require().False(result.IsError, result.Content)
+
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	var sessionID string
+	_, err := fmt.Sscanf(textContent.Text, "Revoke session created: %s", &sessionID)
+	suite.Require().NoError(err)
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Require().Len(session.TransactionDeployments, 2)
+	suite.Equal(allowanceToken, session.TransactionDeployments[0].Receiver)
+	suite.Equal(allowanceWETH, session.TransactionDeployments[1].Receiver)
+	// approve(router, 0)
+	suite.True(strings.HasPrefix(session.TransactionDeployments[0].Data, "0x095ea7b3"))
+	suite.Contains(session.TransactionDeployments[0].Description, "from 1000 to 0")
+}
+
+func (suite *RevokeAllowanceToolTestSuite) TestRevokesSingleToken() {
+	result := suite.callHandler(map[string]interface{}{
+		"owner_address": allowanceOwner,
+		"token_address": strings.ToLower(allowanceWETH),
+	})
+	suite.Require().False(result.IsError, result.Content)
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Contains(textContent.Text, "Revoking 1 allowances")
+}
+
+func (suite *RevokeAllowanceToolTestSuite) TestNothingToRevoke() {
+	result := suite.callHandler(map[string]interface{}{
+		"owner_address":   allowanceOwner,
+		"spender_address": allowanceOwner,
+	})
+	suite.True(result.IsError)
+	textContent, _ := result.Content[0].(mcp.TextContent)
+	suite.Contains(textContent.Text, "No allowances to revoke")
+}
+
+func TestRevokeAllowanceToolTestSuite(t *testing.T) {
+	suite.Run(t, new(RevokeAllowanceToolTestSuite))
+}
@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type revokeAPIKeyTool struct {
+	apiKeyService services.APIKeyService
+}
+
+type RevokeAPIKeyArguments struct {
+	ID string `json:"id" validate:"required"`
+}
+
+func NewRevokeAPIKeyTool(apiKeyService services.APIKeyService) *revokeAPIKeyTool {
+	return &revokeAPIKeyTool{
+		apiKeyService: apiKeyService,
+	}
+}
+
+func (r *revokeAPIKeyTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("revoke_api_key",
+		mcp.WithDescription("Revoke an API key created by create_api_key, its next requests are rejected. Requires the admin role."),
+		mcp.WithString("id",
+			mcp.Required(),
+			mcp.Description("ID of the API key returned by create_api_key"),
+		),
+	)
+
+	return tool
+}
+
+func (r *revokeAPIKeyTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if !utils.HasRole(ctx, adminRole) {
+			return mcp.NewToolResultError("revoke_api_key requires an authenticated user with the admin role"), nil
+		}
+
+		var args RevokeAPIKeyArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		id, err := strconv.ParseUint(args.ID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid id %q: must be a number", args.ID)), nil
+		}
+
+		record, err := r.apiKeyService.RevokeAPIKey(uint(id))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to revoke the API key: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(record)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("API key %s (%s) revoked: ", record.Name, record.Prefix)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// defaultDeadlineSeconds is how long the router accepts a transaction when no deadline override is given
+const defaultDeadlineSeconds = 600
+
+// minGasLimit is the gas used by a plain transfer, no router call can use less
+const minGasLimit = 21000
+
+// RouterTransactionOverrides are the optional execution parameters of the swap and liquidity tools
+type RouterTransactionOverrides struct {
+	DeadlineSeconds string `json:"deadline_seconds,omitempty"`
+	GasLimit        string `json:"gas_limit,omitempty"`
+	GasPrice        string `json:"gas_price,omitempty"`
+	Nonce           string `json:"nonce,omitempty"`
+}
+
+// routerOverrides are the parsed RouterTransactionOverrides
+type routerOverrides struct {
+	// Deadline is the unix timestamp passed to the router
+	Deadline int64
+	GasLimit *string
+	GasPrice *string
+	Nonce    *uint64
+}
+
+// routerOverrideOptions returns the tool options of the RouterTransactionOverrides parameters
+func routerOverrideOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("deadline_seconds",
+			mcp.Description(fmt.Sprintf("Seconds from now after which the router rejects the transaction. Optional, defaults to %d", defaultDeadlineSeconds)),
+		),
+		mcp.WithString("gas_limit",
+			mcp.Description("Gas limit of the router transaction instead of the wallet estimate. Approvals keep the wallet estimate. Optional"),
+		),
+		mcp.WithString("gas_price",
+			mcp.Description("Gas price in wei used for every transaction of the session instead of the wallet default. Optional"),
+		),
+		mcp.WithString("nonce",
+			mcp.Description("Nonce of the first transaction of the session, the following transactions use the next nonces. Optional, defaults to the wallet nonce"),
+		),
+	}
+}
+
+// parseRouterOverrides validates the overrides and resolves the deadline
+func parseRouterOverrides(overrides RouterTransactionOverrides) (*routerOverrides, error) {
+	deadlineSeconds := int64(defaultDeadlineSeconds)
+	if overrides.DeadlineSeconds != "" {
+		seconds, err := strconv.ParseInt(overrides.DeadlineSeconds, 10, 64)
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("Invalid deadline_seconds: must be a positive number of seconds")
+		}
+		deadlineSeconds = seconds
+	}
+
+	parsed := &routerOverrides{Deadline: time.Now().Unix() + deadlineSeconds}
+
+	if overrides.GasLimit != "" {
+		gasLimit, err := strconv.ParseUint(overrides.GasLimit, 10, 64)
+		if err != nil || gasLimit < minGasLimit {
+			return nil, fmt.Errorf("Invalid gas_limit: must be a number of at least %d", minGasLimit)
+		}
+		value := strconv.FormatUint(gasLimit, 10)
+		parsed.GasLimit = &value
+	}
+
+	if overrides.GasPrice != "" {
+		gasPrice, ok := new(big.Int).SetString(overrides.GasPrice, 10)
+		if !ok || gasPrice.Sign() <= 0 {
+			return nil, fmt.Errorf("Invalid gas_price: must be a positive amount of wei")
+		}
+		value := gasPrice.String()
+		parsed.GasPrice = &value
+	}
+
+	if overrides.Nonce != "" {
+		nonce, err := strconv.ParseUint(overrides.Nonce, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid nonce: must be a non-negative number")
+		}
+		parsed.Nonce = &nonce
+	}
+
+	return parsed, nil
+}
+
+// apply persists the overrides into the transactions of a session.
+// The deadline and gas limit only apply to router transactions, the gas price and nonce to every transaction.
+func (o *routerOverrides) apply(transactions []models.TransactionDeployment) {
+	for i := range transactions {
+		if transactions[i].TransactionType != models.TransactionTypeRegular {
+			deadline := o.Deadline
+			transactions[i].Deadline = &deadline
+			transactions[i].GasLimit = o.GasLimit
+		}
+		transactions[i].GasPrice = o.GasPrice
+		if o.Nonce != nil {
+			nonce := *o.Nonce + uint64(i)
+			transactions[i].Nonce = &nonce
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRouterOverridesDefaults(t *testing.T) {
+	overrides, err := parseRouterOverrides(RouterTransactionOverrides{})
+	require.NoError(t, err)
+
+	assert.InDelta(t, time.Now().Unix()+defaultDeadlineSeconds, overrides.Deadline, 2)
+	assert.Nil(t, overrides.GasLimit)
+	assert.Nil(t, overrides.GasPrice)
+	assert.Nil(t, overrides.Nonce)
+}
+
+func TestParseRouterOverridesRejectsInvalidValues(t *testing.T) {
+	cases := map[string]RouterTransactionOverrides{
+		"deadline_seconds": {DeadlineSeconds: "0"},
+		"gas_limit":        {GasLimit: "20000"},
+		"gas_price":        {GasPrice: "-1"},
+		"nonce":            {Nonce: "abc"},
+	}
+	for field, overrides := range cases {
+		_, err := parseRouterOverrides(overrides)
+		assert.ErrorContains(t, err, field)
+	}
+}
+
+func TestApplyRouterOverrides(t *testing.T) {
+	overrides, err := parseRouterOverrides(RouterTransactionOverrides{
+		DeadlineSeconds: "60",
+		GasLimit:        "250000",
+		GasPrice:        "2000000000",
+		Nonce:           "7",
+	})
+	require.NoError(t, err)
+
+	transactions := []models.TransactionDeployment{
+		{TransactionType: models.TransactionTypeRegular},
+		{TransactionType: models.TransactionTypeTokenSwap},
+	}
+	overrides.apply(transactions)
+
+	// The approval keeps the wallet gas estimate and has no deadline
+	approval := transactions[0]
+	assert.Nil(t, approval.Deadline)
+	assert.Nil(t, approval.GasLimit)
+	assert.Equal(t, "2000000000", *approval.GasPrice)
+	assert.Equal(t, uint64(7), *approval.Nonce)
+
+	swap := transactions[1]
+	assert.Equal(t, overrides.Deadline, *swap.Deadline)
+	assert.Equal(t, "250000", *swap.GasLimit)
+	assert.Equal(t, "2000000000", *swap.GasPrice)
+	assert.Equal(t, uint64(8), *swap.Nonce)
+}
@@ -0,0 +1,116 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type saveAddressTool struct {
+	addressBookService services.AddressBookService
+}
+
+type SaveAddressArguments struct {
+	// Required fields
+	Label   string `json:"label" validate:"required"`
+	Address string `json:"address" validate:"required"`
+
+	// Optional fields
+	ChainType string `json:"chain_type,omitempty" validate:"omitempty,oneof=ethereum solana"`
+	Notes     string `json:"notes,omitempty" validate:"max=500"`
+}
+
+func NewSaveAddressTool(addressBookService services.AddressBookService) *saveAddressTool {
+	return &saveAddressTool{
+		addressBookService: addressBookService,
+	}
+}
+
+func (s *saveAddressTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("save_address",
+		mcp.WithDescription("Save an address in the address book under a label such as treasury or marketing wallet. "+
+			"Any tool argument written as @label (e.g. \"@treasury\") is replaced with the saved address before the tool runs. "+
+			"Saving an existing label replaces its address. List the saved addresses with list_addresses."),
+		mcp.WithString("label",
+			mcp.Required(),
+			mcp.Description("Label of the address, 1 to 64 letters, digits, spaces, dots, dashes or underscores, matched case-insensitively"),
+		),
+		mcp.WithString("address",
+			mcp.Required(),
+			mcp.Description("Address to save, an EVM address or a Solana public key"),
+		),
+		mcp.WithString("chain_type",
+			mcp.Description("Chain type of the address. Optional, defaults to ethereum"),
+			mcp.Enum(string(models.TransactionChainTypeEthereum), string(models.TransactionChainTypeSolana)),
+		),
+		mcp.WithString("notes",
+			mcp.Description("Notes about the address, up to 500 characters. Optional"),
+		),
+	)
+
+	return tool
+}
+
+func (s *saveAddressTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SaveAddressArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		chainType := models.TransactionChainType(args.ChainType)
+		if chainType == "" {
+			chainType = models.TransactionChainTypeEthereum
+		}
+		address := strings.TrimSpace(args.Address)
+		switch chainType {
+		case models.TransactionChainTypeEthereum:
+			if !utils.IsValidEthereumAddress(address) {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid Ethereum address: %s", address)), nil
+			}
+			address = common.HexToAddress(address).Hex()
+		case models.TransactionChainTypeSolana:
+			if !utils.IsValidSolanaAddress(address) {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid Solana address: %s", address)), nil
+			}
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		entry := &models.AddressBookEntry{
+			UserID:    userId,
+			Label:     args.Label,
+			Address:   address,
+			ChainType: chainType,
+			Notes:     strings.TrimSpace(args.Notes),
+		}
+		if err := s.addressBookService.SaveAddress(entry); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to save address: %v", err)), nil
+		}
+
+		resultJSON, _ := json.Marshal(entry)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Saved %s as %s, refer to it with \"%s%s\" in any tool argument", entry.Address, entry.Label, services.AddressBookReferencePrefix, entry.Label)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAndListAddresses(t *testing.T) {
+	dbService, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { dbService.Close() })
+	addressBookService := services.NewAddressBookService(dbService.GetDB())
+	saveHandler := NewSaveAddressTool(addressBookService).GetHandler()
+	listHandler := NewListAddressesTool(addressBookService).GetHandler()
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-1"})
+
+	call := func(handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) *mcp.CallToolResult {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(saveHandler, map[string]any{"label": "Treasury", "address": "0x5fbdb2315678afecb367f032d93f642f64180aa3", "notes": "Multisig"})
+	require.False(t, result.IsError, result.Content)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, `"@treasury"`)
+	result = call(saveHandler, map[string]any{"label": "mint", "address": "So11111111111111111111111111111111111111112", "chain_type": "solana"})
+	require.False(t, result.IsError, result.Content)
+
+	result = call(saveHandler, map[string]any{"label": "bad", "address": "0x123"})
+	assert.True(t, result.IsError)
+	result = call(saveHandler, map[string]any{"label": "bad", "address": "0x5fbdb2315678afecb367f032d93f642f64180aa3", "chain_type": "solana"})
+	assert.True(t, result.IsError)
+	result = call(saveHandler, map[string]any{"label": "@treasury", "address": "0x5fbdb2315678afecb367f032d93f642f64180aa3"})
+	assert.True(t, result.IsError)
+
+	result = call(listHandler, map[string]any{"chain_type": "ethereum"})
+	require.False(t, result.IsError, result.Content)
+	var entries []models.AddressBookEntry
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, "treasury", entries[0].Label)
+	// EVM addresses are stored checksummed
+	assert.Equal(t, "0x5FbDB2315678afecb367f032d93F642f64180aa3", entries[0].Address)
+	assert.Equal(t, "Multisig", entries[0].Notes)
+
+	result = call(listHandler, map[string]any{})
+	require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &entries))
+	assert.Len(t, entries, 2)
+}
@@ -0,0 +1,200 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type scheduleBuybackTool struct {
+	chainService      services.ChainService
+	deploymentService services.DeploymentService
+	buybackService    services.BuybackService
+}
+
+type ScheduleBuybackArguments struct {
+	// Required fields
+	DeploymentID      string `json:"deployment_id" validate:"required"`
+	Amount            string `json:"amount" validate:"required"`
+	SlippageTolerance string `json:"slippage_tolerance" validate:"required"`
+	TreasuryAddress   string `json:"treasury_address" validate:"required"`
+	Schedule          string `json:"schedule" validate:"required"`
+
+	// Optional fields
+	StartAt string `json:"start_at,omitempty"`
+	MaxRuns string `json:"max_runs,omitempty"`
+}
+
+func NewScheduleBuybackTool(chainService services.ChainService, deploymentService services.DeploymentService, buybackService services.BuybackService) *scheduleBuybackTool {
+	return &scheduleBuybackTool{
+		chainService:      chainService,
+		deploymentService: deploymentService,
+		buybackService:    buybackService,
+	}
+}
+
+func (s *scheduleBuybackTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("schedule_buyback",
+		mcp.WithDescription(fmt.Sprintf("Schedule a buyback-and-burn of a deployed token on the active chain. On every run a ready-to-sign swap session is created that buys the token with treasury ETH and sends the bought tokens to the burn address %s. Use list_buybacks to get the signing URLs and the cumulative burn.", utils.BurnAddress)),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment to buy back"),
+		),
+		mcp.WithString("amount",
+			mcp.Required(),
+			mcp.Description("Amount of ETH spent on every run, in wei"),
+		),
+		mcp.WithString("slippage_tolerance",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Maximum slippage tolerance as percentage (e.g., '0.5' for 0.5%%), or '%s' to derive it from the pool depth when the swap session is created", utils.AutoSlippage)),
+		),
+		mcp.WithString("treasury_address",
+			mcp.Required(),
+			mcp.Description("Treasury address that signs the swaps and pays the ETH"),
+		),
+		mcp.WithString("schedule",
+			mcp.Required(),
+			mcp.Description("Cron-like schedule: @hourly, @daily, @weekly or @every <duration> (e.g. '@every 6h', minimum 1m)"),
+		),
+		mcp.WithString("start_at",
+			mcp.Description("RFC3339 time of the first run (e.g. 2025-01-01T09:00:00Z). Optional, defaults to now"),
+		),
+		mcp.WithString("max_runs",
+			mcp.Description("Number of runs after which the schedule completes. Optional, runs until cancelled by default"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (s *scheduleBuybackTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ScheduleBuybackArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := s.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Buybacks are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.TreasuryAddress) {
+			return mcp.NewToolResultError("Treasury address is not a valid Ethereum address"), nil
+		}
+
+		amount, ok := new(big.Int).SetString(args.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return mcp.NewToolResultError("Amount must be a positive integer in wei"), nil
+		}
+
+		if err := validateSlippageTolerance(args.SlippageTolerance); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid slippage tolerance: %v", err)), nil
+		}
+
+		interval, err := utils.ParseSchedule(args.Schedule)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid schedule: %v", err)), nil
+		}
+
+		startAt := time.Now()
+		if args.StartAt != "" {
+			startAt, err = time.Parse(time.RFC3339, args.StartAt)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid start_at format, expected RFC3339: %v", err)), nil
+			}
+		}
+
+		var maxRuns int
+		if args.MaxRuns != "" {
+			maxRuns, err = strconv.Atoi(args.MaxRuns)
+			if err != nil || maxRuns <= 0 {
+				return mcp.NewToolResultError("max_runs must be a positive integer"), nil
+			}
+		}
+
+		token, err := getConfirmedDeployment(s.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (token.UserID == nil || *token.UserID != userID) {
+			return mcp.NewToolResultError("Deployment not found"), nil
+		}
+		if token.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Deployment is on different chain (ID: %d) than active chain (ID: %d)", token.ChainID, activeChain.ID)), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		buyback := &models.Buyback{
+			UserID:            userId,
+			ChainID:           activeChain.ID,
+			DeploymentID:      token.ID,
+			TokenAddress:      token.ContractAddress,
+			TreasuryAddress:   args.TreasuryAddress,
+			AmountPerRun:      args.Amount,
+			SlippageTolerance: args.SlippageTolerance,
+			Schedule:          args.Schedule,
+			IntervalSeconds:   int64(interval.Seconds()),
+			NextRunAt:         startAt,
+			MaxRuns:           maxRuns,
+		}
+		if err := s.buybackService.CreateBuyback(buyback); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to schedule buyback: %v", err)), nil
+		}
+
+		buybackJSON, _ := json.Marshal(buyback)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Buyback %d scheduled, first run at %s: ", buyback.ID, startAt.Format(time.RFC3339))),
+				mcp.NewTextContent(string(buybackJSON)),
+			},
+		}, nil
+	}
+}
+
+// NewBuybackExecutor returns the executor used by the buyback scheduler to create the swap session of a run
+func NewBuybackExecutor(swapTool *swapTokensTool) services.BuybackExecutor {
+	return func(buyback models.Buyback) (string, error) {
+		swapSession, err := swapTool.CreateSwapSession(SwapTokensArguments{
+			FromToken:         services.EthTokenAddress,
+			ToToken:           buyback.TokenAddress,
+			Amount:            buyback.AmountPerRun,
+			SlippageTolerance: buyback.SlippageTolerance,
+			UserAddress:       buyback.TreasuryAddress,
+			Metadata: []models.TransactionMetadata{
+				{Key: "buyback_id", Value: strconv.FormatUint(uint64(buyback.ID), 10)},
+				{Key: "run", Value: strconv.Itoa(buyback.RunCount + 1)},
+			},
+			recipient:  utils.BurnAddress,
+			background: true,
+		}, &buyback.Chain, buyback.UserID)
+		if err != nil {
+			return "", err
+		}
+		return swapSession.SessionID, nil
+	}
+}
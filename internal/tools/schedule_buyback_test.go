@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleBuyback(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	buybackService := services.NewBuybackService(db.GetDB())
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, chainService.CreateChain(chain))
+
+	owner := "user-1"
+	token := &models.Deployment{TemplateID: 1, ChainID: chain.ID, ContractAddress: limitOrderToken, Status: models.TransactionStatusConfirmed, UserID: &owner}
+	require.NoError(t, deploymentService.CreateDeployment(token))
+	pending := &models.Deployment{TemplateID: 1, ChainID: chain.ID, Status: models.TransactionStatusPending, UserID: &owner}
+	require.NoError(t, deploymentService.CreateDeployment(pending))
+
+	handler := NewScheduleBuybackTool(chainService, deploymentService, buybackService).GetHandler()
+	call := func(ctx context.Context, args map[string]any) *mcp.CallToolResult {
+		arguments := map[string]any{
+			"deployment_id":      fmt.Sprint(token.ID),
+			"amount":             "100000000000000000",
+			"slippage_tolerance": "1",
+			"treasury_address":   limitOrderUser,
+			"schedule":           "@daily",
+		}
+		for key, value := range args {
+			arguments[key] = value
+		}
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		return result
+	}
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: owner})
+
+	t.Run("schedules the buyback of the token", func(t *testing.T) {
+		result := call(ctx, map[string]any{"start_at": "2030-01-01T09:00:00Z", "max_runs": "4"})
+		require.False(t, result.IsError)
+		require.Len(t, result.Content, 2)
+
+		var buyback models.Buyback
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &buyback))
+		assert.Equal(t, models.BuybackStatusActive, buyback.Status)
+		assert.Equal(t, limitOrderToken, buyback.TokenAddress)
+		assert.Equal(t, 4, buyback.MaxRuns)
+		assert.True(t, buyback.NextRunAt.Equal(time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)))
+	})
+
+	testCases := []struct {
+		name string
+		ctx  context.Context
+		args map[string]any
+	}{
+		{"invalid treasury address", ctx, map[string]any{"treasury_address": "0x123"}},
+		{"zero amount", ctx, map[string]any{"amount": "0"}},
+		{"invalid slippage", ctx, map[string]any{"slippage_tolerance": "abc"}},
+		{"invalid schedule", ctx, map[string]any{"schedule": "every day"}},
+		{"invalid max runs", ctx, map[string]any{"max_runs": "0"}},
+		{"unconfirmed deployment", ctx, map[string]any{"deployment_id": fmt.Sprint(pending.ID)}},
+		{"deployment of another user", utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"}), nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.True(t, call(tc.ctx, tc.args).IsError)
+		})
+	}
+}
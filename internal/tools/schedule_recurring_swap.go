@@ -0,0 +1,196 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type scheduleRecurringSwapTool struct {
+	chainService         services.ChainService
+	recurringSwapService services.RecurringSwapService
+}
+
+type ScheduleRecurringSwapArguments struct {
+	// Required fields
+	FromToken         string `json:"from_token" validate:"required"`
+	ToToken           string `json:"to_token" validate:"required"`
+	Amount            string `json:"amount" validate:"required"`
+	SlippageTolerance string `json:"slippage_tolerance" validate:"required"`
+	UserAddress       string `json:"user_address" validate:"required"`
+	Schedule          string `json:"schedule" validate:"required"`
+
+	// Optional fields
+	StartAt string `json:"start_at,omitempty"`
+	MaxRuns string `json:"max_runs,omitempty"`
+}
+
+func NewScheduleRecurringSwapTool(chainService services.ChainService, recurringSwapService services.RecurringSwapService) *scheduleRecurringSwapTool {
+	return &scheduleRecurringSwapTool{
+		chainService:         chainService,
+		recurringSwapService: recurringSwapService,
+	}
+}
+
+func (s *scheduleRecurringSwapTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("schedule_recurring_swap",
+		mcp.WithDescription("Schedule a recurring swap (dollar-cost averaging) on the active chain, e.g. buy 0.1 ETH of a token daily. A ready-to-sign swap session is created on every run and a notification is sent when it is ready. Use list_recurring_swaps to get the signing URLs."),
+		mcp.WithString("from_token",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Address of the token to swap from (use %s for ETH)", services.EthTokenAddress)),
+		),
+		mcp.WithString("to_token",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Address of the token to swap to (use %s for ETH)", services.EthTokenAddress)),
+		),
+		mcp.WithString("amount",
+			mcp.Required(),
+			mcp.Description("Amount of tokens to swap on every run (in wei for ETH, or smallest unit for tokens)"),
+		),
+		mcp.WithString("slippage_tolerance",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Maximum slippage tolerance as percentage (e.g., '0.5' for 0.5%%), or '%s' to derive it from the pool depth when the swap session is created", utils.AutoSlippage)),
+		),
+		mcp.WithString("user_address",
+			mcp.Required(),
+			mcp.Description("Address that will execute the swaps"),
+		),
+		mcp.WithString("schedule",
+			mcp.Required(),
+			mcp.Description("Cron-like schedule: @hourly, @daily, @weekly or @every <duration> (e.g. '@every 6h', minimum 1m)"),
+		),
+		mcp.WithString("start_at",
+			mcp.Description("RFC3339 time of the first run (e.g. 2025-01-01T09:00:00Z). Optional, defaults to now"),
+		),
+		mcp.WithString("max_runs",
+			mcp.Description("Number of runs after which the schedule completes. Optional, runs until cancelled by default"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (s *scheduleRecurringSwapTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args ScheduleRecurringSwapArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		activeChain, err := s.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Recurring swaps are only supported on Ethereum, got %s", activeChain.ChainType)), nil
+		}
+
+		if !utils.IsValidEthereumAddress(args.UserAddress) {
+			return mcp.NewToolResultError("User address is not a valid Ethereum address"), nil
+		}
+
+		if strings.EqualFold(args.FromToken, args.ToToken) {
+			return mcp.NewToolResultError("Cannot swap token to itself"), nil
+		}
+
+		amount, ok := new(big.Int).SetString(args.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return mcp.NewToolResultError("Amount must be a positive integer in the smallest unit of the token"), nil
+		}
+
+		if err := validateSlippageTolerance(args.SlippageTolerance); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid slippage tolerance: %v", err)), nil
+		}
+
+		interval, err := utils.ParseSchedule(args.Schedule)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid schedule: %v", err)), nil
+		}
+
+		startAt := time.Now()
+		if args.StartAt != "" {
+			startAt, err = time.Parse(time.RFC3339, args.StartAt)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid start_at format, expected RFC3339: %v", err)), nil
+			}
+		}
+
+		var maxRuns int
+		if args.MaxRuns != "" {
+			maxRuns, err = strconv.Atoi(args.MaxRuns)
+			if err != nil || maxRuns <= 0 {
+				return mcp.NewToolResultError("max_runs must be a positive integer"), nil
+			}
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		swap := &models.RecurringSwap{
+			UserID:            userId,
+			ChainID:           activeChain.ID,
+			FromToken:         args.FromToken,
+			ToToken:           args.ToToken,
+			Amount:            args.Amount,
+			SlippageTolerance: args.SlippageTolerance,
+			UserAddress:       args.UserAddress,
+			Schedule:          args.Schedule,
+			IntervalSeconds:   int64(interval.Seconds()),
+			NextRunAt:         startAt,
+			MaxRuns:           maxRuns,
+		}
+		if err := s.recurringSwapService.CreateRecurringSwap(swap); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to schedule recurring swap: %v", err)), nil
+		}
+
+		swapJSON, _ := json.Marshal(swap)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Recurring swap %d scheduled, first run at %s: ", swap.ID, startAt.Format(time.RFC3339))),
+				mcp.NewTextContent(string(swapJSON)),
+			},
+		}, nil
+	}
+}
+
+// NewRecurringSwapExecutor returns the executor used by the recurring swap scheduler to create the swap session of a run
+func NewRecurringSwapExecutor(swapTool *swapTokensTool) services.RecurringSwapExecutor {
+	return func(swap models.RecurringSwap) (string, error) {
+		swapSession, err := swapTool.CreateSwapSession(SwapTokensArguments{
+			FromToken:         swap.FromToken,
+			ToToken:           swap.ToToken,
+			Amount:            swap.Amount,
+			SlippageTolerance: swap.SlippageTolerance,
+			UserAddress:       swap.UserAddress,
+			Metadata: []models.TransactionMetadata{
+				{Key: "recurring_swap_id", Value: strconv.FormatUint(uint64(swap.ID), 10)},
+				{Key: "run", Value: strconv.Itoa(swap.RunCount + 1)},
+			},
+			background: true,
+		}, &swap.Chain, swap.UserID)
+		if err != nil {
+			return "", err
+		}
+		return swapSession.SessionID, nil
+	}
+}
@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleRecurringSwapToolTestSuite struct {
+	suite.Suite
+	db                   services.DBService
+	tool                 *scheduleRecurringSwapTool
+	chainService         services.ChainService
+	recurringSwapService services.RecurringSwapService
+}
+
+func (suite *ScheduleRecurringSwapToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.chainService = services.NewChainService(db.GetDB())
+	suite.recurringSwapService = services.NewRecurringSwapService(db.GetDB())
+	suite.tool = NewScheduleRecurringSwapTool(suite.chainService, suite.recurringSwapService)
+
+	chain := &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(suite.chainService.CreateChain(chain))
+}
+
+func (suite *ScheduleRecurringSwapToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *ScheduleRecurringSwapToolTestSuite) callHandler(arguments map[string]interface{}) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result)
+	return result
+}
+
+func (suite *ScheduleRecurringSwapToolTestSuite) validArguments() map[string]interface{} {
+	return map[string]interface{}{
+		"from_token":         services.EthTokenAddress,
+		"to_token":           limitOrderToken,
+		"amount":             "100000000000000000",
+		"slippage_tolerance": "1",
+		"user_address":       limitOrderUser,
+		"schedule":           "@daily",
+	}
+}
+
+func (suite *ScheduleRecurringSwapToolTestSuite) TestScheduleRecurringSwap() {
+	args := suite.validArguments()
+	args["start_at"] = "2030-01-01T09:00:00Z"
+	args["max_runs"] = "7"
+	result := suite.callHandler(args)
+	suite.Require().False(result.IsError)
+	suite.Require().Len(result.Content, 2)
+
+	textContent, ok := result.Content[1].(mcp.TextContent)
+	suite.Require().True(ok)
+
+	var swap models.RecurringSwap
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &swap))
+	suite.Equal(models.RecurringSwapStatusActive, swap.Status)
+	suite.Equal(int64((24 * time.Hour).Seconds()), swap.IntervalSeconds)
+	suite.Equal(7, swap.MaxRuns)
+
+	stored, err := suite.recurringSwapService.GetRecurringSwap(swap.ID)
+	suite.Require().NoError(err)
+	suite.True(stored.NextRunAt.Equal(time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)))
+}
+
+func (suite *ScheduleRecurringSwapToolTestSuite) TestInvalidArguments() {
+	testCases := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"invalid user address", "user_address", "0x123"},
+		{"same token", "to_token", services.EthTokenAddress},
+		{"zero amount", "amount", "0"},
+		{"invalid slippage", "slippage_tolerance", "abc"},
+		{"invalid schedule", "schedule", "every day"},
+		{"interval too short", "schedule", "@every 10s"},
+		{"invalid start", "start_at", "tomorrow"},
+		{"invalid max runs", "max_runs", "0"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			args := suite.validArguments()
+			args[tc.key] = tc.value
+			result := suite.callHandler(args)
+			suite.True(result.IsError)
+		})
+	}
+}
+
+func TestScheduleRecurringSwapToolTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleRecurringSwapToolTestSuite))
+}
@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+type searchTool struct {
+	searchService services.SearchService
+	serverPort    int
+}
+
+type SearchArguments struct {
+	Query string   `json:"query" validate:"required"`
+	Types []string `json:"types,omitempty" validate:"omitempty,dive,oneof=template deployment liquidity_pool session"`
+	Limit string   `json:"limit,omitempty"`
+}
+
+// SearchResult is a record matched by the search tool, its ID is passed to the follow-up tools of its type
+type SearchResult struct {
+	Type    models.SearchEntityType  `json:"type"`
+	ID      string                   `json:"id"`
+	Title   string                   `json:"title"`
+	Summary string                   `json:"summary"`
+	Status  models.TransactionStatus `json:"status,omitempty"`
+	// Address is the contract address of deployments and the token address of pools
+	Address string `json:"address,omitempty"`
+	// URL is the signing page of the sessions still waiting to be signed
+	URL string `json:"url,omitempty"`
+}
+
+func NewSearchTool(searchService services.SearchService, serverPort int) *searchTool {
+	return &searchTool{
+		searchService: searchService,
+		serverPort:    serverPort,
+	}
+}
+
+func (s *searchTool) GetTool() mcp.Tool {
+	entityTypes := make([]string, len(models.SearchEntityTypes))
+	for i, entityType := range models.SearchEntityTypes {
+		entityTypes[i] = string(entityType)
+	}
+
+	tool := mcp.NewTool("search",
+		mcp.WithDescription("Full-text search across the templates (name, description), deployments (token name and symbol, contract and deployer addresses), "+
+			"liquidity pools (token and pair addresses) and transaction sessions (transaction titles). "+
+			"Every word of the query must match the beginning of a word, best matches first. Returns typed results with the IDs for view_template, call_function, get_pool_info and the other follow-up tools."),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Words to search for, such as a token symbol or the beginning of a contract address"),
+		),
+		mcp.WithArray("types",
+			mcp.Description("Only return results of these types. Optional, defaults to every type"),
+			mcp.WithStringItems(mcp.Enum(entityTypes...)),
+		),
+		mcp.WithString("limit",
+			mcp.Description(fmt.Sprintf("Maximum number of results. Optional, defaults to %d, at most %d", defaultSearchLimit, maxSearchLimit)),
+		),
+	)
+
+	return tool
+}
+
+func (s *searchTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SearchArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		limit := defaultSearchLimit
+		if args.Limit != "" {
+			parsed, err := strconv.Atoi(args.Limit)
+			if err != nil || parsed < 1 || parsed > maxSearchLimit {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid limit %q: must be a number between 1 and %d", args.Limit, maxSearchLimit)), nil
+			}
+			limit = parsed
+		}
+
+		types := make([]models.SearchEntityType, len(args.Types))
+		for i, entityType := range args.Types {
+			types[i] = models.SearchEntityType(entityType)
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		documents, err := s.searchService.Search(userId, args.Query, types, limit)
+		if errors.Is(err, services.ErrEmptySearchQuery) {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid query: %v", err)), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to search: %v", err)), nil
+		}
+
+		results := []SearchResult{}
+		for _, document := range documents {
+			result := SearchResult{
+				Type:    document.EntityType,
+				ID:      document.EntityID,
+				Title:   document.Title,
+				Summary: document.Summary,
+				Status:  document.Status,
+				Address: document.Address,
+			}
+			// Only the pending sessions still need to be signed
+			if document.EntityType == models.SearchEntityTypeSession && document.Status == models.TransactionStatusPending {
+				result.URL, _ = utils.GetTransactionSessionUrl(ctx, s.serverPort, document.EntityID)
+			}
+			results = append(results, result)
+		}
+
+		resultJSON, _ := json.Marshal(results)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Found %d result(s) for %q, pass the IDs to view_template (template_id), call_function (deployment_id) and the other tools of their type, and the pool addresses to get_pool_info: ", len(results), args.Query)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,226 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchToolTestSuite struct {
+	suite.Suite
+	db            services.DBService
+	searchService services.SearchService
+	chain         *models.Chain
+	template      *models.Template
+	deployment    *models.Deployment
+	pool          *models.LiquidityPool
+	session       *models.TransactionSession
+}
+
+func (suite *SearchToolTestSuite) SetupTest() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.searchService = services.NewSearchService(db.GetDB())
+
+	suite.chain = &models.Chain{
+		Name:      "Local",
+		RPC:       "http://localhost:8545",
+		NetworkID: "31337",
+		ChainType: models.TransactionChainTypeEthereum,
+		IsActive:  true,
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.chain).Error)
+
+	suite.template = &models.Template{
+		Name:         "Capped Token",
+		Description:  "ERC20 with a supply cap",
+		ChainType:    models.TransactionChainTypeEthereum,
+		TemplateCode: "contract CappedToken {}",
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.template).Error)
+
+	suite.session = &models.TransactionSession{
+		ID:                   "3f0c7a52-8d4e-4d61-9a57-6a1e2b9c0d11",
+		TransactionStatus:    models.TransactionStatusPending,
+		TransactionChainType: models.TransactionChainTypeEthereum,
+		ChainID:              suite.chain.ID,
+		TransactionDeployments: []models.TransactionDeployment{
+			{Title: "Deploy Rocket Coin", Description: "Deploy the RKT token", TransactionType: models.TransactionTypeRegular},
+		},
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.session).Error)
+
+	suite.deployment = &models.Deployment{
+		TemplateID:      suite.template.ID,
+		ChainID:         suite.chain.ID,
+		ContractAddress: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		TemplateValues:  models.JSON{"TokenName": "Rocket Coin", "TokenSymbol": "RKT", "InitialSupply": float64(1000)},
+		Status:          models.TransactionStatusConfirmed,
+		SessionId:       suite.session.ID,
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.deployment).Error)
+
+	suite.pool = &models.LiquidityPool{
+		TokenAddress:    suite.deployment.ContractAddress,
+		PairAddress:     "0xCafac3dD18aC6c6e92c921884f9E4176737C052c",
+		UniswapVersion:  "v2",
+		Token0:          suite.deployment.ContractAddress,
+		Token1:          "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512",
+		CreatorAddress:  "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		TransactionHash: "0xabc",
+		Status:          models.TransactionStatusConfirmed,
+	}
+	suite.Require().NoError(db.GetDB().Create(suite.pool).Error)
+}
+
+func (suite *SearchToolTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *SearchToolTestSuite) search(ctx context.Context, arguments map[string]interface{}) []SearchResult {
+	result, err := NewSearchTool(suite.searchService, 8080).GetHandler()(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+	suite.Require().NoError(err)
+	suite.Require().False(result.IsError, "%v", result.Content)
+
+	var results []SearchResult
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &results))
+	return results
+}
+
+func (suite *SearchToolTestSuite) TestSearchEntities() {
+	tests := []struct {
+		name     string
+		query    string
+		expected []SearchResult
+	}{
+		{
+			name:     "template_name",
+			query:    "capped",
+			expected: []SearchResult{{Type: models.SearchEntityTypeTemplate, ID: fmt.Sprint(suite.template.ID), Title: "Capped Token"}},
+		},
+		{
+			name:  "token_symbol",
+			query: "rkt",
+			expected: []SearchResult{
+				{Type: models.SearchEntityTypeDeployment, ID: fmt.Sprint(suite.deployment.ID), Title: "Rocket Coin (RKT)"},
+				{Type: models.SearchEntityTypeLiquidityPool, ID: fmt.Sprint(suite.pool.ID), Title: "Rocket Coin (RKT) liquidity pool"},
+				{Type: models.SearchEntityTypeSession, ID: suite.session.ID, Title: "Deploy Rocket Coin"},
+			},
+		},
+		{
+			name:     "pair_address_prefix",
+			query:    "0xcafac3",
+			expected: []SearchResult{{Type: models.SearchEntityTypeLiquidityPool, ID: fmt.Sprint(suite.pool.ID), Title: "Rocket Coin (RKT) liquidity pool"}},
+		},
+		{
+			name:     "every_term_matches",
+			query:    "rocket deploy",
+			expected: []SearchResult{{Type: models.SearchEntityTypeSession, ID: suite.session.ID, Title: "Deploy Rocket Coin"}},
+		},
+		{
+			name:  "no_match",
+			query: "nothing",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			results := suite.search(context.Background(), map[string]interface{}{"query": tt.query})
+			suite.Require().Len(results, len(tt.expected))
+			for _, expected := range tt.expected {
+				found := false
+				for _, result := range results {
+					if result.Type == expected.Type && result.ID == expected.ID {
+						suite.Equal(expected.Title, result.Title)
+						found = true
+					}
+				}
+				suite.True(found, "missing %s %s", expected.Type, expected.ID)
+			}
+		})
+	}
+}
+
+func (suite *SearchToolTestSuite) TestSearchResultDetails() {
+	results := suite.search(context.Background(), map[string]interface{}{
+		"query": "rocket",
+		"types": []interface{}{"session", "liquidity_pool"},
+	})
+	suite.Require().Len(results, 2)
+	for _, result := range results {
+		switch result.Type {
+		case models.SearchEntityTypeSession:
+			suite.Contains(result.URL, suite.session.ID)
+			suite.Equal(models.TransactionStatusPending, result.Status)
+		case models.SearchEntityTypeLiquidityPool:
+			suite.Equal(suite.deployment.ContractAddress, result.Address)
+			suite.Empty(result.URL)
+		default:
+			suite.Fail("unexpected result type", result.Type)
+		}
+	}
+}
+
+func (suite *SearchToolTestSuite) TestSearchFollowsChanges() {
+	suite.Len(suite.search(context.Background(), map[string]interface{}{"query": "capped"}), 1)
+
+	// Updated entities are reindexed
+	suite.template.Name = "Mintable Token"
+	suite.Require().NoError(suite.db.GetDB().Save(suite.template).Error)
+	suite.Empty(suite.search(context.Background(), map[string]interface{}{"query": "capped token"}))
+	suite.Len(suite.search(context.Background(), map[string]interface{}{"query": "mintable"}), 1)
+
+	// Deleted entities are removed from the index
+	suite.Require().NoError(suite.db.GetDB().Delete(suite.template).Error)
+	suite.Empty(suite.search(context.Background(), map[string]interface{}{"query": "mintable"}))
+}
+
+func (suite *SearchToolTestSuite) TestSearchIsUserScoped() {
+	userID := "user-1"
+	template := &models.Template{Name: "Private Capped Token", UserId: &userID, ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract PrivateToken {}"}
+	suite.Require().NoError(suite.db.GetDB().Create(template).Error)
+
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: userID})
+	results := suite.search(ctx, map[string]interface{}{"query": "capped"})
+	suite.Require().Len(results, 1)
+	suite.Equal(fmt.Sprint(template.ID), results[0].ID)
+
+	// Without authentication every record is searched
+	suite.Len(suite.search(context.Background(), map[string]interface{}{"query": "capped"}), 2)
+}
+
+func (suite *SearchToolTestSuite) TestSearchErrors() {
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		errorMsg  string
+	}{
+		{name: "missing_query", arguments: map[string]interface{}{}, errorMsg: "Invalid arguments"},
+		{name: "query_without_words", arguments: map[string]interface{}{"query": "*?!"}, errorMsg: "Invalid query"},
+		{name: "unknown_type", arguments: map[string]interface{}{"query": "rkt", "types": []interface{}{"chain"}}, errorMsg: "Invalid arguments"},
+		{name: "limit_too_large", arguments: map[string]interface{}{"query": "rkt", "limit": "500"}, errorMsg: "Invalid limit"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			result, err := NewSearchTool(suite.searchService, 8080).GetHandler()(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tt.arguments}})
+			suite.Require().NoError(err)
+			suite.True(result.IsError)
+			suite.Contains(result.Content[0].(mcp.TextContent).Text, tt.errorMsg)
+		})
+	}
+}
+
+func TestSearchToolTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchToolTestSuite))
+}
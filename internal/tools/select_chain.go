@@ -8,12 +8,14 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
 func NewSelectChainTool(chainService services.ChainService) (mcp.Tool, server.ToolHandlerFunc) {
 	tool := mcp.NewTool("select_chain",
-		mcp.WithDescription("Select blockchain for token operations. Can select by uuid (recommended). Sets the selection as active in database."),
+		mcp.WithDescription("Select blockchain for token operations. Can select by uuid (recommended). Authenticated users select their own default chain, without authentication the selection is set as active in database. Any tool also accepts a chain_id argument selecting the chain of a single call."),
 		mcp.WithString("chain_type",
 			mcp.Description("The blockchain type to select (ethereum or solana). Legacy parameter."),
 		),
@@ -28,27 +30,51 @@ func NewSelectChainTool(chainService services.ChainService) (mcp.Tool, server.To
 
 		// Validate that at least one parameter is provided
 		if chainType == "" && chainIDStr == "" {
-			return mcp.NewToolResultError("Either chain_type or chain_id parameter is required"), nil
+			return mcp.NewToolResultError("Either chain_type or uuid parameter is required"), nil
 		}
-		uuid, err := strconv.ParseUint(chainIDStr, 10, 32)
-		// Set the active chain by uuid
-		if err := chainService.SetActiveChainByID(uint(uuid)); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Error setting active chain: %v", err)), nil
+
+		var chain *models.Chain
+		if chainIDStr != "" {
+			uuid, err := strconv.ParseUint(chainIDStr, 10, 32)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid uuid: %v", err)), nil
+			}
+			chain, err = chainService.GetChainByID(uint(uuid))
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error getting chain %s: %v", chainIDStr, err)), nil
+			}
+		} else {
+			var err error
+			chain, err = chainService.GetChainByType(chainType)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error getting %s chain: %v", chainType, err)), nil
+			}
 		}
-		// Get the active chain to return current state
-		activeChain, err := chainService.GetActiveChain()
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("Error getting active chain: %v", err)), nil
+
+		// Authenticated users get their own default so they do not switch the chain of the other clients,
+		// the global active chain stays the default of the unauthenticated calls
+		scope := "global"
+		if userID := utils.GetUserID(ctx); userID != "" {
+			if err := chainService.SetUserChain(userID, chain.ID); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error setting default chain: %v", err)), nil
+			}
+			scope = "user"
+		} else {
+			if err := chainService.SetActiveChainByID(chain.ID); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Error setting active chain: %v", err)), nil
+			}
+			chain.IsActive = true
 		}
 
 		result := map[string]interface{}{
-			"id":         activeChain.ID,
-			"chain_type": activeChain.ChainType,
-			"name":       activeChain.Name,
-			"rpc":        activeChain.RPC,
-			"chain_id":   activeChain.NetworkID,
-			"is_active":  activeChain.IsActive,
-			"message":    fmt.Sprintf("Successfully selected %s blockchain (ID: %d)", activeChain.Name, activeChain.ID),
+			"id":         chain.ID,
+			"chain_type": chain.ChainType,
+			"name":       chain.Name,
+			"rpc":        chain.RPC,
+			"chain_id":   chain.NetworkID,
+			"is_active":  chain.IsActive,
+			"scope":      scope,
+			"message":    fmt.Sprintf("Successfully selected %s blockchain (ID: %d)", chain.Name, chain.ID),
 		}
 
 		resultJSON, _ := json.Marshal(result)
@@ -90,6 +90,15 @@ func NewSetChainTool(chainService services.ChainService) (mcp.Tool, server.ToolH
 		mcp.WithString("name",
 			mcp.Description("Optional name for the chain configuration (e.g., 'Ethereum Mainnet', 'Solana Devnet')"),
 		),
+		mcp.WithString("private_relay_rpc",
+			mcp.Description("Optional private transaction relay RPC used by swaps and liquidity changes with mev_protection (e.g., 'https://rpc.flashbots.net' for Flashbots Protect, 'https://rpc.mevblocker.io' for MEV Blocker). Pass an empty string to remove it"),
+		),
+		mcp.WithString("bundler_rpc",
+			mcp.Description("Optional ERC-4337 bundler RPC for the EntryPoint v0.6 (e.g., a Pimlico, Alchemy or Stackup URL). Launches and swaps with gasless are sent through it as UserOperations of the smart account of the wallet. Pass an empty string to remove it"),
+		),
+		mcp.WithString("paymaster_rpc",
+			mcp.Description("Optional ERC-4337 paymaster RPC answering pm_sponsorUserOperation, sponsors the gas of the gasless transactions. Without it the smart account pays its own gas. Requires bundler_rpc"),
+		),
 	)
 
 	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -159,6 +168,37 @@ func NewSetChainTool(chainService services.ChainService) (mcp.Tool, server.ToolH
 			return mcp.NewToolResultError("Invalid chain_type. Supported values: ethereum, solana"), nil
 		}
 
+		// Only change the private relay when the argument is given, so updating the RPC keeps it
+		relayRPC, updateRelay := request.GetArguments()["private_relay_rpc"].(string)
+		relayRPC = strings.TrimSpace(relayRPC)
+		if updateRelay && relayRPC != "" {
+			if chainType != "ethereum" {
+				return mcp.NewToolResultError("private_relay_rpc is only supported for ethereum chains"), nil
+			}
+			if !strings.HasPrefix(relayRPC, "https://") && !strings.HasPrefix(relayRPC, "http://") {
+				return mcp.NewToolResultError("private_relay_rpc must be an http(s) URL"), nil
+			}
+		}
+
+		// Like the private relay, the bundler and paymaster only change when bundler_rpc is given
+		bundlerRPC, updateBundler := request.GetArguments()["bundler_rpc"].(string)
+		bundlerRPC = strings.TrimSpace(bundlerRPC)
+		paymasterRPC := strings.TrimSpace(request.GetString("paymaster_rpc", ""))
+		if paymasterRPC != "" && bundlerRPC == "" {
+			return mcp.NewToolResultError("paymaster_rpc requires bundler_rpc"), nil
+		}
+		for name, url := range map[string]string{"bundler_rpc": bundlerRPC, "paymaster_rpc": paymasterRPC} {
+			if url == "" {
+				continue
+			}
+			if chainType != "ethereum" {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is only supported for ethereum chains", name)), nil
+			}
+			if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+				return mcp.NewToolResultError(fmt.Sprintf("%s must be an http(s) URL", name)), nil
+			}
+		}
+
 		// Check if chain configuration already exists
 		chains, err := chainService.ListChains()
 		if err != nil {
@@ -178,14 +218,27 @@ func NewSetChainTool(chainService services.ChainService) (mcp.Tool, server.ToolH
 			if err := chainService.UpdateChainConfig(chainType, rpc, chainID); err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Error updating chain configuration: %v", err)), nil
 			}
+			if updateRelay {
+				if err := chainService.UpdatePrivateRelayRPC(chainType, relayRPC); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error updating private relay: %v", err)), nil
+				}
+			}
+			if updateBundler {
+				if err := chainService.UpdateAccountAbstraction(chainType, bundlerRPC, paymasterRPC); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error updating bundler: %v", err)), nil
+				}
+			}
 		} else {
 			// Create new chain configuration
 			newChain := &models.Chain{
-				ChainType: models.TransactionChainType(chainType),
-				RPC:       rpc,
-				NetworkID: chainID,
-				Name:      name,
-				IsActive:  false,
+				ChainType:       models.TransactionChainType(chainType),
+				RPC:             rpc,
+				NetworkID:       chainID,
+				Name:            name,
+				IsActive:        false,
+				PrivateRelayRPC: relayRPC,
+				BundlerRPC:      bundlerRPC,
+				PaymasterRPC:    paymasterRPC,
 			}
 			if err := chainService.CreateChain(newChain); err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Error creating chain configuration: %v", err)), nil
@@ -204,6 +257,14 @@ func NewSetChainTool(chainService services.ChainService) (mcp.Tool, server.ToolH
 			"name":       name,
 			"message":    message,
 		}
+		if updateRelay {
+			result["private_relay_rpc"] = relayRPC
+		}
+		if updateBundler {
+			// the urls usually carry an API key, only report whether gasless transactions are enabled
+			result["gasless"] = bundlerRPC != ""
+			result["sponsored"] = paymasterRPC != ""
+		}
 
 		resultJSON, _ := json.Marshal(result)
 		return &mcp.CallToolResult{
@@ -324,6 +324,51 @@ func TestSetChainUpdateExisting(t *testing.T) {
 	// Note: Name is not updated by UpdateChainConfig, only RPC and ChainID
 }
 
+func TestSetChainAccountAbstraction(t *testing.T) {
+	db := setupTestChainService(t)
+	_, handler := NewSetChainTool(db)
+	ctx := context.Background()
+
+	call := func(arguments map[string]interface{}) *mcp.CallToolResult {
+		arguments["chain_type"] = "ethereum"
+		arguments["rpc"] = "https://rpc.com"
+		arguments["chain_id"] = "11155111"
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: arguments}})
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call(map[string]interface{}{"paymaster_rpc": "https://paymaster.com"})
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "paymaster_rpc requires bundler_rpc")
+
+	result = call(map[string]interface{}{"bundler_rpc": "wss://bundler.com"})
+	assert.True(t, result.IsError)
+
+	result = call(map[string]interface{}{"bundler_rpc": "https://bundler.com/?apikey=secret", "paymaster_rpc": "https://paymaster.com/?apikey=secret"})
+	assert.False(t, result.IsError)
+	// the urls carry the API key of the bundler and are not echoed back
+	assert.NotContains(t, result.Content[1].(mcp.TextContent).Text, "secret")
+	assert.Contains(t, result.Content[1].(mcp.TextContent).Text, `"sponsored":true`)
+
+	chain, err := db.GetChainByType("ethereum")
+	require.NoError(t, err)
+	assert.Equal(t, "https://bundler.com/?apikey=secret", chain.BundlerRPC)
+	assert.Equal(t, "https://paymaster.com/?apikey=secret", chain.PaymasterRPC)
+
+	// updating the RPC keeps the bundler, an empty bundler_rpc removes it with its paymaster
+	call(map[string]interface{}{})
+	chain, err = db.GetChainByType("ethereum")
+	require.NoError(t, err)
+	assert.Equal(t, "https://bundler.com/?apikey=secret", chain.BundlerRPC)
+
+	call(map[string]interface{}{"bundler_rpc": ""})
+	chain, err = db.GetChainByType("ethereum")
+	require.NoError(t, err)
+	assert.Empty(t, chain.BundlerRPC)
+	assert.Empty(t, chain.PaymasterRPC)
+}
+
 func TestDefaultChainNames(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type setReferrerTool struct {
+	platformFeeService services.PlatformFeeService
+}
+
+type SetReferrerArguments struct {
+	// Required fields
+	ReferrerAddress string `json:"referrer_address" validate:"required,eth_addr"`
+}
+
+func NewSetReferrerTool(platformFeeService services.PlatformFeeService) *setReferrerTool {
+	return &setReferrerTool{
+		platformFeeService: platformFeeService,
+	}
+}
+
+func (s *setReferrerTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("set_referrer",
+		mcp.WithDescription("Record the address that referred the authenticated user to the launchpad. When the operator configured a platform fee with a referral share, "+
+			"every later launch and swap of the user pays that share of the fee to the referrer in a transfer of its session. The referrer is set once and cannot be changed."),
+		mcp.WithString("referrer_address",
+			mcp.Required(),
+			mcp.Description("EVM address of the referrer receiving the referral share of the fees"),
+		),
+	)
+
+	return tool
+}
+
+func (s *setReferrerTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SetReferrerArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		if user == nil {
+			return mcp.NewToolResultError("set_referrer requires an authenticated user, referrals are tracked per user"), nil
+		}
+
+		config, err := services.PlatformFeeConfigFromEnv()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read the platform fee: %v", err)), nil
+		}
+		if config != nil && strings.EqualFold(config.Recipient, args.ReferrerAddress) {
+			return mcp.NewToolResultError("The platform fee recipient cannot be a referrer"), nil
+		}
+
+		referral, err := s.platformFeeService.SetReferrer(user.Sub, common.HexToAddress(args.ReferrerAddress).Hex())
+		if errors.Is(err, services.ErrReferrerAlreadySet) {
+			return mcp.NewToolResultError(fmt.Sprintf("You were already referred by %s, the referrer cannot be changed", referral.ReferrerAddress)), nil
+		}
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to set the referrer: %v", err)), nil
+		}
+
+		share := "no referral share is configured on this server, the referrer receives nothing for now"
+		if config != nil && config.ReferralShareBps > 0 {
+			share = fmt.Sprintf("the referrer receives %d bps of the platform fee of your launches and swaps", config.ReferralShareBps)
+		}
+		resultJSON, _ := json.Marshal(referral)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Referrer %s recorded, %s: ", referral.ReferrerAddress, share)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,194 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"gorm.io/gorm"
+)
+
+// maxTokenLinks is the number of links of a token, the token list consumers show a few
+const maxTokenLinks = 10
+
+type setTokenMetadataTool struct {
+	deploymentService    services.DeploymentService
+	tokenMetadataService services.TokenMetadataService
+	assetStorage         services.AssetStorage
+	serverPort           int
+}
+
+type SetTokenMetadataArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	Description string            `json:"description,omitempty" validate:"max=1000"`
+	Links       map[string]string `json:"links,omitempty"`
+	LogoBase64  string            `json:"logo_base64,omitempty"`
+}
+
+type SetTokenMetadataResult struct {
+	*services.TokenInfo
+	TokenInfoURL string `json:"token_info_url"`
+	TokenListURL string `json:"token_list_url"`
+}
+
+func NewSetTokenMetadataTool(deploymentService services.DeploymentService, tokenMetadataService services.TokenMetadataService, assetStorage services.AssetStorage, serverPort int) *setTokenMetadataTool {
+	return &setTokenMetadataTool{
+		deploymentService:    deploymentService,
+		tokenMetadataService: tokenMetadataService,
+		assetStorage:         assetStorage,
+		serverPort:           serverPort,
+	}
+}
+
+func (s *setTokenMetadataTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("set_token_metadata",
+		mcp.WithDescription("Set the description, links and logo of a confirmed token deployment. The metadata is served at a public token info URL and the token is added to the token list of the server in the Uniswap token lists format. The description and links replace the previous ones, the logo is kept when no new logo is given."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Description of the token, up to 1000 characters. Optional"),
+		),
+		mcp.WithObject("links",
+			mcp.Description("JSON object mapping the kind of a link to its http or https URL (e.g., {\"website\": \"https://example.com\", \"twitter\": \"https://x.com/example\"}). Optional"),
+		),
+		mcp.WithString("logo_base64",
+			mcp.Description(fmt.Sprintf("Base64 encoded PNG, JPEG, GIF or WebP logo image, up to %d bytes. SVG is not accepted. Optional", services.MaxTokenLogoSize)),
+		),
+	)
+
+	return tool
+}
+
+func (s *setTokenMetadataTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SetTokenMetadataArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		links, err := validateTokenLinks(args.Links)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		deployment, err := getConfirmedDeployment(s.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		// Authenticated users can only describe their own tokens
+		if userID := utils.GetUserID(ctx); userID != "" && (deployment.UserID == nil || *deployment.UserID != userID) {
+			return mcp.NewToolResultError("Deployment not found"), nil
+		}
+
+		existing, err := s.tokenMetadataService.GetTokenMetadata(deployment.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get token metadata: %v", err)), nil
+		}
+		metadata := &models.TokenMetadata{
+			DeploymentID: deployment.ID,
+			Description:  strings.TrimSpace(args.Description),
+			Links:        links,
+		}
+		if existing != nil {
+			metadata.LogoKey = existing.LogoKey
+			metadata.LogoContentType = existing.LogoContentType
+			metadata.LogoURL = existing.LogoURL
+		}
+
+		if args.LogoBase64 != "" {
+			if s.assetStorage == nil {
+				return mcp.NewToolResultError("Logo uploads are not configured on this server"), nil
+			}
+			data, err := base64.StdEncoding.DecodeString(args.LogoBase64)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid logo_base64: %v", err)), nil
+			}
+			logo, err := services.NewTokenLogo(data)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid logo: %v", err)), nil
+			}
+			key := logo.Key(deployment.ID)
+			logoURL, err := s.assetStorage.Put(ctx, key, logo.ContentType, logo.Data)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to store the logo: %v", err)), nil
+			}
+			metadata.LogoKey = key
+			metadata.LogoContentType = logo.ContentType
+			metadata.LogoURL = logoURL
+		}
+
+		if err := s.tokenMetadataService.SaveTokenMetadata(metadata); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to save token metadata: %v", err)), nil
+		}
+		saved, err := s.tokenMetadataService.GetTokenMetadata(deployment.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get token metadata: %v", err)), nil
+		}
+
+		logoURL, err := services.TokenLogoURL(ctx, s.serverPort, saved)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get logo url: %v", err)), nil
+		}
+		tokenInfoURL, err := utils.GetTokenInfoUrl(ctx, s.serverPort, deployment.ID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get token info url: %v", err)), nil
+		}
+		tokenListURL, err := utils.GetTokenListUrl(ctx, s.serverPort)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get token list url: %v", err)), nil
+		}
+
+		result := SetTokenMetadataResult{
+			TokenInfo:    services.BuildTokenInfo(deployment, saved, logoURL),
+			TokenInfoURL: tokenInfoURL,
+			TokenListURL: tokenListURL,
+		}
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Token metadata of deployment %s saved. Token info: %s, token list: %s", args.DeploymentID, tokenInfoURL, tokenListURL)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// validateTokenLinks trims the links and checks they are absolute http or https urls
+func validateTokenLinks(links map[string]string) (map[string]string, error) {
+	if len(links) > maxTokenLinks {
+		return nil, fmt.Errorf("At most %d links are allowed, got %d", maxTokenLinks, len(links))
+	}
+	validated := map[string]string{}
+	for kind, link := range links {
+		kind = strings.TrimSpace(kind)
+		link = strings.TrimSpace(link)
+		if kind == "" || len(kind) > 32 {
+			return nil, fmt.Errorf("Invalid link kind %q: must be 1 to 32 characters", kind)
+		}
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("Invalid %s link %q: must be an http or https URL", kind, link)
+		}
+		validated[kind] = link
+	}
+	return validated, nil
+}
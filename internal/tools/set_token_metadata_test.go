@@ -0,0 +1,150 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+// testPNGLogo starts with the PNG signature, enough for the detected content type
+var testPNGLogo = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 32)...)
+
+type SetTokenMetadataToolTestSuite struct {
+	suite.Suite
+	db                   services.DBService
+	tool                 *setTokenMetadataTool
+	assetDir             string
+	chain                *models.Chain
+	template             *models.Template
+	deploymentService    services.DeploymentService
+	tokenMetadataService services.TokenMetadataService
+}
+
+func (suite *SetTokenMetadataToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	suite.assetDir = suite.T().TempDir()
+	suite.deploymentService = services.NewDeploymentService(db.GetDB())
+	suite.tokenMetadataService = services.NewTokenMetadataService(db.GetDB())
+	suite.tool = NewSetTokenMetadataTool(suite.deploymentService, suite.tokenMetadataService, services.NewLocalAssetStorage(suite.assetDir), 8080)
+
+	chain := &models.Chain{Name: "Test Ethereum", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	suite.Require().NoError(services.NewChainService(db.GetDB()).CreateChain(chain))
+	suite.chain = chain
+
+	template := &models.Template{Name: "Token", ChainType: models.TransactionChainTypeEthereum, TemplateCode: "contract Token {}"}
+	suite.Require().NoError(services.NewTemplateService(db.GetDB()).CreateTemplate(template))
+	suite.template = template
+}
+
+func (suite *SetTokenMetadataToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *SetTokenMetadataToolTestSuite) createDeployment(userID *string) *models.Deployment {
+	deployment := &models.Deployment{
+		UserID:          userID,
+		ChainID:         suite.chain.ID,
+		TemplateID:      suite.template.ID,
+		ContractAddress: "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		TemplateValues:  models.JSON{"TokenName": "My Token", "TokenSymbol": "MTK"},
+		Status:          models.TransactionStatusConfirmed,
+	}
+	suite.Require().NoError(suite.deploymentService.CreateDeployment(deployment))
+	return deployment
+}
+
+func (suite *SetTokenMetadataToolTestSuite) call(ctx context.Context, args map[string]any) *mcp.CallToolResult {
+	request := mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "set_token_metadata", Arguments: args}}
+	result, err := suite.tool.GetHandler()(ctx, request)
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *SetTokenMetadataToolTestSuite) TestSetMetadataWithLogo() {
+	deployment := suite.createDeployment(nil)
+	result := suite.call(context.Background(), map[string]any{
+		"deployment_id": fmt.Sprint(deployment.ID),
+		"description":   "A test token",
+		"links":         map[string]any{"website": "https://example.com"},
+		"logo_base64":   base64.StdEncoding.EncodeToString(testPNGLogo),
+	})
+	suite.Require().False(result.IsError, result.Content)
+
+	var parsed SetTokenMetadataResult
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &parsed))
+	suite.Equal("My Token", parsed.Name)
+	suite.Equal("MTK", parsed.Symbol)
+	suite.Equal("A test token", parsed.Description)
+	suite.Equal(map[string]string{"website": "https://example.com"}, parsed.Links)
+	suite.Equal(fmt.Sprintf("http://localhost:8080/tokens/%d/logo", deployment.ID), parsed.LogoURI)
+	suite.Equal(fmt.Sprintf("http://localhost:8080/tokens/%d", deployment.ID), parsed.TokenInfoURL)
+	suite.Equal("http://localhost:8080/tokens/tokenlist.json", parsed.TokenListURL)
+
+	metadata, err := suite.tokenMetadataService.GetTokenMetadata(deployment.ID)
+	suite.Require().NoError(err)
+	suite.Equal("image/png", metadata.LogoContentType)
+	stored, err := os.ReadFile(filepath.Join(suite.assetDir, filepath.FromSlash(metadata.LogoKey)))
+	suite.Require().NoError(err)
+	suite.Equal(testPNGLogo, stored)
+
+	// Updating the description keeps the logo
+	result = suite.call(context.Background(), map[string]any{
+		"deployment_id": fmt.Sprint(deployment.ID),
+		"description":   "Updated",
+	})
+	suite.Require().False(result.IsError, result.Content)
+	updated, err := suite.tokenMetadataService.GetTokenMetadata(deployment.ID)
+	suite.Require().NoError(err)
+	suite.Equal("Updated", updated.Description)
+	suite.Equal(metadata.LogoKey, updated.LogoKey)
+	suite.Empty(updated.Links)
+}
+
+func (suite *SetTokenMetadataToolTestSuite) TestInvalidArguments() {
+	deployment := suite.createDeployment(nil)
+	tests := []struct {
+		name string
+		args map[string]any
+		want string
+	}{
+		{"svg logo", map[string]any{"logo_base64": base64.StdEncoding.EncodeToString([]byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`))}, "unsupported logo type"},
+		{"invalid base64", map[string]any{"logo_base64": "not base64!"}, "Invalid logo_base64"},
+		{"javascript link", map[string]any{"links": map[string]any{"website": "javascript:alert(1)"}}, "must be an http or https URL"},
+	}
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			test.args["deployment_id"] = fmt.Sprint(deployment.ID)
+			result := suite.call(context.Background(), test.args)
+			suite.True(result.IsError)
+			suite.Contains(result.Content[0].(mcp.TextContent).Text, test.want)
+		})
+	}
+}
+
+func (suite *SetTokenMetadataToolTestSuite) TestOtherUsersDeployment() {
+	owner := "user-1"
+	deployment := suite.createDeployment(&owner)
+	ctx := utils.WithAuthenticatedUser(context.Background(), &utils.AuthenticatedUser{Sub: "user-2"})
+	result := suite.call(ctx, map[string]any{"deployment_id": fmt.Sprint(deployment.ID), "description": "Not mine"})
+	suite.True(result.IsError)
+	suite.Contains(result.Content[0].(mcp.TextContent).Text, "Deployment not found")
+}
+
+func TestSetTokenMetadataToolTestSuite(t *testing.T) {
+	suite.Run(t, new(SetTokenMetadataToolTestSuite))
+}
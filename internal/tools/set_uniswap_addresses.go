@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
@@ -27,8 +28,16 @@ type SetUniswapAddressesArguments struct {
 	FactoryAddress *string `json:"factory_address,omitempty"`
 	RouterAddress  *string `json:"router_address,omitempty"`
 	WETHAddress    *string `json:"weth_address,omitempty"`
+
+	// Optional selection of one of the DEX deployments of the chain
+	DexDeploymentID string `json:"dex_deployment_id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	IsDefault       bool   `json:"is_default,omitempty"`
 }
 
+// dexDeploymentIDDescription documents the dex_deployment_id parameter of the tools trading on a Uniswap deployment
+const dexDeploymentIDDescription = "ID of the DEX deployment to use when the chain has several (e.g. an app-owned fork and the canonical Uniswap), as returned by get_uniswap_addresses. Optional, defaults to the default deployment of the chain"
+
 func NewSetUniswapAddressesTool(uniswapService services.UniswapService, chainService services.ChainService) *setUniswapAddressesTool {
 	return &setUniswapAddressesTool{
 		uniswapService: uniswapService,
@@ -38,7 +47,8 @@ func NewSetUniswapAddressesTool(uniswapService services.UniswapService, chainSer
 
 func (s *setUniswapAddressesTool) GetTool() mcp.Tool {
 	tool := mcp.NewTool("set_uniswap_addresses",
-		mcp.WithDescription("Set or update Uniswap contract addresses (factory, router, WETH) for cases where contracts were deployed externally. Creates a new deployment record if none exists for the active chain. At least one address must be provided."),
+		mcp.WithDescription("Set or update Uniswap contract addresses (factory, router, WETH) for cases where contracts were deployed externally. Creates a new deployment record if none exists for the active chain. "+
+			"A chain can have several DEX deployments (e.g. an app-owned fork and the canonical Uniswap): a new name creates another deployment, dex_deployment_id updates an existing one and is_default selects the deployment the tools use by default. At least one address, name or is_default must be provided."),
 		mcp.WithString("version",
 			mcp.Required(),
 			mcp.Description("Uniswap version (v2, v3, or v4)"),
@@ -52,6 +62,15 @@ func (s *setUniswapAddressesTool) GetTool() mcp.Tool {
 		mcp.WithString("weth_address",
 			mcp.Description("WETH contract address (0x prefixed hex string)"),
 		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description("ID of the DEX deployment to update. Optional, defaults to the deployment with the given name, or the default deployment of the chain"),
+		),
+		mcp.WithString("name",
+			mcp.Description("Name of the DEX deployment (e.g. 'App fork', 'Uniswap'). A name not used on the chain creates a new deployment. Optional"),
+		),
+		mcp.WithBoolean("is_default",
+			mcp.Description("Make this deployment the default DEX deployment of the chain, used by the tools when no dex_deployment_id is given. Optional, defaults to false"),
+		),
 	)
 
 	return tool
@@ -68,9 +87,9 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
 		}
 
-		// Validate that at least one address is provided
-		if args.FactoryAddress == nil && args.RouterAddress == nil && args.WETHAddress == nil {
-			return mcp.NewToolResultError("At least one address (factory_address, router_address, or weth_address) must be provided"), nil
+		// Validate that at least one address or deployment setting is provided
+		if args.FactoryAddress == nil && args.RouterAddress == nil && args.WETHAddress == nil && args.Name == "" && !args.IsDefault {
+			return mcp.NewToolResultError("At least one address (factory_address, router_address, or weth_address), name or is_default must be provided"), nil
 		}
 
 		// Validate version
@@ -102,7 +121,7 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get active chain configuration
-		activeChain, err := s.chainService.GetActiveChain()
+		activeChain, err := s.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
@@ -113,10 +132,13 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get or create Uniswap deployment record
-		existingDeployment, err := s.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+		existingDeployment, err := s.findDexDeployment(userId, activeChain, args)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
 		var deploymentID uint
 
-		if err != nil || existingDeployment == nil {
+		if existingDeployment == nil {
 			// Create new deployment record
 			deploymentID, err = s.uniswapService.CreateUniswapDeployment(activeChain.ID, args.Version, userId)
 			if err != nil {
@@ -128,6 +150,12 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 
 		// Update addresses
 		var updatedFields []string
+		if args.Name != "" && (existingDeployment == nil || existingDeployment.Name != args.Name) {
+			if err := s.uniswapService.UpdateName(deploymentID, args.Name); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to update name: %v", err)), nil
+			}
+			updatedFields = append(updatedFields, "name")
+		}
 		if args.FactoryAddress != nil {
 			if err := s.uniswapService.UpdateFactoryAddress(deploymentID, *args.FactoryAddress); err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to update factory address: %v", err)), nil
@@ -146,6 +174,12 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 			}
 			updatedFields = append(updatedFields, "weth_address")
 		}
+		if args.IsDefault {
+			if err := s.uniswapService.SetDefaultUniswapDeployment(deploymentID); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to set the default DEX deployment: %v", err)), nil
+			}
+			updatedFields = append(updatedFields, "is_default")
+		}
 
 		// Fetch updated deployment to return
 		updatedDeployment, err := s.uniswapService.GetUniswapDeployment(deploymentID)
@@ -156,6 +190,8 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 		result := map[string]interface{}{
 			"id":              updatedDeployment.ID,
 			"version":         updatedDeployment.Version,
+			"name":            updatedDeployment.Name,
+			"is_default":      updatedDeployment.IsDefault,
 			"chain_id":        updatedDeployment.ChainID,
 			"factory_address": updatedDeployment.FactoryAddress,
 			"router_address":  updatedDeployment.RouterAddress,
@@ -174,6 +210,66 @@ func (s *setUniswapAddressesTool) GetHandler() server.ToolHandlerFunc {
 	}
 }
 
+// findDexDeployment returns the deployment updated by the arguments, nil when a new deployment must be created
+func (s *setUniswapAddressesTool) findDexDeployment(userId *string, activeChain *models.Chain, args SetUniswapAddressesArguments) (*models.UniswapDeployment, error) {
+	if args.DexDeploymentID != "" {
+		return selectDexDeployment(s.uniswapService, userId, activeChain, args.DexDeploymentID)
+	}
+
+	if args.Name != "" {
+		deployments, err := s.uniswapService.ListUniswapDeploymentsByChain(userId, activeChain.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list DEX deployments: %v", err)
+		}
+		for _, deployment := range deployments {
+			if deployment.Name == args.Name {
+				return &deployment, nil
+			}
+		}
+		// A chain without deployments names its first one, any other name is a new deployment
+		if len(deployments) > 0 {
+			return nil, nil
+		}
+	}
+
+	existingDeployment, err := s.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+	if err != nil {
+		return nil, nil
+	}
+	return existingDeployment, nil
+}
+
+// selectDexDeployment returns the Uniswap deployment selected by dex_deployment_id, the default deployment of the chain when it is empty.
+// The returned error message is safe to return to the AI client as is
+func selectDexDeployment(uniswapService services.UniswapService, userId *string, chain *models.Chain, dexDeploymentID string) (*models.UniswapDeployment, error) {
+	var id uint64
+	if dexDeploymentID != "" {
+		var err error
+		id, err = strconv.ParseUint(dexDeploymentID, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid dex_deployment_id: must be a numeric ID")
+		}
+	}
+
+	deployment, err := uniswapService.SelectUniswapDeployment(userId, *chain, uint(id))
+	if err != nil {
+		if id == 0 {
+			return nil, fmt.Errorf("No Uniswap deployment found for this chain. Please deploy Uniswap first using deploy_uniswap tool")
+		}
+		return nil, fmt.Errorf("Invalid dex_deployment_id: %v", err)
+	}
+	return deployment, nil
+}
+
+// selectPoolDexDeployment returns the Uniswap deployment selected by dex_deployment_id, the deployment the pool was
+// created on when it is empty
+func selectPoolDexDeployment(uniswapService services.UniswapService, userId *string, chain *models.Chain, pool *models.LiquidityPool, dexDeploymentID string) (*models.UniswapDeployment, error) {
+	if dexDeploymentID == "" && pool.DexDeploymentID != nil {
+		dexDeploymentID = strconv.FormatUint(uint64(*pool.DexDeploymentID), 10)
+	}
+	return selectDexDeployment(uniswapService, userId, chain, dexDeploymentID)
+}
+
 // validateEthereumAddress validates that the address is a valid Ethereum address format
 func validateEthereumAddress(address string) error {
 	// Remove 0x prefix if present
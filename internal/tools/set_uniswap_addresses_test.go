@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -73,7 +74,7 @@ func (suite *SetUniswapAddressesTestSuite) TestGetTool() {
 	suite.Contains(tool.Description, "Set or update Uniswap contract addresses")
 
 	// Check parameters
-	suite.Require().Len(tool.InputSchema.Properties, 4)
+	suite.Require().Len(tool.InputSchema.Properties, 7)
 
 	// Check version parameter
 	versionParam, exists := tool.InputSchema.Properties["version"]
@@ -217,6 +218,75 @@ func (suite *SetUniswapAddressesTestSuite) TestHandlerSuccess_UpdateExistingDepl
 	suite.Equal(newRouterAddress, deployment.RouterAddress)
 }
 
+func (suite *SetUniswapAddressesTestSuite) TestHandlerSuccess_MultipleDexDeployments() {
+	// The app-owned fork is the first deployment of the chain
+	forkID, err := suite.uniswapService.CreateUniswapDeployment(suite.testChain.ID, "v2", nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.uniswapService.UpdateRouterAddress(forkID, "0x5FbDB2315678afecb367f032d93F642f64180aa3"))
+
+	// A new name adds the canonical Uniswap next to the fork and makes it the default
+	canonicalRouter := "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+	result, err := suite.tool.GetHandler()(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"version":        "v2",
+				"name":           "Uniswap",
+				"router_address": canonicalRouter,
+				"is_default":     true,
+			},
+		},
+	})
+	suite.NoError(err)
+	suite.False(result.IsError)
+
+	var response map[string]interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &response))
+	suite.Equal("Uniswap", response["name"])
+	suite.Equal(true, response["is_default"])
+	suite.NotEqual(float64(forkID), response["id"])
+
+	deployments, err := suite.uniswapService.ListUniswapDeploymentsByChain(nil, suite.testChain.ID)
+	suite.NoError(err)
+	suite.Require().Len(deployments, 2)
+	suite.Equal(canonicalRouter, deployments[0].RouterAddress)
+	suite.True(deployments[0].IsDefault)
+
+	// dex_deployment_id updates the fork and selects it as the default again
+	result, err = suite.tool.GetHandler()(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"version":           "v2",
+				"dex_deployment_id": fmt.Sprint(forkID),
+				"name":              "App fork",
+				"is_default":        true,
+			},
+		},
+	})
+	suite.NoError(err)
+	suite.False(result.IsError)
+
+	deployment, err := suite.uniswapService.GetUniswapDeploymentByChain(suite.testChain.ID)
+	suite.NoError(err)
+	suite.Equal(forkID, deployment.ID)
+	suite.Equal("App fork", deployment.Name)
+	suite.Equal("0x5FbDB2315678afecb367f032d93F642f64180aa3", deployment.RouterAddress)
+}
+
+func (suite *SetUniswapAddressesTestSuite) TestHandlerError_UnknownDexDeployment() {
+	result, err := suite.tool.GetHandler()(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"version":           "v2",
+				"dex_deployment_id": "99999",
+				"is_default":        true,
+			},
+		},
+	})
+	suite.NoError(err)
+	suite.True(result.IsError)
+	suite.Contains(result.Content[0].(mcp.TextContent).Text, "Invalid dex_deployment_id")
+}
+
 func (suite *SetUniswapAddressesTestSuite) TestHandlerError_NoAddressProvided() {
 	request := mcp.CallToolRequest{
 		Params: mcp.CallToolParams{
@@ -0,0 +1,144 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type settleDutchAuctionTool struct {
+	chainService        services.ChainService
+	dutchAuctionService services.DutchAuctionService
+	txService           services.TransactionService
+	serverPort          int
+}
+
+type SettleDutchAuctionArguments struct {
+	// Required fields
+	AuctionID string `json:"auction_id" validate:"required"`
+}
+
+func NewSettleDutchAuctionTool(chainService services.ChainService, dutchAuctionService services.DutchAuctionService, txService services.TransactionService, serverPort int) *settleDutchAuctionTool {
+	return &settleDutchAuctionTool{
+		chainService:        chainService,
+		dutchAuctionService: dutchAuctionService,
+		txService:           txService,
+		serverPort:          serverPort,
+	}
+}
+
+func (s *settleDutchAuctionTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("settle_dutch_auction",
+		mcp.WithDescription("Settle a Dutch auction of launch_dutch_auction once it sold out or reached its end time. Creates a session calling settle on the token, which adds the ETH raised with tokens at the final price to its Uniswap V2 pair, "+
+			"sends the LP tokens to the deployer and burns the unsold tokens. The pair refuses liquidity before the settlement. Anyone can sign the settlement."),
+		mcp.WithString("auction_id",
+			mcp.Required(),
+			mcp.Description("ID of the Dutch auction returned by launch_dutch_auction"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (s *settleDutchAuctionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SettleDutchAuctionArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		auctionID, err := strconv.ParseUint(args.AuctionID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid auction ID: %v", err)), nil
+		}
+		auction, err := s.dutchAuctionService.GetDutchAuction(uint(auctionID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Dutch auction not found: %v", err)), nil
+		}
+
+		switch auction.Status {
+		case models.DutchAuctionStatusPending:
+			return mcp.NewToolResultError(fmt.Sprintf("Dutch auction %d is not deployed yet, sign its deployment session %s first", auction.ID, auction.SessionId)), nil
+		case models.DutchAuctionStatusFailed:
+			return mcp.NewToolResultError(fmt.Sprintf("Dutch auction %d failed to deploy", auction.ID)), nil
+		case models.DutchAuctionStatusSettled:
+			return mcp.NewToolResultError(fmt.Sprintf("Dutch auction %d is already settled", auction.ID)), nil
+		case models.DutchAuctionStatusActive:
+			// The monitor may not have read the end yet, the contract checks it again
+			if time.Now().Before(auction.EndTime) && auction.TokensSold != auction.AuctionSupply {
+				return mcp.NewToolResultError(fmt.Sprintf("Dutch auction %d is still running, it ends at %s unless sold out before", auction.ID, auction.EndTime.Format(time.RFC3339))), nil
+			}
+		}
+
+		activeChain, err := s.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+
+		if auction.ChainID != activeChain.ID {
+			return mcp.NewToolResultError(fmt.Sprintf("Dutch auction is on different chain (ID: %d) than active chain (ID: %d)", auction.ChainID, activeChain.ID)), nil
+		}
+
+		data, err := utils.EncodeDutchAuctionSettle()
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		session := services.CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{
+				{
+					Title:           "Settle Dutch Auction",
+					Description:     fmt.Sprintf("Call settle on %s to add %s wei raised to the Uniswap pair", auction.ContractAddress, auction.EthRaised),
+					Data:            data,
+					Value:           "0",
+					Receiver:        auction.ContractAddress,
+					TransactionType: models.TransactionTypeDutchAuctionSettlement,
+				},
+			},
+			ChainType: models.TransactionChainTypeEthereum,
+			ChainID:   auction.ChainID,
+			Metadata: []models.TransactionMetadata{
+				{Key: "dutch_auction_id", Value: strconv.FormatUint(uint64(auction.ID), 10)},
+				{Key: "eth_raised", Value: auction.EthRaised},
+				{Key: "tokens_sold", Value: auction.TokensSold},
+			},
+			UserID: auction.UserID,
+		}
+		session.Context = ctx
+
+		sessionID, err := s.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+		if err := s.dutchAuctionService.SetSettleSession(auction.ID, sessionID); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to update Dutch auction: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, s.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Settlement session created: %s", sessionID)),
+				mcp.NewTextContent(fmt.Sprintf("Dutch auction %d raised %s wei selling %s of %s tokens", auction.ID, auction.EthRaised, auction.TokensSold, auction.AuctionSupply)),
+				mcp.NewTextContent("Please sign the transaction in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,152 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type snapshotHoldersTool struct {
+	deploymentService services.DeploymentService
+	indexerService    services.IndexerService
+}
+
+type SnapshotHoldersArguments struct {
+	// Required fields
+	DeploymentID string `json:"deployment_id" validate:"required"`
+
+	// Optional fields
+	BlockNumber      string   `json:"block_number,omitempty" validate:"omitempty,numeric"`
+	MinBalance       string   `json:"min_balance,omitempty" validate:"omitempty,numeric"`
+	ExcludeAddresses []string `json:"exclude_addresses,omitempty" validate:"omitempty,dive,eth_addr"`
+	Format           string   `json:"format,omitempty" validate:"omitempty,oneof=json csv"`
+}
+
+func NewSnapshotHoldersTool(deploymentService services.DeploymentService, indexerService services.IndexerService) *snapshotHoldersTool {
+	return &snapshotHoldersTool{
+		deploymentService: deploymentService,
+		indexerService:    indexerService,
+	}
+}
+
+func (s *snapshotHoldersTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("snapshot_holders",
+		mcp.WithDescription("Snapshot the holders of a deployed token and their balances at a block height from the transfers of the event indexer. "+
+			"The snapshot is an address,amount recipient list in base units, largest balance first, exported as JSON or CSV to build an airdrop or a Merkle claim from. "+
+			"The token must be indexed up to the block, the indexer picks up confirmed deployments in the background."),
+		mcp.WithString("deployment_id",
+			mcp.Required(),
+			mcp.Description("ID of the confirmed token deployment"),
+		),
+		mcp.WithString("block_number",
+			mcp.Description("Block height of the snapshot, balances include the transfers of this block. Optional, defaults to the last indexed block"),
+		),
+		mcp.WithString("min_balance",
+			mcp.Description("Smallest balance in base units a holder needs to be in the snapshot. Optional"),
+		),
+		mcp.WithArray("exclude_addresses",
+			mcp.Description("Addresses left out of the snapshot, such as the pair, the deployer or a vesting contract. Optional"),
+			mcp.Items(map[string]any{"type": "string"}),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format of the snapshot. Optional, defaults to json"),
+			mcp.Enum(string(utils.HolderSnapshotFormatJSON), string(utils.HolderSnapshotFormatCSV)),
+		),
+	)
+
+	return tool
+}
+
+func (s *snapshotHoldersTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SnapshotHoldersArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		deployment, err := getConfirmedDeployment(s.deploymentService, args.DeploymentID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		lastSyncedBlock, indexed, err := s.indexerService.GetLastSyncedBlock(deployment.ChainID, deployment.ContractAddress)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to read indexer cursor: %v", err)), nil
+		}
+		if !indexed {
+			return mcp.NewToolResultError("The token is not indexed yet, retry once the event indexer has synced it"), nil
+		}
+
+		blockNumber := lastSyncedBlock
+		if args.BlockNumber != "" {
+			blockNumber, err = strconv.ParseUint(args.BlockNumber, 10, 64)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid block_number: %v", err)), nil
+			}
+			if blockNumber > lastSyncedBlock {
+				return mcp.NewToolResultError(fmt.Sprintf("The token is only indexed up to block %d", lastSyncedBlock)), nil
+			}
+		}
+
+		var minBalance *big.Int
+		if args.MinBalance != "" {
+			minBalance, _ = new(big.Int).SetString(args.MinBalance, 10)
+		}
+
+		events, err := s.indexerService.ListEvents(deployment.ChainID, deployment.ContractAddress, models.IndexedEventTypeTransfer)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list indexed transfers: %v", err)), nil
+		}
+		recipients := utils.SnapshotHolderBalances(utils.IndexedTransfers(events), blockNumber, minBalance, args.ExcludeAddresses)
+
+		total := new(big.Int)
+		for _, recipient := range recipients {
+			amount, _ := new(big.Int).SetString(recipient.Amount, 10)
+			total.Add(total, amount)
+		}
+		snapshot := utils.HolderSnapshot{
+			ChainID:      deployment.Chain.NetworkID,
+			TokenAddress: deployment.ContractAddress,
+			BlockNumber:  blockNumber,
+			Holders:      len(recipients),
+			TotalAmount:  total.String(),
+			Recipients:   recipients,
+		}
+
+		message := fmt.Sprintf("Snapshot of %d holders of %s at block %d", snapshot.Holders, deployment.ContractAddress, blockNumber)
+		if utils.HolderSnapshotFormat(args.Format) == utils.HolderSnapshotFormatCSV {
+			var buf bytes.Buffer
+			if err := utils.WriteCSV(&buf, utils.HolderSnapshotColumns, utils.HolderSnapshotRows(recipients)); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to write CSV: %v", err)), nil
+			}
+			return &mcp.CallToolResult{
+				Content: []mcp.Content{
+					mcp.NewTextContent(message + " as CSV: "),
+					mcp.NewTextContent(buf.String()),
+				},
+			}, nil
+		}
+
+		resultJSON, _ := json.Marshal(snapshot)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(message + ": "),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotHolders(t *testing.T) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	require.NoError(t, services.NewChainService(db.GetDB()).CreateChain(chain))
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	deployment := &models.Deployment{ChainID: chain.ID, Status: models.TransactionStatusConfirmed, ContractAddress: reportToken}
+	require.NoError(t, deploymentService.CreateDeployment(deployment))
+	unindexed := &models.Deployment{ChainID: chain.ID, Status: models.TransactionStatusConfirmed, ContractAddress: "0x6666666666666666666666666666666666666666"}
+	require.NoError(t, deploymentService.CreateDeployment(unindexed))
+
+	deployer := "0x4444444444444444444444444444444444444444"
+	buyer := "0x5555555555555555555555555555555555555555"
+	indexerService := services.NewIndexerService(db.GetDB())
+	require.NoError(t, indexerService.ApplyEvents(chain.ID, reportToken, []models.IndexedEvent{
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 10, TransactionHash: "0xmint", Sender: services.EthTokenAddress, Recipient: deployer, Value: "1000"},
+		{EventType: models.IndexedEventTypeTransfer, BlockNumber: 20, TransactionHash: "0xbuy", Sender: deployer, Recipient: buyer, Value: "90"},
+	}, 30))
+
+	handler := NewSnapshotHoldersTool(deploymentService, indexerService).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+	deploymentID := strconv.FormatUint(uint64(deployment.ID), 10)
+
+	t.Run("snapshots the last indexed block", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": deploymentID})
+		require.False(t, result.IsError)
+
+		var snapshot utils.HolderSnapshot
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &snapshot))
+		assert.Equal(t, uint64(30), snapshot.BlockNumber)
+		assert.Equal(t, 2, snapshot.Holders)
+		assert.Equal(t, "1000", snapshot.TotalAmount)
+		assert.Equal(t, []utils.HolderBalance{
+			{Address: common.HexToAddress(deployer).Hex(), Amount: "910"},
+			{Address: common.HexToAddress(buyer).Hex(), Amount: "90"},
+		}, snapshot.Recipients)
+	})
+
+	t.Run("exports an earlier block as CSV", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": deploymentID, "block_number": "15", "format": "csv"})
+		require.False(t, result.IsError)
+		assert.Equal(t, "address,amount\n"+common.HexToAddress(deployer).Hex()+",1000\n", result.Content[1].(mcp.TextContent).Text)
+	})
+
+	t.Run("excludes addresses", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": deploymentID, "exclude_addresses": []any{deployer}})
+		require.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "Snapshot of 1 holders")
+	})
+
+	t.Run("rejects blocks past the indexer cursor", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": deploymentID, "block_number": "31"})
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("rejects tokens that are not indexed", func(t *testing.T) {
+		result := call(map[string]any{"deployment_id": strconv.FormatUint(uint64(unindexed.ID), 10)})
+		assert.True(t, result.IsError)
+	})
+}
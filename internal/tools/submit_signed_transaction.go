@@ -0,0 +1,220 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// DefaultSubmitReceiptTimeout is how long submit_signed_transaction waits for a broadcast transaction to be mined
+const DefaultSubmitReceiptTimeout = 2 * time.Minute
+
+type submitSignedTransactionTool struct {
+	txService      services.TransactionService
+	receiptTimeout time.Duration
+
+	hookMu      sync.RWMutex
+	hookService services.HookService
+}
+
+type SubmitSignedTransactionArguments struct {
+	// Required fields
+	SessionID         string `json:"session_id" validate:"required"`
+	SignedTransaction string `json:"signed_transaction" validate:"required"`
+}
+
+// SubmittedTransactionResult is the state of a transaction submitted with submit_signed_transaction
+type SubmittedTransactionResult struct {
+	SessionID       string                   `json:"session_id"`
+	Index           int                      `json:"index"`
+	TransactionHash string                   `json:"transaction_hash"`
+	Status          models.TransactionStatus `json:"status"`
+	ContractAddress string                   `json:"contract_address,omitempty"`
+	SessionStatus   models.TransactionStatus `json:"session_status"`
+}
+
+func NewSubmitSignedTransactionTool(txService services.TransactionService) *submitSignedTransactionTool {
+	return &submitSignedTransactionTool{
+		txService:      txService,
+		receiptTimeout: DefaultSubmitReceiptTimeout,
+	}
+}
+
+// SetHookService sets the hooks run for the confirmed transactions, they are skipped until it is set
+func (s *submitSignedTransactionTool) SetHookService(hookService services.HookService) {
+	s.hookMu.Lock()
+	defer s.hookMu.Unlock()
+	s.hookService = hookService
+}
+
+func (s *submitSignedTransactionTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("submit_signed_transaction",
+		mcp.WithDescription("Broadcast a transaction signed offline from the file of export_unsigned_transactions and complete it on its session once mined, running the same hooks as a transaction signed in the browser. "+
+			"The transaction must sign one of the exported transactions of the session with the exported from address. "+
+			"When it is not mined in time, call again with the same signed transaction to keep waiting."),
+		mcp.WithString("session_id",
+			mcp.Required(),
+			mcp.Description("ID of the session the transactions were exported from"),
+		),
+		mcp.WithString("signed_transaction",
+			mcp.Required(),
+			mcp.Description("Signed raw transaction in hex, as written by the offline signer"),
+		),
+	)
+
+	return tool
+}
+
+func (s *submitSignedTransactionTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args SubmitSignedTransactionArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		session, err := s.txService.GetTransactionSession(args.SessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Session not found: %v", err)), nil
+		}
+		if userID := utils.GetUserID(ctx); userID != "" && (session.UserID == nil || *session.UserID != userID) {
+			return mcp.NewToolResultError("Session not found"), nil
+		}
+		if session.OfflineExport == nil {
+			return mcp.NewToolResultError("Session was not exported, call export_unsigned_transactions first"), nil
+		}
+		if err := checkOfflineSession(session); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		tx, sender, err := utils.DecodeSignedTransaction(args.SignedTransaction)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if tx.ChainId().String() != session.Chain.NetworkID {
+			return mcp.NewToolResultError(fmt.Sprintf("Transaction is signed for chain %s, the session is on chain %s", tx.ChainId(), session.Chain.NetworkID)), nil
+		}
+		if !strings.EqualFold(sender.Hex(), session.OfflineExport.From) {
+			return mcp.NewToolResultError(fmt.Sprintf("Transaction is signed by %s, the transactions were exported for %s", sender.Hex(), session.OfflineExport.From)), nil
+		}
+		signingHash := utils.SigningHash(tx).Hex()
+		var exported *models.OfflineTransaction
+		for i := range session.OfflineExport.Transactions {
+			if strings.EqualFold(session.OfflineExport.Transactions[i].SigningHash, signingHash) {
+				exported = &session.OfflineExport.Transactions[i]
+				break
+			}
+		}
+		if exported == nil || exported.Index < 0 || exported.Index >= len(session.TransactionDeployments) {
+			return mcp.NewToolResultError("Transaction does not match any exported transaction of the session, it was modified before signing or the session was exported again"), nil
+		}
+		deployment := &session.TransactionDeployments[exported.Index]
+		if deployment.Status == models.TransactionStatusConfirmed {
+			return mcp.NewToolResultError(fmt.Sprintf("Transaction %d (%s) is already confirmed", exported.Index, deployment.Title)), nil
+		}
+
+		client, err := ethclient.DialContext(ctx, session.Chain.RPC)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to connect to %s: %v", session.Chain.RPC, err)), nil
+		}
+		defer client.Close()
+
+		if err := client.SendTransaction(ctx, tx); err != nil {
+			// a transaction submitted again while it waits to be mined is already known to the node
+			if _, _, lookupErr := client.TransactionByHash(ctx, tx.Hash()); lookupErr != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to broadcast transaction %d (%s): %v", exported.Index, deployment.Title, err)), nil
+			}
+		}
+		exported.TransactionHash = tx.Hash().Hex()
+
+		waitCtx, cancel := context.WithTimeout(ctx, s.receiptTimeout)
+		defer cancel()
+		receipt, err := bind.WaitMined(waitCtx, client, tx)
+		if err != nil {
+			if updateErr := s.txService.UpdateTransactionSession(session.ID, session); updateErr != nil {
+				log.Printf("Error updating session %s: %v", session.ID, updateErr)
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				return mcp.NewToolResultError(fmt.Sprintf("Transaction %s was broadcast but is not mined yet, call submit_signed_transaction again with the same signed transaction to keep waiting", exported.TransactionHash)), nil
+			}
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to wait for transaction %s: %v", exported.TransactionHash, err)), nil
+		}
+
+		result := SubmittedTransactionResult{
+			SessionID:       session.ID,
+			Index:           exported.Index,
+			TransactionHash: exported.TransactionHash,
+			Status:          models.TransactionStatusConfirmed,
+		}
+		var contractAddress *string
+		if receipt.ContractAddress != (common.Address{}) {
+			result.ContractAddress = receipt.ContractAddress.Hex()
+			contractAddress = &result.ContractAddress
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			result.Status = models.TransactionStatusFailed
+		}
+		deployment.Status = result.Status
+		session.TransactionStatus = offlineSessionStatus(session.TransactionDeployments)
+		result.SessionStatus = session.TransactionStatus
+		if err := s.txService.UpdateTransactionSession(session.ID, session); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Transaction %s was mined but the session could not be updated: %v", exported.TransactionHash, err)), nil
+		}
+
+		summary := fmt.Sprintf("Transaction %d (%s) of session %s reverted: %s", exported.Index, deployment.Title, session.ID, exported.TransactionHash)
+		if result.Status == models.TransactionStatusConfirmed {
+			summary = fmt.Sprintf("Transaction %d (%s) of session %s confirmed: %s", exported.Index, deployment.Title, session.ID, exported.TransactionHash)
+			s.hookMu.RLock()
+			hookService := s.hookService
+			s.hookMu.RUnlock()
+			if hookService != nil {
+				if err := hookService.OnTransactionConfirmed(deployment.TransactionType, exported.TransactionHash, contractAddress, *session); err != nil {
+					log.Printf("Error on transaction confirmed: %v", err)
+				}
+			}
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(summary),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+			IsError: result.Status == models.TransactionStatusFailed,
+		}, nil
+	}
+}
+
+// offlineSessionStatus is failed once a transaction failed, confirmed once every transaction is confirmed
+func offlineSessionStatus(deployments []models.TransactionDeployment) models.TransactionStatus {
+	status := models.TransactionStatusConfirmed
+	for _, deployment := range deployments {
+		switch deployment.Status {
+		case models.TransactionStatusFailed:
+			return models.TransactionStatusFailed
+		case models.TransactionStatusConfirmed:
+		default:
+			status = models.TransactionStatusPending
+		}
+	}
+	return status
+}
@@ -4,8 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"math/big"
 	"strings"
-	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -16,13 +17,22 @@ import (
 	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
 )
 
+const (
+	// SwapModeExactInput swaps exactly amount of from_token for as many to_token as possible
+	SwapModeExactInput = "exact_input"
+	// SwapModeExactOutput swaps as few from_token as possible for exactly amount of to_token
+	SwapModeExactOutput = "exact_output"
+)
+
 type swapTokensTool struct {
-	chainService     services.ChainService
-	evmService       services.EvmService
-	txService        services.TransactionService
-	liquidityService services.LiquidityService
-	uniswapService   services.UniswapService
-	serverPort       int
+	chainService           services.ChainService
+	evmService             services.EvmService
+	txService              services.TransactionService
+	liquidityService       services.LiquidityService
+	uniswapService         services.UniswapService
+	uniswapContractService services.UniswapContractService
+	jupiterService         services.JupiterService
+	serverPort             int
 }
 
 type SwapTokensArguments struct {
@@ -34,43 +44,96 @@ type SwapTokensArguments struct {
 	UserAddress       string `json:"user_address" validate:"required"`
 
 	// Optional fields
-	Metadata []models.TransactionMetadata `json:"metadata,omitempty"`
+	SwapMode       string                       `json:"swap_mode,omitempty" validate:"omitempty,oneof=exact_input exact_output"`
+	MaxPriceImpact string                       `json:"max_price_impact,omitempty"`
+	MevProtection  bool                         `json:"mev_protection,omitempty"`
+	Gasless        bool                         `json:"gasless,omitempty"`
+	Metadata       []models.TransactionMetadata `json:"metadata,omitempty"`
+	// DexDeploymentID selects one of the DEX deployments of the chain, defaults to the default deployment
+	DexDeploymentID string `json:"dex_deployment_id,omitempty"`
+	// DryRun builds the session without creating it
+	DryRun bool `json:"dry_run,omitempty"`
+	RouterTransactionOverrides
+
+	// ctx is the context of the tool call, the confirmation of the session is requested from its client
+	ctx context.Context
+	// background marks the swaps of the orders run by the background jobs, created without a client to confirm them
+	background bool
+	// recipient receives the swap output instead of user_address, buybacks send it to the burn address
+	recipient string
+}
+
+// SwapSession is a swap transaction session waiting to be signed
+type SwapSession struct {
+	// SessionID is empty for a dry run
+	SessionID string
+	// Request is the created session, or the session a dry run would have created
+	Request services.CreateTransactionSessionRequest
+	// Path is the list of token addresses the swap is routed through
+	Path []string
+	// SlippageTolerance is the slippage in percent, derived from the pool depth when AutoSlippage is set
+	SlippageTolerance float64
+	AutoSlippage      bool
+	// NoQuotedOutput is set when no pool reserves were readable to quote the output, the minimum output falls back to
+	// 1 wei and the slippage tolerance is not enforced
+	NoQuotedOutput bool
+	// MaxAmountIn is the maximum amount of from_token spent by an exact output swap
+	MaxAmountIn string
 }
 
-func NewSwapTokensTool(chainService services.ChainService, liquidityService services.LiquidityService, uniswapService services.UniswapService, txService services.TransactionService, serverPort int, evmService services.EvmService) *swapTokensTool {
+func NewSwapTokensTool(chainService services.ChainService, liquidityService services.LiquidityService, uniswapService services.UniswapService, txService services.TransactionService, serverPort int, evmService services.EvmService, uniswapContractService services.UniswapContractService, jupiterService services.JupiterService) *swapTokensTool {
 	return &swapTokensTool{
-		chainService:     chainService,
-		evmService:       evmService,
-		txService:        txService,
-		liquidityService: liquidityService,
-		uniswapService:   uniswapService,
-		serverPort:       serverPort,
+		chainService:           chainService,
+		evmService:             evmService,
+		txService:              txService,
+		liquidityService:       liquidityService,
+		uniswapService:         uniswapService,
+		uniswapContractService: uniswapContractService,
+		jupiterService:         jupiterService,
+		serverPort:             serverPort,
 	}
 }
 
 func (s *swapTokensTool) GetTool() mcp.Tool {
 	tool := mcp.NewTool("swap_tokens",
-		mcp.WithDescription("Execute token swaps via Uniswap with signing interface. Generates a URL where users can connect wallet and sign the swap transaction."),
+		mcp.WithDescription("Execute token swaps via Uniswap with signing interface. The swap is routed through the known pools (direct or multi-hop) that give the best output. "+
+			"On Solana mainnet-beta the swap is routed through the Jupiter aggregator and signed with a Solana wallet. Generates a URL where users can connect wallet and sign the swap transaction."),
 		mcp.WithString("from_token",
 			mcp.Required(),
-			mcp.Description(fmt.Sprintf("Address of the token to swap from (use %s for ETH)", services.EthTokenAddress)),
+			mcp.Description(fmt.Sprintf("Address of the token to swap from (use %s for ETH). On Solana the mint address (use %s for SOL)", services.EthTokenAddress, utils.SolanaNativeMint)),
 		),
 		mcp.WithString("to_token",
 			mcp.Required(),
-			mcp.Description(fmt.Sprintf("Address of the token to swap to (use %s for ETH)", services.EthTokenAddress)),
+			mcp.Description(fmt.Sprintf("Address of the token to swap to (use %s for ETH). On Solana the mint address (use %s for SOL)", services.EthTokenAddress, utils.SolanaNativeMint)),
 		),
 		mcp.WithString("amount",
 			mcp.Required(),
-			mcp.Description("Amount of tokens to swap (in wei for ETH, or smallest unit for tokens)"),
+			mcp.Description("Amount of tokens to swap (in wei for ETH, or smallest unit for tokens). In exact_output mode this is the amount of to_token to receive"),
 		),
 		mcp.WithString("slippage_tolerance",
 			mcp.Required(),
-			mcp.Description("Maximum slippage tolerance as percentage (e.g., '0.5' for 0.5%)"),
+			mcp.Description(fmt.Sprintf("Maximum slippage tolerance as percentage (e.g., '0.5' for 0.5%%), or '%s' to derive it from the expected price impact on the pool reserves", utils.AutoSlippage)),
 		),
 		mcp.WithString("user_address",
 			mcp.Required(),
 			mcp.Description("Address that will execute the swap"),
 		),
+		mcp.WithString("swap_mode",
+			mcp.Description("exact_input spends exactly amount of from_token, exact_output receives exactly amount of to_token and spends at most the input computed from the pool reserves plus the slippage tolerance. Optional, defaults to exact_input"),
+			mcp.Enum(SwapModeExactInput, SwapModeExactOutput),
+		),
+		mcp.WithString("max_price_impact",
+			mcp.Description(fmt.Sprintf("Maximum accepted price impact as percentage. Swaps above it are rejected. Optional, defaults to %g%% with auto slippage and is only checked when given otherwise", utils.DefaultMaxPriceImpact)),
+		),
+		mcp.WithString("dex_deployment_id",
+			mcp.Description(dexDeploymentIDDescription+". Ignored on Solana"),
+		),
+		mcp.WithBoolean("mev_protection",
+			mcp.Description("Submit the signed swap through the private relay of the chain (e.g. Flashbots Protect) instead of the public mempool to avoid front-running. Requires private_relay_rpc to be configured with set_chain. Optional, defaults to false"),
+		),
+		mcp.WithBoolean("gasless",
+			mcp.Description("Send the approvals and the swap as ERC-4337 UserOperations of the smart account of the wallet through the bundler of the chain, sponsored by its paymaster if configured with set_chain. user_address must be the smart account (see get_smart_account), it holds and spends the tokens. Ethereum only, cannot be combined with mev_protection. Optional, defaults to false"),
+		),
 		mcp.WithArray("metadata",
 			mcp.Description("JSON array of metadata for the transaction (e.g., [{\"key\": \"Swap Type\", \"value\": \"Token Swap\"}]). Optional."),
 			mcp.Items(map[string]any{
@@ -84,7 +147,13 @@ func (s *swapTokensTool) GetTool() mcp.Tool {
 				},
 			}),
 		),
+		withDryRun(),
+		withIdempotencyKey(),
 	)
+
+	for _, option := range routerOverrideOptions() {
+		option(&tool)
+	}
 	return tool
 }
 
@@ -100,12 +169,20 @@ func (s *swapTokensTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		// Get active chain configuration
-		activeChain, err := s.chainService.GetActiveChain()
+		activeChain, err := s.chainService.ResolveChain(ctx)
 		if err != nil {
 			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
 		}
 
-		// Currently only support Ethereum
+		// Solana swaps are routed through Jupiter instead of Uniswap
+		if activeChain.ChainType == models.TransactionChainTypeSolana {
+			if args.Gasless {
+				return mcp.NewToolResultError("gasless transactions are only supported on ethereum chains"), nil
+			}
+			return s.createJupiterSwapTransaction(ctx, args, activeChain)
+		}
+
+		// Uniswap swaps are only supported on Ethereum
 		if activeChain.ChainType != models.TransactionChainTypeEthereum {
 			return mcp.NewToolResultError(fmt.Sprintf("Uniswap swaps are only supported on Ethereum, got %s", activeChain.ChainType)), nil
 		}
@@ -132,30 +209,73 @@ func (s *swapTokensTool) createSwapTransaction(ctx context.Context, args SwapTok
 		userId = &user.Sub
 	}
 
-	// Get active Uniswap deployment
-	_, err := s.uniswapService.GetActiveUniswapDeployment(userId, *activeChain)
+	args.ctx = ctx
+	swapSession, err := s.CreateSwapSession(args, activeChain, userId)
 	if err != nil {
-		return mcp.NewToolResultError("No Uniswap version selected. Please use set_uniswap_version tool first"), nil
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if args.DryRun {
+		return newDryRunResult("swap_tokens", []services.CreateTransactionSessionRequest{swapSession.Request})
 	}
 
-	// Get Uniswap deployment to retrieve contract addresses
-	uniswapDeployment, err := s.uniswapService.GetUniswapDeploymentByChain(activeChain.ID)
+	url, err := utils.GetTransactionSessionUrl(ctx, s.serverPort, swapSession.SessionID)
 	if err != nil {
-		return mcp.NewToolResultError("No Uniswap deployment found for this chain. Please deploy Uniswap first using deploy_uniswap tool"), nil
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Swap transaction session created: %s", swapSession.SessionID)),
+			mcp.NewTextContent(fmt.Sprintf("Swap route: %s", strings.Join(swapSession.Path, " -> "))),
+			mcp.NewTextContent(formatSwapSlippage(swapSession)),
+		},
+	}
+	if swapSession.MaxAmountIn != "" {
+		result.Content = append(result.Content, mcp.NewTextContent(fmt.Sprintf("Exact output swap, maximum input: %s", swapSession.MaxAmountIn)))
+	}
+	result.Content = append(result.Content,
+		mcp.NewTextContent("Please sign the swap transaction in the URL"),
+		mcp.NewTextContent(url),
+	)
+	return result, nil
+}
+
+// CreateSwapSession creates a swap transaction session on the given chain without going through the MCP handler.
+// It is used by the handler as well as by background jobs such as the limit order monitor.
+// The returned error message is safe to return to the AI client as is.
+// With DryRun set the session is built but not created, SessionID is left empty.
+func (s *swapTokensTool) CreateSwapSession(args SwapTokensArguments, activeChain *models.Chain, userId *string) (*SwapSession, error) {
+	// Get the selected Uniswap deployment to retrieve its contract addresses
+	uniswapDeployment, err := selectDexDeployment(s.uniswapService, userId, activeChain, args.DexDeploymentID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Verify required addresses are available
 	if uniswapDeployment.RouterAddress == "" {
-		return mcp.NewToolResultError("Router address not found in Uniswap deployment. Please ensure Uniswap deployment is completed"), nil
+		return nil, fmt.Errorf("Router address not found in Uniswap deployment. Please ensure Uniswap deployment is completed")
 	}
 	if uniswapDeployment.WETHAddress == "" {
-		return mcp.NewToolResultError("WETH address not found in Uniswap deployment. Please ensure Uniswap deployment is completed"), nil
+		return nil, fmt.Errorf("WETH address not found in Uniswap deployment. Please ensure Uniswap deployment is completed")
+	}
+
+	// Parse slippage tolerance, auto slippage is derived from the route below
+	autoSlippage := utils.IsAutoSlippage(args.SlippageTolerance)
+	var slippage float64
+	if !autoSlippage {
+		slippage, err = parseSlippage(args.SlippageTolerance)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid slippage tolerance: %v", err)
+		}
 	}
 
-	// Parse slippage tolerance
-	slippage, err := parseSlippage(args.SlippageTolerance)
+	maxPriceImpact, err := parseMaxPriceImpact(args.MaxPriceImpact)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Invalid slippage tolerance: %v", err)), nil
+		return nil, fmt.Errorf("Invalid max price impact: %v", err)
+	}
+
+	overrides, err := parseRouterOverrides(args.RouterTransactionOverrides)
+	if err != nil {
+		return nil, err
 	}
 
 	// Determine swap type and create transactions
@@ -163,41 +283,126 @@ func (s *swapTokensTool) createSwapTransaction(ctx context.Context, args SwapTok
 	isFromETH := strings.ToLower(args.FromToken) == services.EthTokenAddress
 	isToETH := strings.ToLower(args.ToToken) == services.EthTokenAddress
 
-	if isFromETH && !isToETH {
+	// The router expects WETH in place of ETH in the swap path
+	routeFrom, routeTo := args.FromToken, args.ToToken
+	if isFromETH {
+		routeFrom = uniswapDeployment.WETHAddress
+	}
+	if isToETH {
+		routeTo = uniswapDeployment.WETHAddress
+	}
+
+	exactOutput := args.SwapMode == SwapModeExactOutput
+	var path []string
+	var route *utils.SwapRoute
+	if exactOutput {
+		route, err = s.findExactOutputRoute(activeChain, uniswapDeployment, routeFrom, routeTo, args.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("Exact output swaps require a confirmed pool route with readable reserves to compute the maximum input: %v", err)
+		}
+		path = route.Path
+	} else {
+		path, route = s.findSwapPath(activeChain, uniswapDeployment, routeFrom, routeTo, args.Amount)
+	}
+
+	// The price impact is always checked in auto mode, and in manual mode when a maximum is given
+	if route != nil && (autoSlippage || args.MaxPriceImpact != "") && route.PriceImpact > maxPriceImpact {
+		return nil, fmt.Errorf("Price impact of %.2f%% exceeds the maximum of %.2f%%. Swap a smaller amount or raise max_price_impact", route.PriceImpact, maxPriceImpact)
+	}
+
+	// Without a quoted output the minimum output falls back to 1 wei, the slippage tolerance cannot be enforced
+	minAmountOut := noQuotedMinimumAmountOut
+	var maxAmountIn string
+	if exactOutput {
+		if autoSlippage {
+			slippage = utils.AutoSlippageTolerance(route.PriceImpact)
+		}
+		maxAmountIn = utils.ApplyMaxSlippage(route.AmountIn, slippage).String()
+	} else if route != nil {
+		if autoSlippage {
+			slippage = utils.AutoSlippageTolerance(route.PriceImpact)
+		}
+		minAmountOut = utils.ApplySlippage(route.AmountOut, slippage).String()
+	} else if autoSlippage {
+		return nil, fmt.Errorf("Auto slippage requires a confirmed pool route with readable reserves. Please provide slippage_tolerance as a percentage")
+	}
+
+	recipient := args.UserAddress
+	if args.recipient != "" {
+		recipient = args.recipient
+	}
+
+	if exactOutput {
+		transactionDeployments, err = s.createExactOutputSwap(
+			uniswapDeployment.RouterAddress,
+			path,
+			args.FromToken,
+			args.ToToken,
+			args.Amount,
+			maxAmountIn,
+			recipient,
+			overrides.Deadline,
+		)
+	} else if isFromETH && !isToETH {
 		// ETH to Token swap
 		transactionDeployments, err = s.createETHToTokenSwap(
 			uniswapDeployment.RouterAddress,
-			uniswapDeployment.WETHAddress,
+			path,
 			args.ToToken,
 			args.Amount,
-			slippage,
-			args.UserAddress,
+			minAmountOut,
+			recipient,
+			overrides.Deadline,
 		)
 	} else if !isFromETH && isToETH {
 		// Token to ETH swap
 		transactionDeployments, err = s.createTokenToETHSwap(
 			uniswapDeployment.RouterAddress,
-			uniswapDeployment.WETHAddress,
+			path,
 			args.FromToken,
 			args.Amount,
-			slippage,
-			args.UserAddress,
+			minAmountOut,
+			recipient,
+			overrides.Deadline,
 		)
 	} else {
-		// Token to Token swap (through WETH)
+		// Token to Token swap
 		transactionDeployments, err = s.createTokenToTokenSwap(
 			uniswapDeployment.RouterAddress,
-			uniswapDeployment.WETHAddress,
+			path,
 			args.FromToken,
 			args.ToToken,
 			args.Amount,
-			slippage,
-			args.UserAddress,
+			minAmountOut,
+			recipient,
+			overrides.Deadline,
 		)
 	}
 
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Error creating swap transactions: %v", err)), nil
+		return nil, fmt.Errorf("Error creating swap transactions: %v", err)
+	}
+
+	// Exact output swaps spend at most the maximum input
+	spentAmount := args.Amount
+	if exactOutput {
+		spentAmount = maxAmountIn
+	}
+	transactionDeployments, skippedApprovals := omitSufficientApprovals(activeChain.RPC, args.UserAddress, transactionDeployments, map[string]string{
+		args.FromToken: spentAmount,
+	})
+
+	overrides.apply(transactionDeployments)
+
+	if args.MevProtection {
+		if err := applyMevProtection(activeChain, transactionDeployments); err != nil {
+			return nil, err
+		}
+	}
+	if args.Gasless {
+		if err := applyGasless(activeChain, transactionDeployments); err != nil {
+			return nil, err
+		}
 	}
 
 	// Add metadata
@@ -213,10 +418,62 @@ func (s *swapTokensTool) createSwapTransaction(ctx context.Context, args SwapTok
 		Key:   "amount",
 		Value: args.Amount,
 	})
+	slippageValue := args.SlippageTolerance
+	if autoSlippage {
+		slippageValue = fmt.Sprintf("%.2f (auto)", slippage)
+	}
 	enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
 		Key:   "slippage",
-		Value: args.SlippageTolerance,
+		Value: slippageValue,
 	})
+	enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+		Key:   "swap_path",
+		Value: strings.Join(path, ","),
+	})
+	if args.recipient != "" {
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   "recipient",
+			Value: recipient,
+		})
+	}
+	if args.MevProtection {
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   mevProtectionMetadataKey,
+			Value: "enabled",
+		})
+	}
+	if args.Gasless {
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   gaslessMetadataKey,
+			Value: "enabled",
+		})
+	}
+	if exactOutput {
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   "swap_mode",
+			Value: SwapModeExactOutput,
+		})
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   "expected_amount_in",
+			Value: route.AmountIn.String(),
+		})
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   "max_amount_in",
+			Value: maxAmountIn,
+		})
+	}
+	if route != nil {
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   "expected_amount_out",
+			Value: route.AmountOut.String(),
+		})
+		enhancedMetadata = append(enhancedMetadata, models.TransactionMetadata{
+			Key:   "price_impact",
+			Value: fmt.Sprintf("%.2f", route.PriceImpact),
+		})
+	}
+
+	enhancedMetadata = skippedApprovalsMetadata(enhancedMetadata, skippedApprovals)
 
 	// Create transaction session
 	balances := map[string]*string{}
@@ -226,33 +483,36 @@ func (s *swapTokensTool) createSwapTransaction(ctx context.Context, args SwapTok
 	if !isToETH {
 		balances[args.ToToken] = nil
 	}
-	sessionID, err := s.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
-		TransactionDeployments: transactionDeployments,
-		ChainType:              models.TransactionChainTypeEthereum,
-		ChainID:                activeChain.ID,
-		Metadata:               enhancedMetadata,
-		UserID:                 userId,
-		Balances:               balances,
-	})
-	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Error creating transaction session: %v", err)), nil
+	swapSession := &SwapSession{
+		Request: services.CreateTransactionSessionRequest{
+			TransactionDeployments: transactionDeployments,
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                activeChain.ID,
+			Metadata:               enhancedMetadata,
+			UserID:                 userId,
+			Balances:               balances,
+			Context:                args.ctx,
+			Background:             args.background,
+		},
+		Path:              path,
+		SlippageTolerance: slippage,
+		AutoSlippage:      autoSlippage,
+		NoQuotedOutput:    !exactOutput && route == nil,
+		MaxAmountIn:       maxAmountIn,
+	}
+	if args.DryRun {
+		return swapSession, nil
 	}
 
-	url, err := utils.GetTransactionSessionUrl(s.serverPort, sessionID)
+	swapSession.SessionID, err = s.txService.CreateTransactionSession(swapSession.Request)
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		return nil, fmt.Errorf("Error creating transaction session: %v", err)
 	}
-	return &mcp.CallToolResult{
-		Content: []mcp.Content{
-			mcp.NewTextContent(fmt.Sprintf("Swap transaction session created: %s", sessionID)),
-			mcp.NewTextContent("Please sign the swap transaction in the URL"),
-			mcp.NewTextContent(url),
-		},
-	}, nil
+	return swapSession, nil
 }
 
 // createETHToTokenSwap creates a transaction to swap ETH for tokens
-func (s *swapTokensTool) createETHToTokenSwap(routerAddress, wethAddress, toToken, amount string, slippage float64, userAddress string) ([]models.TransactionDeployment, error) {
+func (s *swapTokensTool) createETHToTokenSwap(routerAddress string, path []string, toToken, amount, minAmountOut string, userAddress string, deadline int64) ([]models.TransactionDeployment, error) {
 	// Get Uniswap V2 Router ABI
 	v2Contracts, err := utils.FetchUniswapV2Contracts()
 	if err != nil {
@@ -264,20 +524,6 @@ func (s *swapTokensTool) createETHToTokenSwap(routerAddress, wethAddress, toToke
 		return nil, fmt.Errorf("failed to marshal Router ABI: %w", err)
 	}
 
-	// Calculate minimum output with slippage
-	// For now, use a simple estimation (in production, use getAmountsOut)
-	minAmountOut := calculateMinimumAmount(amount, slippage)
-
-	// Calculate deadline (10 minutes from now)
-	deadline := time.Now().Unix() + 600
-
-	// Create path: [WETH, toToken]
-	// Pass addresses as strings for proper ABI encoding
-	path := []any{
-		wethAddress,
-		toToken,
-	}
-
 	// Validate addresses
 	if !utils.IsValidEthereumAddress(toToken) {
 		return nil, fmt.Errorf("invalid token address: %s", toToken)
@@ -287,7 +533,7 @@ func (s *swapTokensTool) createETHToTokenSwap(routerAddress, wethAddress, toToke
 	}
 
 	// Create swap transaction
-	functionArgs := []any{minAmountOut, path, userAddress, fmt.Sprintf("%d", deadline)}
+	functionArgs := []any{minAmountOut, toAnyPath(path), userAddress, fmt.Sprintf("%d", deadline)}
 	swapTx, err := s.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
 		ContractAddress: routerAddress,
 		FunctionName:    "swapExactETHForTokens",
@@ -311,7 +557,7 @@ func (s *swapTokensTool) createETHToTokenSwap(routerAddress, wethAddress, toToke
 }
 
 // createTokenToETHSwap creates transactions to swap tokens for ETH
-func (s *swapTokensTool) createTokenToETHSwap(routerAddress, wethAddress, fromToken, amount string, slippage float64, userAddress string) ([]models.TransactionDeployment, error) {
+func (s *swapTokensTool) createTokenToETHSwap(routerAddress string, path []string, fromToken, amount, minAmountOut string, userAddress string, deadline int64) ([]models.TransactionDeployment, error) {
 	// Get Uniswap V2 Router ABI
 	v2Contracts, err := utils.FetchUniswapV2Contracts()
 	if err != nil {
@@ -326,19 +572,6 @@ func (s *swapTokensTool) createTokenToETHSwap(routerAddress, wethAddress, fromTo
 	// Standard ERC20 ABI for approve function
 	erc20ABI := `[{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
 
-	// Calculate minimum output with slippage
-	minAmountOut := calculateMinimumAmount(amount, slippage)
-
-	// Calculate deadline (10 minutes from now)
-	deadline := time.Now().Unix() + 600
-
-	// Create path: [fromToken, WETH]
-	// Pass addresses as strings for proper ABI encoding
-	path := []any{
-		fromToken,
-		wethAddress,
-	}
-
 	// Validate addresses
 	if !utils.IsValidEthereumAddress(fromToken) {
 		return nil, fmt.Errorf("invalid token address: %s", fromToken)
@@ -374,7 +607,7 @@ func (s *swapTokensTool) createTokenToETHSwap(routerAddress, wethAddress, fromTo
 	transactionDeployments = append(transactionDeployments, approveTx)
 
 	// Transaction 2: Swap tokens for ETH
-	swapEthFunctionArgs := []any{amount, minAmountOut, path, userAddress, fmt.Sprintf("%d", deadline)}
+	swapEthFunctionArgs := []any{amount, minAmountOut, toAnyPath(path), userAddress, fmt.Sprintf("%d", deadline)}
 	swapTx, err := s.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
 		ContractAddress: routerAddress,
 		FunctionName:    "swapExactTokensForETH",
@@ -400,8 +633,8 @@ func (s *swapTokensTool) createTokenToETHSwap(routerAddress, wethAddress, fromTo
 	return transactionDeployments, nil
 }
 
-// createTokenToTokenSwap creates transactions to swap tokens for tokens (via WETH)
-func (s *swapTokensTool) createTokenToTokenSwap(routerAddress, wethAddress, fromToken, toToken, amount string, slippage float64, userAddress string) ([]models.TransactionDeployment, error) {
+// createTokenToTokenSwap creates transactions to swap tokens for tokens along the given path
+func (s *swapTokensTool) createTokenToTokenSwap(routerAddress string, path []string, fromToken, toToken, amount, minAmountOut string, userAddress string, deadline int64) ([]models.TransactionDeployment, error) {
 	// Get Uniswap V2 Router ABI
 	v2Contracts, err := utils.FetchUniswapV2Contracts()
 	if err != nil {
@@ -418,21 +651,6 @@ func (s *swapTokensTool) createTokenToTokenSwap(routerAddress, wethAddress, from
 
 	// MaxUint256 for unlimited approval
 
-	// Calculate minimum output with slippage
-	minAmountOut := calculateMinimumAmount(amount, slippage)
-
-	// Calculate deadline (10 minutes from now)
-	deadline := time.Now().Unix() + 600
-
-	// Create path: [fromToken, WETH, toToken]
-	// This assumes both tokens have liquidity with WETH
-	// Pass addresses as strings for proper ABI encoding
-	path := []any{
-		fromToken,
-		wethAddress,
-		toToken,
-	}
-
 	// Validate addresses
 	if !utils.IsValidEthereumAddress(fromToken) {
 		return nil, fmt.Errorf("invalid from token address: %s", fromToken)
@@ -471,7 +689,7 @@ func (s *swapTokensTool) createTokenToTokenSwap(routerAddress, wethAddress, from
 	transactionDeployments = append(transactionDeployments, approveTx)
 
 	// Transaction 2: Swap tokens for tokens
-	swapTokensFunctionArgs := []any{amount, minAmountOut, path, userAddress, fmt.Sprintf("%d", deadline)}
+	swapTokensFunctionArgs := []any{amount, minAmountOut, toAnyPath(path), userAddress, fmt.Sprintf("%d", deadline)}
 	swapTx, err := s.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
 		ContractAddress: routerAddress,
 		FunctionName:    "swapExactTokensForTokens",
@@ -497,6 +715,204 @@ func (s *swapTokensTool) createTokenToTokenSwap(routerAddress, wethAddress, from
 	return transactionDeployments, nil
 }
 
+// createExactOutputSwap creates the transactions of an exact output swap receiving amountOut of toToken for at most maxAmountIn.
+// Token inputs are approved for maxAmountIn only, ETH inputs send maxAmountIn and the router refunds the unspent ETH.
+func (s *swapTokensTool) createExactOutputSwap(routerAddress string, path []string, fromToken, toToken, amountOut, maxAmountIn string, userAddress string, deadline int64) ([]models.TransactionDeployment, error) {
+	// Get Uniswap V2 Router ABI
+	v2Contracts, err := utils.FetchUniswapV2Contracts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Uniswap V2 contracts: %w", err)
+	}
+
+	routerAbi, err := json.Marshal(v2Contracts.Router.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Router ABI: %w", err)
+	}
+
+	if !utils.IsValidEthereumAddress(routerAddress) {
+		return nil, fmt.Errorf("invalid router address: %s", routerAddress)
+	}
+
+	isFromETH := strings.ToLower(fromToken) == services.EthTokenAddress
+	isToETH := strings.ToLower(toToken) == services.EthTokenAddress
+
+	var transactionDeployments []models.TransactionDeployment
+	if !isFromETH {
+		if !utils.IsValidEthereumAddress(fromToken) {
+			return nil, fmt.Errorf("invalid from token address: %s", fromToken)
+		}
+
+		// Transaction 1: Approve the maximum input for Router
+		functionArgs := []any{routerAddress, maxAmountIn}
+		approveTx, err := s.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+			ContractAddress: fromToken,
+			FunctionName:    "approve",
+			FunctionArgs:    functionArgs,
+			Abi:             erc20ApproveAbi,
+			Value:           "0",
+			Title:           "Approve Token for Swap",
+			Description:     fmt.Sprintf("Approve at most %s tokens for Uniswap Router at %s", maxAmountIn, routerAddress),
+			TransactionType: models.TransactionTypeRegular,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create approval transaction: %w", err)
+		}
+		functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI("approve", functionArgs, erc20ApproveAbi)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+		}
+		approveTx.RawContractArguments = &functionArgsString
+		approveTx.ShowBalanceBeforeDeployment = true
+		approveTx.ContractAddress = &fromToken
+		transactionDeployments = append(transactionDeployments, approveTx)
+	}
+	if !isToETH && !utils.IsValidEthereumAddress(toToken) {
+		return nil, fmt.Errorf("invalid to token address: %s", toToken)
+	}
+
+	// Swap transaction
+	var functionName, title, description, value string
+	var functionArgs []any
+	switch {
+	case isFromETH:
+		functionName = "swapETHForExactTokens"
+		functionArgs = []any{amountOut, toAnyPath(path), userAddress, fmt.Sprintf("%d", deadline)}
+		title = "Swap ETH for Exact Tokens"
+		description = fmt.Sprintf("Swap at most %s wei of ETH for %s of %s", maxAmountIn, amountOut, toToken)
+		value = maxAmountIn
+	case isToETH:
+		functionName = "swapTokensForExactETH"
+		functionArgs = []any{amountOut, maxAmountIn, toAnyPath(path), userAddress, fmt.Sprintf("%d", deadline)}
+		title = "Swap Tokens for Exact ETH"
+		description = fmt.Sprintf("Swap at most %s of %s for %s wei of ETH", maxAmountIn, fromToken, amountOut)
+		value = "0"
+	default:
+		functionName = "swapTokensForExactTokens"
+		functionArgs = []any{amountOut, maxAmountIn, toAnyPath(path), userAddress, fmt.Sprintf("%d", deadline)}
+		title = "Swap Tokens for Exact Tokens"
+		description = fmt.Sprintf("Swap at most %s of %s for %s of %s", maxAmountIn, fromToken, amountOut, toToken)
+		value = "0"
+	}
+
+	swapTx, err := s.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: routerAddress,
+		FunctionName:    functionName,
+		FunctionArgs:    functionArgs,
+		Abi:             string(routerAbi),
+		Value:           value,
+		Title:           title,
+		Description:     description,
+		TransactionType: models.TransactionTypeTokenSwap,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create swap transaction: %w", err)
+	}
+	functionArgsString, err := utils.EncodeFunctionArgsToStringMapWithStringABI(functionName, functionArgs, string(routerAbi))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	swapTx.RawContractArguments = &functionArgsString
+	swapTx.MaxSpend = &maxAmountIn
+	if !isFromETH {
+		swapTx.ShowBalanceBeforeDeployment = true
+		swapTx.ContractAddress = &routerAddress
+	}
+	transactionDeployments = append(transactionDeployments, swapTx)
+
+	return transactionDeployments, nil
+}
+
+// findSwapPath picks the route with the best output across the confirmed pools of the deployment.
+// Falls back to the direct pair, or routing through WETH, when no route can be evaluated.
+// fromToken and toToken must already have ETH replaced by WETH.
+func (s *swapTokensTool) findSwapPath(chain *models.Chain, deployment *models.UniswapDeployment, fromToken, toToken, amount string) ([]string, *utils.SwapRoute) {
+	wethAddress := deployment.WETHAddress
+	defaultPath := []string{fromToken, wethAddress, toToken}
+	if strings.EqualFold(fromToken, wethAddress) || strings.EqualFold(toToken, wethAddress) {
+		defaultPath = []string{fromToken, toToken}
+	}
+
+	amountIn, ok := new(big.Int).SetString(amount, 10)
+	if !ok || s.uniswapContractService == nil {
+		return defaultPath, nil
+	}
+
+	routePools, err := s.loadRoutePools(chain, deployment)
+	if err != nil || len(routePools) == 0 {
+		return defaultPath, nil
+	}
+
+	route, err := utils.FindBestSwapRoute(routePools, fromToken, toToken, amountIn, utils.DefaultMaxSwapHops)
+	if err != nil {
+		return defaultPath, nil
+	}
+	return route.Path, route
+}
+
+// findExactOutputRoute picks the route needing the lowest input to receive amountOut across the confirmed pools of the deployment.
+// fromToken and toToken must already have ETH replaced by WETH.
+func (s *swapTokensTool) findExactOutputRoute(chain *models.Chain, deployment *models.UniswapDeployment, fromToken, toToken, amountOut string) (*utils.SwapRoute, error) {
+	amount, ok := new(big.Int).SetString(amountOut, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", amountOut)
+	}
+	if s.uniswapContractService == nil {
+		return nil, fmt.Errorf("pool reserves are not available")
+	}
+
+	routePools, err := s.loadRoutePools(chain, deployment)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FindBestSwapRouteExactOutput(routePools, fromToken, toToken, amount, utils.DefaultMaxSwapHops)
+}
+
+// loadRoutePools reads the reserves of the confirmed pools of the deployment, the swap is sent to its router which only
+// trades through the pairs of its factory. Pools whose reserves cannot be read are skipped
+func (s *swapTokensTool) loadRoutePools(chain *models.Chain, deployment *models.UniswapDeployment) ([]utils.RoutePool, error) {
+	pools, err := s.liquidityService.ListConfirmedLiquidityPoolsByDexDeployment(*deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	routePools := make([]utils.RoutePool, 0, len(pools))
+	for _, pool := range pools {
+		reserve0, reserve1, err := s.uniswapContractService.GetReserves(pool.PairAddress, chain)
+		if err != nil {
+			log.Printf("Skipping pool %d for routing: %v", pool.ID, err)
+			continue
+		}
+
+		tokenA, tokenB := pool.Token0, pool.Token1
+		if strings.EqualFold(tokenA, services.EthTokenAddress) {
+			tokenA = deployment.WETHAddress
+		}
+		if strings.EqualFold(tokenB, services.EthTokenAddress) {
+			tokenB = deployment.WETHAddress
+		}
+
+		reserveA, reserveB := utils.SortPairReserves(tokenA, tokenB, reserve0, reserve1)
+
+		routePools = append(routePools, utils.RoutePool{
+			PairAddress: pool.PairAddress,
+			TokenA:      tokenA,
+			TokenB:      tokenB,
+			ReserveA:    reserveA,
+			ReserveB:    reserveB,
+		})
+	}
+	return routePools, nil
+}
+
+// toAnyPath converts a token path to the []any form expected by the ABI encoder
+func toAnyPath(path []string) []any {
+	anyPath := make([]any, 0, len(path))
+	for _, token := range path {
+		anyPath = append(anyPath, token)
+	}
+	return anyPath
+}
+
 // parseSlippage parses the slippage tolerance string and returns a float
 func parseSlippage(slippageStr string) (float64, error) {
 	var slippage float64
@@ -510,13 +926,41 @@ func parseSlippage(slippageStr string) (float64, error) {
 	return slippage, nil
 }
 
-// calculateMinimumAmount calculates the minimum output amount with slippage
-func calculateMinimumAmount(amount string, slippage float64) string {
-	// TODO: This is a simplified implementation. In production, you should:
-	// 1. Call router.getAmountsOut() to get expected output amount
-	// 2. Apply slippage to the expected output amount
-	//
-	// For now, we'll use a very low minimum to avoid swap failures in tests
-	// This is safe for testing but NOT suitable for production
-	return "1" // Set minimum output to 1 wei to avoid swap failures
+// validateSlippageTolerance checks a slippage tolerance that is applied later, when the swap session is created
+func validateSlippageTolerance(slippageStr string) error {
+	if utils.IsAutoSlippage(slippageStr) {
+		return nil
+	}
+	_, err := parseSlippage(slippageStr)
+	return err
+}
+
+// parseMaxPriceImpact parses the max price impact percentage, an empty string returns the default
+func parseMaxPriceImpact(maxPriceImpactStr string) (float64, error) {
+	if maxPriceImpactStr == "" {
+		return utils.DefaultMaxPriceImpact, nil
+	}
+	var maxPriceImpact float64
+	_, err := fmt.Sscanf(maxPriceImpactStr, "%f", &maxPriceImpact)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max price impact format: %w", err)
+	}
+	if maxPriceImpact <= 0 || maxPriceImpact > 100 {
+		return 0, fmt.Errorf("max price impact must be greater than 0 and at most 100")
+	}
+	return maxPriceImpact, nil
+}
+
+// noQuotedMinimumAmountOut is the minimum output of a swap whose output could not be quoted
+const noQuotedMinimumAmountOut = "1"
+
+// formatSwapSlippage describes the slippage tolerance applied to a swap session
+func formatSwapSlippage(swapSession *SwapSession) string {
+	if swapSession.AutoSlippage {
+		return fmt.Sprintf("Slippage tolerance: %.2f%% (auto, derived from the pool depth)", swapSession.SlippageTolerance)
+	}
+	if swapSession.NoQuotedOutput {
+		return fmt.Sprintf("Slippage tolerance: %g%% (not enforced, no pool reserves were readable to quote the output so the minimum output is 1 wei)", swapSession.SlippageTolerance)
+	}
+	return fmt.Sprintf("Slippage tolerance: %g%%", swapSession.SlippageTolerance)
 }
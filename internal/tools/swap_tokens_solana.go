@@ -0,0 +1,170 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// createJupiterSwapTransaction routes a Solana swap through the Jupiter aggregator.
+// The session holds the serialized transaction built by Jupiter, the signing page passes it to the connected Solana wallet.
+func (s *swapTokensTool) createJupiterSwapTransaction(ctx context.Context, args SwapTokensArguments, activeChain *models.Chain) (*mcp.CallToolResult, error) {
+	if activeChain.NetworkID != utils.SolanaMainnetBeta {
+		return mcp.NewToolResultError(fmt.Sprintf("Jupiter swaps are only available on Solana %s, got %s", utils.SolanaMainnetBeta, activeChain.NetworkID)), nil
+	}
+	if !utils.IsValidSolanaAddress(args.UserAddress) {
+		return mcp.NewToolResultError("User address is not a valid Solana address"), nil
+	}
+	for _, mint := range []string{args.FromToken, args.ToToken} {
+		if !utils.IsValidSolanaAddress(mint) {
+			return mcp.NewToolResultError(fmt.Sprintf("Token %s is not a valid Solana mint address (use %s for SOL)", mint, utils.SolanaNativeMint)), nil
+		}
+	}
+	if args.FromToken == args.ToToken {
+		return mcp.NewToolResultError("Cannot swap token to itself"), nil
+	}
+
+	// Jupiter prices and lands the transaction itself, the Uniswap router options do not apply
+	if utils.IsAutoSlippage(args.SlippageTolerance) {
+		return mcp.NewToolResultError("Auto slippage is only supported for Uniswap swaps. Please provide slippage_tolerance as a percentage"), nil
+	}
+	if args.MevProtection {
+		return mcp.NewToolResultError("MEV protection is only supported on Ethereum chains"), nil
+	}
+	if args.RouterTransactionOverrides != (RouterTransactionOverrides{}) {
+		return mcp.NewToolResultError("deadline_seconds, gas_limit, gas_price and nonce are only supported for Uniswap swaps"), nil
+	}
+
+	slippage, err := parseSlippage(args.SlippageTolerance)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid slippage tolerance: %v", err)), nil
+	}
+	maxPriceImpact, err := parseMaxPriceImpact(args.MaxPriceImpact)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid max price impact: %v", err)), nil
+	}
+
+	exactOutput := args.SwapMode == SwapModeExactOutput
+	swapMode := services.JupiterSwapModeExactIn
+	if exactOutput {
+		swapMode = services.JupiterSwapModeExactOut
+	}
+	quote, err := s.jupiterService.GetQuote(ctx, services.JupiterQuoteRequest{
+		InputMint:   args.FromToken,
+		OutputMint:  args.ToToken,
+		Amount:      args.Amount,
+		SlippageBps: int(math.Round(slippage * 100)),
+		SwapMode:    swapMode,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get Jupiter quote: %v", err)), nil
+	}
+
+	// The price impact is only checked when a maximum is given, as for manual slippage on Uniswap
+	priceImpact := quote.PriceImpact()
+	if args.MaxPriceImpact != "" && priceImpact > maxPriceImpact {
+		return mcp.NewToolResultError(fmt.Sprintf("Price impact of %.2f%% exceeds the maximum of %.2f%%. Swap a smaller amount or raise max_price_impact", priceImpact, maxPriceImpact)), nil
+	}
+
+	swap, err := s.jupiterService.GetSwapTransaction(ctx, quote, args.UserAddress)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to build Jupiter swap transaction: %v", err)), nil
+	}
+
+	var route []string
+	for _, step := range quote.RoutePlan {
+		route = append(route, step.SwapInfo.Label)
+	}
+	swapQuote := &models.SwapQuote{
+		Provider:                  "jupiter",
+		InputMint:                 quote.InputMint,
+		OutputMint:                quote.OutputMint,
+		InAmount:                  quote.InAmount,
+		OutAmount:                 quote.OutAmount,
+		OtherAmountThreshold:      quote.OtherAmountThreshold,
+		SwapMode:                  quote.SwapMode,
+		SlippageBps:               quote.SlippageBps,
+		PriceImpact:               priceImpact,
+		Route:                     route,
+		LastValidBlockHeight:      swap.LastValidBlockHeight,
+		PrioritizationFeeLamports: swap.PrioritizationFeeLamports,
+	}
+	swapTx := models.TransactionDeployment{
+		Title:           "Swap via Jupiter",
+		Description:     fmt.Sprintf("Swap %s for %s through %s", args.FromToken, args.ToToken, strings.Join(route, " -> ")),
+		Data:            swap.SwapTransaction,
+		Value:           "0",
+		Receiver:        services.JupiterAggregatorProgram,
+		TransactionType: models.TransactionTypeTokenSwap,
+		SwapQuote:       swapQuote,
+	}
+	if exactOutput {
+		swapTx.MaxSpend = &quote.OtherAmountThreshold
+	}
+
+	metadata := append(args.Metadata,
+		models.TransactionMetadata{Key: "from_token", Value: args.FromToken},
+		models.TransactionMetadata{Key: "to_token", Value: args.ToToken},
+		models.TransactionMetadata{Key: "amount", Value: args.Amount},
+		models.TransactionMetadata{Key: "slippage", Value: args.SlippageTolerance},
+		models.TransactionMetadata{Key: "swap_path", Value: strings.Join(route, ",")},
+		models.TransactionMetadata{Key: "aggregator", Value: "Jupiter"},
+		models.TransactionMetadata{Key: "expected_amount_out", Value: quote.OutAmount},
+		models.TransactionMetadata{Key: "price_impact", Value: fmt.Sprintf("%.2f", priceImpact)},
+	)
+	if exactOutput {
+		metadata = append(metadata,
+			models.TransactionMetadata{Key: "swap_mode", Value: SwapModeExactOutput},
+			models.TransactionMetadata{Key: "expected_amount_in", Value: quote.InAmount},
+			models.TransactionMetadata{Key: "max_amount_in", Value: quote.OtherAmountThreshold},
+		)
+	}
+
+	user, _ := utils.GetAuthenticatedUser(ctx)
+	var userId *string
+	if user != nil {
+		userId = &user.Sub
+	}
+	session := services.CreateTransactionSessionRequest{
+		TransactionDeployments: []models.TransactionDeployment{swapTx},
+		ChainType:              models.TransactionChainTypeSolana,
+		ChainID:                activeChain.ID,
+		Metadata:               metadata,
+		UserID:                 userId,
+	}
+	if args.DryRun {
+		return newDryRunResult("swap_tokens", []services.CreateTransactionSessionRequest{session})
+	}
+	session.Context = ctx
+	sessionID, err := s.txService.CreateTransactionSession(session)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Error creating transaction session: %v", err)), nil
+	}
+
+	url, err := utils.GetTransactionSessionUrl(ctx, s.serverPort, sessionID)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+	}
+	result := &mcp.CallToolResult{
+		Content: []mcp.Content{
+			mcp.NewTextContent(fmt.Sprintf("Swap transaction session created: %s", sessionID)),
+			mcp.NewTextContent(fmt.Sprintf("Swap route (Jupiter): %s", strings.Join(route, " -> "))),
+			mcp.NewTextContent(fmt.Sprintf("Slippage tolerance: %g%%", slippage)),
+			mcp.NewTextContent(fmt.Sprintf("Expected output: %s, price impact: %.2f%%", quote.OutAmount, priceImpact)),
+		},
+	}
+	if exactOutput {
+		result.Content = append(result.Content, mcp.NewTextContent(fmt.Sprintf("Exact output swap, maximum input: %s", quote.OtherAmountThreshold)))
+	}
+	result.Content = append(result.Content,
+		mcp.NewTextContent(fmt.Sprintf("Please sign the swap transaction in the URL with a Solana wallet before block height %d", swap.LastValidBlockHeight)),
+		mcp.NewTextContent(url),
+	)
+	return result, nil
+}
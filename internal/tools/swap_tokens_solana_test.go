@@ -0,0 +1,134 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	solanaTestUser = "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"
+	solanaTestUSDC = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+)
+
+type fakeJupiterService struct {
+	quoteRequest services.JupiterQuoteRequest
+	quote        *services.JupiterQuote
+}
+
+func (f *fakeJupiterService) GetQuote(ctx context.Context, request services.JupiterQuoteRequest) (*services.JupiterQuote, error) {
+	f.quoteRequest = request
+	return f.quote, nil
+}
+
+func (f *fakeJupiterService) GetSwapTransaction(ctx context.Context, quote *services.JupiterQuote, userPublicKey string) (*services.JupiterSwapTransaction, error) {
+	return &services.JupiterSwapTransaction{SwapTransaction: "AQID", LastValidBlockHeight: 279631227, PrioritizationFeeLamports: 5000}, nil
+}
+
+func setupSolanaSwapTest(t *testing.T, networkID string) (*swapTokensTool, *fakeJupiterService, services.TransactionService) {
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	require.NoError(t, chainService.CreateChain(&models.Chain{ChainType: models.TransactionChainTypeSolana, Name: "Solana Mainnet", RPC: "http://127.0.0.1:1", NetworkID: networkID}))
+	require.NoError(t, chainService.SetActiveChain(string(models.TransactionChainTypeSolana)))
+
+	quote := &services.JupiterQuote{
+		InputMint:            utils.SolanaNativeMint,
+		InAmount:             "1000000000",
+		OutputMint:           solanaTestUSDC,
+		OutAmount:            "150000000",
+		OtherAmountThreshold: "149250000",
+		SwapMode:             services.JupiterSwapModeExactIn,
+		SlippageBps:          50,
+		PriceImpactPct:       "0.0012",
+		RoutePlan:            make([]services.JupiterRoutePlanStep, 1),
+	}
+	quote.RoutePlan[0].SwapInfo.Label = "Raydium"
+	jupiter := &fakeJupiterService{quote: quote}
+
+	txService := services.NewTransactionService(db.GetDB())
+	uniswapService := services.NewUniswapService(db.GetDB())
+	tool := NewSwapTokensTool(chainService, services.NewLiquidityService(db.GetDB()), uniswapService, txService, TEST_SERVER_PORT, services.NewEvmService(), services.NewUniswapContractService(uniswapService), jupiter)
+	return tool, jupiter, txService
+}
+
+func solanaSwapArguments(overrides map[string]any) map[string]any {
+	arguments := map[string]any{
+		"from_token":         utils.SolanaNativeMint,
+		"to_token":           solanaTestUSDC,
+		"amount":             "1000000000",
+		"slippage_tolerance": "0.5",
+		"user_address":       solanaTestUser,
+	}
+	for key, value := range overrides {
+		arguments[key] = value
+	}
+	return arguments
+}
+
+func TestSwapTokensSolanaValidation(t *testing.T) {
+	tool, _, _ := setupSolanaSwapTest(t, utils.SolanaMainnetBeta)
+	handler := tool.GetHandler()
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		expected  string
+	}{
+		{"ethereum user", map[string]any{"user_address": "0x1111111111111111111111111111111111111111"}, "not a valid Solana address"},
+		{"invalid mint", map[string]any{"to_token": "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"}, "not a valid Solana mint address"},
+		{"same token", map[string]any{"to_token": utils.SolanaNativeMint}, "Cannot swap token to itself"},
+		{"auto slippage", map[string]any{"slippage_tolerance": "auto"}, "Auto slippage is only supported for Uniswap swaps"},
+		{"mev protection", map[string]any{"mev_protection": true}, "MEV protection is only supported on Ethereum chains"},
+		{"router overrides", map[string]any{"gas_limit": "300000"}, "only supported for Uniswap swaps"},
+		{"price impact", map[string]any{"max_price_impact": "0.1"}, "Price impact of 0.12% exceeds the maximum of 0.10%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTemplatePackTool(t, context.Background(), handler, solanaSwapArguments(tt.arguments))
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+		})
+	}
+
+	devnetTool, _, _ := setupSolanaSwapTest(t, "devnet")
+	result := callTemplatePackTool(t, context.Background(), devnetTool.GetHandler(), solanaSwapArguments(nil))
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "only available on Solana mainnet-beta")
+}
+
+func TestSwapTokensSolanaJupiter(t *testing.T) {
+	tool, jupiter, txService := setupSolanaSwapTest(t, utils.SolanaMainnetBeta)
+
+	result := callTemplatePackTool(t, context.Background(), tool.GetHandler(), solanaSwapArguments(map[string]any{"swap_mode": SwapModeExactOutput}))
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, 50, jupiter.quoteRequest.SlippageBps)
+	assert.Equal(t, services.JupiterSwapModeExactOut, jupiter.quoteRequest.SwapMode)
+
+	sessionID := strings.TrimPrefix(result.Content[0].(mcp.TextContent).Text, "Swap transaction session created: ")
+	session, err := txService.GetTransactionSession(sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TransactionChainTypeSolana, session.TransactionChainType)
+	require.Len(t, session.TransactionDeployments, 1)
+
+	swap := session.TransactionDeployments[0]
+	assert.Equal(t, "AQID", swap.Data)
+	assert.Equal(t, services.JupiterAggregatorProgram, swap.Receiver)
+	assert.Equal(t, models.TransactionTypeTokenSwap, swap.TransactionType)
+	require.NotNil(t, swap.MaxSpend)
+	assert.Equal(t, "149250000", *swap.MaxSpend)
+	require.NotNil(t, swap.SwapQuote)
+	assert.Equal(t, "jupiter", swap.SwapQuote.Provider)
+	assert.Equal(t, []string{"Raydium"}, swap.SwapQuote.Route)
+	assert.Equal(t, uint64(279631227), swap.SwapQuote.LastValidBlockHeight)
+	assert.InDelta(t, 0.12, swap.SwapQuote.PriceImpact, 1e-9)
+}
@@ -17,10 +17,12 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/constants"
 	"github.com/rxtech-lab/launchpad-mcp/internal/contracts"
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
 	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -95,6 +97,8 @@ func (suite *SwapTokensTestSuite) SetupSuite() {
 		suite.txService,
 		SWAP_TEST_SERVER_PORT,
 		suite.evmService,
+		services.NewUniswapContractService(suite.uniswapService),
+		services.NewJupiterService(nil, ""),
 	)
 
 	// Setup test data
@@ -706,6 +710,11 @@ func (suite *SwapTokensTestSuite) TestSwapETHForTokens() {
 	}
 	sessionID := strings.TrimPrefix(sessionIDContent, "Swap transaction session created: ")
 
+	// The manual tolerance is applied to the output quoted from the pool reserves
+	slippageContent, ok := result.Content[2].(mcp.TextContent)
+	suite.Require().True(ok)
+	suite.Equal("Slippage tolerance: 1%", slippageContent.Text)
+
 	// Get transaction session and deployments
 	session, err := suite.txService.GetTransactionSession(sessionID)
 	suite.NoError(err)
@@ -889,6 +898,177 @@ func (suite *SwapTokensTestSuite) TestSwapTokensForTokens() {
 	suite.T().Logf("  Token2 received: %s", new(big.Int).Sub(finalToken2Balance, initialToken2Balance).String())
 }
 
+func (suite *SwapTokensTestSuite) TestSwapETHForTokensWithAutoSlippage() {
+	swapAmount := big.NewInt(0).Mul(big.NewInt(1), big.NewInt(1e17)) // 0.1 ETH
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"from_token":         services.EthTokenAddress,
+				"to_token":           suite.testToken.Address.Hex(),
+				"amount":             swapAmount.String(),
+				"slippage_tolerance": "auto",
+				"user_address":       suite.testAddress.Hex(),
+			},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.Require().False(result.IsError)
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	suite.Require().True(ok)
+	sessionID := strings.TrimPrefix(textContent.Text, "Swap transaction session created: ")
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+
+	metadata := map[string]string{}
+	for _, entry := range session.Metadata {
+		metadata[entry.Key] = entry.Value
+	}
+	suite.Contains(metadata["slippage"], "(auto)")
+	suite.NotEmpty(metadata["price_impact"])
+
+	// The minimum output is derived from the expected output instead of the 1 wei placeholder
+	deployment := session.TransactionDeployments[0]
+	txReceipt, err := suite.executeTransaction(deployment.Data, deployment.Value, deployment.Receiver)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), txReceipt.Status, "Swap transaction should succeed")
+}
+
+func (suite *SwapTokensTestSuite) TestSwapETHForExactTokens() {
+	var initialBalance *big.Int
+	err := suite.testToken.BoundContract.Call(nil, &[]interface{}{&initialBalance}, "balanceOf", suite.testAddress)
+	suite.Require().NoError(err)
+
+	amountOut := big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)) // exactly 10 tokens
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"from_token":         services.EthTokenAddress,
+				"to_token":           suite.testToken.Address.Hex(),
+				"amount":             amountOut.String(),
+				"swap_mode":          SwapModeExactOutput,
+				"slippage_tolerance": "1",
+				"user_address":       suite.testAddress.Hex(),
+			},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.Require().False(result.IsError, result.Content)
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	suite.Require().True(ok)
+	sessionID := strings.TrimPrefix(textContent.Text, "Swap transaction session created: ")
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Require().Len(session.TransactionDeployments, 1)
+
+	// The ETH sent is the maximum input, the router refunds what is not spent
+	deployment := session.TransactionDeployments[0]
+	suite.Require().NotNil(deployment.MaxSpend)
+	suite.Equal(*deployment.MaxSpend, deployment.Value)
+	suite.Contains(session.Metadata, models.TransactionMetadata{Key: "max_amount_in", Value: deployment.Value})
+
+	txReceipt, err := suite.executeTransaction(deployment.Data, deployment.Value, deployment.Receiver)
+	suite.Require().NoError(err)
+	suite.Equal(uint64(1), txReceipt.Status, "Swap transaction should succeed")
+
+	var finalBalance *big.Int
+	err = suite.testToken.BoundContract.Call(nil, &[]interface{}{&finalBalance}, "balanceOf", suite.testAddress)
+	suite.Require().NoError(err)
+	suite.Equal(amountOut, new(big.Int).Sub(finalBalance, initialBalance))
+}
+
+func (suite *SwapTokensTestSuite) TestSwapTokensForExactETHApprovesMaximumInput() {
+	amountOut := big.NewInt(0).Mul(big.NewInt(1), big.NewInt(1e16)) // exactly 0.01 ETH
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"from_token":         suite.testToken.Address.Hex(),
+				"to_token":           services.EthTokenAddress,
+				"amount":             amountOut.String(),
+				"swap_mode":          SwapModeExactOutput,
+				"slippage_tolerance": "auto",
+				"user_address":       suite.testAddress.Hex(),
+			},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.Require().False(result.IsError, result.Content)
+
+	textContent, ok := result.Content[0].(mcp.TextContent)
+	suite.Require().True(ok)
+	sessionID := strings.TrimPrefix(textContent.Text, "Swap transaction session created: ")
+
+	session, err := suite.txService.GetTransactionSession(sessionID)
+	suite.Require().NoError(err)
+	suite.Require().Len(session.TransactionDeployments, 2)
+
+	// The approval covers the maximum input instead of an unlimited allowance
+	swapTx := session.TransactionDeployments[1]
+	suite.Require().NotNil(swapTx.MaxSpend)
+	suite.Require().NotNil(session.TransactionDeployments[0].RawContractArguments)
+	suite.Contains(*session.TransactionDeployments[0].RawContractArguments, *swapTx.MaxSpend)
+	suite.NotContains(*session.TransactionDeployments[0].RawContractArguments, constants.MaxUint256.String())
+
+	for i, deployment := range session.TransactionDeployments {
+		txReceipt, err := suite.executeTransaction(deployment.Data, deployment.Value, deployment.Receiver)
+		suite.Require().NoError(err)
+		suite.Equal(uint64(1), txReceipt.Status, "Transaction %d should succeed", i+1)
+	}
+}
+
+func (suite *SwapTokensTestSuite) TestExactOutputRequiresPoolRoute() {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"from_token":         services.EthTokenAddress,
+				"to_token":           "0x1234567890123456789012345678901234567890",
+				"amount":             "1000",
+				"swap_mode":          SwapModeExactOutput,
+				"slippage_tolerance": "1",
+				"user_address":       suite.testAddress.Hex(),
+			},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "Exact output swaps require a confirmed pool route")
+	}
+}
+
+func (suite *SwapTokensTestSuite) TestAutoSlippageRejectsHighPriceImpact() {
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"from_token":         services.EthTokenAddress,
+				"to_token":           suite.testToken.Address.Hex(),
+				"amount":             "1000000000000000000",
+				"slippage_tolerance": "auto",
+				"max_price_impact":   "0.0001",
+				"user_address":       suite.testAddress.Hex(),
+			},
+		},
+	}
+
+	result, err := suite.tool.GetHandler()(context.Background(), request)
+	suite.NoError(err)
+	suite.True(result.IsError)
+	if textContent, ok := result.Content[0].(mcp.TextContent); ok {
+		suite.Contains(textContent.Text, "Price impact")
+	}
+}
+
 func (suite *SwapTokensTestSuite) TestInvalidSwapParameters() {
 	// Test invalid slippage
 	request := mcp.CallToolRequest{
@@ -959,3 +1139,9 @@ func TestSwapTokensTestSuite(t *testing.T) {
 
 	suite.Run(t, new(SwapTokensTestSuite))
 }
+
+func TestFormatSwapSlippage(t *testing.T) {
+	assert.Equal(t, "Slippage tolerance: 0.5%", formatSwapSlippage(&SwapSession{SlippageTolerance: 0.5}))
+	assert.Equal(t, "Slippage tolerance: 1.25% (auto, derived from the pool depth)", formatSwapSlippage(&SwapSession{SlippageTolerance: 1.25, AutoSlippage: true}))
+	assert.Contains(t, formatSwapSlippage(&SwapSession{SlippageTolerance: 0.5, NoQuotedOutput: true}), "not enforced")
+}
@@ -0,0 +1,161 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+type templateLeaderboardTool struct {
+	templateService   services.TemplateService
+	deploymentService services.DeploymentService
+	liquidityService  services.LiquidityService
+	uniswapService    services.UniswapService
+}
+
+type TemplateLeaderboardArguments struct {
+	// Optional fields
+	ChainType string `json:"chain_type,omitempty" validate:"omitempty,oneof=ethereum solana"`
+	Limit     string `json:"limit,omitempty"`
+}
+
+func NewTemplateLeaderboardTool(templateService services.TemplateService, deploymentService services.DeploymentService, liquidityService services.LiquidityService, uniswapService services.UniswapService) *templateLeaderboardTool {
+	return &templateLeaderboardTool{
+		templateService:   templateService,
+		deploymentService: deploymentService,
+		liquidityService:  liquidityService,
+		uniswapService:    uniswapService,
+	}
+}
+
+func (t *templateLeaderboardTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("template_leaderboard",
+		mcp.WithDescription(fmt.Sprintf("Rank templates by their launch history to pick proven templates over untested ones. For every template returns the launches, success rate, average deployment gas used and cost, the pools of its tokens with their average price change since the pool was created, and the simulated sell test results. Templates with at least %d confirmed launches and no failed sell test are proven and ranked first.", utils.MinProvenLaunches)),
+		mcp.WithString("chain_type",
+			mcp.Description("Only rank templates of this chain type. Optional"),
+			mcp.Enum(string(models.TransactionChainTypeEthereum), string(models.TransactionChainTypeSolana)),
+		),
+		mcp.WithString("limit",
+			mcp.Description("Maximum number of templates to return. Optional, defaults to 10"),
+		),
+	)
+
+	return tool
+}
+
+func (t *templateLeaderboardTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args TemplateLeaderboardArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		limit := 10
+		if args.Limit != "" {
+			parsed, err := strconv.Atoi(args.Limit)
+			if err != nil || parsed <= 0 {
+				return mcp.NewToolResultError("Invalid limit: must be a positive number"), nil
+			}
+			limit = parsed
+		}
+
+		templates, err := t.templateService.ListTemplates(nil, args.ChainType, "", 0)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list templates: %v", err)), nil
+		}
+
+		deployments, err := t.deploymentService.ListDeployments()
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to list deployments: %v", err)), nil
+		}
+
+		pools, err := t.launchPools(deployments)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to load pools: %v", err)), nil
+		}
+
+		leaderboard := utils.BuildTemplateLeaderboard(templates, deployments, pools)
+		if len(leaderboard) > limit {
+			leaderboard = leaderboard[:limit]
+		}
+
+		leaderboardJSON, _ := json.Marshal(leaderboard)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Template leaderboard of %d templates: ", len(leaderboard))),
+				mcp.NewTextContent(string(leaderboardJSON)),
+			},
+		}, nil
+	}
+}
+
+// launchPools returns the confirmed pools of the confirmed deployments together with their snapshots
+func (t *templateLeaderboardTool) launchPools(deployments []models.Deployment) ([]utils.TemplateLaunchPool, error) {
+	// A negative limit lists every pool
+	pools, err := t.liquidityService.ListLiquidityPools(0, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	wethAddresses := map[uint]string{}
+	var launchPools []utils.TemplateLaunchPool
+	for _, deployment := range deployments {
+		if deployment.Status != models.TransactionStatusConfirmed || deployment.ContractAddress == "" {
+			continue
+		}
+
+		for _, pool := range pools {
+			if pool.Status != models.TransactionStatusConfirmed {
+				continue
+			}
+
+			pairedToken := ""
+			switch {
+			case strings.EqualFold(pool.Token0, deployment.ContractAddress):
+				pairedToken = pool.Token1
+			case strings.EqualFold(pool.Token1, deployment.ContractAddress):
+				pairedToken = pool.Token0
+			default:
+				continue
+			}
+
+			// ETH pairs are created against WETH
+			if pairedToken == services.EthTokenAddress {
+				weth, ok := wethAddresses[deployment.ChainID]
+				if !ok {
+					if uniswapDeployment, err := t.uniswapService.GetUniswapDeploymentByChain(deployment.ChainID); err == nil {
+						weth = uniswapDeployment.WETHAddress
+					}
+					wethAddresses[deployment.ChainID] = weth
+				}
+				pairedToken = weth
+			}
+
+			// The price change is measured from the first snapshot, recorded when the pool was created
+			snapshots, err := t.liquidityService.ListPoolSnapshots(pool.ID, -1)
+			if err != nil {
+				return nil, err
+			}
+
+			launchPools = append(launchPools, utils.TemplateLaunchPool{
+				TemplateID:    deployment.TemplateID,
+				TokenIsToken0: strings.ToLower(deployment.ContractAddress) < strings.ToLower(pairedToken),
+				Snapshots:     snapshots,
+			})
+		}
+	}
+	return launchPools, nil
+}
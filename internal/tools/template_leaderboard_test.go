@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type TemplateLeaderboardToolTestSuite struct {
+	suite.Suite
+	db   services.DBService
+	tool *templateLeaderboardTool
+}
+
+func (suite *TemplateLeaderboardToolTestSuite) SetupSuite() {
+	db, err := services.NewSqliteDBService(":memory:")
+	suite.Require().NoError(err)
+	suite.db = db
+
+	chain := &models.Chain{Name: "Local", RPC: "http://localhost:8545", NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}
+	suite.Require().NoError(services.NewChainService(db.GetDB()).CreateChain(chain))
+	suite.Require().NoError(db.GetDB().Create(&models.UniswapDeployment{Version: "v2", RouterAddress: allowanceRouter, WETHAddress: allowanceWETH, ChainID: chain.ID}).Error)
+
+	templateService := services.NewTemplateService(db.GetDB())
+	proven := &models.Template{Name: "Proven Token", ChainType: models.TransactionChainTypeEthereum}
+	suite.Require().NoError(templateService.CreateTemplate(proven))
+	untested := &models.Template{Name: "New Token", ChainType: models.TransactionChainTypeEthereum}
+	suite.Require().NoError(templateService.CreateTemplate(untested))
+	solana := &models.Template{Name: "Solana Token", ChainType: models.TransactionChainTypeSolana}
+	suite.Require().NoError(templateService.CreateTemplate(solana))
+
+	deploymentService := services.NewDeploymentService(db.GetDB())
+	for _, address := range []string{allowanceToken, "0x5555555555555555555555555555555555555555", "0x6666666666666666666666666666666666666666"} {
+		suite.Require().NoError(deploymentService.CreateDeployment(&models.Deployment{
+			ChainID:         chain.ID,
+			TemplateID:      proven.ID,
+			Status:          models.TransactionStatusConfirmed,
+			ContractAddress: address,
+			GasUsed:         1_200_000,
+			GasCost:         "2400000000000000",
+		}))
+	}
+
+	// The token sorts before WETH, so the snapshot price is the token price in WETH
+	liquidityService := services.NewLiquidityService(db.GetDB())
+	pool := &models.LiquidityPool{TokenAddress: allowanceToken, PairAddress: "0x7777777777777777777777777777777777777777", Token0: allowanceToken, Token1: services.EthTokenAddress, Status: models.TransactionStatusConfirmed}
+	_, err = liquidityService.CreateLiquidityPool(pool)
+	suite.Require().NoError(err)
+	createdAt := time.Now().Add(-time.Hour)
+	for i, price := range []float64{0.001, 0.0015} {
+		suite.Require().NoError(liquidityService.CreatePoolSnapshot(&models.PoolSnapshot{PoolID: pool.ID, Reserve0: "1", Reserve1: "1", Price: price, CreatedAt: createdAt.Add(time.Duration(i) * time.Minute)}))
+	}
+
+	suite.tool = NewTemplateLeaderboardTool(templateService, deploymentService, liquidityService, services.NewUniswapService(db.GetDB()))
+}
+
+func (suite *TemplateLeaderboardToolTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *TemplateLeaderboardToolTestSuite) leaderboard(arguments map[string]interface{}) []utils.TemplateLeaderboardEntry {
+	result, err := suite.tool.GetHandler()(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{Arguments: arguments},
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(result.IsError, result.Content)
+	suite.Require().Len(result.Content, 2)
+
+	var entries []utils.TemplateLeaderboardEntry
+	textContent, _ := result.Content[1].(mcp.TextContent)
+	suite.Require().NoError(json.Unmarshal([]byte(textContent.Text), &entries))
+	return entries
+}
+
+func (suite *TemplateLeaderboardToolTestSuite) TestRanksProvenTemplatesFirst() {
+	entries := suite.leaderboard(map[string]interface{}{"chain_type": "ethereum"})
+	suite.Require().Len(entries, 2)
+
+	suite.Equal("Proven Token", entries[0].TemplateName)
+	suite.Equal(utils.TemplateUsageProven, entries[0].Usage)
+	suite.Equal(3, entries[0].ConfirmedLaunches)
+	suite.Equal(uint64(1_200_000), entries[0].AverageGasUsed)
+	suite.Equal("2400000000000000", entries[0].AverageGasCost)
+	suite.Equal(1, entries[0].Pools)
+	suite.Require().NotNil(entries[0].AveragePriceChangePercent)
+	suite.InDelta(50, *entries[0].AveragePriceChangePercent, 1e-9)
+
+	suite.Equal("New Token", entries[1].TemplateName)
+	suite.Equal(utils.TemplateUsageUntested, entries[1].Usage)
+}
+
+func (suite *TemplateLeaderboardToolTestSuite) TestLimit() {
+	entries := suite.leaderboard(map[string]interface{}{"limit": "1"})
+	suite.Require().Len(entries, 1)
+	suite.Equal("Proven Token", entries[0].TemplateName)
+}
+
+func TestTemplateLeaderboardToolTestSuite(t *testing.T) {
+	suite.Run(t, new(TemplateLeaderboardToolTestSuite))
+}
@@ -0,0 +1,175 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/anvil"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// defaultTemplateFuzzRuns is the number of random transfers of a test_template run without fuzz_runs
+const defaultTemplateFuzzRuns = 20
+
+type testTemplateTool struct {
+	templateService services.TemplateService
+	evmService      services.EvmService
+	anvilPath       string
+}
+
+type TestTemplateArguments struct {
+	// Required fields
+	TemplateID string `json:"template_id" validate:"required"`
+
+	// Optional fields
+	ContractName    string         `json:"contract_name,omitempty"`
+	TemplateValues  map[string]any `json:"template_values,omitempty"`
+	ConstructorArgs []any          `json:"constructor_args,omitempty"`
+	FuzzRuns        *int           `json:"fuzz_runs,omitempty" validate:"omitempty,min=0"`
+	Seed            *int64         `json:"seed,omitempty"`
+}
+
+func NewTestTemplateTool(templateService services.TemplateService, evmService services.EvmService, anvilPath string) *testTemplateTool {
+	return &testTemplateTool{
+		templateService: templateService,
+		evmService:      evmService,
+		anvilPath:       anvilPath,
+	}
+}
+
+func (t *testTemplateTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("test_template",
+		mcp.WithDescription(fmt.Sprintf("Test a rendered template before launching it. The contract is deployed to a throwaway Anvil node and checked by a built-in ERC20 suite: ownership, only the owner can mint, transfers move exactly the amount, transfers beyond the balance revert, approve and transferFrom spend the allowance, and random transfers (fuzz runs) conserve the balances and the total supply. Returns a pass/fail report of every check, checks of functions the contract does not have are skipped. Needs Anvil of Foundry, set %s when it is not on the PATH.", anvil.EnvPath)),
+		mcp.WithString("template_id",
+			mcp.Required(),
+			mcp.Description("ID of the template to test"),
+		),
+		mcp.WithString("contract_name",
+			mcp.Description("Name of the contract to deploy. Optional, defaults to the contract of the template gas report"),
+		),
+		mcp.WithObject("template_values",
+			mcp.Description("JSON object with runtime values for template parameters. Optional, defaults to the sample values of the template"),
+		),
+		mcp.WithArray("constructor_args",
+			mcp.Description("JSON array of constructor arguments for contract deployment. Optional. Please provide this if the template requires constructor arguments."),
+			mcp.Items(map[string]interface{}{
+				"type":        "any",
+				"description": "Constructor argument, provide the final value (e.g., for uint256 value of 1 ETH, provide 1000000000000000000)",
+			}),
+		),
+		mcp.WithNumber("fuzz_runs",
+			mcp.Description(fmt.Sprintf("Number of random transfers checking the supply invariants. Optional, defaults to %d, at most %d", defaultTemplateFuzzRuns, utils.TemplateSuiteMaxFuzzRuns)),
+		),
+		mcp.WithNumber("seed",
+			mcp.Description("Seed of the random transfers, pass the seed of a failed report to replay it. Optional, defaults to a random seed"),
+		),
+	)
+
+	return tool
+}
+
+func (t *testTemplateTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args TestTemplateArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		fuzzRuns := defaultTemplateFuzzRuns
+		if args.FuzzRuns != nil {
+			fuzzRuns = min(*args.FuzzRuns, utils.TemplateSuiteMaxFuzzRuns)
+		}
+		seed := time.Now().UnixNano()
+		if args.Seed != nil {
+			seed = *args.Seed
+		}
+
+		templateID, err := strconv.ParseUint(args.TemplateID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid template_id: %v", err)), nil
+		}
+		template, err := t.templateService.GetTemplateByID(uint(templateID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Template not found: %v", err)), nil
+		}
+		if template.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("Only ethereum templates can be tested, template %d is a %s template", template.ID, template.ChainType)), nil
+		}
+
+		contractName := args.ContractName
+		if contractName == "" && template.Report != nil {
+			contractName = template.Report.ContractName
+		}
+		if contractName == "" {
+			return mcp.NewToolResultError("contract_name is required, the template has no gas report naming its contract"), nil
+		}
+		templateValues := models.JSON(args.TemplateValues)
+		if templateValues == nil {
+			templateValues = template.SampleTemplateValues
+		}
+
+		renderedContract, err := utils.RenderContractTemplate(template.TemplateCode, templateValues)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to render contract template: %v", err)), nil
+		}
+		renderedFiles, err := utils.RenderTemplateFiles(template.Files, templateValues)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to render contract template files: %v", err)), nil
+		}
+		tx, contractAbi, err := t.evmService.GetContractDeploymentTransactionWithContractCode(services.ContractDeploymentWithContractCodeTransactionArgs{
+			ContractCode:        renderedContract,
+			ContractFiles:       renderedFiles,
+			OpenZeppelinVersion: template.OpenZeppelinVersion,
+			ContractName:        contractName,
+			ConstructorArgs:     args.ConstructorArgs,
+			Title:               "Test Contract",
+			Description:         "Deploy the contract to the test node",
+			TransactionType:     models.TransactionTypeTokenDeployment,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to build the deployment: %v", err)), nil
+		}
+
+		node, err := anvil.Start(ctx, t.anvilPath, "")
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to start the test node: %v", err)), nil
+		}
+		defer node.Stop()
+
+		report, err := utils.RunTemplateSuite(ctx, utils.TemplateSuiteRequest{
+			RPC:         node.RPC,
+			DeployData:  tx.Data,
+			ABI:         contractAbi,
+			PrivateKeys: anvil.PrivateKeys,
+			FuzzRuns:    fuzzRuns,
+			Seed:        seed,
+		})
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to run the test suite: %v", err)), nil
+		}
+
+		message := fmt.Sprintf("Template %s passed the test suite", template.Name)
+		if !report.Passed {
+			message = fmt.Sprintf("Template %s failed the test suite, fix the failed checks before launching it", template.Name)
+		}
+		resultJSON, _ := json.Marshal(report)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(message + ": "),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestTemplateRejectsInvalidTemplates(t *testing.T) {
+	templateService, _ := setupTemplatePackTest(t)
+	solanaTemplate := &models.Template{
+		Name:         "Solana Token",
+		ChainType:    models.TransactionChainTypeSolana,
+		TemplateCode: "pub fn main() {}",
+	}
+	require.NoError(t, templateService.CreateTemplate(solanaTemplate))
+
+	handler := NewTestTemplateTool(templateService, services.NewEvmService(), "anvil").GetHandler()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		expected  string
+	}{
+		{"missing template", map[string]any{}, "Invalid arguments"},
+		{"negative fuzz runs", map[string]any{"template_id": "1", "fuzz_runs": -1}, "Invalid arguments"},
+		{"invalid template id", map[string]any{"template_id": "token"}, "Invalid template_id"},
+		{"unknown template", map[string]any{"template_id": "99"}, "Template not found"},
+		{"solana template", map[string]any{"template_id": "2"}, "Only ethereum templates can be tested"},
+		{"missing contract name", map[string]any{"template_id": "1"}, "contract_name is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callTemplatePackTool(t, ctx, handler, tt.arguments)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+		})
+	}
+}
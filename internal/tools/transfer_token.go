@@ -0,0 +1,246 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+// erc20TransferAbi holds the ERC-20 functions transfer_token builds transactions for
+const erc20TransferAbi = `[{"constant":false,"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"type":"function"},` +
+	`{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"type":"function"}]`
+
+const (
+	transferTokenActionTransfer = "transfer"
+	transferTokenActionApprove  = "approve"
+)
+
+type transferTokenTool struct {
+	chainService services.ChainService
+	evmService   services.EvmService
+	txService    services.TransactionService
+	serverPort   int
+}
+
+type TransferTokenArguments struct {
+	// Required fields
+	FromAddress string `json:"from_address" validate:"required,eth_addr"`
+	To          string `json:"to" validate:"required,eth_addr"`
+	Amount      string `json:"amount" validate:"required,numeric"`
+
+	// Optional fields
+	Action       string `json:"action,omitempty" validate:"omitempty,oneof=transfer approve"`
+	TokenAddress string `json:"token_address,omitempty" validate:"omitempty,eth_addr"`
+	Memo         string `json:"memo,omitempty" validate:"omitempty,max=256"`
+}
+
+func NewTransferTokenTool(chainService services.ChainService, evmService services.EvmService, txService services.TransactionService, serverPort int) *transferTokenTool {
+	return &transferTokenTool{
+		chainService: chainService,
+		evmService:   evmService,
+		txService:    txService,
+		serverPort:   serverPort,
+	}
+}
+
+func (t *transferTokenTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("transfer_token",
+		mcp.WithDescription("Send ETH or an ERC-20 token, or approve a spender of an ERC-20 token, on the active Ethereum chain. "+
+			"Transfers are refused when the balance of from_address does not cover the amount. Creates a transaction session with signing URL."),
+		mcp.WithString("from_address",
+			mcp.Required(),
+			mcp.Description("Address that signs the transaction, its balance is checked before a transfer"),
+		),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("Recipient of the transfer, or spender of the approval"),
+		),
+		mcp.WithString("amount",
+			mcp.Required(),
+			mcp.Description("Amount in the smallest unit of the token (e.g., wei for ETH and 18 decimal tokens)"),
+		),
+		mcp.WithString("action",
+			mcp.Description("transfer or approve. Optional, defaults to transfer"),
+			mcp.Enum(transferTokenActionTransfer, transferTokenActionApprove),
+		),
+		mcp.WithString("token_address",
+			mcp.Description("ERC-20 token to transfer or approve. Optional, ETH is sent without it"),
+		),
+		mcp.WithString("memo",
+			mcp.Description("Note stored with the transaction session, not sent on chain. Optional"),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (t *transferTokenTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args TransferTokenArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+		if args.Action == "" {
+			args.Action = transferTokenActionTransfer
+		}
+		if args.Action == transferTokenActionApprove && args.TokenAddress == "" {
+			return mcp.NewToolResultError("token_address is required to approve a spender, ETH has no allowances"), nil
+		}
+		amount, ok := new(big.Int).SetString(args.Amount, 10)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid amount: %s", args.Amount)), nil
+		}
+		if args.Action == transferTokenActionTransfer && amount.Sign() == 0 {
+			return mcp.NewToolResultError("Invalid amount: a transfer must send more than 0"), nil
+		}
+
+		activeChain, err := t.chainService.ResolveChain(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("No active chain selected. Please use select_chain tool first"), nil
+		}
+		if activeChain.ChainType != models.TransactionChainTypeEthereum {
+			return mcp.NewToolResultError(fmt.Sprintf("transfer_token only supports Ethereum chains, got %s", activeChain.ChainType)), nil
+		}
+
+		from := common.HexToAddress(args.FromAddress).Hex()
+		to := common.HexToAddress(args.To).Hex()
+		if args.Action == transferTokenActionTransfer {
+			if err := t.checkBalance(activeChain.RPC, from, args.TokenAddress, amount); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+		}
+
+		tx, err := t.buildTransaction(args, from, to)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create %s transaction: %v", args.Action, err)), nil
+		}
+
+		metadata := []models.TransactionMetadata{
+			{Key: "action", Value: args.Action},
+			{Key: "from_address", Value: from},
+			{Key: "to", Value: to},
+			{Key: "amount", Value: amount.String()},
+		}
+		if args.TokenAddress != "" {
+			metadata = append(metadata, models.TransactionMetadata{Key: "token_address", Value: common.HexToAddress(args.TokenAddress).Hex()})
+		}
+		if args.Memo != "" {
+			metadata = append(metadata, models.TransactionMetadata{Key: "memo", Value: args.Memo})
+		}
+
+		user, _ := utils.GetAuthenticatedUser(ctx)
+		var userId *string
+		if user != nil {
+			userId = &user.Sub
+		}
+
+		session := services.CreateTransactionSessionRequest{
+			TransactionDeployments: []models.TransactionDeployment{tx},
+			ChainType:              models.TransactionChainTypeEthereum,
+			ChainID:                activeChain.ID,
+			Metadata:               metadata,
+			UserID:                 userId,
+		}
+		session.Context = ctx
+		sessionID, err := t.txService.CreateTransactionSession(session)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to create transaction session: %v", err)), nil
+		}
+
+		url, err := utils.GetTransactionSessionUrl(ctx, t.serverPort, sessionID)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("%s session created: %s", tx.Title, sessionID)),
+				mcp.NewTextContent(tx.Description),
+				mcp.NewTextContent("Please sign the transaction in the URL:"),
+				mcp.NewTextContent(url),
+			},
+		}, nil
+	}
+}
+
+// checkBalance refuses a transfer the balance of the sender does not cover, the gas of an ETH transfer is left to
+// the wallet
+func (t *transferTokenTool) checkBalance(rpcURL string, from string, tokenAddress string, amount *big.Int) error {
+	if tokenAddress == "" {
+		balance, err := utils.QueryNativeBalance(rpcURL, from, string(models.TransactionChainTypeEthereum))
+		if err != nil {
+			return fmt.Errorf("Failed to get the balance of %s: %v", from, err)
+		}
+		native, _ := new(big.Int).SetString(balance.NativeBalance, 10)
+		if native.Cmp(amount) < 0 {
+			return fmt.Errorf("Insufficient balance: %s has %s wei, the transfer sends %s wei", from, balance.NativeBalance, amount)
+		}
+		return nil
+	}
+
+	balance, err := utils.QueryERC20Balance(rpcURL, tokenAddress, from)
+	if err != nil {
+		return fmt.Errorf("Failed to get the %s balance of %s: %v", tokenAddress, from, err)
+	}
+	tokenBalance, _ := new(big.Int).SetString(balance.TokenBalance, 10)
+	if tokenBalance.Cmp(amount) < 0 {
+		return fmt.Errorf("Insufficient balance: %s has %s %s (%s), the transfer sends %s", from, balance.TokenBalance, balance.TokenSymbol, balance.FormattedBalance, amount)
+	}
+	return nil
+}
+
+func (t *transferTokenTool) buildTransaction(args TransferTokenArguments, from string, to string) (models.TransactionDeployment, error) {
+	if args.TokenAddress == "" {
+		return models.TransactionDeployment{
+			Title:           "Send ETH",
+			Description:     fmt.Sprintf("Send %s wei from %s to %s", args.Amount, from, to),
+			Data:            "0x",
+			Value:           args.Amount,
+			Receiver:        to,
+			TransactionType: models.TransactionTypeRegular,
+		}, nil
+	}
+
+	token := common.HexToAddress(args.TokenAddress).Hex()
+	functionArgs := []any{to, args.Amount}
+	title := "Transfer token"
+	description := fmt.Sprintf("Transfer %s of token %s from %s to %s", args.Amount, token, from, to)
+	if args.Action == transferTokenActionApprove {
+		title = "Approve token"
+		description = fmt.Sprintf("Allow %s to spend %s of token %s owned by %s", to, args.Amount, token, from)
+	}
+
+	tx, err := t.evmService.GetContractFunctionCallTransaction(services.GetContractFunctionCallTransactionArgs{
+		ContractAddress: token,
+		FunctionName:    args.Action,
+		FunctionArgs:    functionArgs,
+		Abi:             erc20TransferAbi,
+		Value:           "0",
+		Title:           title,
+		Description:     description,
+		TransactionType: models.TransactionTypeRegular,
+	})
+	if err != nil {
+		return models.TransactionDeployment{}, err
+	}
+	rawArgs, err := utils.EncodeFunctionArgsToStringMapWithStringABI(args.Action, functionArgs, erc20TransferAbi)
+	if err != nil {
+		return models.TransactionDeployment{}, fmt.Errorf("failed to marshal raw contract arguments: %w", err)
+	}
+	tx.RawContractArguments = &rawArgs
+	tx.ContractAddress = &token
+	return tx, nil
+}
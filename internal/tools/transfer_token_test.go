@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferToken(t *testing.T) {
+	// The sender holds 1000 wei of ETH and 500 units of the token
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []any           `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		var result any = "0x0"
+		switch request.Method {
+		case "eth_getBalance":
+			result = "0x3e8"
+		case "eth_call":
+			data := request.Params[0].(map[string]any)["data"].(string)
+			switch {
+			case strings.HasPrefix(data, "0x70a08231"):
+				result = "0x00000000000000000000000000000000000000000000000000000000000001f4"
+			case data == "0x313ce567":
+				result = "0x0000000000000000000000000000000000000000000000000000000000000012"
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+	t.Cleanup(rpc.Close)
+
+	db, err := services.NewSqliteDBService(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	chainService := services.NewChainService(db.GetDB())
+	require.NoError(t, chainService.CreateChain(&models.Chain{Name: "Local", RPC: rpc.URL, NetworkID: "31337", ChainType: models.TransactionChainTypeEthereum, IsActive: true}))
+	txService := services.NewTransactionService(db.GetDB())
+
+	handler := NewTransferTokenTool(chainService, services.NewEvmService(), txService, 9999).GetHandler()
+	call := func(args map[string]any) *mcp.CallToolResult {
+		args["from_address"] = "0x1111111111111111111111111111111111111111"
+		args["to"] = "0x2222222222222222222222222222222222222222"
+		result, err := handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		return result
+	}
+	session := func(result *mcp.CallToolResult) *models.TransactionSession {
+		require.False(t, result.IsError, result.Content)
+		_, sessionID, _ := strings.Cut(result.Content[0].(mcp.TextContent).Text, "session created: ")
+		session, err := txService.GetTransactionSession(sessionID)
+		require.NoError(t, err)
+		require.Len(t, session.TransactionDeployments, 1)
+		return session
+	}
+
+	t.Run("sends ETH with a memo", func(t *testing.T) {
+		s := session(call(map[string]any{"amount": "1000", "memo": "Team payout"}))
+		tx := s.TransactionDeployments[0]
+		assert.Equal(t, "0x2222222222222222222222222222222222222222", tx.Receiver)
+		assert.Equal(t, "1000", tx.Value)
+		assert.Contains(t, s.Metadata, models.TransactionMetadata{Key: "memo", Value: "Team payout"})
+	})
+
+	t.Run("transfers and approves a token", func(t *testing.T) {
+		tx := session(call(map[string]any{"amount": "500", "token_address": "0x3333333333333333333333333333333333333333"})).TransactionDeployments[0]
+		assert.Equal(t, "0x3333333333333333333333333333333333333333", tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+		assert.True(t, strings.HasPrefix(tx.Data, "0xa9059cbb"))
+
+		// Approvals are not limited by the balance
+		tx = session(call(map[string]any{"action": "approve", "amount": "10000", "token_address": "0x3333333333333333333333333333333333333333"})).TransactionDeployments[0]
+		assert.True(t, strings.HasPrefix(tx.Data, "0x095ea7b3"))
+	})
+
+	t.Run("rejects transfers above the balance", func(t *testing.T) {
+		assert.True(t, call(map[string]any{"amount": "1001"}).IsError)
+		assert.True(t, call(map[string]any{"amount": "501", "token_address": "0x3333333333333333333333333333333333333333"}).IsError)
+		assert.True(t, call(map[string]any{"amount": "0"}).IsError)
+		assert.True(t, call(map[string]any{"action": "approve", "amount": "1"}).IsError)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rxtech-lab/launchpad-mcp/internal/constants"
+	"github.com/rxtech-lab/launchpad-mcp/internal/contracts"
 	"github.com/rxtech-lab/launchpad-mcp/internal/models"
 	"github.com/rxtech-lab/launchpad-mcp/internal/services"
 	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
@@ -30,6 +31,10 @@ type UpdateTemplateArguments struct {
 	TemplateCode     string         `json:"template_code,omitempty"`
 	TemplateMetadata string         `json:"template_metadata,omitempty"`
 	TemplateValues   map[string]any `json:"template_values,omitempty"`
+	// TemplateFiles replaces the files of the template when set, an empty object removes them
+	TemplateFiles models.TemplateFiles `json:"template_files,omitempty"`
+	// OpenZeppelinVersion pins the vendored OpenZeppelin release when set, an empty string restores the default
+	OpenZeppelinVersion *string `json:"openzeppelin_version,omitempty"`
 }
 
 type UpdateTemplateResult struct {
@@ -63,11 +68,17 @@ func (u *updateTemplateTool) GetTool() mcp.Tool {
 			mcp.Description("New contract name"),
 		),
 		mcp.WithString("template_code",
-			mcp.Description("New template code with Go template syntax ({{.VariableName}})"),
+			mcp.Description("New template code with Go template syntax ({{.VariableName}}) and the template helpers (toWei, checksumAddress, now, randomSalt, upper, lower)"),
 		),
 		mcp.WithString("template_metadata",
 			mcp.Description("JSON object defining template parameters as key-value pairs where values are empty strings (e.g., {\"TokenName\": \"\", \"TokenSymbol\": \"\"})"),
 		),
+		mcp.WithObject("template_files",
+			mcp.Description("JSON object replacing the library and interface files imported by the template code (e.g., {\"lib/Math.sol\": \"library Math {...}\"}). Pass an empty object to remove the files"),
+		),
+		mcp.WithString("openzeppelin_version",
+			mcp.Description("New OpenZeppelin version the template targets (e.g., 4.9.6). Pass an empty string to use the default vendored release"),
+		),
 		mcp.WithObject("template_values",
 			mcp.Description("JSON object with runtime values for template parameters for validation (e.g., {\"TokenName\": \"MyToken\", \"TokenSymbol\": \"MTK\"})"),
 		),
@@ -121,7 +132,7 @@ func (u *updateTemplateTool) GetHandler() server.ToolHandlerFunc {
 		var updatedFields []string
 
 		// Check if any updates are provided
-		hasUpdates := args.Description != "" || args.ChainType != "" || args.TemplateCode != "" || args.TemplateMetadata != "" || args.TemplateValues != nil
+		hasUpdates := args.Description != "" || args.ChainType != "" || args.TemplateCode != "" || args.TemplateMetadata != "" || args.TemplateValues != nil || args.TemplateFiles != nil || args.OpenZeppelinVersion != nil
 
 		if !hasUpdates {
 			return mcp.NewToolResultError("No update parameters provided"), nil
@@ -140,7 +151,7 @@ func (u *updateTemplateTool) GetHandler() server.ToolHandlerFunc {
 			}
 
 			// Check if changing to Solana with template code update
-			if args.ChainType == "solana" && args.TemplateCode != "" {
+			if args.ChainType == "solana" && (args.TemplateCode != "" || args.TemplateFiles != nil || args.OpenZeppelinVersion != nil) {
 				return mcp.NewToolResultError("Cannot update template code when changing chain type to Solana"), nil
 			}
 
@@ -160,9 +171,34 @@ func (u *updateTemplateTool) GetHandler() server.ToolHandlerFunc {
 		}
 
 		var compilationResult *utils.CompilationResult
-		// Update template code if provided
-		if args.TemplateCode != "" {
-			updatedFields = append(updatedFields, "template_code")
+		var anchorResult *utils.AnchorCompilationResult
+		// Update template code, files and OpenZeppelin version if provided
+		if args.TemplateCode != "" || args.TemplateFiles != nil || args.OpenZeppelinVersion != nil {
+			if err := utils.ValidateTemplateFiles(args.TemplateFiles); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid template_files: %v", err)), nil
+			}
+			templateCode := template.TemplateCode
+			if args.TemplateCode != "" {
+				templateCode = args.TemplateCode
+				updatedFields = append(updatedFields, "template_code")
+			}
+			templateFiles := template.Files
+			if args.TemplateFiles != nil {
+				templateFiles = args.TemplateFiles
+				updatedFields = append(updatedFields, "template_files")
+			}
+			openZeppelinVersion := template.OpenZeppelinVersion
+			if args.OpenZeppelinVersion != nil {
+				openZeppelinVersion = ""
+				if *args.OpenZeppelinVersion != "" {
+					openZeppelin, err := contracts.ResolveOpenZeppelinVersion(*args.OpenZeppelinVersion)
+					if err != nil {
+						return mcp.NewToolResultError(fmt.Sprintf("Invalid openzeppelin_version: %v", err)), nil
+					}
+					openZeppelinVersion = openZeppelin.Version
+				}
+				updatedFields = append(updatedFields, "openzeppelin_version")
+			}
 
 			// Use the current or new chain type for validation
 			validationChainType := template.ChainType
@@ -183,19 +219,30 @@ func (u *updateTemplateTool) GetHandler() server.ToolHandlerFunc {
 					}
 				}
 
-				renderedCode, err := utils.RenderContractTemplate(args.TemplateCode, templateValues)
+				renderedCode, err := utils.RenderContractTemplate(templateCode, templateValues)
 				if err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("Error rendering template: %v", err)), nil
 				}
+				renderedFiles, err := utils.RenderTemplateFiles(templateFiles, templateValues)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error rendering template files: %v", err)), nil
+				}
 
 				// Use Solidity version 0.8.27 for validation
-				result, err := utils.CompileSolidity(constants.SolidityCompilerVersion, renderedCode)
+				result, err := utils.CompileSolidityFiles(constants.SolidityCompilerVersion, renderedCode, renderedFiles, openZeppelinVersion)
 				if err != nil {
 					return mcp.NewToolResultError(fmt.Sprintf("Solidity compilation failed: %v", err)), nil
 				}
 
 				compilationResult = &result
 
+				// The report follows the contract of the previous report when the contract name is not changed
+				reportContract := args.ContractName
+				if reportContract == "" && template.Report != nil {
+					reportContract = template.Report.ContractName
+				}
+				template.Report = utils.NewTemplateReport(result, reportContract, constants.SolidityCompilerVersion)
+
 				// Update ABI if contract name provided and compilation successful
 				if args.ContractName != "" {
 					if abi, exists := compilationResult.Abi[args.ContractName]; exists {
@@ -212,12 +259,37 @@ func (u *updateTemplateTool) GetHandler() server.ToolHandlerFunc {
 					}
 				}
 			case "solana":
-				// Solana template code updates are not supported
-				return mcp.NewToolResultError("Solana template code updates are not supported"), nil
+				if len(templateFiles) > 0 || openZeppelinVersion != "" {
+					return mcp.NewToolResultError("template_files and openzeppelin_version are only supported by ethereum templates"), nil
+				}
+				templateValues := args.TemplateValues
+				if templateValues == nil {
+					templateValues = template.SampleTemplateValues
+				}
+				renderedCode, err := utils.RenderAnchorTemplate(templateCode, templateValues)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Error rendering template: %v", err)), nil
+				}
+
+				result, err := utils.CompileAnchorProgram(ctx, renderedCode)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Anchor program validation failed: %v", err)), nil
+				}
+				anchorResult = &result
+				template.Idl = result.Idl
 			}
 
 			// Update the template code
-			template.TemplateCode = args.TemplateCode
+			template.TemplateCode = templateCode
+			template.Files = templateFiles
+			template.OpenZeppelinVersion = openZeppelinVersion
+		}
+
+		// Every template value of the code must be a declared parameter
+		if (args.TemplateCode != "" || args.TemplateFiles != nil || args.TemplateMetadata != "") && len(template.Metadata) > 0 {
+			if err := utils.ValidateTemplateFilesKeys(template.TemplateCode, template.Files, template.Metadata); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Invalid template_metadata: %v", err)), nil
+			}
 		}
 
 		// Save updated template
@@ -241,6 +313,14 @@ func (u *updateTemplateTool) GetHandler() server.ToolHandlerFunc {
 				contractNames = append(contractNames, contractName)
 			}
 			result["contract_names"] = contractNames
+			if len(compilationResult.Warnings) > 0 {
+				result["warnings"] = compilationResult.Warnings
+			}
+		}
+
+		// Add build information for Solana
+		if anchorResult != nil {
+			result["anchor_built"] = anchorResult.Built
 		}
 
 		// Add metadata information if updated
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -363,11 +364,17 @@ contract UpdatedToken {
 			errorMsg:    "Solidity compilation failed",
 		},
 		{
-			name:        "solana_code_update_not_supported",
+			name:        "valid_solana_code_update",
 			chainType:   "solana",
-			newCode:     validSolanaTemplate(),
+			newCode:     strings.Replace(validSolanaTemplate(), "pub fn mint(", "pub fn mint_to(", 1),
+			expectError: false,
+		},
+		{
+			name:        "invalid_solana_code",
+			chainType:   "solana",
+			newCode:     "fn main() {}",
 			expectError: true,
-			errorMsg:    "Solana template code updates are not supported",
+			errorMsg:    "Anchor program validation failed",
 		},
 	}
 
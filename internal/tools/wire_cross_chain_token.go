@@ -0,0 +1,334 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+)
+
+const (
+	CrossChainProtocolLayerZero = "layerzero"
+	CrossChainProtocolAxelar    = "axelar"
+)
+
+type wireCrossChainTokenTool struct {
+	txService          services.TransactionService
+	launchGroupService services.LaunchGroupService
+	serverPort         int
+	// axelarTokenManager reads the ITS token manager address, replaced in tests
+	axelarTokenManager func(rpcURL string, deployer string, salt [32]byte) (string, error)
+}
+
+type WireCrossChainTokenArguments struct {
+	// Required fields
+	LaunchGroupID string `json:"launch_group_id" validate:"required"`
+	Protocol      string `json:"protocol" validate:"required,oneof=layerzero axelar"`
+
+	// Optional fields
+	LzReceiveGas  uint64 `json:"lz_receive_gas,omitempty"`
+	SignerAddress string `json:"signer_address,omitempty" validate:"required_if=Protocol axelar,omitempty,eth_addr"`
+	HomeChainID   string `json:"home_chain_id,omitempty"`
+	GasValue      string `json:"gas_value,omitempty"`
+}
+
+// CrossChainWiringSession is the wiring session of one chain of the launch group
+type CrossChainWiringSession struct {
+	ChainID      string   `json:"chain_id"`
+	ChainName    string   `json:"chain_name"`
+	SessionID    string   `json:"session_id"`
+	URL          string   `json:"url"`
+	Transactions []string `json:"transactions"`
+}
+
+type WireCrossChainTokenResult struct {
+	LaunchGroupID uint   `json:"launch_group_id"`
+	Protocol      string `json:"protocol"`
+	// TokenManager is the ITS token manager granted the minter role on every chain (axelar)
+	TokenManager string                    `json:"token_manager,omitempty"`
+	Sessions     []CrossChainWiringSession `json:"sessions"`
+}
+
+// crossChainDeployment is a confirmed deployment of the launch group with its cross-chain identifiers
+type crossChainDeployment struct {
+	deployment models.Deployment
+	network    utils.CrossChainNetwork
+}
+
+func NewWireCrossChainTokenTool(txService services.TransactionService, launchGroupService services.LaunchGroupService, serverPort int) *wireCrossChainTokenTool {
+	return &wireCrossChainTokenTool{
+		txService:          txService,
+		launchGroupService: launchGroupService,
+		serverPort:         serverPort,
+		axelarTokenManager: utils.GetAxelarTokenManager,
+	}
+}
+
+func (w *wireCrossChainTokenTool) GetTool() mcp.Tool {
+	tool := mcp.NewTool("wire_cross_chain_token",
+		mcp.WithDescription("Wire the deployments of a multi_chain_launch group of a cross-chain token template (import_templates with pack cross-chain) so the token moves natively between the chains. "+
+			"layerzero (LayerZero OFT Token): every deployment sets the other deployments as peers with setPeer and enforced lzReceive options. "+
+			"axelar (Axelar ITS Token): every chain registers the token metadata with the Interchain Token Service and grants the token manager the minter role, the home chain registers the custom token and links it to the other chains. "+
+			"Returns one signing session per chain; for axelar sign the other chains before the home chain."),
+		mcp.WithString("launch_group_id",
+			mcp.Required(),
+			mcp.Description("ID of the launch group returned by multi_chain_launch, every deployment must be confirmed"),
+		),
+		mcp.WithString("protocol",
+			mcp.Required(),
+			mcp.Description("Cross-chain protocol of the template"),
+			mcp.Enum(CrossChainProtocolLayerZero, CrossChainProtocolAxelar),
+		),
+		mcp.WithNumber("lz_receive_gas",
+			mcp.Description(fmt.Sprintf("Executor gas of lzReceive in the enforced options (layerzero). Optional, defaults to %d", utils.DefaultLayerZeroReceiveGas)),
+		),
+		mcp.WithString("signer_address",
+			mcp.Description("Wallet signing the home chain session, the ITS token ID is derived from it (required for axelar)"),
+		),
+		mcp.WithString("home_chain_id",
+			mcp.Description("Chain ID registering and linking the token (axelar). Optional, defaults to the HomeChainID template value of the launch group"),
+		),
+		mcp.WithString("gas_value",
+			mcp.Description("Native token in wei paid to Axelar for every cross-chain registration and link (axelar). Optional, defaults to \"0\""),
+		),
+		withIdempotencyKey(),
+	)
+
+	return tool
+}
+
+func (w *wireCrossChainTokenTool) GetHandler() server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var args WireCrossChainTokenArguments
+		if err := request.BindArguments(&args); err != nil {
+			return nil, fmt.Errorf("failed to bind arguments: %w", err)
+		}
+
+		if err := validator.New().Struct(args); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+		}
+
+		groupID, err := strconv.ParseUint(args.LaunchGroupID, 10, 32)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid launch_group_id format: %v", err)), nil
+		}
+
+		group, err := w.launchGroupService.GetLaunchGroup(uint(groupID))
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Launch group not found: %v", err)), nil
+		}
+
+		// Authenticated users can only wire their own launch groups
+		if userID := utils.GetUserID(ctx); userID != "" && (group.UserID == nil || *group.UserID != userID) {
+			return mcp.NewToolResultError("Launch group not found"), nil
+		}
+
+		deployments, err := crossChainDeployments(group)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		result := WireCrossChainTokenResult{LaunchGroupID: group.ID, Protocol: args.Protocol}
+		var sessions map[uint][]models.TransactionDeployment
+		var order []crossChainDeployment
+		switch args.Protocol {
+		case CrossChainProtocolLayerZero:
+			sessions, err = layerZeroWiring(deployments, args.LzReceiveGas)
+			order = deployments
+		case CrossChainProtocolAxelar:
+			sessions, order, result.TokenManager, err = w.axelarWiring(group, deployments, args)
+		}
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		for _, entry := range order {
+			txs := sessions[entry.deployment.ChainID]
+			sessionID, err := w.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{
+				TransactionDeployments: txs,
+				ChainType:              models.TransactionChainTypeEthereum,
+				ChainID:                entry.deployment.ChainID,
+				UserID:                 group.UserID,
+				Metadata: []models.TransactionMetadata{
+					{Key: "Launch Group", Value: strconv.FormatUint(uint64(group.ID), 10)},
+					{Key: "Protocol", Value: args.Protocol},
+				},
+				Context: ctx,
+			})
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to create the wiring session of %s: %v", entry.deployment.Chain.Name, err)), nil
+			}
+			url, err := utils.GetTransactionSessionUrl(ctx, w.serverPort, sessionID)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get transaction session url: %v", err)), nil
+			}
+
+			session := CrossChainWiringSession{
+				ChainID:   entry.deployment.Chain.NetworkID,
+				ChainName: entry.deployment.Chain.Name,
+				SessionID: sessionID,
+				URL:       url,
+			}
+			for _, tx := range txs {
+				session.Transactions = append(session.Transactions, tx.Title)
+			}
+			result.Sessions = append(result.Sessions, session)
+		}
+
+		resultJSON, _ := json.Marshal(result)
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				mcp.NewTextContent(fmt.Sprintf("Created %d %s wiring sessions for launch group %d, please return every url to the user in order: ", len(result.Sessions), args.Protocol, group.ID)),
+				mcp.NewTextContent(string(resultJSON)),
+			},
+		}, nil
+	}
+}
+
+// crossChainDeployments returns the deployments of the group once all of them are confirmed on supported chains
+func crossChainDeployments(group *models.LaunchGroup) ([]crossChainDeployment, error) {
+	if len(group.Deployments) < 2 {
+		return nil, fmt.Errorf("launch group %d has %d deployments, wiring needs at least 2 chains", group.ID, len(group.Deployments))
+	}
+
+	var deployments []crossChainDeployment
+	for _, deployment := range group.Deployments {
+		if deployment.Status != models.TransactionStatusConfirmed || deployment.ContractAddress == "" {
+			return nil, fmt.Errorf("the deployment on %s is %s, wire the token once every deployment of the launch group is confirmed", deployment.Chain.Name, deployment.Status)
+		}
+		network, err := utils.GetCrossChainNetwork(deployment.Chain.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+		if len(deployments) > 0 && deployments[0].network.Testnet != network.Testnet {
+			return nil, fmt.Errorf("launch group %d mixes mainnets and testnets, they cannot be wired together", group.ID)
+		}
+		deployments = append(deployments, crossChainDeployment{deployment: deployment, network: network})
+	}
+	return deployments, nil
+}
+
+// layerZeroWiring sets every other deployment as a peer of each OFT, keyed by the chain of the session
+func layerZeroWiring(deployments []crossChainDeployment, lzReceiveGas uint64) (map[uint][]models.TransactionDeployment, error) {
+	if lzReceiveGas == 0 {
+		lzReceiveGas = utils.DefaultLayerZeroReceiveGas
+	}
+	options := utils.LayerZeroReceiveOptions(lzReceiveGas)
+
+	sessions := map[uint][]models.TransactionDeployment{}
+	for _, local := range deployments {
+		for _, remote := range deployments {
+			if remote.deployment.ID == local.deployment.ID {
+				continue
+			}
+			setPeer, err := utils.EncodeLayerZeroSetPeer(remote.network.LayerZeroEID, remote.deployment.ContractAddress)
+			if err != nil {
+				return nil, err
+			}
+			setOptions, err := utils.EncodeLayerZeroSetEnforcedOptions(remote.network.LayerZeroEID, options)
+			if err != nil {
+				return nil, err
+			}
+			sessions[local.deployment.ChainID] = append(sessions[local.deployment.ChainID],
+				crossChainWiringTx(fmt.Sprintf("Set %s peer", remote.deployment.Chain.Name), fmt.Sprintf("Trust the OFT %s on endpoint %d", remote.deployment.ContractAddress, remote.network.LayerZeroEID), local.deployment.ContractAddress, setPeer, "0"),
+				crossChainWiringTx(fmt.Sprintf("Set %s enforced options", remote.deployment.Chain.Name), fmt.Sprintf("Give lzReceive %d gas on endpoint %d", lzReceiveGas, remote.network.LayerZeroEID), local.deployment.ContractAddress, setOptions, "0"),
+			)
+		}
+	}
+	return sessions, nil
+}
+
+// axelarWiring registers the token metadata and grants the token manager the minter role on every chain,
+// then registers the custom token and links it to the other chains from the home chain.
+// The home chain is ordered last, its links need the metadata of the other chains.
+func (w *wireCrossChainTokenTool) axelarWiring(group *models.LaunchGroup, deployments []crossChainDeployment, args WireCrossChainTokenArguments) (map[uint][]models.TransactionDeployment, []crossChainDeployment, string, error) {
+	homeChainID := args.HomeChainID
+	if homeChainID == "" {
+		homeChainID = fmt.Sprint(group.TemplateValues["HomeChainID"])
+	}
+	homeIndex := -1
+	for index, entry := range deployments {
+		if entry.deployment.Chain.NetworkID == homeChainID {
+			homeIndex = index
+		}
+	}
+	if homeIndex < 0 {
+		return nil, nil, "", fmt.Errorf("home chain %s is not a chain of launch group %d, pass home_chain_id", homeChainID, group.ID)
+	}
+	home := deployments[homeIndex]
+
+	gasValue := big.NewInt(0)
+	if args.GasValue != "" {
+		value, ok := new(big.Int).SetString(args.GasValue, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, nil, "", fmt.Errorf("invalid gas_value %q, expected an amount in wei", args.GasValue)
+		}
+		gasValue = value
+	}
+
+	salt := utils.CrossChainSalt(group.ID)
+	tokenManager, err := w.axelarTokenManager(home.deployment.Chain.RPC, args.SignerAddress, salt)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to read the ITS token manager on %s: %w", home.deployment.Chain.Name, err)
+	}
+
+	sessions := map[uint][]models.TransactionDeployment{}
+	var order []crossChainDeployment
+	for index, entry := range deployments {
+		token := entry.deployment.ContractAddress
+		registerMetadata, err := utils.EncodeAxelarRegisterTokenMetadata(token, gasValue)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		setMinter, err := utils.EncodeSetMinter(tokenManager, true)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		sessions[entry.deployment.ChainID] = append(sessions[entry.deployment.ChainID],
+			crossChainWiringTx("Register token metadata", fmt.Sprintf("Register %s with the Interchain Token Service", token), utils.AxelarInterchainTokenService, registerMetadata, gasValue.String()),
+			crossChainWiringTx("Grant the token manager the minter role", fmt.Sprintf("Allow the ITS token manager %s to mint and burn", tokenManager), token, setMinter, "0"),
+		)
+		if index != homeIndex {
+			order = append(order, entry)
+		}
+	}
+
+	registerToken, err := utils.EncodeAxelarRegisterCustomToken(salt, home.deployment.ContractAddress, common.Address{}.Hex())
+	if err != nil {
+		return nil, nil, "", err
+	}
+	homeTxs := append(sessions[home.deployment.ChainID],
+		crossChainWiringTx("Register custom token", "Register the token with a mint/burn token manager", utils.AxelarInterchainTokenFactory, registerToken, "0"))
+	for index, remote := range deployments {
+		if index == homeIndex {
+			continue
+		}
+		linkToken, err := utils.EncodeAxelarLinkToken(salt, remote.network.AxelarChain, remote.deployment.ContractAddress, gasValue)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		homeTxs = append(homeTxs, crossChainWiringTx(fmt.Sprintf("Link %s", remote.deployment.Chain.Name), fmt.Sprintf("Link the token to %s on %s", remote.deployment.ContractAddress, remote.network.AxelarChain), utils.AxelarInterchainTokenFactory, linkToken, gasValue.String()))
+	}
+	sessions[home.deployment.ChainID] = homeTxs
+	return sessions, append(order, home), tokenManager, nil
+}
+
+func crossChainWiringTx(title, description, receiver, data, value string) models.TransactionDeployment {
+	return models.TransactionDeployment{
+		Title:           title,
+		Description:     description,
+		Data:            data,
+		Value:           value,
+		Receiver:        receiver,
+		TransactionType: models.TransactionTypeCrossChainWiring,
+	}
+}
@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/rxtech-lab/launchpad-mcp/internal/services"
+	"github.com/rxtech-lab/launchpad-mcp/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var crossChainContracts = []string{"0x1111111111111111111111111111111111111111", "0x2222222222222222222222222222222222222222"}
+
+func setupWireCrossChainTokenTest(t *testing.T, status models.TransactionStatus) (*multiChainLaunchTestEnv, *models.LaunchGroup) {
+	env := setupMultiChainLaunchTest(t)
+	group := &models.LaunchGroup{TemplateID: env.template.ID, ContractName: "Sample", TemplateValues: models.JSON{"HomeChainID": "1"}}
+	require.NoError(t, env.launchGroupService.CreateLaunchGroup(group))
+	for index, chain := range env.chains {
+		sessionID, err := env.txService.CreateTransactionSession(services.CreateTransactionSessionRequest{ChainType: models.TransactionChainTypeEthereum, ChainID: chain.ID})
+		require.NoError(t, err)
+		require.NoError(t, env.deploymentService.CreateDeployment(&models.Deployment{ChainID: chain.ID, TemplateID: env.template.ID, Status: status, ContractAddress: crossChainContracts[index], SessionId: sessionID}))
+		require.NoError(t, env.launchGroupService.AddDeploymentBySessionId(group.ID, sessionID))
+	}
+	return env, group
+}
+
+func callWireCrossChainTokenTool(t *testing.T, tool *wireCrossChainTokenTool, arguments map[string]any) (*mcp.CallToolResult, WireCrossChainTokenResult) {
+	result := callTemplatePackTool(t, context.Background(), tool.GetHandler(), arguments)
+	var wiring WireCrossChainTokenResult
+	if !result.IsError {
+		require.NoError(t, json.Unmarshal([]byte(result.Content[1].(mcp.TextContent).Text), &wiring))
+	}
+	return result, wiring
+}
+
+func TestWireCrossChainTokenValidation(t *testing.T) {
+	env, _ := setupWireCrossChainTokenTest(t, models.TransactionStatusPending)
+	tool := NewWireCrossChainTokenTool(env.txService, env.launchGroupService, TEST_SERVER_PORT)
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		expected  string
+	}{
+		{"unknown protocol", map[string]any{"launch_group_id": "1", "protocol": "wormhole"}, "Invalid arguments"},
+		{"axelar without signer", map[string]any{"launch_group_id": "1", "protocol": "axelar"}, "Invalid arguments"},
+		{"unknown group", map[string]any{"launch_group_id": "9", "protocol": "layerzero"}, "Launch group not found"},
+		{"pending deployments", map[string]any{"launch_group_id": "1", "protocol": "layerzero"}, "once every deployment of the launch group is confirmed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := callWireCrossChainTokenTool(t, tool, tt.arguments)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content[0].(mcp.TextContent).Text, tt.expected)
+		})
+	}
+}
+
+func TestWireCrossChainTokenLayerZero(t *testing.T) {
+	env, _ := setupWireCrossChainTokenTest(t, models.TransactionStatusConfirmed)
+	tool := NewWireCrossChainTokenTool(env.txService, env.launchGroupService, TEST_SERVER_PORT)
+
+	result, wiring := callWireCrossChainTokenTool(t, tool, map[string]any{"launch_group_id": "1", "protocol": "layerzero"})
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	require.Len(t, wiring.Sessions, 2)
+	assert.Equal(t, "1", wiring.Sessions[0].ChainID)
+	assert.Equal(t, []string{"Set Base peer", "Set Base enforced options"}, wiring.Sessions[0].Transactions)
+
+	// Mainnet trusts the Base OFT on the Base endpoint ID
+	session, err := env.txService.GetTransactionSession(wiring.Sessions[0].SessionID)
+	require.NoError(t, err)
+	assert.Equal(t, env.chains[0].ID, session.ChainID)
+	require.Len(t, session.TransactionDeployments, 2)
+	setPeer, err := utils.EncodeLayerZeroSetPeer(30184, crossChainContracts[1])
+	require.NoError(t, err)
+	assert.Equal(t, setPeer, session.TransactionDeployments[0].Data)
+	assert.Equal(t, crossChainContracts[0], session.TransactionDeployments[0].Receiver)
+	assert.Equal(t, models.TransactionTypeCrossChainWiring, session.TransactionDeployments[0].TransactionType)
+}
+
+func TestWireCrossChainTokenAxelar(t *testing.T) {
+	env, group := setupWireCrossChainTokenTest(t, models.TransactionStatusConfirmed)
+	tool := NewWireCrossChainTokenTool(env.txService, env.launchGroupService, TEST_SERVER_PORT)
+	tokenManager := "0x3333333333333333333333333333333333333333"
+	tool.axelarTokenManager = func(rpcURL string, deployer string, salt [32]byte) (string, error) {
+		assert.Equal(t, utils.CrossChainSalt(group.ID), salt)
+		return tokenManager, nil
+	}
+
+	result, wiring := callWireCrossChainTokenTool(t, tool, map[string]any{
+		"launch_group_id": "1",
+		"protocol":        "axelar",
+		"signer_address":  "0x4444444444444444444444444444444444444444",
+		"gas_value":       "1000",
+	})
+	require.False(t, result.IsError, result.Content[0].(mcp.TextContent).Text)
+	assert.Equal(t, tokenManager, wiring.TokenManager)
+	require.Len(t, wiring.Sessions, 2)
+
+	// The remote chain is signed before the home chain links to it
+	assert.Equal(t, "8453", wiring.Sessions[0].ChainID)
+	assert.Equal(t, []string{"Register token metadata", "Grant the token manager the minter role"}, wiring.Sessions[0].Transactions)
+	assert.Equal(t, "1", wiring.Sessions[1].ChainID)
+	assert.Equal(t, []string{"Register token metadata", "Grant the token manager the minter role", "Register custom token", "Link Base"}, wiring.Sessions[1].Transactions)
+
+	session, err := env.txService.GetTransactionSession(wiring.Sessions[1].SessionID)
+	require.NoError(t, err)
+	link := session.TransactionDeployments[3]
+	assert.Equal(t, utils.AxelarInterchainTokenFactory, link.Receiver)
+	assert.Equal(t, "1000", link.Value)
+}
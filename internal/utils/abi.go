@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// GetAbiString extracts the ABI array from a template ABI and returns it as a JSON string.
+// Templates created by create_template store the ABI array under the "abi" key,
+// otherwise the whole JSON object is treated as the ABI.
+func GetAbiString(abiJSON models.JSON) (string, error) {
+	if abiJSON == nil {
+		return "", fmt.Errorf("ABI is empty")
+	}
+
+	if abiData, exists := abiJSON["abi"]; exists {
+		abiBytes, err := json.Marshal(abiData)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal ABI data: %w", err)
+		}
+		return string(abiBytes), nil
+	}
+
+	abiBytes, err := json.Marshal(abiJSON)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ABI: %w", err)
+	}
+	return string(abiBytes), nil
+}
+
+// ToIdentifier converts an arbitrary name (e.g. a template name) into a PascalCase identifier
+// that is safe to use as a variable or type name in generated code.
+// Returns "Contract" if the name does not contain any letters or digits.
+func ToIdentifier(name string) string {
+	var builder strings.Builder
+	upperNext := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+			continue
+		}
+		if upperNext {
+			builder.WriteRune(unicode.ToUpper(r))
+			upperNext = false
+		} else {
+			builder.WriteRune(r)
+		}
+	}
+
+	identifier := builder.String()
+	if identifier == "" {
+		return "Contract"
+	}
+	if unicode.IsDigit(rune(identifier[0])) {
+		identifier = "Contract" + identifier
+	}
+	return identifier
+}
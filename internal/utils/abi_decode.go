@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DecodedValue is an argument or a return value of a contract function converted to JSON: integers are decimal strings, addresses
+// checksummed hex, bytes 0x hex, arrays lists and structs objects keyed by their component names
+type DecodedValue struct {
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// DecodeFunctionInputs unpacks the arguments of a call, data is the calldata without the 4 byte selector
+func DecodeFunctionInputs(method abi.Method, data []byte) ([]DecodedValue, error) {
+	values, err := method.Inputs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the arguments of %s: %w", method.Name, err)
+	}
+	return decodedValues(method.Inputs, values), nil
+}
+
+// DecodeFunctionOutputs unpacks the return data of an eth_call with the outputs of the method
+func DecodeFunctionOutputs(method abi.Method, data []byte) ([]DecodedValue, error) {
+	values, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the outputs of %s: %w", method.Name, err)
+	}
+
+	return decodedValues(method.Outputs, values), nil
+}
+
+func decodedValues(arguments abi.Arguments, values []any) []DecodedValue {
+	decoded := make([]DecodedValue, 0, len(values))
+	for i, argument := range arguments {
+		decoded = append(decoded, DecodedValue{Name: argument.Name, Type: argument.Type.String(), Value: abiValueToJSON(argument.Type, values[i])})
+	}
+	return decoded
+}
+
+func abiValueToJSON(t abi.Type, value any) any {
+	v := reflect.ValueOf(value)
+	switch t.T {
+	case abi.AddressTy:
+		if address, ok := value.(common.Address); ok {
+			return address.Hex()
+		}
+	case abi.BoolTy, abi.StringTy:
+		return value
+	case abi.BytesTy:
+		if data, ok := value.([]byte); ok {
+			return hexutil.Encode(data)
+		}
+	case abi.FixedBytesTy, abi.HashTy:
+		if v.Kind() == reflect.Array {
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			return hexutil.Encode(data)
+		}
+	case abi.SliceTy, abi.ArrayTy:
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = abiValueToJSON(*t.Elem, v.Index(i).Interface())
+		}
+		return items
+	case abi.TupleTy:
+		fields := make(map[string]any, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			name := t.TupleRawNames[i]
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			fields[name] = abiValueToJSON(*elem, v.Field(i).Interface())
+		}
+		return fields
+	}
+	// Integers of every size, *big.Int or uint8 to int64
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeFunctionOutputs(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"getPool","stateMutability":"view","inputs":[],"outputs":[
+		{"name":"pool","type":"tuple","components":[{"name":"token","type":"address"},{"name":"amount","type":"uint256"}]},
+		{"name":"ids","type":"uint8[]"},
+		{"name":"root","type":"bytes32"},
+		{"name":"","type":"bool"}]}]`))
+	require.NoError(t, err)
+	method := parsed.Methods["getPool"]
+
+	pool := struct {
+		Token  common.Address
+		Amount *big.Int
+	}{common.HexToAddress("0x1111111111111111111111111111111111111111"), big.NewInt(1000)}
+	data, err := method.Outputs.Pack(pool, []uint8{1, 2}, [32]byte{0xab}, true)
+	require.NoError(t, err)
+
+	outputs, err := DecodeFunctionOutputs(method, data)
+	require.NoError(t, err)
+	require.Len(t, outputs, 4)
+	assert.Equal(t, "pool", outputs[0].Name)
+	assert.Equal(t, map[string]any{"token": "0x1111111111111111111111111111111111111111", "amount": "1000"}, outputs[0].Value)
+	assert.Equal(t, []any{"1", "2"}, outputs[1].Value)
+	assert.Equal(t, "uint8[]", outputs[1].Type)
+	assert.Equal(t, "0xab00000000000000000000000000000000000000000000000000000000000000", outputs[2].Value)
+	assert.Equal(t, true, outputs[3].Value)
+
+	_, err = DecodeFunctionOutputs(method, nil)
+	assert.Error(t, err)
+}
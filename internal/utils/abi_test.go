@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAbiString(t *testing.T) {
+	t.Run("abi stored under abi key", func(t *testing.T) {
+		abiString, err := GetAbiString(models.JSON{
+			"abi": []interface{}{map[string]interface{}{"type": "function", "name": "totalSupply"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `[{"name":"totalSupply","type":"function"}]`, abiString)
+	})
+
+	t.Run("abi stored as object", func(t *testing.T) {
+		abiString, err := GetAbiString(models.JSON{"type": "function"})
+		require.NoError(t, err)
+		assert.Equal(t, `{"type":"function"}`, abiString)
+	})
+
+	t.Run("nil abi", func(t *testing.T) {
+		_, err := GetAbiString(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestToIdentifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "My Token", expected: "MyToken"},
+		{name: "simple-erc20_token", expected: "SimpleErc20Token"},
+		{name: "ERC20", expected: "ERC20"},
+		{name: "20 Token", expected: "Contract20Token"},
+		{name: "!!!", expected: "Contract"},
+		{name: "", expected: "Contract"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToIdentifier(tt.name))
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	assert.Equal(t, "my_token", ToSnakeCase("MyToken"))
+	assert.Equal(t, "token", ToSnakeCase("Token"))
+	assert.Equal(t, "erc20", ToSnakeCase("ERC20"))
+	assert.Equal(t, "erc20_token", ToSnakeCase("ERC20Token"))
+	assert.Equal(t, "my_erc20_token", ToSnakeCase("MyERC20Token"))
+	assert.Equal(t, "v2_pool", ToSnakeCase("V2Pool"))
+}
@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// AbiTypeScriptArgs contains the contract information used to generate TypeScript typings
+type AbiTypeScriptArgs struct {
+	ContractName    string
+	ContractAddress string
+	// Abi is the ABI array as a JSON string
+	Abi string
+}
+
+// AbiTypeScript is a generated TypeScript typings file for a contract ABI
+type AbiTypeScript struct {
+	Filename string `json:"filename"`
+	Code     string `json:"code"`
+}
+
+// GenerateAbiTypeScript generates abitype/viem compatible TypeScript definitions for a contract ABI.
+// The ABI is exported as a const assertion so viem can infer function names and argument types,
+// along with explicit argument, return and event types for every function and event.
+func GenerateAbiTypeScript(args AbiTypeScriptArgs) (AbiTypeScript, error) {
+	parsedAbi, err := abi.JSON(strings.NewReader(args.Abi))
+	if err != nil {
+		return AbiTypeScript{}, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	var prettyAbi bytes.Buffer
+	if err := json.Indent(&prettyAbi, []byte(args.Abi), "", "  "); err != nil {
+		return AbiTypeScript{}, fmt.Errorf("invalid ABI JSON: %w", err)
+	}
+
+	identifier := ToIdentifier(args.ContractName)
+	variable := strings.ToLower(identifier[:1]) + identifier[1:]
+
+	var builder strings.Builder
+	builder.WriteString("// Generated by launchpad-mcp. Do not edit.\n\n")
+	if args.ContractAddress != "" {
+		fmt.Fprintf(&builder, "export const %sAddress = \"%s\" as const;\n\n", variable, args.ContractAddress)
+	}
+	fmt.Fprintf(&builder, "export const %sAbi = %s as const;\n\n", variable, prettyAbi.String())
+	fmt.Fprintf(&builder, "export type %sAbi = typeof %sAbi;\n", identifier, variable)
+
+	// Map iteration order is random, sort names to keep the output stable
+	methodNames := make([]string, 0, len(parsedAbi.Methods))
+	for name := range parsedAbi.Methods {
+		methodNames = append(methodNames, name)
+	}
+	sort.Strings(methodNames)
+
+	for _, name := range methodNames {
+		method := parsedAbi.Methods[name]
+		typeName := identifier + ToIdentifier(method.Name)
+		fmt.Fprintf(&builder, "\n// %s\n", method.Sig)
+		fmt.Fprintf(&builder, "export type %sArgs = %s;\n", typeName, toTypeScriptTuple(method.Inputs))
+		fmt.Fprintf(&builder, "export type %sReturn = %s;\n", typeName, toTypeScriptReturn(method.Outputs))
+	}
+
+	eventNames := make([]string, 0, len(parsedAbi.Events))
+	for name := range parsedAbi.Events {
+		eventNames = append(eventNames, name)
+	}
+	sort.Strings(eventNames)
+
+	for _, name := range eventNames {
+		event := parsedAbi.Events[name]
+		fmt.Fprintf(&builder, "\n// event %s\n", event.Sig)
+		fmt.Fprintf(&builder, "export type %s%sEvent = %s;\n", identifier, ToIdentifier(event.Name), toTypeScriptObject(event.Inputs))
+	}
+
+	return AbiTypeScript{
+		Filename: fmt.Sprintf("%s.abi.ts", variable),
+		Code:     builder.String(),
+	}, nil
+}
+
+// toTypeScriptType maps a solidity type to its TypeScript type following abitype conventions
+func toTypeScriptType(t abi.Type) string {
+	switch t.T {
+	case abi.AddressTy, abi.BytesTy, abi.FixedBytesTy, abi.HashTy, abi.FunctionTy:
+		return "`0x${string}`"
+	case abi.BoolTy:
+		return "boolean"
+	case abi.StringTy:
+		return "string"
+	case abi.IntTy, abi.UintTy:
+		// abitype maps integers up to 48 bits to number, anything larger to bigint
+		if t.Size <= 48 {
+			return "number"
+		}
+		return "bigint"
+	case abi.SliceTy, abi.ArrayTy:
+		return fmt.Sprintf("readonly %s[]", wrapArrayElement(toTypeScriptType(*t.Elem)))
+	case abi.TupleTy:
+		fields := make([]string, 0, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			fields = append(fields, fmt.Sprintf("%s: %s", t.TupleRawNames[i], toTypeScriptType(*elem)))
+		}
+		return fmt.Sprintf("{ %s }", strings.Join(fields, "; "))
+	default:
+		return "unknown"
+	}
+}
+
+// wrapArrayElement wraps element types that would otherwise bind incorrectly with the [] suffix
+func wrapArrayElement(elem string) string {
+	if strings.HasPrefix(elem, "readonly ") {
+		return fmt.Sprintf("(%s)", elem)
+	}
+	return elem
+}
+
+// toTypeScriptTuple renders ABI arguments as a readonly labeled tuple
+func toTypeScriptTuple(arguments abi.Arguments) string {
+	if len(arguments) == 0 {
+		return "readonly []"
+	}
+	members := make([]string, 0, len(arguments))
+	for i, argument := range arguments {
+		members = append(members, fmt.Sprintf("%s: %s", argumentName(argument, i), toTypeScriptType(argument.Type)))
+	}
+	return fmt.Sprintf("readonly [%s]", strings.Join(members, ", "))
+}
+
+// toTypeScriptReturn renders function outputs the way viem returns them from readContract
+func toTypeScriptReturn(outputs abi.Arguments) string {
+	switch len(outputs) {
+	case 0:
+		return "void"
+	case 1:
+		return toTypeScriptType(outputs[0].Type)
+	default:
+		return toTypeScriptTuple(outputs)
+	}
+}
+
+// toTypeScriptObject renders ABI arguments as an object type keyed by argument name
+func toTypeScriptObject(arguments abi.Arguments) string {
+	if len(arguments) == 0 {
+		return "Record<string, never>"
+	}
+	fields := make([]string, 0, len(arguments))
+	for i, argument := range arguments {
+		fields = append(fields, fmt.Sprintf("%s: %s", argumentName(argument, i), toTypeScriptType(argument.Type)))
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(fields, "; "))
+}
+
+// argumentName returns the argument name, falling back to its position for unnamed arguments
+func argumentName(argument abi.Argument, index int) string {
+	if argument.Name == "" {
+		return fmt.Sprintf("arg%d", index)
+	}
+	return argument.Name
+}
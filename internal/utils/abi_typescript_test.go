@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTypeScriptAbi = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"setHolders","stateMutability":"nonpayable","inputs":[{"name":"holders","type":"tuple[]","components":[{"name":"wallet","type":"address"},{"name":"share","type":"uint16"}]},{"name":"","type":"bytes32"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+func TestGenerateAbiTypeScript(t *testing.T) {
+	typings, err := GenerateAbiTypeScript(AbiTypeScriptArgs{
+		ContractName:    "My Token",
+		ContractAddress: "0x1234567890123456789012345678901234567890",
+		Abi:             testTypeScriptAbi,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "myToken.abi.ts", typings.Filename)
+	assert.Contains(t, typings.Code, `export const myTokenAddress = "0x1234567890123456789012345678901234567890" as const;`)
+	assert.Contains(t, typings.Code, "] as const;")
+	assert.Contains(t, typings.Code, "export type MyTokenAbi = typeof myTokenAbi;")
+	assert.Contains(t, typings.Code, "export type MyTokenBalanceOfArgs = readonly [account: `0x${string}`];")
+	assert.Contains(t, typings.Code, "export type MyTokenBalanceOfReturn = bigint;")
+	assert.Contains(t, typings.Code, "export type MyTokenDecimalsArgs = readonly [];")
+	assert.Contains(t, typings.Code, "export type MyTokenDecimalsReturn = number;")
+	assert.Contains(t, typings.Code, "export type MyTokenTransferReturn = boolean;")
+	assert.Contains(t, typings.Code, "export type MyTokenSetHoldersArgs = readonly [holders: readonly { wallet: `0x${string}`; share: number }[], arg1: `0x${string}`];")
+	assert.Contains(t, typings.Code, "export type MyTokenSetHoldersReturn = void;")
+	assert.Contains(t, typings.Code, "export type MyTokenTransferEvent = { from: `0x${string}`; to: `0x${string}`; value: bigint };")
+
+	// Output is sorted so it is stable between calls
+	again, err := GenerateAbiTypeScript(AbiTypeScriptArgs{
+		ContractName:    "My Token",
+		ContractAddress: "0x1234567890123456789012345678901234567890",
+		Abi:             testTypeScriptAbi,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, typings.Code, again.Code)
+}
+
+func TestGenerateAbiTypeScriptNestedArrays(t *testing.T) {
+	typings, err := GenerateAbiTypeScript(AbiTypeScriptArgs{
+		ContractName: "Matrix",
+		Abi:          `[{"type":"function","name":"grid","stateMutability":"view","inputs":[],"outputs":[{"name":"cells","type":"uint64[][]"},{"name":"label","type":"string"}]}]`,
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, typings.Code, "matrixAddress")
+	assert.Contains(t, typings.Code, "export type MatrixGridReturn = readonly [cells: readonly (readonly bigint[])[], label: string];")
+}
+
+func TestGenerateAbiTypeScriptInvalidAbi(t *testing.T) {
+	_, err := GenerateAbiTypeScript(AbiTypeScriptArgs{
+		ContractName: "Broken",
+		Abi:          `not json`,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DefaultAdminRole is the OpenZeppelin DEFAULT_ADMIN_ROLE, the admin of every role unless changed
+const DefaultAdminRole = "0x0000000000000000000000000000000000000000000000000000000000000000"
+
+// AccessControlAbi is the subset of the OpenZeppelin AccessControl ABI used to manage roles
+const AccessControlAbi = `[
+	{"type":"function","name":"grantRole","stateMutability":"nonpayable","inputs":[{"name":"role","type":"bytes32"},{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"function","name":"revokeRole","stateMutability":"nonpayable","inputs":[{"name":"role","type":"bytes32"},{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"function","name":"renounceRole","stateMutability":"nonpayable","inputs":[{"name":"role","type":"bytes32"},{"name":"callerConfirmation","type":"address"}],"outputs":[]},
+	{"type":"function","name":"hasRole","stateMutability":"view","inputs":[{"name":"role","type":"bytes32"},{"name":"account","type":"address"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"RoleGranted","anonymous":false,"inputs":[{"name":"role","type":"bytes32","indexed":true},{"name":"account","type":"address","indexed":true},{"name":"sender","type":"address","indexed":true}]},
+	{"type":"event","name":"RoleRevoked","anonymous":false,"inputs":[{"name":"role","type":"bytes32","indexed":true},{"name":"account","type":"address","indexed":true},{"name":"sender","type":"address","indexed":true}]}
+]`
+
+var (
+	roleGrantedTopic = crypto.Keccak256Hash([]byte("RoleGranted(bytes32,address,address)")).Hex()
+	roleRevokedTopic = crypto.Keccak256Hash([]byte("RoleRevoked(bytes32,address,address)")).Hex()
+
+	roleNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	roleHashRegex = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+)
+
+// ResolveRole converts a role name such as MINTER_ROLE to the bytes32 role identifier, the keccak256 of the name.
+// DEFAULT_ADMIN_ROLE resolves to the zero hash and bytes32 hex identifiers are returned as is.
+func ResolveRole(role string) (string, error) {
+	role = strings.TrimSpace(role)
+	switch {
+	case role == "DEFAULT_ADMIN_ROLE":
+		return DefaultAdminRole, nil
+	case roleHashRegex.MatchString(role):
+		return strings.ToLower(role), nil
+	case roleNameRegex.MatchString(role):
+		return crypto.Keccak256Hash([]byte(role)).Hex(), nil
+	default:
+		return "", fmt.Errorf("role must be a role name such as MINTER_ROLE or a bytes32 hex identifier, got %q", role)
+	}
+}
+
+// RoleEvent is a RoleGranted or RoleRevoked event of an AccessControl contract
+type RoleEvent struct {
+	Role        string
+	Account     string
+	Granted     bool
+	BlockNumber uint64
+	LogIndex    uint64
+}
+
+// FetchRoleEvents returns the RoleGranted and RoleRevoked events emitted by the contract from fromBlock over at most
+// maxBlocks blocks, in chain order. It also returns the last block of the range and the latest block, the contract is
+// caught up when both are equal
+func FetchRoleEvents(rpcURL, contractAddress string, fromBlock, maxBlocks uint64) ([]RoleEvent, uint64, uint64, error) {
+	client := NewRPCClient(rpcURL)
+
+	latestHex, err := client.GetBlockNumber()
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	latest, err := strconv.ParseUint(strings.TrimPrefix(latestHex, "0x"), 16, 64)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to parse latest block: %w", err)
+	}
+	if fromBlock > latest {
+		return []RoleEvent{}, latest, latest, nil
+	}
+	toBlock := latest
+	if maxBlocks > 0 && latest-fromBlock >= maxBlocks {
+		toBlock = fromBlock + maxBlocks - 1
+	}
+
+	filter := map[string]interface{}{
+		"address":   contractAddress,
+		"fromBlock": fmt.Sprintf("0x%x", fromBlock),
+		"toBlock":   fmt.Sprintf("0x%x", toBlock),
+		"topics":    []interface{}{[]string{roleGrantedTopic, roleRevokedTopic}},
+	}
+	response, err := client.Call("eth_getLogs", []interface{}{filter})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to get role logs: %w", err)
+	}
+
+	logsJSON, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to marshal logs: %w", err)
+	}
+
+	var logs []struct {
+		Topics      []string `json:"topics"`
+		BlockNumber string   `json:"blockNumber"`
+		LogIndex    string   `json:"logIndex"`
+	}
+	if err := json.Unmarshal(logsJSON, &logs); err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to unmarshal logs: %w", err)
+	}
+
+	events := []RoleEvent{}
+	for _, entry := range logs {
+		if len(entry.Topics) < 3 {
+			continue
+		}
+		blockNumber, _ := strconv.ParseUint(strings.TrimPrefix(entry.BlockNumber, "0x"), 16, 64)
+		logIndex, _ := strconv.ParseUint(strings.TrimPrefix(entry.LogIndex, "0x"), 16, 64)
+		events = append(events, RoleEvent{
+			Role:        strings.ToLower(entry.Topics[1]),
+			Account:     common.HexToAddress(entry.Topics[2]).Hex(),
+			Granted:     strings.EqualFold(entry.Topics[0], roleGrantedTopic),
+			BlockNumber: blockNumber,
+			LogIndex:    logIndex,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber == events[j].BlockNumber {
+			return events[i].LogIndex < events[j].LogIndex
+		}
+		return events[i].BlockNumber < events[j].BlockNumber
+	})
+
+	return events, toBlock, latest, nil
+}
+
+// knownRoleNames are the role names used by the OpenZeppelin presets and wizard
+var knownRoleNames = []string{"DEFAULT_ADMIN_ROLE", "MINTER_ROLE", "BURNER_ROLE", "PAUSER_ROLE", "UPGRADER_ROLE", "OPERATOR_ROLE", "URI_SETTER_ROLE", "SNAPSHOT_ROLE", "PROPOSER_ROLE", "EXECUTOR_ROLE", "CANCELLER_ROLE"}
+
+// RoleName returns the well known name of a bytes32 role identifier, or an empty string when the role is unknown
+func RoleName(role string) string {
+	for _, name := range knownRoleNames {
+		if resolved, _ := ResolveRole(name); strings.EqualFold(resolved, role) {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveRole(t *testing.T) {
+	role, err := ResolveRole("DEFAULT_ADMIN_ROLE")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAdminRole, role)
+
+	role, err = ResolveRole("MINTER_ROLE")
+	require.NoError(t, err)
+	assert.Equal(t, "0x9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a6", role)
+
+	role, err = ResolveRole("0x9F2DF0FED2C77648DE5860A4CC508CD0818C85B8B8A1AB4CEEEF8D981C8956A6")
+	require.NoError(t, err)
+	assert.Equal(t, "0x9f2df0fed2c77648de5860a4cc508cd0818c85b8b8a1ab4ceeef8d981c8956a6", role)
+
+	_, err = ResolveRole("minter role")
+	assert.Error(t, err)
+	_, err = ResolveRole("0x1234")
+	assert.Error(t, err)
+}
+
+func TestRoleName(t *testing.T) {
+	minterRole, _ := ResolveRole("MINTER_ROLE")
+	assert.Equal(t, "MINTER_ROLE", RoleName(minterRole))
+	assert.Equal(t, "DEFAULT_ADMIN_ROLE", RoleName(DefaultAdminRole))
+	assert.Empty(t, RoleName(crypto.Keccak256Hash([]byte("CUSTOM_ROLE")).Hex()))
+}
+
+func TestFetchRoleEvents(t *testing.T) {
+	minterRole, _ := ResolveRole("MINTER_ROLE")
+	account := "0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	sender := "0x000000000000000000000000bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+
+	var filter map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var result interface{}
+		switch request.Method {
+		case "eth_blockNumber":
+			result = "0x10"
+		case "eth_getLogs":
+			filter = request.Params[0].(map[string]interface{})
+			// Returned out of order to check the sorting
+			result = []map[string]interface{}{
+				{"topics": []string{roleRevokedTopic, minterRole, account, sender}, "blockNumber": "0x5", "logIndex": "0x0"},
+				{"topics": []string{roleGrantedTopic, minterRole, account, sender}, "blockNumber": "0x2", "logIndex": "0x1"},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result})
+	}))
+	defer server.Close()
+
+	events, synced, latest, err := FetchRoleEvents(server.URL, "0x1111111111111111111111111111111111111111", 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16), latest)
+	assert.Equal(t, uint64(16), synced)
+	assert.Equal(t, "0x1", filter["fromBlock"])
+	assert.Equal(t, "0x10", filter["toBlock"])
+
+	require.Len(t, events, 2)
+	assert.True(t, events[0].Granted)
+	assert.Equal(t, uint64(2), events[0].BlockNumber)
+	assert.Equal(t, minterRole, events[0].Role)
+	assert.Equal(t, "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa", events[0].Account)
+	assert.False(t, events[1].Granted)
+
+	// The range is bounded by maxBlocks
+	_, synced, latest, err = FetchRoleEvents(server.URL, "0x1111111111111111111111111111111111111111", 1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), synced)
+	assert.Equal(t, uint64(16), latest)
+	assert.Equal(t, "0x4", filter["toBlock"])
+
+	// Nothing to fetch once synced past the latest block
+	events, _, _, err = FetchRoleEvents(server.URL, "0x1111111111111111111111111111111111111111", 17, 0)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
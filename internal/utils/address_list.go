@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// AddressListFunction is a contract function that adds addresses to or removes them from a blacklist or whitelist
+type AddressListFunction struct {
+	Name string
+	// Batch functions take an address[] and update every address in one call
+	Batch bool
+	// WithFlag functions are setters such as setBlacklisted(address,bool) used for both adding and removing
+	WithFlag bool
+}
+
+// addressListFunctionNames are the function names used by common blacklist and whitelist token templates
+var addressListFunctionNames = map[string]map[bool][]string{
+	"blacklist": {
+		true:  {"addToBlacklist", "blacklist", "addBlacklist", "batchBlacklist", "blacklistAccounts"},
+		false: {"removeFromBlacklist", "unBlacklist", "unblacklist", "removeBlacklist", "batchUnBlacklist"},
+	},
+	"whitelist": {
+		true:  {"addToWhitelist", "whitelist", "addWhitelist", "batchWhitelist", "whitelistAccounts"},
+		false: {"removeFromWhitelist", "unWhitelist", "unwhitelist", "removeWhitelist", "batchUnWhitelist"},
+	},
+}
+
+// addressListSetterNames are the setters that take the address and a bool telling whether it is on the list
+var addressListSetterNames = map[string][]string{
+	"blacklist": {"setBlacklist", "setBlacklisted", "setBlacklistStatus"},
+	"whitelist": {"setWhitelist", "setWhitelisted", "setWhitelistStatus"},
+}
+
+// FindAddressListFunction finds the function of the ABI that adds (add is true) or removes addresses for the list type
+// (blacklist or whitelist). Batch functions are preferred over single address functions.
+func FindAddressListFunction(abiJSON, listType string, add bool) (*AddressListFunction, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	names, ok := addressListFunctionNames[listType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported list type: %s", listType)
+	}
+
+	var found *AddressListFunction
+	consider := func(candidate *AddressListFunction) {
+		if found == nil || (candidate.Batch && !found.Batch) {
+			found = candidate
+		}
+	}
+
+	for _, method := range parsedABI.Methods {
+		if method.IsConstant() {
+			continue
+		}
+
+		for _, name := range names[add] {
+			if strings.EqualFold(method.RawName, name) && len(method.Inputs) == 1 {
+				if batch, ok := addressInput(method.Inputs[0].Type); ok {
+					consider(&AddressListFunction{Name: method.RawName, Batch: batch})
+				}
+			}
+		}
+
+		for _, name := range addressListSetterNames[listType] {
+			if strings.EqualFold(method.RawName, name) && len(method.Inputs) == 2 && method.Inputs[1].Type.T == abi.BoolTy {
+				if batch, ok := addressInput(method.Inputs[0].Type); ok {
+					consider(&AddressListFunction{Name: method.RawName, Batch: batch, WithFlag: true})
+				}
+			}
+		}
+	}
+
+	if found == nil {
+		action := "remove from"
+		if add {
+			action = "add to"
+		}
+		return nil, fmt.Errorf("contract has no function to %s the %s", action, listType)
+	}
+	return found, nil
+}
+
+// addressInput reports whether the type is an address or an address array, batch is true for arrays
+func addressInput(t abi.Type) (batch bool, ok bool) {
+	if t.T == abi.AddressTy {
+		return false, true
+	}
+	if t.T == abi.SliceTy && t.Elem.T == abi.AddressTy {
+		return true, true
+	}
+	return false, false
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const addressListTestAbi = `[
+	{"type":"function","name":"addToBlacklist","stateMutability":"nonpayable","inputs":[{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"function","name":"batchBlacklist","stateMutability":"nonpayable","inputs":[{"name":"accounts","type":"address[]"}],"outputs":[]},
+	{"type":"function","name":"removeFromBlacklist","stateMutability":"nonpayable","inputs":[{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"function","name":"setWhitelisted","stateMutability":"nonpayable","inputs":[{"name":"account","type":"address"},{"name":"value","type":"bool"}],"outputs":[]},
+	{"type":"function","name":"blacklist","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+func TestFindAddressListFunction(t *testing.T) {
+	// Batch functions are preferred
+	function, err := FindAddressListFunction(addressListTestAbi, "blacklist", true)
+	require.NoError(t, err)
+	assert.Equal(t, AddressListFunction{Name: "batchBlacklist", Batch: true}, *function)
+
+	function, err = FindAddressListFunction(addressListTestAbi, "blacklist", false)
+	require.NoError(t, err)
+	assert.Equal(t, AddressListFunction{Name: "removeFromBlacklist"}, *function)
+
+	// Setters are used for both directions
+	function, err = FindAddressListFunction(addressListTestAbi, "whitelist", true)
+	require.NoError(t, err)
+	assert.Equal(t, AddressListFunction{Name: "setWhitelisted", WithFlag: true}, *function)
+
+	function, err = FindAddressListFunction(addressListTestAbi, "whitelist", false)
+	require.NoError(t, err)
+	assert.Equal(t, AddressListFunction{Name: "setWhitelisted", WithFlag: true}, *function)
+}
+
+func TestFindAddressListFunctionIgnoresViewFunctions(t *testing.T) {
+	abiJSON := `[{"type":"function","name":"blacklist","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+	_, err := FindAddressListFunction(abiJSON, "blacklist", true)
+	assert.ErrorContains(t, err, "no function to add to the blacklist")
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// unlimitedAllowanceThreshold is the allowance above which an approval is reported as unlimited.
+// Wallets and routers approve MaxUint256, which some tokens decrease on every transfer.
+var unlimitedAllowanceThreshold = new(big.Int).Lsh(big.NewInt(1), 255)
+
+// ERC20Allowance is the allowance of a spender to transfer the tokens of an owner
+type ERC20Allowance struct {
+	Allowance *big.Int
+	Unlimited bool
+}
+
+// GetERC20Allowance reads allowance(owner, spender) of an ERC-20 token
+func GetERC20Allowance(rpcURL, tokenAddress, ownerAddress, spenderAddress string) (*ERC20Allowance, error) {
+	client := NewRPCClient(rpcURL)
+
+	// ERC-20 allowance function signature: 0xdd62ed3e
+	data := "0xdd62ed3e" +
+		common.Bytes2Hex(common.LeftPadBytes(common.HexToAddress(ownerAddress).Bytes(), 32)) +
+		common.Bytes2Hex(common.LeftPadBytes(common.HexToAddress(spenderAddress).Bytes(), 32))
+
+	response, err := client.Call("eth_call", []interface{}{
+		map[string]string{
+			"to":   tokenAddress,
+			"data": data,
+		},
+		"latest",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+
+	allowanceHex, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid response format")
+	}
+
+	allowanceHex = strings.TrimPrefix(allowanceHex, "0x")
+	if allowanceHex == "" {
+		return nil, fmt.Errorf("token %s returned no allowance", tokenAddress)
+	}
+	allowance, ok := new(big.Int).SetString(allowanceHex, 16)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse allowance")
+	}
+
+	return &ERC20Allowance{
+		Allowance: allowance,
+		Unlimited: allowance.Cmp(unlimitedAllowanceThreshold) >= 0,
+	}, nil
+}
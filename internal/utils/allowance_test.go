@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetERC20Allowance(t *testing.T) {
+	spender := "0x2222222222222222222222222222222222222222"
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int                 `json:"id"`
+			Params []map[string]string `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		// The spender is the second argument of allowance(owner, spender)
+		data := request.Params[0]["data"]
+		allowance := big.NewInt(0)
+		if strings.HasSuffix(data, strings.TrimPrefix(spender, "0x")) {
+			allowance = maxUint256
+		}
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: "0x" + common.Bytes2Hex(common.LeftPadBytes(allowance.Bytes(), 32))})
+	}))
+	defer server.Close()
+
+	owner := "0x1111111111111111111111111111111111111111"
+	token := "0x3333333333333333333333333333333333333333"
+
+	result, err := GetERC20Allowance(server.URL, token, owner, spender)
+	require.NoError(t, err)
+	assert.Equal(t, maxUint256, result.Allowance)
+	assert.True(t, result.Unlimited)
+
+	result, err = GetERC20Allowance(server.URL, token, owner, owner)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), result.Allowance.Int64())
+	assert.False(t, result.Unlimited)
+}
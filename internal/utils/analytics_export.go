@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// ExportDataset is a table of launch data that can be exported for analytics platforms
+type ExportDataset string
+
+const (
+	ExportDatasetDeployments ExportDataset = "deployments"
+	ExportDatasetPools       ExportDataset = "pools"
+	ExportDatasetSwaps       ExportDataset = "swaps"
+)
+
+// The column schemas are part of the export contract, new columns must only be appended
+var (
+	DeploymentExportColumns = []string{
+		"deployment_id", "chain_id", "chain_name", "template_name", "contract_address",
+		"deployer_address", "transaction_hash", "status", "created_at",
+	}
+	PoolExportColumns = []string{
+		"pool_id", "chain_id", "token_address", "pair_address", "uniswap_version", "token0", "token1",
+		"initial_token0", "initial_token1", "creator_address", "transaction_hash", "status", "created_at",
+	}
+	SwapExportColumns = []string{
+		"snapshot_id", "pool_id", "chain_id", "pair_address", "from_token", "to_token", "amount",
+		"reserve0", "reserve1", "price", "transaction_hash", "session_id", "created_at",
+	}
+)
+
+// SwapExportRecord is a swap recorded on a pool together with the swap parameters from its session
+type SwapExportRecord struct {
+	Snapshot  models.PoolSnapshot
+	Pool      models.LiquidityPool
+	ChainID   string
+	FromToken string
+	ToToken   string
+	Amount    string
+}
+
+// GetExportColumns returns the column schema of the dataset
+func GetExportColumns(dataset ExportDataset) ([]string, error) {
+	switch dataset {
+	case ExportDatasetDeployments:
+		return DeploymentExportColumns, nil
+	case ExportDatasetPools:
+		return PoolExportColumns, nil
+	case ExportDatasetSwaps:
+		return SwapExportColumns, nil
+	default:
+		return nil, fmt.Errorf("unsupported dataset %q, must be one of deployments, pools, swaps", dataset)
+	}
+}
+
+// DeploymentExportRows converts deployments to rows following DeploymentExportColumns
+func DeploymentExportRows(deployments []models.Deployment) [][]string {
+	rows := make([][]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(deployment.ID), 10),
+			deployment.Chain.NetworkID,
+			deployment.Chain.Name,
+			deployment.Template.Name,
+			strings.ToLower(deployment.ContractAddress),
+			strings.ToLower(deployment.DeployerAddress),
+			deployment.TransactionHash,
+			string(deployment.Status),
+			formatExportTime(deployment.CreatedAt),
+		})
+	}
+	return rows
+}
+
+// PoolExportRows converts pools to rows following PoolExportColumns.
+// chainIDs maps the pool ID to the chain ID of the pool
+func PoolExportRows(pools []models.LiquidityPool, chainIDs map[uint]string) [][]string {
+	rows := make([][]string, 0, len(pools))
+	for _, pool := range pools {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(pool.ID), 10),
+			chainIDs[pool.ID],
+			strings.ToLower(pool.TokenAddress),
+			strings.ToLower(pool.PairAddress),
+			pool.UniswapVersion,
+			strings.ToLower(pool.Token0),
+			strings.ToLower(pool.Token1),
+			pool.InitialToken0,
+			pool.InitialToken1,
+			strings.ToLower(pool.CreatorAddress),
+			pool.TransactionHash,
+			string(pool.Status),
+			formatExportTime(pool.CreatedAt),
+		})
+	}
+	return rows
+}
+
+// SwapExportRows converts swap records to rows following SwapExportColumns
+func SwapExportRows(records []SwapExportRecord) [][]string {
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(record.Snapshot.ID), 10),
+			strconv.FormatUint(uint64(record.Pool.ID), 10),
+			record.ChainID,
+			strings.ToLower(record.Pool.PairAddress),
+			strings.ToLower(record.FromToken),
+			strings.ToLower(record.ToToken),
+			record.Amount,
+			record.Snapshot.Reserve0,
+			record.Snapshot.Reserve1,
+			strconv.FormatFloat(record.Snapshot.Price, 'g', -1, 64),
+			record.Snapshot.TransactionHash,
+			record.Snapshot.SessionId,
+			formatExportTime(record.Snapshot.CreatedAt),
+		})
+	}
+	return rows
+}
+
+// WriteCSV writes the header and rows as CSV
+func WriteCSV(w io.Writer, columns []string, rows [][]string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if len(row) != len(columns) {
+			return fmt.Errorf("row has %d values, expected %d", len(row), len(columns))
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatExportTime formats timestamps as RFC3339 in UTC so they parse the same on every platform
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
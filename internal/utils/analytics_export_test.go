@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExportColumns(t *testing.T) {
+	columns, err := GetExportColumns(ExportDatasetSwaps)
+	require.NoError(t, err)
+	assert.Equal(t, SwapExportColumns, columns)
+
+	_, err = GetExportColumns("transfers")
+	assert.ErrorContains(t, err, "unsupported dataset")
+}
+
+func TestDeploymentExportRows(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+8", 8*3600))
+	rows := DeploymentExportRows([]models.Deployment{
+		{
+			ID:              7,
+			ContractAddress: "0xAbCdEf0000000000000000000000000000000001",
+			DeployerAddress: "0xAbCdEf0000000000000000000000000000000002",
+			TransactionHash: "0xhash",
+			Status:          models.TransactionStatusConfirmed,
+			CreatedAt:       createdAt,
+			Chain:           models.Chain{NetworkID: "1", Name: "Ethereum"},
+			Template:        models.Template{Name: "My Token"},
+		},
+	})
+
+	require.Len(t, rows, 1)
+	require.Len(t, rows[0], len(DeploymentExportColumns))
+	assert.Equal(t, []string{
+		"7", "1", "Ethereum", "My Token",
+		"0xabcdef0000000000000000000000000000000001",
+		"0xabcdef0000000000000000000000000000000002",
+		"0xhash", "confirmed", "2025-01-01T19:04:05Z",
+	}, rows[0])
+}
+
+func TestSwapExportRowsMatchColumns(t *testing.T) {
+	rows := SwapExportRows([]SwapExportRecord{
+		{
+			Snapshot: models.PoolSnapshot{ID: 3, Reserve0: "100", Reserve1: "200", Price: 2},
+			Pool:     models.LiquidityPool{ID: 1, PairAddress: "0xPAIR"},
+			ChainID:  "1",
+			Amount:   "10",
+		},
+	})
+
+	require.Len(t, rows, 1)
+	assert.Len(t, rows[0], len(SwapExportColumns))
+	assert.Equal(t, "0xpair", rows[0][3])
+	assert.Equal(t, "2", rows[0][9])
+	// zero timestamps are exported as empty values
+	assert.Equal(t, "", rows[0][12])
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, []string{"a", "b"}, [][]string{{"1", "with, comma"}})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}, {"1", "with, comma"}}, records)
+
+	err = WriteCSV(&bytes.Buffer{}, []string{"a", "b"}, [][]string{{"1"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// uniswapV2SwapTopic is the topic0 of Swap(address,uint256,uint256,uint256,uint256,address)
+	uniswapV2SwapTopic = "0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822"
+	// uniswapV2SyncTopic is the topic0 of Sync(uint112,uint112)
+	uniswapV2SyncTopic = "0x1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1"
+)
+
+// duneChainPattern matches the chain names Dune uses as table name prefixes
+var duneChainPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// duneChains maps EVM chain IDs to the chain names used in Dune table names
+var duneChains = map[string]string{
+	"1":        "ethereum",
+	"10":       "optimism",
+	"56":       "bnb",
+	"100":      "gnosis",
+	"137":      "polygon",
+	"8453":     "base",
+	"42161":    "arbitrum",
+	"43114":    "avalanche_c",
+	"59144":    "linea",
+	"534352":   "scroll",
+	"11155111": "sepolia",
+}
+
+type AnalyticsQueryArgs struct {
+	ChainID         string
+	ContractAddress string
+	// PairAddress is optional, pool queries are only generated when it is set
+	PairAddress string
+	// DuneChain overrides the chain name derived from ChainID
+	DuneChain string
+}
+
+type AnalyticsQuery struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SQL         string `json:"sql"`
+}
+
+// GetDuneChain returns the Dune chain name for an EVM chain ID
+func GetDuneChain(chainID string) (string, bool) {
+	chain, ok := duneChains[chainID]
+	return chain, ok
+}
+
+// GenerateAnalyticsQueries generates starter DuneSQL queries for a launched token and its pool
+func GenerateAnalyticsQueries(args AnalyticsQueryArgs) ([]AnalyticsQuery, error) {
+	if !IsValidEthereumAddress(args.ContractAddress) {
+		return nil, fmt.Errorf("invalid contract address: %s", args.ContractAddress)
+	}
+	if args.PairAddress != "" && !IsValidEthereumAddress(args.PairAddress) {
+		return nil, fmt.Errorf("invalid pair address: %s", args.PairAddress)
+	}
+
+	chain := args.DuneChain
+	if chain != "" && !duneChainPattern.MatchString(chain) {
+		return nil, fmt.Errorf("invalid Dune chain name: %s", chain)
+	}
+	if chain == "" {
+		var ok bool
+		chain, ok = GetDuneChain(args.ChainID)
+		if !ok {
+			return nil, fmt.Errorf("chain %s is not indexed by Dune, provide the Dune chain name explicitly", args.ChainID)
+		}
+	}
+
+	// DuneSQL takes addresses as varbinary literals
+	token := strings.ToLower(args.ContractAddress)
+	queries := []AnalyticsQuery{
+		{
+			Name:        "token_transfers",
+			Description: "Latest transfers of the token",
+			SQL: fmt.Sprintf(`SELECT evt_block_time, evt_tx_hash, "from", "to", value
+FROM erc20_%s.evt_Transfer
+WHERE contract_address = %s
+ORDER BY evt_block_time DESC
+LIMIT 1000`, chain, token),
+		},
+		{
+			Name:        "token_holders",
+			Description: "Current holder balances computed from transfers",
+			SQL: fmt.Sprintf(`WITH balances AS (
+    SELECT "to" AS holder, CAST(value AS INT256) AS amount
+    FROM erc20_%[1]s.evt_Transfer
+    WHERE contract_address = %[2]s
+    UNION ALL
+    SELECT "from" AS holder, -CAST(value AS INT256) AS amount
+    FROM erc20_%[1]s.evt_Transfer
+    WHERE contract_address = %[2]s
+)
+SELECT holder, SUM(amount) AS balance
+FROM balances
+WHERE holder != 0x0000000000000000000000000000000000000000
+GROUP BY holder
+HAVING SUM(amount) > 0
+ORDER BY balance DESC`, chain, token),
+		},
+		{
+			Name:        "daily_transfers",
+			Description: "Daily number of transfers and unique senders of the token",
+			SQL: fmt.Sprintf(`SELECT date_trunc('day', evt_block_time) AS day,
+    COUNT(*) AS transfers,
+    COUNT(DISTINCT "from") AS senders
+FROM erc20_%s.evt_Transfer
+WHERE contract_address = %s
+GROUP BY 1
+ORDER BY 1`, chain, token),
+		},
+	}
+
+	if args.PairAddress == "" {
+		return queries, nil
+	}
+
+	pair := strings.ToLower(args.PairAddress)
+	queries = append(queries,
+		AnalyticsQuery{
+			Name:        "pool_swaps",
+			Description: "Latest swaps of the Uniswap V2 pool decoded from raw logs",
+			SQL: fmt.Sprintf(`SELECT block_time, tx_hash,
+    bytearray_to_uint256(bytearray_substring(data, 1, 32)) AS amount0_in,
+    bytearray_to_uint256(bytearray_substring(data, 33, 32)) AS amount1_in,
+    bytearray_to_uint256(bytearray_substring(data, 65, 32)) AS amount0_out,
+    bytearray_to_uint256(bytearray_substring(data, 97, 32)) AS amount1_out
+FROM %s.logs
+WHERE contract_address = %s
+    AND topic0 = %s
+ORDER BY block_time DESC
+LIMIT 1000`, chain, pair, uniswapV2SwapTopic),
+		},
+		AnalyticsQuery{
+			Name:        "pool_reserves",
+			Description: "Reserve history of the Uniswap V2 pool from Sync events",
+			SQL: fmt.Sprintf(`SELECT block_time, tx_hash,
+    bytearray_to_uint256(bytearray_substring(data, 1, 32)) AS reserve0,
+    bytearray_to_uint256(bytearray_substring(data, 33, 32)) AS reserve1
+FROM %s.logs
+WHERE contract_address = %s
+    AND topic0 = %s
+ORDER BY block_time`, chain, pair, uniswapV2SyncTopic),
+		},
+		AnalyticsQuery{
+			Name:        "pool_daily_swaps",
+			Description: "Daily number of swaps and unique traders of the pool",
+			SQL: fmt.Sprintf(`SELECT date_trunc('day', l.block_time) AS day,
+    COUNT(*) AS swaps,
+    COUNT(DISTINCT t."from") AS traders
+FROM %[1]s.logs l
+JOIN %[1]s.transactions t ON t.hash = l.tx_hash AND t.block_number = l.block_number
+WHERE l.contract_address = %[2]s
+    AND l.topic0 = %[3]s
+GROUP BY 1
+ORDER BY 1`, chain, pair, uniswapV2SwapTopic),
+		},
+	)
+
+	return queries, nil
+}
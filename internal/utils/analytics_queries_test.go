@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	analyticsToken = "0xAbCdEf0000000000000000000000000000000001"
+	analyticsPair  = "0xAbCdEf0000000000000000000000000000000002"
+)
+
+func TestGenerateAnalyticsQueriesTokenOnly(t *testing.T) {
+	queries, err := GenerateAnalyticsQueries(AnalyticsQueryArgs{
+		ChainID:         "8453",
+		ContractAddress: analyticsToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, queries, 3)
+
+	for _, query := range queries {
+		assert.Contains(t, query.SQL, "erc20_base.evt_Transfer")
+		assert.Contains(t, query.SQL, "0xabcdef0000000000000000000000000000000001")
+	}
+}
+
+func TestGenerateAnalyticsQueriesWithPool(t *testing.T) {
+	queries, err := GenerateAnalyticsQueries(AnalyticsQueryArgs{
+		ChainID:         "1",
+		ContractAddress: analyticsToken,
+		PairAddress:     analyticsPair,
+	})
+	require.NoError(t, err)
+	require.Len(t, queries, 6)
+
+	names := []string{}
+	for _, query := range queries {
+		names = append(names, query.Name)
+	}
+	assert.Equal(t, []string{"token_transfers", "token_holders", "daily_transfers", "pool_swaps", "pool_reserves", "pool_daily_swaps"}, names)
+	assert.Contains(t, queries[3].SQL, "FROM ethereum.logs")
+	assert.Contains(t, queries[3].SQL, uniswapV2SwapTopic)
+	assert.Contains(t, queries[4].SQL, uniswapV2SyncTopic)
+}
+
+func TestGenerateAnalyticsQueriesChainOverride(t *testing.T) {
+	_, err := GenerateAnalyticsQueries(AnalyticsQueryArgs{
+		ChainID:         "31337",
+		ContractAddress: analyticsToken,
+	})
+	assert.ErrorContains(t, err, "not indexed by Dune")
+
+	queries, err := GenerateAnalyticsQueries(AnalyticsQueryArgs{
+		ChainID:         "31337",
+		ContractAddress: analyticsToken,
+		DuneChain:       "zksync",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, queries[0].SQL, "erc20_zksync.evt_Transfer")
+
+	_, err = GenerateAnalyticsQueries(AnalyticsQueryArgs{
+		ChainID:         "1",
+		ContractAddress: analyticsToken,
+		DuneChain:       "ethereum; DROP TABLE",
+	})
+	assert.ErrorContains(t, err, "invalid Dune chain name")
+}
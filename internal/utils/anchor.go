@@ -0,0 +1,543 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// EnvAnchorPath overrides the anchor binary building the Solana templates, defaults to anchor on the PATH
+	EnvAnchorPath = "LAUNCHPAD_ANCHOR_PATH"
+	// AnchorVersion is the anchor-lang release the built programs depend on
+	AnchorVersion = "0.30.1"
+	// anchorPlaceholderProgramID is declared for the build when the program does not declare its ID
+	anchorPlaceholderProgramID = "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS"
+	// anchorBuildTimeout bounds a cold anchor build, which compiles every crate of the workspace
+	anchorBuildTimeout = 10 * time.Minute
+)
+
+var (
+	anchorProgramModuleRegex  = regexp.MustCompile(`#\[program\]\s*pub\s+mod\s+(\w+)\s*\{`)
+	anchorInstructionRegex    = regexp.MustCompile(`pub\s+fn\s+(\w+)\s*(?:<[^>(]*>)?\s*\(`)
+	anchorStructRegex         = regexp.MustCompile(`((?:#\[[^\]]*\]\s*)+)pub\s+struct\s+(\w+)[^{;]*\{`)
+	anchorDeclareIDRegex      = regexp.MustCompile(`declare_id!\s*\(\s*"(\w+)"\s*\)`)
+	anchorArrayTypeRegex      = regexp.MustCompile(`^\[(.+);\s*(\d+)\]$`)
+	anchorDeriveAccountsRegex = regexp.MustCompile(`derive\([^)]*\bAccounts\b`)
+)
+
+// AnchorCompilationResult is the IDL of a validated Anchor program, Built is set when the anchor toolchain built it
+type AnchorCompilationResult struct {
+	Idl   models.JSON
+	Built bool
+}
+
+// AnchorPathFromEnv returns the anchor binary of LAUNCHPAD_ANCHOR_PATH, or anchor on the PATH
+func AnchorPathFromEnv() string {
+	if path := os.Getenv(EnvAnchorPath); path != "" {
+		return path
+	}
+	return "anchor"
+}
+
+// RenderAnchorTemplate renders a Solana template with the contract template helpers. Unlike Solidity templates
+// the code is rendered as plain text, the html escaping would rewrite the lifetimes (<'info>) of the program
+func RenderAnchorTemplate(templateCode string, values models.JSON) (string, error) {
+	tmpl, err := template.New("program").Funcs(ContractTemplateFuncs()).Parse(templateCode)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// CompileAnchorProgram validates the Anchor program and, when the anchor toolchain is installed, builds it.
+// The IDL is the one generated by anchor build, or the IDL extracted from the source without the toolchain
+func CompileAnchorProgram(ctx context.Context, code string) (AnchorCompilationResult, error) {
+	idl, err := ValidateAnchorProgram(code)
+	if err != nil {
+		return AnchorCompilationResult{}, err
+	}
+
+	anchorPath, err := exec.LookPath(AnchorPathFromEnv())
+	if err != nil {
+		return AnchorCompilationResult{Idl: idl}, nil
+	}
+	builtIdl, err := BuildAnchorProgram(ctx, anchorPath, code)
+	if err != nil {
+		return AnchorCompilationResult{}, err
+	}
+	return AnchorCompilationResult{Idl: builtIdl, Built: true}, nil
+}
+
+// ValidateAnchorProgram checks the code is a single file Anchor program and returns the IDL of its instructions and accounts
+func ValidateAnchorProgram(code string) (models.JSON, error) {
+	source := stripRustComments(code)
+	if err := checkRustDelimiters(source); err != nil {
+		return nil, err
+	}
+	if !strings.Contains(source, "anchor_lang") {
+		return nil, fmt.Errorf("the program must use anchor_lang (use anchor_lang::prelude::*;)")
+	}
+
+	module := anchorProgramModuleRegex.FindStringSubmatchIndex(source)
+	if module == nil {
+		return nil, fmt.Errorf("no #[program] module found, the instructions must be declared in a #[program] pub mod")
+	}
+	programName := source[module[2]:module[3]]
+	moduleEnd := matchingDelimiter(source, module[1]-1)
+	body := source[module[1]:moduleEnd]
+
+	structs := parseAnchorStructs(source)
+	structsByName := map[string]anchorStruct{}
+	for _, parsed := range structs {
+		structsByName[parsed.name] = parsed
+	}
+	var instructions []any
+	for _, match := range anchorInstructionRegex.FindAllStringSubmatchIndex(body, -1) {
+		name := body[match[2]:match[3]]
+		paramsEnd := matchingDelimiter(body, match[1]-1)
+		params := splitRustList(body[match[1]:paramsEnd])
+		if len(params) == 0 {
+			return nil, fmt.Errorf("instruction %s must take a Context as its first argument", name)
+		}
+
+		accountsName, err := anchorContextAccounts(params[0])
+		if err != nil {
+			return nil, fmt.Errorf("instruction %s: %w", name, err)
+		}
+		accounts, exists := structsByName[accountsName]
+		if !exists || accounts.kind != "accounts" {
+			return nil, fmt.Errorf("instruction %s uses the accounts %s, which is not a #[derive(Accounts)] struct", name, accountsName)
+		}
+
+		args := []any{}
+		for _, param := range params[1:] {
+			argName, argType, ok := strings.Cut(param, ":")
+			if !ok {
+				return nil, fmt.Errorf("instruction %s has an invalid argument %q", name, param)
+			}
+			args = append(args, map[string]any{
+				"name": strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(argName), "mut ")),
+				"type": anchorIdlType(argType),
+			})
+		}
+		instructions = append(instructions, map[string]any{
+			"name":          name,
+			"discriminator": anchorDiscriminator("global:" + name),
+			"accounts":      accounts.accounts(),
+			"args":          args,
+		})
+	}
+	if len(instructions) == 0 {
+		return nil, fmt.Errorf("the #[program] module %s declares no pub fn instruction", programName)
+	}
+
+	accounts := []any{}
+	types := []any{}
+	for _, parsed := range structs {
+		if parsed.kind != "account" {
+			continue
+		}
+		accounts = append(accounts, map[string]any{
+			"name":          parsed.name,
+			"discriminator": anchorDiscriminator("account:" + parsed.name),
+		})
+		types = append(types, map[string]any{
+			"name": parsed.name,
+			"type": map[string]any{"kind": "struct", "fields": parsed.typeFields()},
+		})
+	}
+
+	idl := models.JSON{
+		"metadata": map[string]any{
+			"name":    programName,
+			"version": "0.1.0",
+			"spec":    "0.1.0",
+		},
+		"instructions": instructions,
+		"accounts":     accounts,
+		"types":        types,
+	}
+	if declared := anchorDeclareIDRegex.FindStringSubmatch(code); declared != nil {
+		idl["address"] = declared[1]
+	}
+	return idl, nil
+}
+
+// BuildAnchorProgram builds the program in a temporary Anchor workspace with the anchor binary at anchorPath
+// and returns the IDL generated by the build
+func BuildAnchorProgram(ctx context.Context, anchorPath, code string) (models.JSON, error) {
+	module := anchorProgramModuleRegex.FindStringSubmatch(stripRustComments(code))
+	if module == nil {
+		return nil, fmt.Errorf("no #[program] module found")
+	}
+	programName := module[1]
+
+	release, err := acquireCompilationSlot()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	workspace, err := os.MkdirTemp("", "anchor-template-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Anchor workspace: %w", err)
+	}
+	defer os.RemoveAll(workspace)
+
+	// #[program] refers to the program ID, a placeholder is declared for the build when the template has none
+	programID := anchorPlaceholderProgramID
+	if declared := anchorDeclareIDRegex.FindStringSubmatch(code); declared != nil {
+		programID = declared[1]
+	} else {
+		code = fmt.Sprintf("anchor_lang::declare_id!(%q);\n\n%s", programID, code)
+	}
+
+	dependencies := fmt.Sprintf("anchor-lang = %q\n", AnchorVersion)
+	idlBuild := `["anchor-lang/idl-build"`
+	if strings.Contains(code, "anchor_spl") {
+		dependencies += fmt.Sprintf("anchor-spl = %q\n", AnchorVersion)
+		idlBuild += `, "anchor-spl/idl-build"`
+	}
+	idlBuild += "]"
+
+	files := map[string]string{
+		"Anchor.toml": fmt.Sprintf("[programs.localnet]\n%s = %q\n\n[provider]\ncluster = \"Localnet\"\nwallet = \"~/.config/solana/id.json\"\n", programName, programID),
+		"Cargo.toml":  "[workspace]\nmembers = [\"programs/*\"]\nresolver = \"2\"\n\n[profile.release]\noverflow-checks = true\n",
+		filepath.Join("programs", programName, "Cargo.toml"): fmt.Sprintf("[package]\nname = %q\nversion = \"0.1.0\"\nedition = \"2021\"\n\n"+
+			"[lib]\ncrate-type = [\"cdylib\", \"lib\"]\nname = %q\n\n"+
+			"[features]\ndefault = []\ncpi = [\"no-entrypoint\"]\nno-entrypoint = []\nno-idl = []\nno-log-ix-name = []\nidl-build = %s\n\n"+
+			"[dependencies]\n%s", programName, programName, idlBuild, dependencies),
+		filepath.Join("programs", programName, "src", "lib.rs"): code,
+	}
+	for name, content := range files {
+		path := filepath.Join(workspace, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create Anchor workspace: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, anchorBuildTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, anchorPath, "build")
+	cmd.Dir = workspace
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("anchor build failed: %w\n%s", err, anchorBuildErrors(string(output)))
+	}
+
+	data, err := os.ReadFile(filepath.Join(workspace, "target", "idl", programName+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("anchor build generated no IDL: %w", err)
+	}
+	var idl models.JSON
+	if err := json.Unmarshal(data, &idl); err != nil {
+		return nil, fmt.Errorf("failed to parse the generated IDL: %w", err)
+	}
+	return idl, nil
+}
+
+// anchorBuildErrors keeps the end of the build output, where cargo reports the errors of the failed crate
+func anchorBuildErrors(output string) string {
+	const maxLines = 40
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// anchorStruct is a struct of the program, either a #[derive(Accounts)] struct or an #[account] struct
+type anchorStruct struct {
+	name   string
+	kind   string
+	fields []anchorField
+}
+
+type anchorField struct {
+	name       string
+	fieldType  string
+	attributes []string
+}
+
+// accounts returns the IDL accounts of an instruction, mutable accounts are the ones marked mut or init
+func (s anchorStruct) accounts() []any {
+	accounts := []any{}
+	for _, field := range s.fields {
+		account := map[string]any{"name": field.name}
+		for _, attribute := range field.attributes {
+			if !strings.HasPrefix(attribute, "account(") {
+				continue
+			}
+			for _, constraint := range splitRustList(strings.TrimSuffix(strings.TrimPrefix(attribute, "account("), ")")) {
+				if constraint == "mut" || constraint == "init" || constraint == "init_if_needed" {
+					account["writable"] = true
+				}
+			}
+		}
+		if strings.HasPrefix(field.fieldType, "Signer") {
+			account["signer"] = true
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+func (s anchorStruct) typeFields() []any {
+	fields := []any{}
+	for _, field := range s.fields {
+		fields = append(fields, map[string]any{"name": field.name, "type": anchorIdlType(field.fieldType)})
+	}
+	return fields
+}
+
+// parseAnchorStructs finds the #[derive(Accounts)] and #[account] structs of the stripped source in declaration order
+func parseAnchorStructs(source string) []anchorStruct {
+	var structs []anchorStruct
+	for _, match := range anchorStructRegex.FindAllStringSubmatchIndex(source, -1) {
+		attributes := strings.Join(strings.Fields(source[match[2]:match[3]]), "")
+		var kind string
+		switch {
+		case anchorDeriveAccountsRegex.MatchString(attributes):
+			kind = "accounts"
+		case strings.Contains(attributes, "#[account]") || strings.Contains(attributes, "#[account("):
+			kind = "account"
+		default:
+			continue
+		}
+
+		bodyEnd := matchingDelimiter(source, match[1]-1)
+		parsed := anchorStruct{name: source[match[4]:match[5]], kind: kind}
+		for _, field := range splitRustList(source[match[1]:bodyEnd]) {
+			var fieldAttributes []string
+			for strings.HasPrefix(field, "#[") {
+				end := matchingDelimiter(field, 1)
+				fieldAttributes = append(fieldAttributes, strings.Join(strings.Fields(field[2:end]), ""))
+				field = strings.TrimSpace(field[end+1:])
+			}
+			fieldName, fieldType, ok := strings.Cut(strings.TrimPrefix(field, "pub "), ":")
+			if !ok {
+				continue
+			}
+			parsed.fields = append(parsed.fields, anchorField{
+				name:       strings.TrimSpace(fieldName),
+				fieldType:  strings.TrimSpace(fieldType),
+				attributes: fieldAttributes,
+			})
+		}
+		structs = append(structs, parsed)
+	}
+	return structs
+}
+
+// anchorContextAccounts returns the accounts struct of the ctx: Context<'_, '_, '_, 'info, Accounts<'info>> argument
+func anchorContextAccounts(param string) (string, error) {
+	_, paramType, _ := strings.Cut(param, ":")
+	paramType = strings.TrimSpace(paramType)
+	if !strings.HasPrefix(paramType, "Context") {
+		return "", fmt.Errorf("the first argument must be a Context, got %q", param)
+	}
+	start := strings.Index(paramType, "<")
+	if start < 0 || !strings.HasSuffix(paramType, ">") {
+		return "", fmt.Errorf("the Context must name its accounts struct, got %q", paramType)
+	}
+	generics := splitRustList(paramType[start+1 : len(paramType)-1])
+	accounts := generics[len(generics)-1]
+	if lifetime := strings.Index(accounts, "<"); lifetime >= 0 {
+		accounts = accounts[:lifetime]
+	}
+	return strings.TrimSpace(accounts), nil
+}
+
+// anchorIdlType converts a Rust argument or field type to its Anchor IDL type
+func anchorIdlType(rustType string) any {
+	rustType = strings.Join(strings.Fields(rustType), "")
+	switch rustType {
+	case "u8", "u16", "u32", "u64", "u128", "i8", "i16", "i32", "i64", "i128", "f32", "f64", "bool":
+		return rustType
+	case "String":
+		return "string"
+	case "Pubkey":
+		return "pubkey"
+	case "Vec<u8>":
+		return "bytes"
+	}
+	if inner, ok := rustGeneric(rustType, "Vec"); ok {
+		return map[string]any{"vec": anchorIdlType(inner)}
+	}
+	if inner, ok := rustGeneric(rustType, "Option"); ok {
+		return map[string]any{"option": anchorIdlType(inner)}
+	}
+	if array := anchorArrayTypeRegex.FindStringSubmatch(rustType); array != nil {
+		length, _ := strconv.Atoi(array[2])
+		return map[string]any{"array": []any{anchorIdlType(array[1]), length}}
+	}
+	if path := strings.LastIndex(rustType, "::"); path >= 0 {
+		rustType = rustType[path+2:]
+	}
+	return map[string]any{"defined": map[string]any{"name": rustType}}
+}
+
+func rustGeneric(rustType, name string) (string, bool) {
+	if !strings.HasPrefix(rustType, name+"<") || !strings.HasSuffix(rustType, ">") {
+		return "", false
+	}
+	return rustType[len(name)+1 : len(rustType)-1], true
+}
+
+// anchorDiscriminator is the first 8 bytes of the sha256 of the namespaced name, as generated by Anchor
+func anchorDiscriminator(preimage string) []any {
+	hash := sha256.Sum256([]byte(preimage))
+	discriminator := make([]any, 8)
+	for i := range discriminator {
+		discriminator[i] = int(hash[i])
+	}
+	return discriminator
+}
+
+// stripRustComments blanks the comments and the string and char literals of the code, keeping the offsets and lines
+func stripRustComments(code string) string {
+	stripped := []byte(code)
+	blank := func(from, to int) {
+		for i := from; i < to && i < len(stripped); i++ {
+			if stripped[i] != '\n' {
+				stripped[i] = ' '
+			}
+		}
+	}
+	for i := 0; i < len(code); i++ {
+		switch {
+		case strings.HasPrefix(code[i:], "//"):
+			end := strings.IndexByte(code[i:], '\n')
+			if end < 0 {
+				end = len(code) - i
+			}
+			blank(i, i+end)
+			i += end
+		case strings.HasPrefix(code[i:], "/*"):
+			end := strings.Index(code[i+2:], "*/")
+			if end < 0 {
+				end = len(code) - i
+			} else {
+				end += 4
+			}
+			blank(i, i+end)
+			i += end - 1
+		case code[i] == '"':
+			end := i + 1
+			for end < len(code) && code[end] != '"' {
+				if code[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			blank(i+1, end)
+			i = end
+		case code[i] == '\'':
+			// Char literals, lifetimes ('info) have no closing quote
+			if i+2 < len(code) && code[i+1] != '\\' && code[i+2] == '\'' {
+				blank(i+1, i+2)
+				i += 2
+			} else if i+3 < len(code) && code[i+1] == '\\' && code[i+3] == '\'' {
+				blank(i+1, i+3)
+				i += 3
+			}
+		}
+	}
+	return string(stripped)
+}
+
+// checkRustDelimiters checks the braces, brackets and parentheses of the stripped code are balanced
+func checkRustDelimiters(source string) error {
+	closing := map[byte]byte{')': '(', ']': '[', '}': '{'}
+	var open []byte
+	line := 1
+	for i := 0; i < len(source); i++ {
+		switch char := source[i]; char {
+		case '\n':
+			line++
+		case '(', '[', '{':
+			open = append(open, char)
+		case ')', ']', '}':
+			if len(open) == 0 || open[len(open)-1] != closing[char] {
+				return fmt.Errorf("unexpected %q on line %d", char, line)
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("unclosed %q at the end of the program", open[len(open)-1])
+	}
+	return nil
+}
+
+// matchingDelimiter returns the offset closing the delimiter at start, or the end of the source
+func matchingDelimiter(source string, start int) int {
+	depth := 0
+	for i := start; i < len(source); i++ {
+		switch source[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(source)
+}
+
+// splitRustList splits a comma separated list of the stripped code, ignoring the commas nested in delimiters or generics
+func splitRustList(list string) []string {
+	var items []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '(', '[', '{', '<':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case '>':
+			// -> and => are not generics
+			if i == 0 || (list[i-1] != '-' && list[i-1] != '=') {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				items = append(items, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	items = append(items, list[start:])
+
+	var trimmed []string
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			trimmed = append(trimmed, item)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,216 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAnchorProgram = `use anchor_lang::prelude::*;
+
+declare_id!("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS");
+
+// The counter program { increments }
+#[program]
+pub mod counter {
+    use super::*;
+
+    pub fn initialize(ctx: Context<Initialize>, start: u64, label: String) -> Result<()> {
+        let counter = &mut ctx.accounts.counter;
+        counter.count = start;
+        counter.label = label;
+        msg!("initialized {}", "}");
+        Ok(())
+    }
+
+    pub fn increment<'info>(ctx: Context<'_, '_, '_, 'info, Increment<'info>>, by: Option<u64>, memo: Vec<u8>, owners: Vec<Pubkey>, seed: [u8; 32]) -> Result<()> {
+        ctx.accounts.counter.count += by.unwrap_or(1);
+        Ok(())
+    }
+}
+
+#[derive(Accounts)]
+#[instruction(start: u64)]
+pub struct Initialize<'info> {
+    #[account(init, payer = authority, space = 8 + 8 + 36, seeds = [b"counter"], bump)]
+    pub counter: Account<'info, Counter>,
+    #[account(mut)]
+    pub authority: Signer<'info>,
+    pub system_program: Program<'info, System>,
+}
+
+#[derive(Accounts)]
+pub struct Increment<'info> {
+    #[account(mut, has_one = authority)]
+    pub counter: Account<'info, Counter>,
+    pub authority: Signer<'info>,
+}
+
+#[account]
+pub struct Counter {
+    pub count: u64,
+    pub label: String,
+    pub authority: Pubkey,
+}
+`
+
+func TestValidateAnchorProgram(t *testing.T) {
+	idl, err := ValidateAnchorProgram(testAnchorProgram)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS", idl["address"])
+	assert.Equal(t, "counter", idl["metadata"].(map[string]any)["name"])
+
+	instructions := idl["instructions"].([]any)
+	require.Len(t, instructions, 2)
+	initialize := instructions[0].(map[string]any)
+	assert.Equal(t, "initialize", initialize["name"])
+	// sha256("global:initialize")[:8], the discriminator generated by Anchor
+	assert.Equal(t, []any{175, 175, 109, 31, 13, 152, 155, 237}, initialize["discriminator"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "counter", "writable": true},
+		map[string]any{"name": "authority", "writable": true, "signer": true},
+		map[string]any{"name": "system_program"},
+	}, initialize["accounts"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "start", "type": "u64"},
+		map[string]any{"name": "label", "type": "string"},
+	}, initialize["args"])
+
+	increment := instructions[1].(map[string]any)
+	assert.Equal(t, "increment", increment["name"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "by", "type": map[string]any{"option": "u64"}},
+		map[string]any{"name": "memo", "type": "bytes"},
+		map[string]any{"name": "owners", "type": map[string]any{"vec": "pubkey"}},
+		map[string]any{"name": "seed", "type": map[string]any{"array": []any{"u8", 32}}},
+	}, increment["args"])
+
+	accounts := idl["accounts"].([]any)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "Counter", accounts[0].(map[string]any)["name"])
+	types := idl["types"].([]any)
+	require.Len(t, types, 1)
+	assert.Equal(t, []any{
+		map[string]any{"name": "count", "type": "u64"},
+		map[string]any{"name": "label", "type": "string"},
+		map[string]any{"name": "authority", "type": "pubkey"},
+	}, types[0].(map[string]any)["type"].(map[string]any)["fields"])
+}
+
+func TestValidateAnchorProgramErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		errorMsg string
+	}{
+		{
+			name:     "not_anchor",
+			code:     "fn main() {}",
+			errorMsg: "must use anchor_lang",
+		},
+		{
+			name:     "no_program_module",
+			code:     strings.Replace(testAnchorProgram, "#[program]", "", 1),
+			errorMsg: "no #[program] module found",
+		},
+		{
+			name:     "unclosed_brace",
+			code:     strings.Replace(testAnchorProgram, "Ok(())\n    }\n}", "Ok(())\n    }", 1),
+			errorMsg: "unclosed",
+		},
+		{
+			name:     "unexpected_delimiter",
+			code:     strings.Replace(testAnchorProgram, "by.unwrap_or(1);", "by.unwrap_or(1));", 1),
+			errorMsg: "unexpected ')' on line",
+		},
+		{
+			name:     "no_instruction",
+			code:     "use anchor_lang::prelude::*;\n#[program]\npub mod counter {\n    use super::*;\n}\n",
+			errorMsg: "declares no pub fn instruction",
+		},
+		{
+			name:     "missing_context",
+			code:     strings.Replace(testAnchorProgram, "ctx: Context<Initialize>, ", "", 1),
+			errorMsg: "the first argument must be a Context",
+		},
+		{
+			name:     "undefined_accounts",
+			code:     strings.Replace(testAnchorProgram, "Context<Initialize>", "Context<Setup>", 1),
+			errorMsg: "uses the accounts Setup, which is not a #[derive(Accounts)] struct",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidateAnchorProgram(tt.code)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
+
+func TestRenderAnchorTemplate(t *testing.T) {
+	code, err := RenderAnchorTemplate(`pub const NAME: &str = "{{.TokenName}}";
+pub fn f<'info>(ctx: Context<Accounts<'info>>) {}`, map[string]any{"TokenName": "Counter & Co"})
+	require.NoError(t, err)
+	// Rendered as plain text, neither the lifetimes nor the values are html escaped
+	assert.Equal(t, `pub const NAME: &str = "Counter & Co";
+pub fn f<'info>(ctx: Context<Accounts<'info>>) {}`, code)
+}
+
+func TestCompileAnchorProgramWithoutToolchain(t *testing.T) {
+	t.Setenv(EnvAnchorPath, filepath.Join(t.TempDir(), "anchor"))
+
+	result, err := CompileAnchorProgram(context.Background(), testAnchorProgram)
+	require.NoError(t, err)
+	assert.False(t, result.Built)
+	assert.Len(t, result.Idl["instructions"], 2)
+}
+
+func TestCompileAnchorProgramWithToolchain(t *testing.T) {
+	// The fake anchor writes the IDL of the build and records the generated workspace
+	dir := t.TempDir()
+	anchorPath := filepath.Join(dir, "anchor")
+	script := `#!/bin/sh
+[ "$1" = "build" ] || exit 1
+cp programs/counter/src/lib.rs "` + dir + `/lib.rs"
+cp programs/counter/Cargo.toml "` + dir + `/Cargo.toml"
+mkdir -p target/idl
+echo '{"address": "Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS", "instructions": [{"name": "built"}]}' > target/idl/counter.json
+`
+	require.NoError(t, os.WriteFile(anchorPath, []byte(script), 0755))
+	t.Setenv(EnvAnchorPath, anchorPath)
+
+	// Without declare_id! a placeholder ID is declared for the build
+	code := strings.Replace(testAnchorProgram, `declare_id!("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS");`, "", 1)
+	result, err := CompileAnchorProgram(context.Background(), code)
+	require.NoError(t, err)
+	assert.True(t, result.Built)
+	assert.Equal(t, []any{map[string]any{"name": "built"}}, result.Idl["instructions"])
+
+	built, err := os.ReadFile(filepath.Join(dir, "lib.rs"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(built), `anchor_lang::declare_id!("Fg6PaFpoGXkYsidMpWTK6W2BeZ7FEfcYkg476zPFsLnS");`))
+	manifest, err := os.ReadFile(filepath.Join(dir, "Cargo.toml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), `anchor-lang = "`+AnchorVersion+`"`)
+	assert.NotContains(t, string(manifest), "anchor-spl")
+}
+
+func TestCompileAnchorProgramBuildFailure(t *testing.T) {
+	anchorPath := filepath.Join(t.TempDir(), "anchor")
+	script := "#!/bin/sh\necho 'error[E0425]: cannot find value `count` in this scope'\nexit 101\n"
+	require.NoError(t, os.WriteFile(anchorPath, []byte(script), 0755))
+	t.Setenv(EnvAnchorPath, anchorPath)
+
+	_, err := CompileAnchorProgram(context.Background(), testAnchorProgram)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "anchor build failed")
+	assert.Contains(t, err.Error(), "cannot find value `count`")
+}
@@ -162,6 +162,40 @@ func QueryERC20Balance(rpcURL, tokenAddress, walletAddress string) (*ERC20Balanc
 	}, nil
 }
 
+// QueryERC20TotalSupply queries the total supply of an ERC-20 token, such as the LP token of a pair
+func QueryERC20TotalSupply(rpcURL, tokenAddress string) (*big.Int, error) {
+	if !isValidAddress(tokenAddress) {
+		return nil, fmt.Errorf("invalid address format")
+	}
+
+	// ERC-20 totalSupply function signature: 0x18160ddd
+	response, err := NewRPCClient(rpcURL).Call("eth_call", []interface{}{
+		map[string]string{
+			"to":   tokenAddress,
+			"data": "0x18160ddd",
+		},
+		"latest",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call contract: %w", err)
+	}
+
+	if response.Result == nil {
+		return nil, fmt.Errorf("no result returned from contract call")
+	}
+
+	supplyHex, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid response format")
+	}
+
+	supply, success := new(big.Int).SetString(strings.TrimPrefix(supplyHex, "0x"), 16)
+	if !success {
+		return nil, fmt.Errorf("failed to parse total supply")
+	}
+	return supply, nil
+}
+
 // getTokenSymbol retrieves the symbol of an ERC-20 token
 func getTokenSymbol(client *RPCClient, tokenAddress string) (string, error) {
 	// ERC-20 symbol function signature: 0x95d89b41
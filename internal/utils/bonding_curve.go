@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// bondingCurveStateABI holds the views of the bonding curve template read to track a curve
+const bondingCurveStateABI = `[{"inputs":[],"name":"curveState","outputs":[{"name":"_ethRaised","type":"uint256"},{"name":"_tokensSold","type":"uint256"},{"name":"_virtualEth","type":"uint256"},{"name":"_virtualToken","type":"uint256"},{"name":"_graduated","type":"bool"}],"stateMutability":"view","type":"function"},` +
+	`{"inputs":[],"name":"pair","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
+// BondingCurveState is the state of a bonding curve contract, amounts are in wei and the smallest unit of the token
+type BondingCurveState struct {
+	EthRaised    *big.Int
+	TokensSold   *big.Int
+	VirtualEth   *big.Int
+	VirtualToken *big.Int
+	Graduated    bool
+	PairAddress  string
+}
+
+// BondingCurveReserves returns the initial virtual reserves of a curve like the constructor of the template: the curve
+// is sold out when the threshold is raised and its last price, threshold / (totalSupply - curveSupply), is the opening
+// price of the Uniswap pair
+func BondingCurveReserves(totalSupply, curveSupply, graduationThreshold *big.Int) (*big.Int, *big.Int, error) {
+	liquiditySupply := new(big.Int).Sub(totalSupply, curveSupply)
+	if liquiditySupply.Sign() <= 0 || new(big.Int).Mul(curveSupply, big.NewInt(2)).Cmp(totalSupply) <= 0 {
+		return nil, nil, fmt.Errorf("the curve supply must be more than half and less than all of the total supply")
+	}
+	if graduationThreshold.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("the graduation threshold must be greater than 0")
+	}
+
+	virtualEth := new(big.Int).Quo(new(big.Int).Mul(graduationThreshold, liquiditySupply), new(big.Int).Sub(curveSupply, liquiditySupply))
+	virtualToken := new(big.Int).Quo(new(big.Int).Mul(new(big.Int).Add(virtualEth, graduationThreshold), curveSupply), graduationThreshold)
+	if virtualEth.Sign() == 0 {
+		return nil, nil, fmt.Errorf("the graduation threshold is too small for the supply, the curve would start at a price of 0")
+	}
+	return virtualEth, virtualToken, nil
+}
+
+// ReadBondingCurveState reads the curve state and the pair of a bonding curve contract in one batch
+func ReadBondingCurveState(rpcURL string, contractAddress string) (*BondingCurveState, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(bondingCurveStateABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bonding curve ABI: %w", err)
+	}
+
+	methods := []string{"curveState", "pair"}
+	params := make([][]interface{}, len(methods))
+	for i, method := range methods {
+		data, err := parsedABI.Pack(method)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+		}
+		params[i] = []interface{}{map[string]string{"to": contractAddress, "data": hexutil.Encode(data)}, "latest"}
+	}
+
+	responses, err := NewRPCClient(rpcURL).BatchCall("eth_call", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the bonding curve %s: %w", contractAddress, err)
+	}
+	outputs := make([][]any, len(methods))
+	for i, response := range responses {
+		if response.Error != nil {
+			return nil, fmt.Errorf("failed to call %s on %s: %s", methods[i], contractAddress, response.Error.Message)
+		}
+		result, ok := response.Result.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid response format")
+		}
+		outputs[i], err = parsedABI.Unpack(methods[i], common.FromHex(result))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s of %s: %w", methods[i], contractAddress, err)
+		}
+	}
+
+	return &BondingCurveState{
+		EthRaised:    outputs[0][0].(*big.Int),
+		TokensSold:   outputs[0][1].(*big.Int),
+		VirtualEth:   outputs[0][2].(*big.Int),
+		VirtualToken: outputs[0][3].(*big.Int),
+		Graduated:    outputs[0][4].(bool),
+		PairAddress:  outputs[1][0].(common.Address).Hex(),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBondingCurveReserves(t *testing.T) {
+	totalSupply, _ := new(big.Int).SetString("1000000000000000000000000000", 10)
+	curveSupply, _ := new(big.Int).SetString("800000000000000000000000000", 10)
+	threshold, _ := new(big.Int).SetString("30000000000000000000", 10)
+
+	virtualEth, virtualToken, err := BondingCurveReserves(totalSupply, curveSupply, threshold)
+	require.NoError(t, err)
+	assert.Equal(t, "10000000000000000000", virtualEth.String())
+	assert.Equal(t, "1066666666666666666666666666", virtualToken.String())
+
+	// Sold out at the threshold, the last price of the curve is the opening price of the pair
+	liquiditySupply := new(big.Int).Sub(totalSupply, curveSupply)
+	lastPrice := new(big.Rat).SetFrac(new(big.Int).Add(virtualEth, threshold), new(big.Int).Sub(virtualToken, curveSupply))
+	poolPrice := new(big.Rat).SetFrac(threshold, liquiditySupply)
+	lastPriceFloat, _ := lastPrice.Float64()
+	poolPriceFloat, _ := poolPrice.Float64()
+	assert.InEpsilon(t, poolPriceFloat, lastPriceFloat, 1e-9)
+
+	_, _, err = BondingCurveReserves(totalSupply, new(big.Int).Div(totalSupply, big.NewInt(2)), threshold)
+	assert.Error(t, err)
+	_, _, err = BondingCurveReserves(totalSupply, totalSupply, threshold)
+	assert.Error(t, err)
+	_, _, err = BondingCurveReserves(totalSupply, curveSupply, big.NewInt(0))
+	assert.Error(t, err)
+}
+
+func TestReadBondingCurveState(t *testing.T) {
+	parsedABI, err := abi.JSON(strings.NewReader(bondingCurveStateABI))
+	require.NoError(t, err)
+	curveState, err := parsedABI.Methods["curveState"].Outputs.Pack(big.NewInt(300), big.NewInt(400), big.NewInt(500), big.NewInt(666), true)
+	require.NoError(t, err)
+	pair := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	pairOutput, err := parsedABI.Methods["pair"].Outputs.Pack(pair)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&requests))
+		require.Len(t, requests, 2)
+
+		responses := make([]map[string]interface{}, len(requests))
+		for i, request := range requests {
+			data := request.Params[0].(map[string]interface{})["data"].(string)
+			output := curveState
+			if data == hexutil.Encode(parsedABI.Methods["pair"].ID) {
+				output = pairOutput
+			}
+			responses[i] = map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": hexutil.Encode(output)}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(responses))
+	}))
+	defer server.Close()
+
+	state, err := ReadBondingCurveState(server.URL, "0x0000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, "300", state.EthRaised.String())
+	assert.Equal(t, "400", state.TokensSold.String())
+	assert.Equal(t, "500", state.VirtualEth.String())
+	assert.Equal(t, "666", state.VirtualToken.String())
+	assert.True(t, state.Graduated)
+	assert.Equal(t, pair.Hex(), state.PairAddress)
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BridgeKind is the deposit interface of a canonical bridge
+type BridgeKind string
+
+const (
+	// BridgeKindOPStack bridges deposit through the L1StandardBridge of OP Stack chains (Optimism, Base)
+	BridgeKindOPStack BridgeKind = "op_stack"
+	// BridgeKindArbitrum bridges deposit through the Inbox of Arbitrum chains, the ETH is credited to the sender
+	BridgeKindArbitrum BridgeKind = "arbitrum"
+)
+
+// BridgeDepositMinGasLimit is the L2 gas given to OP Stack deposits, enough for a transfer to a contract
+const BridgeDepositMinGasLimit = 200_000
+
+// CanonicalBridge is the native ETH bridge from SourceChainID to the chain it is keyed by
+type CanonicalBridge struct {
+	Name          string     `json:"name"`
+	Kind          BridgeKind `json:"kind"`
+	SourceChainID string     `json:"source_chain_id"`
+	Address       string     `json:"address"`
+}
+
+// canonicalBridges maps the chain IDs of the supported L2s to the bridge of their settlement chain
+var canonicalBridges = map[string]CanonicalBridge{
+	"10":       {Name: "Optimism L1StandardBridge", Kind: BridgeKindOPStack, SourceChainID: "1", Address: "0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"},
+	"8453":     {Name: "Base L1StandardBridge", Kind: BridgeKindOPStack, SourceChainID: "1", Address: "0x3154Cf16ccdb4C6d922629664174b904d80F2C35"},
+	"42161":    {Name: "Arbitrum One Inbox", Kind: BridgeKindArbitrum, SourceChainID: "1", Address: "0x4Dbd4fc535Ac27206064B68FfCf827b0A60BAB3f"},
+	"11155420": {Name: "OP Sepolia L1StandardBridge", Kind: BridgeKindOPStack, SourceChainID: "11155111", Address: "0xFBb0621E0B23b5478B630BD55a5f21f67730B0F1"},
+	"84532":    {Name: "Base Sepolia L1StandardBridge", Kind: BridgeKindOPStack, SourceChainID: "11155111", Address: "0xfd0Bf71F60660E2f608ed56e1659C450eB113120"},
+	"421614":   {Name: "Arbitrum Sepolia Inbox", Kind: BridgeKindArbitrum, SourceChainID: "11155111", Address: "0xaAe29B0366299461418F5324a79Afc425BE5ae21"},
+}
+
+const bridgeDepositABI = `[
+	{"type":"function","name":"depositETH","stateMutability":"payable","inputs":[{"name":"_minGasLimit","type":"uint32"},{"name":"_extraData","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"depositETHTo","stateMutability":"payable","inputs":[{"name":"_to","type":"address"},{"name":"_minGasLimit","type":"uint32"},{"name":"_extraData","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"depositEth","stateMutability":"payable","inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+// GetCanonicalBridge returns the canonical ETH bridge to the chain
+func GetCanonicalBridge(targetChainID string) (CanonicalBridge, error) {
+	bridge, ok := canonicalBridges[targetChainID]
+	if !ok {
+		supported := make([]string, 0, len(canonicalBridges))
+		for chainID := range canonicalBridges {
+			supported = append(supported, chainID)
+		}
+		slices.Sort(supported)
+		return CanonicalBridge{}, fmt.Errorf("no canonical bridge is known for chain %s, supported target chains: %s", targetChainID, strings.Join(supported, ", "))
+	}
+	return bridge, nil
+}
+
+// EncodeBridgeDeposit returns the calldata of an ETH deposit through the bridge.
+// OP Stack deposits go to recipient, or to the sender when recipient is empty.
+// Arbitrum deposits are always credited to the sender.
+func EncodeBridgeDeposit(kind BridgeKind, recipient string) (string, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(bridgeDepositABI))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse bridge ABI: %w", err)
+	}
+
+	var data []byte
+	switch kind {
+	case BridgeKindOPStack:
+		if recipient == "" {
+			data, err = parsedABI.Pack("depositETH", uint32(BridgeDepositMinGasLimit), []byte{})
+		} else {
+			if !common.IsHexAddress(recipient) {
+				return "", fmt.Errorf("invalid recipient address: %s", recipient)
+			}
+			data, err = parsedABI.Pack("depositETHTo", common.HexToAddress(recipient), uint32(BridgeDepositMinGasLimit), []byte{})
+		}
+	case BridgeKindArbitrum:
+		if recipient != "" {
+			return "", fmt.Errorf("arbitrum deposits are credited to the sender, recipient is not supported")
+		}
+		data, err = parsedABI.Pack("depositEth")
+	default:
+		return "", fmt.Errorf("unsupported bridge kind %q, expected %s or %s", kind, BridgeKindOPStack, BridgeKindArbitrum)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to encode bridge deposit: %w", err)
+	}
+	return hexutil.Encode(data), nil
+}
+
+// ParseWeiAmount parses a positive amount in wei
+func ParseWeiAmount(amount string) (*big.Int, error) {
+	value, ok := new(big.Int).SetString(strings.TrimSpace(amount), 10)
+	if !ok || value.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount %q, expected a positive amount in wei", amount)
+	}
+	return value, nil
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCanonicalBridge(t *testing.T) {
+	bridge, err := GetCanonicalBridge("8453")
+	require.NoError(t, err)
+	assert.Equal(t, BridgeKindOPStack, bridge.Kind)
+	assert.Equal(t, "1", bridge.SourceChainID)
+
+	bridge, err = GetCanonicalBridge("421614")
+	require.NoError(t, err)
+	assert.Equal(t, BridgeKindArbitrum, bridge.Kind)
+	assert.Equal(t, "11155111", bridge.SourceChainID)
+
+	_, err = GetCanonicalBridge("56")
+	assert.ErrorContains(t, err, "no canonical bridge is known for chain 56, supported target chains: 10, 11155420, 42161, 421614, 8453, 84532")
+}
+
+func TestEncodeBridgeDeposit(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      BridgeKind
+		recipient string
+		prefix    string
+		expectErr string
+	}{
+		// depositETH(uint32,bytes)
+		{name: "op stack to signer", kind: BridgeKindOPStack, prefix: "0xb1a1a882"},
+		// depositETHTo(address,uint32,bytes)
+		{name: "op stack to recipient", kind: BridgeKindOPStack, recipient: "0x1111111111111111111111111111111111111111", prefix: "0x9a2ac6d5"},
+		// depositEth()
+		{name: "arbitrum", kind: BridgeKindArbitrum, prefix: "0x439370b1"},
+		{name: "arbitrum recipient", kind: BridgeKindArbitrum, recipient: "0x1111111111111111111111111111111111111111", expectErr: "recipient is not supported"},
+		{name: "invalid recipient", kind: BridgeKindOPStack, recipient: "0x123", expectErr: "invalid recipient address"},
+		{name: "unknown kind", kind: "layerzero", expectErr: "unsupported bridge kind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := EncodeBridgeDeposit(tt.kind, tt.recipient)
+			if tt.expectErr != "" {
+				assert.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.prefix, data[:10])
+		})
+	}
+}
+
+func TestParseWeiAmount(t *testing.T) {
+	amount, err := ParseWeiAmount(" 1000000000000000000 ")
+	require.NoError(t, err)
+	assert.Equal(t, "1000000000000000000", amount.String())
+
+	for _, invalid := range []string{"", "0", "-1", "1.5", "1e18"} {
+		_, err := ParseWeiAmount(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
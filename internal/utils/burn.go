@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BurnAddress is the conventional dead address tokens are sent to when they are burned without a burn function
+const BurnAddress = "0x000000000000000000000000000000000000dEaD"
+
+// transferEventTopic is keccak256("Transfer(address,address,uint256)")
+const transferEventTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// BurnedAmount sums the Transfer events of the token to the burn address in the receipt
+func BurnedAmount(receipt *TransactionReceipt, tokenAddress string) *big.Int {
+	burnTopic := common.BytesToHash(common.HexToAddress(BurnAddress).Bytes()).Hex()
+	total := new(big.Int)
+	for _, log := range receipt.Logs {
+		if !strings.EqualFold(log.Address, tokenAddress) || len(log.Topics) != 3 {
+			continue
+		}
+		if !strings.EqualFold(log.Topics[0], transferEventTopic) || !strings.EqualFold(log.Topics[2], burnTopic) {
+			continue
+		}
+		total.Add(total, new(big.Int).SetBytes(common.FromHex(log.Data)))
+	}
+	return total
+}
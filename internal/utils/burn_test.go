@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBurnedAmount(t *testing.T) {
+	token := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	pair := "0x000000000000000000000000e7f1725e7734ce288f8367e1bb143e90bb3f0512"
+	dead := "0x000000000000000000000000000000000000000000000000000000000000dead"
+	receipt := &TransactionReceipt{
+		Logs: []TransactionLog{
+			// Bought tokens sent to the burn address
+			{Address: token, Topics: []string{transferEventTopic, pair, dead}, Data: "0x00000000000000000000000000000000000000000000000000000000000003e8"},
+			// Transfers to other addresses and of other tokens are ignored
+			{Address: token, Topics: []string{transferEventTopic, dead, pair}, Data: "0x0000000000000000000000000000000000000000000000000000000000000001"},
+			{Address: "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512", Topics: []string{transferEventTopic, pair, dead}, Data: "0x0000000000000000000000000000000000000000000000000000000000000002"},
+		},
+	}
+
+	assert.Equal(t, "1000", BurnedAmount(receipt, token).String())
+	assert.Equal(t, "0", BurnedAmount(&TransactionReceipt{}, token).String())
+}
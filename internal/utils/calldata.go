@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ABISource is an ABI calldata is matched against, Name tells the caller where it comes from
+type ABISource struct {
+	Name string
+	ABI  string
+}
+
+// DecodedCall is calldata decoded with the first ABI source declaring its selector
+type DecodedCall struct {
+	Source    string         `json:"source"`
+	Function  string         `json:"function"`
+	Selector  string         `json:"selector"`
+	Arguments []DecodedValue `json:"arguments"`
+}
+
+// RPCTransaction is the part of eth_getTransactionByHash needed to decode a transaction, To is empty for a
+// contract creation and Value is in wei
+type RPCTransaction struct {
+	Hash  string
+	From  string
+	To    string
+	Input string
+	Value string
+}
+
+// DecodeCalldata matches the selector of the calldata against the sources in order. A source whose method does not
+// unpack the arguments is skipped, another function can share the selector
+func DecodeCalldata(data []byte, sources []ABISource) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata is %d bytes, a function call starts with a 4 byte selector", len(data))
+	}
+	selector := hexutil.Encode(data[:4])
+
+	for _, source := range sources {
+		parsedABI, err := abi.JSON(strings.NewReader(source.ABI))
+		if err != nil {
+			continue
+		}
+		method, err := parsedABI.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		arguments, err := DecodeFunctionInputs(*method, data[4:])
+		if err != nil {
+			continue
+		}
+		return &DecodedCall{Source: source.Name, Function: method.Sig, Selector: selector, Arguments: arguments}, nil
+	}
+	return nil, fmt.Errorf("no known ABI declares the function selector %s", selector)
+}
+
+// GetTransactionByHash fetches a transaction from the RPC
+func GetTransactionByHash(rpcURL string, hash string) (*RPCTransaction, error) {
+	response, err := NewRPCClient(rpcURL).Call("eth_getTransactionByHash", []interface{}{hash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction %s: %w", hash, err)
+	}
+	if response.Result == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash)
+	}
+
+	resultJSON, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction: %w", err)
+	}
+	var tx struct {
+		Hash  string  `json:"hash"`
+		From  string  `json:"from"`
+		To    *string `json:"to"`
+		Input string  `json:"input"`
+		Value string  `json:"value"`
+	}
+	if err := json.Unmarshal(resultJSON, &tx); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal transaction: %w", err)
+	}
+
+	transaction := &RPCTransaction{Hash: tx.Hash, From: tx.From, Input: tx.Input, Value: "0"}
+	if tx.To != nil {
+		transaction.To = *tx.To
+	}
+	if value, ok := new(big.Int).SetString(strings.TrimPrefix(tx.Value, "0x"), 16); ok {
+		transaction.Value = value.String()
+	}
+	return transaction, nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"sort"
+	"time"
+)
+
+// PricePoint is a price observation at a point in time
+type PricePoint struct {
+	Time  time.Time
+	Price float64
+}
+
+// Candle is an OHLC candle for a single time bucket
+type Candle struct {
+	// Time is the start of the bucket as a unix timestamp in seconds
+	Time  int64   `json:"time"`
+	Open  float64 `json:"open"`
+	High  float64 `json:"high"`
+	Low   float64 `json:"low"`
+	Close float64 `json:"close"`
+}
+
+// BuildCandles groups price points into OHLC candles of the given interval.
+// Buckets without any price points are skipped.
+func BuildCandles(points []PricePoint, interval time.Duration) []Candle {
+	if len(points) == 0 || interval <= 0 {
+		return []Candle{}
+	}
+
+	sorted := make([]PricePoint, len(points))
+	copy(sorted, points)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	candles := []Candle{}
+	seconds := int64(interval / time.Second)
+	if seconds == 0 {
+		seconds = 1
+	}
+
+	for _, point := range sorted {
+		bucket := point.Time.Unix() - point.Time.Unix()%seconds
+		if len(candles) > 0 && candles[len(candles)-1].Time == bucket {
+			candle := &candles[len(candles)-1]
+			candle.High = max(candle.High, point.Price)
+			candle.Low = min(candle.Low, point.Price)
+			candle.Close = point.Price
+			continue
+		}
+
+		// Open at the previous close so consecutive candles connect
+		open := point.Price
+		if len(candles) > 0 {
+			open = candles[len(candles)-1].Close
+		}
+		candles = append(candles, Candle{
+			Time:  bucket,
+			Open:  open,
+			High:  max(open, point.Price),
+			Low:   min(open, point.Price),
+			Close: point.Price,
+		})
+	}
+
+	return candles
+}
+
+// CandleIntervalFor picks a candle interval that spreads the given time range over roughly the target number of candles
+func CandleIntervalFor(start, end time.Time, targetCandles int) time.Duration {
+	intervals := []time.Duration{
+		time.Minute,
+		5 * time.Minute,
+		15 * time.Minute,
+		time.Hour,
+		4 * time.Hour,
+		24 * time.Hour,
+		7 * 24 * time.Hour,
+	}
+
+	if targetCandles <= 0 {
+		targetCandles = 1
+	}
+	span := end.Sub(start)
+	for _, interval := range intervals {
+		if span/interval <= time.Duration(targetCandles) {
+			return interval
+		}
+	}
+	return intervals[len(intervals)-1]
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCandles(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).Truncate(time.Hour)
+	points := []PricePoint{
+		{Time: start.Add(70 * time.Minute), Price: 1.5},
+		{Time: start, Price: 1.0},
+		{Time: start.Add(10 * time.Minute), Price: 1.4},
+		{Time: start.Add(20 * time.Minute), Price: 0.8},
+		{Time: start.Add(30 * time.Minute), Price: 1.2},
+		// Empty bucket in between is skipped
+		{Time: start.Add(185 * time.Minute), Price: 2.0},
+	}
+
+	candles := BuildCandles(points, time.Hour)
+	require.Len(t, candles, 3)
+
+	assert.Equal(t, Candle{Time: start.Unix(), Open: 1.0, High: 1.4, Low: 0.8, Close: 1.2}, candles[0])
+	assert.Equal(t, Candle{Time: start.Add(time.Hour).Unix(), Open: 1.2, High: 1.5, Low: 1.2, Close: 1.5}, candles[1])
+	assert.Equal(t, Candle{Time: start.Add(3 * time.Hour).Unix(), Open: 1.5, High: 2.0, Low: 1.5, Close: 2.0}, candles[2])
+}
+
+func TestBuildCandlesEmpty(t *testing.T) {
+	assert.Empty(t, BuildCandles(nil, time.Hour))
+	assert.Empty(t, BuildCandles([]PricePoint{{Time: time.Now(), Price: 1}}, 0))
+}
+
+func TestCandleIntervalFor(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+
+	assert.Equal(t, time.Minute, CandleIntervalFor(start, start.Add(30*time.Minute), 60))
+	assert.Equal(t, 15*time.Minute, CandleIntervalFor(start, start.Add(10*time.Hour), 60))
+	assert.Equal(t, 24*time.Hour, CandleIntervalFor(start, start.Add(30*24*time.Hour), 60))
+	assert.Equal(t, 7*24*time.Hour, CandleIntervalFor(start, start.Add(5*365*24*time.Hour), 60))
+}
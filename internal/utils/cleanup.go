@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// CleanupAction is the on-chain cleanup of an abandoned deployment
+type CleanupAction string
+
+const (
+	// CleanupSelfDestruct calls the self-destruct function of the contract. Since the Cancun upgrade it only sends the
+	// balance of the contract to the beneficiary, the code stays on chain
+	CleanupSelfDestruct CleanupAction = "self_destruct"
+	// CleanupRenounceOwnership gives up the owner of an Ownable contract so nobody can call its admin functions
+	CleanupRenounceOwnership CleanupAction = "renounce_ownership"
+)
+
+// selfDestructFunctionNames are the functions without arguments used by common templates to self-destruct
+var selfDestructFunctionNames = []string{"selfDestruct", "destroy", "kill", "destruct"}
+
+// FindCleanupFunction finds the function of the ABI without arguments performing the cleanup action
+func FindCleanupFunction(abiJSON string, action CleanupAction) (string, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	noArguments := func(method abi.Method) bool {
+		return !method.IsConstant() && len(method.Inputs) == 0
+	}
+	switch action {
+	case CleanupSelfDestruct:
+		if name := findMethod(parsedABI, selfDestructFunctionNames, noArguments); name != "" {
+			return name, nil
+		}
+		return "", fmt.Errorf("contract has no self-destruct function such as destroy()")
+	case CleanupRenounceOwnership:
+		if name := findMethod(parsedABI, []string{"renounceOwnership"}, noArguments); name != "" {
+			return name, nil
+		}
+		return "", fmt.Errorf("contract has no renounceOwnership() function")
+	default:
+		return "", fmt.Errorf("unknown cleanup action %q", action)
+	}
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCleanupFunction(t *testing.T) {
+	abiJSON := `[
+		{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+		{"type":"function","name":"renounceOwnership","stateMutability":"nonpayable","inputs":[],"outputs":[]},
+		{"type":"function","name":"kill","stateMutability":"nonpayable","inputs":[],"outputs":[]}
+	]`
+	name, err := FindCleanupFunction(abiJSON, CleanupRenounceOwnership)
+	require.NoError(t, err)
+	assert.Equal(t, "renounceOwnership", name)
+
+	name, err = FindCleanupFunction(abiJSON, CleanupSelfDestruct)
+	require.NoError(t, err)
+	assert.Equal(t, "kill", name)
+
+	_, err = FindCleanupFunction(abiJSON, CleanupAction("burn"))
+	assert.ErrorContains(t, err, "unknown cleanup action")
+}
+
+func TestFindCleanupFunctionMissing(t *testing.T) {
+	// a destroy taking the beneficiary is not called without arguments
+	abiJSON := `[{"type":"function","name":"destroy","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"}],"outputs":[]}]`
+
+	_, err := FindCleanupFunction(abiJSON, CleanupSelfDestruct)
+	assert.ErrorContains(t, err, "no self-destruct function")
+	_, err = FindCleanupFunction(abiJSON, CleanupRenounceOwnership)
+	assert.ErrorContains(t, err, "no renounceOwnership() function")
+}
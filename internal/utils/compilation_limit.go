@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"errors"
+	"log"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// EnvMaxConcurrentCompilations caps the Solidity compilations and Anchor builds running at once, defaults to the number of CPUs
+const EnvMaxConcurrentCompilations = "LAUNCHPAD_MAX_CONCURRENT_COMPILATIONS"
+
+// compilationSlotWait is how long a compilation waits for a slot before it is refused
+const compilationSlotWait = 5 * time.Second
+
+// ErrTooManyCompilations is returned when every compilation slot stayed busy for compilationSlotWait
+var ErrTooManyCompilations = errors.New("too many compilations are running, retry in a few seconds")
+
+var (
+	compilationSlots     chan struct{}
+	compilationSlotsOnce sync.Once
+)
+
+// MaxConcurrentCompilationsFromEnv returns the cap of LAUNCHPAD_MAX_CONCURRENT_COMPILATIONS, or the number of CPUs when it is unset or invalid
+func MaxConcurrentCompilationsFromEnv() int {
+	value := os.Getenv(EnvMaxConcurrentCompilations)
+	if value == "" {
+		return runtime.NumCPU()
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		log.Printf("Warning: ignoring invalid %s %q, using %d", EnvMaxConcurrentCompilations, value, runtime.NumCPU())
+		return runtime.NumCPU()
+	}
+	return limit
+}
+
+// acquireCompilationSlot waits for a free compilation slot, the returned func releases it
+func acquireCompilationSlot() (func(), error) {
+	compilationSlotsOnce.Do(func() {
+		compilationSlots = make(chan struct{}, MaxConcurrentCompilationsFromEnv())
+	})
+	return acquireSlot(compilationSlots, compilationSlotWait)
+}
+
+func acquireSlot(slots chan struct{}, wait time.Duration) (func(), error) {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-timer.C:
+		return nil, ErrTooManyCompilations
+	}
+}
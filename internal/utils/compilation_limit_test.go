@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireSlot(t *testing.T) {
+	slots := make(chan struct{}, 1)
+	release, err := acquireSlot(slots, time.Millisecond)
+	require.NoError(t, err)
+
+	_, err = acquireSlot(slots, 10*time.Millisecond)
+	assert.ErrorIs(t, err, ErrTooManyCompilations)
+
+	release()
+	release, err = acquireSlot(slots, time.Millisecond)
+	require.NoError(t, err)
+	release()
+}
+
+func TestMaxConcurrentCompilationsFromEnv(t *testing.T) {
+	t.Setenv(EnvMaxConcurrentCompilations, "")
+	assert.Equal(t, runtime.NumCPU(), MaxConcurrentCompilationsFromEnv())
+	t.Setenv(EnvMaxConcurrentCompilations, "2")
+	assert.Equal(t, 2, MaxConcurrentCompilationsFromEnv())
+	t.Setenv(EnvMaxConcurrentCompilations, "0")
+	assert.Equal(t, runtime.NumCPU(), MaxConcurrentCompilationsFromEnv())
+}
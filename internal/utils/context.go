@@ -8,6 +8,12 @@ import (
 // This is separate from the Fiber middleware context key to avoid confusion
 const MCPAuthenticatedUserContextKey = "mcp_authenticated_user"
 
+// ChainIDContextKey is the context key of the chain selected by the chain_id argument of a tool call
+const ChainIDContextKey = "mcp_chain_id"
+
+// APIKeyClientID is the ClientId of the users authenticated by a key of create_api_key, their Scopes are the tools they may call
+const APIKeyClientID = "launchpad-api-key"
+
 // WithAuthenticatedUser stores an authenticated user in the context
 func WithAuthenticatedUser(ctx context.Context, user *AuthenticatedUser) context.Context {
 	return context.WithValue(ctx, MCPAuthenticatedUserContextKey, user)
@@ -84,3 +90,14 @@ func HasScope(ctx context.Context, scope string) bool {
 	}
 	return false
 }
+
+// WithChainID stores the database ID of the chain selected for the tool call
+func WithChainID(ctx context.Context, chainID uint) context.Context {
+	return context.WithValue(ctx, ChainIDContextKey, chainID)
+}
+
+// GetChainID returns the chain selected for the tool call, false when the call uses the default chain
+func GetChainID(ctx context.Context) (uint, bool) {
+	chainID, ok := ctx.Value(ChainIDContextKey).(uint)
+	return chainID, ok
+}
@@ -403,3 +403,12 @@ func TestContextKeyUniqueness(t *testing.T) {
 	// Verify context key is what we expect
 	assert.Equal(t, "mcp_authenticated_user", MCPAuthenticatedUserContextKey)
 }
+
+func TestWithChainID(t *testing.T) {
+	_, ok := GetChainID(context.Background())
+	assert.False(t, ok)
+
+	chainID, ok := GetChainID(WithChainID(context.Background(), 2))
+	require.True(t, ok)
+	assert.Equal(t, uint(2), chainID)
+}
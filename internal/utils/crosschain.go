@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// LayerZeroEndpointV2 is the LayerZero V2 endpoint of the mainnets
+	LayerZeroEndpointV2 = "0x1a44076050125825900e736c501f859c50fE728c"
+	// LayerZeroEndpointV2Testnet is the LayerZero V2 endpoint of the testnets
+	LayerZeroEndpointV2Testnet = "0x6EDCE65403992e310A62460808c4b910D972f10f"
+	// DefaultLayerZeroReceiveGas is the executor gas of lzReceive in the enforced options set by the wiring
+	DefaultLayerZeroReceiveGas = 200_000
+
+	// AxelarInterchainTokenService and AxelarInterchainTokenFactory share their address on every chain
+	AxelarInterchainTokenService = "0xB5FB4BE02232B1bBA4dC8f81dc24C26980dE9e3C"
+	AxelarInterchainTokenFactory = "0x83a93500d23Fbc3e82B410aD07A6a9F7A0b2F4DA"
+	// AxelarTokenManagerMintBurn is the ITS token manager type minting and burning the linked tokens
+	AxelarTokenManagerMintBurn = 4
+)
+
+// CrossChainNetwork is the identifiers of a chain in the LayerZero and Axelar networks
+type CrossChainNetwork struct {
+	// LayerZeroEID is the LayerZero V2 endpoint ID
+	LayerZeroEID uint32
+	// AxelarChain is the Axelar chain name used by the Interchain Token Service
+	AxelarChain string
+	Testnet     bool
+}
+
+// crossChainNetworks maps the chain IDs of the supported chains to their LayerZero and Axelar identifiers
+var crossChainNetworks = map[string]CrossChainNetwork{
+	"1":        {LayerZeroEID: 30101, AxelarChain: "Ethereum"},
+	"10":       {LayerZeroEID: 30111, AxelarChain: "optimism"},
+	"56":       {LayerZeroEID: 30102, AxelarChain: "binance"},
+	"137":      {LayerZeroEID: 30109, AxelarChain: "Polygon"},
+	"8453":     {LayerZeroEID: 30184, AxelarChain: "base"},
+	"42161":    {LayerZeroEID: 30110, AxelarChain: "arbitrum"},
+	"43114":    {LayerZeroEID: 30106, AxelarChain: "Avalanche"},
+	"11155111": {LayerZeroEID: 40161, AxelarChain: "ethereum-sepolia", Testnet: true},
+	"11155420": {LayerZeroEID: 40232, AxelarChain: "optimism-sepolia", Testnet: true},
+	"84532":    {LayerZeroEID: 40245, AxelarChain: "base-sepolia", Testnet: true},
+	"421614":   {LayerZeroEID: 40231, AxelarChain: "arbitrum-sepolia", Testnet: true},
+}
+
+const crossChainWiringABI = `[
+	{"type":"function","name":"setPeer","stateMutability":"nonpayable","inputs":[{"name":"_eid","type":"uint32"},{"name":"_peer","type":"bytes32"}],"outputs":[]},
+	{"type":"function","name":"setEnforcedOptions","stateMutability":"nonpayable","inputs":[{"name":"_eid","type":"uint32"},{"name":"_options","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"setMinter","stateMutability":"nonpayable","inputs":[{"name":"_minter","type":"address"},{"name":"_allowed","type":"bool"}],"outputs":[]},
+	{"type":"function","name":"registerTokenMetadata","stateMutability":"payable","inputs":[{"name":"tokenAddress","type":"address"},{"name":"gasValue","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"registerCustomToken","stateMutability":"payable","inputs":[{"name":"salt","type":"bytes32"},{"name":"tokenAddress","type":"address"},{"name":"tokenManagerType","type":"uint8"},{"name":"operator","type":"address"}],"outputs":[{"name":"tokenId","type":"bytes32"}]},
+	{"type":"function","name":"linkToken","stateMutability":"payable","inputs":[{"name":"salt","type":"bytes32"},{"name":"destinationChain","type":"string"},{"name":"destinationTokenAddress","type":"bytes"},{"name":"tokenManagerType","type":"uint8"},{"name":"linkParams","type":"bytes"},{"name":"gasValue","type":"uint256"}],"outputs":[{"name":"tokenId","type":"bytes32"}]},
+	{"type":"function","name":"linkedTokenId","stateMutability":"view","inputs":[{"name":"deployer","type":"address"},{"name":"salt","type":"bytes32"}],"outputs":[{"name":"tokenId","type":"bytes32"}]},
+	{"type":"function","name":"tokenManagerAddress","stateMutability":"view","inputs":[{"name":"tokenId","type":"bytes32"}],"outputs":[{"name":"tokenManagerAddress_","type":"address"}]}
+]`
+
+// GetCrossChainNetwork returns the LayerZero and Axelar identifiers of the chain
+func GetCrossChainNetwork(chainID string) (CrossChainNetwork, error) {
+	network, ok := crossChainNetworks[chainID]
+	if !ok {
+		return CrossChainNetwork{}, fmt.Errorf("chain %s is not supported by the LayerZero and Axelar wiring", chainID)
+	}
+	return network, nil
+}
+
+// LayerZeroEndpoint returns the LayerZero V2 endpoint of the chain, the constructor argument of the OFT template
+func (n CrossChainNetwork) LayerZeroEndpoint() string {
+	if n.Testnet {
+		return LayerZeroEndpointV2Testnet
+	}
+	return LayerZeroEndpointV2
+}
+
+// LayerZeroReceiveOptions returns the type 3 executor options giving lzReceive the gas
+func LayerZeroReceiveOptions(gas uint64) []byte {
+	// type 3 | executor worker | option size (type + uint128 gas) | lzReceive option | gas
+	options := []byte{0x00, 0x03, 0x01, 0x00, 0x11, 0x01}
+	return append(options, common.LeftPadBytes(new(big.Int).SetUint64(gas).Bytes(), 16)...)
+}
+
+// CrossChainSalt returns the ITS salt of a launch group, the token ID is derived from it and the signer
+func CrossChainSalt(launchGroupID uint) [32]byte {
+	return crypto.Keccak256Hash([]byte(fmt.Sprintf("launchpad-launch-group-%d", launchGroupID)))
+}
+
+func packCrossChainCall(method string, args ...any) (string, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(crossChainWiringABI))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse wiring ABI: %w", err)
+	}
+	data, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s: %w", method, err)
+	}
+	return hexutil.Encode(data), nil
+}
+
+// EncodeLayerZeroSetPeer returns the calldata of setPeer(eid, peer) with the peer address left padded to bytes32
+func EncodeLayerZeroSetPeer(eid uint32, peerAddress string) (string, error) {
+	var peer [32]byte
+	copy(peer[:], common.LeftPadBytes(common.HexToAddress(peerAddress).Bytes(), 32))
+	return packCrossChainCall("setPeer", eid, peer)
+}
+
+// EncodeLayerZeroSetEnforcedOptions returns the calldata of setEnforcedOptions(eid, options)
+func EncodeLayerZeroSetEnforcedOptions(eid uint32, options []byte) (string, error) {
+	return packCrossChainCall("setEnforcedOptions", eid, options)
+}
+
+// EncodeSetMinter returns the calldata of setMinter(minter, allowed) of the ITS template
+func EncodeSetMinter(minter string, allowed bool) (string, error) {
+	return packCrossChainCall("setMinter", common.HexToAddress(minter), allowed)
+}
+
+// EncodeAxelarRegisterTokenMetadata returns the calldata of InterchainTokenService.registerTokenMetadata
+func EncodeAxelarRegisterTokenMetadata(tokenAddress string, gasValue *big.Int) (string, error) {
+	return packCrossChainCall("registerTokenMetadata", common.HexToAddress(tokenAddress), gasValue)
+}
+
+// EncodeAxelarRegisterCustomToken returns the calldata of InterchainTokenFactory.registerCustomToken with a mint/burn token manager
+func EncodeAxelarRegisterCustomToken(salt [32]byte, tokenAddress string, operator string) (string, error) {
+	return packCrossChainCall("registerCustomToken", salt, common.HexToAddress(tokenAddress), uint8(AxelarTokenManagerMintBurn), common.HexToAddress(operator))
+}
+
+// EncodeAxelarLinkToken returns the calldata of InterchainTokenFactory.linkToken to the token on the destination chain
+func EncodeAxelarLinkToken(salt [32]byte, destinationChain string, destinationTokenAddress string, gasValue *big.Int) (string, error) {
+	return packCrossChainCall("linkToken", salt, destinationChain, common.HexToAddress(destinationTokenAddress).Bytes(), uint8(AxelarTokenManagerMintBurn), []byte{}, gasValue)
+}
+
+// GetAxelarTokenManager reads the address of the ITS token manager of the token linked by deployer with salt,
+// the same on every chain
+func GetAxelarTokenManager(rpcURL string, deployer string, salt [32]byte) (string, error) {
+	client := NewRPCClient(rpcURL)
+	call := func(to string, method string, args ...any) ([]any, error) {
+		data, err := packCrossChainCall(method, args...)
+		if err != nil {
+			return nil, err
+		}
+		response, err := client.Call("eth_call", []interface{}{
+			map[string]string{
+				"to":   to,
+				"data": data,
+			},
+			"latest",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to call %s: %w", method, err)
+		}
+		result, ok := response.Result.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid response format")
+		}
+		output, err := hexutil.Decode(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", method, err)
+		}
+		parsedABI, err := abi.JSON(strings.NewReader(crossChainWiringABI))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse wiring ABI: %w", err)
+		}
+		return parsedABI.Unpack(method, output)
+	}
+
+	tokenID, err := call(AxelarInterchainTokenFactory, "linkedTokenId", common.HexToAddress(deployer), salt)
+	if err != nil {
+		return "", err
+	}
+	manager, err := call(AxelarInterchainTokenService, "tokenManagerAddress", tokenID[0])
+	if err != nil {
+		return "", err
+	}
+	return manager[0].(common.Address).Hex(), nil
+}
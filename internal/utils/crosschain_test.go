@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCrossChainNetwork(t *testing.T) {
+	network, err := GetCrossChainNetwork("8453")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(30184), network.LayerZeroEID)
+	assert.Equal(t, "base", network.AxelarChain)
+	assert.Equal(t, LayerZeroEndpointV2, network.LayerZeroEndpoint())
+
+	testnet, err := GetCrossChainNetwork("84532")
+	require.NoError(t, err)
+	assert.Equal(t, LayerZeroEndpointV2Testnet, testnet.LayerZeroEndpoint())
+
+	_, err = GetCrossChainNetwork("999")
+	assert.Error(t, err)
+}
+
+func TestLayerZeroReceiveOptions(t *testing.T) {
+	// Options of OptionsBuilder.newOptions().addExecutorLzReceiveOption(200000, 0)
+	assert.Equal(t, "0x00030100110100000000000000000000000000030d40", hexutil.Encode(LayerZeroReceiveOptions(200_000)))
+}
+
+func TestEncodeCrossChainWiring(t *testing.T) {
+	salt := CrossChainSalt(1)
+	assert.NotEqual(t, salt, CrossChainSalt(2))
+
+	tests := []struct {
+		name     string
+		encode   func() (string, error)
+		selector string
+	}{
+		{"setPeer", func() (string, error) {
+			return EncodeLayerZeroSetPeer(30184, "0x1111111111111111111111111111111111111111")
+		}, "0x3400288b"},
+		{"setEnforcedOptions", func() (string, error) {
+			return EncodeLayerZeroSetEnforcedOptions(30184, LayerZeroReceiveOptions(200_000))
+		}, "0xc31beace"},
+		{"registerTokenMetadata", func() (string, error) {
+			return EncodeAxelarRegisterTokenMetadata("0x1111111111111111111111111111111111111111", big.NewInt(0))
+		}, "0x7fb53dc9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.encode()
+			require.NoError(t, err)
+			assert.Equal(t, tt.selector, data[:10])
+		})
+	}
+
+	data, err := EncodeLayerZeroSetPeer(30184, "0x1111111111111111111111111111111111111111")
+	require.NoError(t, err)
+	assert.Equal(t, "000000000000000000000000"+"1111111111111111111111111111111111111111", data[len(data)-64:], "the peer is left padded to bytes32")
+}
+
+func TestGetAxelarTokenManager(t *testing.T) {
+	tokenID := common.HexToHash("0xabc")
+	manager := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     any   `json:"id"`
+			Params []any `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		call := request.Params[0].(map[string]any)
+		result := common.LeftPadBytes(manager.Bytes(), 32)
+		if call["to"] == AxelarInterchainTokenFactory {
+			result = tokenID.Bytes()
+		} else {
+			// tokenManagerAddress is called with the token ID returned by linkedTokenId
+			assert.Equal(t, hexutil.Encode(tokenID.Bytes()), "0x"+call["data"].(string)[10:])
+		}
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": hexutil.Encode(result)})
+	}))
+	defer server.Close()
+
+	address, err := GetAxelarTokenManager(server.URL, "0x4444444444444444444444444444444444444444", CrossChainSalt(1))
+	require.NoError(t, err)
+	assert.Equal(t, manager.Hex(), address)
+}
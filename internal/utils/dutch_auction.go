@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// dutchAuctionABI holds the functions of the Dutch auction template called by the launchpad
+const dutchAuctionABI = `[{"inputs":[],"name":"auctionState","outputs":[{"name":"_currentPrice","type":"uint256"},{"name":"_ethRaised","type":"uint256"},{"name":"_tokensSold","type":"uint256"},{"name":"_ended","type":"bool"},{"name":"_settled","type":"bool"}],"stateMutability":"view","type":"function"},` +
+	`{"inputs":[],"name":"pair","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},` +
+	`{"inputs":[],"name":"settle","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// DutchAuctionState is the state of a Dutch auction contract, amounts are in wei and the smallest unit of the token
+type DutchAuctionState struct {
+	CurrentPrice *big.Int
+	EthRaised    *big.Int
+	TokensSold   *big.Int
+	Ended        bool
+	Settled      bool
+	PairAddress  string
+}
+
+// DutchAuctionSchedule is the price decay of a Dutch auction, prices are in wei per token (10^18 units)
+type DutchAuctionSchedule struct {
+	StartPrice *big.Int
+	EndPrice   *big.Int
+	StartTime  time.Time
+	EndTime    time.Time
+	// StepDuration is the time between two price drops, 0 for a continuous decay
+	StepDuration time.Duration
+}
+
+// DutchAuctionPricePoint is the price of the auction from At
+type DutchAuctionPricePoint struct {
+	At    time.Time `json:"at"`
+	Price string    `json:"price"`
+}
+
+// Validate checks the schedule like the constructor of the template
+func (s DutchAuctionSchedule) Validate(now time.Time) error {
+	if s.EndPrice.Sign() <= 0 {
+		return fmt.Errorf("the end price must be greater than 0")
+	}
+	if s.StartPrice.Cmp(s.EndPrice) <= 0 {
+		return fmt.Errorf("the start price must be above the end price")
+	}
+	if !s.EndTime.After(s.StartTime) {
+		return fmt.Errorf("the end time must be after the start time")
+	}
+	if !s.EndTime.After(now) {
+		return fmt.Errorf("the end time must be in the future")
+	}
+	if s.StepDuration < 0 || s.StepDuration%time.Second != 0 {
+		return fmt.Errorf("the step duration must be a positive number of seconds")
+	}
+	if s.StepDuration >= s.EndTime.Sub(s.StartTime) {
+		return fmt.Errorf("the step duration must be shorter than the auction")
+	}
+	return nil
+}
+
+// PriceAt returns the price at t like priceAt of the template, times are truncated to the second like block timestamps
+func (s DutchAuctionSchedule) PriceAt(t time.Time) *big.Int {
+	timestamp, start, end := t.Unix(), s.StartTime.Unix(), s.EndTime.Unix()
+	if timestamp <= start {
+		return new(big.Int).Set(s.StartPrice)
+	}
+	if timestamp >= end {
+		return new(big.Int).Set(s.EndPrice)
+	}
+	elapsed := timestamp - start
+	if step := int64(s.StepDuration / time.Second); step > 0 {
+		elapsed -= elapsed % step
+	}
+	decay := new(big.Int).Mul(new(big.Int).Sub(s.StartPrice, s.EndPrice), big.NewInt(elapsed))
+	decay.Quo(decay, big.NewInt(end-start))
+	return decay.Sub(s.StartPrice, decay)
+}
+
+// Points returns the price at count even intervals of the auction, aligned on the price drops of a stepped decay,
+// ending with the end price
+func (s DutchAuctionSchedule) Points(count int) []DutchAuctionPricePoint {
+	if count < 1 {
+		count = 1
+	}
+	interval := s.EndTime.Sub(s.StartTime) / time.Duration(count)
+	if s.StepDuration > 0 {
+		interval = max(interval-interval%s.StepDuration, s.StepDuration)
+	}
+	var points []DutchAuctionPricePoint
+	for at := s.StartTime; at.Before(s.EndTime) && interval > 0; at = at.Add(interval) {
+		points = append(points, DutchAuctionPricePoint{At: at, Price: s.PriceAt(at).String()})
+	}
+	return append(points, DutchAuctionPricePoint{At: s.EndTime, Price: s.EndPrice.String()})
+}
+
+// EncodeDutchAuctionSettle returns the calldata of settle
+func EncodeDutchAuctionSettle() (string, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(dutchAuctionABI))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse Dutch auction ABI: %w", err)
+	}
+	data, err := parsedABI.Pack("settle")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode settle: %w", err)
+	}
+	return hexutil.Encode(data), nil
+}
+
+// ReadDutchAuctionState reads the auction state and the pair of a Dutch auction contract in one batch
+func ReadDutchAuctionState(rpcURL string, contractAddress string) (*DutchAuctionState, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(dutchAuctionABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Dutch auction ABI: %w", err)
+	}
+
+	methods := []string{"auctionState", "pair"}
+	params := make([][]interface{}, len(methods))
+	for i, method := range methods {
+		data, err := parsedABI.Pack(method)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+		}
+		params[i] = []interface{}{map[string]string{"to": contractAddress, "data": hexutil.Encode(data)}, "latest"}
+	}
+
+	responses, err := NewRPCClient(rpcURL).BatchCall("eth_call", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Dutch auction %s: %w", contractAddress, err)
+	}
+	outputs := make([][]any, len(methods))
+	for i, response := range responses {
+		if response.Error != nil {
+			return nil, fmt.Errorf("failed to call %s on %s: %s", methods[i], contractAddress, response.Error.Message)
+		}
+		result, ok := response.Result.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid response format")
+		}
+		outputs[i], err = parsedABI.Unpack(methods[i], common.FromHex(result))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s of %s: %w", methods[i], contractAddress, err)
+		}
+	}
+
+	return &DutchAuctionState{
+		CurrentPrice: outputs[0][0].(*big.Int),
+		EthRaised:    outputs[0][1].(*big.Int),
+		TokensSold:   outputs[0][2].(*big.Int),
+		Ended:        outputs[0][3].(bool),
+		Settled:      outputs[0][4].(bool),
+		PairAddress:  outputs[1][0].(common.Address).Hex(),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutchAuctionSchedule(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := DutchAuctionSchedule{
+		StartPrice: big.NewInt(1000),
+		EndPrice:   big.NewInt(100),
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+	}
+	require.NoError(t, schedule.Validate(start))
+
+	assert.Equal(t, "1000", schedule.PriceAt(start.Add(-time.Minute)).String())
+	assert.Equal(t, "550", schedule.PriceAt(start.Add(30*time.Minute)).String())
+	assert.Equal(t, "100", schedule.PriceAt(start.Add(2*time.Hour)).String())
+
+	// A stepped decay holds the price until the next drop
+	schedule.StepDuration = 20 * time.Minute
+	assert.Equal(t, "700", schedule.PriceAt(start.Add(30*time.Minute)).String())
+	points := schedule.Points(6)
+	require.Len(t, points, 4)
+	assert.Equal(t, []string{"1000", "700", "400", "100"}, []string{points[0].Price, points[1].Price, points[2].Price, points[3].Price})
+	assert.Equal(t, start.Add(time.Hour), points[3].At)
+
+	schedule.StepDuration = 0
+	assert.Len(t, schedule.Points(4), 5)
+
+	invalid := schedule
+	invalid.EndPrice = big.NewInt(1000)
+	assert.ErrorContains(t, invalid.Validate(start), "start price must be above the end price")
+	invalid = schedule
+	invalid.EndPrice = big.NewInt(0)
+	assert.ErrorContains(t, invalid.Validate(start), "end price must be greater than 0")
+	invalid = schedule
+	invalid.EndTime = start
+	assert.ErrorContains(t, invalid.Validate(start), "end time must be after the start time")
+	assert.ErrorContains(t, schedule.Validate(start.Add(2*time.Hour)), "end time must be in the future")
+	invalid = schedule
+	invalid.StepDuration = time.Hour
+	assert.ErrorContains(t, invalid.Validate(start), "step duration must be shorter")
+}
+
+func TestReadDutchAuctionState(t *testing.T) {
+	parsedABI, err := abi.JSON(strings.NewReader(dutchAuctionABI))
+	require.NoError(t, err)
+	auctionState, err := parsedABI.Methods["auctionState"].Outputs.Pack(big.NewInt(550), big.NewInt(300), big.NewInt(400), true, false)
+	require.NoError(t, err)
+	pair := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	pairOutput, err := parsedABI.Methods["pair"].Outputs.Pack(pair)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&requests))
+		require.Len(t, requests, 2)
+
+		responses := make([]map[string]interface{}, len(requests))
+		for i, request := range requests {
+			data := request.Params[0].(map[string]interface{})["data"].(string)
+			output := auctionState
+			if data == hexutil.Encode(parsedABI.Methods["pair"].ID) {
+				output = pairOutput
+			}
+			responses[i] = map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": hexutil.Encode(output)}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(responses))
+	}))
+	defer server.Close()
+
+	state, err := ReadDutchAuctionState(server.URL, "0x0000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, "550", state.CurrentPrice.String())
+	assert.Equal(t, "300", state.EthRaised.String())
+	assert.Equal(t, "400", state.TokensSold.String())
+	assert.True(t, state.Ended)
+	assert.False(t, state.Settled)
+	assert.Equal(t, pair.Hex(), state.PairAddress)
+
+	data, err := EncodeDutchAuctionSettle()
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(parsedABI.Methods["settle"].ID), data)
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+var (
+	pairSwapTopic = crypto.Keccak256Hash([]byte("Swap(address,uint256,uint256,uint256,uint256,address)")).Hex()
+	pairMintTopic = crypto.Keccak256Hash([]byte("Mint(address,uint256,uint256)")).Hex()
+	pairBurnTopic = crypto.Keccak256Hash([]byte("Burn(address,uint256,uint256,address)")).Hex()
+)
+
+// FetchContractEvents returns the Transfer events of a token, or the Swap, Mint, Burn and LP Transfer events of a Uniswap V2
+// pair, emitted from fromBlock over at most maxBlocks blocks, in chain order. It also returns the last block of the range
+// and the latest block, the contract is caught up when both are equal
+func FetchContractEvents(rpcURL, contractAddress string, fromBlock, maxBlocks uint64) ([]models.IndexedEvent, uint64, uint64, error) {
+	client := NewRPCClient(rpcURL)
+
+	latestHex, err := client.GetBlockNumber()
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	latest, err := strconv.ParseUint(strings.TrimPrefix(latestHex, "0x"), 16, 64)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to parse latest block: %w", err)
+	}
+	if fromBlock > latest {
+		return []models.IndexedEvent{}, latest, latest, nil
+	}
+	toBlock := latest
+	if maxBlocks > 0 && latest-fromBlock >= maxBlocks {
+		toBlock = fromBlock + maxBlocks - 1
+	}
+
+	filter := map[string]interface{}{
+		"address":   contractAddress,
+		"fromBlock": fmt.Sprintf("0x%x", fromBlock),
+		"toBlock":   fmt.Sprintf("0x%x", toBlock),
+		"topics":    []interface{}{[]string{transferEventTopic, pairSwapTopic, pairMintTopic, pairBurnTopic}},
+	}
+	response, err := client.Call("eth_getLogs", []interface{}{filter})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to get contract logs: %w", err)
+	}
+
+	logsJSON, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to marshal logs: %w", err)
+	}
+
+	var logs []struct {
+		Address         string   `json:"address"`
+		Topics          []string `json:"topics"`
+		Data            string   `json:"data"`
+		BlockNumber     string   `json:"blockNumber"`
+		BlockTimestamp  string   `json:"blockTimestamp"`
+		TransactionHash string   `json:"transactionHash"`
+		LogIndex        string   `json:"logIndex"`
+	}
+	if err := json.Unmarshal(logsJSON, &logs); err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to unmarshal logs: %w", err)
+	}
+
+	events := []models.IndexedEvent{}
+	for _, entry := range logs {
+		if len(entry.Topics) == 0 {
+			continue
+		}
+		event, ok := decodeContractEvent(entry.Topics, common.FromHex(entry.Data))
+		if !ok {
+			continue
+		}
+		event.ContractAddress = common.HexToAddress(entry.Address).Hex()
+		event.BlockNumber, _ = strconv.ParseUint(strings.TrimPrefix(entry.BlockNumber, "0x"), 16, 64)
+		event.LogIndex, _ = strconv.ParseUint(strings.TrimPrefix(entry.LogIndex, "0x"), 16, 64)
+		event.TransactionHash = strings.ToLower(entry.TransactionHash)
+		if seconds, err := strconv.ParseInt(strings.TrimPrefix(entry.BlockTimestamp, "0x"), 16, 64); err == nil {
+			blockTime := time.Unix(seconds, 0).UTC()
+			event.BlockTime = &blockTime
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber == events[j].BlockNumber {
+			return events[i].LogIndex < events[j].LogIndex
+		}
+		return events[i].BlockNumber < events[j].BlockNumber
+	})
+
+	return events, toBlock, latest, nil
+}
+
+// decodeContractEvent decodes the indexed parameters and the uint256 words of the log data.
+// ERC721 transfers, which index the token id, are not decoded
+func decodeContractEvent(topics []string, data []byte) (models.IndexedEvent, bool) {
+	word := func(i int) string {
+		if len(data) < (i+1)*32 {
+			return "0"
+		}
+		return new(big.Int).SetBytes(data[i*32 : (i+1)*32]).String()
+	}
+	address := func(topic string) string {
+		return common.HexToAddress(topic).Hex()
+	}
+
+	switch {
+	case strings.EqualFold(topics[0], transferEventTopic) && len(topics) == 3:
+		return models.IndexedEvent{EventType: models.IndexedEventTypeTransfer, Sender: address(topics[1]), Recipient: address(topics[2]), Value: word(0)}, true
+	case strings.EqualFold(topics[0], pairSwapTopic) && len(topics) == 3:
+		return models.IndexedEvent{
+			EventType:  models.IndexedEventTypeSwap,
+			Sender:     address(topics[1]),
+			Recipient:  address(topics[2]),
+			Amount0In:  word(0),
+			Amount1In:  word(1),
+			Amount0Out: word(2),
+			Amount1Out: word(3),
+		}, true
+	case strings.EqualFold(topics[0], pairMintTopic) && len(topics) == 2:
+		return models.IndexedEvent{EventType: models.IndexedEventTypeMint, Sender: address(topics[1]), Amount0In: word(0), Amount1In: word(1)}, true
+	case strings.EqualFold(topics[0], pairBurnTopic) && len(topics) == 3:
+		return models.IndexedEvent{EventType: models.IndexedEventTypeBurn, Sender: address(topics[1]), Recipient: address(topics[2]), Amount0Out: word(0), Amount1Out: word(1)}, true
+	}
+	return models.IndexedEvent{}, false
+}
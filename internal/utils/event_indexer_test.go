@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairEventTopics(t *testing.T) {
+	assert.Equal(t, "0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822", pairSwapTopic)
+	assert.Equal(t, "0x4c209b5fc8ad50758f13e2e1088ba56a560dff690a1c6fef26394f4c03821c4f", pairMintTopic)
+	assert.Equal(t, "0xdccd412f0b1252819cb1fd330b93224ca42612892bb3f4f789976e6d81936496", pairBurnTopic)
+}
+
+func TestFetchContractEvents(t *testing.T) {
+	sender := "0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	recipient := "0x000000000000000000000000bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	words := func(values ...string) string {
+		data := "0x"
+		for _, value := range values {
+			data += "00000000000000000000000000000000000000000000000000000000000000"[:62] + value
+		}
+		return data
+	}
+
+	var filter map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var result interface{}
+		switch request.Method {
+		case "eth_blockNumber":
+			result = "0x100"
+		case "eth_getLogs":
+			filter = request.Params[0].(map[string]interface{})
+			// Returned out of order to check the sorting
+			result = []map[string]interface{}{
+				{"address": "0x1111111111111111111111111111111111111111", "topics": []string{pairSwapTopic, sender, recipient}, "data": words("01", "00", "00", "0a"), "blockNumber": "0x5", "logIndex": "0x2", "transactionHash": "0xAB", "blockTimestamp": "0x6553f100"},
+				{"address": "0x1111111111111111111111111111111111111111", "topics": []string{transferEventTopic, sender, recipient}, "data": words("64"), "blockNumber": "0x5", "logIndex": "0x1", "transactionHash": "0xab"},
+				{"address": "0x1111111111111111111111111111111111111111", "topics": []string{pairMintTopic, sender}, "data": words("02", "03"), "blockNumber": "0x3", "logIndex": "0x0", "transactionHash": "0xcd"},
+				{"address": "0x1111111111111111111111111111111111111111", "topics": []string{pairBurnTopic, sender, recipient}, "data": words("04", "05"), "blockNumber": "0x6", "logIndex": "0x0", "transactionHash": "0xef"},
+				// ERC721 transfer with an indexed token id
+				{"address": "0x1111111111111111111111111111111111111111", "topics": []string{transferEventTopic, sender, recipient, sender}, "data": "0x", "blockNumber": "0x7", "logIndex": "0x0", "transactionHash": "0x01"},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result})
+	}))
+	defer server.Close()
+
+	events, synced, latest, err := FetchContractEvents(server.URL, "0x1111111111111111111111111111111111111111", 1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), synced)
+	assert.Equal(t, uint64(256), latest)
+	assert.Equal(t, "0x1", filter["fromBlock"])
+	assert.Equal(t, "0x64", filter["toBlock"])
+
+	require.Len(t, events, 4)
+	assert.Equal(t, models.IndexedEventTypeMint, events[0].EventType)
+	assert.Equal(t, "2", events[0].Amount0In)
+	assert.Equal(t, "3", events[0].Amount1In)
+
+	assert.Equal(t, models.IndexedEventTypeTransfer, events[1].EventType)
+	assert.Equal(t, "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa", events[1].Sender)
+	assert.Equal(t, "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB", events[1].Recipient)
+	assert.Equal(t, "100", events[1].Value)
+	assert.Nil(t, events[1].BlockTime)
+
+	assert.Equal(t, models.IndexedEventTypeSwap, events[2].EventType)
+	assert.Equal(t, "1", events[2].Amount0In)
+	assert.Equal(t, "10", events[2].Amount1Out)
+	assert.Equal(t, "0xab", events[2].TransactionHash)
+	require.NotNil(t, events[2].BlockTime)
+	assert.Equal(t, int64(0x6553f100), events[2].BlockTime.Unix())
+
+	assert.Equal(t, models.IndexedEventTypeBurn, events[3].EventType)
+	assert.Equal(t, "5", events[3].Amount1Out)
+
+	// The last range ends at the latest block
+	_, synced, _, err = FetchContractEvents(server.URL, "0x1111111111111111111111111111111111111111", 200, 100)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(256), synced)
+
+	// Nothing to fetch once synced past the latest block
+	events, _, _, err = FetchContractEvents(server.URL, "0x1111111111111111111111111111111111111111", 257, 100)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,211 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// blockTimeSampleSize is the number of blocks the average block time is measured over
+const blockTimeSampleSize = 1000
+
+// EventLog is a log of a contract decoded with the ABI of its event. Indexed strings, bytes, arrays and structs are
+// only stored as their hash, Args holds the topic for them
+type EventLog struct {
+	BlockNumber     uint64         `json:"block_number"`
+	BlockTime       *time.Time     `json:"block_time,omitempty"`
+	TransactionHash string         `json:"transaction_hash"`
+	LogIndex        uint64         `json:"log_index"`
+	Args            map[string]any `json:"args"`
+}
+
+// EventTopics builds the eth_getLogs topics of the event: its signature followed by the values the indexed
+// parameters are filtered on, parameters without a value match any log
+func EventTopics(event abi.Event, filters map[string]any) ([]interface{}, error) {
+	topics := []interface{}{event.ID.Hex()}
+	indexed := map[string]bool{}
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			continue
+		}
+		indexed[input.Name] = true
+
+		value, ok := filters[input.Name]
+		if !ok || value == nil || value == "" {
+			topics = append(topics, nil)
+			continue
+		}
+		processed, err := processArg(input.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %s: %w", input.Name, err)
+		}
+		encoded, err := abi.MakeTopics([]interface{}{processed})
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter %s: %w", input.Name, err)
+		}
+		topics = append(topics, encoded[0][0].Hex())
+	}
+	for name := range filters {
+		if !indexed[name] {
+			return nil, fmt.Errorf("%s is not an indexed parameter of %s", name, event.Name)
+		}
+	}
+
+	// Trailing wildcards are left out
+	for len(topics) > 1 && topics[len(topics)-1] == nil {
+		topics = topics[:len(topics)-1]
+	}
+	return topics, nil
+}
+
+// FetchEventLogs returns the logs of the event emitted by the contract between fromBlock and toBlock, in chain order
+func FetchEventLogs(rpcURL, contractAddress string, event abi.Event, topics []interface{}, fromBlock, toBlock uint64) ([]EventLog, error) {
+	filter := map[string]interface{}{
+		"address":   contractAddress,
+		"fromBlock": fmt.Sprintf("0x%x", fromBlock),
+		"toBlock":   fmt.Sprintf("0x%x", toBlock),
+		"topics":    topics,
+	}
+	response, err := NewRPCClient(rpcURL).Call("eth_getLogs", []interface{}{filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contract logs: %w", err)
+	}
+
+	logsJSON, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal logs: %w", err)
+	}
+	var logs []struct {
+		Topics          []string `json:"topics"`
+		Data            string   `json:"data"`
+		BlockNumber     string   `json:"blockNumber"`
+		BlockTimestamp  string   `json:"blockTimestamp"`
+		TransactionHash string   `json:"transactionHash"`
+		LogIndex        string   `json:"logIndex"`
+	}
+	if err := json.Unmarshal(logsJSON, &logs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal logs: %w", err)
+	}
+
+	events := make([]EventLog, 0, len(logs))
+	for _, entry := range logs {
+		args, err := DecodeEventLog(event, entry.Topics, common.FromHex(entry.Data))
+		if err != nil {
+			// An event of another contract version with the same signature but different indexing
+			continue
+		}
+		log := EventLog{TransactionHash: strings.ToLower(entry.TransactionHash), Args: args}
+		log.BlockNumber, _ = strconv.ParseUint(strings.TrimPrefix(entry.BlockNumber, "0x"), 16, 64)
+		log.LogIndex, _ = strconv.ParseUint(strings.TrimPrefix(entry.LogIndex, "0x"), 16, 64)
+		if seconds, err := strconv.ParseInt(strings.TrimPrefix(entry.BlockTimestamp, "0x"), 16, 64); err == nil {
+			blockTime := time.Unix(seconds, 0).UTC()
+			log.BlockTime = &blockTime
+		}
+		events = append(events, log)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber == events[j].BlockNumber {
+			return events[i].LogIndex < events[j].LogIndex
+		}
+		return events[i].BlockNumber < events[j].BlockNumber
+	})
+	return events, nil
+}
+
+// DecodeEventLog decodes the indexed parameters of the topics and the other parameters of the data. Unnamed
+// parameters are keyed by their position
+func DecodeEventLog(event abi.Event, topics []string, data []byte) (map[string]any, error) {
+	indexed := 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed++
+		}
+	}
+	if len(topics) != indexed+1 {
+		return nil, fmt.Errorf("expected %d topics, got %d", indexed+1, len(topics))
+	}
+	values, err := event.Inputs.NonIndexed().UnpackValues(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the data of %s: %w", event.Name, err)
+	}
+
+	args := map[string]any{}
+	topic, value := 1, 0
+	for i, input := range event.Inputs {
+		name := input.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		if !input.Indexed {
+			args[name] = abiValueToJSON(input.Type, values[value])
+			value++
+			continue
+		}
+
+		hash := common.HexToHash(topics[topic])
+		topic++
+		switch input.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			args[name] = hash.Hex()
+			continue
+		}
+		decoded := map[string]any{}
+		if err := abi.ParseTopicsIntoMap(decoded, abi.Arguments{input}, []common.Hash{hash}); err != nil {
+			return nil, fmt.Errorf("failed to decode the topic %s of %s: %w", name, event.Name, err)
+		}
+		args[name] = abiValueToJSON(input.Type, decoded[input.Name])
+	}
+	return args, nil
+}
+
+// EstimateBlockBefore estimates the first block mined after the duration before the latest block, from the average
+// block time of the last blocks
+func EstimateBlockBefore(rpcURL string, latest uint64, duration time.Duration) (uint64, error) {
+	if latest == 0 {
+		return 0, nil
+	}
+	sample := min(uint64(blockTimeSampleSize), latest)
+	latestTime, err := GetBlockTimestamp(rpcURL, latest)
+	if err != nil {
+		return 0, err
+	}
+	sampleTime, err := GetBlockTimestamp(rpcURL, latest-sample)
+	if err != nil {
+		return 0, err
+	}
+
+	blockTime := float64(latestTime-sampleTime) / float64(sample)
+	if blockTime <= 0 {
+		return latest, nil
+	}
+	blocks := uint64(duration.Seconds() / blockTime)
+	if blocks >= latest {
+		return 0, nil
+	}
+	return latest - blocks, nil
+}
+
+// GetBlockTimestamp returns the unix timestamp of a block
+func GetBlockTimestamp(rpcURL string, block uint64) (uint64, error) {
+	response, err := NewRPCClient(rpcURL).Call("eth_getBlockByNumber", []interface{}{fmt.Sprintf("0x%x", block), false})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block %d: %w", block, err)
+	}
+	header, ok := response.Result.(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("block %d not found", block)
+	}
+	timestamp, _ := header["timestamp"].(string)
+	seconds, err := strconv.ParseUint(strings.TrimPrefix(timestamp, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp of block %d: %w", block, err)
+	}
+	return seconds, nil
+}
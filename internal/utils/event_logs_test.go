@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventLogs(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(`[{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]}]`))
+	require.NoError(t, err)
+	event := parsed.Events["Transfer"]
+
+	t.Run("builds the topics of the filters", func(t *testing.T) {
+		topics, err := EventTopics(event, map[string]any{"to": "0x2222222222222222222222222222222222222222"})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{transferEventTopic, nil, "0x0000000000000000000000002222222222222222222222222222222222222222"}, topics)
+
+		topics, err = EventTopics(event, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{transferEventTopic}, topics)
+
+		_, err = EventTopics(event, map[string]any{"value": "1"})
+		assert.ErrorContains(t, err, "not an indexed parameter")
+	})
+
+	t.Run("decodes the topics and the data", func(t *testing.T) {
+		args, err := DecodeEventLog(event, []string{
+			transferEventTopic,
+			"0x0000000000000000000000001111111111111111111111111111111111111111",
+			"0x0000000000000000000000002222222222222222222222222222222222222222",
+		}, common.LeftPadBytes(big.NewInt(1000).Bytes(), 32))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"from":  "0x1111111111111111111111111111111111111111",
+			"to":    "0x2222222222222222222222222222222222222222",
+			"value": "1000",
+		}, args)
+
+		// An ERC721 Transfer indexes the token id
+		_, err = DecodeEventLog(event, []string{transferEventTopic, "0x01", "0x02", "0x03"}, nil)
+		assert.Error(t, err)
+	})
+}
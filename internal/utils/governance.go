@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Session metadata of deploy_governance, read by the governance deployment hook to match the deployed contracts
+// with their deployment records
+const (
+	GovernanceTimelockDeploymentIDKey = "governance_timelock_deployment_id"
+	GovernanceTimelockAddressKey      = "governance_timelock_address"
+	GovernanceGovernorDeploymentIDKey = "governance_governor_deployment_id"
+	GovernanceGovernorAddressKey      = "governance_governor_address"
+)
+
+// Governance defaults of the OpenZeppelin wizard, in blocks of 12 seconds and seconds for the timelock delay
+const (
+	DefaultGovernanceVotingDelay   = 7200   // 1 day
+	DefaultGovernanceVotingPeriod  = 50400  // 1 week
+	DefaultGovernanceTimelockDelay = 172800 // 2 days
+	DefaultGovernanceQuorumPercent = 4
+)
+
+// PredictContractAddress returns the address of the contract the deployer creates with the given nonce
+func PredictContractAddress(deployer string, nonce uint64) string {
+	return crypto.CreateAddress(common.HexToAddress(deployer), nonce).Hex()
+}
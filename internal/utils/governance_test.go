@@ -0,0 +1,14 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredictContractAddress(t *testing.T) {
+	// The first contracts deployed by the default Anvil account
+	deployer := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	assert.Equal(t, "0x5FbDB2315678afecb367f032d93F642f64180aa3", PredictContractAddress(deployer, 0))
+	assert.Equal(t, "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512", PredictContractAddress(deployer, 1))
+}
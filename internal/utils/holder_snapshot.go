@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HolderSnapshotFormat is the output format of a holder snapshot
+type HolderSnapshotFormat string
+
+const (
+	HolderSnapshotFormatJSON HolderSnapshotFormat = "json"
+	HolderSnapshotFormatCSV  HolderSnapshotFormat = "csv"
+)
+
+// HolderSnapshotColumns is the CSV schema of a snapshot, the address,amount recipient list airdrops and Merkle claims are built from
+var HolderSnapshotColumns = []string{"address", "amount"}
+
+// HolderBalance is the balance of a holder at the block of a snapshot
+type HolderBalance struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// HolderSnapshot is the token holders and their balances at a block height
+type HolderSnapshot struct {
+	ChainID      string          `json:"chain_id"`
+	TokenAddress string          `json:"token_address"`
+	BlockNumber  uint64          `json:"block_number"`
+	Holders      int             `json:"holders"`
+	TotalAmount  string          `json:"total_amount"`
+	Recipients   []HolderBalance `json:"recipients"`
+}
+
+// SnapshotHolderBalances replays the transfers up to and including blockNumber into the balances of the holders.
+// Holders in exclude and holders below minBalance are left out, the largest balance comes first
+func SnapshotHolderBalances(transfers []TokenTransfer, blockNumber uint64, minBalance *big.Int, exclude []string) []HolderBalance {
+	balances := map[string]*big.Int{}
+	update := func(address string, delta *big.Int) {
+		address = common.HexToAddress(address).Hex()
+		balance, ok := balances[address]
+		if !ok {
+			balance = new(big.Int)
+			balances[address] = balance
+		}
+		balance.Add(balance, delta)
+	}
+	for _, transfer := range transfers {
+		if transfer.BlockNumber > blockNumber {
+			continue
+		}
+		update(transfer.From, new(big.Int).Neg(transfer.Value))
+		update(transfer.To, transfer.Value)
+	}
+
+	// The zero address is the mint and burn counterparty, it never holds the token
+	delete(balances, common.Address{}.Hex())
+	for _, address := range exclude {
+		delete(balances, common.HexToAddress(address).Hex())
+	}
+
+	holders := make([]string, 0, len(balances))
+	for address, balance := range balances {
+		if balance.Sign() <= 0 || (minBalance != nil && balance.Cmp(minBalance) < 0) {
+			continue
+		}
+		holders = append(holders, address)
+	}
+	sort.Slice(holders, func(i, j int) bool {
+		if cmp := balances[holders[i]].Cmp(balances[holders[j]]); cmp != 0 {
+			return cmp > 0
+		}
+		return holders[i] < holders[j]
+	})
+
+	recipients := make([]HolderBalance, 0, len(holders))
+	for _, address := range holders {
+		recipients = append(recipients, HolderBalance{Address: address, Amount: balances[address].String()})
+	}
+	return recipients
+}
+
+// HolderSnapshotRows converts the recipients of a snapshot to rows following HolderSnapshotColumns
+func HolderSnapshotRows(recipients []HolderBalance) [][]string {
+	rows := make([][]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		rows = append(rows, []string{recipient.Address, recipient.Amount})
+	}
+	return rows
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotHolderBalances(t *testing.T) {
+	zero := common.Address{}.Hex()
+	alice := "0x1111111111111111111111111111111111111111"
+	bob := "0x2222222222222222222222222222222222222222"
+	pair := "0x3333333333333333333333333333333333333333"
+	transfers := []TokenTransfer{
+		{From: zero, To: alice, Value: big.NewInt(1000), BlockNumber: 1},
+		{From: alice, To: pair, Value: big.NewInt(500), BlockNumber: 2},
+		{From: pair, To: bob, Value: big.NewInt(50), BlockNumber: 3},
+		{From: bob, To: zero, Value: big.NewInt(50), BlockNumber: 4},
+	}
+
+	t.Run("replays the transfers up to the block", func(t *testing.T) {
+		assert.Equal(t, []HolderBalance{
+			{Address: common.HexToAddress(alice).Hex(), Amount: "500"},
+			{Address: common.HexToAddress(pair).Hex(), Amount: "450"},
+			{Address: common.HexToAddress(bob).Hex(), Amount: "50"},
+		}, SnapshotHolderBalances(transfers, 3, nil, nil))
+	})
+
+	t.Run("leaves out burnt balances", func(t *testing.T) {
+		assert.Len(t, SnapshotHolderBalances(transfers, 4, nil, nil), 2)
+	})
+
+	t.Run("filters excluded and small holders", func(t *testing.T) {
+		assert.Equal(t, []HolderBalance{
+			{Address: common.HexToAddress(alice).Hex(), Amount: "500"},
+		}, SnapshotHolderBalances(transfers, 3, big.NewInt(100), []string{pair}))
+	})
+
+	t.Run("converts to rows", func(t *testing.T) {
+		assert.Equal(t, [][]string{{"0xabc", "1"}}, HolderSnapshotRows([]HolderBalance{{Address: "0xabc", Amount: "1"}}))
+	})
+}
@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SnippetLanguage is a supported integration snippet flavour
+type SnippetLanguage string
+
+const (
+	SnippetLanguageEthers SnippetLanguage = "ethers"
+	SnippetLanguageViem   SnippetLanguage = "viem"
+	SnippetLanguageWagmi  SnippetLanguage = "wagmi"
+	SnippetLanguageWeb3Py SnippetLanguage = "web3py"
+)
+
+// SupportedSnippetLanguages lists every snippet flavour in the order they are generated
+var SupportedSnippetLanguages = []SnippetLanguage{
+	SnippetLanguageEthers,
+	SnippetLanguageViem,
+	SnippetLanguageWagmi,
+	SnippetLanguageWeb3Py,
+}
+
+// SnippetRPCEnvVar is the environment variable the snippets read the RPC URL from. The RPC of the chain is never
+// inlined, it often carries the API key of its provider
+const SnippetRPCEnvVar = "RPC_URL"
+
+// IntegrationSnippetArgs contains the contract information inlined into the generated snippets
+type IntegrationSnippetArgs struct {
+	ContractName    string
+	ContractAddress string
+	// Abi is the ABI array as a JSON string
+	Abi     string
+	ChainID string
+}
+
+// IntegrationSnippet is a ready to paste code snippet for a single language
+type IntegrationSnippet struct {
+	Language SnippetLanguage `json:"language"`
+	Filename string          `json:"filename"`
+	Code     string          `json:"code"`
+}
+
+// ParseSnippetLanguage validates a snippet language string
+func ParseSnippetLanguage(language string) (SnippetLanguage, error) {
+	for _, supported := range SupportedSnippetLanguages {
+		if strings.EqualFold(language, string(supported)) {
+			return supported, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported snippet language: %s. Supported languages: %v", language, SupportedSnippetLanguages)
+}
+
+// GenerateIntegrationSnippet generates a code snippet for the given language with the contract address and ABI inlined
+func GenerateIntegrationSnippet(language SnippetLanguage, args IntegrationSnippetArgs) (IntegrationSnippet, error) {
+	var prettyAbi bytes.Buffer
+	if err := json.Indent(&prettyAbi, []byte(args.Abi), "", "  "); err != nil {
+		return IntegrationSnippet{}, fmt.Errorf("invalid ABI JSON: %w", err)
+	}
+
+	identifier := ToIdentifier(args.ContractName)
+	variable := strings.ToLower(identifier[:1]) + identifier[1:]
+	abi := prettyAbi.String()
+
+	// Chain ID is rendered as a literal, fall back to an empty value when it is not configured
+	jsChainID, pyChainID := args.ChainID, args.ChainID
+	if args.ChainID == "" {
+		jsChainID, pyChainID = "undefined", "None"
+	}
+
+	switch language {
+	case SnippetLanguageEthers:
+		return IntegrationSnippet{
+			Language: language,
+			Filename: fmt.Sprintf("%s.ethers.ts", variable),
+			Code: fmt.Sprintf(`import { Contract, JsonRpcProvider } from "ethers";
+
+export const %[1]sAddress = "%[2]s";
+export const %[1]sAbi = %[3]s;
+
+const provider = new JsonRpcProvider(process.env.%[4]s, %[5]s);
+
+// Use a Signer instead of the provider for state-changing calls
+export const %[1]s = new Contract(%[1]sAddress, %[1]sAbi, provider);
+`, variable, args.ContractAddress, abi, SnippetRPCEnvVar, jsChainID),
+		}, nil
+	case SnippetLanguageViem:
+		return IntegrationSnippet{
+			Language: language,
+			Filename: fmt.Sprintf("%s.viem.ts", variable),
+			Code: fmt.Sprintf(`import { createPublicClient, getContract, http } from "viem";
+
+export const %[1]sAddress = "%[2]s" as const;
+export const %[1]sAbi = %[3]s as const;
+
+export const publicClient = createPublicClient({
+  transport: http(process.env.%[4]s),
+});
+
+export const %[1]s = getContract({
+  address: %[1]sAddress,
+  abi: %[1]sAbi,
+  client: publicClient,
+});
+`, variable, args.ContractAddress, abi, SnippetRPCEnvVar),
+		}, nil
+	case SnippetLanguageWagmi:
+		return IntegrationSnippet{
+			Language: language,
+			Filename: fmt.Sprintf("use%s.ts", identifier),
+			Code: fmt.Sprintf(`import { useReadContract, useWriteContract } from "wagmi";
+
+export const %[1]sAddress = "%[2]s" as const;
+export const %[1]sAbi = %[3]s as const;
+export const %[1]sChainId = %[4]s;
+
+export function useRead%[5]s(functionName: string, args: readonly unknown[] = []) {
+  return useReadContract({
+    address: %[1]sAddress,
+    abi: %[1]sAbi,
+    chainId: %[1]sChainId,
+    functionName: functionName as never,
+    args: args as never,
+  });
+}
+
+export function useWrite%[5]s() {
+  const { writeContract, ...rest } = useWriteContract();
+  const write = (functionName: string, args: readonly unknown[] = []) =>
+    writeContract({
+      address: %[1]sAddress,
+      abi: %[1]sAbi,
+      chainId: %[1]sChainId,
+      functionName: functionName as never,
+      args: args as never,
+    });
+  return { write, ...rest };
+}
+`, variable, args.ContractAddress, abi, jsChainID, identifier),
+		}, nil
+	case SnippetLanguageWeb3Py:
+		return IntegrationSnippet{
+			Language: language,
+			Filename: fmt.Sprintf("%s.py", ToSnakeCase(identifier)),
+			Code: fmt.Sprintf(`import json
+import os
+
+from web3 import Web3
+
+CONTRACT_ADDRESS = Web3.to_checksum_address("%[1]s")
+CHAIN_ID = %[2]s
+ABI = json.loads("""
+%[3]s
+""")
+
+w3 = Web3(Web3.HTTPProvider(os.environ["%[4]s"]))
+%[5]s = w3.eth.contract(address=CONTRACT_ADDRESS, abi=ABI)
+`, args.ContractAddress, pyChainID, abi, SnippetRPCEnvVar, ToSnakeCase(identifier)),
+		}, nil
+	default:
+		return IntegrationSnippet{}, fmt.Errorf("unsupported snippet language: %s", language)
+	}
+}
+
+// ToSnakeCase converts a PascalCase identifier to snake_case, a run of capitals is one word (ERC20Token is erc20_token)
+func ToSnakeCase(identifier string) string {
+	runes := []rune(identifier)
+	var builder strings.Builder
+	for i, r := range runes {
+		if isUpper(r) {
+			// a word starts after a lowercase letter or a digit, or at the last capital of a run followed by lowercase
+			if i > 0 && (!isUpper(runes[i-1]) || (i+1 < len(runes) && isLower(runes[i+1]))) {
+				builder.WriteByte('_')
+			}
+			builder.WriteRune(r + ('a' - 'A'))
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+func isUpper(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+func isLower(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
@@ -0,0 +1,285 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type ContractInterface string
+
+const (
+	InterfaceERC165        ContractInterface = "ERC-165"
+	InterfaceERC20         ContractInterface = "ERC-20"
+	InterfaceERC721        ContractInterface = "ERC-721"
+	InterfaceERC1155       ContractInterface = "ERC-1155"
+	InterfaceERC2612       ContractInterface = "ERC-2612"
+	InterfaceOwnable       ContractInterface = "Ownable"
+	InterfaceAccessControl ContractInterface = "AccessControl"
+	// InterfaceVotes is the OpenZeppelin IVotes interface of ERC20Votes tokens, the voting token of a Governor
+	InterfaceVotes ContractInterface = "IVotes"
+)
+
+// DetectionMethod tells how support for an interface was established
+type DetectionMethod string
+
+const (
+	DetectionMethodERC165   DetectionMethod = "supportsInterface"
+	DetectionMethodSelector DetectionMethod = "selector"
+)
+
+// interfaceSpec describes how an interface is detected.
+// InterfaceID is only set for interfaces registered through ERC-165, the selectors are probed in the
+// deployed bytecode when the contract does not answer supportsInterface.
+type interfaceSpec struct {
+	Interface   ContractInterface
+	InterfaceID string
+	Functions   []string
+}
+
+var interfaceSpecs = []interfaceSpec{
+	{
+		Interface: InterfaceERC20,
+		Functions: []string{"totalSupply()", "balanceOf(address)", "transfer(address,uint256)", "allowance(address,address)", "approve(address,uint256)", "transferFrom(address,address,uint256)"},
+	},
+	{
+		Interface:   InterfaceERC721,
+		InterfaceID: "0x80ac58cd",
+		Functions:   []string{"balanceOf(address)", "ownerOf(uint256)", "safeTransferFrom(address,address,uint256)", "transferFrom(address,address,uint256)", "approve(address,uint256)", "setApprovalForAll(address,bool)", "getApproved(uint256)", "isApprovedForAll(address,address)"},
+	},
+	{
+		Interface:   InterfaceERC1155,
+		InterfaceID: "0xd9b67a26",
+		Functions:   []string{"balanceOf(address,uint256)", "balanceOfBatch(address[],uint256[])", "setApprovalForAll(address,bool)", "isApprovedForAll(address,address)", "safeTransferFrom(address,address,uint256,uint256,bytes)", "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)"},
+	},
+	{
+		Interface: InterfaceERC2612,
+		Functions: []string{"permit(address,address,uint256,uint256,uint8,bytes32,bytes32)", "nonces(address)", "DOMAIN_SEPARATOR()"},
+	},
+	{
+		Interface: InterfaceOwnable,
+		Functions: []string{"owner()", "transferOwnership(address)"},
+	},
+	{
+		Interface:   InterfaceAccessControl,
+		InterfaceID: "0x7965db0b",
+		Functions:   []string{"hasRole(bytes32,address)", "getRoleAdmin(bytes32)", "grantRole(bytes32,address)", "revokeRole(bytes32,address)", "renounceRole(bytes32,address)"},
+	},
+	{
+		Interface: InterfaceVotes,
+		Functions: []string{"getVotes(address)", "getPastVotes(address,uint256)", "getPastTotalSupply(uint256)", "delegates(address)", "delegate(address)"},
+	},
+}
+
+// capabilityFunctions are the state-changing functions that enable the mint, burn and pause flows.
+// A capability is available when any of its functions is found.
+var capabilityFunctions = map[string][]string{
+	"mint":  {"mint(address,uint256)", "mint(uint256)", "safeMint(address)", "safeMint(address,uint256)", "mint(address,uint256,uint256,bytes)"},
+	"burn":  {"burn(uint256)", "burnFrom(address,uint256)", "burn(address,uint256,uint256)"},
+	"pause": {"pause()", "unpause()"},
+}
+
+const (
+	erc165InterfaceID  = "0x01ffc9a7"
+	erc165InvalidID    = "0xffffffff"
+	supportsInterfaceF = "supportsInterface(bytes4)"
+)
+
+// InterfaceDetection is the detection result of a single interface
+type InterfaceDetection struct {
+	Interface ContractInterface `json:"interface"`
+	Supported bool              `json:"supported"`
+	Method    DetectionMethod   `json:"method"`
+}
+
+// ContractCapabilities tells which dependent flows can be used with the contract
+type ContractCapabilities struct {
+	Mint   bool `json:"mint"`
+	Burn   bool `json:"burn"`
+	Pause  bool `json:"pause"`
+	Permit bool `json:"permit"`
+}
+
+type InterfaceDetectionResult struct {
+	ContractAddress string               `json:"contract_address"`
+	SupportsERC165  bool                 `json:"supports_erc165"`
+	Interfaces      []InterfaceDetection `json:"interfaces"`
+	Capabilities    ContractCapabilities `json:"capabilities"`
+}
+
+// SupportsInterfaceFunc calls supportsInterface(bytes4) on the contract
+type SupportsInterfaceFunc func(interfaceID string) (bool, error)
+
+// FunctionSelector returns the 4 byte selector of a function signature such as transfer(address,uint256)
+func FunctionSelector(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
+
+// HasFunctionSelector reports whether the deployed bytecode pushes the selector of the function,
+// which is how the Solidity and Vyper dispatchers match the selectors they implement.
+// Selectors with leading zero bytes are pushed with a shorter PUSH opcode.
+func HasFunctionSelector(code []byte, signature string) bool {
+	selector := bytes.TrimLeft(FunctionSelector(signature), "\x00")
+	if len(selector) == 0 {
+		return false
+	}
+	// PUSH1 is 0x60, PUSHn is 0x5f + n
+	pattern := append([]byte{byte(0x5f + len(selector))}, selector...)
+	return bytes.Contains(code, pattern)
+}
+
+func hasAllFunctionSelectors(code []byte, signatures []string) bool {
+	for _, signature := range signatures {
+		if !HasFunctionSelector(code, signature) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasAnyFunctionSelector(code []byte, signatures []string) bool {
+	for _, signature := range signatures {
+		if HasFunctionSelector(code, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectInterfacesFromCode detects the interfaces of a contract from its deployed bytecode.
+// ERC-165 contracts are asked through supportsInterface, every other interface falls back to selector probing.
+// supportsInterface may be nil when the contract cannot be called.
+func DetectInterfacesFromCode(contractAddress string, code []byte, supportsInterface SupportsInterfaceFunc) (*InterfaceDetectionResult, error) {
+	result := &InterfaceDetectionResult{
+		ContractAddress: contractAddress,
+		Interfaces:      []InterfaceDetection{},
+	}
+
+	// A contract supports ERC-165 when it answers true for the ERC-165 ID and false for 0xffffffff
+	if supportsInterface != nil && HasFunctionSelector(code, supportsInterfaceF) {
+		supported, err := supportsInterface(erc165InterfaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call supportsInterface: %w", err)
+		}
+		if supported {
+			invalid, err := supportsInterface(erc165InvalidID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to call supportsInterface: %w", err)
+			}
+			result.SupportsERC165 = !invalid
+		}
+	}
+
+	method := DetectionMethodSelector
+	if result.SupportsERC165 {
+		method = DetectionMethodERC165
+	}
+	result.Interfaces = append(result.Interfaces, InterfaceDetection{
+		Interface: InterfaceERC165,
+		Supported: result.SupportsERC165,
+		Method:    method,
+	})
+
+	for _, spec := range interfaceSpecs {
+		detection := InterfaceDetection{Interface: spec.Interface, Method: DetectionMethodSelector}
+		if result.SupportsERC165 && spec.InterfaceID != "" {
+			supported, err := supportsInterface(spec.InterfaceID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to call supportsInterface for %s: %w", spec.Interface, err)
+			}
+			detection.Supported = supported
+			detection.Method = DetectionMethodERC165
+		} else {
+			detection.Supported = hasAllFunctionSelectors(code, spec.Functions)
+		}
+		result.Interfaces = append(result.Interfaces, detection)
+	}
+
+	result.Capabilities = ContractCapabilities{
+		Mint:   hasAnyFunctionSelector(code, capabilityFunctions["mint"]),
+		Burn:   hasAnyFunctionSelector(code, capabilityFunctions["burn"]),
+		Pause:  hasAnyFunctionSelector(code, capabilityFunctions["pause"]),
+		Permit: result.Supports(InterfaceERC2612),
+	}
+
+	return result, nil
+}
+
+// Supports reports whether the interface was detected
+func (r *InterfaceDetectionResult) Supports(contractInterface ContractInterface) bool {
+	for _, detection := range r.Interfaces {
+		if detection.Interface == contractInterface {
+			return detection.Supported
+		}
+	}
+	return false
+}
+
+// HasContractCode reports whether a contract is deployed at the address
+func HasContractCode(rpcURL, address string) (bool, error) {
+	response, err := NewRPCClient(rpcURL).Call("eth_getCode", []interface{}{address, "latest"})
+	if err != nil {
+		return false, fmt.Errorf("failed to get the code of %s: %w", address, err)
+	}
+	code, ok := response.Result.(string)
+	if !ok {
+		return false, fmt.Errorf("invalid code response format")
+	}
+	return len(common.FromHex(code)) > 0, nil
+}
+
+// DetectInterfaces fetches the deployed bytecode of the contract and detects its interfaces.
+// Contracts behind a proxy only expose the proxy selectors, so selector probing may miss their interfaces.
+func DetectInterfaces(rpcURL, contractAddress string) (*InterfaceDetectionResult, error) {
+	if !IsValidEthereumAddress(contractAddress) {
+		return nil, fmt.Errorf("invalid contract address: %s", contractAddress)
+	}
+
+	client := NewRPCClient(rpcURL)
+	response, err := client.Call("eth_getCode", []interface{}{contractAddress, "latest"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contract code: %w", err)
+	}
+
+	codeHex, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid code response format")
+	}
+
+	code, err := hex.DecodeString(strings.TrimPrefix(codeHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode contract code: %w", err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("no contract deployed at %s", contractAddress)
+	}
+
+	supportsInterface := func(interfaceID string) (bool, error) {
+		data := "0x" + hex.EncodeToString(FunctionSelector(supportsInterfaceF)) + hex.EncodeToString(common.RightPadBytes(common.FromHex(interfaceID), 32))
+		response, err := client.Call("eth_call", []interface{}{
+			map[string]string{
+				"to":   contractAddress,
+				"data": data,
+			},
+			"latest",
+		})
+		if err != nil {
+			// A reverting supportsInterface means the interface is not supported
+			return false, nil
+		}
+
+		resultHex, ok := response.Result.(string)
+		if !ok {
+			return false, nil
+		}
+		value, ok := new(big.Int).SetString(strings.TrimPrefix(resultHex, "0x"), 16)
+		return ok && value.Sign() != 0, nil
+	}
+
+	return DetectInterfacesFromCode(contractAddress, code, supportsInterface)
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dispatcherCode builds bytecode that pushes the selectors of the functions like a Solidity dispatcher
+func dispatcherCode(signatures ...string) []byte {
+	var code []byte
+	for _, signature := range signatures {
+		selector := FunctionSelector(signature)
+		for len(selector) > 1 && selector[0] == 0 {
+			selector = selector[1:]
+		}
+		code = append(code, 0x80, byte(0x5f+len(selector)))
+		code = append(code, selector...)
+		code = append(code, 0x14)
+	}
+	return code
+}
+
+var erc20Functions = []string{"totalSupply()", "balanceOf(address)", "transfer(address,uint256)", "allowance(address,address)", "approve(address,uint256)", "transferFrom(address,address,uint256)"}
+
+func TestFunctionSelector(t *testing.T) {
+	assert.Equal(t, "a9059cbb", hex.EncodeToString(FunctionSelector("transfer(address,uint256)")))
+	assert.Equal(t, "01ffc9a7", hex.EncodeToString(FunctionSelector("supportsInterface(bytes4)")))
+}
+
+func TestHasFunctionSelector(t *testing.T) {
+	code := dispatcherCode("transfer(address,uint256)")
+	assert.True(t, HasFunctionSelector(code, "transfer(address,uint256)"))
+	assert.False(t, HasFunctionSelector(code, "approve(address,uint256)"))
+
+	// balanceOf(address,uint256) is 0x00fdd58e and is pushed with PUSH3
+	code = dispatcherCode("balanceOf(address,uint256)")
+	assert.Equal(t, byte(0x62), code[1])
+	assert.True(t, HasFunctionSelector(code, "balanceOf(address,uint256)"))
+}
+
+func TestDetectInterfacesFromCodeERC20(t *testing.T) {
+	signatures := append([]string{}, erc20Functions...)
+	signatures = append(signatures,
+		"permit(address,address,uint256,uint256,uint8,bytes32,bytes32)", "nonces(address)", "DOMAIN_SEPARATOR()",
+		"owner()", "transferOwnership(address)",
+		"mint(address,uint256)",
+	)
+
+	result, err := DetectInterfacesFromCode("0x1111111111111111111111111111111111111111", dispatcherCode(signatures...), nil)
+	require.NoError(t, err)
+
+	assert.False(t, result.SupportsERC165)
+	assert.True(t, result.Supports(InterfaceERC20))
+	assert.True(t, result.Supports(InterfaceERC2612))
+	assert.True(t, result.Supports(InterfaceOwnable))
+	assert.False(t, result.Supports(InterfaceERC721))
+	assert.False(t, result.Supports(InterfaceAccessControl))
+	assert.False(t, result.Supports(InterfaceVotes))
+	assert.Equal(t, ContractCapabilities{Mint: true, Permit: true}, result.Capabilities)
+
+	signatures = append(signatures, "getVotes(address)", "getPastVotes(address,uint256)", "getPastTotalSupply(uint256)", "delegates(address)", "delegate(address)")
+	result, err = DetectInterfacesFromCode("0x1111111111111111111111111111111111111111", dispatcherCode(signatures...), nil)
+	require.NoError(t, err)
+	assert.True(t, result.Supports(InterfaceVotes))
+}
+
+func TestDetectInterfacesFromCodeERC165(t *testing.T) {
+	code := dispatcherCode("supportsInterface(bytes4)", "pause()", "unpause()")
+	supported := map[string]bool{"0x01ffc9a7": true, "0x80ac58cd": true, "0x7965db0b": true}
+
+	result, err := DetectInterfacesFromCode("0x1111111111111111111111111111111111111111", code, func(interfaceID string) (bool, error) {
+		return supported[interfaceID], nil
+	})
+	require.NoError(t, err)
+
+	assert.True(t, result.SupportsERC165)
+	assert.True(t, result.Supports(InterfaceERC721))
+	assert.True(t, result.Supports(InterfaceAccessControl))
+	assert.False(t, result.Supports(InterfaceERC1155))
+	assert.True(t, result.Capabilities.Pause)
+	for _, detection := range result.Interfaces {
+		if detection.Interface == InterfaceERC721 {
+			assert.Equal(t, DetectionMethodERC165, detection.Method)
+		}
+	}
+}
+
+func TestDetectInterfacesFromCodeRejectsInvalidERC165(t *testing.T) {
+	// A contract answering true for 0xffffffff does not implement ERC-165 correctly
+	code := dispatcherCode("supportsInterface(bytes4)")
+	result, err := DetectInterfacesFromCode("0x1111111111111111111111111111111111111111", code, func(string) (bool, error) {
+		return true, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, result.SupportsERC165)
+	assert.False(t, result.Supports(InterfaceERC721))
+}
+
+func TestDetectInterfaces(t *testing.T) {
+	code := "0x" + hex.EncodeToString(dispatcherCode(erc20Functions...))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		result := code
+		if request.Params[0] == "0x2222222222222222222222222222222222222222" {
+			result = "0x"
+		}
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result})
+	}))
+	defer server.Close()
+
+	result, err := DetectInterfaces(server.URL, "0x1111111111111111111111111111111111111111")
+	require.NoError(t, err)
+	assert.True(t, result.Supports(InterfaceERC20))
+
+	_, err = DetectInterfaces(server.URL, "0x2222222222222222222222222222222222222222")
+	assert.Error(t, err)
+
+	_, err = DetectInterfaces(server.URL, "0x123")
+	assert.Error(t, err)
+}
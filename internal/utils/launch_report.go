@@ -0,0 +1,582 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// LaunchReportFormat is the output format of a launch report
+type LaunchReportFormat string
+
+const (
+	LaunchReportFormatMarkdown LaunchReportFormat = "markdown"
+	LaunchReportFormatHTML     LaunchReportFormat = "html"
+)
+
+// sparklineBlocks are the bars of the markdown price chart, lowest first
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// TokenTransfer is a Transfer event of an ERC20 token
+type TokenTransfer struct {
+	From        string
+	To          string
+	Value       *big.Int
+	BlockNumber uint64
+	// Timestamp is the time of the block, nil when the node does not return blockTimestamp with the logs
+	Timestamp *time.Time
+}
+
+// HolderCount is the number of addresses holding the token after a block
+type HolderCount struct {
+	BlockNumber uint64     `json:"block_number"`
+	Time        *time.Time `json:"time,omitempty"`
+	Holders     int        `json:"holders"`
+}
+
+// LaunchPoolState is the reserves of the launch pool after a transaction, with the launched token first
+type LaunchPoolState struct {
+	Time            time.Time
+	TransactionType models.TransactionType
+	TokenReserve    *big.Int
+	QuoteReserve    *big.Int
+}
+
+// LaunchVolume is the swap activity of the launch pool, computed from the reserve changes of each swap
+type LaunchVolume struct {
+	Swaps int `json:"swaps"`
+	Buys  int `json:"buys"`
+	Sells int `json:"sells"`
+	// QuoteVolume is the quote token paid in on buys plus the quote token paid out on sells
+	QuoteVolume  string `json:"quote_volume"`
+	TokensBought string `json:"tokens_bought"`
+	TokensSold   string `json:"tokens_sold"`
+}
+
+// LaunchReportDeployment is the deployment section of a launch report
+type LaunchReportDeployment struct {
+	ID              uint      `json:"id"`
+	TemplateName    string    `json:"template_name"`
+	ContractAddress string    `json:"contract_address"`
+	ChainName       string    `json:"chain_name"`
+	ChainID         string    `json:"chain_id"`
+	DeployerAddress string    `json:"deployer_address"`
+	TransactionHash string    `json:"transaction_hash"`
+	GasUsed         string    `json:"gas_used,omitempty"`
+	GasCost         string    `json:"gas_cost,omitempty"`
+	DeployedAt      time.Time `json:"deployed_at"`
+}
+
+// LaunchReportPool is the pool funding section of a launch report
+type LaunchReportPool struct {
+	ID              uint      `json:"id"`
+	PairAddress     string    `json:"pair_address"`
+	QuoteToken      string    `json:"quote_token"`
+	TokenAmount     string    `json:"token_amount"`
+	QuoteAmount     string    `json:"quote_amount"`
+	CreatorAddress  string    `json:"creator_address"`
+	TransactionHash string    `json:"transaction_hash"`
+	CreatedAt       time.Time `json:"created_at"`
+	PageURL         string    `json:"page_url,omitempty"`
+}
+
+// LaunchReport is the summary of a token launch that can be rendered as markdown or HTML
+type LaunchReport struct {
+	Deployment LaunchReportDeployment `json:"deployment"`
+	// Pool, Volume and Candles are missing when the token has no confirmed pool
+	Pool    *LaunchReportPool `json:"pool,omitempty"`
+	Volume  *LaunchVolume     `json:"volume,omitempty"`
+	Candles []Candle          `json:"candles,omitempty"`
+	Holders []HolderCount     `json:"holders,omitempty"`
+	// VolumeSource and HoldersSource tell whether the indexed events, the pool snapshots or the RPC were read
+	VolumeSource  string `json:"volume_source,omitempty"`
+	HoldersSource string `json:"holders_source,omitempty"`
+	// HoldersError is set when the transfer logs of the token could not be read
+	HoldersError string    `json:"holders_error,omitempty"`
+	GeneratedAt  time.Time `json:"generated_at"`
+}
+
+// GetTokenTransfers returns the Transfer events of the token in chain order
+func GetTokenTransfers(rpcURL, tokenAddress string) ([]TokenTransfer, error) {
+	filter := map[string]interface{}{
+		"address":   tokenAddress,
+		"fromBlock": "0x0",
+		"toBlock":   "latest",
+		"topics":    []interface{}{transferEventTopic},
+	}
+
+	response, err := NewRPCClient(rpcURL).Call("eth_getLogs", []interface{}{filter})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer logs: %w", err)
+	}
+
+	logsJSON, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal logs: %w", err)
+	}
+
+	var logs []struct {
+		Topics         []string `json:"topics"`
+		Data           string   `json:"data"`
+		BlockNumber    string   `json:"blockNumber"`
+		BlockTimestamp string   `json:"blockTimestamp"`
+	}
+	if err := json.Unmarshal(logsJSON, &logs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal logs: %w", err)
+	}
+
+	transfers := make([]TokenTransfer, 0, len(logs))
+	for _, entry := range logs {
+		// ERC721 transfers index the token id as a fourth topic, they are not token amounts
+		if len(entry.Topics) != 3 {
+			continue
+		}
+		blockNumber, err := strconv.ParseUint(strings.TrimPrefix(entry.BlockNumber, "0x"), 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block number %s: %w", entry.BlockNumber, err)
+		}
+
+		transfer := TokenTransfer{
+			From:        common.HexToAddress(entry.Topics[1]).Hex(),
+			To:          common.HexToAddress(entry.Topics[2]).Hex(),
+			Value:       new(big.Int).SetBytes(common.FromHex(entry.Data)),
+			BlockNumber: blockNumber,
+		}
+		if seconds, err := strconv.ParseInt(strings.TrimPrefix(entry.BlockTimestamp, "0x"), 16, 64); err == nil {
+			timestamp := time.Unix(seconds, 0).UTC()
+			transfer.Timestamp = &timestamp
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+// HolderGrowth replays the transfers and returns the holder count after each block with transfers.
+// The series is thinned to at most maxPoints entries, the last block is always kept
+func HolderGrowth(transfers []TokenTransfer, maxPoints int) []HolderCount {
+	balances := map[string]*big.Int{}
+	holders := 0
+	counts := []HolderCount{}
+
+	update := func(address string, delta *big.Int) {
+		// The zero address is the mint and burn counterparty, it never holds the token
+		if address == (common.Address{}).Hex() {
+			return
+		}
+		balance, ok := balances[address]
+		if !ok {
+			balance = new(big.Int)
+			balances[address] = balance
+		}
+		before := balance.Sign() > 0
+		balance.Add(balance, delta)
+		after := balance.Sign() > 0
+		if !before && after {
+			holders++
+		} else if before && !after {
+			holders--
+		}
+	}
+
+	for _, transfer := range transfers {
+		update(transfer.From, new(big.Int).Neg(transfer.Value))
+		update(transfer.To, transfer.Value)
+
+		if len(counts) > 0 && counts[len(counts)-1].BlockNumber == transfer.BlockNumber {
+			counts[len(counts)-1].Holders = holders
+			continue
+		}
+		counts = append(counts, HolderCount{BlockNumber: transfer.BlockNumber, Time: transfer.Timestamp, Holders: holders})
+	}
+
+	if maxPoints <= 0 || len(counts) <= maxPoints {
+		return counts
+	}
+	if maxPoints == 1 {
+		return counts[len(counts)-1:]
+	}
+	thinned := make([]HolderCount, 0, maxPoints)
+	step := float64(len(counts)-1) / float64(maxPoints-1)
+	for i := 0; i < maxPoints; i++ {
+		thinned = append(thinned, counts[int(float64(i)*step+0.5)])
+	}
+	return thinned
+}
+
+// BuildLaunchVolume sums the swaps of the states up to until. Each state is compared to the one before it,
+// starting from the initial funding, so liquidity changes move the baseline without counting as volume
+func BuildLaunchVolume(initial LaunchPoolState, states []LaunchPoolState, until time.Time) LaunchVolume {
+	quoteVolume := new(big.Int)
+	tokensBought := new(big.Int)
+	tokensSold := new(big.Int)
+	volume := LaunchVolume{}
+
+	previous := initial
+	for _, state := range states {
+		if state.Time.After(until) {
+			break
+		}
+		if state.TransactionType == models.TransactionTypeTokenSwap {
+			tokenDelta := new(big.Int).Sub(state.TokenReserve, previous.TokenReserve)
+			quoteDelta := new(big.Int).Sub(state.QuoteReserve, previous.QuoteReserve)
+			switch {
+			case tokenDelta.Sign() < 0:
+				// The token left the pool, it was bought with the quote token
+				volume.Buys++
+				tokensBought.Sub(tokensBought, tokenDelta)
+				quoteVolume.Add(quoteVolume, quoteDelta.Abs(quoteDelta))
+			case tokenDelta.Sign() > 0:
+				volume.Sells++
+				tokensSold.Add(tokensSold, tokenDelta)
+				quoteVolume.Add(quoteVolume, quoteDelta.Abs(quoteDelta))
+			}
+			volume.Swaps++
+		}
+		previous = state
+	}
+
+	volume.QuoteVolume = quoteVolume.String()
+	volume.TokensBought = tokensBought.String()
+	volume.TokensSold = tokensSold.String()
+	return volume
+}
+
+// BuildIndexedLaunchVolume sums the indexed Swap events of the launch pool between since and until. ok is false when a
+// swap has no block time, the window cannot be applied then
+func BuildIndexedLaunchVolume(swaps []models.IndexedEvent, tokenIsToken0 bool, since, until time.Time) (volume LaunchVolume, ok bool) {
+	quoteVolume := new(big.Int)
+	tokensBought := new(big.Int)
+	tokensSold := new(big.Int)
+
+	amount := func(value string) *big.Int {
+		parsed, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return new(big.Int)
+		}
+		return parsed
+	}
+	for _, swap := range swaps {
+		if swap.BlockTime == nil {
+			return LaunchVolume{}, false
+		}
+		if swap.BlockTime.Before(since) || swap.BlockTime.After(until) {
+			continue
+		}
+
+		tokenIn, tokenOut, quoteIn, quoteOut := amount(swap.Amount1In), amount(swap.Amount1Out), amount(swap.Amount0In), amount(swap.Amount0Out)
+		if tokenIsToken0 {
+			tokenIn, tokenOut, quoteIn, quoteOut = amount(swap.Amount0In), amount(swap.Amount0Out), amount(swap.Amount1In), amount(swap.Amount1Out)
+		}
+		switch {
+		case tokenOut.Sign() > 0:
+			volume.Buys++
+			tokensBought.Add(tokensBought, tokenOut)
+			quoteVolume.Add(quoteVolume, quoteIn)
+		case tokenIn.Sign() > 0:
+			volume.Sells++
+			tokensSold.Add(tokensSold, tokenIn)
+			quoteVolume.Add(quoteVolume, quoteOut)
+		}
+		volume.Swaps++
+	}
+
+	volume.QuoteVolume = quoteVolume.String()
+	volume.TokensBought = tokensBought.String()
+	volume.TokensSold = tokensSold.String()
+	return volume, true
+}
+
+// IndexedTransfers converts indexed Transfer events for HolderGrowth
+func IndexedTransfers(events []models.IndexedEvent) []TokenTransfer {
+	transfers := make([]TokenTransfer, 0, len(events))
+	for _, event := range events {
+		value, ok := new(big.Int).SetString(event.Value, 10)
+		if !ok {
+			continue
+		}
+		transfers = append(transfers, TokenTransfer{
+			From:        event.Sender,
+			To:          event.Recipient,
+			Value:       value,
+			BlockNumber: event.BlockNumber,
+			Timestamp:   event.BlockTime,
+		})
+	}
+	return transfers
+}
+
+// Sparkline draws the values as a line of block characters scaled between their minimum and maximum
+func Sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low = min(low, value)
+		high = max(high, value)
+	}
+
+	var builder strings.Builder
+	for _, value := range values {
+		index := len(sparklineBlocks) / 2
+		if high > low {
+			index = int((value - low) / (high - low) * float64(len(sparklineBlocks)-1))
+		}
+		builder.WriteRune(sparklineBlocks[index])
+	}
+	return builder.String()
+}
+
+// RenderLaunchReport renders the report in the given format
+func RenderLaunchReport(report LaunchReport, format LaunchReportFormat) (string, error) {
+	switch format {
+	case LaunchReportFormatMarkdown, "":
+		return RenderLaunchReportMarkdown(report), nil
+	case LaunchReportFormatHTML:
+		return RenderLaunchReportHTML(report)
+	default:
+		return "", fmt.Errorf("unsupported report format %s", format)
+	}
+}
+
+// RenderLaunchReportMarkdown renders the report as markdown. Amounts are in the base units of their token
+func RenderLaunchReportMarkdown(report LaunchReport) string {
+	var b strings.Builder
+	deployment := report.Deployment
+	fmt.Fprintf(&b, "# Launch report: %s\n\n", deployment.TemplateName)
+
+	b.WriteString("## Deployment\n\n")
+	b.WriteString("| | |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Contract | `%s` |\n", deployment.ContractAddress)
+	fmt.Fprintf(&b, "| Chain | %s (%s) |\n", deployment.ChainName, deployment.ChainID)
+	fmt.Fprintf(&b, "| Deployer | `%s` |\n", deployment.DeployerAddress)
+	fmt.Fprintf(&b, "| Transaction | `%s` |\n", deployment.TransactionHash)
+	if deployment.GasCost != "" {
+		fmt.Fprintf(&b, "| Gas | %s used, %s wei |\n", deployment.GasUsed, deployment.GasCost)
+	}
+	fmt.Fprintf(&b, "| Deployed at | %s |\n\n", formatReportTime(deployment.DeployedAt))
+
+	b.WriteString("## Pool funding\n\n")
+	if pool := report.Pool; pool != nil {
+		b.WriteString("| | |\n|---|---|\n")
+		fmt.Fprintf(&b, "| Pair | `%s` |\n", pool.PairAddress)
+		fmt.Fprintf(&b, "| Token | %s |\n", pool.TokenAmount)
+		fmt.Fprintf(&b, "| %s | %s |\n", pool.QuoteToken, pool.QuoteAmount)
+		fmt.Fprintf(&b, "| Funded by | `%s` |\n", pool.CreatorAddress)
+		fmt.Fprintf(&b, "| Transaction | `%s` |\n", pool.TransactionHash)
+		fmt.Fprintf(&b, "| Created at | %s |\n", formatReportTime(pool.CreatedAt))
+		if pool.PageURL != "" {
+			fmt.Fprintf(&b, "\n[Pool page](%s)\n", pool.PageURL)
+		}
+		b.WriteString("\n")
+	} else {
+		b.WriteString("The token has no confirmed liquidity pool.\n\n")
+	}
+
+	if volume := report.Volume; volume != nil {
+		b.WriteString("## First 24h swap volume\n\n")
+		b.WriteString("| | |\n|---|---|\n")
+		fmt.Fprintf(&b, "| Swaps | %d (%d buys, %d sells) |\n", volume.Swaps, volume.Buys, volume.Sells)
+		fmt.Fprintf(&b, "| %s volume | %s |\n", report.Pool.QuoteToken, volume.QuoteVolume)
+		fmt.Fprintf(&b, "| Tokens bought | %s |\n", volume.TokensBought)
+		fmt.Fprintf(&b, "| Tokens sold | %s |\n\n", volume.TokensSold)
+	}
+
+	b.WriteString("## Holder growth\n\n")
+	switch {
+	case report.HoldersError != "":
+		fmt.Fprintf(&b, "Holders are unavailable: %s\n\n", report.HoldersError)
+	case len(report.Holders) == 0:
+		b.WriteString("The token has no transfers yet.\n\n")
+	default:
+		b.WriteString("| Block | Time | Holders |\n|---|---|---|\n")
+		for _, count := range report.Holders {
+			when := "-"
+			if count.Time != nil {
+				when = formatReportTime(*count.Time)
+			}
+			fmt.Fprintf(&b, "| %d | %s | %d |\n", count.BlockNumber, when, count.Holders)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(report.Candles) > 0 {
+		first, last := report.Candles[0], report.Candles[len(report.Candles)-1]
+		b.WriteString("## Price\n\n")
+		fmt.Fprintf(&b, "`%s`\n\n", Sparkline(candleCloses(report.Candles)))
+		fmt.Fprintf(&b, "Open %s, close %s, high %s, low %s (%s per token)\n\n",
+			formatReportPrice(first.Open), formatReportPrice(last.Close),
+			formatReportPrice(candleHigh(report.Candles)), formatReportPrice(candleLow(report.Candles)), report.Pool.QuoteToken)
+	}
+
+	fmt.Fprintf(&b, "_Generated at %s_\n", formatReportTime(report.GeneratedAt))
+	return b.String()
+}
+
+// RenderLaunchReportHTML renders the report as a standalone HTML document with an inline SVG price chart
+func RenderLaunchReportHTML(report LaunchReport) (string, error) {
+	tmpl, err := template.New("launch_report").Funcs(template.FuncMap{
+		"time":  formatReportTime,
+		"price": formatReportPrice,
+	}).Parse(launchReportHTMLTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse report template: %w", err)
+	}
+
+	data := struct {
+		LaunchReport
+		ChartPoints string
+		High        float64
+		Low         float64
+	}{LaunchReport: report}
+	if len(report.Candles) > 0 {
+		data.ChartPoints = chartPoints(candleCloses(report.Candles), 600, 160)
+		data.High = candleHigh(report.Candles)
+		data.Low = candleLow(report.Candles)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render report: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// chartPoints scales the values to an SVG polyline of the given size, higher values drawn higher
+func chartPoints(values []float64, width, height float64) string {
+	low, high := values[0], values[0]
+	for _, value := range values {
+		low = min(low, value)
+		high = max(high, value)
+	}
+
+	points := make([]string, 0, len(values))
+	for i, value := range values {
+		x := 0.0
+		if len(values) > 1 {
+			x = float64(i) / float64(len(values)-1) * width
+		}
+		y := height / 2
+		if high > low {
+			y = height - (value-low)/(high-low)*height
+		}
+		points = append(points, fmt.Sprintf("%.1f,%.1f", x, y))
+	}
+	return strings.Join(points, " ")
+}
+
+func candleCloses(candles []Candle) []float64 {
+	closes := make([]float64, 0, len(candles))
+	for _, candle := range candles {
+		closes = append(closes, candle.Close)
+	}
+	return closes
+}
+
+func candleHigh(candles []Candle) float64 {
+	high := candles[0].High
+	for _, candle := range candles {
+		high = max(high, candle.High)
+	}
+	return high
+}
+
+func candleLow(candles []Candle) float64 {
+	low := candles[0].Low
+	for _, candle := range candles {
+		low = min(low, candle.Low)
+	}
+	return low
+}
+
+func formatReportTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+func formatReportPrice(price float64) string {
+	return strconv.FormatFloat(price, 'g', 6, 64)
+}
+
+const launchReportHTMLTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Launch report: {{.Deployment.TemplateName}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; max-width: 720px; margin: 2rem auto; color: #1f2937; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1.5rem; }
+td, th { border-bottom: 1px solid #e5e7eb; padding: 0.4rem; text-align: left; }
+code { font-size: 0.85em; word-break: break-all; }
+svg { background: #f9fafb; border-radius: 6px; }
+</style>
+</head>
+<body>
+<h1>Launch report: {{.Deployment.TemplateName}}</h1>
+<h2>Deployment</h2>
+<table>
+<tr><td>Contract</td><td><code>{{.Deployment.ContractAddress}}</code></td></tr>
+<tr><td>Chain</td><td>{{.Deployment.ChainName}} ({{.Deployment.ChainID}})</td></tr>
+<tr><td>Deployer</td><td><code>{{.Deployment.DeployerAddress}}</code></td></tr>
+<tr><td>Transaction</td><td><code>{{.Deployment.TransactionHash}}</code></td></tr>
+{{- if .Deployment.GasCost}}
+<tr><td>Gas</td><td>{{.Deployment.GasUsed}} used, {{.Deployment.GasCost}} wei</td></tr>
+{{- end}}
+<tr><td>Deployed at</td><td>{{time .Deployment.DeployedAt}}</td></tr>
+</table>
+<h2>Pool funding</h2>
+{{- with .Pool}}
+<table>
+<tr><td>Pair</td><td><code>{{.PairAddress}}</code></td></tr>
+<tr><td>Token</td><td>{{.TokenAmount}}</td></tr>
+<tr><td>{{.QuoteToken}}</td><td>{{.QuoteAmount}}</td></tr>
+<tr><td>Funded by</td><td><code>{{.CreatorAddress}}</code></td></tr>
+<tr><td>Transaction</td><td><code>{{.TransactionHash}}</code></td></tr>
+<tr><td>Created at</td><td>{{time .CreatedAt}}</td></tr>
+</table>
+{{- if .PageURL}}
+<p><a href="{{.PageURL}}">Pool page</a></p>
+{{- end}}
+{{- else}}
+<p>The token has no confirmed liquidity pool.</p>
+{{- end}}
+{{- with .Volume}}
+<h2>First 24h swap volume</h2>
+<table>
+<tr><td>Swaps</td><td>{{.Swaps}} ({{.Buys}} buys, {{.Sells}} sells)</td></tr>
+<tr><td>{{$.Pool.QuoteToken}} volume</td><td>{{.QuoteVolume}}</td></tr>
+<tr><td>Tokens bought</td><td>{{.TokensBought}}</td></tr>
+<tr><td>Tokens sold</td><td>{{.TokensSold}}</td></tr>
+</table>
+{{- end}}
+<h2>Holder growth</h2>
+{{- if .HoldersError}}
+<p>Holders are unavailable: {{.HoldersError}}</p>
+{{- else if .Holders}}
+<table>
+<tr><th>Block</th><th>Time</th><th>Holders</th></tr>
+{{- range .Holders}}
+<tr><td>{{.BlockNumber}}</td><td>{{if .Time}}{{time .Time}}{{else}}-{{end}}</td><td>{{.Holders}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p>The token has no transfers yet.</p>
+{{- end}}
+{{- if .ChartPoints}}
+<h2>Price</h2>
+<svg viewBox="0 0 600 160" width="600" height="160" role="img" aria-label="Price chart">
+<polyline fill="none" stroke="#2563eb" stroke-width="2" points="{{.ChartPoints}}"/>
+</svg>
+<p>High {{price .High}}, low {{price .Low}} ({{.Pool.QuoteToken}} per token)</p>
+{{- end}}
+<p><em>Generated at {{time .GeneratedAt}}</em></p>
+</body>
+</html>
+`
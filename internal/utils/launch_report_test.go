@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHolderGrowth(t *testing.T) {
+	zero := "0x0000000000000000000000000000000000000000"
+	alice := "0x1111111111111111111111111111111111111111"
+	bob := "0x2222222222222222222222222222222222222222"
+	transfers := []TokenTransfer{
+		// Mint to the deployer
+		{From: zero, To: alice, Value: big.NewInt(100), BlockNumber: 1},
+		// Two transfers in one block are counted once
+		{From: alice, To: bob, Value: big.NewInt(10), BlockNumber: 2},
+		{From: bob, To: alice, Value: big.NewInt(5), BlockNumber: 2},
+		// Bob sends everything back and stops holding
+		{From: bob, To: alice, Value: big.NewInt(5), BlockNumber: 3},
+		// Burn
+		{From: alice, To: zero, Value: big.NewInt(100), BlockNumber: 4},
+	}
+
+	counts := HolderGrowth(transfers, 0)
+	require.Len(t, counts, 4)
+	assert.Equal(t, []int{1, 2, 1, 0}, []int{counts[0].Holders, counts[1].Holders, counts[2].Holders, counts[3].Holders})
+	assert.Equal(t, uint64(2), counts[1].BlockNumber)
+
+	thinned := HolderGrowth(transfers, 2)
+	require.Len(t, thinned, 2)
+	assert.Equal(t, uint64(1), thinned[0].BlockNumber)
+	assert.Equal(t, uint64(4), thinned[1].BlockNumber)
+	assert.Equal(t, uint64(4), HolderGrowth(transfers, 1)[0].BlockNumber)
+}
+
+func TestBuildLaunchVolume(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := func(offset time.Duration, txType models.TransactionType, token, quote int64) LaunchPoolState {
+		return LaunchPoolState{Time: start.Add(offset), TransactionType: txType, TokenReserve: big.NewInt(token), QuoteReserve: big.NewInt(quote)}
+	}
+
+	initial := state(0, models.TransactionTypeLiquidityPoolCreation, 1000, 100)
+	states := []LaunchPoolState{
+		// Buy of 90 tokens for 10
+		state(time.Hour, models.TransactionTypeTokenSwap, 910, 110),
+		// Added liquidity is not volume
+		state(2*time.Hour, models.TransactionTypeAddLiquidity, 1820, 220),
+		// Sell of 20 tokens for 2
+		state(3*time.Hour, models.TransactionTypeTokenSwap, 1840, 218),
+		// After the window
+		state(25*time.Hour, models.TransactionTypeTokenSwap, 1000, 400),
+	}
+
+	volume := BuildLaunchVolume(initial, states, start.Add(24*time.Hour))
+	assert.Equal(t, LaunchVolume{
+		Swaps:        2,
+		Buys:         1,
+		Sells:        1,
+		QuoteVolume:  "12",
+		TokensBought: "90",
+		TokensSold:   "20",
+	}, volume)
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "▁▄█", Sparkline([]float64{1, 2, 3}))
+	assert.Equal(t, "▅▅", Sparkline([]float64{2, 2}))
+	assert.Equal(t, "", Sparkline(nil))
+}
+
+func TestRenderLaunchReport(t *testing.T) {
+	blockTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	report := LaunchReport{
+		Deployment: LaunchReportDeployment{
+			ID:              1,
+			TemplateName:    "Moon <Token>",
+			ContractAddress: "0x1111111111111111111111111111111111111111",
+			ChainName:       "Ethereum",
+			ChainID:         "1",
+			DeployedAt:      blockTime,
+		},
+		Pool: &LaunchReportPool{
+			PairAddress: "0x2222222222222222222222222222222222222222",
+			QuoteToken:  "ETH",
+			TokenAmount: "1000",
+			QuoteAmount: "100",
+			PageURL:     "http://localhost:8080/pool/1",
+		},
+		Volume:      &LaunchVolume{Swaps: 3, Buys: 2, Sells: 1, QuoteVolume: "12", TokensBought: "90", TokensSold: "20"},
+		Candles:     []Candle{{Open: 0.1, High: 0.2, Low: 0.1, Close: 0.2}, {Open: 0.2, High: 0.3, Low: 0.15, Close: 0.15}},
+		Holders:     []HolderCount{{BlockNumber: 5, Time: &blockTime, Holders: 3}, {BlockNumber: 6, Holders: 4}},
+		GeneratedAt: blockTime,
+	}
+
+	markdown, err := RenderLaunchReport(report, LaunchReportFormatMarkdown)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "# Launch report: Moon <Token>")
+	assert.Contains(t, markdown, "| Swaps | 3 (2 buys, 1 sells) |")
+	assert.Contains(t, markdown, "| ETH volume | 12 |")
+	assert.Contains(t, markdown, "| 5 | 2026-01-01 12:00 UTC | 3 |")
+	assert.Contains(t, markdown, "| 6 | - | 4 |")
+	assert.Contains(t, markdown, "`█▁`")
+	assert.Contains(t, markdown, "[Pool page](http://localhost:8080/pool/1)")
+
+	html, err := RenderLaunchReport(report, LaunchReportFormatHTML)
+	require.NoError(t, err)
+	assert.Contains(t, html, "Moon &lt;Token&gt;")
+	assert.Contains(t, html, `points="0.0,0.0 600.0,160.0"`)
+	assert.Contains(t, html, "<td>2026-01-01 12:00 UTC</td><td>3</td>")
+
+	// Without a pool only the deployment and holders are reported
+	report.Pool, report.Volume, report.Candles = nil, nil, nil
+	markdown, err = RenderLaunchReport(report, LaunchReportFormatMarkdown)
+	require.NoError(t, err)
+	assert.Contains(t, markdown, "The token has no confirmed liquidity pool.")
+	assert.False(t, strings.Contains(markdown, "## Price"))
+
+	_, err = RenderLaunchReport(report, "pdf")
+	assert.Error(t, err)
+}
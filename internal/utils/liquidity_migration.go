@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// v2PairReadABI is the subset of the Uniswap V2 pair and factory ABIs read before migrating liquidity
+const v2PairReadABI = `[
+	{"type":"function","name":"token0","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"token1","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"getReserves","stateMutability":"view","inputs":[],"outputs":[{"name":"_reserve0","type":"uint112"},{"name":"_reserve1","type":"uint112"},{"name":"_blockTimestampLast","type":"uint32"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getPair","stateMutability":"view","inputs":[{"name":"tokenA","type":"address"},{"name":"tokenB","type":"address"}],"outputs":[{"name":"pair","type":"address"}]}
+]`
+
+// V2PairPosition is the state of a Uniswap V2 pair and the LP balance an owner holds in it
+type V2PairPosition struct {
+	PairAddress string   `json:"pair_address"`
+	Token0      string   `json:"token0"`
+	Token1      string   `json:"token1"`
+	Reserve0    *big.Int `json:"reserve0"`
+	Reserve1    *big.Int `json:"reserve1"`
+	TotalSupply *big.Int `json:"total_supply"`
+	// Balance is the amount of LP tokens held by the owner
+	Balance *big.Int `json:"balance"`
+}
+
+// ReserveOf returns the reserve of token in the pair, nil when the pair does not hold the token
+func (p *V2PairPosition) ReserveOf(token string) *big.Int {
+	switch {
+	case strings.EqualFold(p.Token0, token):
+		return p.Reserve0
+	case strings.EqualFold(p.Token1, token):
+		return p.Reserve1
+	}
+	return nil
+}
+
+// ReadV2PairPosition reads the tokens, reserves and LP supply of a Uniswap V2 pair with the LP balance of owner
+func ReadV2PairPosition(rpcURL, pairAddress, owner string) (*V2PairPosition, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(v2PairReadABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pair ABI: %w", err)
+	}
+	client := NewRPCClient(rpcURL)
+
+	token0, err := callAddress(client, parsedABI, pairAddress, "token0")
+	if err != nil {
+		return nil, err
+	}
+	token1, err := callAddress(client, parsedABI, pairAddress, "token1")
+	if err != nil {
+		return nil, err
+	}
+	reserves, err := callContract(client, parsedABI, pairAddress, "getReserves")
+	if err != nil {
+		return nil, err
+	}
+	if len(reserves) < 2 {
+		return nil, fmt.Errorf("getReserves returned %d values", len(reserves))
+	}
+	totalSupply, err := callContract(client, parsedABI, pairAddress, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+	balance, err := callContract(client, parsedABI, pairAddress, "balanceOf", common.HexToAddress(owner))
+	if err != nil {
+		return nil, err
+	}
+
+	position := &V2PairPosition{PairAddress: pairAddress, Token0: token0.Hex(), Token1: token1.Hex()}
+	var ok0, ok1, okSupply, okBalance bool
+	position.Reserve0, ok0 = reserves[0].(*big.Int)
+	position.Reserve1, ok1 = reserves[1].(*big.Int)
+	position.TotalSupply, okSupply = totalSupply[0].(*big.Int)
+	position.Balance, okBalance = balance[0].(*big.Int)
+	if !ok0 || !ok1 || !okSupply || !okBalance {
+		return nil, fmt.Errorf("pair %s returned invalid amounts", pairAddress)
+	}
+	return position, nil
+}
+
+// GetV2PairAddress calls getPair on a Uniswap V2 factory, an empty address means the pair is not created yet
+func GetV2PairAddress(rpcURL, factoryAddress, tokenA, tokenB string) (string, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(v2PairReadABI))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse factory ABI: %w", err)
+	}
+	values, err := callContract(NewRPCClient(rpcURL), parsedABI, factoryAddress, "getPair", common.HexToAddress(tokenA), common.HexToAddress(tokenB))
+	if err != nil {
+		return "", err
+	}
+	pair, ok := values[0].(common.Address)
+	if !ok {
+		return "", fmt.Errorf("getPair returned %T instead of an address", values[0])
+	}
+	if pair == (common.Address{}) {
+		return "", nil
+	}
+	return pair.Hex(), nil
+}
+
+// LiquidityMigration is the amounts of a token/ETH liquidity position moved from one V2 pair to another
+type LiquidityMigration struct {
+	Liquidity string `json:"liquidity"`
+	// Amounts expected from removing the liquidity and the minimums accepted by the removal
+	ExpectedToken  string `json:"expected_token"`
+	ExpectedETH    string `json:"expected_eth"`
+	RemoveMinToken string `json:"remove_min_token"`
+	RemoveMinETH   string `json:"remove_min_eth"`
+	// Amounts added to the target pair and the minimums accepted by the addition
+	AddToken    string `json:"add_token"`
+	AddETH      string `json:"add_eth"`
+	AddMinToken string `json:"add_min_token"`
+	AddMinETH   string `json:"add_min_eth"`
+	// TargetETHReserve is the ETH reserve of the target pair before the addition, 0 for a new pair
+	TargetETHReserve string `json:"target_eth_reserve"`
+	// SourcePrice and TargetPrice are the ETH prices of one token unit in the pairs, TargetPrice is 0 for a new pair
+	SourcePrice float64 `json:"source_price"`
+	TargetPrice float64 `json:"target_price,omitempty"`
+}
+
+// ComputeLiquidityMigration computes the amounts of migrating liquidity LP tokens of the source pair, whose reserves
+// are sourceToken and sourceETH out of totalSupply, to a target pair holding targetToken and targetETH (zero for a
+// new pair). The addition only spends the minimums of the removal so it never needs more than was received, at the
+// ratio of the target pair when it has reserves. Whatever the target ratio does not take is left in the wallet.
+func ComputeLiquidityMigration(liquidity, totalSupply, sourceToken, sourceETH, targetToken, targetETH *big.Int, slippagePercent float64) (*LiquidityMigration, error) {
+	if liquidity.Sign() <= 0 {
+		return nil, fmt.Errorf("liquidity must be positive")
+	}
+	if totalSupply.Sign() <= 0 || liquidity.Cmp(totalSupply) > 0 {
+		return nil, fmt.Errorf("liquidity %s exceeds the pair supply of %s", liquidity, totalSupply)
+	}
+
+	expectedToken := new(big.Int).Div(new(big.Int).Mul(liquidity, sourceToken), totalSupply)
+	expectedETH := new(big.Int).Div(new(big.Int).Mul(liquidity, sourceETH), totalSupply)
+	if expectedToken.Sign() <= 0 || expectedETH.Sign() <= 0 {
+		return nil, fmt.Errorf("liquidity %s is too small to withdraw both tokens", liquidity)
+	}
+	removeMinToken := ApplySlippage(expectedToken, slippagePercent)
+	removeMinETH := ApplySlippage(expectedETH, slippagePercent)
+
+	addToken, addETH := new(big.Int).Set(removeMinToken), new(big.Int).Set(removeMinETH)
+	migration := &LiquidityMigration{SourcePrice: reservePrice(sourceToken, sourceETH)}
+	if targetToken.Sign() > 0 && targetETH.Sign() > 0 {
+		// the router takes the amounts at the ratio of the target pair
+		optimalETH := new(big.Int).Div(new(big.Int).Mul(addToken, targetETH), targetToken)
+		if optimalETH.Cmp(addETH) <= 0 {
+			addETH = optimalETH
+		} else {
+			addToken = new(big.Int).Div(new(big.Int).Mul(addETH, targetToken), targetETH)
+		}
+		migration.TargetPrice = reservePrice(targetToken, targetETH)
+	}
+	if addToken.Sign() <= 0 || addETH.Sign() <= 0 {
+		return nil, fmt.Errorf("the price of the target pair leaves nothing to add")
+	}
+
+	migration.Liquidity = liquidity.String()
+	migration.ExpectedToken = expectedToken.String()
+	migration.ExpectedETH = expectedETH.String()
+	migration.RemoveMinToken = removeMinToken.String()
+	migration.RemoveMinETH = removeMinETH.String()
+	migration.AddToken = addToken.String()
+	migration.AddETH = addETH.String()
+	migration.AddMinToken = ApplySlippage(addToken, slippagePercent).String()
+	migration.AddMinETH = ApplySlippage(addETH, slippagePercent).String()
+	migration.TargetETHReserve = targetETH.String()
+	return migration, nil
+}
+
+// reservePrice is the ETH price of one token unit of a pair
+func reservePrice(tokenReserve, ethReserve *big.Int) float64 {
+	if tokenReserve.Sign() <= 0 {
+		return 0
+	}
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(ethReserve), new(big.Float).SetInt(tokenReserve)).Float64()
+	return price
+}
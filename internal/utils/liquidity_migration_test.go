@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeLiquidityMigration(t *testing.T) {
+	liquidity, totalSupply := big.NewInt(100), big.NewInt(1000)
+	sourceToken, sourceETH := big.NewInt(1_000_000), big.NewInt(10_000)
+
+	// a new target pair takes the minimums of the removal
+	migration, err := ComputeLiquidityMigration(liquidity, totalSupply, sourceToken, sourceETH, new(big.Int), new(big.Int), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "100000", migration.ExpectedToken)
+	assert.Equal(t, "1000", migration.ExpectedETH)
+	assert.Equal(t, "99000", migration.RemoveMinToken)
+	assert.Equal(t, "990", migration.RemoveMinETH)
+	assert.Equal(t, "99000", migration.AddToken)
+	assert.Equal(t, "990", migration.AddETH)
+	assert.Equal(t, "98010", migration.AddMinToken)
+	assert.Equal(t, "980", migration.AddMinETH)
+	assert.Equal(t, 0.01, migration.SourcePrice)
+	assert.Zero(t, migration.TargetPrice)
+
+	// a cheaper target pair takes less ETH
+	migration, err = ComputeLiquidityMigration(liquidity, totalSupply, sourceToken, sourceETH, big.NewInt(2_000_000), big.NewInt(10_000), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "99000", migration.AddToken)
+	assert.Equal(t, "495", migration.AddETH)
+	assert.Equal(t, "490", migration.AddMinETH)
+	assert.Equal(t, 0.005, migration.TargetPrice)
+
+	// a more expensive target pair takes fewer tokens
+	migration, err = ComputeLiquidityMigration(liquidity, totalSupply, sourceToken, sourceETH, big.NewInt(500_000), big.NewInt(10_000), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "49500", migration.AddToken)
+	assert.Equal(t, "990", migration.AddETH)
+
+	_, err = ComputeLiquidityMigration(big.NewInt(2000), totalSupply, sourceToken, sourceETH, new(big.Int), new(big.Int), 1)
+	assert.ErrorContains(t, err, "exceeds the pair supply")
+}
+
+func TestReadV2PairPosition(t *testing.T) {
+	pair := "0x2222222222222222222222222222222222222222"
+	token := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	weth := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	word := func(value int64) []byte { return common.LeftPadBytes(big.NewInt(value).Bytes(), 32) }
+
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		data := request.Params[0].(map[string]interface{})["data"].(string)
+
+		var output []byte
+		switch data[:10] {
+		case "0x0dfe1681": // token0()
+			output = common.LeftPadBytes(token.Bytes(), 32)
+		case "0xd21220a7": // token1()
+			output = common.LeftPadBytes(weth.Bytes(), 32)
+		case "0x0902f1ac": // getReserves()
+			output = append(append(word(5000), word(50)...), word(1)...)
+		case "0x18160ddd": // totalSupply()
+			output = word(500)
+		case "0x70a08231": // balanceOf(address)
+			output = word(20)
+		case "0xe6a43905": // getPair(address,address)
+			output = common.LeftPadBytes(common.HexToAddress(pair).Bytes(), 32)
+		}
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: hexutil.Encode(output)})
+	}))
+	defer node.Close()
+
+	position, err := ReadV2PairPosition(node.URL, pair, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5000), position.ReserveOf(strings.ToLower(token.Hex())).Int64())
+	assert.Equal(t, int64(50), position.ReserveOf(weth.Hex()).Int64())
+	assert.Nil(t, position.ReserveOf(pair))
+	assert.Equal(t, int64(500), position.TotalSupply.Int64())
+	assert.Equal(t, int64(20), position.Balance.Int64())
+
+	pairAddress, err := GetV2PairAddress(node.URL, "0x5555555555555555555555555555555555555555", token.Hex(), weth.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress(pair).Hex(), pairAddress)
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// SessionHandoffQueryParam is the query parameter carrying the binding of a one-time session handed off to a
+// mobile browser by the browser the session was bound to
+const SessionHandoffQueryParam = "handoff"
+
+// MobileWalletLink opens a signing page in the in-app browser of a mobile wallet, which injects its provider in the
+// page like a browser extension. These are the deep links of the wallets, not WalletConnect pairing uris
+type MobileWalletLink struct {
+	Wallet string `json:"wallet"`
+	URL    string `json:"url"`
+}
+
+// GetMobileSessionUrl returns the url of the signing page of a session for a mobile wallet: the signed session url,
+// with the handed off binding when the session urls are one-time
+func GetMobileSessionUrl(ctx context.Context, serverPort int, sessionId, handoff string) (string, error) {
+	query := url.Values{}
+	if signature := SignSessionID(sessionId); signature != "" {
+		query.Set(SessionSignatureQueryParam, signature)
+	}
+	if handoff != "" {
+		query.Set(SessionHandoffQueryParam, handoff)
+	}
+	return getServerUrlWithQuery(ctx, serverPort, fmt.Sprintf("/tx/%s", sessionId), query)
+}
+
+// GetMobileWalletLinks returns the deep links opening the page in MetaMask, Coinbase Wallet and Trust Wallet
+func GetMobileWalletLinks(pageUrl string) ([]MobileWalletLink, error) {
+	parsedUrl, err := url.Parse(pageUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page url %q: %w", pageUrl, err)
+	}
+	// MetaMask takes the url without its scheme and opens it over https
+	metamask := parsedUrl.Host + parsedUrl.EscapedPath()
+	if parsedUrl.RawQuery != "" {
+		metamask += "?" + parsedUrl.RawQuery
+	}
+	escaped := url.QueryEscape(pageUrl)
+	return []MobileWalletLink{
+		{Wallet: "MetaMask", URL: "https://metamask.app.link/dapp/" + metamask},
+		{Wallet: "Coinbase Wallet", URL: "https://go.cb-w.com/dapp?cb_url=" + escaped},
+		{Wallet: "Trust Wallet", URL: "https://link.trustwallet.com/open_url?coin_id=60&url=" + escaped},
+	}, nil
+}
+
+// IsReachableFromMobile reports whether a phone can open the url: not a loopback host such as localhost
+func IsReachableFromMobile(pageUrl string) bool {
+	parsedUrl, err := url.Parse(pageUrl)
+	if err != nil || parsedUrl.Hostname() == "" {
+		return false
+	}
+	host := parsedUrl.Hostname()
+	if host == "localhost" {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return false
+	}
+	return true
+}
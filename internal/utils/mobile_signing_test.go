@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMobileSessionUrl(t *testing.T) {
+	t.Setenv(EnvSessionURLSecret, "")
+	ctx := WithRequestBaseUrl(context.Background(), "http://192.168.1.20:3000")
+
+	pageUrl, err := GetMobileSessionUrl(ctx, 3000, "session-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "http://192.168.1.20:3000/tx/session-1", pageUrl)
+
+	t.Setenv(EnvSessionURLSecret, "secret")
+	pageUrl, err = GetMobileSessionUrl(ctx, 3000, "session-1", "binding")
+	require.NoError(t, err)
+	assert.Equal(t, "http://192.168.1.20:3000/tx/session-1?handoff=binding&sig="+SignSessionID("session-1"), pageUrl)
+}
+
+func TestGetMobileWalletLinks(t *testing.T) {
+	links, err := GetMobileWalletLinks("https://launchpad.example.com/tx/session-1?sig=abc")
+	require.NoError(t, err)
+	assert.Equal(t, []MobileWalletLink{
+		{Wallet: "MetaMask", URL: "https://metamask.app.link/dapp/launchpad.example.com/tx/session-1?sig=abc"},
+		{Wallet: "Coinbase Wallet", URL: "https://go.cb-w.com/dapp?cb_url=https%3A%2F%2Flaunchpad.example.com%2Ftx%2Fsession-1%3Fsig%3Dabc"},
+		{Wallet: "Trust Wallet", URL: "https://link.trustwallet.com/open_url?coin_id=60&url=https%3A%2F%2Flaunchpad.example.com%2Ftx%2Fsession-1%3Fsig%3Dabc"},
+	}, links)
+}
+
+func TestIsReachableFromMobile(t *testing.T) {
+	assert.False(t, IsReachableFromMobile("http://localhost:3000/tx/1"))
+	assert.False(t, IsReachableFromMobile("http://127.0.0.1:3000/tx/1"))
+	assert.False(t, IsReachableFromMobile("http://[::1]:3000/tx/1"))
+	assert.True(t, IsReachableFromMobile("http://192.168.1.20:3000/tx/1"))
+	assert.True(t, IsReachableFromMobile("https://launchpad.example.com/tx/1"))
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// nftMetadataSchemes are the URI schemes wallets and marketplaces resolve NFT metadata from
+var nftMetadataSchemes = []string{"ipfs", "ar", "https", "http"}
+
+// NormalizeNFTBaseURI checks the metadata URI of a collection. An ERC721A base URI gets a trailing slash as the token
+// ID is appended to it, an ERC1155 URI without the {id} substitution gets {id}.json appended
+func NormalizeNFTBaseURI(uri string, editions bool) (string, error) {
+	uri = strings.TrimSpace(uri)
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URI %q: %w", uri, err)
+	}
+	if !slices.Contains(nftMetadataSchemes, parsed.Scheme) || parsed.Host == "" {
+		return "", fmt.Errorf("invalid base URI %q: must be an %s URI", uri, strings.Join(nftMetadataSchemes, ", "))
+	}
+
+	if editions && strings.Contains(uri, "{id}") {
+		return uri, nil
+	}
+	if !strings.HasSuffix(uri, "/") {
+		uri += "/"
+	}
+	if editions {
+		uri += "{id}.json"
+	}
+	return uri, nil
+}
+
+// AllowlistMerkleRoot returns the root of the Merkle tree of an allowlist as verified by the OpenZeppelin MerkleProof
+// library: the leaves are keccak256(abi.encodePacked(address)) in ascending order, pairs are hashed sorted and the
+// last node of an odd level moves up unchanged
+func AllowlistMerkleRoot(addresses []string) (common.Hash, error) {
+	seen := map[common.Address]bool{}
+	var level [][]byte
+	for _, address := range addresses {
+		if !common.IsHexAddress(address) {
+			return common.Hash{}, fmt.Errorf("invalid allowlist address: %s", address)
+		}
+		account := common.HexToAddress(address)
+		if seen[account] {
+			continue
+		}
+		seen[account] = true
+		level = append(level, crypto.Keccak256(account.Bytes()))
+	}
+	if len(level) == 0 {
+		return common.Hash{}, fmt.Errorf("allowlist is empty")
+	}
+	sort.Slice(level, func(i, j int) bool { return bytes.Compare(level[i], level[j]) < 0 })
+
+	for len(level) > 1 {
+		var next [][]byte
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			left, right := level[i], level[i+1]
+			if bytes.Compare(left, right) > 0 {
+				left, right = right, left
+			}
+			next = append(next, crypto.Keccak256(left, right))
+		}
+		level = next
+	}
+	return common.BytesToHash(level[0]), nil
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeNFTBaseURI(t *testing.T) {
+	uri, err := NormalizeNFTBaseURI("ipfs://bafybei/metadata", false)
+	require.NoError(t, err)
+	assert.Equal(t, "ipfs://bafybei/metadata/", uri)
+
+	uri, err = NormalizeNFTBaseURI("https://example.com/editions/", true)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/editions/{id}.json", uri)
+	uri, err = NormalizeNFTBaseURI("ar://tx/{id}", true)
+	require.NoError(t, err)
+	assert.Equal(t, "ar://tx/{id}", uri)
+
+	for _, invalid := range []string{"", "/metadata", "ftp://example.com/", "ipfs://"} {
+		_, err := NormalizeNFTBaseURI(invalid, false)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestAllowlistMerkleRoot(t *testing.T) {
+	first := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	second := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	third := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	leaf := func(address common.Address) []byte { return crypto.Keccak256(address.Bytes()) }
+	pair := func(a, b []byte) []byte {
+		if common.BytesToHash(a).Big().Cmp(common.BytesToHash(b).Big()) > 0 {
+			a, b = b, a
+		}
+		return crypto.Keccak256(a, b)
+	}
+
+	// A single address is its own root, duplicates are ignored
+	root, err := AllowlistMerkleRoot([]string{first.Hex(), first.Hex()})
+	require.NoError(t, err)
+	assert.Equal(t, common.BytesToHash(leaf(first)), root)
+
+	root, err = AllowlistMerkleRoot([]string{second.Hex(), first.Hex()})
+	require.NoError(t, err)
+	assert.Equal(t, common.BytesToHash(pair(leaf(first), leaf(second))), root)
+
+	// The order of the allowlist does not change the root
+	root, err = AllowlistMerkleRoot([]string{first.Hex(), second.Hex(), third.Hex()})
+	require.NoError(t, err)
+	reordered, err := AllowlistMerkleRoot([]string{third.Hex(), first.Hex(), second.Hex()})
+	require.NoError(t, err)
+	assert.Equal(t, root, reordered)
+
+	_, err = AllowlistMerkleRoot(nil)
+	assert.Error(t, err)
+	_, err = AllowlistMerkleRoot([]string{"0x123"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// UnsignedTransactionFormat identifies the files written by export_unsigned_transactions
+const UnsignedTransactionFormat = "launchpad-unsigned-transactions/v1"
+
+// UnsignedTransactionFile is the export of the pending transactions of a session, signed on an air-gapped
+// machine and submitted back one by one with submit_signed_transaction
+type UnsignedTransactionFile struct {
+	Format       string                `json:"format"`
+	SessionID    string                `json:"session_id"`
+	ChainID      string                `json:"chain_id"`
+	From         string                `json:"from"`
+	Transactions []UnsignedTransaction `json:"transactions"`
+}
+
+// UnsignedTransaction is an EIP-1559 transaction in the JSON-RPC format of eth_signTransaction, so offline signers
+// can read it as is, with its serialized unsigned payload
+type UnsignedTransaction struct {
+	Index                int              `json:"index"`
+	Title                string           `json:"title"`
+	Type                 string           `json:"type"`
+	ChainID              string           `json:"chainId"`
+	Nonce                string           `json:"nonce"`
+	To                   *string          `json:"to"`
+	Value                string           `json:"value"`
+	Data                 string           `json:"data"`
+	Gas                  string           `json:"gas"`
+	MaxFeePerGas         string           `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string           `json:"maxPriorityFeePerGas"`
+	AccessList           types.AccessList `json:"accessList"`
+	// Unsigned is the EIP-2718 unsigned payload: 0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas,
+	// gas, to, value, data, accessList])
+	Unsigned string `json:"unsigned"`
+	// SigningHash is the keccak256 of Unsigned, the hash signed by the signer
+	SigningHash string `json:"signingHash"`
+}
+
+// NewUnsignedTransaction serializes the unsigned EIP-1559 transaction of the transaction deployment at index
+func NewUnsignedTransaction(index int, title string, tx *types.DynamicFeeTx) (UnsignedTransaction, error) {
+	payload, err := rlp.EncodeToBytes([]any{tx.ChainID, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, tx.To, tx.Value, tx.Data, types.AccessList{}})
+	if err != nil {
+		return UnsignedTransaction{}, fmt.Errorf("failed to encode the unsigned transaction: %w", err)
+	}
+	unsigned := append([]byte{types.DynamicFeeTxType}, payload...)
+
+	transaction := UnsignedTransaction{
+		Index:                index,
+		Title:                title,
+		Type:                 hexutil.EncodeUint64(types.DynamicFeeTxType),
+		ChainID:              hexutil.EncodeBig(tx.ChainID),
+		Nonce:                hexutil.EncodeUint64(tx.Nonce),
+		Value:                hexutil.EncodeBig(tx.Value),
+		Data:                 hexutil.Encode(tx.Data),
+		Gas:                  hexutil.EncodeUint64(tx.Gas),
+		MaxFeePerGas:         hexutil.EncodeBig(tx.GasFeeCap),
+		MaxPriorityFeePerGas: hexutil.EncodeBig(tx.GasTipCap),
+		AccessList:           types.AccessList{},
+		Unsigned:             hexutil.Encode(unsigned),
+		SigningHash:          types.LatestSignerForChainID(tx.ChainID).Hash(types.NewTx(tx)).Hex(),
+	}
+	if tx.To != nil {
+		to := tx.To.Hex()
+		transaction.To = &to
+	}
+	return transaction, nil
+}
+
+// DecodeSignedTransaction decodes a raw signed transaction and recovers its sender
+func DecodeSignedTransaction(raw string) (*types.Transaction, common.Address, error) {
+	rawBytes, err := hexutil.Decode(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid signed transaction hex: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(rawBytes); err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid signed transaction: %w", err)
+	}
+	chainID := tx.ChainId()
+	if chainID == nil || chainID.Sign() == 0 {
+		return nil, common.Address{}, fmt.Errorf("signed transaction is not replay protected, it must be signed for a chain id")
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to recover the signer: %w", err)
+	}
+	return tx, sender, nil
+}
+
+// SigningHash returns the hash the signer of the transaction signed, the one of its unsigned payload
+func SigningHash(tx *types.Transaction) common.Hash {
+	chainID := tx.ChainId()
+	if chainID == nil {
+		chainID = new(big.Int)
+	}
+	return types.LatestSignerForChainID(chainID).Hash(tx)
+}
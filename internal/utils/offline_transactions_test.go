@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnsignedTransaction(t *testing.T) {
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	tx := &types.DynamicFeeTx{ChainID: big.NewInt(31337), Nonce: 7, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(3e9), Gas: 21000, To: &to, Value: big.NewInt(1e18), Data: []byte{0x8a, 0x8c}}
+
+	unsigned, err := NewUnsignedTransaction(2, "Fund", tx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, unsigned.Index)
+	assert.Equal(t, "0x2", unsigned.Type)
+	assert.Equal(t, "0x7a69", unsigned.ChainID)
+	assert.Equal(t, "0x7", unsigned.Nonce)
+	assert.Equal(t, "0x5208", unsigned.Gas)
+	assert.Equal(t, "0xde0b6b3a7640000", unsigned.Value)
+	assert.Equal(t, "0x8a8c", unsigned.Data)
+	require.NotNil(t, unsigned.To)
+	assert.Equal(t, to.Hex(), *unsigned.To)
+
+	// the signing hash is the keccak256 of the unsigned payload
+	payload, err := hexutil.Decode(unsigned.Unsigned)
+	require.NoError(t, err)
+	assert.Equal(t, byte(types.DynamicFeeTxType), payload[0])
+	assert.Equal(t, crypto.Keccak256Hash(payload).Hex(), unsigned.SigningHash)
+
+	// contract creations have no receiver
+	tx.To = nil
+	creation, err := NewUnsignedTransaction(0, "Deploy", tx)
+	require.NoError(t, err)
+	assert.Nil(t, creation.To)
+	assert.NotEqual(t, unsigned.SigningHash, creation.SigningHash)
+}
+
+func TestDecodeSignedTransaction(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx := &types.DynamicFeeTx{ChainID: big.NewInt(31337), Nonce: 1, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(3e9), Gas: 100000, Value: big.NewInt(0), Data: []byte{0x60, 0x80}}
+	unsigned, err := NewUnsignedTransaction(0, "Deploy", tx)
+	require.NoError(t, err)
+
+	signedTx, err := types.SignNewTx(key, types.LatestSignerForChainID(tx.ChainID), tx)
+	require.NoError(t, err)
+	raw, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	decoded, sender, err := DecodeSignedTransaction(hexutil.Encode(raw))
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+	assert.Equal(t, signedTx.Hash(), decoded.Hash())
+	assert.Equal(t, unsigned.SigningHash, SigningHash(decoded).Hex())
+
+	_, _, err = DecodeSignedTransaction("0xzz")
+	assert.ErrorContains(t, err, "invalid signed transaction hex")
+	_, _, err = DecodeSignedTransaction("0x02c0")
+	assert.ErrorContains(t, err, "invalid signed transaction")
+
+	// legacy transactions signed without a chain id can be replayed on any chain
+	legacy, err := types.SignNewTx(key, types.HomesteadSigner{}, &types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 21000, Value: big.NewInt(0)})
+	require.NoError(t, err)
+	raw, err = legacy.MarshalBinary()
+	require.NoError(t, err)
+	_, _, err = DecodeSignedTransaction(hexutil.Encode(raw))
+	assert.ErrorContains(t, err, "not replay protected")
+}
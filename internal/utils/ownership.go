@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OwnershipStatus is the admin state of a deployed contract read on chain
+type OwnershipStatus struct {
+	Owner string `json:"owner"`
+	// Renounced is true once owner() returns the zero address
+	Renounced bool `json:"renounced"`
+	// PendingOwner is the owner waiting to accept the ownership of an Ownable2Step contract
+	PendingOwner string `json:"pending_owner,omitempty"`
+	// DeployerIsAdmin is set for AccessControl contracts, the deployer keeps its DEFAULT_ADMIN_ROLE after the
+	// ownership is renounced unless it renounces the role too
+	DeployerIsAdmin *bool `json:"deployer_is_admin,omitempty"`
+}
+
+// ReadOwnershipStatus reads the owner of the contract, and the pending owner and the admin role of the deployer when
+// the ABI has them
+func ReadOwnershipStatus(rpcURL, contractAddress, abiJSON, deployer string) (*OwnershipStatus, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	readsAddress := func(method abi.Method) bool {
+		return method.IsConstant() && len(method.Inputs) == 0 && len(method.Outputs) == 1 && method.Outputs[0].Type.T == abi.AddressTy
+	}
+	if findMethod(parsedABI, []string{"owner"}, readsAddress) == "" {
+		return nil, fmt.Errorf("contract has no owner() function")
+	}
+
+	client := NewRPCClient(rpcURL)
+	owner, err := callAddress(client, parsedABI, contractAddress, "owner")
+	if err != nil {
+		return nil, err
+	}
+	status := &OwnershipStatus{Owner: owner.Hex(), Renounced: owner == (common.Address{})}
+
+	if findMethod(parsedABI, []string{"pendingOwner"}, readsAddress) != "" {
+		pendingOwner, err := callAddress(client, parsedABI, contractAddress, "pendingOwner")
+		if err != nil {
+			return nil, err
+		}
+		if pendingOwner != (common.Address{}) {
+			status.PendingOwner = pendingOwner.Hex()
+		}
+	}
+
+	hasRole := findMethod(parsedABI, []string{"hasRole"}, func(method abi.Method) bool {
+		return method.IsConstant() && len(method.Inputs) == 2 && len(method.Outputs) == 1 && method.Outputs[0].Type.T == abi.BoolTy
+	})
+	if hasRole != "" && IsValidEthereumAddress(deployer) {
+		values, err := callContract(client, parsedABI, contractAddress, hasRole, [32]byte{}, common.HexToAddress(deployer))
+		if err != nil {
+			return nil, err
+		}
+		isAdmin, _ := values[0].(bool)
+		status.DeployerIsAdmin = &isAdmin
+	}
+	return status, nil
+}
+
+// callAddress calls a view function of the contract returning an address
+func callAddress(client *RPCClient, parsedABI abi.ABI, contractAddress, method string) (common.Address, error) {
+	values, err := callContract(client, parsedABI, contractAddress, method)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, ok := values[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("%s returned %T instead of an address", method, values[0])
+	}
+	return address, nil
+}
+
+// callContract calls a view function of the contract at the latest block and decodes its outputs
+func callContract(client *RPCClient, parsedABI abi.ABI, contractAddress, method string, args ...any) ([]any, error) {
+	data, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", method, err)
+	}
+	response, err := client.Call("eth_call", []interface{}{
+		map[string]string{
+			"to":   contractAddress,
+			"data": hexutil.Encode(data),
+		},
+		"latest",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+	result, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid response of %s", method)
+	}
+	output, err := hexutil.Decode(result)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response of %s: %w", method, err)
+	}
+	values, err := parsedABI.Unpack(method, output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", method, err)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%s returned no value", method)
+	}
+	return values, nil
+}
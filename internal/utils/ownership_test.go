@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ownershipTestAbi = `[
+	{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"pendingOwner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"hasRole","stateMutability":"view","inputs":[{"name":"role","type":"bytes32"},{"name":"account","type":"address"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// newOwnershipNode answers owner(), pendingOwner() and hasRole() with the given values
+func newOwnershipNode(t *testing.T, owner, pendingOwner common.Address, isAdmin bool) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, "eth_call", request.Method)
+
+		data := request.Params[0].(map[string]interface{})["data"].(string)
+		var output []byte
+		switch {
+		case strings.HasPrefix(data, "0x8da5cb5b"):
+			output = common.LeftPadBytes(owner.Bytes(), 32)
+		case strings.HasPrefix(data, "0xe30c3978"):
+			output = common.LeftPadBytes(pendingOwner.Bytes(), 32)
+		case strings.HasPrefix(data, "0x91d14854"):
+			output = make([]byte, 32)
+			if isAdmin {
+				output[31] = 1
+			}
+		}
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: hexutil.Encode(output)})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestReadOwnershipStatus(t *testing.T) {
+	owner := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	pendingOwner := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	node := newOwnershipNode(t, owner, pendingOwner, true)
+
+	status, err := ReadOwnershipStatus(node.URL, "0x1111111111111111111111111111111111111111", ownershipTestAbi, owner.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, owner.Hex(), status.Owner)
+	assert.False(t, status.Renounced)
+	assert.Equal(t, pendingOwner.Hex(), status.PendingOwner)
+	require.NotNil(t, status.DeployerIsAdmin)
+	assert.True(t, *status.DeployerIsAdmin)
+}
+
+func TestReadOwnershipStatusRenounced(t *testing.T) {
+	node := newOwnershipNode(t, common.Address{}, common.Address{}, false)
+	// a plain Ownable token without pendingOwner() or hasRole()
+	abiJSON := `[{"type":"function","name":"owner","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]}]`
+
+	status, err := ReadOwnershipStatus(node.URL, "0x1111111111111111111111111111111111111111", abiJSON, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	require.NoError(t, err)
+	assert.True(t, status.Renounced)
+	assert.Empty(t, status.PendingOwner)
+	assert.Nil(t, status.DeployerIsAdmin)
+
+	_, err = ReadOwnershipStatus(node.URL, "0x1111111111111111111111111111111111111111", `[]`, "")
+	assert.ErrorContains(t, err, "no owner() function")
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"math"
+	"math/big"
+)
+
+// ReserveDropPercent returns the largest fall in percent of the two reserves from their baseline, 0 when neither fell
+func ReserveDropPercent(baseline0, baseline1, reserve0, reserve1 *big.Int) float64 {
+	drop := func(baseline, reserve *big.Int) float64 {
+		if baseline.Sign() <= 0 || reserve.Cmp(baseline) >= 0 {
+			return 0
+		}
+		fell, _ := new(big.Float).Quo(new(big.Float).SetInt(new(big.Int).Sub(baseline, reserve)), new(big.Float).SetInt(baseline)).Float64()
+		return fell * 100
+	}
+	return math.Max(drop(baseline0, reserve0), drop(baseline1, reserve1))
+}
+
+// PriceMovePercent returns the change in percent of the reserve1 per reserve0 price from the baseline reserves,
+// negative when the price fell. It is 0 when either price is undefined
+func PriceMovePercent(baseline0, baseline1, reserve0, reserve1 *big.Int) float64 {
+	if baseline0.Sign() <= 0 || baseline1.Sign() <= 0 || reserve0.Sign() <= 0 {
+		return 0
+	}
+	// (reserve1 / reserve0) / (baseline1 / baseline0) - 1
+	numerator := new(big.Float).SetInt(new(big.Int).Mul(reserve1, baseline0))
+	denominator := new(big.Float).SetInt(new(big.Int).Mul(reserve0, baseline1))
+	ratio, _ := new(big.Float).Quo(numerator, denominator).Float64()
+	return (ratio - 1) * 100
+}
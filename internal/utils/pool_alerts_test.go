@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReserveDropPercent(t *testing.T) {
+	assert.InDelta(t, 25.0, ReserveDropPercent(big.NewInt(1000), big.NewInt(100), big.NewInt(750), big.NewInt(110)), 1e-9)
+	assert.InDelta(t, 50.0, ReserveDropPercent(big.NewInt(1000), big.NewInt(100), big.NewInt(900), big.NewInt(50)), 1e-9)
+	assert.Zero(t, ReserveDropPercent(big.NewInt(1000), big.NewInt(100), big.NewInt(1200), big.NewInt(100)))
+	assert.Zero(t, ReserveDropPercent(big.NewInt(0), big.NewInt(0), big.NewInt(10), big.NewInt(10)))
+}
+
+func TestPriceMovePercent(t *testing.T) {
+	// The price of token0 doubles when the pool holds half the token0 for the same token1
+	assert.InDelta(t, 100.0, PriceMovePercent(big.NewInt(1000), big.NewInt(100), big.NewInt(500), big.NewInt(100)), 1e-9)
+	assert.InDelta(t, -50.0, PriceMovePercent(big.NewInt(1000), big.NewInt(100), big.NewInt(1000), big.NewInt(50)), 1e-9)
+	assert.Zero(t, PriceMovePercent(big.NewInt(0), big.NewInt(100), big.NewInt(1000), big.NewInt(50)))
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// RebalanceDirectionBuy swaps ETH for tokens to raise the price of the pool
+	RebalanceDirectionBuy = "buy"
+	// RebalanceDirectionSell swaps tokens for ETH to lower the price of the pool
+	RebalanceDirectionSell = "sell"
+)
+
+// rebalancePrecision is the mantissa precision of the square roots computing the target reserves
+const rebalancePrecision = 256
+
+// PoolRebalance is the single-sided swap moving a token/ETH V2 pair to a target price and the liquidity added at the
+// price reached. Prices are the ETH reserve over the token reserve
+type PoolRebalance struct {
+	Direction    string `json:"direction"`
+	AmountIn     string `json:"amount_in"`
+	AmountOut    string `json:"amount_out"`
+	MinAmountOut string `json:"min_amount_out"`
+	// TokenReserve and ETHReserve are the reserves of the pair after the swap
+	TokenReserve   string  `json:"token_reserve"`
+	ETHReserve     string  `json:"eth_reserve"`
+	CurrentPrice   float64 `json:"current_price"`
+	TargetPrice    float64 `json:"target_price"`
+	ResultingPrice float64 `json:"resulting_price"`
+	// Amounts added to the pair after the swap and the minimums accepted by the addition, empty without an addition
+	AddToken    string `json:"add_token,omitempty"`
+	AddETH      string `json:"add_eth,omitempty"`
+	AddMinToken string `json:"add_min_token,omitempty"`
+	AddMinETH   string `json:"add_min_eth,omitempty"`
+}
+
+// ComputePoolRebalance computes the swap moving a pair holding tokenReserve and ethReserve to targetPrice. Without the
+// fee the reserves reaching the price keep their product, so the token reserve becomes sqrt(k / price) when buying and
+// the ETH reserve sqrt(k * price) when selling; the input pays the 0.3% fee on top, which leaves the price a little past
+// the target. When addETH is positive, addETH and the matching token amount are added at the price reached. Both the
+// swap output and the addition accept slippagePercent.
+func ComputePoolRebalance(tokenReserve, ethReserve *big.Int, targetPrice float64, addETH *big.Int, slippagePercent float64) (*PoolRebalance, error) {
+	if tokenReserve.Sign() <= 0 || ethReserve.Sign() <= 0 {
+		return nil, fmt.Errorf("the pool has no liquidity")
+	}
+	if targetPrice <= 0 {
+		return nil, fmt.Errorf("target price must be positive")
+	}
+
+	product := new(big.Float).SetPrec(rebalancePrecision).SetInt(new(big.Int).Mul(tokenReserve, ethReserve))
+	price := new(big.Float).SetPrec(rebalancePrecision).SetFloat64(targetPrice)
+	rebalance := &PoolRebalance{
+		CurrentPrice: reservePrice(tokenReserve, ethReserve),
+		TargetPrice:  targetPrice,
+	}
+
+	var amountIn, amountOut *big.Int
+	newToken, newETH := new(big.Int).Set(tokenReserve), new(big.Int).Set(ethReserve)
+	if targetPrice > rebalance.CurrentPrice {
+		targetToken, _ := new(big.Float).Sqrt(new(big.Float).Quo(product, price)).Int(nil)
+		amountIn = GetAmountIn(new(big.Int).Sub(tokenReserve, targetToken), ethReserve, tokenReserve)
+		if amountIn != nil {
+			amountOut = GetAmountOut(amountIn, ethReserve, tokenReserve)
+			newToken.Sub(newToken, amountOut)
+			newETH.Add(newETH, amountIn)
+		}
+		rebalance.Direction = RebalanceDirectionBuy
+	} else {
+		targetETH, _ := new(big.Float).Sqrt(new(big.Float).Mul(product, price)).Int(nil)
+		amountIn = GetAmountIn(new(big.Int).Sub(ethReserve, targetETH), tokenReserve, ethReserve)
+		if amountIn != nil {
+			amountOut = GetAmountOut(amountIn, tokenReserve, ethReserve)
+			newToken.Add(newToken, amountIn)
+			newETH.Sub(newETH, amountOut)
+		}
+		rebalance.Direction = RebalanceDirectionSell
+	}
+	if amountIn == nil || amountOut.Sign() <= 0 {
+		return nil, fmt.Errorf("the pool price %g is already at the target price %g", rebalance.CurrentPrice, targetPrice)
+	}
+
+	rebalance.AmountIn = amountIn.String()
+	rebalance.AmountOut = amountOut.String()
+	rebalance.MinAmountOut = ApplySlippage(amountOut, slippagePercent).String()
+	rebalance.TokenReserve = newToken.String()
+	rebalance.ETHReserve = newETH.String()
+	rebalance.ResultingPrice = reservePrice(newToken, newETH)
+
+	if addETH != nil && addETH.Sign() > 0 {
+		addToken := new(big.Int).Div(new(big.Int).Mul(addETH, newToken), newETH)
+		if addToken.Sign() <= 0 {
+			return nil, fmt.Errorf("%s wei is too small to add liquidity at price %g", addETH, rebalance.ResultingPrice)
+		}
+		rebalance.AddToken = addToken.String()
+		rebalance.AddETH = addETH.String()
+		rebalance.AddMinToken = ApplySlippage(addToken, slippagePercent).String()
+		rebalance.AddMinETH = ApplySlippage(addETH, slippagePercent).String()
+	}
+	return rebalance, nil
+}
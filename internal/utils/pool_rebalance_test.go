@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePoolRebalance(t *testing.T) {
+	tokenReserve, ethReserve := big.NewInt(1_000_000), big.NewInt(10_000)
+
+	// raising the price from 0.01 to 0.04 halves the token reserve, the fee is paid on top of the ETH input
+	rebalance, err := ComputePoolRebalance(tokenReserve, ethReserve, 0.04, nil, 1)
+	require.NoError(t, err)
+	assert.Equal(t, RebalanceDirectionBuy, rebalance.Direction)
+	assert.Equal(t, "10031", rebalance.AmountIn)
+	assert.Equal(t, "500022", rebalance.AmountOut)
+	assert.Equal(t, "495021", rebalance.MinAmountOut)
+	assert.Equal(t, "499978", rebalance.TokenReserve)
+	assert.Equal(t, "20031", rebalance.ETHReserve)
+	assert.Equal(t, 0.01, rebalance.CurrentPrice)
+	assert.InDelta(t, 0.04, rebalance.ResultingPrice, 0.0001)
+	assert.Empty(t, rebalance.AddToken)
+
+	// lowering the price to 0.0025 halves the ETH reserve, liquidity is added at the price reached
+	rebalance, err = ComputePoolRebalance(tokenReserve, ethReserve, 0.0025, big.NewInt(1000), 1)
+	require.NoError(t, err)
+	assert.Equal(t, RebalanceDirectionSell, rebalance.Direction)
+	assert.Equal(t, "1003010", rebalance.AmountIn)
+	assert.Equal(t, "5000", rebalance.AmountOut)
+	assert.Equal(t, "2003010", rebalance.TokenReserve)
+	assert.Equal(t, "5000", rebalance.ETHReserve)
+	assert.Equal(t, "400602", rebalance.AddToken)
+	assert.Equal(t, "1000", rebalance.AddETH)
+	assert.Equal(t, "396595", rebalance.AddMinToken)
+	assert.Equal(t, "990", rebalance.AddMinETH)
+
+	_, err = ComputePoolRebalance(tokenReserve, ethReserve, 0.01, nil, 1)
+	assert.ErrorContains(t, err, "already at the target price")
+
+	_, err = ComputePoolRebalance(tokenReserve, ethReserve, 0, nil, 1)
+	assert.ErrorContains(t, err, "target price must be positive")
+
+	_, err = ComputePoolRebalance(new(big.Int), ethReserve, 0.04, nil, 1)
+	assert.ErrorContains(t, err, "no liquidity")
+}
@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+const (
+	// V2SwapFeePercent is the share of the swap input a Uniswap V2 pair keeps for its liquidity providers
+	V2SwapFeePercent = 0.3
+
+	// PositionSourceActual is a position read from the indexed LP token balance of an address
+	PositionSourceActual = "actual"
+	// PositionSourceHypothetical is a position deposited at the start of the window
+	PositionSourceHypothetical = "hypothetical"
+)
+
+// PoolReturns is the fee income and price move of a pool over a window. Values are in base units of the quote token,
+// prices are the quote reserve over the token reserve
+type PoolReturns struct {
+	Since time.Time `json:"since"`
+	Until time.Time `json:"until"`
+	Days  float64   `json:"days"`
+	Swaps int       `json:"swaps"`
+	// QuoteVolume is the quote token paid in on buys plus the quote token paid out on sells
+	QuoteVolume string `json:"quote_volume"`
+	// Fees is the V2SwapFeePercent of the quote volume, kept by the pool
+	Fees              string  `json:"fees"`
+	StartTokenReserve string  `json:"start_token_reserve"`
+	StartQuoteReserve string  `json:"start_quote_reserve"`
+	EndTokenReserve   string  `json:"end_token_reserve"`
+	EndQuoteReserve   string  `json:"end_quote_reserve"`
+	StartPrice        float64 `json:"start_price"`
+	EndPrice          float64 `json:"end_price"`
+	// AverageLiquidity is the time weighted value of the pool, twice its quote reserve
+	AverageLiquidity string `json:"average_liquidity"`
+	// FeeAPR is the annualized percentage of the fees over the average liquidity
+	FeeAPR float64 `json:"fee_apr"`
+	// ImpermanentLoss is the percentage a position loses against holding its deposit, at most 0
+	ImpermanentLoss float64 `json:"impermanent_loss"`
+}
+
+// LPPositionReturns is the outcome of a liquidity position held over the window of PoolReturns
+type LPPositionReturns struct {
+	Source string `json:"source"`
+	// FeeShare is the percentage of the fees earned by the position
+	FeeShare     float64 `json:"fee_share"`
+	TokenDeposit string  `json:"token_deposit"`
+	QuoteDeposit string  `json:"quote_deposit"`
+	// DepositValue is the deposit valued at the start price, HoldValue at the end price
+	DepositValue string `json:"deposit_value"`
+	HoldValue    string `json:"hold_value"`
+	// LPValue is the value of the position in the pool at the end price, fees excluded
+	LPValue string `json:"lp_value"`
+	Fees    string `json:"fees"`
+	// ImpermanentLoss is LPValue minus HoldValue
+	ImpermanentLoss string `json:"impermanent_loss"`
+	// NetReturn is LPValue plus Fees minus DepositValue
+	NetReturn string `json:"net_return"`
+}
+
+// ComputePoolReturns computes the fee APR and impermanent loss of a pool between since and until from its reserve
+// history, ordered from oldest to newest with the initial funding first, and the swap volume of the window. The
+// reserves at the start of the window are the last state before it, or the first state when the pool is younger.
+func ComputePoolReturns(states []LaunchPoolState, volume LaunchVolume, since, until time.Time) (*PoolReturns, error) {
+	if len(states) == 0 {
+		return nil, fmt.Errorf("the pool has no reserve history")
+	}
+	if since.Before(states[0].Time) {
+		since = states[0].Time
+	}
+	if !until.After(since) {
+		return nil, fmt.Errorf("the window ends before the pool was funded")
+	}
+
+	start, end := states[0], states[0]
+	// weighted sums the quote reserve multiplied by the seconds it lasted within the window
+	weighted := new(big.Int)
+	for i, state := range states {
+		if state.Time.After(until) {
+			break
+		}
+		if !state.Time.After(since) {
+			start = state
+		}
+		end = state
+
+		from, to := state.Time, until
+		if i+1 < len(states) && states[i+1].Time.Before(until) {
+			to = states[i+1].Time
+		}
+		if from.Before(since) {
+			from = since
+		}
+		if seconds := int64(to.Sub(from).Seconds()); seconds > 0 {
+			weighted.Add(weighted, new(big.Int).Mul(state.QuoteReserve, big.NewInt(seconds)))
+		}
+	}
+	if start.TokenReserve.Sign() <= 0 || start.QuoteReserve.Sign() <= 0 || end.TokenReserve.Sign() <= 0 || end.QuoteReserve.Sign() <= 0 {
+		return nil, fmt.Errorf("the pool has no liquidity in the window")
+	}
+
+	window := until.Sub(since)
+	averageLiquidity := new(big.Int).Mul(weighted, big.NewInt(2))
+	if seconds := int64(window.Seconds()); seconds > 0 {
+		averageLiquidity.Div(averageLiquidity, big.NewInt(seconds))
+	}
+	quoteVolume, ok := new(big.Int).SetString(volume.QuoteVolume, 10)
+	if !ok {
+		quoteVolume = new(big.Int)
+	}
+	fees := new(big.Int).Div(new(big.Int).Mul(quoteVolume, big.NewInt(int64(V2SwapFeePercent*10))), big.NewInt(1000))
+
+	returns := &PoolReturns{
+		Since:             since,
+		Until:             until,
+		Days:              window.Hours() / 24,
+		Swaps:             volume.Swaps,
+		QuoteVolume:       quoteVolume.String(),
+		Fees:              fees.String(),
+		StartTokenReserve: start.TokenReserve.String(),
+		StartQuoteReserve: start.QuoteReserve.String(),
+		EndTokenReserve:   end.TokenReserve.String(),
+		EndQuoteReserve:   end.QuoteReserve.String(),
+		StartPrice:        reservePrice(start.TokenReserve, start.QuoteReserve),
+		EndPrice:          reservePrice(end.TokenReserve, end.QuoteReserve),
+		AverageLiquidity:  averageLiquidity.String(),
+	}
+	if averageLiquidity.Sign() > 0 {
+		feeRate, _ := new(big.Float).Quo(new(big.Float).SetInt(fees), new(big.Float).SetInt(averageLiquidity)).Float64()
+		returns.FeeAPR = feeRate * (365 * 24 * time.Hour).Hours() / window.Hours() * 100
+	}
+	returns.ImpermanentLoss = ImpermanentLoss(returns.EndPrice/returns.StartPrice) * 100
+	return returns, nil
+}
+
+// ImpermanentLoss returns the relative loss of a constant product position against holding its deposit when the
+// price is multiplied by priceRatio: 2 * sqrt(r) / (1 + r) - 1
+func ImpermanentLoss(priceRatio float64) float64 {
+	if priceRatio <= 0 {
+		return -1
+	}
+	return 2*math.Sqrt(priceRatio)/(1+priceRatio) - 1
+}
+
+// Position computes the returns of a position depositing tokenDeposit and quoteDeposit at the start of the window and
+// earning feeShare percent of the fees
+func (r *PoolReturns) Position(source string, tokenDeposit, quoteDeposit *big.Int, feeShare float64) *LPPositionReturns {
+	value := func(tokens *big.Int, quote *big.Int, price float64) *big.Float {
+		tokenValue := new(big.Float).Mul(new(big.Float).SetInt(tokens), big.NewFloat(price))
+		return tokenValue.Add(tokenValue, new(big.Float).SetInt(quote))
+	}
+	toInt := func(amount *big.Float) *big.Int {
+		result, _ := amount.Int(nil)
+		return result
+	}
+
+	deposit := toInt(value(tokenDeposit, quoteDeposit, r.StartPrice))
+	holdValue := value(tokenDeposit, quoteDeposit, r.EndPrice)
+	lpValue := new(big.Float).Mul(holdValue, big.NewFloat(1+r.ImpermanentLoss/100))
+	fees, _ := new(big.Int).SetString(r.Fees, 10)
+	positionFees := toInt(new(big.Float).Mul(new(big.Float).SetInt(fees), big.NewFloat(feeShare/100)))
+
+	hold, lp := toInt(holdValue), toInt(lpValue)
+	net := new(big.Int).Add(lp, positionFees)
+	return &LPPositionReturns{
+		Source:          source,
+		FeeShare:        feeShare,
+		TokenDeposit:    tokenDeposit.String(),
+		QuoteDeposit:    quoteDeposit.String(),
+		DepositValue:    deposit.String(),
+		HoldValue:       hold.String(),
+		LPValue:         lp.String(),
+		Fees:            positionFees.String(),
+		ImpermanentLoss: new(big.Int).Sub(lp, hold).String(),
+		NetReturn:       net.Sub(net, deposit).String(),
+	}
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputePoolReturns(t *testing.T) {
+	funded := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	states := []LaunchPoolState{
+		{Time: funded, TokenReserve: big.NewInt(1_000_000), QuoteReserve: big.NewInt(10_000)},
+		// buys quadruple the price after a day
+		{Time: funded.Add(24 * time.Hour), TokenReserve: big.NewInt(500_000), QuoteReserve: big.NewInt(20_000)},
+		{Time: funded.Add(72 * time.Hour), TokenReserve: big.NewInt(1_000_000), QuoteReserve: big.NewInt(10_000)},
+	}
+	volume := LaunchVolume{Swaps: 4, QuoteVolume: "100000"}
+
+	// the window starts before the funding, it is moved to the funding
+	returns, err := ComputePoolReturns(states, volume, funded.Add(-time.Hour), funded.Add(48*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, funded, returns.Since)
+	assert.Equal(t, 2.0, returns.Days)
+	assert.Equal(t, "300", returns.Fees)
+	assert.Equal(t, "30000", returns.AverageLiquidity)
+	assert.InDelta(t, 182.5, returns.FeeAPR, 0.0001)
+	assert.Equal(t, 0.01, returns.StartPrice)
+	assert.Equal(t, 0.04, returns.EndPrice)
+	assert.Equal(t, "20000", returns.EndQuoteReserve)
+	assert.InDelta(t, -20, returns.ImpermanentLoss, 0.0001)
+
+	position := returns.Position(PositionSourceHypothetical, big.NewInt(100_000), big.NewInt(1000), 10)
+	assert.Equal(t, "2000", position.DepositValue)
+	assert.Equal(t, "5000", position.HoldValue)
+	assert.Equal(t, "4000", position.LPValue)
+	assert.Equal(t, "-1000", position.ImpermanentLoss)
+	assert.Equal(t, "30", position.Fees)
+	assert.Equal(t, "2030", position.NetReturn)
+
+	// a window starting after a state takes its reserves at the start
+	returns, err = ComputePoolReturns(states, volume, funded.Add(48*time.Hour), funded.Add(96*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "20000", returns.StartQuoteReserve)
+	assert.Equal(t, "10000", returns.EndQuoteReserve)
+	assert.Equal(t, "30000", returns.AverageLiquidity)
+	assert.InDelta(t, -20, returns.ImpermanentLoss, 0.0001)
+
+	_, err = ComputePoolReturns(nil, volume, funded, funded.Add(time.Hour))
+	assert.ErrorContains(t, err, "no reserve history")
+}
+
+func TestImpermanentLoss(t *testing.T) {
+	assert.Zero(t, ImpermanentLoss(1))
+	assert.InDelta(t, -0.0572, ImpermanentLoss(2), 0.0001)
+	assert.InDelta(t, -0.0572, ImpermanentLoss(0.5), 0.0001)
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"math/big"
+)
+
+// WETHPrice is the spot price of a token in a pool paired with WETH, both reserves are in the smallest unit
+type WETHPrice struct {
+	TokenReserve *big.Int
+	WETHReserve  *big.Int
+}
+
+// Value converts an amount of the token to wei at the spot price of the pool, nil when the pool is empty
+func (p WETHPrice) Value(amount *big.Int) *big.Int {
+	if p.TokenReserve == nil || p.WETHReserve == nil || p.TokenReserve.Sign() == 0 {
+		return nil
+	}
+	value := new(big.Int).Mul(amount, p.WETHReserve)
+	return value.Quo(value, p.TokenReserve)
+}
+
+// ShareOfReserve returns the part of the reserve owned by balance LP tokens out of supply, rounded down like burn of the pair
+func ShareOfReserve(reserve, balance, supply *big.Int) *big.Int {
+	if supply.Sign() == 0 {
+		return new(big.Int)
+	}
+	share := new(big.Int).Mul(reserve, balance)
+	return share.Quo(share, supply)
+}
+
+// WeiToUSD values an amount in wei of the native token at its USD price
+func WeiToUSD(wei *big.Int, usdPrice float64) float64 {
+	ether := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(1e18))
+	usd, _ := new(big.Float).Mul(ether, big.NewFloat(usdPrice)).Float64()
+	return usd
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWETHPriceValue(t *testing.T) {
+	// 1,000,000 tokens against 10 WETH
+	price := WETHPrice{TokenReserve: big.NewInt(1_000_000), WETHReserve: big.NewInt(10)}
+	assert.Equal(t, "5", price.Value(big.NewInt(500_000)).String())
+	assert.Nil(t, WETHPrice{TokenReserve: big.NewInt(0), WETHReserve: big.NewInt(10)}.Value(big.NewInt(1)))
+	assert.Nil(t, WETHPrice{}.Value(big.NewInt(1)))
+}
+
+func TestShareOfReserve(t *testing.T) {
+	assert.Equal(t, "250", ShareOfReserve(big.NewInt(1000), big.NewInt(25), big.NewInt(100)).String())
+	assert.Equal(t, "0", ShareOfReserve(big.NewInt(1000), big.NewInt(25), big.NewInt(0)).String())
+}
+
+func TestWeiToUSD(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1500000000000000000", 10)
+	assert.InDelta(t, 3000.0, WeiToUSD(wei, 2000), 1e-9)
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// PrivateRelayStatus is the status of a transaction reported by the status API of a private relay
+type PrivateRelayStatus string
+
+const (
+	PrivateRelayStatusPending   PrivateRelayStatus = "PENDING"
+	PrivateRelayStatusIncluded  PrivateRelayStatus = "INCLUDED"
+	PrivateRelayStatusFailed    PrivateRelayStatus = "FAILED"
+	PrivateRelayStatusCancelled PrivateRelayStatus = "CANCELLED"
+	PrivateRelayStatusUnknown   PrivateRelayStatus = "UNKNOWN"
+)
+
+// flashbotsStatusHosts maps the Flashbots Protect RPC hosts to the host of their transaction status API
+var flashbotsStatusHosts = map[string]string{
+	"rpc.flashbots.net":         "protect.flashbots.net",
+	"rpc-sepolia.flashbots.net": "protect-sepolia.flashbots.net",
+	"rpc-holesky.flashbots.net": "protect-holesky.flashbots.net",
+}
+
+// SendPrivateTransaction submits a signed raw transaction to a private relay RPC and returns the transaction hash
+func SendPrivateTransaction(relayRPC, signedTransaction string) (string, error) {
+	client := NewRPCClient(relayRPC)
+	client.SetTimeout(15 * time.Second)
+
+	response, err := client.Call("eth_sendRawTransaction", []interface{}{signedTransaction})
+	if err != nil {
+		return "", fmt.Errorf("relay rejected the transaction: %w", err)
+	}
+
+	txHash, ok := response.Result.(string)
+	if !ok || txHash == "" {
+		return "", fmt.Errorf("relay did not return a transaction hash")
+	}
+	return txHash, nil
+}
+
+// PrivateRelayStatusURL returns the status API URL of the transaction when the relay has one.
+// Only Flashbots Protect exposes a status API, other relays are tracked through the chain receipt only.
+func PrivateRelayStatusURL(relayRPC, txHash string) (string, bool) {
+	parsed, err := url.Parse(relayRPC)
+	if err != nil {
+		return "", false
+	}
+
+	statusHost, ok := flashbotsStatusHosts[strings.ToLower(parsed.Hostname())]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("https://%s/tx/%s", statusHost, txHash), true
+}
+
+// FetchPrivateRelayStatus reads the status of a transaction from the status API of its relay
+func FetchPrivateRelayStatus(statusURL string) (PrivateRelayStatus, error) {
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Get(statusURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to request relay status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("relay status request failed with status: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read relay status: %w", err)
+	}
+
+	var result struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal relay status: %w", err)
+	}
+
+	return PrivateRelayStatus(strings.ToUpper(result.Status)), nil
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateRelayStatusURL(t *testing.T) {
+	url, ok := PrivateRelayStatusURL("https://rpc.flashbots.net/fast", "0x01")
+	assert.True(t, ok)
+	assert.Equal(t, "https://protect.flashbots.net/tx/0x01", url)
+
+	url, ok = PrivateRelayStatusURL("https://rpc-sepolia.flashbots.net", "0x02")
+	assert.True(t, ok)
+	assert.Equal(t, "https://protect-sepolia.flashbots.net/tx/0x02", url)
+
+	_, ok = PrivateRelayStatusURL("https://rpc.mevblocker.io", "0x03")
+	assert.False(t, ok)
+}
+
+func TestSendPrivateTransaction(t *testing.T) {
+	var method string
+	var params []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		method, params = request.Method, request.Params
+
+		if request.Params[0] == "0xbad" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "error": map[string]interface{}{"code": -32000, "message": "nonce too low"}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": "0xhash"})
+	}))
+	defer server.Close()
+
+	hash, err := SendPrivateTransaction(server.URL, "0xsigned")
+	require.NoError(t, err)
+	assert.Equal(t, "0xhash", hash)
+	assert.Equal(t, "eth_sendRawTransaction", method)
+	assert.Equal(t, []interface{}{"0xsigned"}, params)
+
+	_, err = SendPrivateTransaction(server.URL, "0xbad")
+	assert.ErrorContains(t, err, "nonce too low")
+}
@@ -0,0 +1,474 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QR codes of the signing page urls, scanned by a mobile wallet to sign a session opened on a computer.
+// The text is encoded in byte mode with the M error correction level (15% of the code can be lost), in the
+// smallest of the versions 1 to 20 it fits in
+
+const qrMaxVersion = 20
+
+// qrErrorCorrectionM holds the error correction codewords per block and the number of blocks of the M level of
+// every version, the blocks share the data codewords of the version
+var qrErrorCorrectionM = [qrMaxVersion + 1]struct{ perBlock, blocks int }{
+	{},
+	{10, 1}, {16, 1}, {26, 1}, {18, 2}, {24, 2}, {16, 4}, {18, 4}, {22, 4}, {22, 5}, {26, 5},
+	{30, 5}, {22, 8}, {22, 9}, {24, 9}, {24, 10}, {28, 10}, {28, 11}, {26, 13}, {26, 14}, {26, 16},
+}
+
+// QRCode is the module matrix of a QR code, true modules are dark
+type QRCode struct {
+	Size       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// Dark reports whether the module at column x and row y is dark
+func (q *QRCode) Dark(x, y int) bool {
+	return q.modules[y][x]
+}
+
+// EncodeQRCode encodes text into the smallest QR code it fits in
+func EncodeQRCode(text string) (*QRCode, error) {
+	data := []byte(text)
+	version := 0
+	for v := 1; v <= qrMaxVersion; v++ {
+		capacity := qrDataCodewords(v) * 8
+		if 4+qrCountBits(v)+len(data)*8 <= capacity {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("text of %d bytes is too long for a QR code", len(data))
+	}
+
+	// mode indicator, character count, data, terminator and padding
+	var bits qrBitBuffer
+	bits.append(0x4, 4)
+	bits.append(len(data), qrCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	capacity := qrDataCodewords(version) * 8
+	bits.append(0, min(4, capacity-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+
+	size := version*4 + 17
+	q := &QRCode{Size: size, modules: make([][]bool, size), isFunction: make([][]bool, size)}
+	for i := range q.modules {
+		q.modules[i] = make([]bool, size)
+		q.isFunction[i] = make([]bool, size)
+	}
+	q.drawFunctionPatterns(version)
+	q.drawCodewords(qrInterleave(version, codewords))
+
+	// keep the mask with the lowest penalty
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormatBits(mask)
+		if penalty := q.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		q.applyMask(mask)
+	}
+	q.applyMask(bestMask)
+	q.drawFormatBits(bestMask)
+	return q, nil
+}
+
+// SVG renders the code with a quiet zone of 4 modules, each module moduleSize pixels wide
+func (q *QRCode) SVG(moduleSize int) string {
+	const border = 4
+	dimension := q.Size + border*2
+	var path strings.Builder
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if q.modules[y][x] {
+				fmt.Fprintf(&path, "M%d,%dh1v1h-1z", x+border, y+border)
+			}
+		}
+	}
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%d" height="%d" shape-rendering="crispEdges">`+
+		`<rect width="100%%" height="100%%" fill="#ffffff"/><path d="%s" fill="#000000"/></svg>`,
+		dimension, dimension, dimension*moduleSize, dimension*moduleSize, path.String())
+}
+
+type qrBitBuffer []bool
+
+func (b *qrBitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, (value>>i)&1 == 1)
+	}
+}
+
+// qrCountBits is the length of the character count of byte mode
+func qrCountBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
+
+// qrRawCodewords is the number of codewords of a version, data and error correction, once the function
+// patterns are drawn
+func qrRawCodewords(version int) int {
+	modules := (16*version+128)*version + 64
+	if version >= 2 {
+		alignments := version/7 + 2
+		modules -= (25*alignments-10)*alignments - 55
+		if version >= 7 {
+			modules -= 36
+		}
+	}
+	return modules / 8
+}
+
+func qrDataCodewords(version int) int {
+	ec := qrErrorCorrectionM[version]
+	return qrRawCodewords(version) - ec.perBlock*ec.blocks
+}
+
+// qrInterleave splits the data into the blocks of the version, appends their error correction and interleaves them
+func qrInterleave(version int, data []byte) []byte {
+	ec := qrErrorCorrectionM[version]
+	raw := qrRawCodewords(version)
+	shortBlocks := ec.blocks - raw%ec.blocks
+	shortLength := raw / ec.blocks
+	divisor := qrReedSolomonDivisor(ec.perBlock)
+
+	blocks := make([][]byte, ec.blocks)
+	offset := 0
+	for i := range blocks {
+		length := shortLength - ec.perBlock
+		if i >= shortBlocks {
+			length++
+		}
+		block := append([]byte{}, data[offset:offset+length]...)
+		offset += length
+		remainder := qrReedSolomonRemainder(block, divisor)
+		if i < shortBlocks {
+			// the short blocks skip the column of the extra data codeword of the long blocks
+			block = append(block, 0)
+		}
+		blocks[i] = append(block, remainder...)
+	}
+
+	result := make([]byte, 0, raw)
+	for i := 0; i <= shortLength; i++ {
+		for j, block := range blocks {
+			if i != shortLength-ec.perBlock || j >= shortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// qrMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func qrMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+// qrReedSolomonDivisor returns the generator polynomial of the given degree, without its leading term
+func qrReedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	var root byte = 1
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = qrMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = qrMultiply(root, 0x02)
+	}
+	return result
+}
+
+// qrReedSolomonRemainder returns the error correction codewords of the data
+func qrReedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i := range result {
+			result[i] ^= qrMultiply(divisor[i], factor)
+		}
+	}
+	return result
+}
+
+func (q *QRCode) setFunction(x, y int, dark bool) {
+	q.modules[y][x] = dark
+	q.isFunction[y][x] = true
+}
+
+func (q *QRCode) drawFunctionPatterns(version int) {
+	for i := 0; i < q.Size; i++ {
+		q.setFunction(6, i, i%2 == 0)
+		q.setFunction(i, 6, i%2 == 0)
+	}
+
+	q.drawFinderPattern(3, 3)
+	q.drawFinderPattern(q.Size-4, 3)
+	q.drawFinderPattern(3, q.Size-4)
+
+	positions := qrAlignmentPositions(version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// the corners of the finder patterns have no alignment pattern
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			q.drawAlignmentPattern(x, y)
+		}
+	}
+
+	// reserve the format area, drawn once the mask is chosen
+	q.drawFormatBits(0)
+	q.drawVersion(version)
+}
+
+func (q *QRCode) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			distance := max(abs(dx), abs(dy))
+			if xx, yy := x+dx, y+dy; xx >= 0 && xx < q.Size && yy >= 0 && yy < q.Size {
+				q.setFunction(xx, yy, distance != 2 && distance != 4)
+			}
+		}
+	}
+}
+
+func (q *QRCode) drawAlignmentPattern(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			q.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// qrAlignmentPositions returns the centers of the alignment patterns on each axis
+func qrAlignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+	count := version/7 + 2
+	step := (version*8 + count*3 + 5) / (count*4 - 4) * 2
+	positions := make([]int, count)
+	positions[0] = 6
+	for i, position := count-1, version*4+17-7; i >= 1; i, position = i-1, position-step {
+		positions[i] = position
+	}
+	return positions
+}
+
+// qrFormatBits returns the 15 format bits of the M level and the mask
+func qrFormatBits(mask int) int {
+	data := 0<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+func (q *QRCode) drawFormatBits(mask int) {
+	bits := qrFormatBits(mask)
+	bit := func(i int) bool { return (bits>>i)&1 == 1 }
+
+	for i := 0; i <= 5; i++ {
+		q.setFunction(8, i, bit(i))
+	}
+	q.setFunction(8, 7, bit(6))
+	q.setFunction(8, 8, bit(7))
+	q.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		q.setFunction(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		q.setFunction(q.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		q.setFunction(8, q.Size-15+i, bit(i))
+	}
+	q.setFunction(8, q.Size-8, true)
+}
+
+// qrVersionBits returns the 18 version bits of the versions 7 and up
+func qrVersionBits(version int) int {
+	remainder := version
+	for i := 0; i < 12; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	return version<<12 | remainder
+}
+
+func (q *QRCode) drawVersion(version int) {
+	if version < 7 {
+		return
+	}
+	bits := qrVersionBits(version)
+	for i := 0; i < 18; i++ {
+		dark := (bits>>i)&1 == 1
+		a, b := q.Size-11+i%3, i/3
+		q.setFunction(a, b, dark)
+		q.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords places the codewords in the zigzag of two module columns, from the bottom right corner
+func (q *QRCode) drawCodewords(codewords []byte) {
+	i := 0
+	for right := q.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			// the vertical timing pattern
+			right = 5
+		}
+		for vertical := 0; vertical < q.Size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = q.Size - 1 - vertical
+				}
+				if !q.isFunction[y][x] && i < len(codewords)*8 {
+					q.modules[y][x] = (codewords[i>>3]>>(7-i&7))&1 == 1
+					i++
+				}
+			}
+		}
+	}
+}
+
+func qrMasked(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask flips the data modules of the mask, applying it twice removes it
+func (q *QRCode) applyMask(mask int) {
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if !q.isFunction[y][x] && qrMasked(mask, x, y) {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the patterns confusing the scanners: runs of a color, 2x2 blocks, finder-like runs and the
+// imbalance of dark and light modules
+func (q *QRCode) penalty() int {
+	result := 0
+	line := func(get func(i int) bool) {
+		run := 1
+		for i := 1; i < q.Size; i++ {
+			if get(i) == get(i-1) {
+				run++
+				if run == 5 {
+					result += 3
+				} else if run > 5 {
+					result++
+				}
+			} else {
+				run = 1
+			}
+		}
+		// 1:1:3:1:1 dark runs with 4 light modules on a side
+		finder := []bool{true, false, true, true, true, false, true}
+		for i := 0; i+7 <= q.Size; i++ {
+			matches := true
+			for k, dark := range finder {
+				if get(i+k) != dark {
+					matches = false
+					break
+				}
+			}
+			if !matches {
+				continue
+			}
+			light := func(from, to int) bool {
+				for k := from; k < to; k++ {
+					if k >= 0 && k < q.Size && get(k) {
+						return false
+					}
+				}
+				return true
+			}
+			if light(i-4, i) || light(i+7, i+11) {
+				result += 40
+			}
+		}
+	}
+	for y := 0; y < q.Size; y++ {
+		line(func(i int) bool { return q.modules[y][i] })
+	}
+	for x := 0; x < q.Size; x++ {
+		line(func(i int) bool { return q.modules[i][x] })
+	}
+
+	dark := 0
+	for y := 0; y < q.Size; y++ {
+		for x := 0; x < q.Size; x++ {
+			if q.modules[y][x] {
+				dark++
+			}
+			if x+1 < q.Size && y+1 < q.Size {
+				color := q.modules[y][x]
+				if color == q.modules[y][x+1] && color == q.modules[y+1][x] && color == q.modules[y+1][x+1] {
+					result += 3
+				}
+			}
+		}
+	}
+	total := q.Size * q.Size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	return result + k*10
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQRReedSolomon(t *testing.T) {
+	// the codewords of HELLO WORLD in a 1-M code, from the QR code tutorial of thonky.com
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	assert.Equal(t, []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}, qrReedSolomonRemainder(data, qrReedSolomonDivisor(10)))
+}
+
+func TestQRTables(t *testing.T) {
+	// the data codewords of the M level in the specification
+	assert.Equal(t, 16, qrDataCodewords(1))
+	assert.Equal(t, 28, qrDataCodewords(2))
+	assert.Equal(t, 86, qrDataCodewords(5))
+	assert.Equal(t, 216, qrDataCodewords(10))
+	assert.Equal(t, 669, qrDataCodewords(20))
+
+	assert.Equal(t, 0b101010000010010, qrFormatBits(0))
+	assert.Equal(t, 0b100101010100000, qrFormatBits(7))
+	assert.Equal(t, 0b000111110010010100, qrVersionBits(7))
+	assert.Equal(t, []int{6, 26, 48, 70}, qrAlignmentPositions(15))
+}
+
+// readQRCode decodes a byte mode M code: the format bits, the mask, the codewords and the blocks
+func readQRCode(t *testing.T, q *QRCode) string {
+	format := 0
+	for i := 0; i <= 5; i++ {
+		if q.Dark(8, i) {
+			format |= 1 << i
+		}
+	}
+	for i, p := range [][2]int{{8, 7}, {8, 8}, {7, 8}} {
+		if q.Dark(p[0], p[1]) {
+			format |= 1 << (6 + i)
+		}
+	}
+	for i := 9; i < 15; i++ {
+		if q.Dark(14-i, 8) {
+			format |= 1 << i
+		}
+	}
+	mask := -1
+	for m := 0; m < 8; m++ {
+		if qrFormatBits(m) == format {
+			mask = m
+		}
+	}
+	require.NotEqual(t, -1, mask, "format bits %015b", format)
+
+	var bits []bool
+	for right := q.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < q.Size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vertical
+				if (right+1)&2 == 0 {
+					y = q.Size - 1 - vertical
+				}
+				if !q.isFunction[y][x] {
+					bits = append(bits, q.Dark(x, y) != qrMasked(mask, x, y))
+				}
+			}
+		}
+	}
+
+	version := (q.Size - 17) / 4
+	raw := qrRawCodewords(version)
+	codewords := make([]byte, raw)
+	for i := 0; i < raw*8; i++ {
+		if bits[i] {
+			codewords[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+
+	ec := qrErrorCorrectionM[version]
+	shortBlocks := ec.blocks - raw%ec.blocks
+	dataLength := func(block int) int {
+		if block < shortBlocks {
+			return raw/ec.blocks - ec.perBlock
+		}
+		return raw/ec.blocks - ec.perBlock + 1
+	}
+	blocks := make([][]byte, ec.blocks)
+	i := 0
+	for column := 0; column <= raw/ec.blocks-ec.perBlock; column++ {
+		for block := range blocks {
+			if column < dataLength(block) {
+				blocks[block] = append(blocks[block], codewords[i])
+				i++
+			}
+		}
+	}
+	for column := 0; column < ec.perBlock; column++ {
+		for block := range blocks {
+			blocks[block] = append(blocks[block], codewords[i])
+			i++
+		}
+	}
+	var data []byte
+	for block, codewords := range blocks {
+		length := dataLength(block)
+		require.Equal(t, codewords[length:], qrReedSolomonRemainder(codewords[:length], qrReedSolomonDivisor(ec.perBlock)), "block %d", block)
+		data = append(data, codewords[:length]...)
+	}
+
+	var stream qrBitBuffer
+	for _, b := range data {
+		stream.append(int(b), 8)
+	}
+	read := func(length int) int {
+		value := 0
+		for _, bit := range stream[:length] {
+			value <<= 1
+			if bit {
+				value |= 1
+			}
+		}
+		stream = stream[length:]
+		return value
+	}
+	require.Equal(t, 0x4, read(4))
+	text := make([]byte, read(qrCountBits(version)))
+	for i := range text {
+		text[i] = byte(read(8))
+	}
+	return string(text)
+}
+
+func TestEncodeQRCode(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		size int
+	}{
+		{name: "short text", text: "HELLO WORLD", size: 21},
+		{name: "session url", text: "http://192.168.1.20:3000/tx/7a4e0a51-07a5-4d4e-9a83-0e5b6f5c3d2e?token=" + strings.Repeat("a", 64), size: 49},
+		{name: "version with version bits", text: strings.Repeat("launchpad", 20), size: 53},
+		{name: "two block sizes", text: strings.Repeat("x", 300), size: 69},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := EncodeQRCode(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.size, q.Size)
+			assert.Equal(t, tt.text, readQRCode(t, q))
+
+			// finder pattern corners and the dark module
+			assert.True(t, q.Dark(0, 0))
+			assert.True(t, q.Dark(q.Size-1, 0))
+			assert.True(t, q.Dark(0, q.Size-1))
+			assert.True(t, q.Dark(8, q.Size-8))
+			assert.False(t, q.Dark(7, 7))
+		})
+	}
+
+	_, err := EncodeQRCode(strings.Repeat("x", 700))
+	assert.Error(t, err)
+}
+
+func TestQRCodeSVG(t *testing.T) {
+	q, err := EncodeQRCode("https://example.com")
+	require.NoError(t, err)
+
+	svg := q.SVG(4)
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 33 33" width="132" height="132"`))
+	assert.Contains(t, svg, "M4,4h1v1h-1z")
+	assert.True(t, strings.HasSuffix(svg, "</svg>"))
+}
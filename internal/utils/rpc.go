@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -54,16 +56,25 @@ type RPCError struct {
 
 // TransactionReceipt represents an Ethereum transaction receipt
 type TransactionReceipt struct {
-	TransactionHash   string `json:"transactionHash"`
-	TransactionIndex  string `json:"transactionIndex"`
-	BlockHash         string `json:"blockHash"`
-	BlockNumber       string `json:"blockNumber"`
-	CumulativeGasUsed string `json:"cumulativeGasUsed"`
-	GasUsed           string `json:"gasUsed"`
-	ContractAddress   string `json:"contractAddress"`
-	Status            string `json:"status"`
-	From              string `json:"from"`
-	To                string `json:"to"`
+	TransactionHash   string           `json:"transactionHash"`
+	TransactionIndex  string           `json:"transactionIndex"`
+	BlockHash         string           `json:"blockHash"`
+	BlockNumber       string           `json:"blockNumber"`
+	CumulativeGasUsed string           `json:"cumulativeGasUsed"`
+	GasUsed           string           `json:"gasUsed"`
+	EffectiveGasPrice string           `json:"effectiveGasPrice"`
+	ContractAddress   string           `json:"contractAddress"`
+	Status            string           `json:"status"`
+	From              string           `json:"from"`
+	To                string           `json:"to"`
+	Logs              []TransactionLog `json:"logs"`
+}
+
+// TransactionLog is an event emitted by a transaction
+type TransactionLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
 }
 
 // Call makes a JSON-RPC call
@@ -106,6 +117,53 @@ func (r *RPCClient) Call(method string, params []interface{}) (*JSONRPCResponse,
 	return &response, nil
 }
 
+// BatchCall sends the calls of the same method as one JSON-RPC batch. The responses follow the order of params,
+// the error of a single call is kept on its response
+func (r *RPCClient) BatchCall(method string, params [][]interface{}) ([]*JSONRPCResponse, error) {
+	requests := make([]JSONRPCRequest, len(params))
+	for i, param := range params {
+		requests[i] = JSONRPCRequest{JSONRPC: "2.0", Method: method, Params: param, ID: i + 1}
+	}
+
+	jsonData, err := json.Marshal(requests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", r.URL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: r.timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var batch []JSONRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+		return nil, fmt.Errorf("failed to decode batch response: %w", err)
+	}
+
+	// Servers may answer a batch in any order
+	responses := make([]*JSONRPCResponse, len(params))
+	for i := range batch {
+		if id := batch[i].ID; id >= 1 && id <= len(params) {
+			responses[id-1] = &batch[i]
+		}
+	}
+	for i, response := range responses {
+		if response == nil {
+			return nil, fmt.Errorf("missing response for call %d of the batch", i+1)
+		}
+	}
+	return responses, nil
+}
+
 // GetTransactionReceipt gets the transaction receipt for a given hash
 func (r *RPCClient) GetTransactionReceipt(txHash string) (*TransactionReceipt, error) {
 	response, err := r.Call("eth_getTransactionReceipt", []interface{}{txHash})
@@ -130,6 +188,15 @@ func (r *RPCClient) GetTransactionReceipt(txHash string) (*TransactionReceipt, e
 	return &receipt, nil
 }
 
+// TransactionBlock returns the block number of a mined transaction
+func TransactionBlock(rpcURL, txHash string) (uint64, error) {
+	receipt, err := NewRPCClient(rpcURL).GetTransactionReceipt(txHash)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimPrefix(receipt.BlockNumber, "0x"), 16, 64)
+}
+
 // VerifyTransactionSuccess verifies that a transaction was successful
 func (r *RPCClient) VerifyTransactionSuccess(txHash string) (bool, *TransactionReceipt, error) {
 	receipt, err := r.GetTransactionReceipt(txHash)
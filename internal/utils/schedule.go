@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MinScheduleInterval is the shortest interval accepted for recurring jobs
+const MinScheduleInterval = time.Minute
+
+// ParseSchedule parses a cron-like schedule descriptor into the interval between runs.
+// Supported values are @hourly, @daily, @weekly, @every <duration> (e.g. "@every 6h")
+// and the shorthands hourly, daily and weekly.
+func ParseSchedule(schedule string) (time.Duration, error) {
+	value := strings.ToLower(strings.TrimSpace(schedule))
+
+	switch strings.TrimPrefix(value, "@") {
+	case "hourly":
+		return time.Hour, nil
+	case "daily":
+		return 24 * time.Hour, nil
+	case "weekly":
+		return 7 * 24 * time.Hour, nil
+	}
+
+	if !strings.HasPrefix(value, "@every ") {
+		return 0, fmt.Errorf("unsupported schedule %q, use @hourly, @daily, @weekly or @every <duration>", schedule)
+	}
+
+	interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(value, "@every ")))
+	if err != nil {
+		return 0, fmt.Errorf("invalid schedule duration: %w", err)
+	}
+	if interval < MinScheduleInterval {
+		return 0, fmt.Errorf("schedule interval must be at least %s", MinScheduleInterval)
+	}
+	return interval, nil
+}
+
+// NextScheduledRun returns the first run after now on the schedule that started at previousRun.
+// Runs missed while the server was down are skipped instead of being executed in a burst.
+func NextScheduledRun(previousRun time.Time, interval time.Duration, now time.Time) time.Time {
+	next := previousRun.Add(interval)
+	if next.After(now) {
+		return next
+	}
+
+	missed := now.Sub(next)/interval + 1
+	return next.Add(missed * interval)
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	testCases := []struct {
+		schedule string
+		expected time.Duration
+	}{
+		{"@hourly", time.Hour},
+		{"daily", 24 * time.Hour},
+		{"@Weekly", 7 * 24 * time.Hour},
+		{"@every 6h", 6 * time.Hour},
+		{" @every 90m ", 90 * time.Minute},
+	}
+
+	for _, tc := range testCases {
+		interval, err := ParseSchedule(tc.schedule)
+		require.NoError(t, err, tc.schedule)
+		assert.Equal(t, tc.expected, interval, tc.schedule)
+	}
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, schedule := range []string{"", "0 * * * *", "@every", "@every abc", "@every 10s"} {
+		_, err := ParseSchedule(schedule)
+		assert.Error(t, err, schedule)
+	}
+}
+
+func TestNextScheduledRun(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// On time
+	assert.Equal(t, start.Add(24*time.Hour), NextScheduledRun(start, 24*time.Hour, start.Add(time.Minute)))
+
+	// Missed runs are skipped while keeping the time of day
+	now := start.Add(3*24*time.Hour + time.Hour)
+	assert.Equal(t, start.Add(4*24*time.Hour), NextScheduledRun(start, 24*time.Hour, now))
+
+	// Exactly on a boundary moves to the next run
+	assert.Equal(t, start.Add(3*time.Hour), NextScheduledRun(start, time.Hour, start.Add(2*time.Hour)))
+}
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SellSimulationAccount is the account the simulated trades are sent from, its ETH balance is overridden for the simulation
+const SellSimulationAccount = "0x5e115e115e115e115e115e115e115e115e115e11"
+
+// sellSimulationAbi holds the router and token functions called by the simulation.
+// The fee on transfer variants are used so taxed tokens are not reported as untradable.
+const sellSimulationAbi = `[
+	{"type":"function","name":"swapExactETHForTokensSupportingFeeOnTransferTokens","stateMutability":"payable","inputs":[{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"swapExactTokensForETHSupportingFeeOnTransferTokens","stateMutability":"nonpayable","inputs":[{"name":"amountIn","type":"uint256"},{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+// sellSimulationBalance is the ETH balance given to the simulation account (1000 ETH)
+var sellSimulationBalance = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+
+// SellSimulationRequest describes a buy-then-sell of a token paired with WETH on a Uniswap V2 router
+type SellSimulationRequest struct {
+	RouterAddress string
+	WETHAddress   string
+	TokenAddress  string
+	// BuyAmount is the ETH spent on the buy, in wei
+	BuyAmount *big.Int
+	// SellAmount is the amount of tokens sold back, it must not exceed the tokens received by the buy
+	SellAmount *big.Int
+	Deadline   int64
+}
+
+// SellSimulationStep is the outcome of one simulated call
+type SellSimulationStep struct {
+	Name    string `json:"name"`
+	Success bool   `json:"success"`
+	GasUsed uint64 `json:"gas_used"`
+	Error   string `json:"error,omitempty"`
+}
+
+// SellSimulationResult is the outcome of a simulated buy-then-sell
+type SellSimulationResult struct {
+	Steps []SellSimulationStep `json:"steps"`
+	// TokensBought is the token balance of the simulation account after the buy
+	TokensBought  string `json:"tokens_bought,omitempty"`
+	BuySucceeded  bool   `json:"buy_succeeded"`
+	SellSucceeded bool   `json:"sell_succeeded"`
+}
+
+// SimulateBuyThenSell simulates buying a token with ETH, approving the router and selling part of it back to ETH.
+// The calls are simulated in order on top of the latest block with eth_simulateV1, nothing is broadcast.
+func SimulateBuyThenSell(rpcURL string, request SellSimulationRequest) (*SellSimulationResult, error) {
+	parsedAbi, err := abi.JSON(strings.NewReader(sellSimulationAbi))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse simulation ABI: %w", err)
+	}
+
+	account := common.HexToAddress(SellSimulationAccount)
+	router := common.HexToAddress(request.RouterAddress)
+	weth := common.HexToAddress(request.WETHAddress)
+	token := common.HexToAddress(request.TokenAddress)
+	deadline := big.NewInt(request.Deadline)
+
+	calls := []struct {
+		name  string
+		to    common.Address
+		value *big.Int
+		data  func() ([]byte, error)
+	}{
+		{"buy", router, request.BuyAmount, func() ([]byte, error) {
+			return parsedAbi.Pack("swapExactETHForTokensSupportingFeeOnTransferTokens", big.NewInt(0), []common.Address{weth, token}, account, deadline)
+		}},
+		{"balance", token, nil, func() ([]byte, error) {
+			return parsedAbi.Pack("balanceOf", account)
+		}},
+		{"approve", token, nil, func() ([]byte, error) {
+			return parsedAbi.Pack("approve", router, request.SellAmount)
+		}},
+		{"sell", router, nil, func() ([]byte, error) {
+			return parsedAbi.Pack("swapExactTokensForETHSupportingFeeOnTransferTokens", request.SellAmount, big.NewInt(0), []common.Address{token, weth}, account, deadline)
+		}},
+	}
+
+	simulatedCalls := make([]map[string]string, 0, len(calls))
+	for _, call := range calls {
+		data, err := call.data()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s call: %w", call.name, err)
+		}
+		simulatedCall := map[string]string{
+			"from":  account.Hex(),
+			"to":    call.to.Hex(),
+			"input": hexutil.Encode(data),
+		}
+		if call.value != nil {
+			simulatedCall["value"] = hexutil.EncodeBig(call.value)
+		}
+		simulatedCalls = append(simulatedCalls, simulatedCall)
+	}
+
+	client := NewRPCClient(rpcURL)
+	response, err := client.Call("eth_simulateV1", []interface{}{
+		map[string]interface{}{
+			"blockStateCalls": []interface{}{
+				map[string]interface{}{
+					"stateOverrides": map[string]interface{}{
+						account.Hex(): map[string]string{"balance": hexutil.EncodeBig(sellSimulationBalance)},
+					},
+					"calls": simulatedCalls,
+				},
+			},
+			"validation": false,
+		},
+		"latest",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate trades: %w", err)
+	}
+
+	blocks, ok := response.Result.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return nil, fmt.Errorf("invalid simulation response format")
+	}
+	block, _ := blocks[0].(map[string]interface{})
+	callResults, _ := block["calls"].([]interface{})
+	if len(callResults) != len(calls) {
+		return nil, fmt.Errorf("simulation returned %d results for %d calls", len(callResults), len(calls))
+	}
+
+	result := &SellSimulationResult{}
+	for i, raw := range callResults {
+		callResult, _ := raw.(map[string]interface{})
+		step := SellSimulationStep{Name: calls[i].name}
+		status, _ := callResult["status"].(string)
+		step.Success = status == "0x1"
+		if gasUsed, ok := callResult["gasUsed"].(string); ok {
+			step.GasUsed, _ = hexutil.DecodeUint64(gasUsed)
+		}
+		if !step.Success {
+			step.Error = simulationCallError(callResult)
+		}
+		if calls[i].name == "balance" && step.Success {
+			returnData, _ := callResult["returnData"].(string)
+			if balance, ok := new(big.Int).SetString(strings.TrimPrefix(returnData, "0x"), 16); ok {
+				result.TokensBought = balance.String()
+			}
+		}
+		result.Steps = append(result.Steps, step)
+	}
+
+	result.BuySucceeded = result.Steps[0].Success
+	result.SellSucceeded = result.BuySucceeded && result.Steps[2].Success && result.Steps[3].Success
+	return result, nil
+}
+
+// simulationCallError returns the revert reason of a failed simulated call
+func simulationCallError(callResult map[string]interface{}) string {
+	message := "execution reverted"
+	callError, ok := callResult["error"].(map[string]interface{})
+	if !ok {
+		return message
+	}
+	if errorMessage, ok := callError["message"].(string); ok && errorMessage != "" {
+		message = errorMessage
+	}
+	if data, ok := callError["data"].(string); ok {
+		if revertData, err := hex.DecodeString(strings.TrimPrefix(data, "0x")); err == nil {
+			if reason, err := abi.UnpackRevert(revertData); err == nil {
+				return fmt.Sprintf("%s: %s", message, reason)
+			}
+		}
+	}
+	return message
+}
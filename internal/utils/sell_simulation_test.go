@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSellSimulationServer(t *testing.T, calls []map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID     int           `json:"id"`
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		assert.Equal(t, "eth_simulateV1", request.Method)
+		assert.Len(t, request.Params, 2)
+
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: request.ID, Result: []interface{}{
+			map[string]interface{}{"calls": calls},
+		}})
+	}))
+}
+
+func sellSimulationRequest() SellSimulationRequest {
+	return SellSimulationRequest{
+		RouterAddress: "0x1111111111111111111111111111111111111111",
+		WETHAddress:   "0x2222222222222222222222222222222222222222",
+		TokenAddress:  "0x3333333333333333333333333333333333333333",
+		BuyAmount:     big.NewInt(1e15),
+		SellAmount:    big.NewInt(500),
+		Deadline:      1700000000,
+	}
+}
+
+func TestSimulateBuyThenSellPasses(t *testing.T) {
+	balance := "0x" + common.Bytes2Hex(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32))
+	server := newSellSimulationServer(t, []map[string]interface{}{
+		{"status": "0x1", "gasUsed": "0x1d4c0", "returnData": "0x"},
+		{"status": "0x1", "gasUsed": "0x5208", "returnData": balance},
+		{"status": "0x1", "gasUsed": "0xb5e6", "returnData": "0x"},
+		{"status": "0x1", "gasUsed": "0x1d4c0", "returnData": "0x"},
+	})
+	defer server.Close()
+
+	result, err := SimulateBuyThenSell(server.URL, sellSimulationRequest())
+	require.NoError(t, err)
+	assert.True(t, result.BuySucceeded)
+	assert.True(t, result.SellSucceeded)
+	assert.Equal(t, "1000", result.TokensBought)
+	require.Len(t, result.Steps, 4)
+	assert.Equal(t, "buy", result.Steps[0].Name)
+	assert.Equal(t, uint64(120000), result.Steps[0].GasUsed)
+}
+
+func TestSimulateBuyThenSellReportsRevertReason(t *testing.T) {
+	// Error(string) with the reason "TRANSFER_BLOCKED"
+	revertData := hexutil.Encode(append(FunctionSelector("Error(string)"), common.Hex2Bytes(
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+			"0000000000000000000000000000000000000000000000000000000000000010"+
+			"5452414e534645525f424c4f434b454400000000000000000000000000000000")...))
+	server := newSellSimulationServer(t, []map[string]interface{}{
+		{"status": "0x1", "gasUsed": "0x1d4c0", "returnData": "0x"},
+		{"status": "0x1", "gasUsed": "0x5208", "returnData": "0x" + common.Bytes2Hex(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32))},
+		{"status": "0x1", "gasUsed": "0xb5e6", "returnData": "0x"},
+		{"status": "0x0", "gasUsed": "0x7530", "returnData": "0x", "error": map[string]interface{}{"code": 3, "message": "execution reverted", "data": revertData}},
+	})
+	defer server.Close()
+
+	result, err := SimulateBuyThenSell(server.URL, sellSimulationRequest())
+	require.NoError(t, err)
+	assert.True(t, result.BuySucceeded)
+	assert.False(t, result.SellSucceeded)
+	assert.Equal(t, "execution reverted: TRANSFER_BLOCKED", result.Steps[3].Error)
+}
+
+func TestSimulateBuyThenSellUnsupportedRPC(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(JSONRPCResponse{JSONRPC: "2.0", ID: 1, Error: &RPCError{Code: -32601, Message: "the method eth_simulateV1 does not exist"}})
+	}))
+	defer server.Close()
+
+	_, err := SimulateBuyThenSell(server.URL, sellSimulationRequest())
+	assert.ErrorContains(t, err, "eth_simulateV1 does not exist")
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// EnvSessionURLSecret is the HMAC secret signing the transaction session urls, unset leaves the urls unsigned
+	EnvSessionURLSecret = "LAUNCHPAD_SESSION_URL_SECRET"
+	// SessionSignatureQueryParam is the query parameter carrying the signature of a session url
+	SessionSignatureQueryParam = "sig"
+	// DownloadExpiresQueryParam is the query parameter carrying the expiry, in unix seconds, of a signed download url
+	DownloadExpiresQueryParam = "expires"
+	// DownloadURLTTL is how long a signed download url is valid
+	DownloadURLTTL = time.Hour
+)
+
+// SessionURLSigningEnabled reports whether the session urls are signed and their signature required
+func SessionURLSigningEnabled() bool {
+	return os.Getenv(EnvSessionURLSecret) != ""
+}
+
+// signURL returns the HMAC of the value with the session url secret, empty when signing is disabled
+func signURL(value string) string {
+	secret := os.Getenv(EnvSessionURLSecret)
+	if secret == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// SignSessionID returns the signature of the session url, empty when signing is disabled
+func SignSessionID(sessionID string) string {
+	return signURL("session:" + sessionID)
+}
+
+// VerifySessionSignature reports whether the signature was issued for the session
+func VerifySessionSignature(sessionID, signature string) bool {
+	expected := SignSessionID(sessionID)
+	return expected != "" && signature != "" && hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// SignDownload returns the signature of the download url of the path expiring at expires, empty when signing is disabled
+func SignDownload(path string, expires int64) string {
+	return signURL("download:" + path + ":" + strconv.FormatInt(expires, 10))
+}
+
+// VerifyDownloadSignature reports whether the signature was issued for the download url of the path and has not expired
+func VerifyDownloadSignature(path, expires, signature string) bool {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return false
+	}
+	expected := SignDownload(path, expiresAt)
+	return expected != "" && signature != "" && hmac.Equal([]byte(signature), []byte(expected))
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"crypto/ecdsa"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -17,6 +18,34 @@ func GenerateMessage() string {
 	return fmt.Sprintf("I am signing into Launchpad at %d", time.Now().Unix())
 }
 
+// GenerateSignatureNonce returns a random nonce making a signature request single use
+func GenerateSignatureNonce() (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return hex.EncodeToString(nonce), nil
+}
+
+// GenerateOwnershipMessage returns the message a wallet signs to prove it controls an address, the statement is
+// written by the agent requesting the signature and shown first in the wallet
+func GenerateOwnershipMessage(statement, address, nonce string, issuedAt time.Time) string {
+	if statement == "" {
+		statement = "I control this address and approve Launchpad acting on its behalf."
+	}
+	message := statement + "\n\n"
+	if address != "" {
+		message += fmt.Sprintf("Address: %s\n", common.HexToAddress(address).Hex())
+	}
+	message += fmt.Sprintf("Nonce: %s\nIssued At: %s", nonce, issuedAt.UTC().Format(time.RFC3339))
+	return message
+}
+
+// RecoverPersonalSignatureAddress returns the address that personal_signed the message
+func RecoverPersonalSignatureAddress(message, signature string) (string, error) {
+	return getAddressFromSignature(signature, message)
+}
+
 // VerifyTransactionOwnershipBySignature verifies if the provided signature corresponds to the owner of the transaction identified by txHash.
 // It will fetch the transaction sender address and verify the signature was created by that address.
 func VerifyTransactionOwnershipBySignature(rpcUrl, txHash, signature, message string) (bool, error) {
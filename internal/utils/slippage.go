@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"math"
+	"math/big"
+	"strings"
+)
+
+// AutoSlippage is the slippage_tolerance value that lets the server derive the slippage from the pool depth
+const AutoSlippage = "auto"
+
+const (
+	// MinAutoSlippage is the slippage used for swaps with a negligible price impact, in percent
+	MinAutoSlippage = 0.5
+	// MaxAutoSlippage caps the derived slippage, in percent
+	MaxAutoSlippage = 15.0
+	// DefaultMaxPriceImpact is the price impact above which auto slippage swaps are rejected, in percent
+	DefaultMaxPriceImpact = 5.0
+)
+
+// IsAutoSlippage reports whether the slippage tolerance asks for auto slippage
+func IsAutoSlippage(slippageTolerance string) bool {
+	return strings.EqualFold(strings.TrimSpace(slippageTolerance), AutoSlippage)
+}
+
+// AutoSlippageTolerance derives a slippage bound in percent from the expected price impact of a swap.
+// The expected output already accounts for the impact, the bound covers the pool moving by a fraction of it
+// before the transaction is mined, on top of MinAutoSlippage.
+// The result is rounded up to 2 decimals and capped at MaxAutoSlippage.
+func AutoSlippageTolerance(priceImpact float64) float64 {
+	if priceImpact < 0 {
+		priceImpact = 0
+	}
+	slippage := MinAutoSlippage + priceImpact/2
+	slippage = math.Ceil(slippage*100) / 100
+	return math.Min(slippage, MaxAutoSlippage)
+}
+
+// ApplySlippage returns the minimum amount accepted when the expected amount may slip by slippagePercent
+func ApplySlippage(amount *big.Int, slippagePercent float64) *big.Int {
+	// Work in hundredths of a basis point to keep 2 decimals of the percentage exact
+	precision := int64(1_000_000)
+	keep := precision - int64(math.Round(slippagePercent*float64(precision)/100))
+	if keep <= 0 {
+		return big.NewInt(0)
+	}
+	minimum := new(big.Int).Mul(amount, big.NewInt(keep))
+	return minimum.Div(minimum, big.NewInt(precision))
+}
+
+// ApplyMaxSlippage returns the maximum amount spent when the expected amount may slip by slippagePercent, rounded up
+func ApplyMaxSlippage(amount *big.Int, slippagePercent float64) *big.Int {
+	precision := int64(1_000_000)
+	extra := precision + int64(math.Round(slippagePercent*float64(precision)/100))
+	maximum := new(big.Int).Mul(amount, big.NewInt(extra))
+	maximum.Add(maximum, big.NewInt(precision-1))
+	return maximum.Div(maximum, big.NewInt(precision))
+}
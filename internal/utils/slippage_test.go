@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAutoSlippage(t *testing.T) {
+	assert.True(t, IsAutoSlippage("auto"))
+	assert.True(t, IsAutoSlippage(" AUTO "))
+	assert.False(t, IsAutoSlippage("0.5"))
+	assert.False(t, IsAutoSlippage(""))
+}
+
+func TestAutoSlippageTolerance(t *testing.T) {
+	assert.Equal(t, MinAutoSlippage, AutoSlippageTolerance(0))
+	assert.Equal(t, MinAutoSlippage, AutoSlippageTolerance(-1))
+	assert.Equal(t, 1.5, AutoSlippageTolerance(2))
+	// Rounded up to 2 decimals
+	assert.Equal(t, 0.51, AutoSlippageTolerance(0.011))
+	assert.Equal(t, MaxAutoSlippage, AutoSlippageTolerance(80))
+}
+
+func TestApplySlippage(t *testing.T) {
+	amount := big.NewInt(1_000_000)
+	assert.Equal(t, "995000", ApplySlippage(amount, 0.5).String())
+	assert.Equal(t, "987500", ApplySlippage(amount, 1.25).String())
+	assert.Equal(t, "1000000", ApplySlippage(amount, 0).String())
+	assert.Equal(t, "0", ApplySlippage(amount, 100).String())
+}
+
+func TestApplyMaxSlippage(t *testing.T) {
+	amount := big.NewInt(1_000_000)
+	assert.Equal(t, "1005000", ApplyMaxSlippage(amount, 0.5).String())
+	assert.Equal(t, "1000000", ApplyMaxSlippage(amount, 0).String())
+	// Rounded up so the maximum never falls below the expected amount
+	assert.Equal(t, "2", ApplyMaxSlippage(big.NewInt(1), 0.5).String())
+}
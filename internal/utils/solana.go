@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// SolanaNativeMint is the wrapped SOL mint, swaps from or to it are wrapped and unwrapped from native SOL
+	SolanaNativeMint = "So11111111111111111111111111111111111111112"
+	// SolanaMainnetBeta is the chain ID of the Solana mainnet
+	SolanaMainnetBeta = "mainnet-beta"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// DecodeBase58 decodes a base58 string with the Bitcoin alphabet used by Solana addresses and signatures
+func DecodeBase58(value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("empty base58 string")
+	}
+	number := new(big.Int)
+	radix := big.NewInt(58)
+	for _, char := range value {
+		digit := strings.IndexRune(base58Alphabet, char)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", char)
+		}
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(digit)))
+	}
+
+	// Every leading 1 is a leading zero byte
+	leadingZeros := len(value) - len(strings.TrimLeft(value, "1"))
+	return append(make([]byte, leadingZeros), number.Bytes()...), nil
+}
+
+// IsValidSolanaAddress checks the address is a base58 encoded 32 bytes public key
+func IsValidSolanaAddress(address string) bool {
+	decoded, err := DecodeBase58(address)
+	return err == nil && len(decoded) == 32
+}
+
+// SignatureStatus is the status of a Solana transaction returned by getSignatureStatuses
+type SignatureStatus struct {
+	Slot               uint64 `json:"slot"`
+	ConfirmationStatus string `json:"confirmationStatus"`
+	Err                any    `json:"err"`
+}
+
+// GetSignatureStatus gets the status of a Solana transaction signature, searching the transaction history
+func (r *RPCClient) GetSignatureStatus(signature string) (*SignatureStatus, error) {
+	response, err := r.Call("getSignatureStatuses", []interface{}{
+		[]string{signature},
+		map[string]bool{"searchTransactionHistory": true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signature statuses: %w", err)
+	}
+	var result struct {
+		Value []*SignatureStatus `json:"value"`
+	}
+	if err := json.Unmarshal(resultData, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signature statuses: %w", err)
+	}
+	if len(result.Value) == 0 || result.Value[0] == nil {
+		return nil, fmt.Errorf("transaction not found or not yet processed")
+	}
+	return result.Value[0], nil
+}
+
+// VerifySolanaTransactionSuccess verifies that a Solana transaction was confirmed without error
+func (r *RPCClient) VerifySolanaTransactionSuccess(signature string) (bool, *SignatureStatus, error) {
+	status, err := r.GetSignatureStatus(signature)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// processed transactions can still be dropped, confirmed and finalized ones are voted on by the cluster
+	confirmed := status.ConfirmationStatus == "confirmed" || status.ConfirmationStatus == "finalized"
+	return confirmed && status.Err == nil, status, nil
+}
+
+// QuerySolanaBalance queries the SOL balance of an address, the native balance is in lamports
+func QuerySolanaBalance(rpcURL, address string) (*BalanceResult, error) {
+	if !IsValidSolanaAddress(address) {
+		return nil, fmt.Errorf("invalid address format")
+	}
+
+	client := NewRPCClient(rpcURL)
+	response, err := client.Call("getBalance", []interface{}{address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	resultData, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal balance: %w", err)
+	}
+	var result struct {
+		Value *uint64 `json:"value"`
+	}
+	if err := json.Unmarshal(resultData, &result); err != nil || result.Value == nil {
+		return nil, fmt.Errorf("invalid balance format")
+	}
+
+	lamports := new(big.Int).SetUint64(*result.Value)
+	sol := new(big.Float).Quo(new(big.Float).SetInt(lamports), big.NewFloat(1e9))
+	return &BalanceResult{
+		Address:          address,
+		NativeBalance:    lamports.String(),
+		NativeSymbol:     "SOL",
+		FormattedBalance: sol.Text('f', 9) + " SOL",
+		ChainType:        "solana",
+	}, nil
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBase58(t *testing.T) {
+	decoded, err := DecodeBase58(SolanaNativeMint)
+	require.NoError(t, err)
+	assert.Len(t, decoded, 32)
+
+	// The system program is the all zero public key
+	decoded, err = DecodeBase58("11111111111111111111111111111111")
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, 32), decoded)
+
+	_, err = DecodeBase58("0OIl")
+	assert.Error(t, err)
+}
+
+func TestIsValidSolanaAddress(t *testing.T) {
+	assert.True(t, IsValidSolanaAddress(SolanaNativeMint))
+	assert.True(t, IsValidSolanaAddress("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"))
+	assert.False(t, IsValidSolanaAddress("0x1111111111111111111111111111111111111111"))
+	assert.False(t, IsValidSolanaAddress("abc"))
+	assert.False(t, IsValidSolanaAddress(""))
+}
+
+func TestVerifySolanaTransactionSuccess(t *testing.T) {
+	statuses := map[string]string{
+		"confirmed": `{"slot": 72, "confirmationStatus": "confirmed", "err": null}`,
+		"processed": `{"slot": 72, "confirmationStatus": "processed", "err": null}`,
+		"failed":    `{"slot": 72, "confirmationStatus": "finalized", "err": {"InstructionError": [0, "Custom"]}}`,
+		"unknown":   `null`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "getSignatureStatuses", request.Method)
+		signature := request.Params[0].([]any)[0].(string)
+		_, _ = w.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "result": {"context": {"slot": 80}, "value": [` + statuses[signature] + `]}}`))
+	}))
+	defer server.Close()
+	client := NewRPCClient(server.URL)
+
+	success, status, err := client.VerifySolanaTransactionSuccess("confirmed")
+	require.NoError(t, err)
+	assert.True(t, success)
+	assert.Equal(t, uint64(72), status.Slot)
+
+	success, _, err = client.VerifySolanaTransactionSuccess("processed")
+	require.NoError(t, err)
+	assert.False(t, success, "processed transactions can still be dropped")
+
+	success, _, err = client.VerifySolanaTransactionSuccess("failed")
+	require.NoError(t, err)
+	assert.False(t, success)
+
+	_, _, err = client.VerifySolanaTransactionSuccess("unknown")
+	assert.Error(t, err)
+}
@@ -6,7 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"html/template"
-	"path/filepath"
+	"path"
+	"regexp"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -18,9 +19,31 @@ import (
 type CompilationResult struct {
 	Bytecode map[string]string
 	Abi      map[string]any
+	// GasEstimates are the compiler estimates of every contract, keyed by creation, external and internal
+	GasEstimates map[string]map[string]map[string]string
+	// Warnings are the diagnostics that did not fail the compilation
+	Warnings []string
 }
 
 func CompileSolidity(version string, code string) (CompilationResult, error) {
+	return CompileSolidityFiles(version, code, nil, "")
+}
+
+// CompileSolidityFiles compiles the main contract code with the libraries and interfaces of files, keyed by
+// their path relative to the main contract. OpenZeppelin imports resolve to the vendored openZeppelinVersion,
+// the default submodule when it is empty. Only the contracts of the main file are returned
+func CompileSolidityFiles(version string, code string, files map[string]string, openZeppelinVersion string) (CompilationResult, error) {
+	release, err := acquireCompilationSlot()
+	if err != nil {
+		return CompilationResult{}, err
+	}
+	defer release()
+
+	openZeppelin, err := contracts.ResolveOpenZeppelinVersion(openZeppelinVersion)
+	if err != nil {
+		return CompilationResult{}, err
+	}
+
 	compiler, err := solc.NewWithVersion(version)
 	if err != nil {
 		return CompilationResult{}, err
@@ -30,33 +53,18 @@ func CompileSolidity(version string, code string) (CompilationResult, error) {
 		ImportCallback: func(u string) solc.ImportResult {
 			// Handle @openzeppelin-contracts/ imports
 			if contractPath, ok := strings.CutPrefix(u, "@openzeppelin-contracts/"); ok {
-				embeddedPath := filepath.Join("openzeppelin-contracts", contractPath)
-				// Read the contract content from the embedded filesystem
-				content, err := contracts.OpenZeppelinFS.ReadFile(embeddedPath)
-				if err != nil {
-					return solc.ImportResult{
-						Error: fmt.Sprintf("OpenZeppelin contract %s not found: %v", u, err),
-					}
-				}
-
-				return solc.ImportResult{
-					Contents: string(content),
-				}
+				return readOpenZeppelinImport(openZeppelin, u, contractPath)
 			}
 
 			// Handle @openzeppelin/ imports (legacy format)
 			if contractPath, ok := strings.CutPrefix(u, "@openzeppelin/"); ok {
-				embeddedPath := filepath.Join("openzeppelin-contracts", contractPath)
-				// Read the contract content from the embedded filesystem
-				content, err := contracts.OpenZeppelinFS.ReadFile(embeddedPath)
-				if err != nil {
-					return solc.ImportResult{
-						Error: fmt.Sprintf("OpenZeppelin contract %s not found: %v", u, err),
-					}
-				}
+				return readOpenZeppelinImport(openZeppelin, u, contractPath)
+			}
 
+			// Handle imports of the template files the compiler did not resolve from the sources
+			if content, ok := files[path.Clean(u)]; ok {
 				return solc.ImportResult{
-					Contents: string(content),
+					Contents: content,
 				}
 			}
 
@@ -65,17 +73,21 @@ func CompileSolidity(version string, code string) (CompilationResult, error) {
 			}
 		},
 	}
+	sources := map[string]solc.SourceIn{
+		TemplateMainFile: {
+			Content: code,
+		},
+	}
+	for name, content := range files {
+		sources[name] = solc.SourceIn{Content: content}
+	}
 	result, err := compiler.CompileWithOptions(&solc.Input{
 		Language: "Solidity",
-		Sources: map[string]solc.SourceIn{
-			"contract.sol": {
-				Content: code,
-			},
-		},
+		Sources:  sources,
 		Settings: solc.Settings{
 			OutputSelection: map[string]map[string][]string{
 				"*": {
-					"*": []string{"abi", "evm.bytecode"},
+					"*": []string{"abi", "evm.bytecode", "evm.gasEstimates"},
 				},
 			},
 		},
@@ -84,15 +96,28 @@ func CompileSolidity(version string, code string) (CompilationResult, error) {
 		return CompilationResult{}, err
 	}
 
-	if len(result.Errors) > 0 {
-		return CompilationResult{}, errors.New(fmt.Sprintf("compilation errors: %v", result.Errors))
+	var compileErrors []solc.Error
+	var warnings []string
+	for _, compileError := range result.Errors {
+		if compileError.Severity != "error" {
+			warnings = append(warnings, compileError.Message)
+			continue
+		}
+		compileErrors = append(compileErrors, compileError)
+	}
+	if len(compileErrors) > 0 {
+		if err := openZeppelinSymbolError(openZeppelin, compileErrors); err != nil {
+			return CompilationResult{}, err
+		}
+		return CompilationResult{}, errors.New(fmt.Sprintf("compilation errors: %v", compileErrors))
 	}
 
 	bytecodeMap := make(map[string]string)
 	abiMap := make(map[string]any)
+	gasEstimates := make(map[string]map[string]map[string]string)
 
 	for fileName, contract := range result.Contracts {
-		if fileName != "contract.sol" {
+		if fileName != TemplateMainFile {
 			continue
 		}
 		for contractName, contract := range contract {
@@ -101,15 +126,62 @@ func CompileSolidity(version string, code string) (CompilationResult, error) {
 
 			bytecodeMap[contractName] = bytecode
 			abiMap[contractName] = abi
+			gasEstimates[contractName] = contract.EVM.GasEstimates
 		}
 	}
 
 	return CompilationResult{
-		Bytecode: bytecodeMap,
-		Abi:      abiMap,
+		Bytecode:     bytecodeMap,
+		Abi:          abiMap,
+		GasEstimates: gasEstimates,
+		Warnings:     warnings,
 	}, nil
 }
 
+// readOpenZeppelinImport reads an OpenZeppelin import from the embedded release
+func readOpenZeppelinImport(openZeppelin contracts.OpenZeppelinVersion, u string, contractPath string) solc.ImportResult {
+	embeddedPath := path.Join(openZeppelin.Dir, contractPath)
+	// Read the contract content from the embedded filesystem
+	content, err := contracts.OpenZeppelinFS.ReadFile(embeddedPath)
+	if err != nil {
+		return solc.ImportResult{
+			Error: fmt.Sprintf("OpenZeppelin contract %s not found in OpenZeppelin %s, the file does not exist in the pinned version: %v", u, openZeppelinVersionName(openZeppelin), err),
+		}
+	}
+
+	return solc.ImportResult{
+		Contents: string(content),
+	}
+}
+
+// openZeppelinMissingSymbol matches the solc error of an import of a symbol the imported file does not declare
+var openZeppelinMissingSymbol = regexp.MustCompile(`Declaration "([^"]+)" not found in "(@openzeppelin[^"]*)"`)
+
+// openZeppelinSymbolError explains the imports of symbols the pinned OpenZeppelin version does not declare,
+// nil when the errors are not caused by one
+func openZeppelinSymbolError(openZeppelin contracts.OpenZeppelinVersion, compileErrors []solc.Error) error {
+	for _, compileError := range compileErrors {
+		match := openZeppelinMissingSymbol.FindStringSubmatch(compileError.Message)
+		if match == nil {
+			continue
+		}
+		return fmt.Errorf("%s is not declared by %s in OpenZeppelin %s, pin the OpenZeppelin version the template targets with openzeppelin_version: %v",
+			match[1], match[2], openZeppelinVersionName(openZeppelin), compileErrors)
+	}
+	return nil
+}
+
+func openZeppelinVersionName(openZeppelin contracts.OpenZeppelinVersion) string {
+	name := openZeppelin.Version
+	if name == "" {
+		name = "(unknown version)"
+	}
+	if openZeppelin.Default {
+		name += " (default)"
+	}
+	return name
+}
+
 // EncodeConstructorArgs encodes constructor arguments for ERC20 contracts and appends them to bytecode
 func EncodeConstructorArgs(bytecode, tokenName, tokenSymbol string) (string, error) {
 	// Remove 0x prefix if present
@@ -135,8 +207,9 @@ func EncodeConstructorArgs(bytecode, tokenName, tokenSymbol string) (string, err
 }
 
 // RenderContractTemplate renders the template code with provided values using Go template engine
+// and the helper functions of ContractTemplateFuncs
 func RenderContractTemplate(templateCode string, values models.JSON) (string, error) {
-	tmpl, err := template.New("contract").Parse(templateCode)
+	tmpl, err := template.New("contract").Funcs(ContractTemplateFuncs()).Parse(templateCode)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -1,7 +1,11 @@
 package utils
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/contracts"
+	"github.com/rxtech-lab/solc-go"
 )
 
 const code = `
@@ -62,3 +66,19 @@ func TestCompileSolidity(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenZeppelinSymbolError(t *testing.T) {
+	release := contracts.OpenZeppelinVersion{Version: "4.9.6", Dir: "openzeppelin-contracts-4.9.6"}
+
+	err := openZeppelinSymbolError(release, []solc.Error{{
+		Severity: "error",
+		Message:  `Declaration "Nonces" not found in "@openzeppelin/contracts/utils/Nonces.sol" (referenced as "@openzeppelin/contracts/utils/Nonces.sol").`,
+	}})
+	if err == nil || !strings.Contains(err.Error(), "Nonces is not declared by @openzeppelin/contracts/utils/Nonces.sol in OpenZeppelin 4.9.6") {
+		t.Fatalf("expected a missing symbol error, got %v", err)
+	}
+
+	if err := openZeppelinSymbolError(release, []solc.Error{{Severity: "error", Message: "Expected ';' but got '}'"}}); err != nil {
+		t.Fatalf("expected no missing symbol error, got %v", err)
+	}
+}
@@ -0,0 +1,396 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// uniswapV2PairEventsAbi contains the Uniswap V2 pair events indexed by the generated subgraph
+const uniswapV2PairEventsAbi = `[
+  {"anonymous":false,"inputs":[{"indexed":true,"name":"sender","type":"address"},{"indexed":false,"name":"amount0","type":"uint256"},{"indexed":false,"name":"amount1","type":"uint256"}],"name":"Mint","type":"event"},
+  {"anonymous":false,"inputs":[{"indexed":true,"name":"sender","type":"address"},{"indexed":false,"name":"amount0","type":"uint256"},{"indexed":false,"name":"amount1","type":"uint256"},{"indexed":true,"name":"to","type":"address"}],"name":"Burn","type":"event"},
+  {"anonymous":false,"inputs":[{"indexed":true,"name":"sender","type":"address"},{"indexed":false,"name":"amount0In","type":"uint256"},{"indexed":false,"name":"amount1In","type":"uint256"},{"indexed":false,"name":"amount0Out","type":"uint256"},{"indexed":false,"name":"amount1Out","type":"uint256"},{"indexed":true,"name":"to","type":"address"}],"name":"Swap","type":"event"},
+  {"anonymous":false,"inputs":[{"indexed":false,"name":"reserve0","type":"uint112"},{"indexed":false,"name":"reserve1","type":"uint112"}],"name":"Sync","type":"event"}
+]`
+
+// graphNetworks maps chain IDs to The Graph network names
+var graphNetworks = map[string]string{
+	"1":        "mainnet",
+	"11155111": "sepolia",
+	"17000":    "holesky",
+	"10":       "optimism",
+	"56":       "bsc",
+	"137":      "matic",
+	"8453":     "base",
+	"84532":    "base-sepolia",
+	"42161":    "arbitrum-one",
+	"421614":   "arbitrum-sepolia",
+	"43114":    "avalanche",
+}
+
+// SubgraphArgs contains the contracts indexed by the generated subgraph
+type SubgraphArgs struct {
+	ContractName    string
+	ContractAddress string
+	// Abi is the token ABI array as a JSON string
+	Abi     string
+	ChainID string
+	// Network overrides the Graph network name derived from the chain ID
+	Network    string
+	StartBlock uint64
+	// PairAddress is the Uniswap V2 pair of the token. Pair events are skipped when empty
+	PairAddress string
+}
+
+// SubgraphFile is a single file of the generated subgraph project
+type SubgraphFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// GetGraphNetwork returns The Graph network name for a chain ID
+func GetGraphNetwork(chainID string) (string, bool) {
+	network, ok := graphNetworks[chainID]
+	return network, ok
+}
+
+// GenerateSubgraph generates a Graph Protocol subgraph project (manifest, schema, ABIs and mappings)
+// indexing the token Transfer events and, when a pair address is given, the pool Swap, Mint, Burn and Sync events.
+func GenerateSubgraph(args SubgraphArgs) ([]SubgraphFile, error) {
+	parsedAbi, err := abi.JSON(strings.NewReader(args.Abi))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	transfer, ok := parsedAbi.Events["Transfer"]
+	if !ok || len(transfer.Inputs) != 3 {
+		return nil, fmt.Errorf("token ABI does not contain an ERC20 Transfer event")
+	}
+
+	network := args.Network
+	if network == "" {
+		network, ok = GetGraphNetwork(args.ChainID)
+		if !ok {
+			return nil, fmt.Errorf("unknown Graph network for chain ID %s, please provide the network name", args.ChainID)
+		}
+	}
+
+	var tokenAbi bytes.Buffer
+	if err := json.Indent(&tokenAbi, []byte(args.Abi), "", "  "); err != nil {
+		return nil, fmt.Errorf("invalid ABI JSON: %w", err)
+	}
+
+	identifier := ToIdentifier(args.ContractName)
+	slug := strings.ReplaceAll(ToSnakeCase(identifier), "_", "-")
+	hasPair := args.PairAddress != ""
+
+	files := []SubgraphFile{
+		{Path: "package.json", Content: subgraphPackageJSON(slug, network)},
+		{Path: "subgraph.yaml", Content: subgraphManifest(identifier, network, args, hasPair)},
+		{Path: "schema.graphql", Content: subgraphSchema(hasPair)},
+		{Path: fmt.Sprintf("abis/%s.json", identifier), Content: tokenAbi.String() + "\n"},
+		{Path: "src/token.ts", Content: subgraphTokenMapping(identifier)},
+	}
+
+	if hasPair {
+		files = append(files,
+			SubgraphFile{Path: "abis/UniswapV2Pair.json", Content: uniswapV2PairEventsAbi + "\n"},
+			SubgraphFile{Path: "src/pair.ts", Content: subgraphPairMapping(identifier)},
+		)
+	}
+
+	return files, nil
+}
+
+func subgraphPackageJSON(slug, network string) string {
+	return fmt.Sprintf(`{
+  "name": "%[1]s-subgraph",
+  "version": "0.1.0",
+  "private": true,
+  "scripts": {
+    "codegen": "graph codegen",
+    "build": "graph build",
+    "deploy": "graph deploy --network %[2]s %[1]s",
+    "create-local": "graph create --node http://localhost:8020/ %[1]s",
+    "deploy-local": "graph deploy --node http://localhost:8020/ --ipfs http://localhost:5001 %[1]s"
+  },
+  "dependencies": {
+    "@graphprotocol/graph-cli": "^0.80.0",
+    "@graphprotocol/graph-ts": "^0.35.0"
+  }
+}
+`, slug, network)
+}
+
+func subgraphManifest(identifier, network string, args SubgraphArgs, hasPair bool) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, `specVersion: 1.0.0
+indexerHints:
+  prune: auto
+schema:
+  file: ./schema.graphql
+dataSources:
+  - kind: ethereum
+    name: %[1]s
+    network: %[2]s
+    source:
+      address: "%[3]s"
+      abi: %[1]s
+      startBlock: %[4]d
+    mapping:
+      kind: ethereum/events
+      apiVersion: 0.0.7
+      language: wasm/assemblyscript
+      entities:
+        - Token
+        - Account
+        - Transfer
+      abis:
+        - name: %[1]s
+          file: ./abis/%[1]s.json
+      eventHandlers:
+        - event: Transfer(indexed address,indexed address,uint256)
+          handler: handleTransfer
+      file: ./src/token.ts
+`, identifier, network, args.ContractAddress, args.StartBlock)
+
+	if hasPair {
+		fmt.Fprintf(&builder, `  - kind: ethereum
+    name: %[1]sPair
+    network: %[2]s
+    source:
+      address: "%[3]s"
+      abi: UniswapV2Pair
+      startBlock: %[4]d
+    mapping:
+      kind: ethereum/events
+      apiVersion: 0.0.7
+      language: wasm/assemblyscript
+      entities:
+        - Pool
+        - Swap
+        - Mint
+        - Burn
+      abis:
+        - name: UniswapV2Pair
+          file: ./abis/UniswapV2Pair.json
+      eventHandlers:
+        - event: Swap(indexed address,uint256,uint256,uint256,uint256,indexed address)
+          handler: handleSwap
+        - event: Mint(indexed address,uint256,uint256)
+          handler: handleMint
+        - event: Burn(indexed address,uint256,uint256,indexed address)
+          handler: handleBurn
+        - event: Sync(uint112,uint112)
+          handler: handleSync
+      file: ./src/pair.ts
+`, identifier, network, args.PairAddress, args.StartBlock)
+	}
+
+	return builder.String()
+}
+
+func subgraphSchema(hasPair bool) string {
+	schema := `type Token @entity {
+  id: Bytes!
+  totalTransfers: BigInt!
+  holderCount: BigInt!
+}
+
+type Account @entity {
+  id: Bytes!
+  balance: BigInt!
+}
+
+type Transfer @entity(immutable: true) {
+  id: Bytes!
+  from: Bytes!
+  to: Bytes!
+  value: BigInt!
+  blockNumber: BigInt!
+  blockTimestamp: BigInt!
+  transactionHash: Bytes!
+}
+`
+	if !hasPair {
+		return schema
+	}
+
+	return schema + `
+type Pool @entity {
+  id: Bytes!
+  reserve0: BigInt!
+  reserve1: BigInt!
+  swapCount: BigInt!
+}
+
+type Swap @entity(immutable: true) {
+  id: Bytes!
+  sender: Bytes!
+  to: Bytes!
+  amount0In: BigInt!
+  amount1In: BigInt!
+  amount0Out: BigInt!
+  amount1Out: BigInt!
+  blockNumber: BigInt!
+  blockTimestamp: BigInt!
+  transactionHash: Bytes!
+}
+
+type Mint @entity(immutable: true) {
+  id: Bytes!
+  sender: Bytes!
+  amount0: BigInt!
+  amount1: BigInt!
+  blockNumber: BigInt!
+  blockTimestamp: BigInt!
+  transactionHash: Bytes!
+}
+
+type Burn @entity(immutable: true) {
+  id: Bytes!
+  sender: Bytes!
+  to: Bytes!
+  amount0: BigInt!
+  amount1: BigInt!
+  blockNumber: BigInt!
+  blockTimestamp: BigInt!
+  transactionHash: Bytes!
+}
+`
+}
+
+func subgraphTokenMapping(identifier string) string {
+	return fmt.Sprintf(`import { Address, BigInt, Bytes } from "@graphprotocol/graph-ts";
+import { Transfer as TransferEvent } from "../generated/%[1]s/%[1]s";
+import { Account, Token, Transfer } from "../generated/schema";
+
+function loadToken(address: Bytes): Token {
+  let token = Token.load(address);
+  if (token == null) {
+    token = new Token(address);
+    token.totalTransfers = BigInt.zero();
+    token.holderCount = BigInt.zero();
+  }
+  return token;
+}
+
+function loadAccount(address: Bytes): Account {
+  let account = Account.load(address);
+  if (account == null) {
+    account = new Account(address);
+    account.balance = BigInt.zero();
+  }
+  return account;
+}
+
+export function handleTransfer(event: TransferEvent): void {
+  let transfer = new Transfer(event.transaction.hash.concatI32(event.logIndex.toI32()));
+  transfer.from = event.params.from;
+  transfer.to = event.params.to;
+  transfer.value = event.params.value;
+  transfer.blockNumber = event.block.number;
+  transfer.blockTimestamp = event.block.timestamp;
+  transfer.transactionHash = event.transaction.hash;
+  transfer.save();
+
+  let token = loadToken(event.address);
+  token.totalTransfers = token.totalTransfers.plus(BigInt.fromI32(1));
+
+  if (event.params.from != Address.zero()) {
+    let sender = loadAccount(event.params.from);
+    sender.balance = sender.balance.minus(event.params.value);
+    if (sender.balance.isZero()) {
+      token.holderCount = token.holderCount.minus(BigInt.fromI32(1));
+    }
+    sender.save();
+  }
+
+  if (event.params.to != Address.zero()) {
+    let receiver = loadAccount(event.params.to);
+    if (receiver.balance.isZero() && !event.params.value.isZero()) {
+      token.holderCount = token.holderCount.plus(BigInt.fromI32(1));
+    }
+    receiver.balance = receiver.balance.plus(event.params.value);
+    receiver.save();
+  }
+
+  token.save();
+}
+`, identifier)
+}
+
+func subgraphPairMapping(identifier string) string {
+	return fmt.Sprintf(`import { BigInt, Bytes } from "@graphprotocol/graph-ts";
+import {
+  Burn as BurnEvent,
+  Mint as MintEvent,
+  Swap as SwapEvent,
+  Sync as SyncEvent,
+} from "../generated/%sPair/UniswapV2Pair";
+import { Burn, Mint, Pool, Swap } from "../generated/schema";
+
+function loadPool(address: Bytes): Pool {
+  let pool = Pool.load(address);
+  if (pool == null) {
+    pool = new Pool(address);
+    pool.reserve0 = BigInt.zero();
+    pool.reserve1 = BigInt.zero();
+    pool.swapCount = BigInt.zero();
+  }
+  return pool;
+}
+
+export function handleSwap(event: SwapEvent): void {
+  let swap = new Swap(event.transaction.hash.concatI32(event.logIndex.toI32()));
+  swap.sender = event.params.sender;
+  swap.to = event.params.to;
+  swap.amount0In = event.params.amount0In;
+  swap.amount1In = event.params.amount1In;
+  swap.amount0Out = event.params.amount0Out;
+  swap.amount1Out = event.params.amount1Out;
+  swap.blockNumber = event.block.number;
+  swap.blockTimestamp = event.block.timestamp;
+  swap.transactionHash = event.transaction.hash;
+  swap.save();
+
+  let pool = loadPool(event.address);
+  pool.swapCount = pool.swapCount.plus(BigInt.fromI32(1));
+  pool.save();
+}
+
+export function handleMint(event: MintEvent): void {
+  let mint = new Mint(event.transaction.hash.concatI32(event.logIndex.toI32()));
+  mint.sender = event.params.sender;
+  mint.amount0 = event.params.amount0;
+  mint.amount1 = event.params.amount1;
+  mint.blockNumber = event.block.number;
+  mint.blockTimestamp = event.block.timestamp;
+  mint.transactionHash = event.transaction.hash;
+  mint.save();
+}
+
+export function handleBurn(event: BurnEvent): void {
+  let burn = new Burn(event.transaction.hash.concatI32(event.logIndex.toI32()));
+  burn.sender = event.params.sender;
+  burn.to = event.params.to;
+  burn.amount0 = event.params.amount0;
+  burn.amount1 = event.params.amount1;
+  burn.blockNumber = event.block.number;
+  burn.blockTimestamp = event.block.timestamp;
+  burn.transactionHash = event.transaction.hash;
+  burn.save();
+}
+
+export function handleSync(event: SyncEvent): void {
+  let pool = loadPool(event.address);
+  pool.reserve0 = event.params.reserve0;
+  pool.reserve1 = event.params.reserve1;
+  pool.save();
+}
+`, identifier)
+}
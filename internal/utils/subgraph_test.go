@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSubgraphTokenAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func subgraphFilesByPath(files []SubgraphFile) map[string]string {
+	byPath := map[string]string{}
+	for _, file := range files {
+		byPath[file.Path] = file.Content
+	}
+	return byPath
+}
+
+func TestGenerateSubgraphWithPair(t *testing.T) {
+	files, err := GenerateSubgraph(SubgraphArgs{
+		ContractName:    "My Token",
+		ContractAddress: "0x1234567890123456789012345678901234567890",
+		Abi:             testSubgraphTokenAbi,
+		ChainID:         "11155111",
+		StartBlock:      42,
+		PairAddress:     "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd",
+	})
+	require.NoError(t, err)
+
+	byPath := subgraphFilesByPath(files)
+	require.Contains(t, byPath, "subgraph.yaml")
+	require.Contains(t, byPath, "schema.graphql")
+	require.Contains(t, byPath, "abis/MyToken.json")
+	require.Contains(t, byPath, "abis/UniswapV2Pair.json")
+	require.Contains(t, byPath, "src/token.ts")
+	require.Contains(t, byPath, "src/pair.ts")
+
+	manifest := byPath["subgraph.yaml"]
+	assert.Contains(t, manifest, "network: sepolia")
+	assert.Contains(t, manifest, `address: "0x1234567890123456789012345678901234567890"`)
+	assert.Contains(t, manifest, `address: "0xabcdefabcdefabcdefabcdefabcdefabcdefabcd"`)
+	assert.Contains(t, manifest, "startBlock: 42")
+	assert.Contains(t, manifest, "handler: handleSwap")
+	assert.Contains(t, manifest, "handler: handleMint")
+	assert.Contains(t, manifest, "handler: handleBurn")
+
+	assert.Contains(t, byPath["schema.graphql"], "type Swap @entity(immutable: true)")
+	assert.Contains(t, byPath["src/token.ts"], `from "../generated/MyToken/MyToken"`)
+	assert.Contains(t, byPath["src/pair.ts"], `from "../generated/MyTokenPair/UniswapV2Pair"`)
+	assert.Contains(t, byPath["package.json"], `"name": "my-token-subgraph"`)
+}
+
+func TestGenerateSubgraphWithoutPair(t *testing.T) {
+	files, err := GenerateSubgraph(SubgraphArgs{
+		ContractName:    "Token",
+		ContractAddress: "0x1234567890123456789012345678901234567890",
+		Abi:             testSubgraphTokenAbi,
+		ChainID:         "31337",
+		Network:         "localhost",
+	})
+	require.NoError(t, err)
+
+	byPath := subgraphFilesByPath(files)
+	assert.NotContains(t, byPath, "src/pair.ts")
+	assert.Contains(t, byPath["subgraph.yaml"], "network: localhost")
+	assert.NotContains(t, byPath["subgraph.yaml"], "UniswapV2Pair")
+	assert.NotContains(t, byPath["schema.graphql"], "type Swap")
+}
+
+func TestGenerateSubgraphErrors(t *testing.T) {
+	_, err := GenerateSubgraph(SubgraphArgs{
+		ContractName: "Token",
+		Abi:          `[{"type":"function","name":"foo","inputs":[],"outputs":[]}]`,
+		ChainID:      "1",
+	})
+	assert.ErrorContains(t, err, "Transfer event")
+
+	_, err = GenerateSubgraph(SubgraphArgs{
+		ContractName: "Token",
+		Abi:          testSubgraphTokenAbi,
+		ChainID:      "31337",
+	})
+	assert.ErrorContains(t, err, "unknown Graph network")
+}
@@ -0,0 +1,248 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// DefaultMaxSwapHops is the maximum number of pools a swap route may go through
+const DefaultMaxSwapHops = 3
+
+// RoutePool is a Uniswap V2 pool with its current reserves used for route finding
+type RoutePool struct {
+	PairAddress string
+	TokenA      string
+	TokenB      string
+	ReserveA    *big.Int
+	ReserveB    *big.Int
+}
+
+// SwapRoute is a swap path through one or more pools
+type SwapRoute struct {
+	// Path is the list of token addresses passed to the router
+	Path []string `json:"path"`
+	// Pairs is the list of pair addresses the swap goes through
+	Pairs     []string `json:"pairs"`
+	AmountIn  *big.Int `json:"amount_in"`
+	AmountOut *big.Int `json:"amount_out"`
+	// PriceImpact is the percentage by which AmountOut is below the output at the current pool prices, fees excluded
+	PriceImpact float64 `json:"price_impact"`
+}
+
+// GetAmountOut returns the output amount of a Uniswap V2 swap including the 0.3% fee
+func GetAmountOut(amountIn, reserveIn, reserveOut *big.Int) *big.Int {
+	if amountIn.Sign() <= 0 || reserveIn.Sign() <= 0 || reserveOut.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+
+	amountInWithFee := new(big.Int).Mul(amountIn, big.NewInt(997))
+	numerator := new(big.Int).Mul(amountInWithFee, reserveOut)
+	denominator := new(big.Int).Add(new(big.Int).Mul(reserveIn, big.NewInt(1000)), amountInWithFee)
+	return numerator.Div(numerator, denominator)
+}
+
+// GetAmountIn returns the input amount a Uniswap V2 swap needs to return amountOut including the 0.3% fee.
+// It returns nil when the pool cannot return amountOut.
+func GetAmountIn(amountOut, reserveIn, reserveOut *big.Int) *big.Int {
+	if amountOut.Sign() <= 0 || reserveIn.Sign() <= 0 || reserveOut.Cmp(amountOut) <= 0 {
+		return nil
+	}
+
+	numerator := new(big.Int).Mul(new(big.Int).Mul(reserveIn, amountOut), big.NewInt(1000))
+	denominator := new(big.Int).Mul(new(big.Int).Sub(reserveOut, amountOut), big.NewInt(997))
+	amountIn := numerator.Div(numerator, denominator)
+	return amountIn.Add(amountIn, big.NewInt(1))
+}
+
+// IsLimitPriceReached reports whether swapping amountIn returns at least targetPrice output tokens per input token
+func IsLimitPriceReached(amountIn, reserveIn, reserveOut *big.Int, targetPrice float64) bool {
+	amountOut := GetAmountOut(amountIn, reserveIn, reserveOut)
+	if amountOut.Sign() <= 0 {
+		return false
+	}
+
+	price := new(big.Float).Quo(new(big.Float).SetInt(amountOut), new(big.Float).SetInt(amountIn))
+	return price.Cmp(big.NewFloat(targetPrice)) >= 0
+}
+
+// SortPairReserves maps the reserves of a Uniswap V2 pair to tokenA and tokenB.
+// The pair sorts its tokens by address, reserve0 belongs to the lower address.
+func SortPairReserves(tokenA, tokenB string, reserve0, reserve1 *big.Int) (reserveA, reserveB *big.Int) {
+	if strings.ToLower(tokenA) > strings.ToLower(tokenB) {
+		return reserve1, reserve0
+	}
+	return reserve0, reserve1
+}
+
+// FindBestSwapRoute evaluates direct pairs and multi-hop paths up to maxHops pools
+// and returns the route with the highest output amount.
+// Token addresses are compared case-insensitively.
+func FindBestSwapRoute(pools []RoutePool, fromToken, toToken string, amountIn *big.Int, maxHops int) (*SwapRoute, error) {
+	if amountIn == nil || amountIn.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be greater than 0")
+	}
+	if maxHops <= 0 {
+		maxHops = DefaultMaxSwapHops
+	}
+
+	// adjacency list keyed by lowercase token address
+	edges := map[string][]RoutePool{}
+	for _, pool := range pools {
+		if pool.ReserveA == nil || pool.ReserveB == nil {
+			continue
+		}
+		a, b := strings.ToLower(pool.TokenA), strings.ToLower(pool.TokenB)
+		edges[a] = append(edges[a], pool)
+		edges[b] = append(edges[b], pool)
+	}
+
+	var best *SwapRoute
+	from, to := strings.ToLower(fromToken), strings.ToLower(toToken)
+	visited := map[string]bool{from: true}
+
+	// spot is the output at the pool mid prices with the fee of every hop applied
+	var search func(token string, amount *big.Int, spot *big.Float, path, pairs []string)
+	search = func(token string, amount *big.Int, spot *big.Float, path, pairs []string) {
+		if token == to {
+			if best == nil || amount.Cmp(best.AmountOut) > 0 {
+				best = &SwapRoute{
+					Path:        append([]string{}, path...),
+					Pairs:       append([]string{}, pairs...),
+					AmountIn:    new(big.Int).Set(amountIn),
+					AmountOut:   new(big.Int).Set(amount),
+					PriceImpact: priceImpact(amount, spot),
+				}
+			}
+			return
+		}
+		if len(pairs) >= maxHops {
+			return
+		}
+
+		for _, pool := range edges[token] {
+			next, nextAddress, reserveIn, reserveOut := strings.ToLower(pool.TokenB), pool.TokenB, pool.ReserveA, pool.ReserveB
+			if strings.ToLower(pool.TokenB) == token {
+				next, nextAddress, reserveIn, reserveOut = strings.ToLower(pool.TokenA), pool.TokenA, pool.ReserveB, pool.ReserveA
+			}
+			if visited[next] {
+				continue
+			}
+
+			amountOut := GetAmountOut(amount, reserveIn, reserveOut)
+			if amountOut.Sign() <= 0 {
+				continue
+			}
+
+			nextSpot := new(big.Float).Mul(spot, new(big.Float).Quo(new(big.Float).SetInt(reserveOut), new(big.Float).SetInt(reserveIn)))
+			nextSpot.Mul(nextSpot, big.NewFloat(0.997))
+
+			visited[next] = true
+			search(next, amountOut, nextSpot, append(path, nextAddress), append(pairs, pool.PairAddress))
+			visited[next] = false
+		}
+	}
+	search(from, amountIn, new(big.Float).SetInt(amountIn), []string{fromToken}, []string{})
+
+	if best == nil {
+		return nil, fmt.Errorf("no route found from %s to %s", fromToken, toToken)
+	}
+	return best, nil
+}
+
+// FindBestSwapRouteExactOutput evaluates direct pairs and multi-hop paths up to maxHops pools
+// and returns the route needing the lowest input amount to return exactly amountOut.
+// Token addresses are compared case-insensitively.
+func FindBestSwapRouteExactOutput(pools []RoutePool, fromToken, toToken string, amountOut *big.Int, maxHops int) (*SwapRoute, error) {
+	if amountOut == nil || amountOut.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be greater than 0")
+	}
+	if maxHops <= 0 {
+		maxHops = DefaultMaxSwapHops
+	}
+
+	// adjacency list keyed by lowercase token address
+	edges := map[string][]RoutePool{}
+	for _, pool := range pools {
+		if pool.ReserveA == nil || pool.ReserveB == nil {
+			continue
+		}
+		a, b := strings.ToLower(pool.TokenA), strings.ToLower(pool.TokenB)
+		edges[a] = append(edges[a], pool)
+		edges[b] = append(edges[b], pool)
+	}
+
+	var best *SwapRoute
+	from, to := strings.ToLower(fromToken), strings.ToLower(toToken)
+	visited := map[string]bool{to: true}
+
+	// The search walks from the output token back to the input token.
+	// rate is the output per input at the pool mid prices with the fee of every hop applied.
+	var search func(token string, amount *big.Int, rate *big.Float, path, pairs []string)
+	search = func(token string, amount *big.Int, rate *big.Float, path, pairs []string) {
+		if token == from {
+			if best == nil || amount.Cmp(best.AmountIn) < 0 {
+				route := &SwapRoute{
+					Path:      make([]string, 0, len(path)),
+					Pairs:     make([]string, 0, len(pairs)),
+					AmountIn:  new(big.Int).Set(amount),
+					AmountOut: new(big.Int).Set(amountOut),
+				}
+				for i := len(path) - 1; i >= 0; i-- {
+					route.Path = append(route.Path, path[i])
+				}
+				for i := len(pairs) - 1; i >= 0; i-- {
+					route.Pairs = append(route.Pairs, pairs[i])
+				}
+				// the impact compares the output with the output of amount at the mid prices
+				route.PriceImpact = priceImpact(amountOut, new(big.Float).Mul(new(big.Float).SetInt(amount), rate))
+				best = route
+			}
+			return
+		}
+		if len(pairs) >= maxHops {
+			return
+		}
+
+		for _, pool := range edges[token] {
+			previous, previousAddress, reserveIn, reserveOut := strings.ToLower(pool.TokenB), pool.TokenB, pool.ReserveB, pool.ReserveA
+			if strings.ToLower(pool.TokenB) == token {
+				previous, previousAddress, reserveIn, reserveOut = strings.ToLower(pool.TokenA), pool.TokenA, pool.ReserveA, pool.ReserveB
+			}
+			if visited[previous] {
+				continue
+			}
+
+			amountIn := GetAmountIn(amount, reserveIn, reserveOut)
+			if amountIn == nil {
+				continue
+			}
+
+			nextRate := new(big.Float).Mul(rate, new(big.Float).Quo(new(big.Float).SetInt(reserveOut), new(big.Float).SetInt(reserveIn)))
+			nextRate.Mul(nextRate, big.NewFloat(0.997))
+
+			visited[previous] = true
+			search(previous, amountIn, nextRate, append(path, previousAddress), append(pairs, pool.PairAddress))
+			visited[previous] = false
+		}
+	}
+	search(to, amountOut, big.NewFloat(1), []string{toToken}, []string{})
+
+	if best == nil {
+		return nil, fmt.Errorf("no route found from %s to %s", fromToken, toToken)
+	}
+	return best, nil
+}
+
+// priceImpact returns the percentage by which amountOut is below the spot output
+func priceImpact(amountOut *big.Int, spot *big.Float) float64 {
+	if spot.Sign() <= 0 {
+		return 0
+	}
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(amountOut), spot).Float64()
+	impact := (1 - ratio) * 100
+	if impact < 0 {
+		return 0
+	}
+	return impact
+}
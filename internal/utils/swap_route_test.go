@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	routeTokenA = "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+	routeTokenB = "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"
+	routeWETH   = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+)
+
+func ether(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e18))
+}
+
+func TestGetAmountOut(t *testing.T) {
+	// 1000 * 997 * 1e6 / (1e6 * 1000 + 1000 * 997) = 996.00...
+	amountOut := GetAmountOut(big.NewInt(1000), big.NewInt(1_000_000), big.NewInt(1_000_000))
+	assert.Equal(t, big.NewInt(996), amountOut)
+
+	assert.Equal(t, int64(0), GetAmountOut(big.NewInt(1000), big.NewInt(0), big.NewInt(1_000_000)).Int64())
+}
+
+func TestFindBestSwapRoutePrefersDeeperMultiHop(t *testing.T) {
+	pools := []RoutePool{
+		// Shallow direct pool
+		{PairAddress: "0x01", TokenA: routeTokenA, TokenB: routeTokenB, ReserveA: ether(10), ReserveB: ether(10)},
+		// Deep pools through WETH
+		{PairAddress: "0x02", TokenA: routeTokenA, TokenB: routeWETH, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+		{PairAddress: "0x03", TokenA: routeWETH, TokenB: routeTokenB, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+	}
+
+	route, err := FindBestSwapRoute(pools, routeTokenA, routeTokenB, ether(5), DefaultMaxSwapHops)
+	require.NoError(t, err)
+	assert.Equal(t, []string{routeTokenA, routeWETH, routeTokenB}, route.Path)
+	assert.Equal(t, []string{"0x02", "0x03"}, route.Pairs)
+}
+
+func TestFindBestSwapRoutePrefersDirectPair(t *testing.T) {
+	pools := []RoutePool{
+		{PairAddress: "0x01", TokenA: routeTokenA, TokenB: routeTokenB, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+		{PairAddress: "0x02", TokenA: routeTokenA, TokenB: routeWETH, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+		{PairAddress: "0x03", TokenA: routeWETH, TokenB: routeTokenB, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+	}
+
+	// addresses are matched case-insensitively
+	route, err := FindBestSwapRoute(pools, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", routeTokenB, ether(1), DefaultMaxSwapHops)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", routeTokenB}, route.Path)
+	assert.Equal(t, GetAmountOut(ether(1), ether(10_000), ether(10_000)), route.AmountOut)
+}
+
+func TestFindBestSwapRoutePriceImpact(t *testing.T) {
+	pools := []RoutePool{
+		{PairAddress: "0x01", TokenA: routeTokenA, TokenB: routeTokenB, ReserveA: ether(100), ReserveB: ether(100)},
+	}
+
+	// Swapping 1% of the reserve moves the price by about 1%, the fee is not part of the impact
+	route, err := FindBestSwapRoute(pools, routeTokenA, routeTokenB, ether(1), DefaultMaxSwapHops)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.99, route.PriceImpact, 0.01)
+
+	route, err = FindBestSwapRoute(pools, routeTokenA, routeTokenB, ether(50), DefaultMaxSwapHops)
+	require.NoError(t, err)
+	assert.InDelta(t, 33.3, route.PriceImpact, 0.1)
+}
+
+func TestFindBestSwapRouteRespectsMaxHops(t *testing.T) {
+	pools := []RoutePool{
+		{PairAddress: "0x02", TokenA: routeTokenA, TokenB: routeWETH, ReserveA: ether(100), ReserveB: ether(100)},
+		{PairAddress: "0x03", TokenA: routeWETH, TokenB: routeTokenB, ReserveA: ether(100), ReserveB: ether(100)},
+	}
+
+	_, err := FindBestSwapRoute(pools, routeTokenA, routeTokenB, ether(1), 1)
+	assert.ErrorContains(t, err, "no route found")
+
+	_, err = FindBestSwapRoute(pools, routeTokenA, routeTokenB, big.NewInt(0), DefaultMaxSwapHops)
+	assert.Error(t, err)
+}
+
+func TestGetAmountIn(t *testing.T) {
+	// The input needed for an output is at least the input returning that output
+	amountIn := GetAmountIn(big.NewInt(996), big.NewInt(1_000_000), big.NewInt(1_000_000))
+	assert.Equal(t, big.NewInt(1000), amountIn)
+	assert.Equal(t, big.NewInt(996), GetAmountOut(amountIn, big.NewInt(1_000_000), big.NewInt(1_000_000)))
+
+	// The pool cannot return its whole reserve
+	assert.Nil(t, GetAmountIn(big.NewInt(1_000_000), big.NewInt(1_000_000), big.NewInt(1_000_000)))
+}
+
+func TestFindBestSwapRouteExactOutput(t *testing.T) {
+	pools := []RoutePool{
+		// Shallow direct pool
+		{PairAddress: "0x01", TokenA: routeTokenA, TokenB: routeTokenB, ReserveA: ether(10), ReserveB: ether(10)},
+		// Deep pools through WETH
+		{PairAddress: "0x02", TokenA: routeTokenA, TokenB: routeWETH, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+		{PairAddress: "0x03", TokenA: routeWETH, TokenB: routeTokenB, ReserveA: ether(10_000), ReserveB: ether(10_000)},
+	}
+
+	route, err := FindBestSwapRouteExactOutput(pools, routeTokenA, routeTokenB, ether(5), DefaultMaxSwapHops)
+	require.NoError(t, err)
+	assert.Equal(t, []string{routeTokenA, routeWETH, routeTokenB}, route.Path)
+	assert.Equal(t, []string{"0x02", "0x03"}, route.Pairs)
+	assert.Equal(t, ether(5), route.AmountOut)
+
+	// Swapping the input along the path returns at least the requested output
+	middle := GetAmountOut(route.AmountIn, ether(10_000), ether(10_000))
+	assert.GreaterOrEqual(t, GetAmountOut(middle, ether(10_000), ether(10_000)).Cmp(ether(5)), 0)
+
+	// The direct pool cannot return more than its reserve
+	_, err = FindBestSwapRouteExactOutput(pools[:1], routeTokenA, routeTokenB, ether(10), DefaultMaxSwapHops)
+	assert.ErrorContains(t, err, "no route found")
+}
+
+func TestFindBestSwapRouteExactOutputPriceImpact(t *testing.T) {
+	pools := []RoutePool{
+		{PairAddress: "0x01", TokenA: routeTokenA, TokenB: routeTokenB, ReserveA: ether(100), ReserveB: ether(100)},
+	}
+
+	// Buying 1% of the reserve moves the price by about 1%, the fee is not part of the impact
+	route, err := FindBestSwapRouteExactOutput(pools, routeTokenA, routeTokenB, ether(1), DefaultMaxSwapHops)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, route.PriceImpact, 0.02)
+}
+
+func TestSortPairReserves(t *testing.T) {
+	reserveA, reserveB := SortPairReserves(routeTokenB, routeTokenA, big.NewInt(1), big.NewInt(2))
+	assert.Equal(t, big.NewInt(2), reserveA)
+	assert.Equal(t, big.NewInt(1), reserveB)
+
+	reserveA, reserveB = SortPairReserves(routeTokenA, routeTokenB, big.NewInt(1), big.NewInt(2))
+	assert.Equal(t, big.NewInt(1), reserveA)
+	assert.Equal(t, big.NewInt(2), reserveB)
+}
+
+func TestIsLimitPriceReached(t *testing.T) {
+	// 1 token in a 1:2 pool returns slightly less than 2 after the fee
+	assert.True(t, IsLimitPriceReached(ether(1), ether(10_000), ether(20_000), 1.99))
+	assert.False(t, IsLimitPriceReached(ether(1), ether(10_000), ether(20_000), 2))
+	assert.False(t, IsLimitPriceReached(ether(1), big.NewInt(0), ether(20_000), 0.1))
+}
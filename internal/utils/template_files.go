@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// TemplateMainFile is the source name of the main contract of a template, files are imported relative to it
+	TemplateMainFile = "contract.sol"
+	// MaxTemplateFiles bounds the libraries and interfaces of a template
+	MaxTemplateFiles = 32
+)
+
+// ValidateTemplateFiles checks that every file of a multi-file template is a local .sol path,
+// e.g. lib/Math.sol imported by the main contract with import "./lib/Math.sol"
+func ValidateTemplateFiles(files models.TemplateFiles) error {
+	if len(files) > MaxTemplateFiles {
+		return fmt.Errorf("a template has at most %d files, got %d", MaxTemplateFiles, len(files))
+	}
+	for name, content := range files {
+		if !filepath.IsLocal(name) || path.Clean(name) != name || strings.Contains(name, `\`) {
+			return fmt.Errorf("template file %q must be a clean relative path, e.g. lib/Math.sol", name)
+		}
+		if path.Ext(name) != ".sol" {
+			return fmt.Errorf("template file %s must be a .sol file", name)
+		}
+		if name == TemplateMainFile {
+			return fmt.Errorf("template file %s is reserved for the template code", TemplateMainFile)
+		}
+		if strings.TrimSpace(content) == "" {
+			return fmt.Errorf("template file %s is empty", name)
+		}
+	}
+	return nil
+}
+
+// RenderTemplateFiles renders every file of a multi-file template with the values of the main contract
+func RenderTemplateFiles(files models.TemplateFiles, values models.JSON) (map[string]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	rendered := make(map[string]string, len(files))
+	for name, content := range files {
+		code, err := RenderContractTemplate(content, values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", name, err)
+		}
+		rendered[name] = code
+	}
+	return rendered, nil
+}
+
+// ValidateTemplateFilesKeys applies ValidateTemplateKeys to the template code and every file
+func ValidateTemplateFilesKeys(templateCode string, files models.TemplateFiles, metadata models.JSON) error {
+	if err := ValidateTemplateKeys(templateCode, metadata); err != nil {
+		return err
+	}
+	for name, content := range files {
+		if err := ValidateTemplateKeys(content, metadata); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTemplateFiles(t *testing.T) {
+	assert.NoError(t, ValidateTemplateFiles(nil))
+	assert.NoError(t, ValidateTemplateFiles(models.TemplateFiles{"lib/Math.sol": "library Math {}", "IToken.sol": "interface IToken {}"}))
+
+	tests := []struct {
+		name  string
+		files models.TemplateFiles
+	}{
+		{"absolute path", models.TemplateFiles{"/lib/Math.sol": "library Math {}"}},
+		{"parent directory", models.TemplateFiles{"../Math.sol": "library Math {}"}},
+		{"unclean path", models.TemplateFiles{"./lib/Math.sol": "library Math {}"}},
+		{"not solidity", models.TemplateFiles{"lib/Math.rs": "library Math {}"}},
+		{"main contract", models.TemplateFiles{TemplateMainFile: "contract Other {}"}},
+		{"empty file", models.TemplateFiles{"lib/Math.sol": " "}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, ValidateTemplateFiles(tt.files))
+		})
+	}
+}
+
+func TestRenderTemplateFiles(t *testing.T) {
+	rendered, err := RenderTemplateFiles(models.TemplateFiles{"lib/Supply.sol": "library Supply { uint256 constant INITIAL = {{toWei .Supply}}; }"}, models.JSON{"Supply": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"lib/Supply.sol": "library Supply { uint256 constant INITIAL = 2000000000000000000; }"}, rendered)
+
+	rendered, err = RenderTemplateFiles(nil, models.JSON{})
+	require.NoError(t, err)
+	assert.Nil(t, rendered)
+
+	_, err = RenderTemplateFiles(models.TemplateFiles{"lib/Supply.sol": "{{.Supply"}, models.JSON{})
+	assert.ErrorContains(t, err, "lib/Supply.sol")
+}
+
+func TestValidateTemplateFilesKeys(t *testing.T) {
+	files := models.TemplateFiles{"lib/Supply.sol": "library Supply { uint256 constant INITIAL = {{toWei .Supply}}; }"}
+
+	assert.NoError(t, ValidateTemplateFilesKeys("contract {{.TokenName}} {}", files, models.JSON{"TokenName": "", "Supply": ""}))
+	assert.ErrorContains(t, ValidateTemplateFilesKeys("contract {{.TokenName}} {}", files, models.JSON{"TokenName": ""}), "lib/Supply.sol")
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// defaultTokenDecimals are the decimals of toWei without a decimals argument
+const defaultTokenDecimals = 18
+
+// ContractTemplateFuncs returns the helper functions available to contract templates:
+//
+//	toWei amount [decimals]  converts a decimal amount to its integer base units, 18 decimals by default
+//	checksumAddress address  returns the EIP-55 checksum form of an address
+//	now                      returns the current unix timestamp in seconds
+//	randomSalt               returns a random bytes32 hex value
+//	upper, lower             change the case of a value
+func ContractTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"toWei":           toWei,
+		"checksumAddress": checksumAddress,
+		"now":             func() int64 { return time.Now().Unix() },
+		"randomSalt":      randomSalt,
+		"upper":           func(value any) string { return strings.ToUpper(fmt.Sprint(value)) },
+		"lower":           func(value any) string { return strings.ToLower(fmt.Sprint(value)) },
+	}
+}
+
+func toWei(amount any, decimals ...any) (string, error) {
+	places := defaultTokenDecimals
+	if len(decimals) > 1 {
+		return "", fmt.Errorf("toWei takes at most one decimals argument")
+	}
+	if len(decimals) == 1 {
+		parsed, err := strconv.Atoi(templateNumberString(decimals[0]))
+		if err != nil || parsed < 0 || parsed > 77 {
+			return "", fmt.Errorf("toWei decimals must be an integer between 0 and 77, got %v", decimals[0])
+		}
+		places = parsed
+	}
+
+	value := strings.TrimSpace(templateNumberString(amount))
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" {
+		whole = "0"
+	}
+	if len(fraction) > places {
+		return "", fmt.Errorf("toWei amount %s has more than %d decimals", value, places)
+	}
+	digits := whole + fraction + strings.Repeat("0", places-len(fraction))
+	wei, ok := new(big.Int).SetString(digits, 10)
+	if !ok || wei.Sign() < 0 || strings.ContainsAny(digits, "+-") {
+		return "", fmt.Errorf("toWei amount must be a non-negative decimal number, got %v", amount)
+	}
+	return wei.String(), nil
+}
+
+// templateNumberString formats JSON numbers without an exponent so large amounts are converted exactly
+func templateNumberString(value any) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func checksumAddress(address any) (string, error) {
+	value := fmt.Sprint(address)
+	if !common.IsHexAddress(value) {
+		return "", fmt.Errorf("checksumAddress got an invalid address %s", value)
+	}
+	return common.HexToAddress(value).Hex(), nil
+}
+
+func randomSalt() (string, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return "0x" + hex.EncodeToString(salt), nil
+}
+
+// TemplateKeys returns the sorted template values referenced by the template code, the fields of the
+// top level dot or of $. Fields inside range and with blocks are relative to their element and not reported
+func TemplateKeys(templateCode string) ([]string, error) {
+	tmpl, err := template.New("contract").Funcs(ContractTemplateFuncs()).Parse(templateCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	keys := map[string]bool{}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			collectTemplateKeys(t.Tree.Root, true, keys)
+		}
+	}
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	slices.Sort(result)
+	return result, nil
+}
+
+func collectTemplateKeys(node parse.Node, topLevel bool, keys map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateKeys(child, topLevel, keys)
+		}
+	case *parse.ActionNode:
+		collectTemplateKeys(n.Pipe, topLevel, keys)
+	case *parse.IfNode:
+		collectTemplateKeys(n.Pipe, topLevel, keys)
+		collectTemplateKeys(n.List, topLevel, keys)
+		collectTemplateKeys(n.ElseList, topLevel, keys)
+	case *parse.RangeNode:
+		collectTemplateKeys(n.Pipe, topLevel, keys)
+		collectTemplateKeys(n.List, false, keys)
+		collectTemplateKeys(n.ElseList, topLevel, keys)
+	case *parse.WithNode:
+		collectTemplateKeys(n.Pipe, topLevel, keys)
+		collectTemplateKeys(n.List, false, keys)
+		collectTemplateKeys(n.ElseList, topLevel, keys)
+	case *parse.TemplateNode:
+		collectTemplateKeys(n.Pipe, topLevel, keys)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectTemplateKeys(cmd, topLevel, keys)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectTemplateKeys(arg, topLevel, keys)
+		}
+	case *parse.ChainNode:
+		collectTemplateKeys(n.Node, topLevel, keys)
+	case *parse.FieldNode:
+		if topLevel {
+			keys[n.Ident[0]] = true
+		}
+	case *parse.VariableNode:
+		if n.Ident[0] == "$" && len(n.Ident) > 1 {
+			keys[n.Ident[1]] = true
+		}
+	}
+}
+
+// ValidateTemplateKeys checks that every template value referenced by the template code is a metadata parameter
+func ValidateTemplateKeys(templateCode string, metadata models.JSON) error {
+	keys, err := TemplateKeys(templateCode)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, key := range keys {
+		if _, ok := metadata[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the template references %s which are not defined in the metadata", strings.Join(missing, ", "))
+	}
+	return nil
+}
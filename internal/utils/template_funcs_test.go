@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderContractTemplateFuncs(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		values   models.JSON
+		expected string
+	}{
+		{"toWei default decimals", "{{toWei .Supply}}", models.JSON{"Supply": "1.5"}, "1500000000000000000"},
+		{"toWei json number", "{{toWei .Supply}}", models.JSON{"Supply": float64(1000000)}, "1000000000000000000000000"},
+		{"toWei decimals", "{{toWei .Supply .Decimals}}", models.JSON{"Supply": "2.25", "Decimals": float64(6)}, "2250000"},
+		{"checksumAddress", "{{checksumAddress .Owner}}", models.JSON{"Owner": "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"}, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{"upper and lower", "{{upper .Symbol}} {{lower .Symbol}}", models.JSON{"Symbol": "Mtk"}, "MTK mtk"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := RenderContractTemplate(tt.code, tt.values)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+
+	salt, err := RenderContractTemplate("{{randomSalt}}", nil)
+	require.NoError(t, err)
+	assert.Len(t, salt, 66)
+	assert.True(t, strings.HasPrefix(salt, "0x"))
+
+	now, err := RenderContractTemplate("{{now}}", nil)
+	require.NoError(t, err)
+	assert.Len(t, now, 10)
+}
+
+func TestRenderContractTemplateFuncErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		values models.JSON
+	}{
+		{"toWei too many decimals", "{{toWei .Supply 2}}", models.JSON{"Supply": "1.005"}},
+		{"toWei negative", "{{toWei .Supply}}", models.JSON{"Supply": "-1"}},
+		{"toWei not a number", "{{toWei .Supply}}", models.JSON{"Supply": "ten"}},
+		{"invalid address", "{{checksumAddress .Owner}}", models.JSON{"Owner": "0x1234"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RenderContractTemplate(tt.code, tt.values)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestValidateTemplateKeys(t *testing.T) {
+	code := `contract {{.TokenName}} {
+	uint256 supply = {{toWei $.Supply}};
+	{{if .Mintable}}bool mintable = true;{{end}}
+	{{range .Holders}}address holder = {{.Address}};{{end}}
+}`
+
+	keys, err := TemplateKeys(code)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Holders", "Mintable", "Supply", "TokenName"}, keys)
+
+	metadata := models.JSON{"TokenName": "", "Supply": "", "Mintable": "", "Holders": ""}
+	assert.NoError(t, ValidateTemplateKeys(code, metadata))
+
+	delete(metadata, "Supply")
+	delete(metadata, "Holders")
+	err = ValidateTemplateKeys(code, metadata)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Holders, Supply")
+
+	_, err = TemplateKeys("{{.TokenName")
+	assert.Error(t, err)
+}
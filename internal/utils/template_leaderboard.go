@@ -0,0 +1,172 @@
+package utils
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// MinProvenLaunches is the number of confirmed launches after which a template without failed sell tests is proven
+const MinProvenLaunches = 3
+
+const (
+	TemplateUsageUntested = "untested"
+	TemplateUsageTested   = "tested"
+	TemplateUsageProven   = "proven"
+)
+
+// TemplateLaunchPool is the pool of a token launched from a template with its reserve history
+type TemplateLaunchPool struct {
+	TemplateID uint
+	// TokenIsToken0 is true when the launched token sorts before the paired token in the pair
+	TokenIsToken0 bool
+	// Snapshots are the pool snapshots in chronological order
+	Snapshots []models.PoolSnapshot
+}
+
+// TemplateLeaderboardEntry is the launch history of a template
+type TemplateLeaderboardEntry struct {
+	TemplateID        uint    `json:"template_id"`
+	TemplateName      string  `json:"template_name"`
+	ChainType         string  `json:"chain_type"`
+	Usage             string  `json:"usage"`
+	Launches          int     `json:"launches"`
+	ConfirmedLaunches int     `json:"confirmed_launches"`
+	FailedLaunches    int     `json:"failed_launches"`
+	SuccessRate       float64 `json:"success_rate"`
+	// AverageGasUsed and AverageGasCost (in wei) are averaged over the launches with a recorded receipt
+	AverageGasUsed uint64 `json:"average_gas_used,omitempty"`
+	AverageGasCost string `json:"average_gas_cost,omitempty"`
+	Pools          int    `json:"pools"`
+	// AveragePriceChangePercent is the average change of the token price between the first and the latest pool snapshot
+	AveragePriceChangePercent *float64 `json:"average_price_change_percent,omitempty"`
+	SellTestsPassed           int      `json:"sell_tests_passed"`
+	SellTestsFailed           int      `json:"sell_tests_failed"`
+}
+
+// BuildTemplateLeaderboard aggregates the deployments and pools of every template and ranks proven templates first,
+// then by success rate and number of confirmed launches
+func BuildTemplateLeaderboard(templates []models.Template, deployments []models.Deployment, pools []TemplateLaunchPool) []TemplateLeaderboardEntry {
+	entries := make(map[uint]*TemplateLeaderboardEntry, len(templates))
+	order := make([]uint, 0, len(templates))
+	for _, template := range templates {
+		entries[template.ID] = &TemplateLeaderboardEntry{
+			TemplateID:   template.ID,
+			TemplateName: template.Name,
+			ChainType:    string(template.ChainType),
+		}
+		order = append(order, template.ID)
+	}
+
+	gasUsed := map[uint]uint64{}
+	gasCost := map[uint]*big.Int{}
+	gasLaunches := map[uint]int64{}
+	gasCostLaunches := map[uint]int64{}
+	for _, deployment := range deployments {
+		entry, ok := entries[deployment.TemplateID]
+		if !ok {
+			continue
+		}
+
+		entry.Launches++
+		switch deployment.Status {
+		case models.TransactionStatusConfirmed:
+			entry.ConfirmedLaunches++
+		case models.TransactionStatusFailed:
+			entry.FailedLaunches++
+		}
+
+		switch deployment.SellTestStatus {
+		case models.SellTestStatusPassed:
+			entry.SellTestsPassed++
+		case models.SellTestStatusFailed:
+			entry.SellTestsFailed++
+		}
+
+		if deployment.GasUsed > 0 {
+			gasUsed[deployment.TemplateID] += deployment.GasUsed
+			gasLaunches[deployment.TemplateID]++
+		}
+		if cost, ok := new(big.Int).SetString(deployment.GasCost, 10); ok {
+			if gasCost[deployment.TemplateID] == nil {
+				gasCost[deployment.TemplateID] = new(big.Int)
+			}
+			gasCost[deployment.TemplateID].Add(gasCost[deployment.TemplateID], cost)
+			gasCostLaunches[deployment.TemplateID]++
+		}
+	}
+
+	priceChanges := map[uint][]float64{}
+	for _, pool := range pools {
+		entry, ok := entries[pool.TemplateID]
+		if !ok {
+			continue
+		}
+		entry.Pools++
+		if change, ok := tokenPriceChangePercent(pool); ok {
+			priceChanges[pool.TemplateID] = append(priceChanges[pool.TemplateID], change)
+		}
+	}
+
+	leaderboard := make([]TemplateLeaderboardEntry, 0, len(order))
+	for _, id := range order {
+		entry := entries[id]
+		if finished := entry.ConfirmedLaunches + entry.FailedLaunches; finished > 0 {
+			entry.SuccessRate = float64(entry.ConfirmedLaunches) / float64(finished)
+		}
+		if gasLaunches[id] > 0 {
+			entry.AverageGasUsed = gasUsed[id] / uint64(gasLaunches[id])
+		}
+		if gasCostLaunches[id] > 0 {
+			entry.AverageGasCost = new(big.Int).Div(gasCost[id], big.NewInt(gasCostLaunches[id])).String()
+		}
+		if changes := priceChanges[id]; len(changes) > 0 {
+			var total float64
+			for _, change := range changes {
+				total += change
+			}
+			average := total / float64(len(changes))
+			entry.AveragePriceChangePercent = &average
+		}
+
+		entry.Usage = TemplateUsageUntested
+		if entry.ConfirmedLaunches > 0 {
+			entry.Usage = TemplateUsageTested
+		}
+		if entry.ConfirmedLaunches >= MinProvenLaunches && entry.SellTestsFailed == 0 {
+			entry.Usage = TemplateUsageProven
+		}
+		leaderboard = append(leaderboard, *entry)
+	}
+
+	rank := map[string]int{TemplateUsageProven: 0, TemplateUsageTested: 1, TemplateUsageUntested: 2}
+	sort.SliceStable(leaderboard, func(i, j int) bool {
+		a, b := leaderboard[i], leaderboard[j]
+		if rank[a.Usage] != rank[b.Usage] {
+			return rank[a.Usage] < rank[b.Usage]
+		}
+		if a.SuccessRate != b.SuccessRate {
+			return a.SuccessRate > b.SuccessRate
+		}
+		return a.ConfirmedLaunches > b.ConfirmedLaunches
+	})
+	return leaderboard
+}
+
+// tokenPriceChangePercent returns the change of the launched token price between the first and the latest snapshot
+func tokenPriceChangePercent(pool TemplateLaunchPool) (float64, bool) {
+	if len(pool.Snapshots) < 2 {
+		return 0, false
+	}
+	first, last := pool.Snapshots[0].Price, pool.Snapshots[len(pool.Snapshots)-1].Price
+	if first <= 0 || last <= 0 {
+		return 0, false
+	}
+
+	// Snapshot prices are token0 in token1, invert them when the launched token is token1
+	if !pool.TokenIsToken0 {
+		first, last = 1/first, 1/last
+	}
+	return (last/first - 1) * 100, true
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTemplateLeaderboard(t *testing.T) {
+	templates := []models.Template{
+		{ID: 1, Name: "Untested", ChainType: models.TransactionChainTypeEthereum},
+		{ID: 2, Name: "Tested", ChainType: models.TransactionChainTypeEthereum},
+		{ID: 3, Name: "Proven", ChainType: models.TransactionChainTypeEthereum},
+	}
+	confirmed := func(templateID uint, gasUsed uint64, gasCost string, sellTest models.SellTestStatus) models.Deployment {
+		return models.Deployment{TemplateID: templateID, Status: models.TransactionStatusConfirmed, GasUsed: gasUsed, GasCost: gasCost, SellTestStatus: sellTest}
+	}
+	deployments := []models.Deployment{
+		confirmed(2, 0, "", models.SellTestStatusFailed),
+		{TemplateID: 2, Status: models.TransactionStatusFailed},
+		confirmed(3, 1_000_000, "2000000000000000", models.SellTestStatusPassed),
+		confirmed(3, 2_000_000, "4000000000000000", models.SellTestStatusPassed),
+		confirmed(3, 0, "", ""),
+		{TemplateID: 3, Status: models.TransactionStatusPending},
+		// Deployments of templates that were deleted are left out
+		confirmed(4, 1, "1", ""),
+	}
+	pools := []TemplateLaunchPool{
+		// The token is token0 and its price doubled
+		{TemplateID: 3, TokenIsToken0: true, Snapshots: []models.PoolSnapshot{{Price: 1}, {Price: 2}}},
+		// The token is token1, its price went from 1/4 to 1/2 == price change of 100%
+		{TemplateID: 3, TokenIsToken0: false, Snapshots: []models.PoolSnapshot{{Price: 4}, {Price: 3}, {Price: 2}}},
+		// A single snapshot has no price history
+		{TemplateID: 2, TokenIsToken0: true, Snapshots: []models.PoolSnapshot{{Price: 1}}},
+	}
+
+	leaderboard := BuildTemplateLeaderboard(templates, deployments, pools)
+	require.Len(t, leaderboard, 3)
+
+	proven := leaderboard[0]
+	assert.Equal(t, "Proven", proven.TemplateName)
+	assert.Equal(t, TemplateUsageProven, proven.Usage)
+	assert.Equal(t, 4, proven.Launches)
+	assert.Equal(t, 3, proven.ConfirmedLaunches)
+	assert.Equal(t, 1.0, proven.SuccessRate)
+	assert.Equal(t, uint64(1_500_000), proven.AverageGasUsed)
+	assert.Equal(t, "3000000000000000", proven.AverageGasCost)
+	assert.Equal(t, 2, proven.Pools)
+	require.NotNil(t, proven.AveragePriceChangePercent)
+	assert.InDelta(t, 100, *proven.AveragePriceChangePercent, 1e-9)
+	assert.Equal(t, 2, proven.SellTestsPassed)
+
+	tested := leaderboard[1]
+	assert.Equal(t, TemplateUsageTested, tested.Usage)
+	assert.Equal(t, 0.5, tested.SuccessRate)
+	assert.Equal(t, 1, tested.SellTestsFailed)
+	assert.Equal(t, 1, tested.Pools)
+	assert.Nil(t, tested.AveragePriceChangePercent)
+	assert.Empty(t, tested.AverageGasCost)
+
+	untested := leaderboard[2]
+	assert.Equal(t, TemplateUsageUntested, untested.Usage)
+	assert.Zero(t, untested.Launches)
+}
+
+func TestBuildTemplateLeaderboardFailedSellTestIsNotProven(t *testing.T) {
+	templates := []models.Template{{ID: 1, Name: "Honeypot"}}
+	var deployments []models.Deployment
+	for i := 0; i < MinProvenLaunches; i++ {
+		deployments = append(deployments, models.Deployment{TemplateID: 1, Status: models.TransactionStatusConfirmed})
+	}
+	deployments[0].SellTestStatus = models.SellTestStatusFailed
+
+	leaderboard := BuildTemplateLeaderboard(templates, deployments, nil)
+	require.Len(t, leaderboard, 1)
+	assert.Equal(t, TemplateUsageTested, leaderboard[0].Usage)
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+// GasEstimateInfinite is the estimate of the compiler for code whose gas it cannot bound, like loops over storage
+const GasEstimateInfinite = "infinite"
+
+// NewTemplateReport returns the gas report of the contract of the compilation, nil when the contract is not part of it
+func NewTemplateReport(result CompilationResult, contractName, compilerVersion string) *models.TemplateReport {
+	if _, ok := result.Abi[contractName]; !ok {
+		return nil
+	}
+	estimates := result.GasEstimates[contractName]
+	return &models.TemplateReport{
+		ContractName:    contractName,
+		CompilerVersion: compilerVersion,
+		Creation:        estimates["creation"],
+		External:        estimates["external"],
+		Warnings:        result.Warnings,
+		GeneratedAt:     time.Now().UTC(),
+	}
+}
+
+// TemplateFunctionGas is the estimate of an external function
+type TemplateFunctionGas struct {
+	Signature string `json:"signature"`
+	Gas       string `json:"gas"`
+}
+
+// TemplateReportEntry is the gas report of a template, Available is false for templates without a report
+type TemplateReportEntry struct {
+	TemplateID   uint   `json:"template_id"`
+	TemplateName string `json:"template_name"`
+	ChainType    string `json:"chain_type"`
+	Available    bool   `json:"available"`
+	ContractName string `json:"contract_name,omitempty"`
+	// DeploymentGas is the total creation cost estimate, code deposit included
+	DeploymentGas   string                `json:"deployment_gas,omitempty"`
+	CodeDepositGas  string                `json:"code_deposit_gas,omitempty"`
+	Functions       []TemplateFunctionGas `json:"functions,omitempty"`
+	Warnings        []string              `json:"warnings,omitempty"`
+	CompilerVersion string                `json:"compiler_version,omitempty"`
+	GeneratedAt     *time.Time            `json:"generated_at,omitempty"`
+}
+
+// BuildTemplateReports returns the reports of the templates, the cheapest deployment first.
+// Unbounded estimates sort after the bounded ones and templates without a report come last
+func BuildTemplateReports(templates []models.Template) []TemplateReportEntry {
+	entries := make([]TemplateReportEntry, 0, len(templates))
+	for _, template := range templates {
+		entry := TemplateReportEntry{
+			TemplateID:   template.ID,
+			TemplateName: template.Name,
+			ChainType:    string(template.ChainType),
+		}
+		if report := template.Report; report != nil {
+			generatedAt := report.GeneratedAt
+			entry.Available = true
+			entry.ContractName = report.ContractName
+			entry.DeploymentGas = report.Creation["totalCost"]
+			entry.CodeDepositGas = report.Creation["codeDepositCost"]
+			entry.Warnings = report.Warnings
+			entry.CompilerVersion = report.CompilerVersion
+			entry.GeneratedAt = &generatedAt
+			for signature, gas := range report.External {
+				entry.Functions = append(entry.Functions, TemplateFunctionGas{Signature: signature, Gas: gas})
+			}
+			sort.Slice(entry.Functions, func(i, j int) bool {
+				return entry.Functions[i].Signature < entry.Functions[j].Signature
+			})
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		rankI, gasI := gasSortKey(entries[i])
+		rankJ, gasJ := gasSortKey(entries[j])
+		if rankI != rankJ {
+			return rankI < rankJ
+		}
+		return gasI < gasJ
+	})
+	return entries
+}
+
+// gasSortKey ranks the bounded deployment estimates first, then the unbounded ones, then the missing reports
+func gasSortKey(entry TemplateReportEntry) (int, uint64) {
+	if !entry.Available {
+		return 2, 0
+	}
+	gas, err := strconv.ParseUint(entry.DeploymentGas, 10, 64)
+	if err != nil {
+		return 1, 0
+	}
+	return 0, gas
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTemplateReport(t *testing.T) {
+	result := CompilationResult{
+		Abi: map[string]any{"Token": []any{}},
+		GasEstimates: map[string]map[string]map[string]string{
+			"Token": {
+				"creation": {"codeDepositCost": "400000", "executionCost": "infinite", "totalCost": "infinite"},
+				"external": {"transfer(address,uint256)": "51234"},
+			},
+		},
+		Warnings: []string{"Unused local variable."},
+	}
+
+	report := NewTemplateReport(result, "Token", "0.8.27")
+	require.NotNil(t, report)
+	assert.Equal(t, "Token", report.ContractName)
+	assert.Equal(t, "0.8.27", report.CompilerVersion)
+	assert.Equal(t, "infinite", report.Creation["totalCost"])
+	assert.Equal(t, "51234", report.External["transfer(address,uint256)"])
+	assert.Equal(t, []string{"Unused local variable."}, report.Warnings)
+	assert.False(t, report.GeneratedAt.IsZero())
+
+	assert.Nil(t, NewTemplateReport(result, "Missing", "0.8.27"))
+}
+
+func TestBuildTemplateReports(t *testing.T) {
+	templates := []models.Template{
+		{ID: 1, Name: "No report", ChainType: models.TransactionChainTypeEthereum},
+		{ID: 2, Name: "Expensive", ChainType: models.TransactionChainTypeEthereum, Report: &models.TemplateReport{
+			ContractName: "Expensive",
+			Creation:     map[string]string{"totalCost": "900000", "codeDepositCost": "800000"},
+			External:     map[string]string{"transfer(address,uint256)": "60000", "approve(address,uint256)": "45000"},
+		}},
+		{ID: 3, Name: "Unbounded", ChainType: models.TransactionChainTypeEthereum, Report: &models.TemplateReport{
+			Creation: map[string]string{"totalCost": GasEstimateInfinite},
+		}},
+		{ID: 4, Name: "Cheap", ChainType: models.TransactionChainTypeEthereum, Report: &models.TemplateReport{
+			Creation: map[string]string{"totalCost": "300000"},
+		}},
+	}
+
+	entries := BuildTemplateReports(templates)
+	require.Len(t, entries, 4)
+	assert.Equal(t, []uint{4, 2, 3, 1}, []uint{entries[0].TemplateID, entries[1].TemplateID, entries[2].TemplateID, entries[3].TemplateID})
+	assert.False(t, entries[3].Available)
+
+	expensive := entries[1]
+	assert.True(t, expensive.Available)
+	assert.Equal(t, "900000", expensive.DeploymentGas)
+	assert.Equal(t, "800000", expensive.CodeDepositGas)
+	assert.Equal(t, []TemplateFunctionGas{
+		{Signature: "approve(address,uint256)", Gas: "45000"},
+		{Signature: "transfer(address,uint256)", Gas: "60000"},
+	}, expensive.Functions)
+}
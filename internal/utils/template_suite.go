@@ -0,0 +1,484 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// TemplateSuiteMaxFuzzRuns bounds the random transfers of a template suite run
+const TemplateSuiteMaxFuzzRuns = 200
+
+// templateSuiteTxTimeout bounds the wait for a suite transaction to be mined
+const templateSuiteTxTimeout = 30 * time.Second
+
+const (
+	TemplateCheckPassed  = "passed"
+	TemplateCheckFailed  = "failed"
+	TemplateCheckSkipped = "skipped"
+)
+
+// TemplateSuiteRequest describes a run of the built-in token suite against a throwaway node
+type TemplateSuiteRequest struct {
+	RPC string
+	// DeployData is the contract bytecode followed by the encoded constructor arguments
+	DeployData string
+	ABI        abi.ABI
+	// PrivateKeys are funded accounts of the node, the first deploys and owns the contract, the next two are token holders
+	PrivateKeys []string
+	FuzzRuns    int
+	Seed        int64
+}
+
+// TemplateCheck is the outcome of a check of the suite
+type TemplateCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// TemplateSuiteReport is the outcome of a suite run, Passed is false when any check failed
+type TemplateSuiteReport struct {
+	Passed          bool            `json:"passed"`
+	ContractAddress string          `json:"contract_address,omitempty"`
+	DeploymentGas   uint64          `json:"deployment_gas,omitempty"`
+	Seed            int64           `json:"seed"`
+	FuzzRuns        int             `json:"fuzz_runs"`
+	Checks          []TemplateCheck `json:"checks"`
+}
+
+type templateSuite struct {
+	ctx      context.Context
+	client   *ethclient.Client
+	abi      abi.ABI
+	chainID  *big.Int
+	keys     []*ecdsa.PrivateKey
+	accounts []common.Address
+	contract common.Address
+	// untracked is the supply held outside of the suite accounts, transfers between them must not change it
+	untracked *big.Int
+}
+
+// RunTemplateSuite deploys the contract and runs the ownership, mint, transfer, approve and supply conservation
+// checks of an ERC20 token, followed by random transfers between the suite accounts checking the supply invariants.
+// Checks of functions the contract does not have are skipped. The error is only set when the node cannot be used
+func RunTemplateSuite(ctx context.Context, request TemplateSuiteRequest) (*TemplateSuiteReport, error) {
+	if len(request.PrivateKeys) < 3 {
+		return nil, fmt.Errorf("the suite needs 3 funded accounts, got %d", len(request.PrivateKeys))
+	}
+	client, err := ethclient.DialContext(ctx, request.RPC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", request.RPC, err)
+	}
+	defer client.Close()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	suite := &templateSuite{ctx: ctx, client: client, abi: request.ABI, chainID: chainID}
+	for _, hexKey := range request.PrivateKeys[:3] {
+		key, err := crypto.HexToECDSA(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		suite.keys = append(suite.keys, key)
+		suite.accounts = append(suite.accounts, crypto.PubkeyToAddress(key.PublicKey))
+	}
+
+	report := &TemplateSuiteReport{Seed: request.Seed, FuzzRuns: request.FuzzRuns}
+	add := func(name, status, detail string) {
+		report.Checks = append(report.Checks, TemplateCheck{Name: name, Status: status, Detail: detail})
+	}
+
+	receipt, err := suite.send(0, nil, common.FromHex(request.DeployData))
+	if err != nil {
+		add("deploy", TemplateCheckFailed, err.Error())
+		return report, nil
+	}
+	suite.contract = receipt.ContractAddress
+	report.ContractAddress = receipt.ContractAddress.Hex()
+	report.DeploymentGas = receipt.GasUsed
+	add("deploy", TemplateCheckPassed, fmt.Sprintf("deployed at %s using %d gas", report.ContractAddress, receipt.GasUsed))
+
+	checks := []struct {
+		name string
+		run  func() (string, string)
+	}{
+		{"ownership", suite.checkOwnership},
+		{"mint", suite.checkMint},
+		{"transfer", suite.checkTransfer},
+		{"transfer_exceeding_balance", suite.checkTransferExceedingBalance},
+		{"approve_transfer_from", suite.checkApproveTransferFrom},
+		{"fuzz_transfers", func() (string, string) { return suite.fuzzTransfers(request.FuzzRuns, request.Seed) }},
+	}
+	if !suite.has("totalSupply") || !suite.has("balanceOf", "address") || !suite.has("transfer", "address", "uint256") {
+		add("erc20_interface", TemplateCheckFailed, "the contract has no totalSupply, balanceOf and transfer functions of an ERC20 token")
+		for _, check := range checks {
+			add(check.name, TemplateCheckSkipped, "not an ERC20 token")
+		}
+		return report, nil
+	}
+	add("erc20_interface", TemplateCheckPassed, "")
+
+	if err := suite.snapshotUntracked(); err != nil {
+		return nil, err
+	}
+	for _, check := range checks {
+		status, detail := check.run()
+		add(check.name, status, detail)
+	}
+
+	report.Passed = true
+	for _, check := range report.Checks {
+		if check.Status == TemplateCheckFailed {
+			report.Passed = false
+		}
+	}
+	return report, nil
+}
+
+// has reports whether the contract has the function with the input types
+func (s *templateSuite) has(name string, inputs ...string) bool {
+	method, ok := s.abi.Methods[name]
+	if !ok || len(method.Inputs) != len(inputs) {
+		return false
+	}
+	for i, input := range method.Inputs {
+		if input.Type.String() != inputs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// call runs a view of the contract from the account
+func (s *templateSuite) call(from int, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := s.abi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	output, err := s.client.CallContract(s.ctx, ethereum.CallMsg{From: s.accounts[from], To: &s.contract, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.abi.Unpack(method, output)
+}
+
+// transact sends the contract call from the account and waits for it to be mined
+func (s *templateSuite) transact(from int, method string, args ...interface{}) error {
+	data, err := s.abi.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	_, err = s.send(from, &s.contract, data)
+	return err
+}
+
+// reverts reports whether the contract call from the account would revert
+func (s *templateSuite) reverts(from int, method string, args ...interface{}) bool {
+	data, err := s.abi.Pack(method, args...)
+	if err != nil {
+		return false
+	}
+	_, err = s.client.CallContract(s.ctx, ethereum.CallMsg{From: s.accounts[from], To: &s.contract, Data: data}, nil)
+	return err != nil
+}
+
+func (s *templateSuite) send(from int, to *common.Address, data []byte) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, templateSuiteTxTimeout)
+	defer cancel()
+
+	nonce, err := s.client.PendingNonceAt(ctx, s.accounts[from])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	gasPrice, err := s.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas price: %w", err)
+	}
+	gasLimit, err := s.client.EstimateGas(ctx, ethereum.CallMsg{From: s.accounts[from], To: to, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("transaction would revert: %w", err)
+	}
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: gasPrice, Gas: gasLimit * 12 / 10, To: to, Value: big.NewInt(0), Data: data})
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(s.chainID), s.keys[from])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	if err := s.client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
+	}
+	receipt, err := bind.WaitMined(ctx, s.client, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", signedTx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", signedTx.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+func (s *templateSuite) callUint(from int, method string, args ...interface{}) (*big.Int, error) {
+	values, err := s.call(from, method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", method, err)
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("%s returned %d values", method, len(values))
+	}
+	value, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("%s did not return a uint256", method)
+	}
+	return value, nil
+}
+
+func (s *templateSuite) totalSupply() (*big.Int, error) {
+	return s.callUint(0, "totalSupply")
+}
+
+func (s *templateSuite) balances() ([]*big.Int, error) {
+	balances := make([]*big.Int, len(s.accounts))
+	for i, account := range s.accounts {
+		balance, err := s.callUint(0, "balanceOf", account)
+		if err != nil {
+			return nil, err
+		}
+		balances[i] = balance
+	}
+	return balances, nil
+}
+
+func (s *templateSuite) snapshotUntracked() error {
+	supply, err := s.totalSupply()
+	if err != nil {
+		return err
+	}
+	balances, err := s.balances()
+	if err != nil {
+		return err
+	}
+	s.untracked = new(big.Int).Sub(supply, sumBalances(balances))
+	return nil
+}
+
+// checkSupply verifies that the total supply only changed by minted and that the supply held outside of the suite accounts did not move
+func (s *templateSuite) checkSupply(before, minted *big.Int) error {
+	supply, err := s.totalSupply()
+	if err != nil {
+		return err
+	}
+	if expected := new(big.Int).Add(before, minted); supply.Cmp(expected) != 0 {
+		return fmt.Errorf("total supply is %s, expected %s", supply, expected)
+	}
+	balances, err := s.balances()
+	if err != nil {
+		return err
+	}
+	if untracked := new(big.Int).Sub(supply, sumBalances(balances)); untracked.Cmp(s.untracked) != 0 {
+		return fmt.Errorf("supply outside of the suite accounts changed from %s to %s, transfers are not conserving the balances", s.untracked, untracked)
+	}
+	return nil
+}
+
+func (s *templateSuite) checkOwnership() (string, string) {
+	if !s.has("owner") {
+		return TemplateCheckSkipped, "the contract has no owner function"
+	}
+	values, err := s.call(0, "owner")
+	if err != nil || len(values) != 1 {
+		return TemplateCheckFailed, fmt.Sprintf("owner failed: %v", err)
+	}
+	if owner, _ := values[0].(common.Address); owner != s.accounts[0] {
+		return TemplateCheckFailed, fmt.Sprintf("owner is %s instead of the deployer %s", owner.Hex(), s.accounts[0].Hex())
+	}
+	if s.has("transferOwnership", "address") && !s.reverts(1, "transferOwnership", s.accounts[1]) {
+		return TemplateCheckFailed, "transferOwnership does not revert when called by an account that is not the owner"
+	}
+	return TemplateCheckPassed, "the deployer owns the contract and other accounts cannot take it over"
+}
+
+func (s *templateSuite) checkMint() (string, string) {
+	if !s.has("mint", "address", "uint256") {
+		return TemplateCheckSkipped, "the contract has no mint(address,uint256) function"
+	}
+	amount := big.NewInt(1e18)
+	if !s.reverts(1, "mint", s.accounts[1], amount) {
+		return TemplateCheckFailed, "mint does not revert when called by an account that is not the owner, anyone can inflate the supply"
+	}
+
+	supply, err := s.totalSupply()
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	before, err := s.callUint(0, "balanceOf", s.accounts[0])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if err := s.transact(0, "mint", s.accounts[0], amount); err != nil {
+		return TemplateCheckFailed, fmt.Sprintf("the owner cannot mint: %v", err)
+	}
+	after, err := s.callUint(0, "balanceOf", s.accounts[0])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if received := new(big.Int).Sub(after, before); received.Cmp(amount) != 0 {
+		return TemplateCheckFailed, fmt.Sprintf("minting %s credited %s", amount, received)
+	}
+	if err := s.checkSupply(supply, amount); err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	return TemplateCheckPassed, fmt.Sprintf("only the owner can mint, minting %s increased the total supply by the same amount", amount)
+}
+
+// transferAndCheck transfers from an account to another and verifies the balances moved by exactly the amount
+func (s *templateSuite) transferAndCheck(from, to int, amount *big.Int) error {
+	supply, err := s.totalSupply()
+	if err != nil {
+		return err
+	}
+	before, err := s.balances()
+	if err != nil {
+		return err
+	}
+	if err := s.transact(from, "transfer", s.accounts[to], amount); err != nil {
+		return fmt.Errorf("transfer of %s reverted: %w", amount, err)
+	}
+	after, err := s.balances()
+	if err != nil {
+		return err
+	}
+	if from != to {
+		if sent := new(big.Int).Sub(before[from], after[from]); sent.Cmp(amount) != 0 {
+			return fmt.Errorf("transferring %s debited %s from the sender", amount, sent)
+		}
+		if received := new(big.Int).Sub(after[to], before[to]); received.Cmp(amount) != 0 {
+			return fmt.Errorf("transferring %s credited %s, the token may take a fee on transfers", amount, received)
+		}
+	}
+	return s.checkSupply(supply, big.NewInt(0))
+}
+
+func (s *templateSuite) checkTransfer() (string, string) {
+	balance, err := s.callUint(0, "balanceOf", s.accounts[0])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if balance.Sign() == 0 {
+		return TemplateCheckFailed, "the deployer holds no tokens after the deployment"
+	}
+	amount := new(big.Int).Div(balance, big.NewInt(100))
+	if amount.Sign() == 0 {
+		amount = big.NewInt(1)
+	}
+	if err := s.transferAndCheck(0, 1, amount); err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	return TemplateCheckPassed, fmt.Sprintf("transferring %s moved exactly the amount and kept the total supply", amount)
+}
+
+func (s *templateSuite) checkTransferExceedingBalance() (string, string) {
+	balance, err := s.callUint(0, "balanceOf", s.accounts[1])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if !s.reverts(1, "transfer", s.accounts[2], new(big.Int).Add(balance, big.NewInt(1))) {
+		return TemplateCheckFailed, "transferring more than the balance does not revert"
+	}
+	return TemplateCheckPassed, "transferring more than the balance reverts"
+}
+
+func (s *templateSuite) checkApproveTransferFrom() (string, string) {
+	if !s.has("approve", "address", "uint256") || !s.has("allowance", "address", "address") || !s.has("transferFrom", "address", "address", "uint256") {
+		return TemplateCheckSkipped, "the contract has no approve, allowance and transferFrom functions"
+	}
+	balance, err := s.callUint(0, "balanceOf", s.accounts[0])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	amount := new(big.Int).Div(balance, big.NewInt(100))
+	if amount.Sign() == 0 {
+		return TemplateCheckSkipped, "the deployer holds too few tokens"
+	}
+
+	if err := s.transact(0, "approve", s.accounts[2], amount); err != nil {
+		return TemplateCheckFailed, fmt.Sprintf("approve reverted: %v", err)
+	}
+	allowance, err := s.callUint(0, "allowance", s.accounts[0], s.accounts[2])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if allowance.Cmp(amount) != 0 {
+		return TemplateCheckFailed, fmt.Sprintf("allowance is %s after approving %s", allowance, amount)
+	}
+
+	supply, err := s.totalSupply()
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if err := s.transact(2, "transferFrom", s.accounts[0], s.accounts[1], amount); err != nil {
+		return TemplateCheckFailed, fmt.Sprintf("transferFrom of the approved amount reverted: %v", err)
+	}
+	allowance, err = s.callUint(0, "allowance", s.accounts[0], s.accounts[2])
+	if err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	if allowance.Sign() != 0 {
+		return TemplateCheckFailed, fmt.Sprintf("allowance is %s after spending it", allowance)
+	}
+	if !s.reverts(2, "transferFrom", s.accounts[0], s.accounts[1], big.NewInt(1)) {
+		return TemplateCheckFailed, "transferFrom beyond the allowance does not revert"
+	}
+	if err := s.checkSupply(supply, big.NewInt(0)); err != nil {
+		return TemplateCheckFailed, err.Error()
+	}
+	return TemplateCheckPassed, "transferFrom spends the allowance and reverts beyond it"
+}
+
+// fuzzTransfers sends random amounts between the suite accounts, checking the balances and the supply after each transfer
+func (s *templateSuite) fuzzTransfers(runs int, seed int64) (string, string) {
+	if runs <= 0 {
+		return TemplateCheckSkipped, "no fuzz runs requested"
+	}
+	random := rand.New(rand.NewSource(seed))
+	for run := 1; run <= runs; run++ {
+		balances, err := s.balances()
+		if err != nil {
+			return TemplateCheckFailed, err.Error()
+		}
+		from := random.Intn(len(s.accounts))
+		if balances[from].Sign() == 0 {
+			from = 0
+		}
+		to := random.Intn(len(s.accounts))
+		amount := big.NewInt(0)
+		if balances[from].Sign() > 0 {
+			amount = new(big.Int).Rand(random, new(big.Int).Add(balances[from], big.NewInt(1)))
+		}
+		if err := s.transferAndCheck(from, to, amount); err != nil {
+			return TemplateCheckFailed, fmt.Sprintf("run %d (account %d to %d, amount %s): %v", run, from, to, amount, err)
+		}
+	}
+	return TemplateCheckPassed, fmt.Sprintf("%d random transfers conserved the balances and the total supply", runs)
+}
+
+func sumBalances(values []*big.Int) *big.Int {
+	total := big.NewInt(0)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	return total
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// TokenFeeSetting is a fee setting of a taxed token
+type TokenFeeSetting string
+
+const (
+	TokenFeeSettingBuyFee      TokenFeeSetting = "buy_fee"
+	TokenFeeSettingSellFee     TokenFeeSetting = "sell_fee"
+	TokenFeeSettingFeeReceiver TokenFeeSetting = "fee_receiver"
+)
+
+// TokenFeeSettings is the order in which the fee settings are read and updated
+var TokenFeeSettings = []TokenFeeSetting{TokenFeeSettingBuyFee, TokenFeeSettingSellFee, TokenFeeSettingFeeReceiver}
+
+// TokenFeeFunctions are the contract functions used to read, update and bound a fee setting
+type TokenFeeFunctions struct {
+	// Setter takes the new value as its only argument
+	Setter string
+	// Getter is the view function returning the current value, empty if the contract has none
+	Getter string
+	// Maximum is the view function or public constant returning the highest accepted fee, empty if the template declares none
+	Maximum string
+}
+
+// tokenFeeSetterNames are the setters used by common taxed token templates
+var tokenFeeSetterNames = map[TokenFeeSetting][]string{
+	TokenFeeSettingBuyFee:      {"setBuyFee", "setBuyTax", "updateBuyFee", "updateBuyTax"},
+	TokenFeeSettingSellFee:     {"setSellFee", "setSellTax", "updateSellFee", "updateSellTax"},
+	TokenFeeSettingFeeReceiver: {"setFeeReceiver", "setFeeWallet", "setTaxWallet", "setTreasury", "updateFeeReceiver"},
+}
+
+// tokenFeeGetterNames are the getters and public variables holding the current values
+var tokenFeeGetterNames = map[TokenFeeSetting][]string{
+	TokenFeeSettingBuyFee:      {"buyFee", "buyTax", "getBuyFee", "buyFeeBps"},
+	TokenFeeSettingSellFee:     {"sellFee", "sellTax", "getSellFee", "sellFeeBps"},
+	TokenFeeSettingFeeReceiver: {"feeReceiver", "feeWallet", "taxWallet", "treasury", "getFeeReceiver"},
+}
+
+// tokenFeeMaximumNames are the maximums declared by the templates, the shared MAX_FEE bounds both fees
+var tokenFeeMaximumNames = map[TokenFeeSetting][]string{
+	TokenFeeSettingBuyFee:  {"MAX_BUY_FEE", "MAX_BUY_TAX", "maxBuyFee", "MAX_FEE", "MAX_TAX", "maxFee"},
+	TokenFeeSettingSellFee: {"MAX_SELL_FEE", "MAX_SELL_TAX", "maxSellFee", "MAX_FEE", "MAX_TAX", "maxFee"},
+}
+
+// FindTokenFeeFunctions finds the fee functions of the ABI, keyed by the setting.
+// Settings without a setter are left out, an error is returned when the contract has no fee setter at all.
+func FindTokenFeeFunctions(abiJSON string) (map[TokenFeeSetting]TokenFeeFunctions, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	functions := map[TokenFeeSetting]TokenFeeFunctions{}
+	for _, setting := range TokenFeeSettings {
+		valueType := abi.UintTy
+		if setting == TokenFeeSettingFeeReceiver {
+			valueType = abi.AddressTy
+		}
+
+		// Fee setters must take a uint256, the function arguments are encoded as big integers
+		setter := findMethod(parsedABI, tokenFeeSetterNames[setting], func(method abi.Method) bool {
+			return !method.IsConstant() && len(method.Inputs) == 1 && method.Inputs[0].Type.T == valueType &&
+				(valueType != abi.UintTy || method.Inputs[0].Type.Size == 256)
+		})
+		if setter == "" {
+			continue
+		}
+
+		isView := func(method abi.Method) bool {
+			return method.IsConstant() && len(method.Inputs) == 0 && len(method.Outputs) == 1 && method.Outputs[0].Type.T == valueType
+		}
+		functions[setting] = TokenFeeFunctions{
+			Setter:  setter,
+			Getter:  findMethod(parsedABI, tokenFeeGetterNames[setting], isView),
+			Maximum: findMethod(parsedABI, tokenFeeMaximumNames[setting], isView),
+		}
+	}
+
+	if len(functions) == 0 {
+		return nil, fmt.Errorf("contract has no fee functions such as setBuyFee, setSellFee or setFeeReceiver")
+	}
+	return functions, nil
+}
+
+// findMethod returns the raw name of the first method matching one of the names in order and the filter
+func findMethod(parsedABI abi.ABI, names []string, filter func(abi.Method) bool) string {
+	for _, name := range names {
+		for _, method := range parsedABI.Methods {
+			if strings.EqualFold(method.RawName, name) && filter(method) {
+				return method.RawName
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenFeesTestAbi = `[
+	{"type":"function","name":"setBuyFee","stateMutability":"nonpayable","inputs":[{"name":"fee","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"setSellTax","stateMutability":"nonpayable","inputs":[{"name":"tax","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"setFeeReceiver","stateMutability":"nonpayable","inputs":[{"name":"receiver","type":"address"}],"outputs":[]},
+	{"type":"function","name":"buyFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"sellTax","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"feeReceiver","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"MAX_BUY_FEE","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"MAX_FEE","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+func TestFindTokenFeeFunctions(t *testing.T) {
+	functions, err := FindTokenFeeFunctions(tokenFeesTestAbi)
+	require.NoError(t, err)
+
+	// The specific maximum is preferred over the shared one
+	assert.Equal(t, TokenFeeFunctions{Setter: "setBuyFee", Getter: "buyFee", Maximum: "MAX_BUY_FEE"}, functions[TokenFeeSettingBuyFee])
+	assert.Equal(t, TokenFeeFunctions{Setter: "setSellTax", Getter: "sellTax", Maximum: "MAX_FEE"}, functions[TokenFeeSettingSellFee])
+	assert.Equal(t, TokenFeeFunctions{Setter: "setFeeReceiver", Getter: "feeReceiver"}, functions[TokenFeeSettingFeeReceiver])
+}
+
+func TestFindTokenFeeFunctionsWithoutSetters(t *testing.T) {
+	abiJSON := `[{"type":"function","name":"buyFee","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}]`
+
+	_, err := FindTokenFeeFunctions(abiJSON)
+	assert.ErrorContains(t, err, "contract has no fee functions")
+}
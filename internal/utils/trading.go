@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// EnableTradingFunction is the contract function that opens trading of a token gating its transfers
+type EnableTradingFunction struct {
+	Name string
+	// WithFlag functions are setters such as setTradingEnabled(bool) called with true
+	WithFlag bool
+}
+
+// enableTradingFunctionNames are the functions without arguments used by common templates to open trading
+var enableTradingFunctionNames = []string{"enableTrading", "openTrading", "startTrading", "launchTrading"}
+
+// enableTradingSetterNames are the setters taking a bool telling whether trading is open
+var enableTradingSetterNames = []string{"setTradingEnabled", "setTradingOpen", "setTradingActive", "setTrading"}
+
+// FindEnableTradingFunction finds the function of the ABI that opens trading
+func FindEnableTradingFunction(abiJSON string) (*EnableTradingFunction, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	name := findMethod(parsedABI, enableTradingFunctionNames, func(method abi.Method) bool {
+		return !method.IsConstant() && len(method.Inputs) == 0
+	})
+	if name != "" {
+		return &EnableTradingFunction{Name: name}, nil
+	}
+
+	name = findMethod(parsedABI, enableTradingSetterNames, func(method abi.Method) bool {
+		return !method.IsConstant() && len(method.Inputs) == 1 && method.Inputs[0].Type.T == abi.BoolTy
+	})
+	if name != "" {
+		return &EnableTradingFunction{Name: name, WithFlag: true}, nil
+	}
+
+	return nil, fmt.Errorf("contract has no function to enable trading such as enableTrading()")
+}
+
+// Args returns the arguments of the call opening trading
+func (f *EnableTradingFunction) Args() []any {
+	if f.WithFlag {
+		return []any{true}
+	}
+	return []any{}
+}
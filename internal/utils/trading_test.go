@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindEnableTradingFunction(t *testing.T) {
+	abiJSON := `[
+		{"type":"function","name":"tradingEnabled","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bool"}]},
+		{"type":"function","name":"enableTrading","stateMutability":"nonpayable","inputs":[],"outputs":[]}
+	]`
+	function, err := FindEnableTradingFunction(abiJSON)
+	require.NoError(t, err)
+	assert.Equal(t, EnableTradingFunction{Name: "enableTrading"}, *function)
+	assert.Empty(t, function.Args())
+
+	abiJSON = `[{"type":"function","name":"setTradingEnabled","stateMutability":"nonpayable","inputs":[{"name":"enabled","type":"bool"}],"outputs":[]}]`
+	function, err = FindEnableTradingFunction(abiJSON)
+	require.NoError(t, err)
+	assert.Equal(t, EnableTradingFunction{Name: "setTradingEnabled", WithFlag: true}, *function)
+	assert.Equal(t, []any{true}, function.Args())
+}
+
+func TestFindEnableTradingFunctionMissing(t *testing.T) {
+	abiJSON := `[{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+	_, err := FindEnableTradingFunction(abiJSON)
+	assert.ErrorContains(t, err, "no function to enable trading")
+}
@@ -1,24 +1,185 @@
 package utils
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func GetTransactionSessionUrl(serverPort int, sessionId string) (string, error) {
+// EnvBaseURL is the public url of the API server used in the generated links, e.g. the url of a reverse proxy or
+// tunnel in front of it. A path prefix such as https://example.com/launchpad is kept
+const EnvBaseURL = "BASE_URL"
 
-	// Override baseUrl if BASE_URL env var is set
-	if os.Getenv("BASE_URL") != "" {
-		baseUrl := os.Getenv("BASE_URL")
-		parsedUrl, err := url.Parse(baseUrl)
-		if err != nil {
-			return "", fmt.Errorf("invalid BASE_URL env var: %w", err)
+// RequestBaseUrlContextKey is the context key of the public url of the API server derived from the forwarded headers
+// of the MCP request, used when no public url is configured
+const RequestBaseUrlContextKey = "request_base_url"
+
+// LocalAuthTokenQueryParam is the query parameter carrying the local auth token in generated urls
+const LocalAuthTokenQueryParam = "token"
+
+// localAuthToken is the per-run secret required by the API server in stdio mode, empty when not required
+var localAuthToken string
+
+// GenerateLocalAuthToken returns a random secret for a single run of the server
+func GenerateLocalAuthToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate local auth token: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// publicBaseUrl is the --public-url of the command line, it overrides BASE_URL
+var publicBaseUrl string
+
+// SetPublicBaseUrl sets the public url of the API server used in the generated links, overriding BASE_URL.
+// It must be called before the servers start
+func SetPublicBaseUrl(baseUrl string) error {
+	if baseUrl != "" {
+		if _, err := parseBaseUrl(baseUrl); err != nil {
+			return err
 		}
-		parsedUrl.Path = fmt.Sprintf("/tx/%s", sessionId)
-		return parsedUrl.String(), nil
 	}
+	publicBaseUrl = baseUrl
+	return nil
+}
+
+// WithRequestBaseUrl stores the public url of the API server the request was received on in the context
+func WithRequestBaseUrl(ctx context.Context, baseUrl string) context.Context {
+	return context.WithValue(ctx, RequestBaseUrlContextKey, baseUrl)
+}
+
+// SetLocalAuthToken sets the secret appended to every generated url. It must be called before the servers start
+func SetLocalAuthToken(token string) {
+	localAuthToken = token
+}
+
+// GetTransactionSessionUrl returns the url of the signing page of a session, signed when LAUNCHPAD_SESSION_URL_SECRET is set
+func GetTransactionSessionUrl(ctx context.Context, serverPort int, sessionId string) (string, error) {
+	query := url.Values{}
+	if signature := SignSessionID(sessionId); signature != "" {
+		query.Set(SessionSignatureQueryParam, signature)
+	}
+	return getServerUrlWithQuery(ctx, serverPort, fmt.Sprintf("/tx/%s", sessionId), query)
+}
+
+// GetDeploymentTypingsUrl returns the download url of the TypeScript typings for a deployment, signed and expiring
+// after DownloadURLTTL when LAUNCHPAD_SESSION_URL_SECRET is set
+func GetDeploymentTypingsUrl(ctx context.Context, serverPort int, deploymentId uint) (string, error) {
+	return getSignedDownloadUrl(ctx, serverPort, fmt.Sprintf("/api/deployments/%d/typings", deploymentId))
+}
+
+// GetDeploymentArtifactsUrl returns the download url of the zip archive of the artifacts of a deployment, signed and
+// expiring after DownloadURLTTL when LAUNCHPAD_SESSION_URL_SECRET is set
+func GetDeploymentArtifactsUrl(ctx context.Context, serverPort int, deploymentId uint) (string, error) {
+	return getSignedDownloadUrl(ctx, serverPort, fmt.Sprintf("/deployments/%d/artifacts", deploymentId))
+}
+
+// GetTokenInfoUrl returns the url of the public token info of a deployment
+func GetTokenInfoUrl(ctx context.Context, serverPort int, deploymentId uint) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/tokens/%d", deploymentId))
+}
+
+// GetTokenLogoUrl returns the url of the logo of a deployment served by the launchpad
+func GetTokenLogoUrl(ctx context.Context, serverPort int, deploymentId uint) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/tokens/%d/logo", deploymentId))
+}
+
+// GetTokenListUrl returns the url of the token list of the tokens with metadata
+func GetTokenListUrl(ctx context.Context, serverPort int) (string, error) {
+	return getServerUrl(ctx, serverPort, "/tokens/tokenlist.json")
+}
+
+// GetPoolPageUrl returns the url of the detail page of a liquidity pool
+func GetPoolPageUrl(ctx context.Context, serverPort int, poolId uint) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/pool/%d", poolId))
+}
 
-	url := fmt.Sprintf("http://localhost:%d/tx/%s", serverPort, sessionId)
-	return url, nil
+// GetLaunchCountdownUrl returns the url of the public countdown page of a trading launch
+func GetLaunchCountdownUrl(ctx context.Context, serverPort int, launchId uint) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/launch/%d", launchId))
+}
+
+// GetBondingCurveProgressUrl returns the url of the public progress of a bonding curve
+func GetBondingCurveProgressUrl(ctx context.Context, serverPort int, curveId uint) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/bonding-curves/%d/progress", curveId))
+}
+
+// GetBalancePageUrl returns the url of the balance page of a balance query session
+func GetBalancePageUrl(ctx context.Context, serverPort int, sessionId string) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/balance/%s", sessionId))
+}
+
+// GetRemoveLiquidityUrl returns the url of the signing page of a liquidity removal session
+func GetRemoveLiquidityUrl(ctx context.Context, serverPort int, sessionId string) (string, error) {
+	return getServerUrl(ctx, serverPort, fmt.Sprintf("/liquidity/remove/%s", sessionId))
+}
+
+// getSignedDownloadUrl builds the url of a download on the API server, signed and expiring after DownloadURLTTL when
+// LAUNCHPAD_SESSION_URL_SECRET is set
+func getSignedDownloadUrl(ctx context.Context, serverPort int, path string) (string, error) {
+	query := url.Values{}
+	expires := time.Now().Add(DownloadURLTTL).Unix()
+	if signature := SignDownload(path, expires); signature != "" {
+		query.Set(DownloadExpiresQueryParam, strconv.FormatInt(expires, 10))
+		query.Set(SessionSignatureQueryParam, signature)
+	}
+	return getServerUrlWithQuery(ctx, serverPort, path, query)
+}
+
+// getServerUrl builds an absolute url for the given path on the API server
+func getServerUrl(ctx context.Context, serverPort int, path string) (string, error) {
+	return getServerUrlWithQuery(ctx, serverPort, path, url.Values{})
+}
+
+// getServerUrlWithQuery builds an absolute url for the given path and query on the API server. The public url is
+// the --public-url, BASE_URL, the forwarded url of the MCP request, then localhost
+func getServerUrlWithQuery(ctx context.Context, serverPort int, path string, query url.Values) (string, error) {
+	baseUrl := publicBaseUrl
+	if baseUrl == "" {
+		baseUrl = os.Getenv(EnvBaseURL)
+	}
+	if baseUrl == "" && ctx != nil {
+		baseUrl, _ = ctx.Value(RequestBaseUrlContextKey).(string)
+	}
+	if baseUrl == "" {
+		url := fmt.Sprintf("http://localhost:%d%s", serverPort, path)
+		return withQuery(url, query), nil
+	}
+
+	parsedUrl, err := parseBaseUrl(baseUrl)
+	if err != nil {
+		return "", err
+	}
+	parsedUrl.Path = strings.TrimSuffix(parsedUrl.Path, "/") + path
+	return withQuery(parsedUrl.String(), query), nil
+}
+
+// parseBaseUrl parses a public url of the API server, it must be an absolute http or https url
+func parseBaseUrl(baseUrl string) (*url.URL, error) {
+	parsedUrl, err := url.Parse(baseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public url %q: %w", baseUrl, err)
+	}
+	if (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		return nil, fmt.Errorf("invalid public url %q: must be an absolute http or https url", baseUrl)
+	}
+	return parsedUrl, nil
+}
+
+// withQuery appends the query and the local auth token, when one is set, to the url
+func withQuery(rawUrl string, query url.Values) string {
+	if localAuthToken != "" {
+		query.Set(LocalAuthTokenQueryParam, localAuthToken)
+	}
+	if len(query) == 0 {
+		return rawUrl
+	}
+	return rawUrl + "?" + query.Encode()
 }
@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"context"
+	neturl "net/url"
 	"os"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -93,8 +97,136 @@ func TestGetTransactionSessionUrl(t *testing.T) {
 			tt.setup()
 			defer tt.cleanup()
 
-			url, err := GetTransactionSessionUrl(tt.serverPort, tt.sessionId)
+			url, err := GetTransactionSessionUrl(context.Background(), tt.serverPort, tt.sessionId)
 			tt.validate(t, url, err)
 		})
 	}
 }
+
+func TestGetDeploymentTypingsUrl(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	t.Setenv(EnvSessionURLSecret, "")
+	url, err := GetDeploymentTypingsUrl(context.Background(), 9000, 12)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000/api/deployments/12/typings", url)
+
+	t.Setenv("BASE_URL", "https://api.example.com/")
+	url, err = GetDeploymentTypingsUrl(context.Background(), 9000, 12)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/api/deployments/12/typings", url)
+
+	t.Setenv(EnvSessionURLSecret, "session-url-secret")
+	url, err = GetDeploymentTypingsUrl(context.Background(), 9000, 12)
+	require.NoError(t, err)
+	parsed, err := neturl.Parse(url)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/deployments/12/typings", parsed.Path)
+	assert.True(t, VerifyDownloadSignature(parsed.Path, parsed.Query().Get(DownloadExpiresQueryParam), parsed.Query().Get(SessionSignatureQueryParam)))
+}
+
+func TestGetPoolPageUrl(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	url, err := GetPoolPageUrl(context.Background(), 9000, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000/pool/3", url)
+
+	t.Setenv("BASE_URL", "https://api.example.com")
+	url, err = GetPoolPageUrl(context.Background(), 9000, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/pool/3", url)
+}
+
+func TestGetLaunchCountdownUrl(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	url, err := GetLaunchCountdownUrl(context.Background(), 9000, 5)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000/launch/5", url)
+}
+
+func TestGetTransactionSessionUrlWithLocalAuthToken(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	SetLocalAuthToken("secret")
+	defer SetLocalAuthToken("")
+
+	url, err := GetTransactionSessionUrl(context.Background(), 9000, "session-1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000/tx/session-1?token=secret", url)
+
+	token, err := GenerateLocalAuthToken()
+	require.NoError(t, err)
+	assert.Len(t, token, 64)
+}
+
+func TestGetTransactionSessionUrlWithSignature(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	t.Setenv(EnvSessionURLSecret, "session-url-secret")
+
+	url, err := GetTransactionSessionUrl(context.Background(), 9000, "session-1")
+	require.NoError(t, err)
+	signature := SignSessionID("session-1")
+	assert.NotEmpty(t, signature)
+	assert.Equal(t, "http://localhost:9000/tx/session-1?sig="+signature, url)
+
+	assert.True(t, VerifySessionSignature("session-1", signature))
+	assert.False(t, VerifySessionSignature("session-2", signature))
+	assert.False(t, VerifySessionSignature("session-1", ""))
+
+	t.Setenv(EnvSessionURLSecret, "")
+	assert.False(t, VerifySessionSignature("session-1", signature))
+}
+
+func TestGetDeploymentArtifactsUrlWithSignature(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	t.Setenv(EnvSessionURLSecret, "")
+	url, err := GetDeploymentArtifactsUrl(context.Background(), 9000, 12)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9000/deployments/12/artifacts", url)
+
+	t.Setenv(EnvSessionURLSecret, "session-url-secret")
+	url, err = GetDeploymentArtifactsUrl(context.Background(), 9000, 12)
+	require.NoError(t, err)
+	parsed, err := neturl.Parse(url)
+	require.NoError(t, err)
+	assert.Equal(t, "/deployments/12/artifacts", parsed.Path)
+	expires := parsed.Query().Get(DownloadExpiresQueryParam)
+	signature := parsed.Query().Get(SessionSignatureQueryParam)
+
+	assert.True(t, VerifyDownloadSignature("/deployments/12/artifacts", expires, signature))
+	assert.False(t, VerifyDownloadSignature("/deployments/13/artifacts", expires, signature))
+	assert.False(t, VerifyDownloadSignature("/deployments/12/artifacts", expires+"0", signature))
+
+	expired := time.Now().Add(-time.Minute).Unix()
+	assert.False(t, VerifyDownloadSignature("/deployments/12/artifacts", strconv.FormatInt(expired, 10), SignDownload("/deployments/12/artifacts", expired)))
+}
+
+func TestGetServerUrlPublicBaseUrl(t *testing.T) {
+	t.Setenv("BASE_URL", "https://proxy.example.com/launchpad/")
+	url, err := GetPoolPageUrl(context.Background(), 9000, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "https://proxy.example.com/launchpad/pool/3", url)
+
+	// --public-url overrides BASE_URL
+	require.NoError(t, SetPublicBaseUrl("https://tunnel.example.com"))
+	defer SetPublicBaseUrl("")
+	url, err = GetPoolPageUrl(context.Background(), 9000, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "https://tunnel.example.com/pool/3", url)
+
+	assert.Error(t, SetPublicBaseUrl("tunnel.example.com"))
+	assert.Error(t, SetPublicBaseUrl("ftp://tunnel.example.com"))
+}
+
+func TestGetServerUrlRequestBaseUrl(t *testing.T) {
+	t.Setenv("BASE_URL", "")
+	ctx := WithRequestBaseUrl(context.Background(), "https://forwarded.example.com/mcp-prefix")
+
+	url, err := GetBalancePageUrl(ctx, 9000, "session-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://forwarded.example.com/mcp-prefix/balance/session-1", url)
+
+	// A configured public url wins over the forwarded headers
+	t.Setenv("BASE_URL", "https://public.example.com")
+	url, err = GetRemoveLiquidityUrl(ctx, 9000, "session-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://public.example.com/liquidity/remove/session-1", url)
+}
@@ -0,0 +1,430 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+)
+
+const (
+	// DefaultEntryPointAddress is the ERC-4337 v0.6 EntryPoint, deployed at the same address on every chain
+	DefaultEntryPointAddress = "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"
+	// DefaultAccountFactoryAddress is the SimpleAccountFactory of the v0.6 reference implementation
+	DefaultAccountFactoryAddress = "0x9406Cc6185a346906296840746125a0E44976454"
+	// DeterministicDeployerAddress is the CREATE2 deployer proxy the smart accounts create contracts through,
+	// as SimpleAccount can only call other contracts
+	DeterministicDeployerAddress = "0x4e59b44847b379578588920cA78FbF26c0B4956C"
+	// DummyUserOperationSignature is a well formed signature of SimpleAccount used to estimate the gas of an unsigned operation
+	DummyUserOperationSignature = "0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c"
+)
+
+// accountAbstractionABI holds the functions of the EntryPoint, SimpleAccountFactory and SimpleAccount used to build operations
+const accountAbstractionABI = `[{"inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"name":"createAccount","outputs":[{"name":"ret","type":"address"}],"stateMutability":"nonpayable","type":"function"},` +
+	`{"inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"name":"getAddress","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},` +
+	`{"inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"name":"getNonce","outputs":[{"name":"nonce","type":"uint256"}],"stateMutability":"view","type":"function"},` +
+	`{"inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"name":"execute","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// SmartAccount is the counterfactual SimpleAccount of a wallet, it is deployed by the initCode of its first operation
+type SmartAccount struct {
+	Owner    string `json:"owner"`
+	Address  string `json:"address"`
+	Factory  string `json:"factory"`
+	Deployed bool   `json:"deployed"`
+}
+
+// UserOperationGas is the gas estimate of a bundler, or the gas the paymaster sponsors
+type UserOperationGas struct {
+	CallGasLimit         string
+	VerificationGasLimit string
+	PreVerificationGas   string
+}
+
+// UserOperationReceipt is the result of an operation included in a bundle
+type UserOperationReceipt struct {
+	UserOperationHash string
+	Success           bool
+	// Reason is the revert reason of a failed operation
+	Reason          string
+	TransactionHash string
+}
+
+func parseAccountAbstractionABI() (abi.ABI, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(accountAbstractionABI))
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("failed to parse account abstraction ABI: %w", err)
+	}
+	return parsedABI, nil
+}
+
+// ReadSmartAccount reads the address of the smart account of owner from the factory and whether it is deployed yet
+func ReadSmartAccount(rpcURL, factory, owner string) (*SmartAccount, error) {
+	parsedABI, err := parseAccountAbstractionABI()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsedABI.Pack("getAddress", common.HexToAddress(owner), big.NewInt(0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack getAddress: %w", err)
+	}
+
+	client := NewRPCClient(rpcURL)
+	response, err := client.Call("eth_call", []interface{}{map[string]string{"to": factory, "data": hexutil.Encode(data)}, "latest"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the smart account of %s: %w", owner, err)
+	}
+	result, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid response format")
+	}
+	outputs, err := parsedABI.Unpack("getAddress", common.FromHex(result))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the smart account of %s, is %s an account factory: %w", owner, factory, err)
+	}
+	address := outputs[0].(common.Address).Hex()
+
+	response, err = client.Call("eth_getCode", []interface{}{address, "latest"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the code of %s: %w", address, err)
+	}
+	code, _ := response.Result.(string)
+
+	return &SmartAccount{
+		Owner:    common.HexToAddress(owner).Hex(),
+		Address:  address,
+		Factory:  common.HexToAddress(factory).Hex(),
+		Deployed: len(common.FromHex(code)) > 0,
+	}, nil
+}
+
+// SmartAccountInitCode returns the initCode deploying the smart account of owner with the factory
+func SmartAccountInitCode(factory, owner string) (string, error) {
+	parsedABI, err := parseAccountAbstractionABI()
+	if err != nil {
+		return "", err
+	}
+	data, err := parsedABI.Pack("createAccount", common.HexToAddress(owner), big.NewInt(0))
+	if err != nil {
+		return "", fmt.Errorf("failed to pack createAccount: %w", err)
+	}
+	return hexutil.Encode(append(common.HexToAddress(factory).Bytes(), data...)), nil
+}
+
+// EncodeSmartAccountExecute encodes the call of the smart account sending value and data to the contract to
+func EncodeSmartAccountExecute(to string, value *big.Int, data string) (string, error) {
+	parsedABI, err := parseAccountAbstractionABI()
+	if err != nil {
+		return "", err
+	}
+	callData, err := parsedABI.Pack("execute", common.HexToAddress(to), value, common.FromHex(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to pack execute: %w", err)
+	}
+	return hexutil.Encode(callData), nil
+}
+
+// EncodeSmartAccountDeploy encodes the call of the smart account creating a contract from its creation code through
+// the deterministic deployer and returns the address of the contract. Contracts created this way see the deployer as
+// msg.sender in their constructor, templates taking their owner from msg.sender should take it as an argument instead
+func EncodeSmartAccountDeploy(value *big.Int, creationCode string, salt common.Hash) (string, string, error) {
+	code := common.FromHex(creationCode)
+	if len(code) == 0 {
+		return "", "", fmt.Errorf("the contract creation code is empty")
+	}
+	callData, err := EncodeSmartAccountExecute(DeterministicDeployerAddress, value, hexutil.Encode(append(salt.Bytes(), code...)))
+	if err != nil {
+		return "", "", err
+	}
+	contractAddress := crypto.CreateAddress2(common.HexToAddress(DeterministicDeployerAddress), salt, crypto.Keccak256(code))
+	return callData, contractAddress.Hex(), nil
+}
+
+// ReadEntryPointNonce reads the next nonce of the smart account from the EntryPoint, 0 for an account not deployed yet
+func ReadEntryPointNonce(rpcURL, entryPoint, sender string) (*big.Int, error) {
+	parsedABI, err := parseAccountAbstractionABI()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsedABI.Pack("getNonce", common.HexToAddress(sender), big.NewInt(0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack getNonce: %w", err)
+	}
+	response, err := NewRPCClient(rpcURL).Call("eth_call", []interface{}{map[string]string{"to": entryPoint, "data": hexutil.Encode(data)}, "latest"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the nonce of %s: %w", sender, err)
+	}
+	result, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid response format")
+	}
+	outputs, err := parsedABI.Unpack("getNonce", common.FromHex(result))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the nonce of %s: %w", sender, err)
+	}
+	return outputs[0].(*big.Int), nil
+}
+
+// UserOperationGasFees returns the maxFeePerGas and maxPriorityFeePerGas of an operation sent now. The max fee is
+// twice the gas price so the operation survives a rising base fee until it is bundled
+func UserOperationGasFees(rpcURL string) (*big.Int, *big.Int, error) {
+	client := NewRPCClient(rpcURL)
+	gasPrice, err := rpcBigQuantity(client, "eth_gasPrice")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the gas price: %w", err)
+	}
+	priorityFee, err := rpcBigQuantity(client, "eth_maxPriorityFeePerGas")
+	if err != nil {
+		// chains without EIP-1559 pay the whole gas price as priority fee
+		priorityFee = gasPrice
+	}
+
+	maxFee := new(big.Int).Mul(gasPrice, big.NewInt(2))
+	if priorityFee.Cmp(maxFee) > 0 {
+		priorityFee = maxFee
+	}
+	return maxFee, priorityFee, nil
+}
+
+// UserOperationHash returns the hash the smart account owner signs, as computed by getUserOpHash of the v0.6 EntryPoint
+func UserOperationHash(op *models.UserOperationData, entryPoint string, chainID *big.Int) (common.Hash, error) {
+	quantities := map[string]*big.Int{}
+	for name, value := range map[string]string{
+		"nonce":                op.Nonce,
+		"callGasLimit":         op.CallGasLimit,
+		"verificationGasLimit": op.VerificationGasLimit,
+		"preVerificationGas":   op.PreVerificationGas,
+		"maxFeePerGas":         op.MaxFeePerGas,
+		"maxPriorityFeePerGas": op.MaxPriorityFeePerGas,
+	} {
+		quantity, ok := new(big.Int).SetString(strings.TrimPrefix(value, "0x"), 16)
+		if !ok {
+			return common.Hash{}, fmt.Errorf("invalid %s %q of the user operation", name, value)
+		}
+		quantities[name] = quantity
+	}
+	if !common.IsHexAddress(op.Sender) {
+		return common.Hash{}, fmt.Errorf("invalid sender %q of the user operation", op.Sender)
+	}
+
+	addressType, _ := abi.NewType("address", "", nil)
+	uintType, _ := abi.NewType("uint256", "", nil)
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	packed, err := abi.Arguments{
+		{Type: addressType}, {Type: uintType}, {Type: bytes32Type}, {Type: bytes32Type}, {Type: uintType},
+		{Type: uintType}, {Type: uintType}, {Type: uintType}, {Type: uintType}, {Type: bytes32Type},
+	}.Pack(
+		common.HexToAddress(op.Sender),
+		quantities["nonce"],
+		crypto.Keccak256Hash(common.FromHex(op.InitCode)),
+		crypto.Keccak256Hash(common.FromHex(op.CallData)),
+		quantities["callGasLimit"],
+		quantities["verificationGasLimit"],
+		quantities["preVerificationGas"],
+		quantities["maxFeePerGas"],
+		quantities["maxPriorityFeePerGas"],
+		crypto.Keccak256Hash(common.FromHex(op.PaymasterAndData)),
+	)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to pack the user operation: %w", err)
+	}
+
+	encoded, err := abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: uintType}}.Pack(
+		crypto.Keccak256Hash(packed), common.HexToAddress(entryPoint), chainID,
+	)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to pack the user operation hash: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// RecoverUserOperationSigner recovers the wallet that signed the hash of an operation with personal_sign,
+// the signature SimpleAccount checks against its owner
+func RecoverUserOperationSigner(userOperationHash common.Hash, signature string) (string, error) {
+	sigData, err := hexutil.Decode(signature)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(sigData) != 65 {
+		return "", fmt.Errorf("signature must be exactly 65 bytes")
+	}
+	if sigData[64] >= 27 {
+		sigData[64] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(accounts.TextHash(userOperationHash.Bytes()), sigData)
+	if err != nil {
+		return "", fmt.Errorf("failed to recover public key: %w", err)
+	}
+	return crypto.PubkeyToAddress(*publicKey).Hex(), nil
+}
+
+func newBundlerClient(url string) *RPCClient {
+	client := NewRPCClient(url)
+	client.SetTimeout(15 * time.Second)
+	return client
+}
+
+// EstimateUserOperationGas asks the bundler for the gas limits of an operation signed with DummyUserOperationSignature
+func EstimateUserOperationGas(bundlerRPC string, op *models.UserOperationData, entryPoint string) (*UserOperationGas, error) {
+	response, err := newBundlerClient(bundlerRPC).Call("eth_estimateUserOperationGas", []interface{}{op, entryPoint})
+	if err != nil {
+		return nil, fmt.Errorf("bundler failed to estimate the user operation: %w", err)
+	}
+	var result struct {
+		CallGasLimit         any `json:"callGasLimit"`
+		VerificationGasLimit any `json:"verificationGasLimit"`
+		PreVerificationGas   any `json:"preVerificationGas"`
+	}
+	if err := remarshal(response.Result, &result); err != nil {
+		return nil, fmt.Errorf("invalid user operation gas estimate: %w", err)
+	}
+
+	gas := &UserOperationGas{}
+	for target, value := range map[*string]any{
+		&gas.CallGasLimit:         result.CallGasLimit,
+		&gas.VerificationGasLimit: result.VerificationGasLimit,
+		&gas.PreVerificationGas:   result.PreVerificationGas,
+	} {
+		quantity, err := hexQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user operation gas estimate: %w", err)
+		}
+		*target = quantity
+	}
+	return gas, nil
+}
+
+// SponsorUserOperation asks the paymaster to sponsor an operation with pm_sponsorUserOperation and returns its
+// paymasterAndData, with the gas limits of the operation when the paymaster sets them
+func SponsorUserOperation(paymasterRPC string, op *models.UserOperationData, entryPoint string) (string, *UserOperationGas, error) {
+	response, err := newBundlerClient(paymasterRPC).Call("pm_sponsorUserOperation", []interface{}{op, entryPoint})
+	if err != nil {
+		return "", nil, fmt.Errorf("paymaster refused to sponsor the user operation: %w", err)
+	}
+
+	// the paymasters of the v0.6 EntryPoint answer the paymasterAndData alone or with the gas limits they signed
+	if paymasterAndData, ok := response.Result.(string); ok {
+		return paymasterAndData, nil, nil
+	}
+	var result struct {
+		PaymasterAndData     string `json:"paymasterAndData"`
+		CallGasLimit         any    `json:"callGasLimit"`
+		VerificationGasLimit any    `json:"verificationGasLimit"`
+		PreVerificationGas   any    `json:"preVerificationGas"`
+	}
+	if err := remarshal(response.Result, &result); err != nil || result.PaymasterAndData == "" {
+		return "", nil, fmt.Errorf("paymaster did not return a paymasterAndData")
+	}
+	if result.CallGasLimit == nil || result.VerificationGasLimit == nil || result.PreVerificationGas == nil {
+		return result.PaymasterAndData, nil, nil
+	}
+
+	gas := &UserOperationGas{}
+	for target, value := range map[*string]any{
+		&gas.CallGasLimit:         result.CallGasLimit,
+		&gas.VerificationGasLimit: result.VerificationGasLimit,
+		&gas.PreVerificationGas:   result.PreVerificationGas,
+	} {
+		quantity, err := hexQuantity(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid sponsored gas: %w", err)
+		}
+		*target = quantity
+	}
+	return result.PaymasterAndData, gas, nil
+}
+
+// SendUserOperation sends a signed operation to the bundler and returns its hash
+func SendUserOperation(bundlerRPC string, op *models.UserOperationData, entryPoint string) (string, error) {
+	response, err := newBundlerClient(bundlerRPC).Call("eth_sendUserOperation", []interface{}{op, entryPoint})
+	if err != nil {
+		return "", fmt.Errorf("bundler rejected the user operation: %w", err)
+	}
+	hash, ok := response.Result.(string)
+	if !ok || hash == "" {
+		return "", fmt.Errorf("bundler did not return a user operation hash")
+	}
+	return hash, nil
+}
+
+// GetUserOperationReceipt returns the receipt of an operation, nil while the operation is not bundled yet
+func GetUserOperationReceipt(bundlerRPC, userOperationHash string) (*UserOperationReceipt, error) {
+	response, err := newBundlerClient(bundlerRPC).Call("eth_getUserOperationReceipt", []interface{}{userOperationHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the user operation receipt: %w", err)
+	}
+	if response.Result == nil {
+		return nil, nil
+	}
+
+	var result struct {
+		UserOpHash string `json:"userOpHash"`
+		Success    bool   `json:"success"`
+		Reason     string `json:"reason"`
+		Receipt    struct {
+			TransactionHash string `json:"transactionHash"`
+		} `json:"receipt"`
+	}
+	if err := remarshal(response.Result, &result); err != nil {
+		return nil, fmt.Errorf("invalid user operation receipt: %w", err)
+	}
+	return &UserOperationReceipt{
+		UserOperationHash: result.UserOpHash,
+		Success:           result.Success,
+		Reason:            result.Reason,
+		TransactionHash:   result.Receipt.TransactionHash,
+	}, nil
+}
+
+// remarshal decodes a generic JSON-RPC result into target
+func remarshal(result any, target any) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// hexQuantity normalizes a quantity answered as a hex string or a JSON number to a hex string
+func hexQuantity(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		digits, base := v, 10
+		if strings.HasPrefix(v, "0x") {
+			digits, base = v[2:], 16
+		}
+		quantity, ok := new(big.Int).SetString(digits, base)
+		if !ok {
+			return "", fmt.Errorf("invalid quantity %q", v)
+		}
+		return hexutil.EncodeBig(quantity), nil
+	case float64:
+		return hexutil.EncodeBig(big.NewInt(int64(v))), nil
+	default:
+		return "", fmt.Errorf("invalid quantity %v", value)
+	}
+}
+
+func rpcBigQuantity(client *RPCClient, method string) (*big.Int, error) {
+	response, err := client.Call(method, []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	result, ok := response.Result.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s result %v", method, response.Result)
+	}
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(result, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s result %s", method, result)
+	}
+	return value, nil
+}
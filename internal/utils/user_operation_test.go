@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rxtech-lab/launchpad-mcp/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserOperationHash(t *testing.T) {
+	op := &models.UserOperationData{
+		Sender:               "0x1111111111111111111111111111111111111111",
+		Nonce:                "0x1",
+		InitCode:             "0x",
+		CallData:             "0xb61d27f6",
+		CallGasLimit:         "0x10000",
+		VerificationGasLimit: "0x20000",
+		PreVerificationGas:   "0xc350",
+		MaxFeePerGas:         "0x77359400",
+		MaxPriorityFeePerGas: "0x3b9aca00",
+		PaymasterAndData:     "0x",
+		Signature:            "0x",
+	}
+	hash, err := UserOperationHash(op, DefaultEntryPointAddress, big.NewInt(1))
+	require.NoError(t, err)
+
+	// getUserOpHash hashes the static words of the packed operation with the entry point and the chain id
+	word := func(value *big.Int) []byte { return common.LeftPadBytes(value.Bytes(), 32) }
+	var packed []byte
+	packed = append(packed, common.LeftPadBytes(common.HexToAddress(op.Sender).Bytes(), 32)...)
+	packed = append(packed, word(big.NewInt(1))...)
+	packed = append(packed, crypto.Keccak256(nil)...)
+	packed = append(packed, crypto.Keccak256(common.FromHex(op.CallData))...)
+	for _, gas := range []int64{0x10000, 0x20000, 0xc350, 0x77359400, 0x3b9aca00} {
+		packed = append(packed, word(big.NewInt(gas))...)
+	}
+	packed = append(packed, crypto.Keccak256(nil)...)
+	var encoded []byte
+	encoded = append(encoded, crypto.Keccak256(packed)...)
+	encoded = append(encoded, common.LeftPadBytes(common.HexToAddress(DefaultEntryPointAddress).Bytes(), 32)...)
+	encoded = append(encoded, word(big.NewInt(1))...)
+	assert.Equal(t, crypto.Keccak256Hash(encoded), hash)
+
+	// the signature is not part of the hash, the chain is
+	op.Signature = DummyUserOperationSignature
+	signed, err := UserOperationHash(op, DefaultEntryPointAddress, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, hash, signed)
+	otherChain, err := UserOperationHash(op, DefaultEntryPointAddress, big.NewInt(11155111))
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherChain)
+
+	op.Nonce = "not a nonce"
+	_, err = UserOperationHash(op, DefaultEntryPointAddress, big.NewInt(1))
+	assert.Error(t, err)
+}
+
+func TestRecoverUserOperationSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	hash := crypto.Keccak256Hash([]byte("user operation"))
+
+	// personal_sign of the 32 bytes of the hash, with the 27/28 recovery id of the wallets
+	signature, err := crypto.Sign(accounts.TextHash(hash.Bytes()), key)
+	require.NoError(t, err)
+	signature[64] += 27
+
+	signer, err := RecoverUserOperationSigner(hash, hexutil.Encode(signature))
+	require.NoError(t, err)
+	assert.Equal(t, owner, signer)
+
+	signer, err = RecoverUserOperationSigner(crypto.Keccak256Hash([]byte("other operation")), hexutil.Encode(signature))
+	require.NoError(t, err)
+	assert.NotEqual(t, owner, signer)
+
+	_, err = RecoverUserOperationSigner(hash, "0x1234")
+	assert.Error(t, err)
+}
+
+func TestSmartAccountCallData(t *testing.T) {
+	owner := "0x2222222222222222222222222222222222222222"
+	initCode, err := SmartAccountInitCode(DefaultAccountFactoryAddress, owner)
+	require.NoError(t, err)
+	// the factory address followed by createAccount(owner, 0)
+	assert.Len(t, common.FromHex(initCode), 20+4+32+32)
+	assert.Equal(t, common.HexToAddress(DefaultAccountFactoryAddress).Bytes(), common.FromHex(initCode)[:20])
+
+	callData, err := EncodeSmartAccountExecute(owner, big.NewInt(5), "0xa9059cbb")
+	require.NoError(t, err)
+	assert.Equal(t, "0xb61d27f6", callData[:10])
+
+	salt := crypto.Keccak256Hash([]byte("session:0"))
+	creationCode := "0x6080604052"
+	callData, contractAddress, err := EncodeSmartAccountDeploy(big.NewInt(0), creationCode, salt)
+	require.NoError(t, err)
+	assert.Equal(t, "0xb61d27f6", callData[:10])
+	expected := crypto.CreateAddress2(common.HexToAddress(DeterministicDeployerAddress), salt, crypto.Keccak256(common.FromHex(creationCode)))
+	assert.Equal(t, expected.Hex(), contractAddress)
+
+	_, _, err = EncodeSmartAccountDeploy(big.NewInt(0), "0x", salt)
+	assert.Error(t, err)
+}
+
+func TestHexQuantity(t *testing.T) {
+	for value, expected := range map[any]string{
+		"0x01":         "0x1",
+		"0xc350":       "0xc350",
+		"50000":        "0xc350",
+		float64(50000): "0xc350",
+	} {
+		quantity, err := hexQuantity(value)
+		require.NoError(t, err)
+		assert.Equal(t, expected, quantity)
+	}
+
+	_, err := hexQuantity("0xzz")
+	assert.Error(t, err)
+	_, err = hexQuantity(nil)
+	assert.Error(t, err)
+}
@@ -9,28 +9,46 @@ import (
 )
 
 func main() {
-	contractsDir := "../internal/contracts/openzeppelin-contracts/contracts"
+	baseDir := "../internal/contracts"
 	outputFile := "../internal/contracts/contracts.go"
 
-	// Find all .sol files
+	// The default submodule is openzeppelin-contracts, other pinned versions are vendored as
+	// openzeppelin-contracts-<version> submodules
+	entries, err := os.ReadDir(baseDir)
+	if err != nil {
+		log.Printf("Error reading directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Find all .sol files and the package.json declaring the version of every release
 	var embedPaths []string
-	err := filepath.Walk(contractsDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
+	for _, entry := range entries {
+		if !entry.IsDir() || (entry.Name() != "openzeppelin-contracts" && !strings.HasPrefix(entry.Name(), "openzeppelin-contracts-")) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(baseDir, entry.Name(), "package.json")); err == nil {
+			embedPaths = append(embedPaths, filepath.Join(entry.Name(), "package.json"))
 		}
-		if strings.HasSuffix(path, ".sol") {
-			// Convert to relative path from contracts.go location
-			relPath, err := filepath.Rel("../internal/contracts", path)
+
+		contractsDir := filepath.Join(baseDir, entry.Name(), "contracts")
+		err := filepath.Walk(contractsDir, func(path string, info os.FileInfo, err error) error {
 			if err != nil {
 				return err
 			}
-			embedPaths = append(embedPaths, relPath)
+			if strings.HasSuffix(path, ".sol") {
+				// Convert to relative path from contracts.go location
+				relPath, err := filepath.Rel(baseDir, path)
+				if err != nil {
+					return err
+				}
+				embedPaths = append(embedPaths, relPath)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Error walking directory: %v\n", err)
+			os.Exit(1)
 		}
-		return nil
-	})
-	if err != nil {
-		log.Printf("Error walking directory: %v\n", err)
-		os.Exit(1)
 	}
 
 	// Generate the contracts.go file
@@ -41,6 +59,7 @@ package contracts
 import "embed"
 
 // OpenZeppelin contracts are available as a git submodule at openzeppelin-contracts/
+// and the pinned versions at openzeppelin-contracts-<version>/
 // These contracts are embedded at compile time for easy access during Solidity compilation
 `
 